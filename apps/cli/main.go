@@ -3,14 +3,18 @@ package main
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
 	"os"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/appditto/pippin_nano_wallet/apps/server"
+	"github.com/appditto/pippin_nano_wallet/apps/server/controller"
+	"github.com/appditto/pippin_nano_wallet/libs/cache"
 	"github.com/appditto/pippin_nano_wallet/libs/config"
 	"github.com/appditto/pippin_nano_wallet/libs/database"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
@@ -21,12 +25,20 @@ import (
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	walletmodels "github.com/appditto/pippin_nano_wallet/libs/wallet/models"
 	"golang.org/x/term"
 )
 
+// Set at build time with -ldflags
 var Version = "dev"
+var GitSHA = "unknown"
+var BuildDate = "unknown"
 var walletCmd *flag.FlagSet
 var accountCmd *flag.FlagSet
+var apiKeyCmd *flag.FlagSet
+var auditCmd *flag.FlagSet
+var shellCmd *flag.FlagSet
+var migrateCmd *flag.FlagSet
 
 func usage() {
 	fmt.Println("General commands:")
@@ -40,12 +52,48 @@ func usage() {
 	fmt.Printf("Usage: %s account [options]\n", os.Args[0])
 	fmt.Println("Options:")
 	accountCmd.PrintDefaults()
+	fmt.Println("\n\nAPI key commands:")
+	fmt.Printf("Usage: %s apikey [options]\n", os.Args[0])
+	fmt.Println("Options:")
+	apiKeyCmd.PrintDefaults()
+	fmt.Println("\n\nAudit commands:")
+	fmt.Printf("Usage: %s audit [options]\n", os.Args[0])
+	fmt.Println("Options:")
+	auditCmd.PrintDefaults()
+	fmt.Println("\n\nShell:")
+	fmt.Printf("Usage: %s shell [options]\n", os.Args[0])
+	fmt.Println("Options:")
+	shellCmd.PrintDefaults()
+	fmt.Println("\n\nMigrations:")
+	fmt.Printf("Usage: %s migrate status|up|down [options]\n", os.Args[0])
+	fmt.Println("Options:")
+	migrateCmd.PrintDefaults()
 	return
 }
 
 func init() {
 	walletCmd = flag.NewFlagSet("wallet", flag.ExitOnError)
 	accountCmd = flag.NewFlagSet("account", flag.ExitOnError)
+	apiKeyCmd = flag.NewFlagSet("apikey", flag.ExitOnError)
+	auditCmd = flag.NewFlagSet("audit", flag.ExitOnError)
+	shellCmd = flag.NewFlagSet("shell", flag.ExitOnError)
+	migrateCmd = flag.NewFlagSet("migrate", flag.ExitOnError)
+}
+
+// A date as YYYY-MM-DD or an RFC 3339 time, nil if it's empty
+func ParseAuditTime(value string, name string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	parsed, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		parsed, err = time.Parse(time.RFC3339, value)
+	}
+	if err != nil {
+		fmt.Printf("%s must be YYYY-MM-DD or an RFC 3339 time\n", name)
+		os.Exit(1)
+	}
+	return &parsed
 }
 
 func getWallet(nanoWallet *wallet.NanoWallet, id string) *ent.Wallet {
@@ -146,11 +194,22 @@ func main() {
 	walletViewSeed := walletCmd.Bool("view-seed", false, "View the seed of a wallet (unsafe)")
 	walletEncrypt := walletCmd.Bool("encrypt", false, "Encrypt a wallet with a password")
 	walletDecryt := walletCmd.Bool("decrypt", false, "Decrypt a wallet, remove password requirement")
+	walletBackup := walletCmd.Bool("backup", false, "Write an encrypted backup of a wallet to --file")
+	walletRestore := walletCmd.Bool("restore", false, "Restore a wallet from the backup in --file")
+	walletSweep := walletCmd.Bool("sweep", false, "Receive everything pending on every account of a wallet and send it all to --destination")
+	walletReconcile := walletCmd.Bool("reconcile", false, "Compare a wallet with the node and print a JSON report of unpocketed blocks, forks, missing sends and balance mismatches")
 	// Options that may apply to multiple commands
 	walletId := walletCmd.String("id", "", "Target wallet ID")
 	walletSeed := walletCmd.String("seed", "", "Specify a seed to use when creating/changing wallet (optional for create)")
 	walletPassword := walletCmd.String("password", "", "Specify a password to use if the wallet is locked")
 	walletAllKeys := walletCmd.Bool("all-keys", false, "Show all priv/pub keys for accounts on this wallet")
+	walletLedger := walletCmd.Bool("ledger", false, "Create a hardware wallet that signs on the Ledger at ledger_device (optional for create, cannot be used with --seed)")
+	walletFile := walletCmd.String("file", "", "The backup file to write or restore (required for backup and restore)")
+	walletPassphrase := walletCmd.String("passphrase", "", "The passphrase of the backup, prompted for if it's not given (optional for backup and restore)")
+	walletDestination := walletCmd.String("destination", "", "The account to sweep to (required for sweep)")
+	walletDryRun := walletCmd.Bool("dry-run", false, "Only show what would be swept (optional for sweep)")
+	walletSince := walletCmd.String("since", "", "Only check sends made since this date, YYYY-MM-DD or RFC 3339 (optional for reconcile)")
+	walletScan := walletCmd.Bool("scan", false, "Also create every account of the seed with history on the node, up to restore_gap_limit unused ones in a row (optional for create with --seed and change-seed)")
 
 	// For accounts
 	accountCreate := accountCmd.Bool("create", false, "Create a new account")
@@ -161,6 +220,35 @@ func main() {
 	accountCount := accountCmd.Int("count", 0, "Specify how many accounts to create (optional, cannot be used with --index)")
 	accountKey := accountCmd.String("key", "", "Specify a private key to use when creating account (optional, cannot be used with --index or --count)")
 	repairAdhoc := accountCmd.Bool("repair-adhoc", false, "Repair adhoc accounts")
+	accountVanity := accountCmd.Bool("vanity", false, "Search for a key with an address matching --prefix and --suffix and add it as an adhoc account")
+	accountPrefix := accountCmd.String("prefix", "", "What the address starts with after nano_ or ban_, e.g. 1pip (one of --prefix or --suffix is required for vanity)")
+	accountSuffix := accountCmd.String("suffix", "", "What the address ends with (one of --prefix or --suffix is required for vanity)")
+	accountWorkers := accountCmd.Int("workers", wallet.VanityMaxWorkers(), "How many keys to try at once, at most the number of CPUs (optional for vanity)")
+	accountTimeout := accountCmd.Int("timeout", int(wallet.VanityDefaultTimeout.Seconds()), "Seconds to search for before giving up (optional for vanity)")
+
+	// For API keys
+	apiKeyCreate := apiKeyCmd.Bool("create", false, "Create an API key, it's only shown once")
+	apiKeyList := apiKeyCmd.Bool("list", false, "List all API keys")
+	apiKeyRevoke := apiKeyCmd.Bool("revoke", false, "Revoke an API key")
+	apiKeyName := apiKeyCmd.String("name", "", "Name of the key, to tell keys apart when listing them (required for --create)")
+	apiKeyScope := apiKeyCmd.String("scope", "read", "One of read, send or admin (optional for --create)")
+	apiKeyId := apiKeyCmd.String("id", "", "Target API key ID")
+	apiKeyApprover := apiKeyCmd.Bool("approver", false, "Let the key approve and reject sends that need approvals (optional for --create)")
+
+	// For the audit table
+	auditWalletId := auditCmd.String("id", "", "Only records of this wallet ID (optional)")
+	auditAction := auditCmd.String("action", "", "Only records of this action, e.g. send (optional)")
+	auditFrom := auditCmd.String("from", "", "Only records from this date, YYYY-MM-DD or RFC 3339 (optional)")
+	auditTo := auditCmd.String("to", "", "Only records before this date, YYYY-MM-DD or RFC 3339 (optional)")
+	auditCount := auditCmd.Int("count", 100, "How many of the newest records to show, 0 for all (optional)")
+
+	// For the shell
+	shellOffline := shellCmd.Bool("offline", false, "Only sign send, receive and change blocks from the account state given with them, without the node")
+	shellPassword := shellCmd.String("password", "", "Specify a password to unlock locked wallets with when a command needs them")
+
+	// For migrations
+	migrateSteps := migrateCmd.Int("steps", 1, "How many migrations to roll back (optional for down)")
+	migrateBackupDir := migrateCmd.String("backup-dir", "", "Where to back up a SQLite database before migrating, next to it by default (optional for up and down)")
 
 	if *showHelp {
 		usage()
@@ -168,12 +256,16 @@ func main() {
 	}
 
 	if *version {
-		fmt.Printf("Pippin version: %s\n", Version)
+		fmt.Printf("Pippin version: %s (%s, built %s)\n", Version, GitSHA, BuildDate)
 		os.Exit(0)
 	}
 
 	if *startServer {
-		server.StartPippinServer()
+		server.StartPippinServer(controller.BuildInfo{
+			Version:   Version,
+			GitSHA:    GitSHA,
+			BuildDate: BuildDate,
+		})
 		os.Exit(0)
 	}
 
@@ -203,17 +295,82 @@ func main() {
 	}
 	defer entClient.Close()
 
+	// ** migrate status|up|down (--steps) (--backup-dir)
+	// Before anything else is migrated, so a rollback isn't undone
+	if os.Args[1] == "migrate" {
+		if len(os.Args) < 3 {
+			fmt.Println("expected status, up or down")
+			os.Exit(1)
+		}
+		migrateCmd.Parse(os.Args[3:])
+		migrator, err := database.NewMigrator(dbconn, entClient)
+		if err != nil {
+			fmt.Printf("Failed to create migrator: %v\n", err)
+			os.Exit(1)
+		}
+		defer migrator.Close()
+		migrator.BackupDir = *migrateBackupDir
+		switch os.Args[2] {
+		case "status":
+			status, err := migrator.Status(ctx)
+			if err != nil {
+				fmt.Printf("Failed to get migrations: %v\n", err)
+				os.Exit(1)
+			}
+			for _, migration := range status {
+				applied := "pending"
+				if migration.AppliedAt != nil {
+					applied = "applied " + migration.AppliedAt.Format(time.RFC3339)
+				}
+				fmt.Printf("%d  %s  %s\n", migration.Version, migration.Name, applied)
+			}
+		case "up":
+			applied, err := migrator.Up(ctx)
+			if err != nil {
+				fmt.Printf("Failed to run migrations: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("Applied %d migrations\n", applied)
+		case "down":
+			if *migrateSteps < 1 {
+				fmt.Println("--steps must be at least 1")
+				os.Exit(1)
+			}
+			rolledBack, err := migrator.Down(ctx, *migrateSteps)
+			if err != nil {
+				fmt.Printf("Rolled back %d migrations, failed to roll back the next one: %v\n", rolledBack, err)
+				os.Exit(1)
+			}
+			fmt.Printf("Rolled back %d migrations\n", rolledBack)
+		default:
+			fmt.Println("expected status, up or down")
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Run migrations
-	if err := entClient.Schema.Create(ctx); err != nil {
+	if err := database.Migrate(ctx, dbconn, entClient); err != nil {
 		fmt.Printf("Failed to run migrations: %v", err)
 		os.Exit(1)
 	}
 
 	// Setup RPC handlers
-	rpcClient := rpc.NewRPCClient(conf.Server.NodeRpcUrl)
+	rpcClient := rpc.NewMultiNodeRPCClient(append([]string{conf.Server.NodeRpcUrl}, conf.Server.NodeRpcFallbackUrls...), conf.Server.NodeRpcRoundRobin)
 
 	// Setup pow client
-	pow := pow.NewPippinPow(conf.Wallet.WorkPeers, utils.GetEnv("BPOW_KEY", ""), utils.GetEnv("BPOW_URL", ""), conf.Wallet.WorkTimeout)
+	pow := pow.NewPippinPow(conf.Wallet.WorkPeers, utils.GetEnv("BPOW_KEY", conf.Wallet.BpowKey), utils.GetEnv("BPOW_URL", conf.Wallet.BpowUrl), pow.NewTimeoutPolicy(conf.Wallet.WorkTimeout, conf.Wallet.LargeSendThreshold, conf.Wallet.LargeSendWorkTimeout))
+	pow.Concurrent = conf.Wallet.WorkPeersConcurrent == nil || *conf.Wallet.WorkPeersConcurrent
+	// Validated with the config
+	pow.SetWorkSources(conf.Wallet.WorkSources)
+	cacheClient, err := cache.NewCacheClient(conf.Server.CacheBackend)
+	if err != nil {
+		fmt.Printf("Failed to create cache client: %v\n", err)
+		os.Exit(1)
+	}
+	if conf.Wallet.WorkCacheTTL > 0 {
+		pow.SetWorkCache(cache.NewWorkCache(cacheClient, time.Duration(conf.Wallet.WorkCacheTTL)*time.Second))
+	}
 
 	// Setup nano wallet instance with DB, options, etc.
 	nanoWallet := wallet.NanoWallet{
@@ -251,12 +408,31 @@ func main() {
 					fmt.Println("----------------------------")
 				}
 			}
+			// ** wallet --create --ledger
+		} else if *walletCreate && *walletLedger {
+			if *walletSeed != "" {
+				fmt.Println("--seed cannot be used with --ledger")
+				os.Exit(1)
+			}
+			fmt.Println("Getting the first account from the Ledger...")
+			w, err := nanoWallet.WalletCreateHardware(nil)
+			if err != nil {
+				fmt.Printf("Failed to create wallet: %v\n", err)
+				os.Exit(1)
+			}
+			acct, err := w.QueryAccounts().First(ctx)
+			if err != nil {
+				fmt.Printf("Failed to get account for wallet: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("Hardware wallet created, ID: %s\n", w.ID.String())
+			fmt.Printf("First account: %s\n", acct.Address)
 			// ** wallet --create (--seed)
 		} else if *walletCreate {
 			var seed string
 			if *walletSeed == "" {
 				fmt.Println("Generating secure seed...")
-				seed, err = utils.GenerateSeed(nil)
+				seed, err = utils.GenerateSecureSeed()
 				if err != nil {
 					fmt.Printf("Secue random source may not be available on your OS\n")
 					fmt.Printf("Failed to generate seed: %v\n", err)
@@ -270,7 +446,17 @@ func main() {
 				os.Exit(1)
 			}
 			// Create wallet
-			w, err := nanoWallet.WalletCreate(seed)
+			var w *ent.Wallet
+			if *walletScan && *walletSeed != "" {
+				fmt.Println("Looking up the seed's accounts on the node...")
+				var accounts []*ent.Account
+				w, accounts, err = nanoWallet.WalletCreateScanned(seed, conf.Wallet.RestoreGapLimit)
+				if err == nil {
+					fmt.Printf("Restored %d accounts\n", len(accounts))
+				}
+			} else {
+				w, err = nanoWallet.WalletCreate(seed)
+			}
 			if err != nil {
 				fmt.Printf("Failed to create wallet: %v\n", err)
 				os.Exit(1)
@@ -293,7 +479,17 @@ func main() {
 			RequireUnlockedWallet(&nanoWallet, w, walletPassword)
 
 			// Change seed
-			newest, err := nanoWallet.WalletChangeSeed(w, *walletSeed)
+			var newest *ent.Account
+			if *walletScan {
+				fmt.Println("Looking up the seed's accounts on the node...")
+				var created []*ent.Account
+				newest, created, err = nanoWallet.WalletChangeSeedScanned(w, *walletSeed, conf.Wallet.RestoreGapLimit)
+				if err == nil {
+					fmt.Printf("Restored %d accounts the wallet didn't have\n", len(created))
+				}
+			} else {
+				newest, err = nanoWallet.WalletChangeSeed(w, *walletSeed)
+			}
 			if err != nil {
 				fmt.Printf("Failed to change seed: %v\n", err)
 				os.Exit(1)
@@ -386,6 +582,95 @@ func main() {
 				os.Exit(1)
 			}
 			fmt.Println("Wallet decrypted")
+			// ** wallet --backup --id --file (--passphrase)
+		} else if *walletBackup {
+			RequireID(walletId, "--id is required for --backup")
+			RequireID(walletFile, "--file is required for --backup")
+			w := getWallet(&nanoWallet, *walletId)
+			alreadyUnlocked := RequireUnlockedWallet(&nanoWallet, w, walletPassword)
+			passphrase := *walletPassphrase
+			if passphrase == "" {
+				passphrase = PasswordPrompt()
+			}
+			if passphrase == "" {
+				fmt.Println("Passphrase cannot be empty")
+				os.Exit(1)
+			}
+			backup, err := nanoWallet.WalletBackupCreate(w, passphrase)
+			if !alreadyUnlocked {
+				nanoWallet.LockWallet(w)
+			}
+			if err != nil {
+				fmt.Printf("Failed to create backup: %v\n", err)
+				os.Exit(1)
+			}
+			// It has every key of the wallet
+			if err := os.WriteFile(*walletFile, backup, 0600); err != nil {
+				fmt.Printf("Failed to write backup: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("Backup of wallet %s written to %s\n", w.ID.String(), *walletFile)
+			// ** wallet --restore --file (--passphrase)
+		} else if *walletRestore {
+			RequireID(walletFile, "--file is required for --restore")
+			backup, err := os.ReadFile(*walletFile)
+			if err != nil {
+				fmt.Printf("Failed to read backup: %v\n", err)
+				os.Exit(1)
+			}
+			passphrase := *walletPassphrase
+			if passphrase == "" {
+				passphrase = PasswordPrompt()
+			}
+			w, accounts, err := nanoWallet.WalletBackupRestore(backup, passphrase)
+			if err != nil {
+				fmt.Printf("Failed to restore backup: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("Wallet restored, ID: %s\n", w.ID.String())
+			for _, a := range accounts {
+				fmt.Printf("Account: %s\n", a.Address)
+			}
+			// ** wallet --sweep --id --destination (--dry-run --password)
+		} else if *walletSweep {
+			RequireID(walletId, "--id is required for --sweep")
+			RequireID(walletDestination, "--destination is required for --sweep")
+			if _, err := utils.AddressToPub(*walletDestination, conf.Wallet.Banano); err != nil {
+				fmt.Println("Invalid destination")
+				os.Exit(1)
+			}
+			w := getWallet(&nanoWallet, *walletId)
+			alreadyUnlocked := RequireUnlockedWallet(&nanoWallet, w, walletPassword)
+			sweep, err := nanoWallet.WalletSweep(w, *walletDestination, *walletDryRun, nil, func(done int, total int, sweep *walletmodels.WalletSweep) {
+				swept := sweep.Accounts[len(sweep.Accounts)-1]
+				fmt.Printf("%d/%d Account: %s Receives: %d Send: %s\n", done, total, swept.Address, len(swept.Receives), swept.Send)
+			})
+			if !alreadyUnlocked {
+				nanoWallet.LockWallet(w)
+			}
+			if err != nil {
+				fmt.Printf("Failed to sweep wallet: %v\n", err)
+				os.Exit(1)
+			}
+			if *walletDryRun {
+				for _, a := range sweep.Accounts {
+					fmt.Printf("Account: %s Balance: %s Pending: %s\n", a.Address, a.BalanceRaw, a.PendingRaw)
+				}
+				fmt.Printf("Would sweep %s raw from %d accounts to %s\n", sweep.TotalRaw, len(sweep.Accounts), *walletDestination)
+			} else {
+				fmt.Printf("Swept %s raw from %d accounts to %s\n", sweep.TotalRaw, len(sweep.Accounts), *walletDestination)
+			}
+			// ** wallet --reconcile --id (--since)
+		} else if *walletReconcile {
+			RequireID(walletId, "--id is required for --reconcile")
+			w := getWallet(&nanoWallet, *walletId)
+			reconciliation, err := nanoWallet.WalletReconcile(w, ParseAuditTime(*walletSince, "--since"))
+			if err != nil {
+				fmt.Printf("Failed to reconcile wallet: %v\n", err)
+				os.Exit(1)
+			}
+			report, _ := json.MarshalIndent(controller.WalletReconcileResponse(reconciliation), "", "  ")
+			fmt.Println(string(report))
 		} else {
 			usage()
 		}
@@ -460,6 +745,32 @@ func main() {
 				}
 				fmt.Printf("Account %d created: %s\n", *acc.AccountIndex, acc.Address)
 			}
+		} else if *accountVanity {
+			RequireID(accountWalletId, "--id is required for --vanity")
+			w := getWallet(&nanoWallet, *accountWalletId)
+			pattern, err := utils.NewVanityPattern(*accountPrefix, *accountSuffix, nanoWallet.Banano)
+			if err != nil {
+				fmt.Println("--prefix or --suffix is required, with only the characters of an address")
+				os.Exit(1)
+			}
+			if *accountWorkers < 1 || *accountTimeout < 1 {
+				fmt.Println("--workers and --timeout must be at least 1")
+				os.Exit(1)
+			}
+			alreadyUnlocked := RequireUnlockedWallet(&nanoWallet, w, accountWalletPassword)
+			if !alreadyUnlocked {
+				defer nanoWallet.LockWallet(w)
+			}
+			fmt.Printf("Searching for up to %d seconds...\n", *accountTimeout)
+			vanity, err := nanoWallet.AccountCreateVanity(w, pattern, *accountWorkers, time.Duration(*accountTimeout)*time.Second)
+			if errors.Is(err, utils.ErrVanityNotFound) {
+				fmt.Println("No matching address was found, try a shorter --prefix or --suffix or a longer --timeout")
+				os.Exit(1)
+			} else if err != nil {
+				fmt.Printf("Failed to create vanity account: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("Adhoc account created after %d attempts: %s\n", vanity.Attempts, vanity.Account.Address)
 		} else if *repairAdhoc {
 			// Get all accounts with 64-length private keys
 			accounts, err := nanoWallet.DB.Account.Query().Where(account.PrivateKeyNotNil()).All(ctx)
@@ -491,6 +802,96 @@ func main() {
 				fmt.Printf("Account %s repaired\n", a.Address)
 			}
 		}
+	case "apikey":
+		apiKeyCmd.Parse(os.Args[2:])
+		// ** apikey --create --name (--scope) (--approver)
+		if *apiKeyCreate {
+			RequireID(apiKeyName, "--name is required for --create")
+			created, key, err := nanoWallet.ApiKeyCreate(*apiKeyName, *apiKeyScope)
+			if err != nil {
+				fmt.Printf("Failed to create API key: %v\n", err)
+				os.Exit(1)
+			}
+			if *apiKeyApprover {
+				if _, err := nanoWallet.ApiKeySetApprover(created.ID.String(), true); err != nil {
+					fmt.Printf("Failed to make API key an approver: %v\n", err)
+					os.Exit(1)
+				}
+			}
+			fmt.Printf("API key created: %s\n", created.ID.String())
+			fmt.Printf("Key: %s\n", key)
+			fmt.Println("Store it now, it can't be shown again")
+		} else if *apiKeyList {
+			keys, err := nanoWallet.ApiKeyList()
+			if err != nil {
+				fmt.Printf("Failed to get API keys: %v\n", err)
+				os.Exit(1)
+			}
+			for _, k := range keys {
+				lastUsed := "never"
+				if k.LastUsedAt != nil {
+					lastUsed = k.LastUsedAt.Format(time.RFC3339)
+				}
+				fmt.Printf("%s  %s  scope: %s  approver: %t  created: %s  last used: %s\n", k.ID.String(), k.Name, k.Scope, k.Approver, k.CreatedAt.Format(time.RFC3339), lastUsed)
+			}
+		} else if *apiKeyRevoke {
+			RequireID(apiKeyId, "--id is required for --revoke")
+			if err := nanoWallet.ApiKeyRevoke(*apiKeyId); errors.Is(err, wallet.ErrApiKeyNotFound) {
+				fmt.Println("API key not found")
+				os.Exit(1)
+			} else if err != nil {
+				fmt.Printf("Failed to revoke API key: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Println("API key revoked")
+		} else {
+			usage()
+		}
+	case "audit":
+		auditCmd.Parse(os.Args[2:])
+		// ** audit (--id) (--action) (--from) (--to) (--count)
+		records, err := nanoWallet.AuditRecords(walletmodels.AuditFilter{
+			Wallet: *auditWalletId,
+			Action: *auditAction,
+			Start:  ParseAuditTime(*auditFrom, "--from"),
+			End:    ParseAuditTime(*auditTo, "--to"),
+			Count:  *auditCount,
+		})
+		if errors.Is(err, wallet.ErrInvalidDateRange) {
+			fmt.Println("--to must be after --from")
+			os.Exit(1)
+		} else if err != nil {
+			fmt.Printf("Failed to get audit records: %v\n", err)
+			os.Exit(1)
+		}
+		for _, record := range records {
+			walletID, apiKey, result := "-", "-", "ok"
+			if record.Wallet != nil {
+				walletID = *record.Wallet
+			}
+			if record.APIKeyName != nil {
+				apiKey = *record.APIKeyName
+			}
+			if record.ErrorCode != nil {
+				result = *record.ErrorCode
+			}
+			params, _ := json.Marshal(record.Params)
+			fmt.Printf("%s  %s  wallet: %s  ip: %s  api key: %s  status: %d %s  %s\n", record.CreatedAt.Format(time.RFC3339), record.Action, walletID, record.IP, apiKey, record.Status, result, params)
+		}
+	case "shell":
+		shellCmd.Parse(os.Args[2:])
+		// ** shell (--offline) (--password)
+		// Whoever runs the shell has the database already, API keys aren't asked for
+		conf.Server.RequireApiKey = false
+		hc := controller.HttpController{Wallet: &nanoWallet, RpcClient: rpcClient, PowClient: pow, Cache: cacheClient, Build: controller.BuildInfo{
+			Version:   Version,
+			GitSHA:    GitSHA,
+			BuildDate: BuildDate,
+		}}
+		if err := StartShell(&nanoWallet, &hc, *shellPassword, *shellOffline); err != nil {
+			fmt.Printf("Shell stopped: %v\n", err)
+			os.Exit(1)
+		}
 	default:
 		fmt.Println("expected 'foo' or 'bar' subcommands")
 		os.Exit(1)
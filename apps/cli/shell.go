@@ -0,0 +1,462 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/controller"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"golang.org/x/term"
+)
+
+// pippin shell, a prompt for the gateway and admin actions without starting the server
+// A command is an action and its params as key=value, e.g. send wallet=... source=... amount=1, values with spaces go in double quotes
+// Tab completes the actions and their params from the OpenAPI spec
+// With --offline only send, receive and change work, they're signed from an account state given with the command and printed as a process request, the node isn't asked for anything
+
+// Params of the offline commands, frontier, balance and representative are the account's state
+var offlineCommands = map[string][]string{
+	"send":    {"wallet", "source", "destination", "amount", "frontier", "balance", "representative", "work", "file"},
+	"receive": {"wallet", "account", "hash", "amount", "frontier", "balance", "representative", "work", "file"},
+	"change":  {"wallet", "account", "new_representative", "frontier", "balance", "representative", "work", "file"},
+}
+
+type shell struct {
+	nanoWallet *wallet.NanoWallet
+	hc         *controller.HttpController
+	// Wallets are unlocked with it when a command needs them
+	password string
+	offline  bool
+	// Params of each action, and the type the spec gives them
+	params map[string]map[string]string
+	// Actions that go to the admin gateway
+	admin map[string]bool
+	out   io.Writer
+}
+
+// An http.ResponseWriter that keeps the response for the shell to print
+type shellResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (sr *shellResponse) Header() http.Header {
+	return sr.header
+}
+
+func (sr *shellResponse) WriteHeader(status int) {
+	if sr.status == 0 {
+		sr.status = status
+	}
+}
+
+func (sr *shellResponse) Write(b []byte) (int, error) {
+	if sr.status == 0 {
+		sr.status = http.StatusOK
+	}
+	return sr.body.Write(b)
+}
+
+// Read the actions and their params from the spec the server serves
+func (s *shell) loadSpec() error {
+	s.params = map[string]map[string]string{}
+	s.admin = map[string]bool{}
+	if s.offline {
+		for command, params := range offlineCommands {
+			s.params[command] = map[string]string{}
+			for _, param := range params {
+				s.params[command][param] = "string"
+			}
+		}
+		return nil
+	}
+
+	sr := &shellResponse{header: http.Header{}}
+	s.hc.HandleOpenAPISpec(sr, nil)
+	var spec struct {
+		Paths map[string]struct {
+			Post struct {
+				RequestBody struct {
+					Content map[string]struct {
+						Examples map[string]json.RawMessage `json:"examples"`
+					} `json:"content"`
+				} `json:"requestBody"`
+			} `json:"post"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]struct {
+					Type string `json:"type"`
+				} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(sr.body.Bytes(), &spec); err != nil {
+		return err
+	}
+	for path, item := range spec.Paths {
+		for action := range item.Post.RequestBody.Content["application/json"].Examples {
+			s.params[action] = map[string]string{}
+			for param, property := range spec.Components.Schemas[action].Properties {
+				if param != "action" {
+					s.params[action][param] = property.Type
+				}
+			}
+			if path == "/admin" {
+				s.admin[action] = true
+			}
+		}
+	}
+	return nil
+}
+
+// Split a line into words, double quotes keep spaces in a word
+func splitCommand(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord, quoted := false, false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inWord = true
+		case r == ' ' && !quoted:
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quoted {
+		return nil, errors.New("unterminated quote")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// The request for an action, values are converted to the type the spec gives the param
+func (s *shell) request(action string, args []string) (map[string]interface{}, error) {
+	request := map[string]interface{}{"action": action}
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%s should be key=value", arg)
+		}
+		switch s.params[action][key] {
+		case "integer":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be a number", key)
+			}
+			request[key] = parsed
+		case "boolean":
+			parsed, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be true or false", key)
+			}
+			request[key] = parsed
+		case "array", "object":
+			var parsed interface{}
+			if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+				return nil, fmt.Errorf("%s must be JSON", key)
+			}
+			request[key] = parsed
+		default:
+			request[key] = value
+		}
+	}
+	return request, nil
+}
+
+// Run a line, false once the shell should exit
+func (s *shell) run(line string) bool {
+	words, err := splitCommand(line)
+	if err != nil {
+		fmt.Fprintf(s.out, "⚠️ %v\n", err)
+		return true
+	} else if len(words) < 1 {
+		return true
+	}
+	command := strings.ToLower(words[0])
+	switch command {
+	case "exit", "quit":
+		return false
+	case "help":
+		s.help(words[1:])
+		return true
+	}
+	if _, ok := s.params[command]; !ok {
+		fmt.Fprintf(s.out, "⚠️ Unknown action %s, help lists them\n", command)
+		return true
+	}
+	request, err := s.request(command, words[1:])
+	if err != nil {
+		fmt.Fprintf(s.out, "⚠️ %v\n", err)
+		return true
+	}
+	if s.offline {
+		err = s.runOffline(command, request)
+	} else {
+		err = s.runAction(command, request)
+	}
+	if err != nil {
+		fmt.Fprintf(s.out, "⚠️ %v\n", err)
+	}
+	return true
+}
+
+// List the actions, or the params of one
+func (s *shell) help(args []string) {
+	if len(args) > 0 {
+		params, ok := s.params[args[0]]
+		if !ok {
+			fmt.Fprintf(s.out, "⚠️ Unknown action %s\n", args[0])
+			return
+		}
+		usage := []string{args[0]}
+		for _, param := range sortedKeys(params) {
+			usage = append(usage, param+"=")
+		}
+		fmt.Fprintln(s.out, strings.Join(usage, " "))
+		return
+	}
+	fmt.Fprintln(s.out, strings.Join(sortedKeys(s.params), " "))
+	fmt.Fprintln(s.out, "help <action> lists its params, exit leaves the shell")
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Unlock the request's wallet with --password if it's locked, the gateway doesn't take a password
+func (s *shell) unlock(request map[string]interface{}) (*ent.Wallet, error) {
+	id, _ := request["wallet"].(string)
+	if id == "" {
+		return nil, nil
+	}
+	w, err := s.nanoWallet.GetWallet(id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := wallet.GetDecryptedKeyFromStorage(w, "seed"); errors.Is(err, wallet.ErrWalletLocked) && s.password != "" {
+		if ok, err := s.nanoWallet.UnlockWallet(w, s.password); err != nil || !ok {
+			return nil, fmt.Errorf("failed to unlock wallet: %v", err)
+		}
+	}
+	return w, nil
+}
+
+// Send the request through the gateway, or the admin gateway, and print the response
+func (s *shell) runAction(action string, request map[string]interface{}) error {
+	if _, err := s.unlock(request); err != nil && !errors.Is(err, wallet.ErrWalletNotFound) && !errors.Is(err, wallet.ErrInvalidWallet) {
+		return err
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	path, handle := "/", s.hc.Gateway
+	if s.admin[action] {
+		path, handle = "/admin", s.hc.AdminHandler
+	}
+	r, err := http.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Authorization", "Bearer "+s.hc.AdminToken)
+	r.RemoteAddr = "127.0.0.1:0"
+	sr := &shellResponse{header: http.Header{}}
+	handle(sr, r)
+
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, sr.body.Bytes(), "", "  "); err != nil {
+		fmt.Fprintln(s.out, strings.TrimSpace(sr.body.String()))
+		return nil
+	}
+	fmt.Fprintln(s.out, strings.TrimSpace(pretty.String()))
+	return nil
+}
+
+// Sign a block from the account state in the request and print it as a process request, or write it to file
+func (s *shell) runOffline(command string, request map[string]interface{}) error {
+	param := func(key string) string {
+		value, _ := request[key].(string)
+		return value
+	}
+	var work *string
+	if param("work") != "" {
+		w := param("work")
+		work = &w
+	}
+	state := wallet.AccountState{Frontier: param("frontier"), Balance: param("balance"), Representative: param("representative")}
+	if state.Balance == "" {
+		state.Balance = "0"
+	}
+
+	w, err := s.unlock(request)
+	if err != nil {
+		return err
+	} else if w == nil {
+		return errors.New("wallet is required")
+	}
+	var preview *wallet.BlockPreview
+	switch command {
+	case "send":
+		preview, err = s.nanoWallet.OfflineSendBlock(w, param("source"), param("destination"), param("amount"), state, work)
+	case "receive":
+		preview, err = s.nanoWallet.OfflineReceiveBlock(w, param("account"), param("hash"), param("amount"), state, work)
+	case "change":
+		preview, err = s.nanoWallet.OfflineChangeBlock(w, param("account"), param("new_representative"), state, work)
+	}
+	if err != nil {
+		return err
+	}
+
+	// What the node's process action takes, so it can be published as is
+	process, err := json.MarshalIndent(map[string]interface{}{
+		"action":     "process",
+		"json_block": "true",
+		"subtype":    preview.Subtype,
+		"block":      preview.Block,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(s.out, "Hash: %s\nBalance: %s\n", preview.Hash, preview.Balance)
+	if preview.Block.Work == "" {
+		fmt.Fprintf(s.out, "No work, the block needs work reaching %s before it's published\n", preview.Difficulty)
+	}
+	if file := param("file"); file != "" {
+		if err := os.WriteFile(file, append(process, '\n'), 0600); err != nil {
+			return err
+		}
+		fmt.Fprintf(s.out, "Block written to %s\n", file)
+		return nil
+	}
+	fmt.Fprintln(s.out, string(process))
+	return nil
+}
+
+// Complete the action, or the param of the action, at the end of line before pos
+func (s *shell) complete(line string, pos int, key rune) (string, int, bool) {
+	if key != '\t' {
+		return "", 0, false
+	}
+	before := line[:pos]
+	start := strings.LastIndex(before, " ") + 1
+	word := before[start:]
+	var candidates []string
+	if start == 0 {
+		for _, action := range sortedKeys(s.params) {
+			if strings.HasPrefix(action, word) {
+				candidates = append(candidates, action+" ")
+			}
+		}
+	} else if !strings.Contains(word, "=") {
+		action := strings.Fields(before)[0]
+		for _, param := range sortedKeys(s.params[action]) {
+			if strings.HasPrefix(param, word) {
+				candidates = append(candidates, param+"=")
+			}
+		}
+	}
+	if len(candidates) < 1 {
+		return "", 0, false
+	}
+
+	completed := candidates[0]
+	for _, candidate := range candidates[1:] {
+		for !strings.HasPrefix(candidate, completed) {
+			completed = completed[:len(completed)-1]
+		}
+	}
+	if len(candidates) > 1 && completed == word {
+		fmt.Fprintln(s.out, strings.Join(candidates, " "))
+		return line, pos, true
+	}
+	return before[:start] + completed + line[pos:], start + len(completed), true
+}
+
+// Read commands until exit, from a prompt with completion if stdin is a terminal
+func (s *shell) start() error {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		s.out = os.Stdout
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			if !s.run(scanner.Text()) {
+				break
+			}
+		}
+		return scanner.Err()
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer term.Restore(fd, state)
+	prompt := "pippin> "
+	if s.offline {
+		prompt = "pippin (offline)> "
+	}
+	t := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, prompt)
+	t.AutoCompleteCallback = s.complete
+	s.out = t
+	for {
+		line, err := t.ReadLine()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if !s.run(line) {
+			return nil
+		}
+	}
+}
+
+// Start the shell, the admin actions use a token that only lasts as long as it
+func StartShell(nanoWallet *wallet.NanoWallet, hc *controller.HttpController, password string, offline bool) error {
+	token, err := utils.GenerateSecureSeed()
+	if err != nil {
+		return err
+	}
+	hc.AdminToken = token
+	s := &shell{nanoWallet: nanoWallet, hc: hc, password: password, offline: offline}
+	if err := s.loadSpec(); err != nil {
+		return err
+	}
+	if offline {
+		fmt.Println("Offline, send, receive and change sign blocks from the account state you give them, nothing is published")
+	}
+	fmt.Println("Tab completes actions and params, help lists them, exit leaves the shell")
+	return s.start()
+}
@@ -1,12 +1,26 @@
 package controller
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math/big"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/nano"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
 	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
 )
 
 // Account handlers, reserved for the handlers that directly interact with the account_ actions
@@ -18,6 +32,11 @@ func (hc *HttpController) HandleAccountCreate(rawRequest *map[string]interface{}
 	if request == nil {
 		return
 	}
+	gapLimit, err := parseGapLimit(request.GapLimit)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
 
 	// See if wallet exists
 	dbWallet := hc.WalletExists(request.Wallet, w, r)
@@ -25,13 +44,29 @@ func (hc *HttpController) HandleAccountCreate(rawRequest *map[string]interface{}
 		return
 	}
 
-	// Create the account
-	newAccount, err := hc.Wallet.AccountCreate(dbWallet, idx)
+	// Accounts of another seed aren't in the wallet seed's sequence
+	if gapLimit != nil && request.Seed == nil && !hc.checkGapLimit(dbWallet, 1, *gapLimit, w, r) {
+		return
+	}
+
+	// Create the account, from another seed if one is given
+	var newAccount *ent.Account
+	if request.Seed != nil {
+		newAccount, err = hc.Wallet.AccountCreateFromSeed(dbWallet, *request.Seed, idx)
+	} else {
+		newAccount, err = hc.Wallet.AccountCreate(dbWallet, idx)
+	}
 	if errors.Is(err, wallet.ErrWalletLocked) || errors.Is(err, wallet.ErrInvalidWallet) {
 		ErrWalletLocked(w, r)
 		return
+	} else if errors.Is(err, wallet.ErrInvalidSeed) {
+		ErrInvalidSeed(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrWalletWatchOnly) {
+		ErrBadRequest(w, r, ErrorCodeWalletWatchOnly, "Wallet is watch-only")
+		return
 	} else if errors.Is(err, wallet.ErrAccountExists) {
-		ErrBadRequest(w, r, "Account already exists")
+		ErrBadRequest(w, r, ErrorCodeAccountExists, "Account already exists")
 		return
 	} else if err != nil {
 		ErrInternalServerError(w, r, err.Error())
@@ -46,6 +81,108 @@ func (hc *HttpController) HandleAccountCreate(rawRequest *map[string]interface{}
 	render.JSON(w, r, &resp)
 }
 
+// Handle account_create_vanity, search for a key with an address matching prefix and suffix and add it as an adhoc account
+func (hc *HttpController) HandleAccountCreateVanity(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var vanityRequest requests.AccountCreateVanityRequest
+	if err := mapstructure.Decode(rawRequest, &vanityRequest); err != nil {
+		log.Errorf("Error unmarshalling account_create_vanity request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if vanityRequest.Wallet == "" || vanityRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	pattern, err := utils.NewVanityPattern(vanityRequest.Prefix, vanityRequest.Suffix, hc.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidVanityPattern, "prefix or suffix is required, with only the characters of an address")
+		return
+	}
+	workers := wallet.VanityMaxWorkers()
+	if vanityRequest.Workers != nil {
+		workers, err = utils.ToInt(*vanityRequest.Workers)
+		if err != nil || workers < 1 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+	timeout := wallet.VanityDefaultTimeout
+	if vanityRequest.Timeout != nil {
+		seconds, err := utils.ToInt(*vanityRequest.Timeout)
+		if err != nil || seconds < 1 || time.Duration(seconds)*time.Second > wallet.VanityMaxTimeout {
+			ErrBadRequest(w, r, ErrorCodeInvalidTimeout, fmt.Sprintf("timeout must be between 1 and %d seconds", int(wallet.VanityMaxTimeout.Seconds())))
+			return
+		}
+		timeout = time.Duration(seconds) * time.Second
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(vanityRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	vanity, err := hc.Wallet.AccountCreateVanity(dbWallet, pattern, workers, timeout)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, utils.ErrVanityNotFound) {
+		ErrBadRequest(w, r, ErrorCodeVanityNotFound, "No matching address was found before the timeout, try a shorter prefix or suffix or a longer timeout")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.AccountCreateVanityResponse{
+		Account:  vanity.Account.Address,
+		Attempts: vanity.Attempts,
+	})
+}
+
+// Handle account_create_next, the index and address account_create would create without creating it
+func (hc *HttpController) HandleAccountCreateNext(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.AccountCreateNextRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling account_create_next request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Wallet == "" || request.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	gapLimit, err := parseGapLimit(request.GapLimit)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// The same error account_create would return
+	if gapLimit != nil && !hc.checkGapLimit(dbWallet, 1, *gapLimit, w, r) {
+		return
+	}
+
+	index, address, err := hc.Wallet.AccountCreateNext(dbWallet)
+	if errors.Is(err, wallet.ErrWalletLocked) || errors.Is(err, wallet.ErrInvalidWallet) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrWalletWatchOnly) {
+		ErrBadRequest(w, r, ErrorCodeWalletWatchOnly, "Wallet is watch-only")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.AccountCreateNextResponse{NextIndex: index, WouldBeAccount: address})
+}
+
 // Handle bulk account create based on count param
 func (hc *HttpController) HandleAccountsCreate(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
 	request, count := hc.DecodeBaseRequestWithCount(rawRequest, w, r)
@@ -57,28 +194,92 @@ func (hc *HttpController) HandleAccountsCreate(rawRequest *map[string]interface{
 		return
 	}
 
+	var createRequest requests.AccountsCreateRequest
+	if err := mapstructure.Decode(rawRequest, &createRequest); err != nil {
+		log.Errorf("Error unmarshalling accounts_create request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	var idempotencyKey *uuid.UUID
+	if createRequest.IdempotencyKey != nil {
+		key, err := uuid.Parse(*createRequest.IdempotencyKey)
+		if err != nil {
+			ErrBadRequest(w, r, ErrorCodeInvalidIdempotencyKey, "Invalid idempotency_key")
+			return
+		}
+		idempotencyKey = &key
+	}
+	async, err := asyncRequested(createRequest.Async)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	gapLimit, err := parseGapLimit(createRequest.GapLimit)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
 	// See if wallet exists
 	dbWallet := hc.WalletExists(request.Wallet, w, r)
 	if dbWallet == nil {
 		return
 	}
 
+	if gapLimit != nil {
+		// A retry with the same idempotency key doesn't create anything
+		used := false
+		if idempotencyKey != nil {
+			used, err = hc.Wallet.IdempotencyKeyUsed(dbWallet, *idempotencyKey)
+			if err != nil {
+				ErrInternalServerError(w, r, err.Error())
+				return
+			}
+		}
+		if !used && !hc.checkGapLimit(dbWallet, count, *gapLimit, w, r) {
+			return
+		}
+	}
+
+	if async {
+		// Fails right away if the wallet is locked
+		if _, err := wallet.GetDecryptedKeyFromStorage(dbWallet, "seed"); err != nil {
+			ErrWalletLocked(w, r)
+			return
+		}
+		hc.startJob(dbWallet, "accounts_create", func(progress wallet.JobProgress) (interface{}, error) {
+			return hc.accountsCreate(dbWallet, count, idempotencyKey, progress)
+		}, w, r)
+		return
+	}
+
 	// Create the accounts
-	newAccounts, err := hc.Wallet.AccountsCreate(dbWallet, count)
+	var addresses []string
+	if idempotencyKey != nil {
+		addresses, err = hc.Wallet.AccountsCreateIdempotent(dbWallet, count, *idempotencyKey)
+	} else {
+		var newAccounts []*ent.Account
+		newAccounts, err = hc.Wallet.AccountsCreate(dbWallet, count)
+		// Loop all accounts and get address as simple string array
+		addresses = make([]string, len(newAccounts))
+		for i, account := range newAccounts {
+			addresses[i] = account.Address
+		}
+	}
 	if errors.Is(err, wallet.ErrWalletLocked) || errors.Is(err, wallet.ErrInvalidWallet) {
 		ErrWalletLocked(w, r)
 		return
+	} else if errors.Is(err, wallet.ErrIdempotencyKeyInUse) {
+		ErrBadRequest(w, r, ErrorCodeIdempotencyKeyInUse, err.Error())
+		return
+	} else if errors.Is(err, wallet.ErrWalletWatchOnly) {
+		ErrBadRequest(w, r, ErrorCodeWalletWatchOnly, "Wallet is watch-only")
+		return
 	} else if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
 
-	// Loop all accounts and get address as simple string array
-	addresses := make([]string, len(newAccounts))
-	for i, account := range newAccounts {
-		addresses[i] = account.Address
-	}
-
 	resp := responses.AccountsResponse{
 		Accounts: addresses,
 	}
@@ -87,7 +288,76 @@ func (hc *HttpController) HandleAccountsCreate(rawRequest *map[string]interface{
 	render.JSON(w, r, &resp)
 }
 
-// Handle accounts_list
+// Parse gap_limit, nil if it isn't set
+func parseGapLimit(gapLimit *interface{}) (*int, error) {
+	if gapLimit == nil {
+		return nil, nil
+	}
+	limit, err := utils.ToInt(*gapLimit)
+	if err != nil {
+		return nil, err
+	} else if limit < 1 {
+		return nil, errors.New("gap_limit must be at least 1")
+	}
+	return &limit, nil
+}
+
+// Refuse creating count accounts if that makes more than gapLimit unused accounts in a row, checked with accounts_frontiers
+// Returns false if it was refused, the error response has been written
+func (hc *HttpController) checkGapLimit(dbWallet *ent.Wallet, count int, gapLimit int, w http.ResponseWriter, r *http.Request) bool {
+	gap, err := hc.Wallet.AccountsGap(dbWallet, gapLimit)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return false
+	} else if err != nil {
+		log.Errorf("Error checking gap limit %s", err)
+		ErrInternalServerError(w, r, "Error making accounts_frontiers request")
+		return false
+	}
+	if gap+count > gapLimit {
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, &responses.GapLimitExceededResponse{
+			Error:      "gap_limit_exceeded",
+			ErrorCode:  string(ErrorCodeGapLimitExceeded),
+			GapLimit:   gapLimit,
+			CurrentGap: gap,
+		})
+		return false
+	}
+	return true
+}
+
+// How many accounts an async accounts_create creates between progress updates
+const accountsCreateJobBatch = 100
+
+// Create accounts in batches, progress is reported after each batch
+// With an idempotency key they're created in one step, so a retry returns the same accounts
+func (hc *HttpController) accountsCreate(dbWallet *ent.Wallet, count int, idempotencyKey *uuid.UUID, progress wallet.JobProgress) (*responses.AccountsResponse, error) {
+	resp := &responses.AccountsResponse{
+		Accounts: []string{},
+	}
+	if idempotencyKey != nil {
+		addresses, err := hc.Wallet.AccountsCreateIdempotent(dbWallet, count, *idempotencyKey)
+		if err != nil {
+			return resp, err
+		}
+		resp.Accounts = addresses
+		return resp, nil
+	}
+	for len(resp.Accounts) < count {
+		newAccounts, err := hc.Wallet.AccountsCreate(dbWallet, min(count-len(resp.Accounts), accountsCreateJobBatch))
+		if err != nil {
+			return resp, err
+		}
+		for _, account := range newAccounts {
+			resp.Accounts = append(resp.Accounts, account.Address)
+		}
+		progress(len(resp.Accounts)*100/count, resp)
+	}
+	return resp, nil
+}
+
+// Handle accounts_list, count accounts at a time from offset, count is capped by account_list_max_count
 func (hc *HttpController) HandleAccountList(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
 	request, count := hc.DecodeBaseRequestWithCount(rawRequest, w, r)
 	if request == nil {
@@ -96,6 +366,34 @@ func (hc *HttpController) HandleAccountList(rawRequest *map[string]interface{},
 		// Default 1000
 		count = 1000
 	}
+	if maxCount := hc.Wallet.Config.Server.AccountListMaxCount; maxCount > 0 && count > maxCount {
+		count = maxCount
+	}
+
+	var listRequest requests.AccountListRequest
+	if err := mapstructure.Decode(rawRequest, &listRequest); err != nil {
+		log.Errorf("Error unmarshalling account_list request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	offset := 0
+	if listRequest.Offset != nil {
+		var err error
+		offset, err = utils.ToInt(*listRequest.Offset)
+		if err != nil || offset < 0 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+	withTotal := false
+	if listRequest.Total != nil {
+		var err error
+		withTotal, err = utils.ToBool(*listRequest.Total)
+		if err != nil {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
 
 	// See if wallet exists
 	dbWallet := hc.WalletExists(request.Wallet, w, r)
@@ -104,7 +402,7 @@ func (hc *HttpController) HandleAccountList(rawRequest *map[string]interface{},
 	}
 
 	// Accounts list
-	_, accounts, err := hc.Wallet.AccountsList(dbWallet, count)
+	dbAccounts, err := hc.Wallet.AccountsPage(dbWallet, offset, count)
 	if errors.Is(err, wallet.ErrWalletLocked) {
 		ErrWalletLocked(w, r)
 		return
@@ -112,11 +410,1135 @@ func (hc *HttpController) HandleAccountList(rawRequest *map[string]interface{},
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
+	accounts := make([]string, len(dbAccounts))
+	for i, acc := range dbAccounts {
+		accounts[i] = acc.Address
+	}
 
 	resp := responses.AccountsResponse{
 		Accounts: accounts,
 	}
+	for _, acc := range dbAccounts {
+		if acc.Label == nil {
+			continue
+		} else if resp.Labels == nil {
+			resp.Labels = map[string]string{}
+		}
+		resp.Labels[acc.Address] = *acc.Label
+	}
+	if withTotal {
+		total, err := hc.Wallet.AccountsCount(dbWallet)
+		if err != nil {
+			ErrInternalServerError(w, r, err.Error())
+			return
+		}
+		resp.Total = &total
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle accounts_sync, which accounts of a wallet are opened, and which of the seed's are on chain but not in the wallet
+func (hc *HttpController) HandleAccountsSync(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	result, err := hc.Wallet.AccountsSync(dbWallet)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.AccountsSyncResponse{
+		Opened:        result.Opened,
+		Unopened:      result.Unopened,
+		MissingFromDB: result.MissingFromDB,
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle account_sync, receive everything receivable on an account so it's up to date with the node, the account counterpart to receive_all
+func (hc *HttpController) HandleAccountSync(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var syncRequest requests.AccountSyncRequest
+	if err := mapstructure.Decode(rawRequest, &syncRequest); err != nil {
+		log.Errorf("Error unmarshalling account_sync request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if syncRequest.Wallet == "" || syncRequest.Action == "" || syncRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// Validate account
+	_, err := utils.AddressToPub(syncRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(syncRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		return
+	}
+
+	result, err := hc.Wallet.AccountSync(dbWallet, syncRequest.Account, syncRequest.BpowKey)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
+		return
+	}
+
+	resp := responses.AccountSyncResponse{
+		ReceivedCount: len(result.Received),
+	}
+	if result.Frontier != "" {
+		resp.NewFrontier = &result.Frontier
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle accounts_filter, balances and representatives come from the node so the filters are applied in memory
+func (hc *HttpController) HandleAccountsFilter(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var filterRequest requests.AccountsFilterRequest
+	if err := mapstructure.Decode(rawRequest, &filterRequest); err != nil {
+		log.Errorf("Error unmarshalling accounts_filter request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if filterRequest.Wallet == "" || filterRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// Accounts don't have labels
+	if filterRequest.LabelContains != nil {
+		ErrBadRequest(w, r, ErrorCodeNotSupported, "label_contains is not supported, accounts have no labels")
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(filterRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Validate representative
+	if filterRequest.Representative != nil {
+		if _, err := utils.AddressToPub(*filterRequest.Representative, hc.Wallet.Config.Wallet.Banano); err != nil {
+			ErrBadRequest(w, r, ErrorCodeInvalidRepresentative, "Invalid representative")
+			return
+		}
+	}
+
+	matches, err := hc.Wallet.AccountsFilter(dbWallet, wallet.AccountsFilter{
+		MinBalance:     filterRequest.MinBalanceRaw,
+		MaxBalance:     filterRequest.MaxBalanceRaw,
+		Representative: filterRequest.Representative,
+	})
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrInvalidBalanceFilter) {
+		ErrBadRequest(w, r, ErrorCodeInvalidBalanceFilter, "Invalid min_balance_raw or max_balance_raw")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.AccountsFilterResponse{
+		Accounts: []responses.FilteredAccount{},
+	}
+	for _, match := range matches {
+		resp.Accounts = append(resp.Accounts, responses.FilteredAccount{
+			Account:        match.Address,
+			BalanceRaw:     match.BalanceRaw,
+			Representative: match.Representative,
+		})
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// The accounts of a wallet grouped by representative, with the weight each representative gets from them
+func (hc *HttpController) HandleAccountsWeight(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	weights, err := hc.Wallet.AccountsWeight(dbWallet)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.AccountsWeightResponse{}
+	for representative, weight := range weights {
+		resp[representative] = responses.RepresentativeWeight{
+			Accounts:       weight.Accounts,
+			TotalWeightRaw: weight.TotalWeight.String(),
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle accounts_info, the balance, frontier and representative of every account of a wallet or of the given accounts
+func (hc *HttpController) HandleAccountsInfo(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var infoRequest requests.AccountsInfoRequest
+	if err := mapstructure.Decode(rawRequest, &infoRequest); err != nil {
+		log.Errorf("Error unmarshalling accounts_info request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if infoRequest.Action == "" || (infoRequest.Wallet == "" && len(infoRequest.Accounts) == 0) {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	var dbWallet *ent.Wallet
+	if infoRequest.Wallet != "" {
+		// See if wallet exists
+		dbWallet = hc.WalletExists(infoRequest.Wallet, w, r)
+		if dbWallet == nil {
+			return
+		}
+	} else {
+		// Validate accounts
+		for _, account := range infoRequest.Accounts {
+			if _, err := utils.AddressToPub(account, hc.Wallet.Config.Wallet.Banano); err != nil {
+				ErrInvalidAccount(w, r)
+				return
+			}
+		}
+	}
+
+	infos, err := hc.Wallet.AccountsInfo(dbWallet, infoRequest.Accounts)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		log.Errorf("Error getting accounts_info from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	resp := responses.AccountsInfoResponse{
+		Accounts: map[string]responses.AccountsInfoItem{},
+	}
+	for _, info := range infos {
+		resp.Accounts[info.Address] = responses.AccountsInfoItem{
+			Opened:          info.Opened,
+			BalanceRaw:      info.BalanceRaw,
+			PendingRaw:      info.PendingRaw,
+			Frontier:        info.Frontier,
+			Representative:  info.Representative,
+			DerivationIndex: info.DerivationIndex,
+		}
+	}
 
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, &resp)
 }
+
+// Handle account_remove
+// Accounts with a balance or pending balance are only removed when force is set
+func (hc *HttpController) HandleAccountRemove(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var removeRequest requests.AccountRemoveRequest
+	if err := mapstructure.Decode(rawRequest, &removeRequest); err != nil {
+		log.Errorf("Error unmarshalling account_remove request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if removeRequest.Wallet == "" || removeRequest.Action == "" || removeRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(removeRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Validate account
+	_, err := utils.AddressToPub(removeRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+
+	// Parse as bool
+	force := false
+	if removeRequest.Force != nil {
+		force, err = utils.ToBool(*removeRequest.Force)
+		if err != nil {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+
+	err = hc.Wallet.AccountRemove(dbWallet, removeRequest.Account, force)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	} else if errors.Is(err, wallet.ErrAccountHasBalance) {
+		ErrBadRequest(w, r, ErrorCodeAccountHasBalance, "Account has a balance, set force to remove it anyway")
+		return
+	} else if errors.Is(err, wallet.ErrLastAccount) {
+		ErrBadRequest(w, r, ErrorCodeLastAccount, "Cannot remove the last account")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.RemovedResponse{
+		Removed: "1",
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle account_move, move accounts of source to wallet with their keys, all of them or none
+func (hc *HttpController) HandleAccountMove(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var moveRequest requests.AccountMoveRequest
+	if err := mapstructure.Decode(rawRequest, &moveRequest); err != nil {
+		log.Errorf("Error unmarshalling account_move request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if moveRequest.Wallet == "" || moveRequest.Action == "" || moveRequest.Source == "" || len(moveRequest.Accounts) == 0 {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallets exist
+	destinationWallet := hc.WalletExists(moveRequest.Wallet, w, r)
+	if destinationWallet == nil {
+		return
+	}
+	sourceWallet := hc.WalletExists(moveRequest.Source, w, r)
+	if sourceWallet == nil {
+		return
+	}
+	// The keys of a frozen wallet can't be used, they can't be moved out of it either
+	if !hc.WalletNotFrozen(sourceWallet, w, r) {
+		return
+	}
+
+	// Validate accounts
+	for _, address := range moveRequest.Accounts {
+		if _, err := utils.AddressToPub(address, hc.Wallet.Config.Wallet.Banano); err != nil {
+			ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid account %s", address))
+			return
+		}
+	}
+
+	err := hc.Wallet.AccountMove(sourceWallet, destinationWallet, moveRequest.Accounts)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrSameWallet) {
+		ErrBadRequest(w, r, ErrorCodeSameWallet, "Source and destination wallets are the same")
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	} else if errors.Is(err, wallet.ErrAccountExists) {
+		ErrBadRequest(w, r, ErrorCodeAccountExists, "Account already exists")
+		return
+	} else if errors.Is(err, wallet.ErrLastAccount) {
+		ErrBadRequest(w, r, ErrorCodeLastAccount, "Cannot move the last account")
+		return
+	} else if errors.Is(err, wallet.ErrWalletWatchOnly) {
+		ErrBadRequest(w, r, ErrorCodeWalletWatchOnly, "Wallet is watch-only")
+		return
+	} else if errors.Is(err, wallet.ErrDestinationEncrypted) {
+		ErrBadRequest(w, r, ErrorCodeWalletEncrypted, "Accounts can't be moved to an encrypted wallet")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.MovedResponse{
+		Moved: "1",
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+func accountRepresentativeKey(account string) string {
+	return fmt.Sprintf("account_representative:%s", account)
+}
+
+// Forget the cached representative of accounts that published a change block, account_representative asks the node again
+func (hc *HttpController) forgetRepresentatives(accounts ...string) {
+	for _, account := range accounts {
+		if err := hc.Cache.Delete(accountRepresentativeKey(account)); err != nil {
+			log.Errorf("Error deleting cached representative of %s %s", account, err)
+		}
+	}
+}
+
+// Get the representative of a single account, cached briefly since it rarely changes
+func (hc *HttpController) HandleAccountRepresentative(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var repRequest requests.AccountRepresentativeRequest
+	if err := mapstructure.Decode(rawRequest, &repRequest); err != nil {
+		log.Errorf("Error unmarshalling account_representative request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if repRequest.Wallet == "" || repRequest.Action == "" || repRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(repRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Validate account
+	_, err := utils.AddressToPub(repRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+
+	// Account must belong to this wallet
+	exists, err := hc.Wallet.AccountExists(dbWallet, repRequest.Account)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	} else if !exists {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	}
+
+	cacheKey := accountRepresentativeKey(repRequest.Account)
+	if cached, err := hc.Cache.Get(cacheKey); err == nil && len(cached) > 0 {
+		representative := string(cached)
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, &responses.AccountRepresentativeResponse{
+			Representative: &representative,
+		})
+		return
+	}
+
+	repResp, err := hc.RpcClient.MakeAccountRepresentativeRequest(repRequest.Account)
+	if errors.Is(err, rpc.ErrAccountNotFound) {
+		// Not opened yet, don't cache it since the first receive will give it one
+		reason := "no_blocks"
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, &responses.AccountRepresentativeResponse{
+			Reason: &reason,
+		})
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, "Error making account_representative request to node")
+		return
+	}
+
+	if err := hc.Cache.Set(cacheKey, []byte(repResp.Representative), time.Second*30); err != nil {
+		log.Errorf("Error caching account representative %s", err)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.AccountRepresentativeResponse{
+		Representative: &repResp.Representative,
+	})
+}
+
+// How long block_count_for_account reuses the node's account_info
+const blockCountForAccountCacheTTL = 10 * time.Second
+
+// Handle block_count_for_account, the blocks of an account of the wallet that aren't confirmed yet
+// The account has to be in the wallet so this can't be used to ask the node about any account
+func (hc *HttpController) HandleBlockCountForAccount(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var countRequest requests.BlockCountForAccountRequest
+	if err := mapstructure.Decode(rawRequest, &countRequest); err != nil {
+		log.Errorf("Error unmarshalling block_count_for_account request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if countRequest.Wallet == "" || countRequest.Action == "" || countRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(countRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Validate account
+	_, err := utils.AddressToPub(countRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+
+	// Account must belong to this wallet
+	exists, err := hc.Wallet.AccountExists(dbWallet, countRequest.Account)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	} else if !exists {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	}
+
+	var resp responses.BlockCountForAccountResponse
+	cacheKey := fmt.Sprintf("block_count_for_account:%s", countRequest.Account)
+	if cached, err := hc.Cache.Get(cacheKey); err == nil && json.Unmarshal(cached, &resp) == nil {
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, &resp)
+		return
+	}
+
+	accountInfo, err := hc.RpcClient.MakeAccountInfoRequest(countRequest.Account)
+	if errors.Is(err, rpc.ErrAccountNotFound) {
+		// Not opened yet, it has no blocks, not cached since it can be opened any time
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, &resp)
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, "Error making account_info request")
+		return
+	}
+	resp.BlockCount, err = strconv.ParseUint(accountInfo.BlockCount, 10, 64)
+	if err != nil {
+		ErrInternalServerError(w, r, "Invalid block_count in account_info response")
+		return
+	}
+	// The node calls it confirmation_height, or confirmed_height with include_confirmed
+	confirmed := accountInfo.ConfirmationHeight
+	if confirmed == "" {
+		confirmed = accountInfo.ConfirmedHeight
+	}
+	resp.ConfirmationHeight, err = strconv.ParseUint(confirmed, 10, 64)
+	if err != nil {
+		ErrInternalServerError(w, r, "Invalid confirmation_height in account_info response")
+		return
+	}
+	if resp.BlockCount > resp.ConfirmationHeight {
+		resp.UnconfirmedCount = resp.BlockCount - resp.ConfirmationHeight
+	}
+
+	if encoded, err := json.Marshal(resp); err == nil {
+		if err := hc.Cache.Set(cacheKey, encoded, blockCountForAccountCacheTTL); err != nil {
+			log.Errorf("Error caching block_count_for_account %s", err)
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// How long account_frontier reuses the node's account_info
+const accountFrontierCacheTTL = 5 * time.Second
+
+// Handle account_frontier, the frontier of one account of the wallet from account_info, for building its next block
+// Unlike accounts_frontiers the account has to be in the wallet
+func (hc *HttpController) HandleAccountFrontier(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var frontierRequest requests.AccountFrontierRequest
+	if err := mapstructure.Decode(rawRequest, &frontierRequest); err != nil {
+		log.Errorf("Error unmarshalling account_frontier request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if frontierRequest.Wallet == "" || frontierRequest.Action == "" || frontierRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(frontierRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Validate account
+	_, err := utils.AddressToPub(frontierRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+
+	// Account must belong to this wallet
+	exists, err := hc.Wallet.AccountExists(dbWallet, frontierRequest.Account)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	} else if !exists {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	}
+
+	var resp responses.AccountFrontierResponse
+	cacheKey := fmt.Sprintf("account_frontier:%s", frontierRequest.Account)
+	if cached, err := hc.Cache.Get(cacheKey); err == nil && json.Unmarshal(cached, &resp) == nil {
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, &resp)
+		return
+	}
+
+	accountInfo, err := hc.RpcClient.MakeAccountInfoRequest(frontierRequest.Account)
+	if errors.Is(err, rpc.ErrAccountNotFound) {
+		// Not opened yet, not cached since it can be opened any time
+		resp.BalanceRaw = "0"
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, &resp)
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, "Error making account_info request")
+		return
+	}
+	resp.BlockCount, err = strconv.ParseUint(accountInfo.BlockCount, 10, 64)
+	if err != nil {
+		ErrInternalServerError(w, r, "Invalid block_count in account_info response")
+		return
+	}
+	resp.Frontier = &accountInfo.Frontier
+	resp.OpenBlock = &accountInfo.OpenBlock
+	resp.Representative = &accountInfo.Representative
+	resp.BalanceRaw = accountInfo.Balance
+
+	// cached_at is only in responses from the cache
+	cachedAt := time.Now().Unix()
+	cachedResp := resp
+	cachedResp.CachedAt = &cachedAt
+	if encoded, err := json.Marshal(cachedResp); err == nil {
+		if err := hc.Cache.Set(cacheKey, encoded, accountFrontierCacheTTL); err != nil {
+			log.Errorf("Error caching account_frontier %s", err)
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Statuses of account_representative_check
+const (
+	repStatusOk        = "ok"
+	repStatusOffline   = "offline"
+	repStatusLowWeight = "low_weight"
+)
+
+// Handle account_representative_check, whether the representative of an account is online and has min_rep_weight_percent of the online weight
+// The wallet is optional, with it the account must belong to the wallet
+func (hc *HttpController) HandleAccountRepresentativeCheck(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var checkRequest requests.AccountRepresentativeCheckRequest
+	if err := mapstructure.Decode(rawRequest, &checkRequest); err != nil {
+		log.Errorf("Error unmarshalling account_representative_check request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if checkRequest.Action == "" || checkRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// Validate account
+	_, err := utils.AddressToPub(checkRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+
+	if checkRequest.Wallet != "" {
+		dbWallet := hc.WalletExists(checkRequest.Wallet, w, r)
+		if dbWallet == nil {
+			return
+		}
+		exists, err := hc.Wallet.AccountExists(dbWallet, checkRequest.Account)
+		if err != nil {
+			ErrInternalServerError(w, r, err.Error())
+			return
+		} else if !exists {
+			ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+			return
+		}
+	}
+
+	repResp, err := hc.RpcClient.MakeAccountRepresentativeRequest(checkRequest.Account)
+	if errors.Is(err, rpc.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountUnopened, "Account has no blocks, so no representative")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, "Error making account_representative request to node")
+		return
+	}
+	repPub, err := utils.AddressToPub(repResp.Representative, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInternalServerError(w, r, "Invalid representative from node")
+		return
+	}
+
+	info, err := hc.representativeInfo(repResp.Representative, repPub)
+	if err != nil {
+		log.Errorf("Error getting representative_info from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	resp := responses.AccountRepresentativeCheckResponse{
+		Representative: repResp.Representative,
+		IsOnline:       info.IsOnline,
+		WeightRaw:      info.WeightRaw,
+		WeightPercent:  info.WeightPercentOfOnline,
+		Status:         repStatusOk,
+	}
+	if !info.IsOnline {
+		resp.Status = repStatusOffline
+	} else if info.WeightPercentOfOnline < hc.Wallet.Config.Wallet.MinRepWeightPercent {
+		resp.Status = repStatusLowWeight
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Reasons of validate_account_number
+const (
+	addressReasonOk              = "ok"
+	addressReasonInvalidPrefix   = "invalid_prefix"
+	addressReasonInvalidLength   = "invalid_length"
+	addressReasonInvalidBase32   = "invalid_base32"
+	addressReasonInvalidChecksum = "invalid_checksum"
+)
+
+// Handle validate_account_number, whether an account is a valid address for this network and why not
+func (hc *HttpController) HandleValidateAccountNumber(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var validateRequest requests.ValidateAccountNumberRequest
+	if err := mapstructure.Decode(rawRequest, &validateRequest); err != nil {
+		log.Errorf("Error unmarshalling validate_account_number request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if validateRequest.Action == "" || validateRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	reason := addressReasonOk
+	_, err := nano.ValidateAddress(validateRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	switch {
+	case errors.Is(err, nano.ErrInvalidPrefix):
+		reason = addressReasonInvalidPrefix
+	case errors.Is(err, nano.ErrInvalidLength):
+		reason = addressReasonInvalidLength
+	case errors.Is(err, nano.ErrInvalidBase32):
+		reason = addressReasonInvalidBase32
+	case errors.Is(err, nano.ErrInvalidChecksum):
+		reason = addressReasonInvalidChecksum
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.ValidateAccountNumberResponse{
+		Valid:  reason == addressReasonOk,
+		Reason: reason,
+	})
+}
+
+// Forward account_info to the node, if the account is in a wallet add what we know about it
+// If some blocks aren't confirmed yet has_unconfirmed and unconfirmed_count are added, from the node's own block_count and confirmation height
+// Fields from the node are never overwritten, if there's nothing to add the node response is returned as is
+func (hc *HttpController) HandleAccountInfo(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var infoRequest requests.AccountInfoRequest
+	if err := mapstructure.Decode(rawRequest, &infoRequest); err != nil {
+		log.Errorf("Error unmarshalling account_info request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if infoRequest.Action == "" || infoRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	resp, err := hc.RpcClient.MakeRequest(rawRequest)
+	if err != nil {
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	var nodeResponse map[string]json.RawMessage
+	if json.Unmarshal(resp, &nodeResponse) != nil || nodeResponse["error"] != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(resp)
+		return
+	}
+
+	metadata := map[string]interface{}{}
+	acc, err := hc.Wallet.GetAccountByAddress(infoRequest.Account)
+	if err == nil {
+		metadata["wallet_id"] = acc.WalletID.String()
+		// Ad-hoc accounts aren't derived from the seed
+		if acc.AccountIndex != nil {
+			metadata["derivation_index"] = *acc.AccountIndex
+		}
+	} else if !errors.Is(err, wallet.ErrAccountNotFound) {
+		log.Errorf("Error looking up account for account_info %s", err)
+	}
+	if unconfirmed := unconfirmedCount(nodeResponse); unconfirmed > 0 {
+		metadata["has_unconfirmed"] = true
+		metadata["unconfirmed_count"] = unconfirmed
+	}
+	if len(metadata) == 0 {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(resp)
+		return
+	}
+
+	for k, v := range metadata {
+		if _, ok := nodeResponse[k]; ok {
+			continue
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			ErrInternalServerError(w, r, err.Error())
+			return
+		}
+		nodeResponse[k] = encoded
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &nodeResponse)
+}
+
+// How many blocks of an account_info response are above its confirmation height
+// The node calls it confirmation_height, or confirmed_height with include_confirmed, 0 if it has neither
+func unconfirmedCount(nodeResponse map[string]json.RawMessage) uint64 {
+	var blockCount, height string
+	if json.Unmarshal(nodeResponse["block_count"], &blockCount) != nil {
+		return 0
+	}
+	if json.Unmarshal(nodeResponse["confirmation_height"], &height) != nil && json.Unmarshal(nodeResponse["confirmed_height"], &height) != nil {
+		return 0
+	}
+	count, err := strconv.ParseUint(blockCount, 10, 64)
+	if err != nil {
+		return 0
+	}
+	confirmed, err := strconv.ParseUint(height, 10, 64)
+	if err != nil || confirmed >= count {
+		return 0
+	}
+	return count - confirmed
+}
+
+// Forward account_balance to the node, with include_price the fiat value of balance plus receivable is added
+// With a unit the amounts are converted from raw to it
+func (hc *HttpController) HandleAccountBalance(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var balanceRequest requests.AccountBalanceRequest
+	if err := mapstructure.Decode(rawRequest, &balanceRequest); err != nil {
+		log.Errorf("Error unmarshalling account_balance request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if balanceRequest.Action == "" || balanceRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	if !hc.ValidUnit(balanceRequest.Unit, w, r) {
+		return
+	}
+
+	// The node doesn't know about our options
+	nodeRequest := make(map[string]interface{})
+	for k, v := range *rawRequest {
+		if k != "include_price" && k != "currency" && k != "unit" {
+			nodeRequest[k] = v
+		}
+	}
+	resp, err := hc.RpcClient.MakeRequest(nodeRequest)
+	if err != nil {
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	var nodeResponse map[string]interface{}
+	if (balanceRequest.IncludePrice == nil && balanceRequest.Unit == nil) || json.Unmarshal(resp, &nodeResponse) != nil || nodeResponse["error"] != nil {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(resp)
+		return
+	}
+
+	total := big.NewInt(0)
+	for _, field := range []string{"balance", "receivable"} {
+		asString, _ := nodeResponse[field].(string)
+		if amount, ok := big.NewInt(0).SetString(asString, 10); ok {
+			total.Add(total, amount)
+		}
+	}
+	value, currency, ok := hc.FiatValue(total, balanceRequest.PriceOptions, w, r)
+	if !ok {
+		return
+	} else if value != nil {
+		nodeResponse["value_fiat"] = *value
+		nodeResponse["currency"] = *currency
+	}
+
+	if balanceRequest.Unit != nil {
+		for _, field := range []string{"balance", "pending", "receivable"} {
+			asString, _ := nodeResponse[field].(string)
+			if amount, ok := big.NewInt(0).SetString(asString, 10); ok {
+				nodeResponse[field], _ = utils.RawToUnit(amount, *balanceRequest.Unit, hc.Wallet.Config.Wallet.Banano)
+			}
+		}
+		nodeResponse["unit"] = strings.ToLower(*balanceRequest.Unit)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &nodeResponse)
+}
+
+// Handle account_balance_history, the snapshots recorded every balance_snapshot_interval grouped per hour or day
+func (hc *HttpController) HandleAccountBalanceHistory(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var historyRequest requests.AccountBalanceHistoryRequest
+	if err := mapstructure.Decode(rawRequest, &historyRequest); err != nil {
+		log.Errorf("Error unmarshalling account_balance_history request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if historyRequest.Wallet == "" || historyRequest.Action == "" || historyRequest.Account == "" || historyRequest.Period == "" || historyRequest.StartDate == nil || historyRequest.EndDate == nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	startDate, err := parseHistoryDate(*historyRequest.StartDate)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidDate, "Invalid start_date")
+		return
+	}
+	endDate, err := parseHistoryDate(*historyRequest.EndDate)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidDate, "Invalid end_date")
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(historyRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Validate account
+	_, err = utils.AddressToPub(historyRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+
+	history, err := hc.Wallet.AccountBalanceHistory(dbWallet, historyRequest.Account, historyRequest.Period, startDate, endDate)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	} else if errors.Is(err, wallet.ErrInvalidPeriod) {
+		ErrBadRequest(w, r, ErrorCodeInvalidPeriod, "Invalid period, must be hourly or daily")
+		return
+	} else if errors.Is(err, wallet.ErrInvalidDateRange) {
+		ErrBadRequest(w, r, ErrorCodeInvalidDateRange, "end_date must be after start_date")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.AccountBalanceHistoryResponse{
+		History: []responses.BalanceHistoryEntry{},
+	}
+	for _, entry := range history {
+		resp.History = append(resp.History, responses.BalanceHistoryEntry{
+			PeriodStart: entry.PeriodStart.Unix(),
+			BalanceRaw:  entry.BalanceRaw,
+			SnapshotAt:  entry.SnapshotAt.Unix(),
+		})
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// A unix timestamp, or a date as YYYY-MM-DD which is midnight UTC
+func parseHistoryDate(value interface{}) (time.Time, error) {
+	if date, ok := value.(string); ok {
+		if parsed, err := time.Parse("2006-01-02", date); err == nil {
+			return parsed, nil
+		}
+	}
+	unix, err := utils.ToInt(value)
+	if err != nil || unix < 0 {
+		return time.Time{}, errors.New("invalid date")
+	}
+	return time.Unix(int64(unix), 0), nil
+}
+
+// Blocks per account_history call of account_history_all
+const accountHistoryAllPageSize = 1000
+
+// Handle account_history_all, every page of the node's account_history from the frontier down to the open block
+// Each page is written as it comes in so a long chain is never held in memory, complete is false if max_blocks was reached first
+// Errors after the first page can't change the status anymore, those end the history with complete false and the error
+func (hc *HttpController) HandleAccountHistoryAll(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var historyRequest requests.AccountHistoryAllRequest
+	if err := mapstructure.Decode(rawRequest, &historyRequest); err != nil {
+		log.Errorf("Error unmarshalling account_history_all request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if historyRequest.Action == "" || historyRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	maxBlocks := max(hc.Wallet.Config.Server.AccountHistoryMaxBlocks, 1)
+	if historyRequest.MaxBlocks != nil {
+		requested, err := utils.ToInt(*historyRequest.MaxBlocks)
+		if err != nil || requested < 1 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+		maxBlocks = min(requested, maxBlocks)
+	}
+
+	// Validate account
+	_, err := utils.AddressToPub(historyRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+
+	// The first page is read before anything is written, so its errors are a normal response
+	page, err := hc.RpcClient.MakeAccountHistoryRequest(historyRequest.Account, min(accountHistoryAllPageSize, maxBlocks), nil)
+	if err != nil {
+		ErrInternalServerError(w, r, "Error making account_history request to node")
+		return
+	}
+
+	account, _ := json.Marshal(historyRequest.Account)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, `{"account":%s,"history":[`, account)
+
+	written := 0
+	var pageErr error
+	for {
+		for _, entry := range page.History {
+			encoded, _ := json.Marshal(entry)
+			if written > 0 {
+				w.Write([]byte(","))
+			}
+			w.Write(encoded)
+			written++
+		}
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+		// No previous means this page ended at the open block
+		if page.Previous == "" || written >= maxBlocks {
+			break
+		}
+		head := page.Previous
+		page, pageErr = hc.RpcClient.MakeAccountHistoryRequest(historyRequest.Account, min(accountHistoryAllPageSize, maxBlocks-written), &head)
+		if pageErr != nil {
+			log.Errorf("Error making account_history request for %s %s", historyRequest.Account, pageErr)
+			break
+		}
+	}
+
+	if pageErr != nil {
+		message, _ := json.Marshal("Error making account_history request to node")
+		fmt.Fprintf(w, `],"complete":false,"error":%s}`, message)
+		return
+	}
+	fmt.Fprintf(w, `],"complete":%t}`, page.Previous == "")
+}
+
+// Handle account_history_since, the history of an account in the wallet after since_hash, for clients that poll for new blocks
+// At most max_depth blocks are read looking for since_hash, it's capped by and defaults to account_history_since_max_depth
+func (hc *HttpController) HandleAccountHistorySince(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var sinceRequest requests.AccountHistorySinceRequest
+	if err := mapstructure.Decode(rawRequest, &sinceRequest); err != nil {
+		log.Errorf("Error unmarshalling account_history_since request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if sinceRequest.Wallet == "" || sinceRequest.Action == "" || sinceRequest.Account == "" || sinceRequest.SinceHash == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	maxDepth := max(hc.Wallet.Config.Server.AccountHistorySinceMaxDepth, 1)
+	if sinceRequest.MaxDepth != nil {
+		requested, err := utils.ToInt(*sinceRequest.MaxDepth)
+		if err != nil || requested < 1 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+		maxDepth = min(requested, maxDepth)
+	}
+
+	if !utils.Validate64HexHash(sinceRequest.SinceHash) {
+		ErrInvalidHash(w, r)
+		return
+	}
+
+	dbWallet := hc.WalletExists(sinceRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	history, err := hc.Wallet.AccountHistorySince(dbWallet, sinceRequest.Account, sinceRequest.SinceHash, maxDepth)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	} else if errors.Is(err, wallet.ErrHashNotFoundInChain) {
+		ErrBadRequest(w, r, ErrorCodeHashNotFoundInChain, "hash_not_found_in_chain")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, "Error making account_history request to node")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.AccountHistorySinceResponse{
+		Account: sinceRequest.Account,
+		History: history,
+	})
+}
@@ -3,13 +3,23 @@ package controller
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
+	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/price"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -95,6 +105,189 @@ func TestAccountCreate(t *testing.T) {
 	assert.Equal(t, addr, respJson["account"].(string))
 }
 
+func TestAccountCreateNext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	// No account is opened
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&js)
+			errors := map[string]string{}
+			for _, acc := range js["accounts"].([]interface{}) {
+				errors[acc.(string)] = "Account not found"
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"frontiers": map[string]string{}, "errors": errors})
+		},
+	)
+
+	hc := newTestController(t)
+	seed := "3ab3de2721b57af86637e2c4c8994adf4f8eecfd62f59d013de0353500b9b823"
+	dbWallet, err := hc.Wallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		reqBody["wallet"] = dbWallet.ID.String()
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	for index := 1; index <= 3; index++ {
+		pub, _, err := utils.KeypairFromSeed(seed, uint32(index))
+		assert.Nil(t, err)
+		status, respJson := doRequest(map[string]interface{}{"action": "account_create_next"})
+		assert.Equal(t, 200, status)
+		assert.Equal(t, map[string]interface{}{
+			"next_index":       float64(index),
+			"would_be_account": utils.PubKeyToAddress(pub, false),
+		}, respJson)
+
+		// It's a dry run, account_create creates that account
+		status, respJson = doRequest(map[string]interface{}{"action": "account_create"})
+		assert.Equal(t, 200, status)
+		assert.Equal(t, utils.PubKeyToAddress(pub, false), respJson["account"])
+	}
+	count, err := hc.Wallet.DB.Wallet.QueryAccounts(dbWallet).Count(hc.Wallet.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, count)
+
+	// Refused like account_create, 4 unused accounts in a row already
+	status, respJson := doRequest(map[string]interface{}{"action": "account_create_next", "gap_limit": 4})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "GAP_LIMIT_EXCEEDED", respJson["error_code"])
+	status, _ = doRequest(map[string]interface{}{"action": "account_create_next", "gap_limit": 5})
+	assert.Equal(t, 200, status)
+}
+
+func TestAccountCreateVanity(t *testing.T) {
+	hc := newTestController(t)
+	seed, _ := utils.GenerateSeed(strings.NewReader("a7d0c3f6e9b2d5a8c1f4e7b0d3a6c9f2e5b8d1a4c7f0e3b6d9a2c5f8e1b4d7a0"))
+	wallet, err := hc.Wallet.WalletCreate(seed)
+	assert.Nil(t, err)
+
+	doRequest := func(request map[string]interface{}) (int, map[string]interface{}) {
+		request["action"] = "account_create_vanity"
+		request["wallet"] = wallet.ID.String()
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, resp := doRequest(map[string]interface{}{"prefix": "nano_1z", "workers": 2})
+	assert.Equal(t, 200, status)
+	address := resp["account"].(string)
+	assert.True(t, strings.HasPrefix(address, "nano_1z"))
+	assert.NotZero(t, resp["attempts"])
+	acc, err := hc.Wallet.GetAccount(wallet, address)
+	assert.Nil(t, err)
+	assert.Nil(t, acc.AccountIndex)
+
+	// errors
+	status, resp = doRequest(map[string]interface{}{})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_VANITY_PATTERN", resp["error_code"])
+	status, resp = doRequest(map[string]interface{}{"suffix": "l0"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_VANITY_PATTERN", resp["error_code"])
+	status, resp = doRequest(map[string]interface{}{"prefix": "1z", "timeout": 601})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_TIMEOUT", resp["error_code"])
+	status, resp = doRequest(map[string]interface{}{"prefix": "1z", "workers": 0})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JSON", resp["error_code"])
+	status, resp = doRequest(map[string]interface{}{"prefix": "1pippinpippinpippin", "workers": 1, "timeout": 1})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "VANITY_NOT_FOUND", resp["error_code"])
+}
+
+func TestAccountCreateFromSeed(t *testing.T) {
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("e8b3d6a1f4c9e2b7d0a5f8c3e6b1d4a9f2c7e0b5d8a3f6c1e4b9d2a7f0c5e8bb"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+
+	doCreate := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Same seed as TestAccountCreate, where index 1 is nano_13coy8t4...
+	externalSeed := "3ab3de2721b57af86637e2c4c8994adf4f8eecfd62f59d013de0353500b9b823"
+	status, respJson := doCreate(map[string]interface{}{
+		"action": "account_create",
+		"wallet": wallet.ID.String(),
+		"seed":   externalSeed,
+		"index":  1,
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "nano_13coy8t4jzd516m5ydw8a7mdfguttcm6nkm4t69fwd1dzm87mgj5p8ijge8w", respJson["account"])
+
+	acc, err := hc.Wallet.GetAccount(wallet, "nano_13coy8t4jzd516m5ydw8a7mdfguttcm6nkm4t69fwd1dzm87mgj5p8ijge8w")
+	assert.Nil(t, err)
+	assert.Equal(t, externalSeed, *acc.Seed)
+	assert.Equal(t, 1, *acc.SeedIndex)
+
+	// Without an index it's the first index of the seed that isn't in the wallet
+	pub, _, _ := utils.KeypairFromSeed(externalSeed, 0)
+	status, respJson = doCreate(map[string]interface{}{
+		"action": "account_create",
+		"wallet": wallet.ID.String(),
+		"seed":   externalSeed,
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, utils.PubKeyToAddress(pub, false), respJson["account"])
+
+	status, respJson = doCreate(map[string]interface{}{
+		"action": "account_create",
+		"wallet": wallet.ID.String(),
+		"seed":   externalSeed,
+		"index":  1,
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_EXISTS", respJson["error_code"])
+
+	status, respJson = doCreate(map[string]interface{}{
+		"action": "account_create",
+		"wallet": wallet.ID.String(),
+		"seed":   "1234",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_SEED", respJson["error_code"])
+
+	// Without a seed it's the wallet's next account
+	pub, _, _ = utils.KeypairFromSeed(newSeed, 1)
+	status, respJson = doCreate(map[string]interface{}{
+		"action": "account_create",
+		"wallet": wallet.ID.String(),
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, utils.PubKeyToAddress(pub, false), respJson["account"])
+}
+
 func TestAccountsCreate(t *testing.T) {
 	// Create wallet first
 	// Request JSON
@@ -150,6 +343,130 @@ func TestAccountsCreate(t *testing.T) {
 	}
 }
 
+func TestAccountsCreateIdempotencyKey(t *testing.T) {
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("2e8b5d1a7c4f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e7b0d3a6c9f2e5b"))
+	dbWallet, _ := MockController.Wallet.WalletCreate(newSeed)
+
+	doCreate := func(key string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":          "accounts_create",
+			"wallet":          dbWallet.ID.String(),
+			"count":           3,
+			"idempotency_key": key,
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	key := "6f1c2a9e-3b7d-4e5f-8a0c-1d2e3f4a5b6c"
+	status, first := doCreate(key)
+	assert.Equal(t, 200, status)
+	assert.Len(t, first["accounts"], 3)
+
+	// Retrying returns the same accounts
+	status, second := doCreate(key)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, first["accounts"], second["accounts"])
+
+	// Only one set was created, plus the wallet's first account
+	accounts, _, err := MockController.Wallet.AccountsList(dbWallet, 100)
+	assert.Nil(t, err)
+	assert.Len(t, accounts, 4)
+
+	status, resp := doCreate("not-a-uuid")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_IDEMPOTENCY_KEY", resp["error_code"])
+}
+
+func TestAccountsCreateGapLimit(t *testing.T) {
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("3c6f9e2b5d8a1c4f7e0b3d6a9c2f5e8b1d4a7c0f3e6b9d2a5c8f1e4b7d0a3c6f"))
+	dbWallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	address := func(index uint32) string {
+		pub, _, _ := utils.KeypairFromSeed(newSeed, index)
+		return utils.PubKeyToAddress(pub, false)
+	}
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	opened := map[string]bool{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&js)
+			frontiers := map[string]string{}
+			errors := map[string]string{}
+			for _, acc := range js["accounts"].([]interface{}) {
+				if opened[acc.(string)] {
+					frontiers[acc.(string)] = "791AF413173EEE674A6FCF633B5DFC0F3C33F397F0DA08E987D9E0741D40D81A"
+				} else {
+					errors[acc.(string)] = "Account not found"
+				}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"frontiers": frontiers, "errors": errors})
+		},
+	)
+
+	doCreate := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		reqBody["wallet"] = dbWallet.ID.String()
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Index 0 and the 19 new accounts are unused, that's the limit
+	status, _ := doCreate(map[string]interface{}{"action": "accounts_create", "count": 19, "gap_limit": 20})
+	assert.Equal(t, 200, status)
+
+	status, respJson := doCreate(map[string]interface{}{"action": "account_create", "gap_limit": 20})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "gap_limit_exceeded", respJson["error"])
+	assert.Equal(t, "GAP_LIMIT_EXCEEDED", respJson["error_code"])
+	assert.Equal(t, float64(20), respJson["gap_limit"])
+	assert.Equal(t, float64(20), respJson["current_gap"])
+
+	// Without gap_limit nothing is checked, accounts of another seed aren't in the sequence
+	status, _ = doCreate(map[string]interface{}{"action": "account_create", "seed": "9d2a5c8f1e4b7d0a3c6f9e2b5d8a1c4f7e0b3d6a9c2f5e8b1d4a7c0f3e6b9d2a"})
+	assert.Equal(t, 200, status)
+	status, _ = doCreate(map[string]interface{}{"action": "account_create", "gap_limit": 20, "seed": "9d2a5c8f1e4b7d0a3c6f9e2b5d8a1c4f7e0b3d6a9c2f5e8b1d4a7c0f3e6b9d2a", "index": 1})
+	assert.Equal(t, 200, status)
+
+	// Once an account near the end is used the gap is what comes after it
+	opened[address(17)] = true
+	status, respJson = doCreate(map[string]interface{}{"action": "accounts_create", "count": 20, "gap_limit": 20})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, float64(2), respJson["current_gap"])
+	status, respJson = doCreate(map[string]interface{}{"action": "accounts_create", "count": 18, "gap_limit": "20"})
+	assert.Equal(t, 200, status)
+	assert.Len(t, respJson["accounts"], 18)
+
+	// A retry with an idempotency key that already created its accounts returns them
+	opened[address(37)] = true
+	key := "2f8c1d4e-7a3b-4c6d-9e0f-5b8a2c7d1e3f"
+	status, first := doCreate(map[string]interface{}{"action": "accounts_create", "count": 20, "gap_limit": 20, "idempotency_key": key})
+	assert.Equal(t, 200, status)
+	status, again := doCreate(map[string]interface{}{"action": "accounts_create", "count": 20, "gap_limit": 20, "idempotency_key": key})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, first, again)
+
+	status, respJson = doCreate(map[string]interface{}{"action": "accounts_create", "count": 1, "gap_limit": 0})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"])
+}
+
 func TestAccountList(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("f39a07504c76978f47e6630bb97e6fc169dd734d25ddcb323609a5699789b104"))
 	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
@@ -186,3 +503,1556 @@ func TestAccountList(t *testing.T) {
 		assert.Nil(t, err)
 	}
 }
+
+func TestAccountListPages(t *testing.T) {
+	hc := newTestController(t)
+	hc.Wallet.Config.Server.AccountListMaxCount = 3
+	defer func() { hc.Wallet.Config.Server.AccountListMaxCount = 10000 }()
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("2e8b4d1f7a3c9e5b0d6f2a8c4e1b7d3f9a5c0e6b2d8f4a1c7e3b9d5f0a6c2e8b"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	hc.Wallet.AccountsCreate(wallet, 4)
+
+	list := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		reqBody["action"] = "account_list"
+		reqBody["wallet"] = wallet.ID.String()
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// count is capped by account_list_max_count
+	status, respJson := list(map[string]interface{}{"count": 100, "total": true})
+	assert.Equal(t, 200, status)
+	first := respJson["accounts"].([]interface{})
+	assert.Len(t, first, 3)
+	assert.Equal(t, float64(5), respJson["total"])
+
+	status, respJson = list(map[string]interface{}{"count": 3, "offset": 3})
+	assert.Equal(t, 200, status)
+	second := respJson["accounts"].([]interface{})
+	assert.Len(t, second, 2)
+	assert.NotContains(t, respJson, "total")
+	for _, account := range second {
+		assert.NotContains(t, first, account)
+	}
+
+	status, respJson = list(map[string]interface{}{"offset": 10})
+	assert.Equal(t, 200, status)
+	assert.Len(t, respJson["accounts"], 0)
+
+	status, _ = list(map[string]interface{}{"offset": -1})
+	assert.Equal(t, 400, status)
+}
+
+func TestAccountRemove(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.Unmarshal([]byte(mocks.AccountBalanceResponseStr), &js)
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("7d1c9e4a2b6f80d35e9a1c7b4f2e6d0a8c3b5f9e1d7a2c4b6e8f0a1d3c5b7e92"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	acc, _ := hc.Wallet.AccountCreate(wallet, nil)
+
+	doRemove := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Account with a balance is blocked without force
+	status, respJson := doRemove(map[string]interface{}{
+		"action":  "account_remove",
+		"wallet":  wallet.ID.String(),
+		"account": acc.Address,
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_HAS_BALANCE", respJson["error_code"])
+	exists, _ := hc.Wallet.AccountExists(wallet, acc.Address)
+	assert.True(t, exists)
+
+	// Invalid account
+	status, respJson = doRemove(map[string]interface{}{
+		"action":  "account_remove",
+		"wallet":  wallet.ID.String(),
+		"account": "nano_1234",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", respJson["error_code"])
+
+	// Forced
+	status, respJson = doRemove(map[string]interface{}{
+		"action":  "account_remove",
+		"wallet":  wallet.ID.String(),
+		"account": acc.Address,
+		"force":   true,
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["removed"])
+	exists, _ = hc.Wallet.AccountExists(wallet, acc.Address)
+	assert.False(t, exists)
+}
+
+func TestAccountMove(t *testing.T) {
+	hc := newTestController(t)
+	sourceSeed, _ := utils.GenerateSeed(strings.NewReader("6e9a2c5f8b1e4d7a0c3f6b9e2d5a8c1f4b7e0d3a6c9f2b5e8d1a4c7f0b3e6d9a"))
+	source, _ := hc.Wallet.WalletCreate(sourceSeed)
+	acc, _ := hc.Wallet.AccountCreate(source, nil)
+	destinationSeed, _ := utils.GenerateSeed(strings.NewReader("a2c5f8b1e4d7a0c3f6b9e2d5a8c1f4b7e0d3a6c9f2b5e8d1a4c7f0b3e6d9a2c5"))
+	destination, _ := hc.Wallet.WalletCreate(destinationSeed)
+
+	doMove := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// One account that isn't in source fails the whole move
+	status, respJson := doMove(map[string]interface{}{
+		"action":   "account_move",
+		"wallet":   destination.ID.String(),
+		"source":   source.ID.String(),
+		"accounts": []string{acc.Address, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"},
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", respJson["error_code"])
+	exists, _ := hc.Wallet.AccountExists(source, acc.Address)
+	assert.True(t, exists)
+
+	// Invalid account
+	status, respJson = doMove(map[string]interface{}{
+		"action":   "account_move",
+		"wallet":   destination.ID.String(),
+		"source":   source.ID.String(),
+		"accounts": []string{"nano_1234"},
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", respJson["error_code"])
+
+	status, respJson = doMove(map[string]interface{}{
+		"action":   "account_move",
+		"wallet":   source.ID.String(),
+		"source":   source.ID.String(),
+		"accounts": []string{acc.Address},
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "SAME_WALLET", respJson["error_code"])
+
+	status, respJson = doMove(map[string]interface{}{
+		"action":   "account_move",
+		"wallet":   destination.ID.String(),
+		"source":   source.ID.String(),
+		"accounts": []string{acc.Address},
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["moved"])
+	exists, _ = hc.Wallet.AccountExists(source, acc.Address)
+	assert.False(t, exists)
+	moved, err := hc.Wallet.GetAccount(destination, acc.Address)
+	assert.Nil(t, err)
+	assert.Nil(t, moved.AccountIndex)
+	assert.NotNil(t, moved.PrivateKey)
+
+	// And back, it's an adhoc account of source now
+	status, respJson = doMove(map[string]interface{}{
+		"action":   "account_move",
+		"wallet":   source.ID.String(),
+		"source":   destination.ID.String(),
+		"accounts": []string{acc.Address},
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["moved"])
+
+	// Not into an encrypted wallet
+	hc.Wallet.EncryptWallet(destination, "password")
+	status, respJson = doMove(map[string]interface{}{
+		"action":   "account_move",
+		"wallet":   destination.ID.String(),
+		"source":   source.ID.String(),
+		"accounts": []string{acc.Address},
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_ENCRYPTED", respJson["error_code"])
+	exists, _ = hc.Wallet.AccountExists(source, acc.Address)
+	assert.True(t, exists)
+}
+
+func TestAccountRepresentative(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("2f8a6c4e0b9d7153a2e4c6f8b0d2a4c6e8f0b2d4a6c8e0f2b4d6a8c0e2f4b6d8"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	opened, _ := hc.Wallet.AccountCreate(wallet, nil)
+	unopened, _ := hc.Wallet.AccountCreate(wallet, nil)
+
+	nodeCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			nodeCalls++
+			if pr["action"] == "account_representative" && pr["account"] == opened.Address {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountRepresentativeResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Account not found",
+			})
+		},
+	)
+
+	doRepresentative := func(account string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":  "account_representative",
+			"wallet":  wallet.ID.String(),
+			"account": account,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doRepresentative(opened.Address)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", respJson["representative"])
+	assert.Equal(t, 1, nodeCalls)
+
+	// Second call is served from the cache
+	status, respJson = doRepresentative(opened.Address)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", respJson["representative"])
+	assert.Equal(t, 1, nodeCalls)
+
+	// Account with no blocks
+	status, respJson = doRepresentative(unopened.Address)
+	assert.Equal(t, 200, status)
+	val, ok := respJson["representative"]
+	assert.True(t, ok)
+	assert.Nil(t, val)
+	assert.Equal(t, "no_blocks", respJson["reason"])
+	assert.Equal(t, 2, nodeCalls)
+
+	// Account that isn't in the wallet never reaches the node
+	status, respJson = doRepresentative("nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", respJson["error_code"])
+	status, respJson = doRepresentative("nano_1234")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", respJson["error_code"])
+	assert.Equal(t, 2, nodeCalls)
+}
+
+func TestBlockCountForAccount(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("6c8e0f2b4d6a8c0e2f4b6d82f8a6c4e0b9d7153a2e4c6f8b0d2a4c6e8f0b2d4a"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	confirmed, _ := hc.Wallet.AccountCreate(wallet, nil)
+	partial, _ := hc.Wallet.AccountCreate(wallet, nil)
+	unopened, _ := hc.Wallet.AccountCreate(wallet, nil)
+
+	nodeCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			nodeCalls++
+			if pr["action"] == "account_info" && pr["account"] == confirmed.Address {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":            "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F",
+					"block_count":         "12",
+					"confirmation_height": "12",
+					"balance":             "1000",
+				})
+			} else if pr["action"] == "account_info" && pr["account"] == partial.Address {
+				// include_confirmed names it confirmed_height
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":         "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F",
+					"block_count":      "33",
+					"confirmed_height": "28",
+					"balance":          "1000",
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Account not found",
+			})
+		},
+	)
+
+	doCount := func(account string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":  "block_count_for_account",
+			"wallet":  wallet.ID.String(),
+			"account": account,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Fully confirmed
+	status, respJson := doCount(confirmed.Address)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(12), respJson["block_count"])
+	assert.Equal(t, float64(12), respJson["confirmation_height"])
+	assert.Equal(t, float64(0), respJson["unconfirmed_count"])
+	assert.Equal(t, 1, nodeCalls)
+
+	// Partially confirmed
+	status, respJson = doCount(partial.Address)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(33), respJson["block_count"])
+	assert.Equal(t, float64(28), respJson["confirmation_height"])
+	assert.Equal(t, float64(5), respJson["unconfirmed_count"])
+	assert.Equal(t, 2, nodeCalls)
+
+	// Second call is served from the cache
+	status, respJson = doCount(partial.Address)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(5), respJson["unconfirmed_count"])
+	assert.Equal(t, 2, nodeCalls)
+
+	// Account with no blocks
+	status, respJson = doCount(unopened.Address)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(0), respJson["block_count"])
+	assert.Equal(t, float64(0), respJson["unconfirmed_count"])
+	assert.Equal(t, 3, nodeCalls)
+
+	// Account that isn't in the wallet never reaches the node
+	status, respJson = doCount("nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", respJson["error_code"])
+	status, respJson = doCount("nano_1234")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", respJson["error_code"])
+	assert.Equal(t, 3, nodeCalls)
+}
+
+func TestAccountFrontier(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("3b7e1d5a9c3f7b1e5d9a3c7f1b5e9d3a7c1f5b9e3d7a1c5f9b3e7d1a5c9f3b7e"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	opened, _ := hc.Wallet.AccountCreate(wallet, nil)
+	unopened, _ := hc.Wallet.AccountCreate(wallet, nil)
+
+	nodeCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			nodeCalls++
+			if pr["action"] == "account_info" && pr["account"] == opened.Address {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":            "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F",
+					"open_block":          "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3",
+					"representative":      "nano_1natrium1o3z5519ifou7xii8crpxpk8y65qmkih8e8bpsjri651oza8imdd",
+					"balance":             "1000",
+					"block_count":         "12",
+					"confirmation_height": "12",
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Account not found",
+			})
+		},
+	)
+
+	doFrontier := func(walletID string, account string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":  "account_frontier",
+			"wallet":  walletID,
+			"account": account,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doFrontier(wallet.ID.String(), opened.Address)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{
+		"frontier":       "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F",
+		"open_block":     "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3",
+		"representative": "nano_1natrium1o3z5519ifou7xii8crpxpk8y65qmkih8e8bpsjri651oza8imdd",
+		"balance_raw":    "1000",
+		"block_count":    float64(12),
+	}, respJson)
+	assert.Equal(t, 1, nodeCalls)
+
+	// Second call is served from the cache, with when it was cached
+	status, respJson = doFrontier(wallet.ID.String(), opened.Address)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", respJson["frontier"])
+	assert.Equal(t, float64(12), respJson["block_count"])
+	assert.InDelta(t, float64(time.Now().Unix()), respJson["cached_at"], 5)
+	assert.Equal(t, 1, nodeCalls)
+
+	// Account with no blocks isn't cached
+	for i := 0; i < 2; i++ {
+		status, respJson = doFrontier(wallet.ID.String(), unopened.Address)
+		assert.Equal(t, 200, status)
+		assert.Nil(t, respJson["frontier"])
+		assert.Nil(t, respJson["open_block"])
+		assert.Nil(t, respJson["representative"])
+		assert.Equal(t, "0", respJson["balance_raw"])
+		assert.Equal(t, float64(0), respJson["block_count"])
+		assert.NotContains(t, respJson, "cached_at")
+	}
+	assert.Equal(t, 3, nodeCalls)
+
+	// Accounts of another wallet, or no wallet, never reach the node
+	otherSeed, _ := utils.GenerateSeed(strings.NewReader("9f3b7e1d5a9c3f7b1e5d9a3c7f1b5e9d3a7c1f5b9e3d7a1c5f9b3e7d1a5c9f3b"))
+	otherWallet, _ := hc.Wallet.WalletCreate(otherSeed)
+	status, respJson = doFrontier(otherWallet.ID.String(), opened.Address)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", respJson["error_code"])
+	status, respJson = doFrontier(wallet.ID.String(), "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", respJson["error_code"])
+	status, respJson = doFrontier(wallet.ID.String(), "nano_1234")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", respJson["error_code"])
+	assert.Equal(t, 3, nodeCalls)
+}
+
+func TestAccountRepresentativeCheck(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	conf := *hc.Wallet.Config
+	conf.Wallet.MinRepWeightPercent = 1
+	hc.Wallet.Config = &conf
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("8d2f6b0e4a9c3f7b1e5a9d3c7f1b5e9a3d7c1f5b9e3a7d1c5f9b3e7a1d5c9f3b"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	inWallet, _ := hc.Wallet.AccountCreate(wallet, nil)
+	lowWeight := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	offline := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	unopened := "nano_1111111111111111111111111111111111111111111111111awsq94gtecn"
+
+	representatives := map[string]string{
+		inWallet.Address: "nano_1natrium1o3z5519ifou7xii8crpxpk8y65qmkih8e8bpsjri651oza8imdd",
+		lowWeight:        "nano_1thingspmippfngcrtk1ofd3uwftffnu4qu9xkauo9zkiuep6iknzci3jxa6",
+		offline:          "nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs",
+	}
+	weights := map[string]string{
+		"nano_1natrium1o3z5519ifou7xii8crpxpk8y65qmkih8e8bpsjri651oza8imdd": "5000000000000000000000000000000000000",
+		"nano_1thingspmippfngcrtk1ofd3uwftffnu4qu9xkauo9zkiuep6iknzci3jxa6": "500000000000000000000000000000000000",
+		"nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs": "50000000000000000000000000000000000000",
+	}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "account_representative":
+				if rep, ok := representatives[pr["account"].(string)]; ok {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"representative": rep})
+				}
+			case "account_info":
+				if weight, ok := weights[pr["account"].(string)]; ok {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"weight": weight})
+				}
+			case "representatives_online":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"representatives": []string{
+						"nano_1natrium1o3z5519ifou7xii8crpxpk8y65qmkih8e8bpsjri651oza8imdd",
+						"nano_1thingspmippfngcrtk1ofd3uwftffnu4qu9xkauo9zkiuep6iknzci3jxa6",
+					},
+				})
+			case "confirmation_quorum":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ConfirmationQuorumResponseStr), &js)
+				js["online_stake_total"] = "100000000000000000000000000000000000000"
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Account not found",
+			})
+		},
+	)
+
+	doCheck := func(request map[string]interface{}) (int, []byte) {
+		request["action"] = "account_representative_check"
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	status, body := doCheck(map[string]interface{}{"wallet": wallet.ID.String(), "account": inWallet.Address})
+	assert.Equal(t, 200, status)
+	var resp responses.AccountRepresentativeCheckResponse
+	json.Unmarshal(body, &resp)
+	assert.Equal(t, responses.AccountRepresentativeCheckResponse{
+		Representative: "nano_1natrium1o3z5519ifou7xii8crpxpk8y65qmkih8e8bpsjri651oza8imdd",
+		IsOnline:       true,
+		WeightRaw:      "5000000000000000000000000000000000000",
+		WeightPercent:  5,
+		Status:         "ok",
+	}, resp)
+
+	// No wallet needed
+	status, body = doCheck(map[string]interface{}{"account": lowWeight})
+	assert.Equal(t, 200, status)
+	resp = responses.AccountRepresentativeCheckResponse{}
+	json.Unmarshal(body, &resp)
+	assert.True(t, resp.IsOnline)
+	assert.Equal(t, 0.5, resp.WeightPercent)
+	assert.Equal(t, "low_weight", resp.Status)
+
+	// Offline comes first, whatever the weight
+	status, body = doCheck(map[string]interface{}{"account": offline})
+	assert.Equal(t, 200, status)
+	resp = responses.AccountRepresentativeCheckResponse{}
+	json.Unmarshal(body, &resp)
+	assert.False(t, resp.IsOnline)
+	assert.Equal(t, 50.0, resp.WeightPercent)
+	assert.Equal(t, "offline", resp.Status)
+
+	// errors
+	var errJson map[string]interface{}
+	status, body = doCheck(map[string]interface{}{"account": unopened})
+	assert.Equal(t, 400, status)
+	json.Unmarshal(body, &errJson)
+	assert.Equal(t, "ACCOUNT_UNOPENED", errJson["error_code"])
+	status, body = doCheck(map[string]interface{}{"wallet": wallet.ID.String(), "account": lowWeight})
+	assert.Equal(t, 400, status)
+	json.Unmarshal(body, &errJson)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", errJson["error_code"])
+	status, body = doCheck(map[string]interface{}{"account": "nano_1234"})
+	assert.Equal(t, 400, status)
+	json.Unmarshal(body, &errJson)
+	assert.Equal(t, "INVALID_ACCOUNT", errJson["error_code"])
+}
+
+func TestAccountInfo(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("9d4b2f7a0c5e8b1d3f6a9c2e4b7d0f5a8c1e3b6d9f2a4c7e0b5d8f1a3c6e9b24"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	acc, _ := hc.Wallet.AccountCreate(wallet, nil)
+	outside := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+
+	var forwarded map[string]interface{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			json.NewDecoder(req.Body).Decode(&forwarded)
+			return httpmock.NewStringResponse(200, mocks.AccountInfoResponseStr), nil
+		},
+	)
+
+	doInfo := func(account string) (int, []byte) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":         "account_info",
+			"account":        account,
+			"representative": "true",
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	// In a wallet
+	status, respBody := doInfo(acc.Address)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "true", forwarded["representative"])
+	var respJson map[string]interface{}
+	json.Unmarshal(respBody, &respJson)
+	var nodeJson map[string]interface{}
+	json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &nodeJson)
+	for k, v := range nodeJson {
+		assert.Equal(t, v, respJson[k])
+	}
+	assert.Equal(t, wallet.ID.String(), respJson["wallet_id"])
+	assert.Equal(t, float64(1), respJson["derivation_index"])
+	assert.Len(t, respJson, len(nodeJson)+2)
+
+	// Not in a wallet
+	status, respBody = doInfo(outside)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, mocks.AccountInfoResponseStr, string(respBody))
+
+	// Node fields aren't overwritten
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		httpmock.NewStringResponder(200, `{"frontier":"80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F","wallet_id":"node"}`))
+	status, respBody = doInfo(acc.Address)
+	assert.Equal(t, 200, status)
+	respJson = map[string]interface{}{}
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, "node", respJson["wallet_id"])
+	assert.Equal(t, float64(1), respJson["derivation_index"])
+
+	// Errors from the node are returned as they are
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		httpmock.NewStringResponder(200, `{"error":"Account not found"}`))
+	status, respBody = doInfo(acc.Address)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"error":"Account not found"}`, string(respBody))
+}
+
+func TestAccountInfoUnconfirmed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("6a9c2e4b7d0f5a8c1e3b6d9f2a4c7e0b5d8f1a3c6e9b249d4b2f7a0c5e8b1d3f"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	acc, _ := hc.Wallet.AccountCreate(wallet, nil)
+	outside := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+
+	nodeResponse := ""
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(200, nodeResponse), nil
+		},
+	)
+
+	doInfo := func(account string) map[string]interface{} {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":  "account_info",
+			"account": account,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		assert.Equal(t, 200, resp.StatusCode)
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return respJson
+	}
+
+	// Synced
+	nodeResponse = `{"block_count":"22966","confirmation_height":"22966"}`
+	respJson := doInfo(outside)
+	assert.Nil(t, respJson["has_unconfirmed"])
+	assert.Nil(t, respJson["unconfirmed_count"])
+
+	// Not synced, for any account
+	nodeResponse = `{"block_count":"22966","confirmation_height":"22960"}`
+	respJson = doInfo(outside)
+	assert.Equal(t, true, respJson["has_unconfirmed"])
+	assert.Equal(t, float64(6), respJson["unconfirmed_count"])
+	assert.Equal(t, "22966", respJson["block_count"])
+	assert.Nil(t, respJson["wallet_id"])
+
+	respJson = doInfo(acc.Address)
+	assert.Equal(t, true, respJson["has_unconfirmed"])
+	assert.Equal(t, float64(6), respJson["unconfirmed_count"])
+	assert.Equal(t, wallet.ID.String(), respJson["wallet_id"])
+
+	// With include_confirmed the node calls it confirmed_height
+	nodeResponse = `{"block_count":"10","confirmed_height":"1"}`
+	respJson = doInfo(outside)
+	assert.Equal(t, true, respJson["has_unconfirmed"])
+	assert.Equal(t, float64(9), respJson["unconfirmed_count"])
+
+	// Nothing to compare
+	nodeResponse = `{"block_count":"10"}`
+	respJson = doInfo(outside)
+	assert.Nil(t, respJson["has_unconfirmed"])
+}
+
+func TestAccountBalanceWithPrice(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var forwarded map[string]interface{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			json.NewDecoder(req.Body).Decode(&forwarded)
+			return httpmock.NewStringResponse(200, `{"balance":"1000000000000000000000000000000","pending":"2000000000000000000000000000000","receivable":"2000000000000000000000000000000"}`), nil
+		},
+	)
+	httpmock.RegisterResponder("GET", "https://price.test/simple/price",
+		httpmock.NewStringResponder(200, `{"nano":{"usd":1.5}}`))
+	hc := newTestController(t)
+	hc.PriceClient = price.NewPriceClient("https://price.test/simple/price", []string{"usd"}, false, time.Minute)
+	account := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+
+	doBalance := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doBalance(map[string]interface{}{"action": "account_balance", "account": account, "include_price": true})
+	assert.Equal(t, 200, status)
+	assert.NotContains(t, forwarded, "include_price")
+	assert.Equal(t, "1000000000000000000000000000000", respJson["balance"])
+	assert.Equal(t, "4.50", respJson["value_fiat"])
+	assert.Equal(t, "USD", respJson["currency"])
+
+	// Without include_price the node response is unchanged
+	status, respJson = doBalance(map[string]interface{}{"action": "account_balance", "account": account})
+	assert.Equal(t, 200, status)
+	assert.NotContains(t, respJson, "value_fiat")
+	assert.Len(t, respJson, 3)
+
+	status, respJson = doBalance(map[string]interface{}{"action": "account_balance", "account": account, "include_price": true, "currency": "eur"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "UNSUPPORTED_CURRENCY", respJson["error_code"])
+
+	// The amounts in another unit, the fiat value is the same
+	status, respJson = doBalance(map[string]interface{}{"action": "account_balance", "account": account, "include_price": true, "unit": "NANO"})
+	assert.Equal(t, 200, status)
+	assert.NotContains(t, forwarded, "unit")
+	assert.Equal(t, "1", respJson["balance"])
+	assert.Equal(t, "2", respJson["pending"])
+	assert.Equal(t, "2", respJson["receivable"])
+	assert.Equal(t, "nano", respJson["unit"])
+	assert.Equal(t, "4.50", respJson["value_fiat"])
+
+	// Banano units aren't valid in nano mode
+	forwarded = nil
+	status, respJson = doBalance(map[string]interface{}{"action": "account_balance", "account": account, "unit": "banoshi"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_UNIT", respJson["error_code"])
+	assert.Nil(t, forwarded)
+}
+
+func TestAccountsSync(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("9b2d5a8c1f4e7b0d3a6c9f2e5b8d1a7c4f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d"))
+	dbWallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	accounts, _ := MockController.Wallet.AccountsCreate(dbWallet, 2)
+	first, _ := MockController.Wallet.AccountCreate(dbWallet, nil)
+	pub, _, _ := utils.KeypairFromSeed(newSeed, 0)
+	opened := utils.PubKeyToAddress(pub, false)
+	pub, _, _ = utils.KeypairFromSeed(newSeed, 7)
+	missing := utils.PubKeyToAddress(pub, false)
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "accounts_frontiers" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontiers": map[string]string{
+						opened:  "791AF413173EEE674A6FCF633B5DFC0F3C33F397F0DA08E987D9E0741D40D81A",
+						missing: "6A32397F4E95AF025DE29D9BF1ACE864D5404362258E06489FABDBA9DCCC046F",
+					},
+					"errors": map[string]string{
+						accounts[0].Address: "Account not found",
+					},
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"action": "accounts_sync",
+		"wallet": dbWallet.ID.String(),
+	})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var respJson responses.AccountsSyncResponse
+	json.NewDecoder(resp.Body).Decode(&respJson)
+	assert.Equal(t, []string{opened}, respJson.Opened)
+	// Not in the node's frontiers is unopened, with or without an error for it
+	assert.Equal(t, []string{accounts[0].Address, accounts[1].Address, first.Address}, respJson.Unopened)
+	assert.Equal(t, []string{missing}, respJson.MissingFromDB)
+}
+
+func TestAccountSync(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("c7f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e734e7b0d3a6c9f2e5b8d1a4"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	acc, _ := hc.Wallet.AccountCreate(wallet, nil)
+	pending := "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE"
+	// The frontier has hard coded work in the pow client
+	frontier := "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3"
+
+	receivable := map[string]interface{}{pending: "1000000000000000000000000000000"}
+	var processed []nanoblock.StateBlock
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "account_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       frontier,
+					"balance":        "11999999999999999918751838129509869131",
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			case "receivable":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": receivable})
+			case "block_info":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "process":
+				var sb nanoblock.StateBlock
+				block, _ := json.Marshal(pr["block"])
+				json.Unmarshal(block, &sb)
+				processed = append(processed, sb)
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%X", sb.Hash()),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	doSync := func(account string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":  "account_sync",
+			"wallet":  wallet.ID.String(),
+			"account": account,
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// The receivable block is received on top of the node's frontier
+	status, respJson := doSync(acc.Address)
+	assert.Equal(t, 200, status)
+	assert.Len(t, processed, 1)
+	assert.Equal(t, frontier, processed[0].Previous)
+	assert.Equal(t, pending, processed[0].Link)
+	assert.Equal(t, float64(1), respJson["received_count"])
+	assert.Equal(t, fmt.Sprintf("%X", processed[0].Hash()), respJson["new_frontier"])
+
+	// Nothing to receive
+	receivable = map[string]interface{}{}
+	status, respJson = doSync(acc.Address)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(0), respJson["received_count"])
+	assert.Equal(t, frontier, respJson["new_frontier"])
+	assert.Len(t, processed, 1)
+
+	// errors
+	status, respJson = doSync("nano_1")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", respJson["error_code"])
+	status, respJson = doSync("nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", respJson["error_code"])
+	hc.Wallet.EncryptWallet(wallet, "password")
+	status, respJson = doSync(acc.Address)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_LOCKED", respJson["error_code"])
+}
+
+func TestAccountBalanceHistory(t *testing.T) {
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("6e9c2f5a8d1b4e7c0f3a6d9b2e5c8f1a4d7b0e3c6f9a2d5b8e1c4f7a0d3b6e9c"))
+	dbWallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	acc, _ := MockController.Wallet.AccountCreate(dbWallet, nil)
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	MockController.Wallet.DB.BalanceSnapshot.Create().SetAccountID(acc.ID).SetBalanceRaw("10").SetSnapshotAt(day.Add(time.Hour)).Save(MockController.Wallet.Ctx)
+	MockController.Wallet.DB.BalanceSnapshot.Create().SetAccountID(acc.ID).SetBalanceRaw("20").SetSnapshotAt(day.Add(5 * time.Hour)).Save(MockController.Wallet.Ctx)
+	MockController.Wallet.DB.BalanceSnapshot.Create().SetAccountID(acc.ID).SetBalanceRaw("30").SetSnapshotAt(day.Add(30 * time.Hour)).Save(MockController.Wallet.Ctx)
+
+	doHistory := func(request map[string]interface{}) (int, []byte) {
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	// Dates, end is exclusive
+	status, body := doHistory(map[string]interface{}{
+		"action":     "account_balance_history",
+		"wallet":     dbWallet.ID.String(),
+		"account":    acc.Address,
+		"period":     "daily",
+		"start_date": "2024-03-01",
+		"end_date":   "2024-03-03",
+	})
+	assert.Equal(t, 200, status)
+	var respJson responses.AccountBalanceHistoryResponse
+	json.Unmarshal(body, &respJson)
+	assert.Equal(t, []responses.BalanceHistoryEntry{
+		{PeriodStart: day.Unix(), BalanceRaw: "20", SnapshotAt: day.Add(5 * time.Hour).Unix()},
+		{PeriodStart: day.Add(24 * time.Hour).Unix(), BalanceRaw: "30", SnapshotAt: day.Add(30 * time.Hour).Unix()},
+	}, respJson.History)
+
+	// Unix timestamps
+	status, body = doHistory(map[string]interface{}{
+		"action":     "account_balance_history",
+		"wallet":     dbWallet.ID.String(),
+		"account":    acc.Address,
+		"period":     "hourly",
+		"start_date": day.Unix(),
+		"end_date":   day.Add(24 * time.Hour).Unix(),
+	})
+	assert.Equal(t, 200, status)
+	respJson = responses.AccountBalanceHistoryResponse{}
+	json.Unmarshal(body, &respJson)
+	assert.Len(t, respJson.History, 2)
+	assert.Equal(t, day.Add(time.Hour).Unix(), respJson.History[0].PeriodStart)
+	assert.Equal(t, "10", respJson.History[0].BalanceRaw)
+	assert.Equal(t, day.Add(5*time.Hour).Unix(), respJson.History[1].PeriodStart)
+
+	// Bad input
+	for _, request := range []map[string]interface{}{
+		{"period": "weekly", "start_date": "2024-03-01", "end_date": "2024-03-03"},
+		{"period": "daily", "start_date": "2024-03-03", "end_date": "2024-03-01"},
+		{"period": "daily", "start_date": "March 1st", "end_date": "2024-03-03"},
+	} {
+		request["action"] = "account_balance_history"
+		request["wallet"] = dbWallet.ID.String()
+		request["account"] = acc.Address
+		status, _ = doHistory(request)
+		assert.Equal(t, 400, status)
+	}
+}
+
+func TestAccountsFilter(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("c8f1a4d7b0e3c6f9a2d5b8e1c4f7a0d3b6e9c2f5a8d1b4e7c0f3a6d9b2e5c8f1"))
+	dbWallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	accounts, _ := MockController.Wallet.AccountsCreate(dbWallet, 1)
+	pub, _, _ := utils.KeypairFromSeed(newSeed, 0)
+	first := utils.PubKeyToAddress(pub, false)
+	representative := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "accounts_balances":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"balances": map[string]interface{}{
+						first:               map[string]string{"balance": "1000", "pending": "0", "receivable": "0"},
+						accounts[0].Address: map[string]string{"balance": "10", "pending": "0", "receivable": "0"},
+					},
+				})
+			case "accounts_representatives":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"representatives": map[string]string{
+						first:               representative,
+						accounts[0].Address: "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k",
+					},
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	doFilter := func(request map[string]interface{}) (int, []byte) {
+		request["action"] = "accounts_filter"
+		request["wallet"] = dbWallet.ID.String()
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	status, body := doFilter(map[string]interface{}{"min_balance_raw": "100"})
+	assert.Equal(t, 200, status)
+	var respJson responses.AccountsFilterResponse
+	json.Unmarshal(body, &respJson)
+	assert.Equal(t, []responses.FilteredAccount{{Account: first, BalanceRaw: "1000"}}, respJson.Accounts)
+
+	status, body = doFilter(map[string]interface{}{"max_balance_raw": "100", "representative": representative})
+	assert.Equal(t, 200, status)
+	respJson = responses.AccountsFilterResponse{}
+	json.Unmarshal(body, &respJson)
+	assert.Len(t, respJson.Accounts, 0)
+
+	status, body = doFilter(map[string]interface{}{"representative": representative})
+	assert.Equal(t, 200, status)
+	respJson = responses.AccountsFilterResponse{}
+	json.Unmarshal(body, &respJson)
+	assert.Equal(t, []responses.FilteredAccount{{Account: first, BalanceRaw: "1000", Representative: &representative}}, respJson.Accounts)
+
+	// Bad filters
+	status, _ = doFilter(map[string]interface{}{"label_contains": "savings"})
+	assert.Equal(t, 400, status)
+	status, _ = doFilter(map[string]interface{}{"min_balance_raw": "abc"})
+	assert.Equal(t, 400, status)
+	status, _ = doFilter(map[string]interface{}{"representative": "nano_1234"})
+	assert.Equal(t, 400, status)
+}
+
+func TestAccountsWeight(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("4a7d0b3e6c9f2a5d8b1e4c7f0a3d6b9e2c5f8a1d4b7e0c3f6a9d2b5e8c1f4a7d"))
+	dbWallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	accounts, _ := MockController.Wallet.AccountsCreate(dbWallet, 2)
+	pub, _, _ := utils.KeypairFromSeed(newSeed, 0)
+	first := utils.PubKeyToAddress(pub, false)
+	repA := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	repB := "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k"
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "accounts_balances":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"balances": map[string]interface{}{
+						first:               map[string]string{"balance": "1000", "pending": "5", "receivable": "5"},
+						accounts[0].Address: map[string]string{"balance": "10", "pending": "0", "receivable": "0"},
+						accounts[1].Address: map[string]string{"balance": "3", "pending": "0", "receivable": "0"},
+					},
+				})
+			case "accounts_representatives":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"representatives": map[string]string{
+						first:               repA,
+						accounts[0].Address: repB,
+						accounts[1].Address: repA,
+					},
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"action": "accounts_weight",
+		"wallet": dbWallet.ID.String(),
+	})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var respJson responses.AccountsWeightResponse
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, responses.AccountsWeightResponse{
+		repA: {Accounts: []string{first, accounts[1].Address}, TotalWeightRaw: "1003"},
+		repB: {Accounts: []string{accounts[0].Address}, TotalWeightRaw: "10"},
+	}, respJson)
+}
+
+func TestAccountsInfo(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("7d0b3e6c9f2a5d8b1e4c7f0a3d6b9e2c5f8a1d4b7e0c3f6a9d2b5e8c1f4a7d4a"))
+	dbWallet, _ := hc.Wallet.WalletCreate(newSeed)
+	accounts, _ := hc.Wallet.AccountsCreate(dbWallet, 1)
+	pub, _, _ := utils.KeypairFromSeed(newSeed, 0)
+	first := utils.PubKeyToAddress(pub, false)
+	unopened := accounts[0].Address
+	rep := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	frontier := "791AF413173EEE674A6FCF633B5DFC0F3C33F397F0DA08E987D9E0741D40D81A"
+
+	var mutex sync.Mutex
+	calls := map[string]int{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			mutex.Lock()
+			calls[fmt.Sprint(pr["action"])]++
+			mutex.Unlock()
+			switch pr["action"] {
+			case "accounts_frontiers":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontiers": map[string]string{first: frontier},
+					"errors":    map[string]string{unopened: "Account not found"},
+				})
+			case "accounts_balances":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"balances": map[string]interface{}{
+						first:    map[string]string{"balance": "1000", "pending": "5", "receivable": "5"},
+						unopened: map[string]string{"balance": "0", "pending": "0", "receivable": "0"},
+					},
+				})
+			case "accounts_representatives":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"representatives": map[string]string{first: rep},
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Every account of the wallet, merged by address
+	status, resp := doRequest(map[string]interface{}{"action": "accounts_info", "wallet": dbWallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{
+		first: map[string]interface{}{
+			"opened":           true,
+			"balance_raw":      "1000",
+			"pending_raw":      "5",
+			"frontier":         frontier,
+			"representative":   rep,
+			"derivation_index": float64(0),
+		},
+		unopened: map[string]interface{}{
+			"opened":           false,
+			"balance_raw":      "0",
+			"pending_raw":      "0",
+			"frontier":         nil,
+			"representative":   nil,
+			"derivation_index": float64(1),
+		},
+	}, resp["accounts"])
+	assert.Equal(t, map[string]int{"accounts_frontiers": 1, "accounts_balances": 1, "accounts_representatives": 1}, calls)
+
+	// Given accounts
+	status, resp = doRequest(map[string]interface{}{"action": "accounts_info", "accounts": []string{first}})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{
+		first: map[string]interface{}{
+			"opened":         true,
+			"balance_raw":    "1000",
+			"pending_raw":    "5",
+			"frontier":       frontier,
+			"representative": rep,
+		},
+	}, resp["accounts"])
+
+	// Neither, or a bad account
+	status, resp = doRequest(map[string]interface{}{"action": "accounts_info"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JSON", resp["error_code"])
+	status, resp = doRequest(map[string]interface{}{"action": "accounts_info", "accounts": []string{first, "nano_1234"}})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", resp["error_code"])
+	assert.Equal(t, 2, calls["accounts_frontiers"])
+}
+
+func TestValidateAccountNumber(t *testing.T) {
+	hc := newTestController(t)
+
+	doValidate := func(account string) responses.ValidateAccountNumberResponse {
+		body, _ := json.Marshal(map[string]interface{}{"action": "validate_account_number", "account": account})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		assert.Equal(t, 200, resp.StatusCode)
+		respBody, _ := io.ReadAll(resp.Body)
+		var decoded responses.ValidateAccountNumberResponse
+		json.Unmarshal(respBody, &decoded)
+		return decoded
+	}
+
+	assert.Equal(t, responses.ValidateAccountNumberResponse{Valid: true, Reason: "ok"}, doValidate("nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j"))
+	assert.Equal(t, responses.ValidateAccountNumberResponse{Valid: true, Reason: "ok"}, doValidate("xrb_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j"))
+	assert.Equal(t, responses.ValidateAccountNumberResponse{Valid: false, Reason: "invalid_prefix"}, doValidate("ban_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j"))
+	assert.Equal(t, responses.ValidateAccountNumberResponse{Valid: false, Reason: "invalid_length"}, doValidate("nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51"))
+	assert.Equal(t, responses.ValidateAccountNumberResponse{Valid: false, Reason: "invalid_base32"}, doValidate("nano_3pl37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j"))
+	assert.Equal(t, responses.ValidateAccountNumberResponse{Valid: false, Reason: "invalid_checksum"}, doValidate("nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba511"))
+
+	// Banano only takes ban_
+	conf := *hc.Wallet.Config
+	conf.Wallet.Banano = true
+	hc.Wallet.Config = &conf
+	assert.Equal(t, responses.ValidateAccountNumberResponse{Valid: true, Reason: "ok"}, doValidate("ban_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j"))
+	assert.Equal(t, responses.ValidateAccountNumberResponse{Valid: false, Reason: "invalid_prefix"}, doValidate("nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j"))
+	assert.Equal(t, responses.ValidateAccountNumberResponse{Valid: false, Reason: "invalid_prefix"}, doValidate("xrb_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j"))
+}
+
+func TestAccountHistoryAll(t *testing.T) {
+	account := "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est"
+	// A chain of 2500 blocks, the hash of each is its height
+	chainLength := 2500
+	failingHead := ""
+	var counts []int
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&js)
+			if js["action"] != "account_history" || js["account"] != account {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+			}
+			count, _ := utils.ToInt(js["count"])
+			counts = append(counts, count)
+			height := chainLength
+			if head, ok := js["head"].(string); ok {
+				if head == failingHead {
+					return httpmock.NewStringResponse(500, "error"), nil
+				}
+				parsed, _ := strconv.ParseInt(head, 16, 64)
+				height = int(parsed)
+			}
+			history := []map[string]interface{}{}
+			for ; height > 0 && len(history) < count; height-- {
+				history = append(history, map[string]interface{}{
+					"type":            "receive",
+					"account":         account,
+					"amount":          "1",
+					"local_timestamp": "1551532723",
+					"height":          strconv.Itoa(height),
+					"hash":            fmt.Sprintf("%064X", height),
+					"confirmed":       "true",
+				})
+			}
+			resp := map[string]interface{}{"account": account, "history": history}
+			if height > 0 {
+				resp["previous"] = fmt.Sprintf("%064X", height)
+			}
+			return httpmock.NewJsonResponse(200, resp)
+		},
+	)
+
+	doHistory := func(hc *HttpController, request map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		assert.Nil(t, json.NewDecoder(resp.Body).Decode(&respJson))
+		return resp.StatusCode, respJson
+	}
+	assertHeights := func(history interface{}, from int, to int) {
+		entries := history.([]interface{})
+		assert.Len(t, entries, from-to+1)
+		for i, entry := range entries {
+			assert.Equal(t, strconv.Itoa(from-i), entry.(map[string]interface{})["height"])
+		}
+	}
+
+	// Pages are stitched in order down to the open block
+	status, respJson := doHistory(MockController, map[string]interface{}{"action": "account_history_all", "account": account})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, account, respJson["account"])
+	assert.Equal(t, true, respJson["complete"])
+	assert.NotContains(t, respJson, "error")
+	assertHeights(respJson["history"], 2500, 1)
+	assert.Equal(t, []int{1000, 1000, 1000}, counts)
+
+	// Stops at max_blocks, the last page is only as big as what's left
+	counts = nil
+	status, respJson = doHistory(MockController, map[string]interface{}{"action": "account_history_all", "account": account, "max_blocks": "1500"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, false, respJson["complete"])
+	assertHeights(respJson["history"], 2500, 1001)
+	assert.Equal(t, []int{1000, 500}, counts)
+
+	// Reaching the open block at max_blocks is complete
+	chainLength = 800
+	status, respJson = doHistory(MockController, map[string]interface{}{"action": "account_history_all", "account": account, "max_blocks": 800})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, true, respJson["complete"])
+	assertHeights(respJson["history"], 800, 1)
+
+	// max_blocks can't go over account_history_max_blocks
+	chainLength = 2500
+	counts = nil
+	hc := newTestController(t)
+	conf := *hc.Wallet.Config
+	conf.Server.AccountHistoryMaxBlocks = 1200
+	hc.Wallet.Config = &conf
+	status, respJson = doHistory(hc, map[string]interface{}{"action": "account_history_all", "account": account, "max_blocks": 5000})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, false, respJson["complete"])
+	assertHeights(respJson["history"], 2500, 1301)
+	assert.Equal(t, []int{1000, 200}, counts)
+
+	// A failing page after the first still ends the JSON, with what was written before it
+	failingHead = fmt.Sprintf("%064X", 1500)
+	status, respJson = doHistory(MockController, map[string]interface{}{"action": "account_history_all", "account": account})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, false, respJson["complete"])
+	assert.Equal(t, "Error making account_history request to node", respJson["error"])
+	assertHeights(respJson["history"], 2500, 1501)
+
+	// A failing first page is a normal error
+	failingHead = ""
+	status, respJson = doHistory(MockController, map[string]interface{}{"action": "account_history_all", "account": "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"})
+	assert.Equal(t, 500, status)
+	assert.Equal(t, "Error making account_history request to node", respJson["error"])
+
+	// Invalid account and max_blocks
+	status, respJson = doHistory(MockController, map[string]interface{}{"action": "account_history_all", "account": "nano_1"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", respJson["error_code"])
+	status, respJson = doHistory(MockController, map[string]interface{}{"action": "account_history_all", "account": account, "max_blocks": 0})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"])
+}
+
+func TestAccountHistorySince(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("a5c0f3b8e3b8e1d6a9c4f7b2e5d0a3c8f1b6e9d4a7c2f5b0e3d8a1c6f9b4e7d2"))
+	dbWallet, _ := hc.Wallet.WalletCreate(newSeed)
+	pub, _, _ := utils.KeypairFromSeed(newSeed, 0)
+	account := utils.PubKeyToAddress(pub, false)
+
+	// A chain of 300 blocks, the hash of each is its height
+	var counts []int
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&js)
+			if js["action"] != "account_history" || js["account"] != account {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+			}
+			count, _ := utils.ToInt(js["count"])
+			counts = append(counts, count)
+			height := 300
+			if head, ok := js["head"].(string); ok {
+				parsed, _ := strconv.ParseInt(head, 16, 64)
+				height = int(parsed)
+			}
+			history := []map[string]interface{}{}
+			for ; height > 0 && len(history) < count; height-- {
+				history = append(history, map[string]interface{}{
+					"type":            "send",
+					"account":         "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+					"amount":          "1",
+					"local_timestamp": "1551532723",
+					"height":          strconv.Itoa(height),
+					"hash":            fmt.Sprintf("%064X", height),
+					"confirmed":       "true",
+				})
+			}
+			resp := map[string]interface{}{"account": account, "history": history}
+			if height > 0 {
+				resp["previous"] = fmt.Sprintf("%064X", height)
+			}
+			return httpmock.NewJsonResponse(200, resp)
+		},
+	)
+
+	doSince := func(hc *HttpController, request map[string]interface{}) (int, map[string]interface{}) {
+		request["action"] = "account_history_since"
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		assert.Nil(t, json.NewDecoder(resp.Body).Decode(&respJson))
+		return resp.StatusCode, respJson
+	}
+
+	// Only the blocks after since_hash, newest first
+	status, respJson := doSince(hc, map[string]interface{}{"wallet": dbWallet.ID.String(), "account": account, "since_hash": fmt.Sprintf("%064X", 296)})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, account, respJson["account"])
+	history := respJson["history"].([]interface{})
+	assert.Len(t, history, 4)
+	for i, entry := range history {
+		assert.Equal(t, strconv.Itoa(300-i), entry.(map[string]interface{})["height"])
+	}
+	assert.Equal(t, []int{100}, counts)
+
+	// Nothing after the frontier
+	status, respJson = doSince(hc, map[string]interface{}{"wallet": dbWallet.ID.String(), "account": account, "since_hash": fmt.Sprintf("%064X", 300)})
+	assert.Equal(t, 200, status)
+	assert.Empty(t, respJson["history"])
+
+	// Over more than one page
+	counts = nil
+	status, respJson = doSince(hc, map[string]interface{}{"wallet": dbWallet.ID.String(), "account": account, "since_hash": fmt.Sprintf("%064X", 50)})
+	assert.Equal(t, 200, status)
+	assert.Len(t, respJson["history"], 250)
+	assert.Equal(t, []int{100, 100, 100}, counts)
+
+	// A hash that isn't in the chain
+	status, respJson = doSince(hc, map[string]interface{}{"wallet": dbWallet.ID.String(), "account": account, "since_hash": "80392607E85E73CC3E94B4126F24488EBDFEB174944B890C97E8F36D89591DC5"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "hash_not_found_in_chain", respJson["error"])
+	assert.Equal(t, "HASH_NOT_FOUND_IN_CHAIN", respJson["error_code"])
+
+	// Or deeper than max_depth, which can't go over account_history_since_max_depth
+	counts = nil
+	status, respJson = doSince(hc, map[string]interface{}{"wallet": dbWallet.ID.String(), "account": account, "since_hash": fmt.Sprintf("%064X", 50), "max_depth": 150})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "HASH_NOT_FOUND_IN_CHAIN", respJson["error_code"])
+	assert.Equal(t, []int{100, 50}, counts)
+	conf := *hc.Wallet.Config
+	conf.Server.AccountHistorySinceMaxDepth = 120
+	hc.Wallet.Config = &conf
+	counts = nil
+	status, _ = doSince(hc, map[string]interface{}{"wallet": dbWallet.ID.String(), "account": account, "since_hash": fmt.Sprintf("%064X", 50), "max_depth": 5000})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, []int{100, 20}, counts)
+
+	// The account has to be in the wallet
+	status, respJson = doSince(hc, map[string]interface{}{"wallet": dbWallet.ID.String(), "account": "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", "since_hash": fmt.Sprintf("%064X", 50)})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", respJson["error_code"])
+
+	// Invalid requests
+	status, respJson = doSince(hc, map[string]interface{}{"wallet": dbWallet.ID.String(), "account": account})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"])
+	status, respJson = doSince(hc, map[string]interface{}{"wallet": dbWallet.ID.String(), "account": account, "since_hash": "abc"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_HASH", respJson["error_code"])
+	status, respJson = doSince(hc, map[string]interface{}{"wallet": dbWallet.ID.String(), "account": account, "since_hash": fmt.Sprintf("%064X", 50), "max_depth": 0})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"])
+}
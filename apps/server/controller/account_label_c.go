@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
+)
+
+func accountLabelResponse(acc *ent.Account) responses.AccountLabelResponse {
+	resp := responses.AccountLabelResponse{
+		Account:  acc.Address,
+		Metadata: acc.Metadata,
+	}
+	if acc.Label != nil {
+		resp.Label = *acc.Label
+	}
+	if resp.Metadata == nil {
+		resp.Metadata = map[string]string{}
+	}
+	return resp
+}
+
+// Errors of looking up an account to label, false if there were none
+func errAccountLabel(err error, w http.ResponseWriter, r *http.Request) bool {
+	if err == nil {
+		return false
+	} else if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+	} else if errors.Is(err, wallet.ErrInvalidLabel) {
+		ErrBadRequest(w, r, ErrorCodeInvalidLabel, "Invalid label, must be at most 256 characters")
+	} else if errors.Is(err, wallet.ErrInvalidMetadata) {
+		ErrBadRequest(w, r, ErrorCodeInvalidMetadata, "metadata can have up to 32 keys of up to 64 characters, with values of up to 256 characters")
+	} else {
+		ErrInternalServerError(w, r, err.Error())
+	}
+	return true
+}
+
+// Handle account_label_set, attach a label and metadata to an account, e.g. the user it belongs to
+func (hc *HttpController) HandleAccountLabelSet(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var labelRequest requests.AccountLabelSetRequest
+	if err := mapstructure.Decode(rawRequest, &labelRequest); err != nil {
+		log.Errorf("Error unmarshalling account_label_set request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if labelRequest.Wallet == "" || labelRequest.Action == "" || labelRequest.Account == "" || (labelRequest.Label == nil && labelRequest.Metadata == nil) {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(labelRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	acc, err := hc.Wallet.AccountLabelSet(dbWallet, labelRequest.Account, labelRequest.Label, labelRequest.Metadata)
+	if errAccountLabel(err, w, r) {
+		return
+	}
+
+	resp := accountLabelResponse(acc)
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle account_label_get, the label and metadata of an account
+func (hc *HttpController) HandleAccountLabelGet(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var labelRequest requests.AccountLabelGetRequest
+	if err := mapstructure.Decode(rawRequest, &labelRequest); err != nil {
+		log.Errorf("Error unmarshalling account_label_get request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if labelRequest.Wallet == "" || labelRequest.Action == "" || labelRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(labelRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	acc, err := hc.Wallet.GetAccount(dbWallet, labelRequest.Account)
+	if errAccountLabel(err, w, r) {
+		return
+	}
+
+	resp := accountLabelResponse(acc)
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountLabel(t *testing.T) {
+	hc := newTestController(t)
+	seed, _ := utils.GenerateSeed(strings.NewReader("5d0a7e2c9f4b1836a3d8e5c0f7b2a94e1c6f3a8d5b0e7c2f9a4d1b6e3c8f5a0d"))
+	wallet, err := hc.Wallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	accounts, err := hc.Wallet.AccountsCreate(wallet, 2)
+	assert.Nil(t, err)
+	labeled := accounts[1].Address
+
+	doRequest := func(request map[string]interface{}) (int, map[string]interface{}) {
+		request["wallet"] = wallet.ID.String()
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, resp := doRequest(map[string]interface{}{"action": "account_label_get", "account": labeled})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"account": labeled, "label": "", "metadata": map[string]interface{}{}}, resp)
+
+	status, resp = doRequest(map[string]interface{}{"action": "account_label_set", "account": labeled, "label": "user 42", "metadata": map[string]string{"user_id": "42"}})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"account": labeled, "label": "user 42", "metadata": map[string]interface{}{"user_id": "42"}}, resp)
+	status, resp = doRequest(map[string]interface{}{"action": "account_label_get", "account": labeled})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "user 42", resp["label"])
+
+	// account_list has the labels of the accounts that have one
+	status, resp = doRequest(map[string]interface{}{"action": "account_list"})
+	assert.Equal(t, 200, status)
+	assert.Len(t, resp["accounts"], 3)
+	assert.Equal(t, map[string]interface{}{labeled: "user 42"}, resp["labels"])
+
+	// Only the metadata is replaced
+	status, resp = doRequest(map[string]interface{}{"action": "account_label_set", "account": labeled, "metadata": map[string]string{}})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"account": labeled, "label": "user 42", "metadata": map[string]interface{}{}}, resp)
+
+	// errors
+	status, resp = doRequest(map[string]interface{}{"action": "account_label_set", "account": labeled})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JSON", resp["error_code"])
+	status, resp = doRequest(map[string]interface{}{"action": "account_label_set", "account": labeled, "metadata": map[string]interface{}{"user_id": 42}})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JSON", resp["error_code"])
+	status, resp = doRequest(map[string]interface{}{"action": "account_label_set", "account": labeled, "label": strings.Repeat("a", 257)})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_LABEL", resp["error_code"])
+	status, resp = doRequest(map[string]interface{}{"action": "account_label_set", "account": labeled, "metadata": map[string]string{"": "42"}})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_METADATA", resp["error_code"])
+	status, resp = doRequest(map[string]interface{}{"action": "account_label_get", "account": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", resp["error_code"])
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/apikey"
+)
+
+// Actions that can lose funds if misused, expose the node's network or every wallet's ID, they're only served by AdminHandler
+var adminActions = map[string]actionHandler{
+	"wallet_destroy":             (*HttpController).HandleWalletDestroy,
+	"wallet_change_seed":         (*HttpController).HandleWalletChangeSeedRequest,
+	"wallet_seed":                (*HttpController).HandleWalletSeed,
+	"wallet_backup_create":       (*HttpController).HandleWalletBackupCreate,
+	"wallet_freeze":              (*HttpController).HandleWalletFreeze,
+	"wallet_unfreeze":            (*HttpController).HandleWalletUnfreeze,
+	"wallet_kdf_info":            (*HttpController).HandleWalletKdfInfo,
+	"wallet_lock_all":            (*HttpController).HandleWalletLockAll,
+	"wallet_approval_policy_set": (*HttpController).HandleWalletApprovalPolicySetRequest,
+	"wallet_list":                (*HttpController).HandleWalletList,
+	"peers":                      (*HttpController).HandlePeers,
+	"peer_count":                 (*HttpController).HandlePeerCount,
+	"bootstrap":                  (*HttpController).HandleBootstrap,
+	"bootstrap_any":              (*HttpController).HandleBootstrapAny,
+	"bootstrap_lazy":             (*HttpController).HandleBootstrapLazy,
+	"bootstrap_status":           (*HttpController).HandleBootstrapStatus,
+	"work_peers":                 (*HttpController).HandleWorkPeers,
+	"work_peer_add":              (*HttpController).HandleWorkPeerChange,
+	"work_peer_remove":           (*HttpController).HandleWorkPeerChange,
+	"work_queue_status":          (*HttpController).HandleWorkQueueStatus,
+	"work_cancel_all":            (*HttpController).HandleWorkCancelAll,
+	"work_cache_clear":           (*HttpController).HandleWorkCacheClear,
+	"work_prefetch_accounts":     (*HttpController).HandleWorkPrefetchAccounts,
+	"rate_limit_status":          (*HttpController).HandleRateLimitStatus,
+	"config_reload":              (*HttpController).HandleConfigReload,
+}
+
+// The admin gateway, served at /admin, for the actions in adminActions
+// Requests need the admin token as a bearer token in the Authorization header, or an admin API key in X-Api-Key
+// If no admin token is configured only admin API keys are accepted
+func (hc *HttpController) AdminHandler(w http.ResponseWriter, r *http.Request) {
+	// Verified once, for the check and the audit record
+	found := hc.headerApiKey(r)
+	if !hc.adminKeyOrToken(found, r) {
+		ErrUnauthorized(w, r)
+		return
+	}
+
+	baseRequest, ok := hc.decodeRequest(w, r)
+	if !ok {
+		return
+	}
+
+	action := strings.ToLower(fmt.Sprintf("%v", baseRequest["action"]))
+	traceAction(r, action, baseRequest)
+
+	handle, ok := adminActions[action]
+	if !ok {
+		ErrBadRequest(w, r, ErrorCodeNotAdminAction, "Not an admin action")
+		return
+	}
+	if hc.controlDisabled(action, baseRequest) {
+		ErrControlDisabled(w, r)
+		return
+	}
+	if hc.refuseUnknownFields(action, baseRequest, w, r) {
+		return
+	}
+	// Audit records have the admin key, if it wasn't the admin token
+	if found != nil {
+		r = r.WithContext(context.WithValue(r.Context(), apiKeyContextKey{}, found))
+	}
+	hc.auditAction(action, baseRequest, w, r, func(w http.ResponseWriter) {
+		handle(hc, &baseRequest, w, r)
+	})
+}
+
+// Middleware for routes that need the admin token once one is configured, like pprof
+// Unlike AdminHandler they're open without an admin token
+func (hc *HttpController) AdminAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hc.AdminToken != "" && !hc.isAdmin(r) {
+			ErrUnauthorized(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Check the bearer token against the configured admin token, or whether X-Api-Key is an admin key
+func (hc *HttpController) isAdmin(r *http.Request) bool {
+	return hc.adminKeyOrToken(hc.headerApiKey(r), r)
+}
+
+// Whether found, the request's X-Api-Key, is an admin key, or the bearer token is the admin token
+func (hc *HttpController) adminKeyOrToken(found *ent.ApiKey, r *http.Request) bool {
+	if found != nil && found.Scope == apikey.ScopeAdmin {
+		return true
+	}
+	if hc.AdminToken == "" {
+		return false
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(hc.AdminToken)) == 1
+}
@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminActionsRejectedByGateway(t *testing.T) {
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("b3e8d1a6c9f2e5b8a1d4c7f0e3b6a9d2c5f8e1b4a7d0c3f6e9b2a5d8c1f4e7a0"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+
+	for action := range adminActions {
+		for _, token := range []string{"", "usertoken", mockAdminToken} {
+			body, _ := json.Marshal(map[string]interface{}{
+				"action": action,
+				"wallet": wallet.ID.String(),
+				"seed":   "c37bd1bce9bd8b69c401577773c610e4e84461f9d67b6bc2e9a2b3786b84a8fe",
+			})
+			w := httptest.NewRecorder()
+			// Build request
+			req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			hc.Gateway(w, req)
+			resp := w.Result()
+			defer resp.Body.Close()
+			assert.Equal(t, 403, resp.StatusCode)
+
+			var respJson map[string]interface{}
+			respBody, _ := io.ReadAll(resp.Body)
+			json.Unmarshal(respBody, &respJson)
+			assert.Equal(t, "ADMIN_ONLY", respJson["error_code"])
+		}
+	}
+
+	// Nothing happened to the wallet
+	_, err := hc.Wallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+}
+
+func TestAdminHandler(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// Nothing in the wallet, so it can be destroyed
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		httpmock.NewStringResponder(200, `{"balances":{}}`))
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("e6b9c2f5a8d1e4b7c0f3a6d9e2b5c8f1a4d7e0b3c6f9a2d5e8b1c4f7a0d3e6b9"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+
+	doAdmin := func(hc *HttpController, authorization string, reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		hc.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+	destroy := map[string]interface{}{
+		"action": "wallet_destroy",
+		"wallet": wallet.ID.String(),
+	}
+
+	// Missing or wrong credentials
+	for _, authorization := range []string{"", "Bearer usertoken", mockAdminToken, "Basic " + mockAdminToken, "Bearer " + mockAdminToken + "x"} {
+		status, respJson := doAdmin(hc, authorization, destroy)
+		assert.Equal(t, http.StatusUnauthorized, status)
+		assert.Equal(t, "UNAUTHORIZED", respJson["error_code"])
+	}
+	_, err := hc.Wallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+
+	// Only admin actions are served
+	status, respJson := doAdmin(hc, "Bearer "+mockAdminToken, map[string]interface{}{
+		"action": "wallet_create",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "NOT_ADMIN_ACTION", respJson["error_code"])
+
+	// Admin token
+	status, respJson = doAdmin(hc, "Bearer "+mockAdminToken, destroy)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["destroyed"])
+	_, err = hc.Wallet.GetWallet(wallet.ID.String())
+	assert.NotNil(t, err)
+
+	// With no admin token configured nothing gets in, not even an empty bearer token
+	hc.AdminToken = ""
+	status, _ = doAdmin(hc, "Bearer ", destroy)
+	assert.Equal(t, http.StatusUnauthorized, status)
+}
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
+)
+
+// Handle registering a balance alert on an account
+func (hc *HttpController) HandleAlertRegisterRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var alertRequest requests.AlertRegisterRequest
+	if err := mapstructure.Decode(rawRequest, &alertRequest); err != nil {
+		log.Errorf("Error unmarshalling alert_register request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if alertRequest.Wallet == "" || alertRequest.Action == "" || alertRequest.Account == "" || alertRequest.ThresholdRaw == "" || alertRequest.Direction == "" || alertRequest.CallbackUrl == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(alertRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Validate account
+	_, err := utils.AddressToPub(alertRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid account %s", alertRequest.Account))
+		return
+	}
+
+	alert, err := hc.Wallet.AlertRegister(dbWallet, alertRequest.Account, alertRequest.ThresholdRaw, alertRequest.Direction, alertRequest.CallbackUrl)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	} else if errors.Is(err, wallet.ErrInvalidThreshold) {
+		ErrBadRequest(w, r, ErrorCodeInvalidThreshold, "Invalid threshold")
+		return
+	} else if errors.Is(err, wallet.ErrInvalidDirection) {
+		ErrBadRequest(w, r, ErrorCodeInvalidDirection, "Invalid direction, must be above or below")
+		return
+	} else if errors.Is(err, wallet.ErrInvalidCallbackUrl) {
+		ErrBadRequest(w, r, ErrorCodeInvalidCallbackUrl, "Invalid callback_url")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.AlertRegisterResponse{
+		AlertID: alert.ID.String(),
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle listing the balance alerts of a wallet
+func (hc *HttpController) HandleAlertListRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var listRequest requests.BaseRequest
+	if err := mapstructure.Decode(rawRequest, &listRequest); err != nil {
+		log.Errorf("Error unmarshalling alert_list request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if listRequest.Wallet == "" || listRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(listRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	alerts, err := hc.Wallet.AlertList(dbWallet)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.AlertListResponse{
+		Alerts: []responses.Alert{},
+	}
+	for _, alert := range alerts {
+		resp.Alerts = append(resp.Alerts, responses.Alert{
+			AlertID:      alert.ID.String(),
+			Account:      alert.Account,
+			ThresholdRaw: alert.Threshold,
+			Direction:    alert.Direction.String(),
+			CallbackUrl:  alert.CallbackURL,
+			Fired:        alert.Fired,
+		})
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle deleting a balance alert
+func (hc *HttpController) HandleAlertDeleteRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var deleteRequest requests.AlertDeleteRequest
+	if err := mapstructure.Decode(rawRequest, &deleteRequest); err != nil {
+		log.Errorf("Error unmarshalling alert_delete request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if deleteRequest.Wallet == "" || deleteRequest.Action == "" || deleteRequest.AlertID == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(deleteRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	err := hc.Wallet.AlertDelete(dbWallet, deleteRequest.AlertID)
+	if errors.Is(err, wallet.ErrAlertNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAlertNotFound, "Alert not found")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.AlertDeleteResponse{
+		Deleted: "1",
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
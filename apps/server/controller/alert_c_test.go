@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	rpcreq "github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalanceAlerts(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	// The callback server is real
+	httpmock.RegisterNoResponder(httpmock.InitialTransport.RoundTrip)
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var ar rpcreq.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&ar)
+			resp := map[string]interface{}{}
+			for _, account := range ar.Accounts {
+				resp[account] = map[string]interface{}{"balance": "10", "pending": "0", "receivable": "0"}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"balances": resp,
+			})
+		},
+	)
+	var callbacks []wallet.AlertCallback
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var callback wallet.AlertCallback
+		json.NewDecoder(r.Body).Decode(&callback)
+		callbacks = append(callbacks, callback)
+	}))
+	defer server.Close()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("d6f9b2e5a8c1d4f7b0e3a6c9d2f5b8e1a4c7d0f3b6e9a2c5d8f1b4e7a0c3d629"))
+	dbWallet, err := hc.Wallet.WalletCreate(newSeed)
+	assert.Nil(t, err)
+	acc, err := hc.Wallet.AccountCreate(dbWallet, nil)
+	assert.Nil(t, err)
+
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doRequest(map[string]interface{}{
+		"action":        "alert_register",
+		"wallet":        dbWallet.ID.String(),
+		"account":       acc.Address,
+		"threshold_raw": "100",
+		"direction":     "below",
+		"callback_url":  server.URL,
+	})
+	assert.Equal(t, 200, status)
+	alertID := respJson["alert_id"].(string)
+
+	// Bad direction
+	status, respJson = doRequest(map[string]interface{}{
+		"action":        "alert_register",
+		"wallet":        dbWallet.ID.String(),
+		"account":       acc.Address,
+		"threshold_raw": "100",
+		"direction":     "sideways",
+		"callback_url":  server.URL,
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_DIRECTION", respJson["error_code"])
+
+	// The balance is below the threshold, fires once
+	delivered, err := hc.Wallet.CheckBalanceAlerts(time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, delivered)
+	delivered, err = hc.Wallet.CheckBalanceAlerts(time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Len(t, callbacks, 1)
+	assert.Equal(t, alertID, callbacks[0].AlertID)
+	assert.Equal(t, "10", callbacks[0].BalanceRaw)
+
+	status, respJson = doRequest(map[string]interface{}{
+		"action": "alert_list",
+		"wallet": dbWallet.ID.String(),
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"alert_id":      alertID,
+			"account":       acc.Address,
+			"threshold_raw": "100",
+			"direction":     "below",
+			"callback_url":  server.URL,
+			"fired":         true,
+		},
+	}, respJson["alerts"])
+
+	status, respJson = doRequest(map[string]interface{}{
+		"action":   "alert_delete",
+		"wallet":   dbWallet.ID.String(),
+		"alert_id": alertID,
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["deleted"])
+
+	status, respJson = doRequest(map[string]interface{}{
+		"action":   "alert_delete",
+		"wallet":   dbWallet.ID.String(),
+		"alert_id": alertID,
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ALERT_NOT_FOUND", respJson["error_code"])
+
+	status, respJson = doRequest(map[string]interface{}{
+		"action": "alert_list",
+		"wallet": dbWallet.ID.String(),
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []interface{}{}, respJson["alerts"])
+}
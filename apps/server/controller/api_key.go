@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/apikey"
+	rpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"golang.org/x/exp/slices"
+)
+
+// With server.require_api_key the gateway and /ws need an API key, see the apikey command of the cli
+// Keys are given in the X-Api-Key header or the api_key field of the request
+// A read key can only use READ_SCOPE_ACTIONS, an admin key is needed for ADMIN_SCOPE_ACTIONS, a send key for everything else
+// APPROVER_ACTIONS need a key marked as an approver instead
+// The rate limit is then per key instead of per IP
+
+const apiKeyHeader = "X-Api-Key"
+
+// Pippin's actions that only read, the node's are rpc.READ_ONLY_ACTIONS
+// pipeline checks the scope of each of its actions
+var READ_SCOPE_ACTIONS = []string{
+	"wallet_locked", "wallet_info", "wallet_contains", "wallet_verify", "wallet_reconcile", "wallet_statistics",
+	"wallet_balances", "wallet_balance_total", "wallet_frontiers", "wallet_pending", "wallet_ledger", "wallet_representative", "wallet_representative_history",
+	"wallet_history", "receive_minimum", "list_snapshots", "get_snapshot", "account_list", "account_label_get", "accounts_filter", "accounts_weight", "accounts_info",
+	"account_balance_history", "account_history_all", "account_history_since", "account_balance", "account_info",
+	"account_representative", "account_frontier", "account_representative_check", "account_weight", "account_full_info",
+	"block_count_for_account", "validate_account_number", "key_valid", "verify_message", "alert_list", "job_status", "send_approval_list",
+	"election_statistics", "network_stats", "nano_difficulty_info", "work_difficulty_history", "nano_supply", "circulating_supply",
+	"representative_info", "confirmation_quorum", "send_confirmation_poll", "block_successor", "block_predecessor",
+	"nano_version", "gateway_actions", "pipeline", "account_history", "version", "uptime",
+}
+
+// Actions that create wallets, reveal keys, wallet IDs or the audit records, along with every action on /admin
+var ADMIN_SCOPE_ACTIONS = []string{
+	"wallet_create", "wallet_create_from_seed", "wallet_create_watch_only", "wallet_import_nanowallet", "wallet_import_nault", "wallet_backup_restore",
+	"deterministic_key", "password_change", "wallet_audit", "wallet_list",
+}
+
+// Actions only approver keys can use, whatever their scope, the handlers check it
+var APPROVER_ACTIONS = []string{"send_approve", "send_reject"}
+
+type apiKeyContextKey struct{}
+
+// The scope an action needs
+func actionScope(action string) apikey.Scope {
+	if slices.Contains(ADMIN_SCOPE_ACTIONS, action) {
+		return apikey.ScopeAdmin
+	}
+	if slices.Contains(READ_SCOPE_ACTIONS, action) || slices.Contains(rpc.READ_ONLY_ACTIONS, action) || slices.Contains(APPROVER_ACTIONS, action) {
+		return apikey.ScopeRead
+	}
+	return apikey.ScopeSend
+}
+
+func (hc *HttpController) requireApiKey() bool {
+	return hc.Wallet != nil && hc.Wallet.Config != nil && hc.Wallet.Config.Server.RequireApiKey
+}
+
+// Check the request's API key if one is required, it's removed from request so it isn't forwarded to the node
+// The request returned has the key for refuseAction and the rate limit, false if the error was written
+func (hc *HttpController) authenticate(request map[string]interface{}, w http.ResponseWriter, r *http.Request) (*http.Request, bool) {
+	key := r.Header.Get(apiKeyHeader)
+	if key == "" {
+		if bodyKey, ok := request["api_key"]; ok {
+			key = fmt.Sprintf("%v", bodyKey)
+		}
+	}
+	delete(request, "api_key")
+	if !hc.requireApiKey() {
+		return r, true
+	}
+
+	found, err := hc.verifyApiKey(key)
+	if errors.Is(err, wallet.ErrApiKeyNotFound) {
+		// Guessing keys is limited by IP
+		if hc.RateLimiter != nil && !hc.RateLimiter.Allow(requestIP(r)) {
+			ErrRateLimited(w, r)
+			return r, false
+		}
+		ErrUnauthorized(w, r)
+		return r, false
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return r, false
+	}
+	r = r.WithContext(context.WithValue(r.Context(), apiKeyContextKey{}, found))
+	if hc.RateLimiter != nil && !hc.RateLimiter.Allow(rateLimitKey(r)) {
+		ErrRateLimited(w, r)
+		return r, false
+	}
+	return r, true
+}
+
+// The rate limit bucket of a request, its API key if authenticate checked one, otherwise its IP
+func rateLimitKey(r *http.Request) string {
+	if found, ok := r.Context().Value(apiKeyContextKey{}).(*ent.ApiKey); ok {
+		return "api_key:" + found.ID.String()
+	}
+	return requestIP(r)
+}
+
+func (hc *HttpController) verifyApiKey(key string) (*ent.ApiKey, error) {
+	if key == "" {
+		return nil, wallet.ErrApiKeyNotFound
+	}
+	return hc.Wallet.ApiKeyVerify(key)
+}
+
+// The scope action needs, and whether the request's API key doesn't have it
+// Requests authenticate didn't check a key for are never refused
+func apiKeyRefuses(action string, r *http.Request) (apikey.Scope, bool) {
+	found, ok := r.Context().Value(apiKeyContextKey{}).(*ent.ApiKey)
+	if !ok {
+		return "", false
+	}
+	required := actionScope(action)
+	return required, !wallet.ApiKeyScopeAllows(found.Scope, required)
+}
+
+// The unlock session of the request, its API key's ID, empty if authenticate didn't check a key
+func apiKeySession(r *http.Request) string {
+	if found, ok := r.Context().Value(apiKeyContextKey{}).(*ent.ApiKey); ok {
+		return found.ID.String()
+	}
+	return ""
+}
+
+// The key in the X-Api-Key header, for the admin gateway, nil without a valid one
+func (hc *HttpController) headerApiKey(r *http.Request) *ent.ApiKey {
+	if hc.Wallet == nil {
+		return nil
+	}
+	found, err := hc.verifyApiKey(r.Header.Get(apiKeyHeader))
+	if err != nil {
+		return nil
+	}
+	return found
+}
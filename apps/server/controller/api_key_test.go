@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/apikey"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
+)
+
+func TestActionScopes(t *testing.T) {
+	// Every scoped action is one the gateways or the node serve
+	for _, action := range append(append([]string{}, READ_SCOPE_ACTIONS...), ADMIN_SCOPE_ACTIONS...) {
+		_, ok := gatewayActions[action]
+		_, admin := adminActions[action]
+		assert.True(t, ok || admin || slices.Contains([]string{"account_history", "version", "uptime"}, action), action)
+	}
+	assert.Equal(t, apikey.ScopeRead, actionScope("account_balance"))
+	assert.Equal(t, apikey.ScopeRead, actionScope("accounts_balances"))
+	assert.Equal(t, apikey.ScopeSend, actionScope("send"))
+	assert.Equal(t, apikey.ScopeSend, actionScope("process"))
+	assert.Equal(t, apikey.ScopeAdmin, actionScope("wallet_create"))
+	assert.Equal(t, apikey.ScopeAdmin, actionScope("deterministic_key"))
+	assert.Equal(t, apikey.ScopeAdmin, actionScope("wallet_list"))
+	// The handler checks for an approver key
+	assert.Equal(t, apikey.ScopeRead, actionScope("send_approve"))
+}
+
+func TestGatewayApiKeys(t *testing.T) {
+	hc := newTestController(t)
+	conf := *hc.Wallet.Config
+	hc.Wallet.Config = &conf
+
+	doGateway := func(header string, reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if header != "" {
+			req.Header.Set("X-Api-Key", header)
+		}
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+	validate := map[string]interface{}{
+		"action":  "validate_account_number",
+		"account": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+	}
+
+	// Without require_api_key nothing changes
+	status, _ := doGateway("", validate)
+	assert.Equal(t, 200, status)
+
+	conf.Server.RequireApiKey = true
+	_, readKey, err := hc.Wallet.ApiKeyCreate("reader", "read")
+	assert.Nil(t, err)
+	_, sendKey, err := hc.Wallet.ApiKeyCreate("sender", "send")
+	assert.Nil(t, err)
+	_, adminKey, err := hc.Wallet.ApiKeyCreate("admin", "admin")
+	assert.Nil(t, err)
+
+	status, resp := doGateway("", validate)
+	assert.Equal(t, 401, status)
+	assert.Equal(t, "UNAUTHORIZED", resp["error_code"])
+	status, _ = doGateway("pippin_notakey", validate)
+	assert.Equal(t, 401, status)
+	status, _ = doGateway(readKey, validate)
+	assert.Equal(t, 200, status)
+
+	// Or in the body
+	withKey := map[string]interface{}{"api_key": readKey}
+	for k, v := range validate {
+		withKey[k] = v
+	}
+	status, _ = doGateway("", withKey)
+	assert.Equal(t, 200, status)
+
+	// A read key can't send
+	send := map[string]interface{}{
+		"action":      "send",
+		"wallet":      "1234",
+		"source":      "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+		"destination": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+		"amount":      "1",
+	}
+	status, resp = doGateway(readKey, send)
+	assert.Equal(t, 403, status)
+	assert.Equal(t, "INSUFFICIENT_SCOPE", resp["error_code"])
+	_, resp = doGateway(sendKey, send)
+	assert.NotEqual(t, "INSUFFICIENT_SCOPE", resp["error_code"])
+
+	// Only an admin key can create wallets
+	status, resp = doGateway(sendKey, map[string]interface{}{"action": "wallet_create"})
+	assert.Equal(t, 403, status)
+	assert.Equal(t, "INSUFFICIENT_SCOPE", resp["error_code"])
+	status, resp = doGateway(adminKey, map[string]interface{}{"action": "wallet_create"})
+	assert.Equal(t, 200, status)
+	assert.NotEmpty(t, resp["wallet"])
+
+	// Each action of a pipeline is checked
+	status, resp = doGateway(readKey, map[string]interface{}{
+		"action":  "pipeline",
+		"actions": []map[string]interface{}{{"action": "validate_account_number", "params": map[string]interface{}{"account": validate["account"]}}, {"action": "wallet_create"}},
+	})
+	assert.Equal(t, 200, status)
+	results := resp["results"].([]interface{})
+	assert.Len(t, results, 2)
+	assert.Equal(t, float64(403), results[1].(map[string]interface{})["status"])
+}
+
+func TestAdminHandlerApiKeys(t *testing.T) {
+	hc := newTestController(t)
+	_, sendKey, _ := hc.Wallet.ApiKeyCreate("sender", "send")
+	_, adminKey, _ := hc.Wallet.ApiKeyCreate("admin", "admin")
+
+	for key, expected := range map[string]int{"": 401, sendKey: 401, adminKey: 200} {
+		body, _ := json.Marshal(map[string]interface{}{"action": "work_peers"})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Api-Key", key)
+		hc.AdminHandler(w, req)
+		assert.Equal(t, expected, w.Result().StatusCode)
+	}
+}
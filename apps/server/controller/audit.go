@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"golang.org/x/exp/slices"
+)
+
+// Records sensitive actions for compliance, e.g. every send with its source, destination and amount
+// send, send_with_id, send_raw, wallet_change_seed, wallet_seed and wallet_backup_create are always passed to it, whether they succeed or not
+type AuditLogger interface {
+	LogAction(ctx context.Context, action string, wallet string, details map[string]string)
+}
+
+// Drops everything, used when no audit log is configured
+type NoopAuditLogger struct{}
+
+func (NoopAuditLogger) LogAction(ctx context.Context, action string, wallet string, details map[string]string) {
+}
+
+// A line of the audit log
+type AuditEntry struct {
+	Timestamp string            `json:"timestamp"`
+	Action    string            `json:"action"`
+	Wallet    string            `json:"wallet"`
+	Details   map[string]string `json:"details"`
+}
+
+// Appends every action as a JSON line to a file
+type FileAuditLogger struct {
+	file  *os.File
+	mutex sync.Mutex
+}
+
+// Open path for appending, it's created if it doesn't exist
+func NewFileAuditLogger(path string) (*FileAuditLogger, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileAuditLogger{file: f}, nil
+}
+
+func (l *FileAuditLogger) LogAction(ctx context.Context, action string, wallet string, details map[string]string) {
+	line, err := json.Marshal(AuditEntry{
+		Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+		Action:    action,
+		Wallet:    wallet,
+		Details:   details,
+	})
+	if err != nil {
+		log.Errorf("Error encoding audit entry for %s %s", action, err)
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		log.Errorf("Error writing audit entry for %s %s", action, err)
+	}
+}
+
+func (l *FileAuditLogger) Close() error {
+	return l.file.Close()
+}
+
+// Pass an action to the audit logger, NoopAuditLogger if none is set
+func (hc *HttpController) audit(ctx context.Context, action string, wallet string, details map[string]string) {
+	logger := hc.AuditLogger
+	if logger == nil {
+		logger = NoopAuditLogger{}
+	}
+	logger.LogAction(ctx, action, wallet, details)
+}
+
+// Actions recorded in the audit table, everything that changes a wallet, its keys or its accounts, and what reveals a seed
+// Both gateways record them whether they succeed or not, each action of a pipeline on its own
+var AUDITED_ACTIONS = []string{
+	"wallet_create", "wallet_create_from_seed", "wallet_create_watch_only", "wallet_add_watch", "wallet_import_nanowallet", "wallet_import_nault",
+	"wallet_backup_restore", "account_create", "accounts_create", "account_create_vanity", "account_remove", "account_move", "account_label_set", "password_change", "password_enter",
+	"wallet_add", "wallet_lock", "wallet_accounts_reindex", "snapshot_balances", "receive", "receive_all", "receive_batch", "account_sync",
+	"accounts_sync", "send", "send_with_id", "send_bulk", "send_raw", "sign_block", "sign_message", "sweep_to_wallet", "wallet_sweep", "cross_wallet_transfer",
+	"send_schedule", "send_schedule_cancel", "send_approve", "send_reject", "wallet_approval_policy_set", "alert_register", "alert_delete", "account_representative_set", "accounts_representative_set",
+	"wallet_representative_set", "wallet_auto_receive_set", "wallet_name_set", "receive_minimum_set",
+	"wallet_destroy", "wallet_change_seed", "wallet_seed", "wallet_backup_create", "wallet_freeze", "wallet_unfreeze", "wallet_lock_all",
+	"work_peer_add", "work_peer_remove", "work_cancel_all", "work_cache_clear", "config_reload",
+}
+
+// Request fields that are never recorded, at any depth
+var auditRedactedFields = []string{"seed", "key", "password", "passphrase", "backup", "bpow_key", "api_key"}
+
+const auditRedacted = "[redacted]"
+
+// A copy of value with the redacted fields replaced
+func redactAuditParams(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for field, item := range v {
+			if slices.Contains(auditRedactedFields, field) {
+				redacted[field] = auditRedacted
+			} else {
+				redacted[field] = redactAuditParams(item)
+			}
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, item := range v {
+			redacted[i] = redactAuditParams(item)
+		}
+		return redacted
+	}
+	return value
+}
+
+// Handle action with handle, recording it in the audit table if it's one of AUDITED_ACTIONS
+func (hc *HttpController) auditAction(action string, request map[string]interface{}, w http.ResponseWriter, r *http.Request, handle func(w http.ResponseWriter)) {
+	if !slices.Contains(AUDITED_ACTIONS, action) || hc.Wallet == nil || hc.Wallet.DB == nil {
+		handle(w)
+		return
+	}
+	// Copied before the handler can change the request
+	params := redactAuditParams(request).(map[string]interface{})
+	recorder := &responseRecorder{ResponseWriter: w}
+	handle(recorder)
+
+	record := models.AuditRecord{
+		Action: action,
+		IP:     requestIP(r),
+		Params: params,
+		Status: recorder.status,
+	}
+	if record.Status == 0 {
+		record.Status = http.StatusOK
+	}
+	// Created wallets are only in the response
+	var resp map[string]interface{}
+	json.Unmarshal(recorder.body.Bytes(), &resp)
+	if wallet, ok := params["wallet"].(string); ok {
+		record.Wallet = &wallet
+	} else if wallet, ok := resp["wallet"].(string); ok {
+		record.Wallet = &wallet
+	}
+	if errorCode, ok := resp["error_code"].(string); ok {
+		record.ErrorCode = &errorCode
+	}
+	if found, ok := r.Context().Value(apiKeyContextKey{}).(*ent.ApiKey); ok {
+		record.ApiKeyID = &found.ID
+		record.ApiKeyName = &found.Name
+	}
+	if _, err := hc.Wallet.AuditRecordCreate(record); err != nil {
+		log.Errorf("Error recording %s in the audit table %s", action, err)
+	}
+}
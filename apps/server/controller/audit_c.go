@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	walletmodels "github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
+)
+
+// How many records wallet_audit returns without a count
+const walletAuditDefaultCount = 1000
+
+func auditRecordResponse(record *ent.AuditRecord) responses.AuditRecord {
+	resp := responses.AuditRecord{
+		Action:     record.Action,
+		Wallet:     record.Wallet,
+		IP:         record.IP,
+		ApiKeyName: record.APIKeyName,
+		Params:     record.Params,
+		Status:     record.Status,
+		ErrorCode:  record.ErrorCode,
+		Time:       record.CreatedAt.Unix(),
+	}
+	if record.APIKeyID != nil {
+		keyID := record.APIKeyID.String()
+		resp.ApiKeyID = &keyID
+	}
+	return resp
+}
+
+// Handle wallet_audit, the wallet's audit records, newest first
+// The wallet doesn't have to exist anymore, the records of a destroyed wallet are kept
+func (hc *HttpController) HandleWalletAudit(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var auditRequest requests.WalletAuditRequest
+	if err := mapstructure.Decode(rawRequest, &auditRequest); err != nil {
+		log.Errorf("Error unmarshalling wallet_audit request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if auditRequest.Wallet == "" || auditRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	filter := walletmodels.AuditFilter{
+		Wallet: auditRequest.Wallet,
+		Action: auditRequest.AuditAction,
+		Count:  walletAuditDefaultCount,
+	}
+	if auditRequest.Count != nil {
+		count, err := utils.ToInt(*auditRequest.Count)
+		if err != nil || count < 1 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+		filter.Count = count
+	}
+	if auditRequest.StartDate != nil {
+		parsed, err := parseHistoryDate(*auditRequest.StartDate)
+		if err != nil {
+			ErrBadRequest(w, r, ErrorCodeInvalidDate, "Invalid start_date")
+			return
+		}
+		filter.Start = &parsed
+	}
+	if auditRequest.EndDate != nil {
+		parsed, err := parseHistoryDate(*auditRequest.EndDate)
+		if err != nil {
+			ErrBadRequest(w, r, ErrorCodeInvalidDate, "Invalid end_date")
+			return
+		}
+		filter.End = &parsed
+	}
+
+	records, err := hc.Wallet.AuditRecords(filter)
+	if errors.Is(err, wallet.ErrInvalidDateRange) {
+		ErrBadRequest(w, r, ErrorCodeInvalidDateRange, "end_date must be after start_date")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WalletAuditResponse{
+		Records: []responses.AuditRecord{},
+	}
+	for _, record := range records {
+		resp.Records = append(resp.Records, auditRecordResponse(record))
+	}
+	render.JSON(w, r, &resp)
+}
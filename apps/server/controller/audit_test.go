@@ -0,0 +1,269 @@
+package controller
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingAuditLogger struct {
+	entries []AuditEntry
+}
+
+func (l *recordingAuditLogger) LogAction(ctx context.Context, action string, wallet string, details map[string]string) {
+	l.entries = append(l.entries, AuditEntry{Action: action, Wallet: wallet, Details: details})
+}
+
+func TestFileAuditLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	logger, err := NewFileAuditLogger(path)
+	assert.Nil(t, err)
+	logger.LogAction(context.Background(), "send", "1234", map[string]string{"amount": "1"})
+	logger.LogAction(context.Background(), "wallet_seed", "5678", map[string]string{})
+	assert.Nil(t, logger.Close())
+
+	// Reopening appends
+	logger, err = NewFileAuditLogger(path)
+	assert.Nil(t, err)
+	logger.LogAction(context.Background(), "wallet_change_seed", "1234", nil)
+	assert.Nil(t, logger.Close())
+
+	f, err := os.Open(path)
+	assert.Nil(t, err)
+	defer f.Close()
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry AuditEntry
+		assert.Nil(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	assert.Len(t, entries, 3)
+	assert.Equal(t, "send", entries[0].Action)
+	assert.Equal(t, "1234", entries[0].Wallet)
+	assert.Equal(t, map[string]string{"amount": "1"}, entries[0].Details)
+	assert.NotEqual(t, "", entries[0].Timestamp)
+	assert.Equal(t, "wallet_seed", entries[1].Action)
+	assert.Equal(t, "wallet_change_seed", entries[2].Action)
+}
+
+func TestAuditedActions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr["action"] == "process" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	hc := newTestController(t)
+	logger := &recordingAuditLogger{}
+	hc.AuditLogger = logger
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("d0c3f6e9b2a5d8c1f4e7a0b3e8d1a6c9f2e5b8a1d4c7f0e3b6a9d2c5f8e1b4a7"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	acc, _ := hc.Wallet.AccountCreate(wallet, nil)
+
+	doRequest := func(handler http.HandlerFunc, request map[string]interface{}) int {
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		handler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	status := doRequest(hc.Gateway, map[string]interface{}{
+		"action":      "send",
+		"wallet":      wallet.ID.String(),
+		"source":      acc.Address,
+		"destination": "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj",
+		"amount":      "1",
+		"id":          "audit",
+		"work":        "0000000000000000",
+	})
+	assert.Equal(t, 200, status)
+	assert.Len(t, logger.entries, 1)
+	assert.Equal(t, "send", logger.entries[0].Action)
+	assert.Equal(t, wallet.ID.String(), logger.entries[0].Wallet)
+	assert.Equal(t, acc.Address, logger.entries[0].Details["source"])
+	assert.Equal(t, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", logger.entries[0].Details["destination"])
+	assert.Equal(t, "1", logger.entries[0].Details["amount"])
+	assert.Equal(t, "audit", logger.entries[0].Details["id"])
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", logger.entries[0].Details["block"])
+
+	// Failed sends are audited too
+	status = doRequest(hc.Gateway, map[string]interface{}{
+		"action":      "send",
+		"wallet":      wallet.ID.String(),
+		"source":      acc.Address,
+		"destination": "nano_1234",
+		"amount":      "1",
+	})
+	assert.Equal(t, 400, status)
+	assert.Len(t, logger.entries, 2)
+	assert.Equal(t, "send", logger.entries[1].Action)
+	assert.Equal(t, "", logger.entries[1].Details["block"])
+
+	status = doRequest(hc.AdminHandler, map[string]interface{}{
+		"action": "wallet_seed",
+		"wallet": wallet.ID.String(),
+	})
+	assert.Equal(t, 200, status)
+	assert.Len(t, logger.entries, 3)
+	assert.Equal(t, "wallet_seed", logger.entries[2].Action)
+	assert.Equal(t, wallet.ID.String(), logger.entries[2].Wallet)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("e7a0b3e8d1a6c9f2e5b8a1d4c7f0e3b6a9d2c5f8e1b4a7d0c3f6e9b2a5d8c1f4"))
+	status = doRequest(hc.AdminHandler, map[string]interface{}{
+		"action": "wallet_change_seed",
+		"wallet": wallet.ID.String(),
+		"seed":   seed,
+	})
+	assert.Equal(t, 200, status)
+	assert.Len(t, logger.entries, 4)
+	assert.Equal(t, "wallet_change_seed", logger.entries[3].Action)
+	// The seed is never audited
+	for _, value := range logger.entries[3].Details {
+		assert.NotContains(t, value, seed)
+	}
+}
+
+func TestAuditTable(t *testing.T) {
+	hc := newTestController(t)
+	conf := *hc.Wallet.Config
+	hc.Wallet.Config = &conf
+
+	doRequest := func(handler http.HandlerFunc, header http.Header, reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/", bytes.NewReader(body))
+		req.RemoteAddr = "10.0.0.1:1234"
+		for key, values := range header {
+			req.Header[key] = values
+		}
+		handler(w, req)
+		var respJson map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &respJson)
+		return w.Code, respJson
+	}
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("b3e6c9f2a5d8b1e4c7f0a3d6b9e2c5f8a1d4b7e0c3f6a9d2b5e8c1f4a7d0b3e6"))
+	status, resp := doRequest(hc.Gateway, nil, map[string]interface{}{"action": "wallet_create", "seed": seed})
+	assert.Equal(t, 200, status)
+	walletID := resp["wallet"].(string)
+	status, _ = doRequest(hc.Gateway, nil, map[string]interface{}{"action": "password_change", "wallet": walletID, "password": "hunter2"})
+	assert.Equal(t, 200, status)
+	status, _ = doRequest(hc.Gateway, nil, map[string]interface{}{"action": "receive", "wallet": walletID, "account": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", "block": "notahash"})
+	assert.Equal(t, 400, status)
+	// Only reads, not recorded
+	status, _ = doRequest(hc.Gateway, nil, map[string]interface{}{"action": "wallet_locked", "wallet": walletID})
+	assert.Equal(t, 200, status)
+	status, _ = doRequest(hc.AdminHandler, http.Header{"Authorization": {"Bearer " + mockAdminToken}}, map[string]interface{}{"action": "wallet_seed", "wallet": walletID})
+	assert.NotEqual(t, 0, status)
+
+	// Records have the key of the request
+	conf.Server.RequireApiKey = true
+	_, adminKey, err := hc.Wallet.ApiKeyCreate("auditor", "admin")
+	assert.Nil(t, err)
+	withKey := http.Header{"X-Api-Key": {adminKey}}
+	status, resp = doRequest(hc.Gateway, withKey, map[string]interface{}{"action": "wallet_audit", "wallet": walletID})
+	assert.Equal(t, 200, status)
+	var audit struct {
+		Records []struct {
+			Action     string                 `json:"action"`
+			Wallet     string                 `json:"wallet"`
+			IP         string                 `json:"ip"`
+			ApiKeyName *string                `json:"api_key_name"`
+			Params     map[string]interface{} `json:"params"`
+			Status     int                    `json:"status"`
+			ErrorCode  *string                `json:"error_code"`
+			Time       int64                  `json:"time"`
+		} `json:"records"`
+	}
+	encoded, _ := json.Marshal(resp)
+	assert.Nil(t, json.Unmarshal(encoded, &audit))
+	assert.Len(t, audit.Records, 4)
+	// Newest first
+	assert.Equal(t, "wallet_seed", audit.Records[0].Action)
+	assert.Equal(t, "receive", audit.Records[1].Action)
+	assert.Equal(t, 400, audit.Records[1].Status)
+	assert.NotNil(t, audit.Records[1].ErrorCode)
+	assert.Equal(t, "password_change", audit.Records[2].Action)
+	assert.Equal(t, 200, audit.Records[2].Status)
+	assert.Nil(t, audit.Records[2].ErrorCode)
+	assert.Equal(t, "[redacted]", audit.Records[2].Params["password"])
+	// The wallet of wallet_create is from its response
+	created := audit.Records[3]
+	assert.Equal(t, "wallet_create", created.Action)
+	assert.Equal(t, walletID, created.Wallet)
+	assert.Equal(t, "10.0.0.1", created.IP)
+	assert.Equal(t, "[redacted]", created.Params["seed"])
+	assert.NotZero(t, created.Time)
+	assert.Nil(t, created.ApiKeyName)
+
+	status, _ = doRequest(hc.Gateway, withKey, map[string]interface{}{"action": "wallet_lock", "wallet": walletID})
+	assert.Equal(t, 200, status)
+	status, resp = doRequest(hc.Gateway, withKey, map[string]interface{}{"action": "wallet_audit", "wallet": walletID, "audit_action": "wallet_lock", "count": 1})
+	assert.Equal(t, 200, status)
+	records := resp["records"].([]interface{})
+	assert.Len(t, records, 1)
+	assert.Equal(t, "auditor", records[0].(map[string]interface{})["api_key_name"])
+
+	// wallet_audit needs an admin key
+	_, readKey, _ := hc.Wallet.ApiKeyCreate("reader", "read")
+	status, _ = doRequest(hc.Gateway, http.Header{"X-Api-Key": {readKey}}, map[string]interface{}{"action": "wallet_audit", "wallet": walletID})
+	assert.Equal(t, 403, status)
+	status, resp = doRequest(hc.Gateway, withKey, map[string]interface{}{"action": "wallet_audit", "wallet": walletID, "start_date": "2024-04-01", "end_date": "2024-03-01"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_DATE_RANGE", resp["error_code"])
+	status, resp = doRequest(hc.Gateway, withKey, map[string]interface{}{"action": "wallet_audit", "wallet": walletID, "end_date": "2024-03-01"})
+	assert.Equal(t, 200, status)
+	assert.Empty(t, resp["records"])
+}
+
+func TestRedactAuditParams(t *testing.T) {
+	params := map[string]interface{}{
+		"action":   "wallet_import_nault",
+		"backup":   map[string]interface{}{"seed": "abc"},
+		"password": "hunter2",
+		"sends":    []interface{}{map[string]interface{}{"amount": "1", "key": "abc"}},
+	}
+	assert.Equal(t, map[string]interface{}{
+		"action":   "wallet_import_nault",
+		"backup":   "[redacted]",
+		"password": "[redacted]",
+		"sends":    []interface{}{map[string]interface{}{"amount": "1", "key": "[redacted]"}},
+	}, redactAuditParams(params))
+	// The request itself is left alone
+	assert.Equal(t, "hunter2", params["password"])
+}
@@ -1,19 +1,52 @@
 package controller
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
 	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc"
+	rpcrequests "github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	walletmodels "github.com/appditto/pippin_nano_wallet/libs/wallet/models"
 	"github.com/go-chi/render"
 	"github.com/mitchellh/mapstructure"
 )
 
+// Respond with a block that was built for preview instead of published, or the error building it
+func (hc *HttpController) renderBlockPreview(preview *wallet.BlockPreview, err error, w http.ResponseWriter, r *http.Request) {
+	if err != nil {
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
+		return
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.BlockPreviewResponse{
+		Preview:    true,
+		Subtype:    preview.Subtype,
+		Hash:       preview.Hash,
+		Block:      *preview.Block,
+		Balance:    preview.Balance,
+		Amount:     preview.Amount,
+		Difficulty: preview.Difficulty,
+		Signed:     preview.Signed,
+	})
+}
+
 // Handle receive individual block
 func (hc *HttpController) HandleReceiveRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
 	var receiveRequest requests.ReceiveRequest
@@ -31,6 +64,9 @@ func (hc *HttpController) HandleReceiveRequest(rawRequest *map[string]interface{
 	if dbWallet == nil {
 		return
 	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		return
+	}
 
 	// Validate account
 	_, err := utils.AddressToPub(receiveRequest.Account, hc.Wallet.Config.Wallet.Banano)
@@ -39,10 +75,23 @@ func (hc *HttpController) HandleReceiveRequest(rawRequest *map[string]interface{
 		return
 	}
 
+	// Work can be generated for more than the receive threshold
+	workMultiplier, err := requestedWorkMultiplier(receiveRequest.Difficulty, receiveRequest.Multiplier, pow.ReceiveMultiplier(hc.Wallet.Config.Wallet.Banano))
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidDifficulty, err.Error())
+		return
+	}
+
+	if receiveRequest.Preview != nil && *receiveRequest.Preview {
+		preview, err := hc.Wallet.PreviewReceiveBlock(dbWallet, receiveRequest.Account, receiveRequest.Block, receiveRequest.Work)
+		hc.renderBlockPreview(preview, err, w, r)
+		return
+	}
+
 	// Accounts list
-	resp, err := hc.Wallet.CreateAndPublishReceiveBlock(dbWallet, receiveRequest.Account, receiveRequest.Block, receiveRequest.Work, receiveRequest.BpowKey)
+	resp, err := hc.walletFor(r).CreateAndPublishReceiveBlockAt(dbWallet, receiveRequest.Account, receiveRequest.Block, receiveRequest.Work, receiveRequest.BpowKey, workMultiplier)
 	if err != nil {
-		ErrBadRequest(w, r, err.Error())
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
 		return
 	}
 
@@ -56,19 +105,32 @@ func (hc *HttpController) HandleReceiveRequest(rawRequest *map[string]interface{
 
 // Handle receive all blocks in entire wallet
 func (hc *HttpController) HandleReceiveAllRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
-	request := hc.DecodeBaseRequest(rawRequest, w, r)
-	if request == nil {
+	var receiveRequest requests.ReceiveAllRequest
+	if err := mapstructure.Decode(rawRequest, &receiveRequest); err != nil {
+		log.Errorf("Error unmarshalling receive_all request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if receiveRequest.Wallet == "" || receiveRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	async, err := asyncRequested(receiveRequest.Async)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
 		return
 	}
 
 	// See if wallet exists
-	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	dbWallet := hc.WalletExists(receiveRequest.Wallet, w, r)
 	if dbWallet == nil {
 		return
 	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		return
+	}
 
-	// Accounts list
-	_, accounts, err := hc.Wallet.AccountsList(dbWallet, 0)
+	// Accounts list, watch-only accounts can't receive
+	dbAccounts, _, err := hc.Wallet.AccountsList(dbWallet, 0)
 	if errors.Is(err, wallet.ErrWalletLocked) {
 		ErrWalletLocked(w, r)
 		return
@@ -76,19 +138,99 @@ func (hc *HttpController) HandleReceiveAllRequest(rawRequest *map[string]interfa
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
+	accounts := wallet.SigningAddresses(dbAccounts)
+
+	if async {
+		hc.startJob(dbWallet, "receive_all", func(progress wallet.JobProgress) (interface{}, error) {
+			return hc.receiveAll(dbWallet, accounts, receiveRequest.BpowKey, progress)
+		}, w, r)
+		return
+	}
+
+	resp, err := hc.receiveAll(dbWallet, accounts, receiveRequest.BpowKey, nil)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
 
-	receivedCount := 0
-	for _, account := range accounts {
-		resp, err := hc.Wallet.ReceiveAllBlocks(dbWallet, account, request.BpowKey)
+// Receive everything pending on accounts one at a time, progress is reported after each account if it isn't nil
+func (hc *HttpController) receiveAll(dbWallet *ent.Wallet, accounts []string, bpowKey *string, progress wallet.JobProgress) (*responses.ReceiveAllResponse, error) {
+	resp := &responses.ReceiveAllResponse{}
+	for i, account := range accounts {
+		received, err := hc.Wallet.ReceiveAllBlocks(dbWallet, account, bpowKey)
 		if err != nil {
-			ErrInternalServerError(w, r, err.Error())
+			return resp, err
+		}
+		resp.Received += received
+		if progress != nil {
+			progress((i+1)*100/len(accounts), resp)
+		}
+	}
+	return resp, nil
+}
+
+// Handle receive a list of pending blocks on one account, in order
+func (hc *HttpController) HandleReceiveBatchRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var batchRequest requests.ReceiveBatchRequest
+	if err := mapstructure.Decode(rawRequest, &batchRequest); err != nil {
+		log.Errorf("Error unmarshalling receive batch request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if batchRequest.Wallet == "" || batchRequest.Action == "" || batchRequest.Account == "" || len(batchRequest.Blocks) == 0 {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// Validate account and hashes
+	_, err := utils.AddressToPub(batchRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+	for _, hash := range batchRequest.Blocks {
+		if !utils.Validate64HexHash(hash) {
+			ErrInvalidHash(w, r)
 			return
 		}
-		receivedCount += resp
 	}
 
-	resp := responses.ReceiveAllResponse{
-		Received: receivedCount,
+	// See if wallet exists
+	dbWallet := hc.WalletExists(batchRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		return
+	}
+
+	result, err := hc.walletFor(r).ReceiveBatch(dbWallet, batchRequest.Account, batchRequest.Blocks, batchRequest.BpowKey)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
+		return
+	}
+
+	resp := responses.ReceiveBatchResponse{
+		Received: make([]responses.ReceivedBlock, len(result.Received)),
+		Skipped:  make([]responses.SkippedBlock, len(result.Skipped)),
+	}
+	for i, received := range result.Received {
+		resp.Received[i] = responses.ReceivedBlock{
+			Hash:         received.Hash,
+			ReceivedHash: received.ReceivedHash,
+		}
+	}
+	for i, skipped := range result.Skipped {
+		resp.Skipped[i] = responses.SkippedBlock{
+			Hash:   skipped.Hash,
+			Reason: skipped.Reason,
+		}
 	}
 
 	render.Status(r, http.StatusOK)
@@ -106,76 +248,170 @@ func (hc *HttpController) HandleSendRequest(rawRequest *map[string]interface{},
 		ErrUnableToParseJson(w, r)
 		return
 	}
+	// The amount can be given in nano, or banano or banoshi in banano mode
+	amount, ok := hc.AmountToRaw(sendRequest.Amount, sendRequest.Unit, w, r)
+	if !ok {
+		return
+	}
+	sendRequest.Amount = amount
+
+	// Every send attempt is audited, with the block if it was published
+	auditDetails := map[string]string{
+		"source":      sendRequest.Source,
+		"destination": sendRequest.Destination,
+		"amount":      sendRequest.Amount,
+		"remote_addr": r.RemoteAddr,
+	}
+	if sendRequest.ID != nil {
+		auditDetails["id"] = *sendRequest.ID
+	}
+	defer func() {
+		hc.audit(r.Context(), "send", sendRequest.Wallet, auditDetails)
+	}()
 
 	// See if wallet exists
 	dbWallet := hc.WalletExists(sendRequest.Wallet, w, r)
 	if dbWallet == nil {
 		return
 	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		auditDetails["error"] = "wallet_frozen"
+		return
+	}
 
 	// Validate accounts
 	_, err := utils.AddressToPub(sendRequest.Source, hc.Wallet.Config.Wallet.Banano)
 	if err != nil {
-		ErrBadRequest(w, r, fmt.Sprintf("Invalid source account %s", sendRequest.Source))
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid source account %s", sendRequest.Source))
 		return
 	}
 	_, err = utils.AddressToPub(sendRequest.Destination, hc.Wallet.Config.Wallet.Banano)
 	if err != nil {
-		ErrBadRequest(w, r, fmt.Sprintf("Invalid destination account %s", sendRequest.Destination))
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid destination account %s", sendRequest.Destination))
+		return
+	}
+
+	// Funds sent to an account that was never opened may be lost if nobody has its keys
+	// If the node can't tell, the send goes ahead as if it was opened
+	destinationUnopened := false
+	if _, err := hc.RpcClient.WithContext(r.Context()).MakeAccountInfoRequest(sendRequest.Destination); errors.Is(err, rpc.ErrAccountNotFound) {
+		destinationUnopened = true
+	} else if err != nil {
+		log.Warnf("Unable to check whether %s is opened %s", sendRequest.Destination, err)
+	}
+	if destinationUnopened && sendRequest.AllowUnopened != nil && !*sendRequest.AllowUnopened {
+		auditDetails["error"] = "destination_unopened"
+		ErrBadRequest(w, r, ErrorCodeDestinationUnopened, "destination_unopened")
+		return
+	}
+
+	// Work can be generated for more than the send threshold
+	workMultiplier, err := requestedWorkMultiplier(sendRequest.Difficulty, sendRequest.Multiplier, pow.SendMultiplier(hc.Wallet.Config.Wallet.Banano))
+	if err != nil {
+		auditDetails["error"] = "invalid_difficulty"
+		ErrBadRequest(w, r, ErrorCodeInvalidDifficulty, err.Error())
+		return
+	}
+
+	if sendRequest.Preview != nil && *sendRequest.Preview {
+		auditDetails["preview"] = "true"
+		preview, err := hc.Wallet.PreviewSendBlock(dbWallet, sendRequest.Amount, sendRequest.Source, sendRequest.Destination, sendRequest.Work)
+		if err != nil {
+			auditDetails["error"] = err.Error()
+		}
+		hc.renderBlockPreview(preview, err, w, r)
+		return
+	}
+
+	// It's stored until approver keys approve it
+	if hc.Wallet.RequiresApproval(dbWallet, sendRequest.Amount) {
+		approval, err := hc.requestSendApproval(dbWallet, sendRequest, w, r)
+		if err != nil {
+			auditDetails["error"] = err.Error()
+		} else {
+			auditDetails["approval_id"] = approval.ID.String()
+		}
 		return
 	}
 
 	// Do the send
-	resp, err := hc.Wallet.CreateAndPublishSendBlock(dbWallet, sendRequest.Amount, sendRequest.Source, sendRequest.Destination, sendRequest.ID, sendRequest.Work, sendRequest.BpowKey)
+	resp, err := hc.walletFor(r).CreateAndPublishSendBlockAt(dbWallet, sendRequest.Amount, sendRequest.Source, sendRequest.Destination, sendRequest.ID, sendRequest.Work, sendRequest.BpowKey, workMultiplier)
 	if err != nil {
-		ErrBadRequest(w, r, err.Error())
+		auditDetails["error"] = err.Error()
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
 		return
 	}
+	auditDetails["block"] = resp
 
-	blockResponse := responses.BlockResponse{
-		Block: resp,
+	sendResponse := responses.SendResponse{
+		Block:               resp,
+		DestinationUnopened: destinationUnopened,
 	}
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, &blockResponse)
+	render.JSON(w, r, &sendResponse)
 }
 
-// Handle rep change
-func (hc *HttpController) HandleAccountRepresentativeSetRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
-	var changeRequest requests.AccountRepresentativeSetRequest
-	if err := mapstructure.Decode(rawRequest, &changeRequest); err != nil {
-		log.Errorf("Error unmarshalling representative set request %s", err)
+// Handle send_with_id, a send that happens once per send_id, retrying it returns the block that was sent
+func (hc *HttpController) HandleSendWithIDRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var sendRequest requests.SendWithIDRequest
+	if err := mapstructure.Decode(rawRequest, &sendRequest); err != nil {
+		log.Errorf("Error unmarshalling send_with_id request %s", err)
 		ErrUnableToParseJson(w, r)
 		return
-	} else if changeRequest.Wallet == "" || changeRequest.Action == "" || changeRequest.Representative == "" {
+	} else if sendRequest.Wallet == "" || sendRequest.Action == "" || sendRequest.Amount == "" || sendRequest.Destination == "" || sendRequest.SendID == "" {
 		ErrUnableToParseJson(w, r)
 		return
 	}
 
+	// Audited like send
+	auditDetails := map[string]string{
+		"source":      sendRequest.Source,
+		"destination": sendRequest.Destination,
+		"amount":      sendRequest.Amount,
+		"send_id":     sendRequest.SendID,
+		"remote_addr": r.RemoteAddr,
+	}
+	defer func() {
+		hc.audit(r.Context(), "send_with_id", sendRequest.Wallet, auditDetails)
+	}()
+
 	// See if wallet exists
-	dbWallet := hc.WalletExists(changeRequest.Wallet, w, r)
+	dbWallet := hc.WalletExists(sendRequest.Wallet, w, r)
 	if dbWallet == nil {
 		return
 	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		auditDetails["error"] = "wallet_frozen"
+		return
+	}
 
 	// Validate accounts
-	_, err := utils.AddressToPub(changeRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	_, err := utils.AddressToPub(sendRequest.Source, hc.Wallet.Config.Wallet.Banano)
 	if err != nil {
-		ErrBadRequest(w, r, "Invalid account")
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid source account %s", sendRequest.Source))
 		return
 	}
-	_, err = utils.AddressToPub(changeRequest.Representative, hc.Wallet.Config.Wallet.Banano)
+	_, err = utils.AddressToPub(sendRequest.Destination, hc.Wallet.Config.Wallet.Banano)
 	if err != nil {
-		ErrBadRequest(w, r, "Invalid representative account")
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid destination account %s", sendRequest.Destination))
 		return
 	}
 
-	// Do the send
-	resp, err := hc.Wallet.CreateAndPublishChangeBlock(dbWallet, changeRequest.Account, changeRequest.Representative, changeRequest.Work, changeRequest.BpowKey, false)
+	// Only send can wait for approvals
+	if hc.Wallet.RequiresApproval(dbWallet, sendRequest.Amount) {
+		auditDetails["error"] = "approval_required"
+		ErrBadRequest(w, r, ErrorCodeApprovalRequired, "This send needs approvals, use send")
+		return
+	}
+
+	resp, err := hc.walletFor(r).SendWithID(dbWallet, sendRequest.SendID, sendRequest.Source, sendRequest.Destination, sendRequest.Amount, sendRequest.Work, sendRequest.BpowKey)
 	if err != nil {
-		ErrBadRequest(w, r, err.Error())
+		auditDetails["error"] = err.Error()
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
 		return
 	}
+	auditDetails["block"] = resp
 
 	blockResponse := responses.BlockResponse{
 		Block: resp,
@@ -184,3 +420,1040 @@ func (hc *HttpController) HandleAccountRepresentativeSetRequest(rawRequest *map[
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, &blockResponse)
 }
+
+// Handle send_bulk, sends from one account made in order, a send that fails doesn't stop the rest
+// Sends with an id are only made once, so the request can be retried with the same ids after a partial failure
+func (hc *HttpController) HandleSendBulkRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var bulkRequest requests.SendBulkRequest
+	if err := mapstructure.Decode(rawRequest, &bulkRequest); err != nil {
+		log.Errorf("Error unmarshalling send_bulk request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if bulkRequest.Wallet == "" || bulkRequest.Action == "" || bulkRequest.Source == "" || len(bulkRequest.Sends) == 0 {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	maxSends := hc.Wallet.Config.Server.SendBulkMaxSends
+	if len(bulkRequest.Sends) > maxSends {
+		ErrBadRequest(w, r, ErrorCodeTooManySends, fmt.Sprintf("send_bulk can make at most %d sends", maxSends))
+		return
+	}
+
+	// Validate accounts, nothing is sent unless every send is valid
+	_, err := utils.AddressToPub(bulkRequest.Source, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid source account %s", bulkRequest.Source))
+		return
+	}
+	sends := make([]wallet.BulkSend, len(bulkRequest.Sends))
+	for i, send := range bulkRequest.Sends {
+		if send.Amount == "" || send.Destination == "" {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+		if _, err := utils.AddressToPub(send.Destination, hc.Wallet.Config.Wallet.Banano); err != nil {
+			ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid destination account %s", send.Destination))
+			return
+		}
+		sends[i] = wallet.BulkSend{Destination: send.Destination, Amount: send.Amount, ID: send.ID, Work: send.Work}
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(bulkRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		return
+	}
+	for _, send := range sends {
+		if hc.Wallet.RequiresApproval(dbWallet, send.Amount) {
+			ErrBadRequest(w, r, ErrorCodeApprovalRequired, fmt.Sprintf("The send to %s needs approvals, use send", send.Destination))
+			return
+		}
+	}
+
+	results, err := hc.walletFor(r).SendBulk(dbWallet, bulkRequest.Source, sends, bulkRequest.BpowKey)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
+		return
+	}
+
+	resp := responses.SendBulkResponse{
+		Results: make([]responses.BulkSendResult, len(results)),
+	}
+	for i, result := range results {
+		resp.Results[i] = responses.BulkSendResult{
+			Destination: result.Destination,
+			Amount:      result.Amount,
+			ID:          result.ID,
+			Block:       result.Hash,
+		}
+		// Every send is audited like a send of its own
+		auditDetails := map[string]string{
+			"source":      bulkRequest.Source,
+			"destination": result.Destination,
+			"amount":      result.Amount,
+			"remote_addr": r.RemoteAddr,
+		}
+		if result.ID != nil {
+			auditDetails["id"] = *result.ID
+		}
+		if result.Err != nil {
+			resp.Results[i].Error = result.Err.Error()
+			resp.Results[i].ErrorCode = string(blockErrorCode(result.Err))
+			auditDetails["error"] = result.Err.Error()
+			resp.Failed++
+		} else {
+			auditDetails["block"] = result.Hash
+			resp.Sent++
+		}
+		hc.audit(r.Context(), "send_bulk", bulkRequest.Wallet, auditDetails)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle send_raw, publishing a block that was built and signed outside of Pippin
+func (hc *HttpController) HandleSendRawRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var rawBlockRequest requests.SendRawRequest
+	if err := mapstructure.Decode(rawRequest, &rawBlockRequest); err != nil {
+		log.Errorf("Error unmarshalling send_raw request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if rawBlockRequest.Wallet == "" || rawBlockRequest.Action == "" || rawBlockRequest.Block == nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// Audited like send, the block is what was published
+	auditDetails := map[string]string{
+		"account":     rawBlockRequest.Block.Account,
+		"previous":    rawBlockRequest.Block.Previous,
+		"balance":     rawBlockRequest.Block.Balance,
+		"link":        rawBlockRequest.Block.Link,
+		"remote_addr": r.RemoteAddr,
+	}
+	defer func() {
+		hc.audit(r.Context(), "send_raw", rawBlockRequest.Wallet, auditDetails)
+	}()
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(rawBlockRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		auditDetails["error"] = "wallet_frozen"
+		return
+	}
+
+	_, err := utils.AddressToPub(rawBlockRequest.Block.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		auditDetails["error"] = "invalid_account"
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid account %s", rawBlockRequest.Block.Account))
+		return
+	}
+
+	hash, err := hc.walletFor(r).PublishRawBlock(dbWallet, *rawBlockRequest.Block, rawBlockRequest.Work, rawBlockRequest.BpowKey)
+	if err != nil {
+		auditDetails["error"] = err.Error()
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
+		return
+	}
+	log.Infof("Published raw block %s for %s in wallet %s", hash, rawBlockRequest.Block.Account, rawBlockRequest.Wallet)
+	auditDetails["hash"] = hash
+
+	resp := responses.SendRawResponse{
+		Hash: hash,
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle signing a block that was built outside of Pippin with the key of its account, nothing is published
+// With block_create and send_raw it's the other half of offline signing, the block and its work come from somewhere else
+func (hc *HttpController) HandleSignBlockRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var signRequest requests.SignBlockRequest
+	if err := mapstructure.Decode(rawRequest, &signRequest); err != nil {
+		log.Errorf("Error unmarshalling sign_block request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if signRequest.Wallet == "" || signRequest.Action == "" || signRequest.Block == nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// Audited since the signed block can be published by anyone
+	auditDetails := map[string]string{
+		"account":     signRequest.Block.Account,
+		"previous":    signRequest.Block.Previous,
+		"balance":     signRequest.Block.Balance,
+		"link":        signRequest.Block.Link,
+		"remote_addr": r.RemoteAddr,
+	}
+	defer func() {
+		hc.audit(r.Context(), "sign_block", signRequest.Wallet, auditDetails)
+	}()
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(signRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		auditDetails["error"] = "wallet_frozen"
+		return
+	}
+
+	_, err := utils.AddressToPub(signRequest.Block.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		auditDetails["error"] = "invalid_account"
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid account %s", signRequest.Block.Account))
+		return
+	}
+
+	signed, err := hc.Wallet.SignBlock(dbWallet, *signRequest.Block)
+	if err != nil {
+		auditDetails["error"] = err.Error()
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
+		return
+	}
+	hash := signed.Hash()
+	auditDetails["hash"] = fmt.Sprintf("%X", hash)
+
+	resp := responses.SignBlockResponse{
+		Hash:  fmt.Sprintf("%X", hash),
+		Block: *signed,
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle sweeping accounts that aren't in Pippin into an account of a wallet
+// The source seeds are only used to sign, they are never saved
+func (hc *HttpController) HandleSweepToWalletRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var sweepRequest requests.SweepToWalletRequest
+	if err := mapstructure.Decode(rawRequest, &sweepRequest); err != nil {
+		log.Errorf("Error unmarshalling sweep_to_wallet request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if sweepRequest.Wallet == "" || sweepRequest.Action == "" || sweepRequest.DestinationAccount == "" || len(sweepRequest.Sources) == 0 {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	async, err := asyncRequested(sweepRequest.Async)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(sweepRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		return
+	}
+
+	// Validate destination
+	_, err = utils.AddressToPub(sweepRequest.DestinationAccount, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid destination account %s", sweepRequest.DestinationAccount))
+		return
+	}
+
+	// Validate sources
+	var sources []wallet.SweepSource
+	for _, source := range sweepRequest.Sources {
+		if source.Index == nil {
+			ErrUnableToParseJson(w, r)
+			return
+		} else if !utils.Validate64HexHash(source.Seed) {
+			ErrInvalidSeed(w, r)
+			return
+		}
+		index, err := utils.ToInt(*source.Index)
+		if err != nil || index < 0 || index > math.MaxUint32 {
+			ErrBadRequest(w, r, ErrorCodeInvalidIndex, "Invalid index")
+			return
+		}
+		sources = append(sources, wallet.SweepSource{
+			Seed:  source.Seed,
+			Index: index,
+		})
+	}
+
+	if async {
+		// Fails right away if the destination isn't in the wallet or the wallet is locked
+		_, err := hc.Wallet.GetAccount(dbWallet, sweepRequest.DestinationAccount)
+		if errors.Is(err, wallet.ErrWalletLocked) {
+			ErrWalletLocked(w, r)
+			return
+		} else if errors.Is(err, wallet.ErrAccountNotFound) {
+			ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+			return
+		} else if err != nil {
+			ErrInternalServerError(w, r, err.Error())
+			return
+		}
+		hc.startJob(dbWallet, "sweep_to_wallet", func(progress wallet.JobProgress) (interface{}, error) {
+			resp := &responses.SweepToWalletResponse{
+				Blocks: []string{},
+			}
+			for i, source := range sources {
+				hashes, err := hc.Wallet.SweepToWallet(dbWallet, sweepRequest.DestinationAccount, []wallet.SweepSource{source}, sweepRequest.BpowKey)
+				resp.Blocks = append(resp.Blocks, hashes...)
+				if err != nil {
+					return resp, err
+				}
+				progress((i+1)*100/len(sources), resp)
+			}
+			return resp, nil
+		}, w, r)
+		return
+	}
+
+	hashes, err := hc.Wallet.SweepToWallet(dbWallet, sweepRequest.DestinationAccount, sources, sweepRequest.BpowKey)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	} else if err != nil {
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
+		return
+	}
+
+	resp := responses.SweepToWalletResponse{
+		Blocks: hashes,
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle cross_wallet_transfer, move everything in one wallet to an account of another
+// The destination receives the sends right away, Pippin has the keys of both wallets
+func (hc *HttpController) HandleCrossWalletTransferRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var transferRequest requests.CrossWalletTransferRequest
+	if err := mapstructure.Decode(rawRequest, &transferRequest); err != nil {
+		log.Errorf("Error unmarshalling cross_wallet_transfer request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if transferRequest.Action == "" || transferRequest.SourceWallet == "" || transferRequest.DestinationWallet == "" || transferRequest.DestinationAccount == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallets exist
+	sourceWallet := hc.WalletExists(transferRequest.SourceWallet, w, r)
+	if sourceWallet == nil {
+		return
+	}
+	destinationWallet := hc.WalletExists(transferRequest.DestinationWallet, w, r)
+	if destinationWallet == nil {
+		return
+	}
+	if !hc.WalletNotFrozen(sourceWallet, w, r) || !hc.WalletNotFrozen(destinationWallet, w, r) {
+		return
+	}
+
+	_, err := utils.AddressToPub(transferRequest.DestinationAccount, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid destination account %s", transferRequest.DestinationAccount))
+		return
+	}
+
+	transfer, err := hc.walletFor(r).CrossWalletTransfer(sourceWallet, destinationWallet, transferRequest.DestinationAccount, transferRequest.BpowKey)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrSameWallet) {
+		ErrBadRequest(w, r, ErrorCodeSameWallet, "Source and destination wallets are the same")
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	} else if err != nil {
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
+		return
+	}
+
+	resp := responses.CrossWalletTransferResponse{
+		SourceReceives:      transfer.SourceReceives,
+		Sends:               transfer.Sends,
+		DestinationReceives: transfer.DestinationReceives,
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle wallet_sweep, receive everything pending on every account of a wallet and send it all to one destination
+// With dry_run nothing is published, it only returns what would be moved
+func (hc *HttpController) HandleWalletSweepRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var sweepRequest requests.WalletSweepRequest
+	if err := mapstructure.Decode(rawRequest, &sweepRequest); err != nil {
+		log.Errorf("Error unmarshalling wallet_sweep request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if sweepRequest.Wallet == "" || sweepRequest.Action == "" || sweepRequest.Destination == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	dryRun := false
+	if sweepRequest.DryRun != nil {
+		var err error
+		dryRun, err = utils.ToBool(*sweepRequest.DryRun)
+		if err != nil {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+	async, err := asyncRequested(sweepRequest.Async)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(sweepRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+	if !dryRun && !hc.WalletNotFrozen(dbWallet, w, r) {
+		return
+	}
+
+	// Validate destination
+	_, err = utils.AddressToPub(sweepRequest.Destination, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid destination %s", sweepRequest.Destination))
+		return
+	}
+
+	nanoWallet := hc.walletFor(r)
+	if async && !dryRun {
+		// Fails right away if the wallet is locked
+		if _, err := wallet.GetDecryptedKeyFromStorage(dbWallet, "seed"); errors.Is(err, wallet.ErrWalletLocked) {
+			ErrWalletLocked(w, r)
+			return
+		}
+		hc.startJob(dbWallet, "wallet_sweep", func(progress wallet.JobProgress) (interface{}, error) {
+			sweep, err := nanoWallet.WalletSweep(dbWallet, sweepRequest.Destination, false, sweepRequest.BpowKey, func(done int, total int, sweep *walletmodels.WalletSweep) {
+				progress(done*100/total, walletSweepResponse(sweepRequest.Destination, false, sweep))
+			})
+			if sweep == nil {
+				return nil, err
+			}
+			return walletSweepResponse(sweepRequest.Destination, false, sweep), err
+		}, w, r)
+		return
+	}
+
+	sweep, err := nanoWallet.WalletSweep(dbWallet, sweepRequest.Destination, dryRun, sweepRequest.BpowKey, nil)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, walletSweepResponse(sweepRequest.Destination, dryRun, sweep))
+}
+
+func walletSweepResponse(destination string, dryRun bool, sweep *walletmodels.WalletSweep) *responses.WalletSweepResponse {
+	resp := &responses.WalletSweepResponse{
+		Destination: destination,
+		DryRun:      dryRun,
+		TotalRaw:    sweep.TotalRaw,
+		Accounts:    []responses.WalletSweepAccount{},
+	}
+	for _, swept := range sweep.Accounts {
+		account := responses.WalletSweepAccount{
+			Account:    swept.Address,
+			BalanceRaw: swept.BalanceRaw,
+			PendingRaw: swept.PendingRaw,
+			Receives:   swept.Receives,
+		}
+		if swept.Send != "" {
+			send := swept.Send
+			account.Send = &send
+		}
+		resp.Accounts = append(resp.Accounts, account)
+	}
+	return resp
+}
+
+// Handle rep change
+func (hc *HttpController) HandleAccountRepresentativeSetRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var changeRequest requests.AccountRepresentativeSetRequest
+	if err := mapstructure.Decode(rawRequest, &changeRequest); err != nil {
+		log.Errorf("Error unmarshalling representative set request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if changeRequest.Wallet == "" || changeRequest.Action == "" || changeRequest.Representative == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(changeRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		return
+	}
+
+	// Validate accounts
+	_, err := utils.AddressToPub(changeRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, "Invalid account")
+		return
+	}
+	_, err = utils.AddressToPub(changeRequest.Representative, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidRepresentative, "Invalid representative account")
+		return
+	}
+
+	if changeRequest.Preview != nil && *changeRequest.Preview {
+		preview, err := hc.Wallet.PreviewChangeBlock(dbWallet, changeRequest.Account, changeRequest.Representative, changeRequest.Work)
+		hc.renderBlockPreview(preview, err, w, r)
+		return
+	}
+
+	// Do the send
+	resp, err := hc.walletFor(r).CreateAndPublishChangeBlock(dbWallet, changeRequest.Account, changeRequest.Representative, changeRequest.Work, changeRequest.BpowKey, false)
+	if err != nil {
+		ErrBadRequest(w, r, blockErrorCode(err), err.Error())
+		return
+	}
+	hc.forgetRepresentatives(changeRequest.Account)
+
+	blockResponse := responses.BlockResponse{
+		Block: resp,
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &blockResponse)
+}
+
+// Handle changing the representative of every account in a wallet
+// Accounts that already have the representative are skipped, if some accounts fail the rest are still changed
+func (hc *HttpController) HandleAccountsRepresentativeSetRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var changeRequest requests.AccountsRepresentativeSetRequest
+	if err := mapstructure.Decode(rawRequest, &changeRequest); err != nil {
+		log.Errorf("Error unmarshalling accounts_representative_set request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if changeRequest.Wallet == "" || changeRequest.Action == "" || changeRequest.Representative == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(changeRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		return
+	}
+
+	// Validate account
+	_, err := utils.AddressToPub(changeRequest.Representative, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidRepresentative, "Invalid representative account")
+		return
+	}
+
+	// Every account would fail if the wallet is locked
+	if _, err := wallet.GetDecryptedKeyFromStorage(dbWallet, "seed"); errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	}
+
+	changes, err := hc.Wallet.AccountsRepresentativeSet(dbWallet, changeRequest.Representative, changeRequest.BpowKey)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.AccountsRepresentativeSetResponse{
+		Changed: []responses.RepresentativeChange{},
+		Skipped: changes.Skipped,
+		Failed:  changes.Failed,
+	}
+	for _, change := range changes.Changed {
+		hc.forgetRepresentatives(change.Account)
+		resp.Changed = append(resp.Changed, responses.RepresentativeChange{
+			Account:   change.Account,
+			BlockHash: change.BlockHash,
+		})
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle block_confirm, forwarded to the node at most once per hash every block_confirm_interval seconds
+func (hc *HttpController) HandleBlockConfirmRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var confirmRequest requests.BlockConfirmRequest
+	if err := mapstructure.Decode(rawRequest, &confirmRequest); err != nil {
+		log.Errorf("Error unmarshalling block_confirm request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if confirmRequest.Action == "" || confirmRequest.Hash == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// Only lowercase hex hashes are accepted
+	if !utils.Validate64HexHash(confirmRequest.Hash) || strings.ToLower(confirmRequest.Hash) != confirmRequest.Hash {
+		ErrInvalidHash(w, r)
+		return
+	}
+
+	// Every confirm request makes the node rebroadcast the block, don't let clients spam the network
+	allowed, err := database.GetRedisDB().SetNX(fmt.Sprintf("block_confirm:%s", confirmRequest.Hash), "1", hc.BlockConfirmInterval())
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	} else if !allowed {
+		ErrRateLimited(w, r)
+		return
+	}
+
+	resp, err := hc.RpcClient.MakeRequest(confirmRequest)
+	if err != nil {
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
+
+// How long block_rebroadcast for a hash is refused after it's been called
+const blockRebroadcastInterval = 30 * time.Second
+
+// Handle block_rebroadcast, publish a block from the node's ledger again if it isn't confirmed yet
+func (hc *HttpController) HandleBlockRebroadcastRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var rebroadcastRequest requests.BlockRebroadcastRequest
+	if err := mapstructure.Decode(rawRequest, &rebroadcastRequest); err != nil {
+		log.Errorf("Error unmarshalling block_rebroadcast request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if rebroadcastRequest.Action == "" || rebroadcastRequest.Hash == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	if !utils.Validate64HexHash(rebroadcastRequest.Hash) {
+		ErrInvalidHash(w, r)
+		return
+	}
+	hash := strings.ToUpper(rebroadcastRequest.Hash)
+
+	allowed, err := database.GetRedisDB().SetNX(fmt.Sprintf("block_rebroadcast:%s", hash), "1", blockRebroadcastInterval)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	} else if !allowed {
+		ErrRateLimited(w, r)
+		return
+	}
+
+	blockInfo, err := hc.RpcClient.MakeBlockInfoRequest(hash)
+	if errors.Is(err, rpc.ErrBlockNotFound) {
+		ErrBadRequest(w, r, ErrorCodeBlockNotFound, "Block not found")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, "Error making block_info request")
+		return
+	}
+
+	// The block is confirmed once the account's confirmation height reaches it
+	accountInfo, err := hc.RpcClient.MakeAccountInfoRequest(blockInfo.BlockAccount)
+	if err != nil {
+		ErrInternalServerError(w, r, "Error making account_info request")
+		return
+	}
+	height, err := strconv.ParseUint(blockInfo.Height, 10, 64)
+	if err != nil {
+		ErrInternalServerError(w, r, "Invalid height in block_info response")
+		return
+	}
+	// The node calls it confirmation_height, or confirmed_height with include_confirmed
+	confirmed := accountInfo.ConfirmationHeight
+	if confirmed == "" {
+		confirmed = accountInfo.ConfirmedHeight
+	}
+	confirmationHeight, err := strconv.ParseUint(confirmed, 10, 64)
+	if err != nil {
+		ErrInternalServerError(w, r, "Invalid confirmation_height in account_info response")
+		return
+	}
+	if height <= confirmationHeight {
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, &responses.BlockAlreadyConfirmedResponse{
+			Error:     "already_confirmed",
+			ErrorCode: string(ErrorCodeAlreadyConfirmed),
+			Hash:      hash,
+		})
+		return
+	}
+
+	sb := blockInfo.Contents
+	sb.Banano = hc.Wallet.Config.Wallet.Banano
+	subtype := blockInfo.Subtype
+	resp, err := hc.RpcClient.MakeProcessRequest(rpcrequests.ProcessRequest{
+		BaseRequest: rpcrequests.BaseRequest{
+			Action: "process",
+		},
+		Subtype:   &subtype,
+		JsonBlock: true,
+		Block:     sb,
+	})
+	if err != nil {
+		ErrInternalServerError(w, r, fmt.Sprintf("Error making process request %s", err))
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.BlockRebroadcastResponse{
+		Hash: resp.Hash,
+	})
+}
+
+// An unconfirmed send_confirmation_poll is reused for this long, a confirmed one forever
+const sendConfirmationPollCacheTTL = 1 * time.Second
+
+// Handle send_confirmation_poll, whether a sent block is confirmed, so clients can poll pippin instead of the node
+func (hc *HttpController) HandleSendConfirmationPollRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var pollRequest requests.SendConfirmationPollRequest
+	if err := mapstructure.Decode(rawRequest, &pollRequest); err != nil {
+		log.Errorf("Error unmarshalling send_confirmation_poll request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if pollRequest.Action == "" || pollRequest.Hash == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	if !utils.Validate64HexHash(pollRequest.Hash) {
+		ErrInvalidHash(w, r)
+		return
+	}
+	hash := strings.ToUpper(pollRequest.Hash)
+
+	cacheKey := fmt.Sprintf("send_confirmation_poll:%s", hash)
+	var resp responses.SendConfirmationPollResponse
+	if cached, err := hc.Cache.Get(cacheKey); err == nil && json.Unmarshal(cached, &resp) == nil {
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, &resp)
+		return
+	}
+
+	blockInfo, err := hc.RpcClient.MakeBlockInfoRequest(hash)
+	if errors.Is(err, rpc.ErrBlockNotFound) {
+		ErrBadRequest(w, r, ErrorCodeBlockNotFound, "Block not found")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, "Error making block_info request")
+		return
+	}
+
+	// The node only knows local_timestamp, 0 for blocks it got while bootstrapping
+	timestamp, _ := strconv.ParseInt(blockInfo.LocalTimestamp, 10, 64)
+	resp = responses.SendConfirmationPollResponse{
+		Confirmed: blockInfo.Confirmed == "true",
+		Timestamp: timestamp,
+	}
+	ttl := sendConfirmationPollCacheTTL
+	if resp.Confirmed {
+		resp.Confirmations = 1
+		ttl = 0
+	}
+	if encoded, err := json.Marshal(resp); err == nil {
+		if err := hc.Cache.Set(cacheKey, encoded, ttl); err != nil {
+			log.Errorf("Error caching send_confirmation_poll %s", err)
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Hash the node uses for no block, the successor of a frontier and the previous of an open block
+const zeroBlockHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// Handle block_successor, the next block in the account chain of block
+func (hc *HttpController) HandleBlockSuccessorRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	hash, blockInfo, walletAccount := hc.adjacentBlock(rawRequest, w, r)
+	if blockInfo == nil {
+		return
+	}
+
+	resp := responses.BlockSuccessorResponse{
+		Block:         hash,
+		Account:       blockInfo.BlockAccount,
+		WalletAccount: walletAccount,
+	}
+	if blockInfo.Successor != "" && blockInfo.Successor != zeroBlockHash {
+		resp.Successor = &blockInfo.Successor
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle block_predecessor, the previous block in the account chain of block
+func (hc *HttpController) HandleBlockPredecessorRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	hash, blockInfo, walletAccount := hc.adjacentBlock(rawRequest, w, r)
+	if blockInfo == nil {
+		return
+	}
+
+	resp := responses.BlockPredecessorResponse{
+		Block:         hash,
+		Account:       blockInfo.BlockAccount,
+		WalletAccount: walletAccount,
+	}
+	if blockInfo.Contents.Previous != "" && blockInfo.Contents.Previous != zeroBlockHash {
+		resp.Predecessor = &blockInfo.Contents.Previous
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// The hash and block_info of the block in a block_successor or block_predecessor request, and the wallet of its account if it's in one
+// Writes the error response and returns a nil block_info if there isn't one
+func (hc *HttpController) adjacentBlock(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) (string, *rpcresponses.BlockInfoResponse, *responses.BlockWalletAccount) {
+	var adjacentRequest requests.AdjacentBlockRequest
+	if err := mapstructure.Decode(rawRequest, &adjacentRequest); err != nil {
+		log.Errorf("Error unmarshalling adjacent block request %s", err)
+		ErrUnableToParseJson(w, r)
+		return "", nil, nil
+	} else if adjacentRequest.Action == "" || adjacentRequest.Block == "" {
+		ErrUnableToParseJson(w, r)
+		return "", nil, nil
+	}
+
+	if !utils.Validate64HexHash(adjacentRequest.Block) {
+		ErrInvalidHash(w, r)
+		return "", nil, nil
+	}
+	hash := strings.ToUpper(adjacentRequest.Block)
+
+	blockInfo, err := hc.RpcClient.MakeBlockInfoRequest(hash)
+	if errors.Is(err, rpc.ErrBlockNotFound) {
+		ErrBadRequest(w, r, ErrorCodeBlockNotFound, "Block not found")
+		return "", nil, nil
+	} else if err != nil {
+		ErrInternalServerError(w, r, "Error making block_info request")
+		return "", nil, nil
+	}
+
+	// Link the chain to the wallet the account is in, the same as account_info does
+	var walletAccount *responses.BlockWalletAccount
+	acc, err := hc.Wallet.GetAccountByAddress(blockInfo.BlockAccount)
+	if err == nil {
+		walletAccount = &responses.BlockWalletAccount{
+			Wallet: acc.WalletID.String(),
+			Index:  acc.AccountIndex,
+		}
+	} else if !errors.Is(err, wallet.ErrAccountNotFound) {
+		log.Errorf("Error looking up account for %s %s", adjacentRequest.Action, err)
+	}
+
+	return hash, blockInfo, walletAccount
+}
+
+// receivable_exists is reused for this long
+const receivableExistsCacheTTL = 5 * time.Second
+
+// How many confirmed blocks the accounts can receive of at least threshold, nil is any amount
+// Without a threshold every account is first asked for a single block, so nothing to receive is found quickly
+func (hc *HttpController) receivableCount(accounts []string, threshold *big.Int) (int, error) {
+	first := 0
+	if threshold == nil {
+		for ; first < len(accounts); first++ {
+			probe, err := hc.RpcClient.MakeReceivableRequestWithCount(accounts[first], "", 1)
+			if err != nil {
+				return 0, err
+			}
+			if len(probe.Blocks) > 0 {
+				break
+			}
+		}
+	}
+
+	thresholdRaw := ""
+	if threshold != nil {
+		thresholdRaw = threshold.String()
+	}
+	count := 0
+	// The accounts before first have nothing to receive
+	for _, account := range accounts[first:] {
+		receivable, err := hc.RpcClient.MakeReceivableRequest(account, thresholdRaw)
+		if err != nil {
+			return 0, err
+		}
+		count += len(receivable.Blocks)
+	}
+	return count, nil
+}
+
+// Handle receivable_exists, whether a wallet or account has anything to receive of at least threshold_raw, and how many blocks
+// With only a hash it's the node's receivable_exists, so that's forwarded
+func (hc *HttpController) HandleReceivableExistsRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var existsRequest requests.ReceivableExistsRequest
+	if err := mapstructure.Decode(rawRequest, &existsRequest); err != nil {
+		log.Errorf("Error unmarshalling receivable_exists request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if existsRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	if existsRequest.Hash != "" && existsRequest.Wallet == "" && existsRequest.Account == "" {
+		hc.forwardToNode(*rawRequest, w, r)
+		return
+	}
+	if (existsRequest.Wallet == "") == (existsRequest.Account == "") {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	var threshold *big.Int
+	if existsRequest.ThresholdRaw != nil {
+		parsed, ok := big.NewInt(0).SetString(*existsRequest.ThresholdRaw, 10)
+		if !ok || parsed.Sign() < 0 {
+			ErrBadRequest(w, r, ErrorCodeInvalidAmount, "Invalid threshold_raw")
+			return
+		}
+		// 0 is the same as no threshold
+		if parsed.Sign() > 0 {
+			threshold = parsed
+		}
+	}
+
+	var cacheKey string
+	var accounts []string
+	if existsRequest.Wallet != "" {
+		// See if wallet exists
+		dbWallet := hc.WalletExists(existsRequest.Wallet, w, r)
+		if dbWallet == nil {
+			return
+		}
+		_, walletAccounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+		if err != nil {
+			ErrInternalServerError(w, r, err.Error())
+			return
+		}
+		cacheKey = fmt.Sprintf("receivable_exists:%s", dbWallet.ID)
+		accounts = walletAccounts
+	} else {
+		pub, err := utils.AddressToPub(existsRequest.Account, hc.Wallet.Config.Wallet.Banano)
+		if err != nil {
+			ErrInvalidAccount(w, r)
+			return
+		}
+		cacheKey = fmt.Sprintf("receivable_exists:%X", pub)
+		accounts = []string{existsRequest.Account}
+	}
+	if threshold != nil {
+		cacheKey = fmt.Sprintf("%s:%s", cacheKey, threshold)
+	}
+
+	var resp responses.ReceivableExistsResponse
+	if cached, err := hc.Cache.Get(cacheKey); err == nil && json.Unmarshal(cached, &resp) == nil {
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, &resp)
+		return
+	}
+
+	count, err := hc.receivableCount(accounts, threshold)
+	if err != nil {
+		log.Errorf("Error getting receivable from node %s", err)
+		ErrInternalServerError(w, r, "Error making receivable request")
+		return
+	}
+	resp = responses.ReceivableExistsResponse{
+		HasReceivable: count > 0,
+		Count:         count,
+	}
+	if encoded, err := json.Marshal(resp); err == nil {
+		if err := hc.Cache.Set(cacheKey, encoded, receivableExistsCacheTTL); err != nil {
+			log.Errorf("Error caching receivable_exists %s", err)
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle pending_exists, whether hash is a send to account that hasn't been received yet
+func (hc *HttpController) HandlePendingExistsRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var pendingRequest requests.PendingExistsRequest
+	if err := mapstructure.Decode(rawRequest, &pendingRequest); err != nil {
+		log.Errorf("Error unmarshalling pending_exists request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if pendingRequest.Action == "" || pendingRequest.Account == "" || pendingRequest.Hash == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	pub, err := utils.AddressToPub(pendingRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+	if !utils.Validate64HexHash(pendingRequest.Hash) {
+		ErrInvalidHash(w, r)
+		return
+	}
+
+	exists, err := hc.RpcClient.MakeReceivableExistsRequest(pendingRequest.Hash)
+	if errors.Is(err, rpc.ErrBlockNotFound) {
+		exists = false
+	} else if err != nil {
+		ErrInternalServerError(w, r, "Error making receivable_exists request")
+		return
+	}
+
+	resp := responses.PendingExistsResponse{}
+	if exists {
+		// The node only knows the block is receivable, make sure it's receivable by this account
+		blockInfo, err := hc.RpcClient.MakeBlockInfoRequest(pendingRequest.Hash)
+		if err != nil {
+			ErrInternalServerError(w, r, "Error making block_info request")
+			return
+		}
+		if blockInfo.Subtype == "send" && strings.EqualFold(blockInfo.Contents.Link, hex.EncodeToString(pub)) {
+			resp.Exists = true
+			resp.AmountRaw = &blockInfo.Amount
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
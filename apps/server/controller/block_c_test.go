@@ -2,17 +2,25 @@ package controller
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io"
+	"math"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/cache"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
 	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
@@ -98,7 +106,7 @@ func TestReceive(t *testing.T) {
 	respBody, _ = io.ReadAll(resp.Body)
 	json.Unmarshal(respBody, &rawResp)
 
-	assert.Equal(t, "Invalid account", rawResp["error"])
+	assert.Equal(t, "INVALID_ACCOUNT", rawResp["error_code"])
 }
 
 func TestSend(t *testing.T) {
@@ -186,7 +194,7 @@ func TestSend(t *testing.T) {
 	respBody, _ = io.ReadAll(resp.Body)
 	json.Unmarshal(respBody, &rawResp)
 
-	assert.Equal(t, "Invalid destination account ban_1234", rawResp["error"])
+	assert.Equal(t, "INVALID_ACCOUNT", rawResp["error_code"])
 
 	// Request JSON
 	reqBody = map[string]interface{}{
@@ -211,7 +219,429 @@ func TestSend(t *testing.T) {
 	respBody, _ = io.ReadAll(resp.Body)
 	json.Unmarshal(respBody, &rawResp)
 
-	assert.Equal(t, "Invalid source account ban_1234", rawResp["error"])
+	assert.Equal(t, "INVALID_ACCOUNT", rawResp["error_code"])
+}
+
+func TestBlockPreview(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processed := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			var js map[string]interface{}
+			if pr.Action == "block_info" {
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "account_info" {
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "process" {
+				processed++
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("b0d3a6c9f2e5b8d1a4c7f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e7b0d3"))
+	wallet, err := hc.Wallet.WalletCreate(newSeed)
+	assert.Nil(t, err)
+	acc, err := hc.Wallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	doPreview := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		reqBody["wallet"] = wallet.ID.String()
+		reqBody["preview"] = true
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doPreview(map[string]interface{}{
+		"action":      "send",
+		"source":      acc.Address,
+		"destination": "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj",
+		"amount":      "1000000000000000000000000000000",
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, true, respJson["preview"])
+	assert.Equal(t, "send", respJson["subtype"])
+	assert.Equal(t, "1000000000000000000000000000000", respJson["amount"])
+	assert.Equal(t, "11999998999999999918751838129509869131", respJson["balance"])
+	assert.Equal(t, "fffffff800000000", respJson["difficulty"])
+	assert.Equal(t, true, respJson["signed"])
+	block := respJson["block"].(map[string]interface{})
+	assert.Equal(t, acc.Address, block["account"])
+	assert.Equal(t, "", block["work"])
+	assert.NotEqual(t, "", block["signature"])
+	assert.Len(t, respJson["hash"], 64)
+
+	status, respJson = doPreview(map[string]interface{}{
+		"action":  "receive",
+		"account": acc.Address,
+		"block":   "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE",
+		"work":    "0000000000000000",
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "receive", respJson["subtype"])
+	assert.Equal(t, "30000000000000000000000000000000000", respJson["amount"])
+	assert.Equal(t, "fffffe0000000000", respJson["difficulty"])
+	assert.Equal(t, "0000000000000000", respJson["block"].(map[string]interface{})["work"])
+
+	status, respJson = doPreview(map[string]interface{}{
+		"action":         "account_representative_set",
+		"account":        acc.Address,
+		"representative": "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj",
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "change", respJson["subtype"])
+	assert.NotContains(t, respJson, "amount")
+	assert.Equal(t, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", respJson["block"].(map[string]interface{})["representative"])
+
+	// The amount in nano
+	status, respJson = doPreview(map[string]interface{}{
+		"action":      "send",
+		"source":      acc.Address,
+		"destination": "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj",
+		"amount":      "0.5",
+		"unit":        "nano",
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "500000000000000000000000000000", respJson["amount"])
+	status, respJson = doPreview(map[string]interface{}{
+		"action":      "send",
+		"source":      acc.Address,
+		"destination": "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj",
+		"amount":      "1",
+		"unit":        "banano",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_UNIT", respJson["error_code"])
+	status, respJson = doPreview(map[string]interface{}{
+		"action":      "send",
+		"source":      acc.Address,
+		"destination": "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj",
+		"amount":      "0.5",
+		"unit":        "raw",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_AMOUNT", respJson["error_code"])
+
+	// A work difficulty that can't be generated
+	status, respJson = doPreview(map[string]interface{}{
+		"action":      "send",
+		"source":      acc.Address,
+		"destination": "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj",
+		"amount":      "1",
+		"multiplier":  0.5,
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_DIFFICULTY", respJson["error_code"])
+	status, respJson = doPreview(map[string]interface{}{
+		"action":      "send",
+		"source":      acc.Address,
+		"destination": "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj",
+		"amount":      "1",
+		"multiplier":  2,
+		"difficulty":  "fffffffc00000000",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_DIFFICULTY", respJson["error_code"])
+	status, respJson = doPreview(map[string]interface{}{
+		"action":     "receive",
+		"account":    acc.Address,
+		"block":      "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE",
+		"difficulty": "notadifficulty",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_DIFFICULTY", respJson["error_code"])
+
+	// Errors are the same as without preview
+	status, respJson = doPreview(map[string]interface{}{
+		"action":      "send",
+		"source":      acc.Address,
+		"destination": "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj",
+		"amount":      "12000000000000000000000000000000000000",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INSUFFICIENT_BALANCE", respJson["error_code"])
+
+	assert.Equal(t, 0, processed)
+}
+
+func TestSendUnopenedDestination(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	unopened := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	processCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "account_info":
+				if pr["account"] == unopened {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Account not found"})
+				}
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "process":
+				processCalls++
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("3c9e1a7f5b2d8e4a6c0f9b3d7e1a5c8f2b6d0e4a9c3f7b1d5e8a2c6f0b4d9e3a"))
+	wallet, err := MockController.Wallet.WalletCreate(newSeed)
+	assert.Nil(t, err)
+	acc, err := MockController.Wallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	doSend := func(reqBody map[string]interface{}) (int, []byte) {
+		reqBody["action"] = "send"
+		reqBody["wallet"] = wallet.ID.String()
+		reqBody["source"] = acc.Address
+		reqBody["amount"] = "1000000000000000000000000000000"
+		reqBody["work"] = "0000000000000000"
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	// Sent, with a warning
+	status, body := doSend(map[string]interface{}{"destination": unopened})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"block":"E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3","destination_unopened":true}`, strings.TrimSpace(string(body)))
+	assert.Equal(t, 1, processCalls)
+
+	// Opened accounts don't get the flag
+	status, body = doSend(map[string]interface{}{"destination": acc.Address, "allow_unopened": false})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"block":"E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3"}`, strings.TrimSpace(string(body)))
+	assert.Equal(t, 2, processCalls)
+
+	// Refused
+	status, body = doSend(map[string]interface{}{"destination": unopened, "allow_unopened": false})
+	assert.Equal(t, 400, status)
+	var errJson map[string]interface{}
+	json.Unmarshal(body, &errJson)
+	assert.Equal(t, "DESTINATION_UNOPENED", errJson["error_code"])
+	assert.Equal(t, 2, processCalls)
+}
+
+func TestCrossWalletTransfer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	sourceSeed, _ := utils.GenerateSeed(strings.NewReader("1e4a7d0c3f6b9e2a5d8c1f4b7e0a3d6c9f2b5e8a1d4c7f0b3e6a9d2c5f8b1e4a"))
+	source, _ := hc.Wallet.WalletCreate(sourceSeed)
+	sourceAccounts, _, _ := hc.Wallet.AccountsList(source, 0)
+	destinationSeed, _ := utils.GenerateSeed(strings.NewReader("a9d2c5f8b1e4a7d0c3f6b9e2a5d8c1f4b7e0a3d6c9f2b5e8a1d4c7f0b3e6a9d2"))
+	destination, _ := hc.Wallet.WalletCreate(destinationSeed)
+	destinationAccounts, _, _ := hc.Wallet.AccountsList(destination, 0)
+	sourceAcc := sourceAccounts[0].Address
+	destinationAcc := destinationAccounts[0].Address
+
+	// Both accounts are opened, the source has 10 raw and nothing pending
+	balances := map[string]string{sourceAcc: "10", destinationAcc: "5"}
+	var published []map[string]interface{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "receivable":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": ""})
+			case "account_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        balances[pr["account"].(string)],
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			case "block_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"amount": "10", "subtype": "send", "contents": map[string]interface{}{}})
+			case "process":
+				block := pr["block"].(map[string]interface{})
+				published = append(published, block)
+				balances[block["account"].(string)] = block["balance"].(string)
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", len(published)),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	doTransfer := func(reqBody map[string]interface{}) (int, []byte) {
+		reqBody["action"] = "cross_wallet_transfer"
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	status, body := doTransfer(map[string]interface{}{"source_wallet": source.ID.String(), "destination_wallet": destination.ID.String(), "destination_account": destinationAcc})
+	assert.Equal(t, 200, status)
+	var resp responses.CrossWalletTransferResponse
+	json.Unmarshal(body, &resp)
+	assert.Equal(t, responses.CrossWalletTransferResponse{
+		SourceReceives:      []string{},
+		Sends:               []string{fmt.Sprintf("%064X", 1)},
+		DestinationReceives: []string{fmt.Sprintf("%064X", 2)},
+	}, resp)
+	assert.Len(t, published, 2)
+	assert.Equal(t, "0", balances[sourceAcc])
+	assert.Equal(t, "15", balances[destinationAcc])
+	assert.Equal(t, fmt.Sprintf("%064X", 1), published[1]["link"])
+
+	// errors
+	var errJson map[string]interface{}
+	status, body = doTransfer(map[string]interface{}{"source_wallet": destination.ID.String(), "destination_wallet": destination.ID.String(), "destination_account": destinationAcc})
+	assert.Equal(t, 400, status)
+	json.Unmarshal(body, &errJson)
+	assert.Equal(t, "SAME_WALLET", errJson["error_code"])
+	status, body = doTransfer(map[string]interface{}{"source_wallet": destination.ID.String(), "destination_wallet": source.ID.String(), "destination_account": destinationAcc})
+	assert.Equal(t, 400, status)
+	json.Unmarshal(body, &errJson)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", errJson["error_code"])
+	status, _ = doTransfer(map[string]interface{}{"source_wallet": source.ID.String(), "destination_wallet": destination.ID.String()})
+	assert.Equal(t, 400, status)
+	assert.Len(t, published, 2)
+}
+
+func TestWalletSweep(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	seed, _ := utils.GenerateSeed(strings.NewReader("6d9c2f5b8e1a4d7c0f3b6e9a2d5c8f1b4e7a0d3c6f9b2e5a8d1c4f7b0e3a6d9c"))
+	sweepWallet, _ := hc.Wallet.WalletCreate(seed)
+	accounts, _, _ := hc.Wallet.AccountsList(sweepWallet, 0)
+	funded := accounts[0].Address
+	destination := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+
+	// Opened with 10 raw and nothing pending
+	balances := map[string]string{funded: "10"}
+	var published []map[string]interface{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "accounts_balances":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"balances": map[string]interface{}{funded: map[string]string{"balance": balances[funded], "pending": "0", "receivable": "0"}},
+				})
+			case "receivable":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": ""})
+			case "account_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        balances[pr["account"].(string)],
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			case "process":
+				block := pr["block"].(map[string]interface{})
+				published = append(published, block)
+				balances[block["account"].(string)] = block["balance"].(string)
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", len(published)),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	doSweep := func(reqBody map[string]interface{}) (int, []byte) {
+		reqBody["action"] = "wallet_sweep"
+		reqBody["wallet"] = sweepWallet.ID.String()
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	// Nothing is published on a dry run
+	status, body := doSweep(map[string]interface{}{"destination": destination, "dry_run": true})
+	assert.Equal(t, 200, status)
+	var resp responses.WalletSweepResponse
+	json.Unmarshal(body, &resp)
+	assert.Equal(t, responses.WalletSweepResponse{
+		Destination: destination,
+		DryRun:      true,
+		TotalRaw:    "10",
+		Accounts:    []responses.WalletSweepAccount{{Account: funded, BalanceRaw: "10", PendingRaw: "0", Receives: []string{}}},
+	}, resp)
+	assert.Len(t, published, 0)
+
+	// Held to the approval threshold like a send
+	_, err := hc.Wallet.SetApprovalPolicy(sweepWallet, 1, "5")
+	assert.Nil(t, err)
+	status, body = doSweep(map[string]interface{}{"destination": destination})
+	assert.Equal(t, 400, status)
+	var errJson map[string]interface{}
+	json.Unmarshal(body, &errJson)
+	assert.Equal(t, "APPROVAL_REQUIRED", errJson["error_code"])
+	assert.Len(t, published, 0)
+	_, err = hc.Wallet.SetApprovalPolicy(sweepWallet, 0, "")
+	assert.Nil(t, err)
+
+	status, body = doSweep(map[string]interface{}{"destination": destination})
+	assert.Equal(t, 200, status)
+	resp = responses.WalletSweepResponse{}
+	json.Unmarshal(body, &resp)
+	assert.False(t, resp.DryRun)
+	assert.Len(t, resp.Accounts, 1)
+	assert.Equal(t, fmt.Sprintf("%064X", 1), *resp.Accounts[0].Send)
+	assert.Len(t, published, 1)
+	assert.Equal(t, "0", balances[funded])
+
+	// errors
+	status, body = doSweep(map[string]interface{}{"destination": "nano_notanaccount"})
+	assert.Equal(t, 400, status)
+	json.Unmarshal(body, &errJson)
+	assert.Equal(t, "INVALID_ACCOUNT", errJson["error_code"])
+	status, _ = doSweep(map[string]interface{}{"destination": destination, "dry_run": "maybe"})
+	assert.Equal(t, 400, status)
+	assert.Len(t, published, 1)
 }
 
 func TestAccountRepresentativeSet(t *testing.T) {
@@ -249,6 +679,8 @@ func TestAccountRepresentativeSet(t *testing.T) {
 	assert.Nil(t, err)
 	acc, err := MockController.Wallet.AccountCreate(wallet, nil)
 	assert.Nil(t, err)
+	// Cached by account_representative
+	MockController.Cache.Set(accountRepresentativeKey(acc.Address), []byte("nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"), time.Minute)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"action":         "account_representative_set",
@@ -272,6 +704,8 @@ func TestAccountRepresentativeSet(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", respJson.Block)
+	_, err = MockController.Cache.Get(accountRepresentativeKey(acc.Address))
+	assert.ErrorIs(t, err, cache.ErrCacheMiss)
 
 	// errors
 
@@ -297,7 +731,7 @@ func TestAccountRepresentativeSet(t *testing.T) {
 	respBody, _ = io.ReadAll(resp.Body)
 	json.Unmarshal(respBody, &rawResp)
 
-	assert.Equal(t, "Invalid account", rawResp["error"])
+	assert.Equal(t, "INVALID_ACCOUNT", rawResp["error_code"])
 
 	// Request JSON
 	reqBody = map[string]interface{}{
@@ -320,5 +754,1170 @@ func TestAccountRepresentativeSet(t *testing.T) {
 	respBody, _ = io.ReadAll(resp.Body)
 	json.Unmarshal(respBody, &rawResp)
 
-	assert.Equal(t, "Invalid representative account", rawResp["error"])
+	assert.Equal(t, "INVALID_REPRESENTATIVE", rawResp["error_code"])
+}
+
+func TestBlockConfirm(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	nodeCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BlockConfirmRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "block_confirm" {
+				nodeCalls++
+				return httpmock.NewStringResponse(200, "{\n  \"started\": \"1\"\n}"), nil
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+			return resp, err
+		},
+	)
+
+	doConfirm := func(hash string) (int, string) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "block_confirm",
+			"hash":   hash,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(respBody)
+	}
+
+	// Passes the node response through as is
+	status, body := doConfirm("a5f1b8c3d2e4f60718293a4b5c6d7e8f9011a2b3c4d5e6f708192a3b4c5d6e7f")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "{\n  \"started\": \"1\"\n}", body)
+	assert.Equal(t, 1, nodeCalls)
+
+	// Same hash again is rate limited
+	status, body = doConfirm("a5f1b8c3d2e4f60718293a4b5c6d7e8f9011a2b3c4d5e6f708192a3b4c5d6e7f")
+	assert.Equal(t, 429, status)
+	var rawResp map[string]interface{}
+	json.Unmarshal([]byte(body), &rawResp)
+	assert.Equal(t, "RATE_LIMITED", rawResp["error_code"])
+	assert.Equal(t, 1, nodeCalls)
+
+	// A different hash isn't
+	status, _ = doConfirm("b5f1b8c3d2e4f60718293a4b5c6d7e8f9011a2b3c4d5e6f708192a3b4c5d6e7f")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 2, nodeCalls)
+
+	// Invalid hashes never reach the node
+	status, _ = doConfirm("A5F1B8C3D2E4F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F")
+	assert.Equal(t, 400, status)
+	status, _ = doConfirm("a5f1")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, 2, nodeCalls)
+}
+
+func TestBlockRebroadcast(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	unconfirmed := "C5F1B8C3D2E4F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F"
+	confirmed := "D5F1B8C3D2E4F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F"
+	processCalls := 0
+	var processed map[string]interface{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&js)
+			switch js["action"] {
+			case "block_info":
+				var info map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &info)
+				switch js["hash"] {
+				case unconfirmed:
+					info["height"] = "22967"
+				case confirmed:
+					info["height"] = "22966"
+				default:
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Block not found"})
+				}
+				return httpmock.NewJsonResponse(200, info)
+			case "account_info":
+				var info map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &info)
+				return httpmock.NewJsonResponse(200, info)
+			case "process":
+				processCalls++
+				processed = js
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"hash": unconfirmed})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+
+	doRebroadcast := func(hash string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "block_rebroadcast",
+			"hash":   hash,
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Above the confirmation height the block from block_info is processed again
+	status, respJson := doRebroadcast(unconfirmed)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, unconfirmed, respJson["hash"])
+	assert.Equal(t, 1, processCalls)
+	assert.Equal(t, "send", processed["subtype"])
+	assert.Equal(t, true, processed["json_block"])
+	block := processed["block"].(map[string]interface{})
+	assert.Equal(t, "8a142e07a10996d5", block["work"])
+	assert.Equal(t, "82D41BC16F313E4B2243D14DFFA2FB04679C540C2095FEE7EAE0F2F26880AD56DD48D87A7CC5DD760C5B2D76EE2C205506AA557BF00B60D8DEE312EC7343A501", block["signature"])
+
+	// Same hash again is rate limited
+	status, respJson = doRebroadcast(unconfirmed)
+	assert.Equal(t, 429, status)
+	assert.Equal(t, "RATE_LIMITED", respJson["error_code"])
+	assert.Equal(t, 1, processCalls)
+
+	// At the confirmation height it's already confirmed
+	status, respJson = doRebroadcast(strings.ToLower(confirmed))
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "already_confirmed", respJson["error"])
+	assert.Equal(t, "ALREADY_CONFIRMED", respJson["error_code"])
+	assert.Equal(t, confirmed, respJson["hash"])
+	assert.Equal(t, 1, processCalls)
+
+	// Unknown blocks
+	status, respJson = doRebroadcast("E5F1B8C3D2E4F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "BLOCK_NOT_FOUND", respJson["error_code"])
+
+	status, respJson = doRebroadcast("a5f1")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_HASH", respJson["error_code"])
+	assert.Equal(t, 1, processCalls)
+}
+
+func TestSendConfirmationPoll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hash := "A7C2E9F1B3D5F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F"
+	confirmed := "false"
+	blockInfoCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&js)
+			if js["action"] == "block_info" {
+				if js["hash"] != hash {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Block not found"})
+				}
+				blockInfoCalls++
+				var info map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &info)
+				info["confirmed"] = confirmed
+				info["local_timestamp"] = "1700000000"
+				return httpmock.NewJsonResponse(200, info)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+
+	doPoll := func(hash string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "send_confirmation_poll",
+			"hash":   hash,
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Not confirmed yet
+	status, respJson := doPoll(hash)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, false, respJson["confirmed"])
+	assert.Equal(t, float64(0), respJson["confirmations"])
+	assert.Equal(t, float64(1700000000), respJson["timestamp"])
+	assert.Equal(t, 1, blockInfoCalls)
+
+	// Polled again right away it comes from the cache
+	confirmed = "true"
+	status, respJson = doPoll(strings.ToLower(hash))
+	assert.Equal(t, 200, status)
+	assert.Equal(t, false, respJson["confirmed"])
+	assert.Equal(t, 1, blockInfoCalls)
+
+	// After a second the node is asked again
+	time.Sleep(1100 * time.Millisecond)
+	status, respJson = doPoll(hash)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, true, respJson["confirmed"])
+	assert.Equal(t, float64(1), respJson["confirmations"])
+	assert.Equal(t, 2, blockInfoCalls)
+
+	// Confirmed is kept
+	time.Sleep(1100 * time.Millisecond)
+	status, respJson = doPoll(hash)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, true, respJson["confirmed"])
+	assert.Equal(t, 2, blockInfoCalls)
+
+	// errors
+	status, respJson = doPoll("B7C2E9F1B3D5F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "BLOCK_NOT_FOUND", respJson["error_code"])
+	status, respJson = doPoll("a5f1")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_HASH", respJson["error_code"])
+}
+
+func TestAccountsRepresentativeSet(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("4e7b0d3a6c9f2e5b8d1a4c7f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e73"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	acc, _ := hc.Wallet.AccountCreate(wallet, nil)
+	representative := "nano_1efa1gxbitary1urzix9h13nkzadtz71n3auyj7uztb8i4qbtipu8cxz61ee"
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "account_info" {
+				// The frontier has hard coded work in the pow client
+				rep := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+				if pr["account"] == acc.Address {
+					rep = representative
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "11999999999999999918751838129509869131",
+					"representative": rep,
+				})
+			} else if pr["action"] == "process" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	doSet := func(representative string) (int, []byte) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":         "accounts_representative_set",
+			"wallet":         wallet.ID.String(),
+			"representative": representative,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	// Cached by account_representative, only the changed account's is forgotten
+	accounts, _, _ := hc.Wallet.AccountsList(wallet, 0)
+	for _, account := range accounts {
+		hc.Cache.Set(accountRepresentativeKey(account.Address), []byte("nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"), time.Minute)
+	}
+
+	status, respBody := doSet(representative)
+	assert.Equal(t, 200, status)
+	var respJson responses.AccountsRepresentativeSetResponse
+	json.Unmarshal(respBody, &respJson)
+	assert.Len(t, respJson.Changed, 1)
+	assert.NotEqual(t, acc.Address, respJson.Changed[0].Account)
+	_, err := hc.Cache.Get(accountRepresentativeKey(respJson.Changed[0].Account))
+	assert.ErrorIs(t, err, cache.ErrCacheMiss)
+	_, err = hc.Cache.Get(accountRepresentativeKey(acc.Address))
+	assert.Nil(t, err)
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", respJson.Changed[0].BlockHash)
+	assert.Equal(t, []string{acc.Address}, respJson.Skipped)
+	assert.Equal(t, []string{}, respJson.Failed)
+
+	status, respBody = doSet("nano_1234")
+	assert.Equal(t, 400, status)
+	var errJson map[string]interface{}
+	json.Unmarshal(respBody, &errJson)
+	assert.Equal(t, "INVALID_REPRESENTATIVE", errJson["error_code"])
+
+	// Locked
+	hc.Wallet.EncryptWallet(wallet, "password")
+	status, respBody = doSet(representative)
+	assert.Equal(t, 400, status)
+	errJson = map[string]interface{}{}
+	json.Unmarshal(respBody, &errJson)
+	assert.Equal(t, "WALLET_LOCKED", errJson["error_code"])
+}
+
+func TestSweepToWallet(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("c7f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e734e7b0d3a6c9f2e5b8d1a4"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	acc, _ := hc.Wallet.AccountCreate(wallet, nil)
+	sourceSeed, _ := utils.GenerateSeed(strings.NewReader("a0c3f6e9b2d5a8c1f4e734e7b0d3a6c9f2e5b8d1a4c7f0e3b6d9a2c5f8e1b4d7"))
+
+	var processed []string
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "receivable" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": ""})
+			} else if pr["action"] == "account_info" {
+				// The frontier has hard coded work in the pow client
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "11999999999999999918751838129509869131",
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			} else if pr["action"] == "process" {
+				block := pr["block"].(map[string]interface{})
+				processed = append(processed, block["link"].(string))
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	doSweep := func(destination string, sources []map[string]interface{}) (int, []byte) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":              "sweep_to_wallet",
+			"wallet":              wallet.ID.String(),
+			"destination_account": destination,
+			"sources":             sources,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	status, respBody := doSweep(acc.Address, []map[string]interface{}{{"seed": sourceSeed, "index": 0}})
+	assert.Equal(t, 200, status)
+	var respJson responses.SweepToWalletResponse
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, []string{"E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3"}, respJson.Blocks)
+	// The whole balance went to the destination
+	destinationPub, _ := utils.AddressToPub(acc.Address, false)
+	assert.Len(t, processed, 1)
+	assert.Equal(t, strings.ToUpper(hex.EncodeToString(destinationPub)), strings.ToUpper(processed[0]))
+
+	// errors
+	status, respBody = doSweep(acc.Address, []map[string]interface{}{{"seed": "1234", "index": 0}})
+	assert.Equal(t, 400, status)
+	var errJson map[string]interface{}
+	json.Unmarshal(respBody, &errJson)
+	assert.Equal(t, "INVALID_SEED", errJson["error_code"])
+
+	status, respBody = doSweep(acc.Address, []map[string]interface{}{{"seed": sourceSeed, "index": -1}})
+	assert.Equal(t, 400, status)
+	errJson = map[string]interface{}{}
+	json.Unmarshal(respBody, &errJson)
+	assert.Equal(t, "INVALID_INDEX", errJson["error_code"])
+
+	status, respBody = doSweep("nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", []map[string]interface{}{{"seed": sourceSeed, "index": 0}})
+	assert.Equal(t, 400, status)
+	errJson = map[string]interface{}{}
+	json.Unmarshal(respBody, &errJson)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", errJson["error_code"])
+
+	// Locked
+	hc.Wallet.EncryptWallet(wallet, "password")
+	status, respBody = doSweep(acc.Address, []map[string]interface{}{{"seed": sourceSeed, "index": 0}})
+	assert.Equal(t, 400, status)
+	errJson = map[string]interface{}{}
+	json.Unmarshal(respBody, &errJson)
+	assert.Equal(t, "WALLET_LOCKED", errJson["error_code"])
+	assert.Len(t, processed, 1)
+}
+
+func TestPendingExists(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// The send in BlockInfoResponseStr is to this account
+	destination := "nano_1qato4k7z3spc8gq1zyd8xeqfbzsoxwo36a45ozbrxcatut7up8ohyardu1z"
+	receivableHash := "c5f1b8c3d2e4f60718293a4b5c6d7e8f9011a2b3c4d5e6f708192a3b4c5d6e7f"
+	receivedHash := "d5f1b8c3d2e4f60718293a4b5c6d7e8f9011a2b3c4d5e6f708192a3b4c5d6e7f"
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "receivable_exists":
+				if pr["hash"] == receivableHash {
+					var js map[string]interface{}
+					json.Unmarshal([]byte(mocks.ReceivableExistsResponseStr), &js)
+					return httpmock.NewJsonResponse(200, js)
+				} else if pr["hash"] == receivedHash {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"exists": "0"})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Block not found"})
+			case "block_info":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	doPendingExists := func(account string, hash string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":  "pending_exists",
+			"account": account,
+			"hash":    hash,
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Receivable by the destination, with its amount
+	status, resp := doPendingExists(destination, receivableHash)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"exists": true, "amount_raw": "30000000000000000000000000000000000"}, resp)
+
+	// Already received, no amount
+	status, resp = doPendingExists(destination, receivedHash)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"exists": false}, resp)
+
+	// Unknown to the node
+	status, resp = doPendingExists(destination, "e5f1b8c3d2e4f60718293a4b5c6d7e8f9011a2b3c4d5e6f708192a3b4c5d6e7f")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"exists": false}, resp)
+
+	// Receivable, but by another account
+	status, resp = doPendingExists("nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", receivableHash)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"exists": false}, resp)
+
+	// Invalid account and hash
+	status, _ = doPendingExists("nano_1234", receivableHash)
+	assert.Equal(t, 400, status)
+	status, _ = doPendingExists(destination, "c5f1")
+	assert.Equal(t, 400, status)
+}
+
+func TestReceivableExists(t *testing.T) {
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("3c7a1e9f5b2d8c4a6e0f3b9d7c1a5e8f2b4d6c0a9e3f7b1d5c8a2e6f0b4d9c3a"))
+	dbWallet, _ := hc.Wallet.WalletCreate(newSeed)
+	walletAccount, _ := hc.Wallet.AccountCreate(dbWallet, nil)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// Only the second account of the wallet has something to receive
+	receivable := map[string]string{
+		"A5F1B8C3D2E4F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F": "100",
+		"B5F1B8C3D2E4F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F": "2000",
+		"C5F1B8C3D2E4F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F": "3000",
+	}
+	var calls []string
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "receivable_exists" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ReceivableExistsResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr["action"] != "receivable" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+			}
+			// All of them without a count
+			count := 0
+			if pr["count"] != nil {
+				count, _ = utils.ToInt(pr["count"])
+			}
+			calls = append(calls, fmt.Sprintf("%s:%d:%s", pr["account"], count, pr["threshold"]))
+			blocks := map[string]string{}
+			if pr["account"] == walletAccount.Address {
+				threshold, _ := big.NewInt(0).SetString(pr["threshold"].(string), 10)
+				for hash, amount := range receivable {
+					parsed, _ := big.NewInt(0).SetString(amount, 10)
+					if (count == 0 || len(blocks) < count) && (threshold == nil || parsed.Cmp(threshold) >= 0) {
+						blocks[hash] = amount
+					}
+				}
+			}
+			if len(blocks) == 0 {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": ""})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": blocks})
+		},
+	)
+
+	doReceivableExists := func(request map[string]interface{}) (int, map[string]interface{}) {
+		request["action"] = "receivable_exists"
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Nothing to receive, a single probe is enough
+	_, accounts, _ := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+	firstAccount := accounts[0]
+	if firstAccount == walletAccount.Address {
+		firstAccount = accounts[1]
+	}
+	status, resp := doReceivableExists(map[string]interface{}{"account": firstAccount})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"has_receivable": false, "count": float64(0)}, resp)
+	assert.Equal(t, []string{firstAccount + ":1:"}, calls)
+
+	// Every block of the account that has some is counted
+	calls = nil
+	status, resp = doReceivableExists(map[string]interface{}{"account": walletAccount.Address})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"has_receivable": true, "count": float64(3)}, resp)
+	assert.Equal(t, []string{walletAccount.Address + ":1:", walletAccount.Address + ":0:"}, calls)
+
+	// Same for the wallet, the account that was probed empty isn't asked again
+	calls = nil
+	status, resp = doReceivableExists(map[string]interface{}{"wallet": dbWallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"has_receivable": true, "count": float64(3)}, resp)
+	assert.Len(t, calls, len(accounts)+1)
+	assert.Equal(t, walletAccount.Address+":0:", calls[len(calls)-1])
+
+	// Reused for a while
+	calls = nil
+	status, resp = doReceivableExists(map[string]interface{}{"wallet": dbWallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(3), resp["count"])
+	assert.Empty(t, calls)
+
+	// A threshold counts every account without probing
+	status, resp = doReceivableExists(map[string]interface{}{"wallet": dbWallet.ID.String(), "threshold_raw": "1000"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"has_receivable": true, "count": float64(2)}, resp)
+	assert.Len(t, calls, len(accounts))
+	for _, call := range calls {
+		assert.True(t, strings.HasSuffix(call, ":0:1000"))
+	}
+	status, resp = doReceivableExists(map[string]interface{}{"account": walletAccount.Address, "threshold_raw": "5000"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"has_receivable": false, "count": float64(0)}, resp)
+
+	// The node's receivable_exists
+	status, resp = doReceivableExists(map[string]interface{}{"hash": "C5F1B8C3D2E4F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", resp["exists"])
+
+	// Invalid requests
+	status, _ = doReceivableExists(map[string]interface{}{"wallet": dbWallet.ID.String(), "account": walletAccount.Address})
+	assert.Equal(t, 400, status)
+	status, _ = doReceivableExists(map[string]interface{}{})
+	assert.Equal(t, 400, status)
+	status, resp = doReceivableExists(map[string]interface{}{"account": walletAccount.Address, "threshold_raw": "1.5"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_AMOUNT", resp["error_code"])
+	status, resp = doReceivableExists(map[string]interface{}{"account": "nano_1234"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", resp["error_code"])
+}
+
+func TestSendWithID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processed := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "process" {
+				processed++
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("c9f2e5b8d1a7c4f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e7b0d3a6c9f2"))
+	wallet, err := MockController.Wallet.WalletCreate(newSeed)
+	assert.Nil(t, err)
+	acc, err := MockController.Wallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	doSend := func(sendID string, amount string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":      "send_with_id",
+			"wallet":      wallet.ID.String(),
+			"source":      acc.Address,
+			"destination": acc.Address,
+			"amount":      amount,
+			"send_id":     sendID,
+			"work":        "0000000000000000",
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, resp := doSend("withdrawal-1", "1000000000000000000000000000000")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", resp["block"])
+	assert.Equal(t, 1, processed)
+
+	// A retry returns the same block without publishing anything
+	status, resp = doSend("withdrawal-1", "1000000000000000000000000000000")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", resp["block"])
+	assert.Equal(t, 1, processed)
+
+	// Another amount with the same send_id is refused
+	status, resp = doSend("withdrawal-1", "2000000000000000000000000000000")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "SEND_ID_MISMATCH", resp["error_code"])
+
+	// send_id is required
+	status, _ = doSend("", "1000000000000000000000000000000")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, 1, processed)
+}
+
+func TestSendBulk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	processed := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "process" {
+				processed++
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("5b8e1d4a7c0f3b6e9d2a5c8f1b4e7d0a3c6f9b2e5d8a1c4f7b0e3d6a9c2f5b8e"))
+	wallet, err := hc.Wallet.WalletCreate(newSeed)
+	assert.Nil(t, err)
+	acc, err := hc.Wallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	doSendBulk := func(sends []map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "send_bulk",
+			"wallet": wallet.ID.String(),
+			"source": acc.Address,
+			"sends":  sends,
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	sends := []map[string]interface{}{
+		{"destination": acc.Address, "amount": "1000000000000000000000000000000", "id": "payout-1", "work": "0000000000000000"},
+		{"destination": acc.Address, "amount": "100000000000000000000000000000000000000", "id": "payout-2", "work": "0000000000000000"},
+		{"destination": acc.Address, "amount": "1000000000000000000000000000000", "work": "0000000000000000"},
+	}
+	status, resp := doSendBulk(sends)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(2), resp["sent"])
+	assert.Equal(t, float64(1), resp["failed"])
+	results := resp["results"].([]interface{})
+	assert.Len(t, results, 3)
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", results[0].(map[string]interface{})["block"])
+	assert.Equal(t, "payout-1", results[0].(map[string]interface{})["id"])
+	// The send that failed didn't stop the next one
+	assert.Equal(t, "INSUFFICIENT_BALANCE", results[1].(map[string]interface{})["error_code"])
+	assert.Nil(t, results[1].(map[string]interface{})["block"])
+	assert.NotEmpty(t, results[2].(map[string]interface{})["block"])
+	assert.Equal(t, 2, processed)
+
+	// Nothing is sent when a destination is invalid
+	status, resp = doSendBulk([]map[string]interface{}{{"destination": acc.Address, "amount": "1"}, {"destination": "nano_1234", "amount": "1"}})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", resp["error_code"])
+	status, _ = doSendBulk([]map[string]interface{}{})
+	assert.Equal(t, 400, status)
+
+	// Too many sends are refused
+	conf := *hc.Wallet.Config
+	conf.Server.SendBulkMaxSends = 2
+	hc.Wallet.Config = &conf
+	status, resp = doSendBulk(sends)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "TOO_MANY_SENDS", resp["error_code"])
+	assert.Equal(t, 2, processed)
+}
+
+func TestReceiveBatch(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("d1a4c7f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e734e7b0d3a6c9f2e5b8"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	acc, _ := hc.Wallet.AccountCreate(wallet, nil)
+	pending := "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE"
+	notPending := "000D1BAEC8EC208142C99059B393051BAC8380F9B5A2E6B2489A277D81789F3F"
+
+	var processed []string
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "receivable" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"blocks": map[string]interface{}{pending: "1000000000000000000000000000000"},
+				})
+			} else if pr["action"] == "block_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr["action"] == "account_info" {
+				// The frontier has hard coded work in the pow client
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "11999999999999999918751838129509869131",
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			} else if pr["action"] == "process" {
+				block := pr["block"].(map[string]interface{})
+				processed = append(processed, block["link"].(string))
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	doReceiveBatch := func(account string, blocks []string) (int, []byte) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":  "receive_batch",
+			"wallet":  wallet.ID.String(),
+			"account": account,
+			"blocks":  blocks,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	status, respBody := doReceiveBatch(acc.Address, []string{notPending, pending})
+	assert.Equal(t, 200, status)
+	var respJson responses.ReceiveBatchResponse
+	json.Unmarshal(respBody, &respJson)
+	assert.Equal(t, []responses.ReceivedBlock{{Hash: pending, ReceivedHash: "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3"}}, respJson.Received)
+	assert.Equal(t, []responses.SkippedBlock{{Hash: notPending, Reason: "not_pending"}}, respJson.Skipped)
+	assert.Equal(t, []string{pending}, processed)
+
+	// errors
+	status, respBody = doReceiveBatch(acc.Address, []string{})
+	assert.Equal(t, 400, status)
+	var errJson map[string]interface{}
+	json.Unmarshal(respBody, &errJson)
+	assert.Equal(t, "INVALID_JSON", errJson["error_code"])
+
+	status, respBody = doReceiveBatch(acc.Address, []string{pending, "1234"})
+	assert.Equal(t, 400, status)
+	errJson = map[string]interface{}{}
+	json.Unmarshal(respBody, &errJson)
+	assert.Equal(t, "INVALID_HASH", errJson["error_code"])
+
+	status, respBody = doReceiveBatch("nano_1", []string{pending})
+	assert.Equal(t, 400, status)
+	errJson = map[string]interface{}{}
+	json.Unmarshal(respBody, &errJson)
+	assert.Equal(t, "INVALID_ACCOUNT", errJson["error_code"])
+
+	status, respBody = doReceiveBatch("nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", []string{pending})
+	assert.Equal(t, 400, status)
+	errJson = map[string]interface{}{}
+	json.Unmarshal(respBody, &errJson)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", errJson["error_code"])
+}
+
+func TestBlockSuccessorPredecessor(t *testing.T) {
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("8b3f6d1a4e9c2b7f0a5d8e3c6b1f4a9d2e7c0b5f8a3d6e1c4b9f2a7d0e5c8b3f"))
+	dbWallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	walletAccount, _ := MockController.Wallet.AccountCreate(dbWallet, nil)
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	middle := "A5F1B8C3D2E4F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F"
+	open := "B5F1B8C3D2E4F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F"
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&js)
+			var info map[string]interface{}
+			json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &info)
+			switch js["hash"] {
+			case middle:
+				// A block of an account in the wallet
+				info["block_account"] = walletAccount.Address
+			case open:
+				// The only block of an account outside any wallet
+				info["successor"] = "0000000000000000000000000000000000000000000000000000000000000000"
+				info["contents"].(map[string]interface{})["previous"] = "0000000000000000000000000000000000000000000000000000000000000000"
+			default:
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Block not found"})
+			}
+			return httpmock.NewJsonResponse(200, info)
+		},
+	)
+
+	doRequest := func(action string, block string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": action,
+			"block":  block,
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Linked to the wallet the account is in
+	status, respJson := doRequest("block_successor", strings.ToLower(middle))
+	assert.Equal(t, 200, status)
+	assert.Equal(t, middle, respJson["block"])
+	assert.Equal(t, walletAccount.Address, respJson["account"])
+	assert.Equal(t, "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72", respJson["successor"])
+	assert.Equal(t, map[string]interface{}{"wallet": dbWallet.ID.String(), "index": float64(1)}, respJson["wallet_account"])
+
+	status, respJson = doRequest("block_predecessor", middle)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "CE898C131AAEE25E05362F247760F8A3ACF34A9796A5AE0D9204E86B0637965E", respJson["predecessor"])
+	assert.Equal(t, dbWallet.ID.String(), respJson["wallet_account"].(map[string]interface{})["wallet"])
+
+	// The ends of the chain are null, and there's no wallet_account outside a wallet
+	status, respJson = doRequest("block_successor", open)
+	assert.Equal(t, 200, status)
+	assert.Contains(t, respJson, "successor")
+	assert.Nil(t, respJson["successor"])
+	assert.NotContains(t, respJson, "wallet_account")
+
+	status, respJson = doRequest("block_predecessor", open)
+	assert.Equal(t, 200, status)
+	assert.Contains(t, respJson, "predecessor")
+	assert.Nil(t, respJson["predecessor"])
+	assert.NotContains(t, respJson, "wallet_account")
+
+	status, respJson = doRequest("block_successor", "C5F1B8C3D2E4F60718293A4B5C6D7E8F9011A2B3C4D5E6F708192A3B4C5D6E7F")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "BLOCK_NOT_FOUND", respJson["error_code"])
+
+	status, respJson = doRequest("block_predecessor", "1234")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_HASH", respJson["error_code"])
+}
+
+func TestSendRaw(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processed := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "process" {
+				processed++
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	hc := newTestController(t)
+	logger := &recordingAuditLogger{}
+	hc.AuditLogger = logger
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e7b0d3a6c9f2e5b8d1a4c7f0e3"))
+	wallet, err := hc.Wallet.WalletCreate(newSeed)
+	assert.Nil(t, err)
+	acc, err := hc.Wallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	_, priv, _ := utils.KeypairFromSeed(newSeed, uint32(*acc.AccountIndex))
+
+	// Signed off-device, e.g. by a hardware wallet
+	sb := nanoblock.StateBlock{
+		Type:           "state",
+		Account:        acc.Address,
+		Previous:       "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F",
+		Representative: "nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs",
+		Balance:        "1000",
+		Link:           "d4bbfa50649d80e5f63fc396c6f4cf6321cabd7c1480e964c2701d56aafeb5e3",
+	}
+	var key [32]byte
+	copy(key[:], priv.Seed())
+	assert.Nil(t, sb.Sign(key))
+
+	doSendRaw := func(block nanoblock.StateBlock) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "send_raw",
+			"wallet": wallet.ID.String(),
+			"block":  block,
+			"work":   "0000000000000000",
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, resp := doSendRaw(sb)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", resp["hash"])
+	assert.Equal(t, 1, processed)
+	assert.Len(t, logger.entries, 1)
+	assert.Equal(t, "send_raw", logger.entries[0].Action)
+	assert.Equal(t, acc.Address, logger.entries[0].Details["account"])
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", logger.entries[0].Details["hash"])
+
+	// A block changed after it was signed never reaches the node
+	tampered := sb
+	tampered.Balance = "999"
+	status, resp = doSendRaw(tampered)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_SIGNATURE", resp["error_code"])
+	tampered = sb
+	tampered.Link = "d4bbfa50649d80e5f63fc396c6f4cf6321cabd7c1480e964c2701d56aafeb5e4"
+	status, resp = doSendRaw(tampered)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_SIGNATURE", resp["error_code"])
+	assert.Equal(t, 1, processed)
+	assert.Len(t, logger.entries, 3)
+	assert.Equal(t, "invalid signature", logger.entries[2].Details["error"])
+
+	tampered = sb
+	tampered.Previous = "1234"
+	status, resp = doSendRaw(tampered)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_BLOCK", resp["error_code"])
+
+	// Accounts that aren't in the wallet
+	tampered = sb
+	tampered.Account = "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	status, resp = doSendRaw(tampered)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", resp["error_code"])
+	assert.Equal(t, 1, processed)
+}
+
+func TestSignBlock(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processed := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "process" {
+				processed++
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	hc := newTestController(t)
+	logger := &recordingAuditLogger{}
+	hc.AuditLogger = logger
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("b0d3a6c9f2e5b8d1a4c7f0e3f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e7"))
+	wallet, err := hc.Wallet.WalletCreate(newSeed)
+	assert.Nil(t, err)
+	acc, err := hc.Wallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	pub, _, _ := utils.KeypairFromSeed(newSeed, uint32(*acc.AccountIndex))
+
+	// Built and given work somewhere else, without a signature
+	unsigned := map[string]interface{}{
+		"type":           "state",
+		"account":        acc.Address,
+		"previous":       "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F",
+		"representative": "nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs",
+		"balance":        "1000",
+		"link":           "d4bbfa50649d80e5f63fc396c6f4cf6321cabd7c1480e964c2701d56aafeb5e3",
+		"work":           "0000000000000000",
+	}
+
+	doRequest := func(request map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, resp := doRequest(map[string]interface{}{"action": "sign_block", "wallet": wallet.ID.String(), "block": unsigned})
+	assert.Equal(t, 200, status)
+	block := resp["block"].(map[string]interface{})
+	assert.Equal(t, acc.Address, block["account"])
+	assert.Equal(t, "0000000000000000", block["work"])
+	// The signature is the account's over the hash
+	hash, err := hex.DecodeString(resp["hash"].(string))
+	assert.Nil(t, err)
+	signature, err := hex.DecodeString(block["signature"].(string))
+	assert.Nil(t, err)
+	assert.True(t, ed25519.Verify(ed25519.PublicKey(pub), hash, signature))
+	assert.Equal(t, 0, processed)
+	assert.Len(t, logger.entries, 1)
+	assert.Equal(t, "sign_block", logger.entries[0].Action)
+	assert.Equal(t, resp["hash"], logger.entries[0].Details["hash"])
+
+	// send_raw publishes it as it was returned
+	status, resp = doRequest(map[string]interface{}{"action": "send_raw", "wallet": wallet.ID.String(), "block": block})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 1, processed)
+
+	// Blocks that can't be hashed and accounts that aren't in the wallet
+	invalid := map[string]interface{}{}
+	for k, v := range unsigned {
+		invalid[k] = v
+	}
+	invalid["balance"] = "lots"
+	status, resp = doRequest(map[string]interface{}{"action": "sign_block", "wallet": wallet.ID.String(), "block": invalid})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_BLOCK", resp["error_code"])
+	invalid["balance"] = "1000"
+	invalid["account"] = "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	status, resp = doRequest(map[string]interface{}{"action": "sign_block", "wallet": wallet.ID.String(), "block": invalid})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", resp["error_code"])
+	invalid["account"] = "nano_1"
+	status, resp = doRequest(map[string]interface{}{"action": "sign_block", "wallet": wallet.ID.String(), "block": invalid})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", resp["error_code"])
+	status, resp = doRequest(map[string]interface{}{"action": "sign_block", "wallet": wallet.ID.String()})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JSON", resp["error_code"])
+
+	// Locked wallets can't sign
+	hc.Wallet.EncryptWallet(wallet, "password")
+	status, resp = doRequest(map[string]interface{}{"action": "sign_block", "wallet": wallet.ID.String(), "block": unsigned})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_LOCKED", resp["error_code"])
 }
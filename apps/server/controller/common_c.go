@@ -2,11 +2,15 @@ package controller
 
 import (
 	"errors"
+	"fmt"
+	"math/big"
 	"net/http"
+	"strings"
 
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
 	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/price"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
 	"github.com/mitchellh/mapstructure"
@@ -29,6 +33,15 @@ func (hc *HttpController) WalletExists(walletId string, w http.ResponseWriter, r
 	return dbWallet
 }
 
+// Renders wallet_frozen for a frozen wallet, before a signing action asks the node for anything
+func (hc *HttpController) WalletNotFrozen(dbWallet *ent.Wallet, w http.ResponseWriter, r *http.Request) bool {
+	if dbWallet.FrozenAt != nil {
+		ErrWalletFrozen(w, r, *dbWallet.FrozenAt)
+		return false
+	}
+	return true
+}
+
 // Common map decoding for most requests
 func (hc *HttpController) DecodeBaseRequest(request *map[string]interface{}, w http.ResponseWriter, r *http.Request) *requests.BaseRequest {
 	var baseRequest requests.BaseRequest
@@ -95,3 +108,63 @@ func (hc *HttpController) DecodeAccountCreateRequest(request *map[string]interfa
 
 	return &accountCreateRequest, idx
 }
+
+// Fiat value of raw for requests with include_price, value and currency are nil if it wasn't asked for or the price feed isn't available
+// Returns false if the options are invalid, the error response has been written
+func (hc *HttpController) FiatValue(raw *big.Int, options requests.PriceOptions, w http.ResponseWriter, r *http.Request) (*string, *string, bool) {
+	if options.IncludePrice == nil {
+		return nil, nil, true
+	}
+	includePrice, err := utils.ToBool(*options.IncludePrice)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return nil, nil, false
+	} else if !includePrice || hc.PriceClient == nil {
+		return nil, nil, true
+	}
+
+	currency := hc.PriceClient.DefaultCurrency()
+	if options.Currency != nil {
+		currency = strings.ToLower(*options.Currency)
+	}
+	value, err := hc.PriceClient.FiatValue(r.Context(), raw, currency)
+	if errors.Is(err, price.ErrUnsupportedCurrency) {
+		ErrBadRequest(w, r, ErrorCodeUnsupportedCurrency, "Unsupported currency")
+		return nil, nil, false
+	} else if err != nil {
+		// The fiat value is optional, leave it out
+		return nil, nil, true
+	}
+
+	currency = strings.ToUpper(currency)
+	return &value, &currency, true
+}
+
+// Check the unit of a request with one, nano or raw, or banano, banoshi or raw in banano mode
+// Returns false if it's invalid, the error response has been written
+func (hc *HttpController) ValidUnit(unit *string, w http.ResponseWriter, r *http.Request) bool {
+	if unit == nil {
+		return true
+	}
+	if _, err := utils.UnitToRaw("0", *unit, hc.Wallet.Config.Wallet.Banano); err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidUnit, err.Error())
+		return false
+	}
+	return true
+}
+
+// Convert an amount in unit to raw, without a unit it's already raw
+// Returns false if the unit or amount are invalid, the error response has been written
+func (hc *HttpController) AmountToRaw(amount string, unit *string, w http.ResponseWriter, r *http.Request) (string, bool) {
+	if unit == nil {
+		return amount, true
+	} else if !hc.ValidUnit(unit, w, r) {
+		return "", false
+	}
+	raw, err := utils.UnitToRaw(amount, *unit, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidAmount, fmt.Sprintf("Invalid amount %s", amount))
+		return "", false
+	}
+	return raw.String(), true
+}
@@ -35,7 +35,7 @@ func TestWalletExists(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Contains(t, respJson, "error")
-	assert.Equal(t, "wallet not found", respJson["error"].(string))
+	assert.Equal(t, "WALLET_NOT_FOUND", respJson["error_code"].(string))
 }
 
 func TestDecodeBaseRequest(t *testing.T) {
@@ -71,7 +71,7 @@ func TestDecodeBaseRequest(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Contains(t, respJson, "error")
-	assert.Equal(t, "Unable to parse json", respJson["error"].(string))
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"].(string))
 }
 
 func TestDecodeBaseRequestWithCount(t *testing.T) {
@@ -126,7 +126,7 @@ func TestDecodeBaseRequestWithCount(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Contains(t, respJson, "error")
-	assert.Equal(t, "Unable to parse json", respJson["error"].(string))
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"].(string))
 }
 
 func TestDecodeAccountCreateRequest(t *testing.T) {
@@ -181,5 +181,5 @@ func TestDecodeAccountCreateRequest(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Contains(t, respJson, "error")
-	assert.Equal(t, "Unable to parse json", respJson["error"].(string))
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"].(string))
 }
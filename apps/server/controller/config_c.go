@@ -0,0 +1,31 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/go-chi/render"
+)
+
+// Handle config_reload, the same as a SIGHUP
+// An invalid config.yaml isn't applied, everything keeps its current value
+func (hc *HttpController) HandleConfigReload(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	if hc.ReloadConfig == nil {
+		ErrBadRequest(w, r, ErrorCodeNotImplemented, "The config can't be reloaded")
+		return
+	}
+	restartRequired, err := hc.ReloadConfig()
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidConfig, err.Error())
+		return
+	}
+	resp := responses.ConfigReloadResponse{
+		Reloaded:        true,
+		RestartRequired: restartRequired,
+	}
+	if resp.RestartRequired == nil {
+		resp.RestartRequired = []string{}
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigReload(t *testing.T) {
+	hc := newTestController(t)
+	doAdmin := func() (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{"action": "config_reload"})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		hc.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doAdmin()
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "NOT_IMPLEMENTED", respJson["error_code"])
+
+	var reloadErr error
+	var restartRequired []string
+	hc.ReloadConfig = func() ([]string, error) {
+		return restartRequired, reloadErr
+	}
+	status, respJson = doAdmin()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, true, respJson["reloaded"])
+	assert.Equal(t, []interface{}{}, respJson["restart_required"])
+
+	restartRequired = []string{"server.port"}
+	status, respJson = doAdmin()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []interface{}{"server.port"}, respJson["restart_required"])
+
+	reloadErr = errors.New("invalid node_rpc_url")
+	status, respJson = doAdmin()
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_CONFIG", respJson["error_code"])
+	assert.Equal(t, "invalid node_rpc_url", respJson["error"])
+}
@@ -1,7 +1,14 @@
 package controller
 
 import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/cache"
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
 	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/price"
 	rpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
 )
@@ -10,4 +17,74 @@ type HttpController struct {
 	Wallet    *wallet.NanoWallet
 	RpcClient *rpc.RPCClient
 	PowClient *pow.PippinPow
+	// Responses reused from the node, the backend is server.cache_backend
+	Cache cache.CacheClient
+	// Bearer token for the admin gateway, empty disables it
+	AdminToken string
+	// Fiat prices for include_price, nil if the price feed isn't configured
+	PriceClient *price.PriceClient
+	// Sensitive actions are recorded here, nil is the same as NoopAuditLogger
+	AuditLogger AuditLogger
+	// Gateway requests per client IP, nil or a rate of 0 doesn't limit them
+	RateLimiter *RateLimiter
+	// Re-reads config.yaml for config_reload, returns the changed fields that need a restart, nil if it can't be reloaded
+	ReloadConfig func() ([]string, error)
+	// Pippin's version, for nano_version
+	Build BuildInfo
+	// The node's block_count, see HandleBlockCount
+	blockCountCache blockCountCache
+	// The node's peers, see HandlePeers
+	peersCache peersCache
+	// active_difficulty and confirmation_quorum, see HandleElectionStatistics
+	electionStatisticsCache electionStatisticsCache
+	// telemetry, active_difficulty and confirmation_quorum, see HandleNetworkStats
+	networkStatsCache networkStatsCache
+	// Config values that can change while serving, see ApplyConfig
+	live liveConfig
+}
+
+// Set at build time with -ldflags "-X main.Version=... -X main.GitSHA=... -X main.BuildDate=...", see the Dockerfile
+type BuildInfo struct {
+	Version   string
+	GitSHA    string
+	BuildDate string
+}
+
+// Config values a reload can change while requests are being served
+type liveConfig struct {
+	blockConfirmInterval time.Duration
+	mutex                sync.RWMutex
+}
+
+// Apply the config values that can change without a restart, safe to call while serving requests
+func (hc *HttpController) ApplyConfig(conf *models.PippinConfig) {
+	hc.live.mutex.Lock()
+	hc.live.blockConfirmInterval = time.Duration(conf.Server.BlockConfirmInterval) * time.Second
+	hc.live.mutex.Unlock()
+	if hc.RateLimiter != nil {
+		hc.RateLimiter.SetLimits(conf.Server.RateLimit, conf.Server.RateLimitBurst)
+	}
+	if hc.Wallet != nil {
+		hc.Wallet.ApplyConfig(conf)
+	}
+}
+
+// The wallet for a request, the node and work peers it asks and its logs get the request ID
+func (hc *HttpController) walletFor(r *http.Request) *wallet.NanoWallet {
+	return hc.Wallet.WithContext(r.Context())
+}
+
+// How long block_confirm for a hash is refused after it's been called
+// Until ApplyConfig is called it's block_confirm_interval from Wallet.Config
+func (hc *HttpController) BlockConfirmInterval() time.Duration {
+	hc.live.mutex.RLock()
+	interval := hc.live.blockConfirmInterval
+	hc.live.mutex.RUnlock()
+	if interval > 0 {
+		return interval
+	}
+	if hc.Wallet != nil && hc.Wallet.Config != nil && hc.Wallet.Config.Server.BlockConfirmInterval > 0 {
+		return time.Duration(hc.Wallet.Config.Server.BlockConfirmInterval) * time.Second
+	}
+	return 10 * time.Second
 }
@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+// Header a client sets so a retried request returns the first response instead of running again
+const idempotencyKeyHeader = "X-Idempotency-Key"
+
+// How long a successful response is returned for retries
+const requestDedupeTTL = 5 * time.Minute
+
+// Actions that change something, only these are deduplicated, queries always run
+var DEDUPED_ACTIONS = []string{"wallet_create", "wallet_create_from_seed", "wallet_create_watch_only", "wallet_add_watch", "wallet_import_nanowallet", "wallet_import_nault", "wallet_backup_restore", "account_create", "accounts_create", "account_create_vanity", "account_remove", "password_change", "password_enter", "wallet_add", "wallet_lock", "receive", "receive_all", "receive_batch", "account_sync", "send", "send_with_id", "send_bulk", "send_raw", "sweep_to_wallet", "wallet_sweep", "cross_wallet_transfer", "send_schedule", "send_schedule_cancel", "send_approve", "send_reject", "alert_register", "alert_delete", "snapshot_balances", "account_representative_set", "accounts_representative_set", "wallet_representative_set", "wallet_accounts_reindex", "pipeline"}
+
+// A response as it was written, to replay for the same idempotency key
+type dedupedResponse struct {
+	// Of the request, a retry with the same key has to send the same request
+	RequestHash string `json:"request_hash"`
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// Writes through to the client and keeps a copy of the response
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	rr.status = status
+	rr.ResponseWriter.WriteHeader(status)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	if rr.status == 0 {
+		rr.status = http.StatusOK
+	}
+	rr.body.Write(b)
+	return rr.ResponseWriter.Write(b)
+}
+
+// Keys are per client, the API key the request was made with or its IP without one, so clients can't see each other's responses
+// The key is hashed, so anything a client sends is a valid cache key
+func dedupeCacheKey(client string, idempotencyKey string, action string) string {
+	return fmt.Sprintf("request_dedupe:%s:%x", action, sha256.Sum256([]byte(client+"\x00"+idempotencyKey)))
+}
+
+// Map keys are marshalled sorted, so the same request always has the same hash
+func dedupeRequestHash(request map[string]interface{}) string {
+	encoded, _ := json.Marshal(request)
+	return fmt.Sprintf("%x", sha256.Sum256(encoded))
+}
+
+// Replay the response of an earlier request with the same idempotency key and action, if there was one
+// Otherwise the returned writer records the response and done caches it if it succeeded
+// Requests with the same key run one at a time, so a retry waits for the first to finish
+// A key that was used for another request is refused instead of returning that request's response
+func (hc *HttpController) dedupeRequest(idempotencyKey string, action string, request map[string]interface{}, w http.ResponseWriter, r *http.Request) (recorder http.ResponseWriter, done func(), replayed bool) {
+	cacheKey := dedupeCacheKey(rateLimitKey(r), idempotencyKey, action)
+	requestHash := dedupeRequestHash(request)
+	lock, err := database.GetRedisDB().Obtain(context.Background(), cacheKey+":lock", requestDedupeTTL, &database.LockRetryStrategy)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeRequestInProgress, "A request with this X-Idempotency-Key is already in progress")
+		return nil, nil, true
+	}
+
+	if cached, err := hc.Cache.Get(cacheKey); err == nil {
+		var resp dedupedResponse
+		if err := json.Unmarshal(cached, &resp); err == nil {
+			lock.Release(context.Background())
+			if resp.RequestHash != requestHash {
+				ErrIdempotencyKeyConflict(w, r)
+				return nil, nil, true
+			}
+			log.Debugf("Returning cached %s response for X-Idempotency-Key", action)
+			w.Header().Set("Content-Type", resp.ContentType)
+			w.WriteHeader(resp.Status)
+			w.Write(resp.Body)
+			return nil, nil, true
+		}
+	}
+
+	rr := &responseRecorder{ResponseWriter: w}
+	return rr, func() {
+		defer lock.Release(context.Background())
+		// Failures aren't cached, so they can be retried
+		if rr.status != http.StatusOK {
+			return
+		}
+		encoded, err := json.Marshal(dedupedResponse{
+			RequestHash: requestHash,
+			Status:      rr.status,
+			ContentType: rr.Header().Get("Content-Type"),
+			Body:        rr.body.Bytes(),
+		})
+		if err != nil {
+			return
+		}
+		if err := hc.Cache.Set(cacheKey, encoded, requestDedupeTTL); err != nil {
+			log.Errorf("Error caching %s response %s", action, err)
+		}
+	}, false
+}
@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDedupeRequest(t *testing.T) {
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("6f1c8a3e5b0d7f2a9c4e1b6d3f8a5c0e7b2d9f4a1c6e3b8d5f0a7c2e9b4d1f6a"))
+	dbWallet, _ := hc.Wallet.WalletCreate(newSeed)
+
+	doClientRequest := func(remoteAddr string, reqBody map[string]interface{}, idempotencyKey string) (int, []byte) {
+		reqBody["wallet"] = dbWallet.ID.String()
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("Content-Type", "application/json")
+		if idempotencyKey != "" {
+			req.Header.Set("X-Idempotency-Key", idempotencyKey)
+		}
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+	doRequest := func(reqBody map[string]interface{}, idempotencyKey string) (int, []byte) {
+		return doClientRequest("192.0.2.1:1234", reqBody, idempotencyKey)
+	}
+	accountCount := func() int {
+		_, accounts, _ := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+		return len(accounts)
+	}
+
+	// The retry gets the same account and nothing new is created
+	status, first := doRequest(map[string]interface{}{"action": "account_create"}, "retry-1")
+	assert.Equal(t, 200, status)
+	status, second := doRequest(map[string]interface{}{"action": "account_create"}, "retry-1")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 2, accountCount())
+
+	// Another key or no key runs again
+	status, third := doRequest(map[string]interface{}{"action": "account_create"}, "retry-2")
+	assert.Equal(t, 200, status)
+	assert.NotEqual(t, first, third)
+	status, _ = doRequest(map[string]interface{}{"action": "account_create"}, "")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 4, accountCount())
+
+	// Another client with the same key doesn't get the first client's response
+	status, other := doClientRequest("192.0.2.2:1234", map[string]interface{}{"action": "account_create"}, "retry-1")
+	assert.Equal(t, 200, status)
+	assert.NotEqual(t, first, other)
+	assert.Equal(t, 5, accountCount())
+
+	// The same key with another request is a conflict, not the first response
+	status, conflict := doRequest(map[string]interface{}{"action": "account_create", "index": 7}, "retry-1")
+	assert.Equal(t, 409, status)
+	assert.Contains(t, string(conflict), "IDEMPOTENCY_KEY_IN_USE")
+	assert.Equal(t, 5, accountCount())
+
+	// Failures aren't cached
+	status, _ = doRequest(map[string]interface{}{"action": "accounts_create", "count": 0}, "retry-3")
+	assert.Equal(t, 400, status)
+	status, _ = doRequest(map[string]interface{}{"action": "accounts_create", "count": 1}, "retry-3")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 6, accountCount())
+
+	// Queries always go to the node
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	balancesCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			balancesCalls++
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"balances": map[string]interface{}{},
+			})
+		},
+	)
+	for i := 0; i < 2; i++ {
+		status, _ = doRequest(map[string]interface{}{"action": "wallet_balances"}, "retry-4")
+		assert.Equal(t, 200, status)
+	}
+	assert.Equal(t, 2, balancesCalls)
+}
+
+func TestDedupeCacheKey(t *testing.T) {
+	// The action and the client are part of the key and the client's key is hashed
+	assert.NotEqual(t, dedupeCacheKey("192.0.2.1", "key", "send"), dedupeCacheKey("192.0.2.1", "key", "receive"))
+	assert.NotEqual(t, dedupeCacheKey("192.0.2.1", "key", "send"), dedupeCacheKey("192.0.2.2", "key", "send"))
+	assert.Regexp(t, "^request_dedupe:send:[0-9a-f]{64}$", dedupeCacheKey("192.0.2.1", "key", "send"))
+}
+
+func TestDedupeRequestHash(t *testing.T) {
+	assert.Equal(t, dedupeRequestHash(map[string]interface{}{"action": "send", "amount": "1"}), dedupeRequestHash(map[string]interface{}{"amount": "1", "action": "send"}))
+	assert.NotEqual(t, dedupeRequestHash(map[string]interface{}{"action": "send", "amount": "1"}), dedupeRequestHash(map[string]interface{}{"action": "send", "amount": "2"}))
+}
@@ -1,17 +1,133 @@
 package controller
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
 	"github.com/go-chi/render"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// A stable code for every error, clients should match on it rather than on the message
+// Messages can be reworded, codes never change once they're released
+type ErrorCode string
+
+const (
+	ErrorCodeInvalidJson           ErrorCode = "INVALID_JSON"
+	ErrorCodeInvalidSeed           ErrorCode = "INVALID_SEED"
+	ErrorCodeWalletNotFound        ErrorCode = "WALLET_NOT_FOUND"
+	ErrorCodeWalletLocked          ErrorCode = "WALLET_LOCKED"
+	ErrorCodeWalletNotLocked       ErrorCode = "WALLET_NOT_LOCKED"
+	ErrorCodeInvalidKey            ErrorCode = "INVALID_KEY"
+	ErrorCodePasswordNotSet        ErrorCode = "PASSWORD_NOT_SET"
+	ErrorCodeInvalidHash           ErrorCode = "INVALID_HASH"
+	ErrorCodeWorkFailed            ErrorCode = "WORK_FAILED"
+	ErrorCodeInvalidAccount        ErrorCode = "INVALID_ACCOUNT"
+	ErrorCodeRateLimited           ErrorCode = "RATE_LIMITED"
+	ErrorCodeUnauthorized          ErrorCode = "UNAUTHORIZED"
+	ErrorCodeAdminOnly             ErrorCode = "ADMIN_ONLY"
+	ErrorCodeNotAdminAction        ErrorCode = "NOT_ADMIN_ACTION"
+	ErrorCodeControlDisabled       ErrorCode = "CONTROL_DISABLED"
+	ErrorCodeNotImplemented        ErrorCode = "NOT_IMPLEMENTED"
+	ErrorCodeInternal              ErrorCode = "INTERNAL_ERROR"
+	ErrorCodeAccountNotFound       ErrorCode = "ACCOUNT_NOT_FOUND"
+	ErrorCodeAccountExists         ErrorCode = "ACCOUNT_EXISTS"
+	ErrorCodeAccountHasBalance     ErrorCode = "ACCOUNT_HAS_BALANCE"
+	ErrorCodeAccountUnopened       ErrorCode = "ACCOUNT_UNOPENED"
+	ErrorCodeLastAccount           ErrorCode = "LAST_ACCOUNT"
+	ErrorCodeInvalidIndex          ErrorCode = "INVALID_INDEX"
+	ErrorCodeInvalidRepresentative ErrorCode = "INVALID_REPRESENTATIVE"
+	ErrorCodeInvalidAmount         ErrorCode = "INVALID_AMOUNT"
+	ErrorCodeInsufficientBalance   ErrorCode = "INSUFFICIENT_BALANCE"
+	ErrorCodeBlockNotFound         ErrorCode = "BLOCK_NOT_FOUND"
+	ErrorCodeBlockFailed           ErrorCode = "BLOCK_FAILED"
+	ErrorCodeInvalidBlock          ErrorCode = "INVALID_BLOCK"
+	ErrorCodeInvalidSignature      ErrorCode = "INVALID_SIGNATURE"
+	ErrorCodeAlreadyConfirmed      ErrorCode = "ALREADY_CONFIRMED"
+	ErrorCodeDestinationUnopened   ErrorCode = "DESTINATION_UNOPENED"
+	ErrorCodeInvalidSendID         ErrorCode = "INVALID_SEND_ID"
+	ErrorCodeSendIDMismatch        ErrorCode = "SEND_ID_MISMATCH"
+	ErrorCodeSameWallet            ErrorCode = "SAME_WALLET"
+	ErrorCodeInvalidIdempotencyKey ErrorCode = "INVALID_IDEMPOTENCY_KEY"
+	ErrorCodeIdempotencyKeyInUse   ErrorCode = "IDEMPOTENCY_KEY_IN_USE"
+	ErrorCodeGapLimitExceeded      ErrorCode = "GAP_LIMIT_EXCEEDED"
+	ErrorCodeRequestInProgress     ErrorCode = "REQUEST_IN_PROGRESS"
+	ErrorCodeInvalidBalanceFilter  ErrorCode = "INVALID_BALANCE_FILTER"
+	ErrorCodeNotSupported          ErrorCode = "NOT_SUPPORTED"
+	ErrorCodeInvalidDate           ErrorCode = "INVALID_DATE"
+	ErrorCodeInvalidDateRange      ErrorCode = "INVALID_DATE_RANGE"
+	ErrorCodeInvalidPeriod         ErrorCode = "INVALID_PERIOD"
+	ErrorCodeInvalidInterval       ErrorCode = "INVALID_INTERVAL"
+	ErrorCodeScheduleNotFound      ErrorCode = "SCHEDULE_NOT_FOUND"
+	ErrorCodeInvalidThreshold      ErrorCode = "INVALID_THRESHOLD"
+	ErrorCodeInvalidDirection      ErrorCode = "INVALID_DIRECTION"
+	ErrorCodeInvalidCallbackUrl    ErrorCode = "INVALID_CALLBACK_URL"
+	ErrorCodeAlertNotFound         ErrorCode = "ALERT_NOT_FOUND"
+	ErrorCodeInvalidLabel          ErrorCode = "INVALID_LABEL"
+	ErrorCodeSnapshotNotFound      ErrorCode = "SNAPSHOT_NOT_FOUND"
+	ErrorCodeInvalidName           ErrorCode = "INVALID_NAME"
+	ErrorCodeWalletHasFunds        ErrorCode = "WALLET_HAS_FUNDS"
+	ErrorCodeWalletExists          ErrorCode = "WALLET_EXISTS"
+	ErrorCodeSeedUnavailable       ErrorCode = "SEED_UNAVAILABLE"
+	ErrorCodeInvalidBackup         ErrorCode = "INVALID_BACKUP"
+	ErrorCodeDecryptionFailed      ErrorCode = "DECRYPTION_FAILED"
+	ErrorCodeUnsupportedCurrency   ErrorCode = "UNSUPPORTED_CURRENCY"
+	ErrorCodeInvalidUrl            ErrorCode = "INVALID_URL"
+	ErrorCodeWorkPeerExists        ErrorCode = "WORK_PEER_EXISTS"
+	ErrorCodeWorkPeerNotFound      ErrorCode = "WORK_PEER_NOT_FOUND"
+	ErrorCodeFrontierCacheDisabled ErrorCode = "FRONTIER_CACHE_DISABLED"
+	ErrorCodeInvalidJobID          ErrorCode = "INVALID_JOB_ID"
+	ErrorCodeJobNotFound           ErrorCode = "JOB_NOT_FOUND"
+	ErrorCodeHashNotFoundInChain   ErrorCode = "HASH_NOT_FOUND_IN_CHAIN"
+	ErrorCodeInvalidIP             ErrorCode = "INVALID_IP"
+	ErrorCodeWalletWatchOnly       ErrorCode = "WALLET_WATCH_ONLY"
+	ErrorCodeWalletFrozen          ErrorCode = "WALLET_FROZEN"
+	ErrorCodePipelineTooLong       ErrorCode = "PIPELINE_TOO_LONG"
+	ErrorCodeInvalidPipeline       ErrorCode = "INVALID_PIPELINE"
+	ErrorCodeWalletEncrypted       ErrorCode = "WALLET_ENCRYPTED"
+	ErrorCodeInvalidAction         ErrorCode = "INVALID_ACTION"
+	ErrorCodeInvalidEvent          ErrorCode = "INVALID_EVENT"
+	ErrorCodeTooManySubscriptions  ErrorCode = "TOO_MANY_SUBSCRIPTIONS"
+	ErrorCodeInsufficientScope     ErrorCode = "INSUFFICIENT_SCOPE"
+	ErrorCodeDailySendLimit        ErrorCode = "DAILY_SEND_LIMIT_EXCEEDED"
+	ErrorCodeTooManySends          ErrorCode = "TOO_MANY_SENDS"
+	ErrorCodeNoPrivateKey          ErrorCode = "NO_PRIVATE_KEY"
+	ErrorCodeInvalidConfig         ErrorCode = "INVALID_CONFIG"
+	ErrorCodeInvalidMetadata       ErrorCode = "INVALID_METADATA"
+	ErrorCodeInvalidVanityPattern  ErrorCode = "INVALID_VANITY_PATTERN"
+	ErrorCodeInvalidTimeout        ErrorCode = "INVALID_TIMEOUT"
+	ErrorCodeVanityNotFound        ErrorCode = "VANITY_NOT_FOUND"
+	ErrorCodeInvalidApprovalPolicy ErrorCode = "INVALID_APPROVAL_POLICY"
+	ErrorCodeApprovalNotFound      ErrorCode = "APPROVAL_NOT_FOUND"
+	ErrorCodeApprovalResolved      ErrorCode = "APPROVAL_RESOLVED"
+	ErrorCodeAlreadyApproved       ErrorCode = "ALREADY_APPROVED"
+	ErrorCodeApproverIsRequester   ErrorCode = "APPROVER_IS_REQUESTER"
+	ErrorCodeNotApprover           ErrorCode = "NOT_APPROVER"
+	ErrorCodeInvalidStatus         ErrorCode = "INVALID_STATUS"
+	ErrorCodeApprovalRequired      ErrorCode = "APPROVAL_REQUIRED"
+	ErrorCodeInvalidUnit           ErrorCode = "INVALID_UNIT"
+	ErrorCodeWorkCacheDisabled     ErrorCode = "WORK_CACHE_DISABLED"
+	ErrorCodeInvalidDifficulty     ErrorCode = "INVALID_DIFFICULTY"
+	ErrorCodeRequestTooLarge       ErrorCode = "REQUEST_TOO_LARGE"
+	ErrorCodeUnsupportedMediaType  ErrorCode = "UNSUPPORTED_MEDIA_TYPE"
+	ErrorCodeUnknownField          ErrorCode = "UNKNOWN_FIELD"
 )
 
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Error     string    `json:"error"`
+	ErrorCode ErrorCode `json:"error_code"`
 }
 
 var UnableToParseJsonError = ErrorResponse{
-	Error: "Unable to parse json",
+	Error:     "Unable to parse json",
+	ErrorCode: ErrorCodeInvalidJson,
 }
 
 func ErrUnableToParseJson(w http.ResponseWriter, r *http.Request) {
@@ -20,7 +136,8 @@ func ErrUnableToParseJson(w http.ResponseWriter, r *http.Request) {
 }
 
 var InvalidSeedError = ErrorResponse{
-	Error: "Invalid seed",
+	Error:     "Invalid seed",
+	ErrorCode: ErrorCodeInvalidSeed,
 }
 
 func ErrInvalidSeed(w http.ResponseWriter, r *http.Request) {
@@ -29,7 +146,8 @@ func ErrInvalidSeed(w http.ResponseWriter, r *http.Request) {
 }
 
 var WalletNotFoundError = ErrorResponse{
-	Error: "wallet not found",
+	Error:     "wallet not found",
+	ErrorCode: ErrorCodeWalletNotFound,
 }
 
 func ErrWalletNotFound(w http.ResponseWriter, r *http.Request) {
@@ -38,7 +156,8 @@ func ErrWalletNotFound(w http.ResponseWriter, r *http.Request) {
 }
 
 var WalletLockedError = ErrorResponse{
-	Error: "wallet locked",
+	Error:     "wallet locked",
+	ErrorCode: ErrorCodeWalletLocked,
 }
 
 func ErrWalletLocked(w http.ResponseWriter, r *http.Request) {
@@ -47,7 +166,8 @@ func ErrWalletLocked(w http.ResponseWriter, r *http.Request) {
 }
 
 var WalletNotLockedError = ErrorResponse{
-	Error: "wallet not locked",
+	Error:     "wallet not locked",
+	ErrorCode: ErrorCodeWalletNotLocked,
 }
 
 func ErrWalletNotLocked(w http.ResponseWriter, r *http.Request) {
@@ -56,7 +176,8 @@ func ErrWalletNotLocked(w http.ResponseWriter, r *http.Request) {
 }
 
 var InvalidKeyError = ErrorResponse{
-	Error: "Invalid key",
+	Error:     "Invalid key",
+	ErrorCode: ErrorCodeInvalidKey,
 }
 
 func ErrInvalidKey(w http.ResponseWriter, r *http.Request) {
@@ -65,7 +186,8 @@ func ErrInvalidKey(w http.ResponseWriter, r *http.Request) {
 }
 
 var WalletNoPasswordError = ErrorResponse{
-	Error: "password not set",
+	Error:     "password not set",
+	ErrorCode: ErrorCodePasswordNotSet,
 }
 
 func ErrNoWalletPassword(w http.ResponseWriter, r *http.Request) {
@@ -74,7 +196,8 @@ func ErrNoWalletPassword(w http.ResponseWriter, r *http.Request) {
 }
 
 var InvalidHashError = ErrorResponse{
-	Error: "Invalid hash",
+	Error:     "Invalid hash",
+	ErrorCode: ErrorCodeInvalidHash,
 }
 
 func ErrInvalidHash(w http.ResponseWriter, r *http.Request) {
@@ -83,7 +206,8 @@ func ErrInvalidHash(w http.ResponseWriter, r *http.Request) {
 }
 
 var WorkFailedError = ErrorResponse{
-	Error: "Failed to generate work",
+	Error:     "Failed to generate work",
+	ErrorCode: ErrorCodeWorkFailed,
 }
 
 func ErrWorkFailed(w http.ResponseWriter, r *http.Request) {
@@ -92,7 +216,8 @@ func ErrWorkFailed(w http.ResponseWriter, r *http.Request) {
 }
 
 var InvalidAccountError = ErrorResponse{
-	Error: "Invalid account",
+	Error:     "Invalid account",
+	ErrorCode: ErrorCodeInvalidAccount,
 }
 
 func ErrInvalidAccount(w http.ResponseWriter, r *http.Request) {
@@ -100,16 +225,163 @@ func ErrInvalidAccount(w http.ResponseWriter, r *http.Request) {
 	render.JSON(w, r, &InvalidAccountError)
 }
 
+var RateLimitedError = ErrorResponse{
+	Error:     "Too many requests",
+	ErrorCode: ErrorCodeRateLimited,
+}
+
+func ErrRateLimited(w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusTooManyRequests)
+	render.JSON(w, r, &RateLimitedError)
+}
+
+var UnauthorizedError = ErrorResponse{
+	Error:     "Unauthorized",
+	ErrorCode: ErrorCodeUnauthorized,
+}
+
+func ErrUnauthorized(w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusUnauthorized)
+	render.JSON(w, r, &UnauthorizedError)
+}
+
+// The API key's scope doesn't include scope, which the action needs
+func ErrInsufficientScope(w http.ResponseWriter, r *http.Request, scope string) {
+	render.Status(r, http.StatusForbidden)
+	render.JSON(w, r, &ErrorResponse{
+		Error:     fmt.Sprintf("This action needs an API key with the %s scope", scope),
+		ErrorCode: ErrorCodeInsufficientScope,
+	})
+}
+
+var NotApproverError = ErrorResponse{
+	Error:     "This action needs an approver API key",
+	ErrorCode: ErrorCodeNotApprover,
+}
+
+func ErrNotApprover(w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusForbidden)
+	render.JSON(w, r, &NotApproverError)
+}
+
+var AdminOnlyError = ErrorResponse{
+	Error:     "Admin action, use the /admin endpoint",
+	ErrorCode: ErrorCodeAdminOnly,
+}
+
+func ErrAdminOnly(w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusForbidden)
+	render.JSON(w, r, &AdminOnlyError)
+}
+
+var ControlDisabledError = ErrorResponse{
+	Error:     "control_disabled",
+	ErrorCode: ErrorCodeControlDisabled,
+}
+
+func ErrControlDisabled(w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusForbidden)
+	render.JSON(w, r, &ControlDisabledError)
+}
+
+// The body is larger than max_body_bytes
+func ErrRequestTooLarge(w http.ResponseWriter, r *http.Request, limit int64) {
+	render.Status(r, http.StatusRequestEntityTooLarge)
+	render.JSON(w, r, &ErrorResponse{
+		Error:     fmt.Sprintf("Request body is larger than %d bytes, see max_body_bytes", limit),
+		ErrorCode: ErrorCodeRequestTooLarge,
+	})
+}
+
+// The Content-Type isn't JSON
+func ErrUnsupportedMediaType(w http.ResponseWriter, r *http.Request, contentType string) {
+	render.Status(r, http.StatusUnsupportedMediaType)
+	render.JSON(w, r, &ErrorResponse{
+		Error:     fmt.Sprintf("Unsupported Content-Type %s, send the request as application/json", contentType),
+		ErrorCode: ErrorCodeUnsupportedMediaType,
+	})
+}
+
+// The X-Idempotency-Key was used for a request with another body
+func ErrIdempotencyKeyConflict(w http.ResponseWriter, r *http.Request) {
+	requestFailed(r, ErrorCodeIdempotencyKeyInUse, "X-Idempotency-Key was used for another request")
+	render.Status(r, http.StatusConflict)
+	render.JSON(w, r, &ErrorResponse{
+		Error:     "X-Idempotency-Key was used for another request",
+		ErrorCode: ErrorCodeIdempotencyKeyInUse,
+	})
+}
+
+// Nothing is signed for a frozen wallet, frozen_at is when it was frozen
+func ErrWalletFrozen(w http.ResponseWriter, r *http.Request, frozenAt time.Time) {
+	render.Status(r, http.StatusBadRequest)
+	render.JSON(w, r, &responses.WalletFrozenResponse{
+		Error:     "wallet_frozen",
+		ErrorCode: string(ErrorCodeWalletFrozen),
+		FrozenAt:  frozenAt.UTC().Format(time.RFC3339),
+	})
+}
+
+// Log why the request failed with its ID, and mark its span as failed
+// With the request ID from the response everything it did can be found, e.g. which node or work peer failed a send
+func requestFailed(r *http.Request, errorCode ErrorCode, errorText string) {
+	span := trace.SpanFromContext(r.Context())
+	span.SetStatus(codes.Error, errorText)
+	span.SetAttributes(attribute.String("pippin.error_code", string(errorCode)))
+	log.Ctx(r.Context()).Warn("Request failed", "error_code", errorCode, "error", errorText)
+}
+
+// Anything unexpected, the text is the error itself so they all have the same code
 func ErrInternalServerError(w http.ResponseWriter, r *http.Request, errorText string) {
+	requestFailed(r, ErrorCodeInternal, errorText)
 	render.Status(r, http.StatusInternalServerError)
 	render.JSON(w, r, &ErrorResponse{
-		Error: errorText,
+		Error:     errorText,
+		ErrorCode: ErrorCodeInternal,
 	})
 }
 
-func ErrBadRequest(w http.ResponseWriter, r *http.Request, errorText string) {
+func ErrBadRequest(w http.ResponseWriter, r *http.Request, errorCode ErrorCode, errorText string) {
+	requestFailed(r, errorCode, errorText)
 	render.Status(r, http.StatusBadRequest)
 	render.JSON(w, r, &ErrorResponse{
-		Error: errorText,
+		Error:     errorText,
+		ErrorCode: errorCode,
 	})
 }
+
+// The code for an error creating or publishing a block
+func blockErrorCode(err error) ErrorCode {
+	switch {
+	case errors.Is(err, wallet.ErrInsufficientBalance):
+		return ErrorCodeInsufficientBalance
+	case errors.Is(err, wallet.ErrBlockNotFound):
+		return ErrorCodeBlockNotFound
+	case errors.Is(err, wallet.ErrAccountNotFound):
+		return ErrorCodeAccountNotFound
+	case errors.Is(err, wallet.ErrInvalidSendID):
+		return ErrorCodeInvalidSendID
+	case errors.Is(err, wallet.ErrSendIDMismatch):
+		return ErrorCodeSendIDMismatch
+	case errors.Is(err, wallet.ErrWalletLocked):
+		return ErrorCodeWalletLocked
+	case errors.Is(err, wallet.ErrWalletWatchOnly):
+		return ErrorCodeWalletWatchOnly
+	case errors.Is(err, wallet.ErrWalletFrozen):
+		return ErrorCodeWalletFrozen
+	case errors.Is(err, wallet.ErrNoPrivateKey):
+		return ErrorCodeNoPrivateKey
+	case errors.Is(err, wallet.ErrInvalidBlock):
+		return ErrorCodeInvalidBlock
+	case errors.Is(err, wallet.ErrInvalidSignature):
+		return ErrorCodeInvalidSignature
+	case errors.Is(err, wallet.ErrDailySendLimitExceeded):
+		return ErrorCodeDailySendLimit
+	case errors.Is(err, wallet.ErrApprovalRequired):
+		return ErrorCodeApprovalRequired
+	case errors.Is(err, wallet.ErrWorkDifficultyTooLow):
+		return ErrorCodeInvalidDifficulty
+	default:
+		return ErrorCodeBlockFailed
+	}
+}
@@ -2,10 +2,13 @@ package controller
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,6 +27,7 @@ func TestErrUnableToParseJson(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "Unable to parse json", respJson["error"])
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"])
 }
 
 func TestErrInvalidSeed(t *testing.T) {
@@ -41,6 +45,7 @@ func TestErrInvalidSeed(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "Invalid seed", respJson["error"])
+	assert.Equal(t, "INVALID_SEED", respJson["error_code"])
 }
 
 func TestErrWalletNotFound(t *testing.T) {
@@ -58,6 +63,7 @@ func TestErrWalletNotFound(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "wallet not found", respJson["error"])
+	assert.Equal(t, "WALLET_NOT_FOUND", respJson["error_code"])
 }
 
 func TestErrWalletLocked(t *testing.T) {
@@ -75,6 +81,7 @@ func TestErrWalletLocked(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "wallet locked", respJson["error"])
+	assert.Equal(t, "WALLET_LOCKED", respJson["error_code"])
 }
 
 func TestErrWalletNotLocked(t *testing.T) {
@@ -92,6 +99,7 @@ func TestErrWalletNotLocked(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "wallet not locked", respJson["error"])
+	assert.Equal(t, "WALLET_NOT_LOCKED", respJson["error_code"])
 }
 
 func TestErrInvalidKey(t *testing.T) {
@@ -109,6 +117,7 @@ func TestErrInvalidKey(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "Invalid key", respJson["error"])
+	assert.Equal(t, "INVALID_KEY", respJson["error_code"])
 }
 
 func TestErrNoWalletPassword(t *testing.T) {
@@ -126,6 +135,7 @@ func TestErrNoWalletPassword(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "password not set", respJson["error"])
+	assert.Equal(t, "PASSWORD_NOT_SET", respJson["error_code"])
 }
 
 func TestErrInvalidHash(t *testing.T) {
@@ -143,6 +153,7 @@ func TestErrInvalidHash(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "Invalid hash", respJson["error"])
+	assert.Equal(t, "INVALID_HASH", respJson["error_code"])
 }
 
 func TestErrInternalServerError(t *testing.T) {
@@ -160,6 +171,7 @@ func TestErrInternalServerError(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "server error", respJson["error"])
+	assert.Equal(t, "INTERNAL_ERROR", respJson["error_code"])
 }
 
 func TestErrBadRequest(t *testing.T) {
@@ -167,7 +179,7 @@ func TestErrBadRequest(t *testing.T) {
 	// Build request
 	req := httptest.NewRequest("GET", "/", nil)
 	req.Header.Set("Content-Type", "application/json")
-	ErrBadRequest(w, req, "server error")
+	ErrBadRequest(w, req, ErrorCodeInvalidAmount, "server error")
 	resp := w.Result()
 	defer resp.Body.Close()
 	assert.Equal(t, 400, resp.StatusCode)
@@ -177,6 +189,7 @@ func TestErrBadRequest(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "server error", respJson["error"])
+	assert.Equal(t, "INVALID_AMOUNT", respJson["error_code"])
 }
 
 func TestErrWorkFailed(t *testing.T) {
@@ -194,6 +207,7 @@ func TestErrWorkFailed(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "Failed to generate work", respJson["error"])
+	assert.Equal(t, "WORK_FAILED", respJson["error_code"])
 }
 
 func TestErrInvalidAccount(t *testing.T) {
@@ -211,4 +225,88 @@ func TestErrInvalidAccount(t *testing.T) {
 	json.Unmarshal(respBody, &respJson)
 
 	assert.Equal(t, "Invalid account", respJson["error"])
+	assert.Equal(t, "INVALID_ACCOUNT", respJson["error_code"])
+}
+
+func TestErrRateLimited(t *testing.T) {
+	w := httptest.NewRecorder()
+	// Build request
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Content-Type", "application/json")
+	ErrRateLimited(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 429, resp.StatusCode)
+
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+
+	assert.Equal(t, "Too many requests", respJson["error"])
+	assert.Equal(t, "RATE_LIMITED", respJson["error_code"])
+}
+
+func TestErrUnauthorized(t *testing.T) {
+	w := httptest.NewRecorder()
+	// Build request
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Content-Type", "application/json")
+	ErrUnauthorized(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 401, resp.StatusCode)
+
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+
+	assert.Equal(t, "Unauthorized", respJson["error"])
+	assert.Equal(t, "UNAUTHORIZED", respJson["error_code"])
+}
+
+func TestErrAdminOnly(t *testing.T) {
+	w := httptest.NewRecorder()
+	// Build request
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Content-Type", "application/json")
+	ErrAdminOnly(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 403, resp.StatusCode)
+
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+
+	assert.Equal(t, "Admin action, use the /admin endpoint", respJson["error"])
+	assert.Equal(t, "ADMIN_ONLY", respJson["error_code"])
+}
+
+func TestBlockErrorCode(t *testing.T) {
+	assert.Equal(t, ErrorCodeInsufficientBalance, blockErrorCode(wallet.ErrInsufficientBalance))
+	assert.Equal(t, ErrorCodeBlockNotFound, blockErrorCode(wallet.ErrBlockNotFound))
+	assert.Equal(t, ErrorCodeAccountNotFound, blockErrorCode(fmt.Errorf("sending %w", wallet.ErrAccountNotFound)))
+	assert.Equal(t, ErrorCodeSendIDMismatch, blockErrorCode(wallet.ErrSendIDMismatch))
+	assert.Equal(t, ErrorCodeDailySendLimit, blockErrorCode(fmt.Errorf("%w, 5 raw left", wallet.ErrDailySendLimitExceeded)))
+	assert.Equal(t, ErrorCodeNoPrivateKey, blockErrorCode(wallet.ErrNoPrivateKey))
+	assert.Equal(t, ErrorCodeApprovalRequired, blockErrorCode(wallet.ErrApprovalRequired))
+	assert.Equal(t, ErrorCodeBlockFailed, blockErrorCode(errors.New("Fork")))
+}
+
+func TestErrNotApprover(t *testing.T) {
+	w := httptest.NewRecorder()
+	// Build request
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("Content-Type", "application/json")
+	ErrNotApprover(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 403, resp.StatusCode)
+
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+
+	assert.Equal(t, "This action needs an approver API key", respJson["error"])
+	assert.Equal(t, "NOT_APPROVER", respJson["error_code"])
 }
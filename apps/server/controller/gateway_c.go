@@ -1,16 +1,176 @@
 package controller
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
+	"time"
 
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/apikey"
 	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/go-chi/render"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/slices"
 )
 
-var UNSUPPORTED_WALLET_ACTIONS = []string{"account_move", "account_remove", "receive_minimum", "receive_minimum_set", "search_pending", "search_pending_all", "wallet_add_watch", "wallet_export", "wallet_history", "wallet_ledger", "wallet_republish", "wallet_work_get", "work_get", "work_set"}
+// Categories gateway_actions groups the actions by
+const (
+	gatewayCategoryWallet  = "wallet"
+	gatewayCategoryAccount = "account"
+	gatewayCategoryBlock   = "block"
+	gatewayCategoryUtility = "utility"
+	gatewayCategoryAdmin   = "admin"
+)
+
+// Handles a request for one action
+type actionHandler func(hc *HttpController, request *map[string]interface{}, w http.ResponseWriter, r *http.Request)
+
+type gatewayAction struct {
+	category string
+	handle   actionHandler
+}
+
+// The actions Gateway handles itself, anything else is forwarded to the node
+// Set in init since gateway_actions lists it
+var gatewayActions map[string]gatewayAction
+
+func init() {
+	gatewayActions = map[string]gatewayAction{
+		"wallet_create":                 {gatewayCategoryWallet, (*HttpController).HandleWalletCreate},
+		"wallet_create_from_seed":       {gatewayCategoryWallet, (*HttpController).HandleWalletCreateFromSeed},
+		"wallet_create_watch_only":      {gatewayCategoryWallet, (*HttpController).HandleWalletCreateWatchOnly},
+		"wallet_add_watch":              {gatewayCategoryWallet, (*HttpController).HandleWalletAddWatch},
+		"wallet_import_nanowallet":      {gatewayCategoryWallet, (*HttpController).HandleWalletImportNanoWallet},
+		"wallet_import_nault":           {gatewayCategoryWallet, (*HttpController).HandleWalletImportNault},
+		"wallet_backup_restore":         {gatewayCategoryWallet, (*HttpController).HandleWalletBackupRestore},
+		"account_create":                {gatewayCategoryAccount, (*HttpController).HandleAccountCreate},
+		"account_create_next":           {gatewayCategoryAccount, (*HttpController).HandleAccountCreateNext},
+		"account_create_vanity":         {gatewayCategoryAccount, (*HttpController).HandleAccountCreateVanity},
+		"accounts_create":               {gatewayCategoryAccount, (*HttpController).HandleAccountsCreate},
+		"accounts_filter":               {gatewayCategoryAccount, (*HttpController).HandleAccountsFilter},
+		"accounts_weight":               {gatewayCategoryAccount, (*HttpController).HandleAccountsWeight},
+		"accounts_info":                 {gatewayCategoryAccount, (*HttpController).HandleAccountsInfo},
+		"account_balance_history":       {gatewayCategoryAccount, (*HttpController).HandleAccountBalanceHistory},
+		"account_history_all":           {gatewayCategoryAccount, (*HttpController).HandleAccountHistoryAll},
+		"account_history_since":         {gatewayCategoryAccount, (*HttpController).HandleAccountHistorySince},
+		"accounts_sync":                 {gatewayCategoryAccount, (*HttpController).HandleAccountsSync},
+		"account_sync":                  {gatewayCategoryAccount, (*HttpController).HandleAccountSync},
+		"account_list":                  {gatewayCategoryAccount, (*HttpController).HandleAccountList},
+		"account_remove":                {gatewayCategoryAccount, (*HttpController).HandleAccountRemove},
+		"account_label_set":             {gatewayCategoryAccount, (*HttpController).HandleAccountLabelSet},
+		"account_label_get":             {gatewayCategoryAccount, (*HttpController).HandleAccountLabelGet},
+		"account_move":                  {gatewayCategoryAccount, (*HttpController).HandleAccountMove},
+		"password_change":               {gatewayCategoryWallet, (*HttpController).HandlePasswordChange},
+		"password_enter":                {gatewayCategoryWallet, (*HttpController).HandlePasswordEnter},
+		"wallet_add":                    {gatewayCategoryWallet, (*HttpController).HandleWalletAdd},
+		"wallet_locked":                 {gatewayCategoryWallet, (*HttpController).HandleWalletLocked},
+		"wallet_lock":                   {gatewayCategoryWallet, (*HttpController).HandleWalletLock},
+		"wallet_balances":               {gatewayCategoryWallet, (*HttpController).HandleWalletBalances},
+		"wallet_balance_total":          {gatewayCategoryWallet, (*HttpController).HandleWalletBalanceTotal},
+		"wallet_frontiers":              {gatewayCategoryWallet, (*HttpController).HandleWalletFrontiers},
+		"wallet_pending":                {gatewayCategoryWallet, (*HttpController).HandleWalletPending},
+		"wallet_ledger":                 {gatewayCategoryWallet, (*HttpController).HandleWalletLedger},
+		"snapshot_balances":             {gatewayCategoryWallet, (*HttpController).HandleSnapshotBalances},
+		"list_snapshots":                {gatewayCategoryWallet, (*HttpController).HandleListSnapshots},
+		"get_snapshot":                  {gatewayCategoryWallet, (*HttpController).HandleGetSnapshot},
+		"deterministic_key":             {gatewayCategoryUtility, (*HttpController).HandleDeterministicKey},
+		"key_valid":                     {gatewayCategoryUtility, (*HttpController).HandleKeyValid},
+		"work_generate":                 {gatewayCategoryUtility, (*HttpController).HandleWorkGenerate},
+		"wallet_info":                   {gatewayCategoryWallet, (*HttpController).HandleWalletInfo},
+		"wallet_contains":               {gatewayCategoryWallet, (*HttpController).HandleWalletContains},
+		"wallet_verify":                 {gatewayCategoryWallet, (*HttpController).HandleWalletVerify},
+		"wallet_reconcile":              {gatewayCategoryWallet, (*HttpController).HandleWalletReconcile},
+		"wallet_accounts_reindex":       {gatewayCategoryWallet, (*HttpController).HandleWalletAccountsReindex},
+		"wallet_statistics":             {gatewayCategoryWallet, (*HttpController).HandleWalletStatistics},
+		"receive":                       {gatewayCategoryBlock, (*HttpController).HandleReceiveRequest},
+		"receive_all":                   {gatewayCategoryBlock, (*HttpController).HandleReceiveAllRequest},
+		"receive_batch":                 {gatewayCategoryBlock, (*HttpController).HandleReceiveBatchRequest},
+		"send":                          {gatewayCategoryBlock, (*HttpController).HandleSendRequest},
+		"send_with_id":                  {gatewayCategoryBlock, (*HttpController).HandleSendWithIDRequest},
+		"send_bulk":                     {gatewayCategoryBlock, (*HttpController).HandleSendBulkRequest},
+		"send_raw":                      {gatewayCategoryBlock, (*HttpController).HandleSendRawRequest},
+		"sign_block":                    {gatewayCategoryBlock, (*HttpController).HandleSignBlockRequest},
+		"sign_message":                  {gatewayCategoryAccount, (*HttpController).HandleSignMessage},
+		"verify_message":                {gatewayCategoryUtility, (*HttpController).HandleVerifyMessage},
+		"sweep_to_wallet":               {gatewayCategoryBlock, (*HttpController).HandleSweepToWalletRequest},
+		"wallet_sweep":                  {gatewayCategoryBlock, (*HttpController).HandleWalletSweepRequest},
+		"cross_wallet_transfer":         {gatewayCategoryBlock, (*HttpController).HandleCrossWalletTransferRequest},
+		"block_count":                   {gatewayCategoryBlock, (*HttpController).HandleBlockCount},
+		"election_statistics":           {gatewayCategoryUtility, (*HttpController).HandleElectionStatistics},
+		"network_stats":                 {gatewayCategoryUtility, (*HttpController).HandleNetworkStats},
+		"nano_difficulty_info":          {gatewayCategoryUtility, (*HttpController).HandleNanoDifficultyInfo},
+		"work_difficulty_history":       {gatewayCategoryUtility, (*HttpController).HandleWorkDifficultyHistory},
+		"nano_supply":                   {gatewayCategoryUtility, (*HttpController).HandleNanoSupply},
+		"circulating_supply":            {gatewayCategoryUtility, (*HttpController).HandleCirculatingSupply},
+		"representative_info":           {gatewayCategoryUtility, (*HttpController).HandleRepresentativeInfo},
+		"delegators":                    {gatewayCategoryUtility, (*HttpController).HandleDelegators},
+		"delegators_count":              {gatewayCategoryUtility, (*HttpController).HandleDelegatorsCount},
+		"confirmation_quorum":           {gatewayCategoryUtility, (*HttpController).HandleConfirmationQuorum},
+		"chain":                         {gatewayCategoryBlock, (*HttpController).HandleChain},
+		"block_confirm":                 {gatewayCategoryBlock, (*HttpController).HandleBlockConfirmRequest},
+		"block_rebroadcast":             {gatewayCategoryBlock, (*HttpController).HandleBlockRebroadcastRequest},
+		"send_confirmation_poll":        {gatewayCategoryBlock, (*HttpController).HandleSendConfirmationPollRequest},
+		"block_successor":               {gatewayCategoryBlock, (*HttpController).HandleBlockSuccessorRequest},
+		"block_predecessor":             {gatewayCategoryBlock, (*HttpController).HandleBlockPredecessorRequest},
+		"job_status":                    {gatewayCategoryUtility, (*HttpController).HandleJobStatus},
+		"nano_version":                  {gatewayCategoryUtility, (*HttpController).HandleNanoVersion},
+		"pending_exists":                {gatewayCategoryBlock, (*HttpController).HandlePendingExistsRequest},
+		"receivable_exists":             {gatewayCategoryBlock, (*HttpController).HandleReceivableExistsRequest},
+		"send_schedule":                 {gatewayCategoryBlock, (*HttpController).HandleSendScheduleRequest},
+		"send_schedule_cancel":          {gatewayCategoryBlock, (*HttpController).HandleSendScheduleCancelRequest},
+		"send_approve":                  {gatewayCategoryBlock, (*HttpController).HandleSendApproveRequest},
+		"send_reject":                   {gatewayCategoryBlock, (*HttpController).HandleSendRejectRequest},
+		"send_approval_list":            {gatewayCategoryBlock, (*HttpController).HandleSendApprovalListRequest},
+		"alert_register":                {gatewayCategoryAccount, (*HttpController).HandleAlertRegisterRequest},
+		"alert_list":                    {gatewayCategoryAccount, (*HttpController).HandleAlertListRequest},
+		"alert_delete":                  {gatewayCategoryAccount, (*HttpController).HandleAlertDeleteRequest},
+		"account_balance":               {gatewayCategoryAccount, (*HttpController).HandleAccountBalance},
+		"account_info":                  {gatewayCategoryAccount, (*HttpController).HandleAccountInfo},
+		"account_representative":        {gatewayCategoryAccount, (*HttpController).HandleAccountRepresentative},
+		"block_count_for_account":       {gatewayCategoryAccount, (*HttpController).HandleBlockCountForAccount},
+		"account_frontier":              {gatewayCategoryAccount, (*HttpController).HandleAccountFrontier},
+		"account_representative_check":  {gatewayCategoryAccount, (*HttpController).HandleAccountRepresentativeCheck},
+		"account_weight":                {gatewayCategoryAccount, (*HttpController).HandleAccountWeight},
+		"account_full_info":             {gatewayCategoryAccount, (*HttpController).HandleAccountFullInfo},
+		"validate_account_number":       {gatewayCategoryAccount, (*HttpController).HandleValidateAccountNumber},
+		"account_representative_set":    {gatewayCategoryAccount, (*HttpController).HandleAccountRepresentativeSetRequest},
+		"accounts_representative_set":   {gatewayCategoryAccount, (*HttpController).HandleAccountsRepresentativeSetRequest},
+		"wallet_representative_set":     {gatewayCategoryWallet, (*HttpController).HandleWalletRepresentativeSetRequest},
+		"wallet_auto_receive_set":       {gatewayCategoryWallet, (*HttpController).HandleWalletAutoReceiveSet},
+		"wallet_name_set":               {gatewayCategoryWallet, (*HttpController).HandleWalletNameSet},
+		"receive_minimum":               {gatewayCategoryWallet, (*HttpController).HandleReceiveMinimum},
+		"receive_minimum_set":           {gatewayCategoryWallet, (*HttpController).HandleReceiveMinimumSet},
+		"wallet_representative":         {gatewayCategoryWallet, (*HttpController).HandleWalletRepresentativeRequest},
+		"wallet_representative_history": {gatewayCategoryWallet, (*HttpController).HandleWalletRepresentativeHistoryRequest},
+		"wallet_history":                {gatewayCategoryWallet, (*HttpController).HandleWalletHistory},
+		"wallet_audit":                  {gatewayCategoryWallet, (*HttpController).HandleWalletAudit},
+		"gateway_actions":               {gatewayCategoryUtility, (*HttpController).HandleGatewayActions},
+		"pipeline":                      {gatewayCategoryUtility, (*HttpController).HandlePipeline},
+	}
+}
+
+var UNSUPPORTED_WALLET_ACTIONS = []string{"search_pending", "search_pending_all", "wallet_export", "wallet_republish", "wallet_work_get", "work_get", "work_set"}
+
+// Refused by both gateways when enable_control is false, like the node refuses its own without enable_control
+// Pippin's actions that destroy or reveal keys, and the node's that change the node
+// block_create is also refused when it's given a key or wallet to sign with, see controlDisabled
+var CONTROL_ACTIONS = []string{"account_remove", "account_move", "wallet_destroy", "wallet_change_seed", "wallet_seed", "wallet_backup_create", "work_peer_add", "work_peer_remove", "work_cancel_all", "sign_block", "epoch_upgrade", "node_id", "sign", "stop", "unchecked_clear", "work_cancel", "work_peers_clear"}
+
+// Whether an action is refused because enable_control is false
+func (hc *HttpController) controlDisabled(action string, request map[string]interface{}) bool {
+	if hc.Wallet == nil || hc.Wallet.Config == nil || hc.Wallet.Config.Server.EnableControl == nil || *hc.Wallet.Config.Server.EnableControl {
+		return false
+	}
+	if action == "block_create" {
+		_, hasKey := request["key"]
+		_, hasWallet := request["wallet"]
+		return hasKey || hasWallet
+	}
+	return slices.Contains(CONTROL_ACTIONS, action)
+}
 
 // This is called the "Gateway" because it's the entry point for all requests
 // This API is intended to replace the nano node wallet RPCs
@@ -23,101 +183,161 @@ var UNSUPPORTED_WALLET_ACTIONS = []string{"account_move", "account_remove", "rec
 // The node isn't exactly great at returning errors, and the error messages are not very helpful
 // But as we want to be a drop-in replacement we mimic the behavior
 func (hc *HttpController) Gateway(w http.ResponseWriter, r *http.Request) {
-	var baseRequest map[string]interface{}
-	if err := json.NewDecoder(r.Body).Decode(&baseRequest); err != nil {
-		log.Errorf("Error unmarshalling http base request %s", err)
-		ErrUnableToParseJson(w, r)
+	// With require_api_key it's limited per key instead, once authenticate has checked it
+	if !hc.requireApiKey() && hc.RateLimiter != nil && !hc.RateLimiter.Allow(requestIP(r)) {
+		ErrRateLimited(w, r)
 		return
 	}
 
-	if _, ok := baseRequest["action"]; !ok {
-		ErrUnableToParseJson(w, r)
+	baseRequest, ok := hc.decodeRequest(w, r)
+	if !ok {
 		return
 	}
 
 	action := strings.ToLower(fmt.Sprintf("%v", baseRequest["action"]))
+	traceAction(r, action, baseRequest)
 
-	if slices.Contains(UNSUPPORTED_WALLET_ACTIONS, action) {
-		ErrBadRequest(w, r, "not_implemented")
+	r, ok = hc.authenticate(baseRequest, w, r)
+	if !ok {
 		return
 	}
 
-	switch action {
-	case "wallet_create":
-		hc.HandleWalletCreate(&baseRequest, w, r)
-		return
-	case "account_create":
-		hc.HandleAccountCreate(&baseRequest, w, r)
-		return
-	case "accounts_create":
-		hc.HandleAccountsCreate(&baseRequest, w, r)
-		return
-	case "account_list":
-		hc.HandleAccountList(&baseRequest, w, r)
-		return
-	case "password_change":
-		hc.HandlePasswordChange(&baseRequest, w, r)
-		return
-	case "password_enter":
-		hc.HandlePasswordEnter(&baseRequest, w, r)
+	if hc.refuseAction(action, baseRequest, w, r) {
 		return
-	case "wallet_add":
-		hc.HandleWalletAdd(&baseRequest, w, r)
-		return
-	case "wallet_locked":
-		hc.HandleWalletLocked(&baseRequest, w, r)
-		return
-	case "wallet_lock":
-		hc.HandleWalletLock(&baseRequest, w, r)
-		return
-	case "wallet_destroy":
-		hc.HandleWalletDestroy(&baseRequest, w, r)
-		return
-	case "wallet_balances":
-		hc.HandleWalletBalances(&baseRequest, w, r)
-		return
-	case "wallet_frontiers":
-		hc.HandleWalletFrontiers(&baseRequest, w, r)
-		return
-	case "wallet_pending":
-		hc.HandleWalletPending(&baseRequest, w, r)
-		return
-	case "work_generate":
-		hc.HandleWorkGenerate(&baseRequest, w, r)
-		return
-	case "wallet_info":
-		hc.HandleWalletInfo(&baseRequest, w, r)
-		return
-	case "wallet_contains":
-		hc.HandleWalletContains(&baseRequest, w, r)
-		return
-	case "receive":
-		hc.HandleReceiveRequest(&baseRequest, w, r)
-		return
-	case "receive_all":
-		hc.HandleReceiveAllRequest(&baseRequest, w, r)
-		return
-	case "send":
-		hc.HandleSendRequest(&baseRequest, w, r)
-		return
-	case "account_representative_set":
-		hc.HandleAccountRepresentativeSetRequest(&baseRequest, w, r)
-		return
-	case "wallet_representative_set":
-		hc.HandleWalletRepresentativeSetRequest(&baseRequest, w, r)
+	}
+
+	// Retries with the same X-Idempotency-Key get the first response
+	if idempotencyKey := r.Header.Get(idempotencyKeyHeader); idempotencyKey != "" && slices.Contains(DEDUPED_ACTIONS, action) {
+		recorder, done, replayed := hc.dedupeRequest(idempotencyKey, action, baseRequest, w, r)
+		if replayed {
+			return
+		}
+		defer done()
+		w = recorder
+	}
+
+	hc.dispatchAction(action, &baseRequest, w, r)
+}
+
+// Name the request's span after its action, with the wallet it's for
+func traceAction(r *http.Request, action string, request map[string]interface{}) {
+	span := trace.SpanFromContext(r.Context())
+	if !span.IsRecording() {
 		return
-	case "wallet_representative":
-		hc.HandleWalletRepresentativeRequest(&baseRequest, w, r)
+	}
+	span.SetName(action)
+	span.SetAttributes(attribute.String("pippin.action", action))
+	if walletID, ok := request["wallet"].(string); ok {
+		span.SetAttributes(attribute.String("pippin.wallet", walletID))
+	}
+}
+
+// Write the error for an action the gateway doesn't serve, true if it was refused
+func (hc *HttpController) refuseAction(action string, request map[string]interface{}, w http.ResponseWriter, r *http.Request) bool {
+	if slices.Contains(UNSUPPORTED_WALLET_ACTIONS, action) {
+		ErrBadRequest(w, r, ErrorCodeNotImplemented, "not_implemented")
+		return true
+	}
+
+	// Admin actions are only served by the admin gateway
+	if _, ok := adminActions[action]; ok {
+		ErrAdminOnly(w, r)
+		return true
+	}
+
+	if hc.refuseUnknownFields(action, request, w, r) {
+		return true
+	}
+
+	if scope, refused := apiKeyRefuses(action, r); refused {
+		ErrInsufficientScope(w, r, string(scope))
+		return true
+	}
+
+	if hc.controlDisabled(action, request) {
+		ErrControlDisabled(w, r)
+		return true
+	}
+
+	if hc.sessionLocked(action, request, w, r) {
+		return true
+	}
+	return false
+}
+
+// Actions that work whatever session unlocked the wallet
+var SESSION_FREE_ACTIONS = []string{"password_enter", "wallet_lock", "wallet_locked"}
+
+// Write wallet_locked if the request's wallet is unlocked, but not for its API key, true if it was refused
+// Actions that only read aren't refused, every request for a wallet unlocked for it starts the auto lock countdown over
+func (hc *HttpController) sessionLocked(action string, request map[string]interface{}, w http.ResponseWriter, r *http.Request) bool {
+	walletID, ok := request["wallet"].(string)
+	if !ok || hc.Wallet == nil {
+		return false
+	}
+	dbWallet, err := hc.Wallet.GetWallet(walletID)
+	if err != nil || !dbWallet.Encrypted {
+		// The handler has the errors
+		return false
+	}
+	session := apiKeySession(r)
+	unlocked, err := hc.Wallet.SessionUnlocked(dbWallet, session)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return true
+	} else if unlocked {
+		if err := hc.Wallet.TouchWalletSession(dbWallet, session); err != nil {
+			log.Warnf("Unable to refresh the auto lock of wallet %s %v", dbWallet.ID, err)
+		}
+		return false
+	}
+	if slices.Contains(SESSION_FREE_ACTIONS, action) || actionScope(action) == apikey.ScopeRead {
+		return false
+	}
+	// Locked for everyone, the handler knows whether the action needs it unlocked
+	if unlocked, err := hc.Wallet.SessionUnlocked(dbWallet, ""); err != nil || !unlocked {
+		return false
+	}
+	ErrWalletLocked(w, r)
+	return true
+}
+
+// Handle the action if it's one of gatewayActions, otherwise forward it to the node
+func (hc *HttpController) dispatchAction(action string, request *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	if handler, ok := gatewayActions[action]; ok {
+		defer observeAction(action, time.Now())
+		hc.auditAction(action, *request, w, r, func(w http.ResponseWriter) {
+			handler.handle(hc, request, w, r)
+		})
 		return
-	case "wallet_change_seed":
-		hc.HandleWalletChangeSeedRequest(&baseRequest, w, r)
+	}
+	// Any string can be forwarded, so they share a label
+	defer observeAction(forwardedActionLabel, time.Now())
+
+	resp, err := hc.RpcClient.MakeRequestWithContext(r.Context(), *request)
+	if err != nil {
+		ErrInternalServerError(w, r, "Error forwarding request to node")
 		return
-	default:
-		resp, err := hc.RpcClient.MakeRequest(baseRequest)
-		if err != nil {
-			ErrInternalServerError(w, r, "Error forwarding request to node")
-		}
-		w.Header().Set("Content-Type", "application/json")
-		w.Write(resp)
 	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
+
+// Handle gateway_actions, every action handled by Pippin instead of the node, by category
+func (hc *HttpController) HandleGatewayActions(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	actions := map[string][]string{}
+	for action, handler := range gatewayActions {
+		actions[handler.category] = append(actions[handler.category], action)
+	}
+	for action := range adminActions {
+		actions[gatewayCategoryAdmin] = append(actions[gatewayCategoryAdmin], action)
+	}
+	for _, names := range actions {
+		sort.Strings(names)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.GatewayActionsResponse{
+		Actions: actions,
+	})
 }
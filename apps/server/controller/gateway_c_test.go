@@ -4,17 +4,25 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
+	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/appditto/pippin_nano_wallet/libs/cache"
 	"github.com/appditto/pippin_nano_wallet/libs/config"
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
 	"github.com/appditto/pippin_nano_wallet/libs/database"
 	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/pow"
 	"github.com/appditto/pippin_nano_wallet/libs/rpc"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,6 +31,9 @@ import (
 // ! e.g., we just test that the API returns the response we expect, but don't verify it exists in the database
 
 var MockController *HttpController
+var MockConfig *models.PippinConfig
+
+const mockAdminToken = "4d1f0c9b7a2e6d3f8b5a1c0e9d7f2b4a"
 
 func TestMain(m *testing.M) {
 	os.Exit(testMainWrapper(m))
@@ -35,6 +46,7 @@ func testMainWrapper(m *testing.M) int {
 	defer os.Unsetenv("HOME")
 	defer os.RemoveAll(".testdata")
 	config, _ := config.ParsePippinConfig()
+	MockConfig = config
 	// We use an in-memory sqlite database for testing
 	ctx := context.Background()
 	dbconn, err := database.GetSqlDbConn(true)
@@ -61,19 +73,57 @@ func testMainWrapper(m *testing.M) int {
 		Ctx:        ctx,
 		Banano:     false,
 		Config:     config,
-		WorkClient: pow.NewPippinPow([]string{}, "", "", 30),
+		WorkClient: pow.NewPippinPow([]string{}, "", "", nil),
 		RpcClient:  rpc.NewRPCClient("http://localhost:123456"),
 	}
 
 	MockController = &HttpController{
-		Wallet:    &wallet,
-		RpcClient: rpc.NewRPCClient("http://localhost:123456"),
-		PowClient: pow.NewPippinPow([]string{}, "", "", 30),
+		Wallet:     &wallet,
+		RpcClient:  rpc.NewRPCClient("http://localhost:123456"),
+		PowClient:  pow.NewPippinPow([]string{}, "", "", nil),
+		Cache:      cache.NewMemoryCache(),
+		AdminToken: mockAdminToken,
 	}
 	return m.Run()
 }
 
+// Creates a controller backed by its own in-memory database, so tests using it
+// can't see wallets or accounts created by any other test
+func newTestController(t *testing.T) *HttpController {
+	t.Helper()
+	ctx := context.Background()
+	dbconn := &database.SqliteConn{FileName: fmt.Sprintf("testing_%s", uuid.New().String()), Mode: "memory"}
+	entClient, err := database.NewEntClient(dbconn)
+	if err != nil {
+		t.Fatalf("Failed to create ent client: %v", err)
+	}
+	t.Cleanup(func() { entClient.Close() })
+
+	// Create schema
+	if err := entClient.Schema.Create(ctx); err != nil {
+		t.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	wallet := wallet.NanoWallet{
+		DB:         entClient,
+		Ctx:        ctx,
+		Banano:     false,
+		Config:     MockConfig,
+		WorkClient: pow.NewPippinPow([]string{}, "", "", nil),
+		RpcClient:  rpc.NewRPCClient("http://localhost:123456"),
+	}
+
+	return &HttpController{
+		Wallet:     &wallet,
+		RpcClient:  rpc.NewRPCClient("http://localhost:123456"),
+		PowClient:  pow.NewPippinPow([]string{}, "", "", nil),
+		Cache:      cache.NewMemoryCache(),
+		AdminToken: mockAdminToken,
+	}
+}
+
 func TestBadJson(t *testing.T) {
+	hc := newTestController(t)
 	// Request JSON
 	reqBody := map[string]interface{}{
 		"badjson": "badjson",
@@ -83,7 +133,7 @@ func TestBadJson(t *testing.T) {
 	// Build request
 	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
-	MockController.Gateway(w, req)
+	hc.Gateway(w, req)
 	resp := w.Result()
 	defer resp.Body.Close()
 	assert.Equal(t, 400, resp.StatusCode)
@@ -92,20 +142,21 @@ func TestBadJson(t *testing.T) {
 	respBody, _ := io.ReadAll(resp.Body)
 	json.Unmarshal(respBody, &respJson)
 
-	assert.Equal(t, "Unable to parse json", respJson["error"])
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"])
 }
 
 func TestUnsupportedAction(t *testing.T) {
+	hc := newTestController(t)
 	// Request JSON
 	reqBody := map[string]interface{}{
-		"action": "account_move",
+		"action": "wallet_republish",
 	}
 	body, _ := json.Marshal(reqBody)
 	w := httptest.NewRecorder()
 	// Build request
 	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
-	MockController.Gateway(w, req)
+	hc.Gateway(w, req)
 	resp := w.Result()
 	defer resp.Body.Close()
 	assert.Equal(t, 400, resp.StatusCode)
@@ -114,5 +165,123 @@ func TestUnsupportedAction(t *testing.T) {
 	respBody, _ := io.ReadAll(resp.Body)
 	json.Unmarshal(respBody, &respJson)
 
-	assert.Equal(t, "not_implemented", respJson["error"])
+	assert.Equal(t, "NOT_IMPLEMENTED", respJson["error_code"])
+}
+
+func TestGatewayActions(t *testing.T) {
+	hc := newTestController(t)
+	body, _ := json.Marshal(map[string]interface{}{
+		"action": "gateway_actions",
+	})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	hc.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var respJson struct {
+		Actions map[string][]string `json:"actions"`
+	}
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Nil(t, json.Unmarshal(respBody, &respJson))
+
+	// Every action of the dispatch tables is listed once, under its category
+	listed := 0
+	for _, actions := range respJson.Actions {
+		listed += len(actions)
+	}
+	assert.Equal(t, len(gatewayActions)+len(adminActions), listed)
+	for action, handler := range gatewayActions {
+		assert.Contains(t, respJson.Actions[handler.category], action)
+	}
+	for action := range adminActions {
+		assert.Contains(t, respJson.Actions["admin"], action)
+	}
+	assert.ElementsMatch(t, []string{"wallet", "account", "block", "utility", "admin"}, tableActions(respJson.Actions))
+	assert.Contains(t, respJson.Actions["utility"], "gateway_actions")
+	assert.Contains(t, respJson.Actions["block"], "send")
+	assert.IsNonDecreasing(t, respJson.Actions["wallet"])
+}
+
+func TestControlDisabled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var forwarded []string
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			forwarded = append(forwarded, fmt.Sprint(pr["action"]))
+			// Nothing in the wallet, so it can be destroyed
+			return httpmock.NewStringResponse(200, `{"balances":{}}`), nil
+		},
+	)
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("a8d1e4b7c0f3a6d9e2b5c8f1a4d7e0b3c6f9a2d5e8b1c4f7a0d3e6b9c2f5a8d1"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+
+	doRequest := func(handler http.HandlerFunc, reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		handler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+	signing := map[string]interface{}{"action": "block_create", "key": "c37bd1bce9bd8b69c401577773c610e4e84461f9d67b6bc2e9a2b3786b84a8fe"}
+
+	// Served by default
+	doRequest(hc.Gateway, signing)
+	doRequest(hc.Gateway, map[string]interface{}{"action": "stop"})
+	assert.Equal(t, []string{"block_create", "stop"}, forwarded)
+
+	conf := *MockConfig
+	disabled := false
+	conf.Server.EnableControl = &disabled
+	hc.Wallet.Config = &conf
+
+	// Control actions are refused by both gateways
+	for _, request := range []map[string]interface{}{
+		signing,
+		{"action": "block_create", "wallet": wallet.ID.String()},
+		{"action": "stop"},
+		{"action": "sign_block", "wallet": wallet.ID.String(), "block": map[string]interface{}{}},
+		{"action": "account_remove", "wallet": wallet.ID.String(), "account": "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est"},
+	} {
+		status, respJson := doRequest(hc.Gateway, request)
+		assert.Equal(t, 403, status)
+		assert.Equal(t, "control_disabled", respJson["error"])
+		assert.Equal(t, "CONTROL_DISABLED", respJson["error_code"])
+	}
+	status, respJson := doRequest(hc.AdminHandler, map[string]interface{}{"action": "wallet_destroy", "wallet": wallet.ID.String()})
+	assert.Equal(t, 403, status)
+	assert.Equal(t, "CONTROL_DISABLED", respJson["error_code"])
+	_, err := hc.Wallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"block_create", "stop"}, forwarded)
+
+	// Anything else still is, block_create without a key or wallet doesn't sign
+	status, _ = doRequest(hc.Gateway, map[string]interface{}{"action": "block_create"})
+	assert.Equal(t, 200, status)
+	status, _ = doRequest(hc.Gateway, map[string]interface{}{"action": "wallet_locked", "wallet": wallet.ID.String()})
+	assert.Equal(t, 200, status)
+	status, _ = doRequest(hc.AdminHandler, map[string]interface{}{"action": "work_peers"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []string{"block_create", "stop", "block_create"}, forwarded)
+
+	// Enabling it serves them again
+	enabled := true
+	conf.Server.EnableControl = &enabled
+	status, respJson = doRequest(hc.AdminHandler, map[string]interface{}{"action": "wallet_destroy", "wallet": wallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["destroyed"])
 }
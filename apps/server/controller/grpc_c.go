@@ -0,0 +1,224 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/apps/server/pippinpb"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// The gRPC WalletService, served on grpc_port
+// Each call goes through the gateway as the action it's named after, so API keys, scopes, rate limits,
+// idempotency keys and the audit log work like they do for JSON requests
+
+// Trailer with the gateway's error_code of a failed call
+const grpcErrorCodeTrailer = "pippin-error-code"
+
+type GrpcController struct {
+	pippinpb.UnimplementedWalletServiceServer
+	hc *HttpController
+}
+
+func NewGrpcController(hc *HttpController) *GrpcController {
+	return &GrpcController{hc: hc}
+}
+
+// A gRPC server with the WalletService registered
+func NewGrpcServer(hc *HttpController, opts ...grpc.ServerOption) *grpc.Server {
+	server := grpc.NewServer(opts...)
+	pippinpb.RegisterWalletServiceServer(server, NewGrpcController(hc))
+	return server
+}
+
+// The gateway request for a call, its metadata becomes the headers the gateway reads
+func grpcHttpRequest(ctx context.Context, body []byte) *http.Request {
+	r, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	if p, ok := peer.FromContext(ctx); ok {
+		r.RemoteAddr = p.Addr.String()
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for key, header := range map[string]string{"x-api-key": apiKeyHeader, "x-idempotency-key": idempotencyKeyHeader} {
+		if values := md.Get(key); len(values) > 0 {
+			r.Header.Set(header, values[0])
+		}
+	}
+	return r
+}
+
+// The gRPC code for a gateway error
+func grpcCode(httpStatus int, errorCode ErrorCode) codes.Code {
+	switch {
+	case errorCode == ErrorCodeWalletNotFound:
+		return codes.NotFound
+	case httpStatus == http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case httpStatus == http.StatusForbidden:
+		return codes.PermissionDenied
+	case httpStatus == http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case httpStatus == http.StatusBadRequest:
+		return codes.InvalidArgument
+	}
+	return codes.Internal
+}
+
+func grpcError(ctx context.Context, httpStatus int, errResp ErrorResponse) error {
+	grpc.SetTrailer(ctx, metadata.Pairs(grpcErrorCodeTrailer, string(errResp.ErrorCode)))
+	return status.Error(grpcCode(httpStatus, errResp.ErrorCode), errResp.Error)
+}
+
+// Run action through the gateway and decode its response into resp
+func (gc *GrpcController) gateway(ctx context.Context, request map[string]interface{}, resp interface{}) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	pw := &pipelineWriter{header: http.Header{}}
+	gc.hc.Gateway(pw, grpcHttpRequest(ctx, body))
+
+	var errResp ErrorResponse
+	if err := json.Unmarshal(pw.body.Bytes(), &errResp); err != nil {
+		return status.Error(codes.Internal, pw.body.String())
+	} else if errResp.Error != "" || pw.status != http.StatusOK {
+		return grpcError(ctx, pw.status, errResp)
+	}
+	if err := json.Unmarshal(pw.body.Bytes(), resp); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func (gc *GrpcController) CreateWallet(ctx context.Context, in *pippinpb.CreateWalletRequest) (*pippinpb.CreateWalletResponse, error) {
+	request := map[string]interface{}{"action": "wallet_create", "return_seed": in.ReturnSeed}
+	if in.Seed != "" {
+		request["seed"] = in.Seed
+	}
+	var resp responses.WalletCreateResponse
+	if err := gc.gateway(ctx, request, &resp); err != nil {
+		return nil, err
+	}
+	out := &pippinpb.CreateWalletResponse{Wallet: resp.Wallet}
+	if resp.Seed != nil {
+		out.Seed = *resp.Seed
+	}
+	return out, nil
+}
+
+func (gc *GrpcController) Send(ctx context.Context, in *pippinpb.SendRequest) (*pippinpb.SendResponse, error) {
+	request := map[string]interface{}{
+		"action":      "send",
+		"wallet":      in.Wallet,
+		"source":      in.Source,
+		"destination": in.Destination,
+		"amount":      in.Amount,
+	}
+	if in.Id != "" {
+		request["id"] = in.Id
+	}
+	if in.Work != "" {
+		request["work"] = in.Work
+	}
+	var resp responses.SendResponse
+	if err := gc.gateway(ctx, request, &resp); err != nil {
+		return nil, err
+	}
+	return &pippinpb.SendResponse{Block: resp.Block, DestinationUnopened: resp.DestinationUnopened}, nil
+}
+
+func (gc *GrpcController) Receive(ctx context.Context, in *pippinpb.ReceiveRequest) (*pippinpb.ReceiveResponse, error) {
+	request := map[string]interface{}{
+		"action":  "receive",
+		"wallet":  in.Wallet,
+		"account": in.Account,
+		"block":   in.Block,
+	}
+	if in.Work != "" {
+		request["work"] = in.Work
+	}
+	var resp responses.BlockResponse
+	if err := gc.gateway(ctx, request, &resp); err != nil {
+		return nil, err
+	}
+	return &pippinpb.ReceiveResponse{Block: resp.Block}, nil
+}
+
+func (gc *GrpcController) ListAccounts(ctx context.Context, in *pippinpb.ListAccountsRequest) (*pippinpb.ListAccountsResponse, error) {
+	request := map[string]interface{}{"action": "account_list", "wallet": in.Wallet}
+	if in.Count > 0 {
+		request["count"] = in.Count
+	}
+	var resp responses.AccountsResponse
+	if err := gc.gateway(ctx, request, &resp); err != nil {
+		return nil, err
+	}
+	return &pippinpb.ListAccountsResponse{Accounts: resp.Accounts}, nil
+}
+
+// Like a /ws connection subscribed to one wallet, the stream ends when the call is cancelled
+func (gc *GrpcController) StreamEvents(in *pippinpb.StreamEventsRequest, stream pippinpb.WalletService_StreamEventsServer) error {
+	ctx := stream.Context()
+	r := grpcHttpRequest(ctx, nil)
+	if gc.hc.RateLimiter != nil && !gc.hc.RateLimiter.Allow(requestIP(r)) {
+		return grpcError(ctx, http.StatusTooManyRequests, RateLimitedError)
+	}
+	// Any scope can read the events, the key can only be in x-api-key
+	if gc.hc.requireApiKey() {
+		if _, err := gc.hc.verifyApiKey(r.Header.Get(apiKeyHeader)); err != nil {
+			return grpcError(ctx, http.StatusUnauthorized, UnauthorizedError)
+		}
+	}
+
+	for _, event := range in.Events {
+		if !slices.Contains(WS_EVENTS, event) {
+			return grpcError(ctx, http.StatusBadRequest, ErrorResponse{
+				Error:     fmt.Sprintf("Invalid event %s, must be one of %s", event, strings.Join(WS_EVENTS, ", ")),
+				ErrorCode: ErrorCodeInvalidEvent,
+			})
+		}
+	}
+	if _, err := gc.hc.Wallet.GetWallet(in.Wallet); errors.Is(err, wallet.ErrWalletNotFound) || errors.Is(err, wallet.ErrInvalidWallet) {
+		return grpcError(ctx, http.StatusBadRequest, WalletNotFoundError)
+	} else if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	sub := gc.hc.Wallet.SubscribeEvents()
+	defer sub.Close()
+	sub.Add(in.Wallet, in.Events)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&pippinpb.WalletEvent{
+				Event:   event.Event,
+				Wallet:  event.Wallet,
+				Account: event.Account,
+				Hash:    event.Hash,
+				Subtype: event.Subtype,
+				Amount:  event.Amount,
+				Source:  event.Source,
+				Time:    event.Time.Unix(),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
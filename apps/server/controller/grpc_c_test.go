@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/pippinpb"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// A client of the WalletService served by hc over an in-memory listener
+func newTestGrpcClient(t *testing.T, hc *HttpController) pippinpb.WalletServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	server := NewGrpcServer(hc)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial the gRPC server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pippinpb.NewWalletServiceClient(conn)
+}
+
+func TestGrpcWalletService(t *testing.T) {
+	hc := newTestController(t)
+	client := newTestGrpcClient(t, hc)
+	ctx := context.Background()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("e2a5d8b1f4c7a0e3d6b9f2c5a8e1d4b7f0c3a6e9d2b5f8c1a4e7d0b3f6c9a2e5"))
+	created, err := client.CreateWallet(ctx, &pippinpb.CreateWalletRequest{Seed: seed, ReturnSeed: true})
+	assert.Nil(t, err)
+	assert.Equal(t, seed, created.Seed)
+
+	accounts, err := client.ListAccounts(ctx, &pippinpb.ListAccountsRequest{Wallet: created.Wallet})
+	assert.Nil(t, err)
+	assert.Len(t, accounts.Accounts, 1)
+
+	// The gateway's error_code comes back in the trailer
+	var trailer metadata.MD
+	_, err = client.ListAccounts(ctx, &pippinpb.ListAccountsRequest{Wallet: "2b57d4a0-c8a8-4d7a-8a57-0a0a0a0a0a0a"}, grpc.Trailer(&trailer))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, []string{string(ErrorCodeWalletNotFound)}, trailer.Get(grpcErrorCodeTrailer))
+
+	_, err = client.Receive(ctx, &pippinpb.ReceiveRequest{Wallet: created.Wallet, Account: accounts.Accounts[0], Block: "notahash"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGrpcApiKeys(t *testing.T) {
+	hc := newTestController(t)
+	conf := *hc.Wallet.Config
+	conf.Server.RequireApiKey = true
+	hc.Wallet.Config = &conf
+	client := newTestGrpcClient(t, hc)
+	_, readKey, err := hc.Wallet.ApiKeyCreate("reader", "read")
+	assert.Nil(t, err)
+	_, adminKey, err := hc.Wallet.ApiKeyCreate("admin", "admin")
+	assert.Nil(t, err)
+	withKey := func(key string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), "x-api-key", key)
+	}
+
+	_, err = client.CreateWallet(context.Background(), &pippinpb.CreateWalletRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.CreateWallet(withKey(readKey), &pippinpb.CreateWalletRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	created, err := client.CreateWallet(withKey(adminKey), &pippinpb.CreateWalletRequest{})
+	assert.Nil(t, err)
+	assert.Empty(t, created.Seed)
+
+	_, err = client.ListAccounts(withKey(readKey), &pippinpb.ListAccountsRequest{Wallet: created.Wallet})
+	assert.Nil(t, err)
+
+	stream, err := client.StreamEvents(context.Background(), &pippinpb.StreamEventsRequest{Wallet: created.Wallet})
+	assert.Nil(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestGrpcStreamEvents(t *testing.T) {
+	hc := newTestController(t)
+	client := newTestGrpcClient(t, hc)
+	seed, _ := utils.GenerateSeed(strings.NewReader("f6b9c2e5a8d1f4b7e0c3a6d9f2b5e8c1a4d7f0b3e6c9a2d5f8b1e4c7a0d3f6b9"))
+	wallet, err := hc.Wallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := hc.Wallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.StreamEvents(ctx, &pippinpb.StreamEventsRequest{Wallet: wallet.ID.String(), Events: []string{"send"}})
+	assert.Nil(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	stream, err = client.StreamEvents(ctx, &pippinpb.StreamEventsRequest{Wallet: wallet.ID.String(), Events: []string{"confirmation"}})
+	assert.Nil(t, err)
+	// The subscription starts after the call does, publish until the event arrives
+	received := make(chan struct{})
+	defer close(received)
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-received:
+				return
+			case <-ticker.C:
+				hc.Wallet.PublishConfirmation(acc.Address, "A2", "receive", "1000", "A1")
+			}
+		}
+	}()
+	event, err := stream.Recv()
+	assert.Nil(t, err)
+	assert.NotZero(t, event.Time)
+	assert.Equal(t, "confirmation", event.Event)
+	assert.Equal(t, wallet.ID.String(), event.Wallet)
+	assert.Equal(t, acc.Address, event.Account)
+	assert.Equal(t, "A2", event.Hash)
+	assert.Equal(t, "1000", event.Amount)
+}
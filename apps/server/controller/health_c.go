@@ -0,0 +1,169 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/go-chi/render"
+)
+
+// Statuses of the health check
+const (
+	healthStatusOk       = "ok"
+	healthStatusDegraded = "degraded"
+)
+
+// Statuses of a dependency
+const (
+	dependencyStatusOk      = "ok"
+	dependencyStatusFailing = "failing"
+	dependencyStatusUnknown = "unknown"
+)
+
+// How long a dependency has to answer before it's failing
+const dependencyCheckTimeout = 5 * time.Second
+
+// Time check, failing with its error
+func dependencyCheck(check func() error) responses.DependencyCheck {
+	start := time.Now()
+	err := check()
+	resp := responses.DependencyCheck{
+		Status:    dependencyStatusOk,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		errStr := err.Error()
+		resp.Status = dependencyStatusFailing
+		resp.Error = &errStr
+	}
+	return resp
+}
+
+// block_count, on whichever node answers
+func (hc *HttpController) checkNode(ctx context.Context) error {
+	resp, err := hc.RpcClient.MakeRequestWithContext(ctx, map[string]string{"action": "block_count"})
+	if err != nil {
+		return err
+	}
+	var blockCount map[string]interface{}
+	if err := json.Unmarshal(resp, &blockCount); err != nil {
+		return err
+	} else if errStr, ok := blockCount["error"].(string); ok {
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+// The health work requests to the work peers left behind, nothing is sent to them
+// A peer is failing when its last request failed
+func (hc *HttpController) checkWorkPeers() *responses.WorkPeersCheck {
+	health := hc.PowClient.WorkPeersHealth()
+	if len(health) == 0 {
+		return nil
+	}
+	resp := &responses.WorkPeersCheck{
+		Status: dependencyStatusFailing,
+		Peers:  make([]responses.WorkPeerCheck, len(health)),
+	}
+	for i, peer := range health {
+		status := dependencyStatusUnknown
+		if peer.LastFailure != nil && (peer.LastSuccess == nil || peer.LastFailure.After(*peer.LastSuccess)) {
+			status = dependencyStatusFailing
+		} else if peer.LastSuccess != nil {
+			status = dependencyStatusOk
+		}
+		if status != dependencyStatusFailing {
+			resp.Status = dependencyStatusOk
+		}
+		resp.Peers[i] = responses.WorkPeerCheck{
+			Url:       peer.URL,
+			Status:    status,
+			LatencyMs: peer.AverageLatency.Milliseconds(),
+		}
+	}
+	return resp
+}
+
+// Check the database, redis and the node at once
+func (hc *HttpController) checkDependencies(ctx context.Context) responses.HealthChecks {
+	ctx, cancel := context.WithTimeout(ctx, dependencyCheckTimeout)
+	defer cancel()
+
+	var checks responses.HealthChecks
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		checks.Database = dependencyCheck(func() error {
+			_, err := hc.Wallet.DB.Wallet.Query().Exist(database.WithPrimary(ctx))
+			return err
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		checks.Redis = dependencyCheck(func() error {
+			return database.GetRedisDB().Ping(ctx)
+		})
+	}()
+	go func() {
+		defer wg.Done()
+		checks.Node = dependencyCheck(func() error {
+			return hc.checkNode(ctx)
+		})
+	}()
+	wg.Wait()
+	checks.WorkPeers = hc.checkWorkPeers()
+	return checks
+}
+
+// GET /health, degraded when a dependency is failing or confirmation_quorum isn't healthy
+// Pippin still serves requests when it's degraded, so it's always a 200
+func (hc *HttpController) HandleHealth(w http.ResponseWriter, r *http.Request) {
+	resp := responses.HealthResponse{
+		Status: healthStatusDegraded,
+		Checks: hc.checkDependencies(r.Context()),
+	}
+	quorum, err := hc.confirmationQuorum(false)
+	if err != nil {
+		log.Errorf("Error getting confirmation_quorum for the health check %s", err)
+	} else {
+		quorumStatus, _ := quorum["status"].(string)
+		resp.QuorumStatus = &quorumStatus
+		if quorumStatus == quorumStatusHealthy {
+			resp.Status = healthStatusOk
+		}
+	}
+	checks := resp.Checks
+	if checks.Database.Status != dependencyStatusOk || checks.Redis.Status != dependencyStatusOk || checks.Node.Status != dependencyStatusOk ||
+		(checks.WorkPeers != nil && checks.WorkPeers.Status != dependencyStatusOk) {
+		resp.Status = healthStatusDegraded
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// GET /ready, a 503 until the database, redis and the node can be reached
+// Work peers don't count, work can still come from the other sources
+func (hc *HttpController) HandleReady(w http.ResponseWriter, r *http.Request) {
+	resp := responses.ReadyResponse{
+		Checks: hc.checkDependencies(r.Context()),
+	}
+	resp.Ready = resp.Checks.Database.Status == dependencyStatusOk &&
+		resp.Checks.Redis.Status == dependencyStatusOk &&
+		resp.Checks.Node.Status == dependencyStatusOk
+
+	if resp.Ready {
+		render.Status(r, http.StatusOK)
+	} else {
+		render.Status(r, http.StatusServiceUnavailable)
+	}
+	render.JSON(w, r, &resp)
+}
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReady(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	nodeUp := false
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] != "block_count" || !nodeUp {
+				return httpmock.NewStringResponse(500, "error"), nil
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"count": "1000", "unchecked": "0", "cemented": "1000"})
+		},
+	)
+
+	hc := newTestController(t)
+	doReady := func() (int, responses.ReadyResponse) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/ready", nil)
+		hc.HandleReady(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson responses.ReadyResponse
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// The node can't be reached
+	status, resp := doReady()
+	assert.Equal(t, 503, status)
+	assert.False(t, resp.Ready)
+	assert.Equal(t, "ok", resp.Checks.Database.Status)
+	assert.Nil(t, resp.Checks.Database.Error)
+	assert.Equal(t, "ok", resp.Checks.Redis.Status)
+	assert.Equal(t, "failing", resp.Checks.Node.Status)
+	assert.NotNil(t, resp.Checks.Node.Error)
+	assert.Nil(t, resp.Checks.WorkPeers)
+
+	// Work peers nothing was requested from yet don't fail it
+	nodeUp = true
+	hc.PowClient.SetWorkPeers([]string{"http://localhost:7000"})
+	status, resp = doReady()
+	assert.Equal(t, 200, status)
+	assert.True(t, resp.Ready)
+	assert.Equal(t, "ok", resp.Checks.Node.Status)
+	assert.Nil(t, resp.Checks.Node.Error)
+	assert.Equal(t, &responses.WorkPeersCheck{
+		Status: "ok",
+		Peers:  []responses.WorkPeerCheck{{Url: "http://localhost:7000", Status: "unknown"}},
+	}, resp.Checks.WorkPeers)
+
+	// Not without the database
+	hc.Wallet.DB.Close()
+	status, resp = doReady()
+	assert.Equal(t, 503, status)
+	assert.Equal(t, "failing", resp.Checks.Database.Status)
+}
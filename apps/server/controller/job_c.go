@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+)
+
+// Whether the async option of a request is set
+func asyncRequested(async *interface{}) (bool, error) {
+	if async == nil {
+		return false, nil
+	}
+	return utils.ToBool(*async)
+}
+
+// Run an action in the background as a job of the wallet, the response is only the job_id
+func (hc *HttpController) startJob(dbWallet *ent.Wallet, action string, run wallet.JobFunc, w http.ResponseWriter, r *http.Request) {
+	dbJob, err := hc.Wallet.JobStart(dbWallet, action, run)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.JobResponse{
+		JobID: dbJob.ID.String(),
+	})
+}
+
+// Handle job_status, the state of an action started with async
+func (hc *HttpController) HandleJobStatus(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var statusRequest requests.JobStatusRequest
+	if err := mapstructure.Decode(rawRequest, &statusRequest); err != nil {
+		log.Errorf("Error unmarshalling job_status request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if statusRequest.Action == "" || statusRequest.JobID == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	id, err := uuid.Parse(statusRequest.JobID)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidJobID, "Invalid job_id")
+		return
+	}
+
+	dbJob, err := hc.Wallet.JobGet(id)
+	if errors.Is(err, wallet.ErrJobNotFound) {
+		ErrBadRequest(w, r, ErrorCodeJobNotFound, "Job not found")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.JobStatusResponse{
+		JobID:   dbJob.ID.String(),
+		Action:  dbJob.Action,
+		Status:  dbJob.Status.String(),
+		Percent: dbJob.Percent,
+		Result:  dbJob.Result,
+		Error:   dbJob.Error,
+	})
+}
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobStatus(t *testing.T) {
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("4d9e2b7a1f6c3e8b5a0d7f2c9e4b1a6d3f8c5e0b7a2d9f4c1e6b3a8d5f0c7e2b"))
+	dbWallet, _ := MockController.Wallet.WalletCreate(newSeed)
+
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+	waitForJob := func(jobID string) map[string]interface{} {
+		var respJson map[string]interface{}
+		assert.Eventually(t, func() bool {
+			var status int
+			status, respJson = doRequest(map[string]interface{}{"action": "job_status", "job_id": jobID})
+			return status == 200 && (respJson["status"] == "done" || respJson["status"] == "failed")
+		}, 10*time.Second, 10*time.Millisecond)
+		return respJson
+	}
+
+	// accounts_create returns a job_id right away and the accounts are in the result once it's done
+	status, respJson := doRequest(map[string]interface{}{
+		"action": "accounts_create",
+		"wallet": dbWallet.ID.String(),
+		"count":  150,
+		"async":  true,
+	})
+	assert.Equal(t, 200, status)
+	assert.NotContains(t, respJson, "accounts")
+	jobID := respJson["job_id"].(string)
+
+	respJson = waitForJob(jobID)
+	assert.Equal(t, jobID, respJson["job_id"])
+	assert.Equal(t, "accounts_create", respJson["action"])
+	assert.Equal(t, "done", respJson["status"])
+	assert.Equal(t, float64(100), respJson["percent"])
+	assert.NotContains(t, respJson, "error")
+	assert.Len(t, respJson["result"].(map[string]interface{})["accounts"], 150)
+	_, accounts, _ := MockController.Wallet.AccountsList(dbWallet, math.MaxInt)
+	// The account at index 0 is created with the wallet
+	assert.Len(t, accounts, 151)
+
+	// A failing action keeps what it did before the error
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(500, "error"), nil
+		},
+	)
+	status, respJson = doRequest(map[string]interface{}{
+		"action": "receive_all",
+		"wallet": dbWallet.ID.String(),
+		"async":  "true",
+	})
+	assert.Equal(t, 200, status)
+
+	respJson = waitForJob(respJson["job_id"].(string))
+	assert.Equal(t, "receive_all", respJson["action"])
+	assert.Equal(t, "failed", respJson["status"])
+	assert.Equal(t, float64(0), respJson["percent"])
+	assert.NotEmpty(t, respJson["error"])
+	assert.Equal(t, float64(0), respJson["result"].(map[string]interface{})["received"])
+
+	// Invalid async is refused before anything runs
+	status, respJson = doRequest(map[string]interface{}{
+		"action": "receive_all",
+		"wallet": dbWallet.ID.String(),
+		"async":  "sometimes",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"])
+
+	// Unknown and invalid job ids
+	status, respJson = doRequest(map[string]interface{}{"action": "job_status", "job_id": "5a2f7d1e-93c8-4b6a-8e04-d71c2b9f3a65"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "JOB_NOT_FOUND", respJson["error_code"])
+	status, respJson = doRequest(map[string]interface{}{"action": "job_status", "job_id": "1234"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JOB_ID", respJson["error_code"])
+}
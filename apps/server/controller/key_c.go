@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"encoding/hex"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
+)
+
+// Key handlers, stateless utilities that don't touch the database
+
+// Derive the keypair at index from a seed, same derivation as account_create
+func (hc *HttpController) HandleDeterministicKey(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.DeterministicKeyRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling deterministic_key request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Action == "" || request.Seed == "" || request.Index == nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	if !utils.Validate64HexHash(request.Seed) {
+		ErrInvalidSeed(w, r)
+		return
+	}
+
+	index, err := utils.ToInt(*request.Index)
+	if err != nil || index < 0 || index > math.MaxUint32 {
+		ErrBadRequest(w, r, ErrorCodeInvalidIndex, "Invalid index")
+		return
+	}
+
+	pub, priv, err := utils.KeypairFromSeed(request.Seed, uint32(index))
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.KeyResponse{
+		Private: strings.ToUpper(hex.EncodeToString(priv.Seed())),
+		Public:  strings.ToUpper(hex.EncodeToString(pub)),
+		Account: utils.PubKeyToAddress(pub, hc.Wallet.Banano),
+	})
+}
+
+// Reasons of key_valid
+const (
+	keyReasonInvalidLength = "invalid_length"
+	keyReasonInvalidHex    = "invalid_hex"
+)
+
+// Handle key_valid, whether key is a private key and the account it's for
+// Any 32 bytes are an ed25519 private key, so only the format can be wrong
+func (hc *HttpController) HandleKeyValid(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.KeyValidRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling key_valid request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	if len(request.Key) != 64 {
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, &responses.KeyValidResponse{Reason: keyReasonInvalidLength})
+		return
+	}
+	asHex, err := hex.DecodeString(request.Key)
+	if err != nil {
+		render.Status(r, http.StatusOK)
+		render.JSON(w, r, &responses.KeyValidResponse{Reason: keyReasonInvalidHex})
+		return
+	}
+	priv, err := ed25519.NewKeyFromSeed(asHex)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+	pub := priv.Public().(ed25519.PublicKey)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.KeyValidResponse{
+		Valid:     true,
+		PublicKey: strings.ToUpper(hex.EncodeToString(pub)),
+		Account:   utils.PubKeyToAddress(pub, hc.Wallet.Banano),
+	})
+}
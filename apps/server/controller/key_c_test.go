@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeterministicKey(t *testing.T) {
+	// Test vector from the nano docs
+	reqBody := map[string]interface{}{
+		"action": "deterministic_key",
+		"seed":   "0000000000000000000000000000000000000000000000000000000000000000",
+		"index":  0,
+	}
+	body, _ := json.Marshal(reqBody)
+	w := httptest.NewRecorder()
+	// Build request
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var respJson responses.KeyResponse
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+
+	assert.Equal(t, "9F0E444C69F77A49BD0BE89DB92C38FE713E0963165CCA12FAF5712D7657120F", respJson.Private)
+	assert.Equal(t, "C008B814A7D269A1FA3C6528B19201A24D797912DB9996FF02A1FF356E45552B", respJson.Public)
+	assert.Equal(t, "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7", respJson.Account)
+
+	// Invalid seed
+	reqBody = map[string]interface{}{
+		"action": "deterministic_key",
+		"seed":   "1234",
+		"index":  0,
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	var rawResp map[string]interface{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &rawResp)
+	assert.Equal(t, "INVALID_SEED", rawResp["error_code"])
+
+	// Index out of range
+	reqBody = map[string]interface{}{
+		"action": "deterministic_key",
+		"seed":   "0000000000000000000000000000000000000000000000000000000000000000",
+		"index":  4294967296,
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &rawResp)
+	assert.Equal(t, "INVALID_INDEX", rawResp["error_code"])
+}
+
+func TestKeyValid(t *testing.T) {
+	doKeyValid := func(key interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "key_valid",
+			"key":    key,
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// The private key at index 0 of the zero seed, from the nano docs
+	status, resp := doKeyValid("9f0e444c69f77a49bd0be89db92c38fe713e0963165cca12faf5712d7657120f")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{
+		"valid":      true,
+		"public_key": "C008B814A7D269A1FA3C6528B19201A24D797912DB9996FF02A1FF356E45552B",
+		"account":    "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7",
+	}, resp)
+
+	// Upper case like deterministic_key returns it
+	status, resp = doKeyValid("9F0E444C69F77A49BD0BE89DB92C38FE713E0963165CCA12FAF5712D7657120F")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7", resp["account"])
+
+	// Malformed keys aren't an error
+	status, resp = doKeyValid("9f0e444c69f77a49bd0be89db92c38fe713e0963165cca12faf5712d7657120")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"valid": false, "reason": "invalid_length"}, resp)
+	status, resp = doKeyValid("")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"valid": false, "reason": "invalid_length"}, resp)
+	status, resp = doKeyValid("9f0e444c69f77a49bd0be89db92c38fe713e0963165cca12faf5712d7657120g")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"valid": false, "reason": "invalid_hex"}, resp)
+
+	// Not a string
+	status, _ = doKeyValid(1234)
+	assert.Equal(t, 400, status)
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
+)
+
+// Signed messages, e.g. for a merchant to prove to someone else that a deposit address is theirs
+// The signature is over a prefixed hash of the message, never a block hash, see utils.SignMessage
+
+// Handle sign_message, sign a message with the key of an account of the wallet
+func (hc *HttpController) HandleSignMessage(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var signRequest requests.SignMessageRequest
+	if err := mapstructure.Decode(rawRequest, &signRequest); err != nil {
+		log.Errorf("Error unmarshalling sign_message request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if signRequest.Wallet == "" || signRequest.Action == "" || signRequest.Account == "" || signRequest.Message == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	if _, err := utils.AddressToPub(signRequest.Account, hc.Wallet.Config.Wallet.Banano); err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(signRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+	if !hc.WalletNotFrozen(dbWallet, w, r) {
+		return
+	}
+
+	signature, err := hc.Wallet.SignMessage(dbWallet, signRequest.Account, signRequest.Message)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	} else if errors.Is(err, wallet.ErrWalletWatchOnly) || errors.Is(err, wallet.ErrNoPrivateKey) {
+		ErrBadRequest(w, r, ErrorCodeNoPrivateKey, "No private key for the account, it's watch-only or on a hardware wallet")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.SignMessageResponse{
+		Account:   signRequest.Account,
+		Signature: fmt.Sprintf("%X", signature),
+	})
+}
+
+// Handle verify_message, whether a signature of sign_message is the account's for the message
+func (hc *HttpController) HandleVerifyMessage(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var verifyRequest requests.VerifyMessageRequest
+	if err := mapstructure.Decode(rawRequest, &verifyRequest); err != nil {
+		log.Errorf("Error unmarshalling verify_message request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if verifyRequest.Action == "" || verifyRequest.Account == "" || verifyRequest.Message == "" || verifyRequest.Signature == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	pub, err := utils.AddressToPub(verifyRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+
+	// A signature that isn't hex isn't valid for anything
+	signature, err := hex.DecodeString(verifyRequest.Signature)
+	valid := err == nil && utils.VerifyMessage(pub, verifyRequest.Message, signature, hc.Wallet.Config.Wallet.Banano)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.VerifyMessageResponse{Valid: valid})
+}
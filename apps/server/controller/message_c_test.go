@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignMessage(t *testing.T) {
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("c4e7a0d3f6b9c2e5a8d1f4b7e0c3a6d9f2b5e8c1a4d7f0b3e6c9a2d5f8b1e4c7"))
+	dbWallet, _ := hc.Wallet.WalletCreate(newSeed)
+	acc, _ := hc.Wallet.AccountCreate(dbWallet, nil)
+
+	do := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	message := "Deposit address of order 1234"
+	status, resp := do(map[string]interface{}{"action": "sign_message", "wallet": dbWallet.ID.String(), "account": acc.Address, "message": message})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, acc.Address, resp["account"])
+	signature := resp["signature"].(string)
+	assert.Regexp(t, "^[0-9A-F]{128}$", signature)
+
+	// Anyone can check it, without the wallet
+	status, resp = do(map[string]interface{}{"action": "verify_message", "account": acc.Address, "message": message, "signature": signature})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"valid": true}, resp)
+	status, resp = do(map[string]interface{}{"action": "verify_message", "account": acc.Address, "message": message, "signature": strings.ToLower(signature)})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, true, resp["valid"])
+	for _, request := range []map[string]interface{}{
+		{"action": "verify_message", "account": acc.Address, "message": message + ".", "signature": signature},
+		{"action": "verify_message", "account": "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7", "message": message, "signature": signature},
+		{"action": "verify_message", "account": acc.Address, "message": message, "signature": "not hex"},
+	} {
+		status, resp = do(request)
+		assert.Equal(t, 200, status)
+		assert.Equal(t, false, resp["valid"])
+	}
+
+	// Signed by the key of index 0 of the zero seed
+	status, resp = do(map[string]interface{}{"action": "verify_message", "account": "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7", "message": message,
+		"signature": "47B6D04C2A6D984573B1DF999D3698AA579862B8B87B6D291B489BCD5506C6A1B49E86187FAE9D454ADEE6A68E978B4701118CDC2252755B1940D7D708F70F02"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, true, resp["valid"])
+
+	status, _ = do(map[string]interface{}{"action": "verify_message", "account": "nano_1", "message": message, "signature": signature})
+	assert.Equal(t, 400, status)
+
+	// The account has to be in the wallet
+	status, resp = do(map[string]interface{}{"action": "sign_message", "wallet": dbWallet.ID.String(), "account": "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7", "message": message})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", resp["error_code"])
+	status, _ = do(map[string]interface{}{"action": "sign_message", "wallet": dbWallet.ID.String(), "account": acc.Address})
+	assert.Equal(t, 400, status)
+
+	// Nothing is signed for a frozen wallet
+	_, err := hc.Wallet.WalletFreeze(dbWallet)
+	assert.Nil(t, err)
+	status, resp = do(map[string]interface{}{"action": "sign_message", "wallet": dbWallet.ID.String(), "account": acc.Address, "message": message})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "wallet_frozen", resp["error"])
+}
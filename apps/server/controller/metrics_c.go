@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/metrics"
+)
+
+// The action label of every action that's forwarded to the node
+const forwardedActionLabel = "forwarded"
+
+var actionRequests = metrics.NewCounterVec("pippin_rpc_requests_total", "Actions handled, by action, forwarded for the ones that go to the node", "action")
+var actionDuration = metrics.NewHistogramVec("pippin_rpc_request_duration_seconds", "Seconds actions took, by action, forwarded for the ones that go to the node", metrics.DefaultBuckets, "action")
+
+func observeAction(action string, start time.Time) {
+	actionRequests.Inc(action)
+	actionDuration.ObserveSince(start, action)
+}
+
+// GET /metrics, in the Prometheus text format
+func (hc *HttpController) HandleMetrics(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := metrics.WriteText(w); err != nil {
+		log.Errorf("Error writing metrics %s", err)
+	}
+}
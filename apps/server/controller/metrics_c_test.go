@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		httpmock.NewStringResponder(200, `{"seconds": "6000"}`))
+
+	hc := newTestController(t)
+	handled := actionRequests.Value("gateway_actions")
+	forwarded := actionRequests.Value(forwardedActionLabel)
+	for _, action := range []string{"gateway_actions", "uptime"} {
+		body, _ := json.Marshal(map[string]interface{}{"action": action})
+		w := httptest.NewRecorder()
+		hc.Gateway(w, httptest.NewRequest("POST", "/", bytes.NewReader(body)))
+		assert.Equal(t, 200, w.Code)
+	}
+	assert.Equal(t, handled+1, actionRequests.Value("gateway_actions"))
+	assert.Equal(t, forwarded+1, actionRequests.Value(forwardedActionLabel))
+	assert.Equal(t, uint64(actionRequests.Value("gateway_actions")), actionDuration.Count("gateway_actions"))
+
+	w := httptest.NewRecorder()
+	hc.HandleMetrics(w, httptest.NewRequest("GET", "/metrics", nil))
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", resp.Header.Get("Content-Type"))
+	respBody, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(respBody), "# TYPE pippin_rpc_requests_total counter\n")
+	assert.Contains(t, string(respBody), "pippin_rpc_request_duration_seconds_count{action=\"forwarded\"}")
+	// The libraries' metrics are in the same registry
+	assert.Contains(t, string(respBody), "# TYPE pippin_node_rpc_requests_total counter\n")
+	assert.Contains(t, string(respBody), "# TYPE pippin_database_query_duration_seconds histogram\n")
+	assert.Contains(t, string(respBody), "# TYPE pippin_work_generate_duration_seconds histogram\n")
+}
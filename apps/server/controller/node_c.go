@@ -0,0 +1,1276 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc"
+	rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
+	"golang.org/x/sync/errgroup"
+)
+
+// Node status handlers, they only talk to the node
+
+// Under this sync_percent a node with unchecked blocks is still syncing
+const syncedPercent = 99.9
+
+// The last block_count from the node, reused for block_count_cache_ttl seconds
+type blockCountCache struct {
+	count     *rpcresponses.BlockCountResponse
+	fetchedAt time.Time
+	mutex     sync.Mutex
+}
+
+// Peers are reused for this long
+const peersCacheTTL = 60 * time.Second
+
+// The last peers from the node, without loopback peers
+type peersCache struct {
+	peers     map[string]interface{}
+	fetchedAt time.Time
+	mutex     sync.Mutex
+}
+
+// Election statistics are reused for this long
+const electionStatisticsCacheTTL = 5 * time.Second
+
+// The last complete election_statistics, partial ones aren't kept
+type electionStatisticsCache struct {
+	stats     *responses.ElectionStatisticsResponse
+	fetchedAt time.Time
+	mutex     sync.Mutex
+}
+
+// network_stats is reused for this long
+const networkStatsCacheTTL = 15 * time.Second
+
+// How long network_stats waits for the node, the calls that haven't finished by then are errors
+const networkStatsTimeout = 5 * time.Second
+
+// The last network_stats without errors, ones with errors aren't kept
+type networkStatsCache struct {
+	stats     *responses.NetworkStatsResponse
+	fetchedAt time.Time
+	mutex     sync.Mutex
+}
+
+// chain with include_block_info is reused for this long
+const chainCacheTTL = 60 * time.Second
+
+// representative_info is reused for this long
+const representativeInfoCacheTTL = 60 * time.Second
+
+// account_weight is reused for this long
+const accountWeightCacheTTL = 30 * time.Second
+
+// available_supply and the burn account's balance are reused for this long, they rarely change
+const supplyCacheTTL = 5 * time.Minute
+
+// The node's version is reused for this long, it only changes when the node is upgraded
+const nodeVersionCacheTTL = 5 * time.Minute
+
+// delegators and delegators_count are reused for this long
+const delegatorsCacheTTL = 60 * time.Second
+
+// confirmation_quorum is reused for this long
+const confirmationQuorumCacheTTL = 30 * time.Second
+
+// Statuses of confirmation_quorum, at_risk is online weight under quorumAtRiskPercent of online_weight_minimum
+const (
+	quorumStatusHealthy      = "healthy"
+	quorumStatusAtRisk       = "at_risk"
+	quorumStatusInsufficient = "insufficient"
+)
+
+const quorumAtRiskPercent = 110.0
+
+// Everything was in the genesis block, 2^128 - 1 raw
+var maxSupplyRaw = big.NewInt(0).Sub(big.NewInt(0).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+// Get block_count from the cache, or from the node if it's expired
+func (hc *HttpController) blockCount() (*rpcresponses.BlockCountResponse, error) {
+	hc.blockCountCache.mutex.Lock()
+	defer hc.blockCountCache.mutex.Unlock()
+
+	ttl := time.Duration(hc.Wallet.Config.Server.BlockCountCacheTTL) * time.Second
+	if hc.blockCountCache.count != nil && time.Since(hc.blockCountCache.fetchedAt) < ttl {
+		return hc.blockCountCache.count, nil
+	}
+	count, err := hc.RpcClient.MakeBlockCountRequest()
+	if err != nil {
+		return nil, err
+	}
+	hc.blockCountCache.count = count
+	hc.blockCountCache.fetchedAt = time.Now()
+	return count, nil
+}
+
+// cemented / count * 100, 0 if the node has no blocks
+func syncPercent(count *rpcresponses.BlockCountResponse) float64 {
+	total, ok := big.NewFloat(0).SetString(count.Count)
+	if !ok || total.Sign() <= 0 {
+		return 0
+	}
+	cemented, ok := big.NewFloat(0).SetString(count.Cemented)
+	if !ok {
+		return 0
+	}
+	percent, _ := big.NewFloat(0).Quo(big.NewFloat(0).Mul(cemented, big.NewFloat(100)), total).Float64()
+	return percent
+}
+
+// Handle block_count, the node's block_count with sync_percent and syncing added
+func (hc *HttpController) HandleBlockCount(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	count, err := hc.blockCount()
+	if err != nil {
+		log.Errorf("Error getting block_count from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	resp := responses.BlockCountResponse{
+		Count:       count.Count,
+		Unchecked:   count.Unchecked,
+		Cemented:    count.Cemented,
+		SyncPercent: syncPercent(count),
+	}
+	unchecked, ok := big.NewInt(0).SetString(count.Unchecked, 10)
+	if ok && unchecked.Sign() > 0 && resp.SyncPercent < syncedPercent {
+		resp.Syncing = true
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Get the node's peers from the cache, or from the node if it's expired
+func (hc *HttpController) peers() (map[string]interface{}, error) {
+	hc.peersCache.mutex.Lock()
+	defer hc.peersCache.mutex.Unlock()
+
+	if hc.peersCache.peers != nil && time.Since(hc.peersCache.fetchedAt) < peersCacheTTL {
+		return hc.peersCache.peers, nil
+	}
+	resp, err := hc.RpcClient.MakePeersRequest()
+	if err != nil {
+		return nil, err
+	}
+	peers := make(map[string]interface{}, len(resp.Peers))
+	for address, peer := range resp.Peers {
+		if !isLoopbackPeer(address) {
+			peers[address] = peer
+		}
+	}
+	hc.peersCache.peers = peers
+	hc.peersCache.fetchedAt = time.Now()
+	return peers, nil
+}
+
+// Peers are [ip]:port, e.g. [::1]:7075 or [::ffff:127.0.0.1]:7075
+func isLoopbackPeer(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// Handle peers, admin only, the node's peers without loopback peers
+func (hc *HttpController) HandlePeers(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	peers, err := hc.peers()
+	if err != nil {
+		log.Errorf("Error getting peers from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	resp := responses.PeersResponse{
+		Peers: peers,
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle peer_count, admin only, how many peers HandlePeers would return
+func (hc *HttpController) HandlePeerCount(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	peers, err := hc.peers()
+	if err != nil {
+		log.Errorf("Error getting peers from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	resp := responses.PeerCountResponse{
+		Count: len(peers),
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle bootstrap_lazy, admin only, the node's response is returned as is
+func (hc *HttpController) HandleBootstrapLazy(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var bootstrapRequest requests.BootstrapLazyRequest
+	if err := mapstructure.Decode(rawRequest, &bootstrapRequest); err != nil {
+		log.Errorf("Error unmarshalling bootstrap_lazy request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if bootstrapRequest.Action == "" || bootstrapRequest.Hash == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	if !utils.Validate64HexHash(bootstrapRequest.Hash) {
+		ErrInvalidHash(w, r)
+		return
+	}
+	nodeRequest := map[string]interface{}{
+		"action": "bootstrap_lazy",
+		"hash":   bootstrapRequest.Hash,
+	}
+	if bootstrapRequest.Force != nil {
+		force, err := utils.ToBool(*bootstrapRequest.Force)
+		if err != nil {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+		nodeRequest["force"] = fmt.Sprintf("%t", force)
+	}
+
+	hc.forwardToNode(nodeRequest, w, r)
+}
+
+// How long bootstrap and bootstrap_any are refused after either was called
+const bootstrapInterval = time.Minute
+
+// Every bootstrap opens connections to peers, refuse it if one was started in the last bootstrapInterval
+func (hc *HttpController) bootstrapAllowed(w http.ResponseWriter, r *http.Request) bool {
+	allowed, err := database.GetRedisDB().SetNX("bootstrap", "1", bootstrapInterval)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return false
+	} else if !allowed {
+		ErrRateLimited(w, r)
+		return false
+	}
+	return true
+}
+
+// Handle bootstrap, admin only, bootstrap from the peer at address, the node's response is returned as is
+func (hc *HttpController) HandleBootstrap(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var bootstrapRequest requests.BootstrapRequest
+	if err := mapstructure.Decode(rawRequest, &bootstrapRequest); err != nil {
+		log.Errorf("Error unmarshalling bootstrap request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if bootstrapRequest.Action == "" || bootstrapRequest.Address == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// The node takes the address and the port separately
+	host, port, err := net.SplitHostPort(bootstrapRequest.Address)
+	if err != nil || net.ParseIP(host) == nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidIP, "Invalid address, must be IP:port")
+		return
+	} else if portNumber, err := strconv.Atoi(port); err != nil || portNumber < 1 || portNumber > 65535 {
+		ErrBadRequest(w, r, ErrorCodeInvalidIP, "Invalid address, must be IP:port")
+		return
+	}
+	if !hc.bootstrapAllowed(w, r) {
+		return
+	}
+
+	hc.forwardToNode(map[string]interface{}{
+		"action":  "bootstrap",
+		"address": host,
+		"port":    port,
+	}, w, r)
+}
+
+// Handle bootstrap_any, admin only, the node picks the peer, the node's response is returned as is
+func (hc *HttpController) HandleBootstrapAny(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	if !hc.bootstrapAllowed(w, r) {
+		return
+	}
+	hc.forwardToNode(map[string]interface{}{
+		"action": "bootstrap_any",
+	}, w, r)
+}
+
+// Handle bootstrap_status, admin only, the node's response is returned as is
+func (hc *HttpController) HandleBootstrapStatus(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	hc.forwardToNode(map[string]interface{}{
+		"action": "bootstrap_status",
+	}, w, r)
+}
+
+func (hc *HttpController) forwardToNode(nodeRequest map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	resp, err := hc.RpcClient.MakeRequest(nodeRequest)
+	if err != nil {
+		log.Errorf("Error forwarding %v to node %s", nodeRequest["action"], err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
+
+// Get active_difficulty and confirmation_quorum from the cache, or from the node if it's expired
+// If one of them fails the other is still returned, marked partial, only an error if both fail
+func (hc *HttpController) electionStatistics() (*responses.ElectionStatisticsResponse, error) {
+	hc.electionStatisticsCache.mutex.Lock()
+	defer hc.electionStatisticsCache.mutex.Unlock()
+
+	if hc.electionStatisticsCache.stats != nil && time.Since(hc.electionStatisticsCache.fetchedAt) < electionStatisticsCacheTTL {
+		return hc.electionStatisticsCache.stats, nil
+	}
+
+	stats := &responses.ElectionStatisticsResponse{}
+	difficulty, difficultyErr := hc.RpcClient.MakeActiveDifficultyRequest()
+	if difficultyErr != nil {
+		log.Errorf("Error getting active_difficulty from node %s", difficultyErr)
+		stats.Partial = true
+	} else {
+		stats.NetworkMinimum = difficulty.NetworkMinimum
+		stats.NetworkReceiveMinimum = difficulty.NetworkReceiveMinimum
+		stats.NetworkCurrent = difficulty.NetworkCurrent
+		stats.NetworkReceiveCurrent = difficulty.NetworkReceiveCurrent
+		stats.Multiplier = difficulty.Multiplier
+		stats.DifficultyTrend = difficulty.DifficultyTrend
+	}
+	quorum, quorumErr := hc.RpcClient.MakeConfirmationQuorumRequest()
+	if quorumErr != nil {
+		log.Errorf("Error getting confirmation_quorum from node %s", quorumErr)
+		stats.Partial = true
+	} else {
+		stats.QuorumDelta = quorum.QuorumDelta
+		stats.OnlineWeightQuorumPercent = quorum.OnlineWeightQuorumPercent
+		stats.OnlineWeightMinimum = quorum.OnlineWeightMinimum
+		stats.OnlineStakeTotal = quorum.OnlineStakeTotal
+		stats.TrendedStakeTotal = quorum.TrendedStakeTotal
+		stats.PeersStakeTotal = quorum.PeersStakeTotal
+	}
+
+	if difficultyErr != nil && quorumErr != nil {
+		return nil, quorumErr
+	} else if !stats.Partial {
+		hc.electionStatisticsCache.stats = stats
+		hc.electionStatisticsCache.fetchedAt = time.Now()
+	}
+	return stats, nil
+}
+
+// Handle election_statistics, the node's active_difficulty and confirmation_quorum merged
+func (hc *HttpController) HandleElectionStatistics(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	stats, err := hc.electionStatistics()
+	if err != nil {
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, stats)
+}
+
+// Handle nano_difficulty_info, the node's active_difficulty multiplier with its average, lowest and highest of the last hour
+// The hour comes from what the difficulty updater sampled, so it's per instance and starts empty
+func (hc *HttpController) HandleNanoDifficultyInfo(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	difficulty, err := hc.RpcClient.MakeActiveDifficultyRequest()
+	if err != nil {
+		log.Errorf("Error getting active_difficulty from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+	current, err := strconv.ParseFloat(difficulty.Multiplier, 64)
+	if err != nil {
+		log.Errorf("Error parsing active_difficulty multiplier %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	resp := responses.NanoDifficultyInfoResponse{CurrentMultiplier: current}
+	if stats := hc.PowClient.DifficultyHistory(time.Now()); stats != nil {
+		resp.AverageMultiplier1h = &stats.Average
+		resp.MinMultiplier1h = &stats.Min
+		resp.MaxMultiplier1h = &stats.Max
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle work_difficulty_history, the multipliers the difficulty sampler recorded every 30 seconds in the last hours
+func (hc *HttpController) HandleWorkDifficultyHistory(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var historyRequest requests.WorkDifficultyHistoryRequest
+	if err := mapstructure.Decode(rawRequest, &historyRequest); err != nil {
+		log.Errorf("Error unmarshalling work_difficulty_history request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if historyRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	hours := 24
+	if historyRequest.Hours != nil {
+		var err error
+		hours, err = utils.ToInt(*historyRequest.Hours)
+		if err != nil || (hours != 1 && hours != 6 && hours != 24) {
+			ErrBadRequest(w, r, ErrorCodeInvalidPeriod, "Invalid hours, must be 1, 6 or 24")
+			return
+		}
+	}
+
+	resp := responses.WorkDifficultyHistoryResponse{History: []responses.WorkDifficultySample{}}
+	for _, sample := range hc.PowClient.DifficultySamples(time.Now(), time.Duration(hours)*time.Hour) {
+		resp.History = append(resp.History, responses.WorkDifficultySample{
+			Timestamp:  sample.Timestamp.Unix(),
+			Multiplier: sample.Multiplier,
+		})
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Make a request to the node and decode its response into decoded, an error if the node returned one
+func (hc *HttpController) nodeRequestWithContext(ctx context.Context, request interface{}, decoded interface{}) error {
+	resp, err := hc.RpcClient.MakeRequestWithContext(ctx, request)
+	if err != nil {
+		return err
+	}
+	var nodeResponse map[string]interface{}
+	if err := json.Unmarshal(resp, &nodeResponse); err != nil {
+		return err
+	} else if errStr, ok := nodeResponse["error"].(string); ok {
+		return errors.New(errStr)
+	}
+	return mapstructure.Decode(nodeResponse, decoded)
+}
+
+// A uint64 from the node, nil if it isn't one
+func parseNodeUint(value string) *uint64 {
+	parsed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
+
+// Get telemetry, active_difficulty and confirmation_quorum from the cache, or from the node at the same time if it's expired
+// A call that fails or takes longer than networkStatsTimeout leaves its fields null and is added to errors
+func (hc *HttpController) networkStats() *responses.NetworkStatsResponse {
+	hc.networkStatsCache.mutex.Lock()
+	defer hc.networkStatsCache.mutex.Unlock()
+
+	if hc.networkStatsCache.stats != nil && time.Since(hc.networkStatsCache.fetchedAt) < networkStatsCacheTTL {
+		return hc.networkStatsCache.stats
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), networkStatsTimeout)
+	defer cancel()
+
+	var telemetry rpcresponses.TelemetryResponse
+	var difficulty rpcresponses.ActiveDifficultyResponse
+	var quorum rpcresponses.ConfirmationQuorumResponse
+	calls := []struct {
+		action  string
+		decoded interface{}
+		err     error
+	}{
+		{action: "telemetry", decoded: &telemetry},
+		{action: "active_difficulty", decoded: &difficulty},
+		{action: "confirmation_quorum", decoded: &quorum},
+	}
+	// Every call is waited for, one failing doesn't cancel the others
+	var g errgroup.Group
+	for i := range calls {
+		g.Go(func() error {
+			calls[i].err = hc.nodeRequestWithContext(ctx, requests.BaseRequest{Action: calls[i].action}, calls[i].decoded)
+			return nil
+		})
+	}
+	g.Wait()
+
+	stats := &responses.NetworkStatsResponse{Errors: []string{}}
+	for _, call := range calls {
+		if call.err != nil {
+			log.Errorf("Error getting %s from node %s", call.action, call.err)
+			stats.Errors = append(stats.Errors, fmt.Sprintf("%s: %s", call.action, call.err))
+		}
+	}
+	if calls[0].err == nil {
+		stats.OnlinePeers = parseNodeUint(telemetry.PeerCount)
+		stats.BlockCount = parseNodeUint(telemetry.BlockCount)
+		stats.CementedCount = parseNodeUint(telemetry.CementedCount)
+		stats.UncheckedCount = parseNodeUint(telemetry.UncheckedCount)
+		stats.BandwidthCapBytes = parseNodeUint(telemetry.BandwidthCap)
+	}
+	if calls[1].err == nil {
+		if multiplier, err := strconv.ParseFloat(difficulty.Multiplier, 64); err == nil {
+			stats.ActiveDifficultyMultiplier = &multiplier
+		}
+	}
+	if calls[2].err == nil {
+		online, onlineOk := big.NewInt(0).SetString(quorum.OnlineStakeTotal, 10)
+		minimum, minimumOk := big.NewInt(0).SetString(quorum.OnlineWeightMinimum, 10)
+		if onlineOk && minimumOk {
+			percent := weightPercent(online, minimum)
+			stats.QuorumPercent = &percent
+		}
+	}
+
+	if len(stats.Errors) == 0 {
+		hc.networkStatsCache.stats = stats
+		hc.networkStatsCache.fetchedAt = time.Now()
+	}
+	return stats
+}
+
+// Handle network_stats, the node's telemetry, active_difficulty and confirmation_quorum merged for dashboards
+// It's a 200 even if every call failed, the failures are in errors
+func (hc *HttpController) HandleNetworkStats(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, hc.networkStats())
+}
+
+// Handle chain, forwarded to the node, with include_block_info the block_info of every hash is added
+// Hashes are public, so it doesn't need a wallet
+func (hc *HttpController) HandleChain(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var chainRequest requests.ChainRequest
+	if err := mapstructure.Decode(rawRequest, &chainRequest); err != nil {
+		log.Errorf("Error unmarshalling chain request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if chainRequest.Action == "" || chainRequest.Block == "" || chainRequest.Count == nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	if !utils.Validate64HexHash(chainRequest.Block) {
+		ErrInvalidHash(w, r)
+		return
+	}
+	count, err := utils.ToInt(*chainRequest.Count)
+	if err != nil || count < 1 {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	var offset *int
+	if chainRequest.Offset != nil {
+		o, err := utils.ToInt(*chainRequest.Offset)
+		if err != nil || o < 0 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+		offset = &o
+	}
+	includeBlockInfo := false
+	if chainRequest.IncludeBlockInfo != nil {
+		includeBlockInfo, err = utils.ToBool(*chainRequest.IncludeBlockInfo)
+		if err != nil {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+
+	var resp *responses.ChainResponse
+	if includeBlockInfo {
+		resp, err = hc.chainWithBlockInfo(chainRequest.Block, count, offset)
+	} else {
+		var chain *rpcresponses.ChainResponse
+		chain, err = hc.RpcClient.MakeChainRequest(chainRequest.Block, count, offset)
+		if err == nil {
+			resp = &responses.ChainResponse{
+				Blocks: chain.Blocks,
+			}
+		}
+	}
+	if err != nil {
+		log.Errorf("Error getting chain from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+// The chain with the block_info of every hash, up to block_info_concurrency block_info requests at once
+// Cached for chainCacheTTL
+func (hc *HttpController) chainWithBlockInfo(block string, count int, offset *int) (*responses.ChainResponse, error) {
+	cacheKey := fmt.Sprintf("chain:%s:%d", strings.ToUpper(block), count)
+	if offset != nil {
+		cacheKey = fmt.Sprintf("%s:%d", cacheKey, *offset)
+	}
+	if cached, err := hc.Cache.Get(cacheKey); err == nil {
+		var resp responses.ChainResponse
+		if err := json.Unmarshal(cached, &resp); err == nil {
+			return &resp, nil
+		}
+	}
+
+	chain, err := hc.RpcClient.MakeChainRequest(block, count, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*rpcresponses.BlockInfoResponse, len(chain.Blocks))
+	var g errgroup.Group
+	g.SetLimit(max(hc.Wallet.Config.Server.BlockInfoConcurrency, 1))
+	for i, hash := range chain.Blocks {
+		g.Go(func() error {
+			info, err := hc.RpcClient.MakeBlockInfoRequest(hash)
+			infos[i] = info
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	resp := &responses.ChainResponse{
+		Blocks:    chain.Blocks,
+		BlockInfo: make(map[string]*rpcresponses.BlockInfoResponse, len(chain.Blocks)),
+	}
+	for i, hash := range chain.Blocks {
+		resp.BlockInfo[hash] = infos[i]
+	}
+
+	if encoded, err := json.Marshal(resp); err == nil {
+		if err := hc.Cache.Set(cacheKey, encoded, chainCacheTTL); err != nil {
+			log.Errorf("Error caching chain %s", err)
+		}
+	}
+	return resp, nil
+}
+
+// weight / online * 100, 0 if there's no online weight
+func weightPercent(weight *big.Int, online *big.Int) float64 {
+	if online.Sign() <= 0 {
+		return 0
+	}
+	percent, _ := big.NewRat(0, 1).SetFrac(big.NewInt(0).Mul(weight, big.NewInt(100)), online).Float64()
+	return percent
+}
+
+// The weight of a representative from account_info, whether it's in representatives_online and its share of the online weight
+// Cached for representativeInfoCacheTTL
+func (hc *HttpController) representativeInfo(representative string, pub []byte) (*responses.RepresentativeInfoResponse, error) {
+	cacheKey := fmt.Sprintf("representative_info:%X", pub)
+	if cached, err := hc.Cache.Get(cacheKey); err == nil {
+		var resp responses.RepresentativeInfoResponse
+		if err := json.Unmarshal(cached, &resp); err == nil {
+			return &resp, nil
+		}
+	}
+
+	var info *rpcresponses.AccountInfoResponse
+	var online *rpcresponses.RepresentativesOnlineResponse
+	var quorum *rpcresponses.ConfirmationQuorumResponse
+	var g errgroup.Group
+	g.Go(func() error {
+		var err error
+		info, err = hc.RpcClient.MakeAccountInfoRequest(representative)
+		// Weight can be delegated to an account that was never opened, the node doesn't report it then
+		if errors.Is(err, rpc.ErrAccountNotFound) {
+			info = &rpcresponses.AccountInfoResponse{Weight: "0"}
+			return nil
+		}
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		online, err = hc.RpcClient.MakeRepresentativesOnlineRequest()
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		quorum, err = hc.RpcClient.MakeConfirmationQuorumRequest()
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	weight, ok := big.NewInt(0).SetString(info.Weight, 10)
+	if !ok {
+		return nil, errors.New("Unable to parse weight")
+	}
+	onlineWeight, ok := big.NewInt(0).SetString(quorum.OnlineStakeTotal, 10)
+	if !ok {
+		return nil, errors.New("Unable to parse online_stake_total")
+	}
+
+	resp := &responses.RepresentativeInfoResponse{
+		Representative:        representative,
+		WeightRaw:             weight.String(),
+		OnlineWeightRaw:       onlineWeight.String(),
+		WeightPercentOfOnline: weightPercent(weight, onlineWeight),
+	}
+	// The node may use another prefix, so compare public keys
+	for _, address := range online.Representatives {
+		onlinePub, err := utils.AddressToPub(address, hc.Wallet.Config.Wallet.Banano)
+		if err == nil && bytes.Equal(onlinePub, pub) {
+			resp.IsOnline = true
+			break
+		}
+	}
+
+	if encoded, err := json.Marshal(resp); err == nil {
+		if err := hc.Cache.Set(cacheKey, encoded, representativeInfoCacheTTL); err != nil {
+			log.Errorf("Error caching representative_info %s", err)
+		}
+	}
+	return resp, nil
+}
+
+// Handle representative_info, a representative's weight, online status and share of the online weight
+// It only talks to the node, so it doesn't need a wallet
+func (hc *HttpController) HandleRepresentativeInfo(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var infoRequest requests.RepresentativeInfoRequest
+	if err := mapstructure.Decode(rawRequest, &infoRequest); err != nil {
+		log.Errorf("Error unmarshalling representative info request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if infoRequest.Action == "" || infoRequest.Representative == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	pub, err := utils.AddressToPub(infoRequest.Representative, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+
+	resp, err := hc.representativeInfo(infoRequest.Representative, pub)
+	if err != nil {
+		log.Errorf("Error getting representative_info from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+// The voting weight delegated to an account, cached for accountWeightCacheTTL
+func (hc *HttpController) accountWeight(account string, pub []byte) (*big.Int, error) {
+	cacheKey := fmt.Sprintf("account_weight:%X", pub)
+	if cached, err := hc.Cache.Get(cacheKey); err == nil {
+		if weight, ok := big.NewInt(0).SetString(string(cached), 10); ok {
+			return weight, nil
+		}
+	}
+
+	resp, err := hc.RpcClient.MakeAccountWeightRequest(account)
+	if err != nil {
+		return nil, err
+	}
+	weight, ok := big.NewInt(0).SetString(resp.Weight, 10)
+	if !ok {
+		return nil, errors.New("Unable to parse weight")
+	}
+	if err := hc.Cache.Set(cacheKey, []byte(weight.String()), accountWeightCacheTTL); err != nil {
+		log.Errorf("Error caching account_weight %s", err)
+	}
+	return weight, nil
+}
+
+// Decode a request with an account, the error has been written if it's nil
+func (hc *HttpController) decodeAccountWeightRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) (*requests.AccountWeightRequest, []byte) {
+	var weightRequest requests.AccountWeightRequest
+	if err := mapstructure.Decode(rawRequest, &weightRequest); err != nil {
+		log.Errorf("Error unmarshalling account weight request %s", err)
+		ErrUnableToParseJson(w, r)
+		return nil, nil
+	} else if weightRequest.Action == "" || weightRequest.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return nil, nil
+	}
+
+	pub, err := utils.AddressToPub(weightRequest.Account, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return nil, nil
+	}
+	return &weightRequest, pub
+}
+
+// Handle account_weight, the voting weight delegated to an account, unlike its balance it includes funds that aren't spendable
+// Weight is public, so the account doesn't have to be in a wallet
+func (hc *HttpController) HandleAccountWeight(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	weightRequest, pub := hc.decodeAccountWeightRequest(rawRequest, w, r)
+	if weightRequest == nil {
+		return
+	}
+
+	weight, err := hc.accountWeight(weightRequest.Account, pub)
+	if err != nil {
+		log.Errorf("Error getting account_weight from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.AccountWeightResponse{
+		Weight:     weight.String(),
+		WeightRaw:  weight.String(),
+		WeightNano: utils.RawToReadable(weight, hc.Wallet.Config.Wallet.Banano),
+	})
+}
+
+// Handle account_full_info, an account's voting weight and the representative_info of its representative
+func (hc *HttpController) HandleAccountFullInfo(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	infoRequest, pub := hc.decodeAccountWeightRequest(rawRequest, w, r)
+	if infoRequest == nil {
+		return
+	}
+
+	var weight *big.Int
+	var representative *responses.RepresentativeInfoResponse
+	var g errgroup.Group
+	g.Go(func() error {
+		var err error
+		weight, err = hc.accountWeight(infoRequest.Account, pub)
+		return err
+	})
+	g.Go(func() error {
+		repResp, err := hc.RpcClient.MakeAccountRepresentativeRequest(infoRequest.Account)
+		// Unopened, it has no representative
+		if errors.Is(err, rpc.ErrAccountNotFound) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		repPub, err := utils.AddressToPub(repResp.Representative, hc.Wallet.Config.Wallet.Banano)
+		if err != nil {
+			return err
+		}
+		representative, err = hc.representativeInfo(repResp.Representative, repPub)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		log.Errorf("Error getting account_full_info from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.AccountFullInfoResponse{
+		Account:        infoRequest.Account,
+		WeightRaw:      weight.String(),
+		WeightNano:     utils.RawToReadable(weight, hc.Wallet.Config.Wallet.Banano),
+		Representative: representative,
+	})
+}
+
+// A raw amount from the node, cached for supplyCacheTTL
+func (hc *HttpController) cachedAmount(cacheKey string, fetch func() (string, error)) (*big.Int, error) {
+	if cached, err := hc.Cache.Get(cacheKey); err == nil {
+		if amount, ok := big.NewInt(0).SetString(string(cached), 10); ok {
+			return amount, nil
+		}
+	}
+
+	raw, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	amount, ok := big.NewInt(0).SetString(raw, 10)
+	if !ok {
+		return nil, fmt.Errorf("Unable to parse amount %s", raw)
+	}
+	if err := hc.Cache.Set(cacheKey, []byte(amount.String()), supplyCacheTTL); err != nil {
+		log.Errorf("Error caching %s %s", cacheKey, err)
+	}
+	return amount, nil
+}
+
+// The node's available_supply
+func (hc *HttpController) availableSupply() (*big.Int, error) {
+	return hc.cachedAmount("available_supply", func() (string, error) {
+		supply, err := hc.RpcClient.MakeAvailableSupplyRequest()
+		if err != nil {
+			return "", err
+		}
+		return supply.Available, nil
+	})
+}
+
+// The burn account is the all zero public key, it can't sign so everything sent to it stays receivable
+func (hc *HttpController) burnAccount() string {
+	return utils.PubKeyToAddress(make([]byte, 32), hc.Wallet.Config.Wallet.Banano)
+}
+
+// Balance plus receivable of the burn account
+func (hc *HttpController) burnAccountBalance() (*big.Int, error) {
+	return hc.cachedAmount("burn_account_balance", func() (string, error) {
+		balance, err := hc.RpcClient.MakeAccountBalanceRequest(hc.burnAccount())
+		if err != nil {
+			return "", err
+		}
+		raw, ok := big.NewInt(0).SetString(balance.Balance, 10)
+		if !ok {
+			return "", errors.New("Unable to parse balance")
+		}
+		pending, ok := big.NewInt(0).SetString(balance.Pending, 10)
+		if !ok {
+			return "", errors.New("Unable to parse pending")
+		}
+		return raw.Add(raw, pending).String(), nil
+	})
+}
+
+// Handle nano_supply, available_supply with the max supply and what isn't available of it
+func (hc *HttpController) HandleNanoSupply(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	available, err := hc.availableSupply()
+	if err != nil {
+		log.Errorf("Error getting available_supply from node %s", err)
+		ErrInternalServerError(w, r, "Error making available_supply request to node")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.NanoSupplyResponse{
+		AvailableRaw: available.String(),
+		MaxSupplyRaw: maxSupplyRaw.String(),
+		BurnedRaw:    big.NewInt(0).Sub(maxSupplyRaw, available).String(),
+	})
+}
+
+// Handle circulating_supply, like nano_supply but checked against the burn account's balance
+// The burn account can't hold more than what isn't available
+func (hc *HttpController) HandleCirculatingSupply(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var available, burnBalance *big.Int
+	var g errgroup.Group
+	g.Go(func() error {
+		var err error
+		available, err = hc.availableSupply()
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		burnBalance, err = hc.burnAccountBalance()
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		log.Errorf("Error getting supply from node %s", err)
+		ErrInternalServerError(w, r, "Error making supply requests to node")
+		return
+	}
+
+	burned := big.NewInt(0).Sub(maxSupplyRaw, available)
+	if burned.Sign() < 0 || burnBalance.Cmp(burned) > 0 {
+		log.Errorf("Supply doesn't add up, available %s and burn account %s", available, burnBalance)
+		ErrInternalServerError(w, r, "Supply from the node is inconsistent")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.CirculatingSupplyResponse{
+		CirculatingRaw: available.String(),
+		MaxSupplyRaw:   maxSupplyRaw.String(),
+		BurnedRaw:      burned.String(),
+		BurnAccount:    hc.burnAccount(),
+		BurnAccountRaw: burnBalance.String(),
+	})
+}
+
+// Handle nano_version, Pippin's own version and build, with the node's node_vendor
+// If the node can't be reached node_version is null, the rest is still returned
+func (hc *HttpController) HandleNanoVersion(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	resp := responses.NanoVersionResponse{
+		NodeVendor:   fmt.Sprintf("Pippin v%s", strings.TrimPrefix(hc.Build.Version, "v")),
+		BuildVersion: hc.Build.GitSHA,
+		BuildDate:    hc.Build.BuildDate,
+		Banano:       hc.Wallet.Banano,
+	}
+	nodeVersion, err := hc.nodeVersion()
+	if err != nil {
+		log.Errorf("Error getting version from node %s", err)
+	} else {
+		resp.NodeVersion = &nodeVersion
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// The node_vendor of the node's version, cached for nodeVersionCacheTTL
+func (hc *HttpController) nodeVersion() (string, error) {
+	cacheKey := "node_version"
+	if cached, err := hc.Cache.Get(cacheKey); err == nil {
+		return string(cached), nil
+	}
+
+	version, err := hc.RpcClient.MakeVersionRequest()
+	if err != nil {
+		return "", err
+	}
+	if err := hc.Cache.Set(cacheKey, []byte(version.NodeVendor), nodeVersionCacheTTL); err != nil {
+		log.Errorf("Error caching %s %s", cacheKey, err)
+	}
+	return version.NodeVendor, nil
+}
+
+// The representative and weight_minimum of delegators and delegators_count, from account and threshold if they're not set
+// weight_minimum is returned as a normalized raw amount
+func (hc *HttpController) decodeDelegatorsRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) (*requests.DelegatorsRequest, []byte) {
+	var delegatorsRequest requests.DelegatorsRequest
+	if err := mapstructure.Decode(rawRequest, &delegatorsRequest); err != nil {
+		log.Errorf("Error unmarshalling delegators request %s", err)
+		ErrUnableToParseJson(w, r)
+		return nil, nil
+	}
+	if delegatorsRequest.Representative == "" {
+		delegatorsRequest.Representative = delegatorsRequest.Account
+	}
+	if delegatorsRequest.WeightMinimum == nil {
+		delegatorsRequest.WeightMinimum = delegatorsRequest.Threshold
+	}
+	if delegatorsRequest.Action == "" || delegatorsRequest.Representative == "" {
+		ErrUnableToParseJson(w, r)
+		return nil, nil
+	}
+
+	pub, err := utils.AddressToPub(delegatorsRequest.Representative, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return nil, nil
+	}
+	if delegatorsRequest.WeightMinimum != nil {
+		weightMinimum, ok := big.NewInt(0).SetString(*delegatorsRequest.WeightMinimum, 10)
+		if !ok || weightMinimum.Sign() < 0 {
+			ErrBadRequest(w, r, ErrorCodeInvalidAmount, "Invalid weight_minimum")
+			return nil, nil
+		}
+		normalized := weightMinimum.String()
+		delegatorsRequest.WeightMinimum = &normalized
+	}
+	return &delegatorsRequest, pub
+}
+
+// Every delegator of a representative with at least weightMinimum, merged from delegators calls of delegators_page_size
+// The node returns them in account order after start, so the highest account of a page is the start of the next
+// Returned encoded as the delegators response, cached for delegatorsCacheTTL
+func (hc *HttpController) delegators(representative string, pub []byte, weightMinimum *string) ([]byte, error) {
+	cacheKey := fmt.Sprintf("delegators:%X", pub)
+	if weightMinimum != nil {
+		cacheKey = fmt.Sprintf("%s:%s", cacheKey, *weightMinimum)
+	}
+	if cached, err := hc.Cache.Get(cacheKey); err == nil {
+		return cached, nil
+	}
+
+	pageSize := max(hc.Wallet.Config.Server.DelegatorsPageSize, 1)
+	merged := map[string]string{}
+	var start *string
+	for {
+		page, err := hc.RpcClient.MakeDelegatorsRequest(representative, weightMinimum, pageSize, start)
+		if err != nil {
+			return nil, err
+		}
+		var highest []byte
+		for delegator, balance := range page.Delegators {
+			merged[delegator] = balance
+			delegatorPub, err := utils.AddressToPub(delegator, hc.Wallet.Config.Wallet.Banano)
+			if err != nil {
+				return nil, errors.New("Invalid delegator from node")
+			}
+			if highest == nil || bytes.Compare(delegatorPub, highest) > 0 {
+				highest = delegatorPub
+				start = &delegator
+			}
+		}
+		// A short page is the last one
+		if len(page.Delegators) < pageSize {
+			break
+		}
+	}
+
+	encoded, err := json.Marshal(rpcresponses.DelegatorsResponse{Delegators: merged})
+	if err != nil {
+		return nil, err
+	}
+	if err := hc.Cache.Set(cacheKey, encoded, delegatorsCacheTTL); err != nil {
+		log.Errorf("Error caching delegators %s", err)
+	}
+	return encoded, nil
+}
+
+// Handle delegators, every page of the node's delegators merged into one response
+// The response has an ETag, sending it back as If-None-Match returns 304 Not Modified without a body while the delegators are unchanged
+func (hc *HttpController) HandleDelegators(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	delegatorsRequest, pub := hc.decodeDelegatorsRequest(rawRequest, w, r)
+	if delegatorsRequest == nil {
+		return
+	}
+
+	encoded, err := hc.delegators(delegatorsRequest.Representative, pub, delegatorsRequest.WeightMinimum)
+	if err != nil {
+		log.Errorf("Error getting delegators from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	etag := fmt.Sprintf("\"%x\"", sha256.Sum256(encoded))
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(encoded)
+}
+
+// The node's delegators_count, cached for delegatorsCacheTTL
+// The node can't count by balance, so with weightMinimum the delegators are counted instead
+func (hc *HttpController) delegatorsCount(representative string, pub []byte, weightMinimum *string) (string, error) {
+	if weightMinimum != nil {
+		encoded, err := hc.delegators(representative, pub, weightMinimum)
+		if err != nil {
+			return "", err
+		}
+		var delegators rpcresponses.DelegatorsResponse
+		if err := json.Unmarshal(encoded, &delegators); err != nil {
+			return "", err
+		}
+		return strconv.Itoa(len(delegators.Delegators)), nil
+	}
+
+	cacheKey := fmt.Sprintf("delegators_count:%X", pub)
+	if cached, err := hc.Cache.Get(cacheKey); err == nil {
+		return string(cached), nil
+	}
+	count, err := hc.RpcClient.MakeDelegatorsCountRequest(representative)
+	if err != nil {
+		return "", err
+	}
+	if err := hc.Cache.Set(cacheKey, []byte(count.Count), delegatorsCacheTTL); err != nil {
+		log.Errorf("Error caching delegators_count %s", err)
+	}
+	return count.Count, nil
+}
+
+// Handle delegators_count, how many accounts delegate to a representative without fetching them
+func (hc *HttpController) HandleDelegatorsCount(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	delegatorsRequest, pub := hc.decodeDelegatorsRequest(rawRequest, w, r)
+	if delegatorsRequest == nil {
+		return
+	}
+
+	count, err := hc.delegatorsCount(delegatorsRequest.Representative, pub, delegatorsRequest.WeightMinimum)
+	if err != nil {
+		log.Errorf("Error getting delegators_count from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &rpcresponses.DelegatorsCountResponse{Count: count})
+}
+
+// The node's confirmation_quorum with quorum_reached, quorum_percent and status added, cached for confirmationQuorumCacheTTL
+// quorum_percent is online_stake_total as a share of online_weight_minimum, the quorum is reached at 100
+func (hc *HttpController) confirmationQuorum(peerDetails bool) (map[string]interface{}, error) {
+	cacheKey := fmt.Sprintf("confirmation_quorum:%t", peerDetails)
+	if cached, err := hc.Cache.Get(cacheKey); err == nil {
+		var quorum map[string]interface{}
+		if err := json.Unmarshal(cached, &quorum); err == nil {
+			return quorum, nil
+		}
+	}
+
+	nodeRequest := map[string]interface{}{"action": "confirmation_quorum"}
+	if peerDetails {
+		nodeRequest["peer_details"] = "true"
+	}
+	resp, err := hc.RpcClient.MakeRequest(nodeRequest)
+	if err != nil {
+		return nil, err
+	}
+	var quorum map[string]interface{}
+	if err := json.Unmarshal(resp, &quorum); err != nil {
+		return nil, err
+	} else if errStr, ok := quorum["error"].(string); ok {
+		return nil, errors.New(errStr)
+	}
+
+	online, ok := big.NewInt(0).SetString(fmt.Sprint(quorum["online_stake_total"]), 10)
+	if !ok {
+		return nil, errors.New("Unable to parse online_stake_total")
+	}
+	minimum, ok := big.NewInt(0).SetString(fmt.Sprint(quorum["online_weight_minimum"]), 10)
+	if !ok {
+		return nil, errors.New("Unable to parse online_weight_minimum")
+	}
+	percent := weightPercent(online, minimum)
+	status := quorumStatusHealthy
+	if online.Cmp(minimum) < 0 {
+		status = quorumStatusInsufficient
+	} else if percent < quorumAtRiskPercent {
+		status = quorumStatusAtRisk
+	}
+	quorum["quorum_reached"] = online.Cmp(minimum) >= 0
+	quorum["quorum_percent"] = percent
+	quorum["status"] = status
+
+	if encoded, err := json.Marshal(quorum); err == nil {
+		if err := hc.Cache.Set(cacheKey, encoded, confirmationQuorumCacheTTL); err != nil {
+			log.Errorf("Error caching confirmation_quorum %s", err)
+		}
+	}
+	return quorum, nil
+}
+
+// Handle confirmation_quorum, forwarded to the node with whether the quorum is reached and how safely
+func (hc *HttpController) HandleConfirmationQuorum(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var quorumRequest requests.ConfirmationQuorumRequest
+	if err := mapstructure.Decode(rawRequest, &quorumRequest); err != nil {
+		log.Errorf("Error unmarshalling confirmation_quorum request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	peerDetails := false
+	if quorumRequest.PeerDetails != nil {
+		var err error
+		peerDetails, err = utils.ToBool(*quorumRequest.PeerDetails)
+		if err != nil {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+
+	quorum, err := hc.confirmationQuorum(peerDetails)
+	if err != nil {
+		log.Errorf("Error getting confirmation_quorum from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &quorum)
+}
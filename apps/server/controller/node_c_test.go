@@ -0,0 +1,1327 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockCount(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	nodeCount := map[string]interface{}{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "block_count" {
+				return httpmock.NewJsonResponse(200, nodeCount)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	hc := newTestController(t)
+	doRequest := func() (int, responses.BlockCountResponse, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "block_count",
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		respBody, _ := io.ReadAll(resp.Body)
+		var respJson responses.BlockCountResponse
+		json.Unmarshal(respBody, &respJson)
+		var rawResp map[string]interface{}
+		json.Unmarshal(respBody, &rawResp)
+		return resp.StatusCode, respJson, rawResp
+	}
+
+	for _, tc := range []struct {
+		count       string
+		unchecked   string
+		cemented    string
+		syncPercent float64
+		syncing     bool
+	}{
+		// Synced
+		{"1000", "0", "1000", 100, false},
+		// Behind on cementing but nothing unchecked
+		{"1000", "0", "500", 50, false},
+		// Still syncing
+		{"1000", "10", "250", 25, true},
+		// Above the threshold a few unchecked blocks are normal
+		{"100000", "5", "99950", 99.95, false},
+		{"100000", "5", "99800", 99.8, true},
+		// Nothing yet
+		{"0", "0", "0", 0, false},
+	} {
+		nodeCount = map[string]interface{}{"count": tc.count, "unchecked": tc.unchecked, "cemented": tc.cemented}
+		// Don't get the previous case from the cache
+		hc.blockCountCache.count = nil
+
+		status, respJson, rawResp := doRequest()
+		assert.Equal(t, 200, status)
+		assert.Equal(t, tc.count, respJson.Count)
+		assert.Equal(t, tc.unchecked, respJson.Unchecked)
+		assert.Equal(t, tc.cemented, respJson.Cemented)
+		assert.InDelta(t, tc.syncPercent, respJson.SyncPercent, 0.0001)
+		assert.Equal(t, tc.syncing, respJson.Syncing)
+		// Only there when it's syncing
+		_, ok := rawResp["syncing"]
+		assert.Equal(t, tc.syncing, ok)
+	}
+}
+
+func TestBlockCountCache(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	nodeCount := map[string]interface{}{"count": "1000", "unchecked": "0", "cemented": "1000"}
+	requests := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			requests++
+			return httpmock.NewJsonResponse(200, nodeCount)
+		},
+	)
+
+	hc := newTestController(t)
+	count, err := hc.blockCount()
+	assert.Nil(t, err)
+	assert.Equal(t, "1000", count.Count)
+
+	// Cached for block_count_cache_ttl
+	nodeCount = map[string]interface{}{"count": "2000", "unchecked": "0", "cemented": "2000"}
+	count, err = hc.blockCount()
+	assert.Nil(t, err)
+	assert.Equal(t, "1000", count.Count)
+	assert.Equal(t, 1, requests)
+
+	// Then asks the node again
+	hc.blockCountCache.fetchedAt = time.Now().Add(-time.Duration(hc.Wallet.Config.Server.BlockCountCacheTTL) * time.Second)
+	count, err = hc.blockCount()
+	assert.Nil(t, err)
+	assert.Equal(t, "2000", count.Count)
+	assert.Equal(t, 2, requests)
+
+	// Errors aren't cached
+	nodeCount = map[string]interface{}{"error": "node down"}
+	hc.blockCountCache.count = nil
+	_, err = hc.blockCount()
+	assert.NotNil(t, err)
+	nodeCount = map[string]interface{}{"count": "3000", "unchecked": "0", "cemented": "3000"}
+	count, err = hc.blockCount()
+	assert.Nil(t, err)
+	assert.Equal(t, "3000", count.Count)
+}
+
+func TestPeers(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	nodePeers := mocks.PeersResponseStr
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "peers" {
+				return httpmock.NewStringResponse(200, nodePeers), nil
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	hc := newTestController(t)
+	doRequest := func(action string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": action,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		hc.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// The loopback peer is left out
+	status, respJson := doRequest("peers")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{
+		"[::ffff:172.17.0.1]:32841": "19",
+		"[::ffff:172.17.0.2]:7075":  "19",
+	}, respJson["peers"])
+
+	status, respJson = doRequest("peer_count")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(2), respJson["count"])
+
+	// Cached, the node was only asked once
+	nodePeers = `{"peers": ""}`
+	status, respJson = doRequest("peer_count")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(2), respJson["count"])
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	hc.peersCache.fetchedAt = time.Now().Add(-peersCacheTTL)
+	status, respJson = doRequest("peer_count")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(0), respJson["count"])
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestIsLoopbackPeer(t *testing.T) {
+	assert.True(t, isLoopbackPeer("[::1]:7075"))
+	assert.True(t, isLoopbackPeer("[::ffff:127.0.0.1]:7075"))
+	assert.True(t, isLoopbackPeer("127.0.0.1:7075"))
+	assert.False(t, isLoopbackPeer("[::ffff:172.17.0.1]:32841"))
+	assert.False(t, isLoopbackPeer("not a peer"))
+}
+
+func TestElectionStatistics(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	difficulty := mocks.ActiveDifficultyResponseStr
+	quorum := mocks.ConfirmationQuorumResponseStr
+	nodeCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			nodeCalls++
+			switch pr["action"] {
+			case "active_difficulty":
+				return httpmock.NewStringResponse(200, difficulty), nil
+			case "confirmation_quorum":
+				return httpmock.NewStringResponse(200, quorum), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+		},
+	)
+
+	hc := newTestController(t)
+	doRequest := func() (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "election_statistics",
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Both merged
+	status, resp := doRequest()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{
+		"network_minimum":              "fffffff800000000",
+		"network_receive_minimum":      "fffffe0000000000",
+		"network_current":              "fffffff800000000",
+		"network_receive_current":      "fffffe0000000000",
+		"multiplier":                   "1",
+		"difficulty_trend":             []interface{}{"1", "1.156096135149775"},
+		"quorum_delta":                 "41469707173777717318245825935516662250",
+		"online_weight_quorum_percent": "50",
+		"online_weight_minimum":        "60000000000000000000000000000000000000",
+		"online_stake_total":           "82939414347555434636491651871033324568",
+		"trended_stake_total":          "81939414347555434636491651871033324568",
+		"peers_stake_total":            "69026910610720098597176027400951402360",
+	}, resp)
+	assert.Equal(t, 2, nodeCalls)
+
+	// Cached
+	status, _ = doRequest()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 2, nodeCalls)
+
+	// confirmation_quorum fails
+	hc.electionStatisticsCache.stats = nil
+	quorum = mocks.ErrorResponseStr
+	status, resp = doRequest()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, true, resp["partial"])
+	assert.Equal(t, "1", resp["multiplier"])
+	_, ok := resp["quorum_delta"]
+	assert.False(t, ok)
+
+	// Partial results aren't cached
+	quorum = mocks.ConfirmationQuorumResponseStr
+	difficulty = mocks.ErrorResponseStr
+	status, resp = doRequest()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, true, resp["partial"])
+	assert.Equal(t, "50", resp["online_weight_quorum_percent"])
+	_, ok = resp["multiplier"]
+	assert.False(t, ok)
+
+	// Both fail
+	quorum = mocks.ErrorResponseStr
+	status, _ = doRequest()
+	assert.Equal(t, 500, status)
+}
+
+func TestNanoDifficultyInfo(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	multiplier := "1"
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"multiplier":      multiplier,
+				"network_current": "fffffff800000000",
+			})
+		},
+	)
+
+	hc := newTestController(t)
+	doRequest := func() (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "nano_difficulty_info",
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Nothing sampled yet
+	status, resp := doRequest()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{
+		"current_multiplier":    float64(1),
+		"average_multiplier_1h": nil,
+		"min_multiplier_1h":     nil,
+		"max_multiplier_1h":     nil,
+	}, resp)
+
+	// Samples from the difficulty updater
+	hc.PowClient.NodeRpcUrl = "http://localhost:123456"
+	for _, sampled := range []string{"1", "2", "4.5"} {
+		multiplier = sampled
+		assert.Nil(t, hc.PowClient.UpdateDifficulty(context.Background()))
+	}
+	multiplier = "3"
+	status, resp = doRequest()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{
+		"current_multiplier":    float64(3),
+		"average_multiplier_1h": 2.5,
+		"min_multiplier_1h":     float64(1),
+		"max_multiplier_1h":     4.5,
+	}, resp)
+
+	// The node can't be reached
+	multiplier = "abc"
+	status, _ = doRequest()
+	assert.Equal(t, 500, status)
+}
+
+func TestWorkDifficultyHistory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		httpmock.NewJsonResponderOrPanic(200, map[string]interface{}{
+			"multiplier":      "1.5",
+			"network_current": "fffffff800000000",
+		}),
+	)
+
+	hc := newTestController(t)
+	doRequest := func(request map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Nothing sampled yet
+	status, resp := doRequest(map[string]interface{}{"action": "work_difficulty_history"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"history": []interface{}{}}, resp)
+
+	hc.PowClient.NodeRpcUrl = "http://localhost:123456"
+	assert.Nil(t, hc.PowClient.UpdateDifficulty(context.Background()))
+	now := time.Now()
+	hc.PowClient.SampleDifficulty(now.Add(-12 * time.Hour))
+	hc.PowClient.SampleDifficulty(now.Add(-2 * time.Hour))
+	hc.PowClient.SampleDifficulty(now.Add(-10 * time.Minute))
+
+	status, resp = doRequest(map[string]interface{}{"action": "work_difficulty_history", "hours": 1})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"timestamp": float64(now.Add(-10 * time.Minute).Unix()), "multiplier": 1.5},
+	}, resp["history"])
+
+	status, resp = doRequest(map[string]interface{}{"action": "work_difficulty_history", "hours": "6"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"timestamp": float64(now.Add(-2 * time.Hour).Unix()), "multiplier": 1.5},
+		map[string]interface{}{"timestamp": float64(now.Add(-10 * time.Minute).Unix()), "multiplier": 1.5},
+	}, resp["history"])
+
+	// All 24 hours without hours
+	status, resp = doRequest(map[string]interface{}{"action": "work_difficulty_history"})
+	assert.Equal(t, 200, status)
+	assert.Len(t, resp["history"], 3)
+
+	status, resp = doRequest(map[string]interface{}{"action": "work_difficulty_history", "hours": 12})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_PERIOD", resp["error_code"])
+}
+
+func TestNetworkStats(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var mutex sync.Mutex
+	telemetry := mocks.TelemetryResponseStr
+	nodeCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			// The calls are made at the same time
+			mutex.Lock()
+			defer mutex.Unlock()
+			nodeCalls++
+			switch pr["action"] {
+			case "telemetry":
+				return httpmock.NewStringResponse(200, telemetry), nil
+			case "active_difficulty":
+				return httpmock.NewStringResponse(200, "{\"multiplier\": \"1.5\"}"), nil
+			case "confirmation_quorum":
+				return httpmock.NewStringResponse(200, mocks.ConfirmationQuorumResponseStr), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+		},
+	)
+
+	hc := newTestController(t)
+	doRequest := func() (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "network_stats",
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// All three merged
+	status, resp := doRequest()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(1), resp["online_peers"])
+	assert.Equal(t, float64(5), resp["block_count"])
+	assert.Equal(t, float64(1), resp["cemented_count"])
+	assert.Equal(t, float64(0), resp["unchecked_count"])
+	assert.Equal(t, float64(1572864), resp["bandwidth_cap_bytes"])
+	assert.Equal(t, 1.5, resp["active_difficulty_multiplier"])
+	assert.InDelta(t, 138.23, resp["quorum_percent"], 0.01)
+	assert.Equal(t, []interface{}{}, resp["errors"])
+	assert.Equal(t, 3, nodeCalls)
+
+	// Cached
+	status, _ = doRequest()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 3, nodeCalls)
+
+	// telemetry fails, the others are still there
+	hc.networkStatsCache.stats = nil
+	telemetry = mocks.ErrorResponseStr
+	status, resp = doRequest()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []interface{}{"telemetry: bad input"}, resp["errors"])
+	for _, field := range []string{"online_peers", "block_count", "cemented_count", "unchecked_count", "bandwidth_cap_bytes"} {
+		value, ok := resp[field]
+		assert.True(t, ok)
+		assert.Nil(t, value)
+	}
+	assert.Equal(t, 1.5, resp["active_difficulty_multiplier"])
+	assert.Equal(t, 6, nodeCalls)
+
+	// Results with errors aren't cached
+	telemetry = mocks.TelemetryResponseStr
+	status, resp = doRequest()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(5), resp["block_count"])
+	assert.Equal(t, 9, nodeCalls)
+}
+
+func TestChain(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	start := "f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f80"
+	hashes := []string{}
+	for i := 0; i < 6; i++ {
+		hashes = append(hashes, fmt.Sprintf("C4A1%060X", i))
+	}
+	var mutex sync.Mutex
+	inFlight, maxInFlight, blockInfoCalls := 0, 0, 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "chain":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": hashes})
+			case "block_info":
+				mutex.Lock()
+				inFlight++
+				blockInfoCalls++
+				maxInFlight = max(maxInFlight, inFlight)
+				mutex.Unlock()
+				time.Sleep(20 * time.Millisecond)
+				mutex.Lock()
+				inFlight--
+				mutex.Unlock()
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				js["height"] = pr["hash"]
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	hc := newTestController(t)
+	conf := *hc.Wallet.Config
+	conf.Server.BlockInfoConcurrency = 2
+	hc.Wallet.Config = &conf
+	doChain := func(request map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Just the node's hashes
+	status, resp := doChain(map[string]interface{}{"action": "chain", "block": start, "count": 6})
+	assert.Equal(t, 200, status)
+	assert.Len(t, resp["blocks"], 6)
+	_, ok := resp["block_info"]
+	assert.False(t, ok)
+	assert.Equal(t, 0, blockInfoCalls)
+
+	// With the block_info of each, block_info_concurrency at a time
+	status, resp = doChain(map[string]interface{}{"action": "chain", "block": start, "count": 6, "include_block_info": true})
+	assert.Equal(t, 200, status)
+	assert.Len(t, resp["blocks"], 6)
+	blockInfo := resp["block_info"].(map[string]interface{})
+	assert.Len(t, blockInfo, 6)
+	for _, hash := range hashes {
+		assert.Equal(t, hash, blockInfo[hash].(map[string]interface{})["height"])
+	}
+	assert.Equal(t, 6, blockInfoCalls)
+	assert.Equal(t, 2, maxInFlight)
+
+	// Cached
+	status, resp = doChain(map[string]interface{}{"action": "chain", "block": start, "count": 6, "include_block_info": true})
+	assert.Equal(t, 200, status)
+	assert.Len(t, resp["block_info"], 6)
+	assert.Equal(t, 6, blockInfoCalls)
+
+	// Bad input never reaches the node
+	status, _ = doChain(map[string]interface{}{"action": "chain", "block": "f1a2", "count": 6})
+	assert.Equal(t, 400, status)
+	status, _ = doChain(map[string]interface{}{"action": "chain", "block": start, "count": 0})
+	assert.Equal(t, 400, status)
+	status, _ = doChain(map[string]interface{}{"action": "chain", "block": start, "count": 6, "offset": -1})
+	assert.Equal(t, 400, status)
+}
+
+func TestWeightPercent(t *testing.T) {
+	online, _ := big.NewInt(0).SetString("82939414347555434636491651871033324568", 10)
+	half, _ := big.NewInt(0).SetString("41469707173777717318245825935516662284", 10)
+	assert.Equal(t, 50.0, weightPercent(half, online))
+	assert.Equal(t, 100.0, weightPercent(online, online))
+	assert.Equal(t, 0.0, weightPercent(big.NewInt(0), online))
+	assert.InDelta(t, 33.333333, weightPercent(big.NewInt(1), big.NewInt(3)), 0.000001)
+	assert.Equal(t, 0.0, weightPercent(half, big.NewInt(0)))
+}
+
+func TestRepresentativeInfo(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	online := "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k"
+	offline := "nano_1111111111111111111111111111111111111111111111111awsq94gtecn"
+	unopened := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	// The three node calls are made at once
+	var mutex sync.Mutex
+	calls := map[string]int{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			mutex.Lock()
+			calls[pr["action"].(string)]++
+			mutex.Unlock()
+			switch pr["action"] {
+			case "account_info":
+				if pr["account"] == unopened {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Account not found"})
+				}
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				js["weight"] = "1000000000000000000000000000000000000"
+				return httpmock.NewJsonResponse(200, js)
+			case "representatives_online":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.RepresentativesOnlineResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "confirmation_quorum":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ConfirmationQuorumResponseStr), &js)
+				js["online_stake_total"] = "80000000000000000000000000000000000000"
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	hc := newTestController(t)
+	doRepresentativeInfo := func(representative string) (int, []byte) {
+		body, _ := json.Marshal(map[string]interface{}{"action": "representative_info", "representative": representative})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	status, body := doRepresentativeInfo(online)
+	assert.Equal(t, 200, status)
+	var resp responses.RepresentativeInfoResponse
+	json.Unmarshal(body, &resp)
+	assert.Equal(t, responses.RepresentativeInfoResponse{
+		Representative:        online,
+		WeightRaw:             "1000000000000000000000000000000000000",
+		IsOnline:              true,
+		OnlineWeightRaw:       "80000000000000000000000000000000000000",
+		WeightPercentOfOnline: 1.25,
+	}, resp)
+	assert.Equal(t, map[string]int{"account_info": 1, "representatives_online": 1, "confirmation_quorum": 1}, calls)
+
+	// Cached
+	status, _ = doRepresentativeInfo(online)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 1, calls["account_info"])
+
+	status, body = doRepresentativeInfo(offline)
+	assert.Equal(t, 200, status)
+	resp = responses.RepresentativeInfoResponse{}
+	json.Unmarshal(body, &resp)
+	assert.False(t, resp.IsOnline)
+	assert.Equal(t, 1.25, resp.WeightPercentOfOnline)
+
+	status, body = doRepresentativeInfo(unopened)
+	assert.Equal(t, 200, status)
+	resp = responses.RepresentativeInfoResponse{}
+	json.Unmarshal(body, &resp)
+	assert.Equal(t, "0", resp.WeightRaw)
+	assert.Equal(t, 0.0, resp.WeightPercentOfOnline)
+
+	// Bad input never reaches the node
+	status, _ = doRepresentativeInfo("nano_1")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, 3, calls["account_info"])
+}
+
+func TestAccountWeight(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	account := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	unopened := "nano_1111111111111111111111111111111111111111111111111awsq94gtecn"
+	representative := "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k"
+	var mutex sync.Mutex
+	calls := map[string]int{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			mutex.Lock()
+			calls[pr["action"].(string)]++
+			mutex.Unlock()
+			switch pr["action"] {
+			case "account_weight":
+				if pr["account"] == unopened {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"weight": "0"})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"weight": "1234560000000000000000000000000"})
+			case "account_representative":
+				if pr["account"] == unopened {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Account not found"})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"representative": representative})
+			case "account_info":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				js["weight"] = "1000000000000000000000000000000000000"
+				return httpmock.NewJsonResponse(200, js)
+			case "representatives_online":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.RepresentativesOnlineResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "confirmation_quorum":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ConfirmationQuorumResponseStr), &js)
+				js["online_stake_total"] = "80000000000000000000000000000000000000"
+				return httpmock.NewJsonResponse(200, js)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	hc := newTestController(t)
+	doRequest := func(action string, account string) (int, []byte) {
+		body, _ := json.Marshal(map[string]interface{}{"action": action, "account": account})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	status, body := doRequest("account_weight", account)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"weight":"1234560000000000000000000000000","weight_raw":"1234560000000000000000000000000","weight_nano":"1.23456"}`, strings.TrimSpace(string(body)))
+	assert.Equal(t, 1, calls["account_weight"])
+
+	// Cached
+	status, _ = doRequest("account_weight", account)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 1, calls["account_weight"])
+
+	status, body = doRequest("account_weight", unopened)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"weight":"0","weight_raw":"0","weight_nano":"0"}`, strings.TrimSpace(string(body)))
+
+	// Full info reuses the cached weight
+	status, body = doRequest("account_full_info", account)
+	assert.Equal(t, 200, status)
+	var resp responses.AccountFullInfoResponse
+	json.Unmarshal(body, &resp)
+	assert.Equal(t, responses.AccountFullInfoResponse{
+		Account:    account,
+		WeightRaw:  "1234560000000000000000000000000",
+		WeightNano: "1.23456",
+		Representative: &responses.RepresentativeInfoResponse{
+			Representative:        representative,
+			WeightRaw:             "1000000000000000000000000000000000000",
+			IsOnline:              true,
+			OnlineWeightRaw:       "80000000000000000000000000000000000000",
+			WeightPercentOfOnline: 1.25,
+		},
+	}, resp)
+	assert.Equal(t, 2, calls["account_weight"])
+	assert.Equal(t, 1, calls["account_representative"])
+
+	status, body = doRequest("account_full_info", unopened)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"account":"`+unopened+`","weight_raw":"0","weight_nano":"0","representative":null}`, strings.TrimSpace(string(body)))
+
+	// BANANO
+	conf := *hc.Wallet.Config
+	conf.Wallet.Banano = true
+	hc.Wallet.Config = &conf
+	pub, _ := utils.AddressToPub(account, false)
+	status, body = doRequest("account_weight", utils.PubKeyToAddress(pub, true))
+	assert.Equal(t, 200, status)
+	assert.Contains(t, string(body), `"weight_nano":"12.3456"`)
+
+	// Bad input never reaches the node
+	status, _ = doRequest("account_weight", "nano_1")
+	assert.Equal(t, 400, status)
+	status, _ = doRequest("account_full_info", "")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, 2, calls["account_weight"])
+
+	// Node errors
+	httpmock.RegisterResponder("POST", "http://localhost:123456", httpmock.NewStringResponder(500, "error"))
+	status, _ = doRequest("account_weight", "ban_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k")
+	assert.Equal(t, 500, status)
+}
+
+func TestBootstrap(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var forwarded []map[string]interface{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			forwarded = append(forwarded, pr)
+			switch pr["action"] {
+			case "bootstrap_lazy":
+				return httpmock.NewStringResponse(200, `{"started": "1", "key_inserted": "0"}`), nil
+			case "bootstrap_status":
+				return httpmock.NewStringResponse(200, `{"bootstrap_threads": "2", "running_attempts_count": "1", "total_attempts_count": "5", "connections": {"clients": "5"}, "attempts": []}`), nil
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	hc := newTestController(t)
+	doAdmin := func(reqBody map[string]interface{}) (int, string) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		hc.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(respBody)
+	}
+
+	hash := "FF0144381CFF0B2C079A115E7ADA7E96F43FD219446E7524C48D1CC9900C4F17"
+	status, body := doAdmin(map[string]interface{}{"action": "bootstrap_lazy", "hash": hash, "force": true})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"started": "1", "key_inserted": "0"}`, body)
+	assert.Equal(t, map[string]interface{}{"action": "bootstrap_lazy", "hash": hash, "force": "true"}, forwarded[0])
+
+	// Without force, nothing but the hash is forwarded
+	status, _ = doAdmin(map[string]interface{}{"action": "bootstrap_lazy", "hash": hash, "wallet": "1234"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"action": "bootstrap_lazy", "hash": hash}, forwarded[1])
+
+	status, body = doAdmin(map[string]interface{}{"action": "bootstrap_status"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"bootstrap_threads": "2", "running_attempts_count": "1", "total_attempts_count": "5", "connections": {"clients": "5"}, "attempts": []}`, body)
+
+	// Bad input never reaches the node
+	status, _ = doAdmin(map[string]interface{}{"action": "bootstrap_lazy", "hash": "FF01"})
+	assert.Equal(t, 400, status)
+	status, _ = doAdmin(map[string]interface{}{"action": "bootstrap_lazy", "hash": hash, "force": "maybe"})
+	assert.Equal(t, 400, status)
+	assert.Len(t, forwarded, 3)
+}
+
+func TestBootstrapPeer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var forwarded []map[string]interface{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			forwarded = append(forwarded, pr)
+			return httpmock.NewStringResponse(200, `{"success": ""}`), nil
+		},
+	)
+
+	hc := newTestController(t)
+	database.GetRedisDB().Del("bootstrap")
+	defer database.GetRedisDB().Del("bootstrap")
+	doAdmin := func(reqBody map[string]interface{}) (int, string) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		hc.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(respBody)
+	}
+
+	// Bad addresses never reach the node, and don't count for the limit
+	for _, address := range []string{"203.0.113.7", "peering.nano.org:7075", "203.0.113.7:0", "203.0.113.7:abc"} {
+		status, body := doAdmin(map[string]interface{}{"action": "bootstrap", "address": address})
+		assert.Equal(t, 400, status)
+		assert.Contains(t, body, "INVALID_IP")
+	}
+	assert.Len(t, forwarded, 0)
+
+	status, body := doAdmin(map[string]interface{}{"action": "bootstrap", "address": "[::ffff:203.0.113.7]:7075"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"success": ""}`, body)
+	assert.Equal(t, map[string]interface{}{"action": "bootstrap", "address": "::ffff:203.0.113.7", "port": "7075"}, forwarded[0])
+
+	// Once a minute, for either of them
+	status, body = doAdmin(map[string]interface{}{"action": "bootstrap", "address": "203.0.113.7:7075"})
+	assert.Equal(t, 429, status)
+	assert.Contains(t, body, "RATE_LIMITED")
+	status, _ = doAdmin(map[string]interface{}{"action": "bootstrap_any"})
+	assert.Equal(t, 429, status)
+	assert.Len(t, forwarded, 1)
+
+	database.GetRedisDB().Del("bootstrap")
+	status, body = doAdmin(map[string]interface{}{"action": "bootstrap_any"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"success": ""}`, body)
+	assert.Equal(t, map[string]interface{}{"action": "bootstrap_any"}, forwarded[1])
+
+	// Admin only
+	anyBody, _ := json.Marshal(map[string]interface{}{"action": "bootstrap_any"})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/admin", bytes.NewReader(anyBody))
+	hc.AdminHandler(w, req)
+	assert.Equal(t, 401, w.Result().StatusCode)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(anyBody))
+	hc.Gateway(w, req)
+	assert.Equal(t, 403, w.Result().StatusCode)
+}
+
+func TestSupply(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	burnAccount := "nano_1111111111111111111111111111111111111111111111111111hifc8npp"
+	burnBalance := map[string]string{"balance": "0", "pending": "1000", "receivable": "1000"}
+	var mutex sync.Mutex
+	calls := map[string]int{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			mutex.Lock()
+			defer mutex.Unlock()
+			calls[pr["action"].(string)]++
+			switch pr["action"] {
+			case "available_supply":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AvailableSupplyResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "account_balance":
+				if pr["account"] == burnAccount {
+					return httpmock.NewJsonResponse(200, burnBalance)
+				}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	doSupply := func(hc *HttpController, action string) (int, []byte) {
+		body, _ := json.Marshal(map[string]interface{}{"action": action})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	hc := newTestController(t)
+	status, body := doSupply(hc, "nano_supply")
+	assert.Equal(t, 200, status)
+	var supply responses.NanoSupplyResponse
+	json.Unmarshal(body, &supply)
+	// 2^128 - 1 minus the available supply
+	assert.Equal(t, responses.NanoSupplyResponse{
+		AvailableRaw: "133248061996216572282917317807824970865",
+		MaxSupplyRaw: "340282366920938463463374607431768211455",
+		BurnedRaw:    "207034304924721891180457289623943240590",
+	}, supply)
+
+	status, body = doSupply(hc, "circulating_supply")
+	assert.Equal(t, 200, status)
+	var circulating responses.CirculatingSupplyResponse
+	json.Unmarshal(body, &circulating)
+	assert.Equal(t, responses.CirculatingSupplyResponse{
+		CirculatingRaw: "133248061996216572282917317807824970865",
+		MaxSupplyRaw:   "340282366920938463463374607431768211455",
+		BurnedRaw:      "207034304924721891180457289623943240590",
+		BurnAccount:    burnAccount,
+		BurnAccountRaw: "1000",
+	}, circulating)
+
+	// Both are cached
+	status, _ = doSupply(hc, "circulating_supply")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 1, calls["available_supply"])
+	assert.Equal(t, 1, calls["account_balance"])
+
+	// The burn account can't have more than what's burned
+	burnBalance = map[string]string{"balance": "0", "pending": "207034304924721891180457289623943240591", "receivable": "207034304924721891180457289623943240591"}
+	status, _ = doSupply(newTestController(t), "circulating_supply")
+	assert.Equal(t, 500, status)
+}
+
+func TestNanoVersion(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	versionCalls := 0
+	nodeUp := true
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&js)
+			if js["action"] != "version" || !nodeUp {
+				return httpmock.NewStringResponse(500, "error"), nil
+			}
+			versionCalls++
+			var info map[string]interface{}
+			json.Unmarshal([]byte(mocks.VersionResponseStr), &info)
+			return httpmock.NewJsonResponse(200, info)
+		},
+	)
+
+	doRequest := func(hc *HttpController) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "nano_version",
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	hc := newTestController(t)
+	hc.Build = BuildInfo{Version: "1.2.3", GitSHA: "abc1234", BuildDate: "2024-01-02T03:04:05Z"}
+	status, respJson := doRequest(hc)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{
+		"node_vendor":   "Pippin v1.2.3",
+		"build_version": "abc1234",
+		"build_date":    "2024-01-02T03:04:05Z",
+		"banano":        false,
+		"node_version":  "Nano V25.1",
+	}, respJson)
+
+	// The node's version is cached
+	status, respJson = doRequest(hc)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "Nano V25.1", respJson["node_version"])
+	assert.Equal(t, 1, versionCalls)
+
+	// banano is from the wallet, and the rest is returned when the node is down
+	nodeUp = false
+	hc = newTestController(t)
+	hc.Wallet.Banano = true
+	hc.Build = BuildInfo{Version: "v1.2.3"}
+	status, respJson = doRequest(hc)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, true, respJson["banano"])
+	assert.Equal(t, "Pippin v1.2.3", respJson["node_vendor"])
+	assert.Contains(t, respJson, "node_version")
+	assert.Nil(t, respJson["node_version"])
+}
+
+func TestDelegators(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	representative := "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est"
+	// 10 delegators in account order, with balances of 1000 to 10000
+	var delegators []string
+	for i := 1; i <= 10; i++ {
+		pub := make([]byte, 32)
+		pub[0] = byte(i)
+		delegators = append(delegators, utils.PubKeyToAddress(pub, false))
+	}
+	var starts []interface{}
+	countCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&js)
+			if js["account"] != representative {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Bad account number"})
+			}
+			if js["action"] == "delegators_count" {
+				countCalls++
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"count": "10"})
+			}
+			starts = append(starts, js["start"])
+			count, _ := utils.ToInt(js["count"])
+			threshold := big.NewInt(0)
+			if js["threshold"] != nil {
+				threshold.SetString(js["threshold"].(string), 10)
+			}
+			page := map[string]string{}
+			started := js["start"] == nil
+			for i, delegator := range delegators {
+				if !started {
+					started = delegator == js["start"]
+					continue
+				}
+				balance := big.NewInt(int64(i+1) * 1000)
+				if len(page) < count && balance.Cmp(threshold) >= 0 {
+					page[delegator] = balance.String()
+				}
+			}
+			if len(page) == 0 {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"delegators": ""})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"delegators": page})
+		},
+	)
+
+	hc := newTestController(t)
+	conf := *hc.Wallet.Config
+	conf.Server.DelegatorsPageSize = 5
+	hc.Wallet.Config = &conf
+	doRequest := func(request map[string]interface{}, etag string) (*http.Response, []byte) {
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp, respBody
+	}
+
+	// Two pages of 5 are merged, the empty third page ends it
+	resp, body := doRequest(map[string]interface{}{"action": "delegators", "representative": representative}, "")
+	assert.Equal(t, 200, resp.StatusCode)
+	var respJson map[string]map[string]string
+	json.Unmarshal(body, &respJson)
+	assert.Len(t, respJson["delegators"], 10)
+	for i, delegator := range delegators {
+		assert.Equal(t, fmt.Sprintf("%d", (i+1)*1000), respJson["delegators"][delegator])
+	}
+	assert.Equal(t, []interface{}{nil, delegators[4], delegators[9]}, starts)
+	etag := resp.Header.Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	// The node's account is the same, and it's cached with the same ETag
+	resp, cached := doRequest(map[string]interface{}{"action": "delegators", "account": representative}, "")
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, body, cached)
+	assert.Equal(t, etag, resp.Header.Get("ETag"))
+	assert.Len(t, starts, 3)
+
+	// Unchanged for a client that has it
+	resp, cached = doRequest(map[string]interface{}{"action": "delegators", "representative": representative}, etag)
+	assert.Equal(t, 304, resp.StatusCode)
+	assert.Empty(t, cached)
+
+	// weight_minimum is the node's threshold
+	starts = nil
+	resp, body = doRequest(map[string]interface{}{"action": "delegators", "representative": representative, "weight_minimum": "7000"}, "")
+	assert.Equal(t, 200, resp.StatusCode)
+	json.Unmarshal(body, &respJson)
+	assert.Len(t, respJson["delegators"], 4)
+	assert.NotEqual(t, etag, resp.Header.Get("ETag"))
+	assert.Equal(t, []interface{}{nil}, starts)
+
+	// delegators_count only asks the node's delegators_count, and counts the delegators with weight_minimum
+	resp, body = doRequest(map[string]interface{}{"action": "delegators_count", "representative": representative}, "")
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.JSONEq(t, `{"count":"10"}`, string(body))
+	resp, body = doRequest(map[string]interface{}{"action": "delegators_count", "representative": representative}, "")
+	assert.JSONEq(t, `{"count":"10"}`, string(body))
+	assert.Equal(t, 1, countCalls)
+	resp, body = doRequest(map[string]interface{}{"action": "delegators_count", "representative": representative, "threshold": "7000"}, "")
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.JSONEq(t, `{"count":"4"}`, string(body))
+	assert.Equal(t, 1, countCalls)
+
+	// Invalid requests and node errors
+	resp, body = doRequest(map[string]interface{}{"action": "delegators", "representative": representative, "weight_minimum": "-1"}, "")
+	assert.Equal(t, 400, resp.StatusCode)
+	assert.Contains(t, string(body), "INVALID_AMOUNT")
+	resp, body = doRequest(map[string]interface{}{"action": "delegators_count", "representative": "nano_1"}, "")
+	assert.Equal(t, 400, resp.StatusCode)
+	assert.Contains(t, string(body), "INVALID_ACCOUNT")
+	resp, _ = doRequest(map[string]interface{}{"action": "delegators", "representative": "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"}, "")
+	assert.Equal(t, 500, resp.StatusCode)
+}
+
+func TestConfirmationQuorum(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	onlineStakeTotal := ""
+	nodeCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			// For the health check's node check
+			if pr["action"] == "block_count" && onlineStakeTotal != "" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"count": "1000", "unchecked": "0", "cemented": "1000"})
+			}
+			nodeCalls++
+			if pr["action"] != "confirmation_quorum" || onlineStakeTotal == "" {
+				return httpmock.NewStringResponse(500, "error"), nil
+			}
+			var js map[string]interface{}
+			json.Unmarshal([]byte(mocks.ConfirmationQuorumResponseStr), &js)
+			js["online_stake_total"] = onlineStakeTotal
+			if pr["peer_details"] == "true" {
+				js["peers"] = []interface{}{}
+			}
+			return httpmock.NewJsonResponse(200, js)
+		},
+	)
+
+	doQuorum := func(hc *HttpController, request map[string]interface{}) (int, map[string]interface{}) {
+		request["action"] = "confirmation_quorum"
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+	doHealth := func(hc *HttpController) (int, map[string]interface{}) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/health", nil)
+		hc.HandleHealth(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// online_weight_minimum is 60000000000000000000000000000000000000
+	for _, tc := range []struct {
+		online  string
+		reached bool
+		percent float64
+		status  string
+		health  string
+	}{
+		{"82939414347555434636491651871033324568", true, 138.23235724592572, "healthy", "ok"},
+		{"63000000000000000000000000000000000000", true, 105, "at_risk", "degraded"},
+		{"60000000000000000000000000000000000000", true, 100, "at_risk", "degraded"},
+		{"45000000000000000000000000000000000000", false, 75, "insufficient", "degraded"},
+	} {
+		hc := newTestController(t)
+		onlineStakeTotal = tc.online
+		nodeCalls = 0
+
+		status, resp := doQuorum(hc, map[string]interface{}{})
+		assert.Equal(t, 200, status)
+		assert.Equal(t, tc.online, resp["online_stake_total"])
+		assert.Equal(t, "50", resp["online_weight_quorum_percent"])
+		assert.Equal(t, tc.reached, resp["quorum_reached"])
+		assert.InDelta(t, tc.percent, resp["quorum_percent"], 0.000001)
+		assert.Equal(t, tc.status, resp["status"])
+
+		// The health check uses the same cached response
+		status, resp = doHealth(hc)
+		assert.Equal(t, 200, status)
+		assert.Equal(t, tc.health, resp["status"])
+		assert.Equal(t, tc.status, resp["quorum_status"])
+		assert.Equal(t, 1, nodeCalls)
+	}
+
+	// peer_details is passed on and cached apart
+	hc := newTestController(t)
+	status, resp := doQuorum(hc, map[string]interface{}{"peer_details": "true"})
+	assert.Equal(t, 200, status)
+	assert.Contains(t, resp, "peers")
+	status, resp = doQuorum(hc, map[string]interface{}{})
+	assert.Equal(t, 200, status)
+	assert.NotContains(t, resp, "peers")
+	status, _ = doQuorum(hc, map[string]interface{}{"peer_details": "sometimes"})
+	assert.Equal(t, 400, status)
+
+	// The node can't be reached
+	hc = newTestController(t)
+	onlineStakeTotal = ""
+	status, _ = doQuorum(hc, map[string]interface{}{})
+	assert.Equal(t, 500, status)
+	status, resp = doHealth(hc)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "degraded", resp["status"])
+	assert.NotContains(t, resp, "quorum_status")
+}
@@ -0,0 +1,536 @@
+package controller
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+)
+
+// OpenAPI spec for the gateway and admin actions
+// openapi.json is generated from apiActions by tools/openapi, run `go generate ./...` after changing an action
+//go:generate go run ../tools/openapi
+
+//go:embed openapi.json
+var openAPISpec []byte
+
+type apiAction struct {
+	Action   string
+	Summary  string
+	Request  interface{}
+	Required []string
+	Example  map[string]interface{}
+}
+
+const exampleWallet = "186e3283-f27d-4ef5-87e3-84322dd740a2"
+const exampleAccount = "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7"
+const exampleDestination = "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+const exampleHash = "e2fb233ef4554077a7bf1aa85851d5bf0b36965d2b0fb504b2bc778ab89917d3"
+const exampleSeed = "95d72ce5eca6abfde45f77bd75f1c888223bcca2d5178df2a1d89533005c69dc"
+
+// Every action handled by the gateway, keep in sync with gatewayActions
+var apiActions = []apiAction{
+	{"wallet_create", "Create a new wallet, optionally from an existing seed, return_seed returns the seed once, scan creates the seed's accounts with history on chain", requests.WalletCreateRequest{}, []string{"action"},
+		map[string]interface{}{"action": "wallet_create", "seed": exampleSeed, "return_seed": false, "scan": true, "gap_limit": 20}},
+	{"wallet_create_from_seed", "Create a wallet from an existing seed with its first count accounts, from index 0", requests.WalletCreateFromSeedRequest{}, []string{"action", "seed"},
+		map[string]interface{}{"action": "wallet_create_from_seed", "seed": exampleSeed, "count": 5, "name": "Hot wallet"}},
+	{"wallet_create_watch_only", "Create a watch-only wallet with an account for each address, up to watch_only_max_accounts, it can be queried but can't sign", requests.WalletCreateWatchOnlyRequest{}, []string{"action", "accounts"},
+		map[string]interface{}{"action": "wallet_create_watch_only", "accounts": []string{exampleAccount, exampleDestination}, "name": "Cold storage"}},
+	{"wallet_add_watch", "Add addresses to a wallet as watch-only accounts, they're included in its balances, pending and history but anything that signs for them returns no_private_key", requests.WalletAddWatchRequest{}, []string{"action", "wallet", "accounts"},
+		map[string]interface{}{"action": "wallet_add_watch", "wallet": exampleWallet, "accounts": []string{exampleDestination}}},
+	{"wallet_import_nanowallet", "Create a wallet from a version 1 NanoWallet backup, a wrong passphrase returns decryption_failed", requests.WalletImportNanoWalletRequest{}, []string{"action", "backup", "passphrase"},
+		map[string]interface{}{"action": "wallet_import_nanowallet", "passphrase": "correct horse battery staple", "backup": map[string]interface{}{
+			"version":    1,
+			"salt":       "8f3a1c6e9b2d5f7a0c4e8b1d3f6a9c2e",
+			"iv":         "1b4d7f0a3c6e9b2d5f8a1c4e7b0d3f6a",
+			"iterations": 10000,
+			"seed":       "befddffefd68aa01d4aff69c6b445194c2da4f97e2c6a08edf31646586bb02befa9f313bbafa7f80b503f9e1c41cac7cad2fde9fd020e2fe69d214a19532cf8112d5501c7959f23255696e4553a63404",
+			"accounts":   []map[string]interface{}{{"index": 0, "account": "nano_3pdaccf89w1aewqi78443i99pkcrtudjpihhksjs1ip1aum9eou3krz1nks9"}},
+		}}},
+	{"wallet_import_nault", "Create a wallet for every wallet in a version 1 Nault backup, a wrong passphrase returns decryption_failed", requests.WalletImportNaultRequest{}, []string{"action", "backup", "passphrase"},
+		map[string]interface{}{"action": "wallet_import_nault", "passphrase": "nault backup passphrase", "backup": map[string]interface{}{
+			"version":    1,
+			"salt":       "5c2e8a1f4b7d0e3a6c9f2b5d8e1a4c7f",
+			"iterations": 10000,
+			"nonce":      "9a3c5e7f1b2d4f6a8c0e2b4d",
+			"data":       "d61a2bad3e001f404183647d99a5117ae1150863aaab33b214bd491e008455e4...",
+		}}},
+	{"wallet_backup_restore", "Restore a wallet_backup_create backup with the wallet's ID, unencrypted, wallet_exists if the wallet is already there", requests.WalletBackupRestoreRequest{}, []string{"action", "backup", "passphrase"},
+		map[string]interface{}{"action": "wallet_backup_restore", "passphrase": "correct horse battery staple", "backup": map[string]interface{}{
+			"version":    1,
+			"salt":       "0d3f6a9c2e5b8d1f4a7c0e3b6d9f2a5c",
+			"iterations": 600000,
+			"nonce":      "4e7b0d3f6a9c2e5b8d1f4a7c",
+			"data":       "7a1c4e9b2d5f8a0c3e6b9d2f5a8c1e4b7d0f3a6c9e2b5d8f1a4c7e0b3d6f9a2c...",
+		}}},
+	{"account_create", "Create the next account in a wallet, or one derived from another seed, refused with gap_limit_exceeded if gap_limit unused accounts in a row would be exceeded", requests.AccountCreateRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "account_create", "wallet": exampleWallet}},
+	{"account_create_vanity", "Search for a key with an address matching prefix and suffix using up to workers goroutines for up to timeout seconds and add it to the wallet as an adhoc account, refused with VANITY_NOT_FOUND if none matched in time", requests.AccountCreateVanityRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "account_create_vanity", "wallet": exampleWallet, "prefix": "1pip", "workers": 4, "timeout": 60}},
+	{"account_create_next", "The next_index account_create would create an account at and the would_be_account, nothing is created, refused with gap_limit_exceeded like account_create", requests.AccountCreateNextRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "account_create_next", "wallet": exampleWallet, "gap_limit": 20}},
+	{"accounts_create", "Create count accounts in a wallet, retrying with the same idempotency_key returns the accounts created the first time, async returns a job_id for job_status, refused with gap_limit_exceeded if gap_limit unused accounts in a row would be exceeded", requests.AccountsCreateRequest{}, []string{"action", "wallet", "count"},
+		map[string]interface{}{"action": "accounts_create", "wallet": exampleWallet, "count": 10}},
+	{"account_list", "List accounts in a wallet oldest first, count at a time (up to account_list_max_count) from offset, total returns how many the wallet has", requests.AccountListRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "account_list", "wallet": exampleWallet, "count": 100, "offset": 200, "total": true}},
+	{"accounts_filter", "Accounts of a wallet whose balance and representative match every given filter", requests.AccountsFilterRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "accounts_filter", "wallet": exampleWallet, "min_balance_raw": "1000000000000000000000000000000", "representative": exampleDestination}},
+	{"accounts_weight", "Accounts of a wallet grouped by representative, with the total_weight_raw they delegate to it", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "accounts_weight", "wallet": exampleWallet}},
+	{"accounts_info", "The opened state, balance_raw, pending_raw, frontier and representative of every account of a wallet, with their derivation_index, or of the given accounts, keyed by account", requests.AccountsInfoRequest{}, []string{"action"},
+		map[string]interface{}{"action": "accounts_info", "accounts": []string{exampleAccount}}},
+	{"account_balance_history", "Balance of an account per hour or day, from the snapshots recorded every balance_snapshot_interval", requests.AccountBalanceHistoryRequest{}, []string{"action", "wallet", "account", "period", "start_date", "end_date"},
+		map[string]interface{}{"action": "account_balance_history", "wallet": exampleWallet, "account": exampleAccount, "period": "daily", "start_date": "2024-03-01", "end_date": "2024-04-01"}},
+	{"account_history_all", "Every send and receive of an account from account_history, fetched page by page down to the open block and streamed, complete is false if max_blocks was reached first", requests.AccountHistoryAllRequest{}, []string{"action", "account"},
+		map[string]interface{}{"action": "account_history_all", "account": exampleAccount, "max_blocks": 10000}},
+	{"account_history_since", "Sends and receives of an account in the wallet after since_hash, newest first, read from the frontier back at most max_depth blocks, hash_not_found_in_chain if since_hash isn't in them", requests.AccountHistorySinceRequest{}, []string{"action", "wallet", "account", "since_hash"},
+		map[string]interface{}{"action": "account_history_since", "wallet": exampleWallet, "account": exampleAccount, "since_hash": exampleHash}},
+	{"accounts_sync", "Compare the accounts of a wallet with the node, which are opened, which aren't, and which accounts of the seed are opened but missing from the wallet", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "accounts_sync", "wallet": exampleWallet}},
+	{"account_sync", "Bring an account of the wallet up to date with the node, its frontier is read from the node and everything receivable is received, returns how many blocks were received and the new frontier", requests.AccountSyncRequest{}, []string{"action", "wallet", "account"},
+		map[string]interface{}{"action": "account_sync", "wallet": exampleWallet, "account": exampleAccount}},
+	{"account_remove", "Remove an account from a wallet", requests.AccountRemoveRequest{}, []string{"action", "wallet", "account"},
+		map[string]interface{}{"action": "account_remove", "wallet": exampleWallet, "account": exampleAccount, "force": false}},
+	{"account_label_set", "Set the label and key/value metadata of an account, an empty label or metadata object removes it", requests.AccountLabelSetRequest{}, []string{"action", "wallet", "account"},
+		map[string]interface{}{"action": "account_label_set", "wallet": exampleWallet, "account": exampleAccount, "label": "user 42", "metadata": map[string]string{"user_id": "42"}}},
+	{"account_label_get", "The label and metadata of an account", requests.AccountLabelGetRequest{}, []string{"action", "wallet", "account"},
+		map[string]interface{}{"action": "account_label_get", "wallet": exampleWallet, "account": exampleAccount}},
+	{"account_move", "Move accounts of the source wallet to wallet with their keys, all of them or none, wallet can't be encrypted", requests.AccountMoveRequest{}, []string{"action", "wallet", "source", "accounts"},
+		map[string]interface{}{"action": "account_move", "wallet": exampleWallet, "source": "5f3a8d21-6c4e-4b7a-9e12-0d8c7b6a5f43", "accounts": []string{exampleAccount}}},
+	{"password_change", "Set or change the wallet password", requests.PasswordChangeRequest{}, []string{"action", "wallet", "password"},
+		map[string]interface{}{"action": "password_change", "wallet": exampleWallet, "password": "hunter2"}},
+	{"password_enter", "Unlock a wallet, with an API key only for that key until it locks it, other keys have to unlock it too", requests.PasswordEnterRequest{}, []string{"action", "wallet", "password"},
+		map[string]interface{}{"action": "password_enter", "wallet": exampleWallet, "password": "hunter2"}},
+	{"wallet_add", "Add an ad-hoc private key to a wallet", requests.WalletAddRequest{}, []string{"action", "wallet", "key"},
+		map[string]interface{}{"action": "wallet_add", "wallet": exampleWallet, "key": exampleSeed}},
+	{"wallet_locked", "Check whether a wallet is locked, for the request's API key", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_locked", "wallet": exampleWallet}},
+	{"wallet_lock", "Lock a wallet, with an API key only that key's unlock ends and the wallet is locked once no keys have it unlocked", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_lock", "wallet": exampleWallet}},
+	{"wallet_balances", "Balances of every account in a wallet", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_balances", "wallet": exampleWallet}},
+	{"wallet_balance_total", "Sum of the balances and receivable amounts of every account in a wallet, optionally with the fiat value, unit changes the unit of balance, pending and total", requests.WalletBalanceTotalRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_balance_total", "wallet": exampleWallet, "include_price": true, "currency": "usd"}},
+	{"wallet_frontiers", "Frontiers of every account in a wallet", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_frontiers", "wallet": exampleWallet}},
+	{"wallet_pending", "Pending blocks for every account in a wallet", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_pending", "wallet": exampleWallet}},
+	{"wallet_ledger", "Frontier, open block, balance and block count of every opened account in a wallet, from the node's accounts_info", requests.WalletLedgerRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_ledger", "wallet": exampleWallet, "representative": true, "weight": true, "pending": true}},
+	{"snapshot_balances", "Record the balance of every account in a wallet, with an optional label", requests.SnapshotBalancesRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "snapshot_balances", "wallet": exampleWallet, "label": "2023 year end"}},
+	{"list_snapshots", "List the balance snapshots of a wallet, newest first", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "list_snapshots", "wallet": exampleWallet}},
+	{"get_snapshot", "A balance snapshot with the balance of every account in it", requests.GetSnapshotRequest{}, []string{"action", "wallet", "snapshot_id"},
+		map[string]interface{}{"action": "get_snapshot", "wallet": exampleWallet, "snapshot_id": "3f8a2c1d-9b4e-4a7f-8c2d-5e1b9a6f3c07"}},
+	{"deterministic_key", "Derive the key pair at index of a seed", requests.DeterministicKeyRequest{}, []string{"action", "seed", "index"},
+		map[string]interface{}{"action": "deterministic_key", "seed": exampleSeed, "index": 0}},
+	{"key_valid", "Whether a private key is 64 hex characters, with the public_key and account it's for, or the reason it isn't", requests.KeyValidRequest{}, []string{"action", "key"},
+		map[string]interface{}{"action": "key_valid", "key": exampleSeed}},
+	{"work_generate", "Generate proof of work for a hash, difficulty (hex) or multiplier (of the subtype's threshold) asks for more than the default", requests.WorkGenerateRequest{}, []string{"action", "hash"},
+		map[string]interface{}{"action": "work_generate", "hash": exampleHash}},
+	{"wallet_info", "Summary of a wallet's balances and accounts", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_info", "wallet": exampleWallet}},
+	{"wallet_contains", "Check whether an account belongs to a wallet", requests.WalletContainsRequest{}, []string{"action", "wallet", "account"},
+		map[string]interface{}{"action": "wallet_contains", "wallet": exampleWallet, "account": exampleAccount}},
+	{"wallet_verify", "Check that every account of a wallet is derived from its stored keys, works while locked with address format checks only", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_verify", "wallet": exampleWallet}},
+	{"wallet_reconcile", "Compare a wallet's accounts and sends made with an id with the node, reports unpocketed blocks, forks, missing sends and balance mismatches, start_date only checks the sends since then", requests.WalletReconcileRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_reconcile", "wallet": exampleWallet, "start_date": "2024-03-01"}},
+	{"wallet_accounts_reindex", "Set the account_index of accounts derived from the wallet seed that don't have it or have the wrong one, accounts that don't match are returned but not removed", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_accounts_reindex", "wallet": exampleWallet}},
+	{"wallet_statistics", "Sends, receives, unique counterparties and the largest send of a wallet over the last day, week or month from account_history, confirmed and unconfirmed blocks apart, cached for wallet_statistics_cache_ttl seconds", requests.WalletStatisticsRequest{}, []string{"action", "wallet", "period"},
+		map[string]interface{}{"action": "wallet_statistics", "wallet": exampleWallet, "period": "week"}},
+	{"receive", "Receive a pending block, preview returns the block instead of publishing it, difficulty or multiplier generates harder work than the network needs", requests.ReceiveRequest{}, []string{"action", "wallet", "account", "block"},
+		map[string]interface{}{"action": "receive", "wallet": exampleWallet, "account": exampleAccount, "block": exampleHash}},
+	{"receive_all", "Receive every pending block in a wallet, async returns a job_id for job_status", requests.ReceiveAllRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "receive_all", "wallet": exampleWallet}},
+	{"receive_batch", "Receive the given pending blocks on an account in order, blocks that aren't pending are skipped", requests.ReceiveBatchRequest{}, []string{"action", "wallet", "account", "blocks"},
+		map[string]interface{}{"action": "receive_batch", "wallet": exampleWallet, "account": exampleAccount, "blocks": []string{exampleHash}}},
+	{"send", "Send from an account in a wallet, destination_unopened is set if the destination was never opened, allow_unopened false refuses those sends, preview returns the block instead of publishing it, difficulty or multiplier generates harder work than the network needs, sends over the wallet's approval threshold are stored and return an approval_id instead, unit is what amount is in (raw, nano, or raw, banano and banoshi in banano mode)", requests.SendRequest{}, []string{"action", "wallet", "source", "destination", "amount"},
+		map[string]interface{}{"action": "send", "wallet": exampleWallet, "source": exampleAccount, "destination": exampleDestination, "amount": "1000000000000000000000000000000", "id": "7081e2b8fec9146e"}},
+	{"send_with_id", "Send once per send_id, retrying returns the block that was sent, a failed send is retried", requests.SendWithIDRequest{}, []string{"action", "wallet", "source", "destination", "amount", "send_id"},
+		map[string]interface{}{"action": "send_with_id", "wallet": exampleWallet, "source": exampleAccount, "destination": exampleDestination, "amount": "1000000000000000000000000000000", "send_id": "withdrawal-7081e2b8"}},
+	{"send_bulk", "Send from one account to each destination in order, a send that fails doesn't stop the rest, sends with an id are only made once so retries are safe", requests.SendBulkRequest{}, []string{"action", "wallet", "source", "sends"},
+		map[string]interface{}{"action": "send_bulk", "wallet": exampleWallet, "source": exampleAccount, "sends": []map[string]interface{}{{"destination": exampleDestination, "amount": "1000000000000000000000000000000", "id": "payout-1"}}}},
+	{"send_raw", "Publish a state block that was built and signed outside of Pippin, its account has to be in the wallet", requests.SendRawRequest{}, []string{"action", "wallet", "block"},
+		map[string]interface{}{"action": "send_raw", "wallet": exampleWallet, "block": map[string]interface{}{
+			"type":           "state",
+			"account":        exampleAccount,
+			"previous":       exampleHash,
+			"representative": exampleAccount,
+			"balance":        "1000000000000000000000000000000",
+			"link":           "d4bbfa50649d80e5f63fc396c6f4cf6321cabd7c1480e964c2701d56aafeb5e3",
+			"signature":      "b580fa76c0b763aa8a8a90af8592155c9478554ce04c87b5fb115baae624eafa116e04fffb273405c0ffcff6dfb021526292ac4418f3988d7684e15e486f1409",
+		}}},
+	{"sign_message", "Sign a message with the key of an account in the wallet, to prove the wallet holds it, the signature is over a prefixed hash of the message and can't be used as a block's", requests.SignMessageRequest{}, []string{"action", "wallet", "account", "message"},
+		map[string]interface{}{"action": "sign_message", "wallet": exampleWallet, "account": exampleAccount, "message": "Deposit address of order 1234"}},
+	{"verify_message", "Whether a signature of sign_message is the account's for the message", requests.VerifyMessageRequest{}, []string{"action", "account", "message", "signature"},
+		map[string]interface{}{"action": "verify_message", "account": exampleAccount, "message": "Deposit address of order 1234", "signature": "47B6D04C2A6D984573B1DF999D3698AA579862B8B87B6D291B489BCD5506C6A1B49E86187FAE9D454ADEE6A68E978B4701118CDC2252755B1940D7D708F70F02"}},
+	{"sign_block", "Sign a state block that was built outside of Pippin with the key of its account, which has to be in the wallet, the block is returned with its signature and isn't published", requests.SignBlockRequest{}, []string{"action", "wallet", "block"},
+		map[string]interface{}{"action": "sign_block", "wallet": exampleWallet, "block": map[string]interface{}{
+			"type":           "state",
+			"account":        exampleAccount,
+			"previous":       exampleHash,
+			"representative": exampleAccount,
+			"balance":        "1000000000000000000000000000000",
+			"link":           "d4bbfa50649d80e5f63fc396c6f4cf6321cabd7c1480e964c2701d56aafeb5e3",
+		}}},
+	{"sweep_to_wallet", "Receive everything pending on accounts derived from the given seeds, then send their balances to an account of a wallet, async returns a job_id for job_status", requests.SweepToWalletRequest{}, []string{"action", "wallet", "destination_account", "sources"},
+		map[string]interface{}{"action": "sweep_to_wallet", "wallet": exampleWallet, "destination_account": exampleAccount, "sources": []map[string]interface{}{{"seed": exampleSeed, "index": 0}}}},
+	{"cross_wallet_transfer", "Receive everything pending on the accounts of a wallet, send their balances to an account of another wallet and receive them there", requests.CrossWalletTransferRequest{}, []string{"action", "source_wallet", "destination_wallet", "destination_account"},
+		map[string]interface{}{"action": "cross_wallet_transfer", "source_wallet": exampleWallet, "destination_wallet": "a3f1c7d2-5e8b-4c09-9d6a-2b7e4f1c8a35", "destination_account": exampleAccount}},
+	{"wallet_sweep", "Receive everything pending on every account of a wallet and send their balances to one destination, dry_run only returns what would be moved, async returns a job_id for job_status", requests.WalletSweepRequest{}, []string{"action", "wallet", "destination"},
+		map[string]interface{}{"action": "wallet_sweep", "wallet": exampleWallet, "destination": exampleAccount, "dry_run": true}},
+	{"block_count", "Forward block_count to the node, with sync_percent and syncing if it's still catching up, cached for block_count_cache_ttl seconds", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "block_count"}},
+	{"election_statistics", "The node's active_difficulty (with difficulty_trend) and confirmation_quorum in one response, cached for 5 seconds, partial if one of them failed", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "election_statistics"}},
+	{"network_stats", "The node's telemetry, active_difficulty and confirmation_quorum fetched at the same time and merged for dashboards, cached for 15 seconds, fields of a call that failed are null and it's in errors", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "network_stats"}},
+	{"nano_difficulty_info", "The node's current active_difficulty multiplier, with the average, min and max of the multipliers sampled every difficulty_update_interval seconds over the last hour", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "nano_difficulty_info"}},
+	{"work_difficulty_history", "The active_difficulty multipliers recorded every 30 seconds over the last hours, 1, 6 or 24 (the default), oldest first, kept in memory for 24 hours", requests.WorkDifficultyHistoryRequest{}, []string{"action"},
+		map[string]interface{}{"action": "work_difficulty_history", "hours": 6}},
+	{"nano_supply", "The node's available_supply as available_raw, with max_supply_raw and burned_raw (max minus available), cached for 5 minutes", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "nano_supply"}},
+	{"circulating_supply", "Like nano_supply, with the balance of the burn account, which can't be more than burned_raw, cached for 5 minutes", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "circulating_supply"}},
+	{"representative_info", "The voting weight of a representative from account_info, whether it's in representatives_online and weight_percent_of_online from confirmation_quorum, cached for 60 seconds", requests.RepresentativeInfoRequest{}, []string{"action", "representative"},
+		map[string]interface{}{"action": "representative_info", "representative": exampleDestination}},
+	{"confirmation_quorum", "Forward confirmation_quorum to the node, with quorum_reached, quorum_percent of online_weight_minimum and a status of healthy, at_risk or insufficient, cached for 30 seconds", requests.ConfirmationQuorumRequest{}, []string{"action"},
+		map[string]interface{}{"action": "confirmation_quorum"}},
+	{"delegators", "Every delegator of a representative with its balance, every page of the node's delegators merged and cached for 60 seconds, with an ETag for If-None-Match", requests.DelegatorsRequest{}, []string{"action", "representative"},
+		map[string]interface{}{"action": "delegators", "representative": exampleDestination, "weight_minimum": "1000000000000000000000000000000"}},
+	{"delegators_count", "How many accounts delegate to a representative from the node's delegators_count, counted from delegators with weight_minimum, cached for 60 seconds", requests.DelegatorsRequest{}, []string{"action", "representative"},
+		map[string]interface{}{"action": "delegators_count", "representative": exampleDestination}},
+	{"chain", "Forward chain to the node, with include_block_info the block_info of every hash is added, cached for 60 seconds", requests.ChainRequest{}, []string{"action", "block", "count"},
+		map[string]interface{}{"action": "chain", "block": exampleHash, "count": 10, "include_block_info": true}},
+	{"block_confirm", "Ask the node to request confirmation of a block", requests.BlockConfirmRequest{}, []string{"action", "hash"},
+		map[string]interface{}{"action": "block_confirm", "hash": exampleHash}},
+	{"block_rebroadcast", "Publish a block from the node's ledger again with process, unless it's already confirmed, at most once per hash every 30 seconds", requests.BlockRebroadcastRequest{}, []string{"action", "hash"},
+		map[string]interface{}{"action": "block_rebroadcast", "hash": exampleHash}},
+	{"send_confirmation_poll", "Whether a sent block is confirmed from block_info, with its local timestamp, cached forever once it's confirmed and for 1 second until then", requests.SendConfirmationPollRequest{}, []string{"action", "hash"},
+		map[string]interface{}{"action": "send_confirmation_poll", "hash": exampleHash}},
+	{"block_successor", "The next block in the account chain of block from block_info, null for the frontier, with wallet_account if the account is in a wallet", requests.AdjacentBlockRequest{}, []string{"action", "block"},
+		map[string]interface{}{"action": "block_successor", "block": exampleHash}},
+	{"block_predecessor", "The previous block in the account chain of block from block_info, null for an open block, with wallet_account if the account is in a wallet", requests.AdjacentBlockRequest{}, []string{"action", "block"},
+		map[string]interface{}{"action": "block_predecessor", "block": exampleHash}},
+	{"nano_version", "Pippin's version as node_vendor, the git SHA it was built from as build_version, its build_date, whether it's in banano mode and the node_vendor of the connected node as node_version, from version cached for 5 minutes", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "nano_version"}},
+	{"gateway_actions", "Every action handled by Pippin instead of the node, grouped by category (wallet, account, block, utility and admin), admin actions are served at /admin", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "gateway_actions"}},
+	{"pipeline", "Run up to pipeline_max_actions actions in order, stopping at the first that fails, params of an action can use $previous.<field> of the response before it", requests.PipelineRequest{}, []string{"action", "actions"},
+		map[string]interface{}{"action": "pipeline", "actions": []interface{}{
+			map[string]interface{}{"action": "receive_all", "params": map[string]interface{}{"wallet": exampleWallet}},
+			map[string]interface{}{"action": "send", "params": map[string]interface{}{"wallet": exampleWallet, "source": exampleAccount, "destination": exampleDestination, "amount": "1000000000000000000000000000000"}},
+			map[string]interface{}{"action": "block_info", "params": map[string]interface{}{"hash": "$previous.block"}},
+		}}},
+	{"job_status", "The status, percent and result so far of an action started with async, jobs are deleted job_ttl seconds after they're created", requests.JobStatusRequest{}, []string{"action", "job_id"},
+		map[string]interface{}{"action": "job_status", "job_id": "5a2f7d1e-93c8-4b6a-8e04-d71c2b9f3a65"}},
+	{"pending_exists", "Check whether a block is a send to an account that hasn't been received yet, with its amount_raw if it is", requests.PendingExistsRequest{}, []string{"action", "account", "hash"},
+		map[string]interface{}{"action": "pending_exists", "account": exampleAccount, "hash": exampleHash}},
+	{"receivable_exists", "Whether a wallet or account has confirmed blocks to receive of at least threshold_raw and how many, cached for 5 seconds, with only a hash it's forwarded to the node", requests.ReceivableExistsRequest{}, []string{"action"},
+		map[string]interface{}{"action": "receivable_exists", "wallet": exampleWallet, "threshold_raw": "1000000000000000000000000"}},
+	{"send_schedule", "Schedule a recurring send", requests.SendScheduleRequest{}, []string{"action", "wallet", "source", "destination", "amount_raw", "interval_seconds"},
+		map[string]interface{}{"action": "send_schedule", "wallet": exampleWallet, "source": exampleAccount, "destination": exampleDestination, "amount_raw": "1000000000000000000000000000000", "interval_seconds": 86400, "start_at": 1700000000}},
+	{"send_schedule_cancel", "Cancel a recurring send", requests.SendScheduleCancelRequest{}, []string{"action", "wallet", "schedule_id"},
+		map[string]interface{}{"action": "send_schedule_cancel", "wallet": exampleWallet, "schedule_id": "0b1d8c7e-62a4-4f0d-9a57-3c2e1f6b8d90"}},
+	{"send_approve", "Approve a send that needs approvals with an approver API key, it's published with the approval that reaches approvals_required", requests.SendApprovalRequest{}, []string{"action", "wallet", "approval_id"},
+		map[string]interface{}{"action": "send_approve", "wallet": exampleWallet, "approval_id": "3e9b1c7a-5d2f-4a80-b6e4-91c3d7f2a058"}},
+	{"send_reject", "Reject a send that needs approvals with an approver API key, it's never published", requests.SendApprovalRequest{}, []string{"action", "wallet", "approval_id"},
+		map[string]interface{}{"action": "send_reject", "wallet": exampleWallet, "approval_id": "3e9b1c7a-5d2f-4a80-b6e4-91c3d7f2a058"}},
+	{"send_approval_list", "The sends of a wallet that needed approvals, newest first, only the ones with status (pending, sent or rejected) if it's given", requests.SendApprovalListRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "send_approval_list", "wallet": exampleWallet, "status": "pending"}},
+	{"alert_register", "Call callback_url when the balance of an account goes above or below a threshold", requests.AlertRegisterRequest{}, []string{"action", "wallet", "account", "threshold_raw", "direction", "callback_url"},
+		map[string]interface{}{"action": "alert_register", "wallet": exampleWallet, "account": exampleAccount, "threshold_raw": "1000000000000000000000000000000", "direction": "below", "callback_url": "https://example.com/pippin/alert"}},
+	{"alert_list", "List the balance alerts of a wallet", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "alert_list", "wallet": exampleWallet}},
+	{"alert_delete", "Delete a balance alert", requests.AlertDeleteRequest{}, []string{"action", "wallet", "alert_id"},
+		map[string]interface{}{"action": "alert_delete", "wallet": exampleWallet, "alert_id": "7c3e9a1f-48b2-4d6e-a0f5-2b8d1c4e6a93"}},
+	{"account_balance", "Forward account_balance to the node, include_price adds the fiat value, unit converts the amounts from raw", requests.AccountBalanceRequest{}, []string{"action", "account"},
+		map[string]interface{}{"action": "account_balance", "account": exampleAccount, "include_price": true, "currency": "usd"}},
+	{"account_info", "Forward account_info to the node, with wallet_id and derivation_index added for accounts in a wallet and unconfirmed_count when confirmation is behind", requests.AccountInfoRequest{}, []string{"action", "account"},
+		map[string]interface{}{"action": "account_info", "account": exampleAccount, "representative": "true"}},
+	{"account_representative", "Get the representative of an account, null with reason no_blocks if it has no blocks yet", requests.AccountRepresentativeRequest{}, []string{"action", "wallet", "account"},
+		map[string]interface{}{"action": "account_representative", "wallet": exampleWallet, "account": exampleAccount}},
+	{"block_count_for_account", "The block_count and confirmation_height of an account of the wallet from account_info, with unconfirmed_count, the difference, reused for 10 seconds", requests.BlockCountForAccountRequest{}, []string{"action", "wallet", "account"},
+		map[string]interface{}{"action": "block_count_for_account", "wallet": exampleWallet, "account": exampleAccount}},
+	{"account_frontier", "The frontier, open_block, representative, balance_raw and block_count of an account of the wallet from account_info, reused for 5 seconds, with cached_at when it's from the cache", requests.AccountFrontierRequest{}, []string{"action", "wallet", "account"},
+		map[string]interface{}{"action": "account_frontier", "wallet": exampleWallet, "account": exampleAccount}},
+	{"account_representative_check", "Check whether the representative of an account is online and has min_rep_weight_percent of the online weight, status is ok, offline or low_weight, wallet is optional", requests.AccountRepresentativeCheckRequest{}, []string{"action", "account"},
+		map[string]interface{}{"action": "account_representative_check", "wallet": exampleWallet, "account": exampleAccount}},
+	{"account_weight", "The voting weight delegated to an account in raw and NANO (or BANANO), cached for 30 seconds", requests.AccountWeightRequest{}, []string{"action", "account"},
+		map[string]interface{}{"action": "account_weight", "account": exampleAccount}},
+	{"account_full_info", "The voting weight of an account with the representative_info of its representative", requests.AccountWeightRequest{}, []string{"action", "account"},
+		map[string]interface{}{"action": "account_full_info", "account": exampleAccount}},
+	{"validate_account_number", "Check whether an account is a valid address, reason is ok, invalid_prefix, invalid_length, invalid_base32 or invalid_checksum", requests.ValidateAccountNumberRequest{}, []string{"action", "account"},
+		map[string]interface{}{"action": "validate_account_number", "account": exampleAccount}},
+	{"account_representative_set", "Change the representative of an account, preview returns the block instead of publishing it", requests.AccountRepresentativeSetRequest{}, []string{"action", "wallet", "account", "representative"},
+		map[string]interface{}{"action": "account_representative_set", "wallet": exampleWallet, "account": exampleAccount, "representative": exampleDestination}},
+	{"accounts_representative_set", "Change the representative of every account in a wallet that doesn't already have it", requests.AccountsRepresentativeSetRequest{}, []string{"action", "wallet", "representative"},
+		map[string]interface{}{"action": "accounts_representative_set", "wallet": exampleWallet, "representative": exampleDestination}},
+	{"wallet_representative_set", "Set the representative new accounts of a wallet are opened with, with update_existing_accounts change blocks are also published for its accounts and the changed, skipped and failed accounts are returned", requests.WalletRepresentativeSetRequest{}, []string{"action", "wallet", "representative"},
+		map[string]interface{}{"action": "wallet_representative_set", "wallet": exampleWallet, "representative": exampleDestination, "update_existing_accounts": true}},
+	{"wallet_auto_receive_set", "Turn receiving the wallet's pending blocks in the background on or off, returns its amount and auto_receive like receive_minimum", requests.WalletAutoReceiveSetRequest{}, []string{"action", "wallet", "enabled"},
+		map[string]interface{}{"action": "wallet_auto_receive_set", "wallet": exampleWallet, "enabled": false}},
+	{"wallet_name_set", "Set the name wallet_list shows for the wallet, an empty name removes it", requests.WalletNameSetRequest{}, []string{"action", "wallet", "name"},
+		map[string]interface{}{"action": "wallet_name_set", "wallet": exampleWallet, "name": "payroll"}},
+	{"receive_minimum", "The smallest amount in raw received for the wallet in the background, its own or the config's receive_minimum, and whether auto_receive is on", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "receive_minimum", "wallet": exampleWallet}},
+	{"receive_minimum_set", "Set the wallet's own receive minimum in raw, returns it like receive_minimum", requests.ReceiveMinimumSetRequest{}, []string{"action", "wallet", "amount"},
+		map[string]interface{}{"action": "receive_minimum_set", "wallet": exampleWallet, "amount": "1000000000000000000000000"}},
+	{"wallet_representative", "Get the representative for a wallet", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_representative", "wallet": exampleWallet}},
+	{"wallet_representative_history", "The representative changes published for the accounts of a wallet, or only for account, from start_date up to end_date, oldest first", requests.WalletRepresentativeHistoryRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_representative_history", "wallet": exampleWallet, "account": exampleAccount, "start_date": "2024-03-01", "end_date": "2024-04-01"}},
+	{"wallet_history", "Sends and receives of every account in the wallet merged newest first by local_timestamp, each with block_account, optionally only one account or direction (send or receive), starting at head and paged with count and offset", requests.WalletHistoryRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_history", "wallet": exampleWallet, "direction": "receive", "count": 50, "offset": 100}},
+	{"wallet_audit", "The audit records of a wallet newest first, every state-changing request with its ip, API key, redacted params and result, optionally only audit_action, from start_date up to end_date, at most count (default 1000)", requests.WalletAuditRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_audit", "wallet": exampleWallet, "audit_action": "send", "start_date": "2024-03-01", "end_date": "2024-04-01"}},
+}
+
+// Every action handled by the admin gateway, keep in sync with adminActions
+var adminAPIActions = []apiAction{
+	{"wallet_list", "List every wallet with its name and account count, wallet IDs are what every wallet action needs so it's admin only", requests.WalletListRequest{}, []string{"action"},
+		map[string]interface{}{"action": "wallet_list", "offset": 0, "limit": 100}},
+	{"wallet_destroy", "Delete a wallet and all of its accounts, refused while it has funds unless force is set", requests.WalletDestroyRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_destroy", "wallet": exampleWallet}},
+	{"wallet_change_seed", "Replace the seed of a wallet, scan creates the new seed's accounts with history on chain", requests.WalletChangeSeedRequest{}, []string{"action", "wallet", "seed"},
+		map[string]interface{}{"action": "wallet_change_seed", "wallet": exampleWallet, "seed": exampleSeed, "scan": true}},
+	{"wallet_seed", "Get the seed of a wallet, decrypted if the wallet is encrypted", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_seed", "wallet": exampleWallet}},
+	{"wallet_backup_create", "Export the seed, keys and accounts of a wallet as a backup encrypted with passphrase, for wallet_backup_restore", requests.WalletBackupCreateRequest{}, []string{"action", "wallet", "passphrase"},
+		map[string]interface{}{"action": "wallet_backup_create", "wallet": exampleWallet, "passphrase": "correct horse battery staple"}},
+	{"wallet_freeze", "Freeze a wallet, every signing action for it returns wallet_frozen with frozen_at until it's unfrozen", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_freeze", "wallet": exampleWallet}},
+	{"wallet_unfreeze", "Unfreeze a wallet so it can sign again", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "wallet_unfreeze", "wallet": exampleWallet}},
+	{"wallet_lock_all", "Lock every encrypted wallet for every API key, returns how many were unlocked", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "wallet_lock_all"}},
+	{"wallet_kdf_info", "How the keys of every encrypted wallet, or only of wallet, are derived from their passwords, outdated ones are upgraded to the config's kdf when they're unlocked", requests.WalletKdfInfoRequest{}, []string{"action"},
+		map[string]interface{}{"action": "wallet_kdf_info", "wallet": exampleWallet}},
+	{"wallet_approval_policy_set", "Require approvals_required approvals from approver API keys for sends of more than threshold raw, 0 turns it off", requests.WalletApprovalPolicySetRequest{}, []string{"action", "wallet", "approvals_required"},
+		map[string]interface{}{"action": "wallet_approval_policy_set", "wallet": exampleWallet, "approvals_required": 2, "threshold": "1000000000000000000000000000000000"}},
+	{"peers", "Forward peers to the node, without loopback peers, cached for 60 seconds", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "peers"}},
+	{"peer_count", "The number of peers the peers action returns", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "peer_count"}},
+	{"bootstrap", "Forward bootstrap to the node, with the address and port of the peer at address, at most once a minute with bootstrap_any", requests.BootstrapRequest{}, []string{"action", "address"},
+		map[string]interface{}{"action": "bootstrap", "address": "[::ffff:203.0.113.7]:7075"}},
+	{"bootstrap_any", "Forward bootstrap_any to the node, which picks the peers, at most once a minute with bootstrap", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "bootstrap_any"}},
+	{"bootstrap_lazy", "Forward bootstrap_lazy to the node, to fetch a block it hasn't seen", requests.BootstrapLazyRequest{}, []string{"action", "hash"},
+		map[string]interface{}{"action": "bootstrap_lazy", "hash": exampleHash, "force": false}},
+	{"bootstrap_status", "Forward bootstrap_status to the node", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "bootstrap_status"}},
+	{"work_peers", "The configured work peers with their last_success, last_failure and average_latency_ms", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "work_peers"}},
+	{"work_peer_add", "Add a work peer until the config is reloaded", requests.WorkPeerRequest{}, []string{"action", "url"},
+		map[string]interface{}{"action": "work_peer_add", "url": "http://localhost:7000"}},
+	{"work_peer_remove", "Remove a work peer until the config is reloaded", requests.WorkPeerRequest{}, []string{"action", "url"},
+		map[string]interface{}{"action": "work_peer_remove", "url": "http://localhost:7000"}},
+	{"work_queue_status", "The work jobs queued for local PoW and in progress by account, with the average wait of the last minute and the accounts blocked in the queue", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "work_queue_status"}},
+	{"rate_limit_status", "The token bucket of an ip, or the count IPs with the fewest tokens left, with the rate and burst of server.rate_limit", requests.RateLimitStatusRequest{}, []string{"action"},
+		map[string]interface{}{"action": "rate_limit_status", "ip": "203.0.113.7"}},
+	{"config_reload", "Re-read config.yaml like a SIGHUP and apply the fields that don't need a restart, the other changed fields are in restart_required", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "config_reload"}},
+	{"work_cancel_all", "Cancel every work job that's queued or in progress, waiting up to work_cancel_timeout seconds for them to return", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "work_cancel_all"}},
+	{"work_cache_clear", "Remove all of the work cached by root, returns how many roots had work", requests.BaseRequest{}, []string{"action"},
+		map[string]interface{}{"action": "work_cache_clear"}},
+	{"work_prefetch_accounts", "Generate work for the frontier of every opened account in the wallet in the background, kept in the frontier cache for the next block", requests.BaseRequest{}, []string{"action", "wallet"},
+		map[string]interface{}{"action": "work_prefetch_accounts", "wallet": exampleWallet}},
+}
+
+// Build the OpenAPI 3.0 document from apiActions and the request models
+func BuildOpenAPISpec() ([]byte, error) {
+	schemas := map[string]interface{}{
+		"ErrorResponse": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"error":      map[string]interface{}{"type": "string"},
+				"error_code": map[string]interface{}{"type": "string"},
+			},
+		},
+	}
+
+	gateway := gatewayOperation(apiActions, schemas, "gateway", "Gateway for all wallet actions")
+	admin := gatewayOperation(adminAPIActions, schemas, "admin", "Gateway for admin actions, requires the admin token")
+	admin["security"] = []interface{}{map[string]interface{}{"adminToken": []string{}}}
+	admin["responses"].(map[string]interface{})["401"] = map[string]interface{}{
+		"description": "Missing or invalid admin token",
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": map[string]interface{}{"$ref": "#/components/schemas/ErrorResponse"},
+			},
+		},
+	}
+
+	spec := map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":       "Pippin",
+			"description": "Every action is a POST to / with the action name in the action field of the body. Actions not listed are forwarded to the node. Admin actions are a POST to /admin instead.",
+			"version":     "1.0.0",
+		},
+		"paths": map[string]interface{}{
+			"/": map[string]interface{}{
+				"post": gateway,
+			},
+			"/admin": map[string]interface{}{
+				"post": admin,
+			},
+		},
+		"components": map[string]interface{}{
+			"schemas": schemas,
+			"securitySchemes": map[string]interface{}{
+				"adminToken": map[string]interface{}{
+					"type":        "http",
+					"scheme":      "bearer",
+					"description": "The PIPPIN_ADMIN_TOKEN of the server",
+				},
+			},
+		},
+	}
+
+	encoded, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(encoded, '\n'), nil
+}
+
+// The POST operation for a gateway serving actions, their request schemas are added to schemas
+func gatewayOperation(actions []apiAction, schemas map[string]interface{}, operationId string, summary string) map[string]interface{} {
+	oneOf := make([]interface{}, len(actions))
+	mapping := map[string]interface{}{}
+	examples := map[string]interface{}{}
+
+	for i, a := range actions {
+		properties := map[string]interface{}{}
+		requestProperties(reflect.TypeOf(a.Request), properties)
+		// Each schema only matches its own action
+		properties["action"] = map[string]interface{}{"type": "string", "enum": []string{a.Action}}
+
+		schemas[a.Action] = map[string]interface{}{
+			"type":        "object",
+			"description": a.Summary,
+			"properties":  properties,
+			"required":    a.Required,
+			"example":     a.Example,
+		}
+		ref := "#/components/schemas/" + a.Action
+		oneOf[i] = map[string]interface{}{"$ref": ref}
+		mapping[a.Action] = ref
+		examples[a.Action] = map[string]interface{}{
+			"summary": a.Summary,
+			"value":   a.Example,
+		}
+	}
+
+	return map[string]interface{}{
+		"summary":     summary,
+		"operationId": operationId,
+		"requestBody": map[string]interface{}{
+			"required": true,
+			"content": map[string]interface{}{
+				"application/json": map[string]interface{}{
+					"schema": map[string]interface{}{
+						"oneOf": oneOf,
+						"discriminator": map[string]interface{}{
+							"propertyName": "action",
+							"mapping":      mapping,
+						},
+					},
+					"examples": examples,
+				},
+			},
+		},
+		"responses": map[string]interface{}{
+			"200": map[string]interface{}{
+				"description": "Action result, the shape depends on the action",
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": map[string]interface{}{"type": "object"},
+					},
+				},
+			},
+			"400": map[string]interface{}{
+				"description": "Invalid request",
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{
+						"schema": map[string]interface{}{"$ref": "#/components/schemas/ErrorResponse"},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Collect json properties of a request struct, fields of embedded structs included
+// Fields declared on the outer struct win over embedded ones, like encoding/json
+func requestProperties(t reflect.Type, properties map[string]interface{}) {
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous {
+			embedded = append(embedded, f.Type)
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		properties[name] = propertySchema(f.Type)
+	}
+	for _, e := range embedded {
+		nested := map[string]interface{}{}
+		requestProperties(e, nested)
+		for name, schema := range nested {
+			if _, ok := properties[name]; !ok {
+				properties[name] = schema
+			}
+		}
+	}
+}
+
+func propertySchema(t reflect.Type) map[string]interface{} {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": propertySchema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object"}
+	case reflect.Struct:
+		properties := map[string]interface{}{}
+		requestProperties(t, properties)
+		return map[string]interface{}{"type": "object", "properties": properties}
+	}
+	// interface{} fields are loosely typed, the handler parses them
+	return map[string]interface{}{
+		"oneOf": []interface{}{
+			map[string]interface{}{"type": "integer"},
+			map[string]interface{}{"type": "string"},
+			map[string]interface{}{"type": "boolean"},
+		},
+	}
+}
+
+// Serve the OpenAPI spec
+func (hc *HttpController) HandleOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(openAPISpec)
+}
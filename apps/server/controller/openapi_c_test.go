@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Sorted names of the actions in a dispatch table
+func tableActions[T any](table map[string]T) []string {
+	var actions []string
+	for action := range table {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+// Actions in the discriminator mapping of the spec for path
+func specActions(t *testing.T, path string) []string {
+	var spec map[string]interface{}
+	err := json.Unmarshal(openAPISpec, &spec)
+	assert.Nil(t, err)
+	assert.Equal(t, "3.0.3", spec["openapi"])
+
+	mapping := spec["paths"].(map[string]interface{})[path].(map[string]interface{})["post"].(map[string]interface{})["requestBody"].(map[string]interface{})["content"].(map[string]interface{})["application/json"].(map[string]interface{})["schema"].(map[string]interface{})["discriminator"].(map[string]interface{})["mapping"].(map[string]interface{})
+	var actions []string
+	for action := range mapping {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+func TestOpenAPISpecMatchesGateway(t *testing.T) {
+	actions := tableActions(gatewayActions)
+	assert.NotEmpty(t, actions)
+	assert.Equal(t, actions, specActions(t, "/"))
+}
+
+func TestOpenAPISpecMatchesAdminHandler(t *testing.T) {
+	actions := tableActions(adminActions)
+	assert.NotEmpty(t, actions)
+	assert.Equal(t, actions, specActions(t, "/admin"))
+}
+
+func TestOpenAPISpecUpToDate(t *testing.T) {
+	generated, err := BuildOpenAPISpec()
+	assert.Nil(t, err)
+	assert.Equal(t, string(generated), string(openAPISpec), "openapi.json is stale, run go generate ./...")
+}
+
+func TestOpenAPISpecSchemas(t *testing.T) {
+	var spec map[string]interface{}
+	json.Unmarshal(openAPISpec, &spec)
+	schemas := spec["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+
+	send := schemas["send"].(map[string]interface{})
+	properties := send["properties"].(map[string]interface{})
+	// Fields from the embedded BaseRequest are included
+	assert.Contains(t, properties, "wallet")
+	assert.Contains(t, properties, "bpow_key")
+	assert.Equal(t, "string", properties["amount"].(map[string]interface{})["type"])
+	assert.Equal(t, []interface{}{"send"}, properties["action"].(map[string]interface{})["enum"])
+	assert.Contains(t, send["required"], "destination")
+	assert.Equal(t, "send", send["example"].(map[string]interface{})["action"])
+}
+
+func TestHandleOpenAPISpec(t *testing.T) {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/openapi.json", nil)
+	MockController.HandleOpenAPISpec(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, openAPISpec, body)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
 	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
 	"github.com/go-chi/render"
@@ -54,8 +55,8 @@ func (hc *HttpController) HandlePasswordEnter(rawRequest *map[string]interface{}
 		return
 	}
 
-	// Unlock the wallet
-	unlocked, err := hc.Wallet.UnlockWallet(dbWallet, passwordEnterRequest.Password)
+	// Unlock the wallet, only for the API key if there's one
+	unlocked, err := hc.Wallet.UnlockWalletSession(dbWallet, passwordEnterRequest.Password, apiKeySession(r))
 	var resp = responses.PasswordEnterResponse{Valid: "1"}
 	if errors.Is(err, wallet.ErrWalletNotLocked) {
 		ErrWalletNotLocked(w, r)
@@ -67,3 +68,60 @@ func (hc *HttpController) HandlePasswordEnter(rawRequest *map[string]interface{}
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, resp)
 }
+
+// Handle wallet_kdf_info, how the keys of encrypted wallets are derived from their passwords
+// Wallets still on sha256 or older Argon2id parameters are upgraded the next time they're unlocked
+func (hc *HttpController) HandleWalletKdfInfo(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var kdfRequest requests.WalletKdfInfoRequest
+	if err := mapstructure.Decode(rawRequest, &kdfRequest); err != nil {
+		log.Errorf("Error unmarshalling wallet_kdf_info request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if kdfRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	var dbWallet *ent.Wallet
+	if kdfRequest.Wallet != "" {
+		// See if wallet exists
+		dbWallet = hc.WalletExists(kdfRequest.Wallet, w, r)
+		if dbWallet == nil {
+			return
+		}
+	}
+
+	infos, err := hc.Wallet.WalletKdfInfo(dbWallet)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WalletKdfInfoResponse{
+		Current: responses.WalletKdf{
+			Algorithm:   "argon2id",
+			Memory:      uint32(hc.Wallet.Config.Wallet.KdfMemory),
+			Iterations:  uint32(hc.Wallet.Config.Wallet.KdfIterations),
+			Parallelism: uint8(hc.Wallet.Config.Wallet.KdfParallelism),
+		},
+		Wallets: []responses.WalletKdfInfo{},
+	}
+	for _, info := range infos {
+		item := responses.WalletKdfInfo{
+			Wallet:      info.WalletID.String(),
+			Encrypted:   info.Encrypted,
+			Memory:      info.Memory,
+			Iterations:  info.Iterations,
+			Parallelism: info.Parallelism,
+			Outdated:    info.Outdated,
+		}
+		if info.Algorithm != "" {
+			algorithm := info.Algorithm
+			item.Algorithm = &algorithm
+		}
+		resp.Wallets = append(resp.Wallets, item)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
@@ -133,3 +133,133 @@ func TestPasswordEnter(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, newSeed, seed)
 }
+
+func TestWalletKdfInfo(t *testing.T) {
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("c4f1a8d5e2b9c6f3a0d7e4b1c8f5a2d9e6b3c0f7a4d1e8b5c2f9a6d3e0b7c4f1"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+
+	doRequest := func(path string, reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", path, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if path == "/admin" {
+			req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+			hc.AdminHandler(w, req)
+		} else {
+			hc.Gateway(w, req)
+		}
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Admin only
+	status, _ := doRequest("/", map[string]interface{}{"action": "wallet_kdf_info"})
+	assert.Equal(t, 403, status)
+	status, respJson := doRequest("/admin", map[string]interface{}{"action": "wallet_kdf_info", "wallet": "3c9e1a7f-5b2d-4e8a-9c6f-1d3b5a7e9c2f"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_NOT_FOUND", respJson["error_code"])
+
+	current := map[string]interface{}{"algorithm": "argon2id", "memory": float64(19456), "iterations": float64(2), "parallelism": float64(1)}
+	status, respJson = doRequest("/admin", map[string]interface{}{"action": "wallet_kdf_info", "wallet": wallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, current, respJson["current"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"wallet": wallet.ID.String(), "encrypted": false, "algorithm": nil, "outdated": false}}, respJson["wallets"])
+
+	_, err := hc.Wallet.EncryptWallet(wallet, "mypassword")
+	assert.Nil(t, err)
+	status, respJson = doRequest("/admin", map[string]interface{}{"action": "wallet_kdf_info"})
+	assert.Equal(t, 200, status)
+	var found bool
+	for _, item := range respJson["wallets"].([]interface{}) {
+		info := item.(map[string]interface{})
+		assert.Equal(t, true, info["encrypted"])
+		if info["wallet"] == wallet.ID.String() {
+			found = true
+			assert.Equal(t, "argon2id", info["algorithm"])
+			assert.Equal(t, float64(19456), info["memory"])
+			assert.Equal(t, float64(2), info["iterations"])
+			assert.Equal(t, float64(1), info["parallelism"])
+			assert.Equal(t, false, info["outdated"])
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestUnlockSessions(t *testing.T) {
+	hc := newTestController(t)
+	conf := *hc.Wallet.Config
+	conf.Server.RequireApiKey = true
+	hc.Wallet.Config = &conf
+	_, firstKey, _ := hc.Wallet.ApiKeyCreate("first", "send")
+	_, secondKey, _ := hc.Wallet.ApiKeyCreate("second", "send")
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("d7a4e1b8c5f2a9d6e3b0c7f4a1d8e5b2c9f6a3d0e7b4c1f8a5d2e9b6c3f0a7d4"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	_, err := hc.Wallet.EncryptWallet(wallet, "mypassword")
+	assert.Nil(t, err)
+
+	doRequest := func(key string, reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if key == "" {
+			req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+			hc.AdminHandler(w, req)
+		} else {
+			req.Header.Set("X-Api-Key", key)
+			hc.Gateway(w, req)
+		}
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+	locked := func(key string) interface{} {
+		_, resp := doRequest(key, map[string]interface{}{"action": "wallet_locked", "wallet": wallet.ID.String()})
+		return resp["locked"]
+	}
+	accountCreate := map[string]interface{}{"action": "account_create", "wallet": wallet.ID.String()}
+
+	// Unlocked for the first key only
+	_, resp := doRequest(firstKey, map[string]interface{}{"action": "password_enter", "wallet": wallet.ID.String(), "password": "mypassword"})
+	assert.Equal(t, "1", resp["valid"])
+	assert.Equal(t, "0", locked(firstKey))
+	assert.Equal(t, "1", locked(secondKey))
+	status, _ := doRequest(firstKey, accountCreate)
+	assert.Equal(t, 200, status)
+	status, resp = doRequest(secondKey, accountCreate)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_LOCKED", resp["error_code"])
+
+	// Until it unlocks it too
+	_, resp = doRequest(secondKey, map[string]interface{}{"action": "password_enter", "wallet": wallet.ID.String(), "password": "mypassword"})
+	assert.Equal(t, "1", resp["valid"])
+	status, _ = doRequest(secondKey, accountCreate)
+	assert.Equal(t, 200, status)
+
+	// Locking only ends the key's session
+	_, resp = doRequest(firstKey, map[string]interface{}{"action": "wallet_lock", "wallet": wallet.ID.String()})
+	assert.Equal(t, "1", resp["locked"])
+	assert.Equal(t, "1", locked(firstKey))
+	assert.Equal(t, "0", locked(secondKey))
+
+	// wallet_lock_all is admin only and locks it for everyone
+	status, _ = doRequest(secondKey, map[string]interface{}{"action": "wallet_lock_all"})
+	assert.Equal(t, 403, status)
+	status, resp = doRequest("", map[string]interface{}{"action": "wallet_lock_all"})
+	assert.Equal(t, 200, status)
+	assert.GreaterOrEqual(t, resp["locked"], float64(1))
+	assert.Equal(t, "1", locked(secondKey))
+	_, err = pw.GetDecryptedKeyFromStorage(wallet, "seed")
+	assert.ErrorIs(t, err, pw.ErrWalletLocked)
+}
@@ -0,0 +1,186 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
+)
+
+// A pipeline runs several actions one after another in one request, e.g. receive_all, then send, then account_representative_set
+// Each action is handled like a gateway request of its own, its params can use what the action before it returned
+
+// A param that's this followed by a field of the previous action's response is replaced with the field
+// Fields of nested objects are separated by dots, e.g. $previous.wallet_account.wallet
+const pipelinePreviousPrefix = "$previous."
+
+// Keeps the response of a pipeline action instead of writing it to the client
+type pipelineWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (pw *pipelineWriter) Header() http.Header {
+	return pw.header
+}
+
+func (pw *pipelineWriter) WriteHeader(status int) {
+	if pw.status == 0 {
+		pw.status = status
+	}
+}
+
+func (pw *pipelineWriter) Write(b []byte) (int, error) {
+	if pw.status == 0 {
+		pw.status = http.StatusOK
+	}
+	return pw.body.Write(b)
+}
+
+// Replace the $previous params in value with the fields of previous, the response of the action before
+func resolvePipelineParams(value interface{}, previous map[string]interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		if !strings.HasPrefix(v, pipelinePreviousPrefix) {
+			return v, nil
+		} else if previous == nil {
+			return nil, fmt.Errorf("%s can't be used in the first action", v)
+		}
+		var field interface{} = previous
+		for _, key := range strings.Split(strings.TrimPrefix(v, pipelinePreviousPrefix), ".") {
+			object, ok := field.(map[string]interface{})
+			if ok {
+				field, ok = object[key]
+			}
+			if !ok {
+				return nil, fmt.Errorf("%s isn't in the previous response", v)
+			}
+		}
+		return field, nil
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			resolvedItem, err := resolvePipelineParams(item, previous)
+			if err != nil {
+				return nil, err
+			}
+			resolved[key] = resolvedItem
+		}
+		return resolved, nil
+	case []interface{}:
+		resolved := make([]interface{}, len(v))
+		for i, item := range v {
+			resolvedItem, err := resolvePipelineParams(item, previous)
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = resolvedItem
+		}
+		return resolved, nil
+	default:
+		return v, nil
+	}
+}
+
+// Run one action of a pipeline like the gateway would, without deduplication, the pipeline itself is deduplicated
+// Returns its result and its response if it succeeded, nil if it failed
+func (hc *HttpController) runPipelineAction(step requests.PipelineStep, previous map[string]interface{}, r *http.Request) (responses.PipelineResult, map[string]interface{}) {
+	action := strings.ToLower(step.Action)
+	result := responses.PipelineResult{Action: action}
+
+	resolved, err := resolvePipelineParams(step.Params, previous)
+	if err != nil {
+		result.Status = http.StatusBadRequest
+		result.Response = ErrorResponse{Error: err.Error(), ErrorCode: ErrorCodeInvalidPipeline}
+		return result, nil
+	}
+	request, _ := resolved.(map[string]interface{})
+	if request == nil {
+		request = map[string]interface{}{}
+	}
+	request["action"] = action
+
+	pw := &pipelineWriter{header: http.Header{}}
+	// render.Status sets the status on the request, so every action gets its own copy
+	actionRequest := r.WithContext(r.Context())
+	if !hc.refuseAction(action, request, pw, actionRequest) {
+		hc.dispatchAction(action, &request, pw, actionRequest)
+	}
+	result.Status = pw.status
+
+	// The node returns its errors with a 200
+	var response map[string]interface{}
+	if err := json.Unmarshal(pw.body.Bytes(), &response); err != nil {
+		result.Response = pw.body.String()
+		return result, nil
+	}
+	result.Response = response
+	if _, isError := response["error"]; isError || result.Status != http.StatusOK {
+		return result, nil
+	}
+	return result, response
+}
+
+// Handle pipeline, the actions are run in order until one fails
+// Each takes a token from the rate limit like a request of its own, the first used the pipeline's
+func (hc *HttpController) HandlePipeline(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var pipelineRequest requests.PipelineRequest
+	if err := mapstructure.Decode(rawRequest, &pipelineRequest); err != nil {
+		log.Errorf("Error unmarshalling pipeline request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if pipelineRequest.Action == "" || len(pipelineRequest.Actions) == 0 {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	maxActions := hc.Wallet.Config.Server.PipelineMaxActions
+	if len(pipelineRequest.Actions) > maxActions {
+		ErrBadRequest(w, r, ErrorCodePipelineTooLong, fmt.Sprintf("A pipeline can run at most %d actions", maxActions))
+		return
+	}
+	for _, step := range pipelineRequest.Actions {
+		if step.Action == "" {
+			ErrUnableToParseJson(w, r)
+			return
+		} else if strings.ToLower(step.Action) == "pipeline" {
+			ErrBadRequest(w, r, ErrorCodeInvalidPipeline, "Pipelines can't be nested")
+			return
+		}
+	}
+
+	resp := responses.PipelineResponse{
+		Results: []responses.PipelineResult{},
+	}
+	var previous map[string]interface{}
+	for i, step := range pipelineRequest.Actions {
+		if i > 0 && hc.RateLimiter != nil && !hc.RateLimiter.Allow(rateLimitKey(r)) {
+			resp.Results = append(resp.Results, responses.PipelineResult{
+				Action:   strings.ToLower(step.Action),
+				Status:   http.StatusTooManyRequests,
+				Response: RateLimitedError,
+			})
+			resp.Failed = true
+			break
+		}
+		result, response := hc.runPipelineAction(step, previous, r)
+		resp.Results = append(resp.Results, result)
+		if response == nil {
+			resp.Failed = true
+			break
+		}
+		resp.Completed++
+		previous = response
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
@@ -0,0 +1,197 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolvePipelineParams(t *testing.T) {
+	previous := map[string]interface{}{
+		"block":          "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3",
+		"wallet_account": map[string]interface{}{"index": float64(3)},
+	}
+	resolved, err := resolvePipelineParams(map[string]interface{}{
+		"id":      "$previous.block",
+		"index":   "$previous.wallet_account.index",
+		"blocks":  []interface{}{"$previous.block", "previous.block"},
+		"wallet":  "1234",
+		"balance": float64(5),
+	}, previous)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"id":      "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3",
+		"index":   float64(3),
+		"blocks":  []interface{}{"E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", "previous.block"},
+		"wallet":  "1234",
+		"balance": float64(5),
+	}, resolved)
+
+	_, err = resolvePipelineParams(map[string]interface{}{"id": "$previous.hash"}, previous)
+	assert.ErrorContains(t, err, "$previous.hash isn't in the previous response")
+	_, err = resolvePipelineParams(map[string]interface{}{"id": "$previous.block.hash"}, previous)
+	assert.ErrorContains(t, err, "isn't in the previous response")
+	_, err = resolvePipelineParams(map[string]interface{}{"id": "$previous.block"}, nil)
+	assert.ErrorContains(t, err, "can't be used in the first action")
+}
+
+func TestPipeline(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	nodeActions := []string{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			nodeActions = append(nodeActions, pr.Action)
+			var js map[string]interface{}
+			if pr.Action == "block_info" {
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+			} else if pr.Action == "account_info" {
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+			} else if pr.Action == "process" {
+				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
+			} else {
+				js = map[string]interface{}{"error": "error"}
+			}
+			return httpmock.NewJsonResponse(200, js)
+		},
+	)
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("4e7c0f2a5d8b1e4c763c8f1a6d9b2e5c8f0a3d6b9e1c4f7a2d5b8e0c3f6a9d1b"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	// The wallet is created with index 0, account_create makes index 1
+	pub, _, _ := utils.KeypairFromSeed(newSeed, 1)
+	address := utils.PubKeyToAddress(pub, false)
+
+	doPipeline := func(actions []map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":  "pipeline",
+			"actions": actions,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Create an account, receive on it, then send from it with the receive's hash as the id
+	status, respJson := doPipeline([]map[string]interface{}{
+		{"action": "account_create", "params": map[string]interface{}{"wallet": wallet.ID.String()}},
+		{"action": "receive", "params": map[string]interface{}{
+			"wallet":  wallet.ID.String(),
+			"account": "$previous.account",
+			"block":   "95D72CE5ECA6ABFDE45F77BD75F1C888223BCCA2D5178DF2A1D89533005C69DC",
+			"work":    "0000000000000000",
+		}},
+		{"action": "send", "params": map[string]interface{}{
+			"wallet":      wallet.ID.String(),
+			"source":      address,
+			"destination": address,
+			"amount":      "1000000000000000000000000000000",
+			"id":          "$previous.block",
+			"work":        "0000000000000000",
+		}},
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(3), respJson["completed"])
+	assert.Equal(t, false, respJson["failed"])
+	results := respJson["results"].([]interface{})
+	assert.Len(t, results, 3)
+	assert.Equal(t, map[string]interface{}{
+		"action":   "account_create",
+		"status":   float64(200),
+		"response": map[string]interface{}{"account": address},
+	}, results[0])
+	assert.Equal(t, "receive", results[1].(map[string]interface{})["action"])
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", results[1].(map[string]interface{})["response"].(map[string]interface{})["block"])
+	assert.Equal(t, "send", results[2].(map[string]interface{})["action"])
+	assert.Equal(t, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3", results[2].(map[string]interface{})["response"].(map[string]interface{})["block"])
+	assert.Contains(t, nodeActions, "process")
+	// The send was made with the receive's hash as its id
+	block, err := hc.Wallet.GetBlockFromDatabase(wallet, address, "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3")
+	assert.Nil(t, err)
+	assert.Equal(t, "send", block.Subtype)
+
+	// It stops at the first action that fails
+	status, respJson = doPipeline([]map[string]interface{}{
+		{"action": "account_create", "params": map[string]interface{}{"wallet": wallet.ID.String()}},
+		{"action": "send", "params": map[string]interface{}{"wallet": wallet.ID.String(), "source": "$previous.account", "destination": "ban_1234", "amount": "1"}},
+		{"action": "account_create", "params": map[string]interface{}{"wallet": wallet.ID.String()}},
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(1), respJson["completed"])
+	assert.Equal(t, true, respJson["failed"])
+	results = respJson["results"].([]interface{})
+	assert.Len(t, results, 2)
+	assert.Equal(t, float64(400), results[1].(map[string]interface{})["status"])
+	assert.Equal(t, "INVALID_ACCOUNT", results[1].(map[string]interface{})["response"].(map[string]interface{})["error_code"])
+	_, addresses, err := hc.Wallet.AccountsList(wallet, 0)
+	assert.Nil(t, err)
+	assert.Len(t, addresses, 3)
+
+	// Node errors come back with a 200, they fail the action too
+	status, respJson = doPipeline([]map[string]interface{}{
+		{"action": "version"},
+		{"action": "account_create", "params": map[string]interface{}{"wallet": wallet.ID.String()}},
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(0), respJson["completed"])
+	assert.Equal(t, true, respJson["failed"])
+	assert.Equal(t, map[string]interface{}{"error": "error"}, respJson["results"].([]interface{})[0].(map[string]interface{})["response"])
+
+	// A field that isn't in the previous response, and actions the gateway refuses
+	status, respJson = doPipeline([]map[string]interface{}{
+		{"action": "account_create", "params": map[string]interface{}{"wallet": wallet.ID.String()}},
+		{"action": "receive_all", "params": map[string]interface{}{"wallet": "$previous.wallet"}},
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "INVALID_PIPELINE", respJson["results"].([]interface{})[1].(map[string]interface{})["response"].(map[string]interface{})["error_code"])
+	status, respJson = doPipeline([]map[string]interface{}{
+		{"action": "wallet_destroy", "params": map[string]interface{}{"wallet": wallet.ID.String()}},
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(403), respJson["results"].([]interface{})[0].(map[string]interface{})["status"])
+	_, err = hc.Wallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+
+	// Nothing runs for pipelines that are too long, nested or empty
+	nodeActions = []string{}
+	tooLong := []map[string]interface{}{}
+	for i := 0; i <= hc.Wallet.Config.Server.PipelineMaxActions; i++ {
+		tooLong = append(tooLong, map[string]interface{}{"action": "version"})
+	}
+	status, respJson = doPipeline(tooLong)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "PIPELINE_TOO_LONG", respJson["error_code"])
+	status, respJson = doPipeline([]map[string]interface{}{
+		{"action": "version"},
+		{"action": "pipeline", "params": map[string]interface{}{"actions": []interface{}{}}},
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_PIPELINE", respJson["error_code"])
+	status, respJson = doPipeline([]map[string]interface{}{})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"])
+	assert.Empty(t, nodeActions)
+}
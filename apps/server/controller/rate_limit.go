@@ -0,0 +1,248 @@
+package controller
+
+import (
+	"container/list"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
+)
+
+// How many IPs rate_limit_status returns without an ip or a count
+const rateLimitStatusDefaultCount = 10
+
+// At most this many buckets are kept, the one used least recently is dropped for a new one
+// Idle buckets are full again by then, and a full bucket is the same as no bucket
+const rateLimitMaxBuckets = 10000
+
+// A token bucket for every client IP, each gateway request takes a token
+// Tokens come back at rate per second, up to burst, a client without tokens is rate limited
+// A rate of 0 doesn't limit anything, SetLimits can turn it on later
+type RateLimiter struct {
+	rate    float64
+	burst   float64
+	buckets map[string]*list.Element
+	// Of *rateLimitBucket, the most recently used first
+	lru        *list.List
+	maxBuckets int
+	mutex      sync.Mutex
+	// For tests
+	now func() time.Time
+}
+
+type rateLimitBucket struct {
+	ip         string
+	tokens     float64
+	lastRefill time.Time
+}
+
+// The state of an IP's bucket
+type RateLimitBucket struct {
+	IP         string
+	Tokens     float64
+	LastRefill time.Time
+}
+
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		rate:       rate,
+		burst:      float64(burst),
+		buckets:    map[string]*list.Element{},
+		lru:        list.New(),
+		maxBuckets: rateLimitMaxBuckets,
+		now:        time.Now,
+	}
+}
+
+// Change the rate and burst, for a config reload, buckets keep their tokens up to the new burst
+func (rl *RateLimiter) SetLimits(rate float64, burst int) {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	now := rl.now()
+	for e := rl.lru.Front(); e != nil; e = e.Next() {
+		rl.refill(e.Value.(*rateLimitBucket), now)
+	}
+	rl.rate = rate
+	rl.burst = float64(burst)
+	for e := rl.lru.Front(); e != nil; e = e.Next() {
+		if bucket := e.Value.(*rateLimitBucket); bucket.tokens > rl.burst {
+			bucket.tokens = rl.burst
+		}
+	}
+}
+
+// The rate and burst, it's off while the rate is 0
+func (rl *RateLimiter) Limits() (float64, float64) {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	return rl.rate, rl.burst
+}
+
+// Add the tokens that came back since the last refill
+func (rl *RateLimiter) refill(bucket *rateLimitBucket, now time.Time) {
+	bucket.tokens += now.Sub(bucket.lastRefill).Seconds() * rl.rate
+	if bucket.tokens > rl.burst {
+		bucket.tokens = rl.burst
+	}
+	bucket.lastRefill = now
+}
+
+// Drop the buckets that are full again, a client without one starts with a full bucket anyway
+func (rl *RateLimiter) prune(now time.Time) {
+	for e := rl.lru.Front(); e != nil; {
+		next := e.Next()
+		bucket := e.Value.(*rateLimitBucket)
+		rl.refill(bucket, now)
+		if bucket.tokens >= rl.burst {
+			rl.remove(e)
+		}
+		e = next
+	}
+}
+
+func (rl *RateLimiter) remove(e *list.Element) {
+	delete(rl.buckets, e.Value.(*rateLimitBucket).ip)
+	rl.lru.Remove(e)
+}
+
+// Take a token for ip, false if it has none left
+func (rl *RateLimiter) Allow(ip string) bool {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	if rl.rate <= 0 {
+		return true
+	}
+	now := rl.now()
+	var bucket *rateLimitBucket
+	if e, ok := rl.buckets[ip]; ok {
+		bucket = e.Value.(*rateLimitBucket)
+		rl.refill(bucket, now)
+		rl.lru.MoveToFront(e)
+	} else {
+		for rl.lru.Len() >= rl.maxBuckets {
+			rl.remove(rl.lru.Back())
+		}
+		bucket = &rateLimitBucket{ip: ip, tokens: rl.burst, lastRefill: now}
+		rl.buckets[ip] = rl.lru.PushFront(bucket)
+	}
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// The bucket of ip as of now, false if it has a full bucket because it hasn't made requests recently
+func (rl *RateLimiter) Status(ip string) (RateLimitBucket, bool) {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	e, ok := rl.buckets[ip]
+	if !ok {
+		return RateLimitBucket{}, false
+	}
+	bucket := e.Value.(*rateLimitBucket)
+	rl.refill(bucket, rl.now())
+	return RateLimitBucket{IP: ip, Tokens: bucket.tokens, LastRefill: bucket.lastRefill}, true
+}
+
+// The count IPs with the fewest tokens left, the ones closest to being rate limited first
+func (rl *RateLimiter) Lowest(count int) []RateLimitBucket {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	rl.prune(rl.now())
+	ret := make([]RateLimitBucket, 0, rl.lru.Len())
+	for e := rl.lru.Front(); e != nil; e = e.Next() {
+		bucket := e.Value.(*rateLimitBucket)
+		ret = append(ret, RateLimitBucket{IP: bucket.ip, Tokens: bucket.tokens, LastRefill: bucket.lastRefill})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Tokens == ret[j].Tokens {
+			return ret[i].IP < ret[j].IP
+		}
+		return ret[i].Tokens < ret[j].Tokens
+	})
+	if len(ret) > count {
+		ret = ret[:count]
+	}
+	return ret
+}
+
+// RemoteAddr without the port, it's the client's IP once middleware.RealIP ran
+func requestIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func rateLimitBucketResponse(bucket RateLimitBucket) responses.RateLimitBucketResponse {
+	lastRefill := bucket.LastRefill.Unix()
+	return responses.RateLimitBucketResponse{
+		IP:         bucket.IP,
+		Tokens:     bucket.Tokens,
+		LastRefill: &lastRefill,
+	}
+}
+
+// Handle rate_limit_status, the token bucket of an ip, or the IPs closest to being rate limited
+func (hc *HttpController) HandleRateLimitStatus(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var statusRequest requests.RateLimitStatusRequest
+	if err := mapstructure.Decode(rawRequest, &statusRequest); err != nil {
+		log.Errorf("Error unmarshalling rate_limit_status request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if statusRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	if statusRequest.IP != "" && net.ParseIP(statusRequest.IP) == nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidIP, "Invalid ip")
+		return
+	}
+	count := rateLimitStatusDefaultCount
+	if statusRequest.Count != nil {
+		var err error
+		count, err = utils.ToInt(*statusRequest.Count)
+		if err != nil || count < 1 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+
+	resp := responses.RateLimitStatusResponse{
+		Buckets: []responses.RateLimitBucketResponse{},
+	}
+	var rate, burst float64
+	if hc.RateLimiter != nil {
+		rate, burst = hc.RateLimiter.Limits()
+	}
+	if rate > 0 {
+		resp.Enabled = true
+		resp.Rate = rate
+		resp.Burst = int(burst)
+		if statusRequest.IP != "" {
+			// Without a bucket it hasn't made a request recently, it has every token
+			entry := responses.RateLimitBucketResponse{IP: statusRequest.IP, Tokens: burst}
+			if bucket, ok := hc.RateLimiter.Status(statusRequest.IP); ok {
+				entry = rateLimitBucketResponse(bucket)
+			}
+			resp.Buckets = append(resp.Buckets, entry)
+		} else {
+			for _, bucket := range hc.RateLimiter.Lowest(count) {
+				resp.Buckets = append(resp.Buckets, rateLimitBucketResponse(bucket))
+			}
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
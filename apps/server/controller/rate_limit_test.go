@@ -0,0 +1,214 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	rl := NewRateLimiter(1, 2)
+	rl.now = func() time.Time { return now }
+
+	assert.True(t, rl.Allow("10.0.0.1"))
+	assert.True(t, rl.Allow("10.0.0.1"))
+	assert.False(t, rl.Allow("10.0.0.1"))
+	assert.True(t, rl.Allow("10.0.0.2"))
+
+	// Each IP has its own bucket
+	bucket, ok := rl.Status("10.0.0.1")
+	assert.True(t, ok)
+	assert.Equal(t, float64(0), bucket.Tokens)
+	bucket, ok = rl.Status("10.0.0.2")
+	assert.True(t, ok)
+	assert.Equal(t, float64(1), bucket.Tokens)
+	_, ok = rl.Status("10.0.0.3")
+	assert.False(t, ok)
+
+	// Tokens come back at rate, up to burst
+	now = now.Add(1500 * time.Millisecond)
+	bucket, _ = rl.Status("10.0.0.1")
+	assert.Equal(t, 1.5, bucket.Tokens)
+	assert.Equal(t, now, bucket.LastRefill)
+	assert.True(t, rl.Allow("10.0.0.1"))
+	assert.False(t, rl.Allow("10.0.0.1"))
+
+	// Full buckets are dropped
+	lowest := rl.Lowest(10)
+	assert.Len(t, lowest, 1)
+	assert.Equal(t, "10.0.0.1", lowest[0].IP)
+	_, ok = rl.Status("10.0.0.2")
+	assert.False(t, ok)
+
+	// A lower burst caps the buckets, a rate of 0 turns it off
+	rl.SetLimits(2, 1)
+	now = now.Add(time.Second)
+	bucket, _ = rl.Status("10.0.0.1")
+	assert.Equal(t, float64(1), bucket.Tokens)
+	rate, burst := rl.Limits()
+	assert.Equal(t, float64(2), rate)
+	assert.Equal(t, float64(1), burst)
+	assert.True(t, rl.Allow("10.0.0.1"))
+	assert.False(t, rl.Allow("10.0.0.1"))
+	rl.SetLimits(0, 1)
+	assert.True(t, rl.Allow("10.0.0.1"))
+}
+
+func TestRateLimiterMaxBuckets(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	rl := NewRateLimiter(1, 1)
+	rl.now = func() time.Time { return now }
+	rl.maxBuckets = 2
+
+	assert.True(t, rl.Allow("10.0.0.1"))
+	assert.True(t, rl.Allow("10.0.0.2"))
+	// 10.0.0.1 was used more recently, so 10.0.0.2 is dropped for 10.0.0.3
+	assert.False(t, rl.Allow("10.0.0.1"))
+	assert.True(t, rl.Allow("10.0.0.3"))
+	_, ok := rl.Status("10.0.0.2")
+	assert.False(t, ok)
+	bucket, ok := rl.Status("10.0.0.1")
+	assert.True(t, ok)
+	assert.Equal(t, float64(0), bucket.Tokens)
+	assert.Len(t, rl.buckets, 2)
+	assert.Equal(t, 2, rl.lru.Len())
+}
+
+func TestRateLimitStatus(t *testing.T) {
+	hc := newTestController(t)
+	now := time.Unix(1700000000, 0)
+	hc.RateLimiter = NewRateLimiter(1, 3)
+	hc.RateLimiter.now = func() time.Time { return now }
+
+	doGateway := func(remoteAddr string) int {
+		body, _ := json.Marshal(map[string]interface{}{"action": "gateway_actions"})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.RemoteAddr = remoteAddr
+		hc.Gateway(w, req)
+		return w.Result().StatusCode
+	}
+	doStatus := func(request map[string]interface{}) (int, map[string]interface{}) {
+		request["action"] = "rate_limit_status"
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		hc.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// The first IP uses up its burst, the second makes one request
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, 200, doGateway("203.0.113.7:1234"))
+	}
+	assert.Equal(t, 429, doGateway("203.0.113.7:1235"))
+	assert.Equal(t, 200, doGateway("198.51.100.2:4321"))
+
+	status, respJson := doStatus(map[string]interface{}{"ip": "203.0.113.7"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, true, respJson["enabled"])
+	assert.Equal(t, float64(1), respJson["rate"])
+	assert.Equal(t, float64(3), respJson["burst"])
+	buckets := respJson["buckets"].([]interface{})
+	assert.Len(t, buckets, 1)
+	assert.Equal(t, "203.0.113.7", buckets[0].(map[string]interface{})["ip"])
+	assert.Equal(t, float64(0), buckets[0].(map[string]interface{})["tokens"])
+	assert.Equal(t, float64(1700000000), buckets[0].(map[string]interface{})["last_refill"])
+
+	status, respJson = doStatus(map[string]interface{}{"ip": "198.51.100.2"})
+	assert.Equal(t, 200, status)
+	buckets = respJson["buckets"].([]interface{})
+	assert.Equal(t, float64(2), buckets[0].(map[string]interface{})["tokens"])
+
+	// An IP that made no requests has every token
+	status, respJson = doStatus(map[string]interface{}{"ip": "192.0.2.1"})
+	assert.Equal(t, 200, status)
+	buckets = respJson["buckets"].([]interface{})
+	assert.Equal(t, float64(3), buckets[0].(map[string]interface{})["tokens"])
+	assert.Nil(t, buckets[0].(map[string]interface{})["last_refill"])
+
+	// Without an ip, the closest to being rate limited first
+	status, respJson = doStatus(map[string]interface{}{})
+	assert.Equal(t, 200, status)
+	buckets = respJson["buckets"].([]interface{})
+	assert.Len(t, buckets, 2)
+	assert.Equal(t, "203.0.113.7", buckets[0].(map[string]interface{})["ip"])
+	assert.Equal(t, "198.51.100.2", buckets[1].(map[string]interface{})["ip"])
+	status, respJson = doStatus(map[string]interface{}{"count": "1"})
+	assert.Equal(t, 200, status)
+	assert.Len(t, respJson["buckets"], 1)
+
+	// Tokens come back
+	now = now.Add(2 * time.Second)
+	status, respJson = doStatus(map[string]interface{}{"ip": "203.0.113.7"})
+	assert.Equal(t, 200, status)
+	buckets = respJson["buckets"].([]interface{})
+	assert.Equal(t, float64(2), buckets[0].(map[string]interface{})["tokens"])
+	assert.Equal(t, float64(1700000002), buckets[0].(map[string]interface{})["last_refill"])
+	assert.Equal(t, 200, doGateway("203.0.113.7:1236"))
+
+	// errors
+	status, respJson = doStatus(map[string]interface{}{"ip": "not an ip"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_IP", respJson["error_code"])
+	status, respJson = doStatus(map[string]interface{}{"count": 0})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"])
+
+	// Off with a rate of 0 or without a rate limiter
+	hc.RateLimiter.SetLimits(0, 3)
+	status, respJson = doStatus(map[string]interface{}{})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, false, respJson["enabled"])
+	hc.RateLimiter = nil
+	status, respJson = doStatus(map[string]interface{}{})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, false, respJson["enabled"])
+	assert.Len(t, respJson["buckets"], 0)
+}
+
+func TestRateLimitPerApiKey(t *testing.T) {
+	hc := newTestController(t)
+	conf := *hc.Wallet.Config
+	conf.Server.RequireApiKey = true
+	hc.Wallet.Config = &conf
+	now := time.Unix(1700000000, 0)
+	hc.RateLimiter = NewRateLimiter(1, 2)
+	hc.RateLimiter.now = func() time.Time { return now }
+	_, firstKey, _ := hc.Wallet.ApiKeyCreate("first", "read")
+	_, secondKey, _ := hc.Wallet.ApiKeyCreate("second", "read")
+
+	doGateway := func(key string, remoteAddr string) int {
+		body, _ := json.Marshal(map[string]interface{}{"action": "gateway_actions"})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Api-Key", key)
+		req.RemoteAddr = remoteAddr
+		hc.Gateway(w, req)
+		return w.Result().StatusCode
+	}
+
+	// Keys from the same IP have their own buckets, a key from another IP shares its bucket
+	assert.Equal(t, 200, doGateway(firstKey, "203.0.113.7:1234"))
+	assert.Equal(t, 200, doGateway(firstKey, "198.51.100.2:4321"))
+	assert.Equal(t, 429, doGateway(firstKey, "192.0.2.1:1111"))
+	assert.Equal(t, 200, doGateway(secondKey, "203.0.113.7:1234"))
+
+	// Wrong keys are limited by IP
+	assert.Equal(t, 401, doGateway("pippin_wrong", "203.0.113.7:1234"))
+	assert.Equal(t, 401, doGateway("pippin_wrong", "203.0.113.7:1234"))
+	assert.Equal(t, 429, doGateway("pippin_wrong", "203.0.113.7:1234"))
+}
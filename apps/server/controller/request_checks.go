@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+// Checks of the request bodies both gateways get, before anything looks at the action
+// The body is limited to max_body_bytes, and has to be sent as application/json
+// With strict_requests fields an action doesn't have are refused, instead of being ignored like a typo would be
+
+// Fields any request can have, whatever its action
+var commonRequestFields = []string{"action", "api_key", "bpow_key"}
+
+// Actions Pippin adds to and forwards to the node with the rest of the request, so they take whatever fields the node does
+var forwardedNodeActions = []string{
+	"account_balance", "account_info", "block_count", "bootstrap", "bootstrap_any", "bootstrap_lazy", "bootstrap_status",
+	"chain", "confirmation_quorum", "peers",
+}
+
+// The fields of each of Pippin's actions, from the request models of the OpenAPI spec
+var actionFields = sync.OnceValue(func() map[string][]string {
+	fields := map[string][]string{}
+	for _, a := range append(slices.Clone(apiActions), adminAPIActions...) {
+		if slices.Contains(forwardedNodeActions, a.Action) {
+			continue
+		}
+		properties := map[string]interface{}{}
+		requestProperties(reflect.TypeOf(a.Request), properties)
+		for name := range properties {
+			fields[a.Action] = append(fields[a.Action], name)
+		}
+	}
+	return fields
+})
+
+// Browsers send text/plain and form-urlencoded bodies from any page without a CORS preflight, so only JSON is accepted
+func jsonContentType(contentType string, allowMissing bool) bool {
+	if contentType == "" {
+		return allowMissing
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// A body without a Content-Type is taken as JSON, unless browsers are allowed in with cors_allowed_origins or requests are checked with strict_requests
+func (hc *HttpController) allowMissingContentType() bool {
+	if hc.Wallet == nil || hc.Wallet.Config == nil {
+		return true
+	}
+	return len(hc.Wallet.Config.Server.CorsAllowedOrigins) == 0 && !hc.Wallet.Config.Server.StrictRequests
+}
+
+// Decode the body of a request to a gateway, false if it was refused and the error was written
+func (hc *HttpController) decodeRequest(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	if contentType := r.Header.Get("Content-Type"); !jsonContentType(contentType, hc.allowMissingContentType()) {
+		ErrUnsupportedMediaType(w, r, contentType)
+		return nil, false
+	}
+	body := r.Body
+	if hc.Wallet != nil && hc.Wallet.Config != nil && hc.Wallet.Config.Server.MaxBodyBytes > 0 {
+		body = http.MaxBytesReader(w, r.Body, hc.Wallet.Config.Server.MaxBodyBytes)
+	}
+
+	var request map[string]interface{}
+	if err := json.NewDecoder(body).Decode(&request); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			ErrRequestTooLarge(w, r, tooLarge.Limit)
+			return nil, false
+		}
+		log.Ctx(r.Context()).Errorf("Error unmarshalling http base request %s", err)
+		ErrUnableToParseJson(w, r)
+		return nil, false
+	}
+	if _, ok := request["action"]; !ok {
+		ErrUnableToParseJson(w, r)
+		return nil, false
+	}
+	return request, true
+}
+
+// The fields of request its action doesn't have, sorted, none for actions that go to the node
+func unknownFields(action string, request map[string]interface{}) []string {
+	fields, ok := actionFields()[action]
+	if !ok {
+		return nil
+	}
+	unknown := []string{}
+	for name := range request {
+		if !slices.Contains(fields, name) && !slices.Contains(commonRequestFields, name) {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// Write unknown_field if strict_requests is on and request has fields its action doesn't, true if it was refused
+func (hc *HttpController) refuseUnknownFields(action string, request map[string]interface{}, w http.ResponseWriter, r *http.Request) bool {
+	if hc.Wallet == nil || hc.Wallet.Config == nil || !hc.Wallet.Config.Server.StrictRequests {
+		return false
+	}
+	unknown := unknownFields(action, request)
+	if len(unknown) == 0 {
+		return false
+	}
+	ErrBadRequest(w, r, ErrorCodeUnknownField, fmt.Sprintf("Unknown field %s for %s, its fields are in /openapi.json", strings.Join(unknown, ", "), action))
+	return true
+}
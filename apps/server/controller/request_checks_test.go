@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func gatewayError(t *testing.T, hc *HttpController, body []byte, contentType string) (int, map[string]interface{}) {
+	t.Helper()
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	hc.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	return resp.StatusCode, respJson
+}
+
+func TestJsonContentType(t *testing.T) {
+	assert.True(t, jsonContentType("", true))
+	assert.False(t, jsonContentType("", false))
+	assert.True(t, jsonContentType("application/json", false))
+	assert.True(t, jsonContentType("application/json; charset=utf-8", false))
+	assert.True(t, jsonContentType("application/vnd.api+json", false))
+	assert.False(t, jsonContentType("application/x-www-form-urlencoded", true))
+	assert.False(t, jsonContentType("text/plain", true))
+	assert.False(t, jsonContentType("multipart/form-data; boundary=x", true))
+	assert.False(t, jsonContentType("application/xml", true))
+	assert.False(t, jsonContentType("not a type;;", true))
+}
+
+func TestRequestTooLarge(t *testing.T) {
+	hc := newTestController(t)
+	limit := MockConfig.Server.MaxBodyBytes
+	MockConfig.Server.MaxBodyBytes = 64
+	defer func() { MockConfig.Server.MaxBodyBytes = limit }()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"action": "account_list",
+		"wallet": strings.Repeat("a", 128),
+	})
+	status, respJson := gatewayError(t, hc, body, "application/json")
+	assert.Equal(t, 413, status)
+	assert.Equal(t, "REQUEST_TOO_LARGE", respJson["error_code"])
+}
+
+func TestUnsupportedMediaType(t *testing.T) {
+	hc := newTestController(t)
+	body, _ := json.Marshal(map[string]interface{}{
+		"action": "wallet_create",
+	})
+	status, respJson := gatewayError(t, hc, body, "application/xml")
+	assert.Equal(t, 415, status)
+	assert.Equal(t, "UNSUPPORTED_MEDIA_TYPE", respJson["error_code"])
+
+	// A browser can send these from any page without a preflight
+	for _, contentType := range []string{"text/plain", "text/plain;charset=UTF-8", "application/x-www-form-urlencoded"} {
+		status, respJson = gatewayError(t, hc, body, contentType)
+		assert.Equal(t, 415, status, contentType)
+		assert.Equal(t, "UNSUPPORTED_MEDIA_TYPE", respJson["error_code"], contentType)
+	}
+
+	// No Content-Type is fine, unless browsers are allowed in
+	status, respJson = gatewayError(t, hc, body, "")
+	assert.NotEqual(t, 415, status)
+	MockConfig.Server.CorsAllowedOrigins = []string{"*"}
+	defer func() { MockConfig.Server.CorsAllowedOrigins = nil }()
+	status, respJson = gatewayError(t, hc, body, "")
+	assert.Equal(t, 415, status)
+	assert.Equal(t, "UNSUPPORTED_MEDIA_TYPE", respJson["error_code"])
+}
+
+func TestStrictRequests(t *testing.T) {
+	hc := newTestController(t)
+	body, _ := json.Marshal(map[string]interface{}{
+		"action":  "account_list",
+		"wallet":  exampleWallet,
+		"countt":  10,
+		"api_key": "ignored",
+	})
+
+	// Off by default, the typo is ignored
+	status, respJson := gatewayError(t, hc, body, "application/json")
+	assert.NotEqual(t, "UNKNOWN_FIELD", respJson["error_code"])
+
+	MockConfig.Server.StrictRequests = true
+	defer func() { MockConfig.Server.StrictRequests = false }()
+	status, respJson = gatewayError(t, hc, body, "application/json")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "UNKNOWN_FIELD", respJson["error_code"])
+	assert.Contains(t, respJson["error"], "countt")
+}
+
+func TestUnknownFields(t *testing.T) {
+	// The examples of the spec are requests that should work
+	for _, a := range append(apiActions, adminAPIActions...) {
+		assert.Empty(t, unknownFields(a.Action, a.Example), a.Action)
+	}
+	assert.Equal(t, []string{"a", "b"}, unknownFields("account_list", map[string]interface{}{"action": "account_list", "wallet": exampleWallet, "b": 1, "a": 1}))
+	// Forwarded to the node, which has its own fields
+	assert.Nil(t, unknownFields("account_info", map[string]interface{}{"action": "account_info", "representative": true}))
+}
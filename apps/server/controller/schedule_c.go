@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
+)
+
+// Handle creating a recurring send
+func (hc *HttpController) HandleSendScheduleRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var scheduleRequest requests.SendScheduleRequest
+	if err := mapstructure.Decode(rawRequest, &scheduleRequest); err != nil {
+		log.Errorf("Error unmarshalling send_schedule request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if scheduleRequest.Wallet == "" || scheduleRequest.Action == "" || scheduleRequest.AmountRaw == "" || scheduleRequest.Source == "" || scheduleRequest.Destination == "" || scheduleRequest.IntervalSeconds == nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	interval, err := utils.ToInt(*scheduleRequest.IntervalSeconds)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	startAt := time.Now()
+	if scheduleRequest.StartAt != nil {
+		startUnix, err := utils.ToInt(*scheduleRequest.StartAt)
+		if err != nil || startUnix < 0 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+		startAt = time.Unix(int64(startUnix), 0)
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(scheduleRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Validate accounts
+	_, err = utils.AddressToPub(scheduleRequest.Source, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid source account %s", scheduleRequest.Source))
+		return
+	}
+	_, err = utils.AddressToPub(scheduleRequest.Destination, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid destination account %s", scheduleRequest.Destination))
+		return
+	}
+
+	schedule, err := hc.Wallet.SendScheduleCreate(dbWallet, scheduleRequest.Source, scheduleRequest.Destination, scheduleRequest.AmountRaw, interval, startAt)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	} else if errors.Is(err, wallet.ErrInvalidInterval) {
+		ErrBadRequest(w, r, ErrorCodeInvalidInterval, "Invalid interval")
+		return
+	} else if errors.Is(err, wallet.ErrInvalidAmount) {
+		ErrBadRequest(w, r, ErrorCodeInvalidAmount, "Invalid amount")
+		return
+	} else if errors.Is(err, wallet.ErrApprovalRequired) {
+		ErrBadRequest(w, r, ErrorCodeApprovalRequired, "Sends of more than the wallet's approval threshold can't be scheduled")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.SendScheduleResponse{
+		ScheduleID: schedule.ID.String(),
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle stopping a recurring send
+func (hc *HttpController) HandleSendScheduleCancelRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var cancelRequest requests.SendScheduleCancelRequest
+	if err := mapstructure.Decode(rawRequest, &cancelRequest); err != nil {
+		log.Errorf("Error unmarshalling send_schedule_cancel request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if cancelRequest.Wallet == "" || cancelRequest.Action == "" || cancelRequest.ScheduleID == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(cancelRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	err := hc.Wallet.SendScheduleCancel(dbWallet, cancelRequest.ScheduleID)
+	if errors.Is(err, wallet.ErrScheduleNotFound) {
+		ErrBadRequest(w, r, ErrorCodeScheduleNotFound, "Schedule not found")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.SendScheduleCancelResponse{
+		Cancelled: "1",
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
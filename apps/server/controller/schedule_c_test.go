@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendSchedule(t *testing.T) {
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("a3c6e9f2b5d8a1c4e7f0b3d6a9c2e5f8b1d4a7c0e3f6b9d2a5c8e1f4b7d0a361"))
+	wallet, err := hc.Wallet.WalletCreate(newSeed)
+	assert.Nil(t, err)
+	acc, err := hc.Wallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doRequest(map[string]interface{}{
+		"action":           "send_schedule",
+		"wallet":           wallet.ID.String(),
+		"source":           acc.Address,
+		"destination":      "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj",
+		"amount_raw":       "1000000000000000000000000000000",
+		"interval_seconds": 86400,
+		"start_at":         1700000000,
+	})
+	assert.Equal(t, 200, status)
+	assert.Contains(t, respJson, "schedule_id")
+	scheduleID, err := uuid.Parse(respJson["schedule_id"].(string))
+	assert.Nil(t, err)
+
+	schedule, err := hc.Wallet.DB.SendSchedule.Get(hc.Wallet.Ctx, scheduleID)
+	assert.Nil(t, err)
+	assert.Equal(t, 86400, schedule.IntervalSeconds)
+	assert.Equal(t, int64(1700000000), schedule.NextRunAt.Unix())
+
+	// Bad interval
+	status, respJson = doRequest(map[string]interface{}{
+		"action":           "send_schedule",
+		"wallet":           wallet.ID.String(),
+		"source":           acc.Address,
+		"destination":      "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj",
+		"amount_raw":       "1000000000000000000000000000000",
+		"interval_seconds": 0,
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_INTERVAL", respJson["error_code"])
+
+	// Cancel
+	status, respJson = doRequest(map[string]interface{}{
+		"action":      "send_schedule_cancel",
+		"wallet":      wallet.ID.String(),
+		"schedule_id": scheduleID.String(),
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["cancelled"])
+
+	status, respJson = doRequest(map[string]interface{}{
+		"action":      "send_schedule_cancel",
+		"wallet":      wallet.ID.String(),
+		"schedule_id": scheduleID.String(),
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "SCHEDULE_NOT_FOUND", respJson["error_code"])
+}
@@ -0,0 +1,234 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+)
+
+// Sends of more than a wallet's approval threshold are stored by send instead of published
+// send_approve and send_reject need an API key marked as an approver, so they only work with server.require_api_key
+
+// The request's API key if it's an approver, otherwise the error is written
+func approverKey(w http.ResponseWriter, r *http.Request) *ent.ApiKey {
+	found, ok := r.Context().Value(apiKeyContextKey{}).(*ent.ApiKey)
+	if !ok || !found.Approver {
+		ErrNotApprover(w, r)
+		return nil
+	}
+	return found
+}
+
+// The API key of the request, nil if authenticate didn't check one
+func requestApiKeyID(r *http.Request) *uuid.UUID {
+	if found, ok := r.Context().Value(apiKeyContextKey{}).(*ent.ApiKey); ok {
+		return &found.ID
+	}
+	return nil
+}
+
+func sendApprovalResponse(approval *ent.SendApproval) responses.SendApprovalResponse {
+	resp := responses.SendApprovalResponse{
+		ApprovalID:        approval.ID.String(),
+		Status:            string(approval.Status),
+		Source:            approval.Source,
+		Destination:       approval.Destination,
+		Amount:            approval.Amount,
+		Approvals:         len(approval.ApprovedBy),
+		ApprovalsRequired: approval.ApprovalsRequired,
+		CreatedAt:         approval.CreatedAt.Unix(),
+	}
+	if approval.BlockHash != nil {
+		resp.Block = *approval.BlockHash
+	}
+	return resp
+}
+
+// The error code of an error approving or rejecting a send, publishing it fails like send
+func sendApprovalErrorCode(err error) ErrorCode {
+	switch {
+	case errors.Is(err, wallet.ErrSendApprovalNotFound):
+		return ErrorCodeApprovalNotFound
+	case errors.Is(err, wallet.ErrSendApprovalResolved):
+		return ErrorCodeApprovalResolved
+	case errors.Is(err, wallet.ErrSendAlreadyApproved):
+		return ErrorCodeAlreadyApproved
+	case errors.Is(err, wallet.ErrApproverIsRequester):
+		return ErrorCodeApproverIsRequester
+	default:
+		return blockErrorCode(err)
+	}
+}
+
+// Store a send that needs approvals, called by send once the request is validated
+func (hc *HttpController) requestSendApproval(dbWallet *ent.Wallet, sendRequest requests.SendRequest, w http.ResponseWriter, r *http.Request) (*ent.SendApproval, error) {
+	approval, err := hc.Wallet.SendApprovalCreate(dbWallet, sendRequest.Source, sendRequest.Destination, sendRequest.Amount, sendRequest.ID, sendRequest.Work, requestApiKeyID(r))
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return nil, err
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return nil, err
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return nil, err
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, sendApprovalResponse(approval))
+	return approval, nil
+}
+
+// Handle send_approve, the send is published once it has enough approvals
+func (hc *HttpController) HandleSendApproveRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var approveRequest requests.SendApprovalRequest
+	if err := mapstructure.Decode(rawRequest, &approveRequest); err != nil {
+		log.Errorf("Error unmarshalling send_approve request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if approveRequest.Wallet == "" || approveRequest.Action == "" || approveRequest.ApprovalID == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	approver := approverKey(w, r)
+	if approver == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(approveRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	approval, err := hc.Wallet.SendApprove(dbWallet, approveRequest.ApprovalID, approver.ID, approveRequest.BpowKey)
+	if err != nil {
+		ErrBadRequest(w, r, sendApprovalErrorCode(err), err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, sendApprovalResponse(approval))
+}
+
+// Handle send_reject, the send is never published
+func (hc *HttpController) HandleSendRejectRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var rejectRequest requests.SendApprovalRequest
+	if err := mapstructure.Decode(rawRequest, &rejectRequest); err != nil {
+		log.Errorf("Error unmarshalling send_reject request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if rejectRequest.Wallet == "" || rejectRequest.Action == "" || rejectRequest.ApprovalID == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	if approverKey(w, r) == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(rejectRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	approval, err := hc.Wallet.SendReject(dbWallet, rejectRequest.ApprovalID)
+	if err != nil {
+		ErrBadRequest(w, r, sendApprovalErrorCode(err), err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, sendApprovalResponse(approval))
+}
+
+// Handle send_approval_list, the wallet's sends that needed approvals, newest first
+func (hc *HttpController) HandleSendApprovalListRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var listRequest requests.SendApprovalListRequest
+	if err := mapstructure.Decode(rawRequest, &listRequest); err != nil {
+		log.Errorf("Error unmarshalling send_approval_list request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if listRequest.Wallet == "" || listRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(listRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	approvals, err := hc.Wallet.SendApprovalList(dbWallet, listRequest.Status)
+	if errors.Is(err, wallet.ErrInvalidSendApprovalStatus) {
+		ErrBadRequest(w, r, ErrorCodeInvalidStatus, err.Error())
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.SendApprovalListResponse{
+		Approvals: make([]responses.SendApprovalResponse, len(approvals)),
+	}
+	for i, approval := range approvals {
+		resp.Approvals[i] = sendApprovalResponse(approval)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle wallet_approval_policy_set, how many approvals sends of more than threshold need, 0 turns it off
+func (hc *HttpController) HandleWalletApprovalPolicySetRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var setRequest requests.WalletApprovalPolicySetRequest
+	if err := mapstructure.Decode(rawRequest, &setRequest); err != nil {
+		log.Errorf("Error unmarshalling wallet_approval_policy_set request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if setRequest.Wallet == "" || setRequest.Action == "" || setRequest.ApprovalsRequired == nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	approvalsRequired, err := utils.ToInt(*setRequest.ApprovalsRequired)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(setRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	updated, err := hc.Wallet.SetApprovalPolicy(dbWallet, approvalsRequired, setRequest.Threshold)
+	if errors.Is(err, wallet.ErrInvalidApprovalPolicy) {
+		ErrBadRequest(w, r, ErrorCodeInvalidApprovalPolicy, err.Error())
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WalletApprovalPolicyResponse{}
+	if updated.ApprovalsRequired != nil && updated.ApprovalThreshold != nil {
+		resp.ApprovalsRequired = *updated.ApprovalsRequired
+		resp.Threshold = *updated.ApprovalThreshold
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
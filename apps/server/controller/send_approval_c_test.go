@@ -0,0 +1,170 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendApprovals(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processed := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			var js map[string]interface{}
+			if pr.Action == "account_info" {
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "process" {
+				processed++
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"hash": fmt.Sprintf("5A%062X", processed)})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+
+	hc := newTestController(t)
+	conf := *hc.Wallet.Config
+	hc.Wallet.Config = &conf
+	conf.Server.RequireApiKey = true
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("c4e7a1d3f6b9e2c5a8d0f3b6e9c1a4d7f0b2e5c8a1d4f7b0e3c6a9d2f5b8e1c4"))
+	wallet, err := hc.Wallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := hc.Wallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	_, sendKey, err := hc.Wallet.ApiKeyCreate("sender", "send")
+	assert.Nil(t, err)
+	_, adminKey, err := hc.Wallet.ApiKeyCreate("admin", "admin")
+	assert.Nil(t, err)
+	first, firstKey, err := hc.Wallet.ApiKeyCreate("first approver", "read")
+	assert.Nil(t, err)
+	_, err = hc.Wallet.ApiKeySetApprover(first.ID.String(), true)
+	assert.Nil(t, err)
+	second, secondKey, err := hc.Wallet.ApiKeyCreate("second approver", "read")
+	assert.Nil(t, err)
+	_, err = hc.Wallet.ApiKeySetApprover(second.ID.String(), true)
+	assert.Nil(t, err)
+
+	doRequest := func(handler http.HandlerFunc, path string, key string, reqBody map[string]interface{}) (int, map[string]interface{}) {
+		reqBody["wallet"] = wallet.ID.String()
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", path, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Api-Key", key)
+		handler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+	send := func(amount string) map[string]interface{} {
+		return map[string]interface{}{
+			"action":      "send",
+			"source":      acc.Address,
+			"destination": "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj",
+			"amount":      amount,
+			"work":        "0000000000000000",
+		}
+	}
+
+	// Only on /admin
+	status, respJson := doRequest(hc.Gateway, "/", adminKey, map[string]interface{}{"action": "wallet_approval_policy_set", "approvals_required": 2, "threshold": "1000"})
+	assert.Equal(t, 403, status)
+	assert.Equal(t, "ADMIN_ONLY", respJson["error_code"])
+	status, respJson = doRequest(hc.AdminHandler, "/admin", adminKey, map[string]interface{}{"action": "wallet_approval_policy_set", "approvals_required": 11, "threshold": "1000"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_APPROVAL_POLICY", respJson["error_code"])
+	status, respJson = doRequest(hc.AdminHandler, "/admin", adminKey, map[string]interface{}{"action": "wallet_approval_policy_set", "approvals_required": 2, "threshold": "1000"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(2), respJson["approvals_required"])
+	assert.Equal(t, "1000", respJson["threshold"])
+
+	// Up to the threshold it's sent right away
+	status, respJson = doRequest(hc.Gateway, "/", sendKey, send("1000"))
+	assert.Equal(t, 200, status)
+	assert.Equal(t, fmt.Sprintf("5A%062X", 1), respJson["block"])
+	assert.Equal(t, 1, processed)
+
+	status, respJson = doRequest(hc.Gateway, "/", sendKey, send("5000"))
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "pending", respJson["status"])
+	assert.Equal(t, float64(0), respJson["approvals"])
+	assert.Equal(t, float64(2), respJson["approvals_required"])
+	assert.Nil(t, respJson["block"])
+	approvalID := respJson["approval_id"].(string)
+	assert.Equal(t, 1, processed)
+
+	// Other sends can't go around it
+	withID := send("5000")
+	withID["action"] = "send_with_id"
+	withID["send_id"] = "payout-1"
+	status, respJson = doRequest(hc.Gateway, "/", sendKey, withID)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "APPROVAL_REQUIRED", respJson["error_code"])
+
+	status, respJson = doRequest(hc.Gateway, "/", firstKey, map[string]interface{}{"action": "send_approval_list", "status": "pending"})
+	assert.Equal(t, 200, status)
+	approvals := respJson["approvals"].([]interface{})
+	assert.Len(t, approvals, 1)
+	assert.Equal(t, approvalID, approvals[0].(map[string]interface{})["approval_id"])
+	assert.Equal(t, "5000", approvals[0].(map[string]interface{})["amount"])
+
+	// A key that isn't an approver can't, even an admin one
+	approve := map[string]interface{}{"action": "send_approve", "approval_id": approvalID}
+	status, respJson = doRequest(hc.Gateway, "/", adminKey, approve)
+	assert.Equal(t, 403, status)
+	assert.Equal(t, "NOT_APPROVER", respJson["error_code"])
+
+	status, respJson = doRequest(hc.Gateway, "/", firstKey, approve)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "pending", respJson["status"])
+	assert.Equal(t, float64(1), respJson["approvals"])
+	status, respJson = doRequest(hc.Gateway, "/", firstKey, approve)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ALREADY_APPROVED", respJson["error_code"])
+	assert.Equal(t, 1, processed)
+
+	status, respJson = doRequest(hc.Gateway, "/", secondKey, approve)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "sent", respJson["status"])
+	assert.Equal(t, fmt.Sprintf("5A%062X", 2), respJson["block"])
+	assert.Equal(t, 2, processed)
+
+	// A rejected send is never published
+	_, respJson = doRequest(hc.Gateway, "/", sendKey, send("5000"))
+	rejectedID := respJson["approval_id"].(string)
+	status, respJson = doRequest(hc.Gateway, "/", secondKey, map[string]interface{}{"action": "send_reject", "approval_id": rejectedID})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "rejected", respJson["status"])
+	status, respJson = doRequest(hc.Gateway, "/", firstKey, map[string]interface{}{"action": "send_approve", "approval_id": rejectedID})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "APPROVAL_RESOLVED", respJson["error_code"])
+	status, respJson = doRequest(hc.Gateway, "/", firstKey, map[string]interface{}{"action": "send_reject", "approval_id": "notauuid"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "APPROVAL_NOT_FOUND", respJson["error_code"])
+	assert.Equal(t, 2, processed)
+
+	status, respJson = doRequest(hc.Gateway, "/", firstKey, map[string]interface{}{"action": "send_approval_list", "status": "approved"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_STATUS", respJson["error_code"])
+}
@@ -0,0 +1,138 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/go-chi/render"
+	"github.com/mitchellh/mapstructure"
+)
+
+func snapshotResponse(snapshot *ent.WalletSnapshot) responses.Snapshot {
+	return responses.Snapshot{
+		SnapshotID:   snapshot.ID.String(),
+		Label:        snapshot.Label,
+		CreatedAt:    snapshot.CreatedAt.Unix(),
+		AccountCount: snapshot.AccountCount,
+		TotalRaw:     snapshot.TotalRaw,
+	}
+}
+
+// Handle recording the balances of every account in a wallet
+func (hc *HttpController) HandleSnapshotBalances(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var snapshotRequest requests.SnapshotBalancesRequest
+	if err := mapstructure.Decode(rawRequest, &snapshotRequest); err != nil {
+		log.Errorf("Error unmarshalling snapshot_balances request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if snapshotRequest.Wallet == "" || snapshotRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(snapshotRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	snapshot, err := hc.Wallet.SnapshotBalances(dbWallet, snapshotRequest.Label, time.Now())
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrInvalidSnapshotLabel) {
+		ErrBadRequest(w, r, ErrorCodeInvalidLabel, "Invalid label, must be at most 128 characters")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := snapshotResponse(snapshot)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle listing the snapshots of a wallet
+func (hc *HttpController) HandleListSnapshots(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var listRequest requests.BaseRequest
+	if err := mapstructure.Decode(rawRequest, &listRequest); err != nil {
+		log.Errorf("Error unmarshalling list_snapshots request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if listRequest.Wallet == "" || listRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(listRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	snapshots, err := hc.Wallet.WalletSnapshots(dbWallet)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.ListSnapshotsResponse{
+		Snapshots: []responses.Snapshot{},
+	}
+	for _, snapshot := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, snapshotResponse(snapshot))
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle getting a snapshot with the balance of every account in it
+func (hc *HttpController) HandleGetSnapshot(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var getRequest requests.GetSnapshotRequest
+	if err := mapstructure.Decode(rawRequest, &getRequest); err != nil {
+		log.Errorf("Error unmarshalling get_snapshot request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if getRequest.Wallet == "" || getRequest.Action == "" || getRequest.SnapshotID == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(getRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	snapshot, err := hc.Wallet.GetWalletSnapshot(dbWallet, getRequest.SnapshotID)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrSnapshotNotFound) {
+		ErrBadRequest(w, r, ErrorCodeSnapshotNotFound, "Snapshot not found")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.GetSnapshotResponse{
+		Snapshot: snapshotResponse(snapshot),
+		Balances: map[string]string{},
+	}
+	for _, balance := range snapshot.Edges.Balances {
+		resp.Balances[balance.Edges.Account.Address] = balance.BalanceRaw
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
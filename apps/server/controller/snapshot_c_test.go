@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	rpcreq "github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalanceSnapshots(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	balance := "10"
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var ar rpcreq.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&ar)
+			resp := map[string]interface{}{}
+			for _, account := range ar.Accounts {
+				resp[account] = map[string]interface{}{"balance": balance, "pending": "0", "receivable": "0"}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"balances": resp,
+			})
+		},
+	)
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("a4c7e0b3d6f9a2c5e8b1d4f7a0c3e6b9d2f5a8c1e4b7d0f3a6c9e2b5d8f1a4c7"))
+	dbWallet, err := hc.Wallet.WalletCreate(newSeed)
+	assert.Nil(t, err)
+	acc, err := hc.Wallet.AccountCreate(dbWallet, nil)
+	assert.Nil(t, err)
+
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doRequest(map[string]interface{}{
+		"action": "snapshot_balances",
+		"wallet": dbWallet.ID.String(),
+		"label":  "2023",
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "2023", respJson["label"])
+	assert.Equal(t, "20", respJson["total_raw"])
+	assert.Equal(t, float64(2), respJson["account_count"])
+	firstID := respJson["snapshot_id"].(string)
+
+	// The balances change after the first snapshot
+	balance = "7"
+	status, respJson = doRequest(map[string]interface{}{
+		"action": "snapshot_balances",
+		"wallet": dbWallet.ID.String(),
+	})
+	assert.Equal(t, 200, status)
+	assert.Nil(t, respJson["label"])
+	assert.Equal(t, "14", respJson["total_raw"])
+	secondID := respJson["snapshot_id"].(string)
+
+	// The first snapshot still has the balances it was taken with
+	status, respJson = doRequest(map[string]interface{}{
+		"action":      "get_snapshot",
+		"wallet":      dbWallet.ID.String(),
+		"snapshot_id": firstID,
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "20", respJson["total_raw"])
+	balances := respJson["balances"].(map[string]interface{})
+	assert.Len(t, balances, 2)
+	assert.Equal(t, "10", balances[acc.Address])
+
+	status, respJson = doRequest(map[string]interface{}{
+		"action":      "get_snapshot",
+		"wallet":      dbWallet.ID.String(),
+		"snapshot_id": secondID,
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "7", respJson["balances"].(map[string]interface{})[acc.Address])
+
+	status, respJson = doRequest(map[string]interface{}{
+		"action": "list_snapshots",
+		"wallet": dbWallet.ID.String(),
+	})
+	assert.Equal(t, 200, status)
+	snapshots := respJson["snapshots"].([]interface{})
+	assert.Len(t, snapshots, 2)
+	ids := []string{snapshots[0].(map[string]interface{})["snapshot_id"].(string), snapshots[1].(map[string]interface{})["snapshot_id"].(string)}
+	assert.ElementsMatch(t, []string{firstID, secondID}, ids)
+
+	status, respJson = doRequest(map[string]interface{}{
+		"action":      "get_snapshot",
+		"wallet":      dbWallet.ID.String(),
+		"snapshot_id": "3f8a2c1d-9b4e-4a7f-8c2d-5e1b9a6f3c07",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "SNAPSHOT_NOT_FOUND", respJson["error_code"])
+
+	status, respJson = doRequest(map[string]interface{}{
+		"action": "snapshot_balances",
+		"wallet": dbWallet.ID.String(),
+		"label":  strings.Repeat("a", 129),
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_LABEL", respJson["error_code"])
+}
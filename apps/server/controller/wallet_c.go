@@ -2,17 +2,24 @@ package controller
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
 	"github.com/appditto/pippin_nano_wallet/libs/log"
+	rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	walletmodels "github.com/appditto/pippin_nano_wallet/libs/wallet/models"
 	"github.com/go-chi/render"
 	"github.com/mitchellh/mapstructure"
 )
@@ -29,22 +36,49 @@ func (hc *HttpController) HandleWalletCreate(request *map[string]interface{}, w
 		return
 	}
 
-	var seed string
+	// Parse as bool
+	returnSeed := false
 	var err error
+	if walletCreateRequest.ReturnSeed != nil {
+		returnSeed, err = utils.ToBool(*walletCreateRequest.ReturnSeed)
+		if err != nil {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+
+	scan, gapLimit, err := hc.parseRestoreScan(walletCreateRequest.Scan, walletCreateRequest.GapLimit)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	var seed string
 	if walletCreateRequest.Seed != nil {
 		seed = *walletCreateRequest.Seed
 	} else {
-		seed, err = utils.GenerateSeed(nil)
+		seed, err = utils.GenerateSecureSeed()
 		if err != nil {
-			ErrInternalServerError(w, r, err.Error())
-			return
+			// Without a secure random source no seed can be trusted
+			log.Fatalf("Unable to generate a secure seed %s", err)
 		}
 	}
 
-	newWallet, err := hc.Wallet.WalletCreate(seed)
+	// A new seed has nothing on chain to find
+	var newWallet *ent.Wallet
+	var accounts []*ent.Account
+	if scan && walletCreateRequest.Seed != nil {
+		newWallet, accounts, err = hc.Wallet.WalletCreateScanned(seed, gapLimit)
+	} else {
+		newWallet, err = hc.Wallet.WalletCreate(seed)
+	}
 	if errors.Is(err, wallet.ErrInvalidSeed) {
 		ErrInvalidSeed(w, r)
 		return
+	} else if returnSeed && ent.IsConstraintError(err) {
+		// The seed is only shown once, a wallet that already exists can't be used to get it again
+		ErrBadRequest(w, r, ErrorCodeSeedUnavailable, "return_seed is only available when creating a new wallet, before it is encrypted")
+		return
 	} else if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
@@ -55,10 +89,376 @@ func (hc *HttpController) HandleWalletCreate(request *map[string]interface{}, w
 	walletCreateResponse := responses.WalletCreateResponse{
 		Wallet: newWallet.ID.String(),
 	}
+	if returnSeed {
+		walletCreateResponse.Seed = &seed
+	}
+	for _, acc := range accounts {
+		walletCreateResponse.Accounts = append(walletCreateResponse.Accounts, acc.Address)
+	}
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, &walletCreateResponse)
 }
 
+// Whether scan is set, and the gap limit to scan with, restore_gap_limit unless gap_limit is given
+func (hc *HttpController) parseRestoreScan(scan *interface{}, gapLimit *interface{}) (bool, int, error) {
+	if scan == nil {
+		return false, 0, nil
+	}
+	doScan, err := utils.ToBool(*scan)
+	if err != nil || !doScan {
+		return false, 0, err
+	}
+	limit, err := parseGapLimit(gapLimit)
+	if err != nil {
+		return false, 0, err
+	} else if limit != nil {
+		return true, *limit, nil
+	}
+	return true, hc.Wallet.Config.Wallet.RestoreGapLimit, nil
+}
+
+// Create a wallet from an existing seed with its first count accounts, count defaults to 1
+func (hc *HttpController) HandleWalletCreateFromSeed(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.WalletCreateFromSeedRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling wallet_create_from_seed request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Action == "" || request.Seed == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	count := 1
+	if request.Count != nil {
+		var err error
+		count, err = utils.ToInt(*request.Count)
+		if err != nil || count < 1 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+
+	newWallet, accounts, err := hc.Wallet.WalletCreateFromSeed(request.Seed, count, request.Name)
+	if errors.Is(err, wallet.ErrInvalidSeed) {
+		ErrInvalidSeed(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrInvalidWalletName) {
+		ErrBadRequest(w, r, ErrorCodeInvalidName, "Invalid name, must be 1 to 128 characters")
+		return
+	} else if ent.IsConstraintError(err) {
+		ErrBadRequest(w, r, ErrorCodeWalletExists, "A wallet with this seed already exists")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WalletCreateFromSeedResponse{
+		Wallet:   newWallet.ID.String(),
+		Accounts: []string{},
+	}
+	for _, acc := range accounts {
+		resp.Accounts = append(resp.Accounts, acc.Address)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Create a watch-only wallet from accounts, it can be queried like any other wallet but nothing it has can be signed
+func (hc *HttpController) HandleWalletCreateWatchOnly(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.WalletCreateWatchOnlyRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling wallet_create_watch_only request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Action == "" || len(request.Accounts) == 0 {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	maxAccounts := hc.Wallet.Config.Server.WatchOnlyMaxAccounts
+	if len(request.Accounts) > maxAccounts {
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Too many accounts, at most %d", maxAccounts))
+		return
+	}
+	for _, address := range request.Accounts {
+		if _, err := utils.AddressToPub(address, hc.Wallet.Config.Wallet.Banano); err != nil {
+			ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid account %s", address))
+			return
+		}
+	}
+
+	newWallet, accounts, err := hc.Wallet.WalletCreateWatchOnly(request.Accounts, request.Name)
+	if errors.Is(err, wallet.ErrInvalidWalletName) {
+		ErrBadRequest(w, r, ErrorCodeInvalidName, "Invalid name, must be 1 to 128 characters")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WalletCreateFromSeedResponse{
+		Wallet:   newWallet.ID.String(),
+		Accounts: []string{},
+	}
+	for _, acc := range accounts {
+		resp.Accounts = append(resp.Accounts, acc.Address)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Add addresses to an existing wallet as watch-only accounts, they have no private key
+func (hc *HttpController) HandleWalletAddWatch(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.WalletAddWatchRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling wallet_add_watch request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Wallet == "" || request.Action == "" || len(request.Accounts) == 0 {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	maxAccounts := hc.Wallet.Config.Server.WatchOnlyMaxAccounts
+	if len(request.Accounts) > maxAccounts {
+		ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Too many accounts, at most %d", maxAccounts))
+		return
+	}
+	for _, address := range request.Accounts {
+		if _, err := utils.AddressToPub(address, hc.Wallet.Config.Wallet.Banano); err != nil {
+			ErrBadRequest(w, r, ErrorCodeInvalidAccount, fmt.Sprintf("Invalid account %s", address))
+			return
+		}
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	added, err := hc.Wallet.WalletAddWatch(dbWallet, request.Accounts)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WalletAddWatchResponse{
+		Success:  "",
+		Accounts: []string{},
+	}
+	for _, acc := range added {
+		resp.Accounts = append(resp.Accounts, acc.Address)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Backups can be given as the file contents or as the object
+func backupBytes(backup interface{}) ([]byte, error) {
+	if asString, ok := backup.(string); ok {
+		return []byte(asString), nil
+	}
+	return json.Marshal(backup)
+}
+
+// Create a wallet from a NanoWallet backup, see wallet.NanoWalletBackup for the supported format
+func (hc *HttpController) HandleWalletImportNanoWallet(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.WalletImportNanoWalletRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling wallet_import_nanowallet request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Action == "" || request.Backup == nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	backup, err := backupBytes(*request.Backup)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	newWallet, accounts, err := hc.Wallet.WalletImportNanoWallet(backup, request.Passphrase)
+	if errors.Is(err, wallet.ErrDecryptionFailed) {
+		ErrBadRequest(w, r, ErrorCodeDecryptionFailed, "decryption_failed")
+		return
+	} else if errors.Is(err, wallet.ErrInvalidBackup) {
+		ErrBadRequest(w, r, ErrorCodeInvalidBackup, fmt.Sprintf("Invalid backup, only NanoWallet version %d backups are supported", wallet.NanoWalletBackupVersion))
+		return
+	} else if ent.IsConstraintError(err) {
+		ErrBadRequest(w, r, ErrorCodeWalletExists, "A wallet with this seed already exists")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WalletImportNanoWalletResponse{
+		Wallet:   newWallet.ID.String(),
+		Accounts: []string{},
+	}
+	for _, acc := range accounts {
+		resp.Accounts = append(resp.Accounts, acc.Address)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Create a wallet for every wallet in a Nault backup, see wallet.NaultBackup for the supported format
+func (hc *HttpController) HandleWalletImportNault(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.WalletImportNaultRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling wallet_import_nault request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Action == "" || request.Backup == nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	backup, err := backupBytes(*request.Backup)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	wallets, err := hc.Wallet.WalletImportNault(backup, request.Passphrase)
+	if errors.Is(err, wallet.ErrDecryptionFailed) {
+		ErrBadRequest(w, r, ErrorCodeDecryptionFailed, "decryption_failed")
+		return
+	} else if errors.Is(err, wallet.ErrInvalidBackup) {
+		ErrBadRequest(w, r, ErrorCodeInvalidBackup, fmt.Sprintf("Invalid backup, only Nault version %d backups are supported", wallet.NaultBackupVersion))
+		return
+	} else if ent.IsConstraintError(err) {
+		ErrBadRequest(w, r, ErrorCodeWalletExists, "A wallet in the backup already exists")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WalletImportNaultResponse{
+		Wallets: []string{},
+	}
+	for _, newWallet := range wallets {
+		resp.Wallets = append(resp.Wallets, newWallet.ID.String())
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle wallet_backup_restore, the wallet is created with the ID and accounts it had, unencrypted
+func (hc *HttpController) HandleWalletBackupRestore(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.WalletBackupRestoreRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling wallet_backup_restore request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Action == "" || request.Backup == nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	backup, err := backupBytes(*request.Backup)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	newWallet, accounts, err := hc.Wallet.WalletBackupRestore(backup, request.Passphrase)
+	if errors.Is(err, wallet.ErrDecryptionFailed) {
+		ErrBadRequest(w, r, ErrorCodeDecryptionFailed, "decryption_failed")
+		return
+	} else if errors.Is(err, wallet.ErrInvalidBackup) {
+		ErrBadRequest(w, r, ErrorCodeInvalidBackup, fmt.Sprintf("Invalid backup, only version %d backups from wallet_backup_create are supported", wallet.PippinBackupVersion))
+		return
+	} else if ent.IsConstraintError(err) {
+		ErrBadRequest(w, r, ErrorCodeWalletExists, "The wallet in this backup already exists")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WalletBackupRestoreResponse{
+		Wallet:   newWallet.ID.String(),
+		Accounts: []string{},
+	}
+	for _, acc := range accounts {
+		resp.Accounts = append(resp.Accounts, acc.Address)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// List every wallet, paginated with offset and limit, only on /admin since a wallet's ID is all send needs
+// Seeds are never included in the response
+func (hc *HttpController) HandleWalletList(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.WalletListRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling wallet_list request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	maxLimit := hc.Wallet.Config.Server.WalletListMaxLimit
+	offset := 0
+	limit := maxLimit
+	var err error
+	if request.Offset != nil {
+		offset, err = utils.ToInt(*request.Offset)
+		if err != nil || offset < 0 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+	if request.Limit != nil {
+		limit, err = utils.ToInt(*request.Limit)
+		if err != nil || limit < 1 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+		if limit > maxLimit {
+			limit = maxLimit
+		}
+	}
+
+	summaries, err := hc.Wallet.WalletList(offset, limit)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WalletListResponse{
+		Wallets: make([]responses.WalletListItem, len(summaries)),
+	}
+	for i, summary := range summaries {
+		resp.Wallets[i] = responses.WalletListItem{
+			WalletID:     summary.ID.String(),
+			Name:         summary.Name,
+			AccountCount: summary.AccountCount,
+			WatchOnly:    summary.WatchOnly,
+			CreatedAt:    summary.CreatedAt.Unix(),
+		}
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
 // For adding adhoc keys to the wallet
 func (hc *HttpController) HandleWalletAdd(request *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
 	// mapstructure decode
@@ -123,13 +523,17 @@ func (hc *HttpController) HandleWalletLocked(rawRequest *map[string]interface{},
 		return
 	}
 
-	// Check if wallet is locked
-	_, err := wallet.GetDecryptedKeyFromStorage(dbWallet, "seed")
-	var resp responses.WalletLockedResponse
+	// Check if wallet is locked, for the API key if there's one
+	unlocked, err := hc.Wallet.SessionUnlocked(dbWallet, apiKeySession(r))
 	if err != nil {
-		resp.Locked = "1"
-	} else {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+	var resp responses.WalletLockedResponse
+	if unlocked {
 		resp.Locked = "0"
+	} else {
+		resp.Locked = "1"
 	}
 
 	render.Status(r, http.StatusOK)
@@ -149,8 +553,8 @@ func (hc *HttpController) HandleWalletLock(rawRequest *map[string]interface{}, w
 		return
 	}
 
-	// Lock wallet
-	err := hc.Wallet.LockWallet(dbWallet)
+	// Lock wallet, with an API key only its session ends
+	err := hc.Wallet.LockWalletSession(dbWallet, apiKeySession(r))
 	var resp = responses.WalletLockedResponse{
 		Locked: "1",
 	}
@@ -165,21 +569,97 @@ func (hc *HttpController) HandleWalletLock(rawRequest *map[string]interface{}, w
 	render.JSON(w, r, &resp)
 }
 
+// Handle wallet_lock_all, lock every encrypted wallet for everyone, e.g. when a client's API key may have leaked
+func (hc *HttpController) HandleWalletLockAll(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	locked, err := hc.Wallet.LockAllWallets()
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+	log.Infof("Locked %d wallets", locked)
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.WalletLockAllResponse{
+		Locked: locked,
+	})
+}
+
 func (hc *HttpController) HandleWalletDestroy(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
-	request := hc.DecodeBaseRequest(rawRequest, w, r)
-	if request == nil {
+	var request requests.WalletDestroyRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling wallet_destroy request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Wallet == "" || request.Action == "" {
+		ErrUnableToParseJson(w, r)
 		return
 	}
 
+	force := false
+	if request.Force != nil {
+		var err error
+		force, err = utils.ToBool(*request.Force)
+		if err != nil {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+
 	// See if wallet exists
 	dbWallet := hc.WalletExists(request.Wallet, w, r)
 	if dbWallet == nil {
 		return
 	}
 
-	// Destroy list
-	err := hc.Wallet.WalletDestroy(dbWallet)
-	var resp = responses.WalletDestroyResponse{
+	// Destroying is irreversible, so refuse while any account has a balance or anything receivable
+	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+	if !force && len(accounts) > 0 {
+		balances, err := hc.RpcClient.MakeAccountsBalancesRequest(accounts)
+		if err != nil {
+			ErrInternalServerError(w, r, err.Error())
+			return
+		}
+		balanceSum, pendingSum, err := sumBalances(balances)
+		if err != nil {
+			ErrInternalServerError(w, r, err.Error())
+			return
+		}
+		total := big.NewInt(0).Add(balanceSum, pendingSum)
+		if total.Sign() > 0 {
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, &responses.WalletHasFundsResponse{
+				Error:      "wallet_has_funds",
+				ErrorCode:  string(ErrorCodeWalletHasFunds),
+				BalanceRaw: total.String(),
+			})
+			return
+		}
+	}
+
+	// Only a fingerprint of the seed is logged, never the seed itself
+	seed, err := wallet.GetDecryptedKeyFromStorage(dbWallet, "seed")
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+	fingerprint, err := utils.SeedFingerprint(seed)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	// Destroy list
+	err = hc.Wallet.WalletDestroy(dbWallet)
+	var resp = responses.WalletDestroyResponse{
 		Destroyed: "1",
 	}
 	if errors.Is(err, wallet.ErrWalletLocked) {
@@ -187,13 +667,767 @@ func (hc *HttpController) HandleWalletDestroy(rawRequest *map[string]interface{}
 		return
 	} else if err != nil {
 		resp.Destroyed = "0"
+	} else {
+		log.Warnf("Destroyed wallet %s, seed fingerprint %s, %d accounts, force %t", dbWallet.ID.String(), fingerprint, len(accounts), force)
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+func (hc *HttpController) HandleWalletBalances(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Get accounts on wallet
+	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	// Get RPC balances
+	resp, err := hc.RpcClient.MakeAccountsBalancesRequest(accounts)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	// Return balances
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+// Sum of the balances and receivable amounts of every account in a wallet
+func (hc *HttpController) HandleWalletBalanceTotal(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.WalletBalanceTotalRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling wallet_balance_total request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Wallet == "" || request.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	if !hc.ValidUnit(request.Unit, w, r) {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Get accounts on wallet
+	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	// Get RPC balances, in one request for all accounts
+	balances, err := hc.RpcClient.MakeAccountsBalancesRequest(accounts)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	balanceSum, pendingSum, err := sumBalances(balances)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+	total := big.NewInt(0).Add(balanceSum, pendingSum)
+
+	resp := responses.WalletBalanceTotalResponse{
+		BalanceRaw: balanceSum.String(),
+		PendingRaw: pendingSum.String(),
+		TotalRaw:   total.String(),
+		Balance:    utils.RawToReadable(balanceSum, hc.Wallet.Config.Wallet.Banano),
+		Pending:    utils.RawToReadable(pendingSum, hc.Wallet.Config.Wallet.Banano),
+		Total:      utils.RawToReadable(total, hc.Wallet.Config.Wallet.Banano),
+	}
+	if request.Unit != nil {
+		resp.Balance, _ = utils.RawToUnit(balanceSum, *request.Unit, hc.Wallet.Config.Wallet.Banano)
+		resp.Pending, _ = utils.RawToUnit(pendingSum, *request.Unit, hc.Wallet.Config.Wallet.Banano)
+		resp.Total, _ = utils.RawToUnit(total, *request.Unit, hc.Wallet.Config.Wallet.Banano)
+		resp.Unit = strings.ToLower(*request.Unit)
+	}
+	var ok bool
+	resp.ValueFiat, resp.Currency, ok = hc.FiatValue(total, request.PriceOptions, w, r)
+	if !ok {
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+func (hc *HttpController) HandleWalletFrontiers(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Get accounts on wallet
+	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	// Get RPC balances
+	resp, err := hc.RpcClient.MakeAccountsFrontiersRequest(accounts)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	// Return balances
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (hc *HttpController) HandleWalletPending(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Get accounts on wallet
+	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	// Get RPC balances
+	resp, err := hc.RpcClient.MakeAccountsPendingRequest(accounts)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	// Return balances
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+// Handle wallet_ledger, like the node's, accounts_info is called for the wallet's accounts in batches
+func (hc *HttpController) HandleWalletLedger(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var ledgerRequest requests.WalletLedgerRequest
+	if err := mapstructure.Decode(rawRequest, &ledgerRequest); err != nil {
+		log.Errorf("Error unmarshalling wallet_ledger request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if ledgerRequest.Wallet == "" || ledgerRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	flags := map[string]bool{}
+	for name, value := range map[string]*interface{}{
+		"representative": ledgerRequest.Representative,
+		"weight":         ledgerRequest.Weight,
+		"pending":        ledgerRequest.Pending,
+		"receivable":     ledgerRequest.Receivable,
+	} {
+		if value == nil {
+			continue
+		}
+		flag, err := utils.ToBool(*value)
+		if err != nil {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+		flags[name] = flag
+	}
+	var modifiedSince int64
+	if ledgerRequest.ModifiedSince != nil {
+		since, err := utils.ToInt(*ledgerRequest.ModifiedSince)
+		if err != nil || since < 0 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+		modifiedSince = int64(since)
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(ledgerRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	pending := flags["pending"] || flags["receivable"]
+	ledger, err := hc.Wallet.WalletLedger(dbWallet, flags["representative"], flags["weight"], pending, modifiedSince)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		log.Errorf("Error getting wallet_ledger from node %s", err)
+		ErrInternalServerError(w, r, "Error forwarding request to node")
+		return
+	}
+
+	labels, err := hc.Wallet.AccountLabels(dbWallet)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WalletLedgerResponse{
+		Accounts: map[string]responses.WalletLedgerItem{},
+	}
+	for address, info := range ledger {
+		item := responses.WalletLedgerItem{
+			Frontier:            info.Frontier,
+			OpenBlock:           info.OpenBlock,
+			RepresentativeBlock: info.RepresentativeBlock,
+			Balance:             info.Balance,
+			ModifiedTimestamp:   info.ModifiedTimestamp,
+			BlockCount:          info.BlockCount,
+			Representative:      info.Representative,
+			Weight:              info.Weight,
+			Label:               labels[address],
+		}
+		if pending {
+			// Newer nodes call it receivable, both are returned like the node does
+			item.Pending = info.Receivable
+			if item.Pending == "" {
+				item.Pending = info.Pending
+			}
+			item.Receivable = item.Pending
+		}
+		resp.Accounts[address] = item
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+func (hc *HttpController) HandleWalletInfo(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Get accounts on wallet
+	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	// Get RPC balances
+	resp, err := hc.RpcClient.MakeAccountsBalancesRequest(accounts)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	balance := big.NewInt(0)
+	pendingBalance := big.NewInt(0)
+
+	// for k, v in balance_json['balances'].items():
+	// balance += int(v['balance'])
+	// pending_bal += int(v['pending'])
+	for _, v := range *resp.Balances {
+		// Balance to bigint
+		balanceBigInt, ok := new(big.Int).SetString(v.Balance, 10)
+		if !ok {
+			ErrInternalServerError(w, r, "Could not parse balance")
+			return
+		}
+		balance = balance.Add(balance, balanceBigInt)
+		pendingBigInt, ok := new(big.Int).SetString(v.Pending, 10)
+		if !ok {
+			ErrInternalServerError(w, r, "Could not parse balance")
+			return
+		}
+		pendingBalance = pendingBalance.Add(pendingBalance, pendingBigInt)
+	}
+
+	// Retrieve wallet info from database
+	walletInfo, err := hc.Wallet.WalletInfo(dbWallet)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	// Return balances
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, responses.WalletInfoResponse{
+		Balance:            balance.String(),
+		Pending:            pendingBalance.String(),
+		Receivable:         pendingBalance.String(),
+		AccountsCount:      walletInfo.AccountsCount,
+		AdhocCount:         walletInfo.AdhocCount,
+		DeterministicCount: walletInfo.DeterministicCount,
+		DeterministicIndex: walletInfo.DeterministicIndex,
+	})
+}
+
+func (hc *HttpController) HandleWalletContains(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var request requests.WalletContainsRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Wallet == "" || request.Action == "" || request.Account == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Validate account
+	_, err := utils.AddressToPub(request.Account, hc.Wallet.Banano)
+	if err != nil {
+		ErrInvalidAccount(w, r)
+		return
+	}
+
+	// See if account exists
+	exists, err := hc.Wallet.AccountExists(dbWallet, request.Account)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	var resp responses.WalletContainsResponse
+	if exists {
+		resp = responses.WalletContainsResponse{
+			Exists: "1",
+		}
+	} else {
+		resp = responses.WalletContainsResponse{
+			Exists: "0",
+		}
+	}
+
+	// Return balances
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+func (hc *HttpController) HandleWalletRepresentativeSetRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var changeRequest requests.WalletRepresentativeSetRequest
+	if err := mapstructure.Decode(rawRequest, &changeRequest); err != nil {
+		log.Errorf("Error unmarshalling receive request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if changeRequest.Wallet == "" || changeRequest.Action == "" || changeRequest.Representative == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(changeRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Validate account
+	_, err := utils.AddressToPub(changeRequest.Representative, hc.Wallet.Config.Wallet.Banano)
+	if err != nil {
+		ErrBadRequest(w, r, ErrorCodeInvalidRepresentative, "Invalid representative account")
+		return
+	}
+
+	// Parse as bool
+	updateExisting := false
+	if changeRequest.UpdateExistingAccounts != nil {
+		updateExisting, err = utils.ToBool(*changeRequest.UpdateExistingAccounts)
+	}
+	// Only changing the accounts signs anything
+	if updateExisting && !hc.WalletNotFrozen(dbWallet, w, r) {
+		return
+	}
+
+	changes, err := hc.Wallet.WalletRepresentativeSet(dbWallet, changeRequest.Representative, updateExisting, changeRequest.BpowKey)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	}
+	setResponse := responses.WalletRepresentativeSetResponse{
+		Set: "1",
+	}
+	if err != nil {
+		setResponse.Set = "0"
+	}
+	// Every account that already existed, changed or not
+	if changes != nil {
+		setResponse.AccountsRepresentativeSetResponse = &responses.AccountsRepresentativeSetResponse{
+			Changed: []responses.RepresentativeChange{},
+			Skipped: changes.Skipped,
+			Failed:  changes.Failed,
+		}
+		for _, change := range changes.Changed {
+			hc.forgetRepresentatives(change.Account)
+			setResponse.Changed = append(setResponse.Changed, responses.RepresentativeChange{
+				Account:   change.Account,
+				BlockHash: change.BlockHash,
+			})
+		}
+	}
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, setResponse)
+}
+
+func (hc *HttpController) HandleWalletRepresentativeRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// See if wallet locked
+	_, err := wallet.GetDecryptedKeyFromStorage(dbWallet, "seed")
+	if errors.Is(err, wallet.ErrWalletLocked) || errors.Is(err, wallet.ErrInvalidWallet) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	var representative string
+	if dbWallet.Representative == nil {
+		// Just get a random one since we don't have one set
+		representative, err = hc.Wallet.Config.GetRandomRep()
+		if err != nil {
+			ErrInternalServerError(w, r, err.Error())
+			return
+		}
+	} else {
+		representative = *dbWallet.Representative
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.RepresentativeResponse{
+		Representative: representative,
+	})
+}
+
+// Handle wallet_representative_history, the representative changes published for the wallet's accounts
+func (hc *HttpController) HandleWalletRepresentativeHistoryRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var historyRequest requests.WalletRepresentativeHistoryRequest
+	if err := mapstructure.Decode(rawRequest, &historyRequest); err != nil {
+		log.Errorf("Error unmarshalling wallet_representative_history request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if historyRequest.Wallet == "" || historyRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	var startDate, endDate *time.Time
+	if historyRequest.StartDate != nil {
+		parsed, err := parseHistoryDate(*historyRequest.StartDate)
+		if err != nil {
+			ErrBadRequest(w, r, ErrorCodeInvalidDate, "Invalid start_date")
+			return
+		}
+		startDate = &parsed
+	}
+	if historyRequest.EndDate != nil {
+		parsed, err := parseHistoryDate(*historyRequest.EndDate)
+		if err != nil {
+			ErrBadRequest(w, r, ErrorCodeInvalidDate, "Invalid end_date")
+			return
+		}
+		endDate = &parsed
+	}
+
+	// Validate account
+	if historyRequest.Account != "" {
+		if _, err := utils.AddressToPub(historyRequest.Account, hc.Wallet.Config.Wallet.Banano); err != nil {
+			ErrInvalidAccount(w, r)
+			return
+		}
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(historyRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	history, err := hc.Wallet.WalletRepresentativeHistory(dbWallet, historyRequest.Account, startDate, endDate)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	} else if errors.Is(err, wallet.ErrInvalidDateRange) {
+		ErrBadRequest(w, r, ErrorCodeInvalidDateRange, "end_date must be after start_date")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	resp := responses.WalletRepresentativeHistoryResponse{
+		History: []responses.RepresentativeHistoryEntry{},
+	}
+	for _, entry := range history {
+		resp.History = append(resp.History, responses.RepresentativeHistoryEntry{
+			Account:           entry.Account,
+			OldRepresentative: entry.OldRepresentative,
+			NewRepresentative: entry.NewRepresentative,
+			BlockHash:         entry.BlockHash,
+			ChangedAt:         entry.ChangedAt.Unix(),
+		})
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+func (hc *HttpController) HandleWalletChangeSeedRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var changeRequest requests.WalletChangeSeedRequest
+	if err := mapstructure.Decode(rawRequest, &changeRequest); err != nil {
+		log.Errorf("Error unmarshalling change seed request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if changeRequest.Wallet == "" || changeRequest.Action == "" || changeRequest.Seed == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	scan, gapLimit, err := hc.parseRestoreScan(changeRequest.Scan, changeRequest.GapLimit)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// The seed itself is never audited
+	auditDetails := map[string]string{
+		"remote_addr": r.RemoteAddr,
+	}
+	defer func() {
+		hc.audit(r.Context(), "wallet_change_seed", changeRequest.Wallet, auditDetails)
+	}()
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(changeRequest.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	// Change the seed, with the accounts of the new one found on chain
+	var newest *ent.Account
+	if scan {
+		var created []*ent.Account
+		newest, created, err = hc.Wallet.WalletChangeSeedScanned(dbWallet, changeRequest.Seed, gapLimit)
+		auditDetails["scanned_accounts_created"] = fmt.Sprint(len(created))
+	} else {
+		newest, err = hc.Wallet.WalletChangeSeed(dbWallet, changeRequest.Seed)
+	}
+	if err != nil {
+		auditDetails["error"] = err.Error()
+	}
+	if errors.Is(err, wallet.ErrWalletLocked) || errors.Is(err, wallet.ErrInvalidWallet) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrInvalidSeed) {
+		ErrBadRequest(w, r, ErrorCodeInvalidSeed, err.Error())
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.WalletChangeSeedResponse{
+		Success:             "",
+		LastRestoredAccount: newest.Address,
+		RestoredCount:       *newest.AccountIndex + 1,
+	})
+}
+
+// Return the seed of a wallet, decrypted if the wallet is encrypted
+// Only served by the admin gateway, every call is logged whether it works or not
+func (hc *HttpController) HandleWalletSeed(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	log.Warnf("wallet_seed requested for wallet %v from %s", (*rawRequest)["wallet"], r.RemoteAddr)
+	auditWallet, _ := (*rawRequest)["wallet"].(string)
+	hc.audit(r.Context(), "wallet_seed", auditWallet, map[string]string{
+		"remote_addr": r.RemoteAddr,
+	})
+
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	seed, err := wallet.GetDecryptedKeyFromStorage(dbWallet, "seed")
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	// Watch-only and hardware wallets don't have a seed
+	resp := responses.WalletSeedResponse{}
+	if seed != "" {
+		resp.Seed = &seed
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &resp)
+}
+
+// Handle wallet_backup_create, the whole wallet encrypted with passphrase, see wallet.PippinBackup for the format
+// Like wallet_seed it has the wallet's keys so every call is logged
+func (hc *HttpController) HandleWalletBackupCreate(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	log.Warnf("wallet_backup_create requested for wallet %v from %s", (*rawRequest)["wallet"], r.RemoteAddr)
+	auditWallet, _ := (*rawRequest)["wallet"].(string)
+	hc.audit(r.Context(), "wallet_backup_create", auditWallet, map[string]string{
+		"remote_addr": r.RemoteAddr,
+	})
+
+	var request requests.WalletBackupCreateRequest
+	if err := mapstructure.Decode(rawRequest, &request); err != nil {
+		log.Errorf("Error unmarshalling wallet_backup_create request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if request.Wallet == "" || request.Action == "" || request.Passphrase == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	backup, err := hc.Wallet.WalletBackupCreate(dbWallet, request.Passphrase)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.WalletBackupCreateResponse{Backup: json.RawMessage(backup)})
+}
+
+func walletFreezeResponse(dbWallet *ent.Wallet) responses.WalletFreezeResponse {
+	resp := responses.WalletFreezeResponse{Frozen: dbWallet.FrozenAt != nil}
+	if dbWallet.FrozenAt != nil {
+		frozenAt := dbWallet.FrozenAt.UTC().Format(time.RFC3339)
+		resp.FrozenAt = &frozenAt
+	}
+	return resp
+}
+
+// Handle wallet_freeze, nothing can be signed for the wallet until wallet_unfreeze
+func (hc *HttpController) HandleWalletFreeze(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	frozen, err := hc.Wallet.WalletFreeze(dbWallet)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+	log.Warnf("Wallet %s frozen from %s", request.Wallet, r.RemoteAddr)
+	hc.audit(r.Context(), "wallet_freeze", request.Wallet, map[string]string{
+		"remote_addr": r.RemoteAddr,
+	})
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, walletFreezeResponse(frozen))
+}
+
+// Handle wallet_unfreeze
+func (hc *HttpController) HandleWalletUnfreeze(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	unfrozen, err := hc.Wallet.WalletUnfreeze(dbWallet)
+	if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
 	}
+	log.Warnf("Wallet %s unfrozen from %s", request.Wallet, r.RemoteAddr)
+	hc.audit(r.Context(), "wallet_unfreeze", request.Wallet, map[string]string{
+		"remote_addr": r.RemoteAddr,
+	})
 
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, &resp)
+	render.JSON(w, r, walletFreezeResponse(unfrozen))
 }
 
-func (hc *HttpController) HandleWalletBalances(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+// Handle wallet_verify
+// Works while the wallet is locked, then only the address formats are checked
+func (hc *HttpController) HandleWalletVerify(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
 	request := hc.DecodeBaseRequest(rawRequest, w, r)
 	if request == nil {
 		return
@@ -205,57 +1439,106 @@ func (hc *HttpController) HandleWalletBalances(rawRequest *map[string]interface{
 		return
 	}
 
-	// Get accounts on wallet
-	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
+	verification, err := hc.Wallet.WalletVerify(dbWallet)
 	if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
 
-	// Get RPC balances
-	resp, err := hc.RpcClient.MakeAccountsBalancesRequest(accounts)
-	if err != nil {
-		ErrInternalServerError(w, r, err.Error())
-		return
+	resp := responses.WalletVerifyResponse{
+		Mismatches: []responses.AccountMismatch{},
+		Locked:     verification.Locked,
+	}
+	for _, mismatch := range verification.Mismatches {
+		resp.Mismatches = append(resp.Mismatches, responses.AccountMismatch{
+			Account:  mismatch.Account,
+			Reason:   mismatch.Reason,
+			Expected: mismatch.Expected,
+		})
 	}
 
-	// Return balances
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, resp)
+	render.JSON(w, r, &resp)
 }
 
-func (hc *HttpController) HandleWalletFrontiers(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
-	request := hc.DecodeBaseRequest(rawRequest, w, r)
-	if request == nil {
+// Handle wallet_reconcile
+// Only reads, so it works while the wallet is locked
+func (hc *HttpController) HandleWalletReconcile(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var reconcileRequest requests.WalletReconcileRequest
+	if err := mapstructure.Decode(rawRequest, &reconcileRequest); err != nil {
+		log.Errorf("Error unmarshalling wallet_reconcile request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if reconcileRequest.Wallet == "" || reconcileRequest.Action == "" {
+		ErrUnableToParseJson(w, r)
 		return
 	}
+	var since *time.Time
+	if reconcileRequest.StartDate != nil {
+		parsed, err := parseHistoryDate(*reconcileRequest.StartDate)
+		if err != nil {
+			ErrBadRequest(w, r, ErrorCodeInvalidDate, "Invalid start_date")
+			return
+		}
+		since = &parsed
+	}
 
 	// See if wallet exists
-	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	dbWallet := hc.WalletExists(reconcileRequest.Wallet, w, r)
 	if dbWallet == nil {
 		return
 	}
 
-	// Get accounts on wallet
-	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
-	if err != nil {
-		ErrInternalServerError(w, r, err.Error())
-		return
-	}
-
-	// Get RPC balances
-	resp, err := hc.RpcClient.MakeAccountsFrontiersRequest(accounts)
+	reconciliation, err := hc.walletFor(r).WalletReconcile(dbWallet, since)
 	if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
 
-	// Return balances
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, resp)
+	render.JSON(w, r, WalletReconcileResponse(reconciliation))
 }
 
-func (hc *HttpController) HandleWalletPending(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+// The wallet_reconcile response for a reconciliation, pippin wallet --reconcile prints it too
+func WalletReconcileResponse(reconciliation *walletmodels.WalletReconciliation) *responses.WalletReconcileResponse {
+	resp := responses.WalletReconcileResponse{
+		AccountsChecked:   reconciliation.AccountsChecked,
+		SendsChecked:      reconciliation.SendsChecked,
+		Unpocketed:        []responses.UnpocketedAccount{},
+		Forks:             []responses.ReconcileFork{},
+		MissingSends:      []responses.MissingSend{},
+		BalanceMismatches: []responses.BalanceMismatch{},
+	}
+	for _, unpocketed := range reconciliation.Unpocketed {
+		resp.Unpocketed = append(resp.Unpocketed, responses.UnpocketedAccount{Account: unpocketed.Account, Blocks: unpocketed.Blocks})
+	}
+	for _, fork := range reconciliation.Forks {
+		resp.Forks = append(resp.Forks, responses.ReconcileFork{Account: fork.Account, SendID: fork.SendID, Block: fork.Block, NodeBlock: fork.NodeBlock})
+	}
+	for _, missing := range reconciliation.MissingSends {
+		resp.MissingSends = append(resp.MissingSends, responses.MissingSend{
+			Account:     missing.Account,
+			SendID:      missing.SendID,
+			Block:       missing.Block,
+			Destination: missing.Destination,
+			AmountRaw:   missing.AmountRaw,
+			Reason:      missing.Reason,
+		})
+	}
+	for _, mismatch := range reconciliation.BalanceMismatches {
+		resp.BalanceMismatches = append(resp.BalanceMismatches, responses.BalanceMismatch{
+			Account:        mismatch.Account,
+			Frontier:       mismatch.Frontier,
+			BalanceRaw:     mismatch.BalanceRaw,
+			NodeBalanceRaw: mismatch.NodeBalanceRaw,
+		})
+	}
+	return &resp
+}
+
+// Handle wallet_accounts_reindex
+// For accounts from before account_index was stored, nothing is removed, accounts we can't match are only returned
+func (hc *HttpController) HandleWalletAccountsReindex(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
 	request := hc.DecodeBaseRequest(rawRequest, w, r)
 	if request == nil {
 		return
@@ -267,254 +1550,345 @@ func (hc *HttpController) HandleWalletPending(rawRequest *map[string]interface{}
 		return
 	}
 
-	// Get accounts on wallet
-	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
-	if err != nil {
-		ErrInternalServerError(w, r, err.Error())
+	reindex, err := hc.Wallet.WalletAccountsReindex(dbWallet)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
 		return
-	}
-
-	// Get RPC balances
-	resp, err := hc.RpcClient.MakeAccountsPendingRequest(accounts)
-	if err != nil {
+	} else if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
 
-	// Return balances
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, resp)
+	render.JSON(w, r, &responses.WalletAccountsReindexResponse{
+		Updated:   reindex.Updated,
+		Unmatched: reindex.Unmatched,
+	})
 }
 
-func (hc *HttpController) HandleWalletInfo(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
-	request := hc.DecodeBaseRequest(rawRequest, w, r)
-	if request == nil {
+// Handle wallet_statistics
+func (hc *HttpController) HandleWalletStatistics(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var statisticsRequest requests.WalletStatisticsRequest
+	if err := mapstructure.Decode(rawRequest, &statisticsRequest); err != nil {
+		log.Errorf("Error unmarshalling wallet_statistics request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if statisticsRequest.Wallet == "" || statisticsRequest.Action == "" || statisticsRequest.Period == "" {
+		ErrUnableToParseJson(w, r)
 		return
 	}
 
 	// See if wallet exists
-	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	dbWallet := hc.WalletExists(statisticsRequest.Wallet, w, r)
 	if dbWallet == nil {
 		return
 	}
 
-	// Get accounts on wallet
-	_, accounts, err := hc.Wallet.AccountsList(dbWallet, math.MaxInt)
-	if err != nil {
+	resp, err := hc.walletStatistics(dbWallet, statisticsRequest.Period)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrInvalidPeriod) {
+		ErrBadRequest(w, r, ErrorCodeInvalidPeriod, "Invalid period, must be day, week or month")
+		return
+	} else if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
 
-	// Get RPC balances
-	resp, err := hc.RpcClient.MakeAccountsBalancesRequest(accounts)
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, resp)
+}
+
+// The statistics of a wallet over period, cached for wallet_statistics_cache_ttl seconds
+func (hc *HttpController) walletStatistics(dbWallet *ent.Wallet, period string) (*responses.WalletStatisticsResponse, error) {
+	// Not even cached statistics are returned for a locked wallet
+	if _, err := wallet.GetDecryptedKeyFromStorage(dbWallet, "seed"); err != nil {
+		return nil, err
+	}
+	cacheKey := fmt.Sprintf("wallet_statistics:%s:%s", dbWallet.ID, period)
+	if cached, err := hc.Cache.Get(cacheKey); err == nil {
+		var resp responses.WalletStatisticsResponse
+		if err := json.Unmarshal(cached, &resp); err == nil {
+			return &resp, nil
+		}
+	}
+
+	stats, err := hc.Wallet.WalletStatistics(dbWallet, period, time.Now())
 	if err != nil {
-		ErrInternalServerError(w, r, err.Error())
-		return
+		return nil, err
+	}
+	resp := &responses.WalletStatisticsResponse{
+		Period:      period,
+		Since:       stats.Since.Unix(),
+		Confirmed:   blockStatistics(stats.Confirmed),
+		Unconfirmed: blockStatistics(stats.Unconfirmed),
 	}
 
-	balance := big.NewInt(0)
-	pendingBalance := big.NewInt(0)
+	if encoded, err := json.Marshal(resp); err == nil {
+		if err := hc.Cache.Set(cacheKey, encoded, time.Duration(hc.Wallet.Config.Server.WalletStatisticsCacheTTL)*time.Second); err != nil {
+			log.Errorf("Error caching wallet_statistics %s", err)
+		}
+	}
+	return resp, nil
+}
 
-	// for k, v in balance_json['balances'].items():
-	// balance += int(v['balance'])
-	// pending_bal += int(v['pending'])
-	for _, v := range *resp.Balances {
-		// Balance to bigint
-		balanceBigInt, ok := new(big.Int).SetString(v.Balance, 10)
+func blockStatistics(stats walletmodels.BlockStatistics) responses.BlockStatistics {
+	resp := responses.BlockStatistics{
+		Sends: responses.BlockTotals{
+			Count:    stats.SendCount,
+			TotalRaw: stats.SendTotal.String(),
+		},
+		Receives: responses.BlockTotals{
+			Count:    stats.ReceiveCount,
+			TotalRaw: stats.ReceiveTotal.String(),
+		},
+		UniqueCounterparties: stats.UniqueCounterparties,
+	}
+	if stats.LargestSend != nil {
+		resp.LargestSend = &responses.LargestSend{
+			Hash:      stats.LargestSend.Hash,
+			Account:   stats.LargestSend.Account,
+			AmountRaw: stats.LargestSend.Amount.String(),
+		}
+	}
+	return resp
+}
+
+// Sum the balances and receivable amounts of an accounts_balances response
+func sumBalances(balances *rpcresponses.AccountsBalancesResponse) (*big.Int, *big.Int, error) {
+	balanceSum := big.NewInt(0)
+	pendingSum := big.NewInt(0)
+	for _, item := range *balances.Balances {
+		balance, ok := big.NewInt(0).SetString(item.Balance, 10)
 		if !ok {
-			ErrInternalServerError(w, r, "Could not parse balance")
-			return
+			return nil, nil, errors.New("Unable to parse balance")
 		}
-		balance = balance.Add(balance, balanceBigInt)
-		pendingBigInt, ok := new(big.Int).SetString(v.Pending, 10)
+		balanceSum.Add(balanceSum, balance)
+		// Accounts that haven't received anything yet might not have a receivable entry
+		if item.Receivable == "" {
+			continue
+		}
+		receivable, ok := big.NewInt(0).SetString(item.Receivable, 10)
 		if !ok {
-			ErrInternalServerError(w, r, "Could not parse balance")
-			return
+			return nil, nil, errors.New("Unable to parse receivable")
 		}
-		pendingBalance = pendingBalance.Add(pendingBalance, pendingBigInt)
+		pendingSum.Add(pendingSum, receivable)
 	}
+	return balanceSum, pendingSum, nil
+}
 
-	// Retrieve wallet info from database
-	walletInfo, err := hc.Wallet.WalletInfo(dbWallet)
-	if err != nil {
-		ErrInternalServerError(w, r, err.Error())
+func (hc *HttpController) receiveMinimumResponse(dbWallet *ent.Wallet) responses.ReceiveMinimumResponse {
+	resp := responses.ReceiveMinimumResponse{
+		Amount:      hc.Wallet.ReceiveMinimum(dbWallet).String(),
+		AutoReceive: "0",
+	}
+	if dbWallet.AutoReceive {
+		resp.AutoReceive = "1"
+	}
+	return resp
+}
+
+// Handle receive_minimum, the smallest amount auto received for the wallet
+func (hc *HttpController) HandleReceiveMinimum(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
 		return
 	}
 
-	// Return balances
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, responses.WalletInfoResponse{
-		Balance:            balance.String(),
-		Pending:            pendingBalance.String(),
-		Receivable:         pendingBalance.String(),
-		AccountsCount:      walletInfo.AccountsCount,
-		AdhocCount:         walletInfo.AdhocCount,
-		DeterministicCount: walletInfo.DeterministicCount,
-		DeterministicIndex: walletInfo.DeterministicIndex,
-	})
+	render.JSON(w, r, hc.receiveMinimumResponse(dbWallet))
 }
 
-func (hc *HttpController) HandleWalletContains(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
-	var request requests.WalletContainsRequest
-	if err := mapstructure.Decode(rawRequest, &request); err != nil {
-		log.Errorf("Error unmarshalling request %s", err)
+// Handle receive_minimum_set, the wallet's own receive minimum instead of the config's
+func (hc *HttpController) HandleReceiveMinimumSet(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var setRequest requests.ReceiveMinimumSetRequest
+	if err := mapstructure.Decode(rawRequest, &setRequest); err != nil {
+		log.Errorf("Error unmarshalling receive_minimum_set request %s", err)
 		ErrUnableToParseJson(w, r)
 		return
-	} else if request.Wallet == "" || request.Action == "" || request.Account == "" {
+	} else if setRequest.Wallet == "" || setRequest.Action == "" || setRequest.Amount == "" {
 		ErrUnableToParseJson(w, r)
 		return
 	}
 
 	// See if wallet exists
-	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	dbWallet := hc.WalletExists(setRequest.Wallet, w, r)
 	if dbWallet == nil {
 		return
 	}
 
-	// Validate account
-	_, err := utils.AddressToPub(request.Account, hc.Wallet.Banano)
-	if err != nil {
-		ErrInvalidAccount(w, r)
+	updated, err := hc.Wallet.SetReceiveMinimum(dbWallet, setRequest.Amount)
+	if errors.Is(err, wallet.ErrInvalidReceiveMinimum) {
+		ErrBadRequest(w, r, ErrorCodeInvalidAmount, "Invalid amount, must be between 1 and the max supply in raw")
 		return
-	}
-
-	// See if account exists
-	exists, err := hc.Wallet.AccountExists(dbWallet, request.Account)
-	if err != nil {
+	} else if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
 
-	var resp responses.WalletContainsResponse
-	if exists {
-		resp = responses.WalletContainsResponse{
-			Exists: "1",
-		}
-	} else {
-		resp = responses.WalletContainsResponse{
-			Exists: "0",
-		}
-	}
-
-	// Return balances
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, resp)
+	render.JSON(w, r, hc.receiveMinimumResponse(updated))
 }
 
-func (hc *HttpController) HandleWalletRepresentativeSetRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
-	var changeRequest requests.WalletRepresentativeSetRequest
-	if err := mapstructure.Decode(rawRequest, &changeRequest); err != nil {
-		log.Errorf("Error unmarshalling receive request %s", err)
+// Handle wallet_auto_receive_set, turn receiving the wallet's pending blocks in the background on or off
+func (hc *HttpController) HandleWalletAutoReceiveSet(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var setRequest requests.WalletAutoReceiveSetRequest
+	if err := mapstructure.Decode(rawRequest, &setRequest); err != nil {
+		log.Errorf("Error unmarshalling wallet_auto_receive_set request %s", err)
 		ErrUnableToParseJson(w, r)
 		return
-	} else if changeRequest.Wallet == "" || changeRequest.Action == "" || changeRequest.Representative == "" {
+	} else if setRequest.Wallet == "" || setRequest.Action == "" || setRequest.Enabled == nil || *setRequest.Enabled == nil {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+	enabled, err := utils.ToBool(*setRequest.Enabled)
+	if err != nil {
 		ErrUnableToParseJson(w, r)
 		return
 	}
 
 	// See if wallet exists
-	dbWallet := hc.WalletExists(changeRequest.Wallet, w, r)
+	dbWallet := hc.WalletExists(setRequest.Wallet, w, r)
 	if dbWallet == nil {
 		return
 	}
 
-	// Validate account
-	_, err := utils.AddressToPub(changeRequest.Representative, hc.Wallet.Config.Wallet.Banano)
+	updated, err := hc.Wallet.SetAutoReceive(dbWallet, enabled)
 	if err != nil {
-		ErrBadRequest(w, r, "Invalid representative account")
+		ErrInternalServerError(w, r, err.Error())
 		return
 	}
 
-	// Parse as bool
-	updateExisting := false
-	if changeRequest.UpdateExistingAccounts != nil {
-		updateExisting, err = utils.ToBool(*changeRequest.UpdateExistingAccounts)
-	}
-
-	err = hc.Wallet.WalletRepresentativeSet(dbWallet, changeRequest.Representative, updateExisting, changeRequest.BpowKey)
-	setResponse := responses.SetResponse{
-		Set: "1",
-	}
-	if err != nil {
-		setResponse.Set = "0"
-	}
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, setResponse)
+	render.JSON(w, r, hc.receiveMinimumResponse(updated))
 }
 
-func (hc *HttpController) HandleWalletRepresentativeRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
-	request := hc.DecodeBaseRequest(rawRequest, w, r)
-	if request == nil {
+// Handle wallet_name_set, name a wallet for wallet_list
+func (hc *HttpController) HandleWalletNameSet(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var setRequest requests.WalletNameSetRequest
+	if err := mapstructure.Decode(rawRequest, &setRequest); err != nil {
+		log.Errorf("Error unmarshalling wallet_name_set request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if setRequest.Wallet == "" || setRequest.Action == "" || setRequest.Name == nil {
+		ErrUnableToParseJson(w, r)
 		return
 	}
 
 	// See if wallet exists
-	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	dbWallet := hc.WalletExists(setRequest.Wallet, w, r)
 	if dbWallet == nil {
 		return
 	}
 
-	// See if wallet locked
-	_, err := wallet.GetDecryptedKeyFromStorage(dbWallet, "seed")
-	if errors.Is(err, wallet.ErrWalletLocked) || errors.Is(err, wallet.ErrInvalidWallet) {
-		ErrWalletLocked(w, r)
+	updated, err := hc.Wallet.WalletNameSet(dbWallet, *setRequest.Name)
+	if errors.Is(err, wallet.ErrInvalidWalletName) {
+		ErrBadRequest(w, r, ErrorCodeInvalidName, "Invalid name, must be at most 128 characters")
 		return
 	} else if err != nil {
 		ErrInternalServerError(w, r, err.Error())
 		return
 	}
 
-	var representative string
-	if dbWallet.Representative == nil {
-		// Just get a random one since we don't have one set
-		representative, err = hc.Wallet.Config.GetRandomRep()
-		if err != nil {
-			ErrInternalServerError(w, r, err.Error())
-			return
-		}
-	} else {
-		representative = *dbWallet.Representative
-	}
-
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, &responses.RepresentativeResponse{
-		Representative: representative,
+	render.JSON(w, r, &responses.WalletNameSetResponse{
+		Wallet: updated.ID.String(),
+		Name:   updated.Name,
 	})
 }
 
-func (hc *HttpController) HandleWalletChangeSeedRequest(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
-	var changeRequest requests.WalletChangeSeedRequest
-	if err := mapstructure.Decode(rawRequest, &changeRequest); err != nil {
-		log.Errorf("Error unmarshalling change seed request %s", err)
+// Most blocks wallet_history returns at once, and the default count
+const walletHistoryMaxCount = 1000
+
+// Handle wallet_history, the account_history of every account in the wallet merged newest first
+// Every account's chain is read at most account_history_since_max_depth blocks back
+func (hc *HttpController) HandleWalletHistory(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var historyRequest requests.WalletHistoryRequest
+	if err := mapstructure.Decode(rawRequest, &historyRequest); err != nil {
+		log.Errorf("Error unmarshalling wallet_history request %s", err)
 		ErrUnableToParseJson(w, r)
 		return
-	} else if changeRequest.Wallet == "" || changeRequest.Action == "" || changeRequest.Seed == "" {
+	} else if historyRequest.Wallet == "" || historyRequest.Action == "" {
 		ErrUnableToParseJson(w, r)
 		return
 	}
 
+	offset := 0
+	count := walletHistoryMaxCount
+	var err error
+	if historyRequest.Offset != nil {
+		offset, err = utils.ToInt(*historyRequest.Offset)
+		if err != nil || offset < 0 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+	}
+	if historyRequest.Count != nil {
+		count, err = utils.ToInt(*historyRequest.Count)
+		if err != nil || count < 1 {
+			ErrUnableToParseJson(w, r)
+			return
+		}
+		count = min(count, walletHistoryMaxCount)
+	}
+
+	// Validate account and head
+	if historyRequest.Account != nil {
+		if _, err := utils.AddressToPub(*historyRequest.Account, hc.Wallet.Config.Wallet.Banano); err != nil {
+			ErrInvalidAccount(w, r)
+			return
+		}
+	}
+	if historyRequest.Head != nil && !utils.Validate64HexHash(*historyRequest.Head) {
+		ErrInvalidHash(w, r)
+		return
+	}
+
 	// See if wallet exists
-	dbWallet := hc.WalletExists(changeRequest.Wallet, w, r)
+	dbWallet := hc.WalletExists(historyRequest.Wallet, w, r)
 	if dbWallet == nil {
 		return
 	}
 
-	// Change the seed
-	newest, err := hc.Wallet.WalletChangeSeed(dbWallet, changeRequest.Seed)
-	if errors.Is(err, wallet.ErrWalletLocked) || errors.Is(err, wallet.ErrInvalidWallet) {
+	history, err := hc.Wallet.WalletHistory(dbWallet, wallet.WalletHistoryFilter{
+		Account:   historyRequest.Account,
+		Direction: historyRequest.Direction,
+		Head:      historyRequest.Head,
+	}, offset, count, max(hc.Wallet.Config.Server.AccountHistorySinceMaxDepth, 1))
+	if errors.Is(err, wallet.ErrWalletLocked) {
 		ErrWalletLocked(w, r)
 		return
-	} else if errors.Is(err, wallet.ErrInvalidSeed) {
-		ErrBadRequest(w, r, err.Error())
+	} else if errors.Is(err, wallet.ErrInvalidDirection) {
+		ErrBadRequest(w, r, ErrorCodeInvalidDirection, "Invalid direction, must be send or receive")
+		return
+	} else if errors.Is(err, wallet.ErrAccountNotFound) {
+		ErrBadRequest(w, r, ErrorCodeAccountNotFound, "Account not found")
+		return
+	} else if errors.Is(err, wallet.ErrBlockNotFound) {
+		ErrBadRequest(w, r, ErrorCodeBlockNotFound, "Block not found")
+		return
 	} else if err != nil {
-		ErrInternalServerError(w, r, err.Error())
+		ErrInternalServerError(w, r, "Error making account_history request to node")
 		return
 	}
 
+	resp := responses.WalletHistoryResponse{
+		History: make([]responses.WalletHistoryEntry, len(history)),
+	}
+	for i, entry := range history {
+		resp.History[i] = responses.WalletHistoryEntry{
+			BlockAccount:        entry.BlockAccount,
+			AccountHistoryEntry: entry.AccountHistoryEntry,
+		}
+	}
+
 	render.Status(r, http.StatusOK)
-	render.JSON(w, r, &responses.WalletChangeSeedResponse{
-		Success:             "",
-		LastRestoredAccount: newest.Address,
-		RestoredCount:       *newest.AccountIndex + 1,
-	})
+	render.JSON(w, r, &resp)
 }
@@ -2,15 +2,22 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/cache"
+	"github.com/appditto/pippin_nano_wallet/libs/price"
 	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	rpcreq "github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
 	rpcresp "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/google/uuid"
@@ -63,7 +70,7 @@ func TestWalletCreateWithInvalidSeed(t *testing.T) {
 	respBody, _ := io.ReadAll(resp.Body)
 	json.Unmarshal(respBody, &respJson)
 
-	assert.Equal(t, "Invalid seed", respJson["error"])
+	assert.Equal(t, "INVALID_SEED", respJson["error_code"])
 }
 
 func TestWalletCreateWithValidSeed(t *testing.T) {
@@ -92,6 +99,180 @@ func TestWalletCreateWithValidSeed(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWalletCreateReturnSeed(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("7e2b9d4f1a6c3e8b0d5f2a7c9e4b1d6f3a8c0e5b2d7f9a4c1e6b3d8f0a5c2e97"))
+	// Request JSON
+	reqBody := map[string]interface{}{
+		"action":      "wallet_create",
+		"seed":        seed,
+		"return_seed": true,
+	}
+	body, _ := json.Marshal(reqBody)
+	w := httptest.NewRecorder()
+	// Build request
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var respJson map[string]interface{}
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+
+	_, err := uuid.Parse(respJson["wallet"].(string))
+	assert.Nil(t, err)
+	assert.Equal(t, seed, respJson["seed"])
+
+	// The seed is only returned once
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	respJson = map[string]interface{}{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+
+	assert.Equal(t, "SEED_UNAVAILABLE", respJson["error_code"])
+	assert.NotContains(t, respJson, "seed")
+
+	// Not returned unless requested
+	reqBody = map[string]interface{}{
+		"action": "wallet_create",
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	respJson = map[string]interface{}{}
+	respBody, _ = io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+	assert.NotContains(t, respJson, "seed")
+}
+
+func TestWalletList(t *testing.T) {
+	hc := newTestController(t)
+	var created []string
+	for i := 0; i < 5; i++ {
+		seed, _ := utils.GenerateSeed(nil)
+		wallet, err := hc.Wallet.WalletCreate(seed)
+		assert.Nil(t, err)
+		created = append(created, wallet.ID.String())
+	}
+	doAdmin := func(reqBody map[string]interface{}) (int, []byte) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		hc.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Named with wallet_name_set
+	status, _ := doRequest(map[string]interface{}{"action": "wallet_name_set", "wallet": created[1], "name": "payroll"})
+	assert.Equal(t, 200, status)
+
+	status, respBody := doAdmin(map[string]interface{}{
+		"action": "wallet_list",
+		"offset": 1,
+		"limit":  3,
+	})
+	assert.Equal(t, 200, status)
+	var respJson responses.WalletListResponse
+	json.Unmarshal(respBody, &respJson)
+
+	// Returned in creation order, and never includes the seed
+	assert.Len(t, respJson.Wallets, 3)
+	for i, item := range respJson.Wallets {
+		assert.Equal(t, created[i+1], item.WalletID)
+		assert.Equal(t, 1, item.AccountCount)
+	}
+	assert.Equal(t, "payroll", *respJson.Wallets[0].Name)
+	assert.Nil(t, respJson.Wallets[1].Name)
+	assert.NotContains(t, string(respBody), "seed")
+
+	// Limit above the configured max is clamped
+	maxLimit := hc.Wallet.Config.Server.WalletListMaxLimit
+	hc.Wallet.Config.Server.WalletListMaxLimit = 2
+	defer func() { hc.Wallet.Config.Server.WalletListMaxLimit = maxLimit }()
+	status, respBody = doAdmin(map[string]interface{}{
+		"action": "wallet_list",
+		"limit":  50,
+	})
+	assert.Equal(t, 200, status)
+	json.Unmarshal(respBody, &respJson)
+	assert.Len(t, respJson.Wallets, 2)
+	assert.Equal(t, created[0], respJson.Wallets[0].WalletID)
+
+	// Bad offset
+	status, _ = doAdmin(map[string]interface{}{
+		"action": "wallet_list",
+		"offset": -1,
+	})
+	assert.Equal(t, 400, status)
+}
+
+func TestWalletNameSet(t *testing.T) {
+	hc := newTestController(t)
+	seed, _ := utils.GenerateSeed(strings.NewReader("3d6f9b2e5c8a1d4f7b0e3c6a9d2f5b8e1c4a7d0f3b6e9c2a5d8f1b4e7c0a3d6f"))
+	dbWallet, _ := hc.Wallet.WalletCreate(seed)
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doRequest(map[string]interface{}{"action": "wallet_name_set", "wallet": dbWallet.ID.String(), "name": "payroll"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, dbWallet.ID.String(), respJson["wallet"])
+	assert.Equal(t, "payroll", respJson["name"])
+
+	status, respJson = doRequest(map[string]interface{}{"action": "wallet_name_set", "wallet": dbWallet.ID.String(), "name": strings.Repeat("a", 129)})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_NAME", respJson["error_code"])
+
+	// Empty removes it
+	status, respJson = doRequest(map[string]interface{}{"action": "wallet_name_set", "wallet": dbWallet.ID.String(), "name": ""})
+	assert.Equal(t, 200, status)
+	assert.Nil(t, respJson["name"])
+
+	status, _ = doRequest(map[string]interface{}{"action": "wallet_name_set", "wallet": dbWallet.ID.String()})
+	assert.Equal(t, 400, status)
+}
+
 func TestWalletAdd(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("E11A48D701EA1F8A66A4EB587CDC8808D726FE75B325DF204F62CA2B43F9ADA1"))
 	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
@@ -141,7 +322,7 @@ func TestWalletAdd(t *testing.T) {
 
 	// Make sure account is valid
 	assert.Contains(t, respJson, "error")
-	assert.Equal(t, "Invalid key", respJson["error"].(string))
+	assert.Equal(t, "INVALID_KEY", respJson["error_code"].(string))
 }
 
 func TestWalletLocked(t *testing.T) {
@@ -243,56 +424,98 @@ func TestWalletLock(t *testing.T) {
 }
 
 func TestWalletDestroy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	balances := `{"balances":{}}`
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(200, balances), nil
+		},
+	)
+
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("43cededf4d2bacaa096bfe0251519d2adedc31aa1a417073c5a23f30e74b3ed7"))
 	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
 	// lock walet
 	MockController.Wallet.EncryptWallet(wallet, "password")
-	// Request JSON
+
+	doDestroy := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		MockController.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
 	reqBody := map[string]interface{}{
 		"action": "wallet_destroy",
 		"wallet": wallet.ID.String(),
 	}
-	body, _ := json.Marshal(reqBody)
-	w := httptest.NewRecorder()
-	// Build request
-	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
-	req.Header.Set("Content-Type", "application/json")
-	MockController.Gateway(w, req)
-	resp := w.Result()
-	defer resp.Body.Close()
-	assert.Equal(t, 400, resp.StatusCode)
 
-	var respJson map[string]interface{}
-	respBody, _ := io.ReadAll(resp.Body)
-	json.Unmarshal(respBody, &respJson)
-
-	assert.Contains(t, respJson, "error")
-	assert.Equal(t, "wallet locked", respJson["error"].(string))
+	status, respJson := doDestroy(reqBody)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_LOCKED", respJson["error_code"])
 
 	// unlock wallet
 	MockController.Wallet.UnlockWallet(wallet, "password")
-	reqBody = map[string]interface{}{
+	_, accounts, _ := MockController.Wallet.AccountsList(wallet, 0)
+
+	// Refused while an account has a balance or something receivable
+	balances = fmt.Sprintf(`{"balances":{"%s":{"balance":"0","receivable":"1000"}}}`, accounts[0])
+	status, respJson = doDestroy(reqBody)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_HAS_FUNDS", respJson["error_code"])
+	assert.Equal(t, "1000", respJson["balance_raw"])
+
+	balances = fmt.Sprintf(`{"balances":{"%s":{"balance":"5","receivable":"1000"}}}`, accounts[0])
+	status, respJson = doDestroy(reqBody)
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "1005", respJson["balance_raw"])
+
+	_, err := MockController.Wallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+
+	// Invalid force
+	status, _ = doDestroy(map[string]interface{}{
 		"action": "wallet_destroy",
 		"wallet": wallet.ID.String(),
-	}
-	body, _ = json.Marshal(reqBody)
-	w = httptest.NewRecorder()
-	// Build request
-	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
-	req.Header.Set("Content-Type", "application/json")
-	MockController.Gateway(w, req)
-	resp = w.Result()
-	defer resp.Body.Close()
-	assert.Equal(t, 200, resp.StatusCode)
-
-	respBody, _ = io.ReadAll(resp.Body)
-	json.Unmarshal(respBody, &respJson)
+		"force":  "maybe",
+	})
+	assert.Equal(t, 400, status)
 
-	assert.Contains(t, respJson, "destroyed")
-	assert.Equal(t, "1", respJson["destroyed"].(string))
+	// Force destroys it anyway
+	status, respJson = doDestroy(map[string]interface{}{
+		"action": "wallet_destroy",
+		"wallet": wallet.ID.String(),
+		"force":  true,
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["destroyed"])
 
 	// check if wallet is destroyed
-	_, err := MockController.Wallet.GetWallet(wallet.ID.String())
+	_, err = MockController.Wallet.GetWallet(wallet.ID.String())
+	assert.NotNil(t, err)
+
+	// Without funds it doesn't need force
+	newSeed, _ = utils.GenerateSeed(strings.NewReader("7d2a9f4c1e8b5a3d6f0c9e2b7a4d1f8c5e3b0a6d9f2c7e4b1a8d5f3c0e6b9a24"))
+	wallet, _ = MockController.Wallet.WalletCreate(newSeed)
+	_, accounts, _ = MockController.Wallet.AccountsList(wallet, 0)
+	balances = fmt.Sprintf(`{"balances":{"%s":{"balance":"0","receivable":"0"}}}`, accounts[0])
+	status, respJson = doDestroy(map[string]interface{}{
+		"action": "wallet_destroy",
+		"wallet": wallet.ID.String(),
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["destroyed"])
+	_, err = MockController.Wallet.GetWallet(wallet.ID.String())
 	assert.NotNil(t, err)
 }
 
@@ -335,6 +558,168 @@ func TestWalletBalances(t *testing.T) {
 	assert.Equal(t, "0", balances["nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"].Pending)
 	assert.Equal(t, "0", balances["nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"].Receivable)
 }
+
+func TestWalletBalanceTotal(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// Each account gets a different balance
+	balances := []map[string]interface{}{
+		{"balance": "1000000000000000000000000000000", "pending": "0", "receivable": "0"},
+		{"balance": "0", "pending": "2500000000000000000000000000000", "receivable": "2500000000000000000000000000000"},
+		{"balance": "11999999999999999918751838129509869131", "pending": "1", "receivable": "1"},
+	}
+	rpcCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			rpcCalls++
+			var ar rpcreq.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&ar)
+			if ar.Action != "accounts_balances" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"error": "error",
+				})
+			}
+			resp := map[string]interface{}{}
+			for i, account := range ar.Accounts {
+				resp[account] = balances[i%len(balances)]
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"balances": resp,
+			})
+		},
+	)
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("c81f4e7a0d3b6c9f2e5a8d1b4c7f0a3e6d9b2c5f8a1e4d7b0c3f6a9e2d5b8c14"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	hc.Wallet.AccountsCreate(wallet, 2)
+
+	// Request JSON
+	reqBody := map[string]interface{}{
+		"action": "wallet_balance_total",
+		"wallet": wallet.ID.String(),
+	}
+	body, _ := json.Marshal(reqBody)
+	w := httptest.NewRecorder()
+	// Build request
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	hc.Gateway(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var respJson responses.WalletBalanceTotalResponse
+	respBody, _ := io.ReadAll(resp.Body)
+	json.Unmarshal(respBody, &respJson)
+
+	// One RPC call for every account
+	assert.Equal(t, 1, rpcCalls)
+	assert.Equal(t, "12000000999999999918751838129509869131", respJson.BalanceRaw)
+	assert.Equal(t, "2500000000000000000000000000001", respJson.PendingRaw)
+	assert.Equal(t, "12000003499999999918751838129509869132", respJson.TotalRaw)
+	assert.Equal(t, "12000000.999999999918751838129509869131", respJson.Balance)
+	assert.Equal(t, "2.500000000000000000000000000001", respJson.Pending)
+	assert.Equal(t, "12000003.499999999918751838129509869132", respJson.Total)
+}
+
+func TestWalletBalanceTotalWithPrice(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var ar rpcreq.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&ar)
+			resp := map[string]interface{}{}
+			for _, account := range ar.Accounts {
+				resp[account] = map[string]interface{}{"balance": "1000000000000000000000000000000", "pending": "0", "receivable": "1000000000000000000000000000000"}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"balances": resp,
+			})
+		},
+	)
+	priceCalls := 0
+	httpmock.RegisterResponder("GET", "https://price.test/simple/price",
+		func(req *http.Request) (*http.Response, error) {
+			priceCalls++
+			return httpmock.NewStringResponse(200, `{"nano":{"usd":1.5,"eur":1.25}}`), nil
+		},
+	)
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("4e8a1c5f9b2d6e0a3c7f1b4d8e2a5c9f0b3d7e1a4c8f2b5d9e0a3c6f1b4d7e28"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+
+	doTotal := func(reqBody map[string]interface{}) (int, responses.WalletBalanceTotalResponse, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson responses.WalletBalanceTotalResponse
+		var respMap map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		json.Unmarshal(respBody, &respMap)
+		return resp.StatusCode, respJson, respMap
+	}
+
+	// No price client, the fields are left out
+	status, respJson, respMap := doTotal(map[string]interface{}{"action": "wallet_balance_total", "wallet": wallet.ID.String(), "include_price": true})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "2000000000000000000000000000000", respJson.TotalRaw)
+	assert.NotContains(t, respMap, "value_fiat")
+	assert.NotContains(t, respMap, "currency")
+
+	hc.PriceClient = price.NewPriceClient("https://price.test/simple/price", []string{"usd", "eur"}, false, time.Minute)
+
+	// Default currency
+	status, respJson, _ = doTotal(map[string]interface{}{"action": "wallet_balance_total", "wallet": wallet.ID.String(), "include_price": true})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "3.00", *respJson.ValueFiat)
+	assert.Equal(t, "USD", *respJson.Currency)
+
+	// Requested currency, from the cache
+	status, respJson, _ = doTotal(map[string]interface{}{"action": "wallet_balance_total", "wallet": wallet.ID.String(), "include_price": "true", "currency": "EUR"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "2.50", *respJson.ValueFiat)
+	assert.Equal(t, "EUR", *respJson.Currency)
+	assert.Equal(t, 1, priceCalls)
+
+	// Not asked for
+	status, _, respMap = doTotal(map[string]interface{}{"action": "wallet_balance_total", "wallet": wallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.NotContains(t, respMap, "value_fiat")
+
+	// Another unit
+	status, respJson, _ = doTotal(map[string]interface{}{"action": "wallet_balance_total", "wallet": wallet.ID.String(), "unit": "raw"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "2000000000000000000000000000000", respJson.Total)
+	assert.Equal(t, respJson.BalanceRaw, respJson.Balance)
+	assert.Equal(t, "raw", respJson.Unit)
+	status, _, respMap = doTotal(map[string]interface{}{"action": "wallet_balance_total", "wallet": wallet.ID.String(), "unit": "banano"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_UNIT", respMap["error_code"])
+
+	// Unsupported currency
+	status, _, respMap = doTotal(map[string]interface{}{"action": "wallet_balance_total", "wallet": wallet.ID.String(), "include_price": true, "currency": "gbp"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "UNSUPPORTED_CURRENCY", respMap["error_code"])
+
+	// Feed down, the balance is still returned
+	hc.PriceClient = price.NewPriceClient("https://price.test/simple/price", []string{"usd"}, false, time.Minute)
+	httpmock.RegisterResponder("GET", "https://price.test/simple/price", httpmock.NewStringResponder(500, ""))
+	status, respJson, respMap = doTotal(map[string]interface{}{"action": "wallet_balance_total", "wallet": wallet.ID.String(), "include_price": true})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "2000000000000000000000000000000", respJson.TotalRaw)
+	assert.NotContains(t, respMap, "value_fiat")
+}
+
 func TestWalletFrontiers(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -374,6 +759,88 @@ func TestWalletFrontiers(t *testing.T) {
 	assert.Equal(t, "6A32397F4E95AF025DE29D9BF1ACE864D5404362258E06489FABDBA9DCCC046F", frontiers["nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7"])
 }
 
+func TestWalletLedger(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("c41f8a2e6b0d3f7a1c5e9b2d6f0a4c8e2b6d0f4a8c2e6b0d4f8a2c6e0b4d8f2a"))
+	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	accounts, _ := MockController.Wallet.AccountsCreate(wallet, 1)
+	pub, _, _ := utils.KeypairFromSeed(newSeed, 0)
+	opened := utils.PubKeyToAddress(pub, false)
+
+	var infoRequest map[string]interface{}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			json.NewDecoder(req.Body).Decode(&infoRequest)
+			info := map[string]interface{}{
+				"frontier":             "FF84533A571D953A596EA401FD41743AC85D04F406E76FDE4408EAED50B473C5",
+				"open_block":           "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948",
+				"representative_block": "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948",
+				"balance":              "1000",
+				"modified_timestamp":   "1606934662",
+				"block_count":          "22",
+				"weight":               "5",
+				"receivable":           "7",
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"infos":  map[string]interface{}{opened: info},
+				"errors": map[string]string{accounts[0].Address: "Account not found"},
+			})
+		},
+	)
+
+	ledger := func(request map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := ledger(map[string]interface{}{"action": "wallet_ledger", "wallet": wallet.ID.String(), "weight": "true", "receivable": true})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "accounts_info", infoRequest["action"])
+	assert.Equal(t, true, infoRequest["weight"])
+	assert.Equal(t, true, infoRequest["pending"])
+	assert.Nil(t, infoRequest["representative"])
+	ledgerAccounts := respJson["accounts"].(map[string]interface{})
+	assert.Len(t, ledgerAccounts, 1)
+	assert.Equal(t, map[string]interface{}{
+		"frontier":             "FF84533A571D953A596EA401FD41743AC85D04F406E76FDE4408EAED50B473C5",
+		"open_block":           "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948",
+		"representative_block": "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948",
+		"balance":              "1000",
+		"modified_timestamp":   "1606934662",
+		"block_count":          "22",
+		"weight":               "5",
+		"pending":              "7",
+		"receivable":           "7",
+	}, ledgerAccounts[opened])
+
+	// With the account's label
+	label := "user 42"
+	_, err := MockController.Wallet.AccountLabelSet(wallet, opened, &label, nil)
+	assert.Nil(t, err)
+	status, respJson = ledger(map[string]interface{}{"action": "wallet_ledger", "wallet": wallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "user 42", respJson["accounts"].(map[string]interface{})[opened].(map[string]interface{})["label"])
+
+	// Modified before modified_since
+	status, respJson = ledger(map[string]interface{}{"action": "wallet_ledger", "wallet": wallet.ID.String(), "modified_since": "1700000000"})
+	assert.Equal(t, 200, status)
+	assert.Len(t, respJson["accounts"], 0)
+
+	status, _ = ledger(map[string]interface{}{"action": "wallet_ledger", "wallet": wallet.ID.String(), "weight": "yes"})
+	assert.Equal(t, 400, status)
+}
+
 func TestWalletPending(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -504,7 +971,7 @@ func TestWalletContains(t *testing.T) {
 	respBody, _ = io.ReadAll(resp.Body)
 	json.Unmarshal(respBody, &errEsp)
 
-	assert.Equal(t, "Invalid account", errEsp["error"])
+	assert.Equal(t, "INVALID_ACCOUNT", errEsp["error_code"])
 
 	// Valid account that doesnt exist in wallet
 	reqBody = map[string]interface{}{
@@ -528,6 +995,213 @@ func TestWalletContains(t *testing.T) {
 	assert.Equal(t, "0", respJson.Exists)
 }
 
+func TestWalletVerify(t *testing.T) {
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("4f7b0e3c6a9d2f5b8e1c4a7d0f3b6e9c2a5d8f1b4e7c0a3d6f9b2e5c8a1d4f7b"))
+	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	accs, _ := MockController.Wallet.AccountsCreate(wallet, 1)
+	// Corrupt the checksum of the new account
+	broken := accs[0].Address[:len(accs[0].Address)-1] + "1"
+	MockController.Wallet.DB.Account.UpdateOne(accs[0]).SetAddress(broken).Save(MockController.Wallet.Ctx)
+
+	doVerify := func() (int, responses.WalletVerifyResponse) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "wallet_verify",
+			"wallet": wallet.ID.String(),
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson responses.WalletVerifyResponse
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doVerify()
+	assert.Equal(t, 200, status)
+	assert.False(t, respJson.Locked)
+	assert.Equal(t, []responses.AccountMismatch{{Account: broken, Reason: "invalid_address"}}, respJson.Mismatches)
+
+	// Still works when locked
+	MockController.Wallet.EncryptWallet(wallet, "mypassword")
+	status, respJson = doVerify()
+	assert.Equal(t, 200, status)
+	assert.True(t, respJson.Locked)
+	assert.Equal(t, []responses.AccountMismatch{{Account: broken, Reason: "invalid_address"}}, respJson.Mismatches)
+}
+
+func TestWalletReconcile(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("8c1f4a7d0b3e6c9f2a5d8b1e4c7f0a3d6b9e2c5f8a1d4b7e0c3f6a9d2b5e8c1f"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	accs, _ := hc.Wallet.AccountsCreate(wallet, 1)
+	pending := strings.Repeat("D", 64)
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "accounts_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"infos": ""})
+			case "accounts_pending":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"blocks": map[string][]string{accs[0].Address: {pending}},
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+
+	doReconcile := func(request map[string]interface{}) (int, map[string]interface{}) {
+		request["action"] = "wallet_reconcile"
+		request["wallet"] = wallet.ID.String()
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doReconcile(map[string]interface{}{})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(2), respJson["accounts_checked"])
+	assert.Equal(t, float64(0), respJson["sends_checked"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"account": accs[0].Address, "blocks": []interface{}{pending}}}, respJson["unpocketed"])
+	assert.Equal(t, []interface{}{}, respJson["forks"])
+	assert.Equal(t, []interface{}{}, respJson["missing_sends"])
+	assert.Equal(t, []interface{}{}, respJson["balance_mismatches"])
+
+	status, _ = doReconcile(map[string]interface{}{"start_date": "2024-03-01"})
+	assert.Equal(t, 200, status)
+	status, respJson = doReconcile(map[string]interface{}{"start_date": "yesterday"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_DATE", respJson["error_code"])
+}
+
+func TestWalletAccountsReindex(t *testing.T) {
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("1b4e7c0a3d6f9b2e5c8a1d4f7b4f7b0e3c6a9d2f5b8e1c4a7d0f3b6e9c2a5d8f"))
+	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
+	accs, _ := MockController.Wallet.AccountsCreate(wallet, 1)
+	// From before the index was stored
+	MockController.Wallet.DB.Account.UpdateOne(accs[0]).ClearAccountIndex().Save(MockController.Wallet.Ctx)
+	stranger := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	MockController.Wallet.DB.Account.Create().SetWallet(wallet).SetAddress(stranger).Save(MockController.Wallet.Ctx)
+
+	doReindex := func() (int, []byte) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "wallet_accounts_reindex",
+			"wallet": wallet.ID.String(),
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	status, body := doReindex()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"updated":1,"unmatched":["`+stranger+`"]}`, strings.TrimSpace(string(body)))
+	acct, _ := MockController.Wallet.GetAccount(wallet, accs[0].Address)
+	assert.Equal(t, 1, *acct.AccountIndex)
+
+	// The seed is encrypted while locked
+	MockController.Wallet.EncryptWallet(wallet, "mypassword")
+	status, _ = doReindex()
+	assert.Equal(t, 400, status)
+}
+
+func TestWalletStatistics(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("5d2a8f3c6b9e1d4a7f0c3b6e9d2a5f8c1b4e7d0a3f6c9b2e5d8a1f4c7b0e3d6a"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	destination := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	now := time.Now().Unix()
+	historyCalls := 0
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			historyCalls++
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"history": []interface{}{
+					map[string]interface{}{"type": "send", "account": destination, "amount": "300", "local_timestamp": fmt.Sprint(now - 60), "hash": "B", "confirmed": "false"},
+					map[string]interface{}{"type": "send", "account": destination, "amount": "1000", "local_timestamp": fmt.Sprint(now - 120), "hash": "A", "confirmed": "true"},
+					map[string]interface{}{"type": "receive", "account": destination, "amount": "2000", "local_timestamp": fmt.Sprint(now - 180), "hash": "C", "confirmed": "true"},
+				},
+			})
+		},
+	)
+
+	doStatistics := func(period string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "wallet_statistics",
+			"wallet": wallet.ID.String(),
+			"period": period,
+		})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doStatistics("day")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "day", respJson["period"])
+	assert.InDelta(t, now-86400, respJson["since"], 5)
+	assert.Equal(t, map[string]interface{}{
+		"sends":                 map[string]interface{}{"count": float64(1), "total_raw": "1000"},
+		"receives":              map[string]interface{}{"count": float64(1), "total_raw": "2000"},
+		"unique_counterparties": float64(1),
+		"largest_send":          map[string]interface{}{"hash": "A", "account": destination, "amount_raw": "1000"},
+	}, respJson["confirmed"])
+	assert.Equal(t, map[string]interface{}{
+		"sends":                 map[string]interface{}{"count": float64(1), "total_raw": "300"},
+		"receives":              map[string]interface{}{"count": float64(0), "total_raw": "0"},
+		"unique_counterparties": float64(1),
+		"largest_send":          map[string]interface{}{"hash": "B", "account": destination, "amount_raw": "300"},
+	}, respJson["unconfirmed"])
+	assert.Equal(t, 1, historyCalls)
+
+	// Cached per period
+	status, _ = doStatistics("day")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 1, historyCalls)
+	status, _ = doStatistics("week")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, 2, historyCalls)
+
+	status, respJson = doStatistics("year")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_PERIOD", respJson["error_code"])
+
+	// Not even from the cache while locked
+	hc.Wallet.EncryptWallet(wallet, "mypassword")
+	status, respJson = doStatistics("day")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_LOCKED", respJson["error_code"])
+}
+
 func TestWalletRepresentativeSet(t *testing.T) {
 	newSeed, _ := utils.GenerateSeed(strings.NewReader("b40ee7fa4a110bb17e7706e30eeed3bc360f571ddde6b436d7926ed3e77449f2"))
 	wallet, _ := MockController.Wallet.WalletCreate(newSeed)
@@ -573,7 +1247,7 @@ func TestWalletRepresentativeSet(t *testing.T) {
 	respBody, _ = io.ReadAll(resp.Body)
 	json.Unmarshal(respBody, &errEsp)
 
-	assert.Equal(t, "Invalid representative account", errEsp["error"])
+	assert.Equal(t, "INVALID_REPRESENTATIVE", errEsp["error_code"])
 }
 
 func TestWalletRepresentative(t *testing.T) {
@@ -622,7 +1296,138 @@ func TestWalletRepresentative(t *testing.T) {
 	respBody, _ = io.ReadAll(resp.Body)
 	json.Unmarshal(respBody, &errEsp)
 
-	assert.Equal(t, "wallet locked", errEsp["error"])
+	assert.Equal(t, "WALLET_LOCKED", errEsp["error_code"])
+}
+
+func TestWalletRepresentativeHistory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("f4b7d0a3e6c9f2b5d8a1e4c7f0b3d6a9e2c5f8b1d4a7e0c3f6b9d2a5e8c1f4b7"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	acc, _ := hc.Wallet.AccountCreate(wallet, nil)
+	original := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	first := "nano_1efa1gxbitary1urzix9h13nkzadtz71n3auyj7uztb8i4qbtipu8cxz61ee"
+	second := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+
+	// The node has whatever representative the last processed block set
+	representative := original
+	var hashes []string
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "account_info":
+				// The frontier has hard coded work in the pow client
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "11999999999999999918751838129509869131",
+					"representative": representative,
+				})
+			case "process":
+				representative = pr["block"].(map[string]interface{})["representative"].(string)
+				hashes = append(hashes, fmt.Sprintf("%064X", len(hashes)+1))
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": hashes[len(hashes)-1],
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	doRequest := func(request map[string]interface{}) (int, map[string]interface{}) {
+		request["wallet"] = wallet.ID.String()
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	for _, rep := range []string{first, second} {
+		status, _ := doRequest(map[string]interface{}{
+			"action":         "account_representative_set",
+			"account":        acc.Address,
+			"representative": rep,
+		})
+		assert.Equal(t, 200, status)
+	}
+	assert.Len(t, hashes, 2)
+
+	status, respJson := doRequest(map[string]interface{}{
+		"action":  "wallet_representative_history",
+		"account": acc.Address,
+	})
+	assert.Equal(t, 200, status)
+	history := respJson["history"].([]interface{})
+	assert.Len(t, history, 2)
+	entry := history[0].(map[string]interface{})
+	assert.Equal(t, acc.Address, entry["account"])
+	assert.Equal(t, original, entry["old_representative"])
+	assert.Equal(t, first, entry["new_representative"])
+	assert.Equal(t, hashes[0], entry["block_hash"])
+	assert.NotZero(t, entry["changed_at"])
+	entry = history[1].(map[string]interface{})
+	assert.Equal(t, first, entry["old_representative"])
+	assert.Equal(t, second, entry["new_representative"])
+	assert.Equal(t, hashes[1], entry["block_hash"])
+
+	// Date range
+	status, respJson = doRequest(map[string]interface{}{
+		"action":     "wallet_representative_history",
+		"start_date": "2020-01-01",
+		"end_date":   "2021-01-01",
+	})
+	assert.Equal(t, 200, status)
+	assert.Len(t, respJson["history"], 0)
+	status, respJson = doRequest(map[string]interface{}{
+		"action":     "wallet_representative_history",
+		"start_date": "2020-01-01",
+	})
+	assert.Equal(t, 200, status)
+	assert.Len(t, respJson["history"], 2)
+
+	// errors
+	status, respJson = doRequest(map[string]interface{}{
+		"action":     "wallet_representative_history",
+		"start_date": "yesterday",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_DATE", respJson["error_code"])
+	status, respJson = doRequest(map[string]interface{}{
+		"action":     "wallet_representative_history",
+		"start_date": "2021-01-01",
+		"end_date":   "2020-01-01",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_DATE_RANGE", respJson["error_code"])
+	status, respJson = doRequest(map[string]interface{}{
+		"action":  "wallet_representative_history",
+		"account": "nano_1",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", respJson["error_code"])
+	status, respJson = doRequest(map[string]interface{}{
+		"action":  "wallet_representative_history",
+		"account": "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", respJson["error_code"])
+	hc.Wallet.EncryptWallet(wallet, "password")
+	status, respJson = doRequest(map[string]interface{}{
+		"action": "wallet_representative_history",
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_LOCKED", respJson["error_code"])
 }
 
 func TestWalletChangeSeed(t *testing.T) {
@@ -637,9 +1442,10 @@ func TestWalletChangeSeed(t *testing.T) {
 	body, _ := json.Marshal(reqBody)
 	w := httptest.NewRecorder()
 	// Build request
-	req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
-	MockController.Gateway(w, req)
+	req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+	MockController.AdminHandler(w, req)
 	resp := w.Result()
 	defer resp.Body.Close()
 	assert.Equal(t, 200, resp.StatusCode)
@@ -665,9 +1471,10 @@ func TestWalletChangeSeed(t *testing.T) {
 	body, _ = json.Marshal(reqBody)
 	w = httptest.NewRecorder()
 	// Build request
-	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req = httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
-	MockController.Gateway(w, req)
+	req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+	MockController.AdminHandler(w, req)
 	resp = w.Result()
 	defer resp.Body.Close()
 	assert.Equal(t, 400, resp.StatusCode)
@@ -676,5 +1483,877 @@ func TestWalletChangeSeed(t *testing.T) {
 	respBody, _ = io.ReadAll(resp.Body)
 	json.Unmarshal(respBody, &errEsp)
 
-	assert.Equal(t, "wallet locked", errEsp["error"])
+	assert.Equal(t, "WALLET_LOCKED", errEsp["error_code"])
+}
+
+// Answer accounts_frontiers with the opened accounts and accounts_pending with the receivable ones
+func mockSeedScan(opened map[string]bool, receivable map[string]bool) {
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			frontiers := map[string]string{}
+			blocks := map[string][]string{}
+			for _, acc := range pr["accounts"].([]interface{}) {
+				if opened[acc.(string)] {
+					frontiers[acc.(string)] = "791AF413173EEE674A6FCF633B5DFC0F3C33F397F0DA08E987D9E0741D40D81A"
+				}
+				if receivable[acc.(string)] {
+					blocks[acc.(string)] = []string{"4C1FEEF0BEA7F50BE35489A1233FE002B212DEA554B55B1B470D78BD8F210C74"}
+				}
+			}
+			if pr["action"] == "accounts_pending" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": blocks})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"frontiers": frontiers})
+		},
+	)
+}
+
+func TestWalletCreateScan(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	seed, _ := utils.GenerateSeed(strings.NewReader("7c0f3e6b9d2a5c8f1e4b7d0a3c6f9e2b5d8a1c4f7e0b3d6a9c2f5e8b1d4a7c0f"))
+	address := func(index uint32) string {
+		pub, _, _ := utils.KeypairFromSeed(seed, index)
+		return utils.PubKeyToAddress(pub, false)
+	}
+	mockSeedScan(map[string]bool{address(2): true}, map[string]bool{address(9): true})
+
+	doCreate := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// 9 is past a gap of 5
+	status, respJson := doCreate(map[string]interface{}{"action": "wallet_create", "seed": seed, "scan": true, "gap_limit": 5})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []interface{}{address(0), address(2)}, respJson["accounts"])
+	assert.Equal(t, 4, httpmock.GetTotalCallCount())
+
+	// Not a gap limit
+	status, respJson = doCreate(map[string]interface{}{"action": "wallet_create", "seed": seed, "scan": true, "gap_limit": 0})
+	assert.Equal(t, 400, status)
+
+	// restore_gap_limit is 20
+	seed, _ = utils.GenerateSeed(strings.NewReader("8d1a4c7f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e7b0d3a6c9f2e5b8d1a"))
+	httpmock.Reset()
+	mockSeedScan(map[string]bool{address(2): true}, map[string]bool{address(9): true})
+	status, respJson = doCreate(map[string]interface{}{"action": "wallet_create", "seed": seed, "scan": "true"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []interface{}{address(0), address(2), address(9)}, respJson["accounts"])
+	dbWallet, err := hc.Wallet.GetWallet(respJson["wallet"].(string))
+	assert.Nil(t, err)
+	count, err := dbWallet.QueryAccounts().Count(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+
+	// Nothing is scanned without it
+	httpmock.Reset()
+	status, respJson = doCreate(map[string]interface{}{"action": "wallet_create", "seed": "4F0B3E6D9A2C5F8E1B4D7A0C3F6E9B2D5A8C1F4E7B0D3A6C9F2E5B8D1A4C7F03"})
+	assert.Equal(t, 200, status)
+	assert.NotContains(t, respJson, "accounts")
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+}
+
+func TestWalletChangeSeedScan(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	oldSeed, _ := utils.GenerateSeed(strings.NewReader("9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e7b0d3a6c9f2e5b8d1a4c7f0e3b6d9a2c"))
+	wallet, _ := hc.Wallet.WalletCreate(oldSeed)
+	wallet, _ = hc.Wallet.GetWallet(wallet.ID.String())
+	newSeed := "2b5d8a1c4f7e0b3d6a9c2f5e8b1d4a7c0f3e6b9d2a5c8f1e4b7d0a3c6f9e2b5d"
+	address := func(index uint32) string {
+		pub, _, _ := utils.KeypairFromSeed(newSeed, index)
+		return utils.PubKeyToAddress(pub, false)
+	}
+	mockSeedScan(map[string]bool{address(3): true}, map[string]bool{address(15): true})
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"action": "wallet_change_seed",
+		"wallet": wallet.ID.String(),
+		"seed":   newSeed,
+		"scan":   true,
+	})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+	hc.AdminHandler(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	var respJson responses.WalletChangeSeedResponse
+	json.NewDecoder(resp.Body).Decode(&respJson)
+	assert.Equal(t, address(15), respJson.LastRestoredAccount)
+	assert.Equal(t, 16, respJson.RestoredCount)
+
+	count, err := wallet.QueryAccounts().Count(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+	_, err = hc.Wallet.GetAccount(wallet, address(3))
+	assert.Nil(t, err)
+}
+
+func TestWalletSeed(t *testing.T) {
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("3c8f1a6d9b2e5c8f0a3d6b9e1c4f7a2d5b8e0c3f6a9d1b4e7c0f2a5d8b1e4c76"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+
+	doSeed := func(walletID string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action": "wallet_seed",
+			"wallet": walletID,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		hc.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doSeed(wallet.ID.String())
+	assert.Equal(t, 200, status)
+	assert.Equal(t, newSeed, respJson["seed"])
+
+	status, respJson = doSeed("8a3e1c5b-2f4d-4e6a-9b7c-0d1e2f3a4b5c")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_NOT_FOUND", respJson["error_code"])
+
+	// Encrypted wallets have to be unlocked, the decrypted seed is returned
+	hc.Wallet.EncryptWallet(wallet, "password")
+	status, respJson = doSeed(wallet.ID.String())
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_LOCKED", respJson["error_code"])
+
+	hc.Wallet.UnlockWallet(wallet, "password")
+	status, respJson = doSeed(wallet.ID.String())
+	assert.Equal(t, 200, status)
+	assert.Equal(t, newSeed, respJson["seed"])
+
+	// Watch-only wallets have no seed
+	watchOnly, err := hc.Wallet.DB.Wallet.Create().SetSeed("").Save(hc.Wallet.Ctx)
+	assert.Nil(t, err)
+	status, respJson = doSeed(watchOnly.ID.String())
+	assert.Equal(t, 200, status)
+	assert.Contains(t, respJson, "seed")
+	assert.Nil(t, respJson["seed"])
+}
+
+func TestWalletCreateFromSeed(t *testing.T) {
+	hc := newTestController(t)
+	// Nano test vector, the zero seed
+	seed := "0000000000000000000000000000000000000000000000000000000000000000"
+
+	doCreate := func(reqBody map[string]interface{}) (int, []byte) {
+		reqBody["action"] = "wallet_create_from_seed"
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	// Bad input creates nothing
+	status, _ := doCreate(map[string]interface{}{"seed": "1234", "count": 2})
+	assert.Equal(t, 400, status)
+	status, _ = doCreate(map[string]interface{}{"seed": seed, "count": 0})
+	assert.Equal(t, 400, status)
+	status, _ = doCreate(map[string]interface{}{"seed": seed, "count": 2, "name": strings.Repeat("a", 129)})
+	assert.Equal(t, 400, status)
+
+	status, body := doCreate(map[string]interface{}{"seed": seed, "count": 2, "name": "Migrated"})
+	assert.Equal(t, 200, status)
+	var respJson responses.WalletCreateFromSeedResponse
+	json.Unmarshal(body, &respJson)
+	_, err := uuid.Parse(respJson.Wallet)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7",
+		"nano_3rrf6cus8pye6o1kzi5n6wwjof8bjb7ff4xcgesi3njxid6x64pms6onw1f9",
+	}, respJson.Accounts)
+	dbWallet, err := hc.Wallet.GetWallet(respJson.Wallet)
+	assert.Nil(t, err)
+	assert.Equal(t, "Migrated", *dbWallet.Name)
+
+	// The seed has a wallet now
+	status, _ = doCreate(map[string]interface{}{"seed": seed})
+	assert.Equal(t, 400, status)
+}
+
+func TestWalletCreateWatchOnly(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var ar rpcreq.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&ar)
+			if ar.Action != "accounts_balances" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Account not found"})
+			}
+			balances := map[string]interface{}{}
+			for _, account := range ar.Accounts {
+				balances[account] = map[string]interface{}{"balance": "1000", "pending": "0", "receivable": "0"}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"balances": balances})
+		},
+	)
+
+	hc := newTestController(t)
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+	accounts := []interface{}{
+		"nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7",
+		"nano_3rrf6cus8pye6o1kzi5n6wwjof8bjb7ff4xcgesi3njxid6x64pms6onw1f9",
+	}
+
+	// Every address is checked, banano ones aren't nano addresses
+	status, respJson := doRequest(map[string]interface{}{"action": "wallet_create_watch_only", "accounts": []interface{}{accounts[0], "ban_3rrf6cus8pye6o1kzi5n6wwjof8bjb7ff4xcgesi3njxid6x64pms6onw1f9"}})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", respJson["error_code"])
+	status, _ = doRequest(map[string]interface{}{"action": "wallet_create_watch_only", "accounts": []interface{}{}})
+	assert.Equal(t, 400, status)
+	hc.Wallet.Config.Server.WatchOnlyMaxAccounts = 1
+	status, respJson = doRequest(map[string]interface{}{"action": "wallet_create_watch_only", "accounts": accounts})
+	hc.Wallet.Config.Server.WatchOnlyMaxAccounts = 1000
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", respJson["error_code"])
+
+	status, respJson = doRequest(map[string]interface{}{"action": "wallet_create_watch_only", "accounts": accounts, "name": "Cold storage"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, accounts, respJson["accounts"])
+	walletID := respJson["wallet"].(string)
+
+	// Listed as watch-only, wallet_list is admin only
+	body, _ := json.Marshal(map[string]interface{}{"action": "wallet_list"})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+	hc.AdminHandler(w, req)
+	status = w.Code
+	respJson = nil
+	json.NewDecoder(w.Body).Decode(&respJson)
+	assert.Equal(t, 200, status)
+	assert.Len(t, respJson["wallets"], 1)
+	listed := respJson["wallets"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, walletID, listed["wallet_id"])
+	assert.Equal(t, true, listed["watch_only"])
+	assert.Equal(t, float64(2), listed["account_count"])
+
+	// Balances can be queried
+	status, respJson = doRequest(map[string]interface{}{"action": "wallet_balances", "wallet": walletID})
+	assert.Equal(t, 200, status)
+	assert.Len(t, respJson["balances"], 2)
+	assert.Equal(t, "1000", respJson["balances"].(map[string]interface{})[accounts[0].(string)].(map[string]interface{})["balance"])
+
+	// Nothing can be signed
+	status, respJson = doRequest(map[string]interface{}{"action": "send", "wallet": walletID, "source": accounts[0], "destination": accounts[1], "amount": "1"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_WATCH_ONLY", respJson["error_code"])
+	status, respJson = doRequest(map[string]interface{}{"action": "account_create", "wallet": walletID})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_WATCH_ONLY", respJson["error_code"])
+}
+
+func TestWalletAddWatch(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var ar rpcreq.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&ar)
+			if ar.Action != "accounts_balances" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Account not found"})
+			}
+			balances := map[string]interface{}{}
+			for _, account := range ar.Accounts {
+				balances[account] = map[string]interface{}{"balance": "1000", "pending": "0", "receivable": "0"}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"balances": balances})
+		},
+	)
+
+	hc := newTestController(t)
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+	watched := "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7"
+
+	status, respJson := doRequest(map[string]interface{}{"action": "wallet_create"})
+	assert.Equal(t, 200, status)
+	walletID := respJson["wallet"].(string)
+
+	status, respJson = doRequest(map[string]interface{}{"action": "wallet_add_watch", "wallet": walletID, "accounts": []interface{}{"ban_3rrf6cus8pye6o1kzi5n6wwjof8bjb7ff4xcgesi3njxid6x64pms6onw1f9"}})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_ACCOUNT", respJson["error_code"])
+	status, _ = doRequest(map[string]interface{}{"action": "wallet_add_watch", "wallet": walletID, "accounts": []interface{}{}})
+	assert.Equal(t, 400, status)
+	status, respJson = doRequest(map[string]interface{}{"action": "wallet_add_watch", "wallet": "a69e3ab8-a8d2-4ec1-bf3b-4b3b66c2ae1f", "accounts": []interface{}{watched}})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_NOT_FOUND", respJson["error_code"])
+
+	status, respJson = doRequest(map[string]interface{}{"action": "wallet_add_watch", "wallet": walletID, "accounts": []interface{}{watched}})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "", respJson["success"])
+	assert.Equal(t, []interface{}{watched}, respJson["accounts"])
+	// Already in the wallet
+	status, respJson = doRequest(map[string]interface{}{"action": "wallet_add_watch", "wallet": walletID, "accounts": []interface{}{watched}})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []interface{}{}, respJson["accounts"])
+
+	// Its balance is the wallet's too
+	status, respJson = doRequest(map[string]interface{}{"action": "wallet_balances", "wallet": walletID})
+	assert.Equal(t, 200, status)
+	assert.Len(t, respJson["balances"], 2)
+	assert.Contains(t, respJson["balances"], watched)
+
+	// But nothing can be sent from it
+	status, respJson = doRequest(map[string]interface{}{"action": "send", "wallet": walletID, "source": watched, "destination": watched, "amount": "1"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "NO_PRIVATE_KEY", respJson["error_code"])
+	assert.Equal(t, "no_private_key", respJson["error"])
+}
+
+func TestWalletImportNanoWallet(t *testing.T) {
+	// A version 1 NanoWallet backup of a throwaway seed, encrypted with "correct horse battery staple"
+	backup, err := os.ReadFile("testdata/nanowallet_backup_v1.json")
+	assert.Nil(t, err)
+	var backupJson map[string]interface{}
+	assert.Nil(t, json.Unmarshal(backup, &backupJson))
+	hc := newTestController(t)
+
+	doImport := func(backup interface{}, passphrase string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":     "wallet_import_nanowallet",
+			"backup":     backup,
+			"passphrase": passphrase,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doImport(backupJson, "wrong passphrase")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "DECRYPTION_FAILED", respJson["error_code"])
+
+	status, respJson = doImport(map[string]interface{}{"version": 2}, "correct horse battery staple")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_BACKUP", respJson["error_code"])
+
+	status, respJson = doImport(backupJson, "correct horse battery staple")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []interface{}{
+		"nano_3pdaccf89w1aewqi78443i99pkcrtudjpihhksjs1ip1aum9eou3krz1nks9",
+		"nano_347pq7obkgbs1ji6dtwh3yq14ijf1aqgfb1mfwm5wz36dh9s4yfwccds6q8s",
+		"nano_31mqdf4w9tgy7jjknmm6c3zgxnwttbud3w78c8kokx3qpiwzyxs6rftsjsbx",
+	}, respJson["accounts"])
+	walletID, err := uuid.Parse(respJson["wallet"].(string))
+	assert.Nil(t, err)
+	dbWallet, err := hc.Wallet.GetWallet(walletID.String())
+	assert.Nil(t, err)
+	assert.Equal(t, "3363396532613566386231643465376130633366366239643265356138633166", dbWallet.Seed)
+
+	// The file contents work too, but it's already imported
+	status, respJson = doImport(string(backup), "correct horse battery staple")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_EXISTS", respJson["error_code"])
+}
+
+func TestWalletImportNault(t *testing.T) {
+	// A version 1 Nault backup of two throwaway wallets, encrypted with "nault backup passphrase"
+	backup, err := os.ReadFile("testdata/nault_backup_v1.json")
+	assert.Nil(t, err)
+	hc := newTestController(t)
+
+	doImport := func(passphrase string) (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{
+			"action":     "wallet_import_nault",
+			"backup":     string(backup),
+			"passphrase": passphrase,
+		})
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doImport("wrong passphrase")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "DECRYPTION_FAILED", respJson["error_code"])
+
+	status, respJson = doImport("nault backup passphrase")
+	assert.Equal(t, 200, status)
+	assert.Len(t, respJson["wallets"], 2)
+	seeds := []string{}
+	for _, id := range respJson["wallets"].([]interface{}) {
+		dbWallet, err := hc.Wallet.GetWallet(id.(string))
+		assert.Nil(t, err)
+		seeds = append(seeds, dbWallet.Seed)
+	}
+	assert.Equal(t, []string{"3765316134643963326635623865306133643663396631623465376132643563", "6134633766306233653664396132633566386231653464376130633366366239"}, seeds)
+
+	status, respJson = doImport("nault backup passphrase")
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_EXISTS", respJson["error_code"])
+}
+
+func TestWalletBackup(t *testing.T) {
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("4f8a2c6e0b3d7f1a5c9e2b4d8f0a3c7e1b5d9f2a6c0e4b8d1f3a7c5e9b2d6f0a"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	accounts, _ := hc.Wallet.AccountsCreate(wallet, 2)
+
+	doRequest := func(handler http.HandlerFunc, request map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(request)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		handler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// It has the keys so it's admin only
+	status, respJson := doRequest(hc.Gateway, map[string]interface{}{"action": "wallet_backup_create", "wallet": wallet.ID.String(), "passphrase": "hunter2"})
+	assert.Equal(t, 403, status)
+	status, _ = doRequest(hc.AdminHandler, map[string]interface{}{"action": "wallet_backup_create", "wallet": wallet.ID.String()})
+	assert.Equal(t, 400, status)
+
+	status, respJson = doRequest(hc.AdminHandler, map[string]interface{}{"action": "wallet_backup_create", "wallet": wallet.ID.String(), "passphrase": "hunter2"})
+	assert.Equal(t, 200, status)
+	backup := respJson["backup"].(map[string]interface{})
+	assert.Equal(t, float64(1), backup["version"])
+	assert.NotContains(t, backup["data"], newSeed)
+
+	status, respJson = doRequest(hc.Gateway, map[string]interface{}{"action": "wallet_backup_restore", "backup": backup, "passphrase": "wrong"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "DECRYPTION_FAILED", respJson["error_code"])
+	status, respJson = doRequest(hc.Gateway, map[string]interface{}{"action": "wallet_backup_restore", "backup": backup, "passphrase": "hunter2"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_EXISTS", respJson["error_code"])
+
+	// Restored with the same ID and accounts, from the file contents too
+	hc.Wallet.WalletDestroy(wallet)
+	encoded, _ := json.Marshal(backup)
+	status, respJson = doRequest(hc.Gateway, map[string]interface{}{"action": "wallet_backup_restore", "backup": string(encoded), "passphrase": "hunter2"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, wallet.ID.String(), respJson["wallet"])
+	assert.Len(t, respJson["accounts"], 3)
+	assert.Contains(t, respJson["accounts"], accounts[1].Address)
+	restored, err := hc.Wallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+	assert.Equal(t, newSeed, restored.Seed)
+}
+
+func TestWalletFreeze(t *testing.T) {
+	// Nothing may reach the node while the wallet is frozen
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("9e1c4f7a2d5b8e0c3f6a9d1b4e7c0f2a5d8b1e4c763c8f1a6d9b2e5c8f0a3d6b"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	acc, _ := hc.Wallet.AccountCreate(wallet, nil)
+	other, _ := hc.Wallet.WalletCreate(strings.Repeat("5", 64))
+	otherAcc, _ := hc.Wallet.AccountCreate(other, nil)
+
+	doRequest := func(path string, reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", path, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if path == "/admin" {
+			req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+			hc.AdminHandler(w, req)
+		} else {
+			hc.Gateway(w, req)
+		}
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// Admin only
+	status, _ := doRequest("/", map[string]interface{}{"action": "wallet_freeze", "wallet": wallet.ID.String()})
+	assert.Equal(t, 403, status)
+	status, respJson := doRequest("/admin", map[string]interface{}{"action": "wallet_freeze", "wallet": "8a3e1c5b-2f4d-4e6a-9b7c-0d1e2f3a4b5c"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_NOT_FOUND", respJson["error_code"])
+
+	status, respJson = doRequest("/admin", map[string]interface{}{"action": "wallet_freeze", "wallet": wallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, true, respJson["frozen"])
+	frozenAt := respJson["frozen_at"]
+	_, err := time.Parse(time.RFC3339, frozenAt.(string))
+	assert.Nil(t, err)
+
+	hash := strings.Repeat("A", 64)
+	block := map[string]interface{}{"account": acc.Address}
+	signing := []map[string]interface{}{
+		{"action": "receive", "wallet": wallet.ID.String(), "account": acc.Address, "block": hash},
+		{"action": "receive_all", "wallet": wallet.ID.String()},
+		{"action": "receive_batch", "wallet": wallet.ID.String(), "account": acc.Address, "blocks": []string{hash}},
+		{"action": "account_sync", "wallet": wallet.ID.String(), "account": acc.Address},
+		{"action": "send", "wallet": wallet.ID.String(), "source": acc.Address, "destination": otherAcc.Address, "amount": "1"},
+		{"action": "send_with_id", "wallet": wallet.ID.String(), "source": acc.Address, "destination": otherAcc.Address, "amount": "1", "send_id": "frozen"},
+		{"action": "send_raw", "wallet": wallet.ID.String(), "block": block},
+		{"action": "sign_block", "wallet": wallet.ID.String(), "block": block},
+		{"action": "sweep_to_wallet", "wallet": wallet.ID.String(), "destination_account": acc.Address, "sources": []map[string]interface{}{{"seed": strings.Repeat("1", 64), "index": 0}}},
+		{"action": "cross_wallet_transfer", "source_wallet": wallet.ID.String(), "destination_wallet": other.ID.String(), "destination_account": otherAcc.Address},
+		{"action": "cross_wallet_transfer", "source_wallet": other.ID.String(), "destination_wallet": wallet.ID.String(), "destination_account": acc.Address},
+		{"action": "account_representative_set", "wallet": wallet.ID.String(), "account": acc.Address, "representative": otherAcc.Address},
+		{"action": "accounts_representative_set", "wallet": wallet.ID.String(), "representative": otherAcc.Address},
+		{"action": "wallet_representative_set", "wallet": wallet.ID.String(), "representative": otherAcc.Address, "update_existing_accounts": true},
+	}
+	for _, request := range signing {
+		status, respJson := doRequest("/", request)
+		assert.Equal(t, 400, status, request["action"])
+		assert.Equal(t, "wallet_frozen", respJson["error"], request["action"])
+		assert.Equal(t, "WALLET_FROZEN", respJson["error_code"], request["action"])
+		assert.Equal(t, frozenAt, respJson["frozen_at"], request["action"])
+	}
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+
+	// Freezing again keeps when it was frozen
+	status, respJson = doRequest("/admin", map[string]interface{}{"action": "wallet_freeze", "wallet": wallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, frozenAt, respJson["frozen_at"])
+
+	status, respJson = doRequest("/admin", map[string]interface{}{"action": "wallet_unfreeze", "wallet": wallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, false, respJson["frozen"])
+	assert.NotContains(t, respJson, "frozen_at")
+
+	// Signing works again
+	status, respJson = doRequest("/", map[string]interface{}{"action": "sign_block", "wallet": wallet.ID.String(), "block": map[string]interface{}{
+		"account":        acc.Address,
+		"previous":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		"representative": otherAcc.Address,
+		"balance":        "600",
+		"link":           "d4bbfa50649d80e5f63fc396c6f4cf6321cabd7c1480e964c2701d56aafeb5e3",
+		"work":           "205452237a9b01f4",
+	}})
+	assert.Equal(t, 200, status)
+	assert.NotContains(t, respJson, "error")
+}
+
+func TestReceiveMinimum(t *testing.T) {
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("4c7e1a9d3f6b2e8c5a0d7f1b4e9c2a6d8f3b5e0c7a1d4f9b2e6c8a3d5f0b7e1c"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		// Build request
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	// The config's receive_minimum until the wallet has its own
+	status, respJson := doRequest(map[string]interface{}{"action": "receive_minimum", "wallet": wallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"amount": hc.Wallet.Config.Wallet.ReceiveMinimum, "auto_receive": "1"}, respJson)
+
+	status, respJson = doRequest(map[string]interface{}{"action": "receive_minimum_set", "wallet": wallet.ID.String(), "amount": "1000000000000000000000000000000"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1000000000000000000000000000000", respJson["amount"])
+	status, respJson = doRequest(map[string]interface{}{"action": "receive_minimum", "wallet": wallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1000000000000000000000000000000", respJson["amount"])
+
+	for _, amount := range []string{"0", "-1", "1nano", "133248290000000000000000000000000000001"} {
+		status, respJson = doRequest(map[string]interface{}{"action": "receive_minimum_set", "wallet": wallet.ID.String(), "amount": amount})
+		assert.Equal(t, 400, status)
+		assert.Equal(t, "INVALID_AMOUNT", respJson["error_code"])
+	}
+	status, respJson = doRequest(map[string]interface{}{"action": "receive_minimum_set", "wallet": "8a3e1c5b-2f4d-4e6a-9b7c-0d1e2f3a4b5c", "amount": "1"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_NOT_FOUND", respJson["error_code"])
+
+	// Turned off and on again
+	status, respJson = doRequest(map[string]interface{}{"action": "wallet_auto_receive_set", "wallet": wallet.ID.String(), "enabled": false})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "0", respJson["auto_receive"])
+	status, respJson = doRequest(map[string]interface{}{"action": "receive_minimum", "wallet": wallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "0", respJson["auto_receive"])
+	status, respJson = doRequest(map[string]interface{}{"action": "wallet_auto_receive_set", "wallet": wallet.ID.String(), "enabled": "1"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["auto_receive"])
+
+	status, _ = doRequest(map[string]interface{}{"action": "wallet_auto_receive_set", "wallet": wallet.ID.String()})
+	assert.Equal(t, 400, status)
+	status, _ = doRequest(map[string]interface{}{"action": "wallet_auto_receive_set", "wallet": wallet.ID.String(), "enabled": "maybe"})
+	assert.Equal(t, 400, status)
+}
+
+func TestWalletHistory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("9e2b5d8a1c4f7e0b3d6a9c2f5e8b1d4a7c0f3e6b9d2a5c8f1e4b7d0a3c6f9e2b"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	second, _ := hc.Wallet.AccountCreate(wallet, nil)
+	pub, _, _ := utils.KeypairFromSeed(newSeed, 0)
+	first := utils.PubKeyToAddress(pub, false)
+
+	// The first account received at 100 and 300, the second sent at 200
+	chains := map[string][]map[string]interface{}{
+		first: {
+			{"type": "receive", "local_timestamp": "300", "height": "2", "hash": fmt.Sprintf("%064X", 12)},
+			{"type": "receive", "local_timestamp": "100", "height": "1", "hash": fmt.Sprintf("%064X", 11)},
+		},
+		second.Address: {
+			{"type": "send", "local_timestamp": "200", "height": "1", "hash": fmt.Sprintf("%064X", 21)},
+		},
+	}
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&js)
+			switch js["action"] {
+			case "account_history":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"account": js["account"], "history": chains[js["account"].(string)]})
+			case "block_info":
+				if js["hash"] == fmt.Sprintf("%064X", 21) {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"block_account": second.Address, "height": "1", "local_timestamp": "200"})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Block not found"})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		reqBody["action"] = "wallet_history"
+		reqBody["wallet"] = wallet.ID.String()
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+	hashes := func(respJson map[string]interface{}) []string {
+		ret := []string{}
+		for _, entry := range respJson["history"].([]interface{}) {
+			ret = append(ret, entry.(map[string]interface{})["hash"].(string))
+		}
+		return ret
+	}
+
+	status, respJson := doRequest(map[string]interface{}{})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []string{fmt.Sprintf("%064X", 12), fmt.Sprintf("%064X", 21), fmt.Sprintf("%064X", 11)}, hashes(respJson))
+	assert.Equal(t, second.Address, respJson["history"].([]interface{})[1].(map[string]interface{})["block_account"])
+	assert.Equal(t, "send", respJson["history"].([]interface{})[1].(map[string]interface{})["type"])
+
+	status, respJson = doRequest(map[string]interface{}{"count": "1", "offset": 1})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []string{fmt.Sprintf("%064X", 21)}, hashes(respJson))
+
+	status, respJson = doRequest(map[string]interface{}{"direction": "receive"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []string{fmt.Sprintf("%064X", 12), fmt.Sprintf("%064X", 11)}, hashes(respJson))
+
+	status, respJson = doRequest(map[string]interface{}{"account": second.Address})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []string{fmt.Sprintf("%064X", 21)}, hashes(respJson))
+
+	// Starting at the second account's send leaves out the newer receive
+	status, respJson = doRequest(map[string]interface{}{"head": fmt.Sprintf("%064X", 21)})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, []string{fmt.Sprintf("%064X", 21), fmt.Sprintf("%064X", 11)}, hashes(respJson))
+
+	status, respJson = doRequest(map[string]interface{}{"head": fmt.Sprintf("%064X", 99)})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "BLOCK_NOT_FOUND", respJson["error_code"])
+	status, respJson = doRequest(map[string]interface{}{"head": "abc"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_HASH", respJson["error_code"])
+	status, respJson = doRequest(map[string]interface{}{"direction": "change"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "INVALID_DIRECTION", respJson["error_code"])
+	status, respJson = doRequest(map[string]interface{}{"account": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "ACCOUNT_NOT_FOUND", respJson["error_code"])
+	status, _ = doRequest(map[string]interface{}{"count": 0})
+	assert.Equal(t, 400, status)
+}
+
+func TestWalletRepresentativeSetUpdateExisting(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("3a6d9f2c5e8b1a4d7f0c3e6b9a2d5f8c1e4b7a0d3f6c9e2b5a8d1f4c7e0b3a6d"))
+	wallet, _ := hc.Wallet.WalletCreate(newSeed)
+	unopened, _ := hc.Wallet.AccountCreate(wallet, nil)
+	pub, _, _ := utils.KeypairFromSeed(newSeed, 0)
+	opened := utils.PubKeyToAddress(pub, false)
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var js map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&js)
+			if js["action"] == "account_info" && js["account"] == unopened.Address {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Account not found"})
+			} else if js["action"] == "account_info" {
+				// The frontier has hard coded work in the pow client
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "1",
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			} else if js["action"] == "process" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"hash": strings.Repeat("C", 64)})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+
+	doRequest := func(reqBody map[string]interface{}) (int, map[string]interface{}) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		hc.Gateway(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+
+		var respJson map[string]interface{}
+		respBody, _ := io.ReadAll(resp.Body)
+		json.Unmarshal(respBody, &respJson)
+		return resp.StatusCode, respJson
+	}
+
+	hc.Cache.Set(accountRepresentativeKey(opened), []byte("nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"), time.Minute)
+
+	// Every existing account is reported
+	status, respJson := doRequest(map[string]interface{}{
+		"action":                   "wallet_representative_set",
+		"wallet":                   wallet.ID.String(),
+		"representative":           "nano_1jtx5p8141zjtukz4msp1x93st7nh475f74odj8673qqm96xczmtcnanos1o",
+		"update_existing_accounts": true,
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "1", respJson["set"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"account": opened, "block_hash": strings.Repeat("C", 64)}}, respJson["changed"])
+	assert.Equal(t, []interface{}{unopened.Address}, respJson["skipped"])
+	assert.Equal(t, []interface{}{}, respJson["failed"])
+	// account_representative asks the node again
+	_, err := hc.Cache.Get(accountRepresentativeKey(opened))
+	assert.ErrorIs(t, err, cache.ErrCacheMiss)
+
+	// Only setting it doesn't list any accounts
+	status, respJson = doRequest(map[string]interface{}{
+		"action":         "wallet_representative_set",
+		"wallet":         wallet.ID.String(),
+		"representative": "nano_1jtx5p8141zjtukz4msp1x93st7nh475f74odj8673qqm96xczmtcnanos1o",
+	})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, map[string]interface{}{"set": "1"}, respJson)
+
+	hc.Wallet.EncryptWallet(wallet, "password")
+	status, respJson = doRequest(map[string]interface{}{
+		"action":                   "wallet_representative_set",
+		"wallet":                   wallet.ID.String(),
+		"representative":           "nano_1jtx5p8141zjtukz4msp1x93st7nh475f74odj8673qqm96xczmtcnanos1o",
+		"update_existing_accounts": true,
+	})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WALLET_LOCKED", respJson["error_code"])
 }
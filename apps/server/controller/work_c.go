@@ -1,14 +1,19 @@
 package controller
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
 	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/cache"
 	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/pow"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
 	"github.com/go-chi/render"
 	"github.com/mitchellh/mapstructure"
 )
@@ -30,25 +35,16 @@ func (hc *HttpController) HandleWorkGenerate(rawRequest *map[string]interface{},
 		return
 	}
 
-	// Difficulty is optional, we default to 64 if in nano mode, 1 if banano mode
-	// Banano is always 1 no matter what
-	difficulty := 1
-	if !hc.Wallet.Banano {
-		// If they override difficulty, convert it to a multiplier
-		if workRequest.Difficulty != "" {
-			difficultyUint, err := strconv.ParseUint(workRequest.Difficulty, 16, 64)
-			// Their difficulty is invalid
-			if err != nil {
-				ErrUnableToParseJson(w, r)
-			}
-			difficulty = pow.MultiplierFromDifficulty(difficultyUint)
-		} else if workRequest.Subtype == "receive" {
-			// Receives always get 1
-			difficulty = 1
-		} else {
-			// Default to 64 (nano send)
-			difficulty = 64
-		}
+	// Difficulty is optional, it defaults to the send or receive threshold of nano or banano raised for the network
+	difficulty := pow.SendMultiplier(hc.Wallet.Banano)
+	if workRequest.Subtype == "receive" {
+		difficulty = pow.ReceiveMultiplier(hc.Wallet.Banano)
+	}
+	// One they asked for is used as is
+	requested, err := requestedWorkMultiplier(&workRequest.Difficulty, workRequest.Multiplier, difficulty)
+	if err != nil {
+		ErrUnableToParseJson(w, r)
+		return
 	}
 
 	blockAward := true
@@ -56,9 +52,16 @@ func (hc *HttpController) HandleWorkGenerate(rawRequest *map[string]interface{},
 		blockAward = *workRequest.BlockAward
 	}
 
-	work, err := hc.PowClient.WorkGenerateMeta(workRequest.Hash, difficulty, true, blockAward, workRequest.BpowKey)
+	// The peers get the request ID, work isn't given up on if the client goes away, like before
+	ctx := context.WithoutCancel(r.Context())
+	var work string
+	if requested > 0 {
+		work, err = hc.PowClient.WorkGenerateAtMultiplierWithContext(ctx, "", nil, workRequest.Hash, requested, true, blockAward, workRequest.BpowKey)
+	} else {
+		work, err = hc.PowClient.WorkGenerateForAccountWithContext(ctx, "", nil, workRequest.Hash, difficulty, true, blockAward, workRequest.BpowKey)
+	}
 	if err != nil {
-		log.Errorf("Error generating work %s", err)
+		log.Ctx(ctx).Errorf("Error generating work %s", err)
 		ErrWorkFailed(w, r)
 		return
 	}
@@ -70,3 +73,172 @@ func (hc *HttpController) HandleWorkGenerate(rawRequest *map[string]interface{},
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, resp)
 }
+
+// Largest multiplier a request can ask for, far more than any network needs
+const maxRequestedMultiplier = 1 << 20
+
+// The work multiplier a request asked for with difficulty, a hex threshold, or multiplier, a multiple of threshold
+// 0 if it asked for neither
+func requestedWorkMultiplier(difficulty *string, multiplier *interface{}, threshold int) (int, error) {
+	hasDifficulty := difficulty != nil && *difficulty != ""
+	if hasDifficulty && multiplier != nil {
+		return 0, errors.New("only one of difficulty or multiplier can be given")
+	} else if hasDifficulty {
+		parsed, err := strconv.ParseUint(*difficulty, 16, 64)
+		if err != nil {
+			return 0, errors.New("difficulty must be a hex threshold, e.g. fffffff800000000")
+		}
+		return min(pow.MultiplierReaching(parsed), maxRequestedMultiplier), nil
+	} else if multiplier != nil {
+		parsed, err := utils.ToFloat(*multiplier)
+		if err != nil || !(parsed >= 1) || parsed*float64(threshold) > maxRequestedMultiplier {
+			return 0, errors.New("multiplier must be a number of at least 1")
+		}
+		return pow.ScaleMultiplier(threshold, parsed), nil
+	}
+	return 0, nil
+}
+
+// The configured work peers with their health
+func (hc *HttpController) workPeersResponse() *responses.WorkPeersResponse {
+	health := hc.PowClient.WorkPeersHealth()
+	resp := &responses.WorkPeersResponse{
+		WorkPeers: make([]responses.WorkPeer, len(health)),
+	}
+	for i, peer := range health {
+		resp.WorkPeers[i] = responses.WorkPeer{
+			Url:              peer.URL,
+			Protocol:         peer.Protocol,
+			AverageLatencyMs: peer.AverageLatency.Milliseconds(),
+			Successes:        peer.Successes,
+			Failures:         peer.Failures,
+			InvalidWork:      peer.InvalidWork,
+		}
+		if peer.LastSuccess != nil {
+			lastSuccess := peer.LastSuccess.Unix()
+			resp.WorkPeers[i].LastSuccess = &lastSuccess
+		}
+		if peer.LastFailure != nil {
+			lastFailure := peer.LastFailure.Unix()
+			resp.WorkPeers[i].LastFailure = &lastFailure
+		}
+	}
+	return resp
+}
+
+// Handle work_peers, the work peers work_generate requests are sent to and their health
+func (hc *HttpController) HandleWorkPeers(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, hc.workPeersResponse())
+}
+
+// Handle work_queue_status, the work jobs waiting in the queue and running, to see whether work is the bottleneck
+func (hc *HttpController) HandleWorkQueueStatus(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	status := hc.PowClient.WorkQueueStatus()
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.WorkQueueStatusResponse{
+		Queued:              status.Queued,
+		InProgress:          status.InProgress,
+		InProgressByAccount: status.InProgressByAccount,
+		AverageWaitMs:       status.AverageWait.Milliseconds(),
+		BlockedAccounts:     status.BlockedAccounts,
+	})
+}
+
+// Handle work_cancel_all, cancel every work job so the queue is drained, e.g. before switching work servers
+func (hc *HttpController) HandleWorkCancelAll(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	cancelled := hc.PowClient.WorkCancelAll(time.Duration(hc.Wallet.Config.Wallet.WorkCancelTimeout) * time.Second)
+	log.Infof("Cancelled %d work jobs", cancelled)
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.WorkCancelAllResponse{
+		Cancelled: cancelled,
+	})
+}
+
+// Handle work_cache_clear, remove all of the cached work so it's generated again
+func (hc *HttpController) HandleWorkCacheClear(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	cleared, err := hc.PowClient.WorkCacheClear()
+	if errors.Is(err, pow.ErrWorkCacheDisabled) {
+		ErrBadRequest(w, r, ErrorCodeWorkCacheDisabled, "The work cache is disabled, work_cache_ttl is 0")
+		return
+	} else if errors.Is(err, cache.ErrDeletePrefixNotSupported) {
+		ErrBadRequest(w, r, ErrorCodeNotSupported, "The work cache can't be cleared with this cache_backend, the work expires after work_cache_ttl")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+	log.Infof("Cleared the cached work of %d roots", cleared)
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.WorkCacheClearResponse{
+		Cleared: cleared,
+	})
+}
+
+// Handle work_peer_add and work_peer_remove, they change the work peers until the config is reloaded
+func (hc *HttpController) HandleWorkPeerChange(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	var peerRequest requests.WorkPeerRequest
+	if err := mapstructure.Decode(rawRequest, &peerRequest); err != nil {
+		log.Errorf("Error unmarshalling work peer request %s", err)
+		ErrUnableToParseJson(w, r)
+		return
+	} else if peerRequest.Action == "" || peerRequest.Url == "" {
+		ErrUnableToParseJson(w, r)
+		return
+	}
+
+	var err error
+	if peerRequest.Action == "work_peer_add" {
+		err = hc.PowClient.AddWorkPeer(peerRequest.Url)
+	} else {
+		err = hc.PowClient.RemoveWorkPeer(peerRequest.Url)
+	}
+	if errors.Is(err, pow.ErrInvalidWorkPeer) {
+		ErrBadRequest(w, r, ErrorCodeInvalidUrl, "Invalid url")
+		return
+	} else if errors.Is(err, pow.ErrWorkPeerExists) {
+		ErrBadRequest(w, r, ErrorCodeWorkPeerExists, "Work peer already exists")
+		return
+	} else if errors.Is(err, pow.ErrWorkPeerNotFound) {
+		ErrBadRequest(w, r, ErrorCodeWorkPeerNotFound, "Work peer not found")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+	log.Infof("%s %s from %s", peerRequest.Action, peerRequest.Url, r.RemoteAddr)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, hc.workPeersResponse())
+}
+
+// Handle work_prefetch_accounts, generate work for every account's frontier in the background so the next blocks don't wait for it
+func (hc *HttpController) HandleWorkPrefetchAccounts(rawRequest *map[string]interface{}, w http.ResponseWriter, r *http.Request) {
+	request := hc.DecodeBaseRequest(rawRequest, w, r)
+	if request == nil {
+		return
+	}
+
+	// See if wallet exists
+	dbWallet := hc.WalletExists(request.Wallet, w, r)
+	if dbWallet == nil {
+		return
+	}
+
+	queued, err := hc.Wallet.WorkPrefetch(dbWallet)
+	if errors.Is(err, wallet.ErrWalletLocked) {
+		ErrWalletLocked(w, r)
+		return
+	} else if errors.Is(err, wallet.ErrFrontierCacheDisabled) {
+		ErrBadRequest(w, r, ErrorCodeFrontierCacheDisabled, "The frontier cache is disabled, there's nowhere to keep the work")
+		return
+	} else if err != nil {
+		ErrInternalServerError(w, r, err.Error())
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, &responses.WorkPrefetchAccountsResponse{
+		Queued: queued,
+	})
+}
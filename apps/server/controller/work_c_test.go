@@ -4,9 +4,17 @@ import (
 	"bytes"
 	"encoding/json"
 	"io"
+	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/cache"
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -55,6 +63,293 @@ func TestWorkGenerate(t *testing.T) {
 
 	// Make sure uuid is valid
 	assert.Contains(t, respJson, "error")
-	assert.Equal(t, "Invalid hash", respJson["error"])
+	assert.Equal(t, "INVALID_HASH", respJson["error_code"])
 
+	// Test invalid difficulty
+	reqBody = map[string]interface{}{
+		"action":     "work_generate",
+		"hash":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		"difficulty": "notadifficulty",
+	}
+	body, _ = json.Marshal(reqBody)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	MockController.Gateway(w, req)
+	resp = w.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, 400, resp.StatusCode)
+
+	respJson = map[string]interface{}{}
+	respBody, _ = io.ReadAll(resp.Body)
+	assert.Nil(t, json.Unmarshal(respBody, &respJson))
+	assert.Equal(t, "INVALID_JSON", respJson["error_code"])
+	assert.NotContains(t, respJson, "work")
+
+	// A multiplier instead
+	for multiplier, status := range map[interface{}]int{2: 200, "1.5": 200, 0.5: 400, "lots": 400} {
+		reqBody = map[string]interface{}{
+			"action":     "work_generate",
+			"hash":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+			"multiplier": multiplier,
+		}
+		body, _ = json.Marshal(reqBody)
+		w = httptest.NewRecorder()
+		req = httptest.NewRequest("POST", "/", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		MockController.Gateway(w, req)
+		assert.Equal(t, status, w.Code, multiplier)
+	}
+}
+
+func TestRequestedWorkMultiplier(t *testing.T) {
+	multiplier, err := requestedWorkMultiplier(nil, nil, 64)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, multiplier)
+	multiplier, err = requestedWorkMultiplier(utils.ToPtr(""), nil, 64)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, multiplier)
+
+	// A difficulty is the same whatever the threshold
+	multiplier, err = requestedWorkMultiplier(utils.ToPtr("fffffffc00000000"), nil, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 128, multiplier)
+	_, err = requestedWorkMultiplier(utils.ToPtr("nothex"), nil, 64)
+	assert.NotNil(t, err)
+
+	// A multiplier is of the threshold
+	var asked interface{} = 1.5
+	multiplier, err = requestedWorkMultiplier(nil, &asked, 64)
+	assert.Nil(t, err)
+	assert.Equal(t, 96, multiplier)
+	asked = "2"
+	multiplier, err = requestedWorkMultiplier(nil, &asked, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, multiplier)
+	for _, invalid := range []interface{}{0.5, "abc", true, 1e12} {
+		asked = invalid
+		_, err = requestedWorkMultiplier(nil, &asked, 64)
+		assert.NotNil(t, err, invalid)
+	}
+
+	// Not both
+	asked = 2
+	_, err = requestedWorkMultiplier(utils.ToPtr("fffffffc00000000"), &asked, 64)
+	assert.NotNil(t, err)
+}
+
+func TestWorkPeers(t *testing.T) {
+	hc := newTestController(t)
+	doAdmin := func(reqBody map[string]interface{}) (int, []byte) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		hc.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	status, body := doAdmin(map[string]interface{}{"action": "work_peers"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"work_peers":[]}`, strings.TrimSpace(string(body)))
+
+	status, body = doAdmin(map[string]interface{}{"action": "work_peer_add", "url": "http://localhost:7000"})
+	assert.Equal(t, 200, status)
+	var respJson responses.WorkPeersResponse
+	json.Unmarshal(body, &respJson)
+	assert.Equal(t, []responses.WorkPeer{{Url: "http://localhost:7000"}}, respJson.WorkPeers)
+	assert.Equal(t, []string{"http://localhost:7000"}, hc.PowClient.WorkPeers())
+
+	status, body = doAdmin(map[string]interface{}{"action": "work_peers"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"work_peers":[{"url":"http://localhost:7000","protocol":"","last_success":null,"last_failure":null,"average_latency_ms":0,"successes":0,"failures":0,"invalid_work":0}]}`, strings.TrimSpace(string(body)))
+
+	// errors
+	var errJson map[string]interface{}
+	status, body = doAdmin(map[string]interface{}{"action": "work_peer_add", "url": "http://localhost:7000"})
+	assert.Equal(t, 400, status)
+	json.Unmarshal(body, &errJson)
+	assert.Equal(t, "WORK_PEER_EXISTS", errJson["error_code"])
+	status, body = doAdmin(map[string]interface{}{"action": "work_peer_add", "url": "localhost:7000"})
+	assert.Equal(t, 400, status)
+	json.Unmarshal(body, &errJson)
+	assert.Equal(t, "INVALID_URL", errJson["error_code"])
+	status, _ = doAdmin(map[string]interface{}{"action": "work_peer_add"})
+	assert.Equal(t, 400, status)
+
+	status, body = doAdmin(map[string]interface{}{"action": "work_peer_remove", "url": "http://localhost:7000"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"work_peers":[]}`, strings.TrimSpace(string(body)))
+	status, body = doAdmin(map[string]interface{}{"action": "work_peer_remove", "url": "http://localhost:7000"})
+	assert.Equal(t, 400, status)
+	json.Unmarshal(body, &errJson)
+	assert.Equal(t, "WORK_PEER_NOT_FOUND", errJson["error_code"])
+}
+
+func TestWorkQueueStatus(t *testing.T) {
+	hc := newTestController(t)
+	body, _ := json.Marshal(map[string]interface{}{"action": "work_queue_status"})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+	hc.AdminHandler(w, req)
+	resp := w.Result()
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, `{"queued":0,"in_progress":0,"in_progress_by_account":{},"average_wait_ms":0,"blocked_accounts":[]}`, strings.TrimSpace(string(respBody)))
+}
+
+func TestWorkCancelAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// The work peer holds work_generate until it's cancelled
+	httpmock.RegisterResponder("POST", "http://localhost:7001",
+		func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		},
+	)
+
+	hc := newTestController(t)
+	doAdmin := func() (int, string) {
+		body, _ := json.Marshal(map[string]interface{}{"action": "work_cancel_all"})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		hc.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, strings.TrimSpace(string(respBody))
+	}
+
+	status, body := doAdmin()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"cancelled":0}`, body)
+
+	assert.Nil(t, hc.PowClient.AddWorkPeer("http://localhost:7001"))
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := hc.PowClient.WorkGenerateMeta("cancelhash", 1, false, false, "")
+			errs <- err
+		}()
+	}
+	assert.Eventually(t, func() bool { return hc.PowClient.WorkQueueStatus().InProgress == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	status, body = doAdmin()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"cancelled":2}`, body)
+	assert.ErrorIs(t, <-errs, pow.ErrWorkCancelled)
+	assert.ErrorIs(t, <-errs, pow.ErrWorkCancelled)
+}
+
+func TestWorkPrefetchAccounts(t *testing.T) {
+	hc := newTestController(t)
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("8d1f4a7c0e3b6d9f2a5c8e1b4d7f0a3c6e9b2d5f8a1c4e7b0d3f6a9c2e5b8d14"))
+	dbWallet, _ := hc.Wallet.WalletCreate(newSeed)
+	accounts, _ := hc.Wallet.AccountsCreate(dbWallet, 2)
+	unopened := accounts[1].Address
+
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "accounts_frontiers" {
+				frontiers := map[string]interface{}{}
+				for _, account := range pr["accounts"].([]interface{}) {
+					if account != unopened {
+						// The frontier has hard coded work in the pow client
+						frontiers[account.(string)] = "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3"
+					}
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontiers": frontiers,
+					"errors": map[string]interface{}{
+						unopened: "Account not found",
+					},
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	doAdmin := func(reqBody map[string]interface{}) (int, []byte) {
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		hc.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, respBody
+	}
+
+	status, body := doAdmin(map[string]interface{}{"action": "work_prefetch_accounts", "wallet": dbWallet.ID.String()})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, `{"queued":2}`, strings.TrimSpace(string(body)))
+
+	status, _ = doAdmin(map[string]interface{}{"action": "work_prefetch_accounts"})
+	assert.Equal(t, 400, status)
+
+	// Not through the gateway
+	reqBody, _ := json.Marshal(map[string]interface{}{"action": "work_prefetch_accounts", "wallet": dbWallet.ID.String()})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	hc.Gateway(w, req)
+	assert.Equal(t, 403, w.Result().StatusCode)
+}
+
+func TestWorkCacheClear(t *testing.T) {
+	hc := newTestController(t)
+	doAdmin := func() (int, map[string]interface{}) {
+		body, _ := json.Marshal(map[string]interface{}{"action": "work_cache_clear"})
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/admin", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+mockAdminToken)
+		hc.AdminHandler(w, req)
+		resp := w.Result()
+		defer resp.Body.Close()
+		var respJson map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&respJson)
+		return resp.StatusCode, respJson
+	}
+
+	status, respJson := doAdmin()
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "WORK_CACHE_DISABLED", respJson["error_code"])
+
+	workCache := cache.NewWorkCache(hc.Cache, time.Minute)
+	hc.PowClient.SetWorkCache(workCache)
+	assert.Nil(t, workCache.Set("7C1E4A9F2B5D8E0A3C6F9B2E5D8A1C4F7E0B3D6A9C2F5E8B1D4A7C0F3E6B9D2A", "205452237a9b01f4", pow.DifficultyFromMultiplier(1)))
+	assert.Nil(t, workCache.Set("8D2F5B0A3C6E9F1B4D7A0C3E6F9B2D5A8C1E4F7B0D3A6C9E2F5B8D1A4C7E0F3B", "205452237a9b01f4", pow.DifficultyFromMultiplier(1)))
+
+	status, respJson = doAdmin()
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(2), respJson["cleared"])
+	_, _, ok := workCache.Get("7C1E4A9F2B5D8E0A3C6F9B2E5D8A1C4F7E0B3D6A9C2F5E8B1D4A7C0F3E6B9D2A")
+	assert.False(t, ok)
+
+	// Memcached can't list the work to delete it
+	hc.PowClient.SetWorkCache(cache.NewWorkCache(cache.NewMemcachedCache("localhost:11211", "pippin"), time.Minute))
+	status, respJson = doAdmin()
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "NOT_SUPPORTED", respJson["error_code"])
 }
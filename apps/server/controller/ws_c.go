@@ -0,0 +1,182 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/requests"
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	walletmodels "github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// How long a write to a /ws client can take
+	wsWriteWait = 10 * time.Second
+	// A client that doesn't answer a ping within this is disconnected
+	wsPongWait = 60 * time.Second
+	// Pings go out before the client's pong wait runs out
+	wsPingPeriod = wsPongWait * 9 / 10
+	// Subscribe messages are small, anything bigger isn't one
+	wsMaxMessageSize = 4096
+	// How many wallets one connection can subscribe to
+	wsMaxWallets = 100
+)
+
+// The kinds of events a /ws client can ask for
+var WS_EVENTS = []string{
+	walletmodels.WalletEventConfirmation,
+	walletmodels.WalletEventReceivable,
+	walletmodels.WalletEventPocketed,
+	walletmodels.WalletEventWork,
+}
+
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// GET /ws, streams the events of the wallets a client subscribes to
+// Clients send {"action": "subscribe", "wallet": "...", "events": [...]} and {"action": "unsubscribe", "wallet": "..."}
+// Events only come from this instance, confirmations need node_ws_url
+// With require_api_key the upgrade request needs an API key in X-Api-Key
+func (hc *HttpController) HandleWebsocket(w http.ResponseWriter, r *http.Request) {
+	if hc.RateLimiter != nil && !hc.RateLimiter.Allow(requestIP(r)) {
+		ErrRateLimited(w, r)
+		return
+	}
+
+	// Any scope can read the events, the key can only be in X-Api-Key
+	if hc.requireApiKey() {
+		if _, err := hc.verifyApiKey(r.Header.Get(apiKeyHeader)); err != nil {
+			ErrUnauthorized(w, r)
+			return
+		}
+	}
+
+	// Upgrade already responded if it failed
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Errorf("Error upgrading websocket connection %s", err)
+		return
+	}
+
+	sub := hc.Wallet.SubscribeEvents()
+	replies := make(chan interface{}, 10)
+	done := make(chan struct{})
+	go wsWrite(conn, sub, replies, done)
+
+	conn.SetReadLimit(wsMaxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	conn.SetPongHandler(func(string) error {
+		conn.SetReadDeadline(time.Now().Add(wsPongWait))
+		return nil
+	})
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			break
+		}
+		select {
+		case replies <- hc.wsHandleMessage(sub, message):
+		case <-done:
+		}
+	}
+
+	// The writer stops once the events are closed
+	sub.Close()
+	<-done
+}
+
+// The reply to a client message, an ack or an ErrorResponse
+func (hc *HttpController) wsHandleMessage(sub *wallet.EventSubscription, message []byte) interface{} {
+	var request requests.WSSubscribeRequest
+	if err := json.Unmarshal(message, &request); err != nil || request.Wallet == "" {
+		return &UnableToParseJsonError
+	}
+
+	switch strings.ToLower(request.Action) {
+	case "subscribe":
+		for _, event := range request.Events {
+			if !slices.Contains(WS_EVENTS, event) {
+				return &ErrorResponse{
+					Error:     fmt.Sprintf("Invalid event %s, must be one of %s", event, strings.Join(WS_EVENTS, ", ")),
+					ErrorCode: ErrorCodeInvalidEvent,
+				}
+			}
+		}
+		if _, err := hc.Wallet.GetWallet(request.Wallet); errors.Is(err, wallet.ErrWalletNotFound) || errors.Is(err, wallet.ErrInvalidWallet) {
+			return &WalletNotFoundError
+		} else if err != nil {
+			return &ErrorResponse{Error: err.Error(), ErrorCode: ErrorCodeInternal}
+		}
+		if !sub.Subscribed(request.Wallet) && sub.Wallets() >= wsMaxWallets {
+			return &ErrorResponse{
+				Error:     fmt.Sprintf("Can't subscribe to more than %d wallets", wsMaxWallets),
+				ErrorCode: ErrorCodeTooManySubscriptions,
+			}
+		}
+		sub.Add(request.Wallet, request.Events)
+	case "unsubscribe":
+		sub.Remove(request.Wallet)
+	default:
+		return &ErrorResponse{
+			Error:     "Invalid action, must be subscribe or unsubscribe",
+			ErrorCode: ErrorCodeInvalidAction,
+		}
+	}
+
+	return &responses.WSAckResponse{
+		Ack:    strings.ToLower(request.Action),
+		Wallet: request.Wallet,
+	}
+}
+
+// The only writer of conn, it closes conn and done when it stops
+func wsWrite(conn *websocket.Conn, sub *wallet.EventSubscription, replies <-chan interface{}, done chan struct{}) {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer func() {
+		ticker.Stop()
+		conn.Close()
+		close(done)
+	}()
+
+	for {
+		select {
+		case reply := <-replies:
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteJSON(reply); err != nil {
+				return
+			}
+		case event, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteJSON(&responses.WSEventResponse{
+				Event:   event.Event,
+				Wallet:  event.Wallet,
+				Account: event.Account,
+				Hash:    event.Hash,
+				Subtype: event.Subtype,
+				Amount:  event.Amount,
+				Source:  event.Source,
+				Time:    event.Time.Unix(),
+			}); err != nil {
+				return
+			}
+		case <-ticker.C:
+			conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebsocket(t *testing.T) {
+	hc := newTestController(t)
+	seed, _ := utils.GenerateSeed(strings.NewReader("9c2f5b8e1d4a7c0f3b6e9d2a5c8f1b4e7d0a3c6f9b2e5d8a1c4f7b0e3d6a9c2f"))
+	wallet, err := hc.Wallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := hc.Wallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(hc.HandleWebsocket))
+	defer server.Close()
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	var errResp ErrorResponse
+	assert.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte("badjson")))
+	assert.Nil(t, conn.ReadJSON(&errResp))
+	assert.Equal(t, ErrorCodeInvalidJson, errResp.ErrorCode)
+
+	assert.Nil(t, conn.WriteJSON(map[string]interface{}{"action": "subscribe", "wallet": "2b57d4a0-c8a8-4d7a-8a57-0a0a0a0a0a0a"}))
+	assert.Nil(t, conn.ReadJSON(&errResp))
+	assert.Equal(t, ErrorCodeWalletNotFound, errResp.ErrorCode)
+
+	assert.Nil(t, conn.WriteJSON(map[string]interface{}{"action": "subscribe", "wallet": wallet.ID.String(), "events": []string{"send"}}))
+	assert.Nil(t, conn.ReadJSON(&errResp))
+	assert.Equal(t, ErrorCodeInvalidEvent, errResp.ErrorCode)
+
+	assert.Nil(t, conn.WriteJSON(map[string]interface{}{"action": "listen", "wallet": wallet.ID.String()}))
+	assert.Nil(t, conn.ReadJSON(&errResp))
+	assert.Equal(t, ErrorCodeInvalidAction, errResp.ErrorCode)
+
+	var ack responses.WSAckResponse
+	assert.Nil(t, conn.WriteJSON(map[string]interface{}{"action": "subscribe", "wallet": wallet.ID.String(), "events": []string{"confirmation"}}))
+	assert.Nil(t, conn.ReadJSON(&ack))
+	assert.Equal(t, responses.WSAckResponse{Ack: "subscribe", Wallet: wallet.ID.String()}, ack)
+
+	// Only the kinds that were asked for
+	hc.Wallet.PublishConfirmation("nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", "A1", "send", "1000", acc.Address)
+	hc.Wallet.PublishConfirmation(acc.Address, "A2", "receive", "1000", "A1")
+	var event responses.WSEventResponse
+	assert.Nil(t, conn.ReadJSON(&event))
+	assert.NotZero(t, event.Time)
+	event.Time = 0
+	assert.Equal(t, responses.WSEventResponse{
+		Event:   "confirmation",
+		Wallet:  wallet.ID.String(),
+		Account: acc.Address,
+		Hash:    "A2",
+		Subtype: "receive",
+		Amount:  "1000",
+	}, event)
+
+	// Nothing once unsubscribed, the ack is the next message
+	assert.Nil(t, conn.WriteJSON(map[string]interface{}{"action": "unsubscribe", "wallet": wallet.ID.String()}))
+	assert.Nil(t, conn.ReadJSON(&ack))
+	assert.Equal(t, "unsubscribe", ack.Ack)
+	hc.Wallet.PublishConfirmation(acc.Address, "A3", "receive", "1000", "A1")
+	assert.Nil(t, conn.WriteJSON(map[string]interface{}{"action": "subscribe", "wallet": wallet.ID.String()}))
+	assert.Nil(t, conn.ReadJSON(&ack))
+	assert.Equal(t, "subscribe", ack.Ack)
+}
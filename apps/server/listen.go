@@ -0,0 +1,75 @@
+package server
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/controller"
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// The http.Server for handler, with HTTP/2 as enable_http2 and enable_h2c say
+// Over TLS HTTP/2 is negotiated with ALPN, without TLS clients have to speak h2c, or upgrade to it
+func newHTTPServer(conf *models.ServerConfig, handler http.Handler) *http.Server {
+	server := &http.Server{Addr: fmt.Sprintf("%s:%d", conf.Host, conf.Port)}
+	if conf.EnableHTTP2 != nil && !*conf.EnableHTTP2 {
+		// A non-nil empty map turns off HTTP/2 over TLS
+		server.TLSNextProto = map[string]func(*http.Server, *tls.Conn, http.Handler){}
+		server.Handler = handler
+		return server
+	}
+	h2s := &http2.Server{}
+	if conf.EnableH2C == nil || *conf.EnableH2C {
+		handler = h2c.NewHandler(handler, h2s)
+	}
+	server.Handler = handler
+	// Only fails if the server's TLS config can't do HTTP/2, it doesn't have one yet
+	if err := http2.ConfigureServer(server, h2s); err != nil {
+		log.Errorf("Unable to configure HTTP/2 %s", err)
+	}
+	return server
+}
+
+// Serve with the certificate of certs, or without TLS if it's nil, returns when the server stops
+func listenAndServe(conf *models.ServerConfig, server *http.Server, certs *certReloader) error {
+	if certs == nil {
+		return server.ListenAndServe()
+	}
+	// Keep what http2.ConfigureServer put in it
+	if server.TLSConfig == nil {
+		server.TLSConfig = &tls.Config{}
+	}
+	if err := configureTLS(server.TLSConfig, conf, certs); err != nil {
+		return err
+	}
+	return server.ListenAndServeTLS("", "")
+}
+
+// The gRPC WalletService, with the same TLS as the gateway
+func newGrpcServer(conf *models.ServerConfig, hc *controller.HttpController, certs *certReloader) (*grpc.Server, error) {
+	var opts []grpc.ServerOption
+	if certs != nil {
+		tlsConf := &tls.Config{}
+		if err := configureTLS(tlsConf, conf, certs); err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConf)))
+	}
+	return controller.NewGrpcServer(hc, opts...), nil
+}
+
+// Serve server on grpc_port, returns when the server stops
+func serveGrpc(conf *models.ServerConfig, server *grpc.Server) error {
+	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", conf.Host, conf.GrpcPort))
+	if err != nil {
+		return err
+	}
+	return server.Serve(lis)
+}
@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/controller"
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/creasty/defaults"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/http2"
+)
+
+// A client that speaks h2c with prior knowledge
+var h2cClient = &http.Client{
+	Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	},
+}
+
+// The gateway behind the handler newHTTPServer builds for conf
+func serveGateway(t *testing.T, conf *models.PippinConfig) *httptest.Server {
+	hc := &controller.HttpController{Wallet: &wallet.NanoWallet{Config: conf}}
+	app := chi.NewRouter()
+	app.Post("/", hc.Gateway)
+	ts := httptest.NewServer(newHTTPServer(&conf.Server, app).Handler)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func postGatewayActions(client *http.Client, url string) (*http.Response, error) {
+	resp, err := client.Post(url, "application/json", strings.NewReader(`{"action": "gateway_actions"}`))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var respJson map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&respJson); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func TestHTTP2Gateway(t *testing.T) {
+	var conf models.PippinConfig
+	defaults.Set(&conf)
+	conf.Server.Host = "127.0.0.1"
+	conf.Server.Port = 11338
+
+	// HTTP/2 is negotiated over TLS by default
+	server := newHTTPServer(&conf.Server, http.NotFoundHandler())
+	assert.Equal(t, "127.0.0.1:11338", server.Addr)
+	assert.Contains(t, server.TLSNextProto, "h2")
+
+	// h2c is on by default, HTTP/1.1 clients still work
+	ts := serveGateway(t, &conf)
+	resp, err := postGatewayActions(h2cClient, ts.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, resp.ProtoMajor)
+	resp, err = postGatewayActions(http.DefaultClient, ts.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, resp.ProtoMajor)
+
+	// Without h2c, plain HTTP/2 is refused
+	disabled := false
+	conf.Server.EnableH2C = &disabled
+	ts = serveGateway(t, &conf)
+	_, err = postGatewayActions(h2cClient, ts.URL)
+	assert.NotNil(t, err)
+	resp, err = postGatewayActions(http.DefaultClient, ts.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, resp.ProtoMajor)
+
+	// enable_http2 false turns off both
+	conf.Server.EnableH2C = nil
+	conf.Server.EnableHTTP2 = &disabled
+	server = newHTTPServer(&conf.Server, http.NotFoundHandler())
+	assert.NotNil(t, server.TLSNextProto)
+	assert.Empty(t, server.TLSNextProto)
+	ts = serveGateway(t, &conf)
+	_, err = postGatewayActions(h2cClient, ts.URL)
+	assert.NotNil(t, err)
+}
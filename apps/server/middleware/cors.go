@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Headers browsers may send cross origin, everything a client of the gateway uses
+var corsAllowedHeaders = []string{"Content-Type", "Authorization", "X-Api-Key", "X-Request-ID", "If-None-Match", "traceparent"}
+
+// How long browsers can cache a preflight, in seconds
+const corsMaxAge = "600"
+
+// CORS lets browsers on one of origins call the server, * is any origin
+// A preflight OPTIONS request from an allowed origin is answered here and doesn't go any further
+// Requests without an Origin or from one that isn't allowed go through without any CORS headers, so the browser blocks them
+func CORS(origins []string, methods []string) func(http.Handler) http.Handler {
+	anyOrigin := slices.Contains(origins, "*")
+	allowMethods := strings.Join(methods, ", ")
+	allowHeaders := strings.Join(corsAllowedHeaders, ", ")
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" || !(anyOrigin || slices.Contains(origins, origin)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if anyOrigin {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			} else {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				w.Header().Add("Vary", "Origin")
+			}
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				w.Header().Set("Access-Control-Allow-Methods", allowMethods)
+				w.Header().Set("Access-Control-Allow-Headers", allowHeaders)
+				w.Header().Set("Access-Control-Max-Age", corsMaxAge)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			w.Header().Set("Access-Control-Expose-Headers", RequestIDHeader)
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func corsRouter(origins []string) *chi.Mux {
+	r := chi.NewRouter()
+	r.Use(CORS(origins, []string{"GET", "POST"}))
+	r.Post("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	return r
+}
+
+func TestCORSAllowedOrigin(t *testing.T) {
+	r := corsRouter([]string{"https://app.example.com"})
+
+	// Preflight is answered before routing, there's no OPTIONS route
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("OPTIONS", "/", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "Origin", w.Header().Get("Vary"))
+	assert.Equal(t, "GET, POST", w.Header().Get("Access-Control-Allow-Methods"))
+	assert.Contains(t, w.Header().Get("Access-Control-Allow-Headers"), "X-Api-Key")
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	r.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "ok", w.Body.String())
+	assert.Equal(t, "https://app.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, RequestIDHeader, w.Header().Get("Access-Control-Expose-Headers"))
+}
+
+func TestCORSOtherOrigin(t *testing.T) {
+	r := corsRouter([]string{"https://app.example.com"})
+
+	// No headers, so the browser blocks it
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("OPTIONS", "/", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	r.ServeHTTP(w, req)
+	assert.NotEqual(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+
+	// Not from a browser
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/", nil)
+	r.ServeHTTP(w, req)
+	assert.Equal(t, "ok", w.Body.String())
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestCORSAnyOrigin(t *testing.T) {
+	r := corsRouter([]string{"*"})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/", nil)
+	req.Header.Set("Origin", "https://anything.example.com")
+	r.ServeHTTP(w, req)
+	assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, w.Header().Get("Vary"))
+}
@@ -134,6 +134,11 @@ type defaultLogEntry struct {
 }
 
 func (l *defaultLogEntry) Write(status, bytes int, header http.Header, elapsed time.Duration, extra interface{}) {
+	// One object per request, with fields to search for instead of a line to parse
+	if log.IsJSON() {
+		log.Info("request", "request_id", GetReqID(l.request.Context()), "method", l.request.Method, "uri", l.request.RequestURI, "remote_addr", l.request.RemoteAddr, "status", status, "bytes", bytes, "duration_ms", elapsed.Milliseconds())
+		return
+	}
 	switch {
 	case status < 200:
 		cW(l.buf, l.useColor, bBlue, "%03d", status)
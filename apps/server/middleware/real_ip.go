@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"strings"
+)
+
+var xForwardedFor = http.CanonicalHeaderKey("X-Forwarded-For")
+
+// RealIP replaces RemoteAddr with the client's IP from X-Forwarded-For, but only for requests that come from
+// one of trustedProxies, so anything after it that looks at RemoteAddr sees the client and not the proxy
+// Anybody else could put anything in the header, so it's ignored for them
+func RealIP(trustedProxies []net.IPNet) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		fn := func(w http.ResponseWriter, r *http.Request) {
+			if ip, ok := forwardedIP(r, trustedProxies); ok {
+				r.RemoteAddr = ip
+			}
+			next.ServeHTTP(w, r)
+		}
+		return http.HandlerFunc(fn)
+	}
+}
+
+// ClientIP is the IP of whoever made the request, from X-Forwarded-For if it came through trustedProxies
+func ClientIP(r *http.Request, trustedProxies []net.IPNet) string {
+	if ip, ok := forwardedIP(r, trustedProxies); ok {
+		return ip
+	}
+	return remoteIP(r.RemoteAddr)
+}
+
+func forwardedIP(r *http.Request, trustedProxies []net.IPNet) (string, bool) {
+	if len(trustedProxies) < 1 || r.Header.Get(xForwardedFor) == "" {
+		return "", false
+	}
+	hop := remoteIP(r.RemoteAddr)
+	if !isTrusted(hop, trustedProxies) {
+		return "", false
+	}
+
+	// Every proxy appends who it got the request from, so walk back from the right
+	// The first one a trusted proxy didn't add is the client, everything to the left of it could be made up
+	entries := strings.Split(strings.Join(r.Header.Values(xForwardedFor), ","), ",")
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := strings.TrimSpace(entries[i])
+		if net.ParseIP(entry) == nil {
+			break
+		}
+		hop = entry
+		if !isTrusted(hop, trustedProxies) {
+			break
+		}
+	}
+	return hop, true
+}
+
+func isTrusted(ip string, trustedProxies []net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, proxy := range trustedProxies {
+		if proxy.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoteAddr is host:port, except when something already replaced it with just the IP
+func remoteIP(remoteAddr string) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParseCIDRs(t *testing.T, cidrs ...string) []net.IPNet {
+	nets := []net.IPNet{}
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		assert.Nil(t, err)
+		nets = append(nets, *ipNet)
+	}
+	return nets
+}
+
+func TestClientIP(t *testing.T) {
+	trusted := mustParseCIDRs(t, "10.0.0.0/8", "fd00::/8")
+
+	tests := map[string]struct {
+		remoteAddr     string
+		forwardedFor   []string
+		expectedIP     string
+		trustedProxies []net.IPNet
+	}{
+		"No proxy": {
+			"203.0.113.7:51234", nil, "203.0.113.7", trusted,
+		},
+		"Single trusted proxy": {
+			"10.0.0.2:51234", []string{"203.0.113.7"}, "203.0.113.7", trusted,
+		},
+		"Multi-hop through trusted proxies": {
+			"10.0.0.2:51234", []string{"203.0.113.7, 10.0.0.3, 10.0.0.4"}, "203.0.113.7", trusted,
+		},
+		"Multi-hop in separate headers": {
+			"10.0.0.2:51234", []string{"203.0.113.7", "10.0.0.3"}, "203.0.113.7", trusted,
+		},
+		"IPv6 proxy": {
+			"[fd00::2]:51234", []string{"2001:db8::7"}, "2001:db8::7", trusted,
+		},
+		"Spoofed from an untrusted source": {
+			"203.0.113.7:51234", []string{"198.51.100.1"}, "203.0.113.7", trusted,
+		},
+		"Spoofed entries left of the client are ignored": {
+			"10.0.0.2:51234", []string{"198.51.100.1, 203.0.113.7, 10.0.0.3"}, "203.0.113.7", trusted,
+		},
+		"Garbage stops at the last good hop": {
+			"10.0.0.2:51234", []string{"not-an-ip, 10.0.0.3"}, "10.0.0.3", trusted,
+		},
+		"Every hop trusted": {
+			"10.0.0.2:51234", []string{"10.0.0.5, 10.0.0.3"}, "10.0.0.5", trusted,
+		},
+		"No trusted proxies": {
+			"10.0.0.2:51234", []string{"203.0.113.7"}, "10.0.0.2", nil,
+		},
+	}
+
+	for name, test := range tests {
+		req := httptest.NewRequest("POST", "/", nil)
+		req.RemoteAddr = test.remoteAddr
+		for _, value := range test.forwardedFor {
+			req.Header.Add("X-Forwarded-For", value)
+		}
+		assert.Equal(t, test.expectedIP, ClientIP(req, test.trustedProxies), name)
+	}
+}
+
+func TestRealIP(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(RealIP(mustParseCIDRs(t, "10.0.0.0/8")))
+	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.RemoteAddr))
+	})
+
+	// Replaced for a trusted proxy
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.0.0.2:51234"
+	req.Header.Set("X-Forwarded-For", "198.51.100.1, 203.0.113.7")
+	r.ServeHTTP(w, req)
+	assert.Equal(t, "203.0.113.7", w.Body.String())
+
+	// Anybody else keeps their address
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "203.0.113.7:51234"
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	r.ServeHTTP(w, req)
+	assert.Equal(t, "203.0.113.7:51234", w.Body.String())
+}
@@ -12,19 +12,19 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"regexp"
 	"strings"
 	"sync/atomic"
-)
-
-// Key to use when setting the request ID.
-type ctxKeyRequestID int
 
-// RequestIDKey is the key that holds the unique request ID in a request context.
-const RequestIDKey ctxKeyRequestID = 0
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
 
 // RequestIDHeader is the name of the HTTP Header which contains the request id.
 // Exported so that it can be changed by developers
-var RequestIDHeader = "X-Request-Id"
+var RequestIDHeader = log.RequestIDHeader
+
+// IDs clients send are kept if they're like this, otherwise they'd end up in the logs and the node's requests as they are
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:/+=-]{1,128}$`)
 
 var prefix string
 var reqid uint64
@@ -66,15 +66,17 @@ func init() {
 // where "random" is a base62 random string that uniquely identifies this go
 // process, and where the last number is an atomically incremented request
 // counter.
+// The ID is stored with log.WithRequestID and returned in the RequestIDHeader of the response.
 func RequestID(next http.Handler) http.Handler {
 	fn := func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		requestID := r.Header.Get(RequestIDHeader)
-		if requestID == "" {
+		if !validRequestID.MatchString(requestID) {
 			myid := atomic.AddUint64(&reqid, 1)
 			requestID = fmt.Sprintf("%s-%06d", prefix, myid)
 		}
-		ctx = context.WithValue(ctx, RequestIDKey, requestID)
+		ctx = log.WithRequestID(ctx, requestID)
+		w.Header().Set(RequestIDHeader, requestID)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	}
 	return http.HandlerFunc(fn)
@@ -83,13 +85,7 @@ func RequestID(next http.Handler) http.Handler {
 // GetReqID returns a request ID from the given context if one is present.
 // Returns the empty string if a request ID cannot be found.
 func GetReqID(ctx context.Context) string {
-	if ctx == nil {
-		return ""
-	}
-	if reqID, ok := ctx.Value(RequestIDKey).(string); ok {
-		return reqID
-	}
-	return ""
+	return log.RequestID(ctx)
 }
 
 // NextRequestID generates the next request ID in the sequence.
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
 )
 
 func maintainDefaultRequestID() func() {
@@ -69,3 +71,37 @@ func TestRequestID(t *testing.T) {
 		}
 	}
 }
+
+func TestRequestIDResponse(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(RequestID)
+	r.Get("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(GetReqID(r.Context())))
+	})
+
+	// The client's ID is kept and returned
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Set("X-Request-ID", "send-42")
+	r.ServeHTTP(w, req)
+	assert.Equal(t, "send-42", w.Body.String())
+	assert.Equal(t, "send-42", w.Header().Get("X-Request-ID"))
+
+	// Without one an ID is generated
+	w = httptest.NewRecorder()
+	req, _ = http.NewRequest("GET", "/", nil)
+	r.ServeHTTP(w, req)
+	generated := w.Header().Get("X-Request-ID")
+	assert.True(t, strings.HasPrefix(generated, prefix+"-"))
+	assert.Equal(t, generated, w.Body.String())
+
+	// IDs that can't go in the logs as they are get replaced
+	for _, id := range []string{"has spaces", "line\nbreak", strings.Repeat("a", 129)} {
+		w = httptest.NewRecorder()
+		req, _ = http.NewRequest("GET", "/", nil)
+		req.Header.Set("X-Request-ID", id)
+		r.ServeHTTP(w, req)
+		assert.NotEqual(t, id, w.Header().Get("X-Request-ID"))
+		assert.True(t, strings.HasPrefix(w.Header().Get("X-Request-ID"), prefix+"-"))
+	}
+}
@@ -0,0 +1,10 @@
+package requests
+
+type AccountBalanceHistoryRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string `json:"account" mapstructure:"account"`
+	Period      string `json:"period" mapstructure:"period"`
+	// Unix timestamps, or dates as YYYY-MM-DD
+	StartDate *interface{} `json:"start_date" mapstructure:"start_date"`
+	EndDate   *interface{} `json:"end_date" mapstructure:"end_date"`
+}
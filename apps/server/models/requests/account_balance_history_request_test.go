@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountBalanceHistoryRequest(t *testing.T) {
+	encoded := `{"action":"account_balance_history","wallet":"1234","account":"nano_1","period":"daily","start_date":1700000000,"end_date":"2024-03-01"}`
+	var decoded AccountBalanceHistoryRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_balance_history", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "daily", decoded.Period)
+	assert.Equal(t, float64(1700000000), *decoded.StartDate)
+	assert.Equal(t, "2024-03-01", *decoded.EndDate)
+}
+
+func TestMapStructureDecodeAccountBalanceHistoryRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":     "account_balance_history",
+		"wallet":     "1234",
+		"account":    "nano_1",
+		"period":     "hourly",
+		"start_date": "2024-03-01",
+	}
+	var decoded AccountBalanceHistoryRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_balance_history", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "hourly", decoded.Period)
+	assert.Equal(t, "2024-03-01", *decoded.StartDate)
+	assert.Nil(t, decoded.EndDate)
+}
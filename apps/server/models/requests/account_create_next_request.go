@@ -0,0 +1,7 @@
+package requests
+
+type AccountCreateNextRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	// Refuse if account_create would make more than this many unused accounts in a row
+	GapLimit *interface{} `json:"gap_limit,omitempty" mapstructure:"gap_limit,omitempty"`
+}
@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountCreateNextRequest(t *testing.T) {
+	encoded := `{"action":"account_create_next","wallet":"1234","gap_limit":20}`
+	var decoded AccountCreateNextRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_create_next", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, float64(20), *decoded.GapLimit)
+}
+
+func TestMapStructureDecodeAccountCreateNextRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "account_create_next",
+		"wallet": "1234",
+	}
+	var decoded AccountCreateNextRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_create_next", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Nil(t, decoded.GapLimit)
+}
@@ -3,4 +3,7 @@ package requests
 type AccountCreateRequest struct {
 	BaseRequest `mapstructure:",squash"`
 	Index       *interface{} `json:"index,omitempty" mapstructure:"index,omitempty"`
+	Seed        *string      `json:"seed,omitempty" mapstructure:"seed,omitempty"`
+	// Refuse if there would be more than this many unused accounts in a row
+	GapLimit *interface{} `json:"gap_limit,omitempty" mapstructure:"gap_limit,omitempty"`
 }
@@ -15,17 +15,30 @@ func TestDecodeAccountCreateRequest(t *testing.T) {
 	assert.Equal(t, "account_create", decoded.Action)
 	assert.Equal(t, "1234", decoded.Wallet)
 	assert.Equal(t, 1.0, *decoded.Index)
+	assert.Nil(t, decoded.Seed)
+	assert.Nil(t, decoded.GapLimit)
+
+	encoded = `{"action":"account_create","wallet":"1234","seed":"my seed","gap_limit":"20"}`
+	var decodedSeed AccountCreateRequest
+	json.Unmarshal([]byte(encoded), &decodedSeed)
+	assert.Equal(t, "my seed", *decodedSeed.Seed)
+	assert.Nil(t, decodedSeed.Index)
+	assert.Equal(t, "20", *decodedSeed.GapLimit)
 }
 
 func TestMapStructureDecodeAccountCreateRequest(t *testing.T) {
 	request := map[string]interface{}{
-		"action": "account_create",
-		"wallet": "1234",
-		"index":  1,
+		"action":    "account_create",
+		"wallet":    "1234",
+		"index":     1,
+		"seed":      "my seed",
+		"gap_limit": 20,
 	}
 	var decoded AccountCreateRequest
 	mapstructure.Decode(request, &decoded)
 	assert.Equal(t, "account_create", decoded.Action)
 	assert.Equal(t, "1234", decoded.Wallet)
 	assert.Equal(t, 1, *decoded.Index)
+	assert.Equal(t, "my seed", *decoded.Seed)
+	assert.Equal(t, 20, *decoded.GapLimit)
 }
@@ -0,0 +1,10 @@
+package requests
+
+// prefix and suffix of the address to search for, at least one of them, workers and timeout (seconds) are optional
+type AccountCreateVanityRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Prefix      string       `json:"prefix,omitempty" mapstructure:"prefix,omitempty"`
+	Suffix      string       `json:"suffix,omitempty" mapstructure:"suffix,omitempty"`
+	Workers     *interface{} `json:"workers,omitempty" mapstructure:"workers,omitempty"`
+	Timeout     *interface{} `json:"timeout,omitempty" mapstructure:"timeout,omitempty"`
+}
@@ -0,0 +1,37 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountCreateVanityRequest(t *testing.T) {
+	encoded := `{"action":"account_create_vanity","wallet":"1234","prefix":"1pip","suffix":"x","workers":4,"timeout":"30"}`
+	var decoded AccountCreateVanityRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_create_vanity", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "1pip", decoded.Prefix)
+	assert.Equal(t, "x", decoded.Suffix)
+	assert.Equal(t, 4.0, *decoded.Workers)
+	assert.Equal(t, "30", *decoded.Timeout)
+}
+
+func TestMapStructureDecodeAccountCreateVanityRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "account_create_vanity",
+		"wallet": "1234",
+		"suffix": "x",
+	}
+	var decoded AccountCreateVanityRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_create_vanity", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Empty(t, decoded.Prefix)
+	assert.Equal(t, "x", decoded.Suffix)
+	assert.Nil(t, decoded.Workers)
+	assert.Nil(t, decoded.Timeout)
+}
@@ -0,0 +1,7 @@
+package requests
+
+// The account has to be in the wallet
+type AccountFrontierRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string `json:"account" mapstructure:"account"`
+}
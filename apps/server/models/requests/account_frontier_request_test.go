@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountFrontierRequest(t *testing.T) {
+	encoded := `{"action":"account_frontier","wallet":"1234","account":"nano_1"}`
+	var decoded AccountFrontierRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_frontier", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.BpowKey)
+}
+
+func TestMapStructureDecodeAccountFrontierRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "account_frontier",
+		"wallet":  "1234",
+		"account": "nano_1",
+	}
+	var decoded AccountFrontierRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_frontier", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.BpowKey)
+}
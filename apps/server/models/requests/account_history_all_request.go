@@ -0,0 +1,8 @@
+package requests
+
+type AccountHistoryAllRequest struct {
+	Action  string `json:"action" mapstructure:"action"`
+	Account string `json:"account" mapstructure:"account"`
+	// Optional, at most account_history_max_blocks
+	MaxBlocks *interface{} `json:"max_blocks" mapstructure:"max_blocks"`
+}
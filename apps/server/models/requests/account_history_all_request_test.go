@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountHistoryAllRequest(t *testing.T) {
+	encoded := `{"action":"account_history_all","account":"nano_1","max_blocks":500}`
+	var decoded AccountHistoryAllRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_history_all", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, float64(500), *decoded.MaxBlocks)
+}
+
+func TestMapStructureDecodeAccountHistoryAllRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "account_history_all",
+		"account": "nano_1",
+	}
+	var decoded AccountHistoryAllRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_history_all", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.MaxBlocks)
+}
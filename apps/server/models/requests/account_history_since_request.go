@@ -0,0 +1,9 @@
+package requests
+
+type AccountHistorySinceRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string `json:"account" mapstructure:"account"`
+	SinceHash   string `json:"since_hash" mapstructure:"since_hash"`
+	// Optional, at most account_history_since_max_depth
+	MaxDepth *interface{} `json:"max_depth" mapstructure:"max_depth"`
+}
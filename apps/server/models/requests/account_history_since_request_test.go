@@ -0,0 +1,36 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountHistorySinceRequest(t *testing.T) {
+	encoded := `{"action":"account_history_since","wallet":"1234","account":"nano_1","since_hash":"abc","max_depth":500}`
+	var decoded AccountHistorySinceRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_history_since", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "abc", decoded.SinceHash)
+	assert.Equal(t, float64(500), *decoded.MaxDepth)
+}
+
+func TestMapStructureDecodeAccountHistorySinceRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":     "account_history_since",
+		"wallet":     "1234",
+		"account":    "nano_1",
+		"since_hash": "abc",
+	}
+	var decoded AccountHistorySinceRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_history_since", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "abc", decoded.SinceHash)
+	assert.Nil(t, decoded.MaxDepth)
+}
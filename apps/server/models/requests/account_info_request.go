@@ -0,0 +1,7 @@
+package requests
+
+// Any other options are forwarded to the node as they are
+type AccountInfoRequest struct {
+	Action  string `json:"action" mapstructure:"action"`
+	Account string `json:"account" mapstructure:"account"`
+}
@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountInfoRequest(t *testing.T) {
+	encoded := `{"action":"account_info","account":"nano_1","representative":"true"}`
+	var decoded AccountInfoRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_info", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+}
+
+func TestMapStructureDecodeAccountInfoRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":         "account_info",
+		"account":        "nano_1",
+		"representative": "true",
+	}
+	var decoded AccountInfoRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_info", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+}
@@ -0,0 +1,14 @@
+package requests
+
+// A label that's empty removes it, metadata replaces what was there and an empty object removes it
+type AccountLabelSetRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string            `json:"account" mapstructure:"account"`
+	Label       *string           `json:"label,omitempty" mapstructure:"label,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty" mapstructure:"metadata,omitempty"`
+}
+
+type AccountLabelGetRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string `json:"account" mapstructure:"account"`
+}
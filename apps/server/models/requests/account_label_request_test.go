@@ -0,0 +1,50 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountLabelSetRequest(t *testing.T) {
+	encoded := `{"action":"account_label_set","wallet":"1234","account":"nano_1","label":"user 42","metadata":{"user_id":"42"}}`
+	var decoded AccountLabelSetRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_label_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "user 42", *decoded.Label)
+	assert.Equal(t, map[string]string{"user_id": "42"}, decoded.Metadata)
+}
+
+func TestMapStructureDecodeAccountLabelSetRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":   "account_label_set",
+		"wallet":   "1234",
+		"account":  "nano_1",
+		"metadata": map[string]interface{}{},
+	}
+	var decoded AccountLabelSetRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_label_set", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.Label)
+	// An empty object isn't the same as leaving it out
+	assert.NotNil(t, decoded.Metadata)
+	assert.Empty(t, decoded.Metadata)
+}
+
+func TestMapStructureDecodeAccountLabelGetRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "account_label_get",
+		"wallet":  "1234",
+		"account": "nano_1",
+	}
+	var decoded AccountLabelGetRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_label_get", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+}
@@ -0,0 +1,9 @@
+package requests
+
+type AccountListRequest struct {
+	BaseRequestWithCount `mapstructure:",squash"`
+	// Skip this many accounts, to page through them count at a time
+	Offset *interface{} `json:"offset,omitempty" mapstructure:"offset,omitempty"`
+	// Also return how many accounts the wallet has
+	Total *interface{} `json:"total,omitempty" mapstructure:"total,omitempty"`
+}
@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountListRequest(t *testing.T) {
+	encoded := `{"action":"account_list","wallet":"1234","count":100,"offset":"200","total":true}`
+	var decoded AccountListRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_list", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, float64(100), *decoded.Count)
+	assert.Equal(t, "200", *decoded.Offset)
+	assert.Equal(t, true, *decoded.Total)
+}
+
+func TestMapStructureDecodeAccountListRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "account_list",
+		"wallet": "1234",
+		"offset": 200,
+	}
+	var decoded AccountListRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_list", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, 200, *decoded.Offset)
+	assert.Nil(t, decoded.Count)
+	assert.Nil(t, decoded.Total)
+}
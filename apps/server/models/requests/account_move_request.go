@@ -0,0 +1,8 @@
+package requests
+
+// Like the node, wallet is where the accounts are moved to and source is the wallet they're in
+type AccountMoveRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Source      string   `json:"source" mapstructure:"source"`
+	Accounts    []string `json:"accounts" mapstructure:"accounts"`
+}
@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountMoveRequest(t *testing.T) {
+	encoded := `{"action":"account_move","wallet":"1234","source":"5678","accounts":["nano_1","nano_2"]}`
+	var decoded AccountMoveRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_move", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "5678", decoded.Source)
+	assert.Equal(t, []string{"nano_1", "nano_2"}, decoded.Accounts)
+}
+
+func TestMapStructureDecodeAccountMoveRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":   "account_move",
+		"wallet":   "1234",
+		"source":   "5678",
+		"accounts": []interface{}{"nano_1", "nano_2"},
+	}
+	var decoded AccountMoveRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_move", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "5678", decoded.Source)
+	assert.Equal(t, []string{"nano_1", "nano_2"}, decoded.Accounts)
+}
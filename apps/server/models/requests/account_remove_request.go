@@ -0,0 +1,7 @@
+package requests
+
+type AccountRemoveRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string       `json:"account" mapstructure:"account"`
+	Force       *interface{} `json:"force,omitempty" mapstructure:"force,omitempty"`
+}
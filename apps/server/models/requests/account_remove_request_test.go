@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountRemoveRequest(t *testing.T) {
+	encoded := `{"action":"account_remove","wallet":"1234","account":"nano_1","force":true}`
+	var decoded AccountRemoveRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_remove", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, true, *decoded.Force)
+	assert.Nil(t, decoded.BpowKey)
+}
+
+func TestMapStructureDecodeAccountRemoveRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "account_remove",
+		"wallet":  "1234",
+		"account": "nano_1",
+	}
+	var decoded AccountRemoveRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_remove", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.Force)
+	assert.Nil(t, decoded.BpowKey)
+}
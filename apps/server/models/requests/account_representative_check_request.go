@@ -0,0 +1,7 @@
+package requests
+
+// Wallet is optional, with it the account must belong to the wallet
+type AccountRepresentativeCheckRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string `json:"account" mapstructure:"account"`
+}
@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountRepresentativeCheckRequest(t *testing.T) {
+	encoded := `{"action":"account_representative_check","wallet":"1234","account":"nano_1"}`
+	var decoded AccountRepresentativeCheckRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_representative_check", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+}
+
+func TestMapStructureDecodeAccountRepresentativeCheckRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "account_representative_check",
+		"account": "nano_1",
+	}
+	var decoded AccountRepresentativeCheckRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_representative_check", decoded.Action)
+	assert.Equal(t, "", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+}
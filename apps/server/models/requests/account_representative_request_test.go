@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountRepresentativeRequest(t *testing.T) {
+	encoded := `{"action":"account_representative","wallet":"1234","account":"nano_1"}`
+	var decoded AccountRepresentativeRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_representative", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.BpowKey)
+}
+
+func TestMapStructureDecodeAccountRepresentativeRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "account_representative",
+		"wallet":  "1234",
+		"account": "nano_1",
+	}
+	var decoded AccountRepresentativeRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_representative", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.BpowKey)
+}
@@ -5,4 +5,6 @@ type AccountRepresentativeSetRequest struct {
 	Account        string  `json:"account" mapstructure:"account"`
 	Representative string  `json:"representative" mapstructure:"representative"`
 	Work           *string `json:"work,omitempty" mapstructure:"work,omitempty"`
+	// Return the block instead of publishing it
+	Preview *bool `json:"preview,omitempty" mapstructure:"preview,omitempty"`
 }
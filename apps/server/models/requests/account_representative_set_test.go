@@ -18,6 +18,12 @@ func TestDecodeAccountRepresentativeSetRequest(t *testing.T) {
 	assert.Equal(t, "nano_2", decoded.Representative)
 	assert.Nil(t, decoded.Work)
 	assert.Nil(t, decoded.BpowKey)
+	assert.Nil(t, decoded.Preview)
+
+	encoded = `{"action":"account_representative_set","wallet":"1234","account":"nano_1","representative":"nano_2","preview":true}`
+	decoded = AccountRepresentativeSetRequest{}
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.True(t, *decoded.Preview)
 }
 
 func TestMapStructureDecodeAccountRepresentativeSetRequest(t *testing.T) {
@@ -26,9 +32,11 @@ func TestMapStructureDecodeAccountRepresentativeSetRequest(t *testing.T) {
 		"wallet":         "1234",
 		"account":        "nano_1",
 		"representative": "nano_2",
+		"preview":        true,
 	}
 	var decoded AccountRepresentativeSetRequest
 	mapstructure.Decode(request, &decoded)
+	assert.True(t, *decoded.Preview)
 	assert.Equal(t, "account_representative_set", decoded.Action)
 	assert.Equal(t, "1234", decoded.Wallet)
 	assert.Equal(t, "nano_1", decoded.Account)
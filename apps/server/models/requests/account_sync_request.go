@@ -0,0 +1,6 @@
+package requests
+
+type AccountSyncRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string `json:"account" mapstructure:"account"`
+}
@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountSyncRequest(t *testing.T) {
+	encoded := `{"action":"account_sync","wallet":"1234","account":"nano_1","bpow_key":"key"}`
+	var decoded AccountSyncRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_sync", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "key", *decoded.BpowKey)
+}
+
+func TestMapStructureDecodeAccountSyncRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "account_sync",
+		"wallet":  "1234",
+		"account": "nano_1",
+	}
+	var decoded AccountSyncRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_sync", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.BpowKey)
+}
@@ -0,0 +1,7 @@
+package requests
+
+// account_weight and account_full_info
+type AccountWeightRequest struct {
+	Action  string `json:"action" mapstructure:"action"`
+	Account string `json:"account" mapstructure:"account"`
+}
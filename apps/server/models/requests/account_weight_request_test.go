@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountWeightRequest(t *testing.T) {
+	encoded := `{"action":"account_weight","account":"nano_1"}`
+	var decoded AccountWeightRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_weight", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+}
+
+func TestMapStructureDecodeAccountWeightRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "account_full_info",
+		"account": "nano_1",
+	}
+	var decoded AccountWeightRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_full_info", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+}
@@ -0,0 +1,11 @@
+package requests
+
+type AccountsCreateRequest struct {
+	BaseRequestWithCount `mapstructure:",squash"`
+	// A UUID, retrying with the same one returns the accounts created the first time
+	IdempotencyKey *string `json:"idempotency_key,omitempty" mapstructure:"idempotency_key,omitempty"`
+	// Create them in the background as a job
+	Async *interface{} `json:"async,omitempty" mapstructure:"async,omitempty"`
+	// Refuse if there would be more than this many unused accounts in a row
+	GapLimit *interface{} `json:"gap_limit,omitempty" mapstructure:"gap_limit,omitempty"`
+}
@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountsCreateRequest(t *testing.T) {
+	encoded := `{"action":"accounts_create","wallet":"1234","count":"10"}`
+	var decoded AccountsCreateRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "accounts_create", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Nil(t, decoded.IdempotencyKey)
+	assert.Nil(t, decoded.Async)
+	assert.Nil(t, decoded.GapLimit)
+
+	encoded = `{"action":"accounts_create","wallet":"1234","count":"10","idempotency_key":"0b1d8c7e-62a4-4f0d-9a57-3c2e1f6b8d90","async":true,"gap_limit":20}`
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "0b1d8c7e-62a4-4f0d-9a57-3c2e1f6b8d90", *decoded.IdempotencyKey)
+	assert.Equal(t, true, *decoded.Async)
+	assert.Equal(t, 20.0, *decoded.GapLimit)
+}
+
+func TestMapStructureDecodeAccountsCreateRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":          "accounts_create",
+		"wallet":          "1234",
+		"count":           "2",
+		"idempotency_key": "0b1d8c7e-62a4-4f0d-9a57-3c2e1f6b8d90",
+		"gap_limit":       20,
+	}
+	var decoded AccountsCreateRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "accounts_create", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	count, _ := utils.ToInt(*decoded.Count)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, "0b1d8c7e-62a4-4f0d-9a57-3c2e1f6b8d90", *decoded.IdempotencyKey)
+	assert.Equal(t, 20, *decoded.GapLimit)
+}
@@ -0,0 +1,9 @@
+package requests
+
+type AccountsFilterRequest struct {
+	BaseRequest    `mapstructure:",squash"`
+	MinBalanceRaw  *string `json:"min_balance_raw,omitempty" mapstructure:"min_balance_raw,omitempty"`
+	MaxBalanceRaw  *string `json:"max_balance_raw,omitempty" mapstructure:"max_balance_raw,omitempty"`
+	LabelContains  *string `json:"label_contains,omitempty" mapstructure:"label_contains,omitempty"`
+	Representative *string `json:"representative,omitempty" mapstructure:"representative,omitempty"`
+}
@@ -0,0 +1,36 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountsFilterRequest(t *testing.T) {
+	encoded := `{"action":"accounts_filter","wallet":"1234","min_balance_raw":"1","max_balance_raw":"1000","representative":"nano_1"}`
+	var decoded AccountsFilterRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "accounts_filter", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "1", *decoded.MinBalanceRaw)
+	assert.Equal(t, "1000", *decoded.MaxBalanceRaw)
+	assert.Equal(t, "nano_1", *decoded.Representative)
+	assert.Nil(t, decoded.LabelContains)
+}
+
+func TestMapStructureDecodeAccountsFilterRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":          "accounts_filter",
+		"wallet":          "1234",
+		"max_balance_raw": "1000",
+	}
+	var decoded AccountsFilterRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "accounts_filter", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Nil(t, decoded.MinBalanceRaw)
+	assert.Equal(t, "1000", *decoded.MaxBalanceRaw)
+	assert.Nil(t, decoded.Representative)
+}
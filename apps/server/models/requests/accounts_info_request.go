@@ -0,0 +1,7 @@
+package requests
+
+// Either a wallet, for all of its accounts, or accounts
+type AccountsInfoRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Accounts    []string `json:"accounts,omitempty" mapstructure:"accounts,omitempty"`
+}
@@ -0,0 +1,38 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountsInfoRequest(t *testing.T) {
+	encoded := `{"action":"accounts_info","accounts":["nano_1","nano_2"]}`
+	var decoded AccountsInfoRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "accounts_info", decoded.Action)
+	assert.Equal(t, "", decoded.Wallet)
+	assert.Equal(t, []string{"nano_1", "nano_2"}, decoded.Accounts)
+}
+
+func TestMapStructureDecodeAccountsInfoRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "accounts_info",
+		"wallet": "1234",
+	}
+	var decoded AccountsInfoRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "accounts_info", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Nil(t, decoded.Accounts)
+
+	request = map[string]interface{}{
+		"action":   "accounts_info",
+		"accounts": []interface{}{"nano_1"},
+	}
+	decoded = AccountsInfoRequest{}
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, []string{"nano_1"}, decoded.Accounts)
+}
@@ -0,0 +1,6 @@
+package requests
+
+type AccountsRepresentativeSetRequest struct {
+	BaseRequest    `mapstructure:",squash"`
+	Representative string `json:"representative" mapstructure:"representative"`
+}
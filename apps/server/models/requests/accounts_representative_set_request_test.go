@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountsRepresentativeSetRequest(t *testing.T) {
+	encoded := `{"action":"accounts_representative_set","wallet":"1234","representative":"nano_1"}`
+	var decoded AccountsRepresentativeSetRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "accounts_representative_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Representative)
+	assert.Nil(t, decoded.BpowKey)
+}
+
+func TestMapStructureDecodeAccountsRepresentativeSetRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":         "accounts_representative_set",
+		"wallet":         "1234",
+		"representative": "nano_1",
+		"bpow_key":       "abc",
+	}
+	var decoded AccountsRepresentativeSetRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "accounts_representative_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Representative)
+	assert.Equal(t, "abc", *decoded.BpowKey)
+}
@@ -0,0 +1,7 @@
+package requests
+
+// For block_successor and block_predecessor
+type AdjacentBlockRequest struct {
+	Action string `json:"action" mapstructure:"action"`
+	Block  string `json:"block" mapstructure:"block"`
+}
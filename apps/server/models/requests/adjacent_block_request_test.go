@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAdjacentBlockRequest(t *testing.T) {
+	encoded := `{"action":"block_successor","block":"abc"}`
+	var decoded AdjacentBlockRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "block_successor", decoded.Action)
+	assert.Equal(t, "abc", decoded.Block)
+}
+
+func TestMapStructureDecodeAdjacentBlockRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "block_predecessor",
+		"block":  "abc",
+	}
+	var decoded AdjacentBlockRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "block_predecessor", decoded.Action)
+	assert.Equal(t, "abc", decoded.Block)
+}
@@ -0,0 +1,14 @@
+package requests
+
+type AlertRegisterRequest struct {
+	BaseRequest  `mapstructure:",squash"`
+	Account      string `json:"account" mapstructure:"account"`
+	ThresholdRaw string `json:"threshold_raw" mapstructure:"threshold_raw"`
+	Direction    string `json:"direction" mapstructure:"direction"`
+	CallbackUrl  string `json:"callback_url" mapstructure:"callback_url"`
+}
+
+type AlertDeleteRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	AlertID     string `json:"alert_id" mapstructure:"alert_id"`
+}
@@ -0,0 +1,62 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAlertRegisterRequest(t *testing.T) {
+	encoded := `{"action":"alert_register","wallet":"1234","account":"nano_1","threshold_raw":"1000","direction":"below","callback_url":"https://example.com"}`
+	var decoded AlertRegisterRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "alert_register", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "1000", decoded.ThresholdRaw)
+	assert.Equal(t, "below", decoded.Direction)
+	assert.Equal(t, "https://example.com", decoded.CallbackUrl)
+}
+
+func TestMapStructureDecodeAlertRegisterRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":        "alert_register",
+		"wallet":        "1234",
+		"account":       "nano_1",
+		"threshold_raw": "1000",
+		"direction":     "above",
+		"callback_url":  "https://example.com",
+	}
+	var decoded AlertRegisterRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "alert_register", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "1000", decoded.ThresholdRaw)
+	assert.Equal(t, "above", decoded.Direction)
+	assert.Equal(t, "https://example.com", decoded.CallbackUrl)
+}
+
+func TestDecodeAlertDeleteRequest(t *testing.T) {
+	encoded := `{"action":"alert_delete","wallet":"1234","alert_id":"5678"}`
+	var decoded AlertDeleteRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "alert_delete", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "5678", decoded.AlertID)
+}
+
+func TestMapStructureDecodeAlertDeleteRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":   "alert_delete",
+		"wallet":   "1234",
+		"alert_id": "5678",
+	}
+	var decoded AlertDeleteRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "alert_delete", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "5678", decoded.AlertID)
+}
@@ -0,0 +1,6 @@
+package requests
+
+type BlockConfirmRequest struct {
+	Action string `json:"action" mapstructure:"action"`
+	Hash   string `json:"hash" mapstructure:"hash"`
+}
@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeBlockConfirmRequest(t *testing.T) {
+	encoded := `{"action":"block_confirm","hash":"abc"}`
+	var decoded BlockConfirmRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "block_confirm", decoded.Action)
+	assert.Equal(t, "abc", decoded.Hash)
+}
+
+func TestMapStructureDecodeBlockConfirmRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "block_confirm",
+		"hash":   "abc",
+	}
+	var decoded BlockConfirmRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "block_confirm", decoded.Action)
+	assert.Equal(t, "abc", decoded.Hash)
+}
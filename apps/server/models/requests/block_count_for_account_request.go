@@ -0,0 +1,7 @@
+package requests
+
+// The account has to be in the wallet
+type BlockCountForAccountRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string `json:"account" mapstructure:"account"`
+}
@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeBlockCountForAccountRequest(t *testing.T) {
+	encoded := `{"action":"block_count_for_account","wallet":"1234","account":"nano_1"}`
+	var decoded BlockCountForAccountRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "block_count_for_account", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.BpowKey)
+}
+
+func TestMapStructureDecodeBlockCountForAccountRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "block_count_for_account",
+		"wallet":  "1234",
+		"account": "nano_1",
+	}
+	var decoded BlockCountForAccountRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "block_count_for_account", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.BpowKey)
+}
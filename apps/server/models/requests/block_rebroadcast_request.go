@@ -0,0 +1,6 @@
+package requests
+
+type BlockRebroadcastRequest struct {
+	Action string `json:"action" mapstructure:"action"`
+	Hash   string `json:"hash" mapstructure:"hash"`
+}
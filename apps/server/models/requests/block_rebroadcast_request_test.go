@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeBlockRebroadcastRequest(t *testing.T) {
+	encoded := `{"action":"block_rebroadcast","hash":"abc"}`
+	var decoded BlockRebroadcastRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "block_rebroadcast", decoded.Action)
+	assert.Equal(t, "abc", decoded.Hash)
+}
+
+func TestMapStructureDecodeBlockRebroadcastRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "block_rebroadcast",
+		"hash":   "abc",
+	}
+	var decoded BlockRebroadcastRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "block_rebroadcast", decoded.Action)
+	assert.Equal(t, "abc", decoded.Hash)
+}
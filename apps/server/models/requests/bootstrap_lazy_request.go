@@ -0,0 +1,7 @@
+package requests
+
+type BootstrapLazyRequest struct {
+	Action string       `json:"action" mapstructure:"action"`
+	Hash   string       `json:"hash" mapstructure:"hash"`
+	Force  *interface{} `json:"force,omitempty" mapstructure:"force,omitempty"`
+}
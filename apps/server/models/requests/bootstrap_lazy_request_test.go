@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeBootstrapLazyRequest(t *testing.T) {
+	encoded := `{"action":"bootstrap_lazy","hash":"FF0144381CFF0B2C079A115E7ADA7E96F43FD219446E7524C48D1CC9900C4F17","force":true}`
+	var decoded BootstrapLazyRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "bootstrap_lazy", decoded.Action)
+	assert.Equal(t, "FF0144381CFF0B2C079A115E7ADA7E96F43FD219446E7524C48D1CC9900C4F17", decoded.Hash)
+	assert.Equal(t, true, *decoded.Force)
+}
+
+func TestMapStructureDecodeBootstrapLazyRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "bootstrap_lazy",
+		"hash":   "FF0144381CFF0B2C079A115E7ADA7E96F43FD219446E7524C48D1CC9900C4F17",
+	}
+	var decoded BootstrapLazyRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "bootstrap_lazy", decoded.Action)
+	assert.Equal(t, "FF0144381CFF0B2C079A115E7ADA7E96F43FD219446E7524C48D1CC9900C4F17", decoded.Hash)
+	assert.Nil(t, decoded.Force)
+}
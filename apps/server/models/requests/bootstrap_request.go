@@ -0,0 +1,7 @@
+package requests
+
+// address is the IP and port of a peer, like [::ffff:203.0.113.7]:7075
+type BootstrapRequest struct {
+	Action  string `json:"action" mapstructure:"action"`
+	Address string `json:"address" mapstructure:"address"`
+}
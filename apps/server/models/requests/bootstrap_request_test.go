@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeBootstrapRequest(t *testing.T) {
+	encoded := `{"action":"bootstrap","address":"203.0.113.7:7075"}`
+	var decoded BootstrapRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "bootstrap", decoded.Action)
+	assert.Equal(t, "203.0.113.7:7075", decoded.Address)
+}
+
+func TestMapStructureDecodeBootstrapRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "bootstrap",
+		"address": "[::ffff:203.0.113.7]:7075",
+	}
+	var decoded BootstrapRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "bootstrap", decoded.Action)
+	assert.Equal(t, "[::ffff:203.0.113.7]:7075", decoded.Address)
+}
@@ -0,0 +1,9 @@
+package requests
+
+type ChainRequest struct {
+	Action           string       `json:"action" mapstructure:"action"`
+	Block            string       `json:"block" mapstructure:"block"`
+	Count            *interface{} `json:"count" mapstructure:"count"`
+	Offset           *interface{} `json:"offset,omitempty" mapstructure:"offset,omitempty"`
+	IncludeBlockInfo *interface{} `json:"include_block_info,omitempty" mapstructure:"include_block_info,omitempty"`
+}
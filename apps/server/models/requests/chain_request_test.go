@@ -0,0 +1,42 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeChainRequest(t *testing.T) {
+	encoded := `{"action":"chain","block":"abc","count":"10"}`
+	var decoded ChainRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "chain", decoded.Action)
+	assert.Equal(t, "abc", decoded.Block)
+	count, _ := utils.ToInt(*decoded.Count)
+	assert.Equal(t, 10, count)
+	assert.Nil(t, decoded.Offset)
+	assert.Nil(t, decoded.IncludeBlockInfo)
+}
+
+func TestMapStructureDecodeChainRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":             "chain",
+		"block":              "abc",
+		"count":              10,
+		"offset":             "2",
+		"include_block_info": true,
+	}
+	var decoded ChainRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "chain", decoded.Action)
+	assert.Equal(t, "abc", decoded.Block)
+	count, _ := utils.ToInt(*decoded.Count)
+	assert.Equal(t, 10, count)
+	offset, _ := utils.ToInt(*decoded.Offset)
+	assert.Equal(t, 2, offset)
+	includeBlockInfo, _ := utils.ToBool(*decoded.IncludeBlockInfo)
+	assert.True(t, includeBlockInfo)
+}
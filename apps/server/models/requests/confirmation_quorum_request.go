@@ -0,0 +1,6 @@
+package requests
+
+type ConfirmationQuorumRequest struct {
+	Action      string       `json:"action" mapstructure:"action"`
+	PeerDetails *interface{} `json:"peer_details,omitempty" mapstructure:"peer_details,omitempty"`
+}
@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeConfirmationQuorumRequest(t *testing.T) {
+	encoded := `{"action":"confirmation_quorum","peer_details":"true"}`
+	var decoded ConfirmationQuorumRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "confirmation_quorum", decoded.Action)
+	assert.Equal(t, "true", *decoded.PeerDetails)
+}
+
+func TestMapStructureDecodeConfirmationQuorumRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "confirmation_quorum",
+	}
+	var decoded ConfirmationQuorumRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "confirmation_quorum", decoded.Action)
+	assert.Nil(t, decoded.PeerDetails)
+}
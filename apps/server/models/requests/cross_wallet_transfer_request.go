@@ -0,0 +1,9 @@
+package requests
+
+type CrossWalletTransferRequest struct {
+	Action             string  `json:"action" mapstructure:"action"`
+	SourceWallet       string  `json:"source_wallet" mapstructure:"source_wallet"`
+	DestinationWallet  string  `json:"destination_wallet" mapstructure:"destination_wallet"`
+	DestinationAccount string  `json:"destination_account" mapstructure:"destination_account"`
+	BpowKey            *string `json:"bpow_key,omitempty" mapstructure:"bpow_key,omitempty"`
+}
@@ -0,0 +1,37 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeCrossWalletTransferRequest(t *testing.T) {
+	encoded := `{"action":"cross_wallet_transfer","source_wallet":"1234","destination_wallet":"5678","destination_account":"nano_1"}`
+	var decoded CrossWalletTransferRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "cross_wallet_transfer", decoded.Action)
+	assert.Equal(t, "1234", decoded.SourceWallet)
+	assert.Equal(t, "5678", decoded.DestinationWallet)
+	assert.Equal(t, "nano_1", decoded.DestinationAccount)
+	assert.Nil(t, decoded.BpowKey)
+}
+
+func TestMapStructureDecodeCrossWalletTransferRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":              "cross_wallet_transfer",
+		"source_wallet":       "1234",
+		"destination_wallet":  "5678",
+		"destination_account": "nano_1",
+		"bpow_key":            "abc",
+	}
+	var decoded CrossWalletTransferRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "cross_wallet_transfer", decoded.Action)
+	assert.Equal(t, "1234", decoded.SourceWallet)
+	assert.Equal(t, "5678", decoded.DestinationWallet)
+	assert.Equal(t, "nano_1", decoded.DestinationAccount)
+	assert.Equal(t, "abc", *decoded.BpowKey)
+}
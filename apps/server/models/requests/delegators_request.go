@@ -0,0 +1,11 @@
+package requests
+
+// For delegators and delegators_count, account and threshold are the node's names and also accepted
+type DelegatorsRequest struct {
+	Action         string `json:"action" mapstructure:"action"`
+	Representative string `json:"representative" mapstructure:"representative"`
+	Account        string `json:"account,omitempty" mapstructure:"account,omitempty"`
+	// Raw, delegators with a lower balance are left out
+	WeightMinimum *string `json:"weight_minimum,omitempty" mapstructure:"weight_minimum,omitempty"`
+	Threshold     *string `json:"threshold,omitempty" mapstructure:"threshold,omitempty"`
+}
@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeDelegatorsRequest(t *testing.T) {
+	encoded := `{"action":"delegators","representative":"nano_1","weight_minimum":"1000"}`
+	var decoded DelegatorsRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "delegators", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Representative)
+	assert.Equal(t, "", decoded.Account)
+	assert.Equal(t, "1000", *decoded.WeightMinimum)
+	assert.Nil(t, decoded.Threshold)
+}
+
+func TestMapStructureDecodeDelegatorsRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":    "delegators_count",
+		"account":   "nano_1",
+		"threshold": "1000",
+	}
+	var decoded DelegatorsRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "delegators_count", decoded.Action)
+	assert.Equal(t, "", decoded.Representative)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.WeightMinimum)
+	assert.Equal(t, "1000", *decoded.Threshold)
+}
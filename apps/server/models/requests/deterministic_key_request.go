@@ -0,0 +1,7 @@
+package requests
+
+type DeterministicKeyRequest struct {
+	Action string       `json:"action" mapstructure:"action"`
+	Seed   string       `json:"seed" mapstructure:"seed"`
+	Index  *interface{} `json:"index" mapstructure:"index"`
+}
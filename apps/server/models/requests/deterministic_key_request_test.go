@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeDeterministicKeyRequest(t *testing.T) {
+	encoded := `{"action":"deterministic_key","seed":"1234","index":"5"}`
+	var decoded DeterministicKeyRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "deterministic_key", decoded.Action)
+	assert.Equal(t, "1234", decoded.Seed)
+	assert.Equal(t, "5", *decoded.Index)
+}
+
+func TestMapStructureDecodeDeterministicKeyRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "deterministic_key",
+		"seed":   "1234",
+		"index":  5,
+	}
+	var decoded DeterministicKeyRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "deterministic_key", decoded.Action)
+	assert.Equal(t, "1234", decoded.Seed)
+	assert.Equal(t, 5, *decoded.Index)
+}
@@ -0,0 +1,6 @@
+package requests
+
+type JobStatusRequest struct {
+	Action string `json:"action" mapstructure:"action"`
+	JobID  string `json:"job_id" mapstructure:"job_id"`
+}
@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeJobStatusRequest(t *testing.T) {
+	encoded := `{"action":"job_status","job_id":"1234"}`
+	var decoded JobStatusRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "job_status", decoded.Action)
+	assert.Equal(t, "1234", decoded.JobID)
+}
+
+func TestMapStructureDecodeJobStatusRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "job_status",
+		"job_id": "1234",
+	}
+	var decoded JobStatusRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "job_status", decoded.Action)
+	assert.Equal(t, "1234", decoded.JobID)
+}
@@ -0,0 +1,6 @@
+package requests
+
+type KeyValidRequest struct {
+	Action string `json:"action" mapstructure:"action"`
+	Key    string `json:"key" mapstructure:"key"`
+}
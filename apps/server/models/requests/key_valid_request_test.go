@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeKeyValidRequest(t *testing.T) {
+	encoded := `{"action":"key_valid","key":"abc"}`
+	var decoded KeyValidRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "key_valid", decoded.Action)
+	assert.Equal(t, "abc", decoded.Key)
+}
+
+func TestMapStructureDecodeKeyValidRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "key_valid",
+		"key":    "abc",
+	}
+	var decoded KeyValidRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "key_valid", decoded.Action)
+	assert.Equal(t, "abc", decoded.Key)
+}
@@ -0,0 +1,7 @@
+package requests
+
+type PendingExistsRequest struct {
+	Action  string `json:"action" mapstructure:"action"`
+	Account string `json:"account" mapstructure:"account"`
+	Hash    string `json:"hash" mapstructure:"hash"`
+}
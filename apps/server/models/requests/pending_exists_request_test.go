@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodePendingExistsRequest(t *testing.T) {
+	encoded := `{"action":"pending_exists","account":"nano_1","hash":"abc"}`
+	var decoded PendingExistsRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "pending_exists", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "abc", decoded.Hash)
+}
+
+func TestMapStructureDecodePendingExistsRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "pending_exists",
+		"account": "nano_1",
+		"hash":    "abc",
+	}
+	var decoded PendingExistsRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "pending_exists", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "abc", decoded.Hash)
+}
@@ -0,0 +1,12 @@
+package requests
+
+// One action of a pipeline, params are the rest of its request
+type PipelineStep struct {
+	Action string                 `json:"action" mapstructure:"action"`
+	Params map[string]interface{} `json:"params" mapstructure:"params"`
+}
+
+type PipelineRequest struct {
+	Action  string         `json:"action" mapstructure:"action"`
+	Actions []PipelineStep `json:"actions" mapstructure:"actions"`
+}
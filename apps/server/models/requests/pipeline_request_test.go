@@ -0,0 +1,38 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodePipelineRequest(t *testing.T) {
+	encoded := `{"action":"pipeline","actions":[{"action":"account_create","params":{"wallet":"1234"}},{"action":"receive_all","params":{"wallet":"1234"}}]}`
+	var decoded PipelineRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "pipeline", decoded.Action)
+	assert.Len(t, decoded.Actions, 2)
+	assert.Equal(t, "account_create", decoded.Actions[0].Action)
+	assert.Equal(t, "1234", decoded.Actions[0].Params["wallet"])
+	assert.Equal(t, "receive_all", decoded.Actions[1].Action)
+}
+
+func TestMapStructureDecodePipelineRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "pipeline",
+		"actions": []interface{}{
+			map[string]interface{}{"action": "account_create", "params": map[string]interface{}{"wallet": "1234"}},
+			map[string]interface{}{"action": "receive", "params": map[string]interface{}{"account": "$previous.account"}},
+		},
+	}
+	var decoded PipelineRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "pipeline", decoded.Action)
+	assert.Len(t, decoded.Actions, 2)
+	assert.Equal(t, "account_create", decoded.Actions[0].Action)
+	assert.Equal(t, "1234", decoded.Actions[0].Params["wallet"])
+	assert.Equal(t, "receive", decoded.Actions[1].Action)
+	assert.Equal(t, "$previous.account", decoded.Actions[1].Params["account"])
+}
@@ -0,0 +1,23 @@
+package requests
+
+// Options for including the fiat value of a balance, embedded in balance requests
+type PriceOptions struct {
+	IncludePrice *interface{} `json:"include_price,omitempty" mapstructure:"include_price,omitempty"`
+	Currency     *string      `json:"currency,omitempty" mapstructure:"currency,omitempty"`
+}
+
+type WalletBalanceTotalRequest struct {
+	BaseRequest  `mapstructure:",squash"`
+	PriceOptions `mapstructure:",squash"`
+	// The unit of balance, pending and total instead of nano or banano
+	Unit *string `json:"unit,omitempty" mapstructure:"unit,omitempty"`
+}
+
+// Any other options are forwarded to the node as they are
+type AccountBalanceRequest struct {
+	Action       string `json:"action" mapstructure:"action"`
+	Account      string `json:"account" mapstructure:"account"`
+	PriceOptions `mapstructure:",squash"`
+	// The unit of the amounts instead of raw
+	Unit *string `json:"unit,omitempty" mapstructure:"unit,omitempty"`
+}
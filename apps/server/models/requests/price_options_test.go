@@ -0,0 +1,60 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletBalanceTotalRequest(t *testing.T) {
+	encoded := `{"action":"wallet_balance_total","wallet":"1234","include_price":true,"currency":"eur","unit":"banoshi"}`
+	var decoded WalletBalanceTotalRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_balance_total", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, true, *decoded.IncludePrice)
+	assert.Equal(t, "eur", *decoded.Currency)
+	assert.Equal(t, "banoshi", *decoded.Unit)
+}
+
+func TestMapStructureDecodeWalletBalanceTotalRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":        "wallet_balance_total",
+		"wallet":        "1234",
+		"include_price": "true",
+	}
+	var decoded WalletBalanceTotalRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_balance_total", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "true", *decoded.IncludePrice)
+	assert.Nil(t, decoded.Currency)
+	assert.Nil(t, decoded.Unit)
+}
+
+func TestDecodeAccountBalanceRequest(t *testing.T) {
+	encoded := `{"action":"account_balance","account":"nano_1","include_price":true,"currency":"eur"}`
+	var decoded AccountBalanceRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "account_balance", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, true, *decoded.IncludePrice)
+	assert.Equal(t, "eur", *decoded.Currency)
+}
+
+func TestMapStructureDecodeAccountBalanceRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "account_balance",
+		"account": "nano_1",
+		"unit":    "nano",
+	}
+	var decoded AccountBalanceRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "account_balance", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.IncludePrice)
+	assert.Nil(t, decoded.Currency)
+	assert.Equal(t, "nano", *decoded.Unit)
+}
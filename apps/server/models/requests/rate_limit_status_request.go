@@ -0,0 +1,8 @@
+package requests
+
+// Without an ip it's the count IPs closest to being rate limited
+type RateLimitStatusRequest struct {
+	Action string       `json:"action" mapstructure:"action"`
+	IP     string       `json:"ip,omitempty" mapstructure:"ip,omitempty"`
+	Count  *interface{} `json:"count,omitempty" mapstructure:"count,omitempty"`
+}
@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeRateLimitStatusRequest(t *testing.T) {
+	encoded := `{"action":"rate_limit_status","ip":"203.0.113.7","count":5}`
+	var decoded RateLimitStatusRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "rate_limit_status", decoded.Action)
+	assert.Equal(t, "203.0.113.7", decoded.IP)
+	assert.Equal(t, float64(5), *decoded.Count)
+}
+
+func TestMapStructureDecodeRateLimitStatusRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "rate_limit_status",
+	}
+	var decoded RateLimitStatusRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "rate_limit_status", decoded.Action)
+	assert.Equal(t, "", decoded.IP)
+	assert.Nil(t, decoded.Count)
+}
@@ -0,0 +1,11 @@
+package requests
+
+// Either wallet or account, hash is the node's receivable_exists and forwarded to it
+type ReceivableExistsRequest struct {
+	Action  string `json:"action" mapstructure:"action"`
+	Wallet  string `json:"wallet,omitempty" mapstructure:"wallet,omitempty"`
+	Account string `json:"account,omitempty" mapstructure:"account,omitempty"`
+	// Raw, smaller blocks aren't counted
+	ThresholdRaw *string `json:"threshold_raw,omitempty" mapstructure:"threshold_raw,omitempty"`
+	Hash         string  `json:"hash,omitempty" mapstructure:"hash,omitempty"`
+}
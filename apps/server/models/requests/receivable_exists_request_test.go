@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeReceivableExistsRequest(t *testing.T) {
+	encoded := `{"action":"receivable_exists","wallet":"1234","threshold_raw":"1000"}`
+	var decoded ReceivableExistsRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "receivable_exists", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "", decoded.Account)
+	assert.Equal(t, "1000", *decoded.ThresholdRaw)
+	assert.Equal(t, "", decoded.Hash)
+}
+
+func TestMapStructureDecodeReceivableExistsRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "receivable_exists",
+		"account": "nano_1",
+	}
+	var decoded ReceivableExistsRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "receivable_exists", decoded.Action)
+	assert.Equal(t, "", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Nil(t, decoded.ThresholdRaw)
+}
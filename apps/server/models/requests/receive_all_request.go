@@ -0,0 +1,7 @@
+package requests
+
+type ReceiveAllRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	// Receive in the background as a job
+	Async *interface{} `json:"async,omitempty" mapstructure:"async,omitempty"`
+}
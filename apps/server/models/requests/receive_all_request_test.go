@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeReceiveAllRequest(t *testing.T) {
+	encoded := `{"action":"receive_all","wallet":"1234"}`
+	var decoded ReceiveAllRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "receive_all", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Nil(t, decoded.Async)
+
+	encoded = `{"action":"receive_all","wallet":"1234","async":true}`
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, true, *decoded.Async)
+}
+
+func TestMapStructureDecodeReceiveAllRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "receive_all",
+		"wallet": "1234",
+		"async":  "true",
+	}
+	var decoded ReceiveAllRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "receive_all", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "true", *decoded.Async)
+}
@@ -0,0 +1,8 @@
+package requests
+
+type ReceiveBatchRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	BpowKey     *string  `json:"bpow_key,omitempty" mapstructure:"bpow_key,omitempty"`
+	Account     string   `json:"account" mapstructure:"account"`
+	Blocks      []string `json:"blocks" mapstructure:"blocks"`
+}
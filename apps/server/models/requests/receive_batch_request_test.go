@@ -0,0 +1,37 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeReceiveBatchRequest(t *testing.T) {
+	encoded := `{"action":"receive_batch","wallet":"1234","account":"nano_1","blocks":["ABCD","EFGH"]}`
+	var decoded ReceiveBatchRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "receive_batch", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, []string{"ABCD", "EFGH"}, decoded.Blocks)
+	assert.Nil(t, decoded.BpowKey)
+}
+
+func TestMapStructureDecodeReceiveBatchRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":   "receive_batch",
+		"wallet":   "1234",
+		"account":  "nano_1",
+		"blocks":   []interface{}{"ABCD", "EFGH"},
+		"bpow_key": "abc",
+	}
+	var decoded ReceiveBatchRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "receive_batch", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, []string{"ABCD", "EFGH"}, decoded.Blocks)
+	assert.Equal(t, "abc", *decoded.BpowKey)
+}
@@ -0,0 +1,7 @@
+package requests
+
+type ReceiveMinimumSetRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	// In raw
+	Amount string `json:"amount" mapstructure:"amount"`
+}
@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeReceiveMinimumSetRequest(t *testing.T) {
+	encoded := `{"action":"receive_minimum_set","wallet":"1234","amount":"1000"}`
+	var decoded ReceiveMinimumSetRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "receive_minimum_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "1000", decoded.Amount)
+}
+
+func TestMapStructureDecodeReceiveMinimumSetRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "receive_minimum_set",
+		"wallet": "1234",
+		"amount": "1000",
+	}
+	var decoded ReceiveMinimumSetRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "receive_minimum_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "1000", decoded.Amount)
+}
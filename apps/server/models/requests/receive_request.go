@@ -6,4 +6,9 @@ type ReceiveRequest struct {
 	BpowKey     *string `json:"bpow_key,omitempty" mapstructure:"bpow_key,omitempty"`
 	Account     string  `json:"account" mapstructure:"account"`
 	Block       string  `json:"block" mapstructure:"block"`
+	// Return the block instead of publishing it
+	Preview *bool `json:"preview,omitempty" mapstructure:"preview,omitempty"`
+	// Work threshold instead of the receive's, a hex difficulty or a multiple of the receive's, not both
+	Difficulty *string      `json:"difficulty,omitempty" mapstructure:"difficulty,omitempty"`
+	Multiplier *interface{} `json:"multiplier,omitempty" mapstructure:"multiplier,omitempty"`
 }
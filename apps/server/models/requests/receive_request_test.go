@@ -17,17 +17,28 @@ func TestDecodeReceiveRequest(t *testing.T) {
 	assert.Equal(t, "nano_1", decoded.Account)
 	assert.Equal(t, "abc", *decoded.BpowKey)
 	assert.Nil(t, decoded.Work)
+	assert.Nil(t, decoded.Preview)
+
+	encoded = `{"action":"receive","wallet":"1234","account":"nano_1","preview":true}`
+	decoded = ReceiveRequest{}
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.True(t, *decoded.Preview)
 }
 
 func TestMapStructureDecodeReceiveRequest(t *testing.T) {
 	request := map[string]interface{}{
-		"action":   "receive",
-		"wallet":   "1234",
-		"account":  "nano_1",
-		"bpow_key": "abc",
+		"action":     "receive",
+		"wallet":     "1234",
+		"account":    "nano_1",
+		"bpow_key":   "abc",
+		"preview":    false,
+		"multiplier": "2",
 	}
 	var decoded ReceiveRequest
 	mapstructure.Decode(request, &decoded)
+	assert.False(t, *decoded.Preview)
+	assert.Equal(t, "2", *decoded.Multiplier)
+	assert.Nil(t, decoded.Difficulty)
 	assert.Equal(t, "receive", decoded.Action)
 	assert.Equal(t, "1234", decoded.Wallet)
 	assert.Equal(t, "nano_1", decoded.Account)
@@ -0,0 +1,6 @@
+package requests
+
+type RepresentativeInfoRequest struct {
+	Action         string `json:"action" mapstructure:"action"`
+	Representative string `json:"representative" mapstructure:"representative"`
+}
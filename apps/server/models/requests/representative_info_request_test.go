@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeRepresentativeInfoRequest(t *testing.T) {
+	encoded := `{"action":"representative_info","representative":"nano_1"}`
+	var decoded RepresentativeInfoRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "representative_info", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Representative)
+}
+
+func TestMapStructureDecodeRepresentativeInfoRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":         "representative_info",
+		"representative": "nano_1",
+	}
+	var decoded RepresentativeInfoRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "representative_info", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Representative)
+}
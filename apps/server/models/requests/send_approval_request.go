@@ -0,0 +1,19 @@
+package requests
+
+type SendApprovalRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	ApprovalID  string `json:"approval_id" mapstructure:"approval_id"`
+}
+
+type SendApprovalListRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	// pending, sent or rejected, every send if it's not given
+	Status string `json:"status,omitempty" mapstructure:"status,omitempty"`
+}
+
+type WalletApprovalPolicySetRequest struct {
+	BaseRequest       `mapstructure:",squash"`
+	ApprovalsRequired *interface{} `json:"approvals_required" mapstructure:"approvals_required"`
+	// In raw, only sends of more than it need approvals
+	Threshold string `json:"threshold,omitempty" mapstructure:"threshold,omitempty"`
+}
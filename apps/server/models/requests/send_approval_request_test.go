@@ -0,0 +1,73 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSendApprovalRequest(t *testing.T) {
+	encoded := `{"action":"send_approve","wallet":"1234","approval_id":"5678"}`
+	var decoded SendApprovalRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "send_approve", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "5678", decoded.ApprovalID)
+}
+
+func TestMapStructureDecodeSendApprovalRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":      "send_reject",
+		"wallet":      "1234",
+		"approval_id": "5678",
+	}
+	var decoded SendApprovalRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "send_reject", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "5678", decoded.ApprovalID)
+}
+
+func TestDecodeSendApprovalListRequest(t *testing.T) {
+	encoded := `{"action":"send_approval_list","wallet":"1234","status":"pending"}`
+	var decoded SendApprovalListRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "send_approval_list", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "pending", decoded.Status)
+
+	request := map[string]interface{}{
+		"action": "send_approval_list",
+		"wallet": "1234",
+	}
+	decoded = SendApprovalListRequest{}
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "", decoded.Status)
+}
+
+func TestDecodeWalletApprovalPolicySetRequest(t *testing.T) {
+	encoded := `{"action":"wallet_approval_policy_set","wallet":"1234","approvals_required":2,"threshold":"1000"}`
+	var decoded WalletApprovalPolicySetRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_approval_policy_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, float64(2), *decoded.ApprovalsRequired)
+	assert.Equal(t, "1000", decoded.Threshold)
+}
+
+func TestMapStructureDecodeWalletApprovalPolicySetRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":             "wallet_approval_policy_set",
+		"wallet":             "1234",
+		"approvals_required": "0",
+	}
+	var decoded WalletApprovalPolicySetRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_approval_policy_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "0", *decoded.ApprovalsRequired)
+	assert.Equal(t, "", decoded.Threshold)
+}
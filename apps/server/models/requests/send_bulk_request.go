@@ -0,0 +1,15 @@
+package requests
+
+// One send of send_bulk, like the fields of a send
+type BulkSendEntry struct {
+	Destination string  `json:"destination" mapstructure:"destination"`
+	Amount      string  `json:"amount" mapstructure:"amount"`
+	ID          *string `json:"id,omitempty" mapstructure:"id,omitempty"`
+	Work        *string `json:"work,omitempty" mapstructure:"work,omitempty"`
+}
+
+type SendBulkRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Source      string          `json:"source" mapstructure:"source"`
+	Sends       []BulkSendEntry `json:"sends" mapstructure:"sends"`
+}
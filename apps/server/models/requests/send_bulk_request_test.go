@@ -0,0 +1,44 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSendBulkRequest(t *testing.T) {
+	encoded := `{"action":"send_bulk","wallet":"1234","source":"nano_1","sends":[{"destination":"nano_2","amount":"1000","id":"a"},{"destination":"nano_3","amount":"2000"}]}`
+	var decoded SendBulkRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "send_bulk", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Source)
+	assert.Len(t, decoded.Sends, 2)
+	assert.Equal(t, "nano_2", decoded.Sends[0].Destination)
+	assert.Equal(t, "1000", decoded.Sends[0].Amount)
+	assert.Equal(t, "a", *decoded.Sends[0].ID)
+	assert.Nil(t, decoded.Sends[1].ID)
+	assert.Nil(t, decoded.Sends[1].Work)
+}
+
+func TestMapStructureDecodeSendBulkRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "send_bulk",
+		"wallet": "1234",
+		"source": "nano_1",
+		"sends": []interface{}{
+			map[string]interface{}{"destination": "nano_2", "amount": "1000", "id": "a", "work": "abc"},
+		},
+	}
+	var decoded SendBulkRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "send_bulk", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Source)
+	assert.Len(t, decoded.Sends, 1)
+	assert.Equal(t, "nano_2", decoded.Sends[0].Destination)
+	assert.Equal(t, "a", *decoded.Sends[0].ID)
+	assert.Equal(t, "abc", *decoded.Sends[0].Work)
+}
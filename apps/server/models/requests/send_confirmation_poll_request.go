@@ -0,0 +1,6 @@
+package requests
+
+type SendConfirmationPollRequest struct {
+	Action string `json:"action" mapstructure:"action"`
+	Hash   string `json:"hash" mapstructure:"hash"`
+}
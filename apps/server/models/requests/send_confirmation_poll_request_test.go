@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSendConfirmationPollRequest(t *testing.T) {
+	encoded := `{"action":"send_confirmation_poll","hash":"abc"}`
+	var decoded SendConfirmationPollRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "send_confirmation_poll", decoded.Action)
+	assert.Equal(t, "abc", decoded.Hash)
+}
+
+func TestMapStructureDecodeSendConfirmationPollRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "send_confirmation_poll",
+		"hash":   "abc",
+	}
+	var decoded SendConfirmationPollRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "send_confirmation_poll", decoded.Action)
+	assert.Equal(t, "abc", decoded.Hash)
+}
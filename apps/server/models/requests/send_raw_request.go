@@ -0,0 +1,9 @@
+package requests
+
+import "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+
+type SendRawRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Block       *block.StateBlock `json:"block" mapstructure:"block"`
+	Work        *string           `json:"work,omitempty" mapstructure:"work,omitempty"`
+}
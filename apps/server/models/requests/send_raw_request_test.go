@@ -0,0 +1,48 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSendRawRequest(t *testing.T) {
+	encoded := `{"action":"send_raw","wallet":"1234","block":{"type":"state","account":"nano_1","previous":"abc","representative":"nano_2","balance":"1000","link":"def","signature":"sig","work":""},"work":"0000"}`
+	var decoded SendRawRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "send_raw", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Block.Account)
+	assert.Equal(t, "abc", decoded.Block.Previous)
+	assert.Equal(t, "nano_2", decoded.Block.Representative)
+	assert.Equal(t, "1000", decoded.Block.Balance)
+	assert.Equal(t, "def", decoded.Block.Link)
+	assert.Equal(t, "sig", decoded.Block.Signature)
+	assert.Equal(t, "0000", *decoded.Work)
+}
+
+func TestMapStructureDecodeSendRawRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "send_raw",
+		"wallet": "1234",
+		"block": map[string]interface{}{
+			"type":           "state",
+			"account":        "nano_1",
+			"previous":       "abc",
+			"representative": "nano_2",
+			"balance":        "1000",
+			"link":           "def",
+			"signature":      "sig",
+		},
+	}
+	var decoded SendRawRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "send_raw", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Block.Account)
+	assert.Equal(t, "1000", decoded.Block.Balance)
+	assert.Equal(t, "sig", decoded.Block.Signature)
+	assert.Nil(t, decoded.Work)
+}
@@ -12,6 +12,15 @@ type SendRequest struct {
 	Amount      string  `json:"amount" mapstructure:"amount"`
 	ID          *string `json:"id,omitempty" mapstructure:"id,omitempty"`
 	Work        *string `json:"work,omitempty" mapstructure:"work,omitempty"`
+	// What amount is in, raw by default
+	Unit *string `json:"unit,omitempty" mapstructure:"unit,omitempty"`
+	// With false, sends to accounts that were never opened are refused
+	AllowUnopened *bool `json:"allow_unopened,omitempty" mapstructure:"allow_unopened,omitempty"`
+	// Return the block instead of publishing it
+	Preview *bool `json:"preview,omitempty" mapstructure:"preview,omitempty"`
+	// Work threshold instead of the send's, a hex difficulty or a multiple of the send's, not both
+	Difficulty *string      `json:"difficulty,omitempty" mapstructure:"difficulty,omitempty"`
+	Multiplier *interface{} `json:"multiplier,omitempty" mapstructure:"multiplier,omitempty"`
 }
 
 func (r *SendRequest) UnmarshalJSON(data []byte) error {
@@ -19,6 +19,19 @@ func TestDecodeSendRequest(t *testing.T) {
 	assert.Equal(t, "abc", *decoded.BpowKey)
 	assert.Equal(t, "1234", decoded.Amount)
 	assert.Nil(t, decoded.Work)
+	assert.Nil(t, decoded.AllowUnopened)
+	assert.Nil(t, decoded.Preview)
+	assert.Nil(t, decoded.Unit)
+	assert.Nil(t, decoded.Difficulty)
+	assert.Nil(t, decoded.Multiplier)
+
+	encoded = `{"action":"send","wallet":"1234","source":"nano_1","destination":"nano_2","amount":"1.5","allow_unopened":false,"preview":true,"unit":"banano"}`
+	decoded = SendRequest{}
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.False(t, *decoded.AllowUnopened)
+	assert.True(t, *decoded.Preview)
+	assert.Equal(t, "1.5", decoded.Amount)
+	assert.Equal(t, "banano", *decoded.Unit)
 }
 
 func TestDecodeSendRequestNumericAmount(t *testing.T) {
@@ -42,9 +55,17 @@ func TestMapStructureDecodeSendRequest(t *testing.T) {
 		"destination": "nano_2",
 		"amount":      "1234",
 		"bpow_key":    "abc",
+		"preview":     true,
+		"unit":        "raw",
+		"difficulty":  "fffffff800000000",
+		"multiplier":  1.5,
 	}
 	var decoded SendRequest
 	mapstructure.Decode(request, &decoded)
+	assert.True(t, *decoded.Preview)
+	assert.Equal(t, "raw", *decoded.Unit)
+	assert.Equal(t, "fffffff800000000", *decoded.Difficulty)
+	assert.Equal(t, 1.5, *decoded.Multiplier)
 	assert.Equal(t, "send", decoded.Action)
 	assert.Equal(t, "1234", decoded.Wallet)
 	assert.Equal(t, "nano_1", decoded.Source)
@@ -0,0 +1,15 @@
+package requests
+
+type SendScheduleRequest struct {
+	BaseRequest     `mapstructure:",squash"`
+	Source          string       `json:"source" mapstructure:"source"`
+	Destination     string       `json:"destination" mapstructure:"destination"`
+	AmountRaw       string       `json:"amount_raw" mapstructure:"amount_raw"`
+	IntervalSeconds *interface{} `json:"interval_seconds" mapstructure:"interval_seconds"`
+	StartAt         *interface{} `json:"start_at,omitempty" mapstructure:"start_at,omitempty"`
+}
+
+type SendScheduleCancelRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	ScheduleID  string `json:"schedule_id" mapstructure:"schedule_id"`
+}
@@ -0,0 +1,66 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSendScheduleRequest(t *testing.T) {
+	encoded := `{"action":"send_schedule","wallet":"1234","source":"nano_1","destination":"nano_2","amount_raw":"1000","interval_seconds":60,"start_at":1700000000}`
+	var decoded SendScheduleRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "send_schedule", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Source)
+	assert.Equal(t, "nano_2", decoded.Destination)
+	assert.Equal(t, "1000", decoded.AmountRaw)
+	assert.Equal(t, float64(60), *decoded.IntervalSeconds)
+	assert.Equal(t, float64(1700000000), *decoded.StartAt)
+	assert.Nil(t, decoded.BpowKey)
+}
+
+func TestMapStructureDecodeSendScheduleRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":           "send_schedule",
+		"wallet":           "1234",
+		"source":           "nano_1",
+		"destination":      "nano_2",
+		"amount_raw":       "1000",
+		"interval_seconds": "60",
+	}
+	var decoded SendScheduleRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "send_schedule", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Source)
+	assert.Equal(t, "nano_2", decoded.Destination)
+	assert.Equal(t, "1000", decoded.AmountRaw)
+	assert.Equal(t, "60", *decoded.IntervalSeconds)
+	assert.Nil(t, decoded.StartAt)
+	assert.Nil(t, decoded.BpowKey)
+}
+
+func TestDecodeSendScheduleCancelRequest(t *testing.T) {
+	encoded := `{"action":"send_schedule_cancel","wallet":"1234","schedule_id":"5678"}`
+	var decoded SendScheduleCancelRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "send_schedule_cancel", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "5678", decoded.ScheduleID)
+}
+
+func TestMapStructureDecodeSendScheduleCancelRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":      "send_schedule_cancel",
+		"wallet":      "1234",
+		"schedule_id": "5678",
+	}
+	var decoded SendScheduleCancelRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "send_schedule_cancel", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "5678", decoded.ScheduleID)
+}
@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+type SendWithIDRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Source      string  `json:"source" mapstructure:"source"`
+	Destination string  `json:"destination" mapstructure:"destination"`
+	Amount      string  `json:"amount" mapstructure:"amount"`
+	SendID      string  `json:"send_id" mapstructure:"send_id"`
+	Work        *string `json:"work,omitempty" mapstructure:"work,omitempty"`
+}
+
+func (r *SendWithIDRequest) UnmarshalJSON(data []byte) error {
+	type Alias SendWithIDRequest
+	aux := struct {
+		*Alias
+		Amount json.Number `json:"amount"`
+	}{
+		Alias: (*Alias)(r),
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&aux); err != nil {
+		return err
+	}
+
+	r.Amount = aux.Amount.String()
+	return nil
+}
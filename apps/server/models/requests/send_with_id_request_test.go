@@ -0,0 +1,43 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSendWithIDRequest(t *testing.T) {
+	encoded := `{"action":"send_with_id","wallet":"1234","source":"nano_1","destination":"nano_2","amount":12340000000000000000000000000,"send_id":"withdrawal-42"}`
+	var decoded SendWithIDRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "send_with_id", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Source)
+	assert.Equal(t, "nano_2", decoded.Destination)
+	assert.Equal(t, "12340000000000000000000000000", decoded.Amount)
+	assert.Equal(t, "withdrawal-42", decoded.SendID)
+	assert.Nil(t, decoded.Work)
+}
+
+func TestMapStructureDecodeSendWithIDRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":      "send_with_id",
+		"wallet":      "1234",
+		"source":      "nano_1",
+		"destination": "nano_2",
+		"amount":      "1234",
+		"send_id":     "withdrawal-42",
+		"work":        "0000000000000000",
+	}
+	var decoded SendWithIDRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "send_with_id", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Source)
+	assert.Equal(t, "nano_2", decoded.Destination)
+	assert.Equal(t, "1234", decoded.Amount)
+	assert.Equal(t, "withdrawal-42", decoded.SendID)
+	assert.Equal(t, "0000000000000000", *decoded.Work)
+}
@@ -0,0 +1,9 @@
+package requests
+
+import "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+
+// The block doesn't need a signature, it's the one being added
+type SignBlockRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Block       *block.StateBlock `json:"block" mapstructure:"block"`
+}
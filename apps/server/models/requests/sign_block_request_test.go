@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSignBlockRequest(t *testing.T) {
+	encoded := `{"action":"sign_block","wallet":"1234","block":{"type":"state","account":"nano_1","previous":"abc","representative":"nano_2","balance":"1000","link":"def","work":"0000"}}`
+	var decoded SignBlockRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "sign_block", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Block.Account)
+	assert.Equal(t, "abc", decoded.Block.Previous)
+	assert.Equal(t, "nano_2", decoded.Block.Representative)
+	assert.Equal(t, "1000", decoded.Block.Balance)
+	assert.Equal(t, "def", decoded.Block.Link)
+	assert.Equal(t, "0000", decoded.Block.Work)
+	assert.Equal(t, "", decoded.Block.Signature)
+}
+
+func TestMapStructureDecodeSignBlockRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "sign_block",
+		"wallet": "1234",
+		"block": map[string]interface{}{
+			"account":        "nano_1",
+			"previous":       "abc",
+			"representative": "nano_2",
+			"balance":        "1000",
+			"link":           "def",
+		},
+	}
+	var decoded SignBlockRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "sign_block", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Block.Account)
+	assert.Equal(t, "1000", decoded.Block.Balance)
+	assert.Equal(t, "", decoded.Block.Type)
+}
@@ -0,0 +1,8 @@
+package requests
+
+// Any text, it's signed as it is
+type SignMessageRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Account     string `json:"account" mapstructure:"account"`
+	Message     string `json:"message" mapstructure:"message"`
+}
@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSignMessageRequest(t *testing.T) {
+	encoded := `{"action":"sign_message","wallet":"1234","account":"nano_1","message":"order 1234"}`
+	var decoded SignMessageRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "sign_message", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "order 1234", decoded.Message)
+}
+
+func TestMapStructureDecodeSignMessageRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "sign_message",
+		"wallet":  "1234",
+		"account": "nano_1",
+		"message": "order 1234",
+	}
+	var decoded SignMessageRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "sign_message", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "order 1234", decoded.Message)
+}
@@ -0,0 +1,11 @@
+package requests
+
+type SnapshotBalancesRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Label       *string `json:"label,omitempty" mapstructure:"label,omitempty"`
+}
+
+type GetSnapshotRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	SnapshotID  string `json:"snapshot_id" mapstructure:"snapshot_id"`
+}
@@ -0,0 +1,58 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSnapshotBalancesRequest(t *testing.T) {
+	encoded := `{"action":"snapshot_balances","wallet":"1234","label":"2023"}`
+	var decoded SnapshotBalancesRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "snapshot_balances", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "2023", *decoded.Label)
+
+	encoded = `{"action":"snapshot_balances","wallet":"1234"}`
+	decoded = SnapshotBalancesRequest{}
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Nil(t, decoded.Label)
+}
+
+func TestMapStructureDecodeSnapshotBalancesRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "snapshot_balances",
+		"wallet": "1234",
+		"label":  "2023",
+	}
+	var decoded SnapshotBalancesRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "snapshot_balances", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "2023", *decoded.Label)
+}
+
+func TestDecodeGetSnapshotRequest(t *testing.T) {
+	encoded := `{"action":"get_snapshot","wallet":"1234","snapshot_id":"5678"}`
+	var decoded GetSnapshotRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "get_snapshot", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "5678", decoded.SnapshotID)
+}
+
+func TestMapStructureDecodeGetSnapshotRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":      "get_snapshot",
+		"wallet":      "1234",
+		"snapshot_id": "5678",
+	}
+	var decoded GetSnapshotRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "get_snapshot", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "5678", decoded.SnapshotID)
+}
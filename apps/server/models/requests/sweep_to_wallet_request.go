@@ -0,0 +1,15 @@
+package requests
+
+// An account to sweep, the key at index of seed
+type SweepSource struct {
+	Seed  string       `json:"seed" mapstructure:"seed"`
+	Index *interface{} `json:"index" mapstructure:"index"`
+}
+
+type SweepToWalletRequest struct {
+	BaseRequest        `mapstructure:",squash"`
+	DestinationAccount string        `json:"destination_account" mapstructure:"destination_account"`
+	Sources            []SweepSource `json:"sources" mapstructure:"sources"`
+	// Sweep in the background as a job
+	Async *interface{} `json:"async,omitempty" mapstructure:"async,omitempty"`
+}
@@ -0,0 +1,45 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSweepToWalletRequest(t *testing.T) {
+	encoded := `{"action":"sweep_to_wallet","wallet":"1234","destination_account":"nano_1","bpow_key":"abc","sources":[{"seed":"5678","index":"2"}]}`
+	var decoded SweepToWalletRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "sweep_to_wallet", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.DestinationAccount)
+	assert.Equal(t, "abc", *decoded.BpowKey)
+	assert.Len(t, decoded.Sources, 1)
+	assert.Equal(t, "5678", decoded.Sources[0].Seed)
+	assert.Equal(t, "2", *decoded.Sources[0].Index)
+	assert.Nil(t, decoded.Async)
+}
+
+func TestMapStructureDecodeSweepToWalletRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":              "sweep_to_wallet",
+		"wallet":              "1234",
+		"destination_account": "nano_1",
+		"sources": []interface{}{
+			map[string]interface{}{"seed": "5678", "index": 2},
+		},
+		"async": true,
+	}
+	var decoded SweepToWalletRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "sweep_to_wallet", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.DestinationAccount)
+	assert.Nil(t, decoded.BpowKey)
+	assert.Len(t, decoded.Sources, 1)
+	assert.Equal(t, "5678", decoded.Sources[0].Seed)
+	assert.Equal(t, 2, *decoded.Sources[0].Index)
+	assert.Equal(t, true, *decoded.Async)
+}
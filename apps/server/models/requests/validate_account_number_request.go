@@ -0,0 +1,6 @@
+package requests
+
+type ValidateAccountNumberRequest struct {
+	Action  string `json:"action" mapstructure:"action"`
+	Account string `json:"account" mapstructure:"account"`
+}
@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeValidateAccountNumberRequest(t *testing.T) {
+	encoded := `{"action":"validate_account_number","account":"nano_1"}`
+	var decoded ValidateAccountNumberRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "validate_account_number", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+}
+
+func TestMapStructureDecodeValidateAccountNumberRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "validate_account_number",
+		"account": "nano_1",
+	}
+	var decoded ValidateAccountNumberRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "validate_account_number", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+}
@@ -0,0 +1,9 @@
+package requests
+
+// No wallet, anyone can check a signature sign_message made
+type VerifyMessageRequest struct {
+	Action    string `json:"action" mapstructure:"action"`
+	Account   string `json:"account" mapstructure:"account"`
+	Message   string `json:"message" mapstructure:"message"`
+	Signature string `json:"signature" mapstructure:"signature"`
+}
@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeVerifyMessageRequest(t *testing.T) {
+	encoded := `{"action":"verify_message","account":"nano_1","message":"order 1234","signature":"ABCD"}`
+	var decoded VerifyMessageRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "verify_message", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "order 1234", decoded.Message)
+	assert.Equal(t, "ABCD", decoded.Signature)
+}
+
+func TestMapStructureDecodeVerifyMessageRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":    "verify_message",
+		"account":   "nano_1",
+		"message":   "order 1234",
+		"signature": "ABCD",
+	}
+	var decoded VerifyMessageRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "verify_message", decoded.Action)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, "order 1234", decoded.Message)
+	assert.Equal(t, "ABCD", decoded.Signature)
+}
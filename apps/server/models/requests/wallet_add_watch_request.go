@@ -0,0 +1,6 @@
+package requests
+
+type WalletAddWatchRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Accounts    []string `json:"accounts" mapstructure:"accounts"`
+}
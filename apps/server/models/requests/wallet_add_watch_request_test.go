@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletAddWatchRequest(t *testing.T) {
+	encoded := `{"action":"wallet_add_watch","wallet":"1234","accounts":["nano_1","nano_2"]}`
+	var decoded WalletAddWatchRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_add_watch", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, []string{"nano_1", "nano_2"}, decoded.Accounts)
+}
+
+func TestMapStructureDecodeWalletAddWatchRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":   "wallet_add_watch",
+		"wallet":   "1234",
+		"accounts": []interface{}{"nano_1"},
+	}
+	var decoded WalletAddWatchRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_add_watch", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, []string{"nano_1"}, decoded.Accounts)
+}
@@ -0,0 +1,12 @@
+package requests
+
+type WalletAuditRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	// Optional, only records of this action
+	AuditAction string `json:"audit_action,omitempty" mapstructure:"audit_action,omitempty"`
+	// Optional, unix timestamps, or dates as YYYY-MM-DD
+	StartDate *interface{} `json:"start_date,omitempty" mapstructure:"start_date,omitempty"`
+	EndDate   *interface{} `json:"end_date,omitempty" mapstructure:"end_date,omitempty"`
+	// Optional, the newest count records, default 1000
+	Count *interface{} `json:"count,omitempty" mapstructure:"count,omitempty"`
+}
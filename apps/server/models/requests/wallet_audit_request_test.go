@@ -0,0 +1,36 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletAuditRequest(t *testing.T) {
+	encoded := `{"action":"wallet_audit","wallet":"1234","audit_action":"send","start_date":1700000000,"end_date":"2024-03-01","count":10}`
+	var decoded WalletAuditRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_audit", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "send", decoded.AuditAction)
+	assert.Equal(t, float64(1700000000), *decoded.StartDate)
+	assert.Equal(t, "2024-03-01", *decoded.EndDate)
+	assert.Equal(t, float64(10), *decoded.Count)
+}
+
+func TestMapStructureDecodeWalletAuditRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "wallet_audit",
+		"wallet": "1234",
+	}
+	var decoded WalletAuditRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_audit", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "", decoded.AuditAction)
+	assert.Nil(t, decoded.StartDate)
+	assert.Nil(t, decoded.EndDate)
+	assert.Nil(t, decoded.Count)
+}
@@ -0,0 +1,7 @@
+package requests
+
+type WalletAutoReceiveSetRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	// true or false, also accepts "1" and "0" like the node's booleans
+	Enabled *interface{} `json:"enabled" mapstructure:"enabled"`
+}
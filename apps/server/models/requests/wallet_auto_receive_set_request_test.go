@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletAutoReceiveSetRequest(t *testing.T) {
+	encoded := `{"action":"wallet_auto_receive_set","wallet":"1234","enabled":false}`
+	var decoded WalletAutoReceiveSetRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_auto_receive_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, false, *decoded.Enabled)
+}
+
+func TestMapStructureDecodeWalletAutoReceiveSetRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":  "wallet_auto_receive_set",
+		"wallet":  "1234",
+		"enabled": "1",
+	}
+	var decoded WalletAutoReceiveSetRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_auto_receive_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "1", *decoded.Enabled)
+}
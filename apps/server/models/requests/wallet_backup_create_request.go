@@ -0,0 +1,7 @@
+package requests
+
+type WalletBackupCreateRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	// The backup is encrypted with it, it's needed to restore it
+	Passphrase string `json:"passphrase" mapstructure:"passphrase"`
+}
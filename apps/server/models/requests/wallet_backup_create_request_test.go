@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletBackupCreateRequest(t *testing.T) {
+	encoded := `{"action":"wallet_backup_create","wallet":"1234","passphrase":"hunter2"}`
+	var decoded WalletBackupCreateRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_backup_create", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "hunter2", decoded.Passphrase)
+}
+
+func TestMapStructureDecodeWalletBackupCreateRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":     "wallet_backup_create",
+		"wallet":     "1234",
+		"passphrase": "hunter2",
+	}
+	var decoded WalletBackupCreateRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_backup_create", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "hunter2", decoded.Passphrase)
+}
@@ -0,0 +1,8 @@
+package requests
+
+type WalletBackupRestoreRequest struct {
+	Action string `json:"action" mapstructure:"action"`
+	// The wallet_backup_create backup, as an object or as a string
+	Backup     *interface{} `json:"backup" mapstructure:"backup"`
+	Passphrase string       `json:"passphrase" mapstructure:"passphrase"`
+}
@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletBackupRestoreRequest(t *testing.T) {
+	encoded := `{"action":"wallet_backup_restore","backup":{"version":1},"passphrase":"hunter2"}`
+	var decoded WalletBackupRestoreRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_backup_restore", decoded.Action)
+	assert.Equal(t, map[string]interface{}{"version": float64(1)}, *decoded.Backup)
+	assert.Equal(t, "hunter2", decoded.Passphrase)
+}
+
+func TestMapStructureDecodeWalletBackupRestoreRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":     "wallet_backup_restore",
+		"backup":     `{"version":1}`,
+		"passphrase": "hunter2",
+	}
+	var decoded WalletBackupRestoreRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_backup_restore", decoded.Action)
+	assert.Equal(t, `{"version":1}`, *decoded.Backup)
+	assert.Equal(t, "hunter2", decoded.Passphrase)
+}
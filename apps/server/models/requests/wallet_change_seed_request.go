@@ -2,5 +2,7 @@ package requests
 
 type WalletChangeSeedRequest struct {
 	BaseRequest `mapstructure:",squash"`
-	Seed        string `json:"seed" mapstructure:"seed"`
+	Seed        string       `json:"seed" mapstructure:"seed"`
+	Scan        *interface{} `json:"scan,omitempty" mapstructure:"scan,omitempty"`
+	GapLimit    *interface{} `json:"gap_limit,omitempty" mapstructure:"gap_limit,omitempty"`
 }
@@ -15,6 +15,13 @@ func TestDecodeWalletChangeSeedRequest(t *testing.T) {
 	assert.Equal(t, "wallet_change_seed", decoded.Action)
 	assert.Equal(t, "sdasdas", decoded.Seed)
 	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Nil(t, decoded.Scan)
+
+	encoded = `{"action":"wallet_change_seed","seed":"sdasdas","wallet":"1234","scan":true,"gap_limit":50}`
+	var decodedScan WalletChangeSeedRequest
+	json.Unmarshal([]byte(encoded), &decodedScan)
+	assert.Equal(t, true, *decodedScan.Scan)
+	assert.Equal(t, float64(50), *decodedScan.GapLimit)
 }
 
 func TestMapStructureDecodeWalletChangeSeedRuest(t *testing.T) {
@@ -22,10 +29,13 @@ func TestMapStructureDecodeWalletChangeSeedRuest(t *testing.T) {
 		"action": "wallet_change_seed",
 		"seed":   "sdasdas",
 		"wallet": "1234",
+		"scan":   true,
 	}
 	var decoded WalletChangeSeedRequest
 	mapstructure.Decode(request, &decoded)
 	assert.Equal(t, "wallet_change_seed", decoded.Action)
 	assert.Equal(t, "sdasdas", decoded.Seed)
 	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, true, *decoded.Scan)
+	assert.Nil(t, decoded.GapLimit)
 }
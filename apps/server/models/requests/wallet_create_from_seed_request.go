@@ -0,0 +1,8 @@
+package requests
+
+type WalletCreateFromSeedRequest struct {
+	Action string       `json:"action" mapstructure:"action"`
+	Seed   string       `json:"seed" mapstructure:"seed"`
+	Count  *interface{} `json:"count,omitempty" mapstructure:"count,omitempty"`
+	Name   *string      `json:"name,omitempty" mapstructure:"name,omitempty"`
+}
@@ -0,0 +1,33 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletCreateFromSeedRequest(t *testing.T) {
+	encoded := `{"action":"wallet_create_from_seed","seed":"1234","count":5,"name":"Savings"}`
+	var decoded WalletCreateFromSeedRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_create_from_seed", decoded.Action)
+	assert.Equal(t, "1234", decoded.Seed)
+	assert.Equal(t, float64(5), *decoded.Count)
+	assert.Equal(t, "Savings", *decoded.Name)
+}
+
+func TestMapStructureDecodeWalletCreateFromSeedRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "wallet_create_from_seed",
+		"seed":   "1234",
+		"count":  "5",
+	}
+	var decoded WalletCreateFromSeedRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_create_from_seed", decoded.Action)
+	assert.Equal(t, "1234", decoded.Seed)
+	assert.Equal(t, "5", *decoded.Count)
+	assert.Nil(t, decoded.Name)
+}
@@ -1,6 +1,10 @@
 package requests
 
 type WalletCreateRequest struct {
-	Action string  `json:"action" mapstructure:"action"`
-	Seed   *string `json:"seed,omitempty" mapstructure:"seed,omitempty"`
+	Action     string       `json:"action" mapstructure:"action"`
+	Seed       *string      `json:"seed,omitempty" mapstructure:"seed,omitempty"`
+	ReturnSeed *interface{} `json:"return_seed,omitempty" mapstructure:"return_seed,omitempty"`
+	// Create every account of seed with history on chain
+	Scan     *interface{} `json:"scan,omitempty" mapstructure:"scan,omitempty"`
+	GapLimit *interface{} `json:"gap_limit,omitempty" mapstructure:"gap_limit,omitempty"`
 }
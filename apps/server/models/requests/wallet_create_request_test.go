@@ -18,17 +18,39 @@ func TestDecodeWalletCreateRequest(t *testing.T) {
 	json.Unmarshal([]byte(encoded), &decoded)
 	assert.Equal(t, "wallet_create", decoded.Action)
 	assert.Equal(t, "my seed", *decoded.Seed)
+	assert.Nil(t, decoded.ReturnSeed)
+
+	encoded = `{"action":"wallet_create", "return_seed":true}`
+	var decodedReturnSeed WalletCreateRequest
+	json.Unmarshal([]byte(encoded), &decodedReturnSeed)
+	assert.Equal(t, "wallet_create", decodedReturnSeed.Action)
+	assert.Nil(t, decodedReturnSeed.Seed)
+	assert.Equal(t, true, *decodedReturnSeed.ReturnSeed)
+	assert.Nil(t, decodedReturnSeed.Scan)
+	assert.Nil(t, decodedReturnSeed.GapLimit)
+
+	encoded = `{"action":"wallet_create", "seed":"my seed", "scan":true, "gap_limit":50}`
+	var decodedScan WalletCreateRequest
+	json.Unmarshal([]byte(encoded), &decodedScan)
+	assert.Equal(t, true, *decodedScan.Scan)
+	assert.Equal(t, float64(50), *decodedScan.GapLimit)
 }
 
 func TestMapStructureDecodeWalletCreateRequest(t *testing.T) {
 	request := map[string]interface{}{
-		"action": "wallet_create",
-		"seed":   "my seed",
+		"action":      "wallet_create",
+		"seed":        "my seed",
+		"return_seed": "true",
+		"scan":        "true",
+		"gap_limit":   "50",
 	}
 	var decoded WalletCreateRequest
 	mapstructure.Decode(request, &decoded)
 	assert.Equal(t, "wallet_create", decoded.Action)
 	assert.Equal(t, "my seed", *decoded.Seed)
+	assert.Equal(t, "true", *decoded.ReturnSeed)
+	assert.Equal(t, "true", *decoded.Scan)
+	assert.Equal(t, "50", *decoded.GapLimit)
 
 	var decodedNoSeed WalletCreateRequest
 	request = map[string]interface{}{
@@ -37,4 +59,6 @@ func TestMapStructureDecodeWalletCreateRequest(t *testing.T) {
 	mapstructure.Decode(request, &decodedNoSeed)
 	assert.Equal(t, "wallet_create", decodedNoSeed.Action)
 	assert.Nil(t, decodedNoSeed.Seed)
+	assert.Nil(t, decodedNoSeed.ReturnSeed)
+	assert.Nil(t, decodedNoSeed.Scan)
 }
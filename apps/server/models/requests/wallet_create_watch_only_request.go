@@ -0,0 +1,7 @@
+package requests
+
+type WalletCreateWatchOnlyRequest struct {
+	Action   string   `json:"action" mapstructure:"action"`
+	Accounts []string `json:"accounts" mapstructure:"accounts"`
+	Name     *string  `json:"name,omitempty" mapstructure:"name,omitempty"`
+}
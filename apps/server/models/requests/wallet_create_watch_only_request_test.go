@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletCreateWatchOnlyRequest(t *testing.T) {
+	encoded := `{"action":"wallet_create_watch_only","accounts":["nano_1","nano_2"],"name":"Cold storage"}`
+	var decoded WalletCreateWatchOnlyRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_create_watch_only", decoded.Action)
+	assert.Equal(t, []string{"nano_1", "nano_2"}, decoded.Accounts)
+	assert.Equal(t, "Cold storage", *decoded.Name)
+}
+
+func TestMapStructureDecodeWalletCreateWatchOnlyRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":   "wallet_create_watch_only",
+		"accounts": []interface{}{"nano_1"},
+	}
+	var decoded WalletCreateWatchOnlyRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_create_watch_only", decoded.Action)
+	assert.Equal(t, []string{"nano_1"}, decoded.Accounts)
+	assert.Nil(t, decoded.Name)
+}
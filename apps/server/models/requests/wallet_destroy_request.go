@@ -0,0 +1,6 @@
+package requests
+
+type WalletDestroyRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Force       *interface{} `json:"force,omitempty" mapstructure:"force,omitempty"`
+}
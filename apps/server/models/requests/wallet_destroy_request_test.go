@@ -0,0 +1,36 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletDestroyRequest(t *testing.T) {
+	encoded := `{"action":"wallet_destroy","wallet":"1234"}`
+	var decoded WalletDestroyRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_destroy", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Nil(t, decoded.Force)
+
+	encoded = `{"action":"wallet_destroy","wallet":"1234","force":true}`
+	var decodedForce WalletDestroyRequest
+	json.Unmarshal([]byte(encoded), &decodedForce)
+	assert.Equal(t, true, *decodedForce.Force)
+}
+
+func TestMapStructureDecodeWalletDestroyRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "wallet_destroy",
+		"wallet": "1234",
+		"force":  "true",
+	}
+	var decoded WalletDestroyRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_destroy", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "true", *decoded.Force)
+}
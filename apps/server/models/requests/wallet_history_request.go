@@ -0,0 +1,13 @@
+package requests
+
+type WalletHistoryRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	// Optional, only this account of the wallet
+	Account *string `json:"account,omitempty" mapstructure:"account,omitempty"`
+	// Optional, send or receive
+	Direction *string `json:"direction,omitempty" mapstructure:"direction,omitempty"`
+	// Optional, start at this block instead of the newest one
+	Head   *string      `json:"head,omitempty" mapstructure:"head,omitempty"`
+	Count  *interface{} `json:"count,omitempty" mapstructure:"count,omitempty"`
+	Offset *interface{} `json:"offset,omitempty" mapstructure:"offset,omitempty"`
+}
@@ -0,0 +1,38 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletHistoryRequest(t *testing.T) {
+	encoded := `{"action":"wallet_history","wallet":"1234","account":"nano_1","direction":"send","head":"abc","count":"50","offset":10}`
+	var decoded WalletHistoryRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_history", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", *decoded.Account)
+	assert.Equal(t, "send", *decoded.Direction)
+	assert.Equal(t, "abc", *decoded.Head)
+	assert.Equal(t, "50", *decoded.Count)
+	assert.Equal(t, float64(10), *decoded.Offset)
+}
+
+func TestMapStructureDecodeWalletHistoryRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "wallet_history",
+		"wallet": "1234",
+	}
+	var decoded WalletHistoryRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_history", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Nil(t, decoded.Account)
+	assert.Nil(t, decoded.Direction)
+	assert.Nil(t, decoded.Head)
+	assert.Nil(t, decoded.Count)
+	assert.Nil(t, decoded.Offset)
+}
@@ -0,0 +1,8 @@
+package requests
+
+type WalletImportNanoWalletRequest struct {
+	Action string `json:"action" mapstructure:"action"`
+	// The NanoWallet JSON export, as an object or as a string
+	Backup     *interface{} `json:"backup" mapstructure:"backup"`
+	Passphrase string       `json:"passphrase" mapstructure:"passphrase"`
+}
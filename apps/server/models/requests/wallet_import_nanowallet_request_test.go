@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletImportNanoWalletRequest(t *testing.T) {
+	encoded := `{"action":"wallet_import_nanowallet","backup":{"version":1},"passphrase":"hunter2"}`
+	var decoded WalletImportNanoWalletRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_import_nanowallet", decoded.Action)
+	assert.Equal(t, map[string]interface{}{"version": float64(1)}, *decoded.Backup)
+	assert.Equal(t, "hunter2", decoded.Passphrase)
+}
+
+func TestMapStructureDecodeWalletImportNanoWalletRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":     "wallet_import_nanowallet",
+		"backup":     `{"version":1}`,
+		"passphrase": "hunter2",
+	}
+	var decoded WalletImportNanoWalletRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_import_nanowallet", decoded.Action)
+	assert.Equal(t, `{"version":1}`, *decoded.Backup)
+	assert.Equal(t, "hunter2", decoded.Passphrase)
+}
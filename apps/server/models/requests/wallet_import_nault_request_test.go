@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletImportNaultRequest(t *testing.T) {
+	encoded := `{"action":"wallet_import_nault","backup":{"version":1},"passphrase":"hunter2"}`
+	var decoded WalletImportNaultRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_import_nault", decoded.Action)
+	assert.Equal(t, map[string]interface{}{"version": float64(1)}, *decoded.Backup)
+	assert.Equal(t, "hunter2", decoded.Passphrase)
+}
+
+func TestMapStructureDecodeWalletImportNaultRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":     "wallet_import_nault",
+		"backup":     `{"version":1}`,
+		"passphrase": "hunter2",
+	}
+	var decoded WalletImportNaultRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_import_nault", decoded.Action)
+	assert.Equal(t, `{"version":1}`, *decoded.Backup)
+	assert.Equal(t, "hunter2", decoded.Passphrase)
+}
@@ -0,0 +1,7 @@
+package requests
+
+// Without a wallet it's every encrypted wallet
+type WalletKdfInfoRequest struct {
+	Action string `json:"action" mapstructure:"action"`
+	Wallet string `json:"wallet,omitempty" mapstructure:"wallet,omitempty"`
+}
@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletKdfInfoRequest(t *testing.T) {
+	encoded := `{"action":"wallet_kdf_info","wallet":"1234"}`
+	var decoded WalletKdfInfoRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_kdf_info", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+}
+
+func TestMapStructureDecodeWalletKdfInfoRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "wallet_kdf_info",
+	}
+	var decoded WalletKdfInfoRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_kdf_info", decoded.Action)
+	assert.Equal(t, "", decoded.Wallet)
+}
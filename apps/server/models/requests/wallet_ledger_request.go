@@ -0,0 +1,12 @@
+package requests
+
+// Like the node's wallet_ledger, receivable is the same as pending
+type WalletLedgerRequest struct {
+	BaseRequest    `mapstructure:",squash"`
+	Representative *interface{} `json:"representative,omitempty" mapstructure:"representative,omitempty"`
+	Weight         *interface{} `json:"weight,omitempty" mapstructure:"weight,omitempty"`
+	Pending        *interface{} `json:"pending,omitempty" mapstructure:"pending,omitempty"`
+	Receivable     *interface{} `json:"receivable,omitempty" mapstructure:"receivable,omitempty"`
+	// Unix timestamp, accounts modified before it are left out
+	ModifiedSince *interface{} `json:"modified_since,omitempty" mapstructure:"modified_since,omitempty"`
+}
@@ -0,0 +1,43 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletLedgerRequest(t *testing.T) {
+	encoded := `{"action":"wallet_ledger","wallet":"1234","representative":"true","modified_since":"1700000000"}`
+	var decoded WalletLedgerRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_ledger", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	representative, _ := utils.ToBool(*decoded.Representative)
+	assert.True(t, representative)
+	modifiedSince, _ := utils.ToInt(*decoded.ModifiedSince)
+	assert.Equal(t, 1700000000, modifiedSince)
+	assert.Nil(t, decoded.Weight)
+	assert.Nil(t, decoded.Pending)
+}
+
+func TestMapStructureDecodeWalletLedgerRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":     "wallet_ledger",
+		"wallet":     "1234",
+		"weight":     true,
+		"receivable": "true",
+	}
+	var decoded WalletLedgerRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_ledger", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	weight, _ := utils.ToBool(*decoded.Weight)
+	assert.True(t, weight)
+	receivable, _ := utils.ToBool(*decoded.Receivable)
+	assert.True(t, receivable)
+	assert.Nil(t, decoded.Representative)
+	assert.Nil(t, decoded.ModifiedSince)
+}
@@ -0,0 +1,7 @@
+package requests
+
+type WalletListRequest struct {
+	Action string       `json:"action" mapstructure:"action"`
+	Offset *interface{} `json:"offset,omitempty" mapstructure:"offset,omitempty"`
+	Limit  *interface{} `json:"limit,omitempty" mapstructure:"limit,omitempty"`
+}
@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletListRequest(t *testing.T) {
+	encoded := `{"action":"wallet_list","offset":10,"limit":"20"}`
+	var decoded WalletListRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_list", decoded.Action)
+	assert.Equal(t, float64(10), *decoded.Offset)
+	assert.Equal(t, "20", *decoded.Limit)
+}
+
+func TestMapStructureDecodeWalletListRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "wallet_list",
+		"offset": 10,
+	}
+	var decoded WalletListRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_list", decoded.Action)
+	assert.Equal(t, 10, *decoded.Offset)
+	assert.Nil(t, decoded.Limit)
+}
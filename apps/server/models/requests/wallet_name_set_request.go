@@ -0,0 +1,7 @@
+package requests
+
+type WalletNameSetRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	// Up to 128 characters, empty removes the name
+	Name *string `json:"name" mapstructure:"name"`
+}
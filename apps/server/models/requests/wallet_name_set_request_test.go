@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletNameSetRequest(t *testing.T) {
+	encoded := `{"action":"wallet_name_set","wallet":"1234","name":"payroll"}`
+	var decoded WalletNameSetRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_name_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "payroll", *decoded.Name)
+}
+
+func TestMapStructureDecodeWalletNameSetRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "wallet_name_set",
+		"wallet": "1234",
+		"name":   "",
+	}
+	var decoded WalletNameSetRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_name_set", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "", *decoded.Name)
+}
@@ -0,0 +1,7 @@
+package requests
+
+type WalletReconcileRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	// Optional, only sends made since then are checked, a unix timestamp or a date as YYYY-MM-DD
+	StartDate *interface{} `json:"start_date,omitempty" mapstructure:"start_date,omitempty"`
+}
@@ -0,0 +1,10 @@
+package requests
+
+type WalletRepresentativeHistoryRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	// Optional, only changes of this account
+	Account string `json:"account,omitempty" mapstructure:"account,omitempty"`
+	// Optional, unix timestamps, or dates as YYYY-MM-DD
+	StartDate *interface{} `json:"start_date,omitempty" mapstructure:"start_date,omitempty"`
+	EndDate   *interface{} `json:"end_date,omitempty" mapstructure:"end_date,omitempty"`
+}
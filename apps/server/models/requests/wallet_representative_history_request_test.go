@@ -0,0 +1,34 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletRepresentativeHistoryRequest(t *testing.T) {
+	encoded := `{"action":"wallet_representative_history","wallet":"1234","account":"nano_1","start_date":1700000000,"end_date":"2024-03-01"}`
+	var decoded WalletRepresentativeHistoryRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_representative_history", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Account)
+	assert.Equal(t, float64(1700000000), *decoded.StartDate)
+	assert.Equal(t, "2024-03-01", *decoded.EndDate)
+}
+
+func TestMapStructureDecodeWalletRepresentativeHistoryRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "wallet_representative_history",
+		"wallet": "1234",
+	}
+	var decoded WalletRepresentativeHistoryRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_representative_history", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "", decoded.Account)
+	assert.Nil(t, decoded.StartDate)
+	assert.Nil(t, decoded.EndDate)
+}
@@ -0,0 +1,7 @@
+package requests
+
+type WalletStatisticsRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	// day, week or month
+	Period string `json:"period" mapstructure:"period"`
+}
@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletStatisticsRequest(t *testing.T) {
+	encoded := `{"action":"wallet_statistics","wallet":"1234","period":"week"}`
+	var decoded WalletStatisticsRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_statistics", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "week", decoded.Period)
+}
+
+func TestMapStructureDecodeWalletStatisticsRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "wallet_statistics",
+		"wallet": "1234",
+		"period": "week",
+	}
+	var decoded WalletStatisticsRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_statistics", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "week", decoded.Period)
+}
@@ -0,0 +1,10 @@
+package requests
+
+type WalletSweepRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Destination string `json:"destination" mapstructure:"destination"`
+	// Only report what would be moved
+	DryRun *interface{} `json:"dry_run,omitempty" mapstructure:"dry_run,omitempty"`
+	// Sweep in the background as a job
+	Async *interface{} `json:"async,omitempty" mapstructure:"async,omitempty"`
+}
@@ -0,0 +1,42 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWalletSweepRequest(t *testing.T) {
+	encoded := `{"action":"wallet_sweep","wallet":"1234","destination":"nano_1","dry_run":"true"}`
+	var decoded WalletSweepRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "wallet_sweep", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Destination)
+	dryRun, _ := utils.ToBool(*decoded.DryRun)
+	assert.True(t, dryRun)
+	assert.Nil(t, decoded.Async)
+	assert.Nil(t, decoded.BpowKey)
+}
+
+func TestMapStructureDecodeWalletSweepRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":      "wallet_sweep",
+		"wallet":      "1234",
+		"destination": "nano_1",
+		"async":       true,
+		"bpow_key":    "abc",
+	}
+	var decoded WalletSweepRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "wallet_sweep", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, "nano_1", decoded.Destination)
+	assert.Equal(t, "abc", *decoded.BpowKey)
+	async, _ := utils.ToBool(*decoded.Async)
+	assert.True(t, async)
+	assert.Nil(t, decoded.DryRun)
+}
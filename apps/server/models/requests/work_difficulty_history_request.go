@@ -0,0 +1,7 @@
+package requests
+
+// hours is 1, 6 or 24, without it it's all 24
+type WorkDifficultyHistoryRequest struct {
+	Action string       `json:"action" mapstructure:"action"`
+	Hours  *interface{} `json:"hours,omitempty" mapstructure:"hours,omitempty"`
+}
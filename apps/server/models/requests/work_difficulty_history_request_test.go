@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWorkDifficultyHistoryRequest(t *testing.T) {
+	encoded := `{"action":"work_difficulty_history","hours":6}`
+	var decoded WorkDifficultyHistoryRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "work_difficulty_history", decoded.Action)
+	assert.Equal(t, float64(6), *decoded.Hours)
+}
+
+func TestMapStructureDecodeWorkDifficultyHistoryRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "work_difficulty_history",
+	}
+	var decoded WorkDifficultyHistoryRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "work_difficulty_history", decoded.Action)
+	assert.Nil(t, decoded.Hours)
+}
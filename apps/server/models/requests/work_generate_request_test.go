@@ -28,9 +28,11 @@ func TestMapStructureDecodeWorkGenerateRequest(t *testing.T) {
 		"subtype":     "my subtype",
 		"block_award": true,
 		"bpow_key":    "my bpow key",
+		"multiplier":  2.5,
 	}
 	var decoded WorkGenerateRequest
 	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, 2.5, *decoded.Multiplier)
 	assert.Equal(t, "work_generate", decoded.Action)
 	assert.Equal(t, "my hash", decoded.Hash)
 	assert.Equal(t, "my difficulty", decoded.Difficulty)
@@ -0,0 +1,7 @@
+package requests
+
+// For work_peer_add and work_peer_remove
+type WorkPeerRequest struct {
+	Action string `json:"action" mapstructure:"action"`
+	Url    string `json:"url" mapstructure:"url"`
+}
@@ -0,0 +1,28 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWorkPeerRequest(t *testing.T) {
+	encoded := `{"action":"work_peer_add","url":"http://localhost:7000"}`
+	var decoded WorkPeerRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "work_peer_add", decoded.Action)
+	assert.Equal(t, "http://localhost:7000", decoded.Url)
+}
+
+func TestMapStructureDecodeWorkPeerRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action": "work_peer_remove",
+		"url":    "http://localhost:7000",
+	}
+	var decoded WorkPeerRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "work_peer_remove", decoded.Action)
+	assert.Equal(t, "http://localhost:7000", decoded.Url)
+}
@@ -0,0 +1,8 @@
+package requests
+
+// A message from a /ws client, action is subscribe or unsubscribe
+// Without events a subscription gets every kind of event of the wallet
+type WSSubscribeRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Events      []string `json:"events,omitempty" mapstructure:"events,omitempty"`
+}
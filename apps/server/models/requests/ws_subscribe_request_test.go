@@ -0,0 +1,23 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeWSSubscribeRequest(t *testing.T) {
+	encoded := `{"action":"subscribe","wallet":"1234","events":["receivable","pocketed"]}`
+	var decoded WSSubscribeRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "subscribe", decoded.Action)
+	assert.Equal(t, "1234", decoded.Wallet)
+	assert.Equal(t, []string{"receivable", "pocketed"}, decoded.Events)
+
+	encoded = `{"action":"unsubscribe","wallet":"1234"}`
+	decoded = WSSubscribeRequest{}
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "unsubscribe", decoded.Action)
+	assert.Nil(t, decoded.Events)
+}
@@ -0,0 +1,12 @@
+package responses
+
+type AccountBalanceHistoryResponse struct {
+	History []BalanceHistoryEntry `json:"history" mapstructure:"history"`
+}
+
+type BalanceHistoryEntry struct {
+	// Unix timestamps
+	PeriodStart int64  `json:"period_start" mapstructure:"period_start"`
+	BalanceRaw  string `json:"balance_raw" mapstructure:"balance_raw"`
+	SnapshotAt  int64  `json:"snapshot_at" mapstructure:"snapshot_at"`
+}
@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountBalanceHistoryResponse(t *testing.T) {
+	response := AccountBalanceHistoryResponse{
+		History: []BalanceHistoryEntry{
+			{PeriodStart: 1709251200, BalanceRaw: "1000", SnapshotAt: 1709334000},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"history\":[{\"period_start\":1709251200,\"balance_raw\":\"1000\",\"snapshot_at\":1709334000}]}", string(encoded))
+}
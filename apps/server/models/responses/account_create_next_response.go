@@ -0,0 +1,6 @@
+package responses
+
+type AccountCreateNextResponse struct {
+	NextIndex      int    `json:"next_index" mapstructure:"next_index"`
+	WouldBeAccount string `json:"would_be_account" mapstructure:"would_be_account"`
+}
@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountCreateNextResponse(t *testing.T) {
+	encoded, err := json.Marshal(AccountCreateNextResponse{
+		NextIndex:      3,
+		WouldBeAccount: "nano_1frwge7oebdn87jip7k3sa1uuyf4yxxjh8jg67i69r7smf7tddj1gr6yremf",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"next_index\":3,\"would_be_account\":\"nano_1frwge7oebdn87jip7k3sa1uuyf4yxxjh8jg67i69r7smf7tddj1gr6yremf\"}", string(encoded))
+}
@@ -0,0 +1,7 @@
+package responses
+
+// attempts is how many keys were tried before one matched
+type AccountCreateVanityResponse struct {
+	Account  string `json:"account" mapstructure:"account"`
+	Attempts uint64 `json:"attempts" mapstructure:"attempts"`
+}
@@ -0,0 +1,18 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountCreateVanityResponse(t *testing.T) {
+	response := AccountCreateVanityResponse{
+		Account:  "nano_1pip",
+		Attempts: 31337,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"account\":\"nano_1pip\",\"attempts\":31337}", string(encoded))
+}
@@ -0,0 +1,12 @@
+package responses
+
+// frontier, open_block and representative are null for an account that isn't opened yet
+// cached_at is the unix timestamp the response was cached at, only set when it's from the cache
+type AccountFrontierResponse struct {
+	Frontier       *string `json:"frontier" mapstructure:"frontier"`
+	OpenBlock      *string `json:"open_block" mapstructure:"open_block"`
+	Representative *string `json:"representative" mapstructure:"representative"`
+	BalanceRaw     string  `json:"balance_raw" mapstructure:"balance_raw"`
+	BlockCount     uint64  `json:"block_count" mapstructure:"block_count"`
+	CachedAt       *int64  `json:"cached_at,omitempty" mapstructure:"cached_at,omitempty"`
+}
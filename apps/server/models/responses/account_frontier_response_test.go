@@ -0,0 +1,30 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountFrontierResponse(t *testing.T) {
+	frontier := "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F"
+	openBlock := "E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3"
+	representative := "nano_1"
+	response := AccountFrontierResponse{
+		Frontier:       &frontier,
+		OpenBlock:      &openBlock,
+		Representative: &representative,
+		BalanceRaw:     "1000",
+		BlockCount:     12,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"frontier\":\"80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F\",\"open_block\":\"E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3\",\"representative\":\"nano_1\",\"balance_raw\":\"1000\",\"block_count\":12}", string(encoded))
+
+	cachedAt := int64(1700000000)
+	response = AccountFrontierResponse{BalanceRaw: "0", CachedAt: &cachedAt}
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"frontier\":null,\"open_block\":null,\"representative\":null,\"balance_raw\":\"0\",\"block_count\":0,\"cached_at\":1700000000}", string(encoded))
+}
@@ -0,0 +1,9 @@
+package responses
+
+import rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+
+// history is newest first, empty if nothing came after since_hash
+type AccountHistorySinceResponse struct {
+	Account string                             `json:"account"`
+	History []rpcresponses.AccountHistoryEntry `json:"history"`
+}
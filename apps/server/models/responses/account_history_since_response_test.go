@@ -0,0 +1,26 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountHistorySinceResponse(t *testing.T) {
+	response := AccountHistorySinceResponse{
+		Account: "nano_1",
+		History: []rpcresponses.AccountHistoryEntry{},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"account\":\"nano_1\",\"history\":[]}", string(encoded))
+
+	response.History = append(response.History, rpcresponses.AccountHistoryEntry{Type: "send", Hash: "abc"})
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	var decoded map[string]interface{}
+	json.Unmarshal(encoded, &decoded)
+	assert.Equal(t, "abc", decoded["history"].([]interface{})[0].(map[string]interface{})["hash"])
+}
@@ -0,0 +1,8 @@
+package responses
+
+// label is empty and metadata an empty object when they're not set
+type AccountLabelResponse struct {
+	Account  string            `json:"account" mapstructure:"account"`
+	Label    string            `json:"label" mapstructure:"label"`
+	Metadata map[string]string `json:"metadata" mapstructure:"metadata"`
+}
@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountLabelResponse(t *testing.T) {
+	response := AccountLabelResponse{
+		Account:  "nano_1",
+		Label:    "user 42",
+		Metadata: map[string]string{"user_id": "42"},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"account\":\"nano_1\",\"label\":\"user 42\",\"metadata\":{\"user_id\":\"42\"}}", string(encoded))
+}
@@ -0,0 +1,10 @@
+package responses
+
+// status is ok, offline or low_weight
+type AccountRepresentativeCheckResponse struct {
+	Representative string  `json:"representative" mapstructure:"representative"`
+	IsOnline       bool    `json:"is_online" mapstructure:"is_online"`
+	WeightRaw      string  `json:"weight_raw" mapstructure:"weight_raw"`
+	WeightPercent  float64 `json:"weight_percent" mapstructure:"weight_percent"`
+	Status         string  `json:"status" mapstructure:"status"`
+}
@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountRepresentativeCheckResponse(t *testing.T) {
+	response := AccountRepresentativeCheckResponse{
+		Representative: "nano_1",
+		IsOnline:       true,
+		WeightRaw:      "1000",
+		WeightPercent:  0.05,
+		Status:         "low_weight",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"representative\":\"nano_1\",\"is_online\":true,\"weight_raw\":\"1000\",\"weight_percent\":0.05,\"status\":\"low_weight\"}", string(encoded))
+}
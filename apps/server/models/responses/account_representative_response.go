@@ -0,0 +1,7 @@
+package responses
+
+// Representative is null for accounts that have no blocks yet, reason says why
+type AccountRepresentativeResponse struct {
+	Representative *string `json:"representative" mapstructure:"representative"`
+	Reason         *string `json:"reason,omitempty" mapstructure:"reason,omitempty"`
+}
@@ -0,0 +1,28 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountRepresentativeResponse(t *testing.T) {
+	representative := "1"
+	response := AccountRepresentativeResponse{
+		Representative: &representative,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"representative\":\"1\"}", string(encoded))
+}
+
+func TestEncodeAccountRepresentativeResponseNoBlocks(t *testing.T) {
+	reason := "no_blocks"
+	response := AccountRepresentativeResponse{
+		Reason: &reason,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"representative\":null,\"reason\":\"no_blocks\"}", string(encoded))
+}
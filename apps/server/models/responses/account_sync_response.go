@@ -0,0 +1,7 @@
+package responses
+
+// new_frontier is null if the account is still unopened
+type AccountSyncResponse struct {
+	ReceivedCount int     `json:"received_count"`
+	NewFrontier   *string `json:"new_frontier"`
+}
@@ -0,0 +1,24 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountSyncResponse(t *testing.T) {
+	frontier := "abc"
+	response := AccountSyncResponse{
+		ReceivedCount: 2,
+		NewFrontier:   &frontier,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"received_count\":2,\"new_frontier\":\"abc\"}", string(encoded))
+
+	response = AccountSyncResponse{}
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"received_count\":0,\"new_frontier\":null}", string(encoded))
+}
@@ -0,0 +1,17 @@
+package responses
+
+// The voting weight delegated to an account, weight is the same as weight_raw, as the node returns it
+type AccountWeightResponse struct {
+	Weight     string `json:"weight" mapstructure:"weight"`
+	WeightRaw  string `json:"weight_raw" mapstructure:"weight_raw"`
+	WeightNano string `json:"weight_nano" mapstructure:"weight_nano"`
+}
+
+// An account's voting weight and the representative_info of its representative
+// Representative is nil if the account isn't opened
+type AccountFullInfoResponse struct {
+	Account        string                      `json:"account" mapstructure:"account"`
+	WeightRaw      string                      `json:"weight_raw" mapstructure:"weight_raw"`
+	WeightNano     string                      `json:"weight_nano" mapstructure:"weight_nano"`
+	Representative *RepresentativeInfoResponse `json:"representative" mapstructure:"representative"`
+}
@@ -0,0 +1,42 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountWeightResponse(t *testing.T) {
+	response := AccountWeightResponse{
+		Weight:     "1500000000000000000000000000000",
+		WeightRaw:  "1500000000000000000000000000000",
+		WeightNano: "1.5",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"weight\":\"1500000000000000000000000000000\",\"weight_raw\":\"1500000000000000000000000000000\",\"weight_nano\":\"1.5\"}", string(encoded))
+}
+
+func TestEncodeAccountFullInfoResponse(t *testing.T) {
+	response := AccountFullInfoResponse{
+		Account:    "nano_1",
+		WeightRaw:  "1000000000000000000000000000000",
+		WeightNano: "1",
+		Representative: &RepresentativeInfoResponse{
+			Representative:        "nano_2",
+			WeightRaw:             "1000",
+			IsOnline:              true,
+			OnlineWeightRaw:       "8000",
+			WeightPercentOfOnline: 12.5,
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"account\":\"nano_1\",\"weight_raw\":\"1000000000000000000000000000000\",\"weight_nano\":\"1\",\"representative\":{\"representative\":\"nano_2\",\"weight_raw\":\"1000\",\"is_online\":true,\"online_weight_raw\":\"8000\",\"weight_percent_of_online\":12.5}}", string(encoded))
+
+	response.Representative = nil
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"account\":\"nano_1\",\"weight_raw\":\"1000000000000000000000000000000\",\"weight_nano\":\"1\",\"representative\":null}", string(encoded))
+}
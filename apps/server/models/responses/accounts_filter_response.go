@@ -0,0 +1,11 @@
+package responses
+
+type AccountsFilterResponse struct {
+	Accounts []FilteredAccount `json:"accounts" mapstructure:"accounts"`
+}
+
+type FilteredAccount struct {
+	Account        string  `json:"account" mapstructure:"account"`
+	BalanceRaw     string  `json:"balance_raw" mapstructure:"balance_raw"`
+	Representative *string `json:"representative,omitempty" mapstructure:"representative,omitempty"`
+}
@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountsFilterResponse(t *testing.T) {
+	representative := "nano_3"
+	response := AccountsFilterResponse{
+		Accounts: []FilteredAccount{
+			{Account: "nano_1", BalanceRaw: "1000"},
+			{Account: "nano_2", BalanceRaw: "0", Representative: &representative},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"accounts\":[{\"account\":\"nano_1\",\"balance_raw\":\"1000\"},{\"account\":\"nano_2\",\"balance_raw\":\"0\",\"representative\":\"nano_3\"}]}", string(encoded))
+}
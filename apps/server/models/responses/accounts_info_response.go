@@ -0,0 +1,16 @@
+package responses
+
+// Keyed by account
+type AccountsInfoResponse struct {
+	Accounts map[string]AccountsInfoItem `json:"accounts" mapstructure:"accounts"`
+}
+
+// frontier and representative are null for unopened accounts, derivation_index is only there for accounts of a wallet derived from its seed
+type AccountsInfoItem struct {
+	Opened          bool    `json:"opened" mapstructure:"opened"`
+	BalanceRaw      string  `json:"balance_raw" mapstructure:"balance_raw"`
+	PendingRaw      string  `json:"pending_raw" mapstructure:"pending_raw"`
+	Frontier        *string `json:"frontier" mapstructure:"frontier"`
+	Representative  *string `json:"representative" mapstructure:"representative"`
+	DerivationIndex *int    `json:"derivation_index,omitempty" mapstructure:"derivation_index,omitempty"`
+}
@@ -0,0 +1,23 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountsInfoResponse(t *testing.T) {
+	frontier := "791AF413"
+	representative := "nano_1"
+	index := 0
+	response := AccountsInfoResponse{
+		Accounts: map[string]AccountsInfoItem{
+			"nano_2": {Opened: true, BalanceRaw: "1000", PendingRaw: "5", Frontier: &frontier, Representative: &representative, DerivationIndex: &index},
+			"nano_3": {BalanceRaw: "0", PendingRaw: "0"},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"accounts\":{\"nano_2\":{\"opened\":true,\"balance_raw\":\"1000\",\"pending_raw\":\"5\",\"frontier\":\"791AF413\",\"representative\":\"nano_1\",\"derivation_index\":0},\"nano_3\":{\"opened\":false,\"balance_raw\":\"0\",\"pending_raw\":\"0\",\"frontier\":null,\"representative\":null}}}", string(encoded))
+}
@@ -0,0 +1,12 @@
+package responses
+
+type RepresentativeChange struct {
+	Account   string `json:"account"`
+	BlockHash string `json:"block_hash"`
+}
+
+type AccountsRepresentativeSetResponse struct {
+	Changed []RepresentativeChange `json:"changed"`
+	Skipped []string               `json:"skipped"`
+	Failed  []string               `json:"failed"`
+}
@@ -0,0 +1,24 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountsRepresentativeSetResponse(t *testing.T) {
+	response := AccountsRepresentativeSetResponse{
+		Changed: []RepresentativeChange{
+			{
+				Account:   "nano_1",
+				BlockHash: "ABCD",
+			},
+		},
+		Skipped: []string{"nano_2"},
+		Failed:  []string{},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"changed\":[{\"account\":\"nano_1\",\"block_hash\":\"ABCD\"}],\"skipped\":[\"nano_2\"],\"failed\":[]}", string(encoded))
+}
@@ -1,5 +1,9 @@
 package responses
 
+// labels of the accounts that have one, by address
+// total is how many accounts the wallet has, for account_list with total
 type AccountsResponse struct {
-	Accounts []string `json:"accounts" mapstructure:"accounts"`
+	Accounts []string          `json:"accounts" mapstructure:"accounts"`
+	Labels   map[string]string `json:"labels,omitempty" mapstructure:"labels,omitempty"`
+	Total    *int              `json:"total,omitempty" mapstructure:"total,omitempty"`
 }
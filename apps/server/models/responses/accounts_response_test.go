@@ -14,4 +14,15 @@ func TestEncodeAccountsResponse(t *testing.T) {
 	encoded, err := json.Marshal(response)
 	assert.Nil(t, err)
 	assert.Equal(t, "{\"accounts\":[\"account\",\"account2\"]}", string(encoded))
+
+	response.Labels = map[string]string{"account2": "user 42"}
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"accounts\":[\"account\",\"account2\"],\"labels\":{\"account2\":\"user 42\"}}", string(encoded))
+
+	total := 100000
+	response.Total = &total
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"accounts\":[\"account\",\"account2\"],\"labels\":{\"account2\":\"user 42\"},\"total\":100000}", string(encoded))
 }
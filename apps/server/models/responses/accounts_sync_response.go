@@ -0,0 +1,7 @@
+package responses
+
+type AccountsSyncResponse struct {
+	Opened        []string `json:"opened" mapstructure:"opened"`
+	Unopened      []string `json:"unopened" mapstructure:"unopened"`
+	MissingFromDB []string `json:"missing_from_db" mapstructure:"missing_from_db"`
+}
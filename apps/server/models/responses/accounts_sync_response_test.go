@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountsSyncResponse(t *testing.T) {
+	response := AccountsSyncResponse{
+		Opened:        []string{"nano_1"},
+		Unopened:      []string{"nano_2", "nano_3"},
+		MissingFromDB: []string{},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"opened\":[\"nano_1\"],\"unopened\":[\"nano_2\",\"nano_3\"],\"missing_from_db\":[]}", string(encoded))
+}
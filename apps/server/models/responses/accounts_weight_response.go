@@ -0,0 +1,9 @@
+package responses
+
+// Keyed by representative
+type AccountsWeightResponse map[string]RepresentativeWeight
+
+type RepresentativeWeight struct {
+	Accounts       []string `json:"accounts" mapstructure:"accounts"`
+	TotalWeightRaw string   `json:"total_weight_raw" mapstructure:"total_weight_raw"`
+}
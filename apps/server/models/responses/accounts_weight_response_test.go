@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountsWeightResponse(t *testing.T) {
+	response := AccountsWeightResponse{
+		"nano_3": {Accounts: []string{"nano_1", "nano_2"}, TotalWeightRaw: "1010"},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"nano_3\":{\"accounts\":[\"nano_1\",\"nano_2\"],\"total_weight_raw\":\"1010\"}}", string(encoded))
+}
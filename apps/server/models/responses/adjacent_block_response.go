@@ -0,0 +1,23 @@
+package responses
+
+// The wallet an account is in, ad-hoc accounts have no index
+type BlockWalletAccount struct {
+	Wallet string `json:"wallet" mapstructure:"wallet"`
+	Index  *int   `json:"index,omitempty" mapstructure:"index,omitempty"`
+}
+
+// Successor is nil if the block is the frontier
+type BlockSuccessorResponse struct {
+	Block         string              `json:"block" mapstructure:"block"`
+	Account       string              `json:"account" mapstructure:"account"`
+	Successor     *string             `json:"successor" mapstructure:"successor"`
+	WalletAccount *BlockWalletAccount `json:"wallet_account,omitempty" mapstructure:"wallet_account,omitempty"`
+}
+
+// Predecessor is nil if the block opened the account
+type BlockPredecessorResponse struct {
+	Block         string              `json:"block" mapstructure:"block"`
+	Account       string              `json:"account" mapstructure:"account"`
+	Predecessor   *string             `json:"predecessor" mapstructure:"predecessor"`
+	WalletAccount *BlockWalletAccount `json:"wallet_account,omitempty" mapstructure:"wallet_account,omitempty"`
+}
@@ -0,0 +1,47 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockSuccessorResponse(t *testing.T) {
+	successor := "def"
+	index := 2
+	response := BlockSuccessorResponse{
+		Block:     "abc",
+		Account:   "nano_1",
+		Successor: &successor,
+		WalletAccount: &BlockWalletAccount{
+			Wallet: "1234",
+			Index:  &index,
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"block\":\"abc\",\"account\":\"nano_1\",\"successor\":\"def\",\"wallet_account\":{\"wallet\":\"1234\",\"index\":2}}", string(encoded))
+
+	// The frontier of an account outside any wallet
+	response = BlockSuccessorResponse{
+		Block:   "abc",
+		Account: "nano_1",
+	}
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"block\":\"abc\",\"account\":\"nano_1\",\"successor\":null}", string(encoded))
+}
+
+func TestBlockPredecessorResponse(t *testing.T) {
+	response := BlockPredecessorResponse{
+		Block:   "abc",
+		Account: "nano_1",
+		WalletAccount: &BlockWalletAccount{
+			Wallet: "1234",
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"block\":\"abc\",\"account\":\"nano_1\",\"predecessor\":null,\"wallet_account\":{\"wallet\":\"1234\"}}", string(encoded))
+}
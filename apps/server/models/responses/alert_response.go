@@ -0,0 +1,22 @@
+package responses
+
+type AlertRegisterResponse struct {
+	AlertID string `json:"alert_id" mapstructure:"alert_id"`
+}
+
+type Alert struct {
+	AlertID      string `json:"alert_id" mapstructure:"alert_id"`
+	Account      string `json:"account" mapstructure:"account"`
+	ThresholdRaw string `json:"threshold_raw" mapstructure:"threshold_raw"`
+	Direction    string `json:"direction" mapstructure:"direction"`
+	CallbackUrl  string `json:"callback_url" mapstructure:"callback_url"`
+	Fired        bool   `json:"fired" mapstructure:"fired"`
+}
+
+type AlertListResponse struct {
+	Alerts []Alert `json:"alerts" mapstructure:"alerts"`
+}
+
+type AlertDeleteResponse struct {
+	Deleted string `json:"deleted" mapstructure:"deleted"`
+}
@@ -0,0 +1,48 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAlertRegisterResponse(t *testing.T) {
+	response := AlertRegisterResponse{
+		AlertID: "1234",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"alert_id\":\"1234\"}", string(encoded))
+}
+
+func TestEncodeAlertListResponse(t *testing.T) {
+	response := AlertListResponse{
+		Alerts: []Alert{
+			{
+				AlertID:      "1234",
+				Account:      "nano_1",
+				ThresholdRaw: "1000",
+				Direction:    "below",
+				CallbackUrl:  "https://example.com",
+				Fired:        true,
+			},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"alerts\":[{\"alert_id\":\"1234\",\"account\":\"nano_1\",\"threshold_raw\":\"1000\",\"direction\":\"below\",\"callback_url\":\"https://example.com\",\"fired\":true}]}", string(encoded))
+
+	encoded, err = json.Marshal(AlertListResponse{Alerts: []Alert{}})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"alerts\":[]}", string(encoded))
+}
+
+func TestEncodeAlertDeleteResponse(t *testing.T) {
+	response := AlertDeleteResponse{
+		Deleted: "1",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"deleted\":\"1\"}", string(encoded))
+}
@@ -0,0 +1,8 @@
+package responses
+
+// unconfirmed_count is block_count - confirmation_height
+type BlockCountForAccountResponse struct {
+	BlockCount         uint64 `json:"block_count" mapstructure:"block_count"`
+	ConfirmationHeight uint64 `json:"confirmation_height" mapstructure:"confirmation_height"`
+	UnconfirmedCount   uint64 `json:"unconfirmed_count" mapstructure:"unconfirmed_count"`
+}
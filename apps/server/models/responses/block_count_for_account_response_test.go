@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockCountForAccountResponse(t *testing.T) {
+	response := BlockCountForAccountResponse{
+		BlockCount:         12,
+		ConfirmationHeight: 9,
+		UnconfirmedCount:   3,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"block_count\":12,\"confirmation_height\":9,\"unconfirmed_count\":3}", string(encoded))
+}
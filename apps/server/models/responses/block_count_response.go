@@ -0,0 +1,9 @@
+package responses
+
+type BlockCountResponse struct {
+	Count       string  `json:"count" mapstructure:"count"`
+	Unchecked   string  `json:"unchecked" mapstructure:"unchecked"`
+	Cemented    string  `json:"cemented" mapstructure:"cemented"`
+	SyncPercent float64 `json:"sync_percent" mapstructure:"sync_percent"`
+	Syncing     bool    `json:"syncing,omitempty" mapstructure:"syncing,omitempty"`
+}
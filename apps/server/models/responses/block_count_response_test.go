@@ -0,0 +1,31 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeBlockCountResponse(t *testing.T) {
+	response := BlockCountResponse{
+		Count:       "1000",
+		Unchecked:   "10",
+		Cemented:    "25",
+		SyncPercent: 2.5,
+		Syncing:     true,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"count\":\"1000\",\"unchecked\":\"10\",\"cemented\":\"25\",\"sync_percent\":2.5,\"syncing\":true}", string(encoded))
+
+	response = BlockCountResponse{
+		Count:       "1000",
+		Unchecked:   "0",
+		Cemented:    "1000",
+		SyncPercent: 100,
+	}
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"count\":\"1000\",\"unchecked\":\"0\",\"cemented\":\"1000\",\"sync_percent\":100}", string(encoded))
+}
@@ -0,0 +1,16 @@
+package responses
+
+import "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+
+// The block a send, receive or representative change with preview would publish, nothing was published
+// Work is empty unless it was given or already prefetched, difficulty is what it has to reach
+type BlockPreviewResponse struct {
+	Preview    bool             `json:"preview"`
+	Subtype    string           `json:"subtype"`
+	Hash       string           `json:"hash"`
+	Block      block.StateBlock `json:"block"`
+	Balance    string           `json:"balance"`
+	Amount     string           `json:"amount,omitempty"`
+	Difficulty string           `json:"difficulty"`
+	Signed     bool             `json:"signed"`
+}
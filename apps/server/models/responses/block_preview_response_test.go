@@ -0,0 +1,40 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockPreviewResponse(t *testing.T) {
+	response := BlockPreviewResponse{
+		Preview: true,
+		Subtype: "change",
+		Hash:    "abc",
+		Block: block.StateBlock{
+			Account: "nano_1",
+		},
+		Balance:    "1000",
+		Difficulty: "fffffff800000000",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	var decoded map[string]interface{}
+	json.Unmarshal(encoded, &decoded)
+	assert.Equal(t, true, decoded["preview"])
+	assert.Equal(t, "change", decoded["subtype"])
+	assert.Equal(t, "abc", decoded["hash"])
+	assert.Equal(t, "nano_1", decoded["block"].(map[string]interface{})["account"])
+	assert.Equal(t, "1000", decoded["balance"])
+	assert.NotContains(t, decoded, "amount")
+	assert.Equal(t, "fffffff800000000", decoded["difficulty"])
+	assert.Equal(t, false, decoded["signed"])
+
+	response.Amount = "1"
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	json.Unmarshal(encoded, &decoded)
+	assert.Equal(t, "1", decoded["amount"])
+}
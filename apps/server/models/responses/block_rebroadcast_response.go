@@ -0,0 +1,12 @@
+package responses
+
+type BlockRebroadcastResponse struct {
+	Hash string `json:"hash" mapstructure:"hash"`
+}
+
+// Returned instead of rebroadcasting a block the node has already confirmed
+type BlockAlreadyConfirmedResponse struct {
+	Error     string `json:"error" mapstructure:"error"`
+	ErrorCode string `json:"error_code" mapstructure:"error_code"`
+	Hash      string `json:"hash" mapstructure:"hash"`
+}
@@ -0,0 +1,28 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockRebroadcastResponse(t *testing.T) {
+	response := BlockRebroadcastResponse{
+		Hash: "abc",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"hash\":\"abc\"}", string(encoded))
+}
+
+func TestBlockAlreadyConfirmedResponse(t *testing.T) {
+	response := BlockAlreadyConfirmedResponse{
+		Error:     "already_confirmed",
+		ErrorCode: "ALREADY_CONFIRMED",
+		Hash:      "abc",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"error\":\"already_confirmed\",\"error_code\":\"ALREADY_CONFIRMED\",\"hash\":\"abc\"}", string(encoded))
+}
@@ -0,0 +1,9 @@
+package responses
+
+import rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+
+// block_info is keyed by hash, only with include_block_info
+type ChainResponse struct {
+	Blocks    []string                                   `json:"blocks" mapstructure:"blocks"`
+	BlockInfo map[string]*rpcresponses.BlockInfoResponse `json:"block_info,omitempty" mapstructure:"block_info,omitempty"`
+}
@@ -0,0 +1,27 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeChainResponse(t *testing.T) {
+	response := ChainResponse{
+		Blocks: []string{"abc"},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"blocks\":[\"abc\"]}", string(encoded))
+
+	response.BlockInfo = map[string]*rpcresponses.BlockInfoResponse{
+		"abc": {Height: "5"},
+	}
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	var decoded map[string]interface{}
+	json.Unmarshal(encoded, &decoded)
+	assert.Equal(t, "5", decoded["block_info"].(map[string]interface{})["abc"].(map[string]interface{})["height"])
+}
@@ -0,0 +1,7 @@
+package responses
+
+// restart_required are the changed fields that keep their old value until a restart, like server.port
+type ConfigReloadResponse struct {
+	Reloaded        bool     `json:"reloaded" mapstructure:"reloaded"`
+	RestartRequired []string `json:"restart_required" mapstructure:"restart_required"`
+}
@@ -0,0 +1,14 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigReloadResponse(t *testing.T) {
+	encoded, err := json.Marshal(ConfigReloadResponse{Reloaded: true, RestartRequired: []string{"server.port"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"reloaded\":true,\"restart_required\":[\"server.port\"]}", string(encoded))
+}
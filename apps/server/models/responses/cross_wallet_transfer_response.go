@@ -0,0 +1,7 @@
+package responses
+
+type CrossWalletTransferResponse struct {
+	SourceReceives      []string `json:"source_receives" mapstructure:"source_receives"`
+	Sends               []string `json:"sends" mapstructure:"sends"`
+	DestinationReceives []string `json:"destination_receives" mapstructure:"destination_receives"`
+}
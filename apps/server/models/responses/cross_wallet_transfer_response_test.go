@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeCrossWalletTransferResponse(t *testing.T) {
+	response := CrossWalletTransferResponse{
+		SourceReceives:      []string{"1"},
+		Sends:               []string{"2"},
+		DestinationReceives: []string{"3"},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"source_receives\":[\"1\"],\"sends\":[\"2\"],\"destination_receives\":[\"3\"]}", string(encoded))
+}
@@ -0,0 +1,19 @@
+package responses
+
+// active_difficulty and confirmation_quorum from the node in one response
+// If one of them failed its fields are left out and partial is true
+type ElectionStatisticsResponse struct {
+	NetworkMinimum            string   `json:"network_minimum,omitempty" mapstructure:"network_minimum,omitempty"`
+	NetworkReceiveMinimum     string   `json:"network_receive_minimum,omitempty" mapstructure:"network_receive_minimum,omitempty"`
+	NetworkCurrent            string   `json:"network_current,omitempty" mapstructure:"network_current,omitempty"`
+	NetworkReceiveCurrent     string   `json:"network_receive_current,omitempty" mapstructure:"network_receive_current,omitempty"`
+	Multiplier                string   `json:"multiplier,omitempty" mapstructure:"multiplier,omitempty"`
+	DifficultyTrend           []string `json:"difficulty_trend,omitempty" mapstructure:"difficulty_trend,omitempty"`
+	QuorumDelta               string   `json:"quorum_delta,omitempty" mapstructure:"quorum_delta,omitempty"`
+	OnlineWeightQuorumPercent string   `json:"online_weight_quorum_percent,omitempty" mapstructure:"online_weight_quorum_percent,omitempty"`
+	OnlineWeightMinimum       string   `json:"online_weight_minimum,omitempty" mapstructure:"online_weight_minimum,omitempty"`
+	OnlineStakeTotal          string   `json:"online_stake_total,omitempty" mapstructure:"online_stake_total,omitempty"`
+	TrendedStakeTotal         string   `json:"trended_stake_total,omitempty" mapstructure:"trended_stake_total,omitempty"`
+	PeersStakeTotal           string   `json:"peers_stake_total,omitempty" mapstructure:"peers_stake_total,omitempty"`
+	Partial                   bool     `json:"partial,omitempty" mapstructure:"partial,omitempty"`
+}
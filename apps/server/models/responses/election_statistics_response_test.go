@@ -0,0 +1,27 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeElectionStatisticsResponse(t *testing.T) {
+	response := ElectionStatisticsResponse{
+		Multiplier:      "1",
+		DifficultyTrend: []string{"1", "1.5"},
+		QuorumDelta:     "4146",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"multiplier\":\"1\",\"difficulty_trend\":[\"1\",\"1.5\"],\"quorum_delta\":\"4146\"}", string(encoded))
+
+	response = ElectionStatisticsResponse{
+		QuorumDelta: "4146",
+		Partial:     true,
+	}
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"quorum_delta\":\"4146\",\"partial\":true}", string(encoded))
+}
@@ -0,0 +1,9 @@
+package responses
+
+// Returned instead of creating accounts past the gap limit
+type GapLimitExceededResponse struct {
+	Error      string `json:"error" mapstructure:"error"`
+	ErrorCode  string `json:"error_code" mapstructure:"error_code"`
+	GapLimit   int    `json:"gap_limit" mapstructure:"gap_limit"`
+	CurrentGap int    `json:"current_gap" mapstructure:"current_gap"`
+}
@@ -0,0 +1,20 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGapLimitExceededResponse(t *testing.T) {
+	response := GapLimitExceededResponse{
+		Error:      "gap_limit_exceeded",
+		ErrorCode:  "GAP_LIMIT_EXCEEDED",
+		GapLimit:   20,
+		CurrentGap: 18,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"error\":\"gap_limit_exceeded\",\"error_code\":\"GAP_LIMIT_EXCEEDED\",\"gap_limit\":20,\"current_gap\":18}", string(encoded))
+}
@@ -0,0 +1,6 @@
+package responses
+
+// Actions handled by Pippin, keyed by category
+type GatewayActionsResponse struct {
+	Actions map[string][]string `json:"actions" mapstructure:"actions"`
+}
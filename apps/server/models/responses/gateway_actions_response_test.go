@@ -0,0 +1,20 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewayActionsResponse(t *testing.T) {
+	response := GatewayActionsResponse{
+		Actions: map[string][]string{
+			"block":   {"receive", "send"},
+			"utility": {"gateway_actions"},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"actions\":{\"block\":[\"receive\",\"send\"],\"utility\":[\"gateway_actions\"]}}", string(encoded))
+}
@@ -0,0 +1,42 @@
+package responses
+
+// status is ok or degraded, quorum_status is the status of confirmation_quorum, left out if the node couldn't be reached
+type HealthResponse struct {
+	Status       string       `json:"status" mapstructure:"status"`
+	QuorumStatus *string      `json:"quorum_status,omitempty" mapstructure:"quorum_status,omitempty"`
+	Checks       HealthChecks `json:"checks" mapstructure:"checks"`
+}
+
+// ready is false when the database, redis or the node can't be reached
+type ReadyResponse struct {
+	Ready  bool         `json:"ready" mapstructure:"ready"`
+	Checks HealthChecks `json:"checks" mapstructure:"checks"`
+}
+
+// work_peers is left out when there aren't any
+type HealthChecks struct {
+	Database  DependencyCheck `json:"database" mapstructure:"database"`
+	Redis     DependencyCheck `json:"redis" mapstructure:"redis"`
+	Node      DependencyCheck `json:"node" mapstructure:"node"`
+	WorkPeers *WorkPeersCheck `json:"work_peers,omitempty" mapstructure:"work_peers,omitempty"`
+}
+
+// status is ok or failing, error is why it's failing
+type DependencyCheck struct {
+	Status    string  `json:"status" mapstructure:"status"`
+	LatencyMs int64   `json:"latency_ms" mapstructure:"latency_ms"`
+	Error     *string `json:"error,omitempty" mapstructure:"error,omitempty"`
+}
+
+// status is failing when every peer is failing
+type WorkPeersCheck struct {
+	Status string          `json:"status" mapstructure:"status"`
+	Peers  []WorkPeerCheck `json:"peers" mapstructure:"peers"`
+}
+
+// status is unknown until work was requested from the peer, latency_ms is its average latency
+type WorkPeerCheck struct {
+	Url       string `json:"url" mapstructure:"url"`
+	Status    string `json:"status" mapstructure:"status"`
+	LatencyMs int64  `json:"latency_ms" mapstructure:"latency_ms"`
+}
@@ -0,0 +1,24 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeHealthResponse(t *testing.T) {
+	quorumStatus := "healthy"
+	ok := DependencyCheck{Status: "ok", LatencyMs: 2}
+	checks := HealthChecks{Database: ok, Redis: ok, Node: ok}
+	encoded, err := json.Marshal(HealthResponse{Status: "ok", QuorumStatus: &quorumStatus, Checks: checks})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"status\":\"ok\",\"quorum_status\":\"healthy\",\"checks\":{\"database\":{\"status\":\"ok\",\"latency_ms\":2},\"redis\":{\"status\":\"ok\",\"latency_ms\":2},\"node\":{\"status\":\"ok\",\"latency_ms\":2}}}", string(encoded))
+
+	errStr := "no node could be reached"
+	checks.Node = DependencyCheck{Status: "failing", LatencyMs: 5000, Error: &errStr}
+	checks.WorkPeers = &WorkPeersCheck{Status: "ok", Peers: []WorkPeerCheck{{Url: "http://localhost:7000", Status: "unknown"}}}
+	encoded, err = json.Marshal(ReadyResponse{Ready: false, Checks: checks})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"ready\":false,\"checks\":{\"database\":{\"status\":\"ok\",\"latency_ms\":2},\"redis\":{\"status\":\"ok\",\"latency_ms\":2},\"node\":{\"status\":\"failing\",\"latency_ms\":5000,\"error\":\"no node could be reached\"},\"work_peers\":{\"status\":\"ok\",\"peers\":[{\"url\":\"http://localhost:7000\",\"status\":\"unknown\",\"latency_ms\":0}]}}}", string(encoded))
+}
@@ -0,0 +1,18 @@
+package responses
+
+// Returned instead of the result of an action that runs as a job
+type JobResponse struct {
+	JobID string `json:"job_id" mapstructure:"job_id"`
+}
+
+type JobStatusResponse struct {
+	JobID  string `json:"job_id" mapstructure:"job_id"`
+	Action string `json:"action" mapstructure:"action"`
+	// pending, running, done or failed
+	Status  string `json:"status" mapstructure:"status"`
+	Percent int    `json:"percent" mapstructure:"percent"`
+	// The response of the action, partial until the job is done, null before it's made progress
+	Result map[string]interface{} `json:"result" mapstructure:"result"`
+	// Only when it failed
+	Error *string `json:"error,omitempty" mapstructure:"error,omitempty"`
+}
@@ -0,0 +1,38 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeJobResponse(t *testing.T) {
+	response := JobResponse{
+		JobID: "1234",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"job_id\":\"1234\"}", string(encoded))
+}
+
+func TestEncodeJobStatusResponse(t *testing.T) {
+	response := JobStatusResponse{
+		JobID:   "1234",
+		Action:  "receive_all",
+		Status:  "running",
+		Percent: 50,
+		Result:  map[string]interface{}{"received": 2},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"job_id\":\"1234\",\"action\":\"receive_all\",\"status\":\"running\",\"percent\":50,\"result\":{\"received\":2}}", string(encoded))
+
+	failure := "node unavailable"
+	response.Status = "failed"
+	response.Result = nil
+	response.Error = &failure
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"job_id\":\"1234\",\"action\":\"receive_all\",\"status\":\"failed\",\"percent\":50,\"result\":null,\"error\":\"node unavailable\"}", string(encoded))
+}
@@ -0,0 +1,7 @@
+package responses
+
+type KeyResponse struct {
+	Private string `json:"private" mapstructure:"private"`
+	Public  string `json:"public" mapstructure:"public"`
+	Account string `json:"account" mapstructure:"account"`
+}
@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyResponse(t *testing.T) {
+	response := KeyResponse{
+		Private: "1",
+		Public:  "2",
+		Account: "nano_3",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"private\":\"1\",\"public\":\"2\",\"account\":\"nano_3\"}", string(encoded))
+}
@@ -0,0 +1,10 @@
+package responses
+
+// public_key and account are only set when the key is valid, reason only when it isn't
+// reason is invalid_length or invalid_hex
+type KeyValidResponse struct {
+	Valid     bool   `json:"valid" mapstructure:"valid"`
+	PublicKey string `json:"public_key,omitempty" mapstructure:"public_key,omitempty"`
+	Account   string `json:"account,omitempty" mapstructure:"account,omitempty"`
+	Reason    string `json:"reason,omitempty" mapstructure:"reason,omitempty"`
+}
@@ -0,0 +1,18 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeKeyValidResponse(t *testing.T) {
+	encoded, err := json.Marshal(KeyValidResponse{Valid: true, PublicKey: "ABC", Account: "nano_1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"valid\":true,\"public_key\":\"ABC\",\"account\":\"nano_1\"}", string(encoded))
+
+	encoded, err = json.Marshal(KeyValidResponse{Reason: "invalid_hex"})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"valid\":false,\"reason\":\"invalid_hex\"}", string(encoded))
+}
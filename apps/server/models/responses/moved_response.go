@@ -0,0 +1,5 @@
+package responses
+
+type MovedResponse struct {
+	Moved string `json:"moved" mapstructure:"moved"`
+}
@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeMovedResponse(t *testing.T) {
+	response := MovedResponse{
+		Moved: "1",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"moved\":\"1\"}", string(encoded))
+}
@@ -0,0 +1,10 @@
+package responses
+
+// The node's active_difficulty multiplier now and over the last hour
+// The hour's fields are null until Pippin has sampled active_difficulty
+type NanoDifficultyInfoResponse struct {
+	CurrentMultiplier   float64  `json:"current_multiplier" mapstructure:"current_multiplier"`
+	AverageMultiplier1h *float64 `json:"average_multiplier_1h" mapstructure:"average_multiplier_1h"`
+	MinMultiplier1h     *float64 `json:"min_multiplier_1h" mapstructure:"min_multiplier_1h"`
+	MaxMultiplier1h     *float64 `json:"max_multiplier_1h" mapstructure:"max_multiplier_1h"`
+}
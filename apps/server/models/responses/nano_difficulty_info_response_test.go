@@ -0,0 +1,26 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeNanoDifficultyInfoResponse(t *testing.T) {
+	average, min, max := 1.25, 1.0, 2.0
+	response := NanoDifficultyInfoResponse{
+		CurrentMultiplier:   1.5,
+		AverageMultiplier1h: &average,
+		MinMultiplier1h:     &min,
+		MaxMultiplier1h:     &max,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"current_multiplier\":1.5,\"average_multiplier_1h\":1.25,\"min_multiplier_1h\":1,\"max_multiplier_1h\":2}", string(encoded))
+
+	response = NanoDifficultyInfoResponse{CurrentMultiplier: 1}
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"current_multiplier\":1,\"average_multiplier_1h\":null,\"min_multiplier_1h\":null,\"max_multiplier_1h\":null}", string(encoded))
+}
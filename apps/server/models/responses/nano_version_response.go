@@ -0,0 +1,10 @@
+package responses
+
+// node_vendor and build_version are named like the node's version response
+type NanoVersionResponse struct {
+	NodeVendor   string  `json:"node_vendor" mapstructure:"node_vendor"`
+	BuildVersion string  `json:"build_version" mapstructure:"build_version"`
+	BuildDate    string  `json:"build_date" mapstructure:"build_date"`
+	Banano       bool    `json:"banano" mapstructure:"banano"`
+	NodeVersion  *string `json:"node_version" mapstructure:"node_version"`
+}
@@ -0,0 +1,22 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNanoVersionResponse(t *testing.T) {
+	nodeVersion := "Nano V25.1"
+	response := NanoVersionResponse{
+		NodeVendor:   "Pippin v1.2.3",
+		BuildVersion: "abc1234",
+		BuildDate:    "2024-01-02T03:04:05Z",
+		Banano:       false,
+		NodeVersion:  &nodeVersion,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"node_vendor\":\"Pippin v1.2.3\",\"build_version\":\"abc1234\",\"build_date\":\"2024-01-02T03:04:05Z\",\"banano\":false,\"node_version\":\"Nano V25.1\"}", string(encoded))
+}
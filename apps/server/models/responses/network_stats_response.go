@@ -0,0 +1,14 @@
+package responses
+
+// telemetry, active_difficulty and confirmation_quorum from the node merged for dashboards
+// The fields of a call that failed are null and the failure is in errors
+type NetworkStatsResponse struct {
+	OnlinePeers                *uint64  `json:"online_peers" mapstructure:"online_peers"`
+	BlockCount                 *uint64  `json:"block_count" mapstructure:"block_count"`
+	CementedCount              *uint64  `json:"cemented_count" mapstructure:"cemented_count"`
+	UncheckedCount             *uint64  `json:"unchecked_count" mapstructure:"unchecked_count"`
+	BandwidthCapBytes          *uint64  `json:"bandwidth_cap_bytes" mapstructure:"bandwidth_cap_bytes"`
+	ActiveDifficultyMultiplier *float64 `json:"active_difficulty_multiplier" mapstructure:"active_difficulty_multiplier"`
+	QuorumPercent              *float64 `json:"quorum_percent" mapstructure:"quorum_percent"`
+	Errors                     []string `json:"errors" mapstructure:"errors"`
+}
@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeNetworkStatsResponse(t *testing.T) {
+	peers := uint64(12)
+	multiplier := 1.5
+	response := NetworkStatsResponse{
+		OnlinePeers:                &peers,
+		ActiveDifficultyMultiplier: &multiplier,
+		Errors:                     []string{"confirmation_quorum: bad input"},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"online_peers\":12,\"block_count\":null,\"cemented_count\":null,\"unchecked_count\":null,\"bandwidth_cap_bytes\":null,\"active_difficulty_multiplier\":1.5,\"quorum_percent\":null,\"errors\":[\"confirmation_quorum: bad input\"]}", string(encoded))
+}
@@ -0,0 +1,9 @@
+package responses
+
+type PeersResponse struct {
+	Peers map[string]interface{} `json:"peers" mapstructure:"peers"`
+}
+
+type PeerCountResponse struct {
+	Count int `json:"count" mapstructure:"count"`
+}
@@ -0,0 +1,28 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodePeersResponse(t *testing.T) {
+	response := PeersResponse{
+		Peers: map[string]interface{}{
+			"[::ffff:172.17.0.1]:32841": "19",
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"peers\":{\"[::ffff:172.17.0.1]:32841\":\"19\"}}", string(encoded))
+}
+
+func TestEncodePeerCountResponse(t *testing.T) {
+	response := PeerCountResponse{
+		Count: 2,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"count\":2}", string(encoded))
+}
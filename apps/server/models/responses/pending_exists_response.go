@@ -0,0 +1,7 @@
+package responses
+
+// Amount is only set when the block exists
+type PendingExistsResponse struct {
+	Exists    bool    `json:"exists" mapstructure:"exists"`
+	AmountRaw *string `json:"amount_raw,omitempty" mapstructure:"amount_raw,omitempty"`
+}
@@ -0,0 +1,23 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodePendingExistsResponse(t *testing.T) {
+	amount := "1000"
+	response := PendingExistsResponse{
+		Exists:    true,
+		AmountRaw: &amount,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"exists\":true,\"amount_raw\":\"1000\"}", string(encoded))
+
+	encoded, err = json.Marshal(PendingExistsResponse{})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"exists\":false}", string(encoded))
+}
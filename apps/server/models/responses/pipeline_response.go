@@ -0,0 +1,15 @@
+package responses
+
+// What one action of a pipeline returned, response is its response as it would be without the pipeline
+type PipelineResult struct {
+	Action   string      `json:"action" mapstructure:"action"`
+	Status   int         `json:"status" mapstructure:"status"`
+	Response interface{} `json:"response" mapstructure:"response"`
+}
+
+// Results are in the order the actions ran, if one failed it's the last and failed is true
+type PipelineResponse struct {
+	Results   []PipelineResult `json:"results" mapstructure:"results"`
+	Completed int              `json:"completed" mapstructure:"completed"`
+	Failed    bool             `json:"failed" mapstructure:"failed"`
+}
@@ -0,0 +1,22 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelineResponse(t *testing.T) {
+	response := PipelineResponse{
+		Results: []PipelineResult{
+			{Action: "account_create", Status: 200, Response: map[string]interface{}{"account": "nano_1"}},
+			{Action: "send", Status: 400, Response: map[string]interface{}{"error": "Insufficient balance", "error_code": "INSUFFICIENT_BALANCE"}},
+		},
+		Completed: 1,
+		Failed:    true,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"results\":[{\"action\":\"account_create\",\"status\":200,\"response\":{\"account\":\"nano_1\"}},{\"action\":\"send\",\"status\":400,\"response\":{\"error\":\"Insufficient balance\",\"error_code\":\"INSUFFICIENT_BALANCE\"}}],\"completed\":1,\"failed\":true}", string(encoded))
+}
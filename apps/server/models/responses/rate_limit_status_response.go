@@ -0,0 +1,16 @@
+package responses
+
+// last_refill is null for an IP without a bucket, it has every token
+type RateLimitBucketResponse struct {
+	IP         string  `json:"ip"`
+	Tokens     float64 `json:"tokens"`
+	LastRefill *int64  `json:"last_refill"`
+}
+
+// rate is tokens per second and burst the most an IP can have, both 0 if rate limiting is off
+type RateLimitStatusResponse struct {
+	Enabled bool                      `json:"enabled"`
+	Rate    float64                   `json:"rate"`
+	Burst   int                       `json:"burst"`
+	Buckets []RateLimitBucketResponse `json:"buckets"`
+}
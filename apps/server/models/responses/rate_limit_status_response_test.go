@@ -0,0 +1,23 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeRateLimitStatusResponse(t *testing.T) {
+	lastRefill := int64(1700000000)
+	encoded, err := json.Marshal(RateLimitStatusResponse{
+		Enabled: true,
+		Rate:    5,
+		Burst:   20,
+		Buckets: []RateLimitBucketResponse{
+			{IP: "203.0.113.7", Tokens: 1.5, LastRefill: &lastRefill},
+			{IP: "192.0.2.1", Tokens: 20},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"enabled\":true,\"rate\":5,\"burst\":20,\"buckets\":[{\"ip\":\"203.0.113.7\",\"tokens\":1.5,\"last_refill\":1700000000},{\"ip\":\"192.0.2.1\",\"tokens\":20,\"last_refill\":null}]}", string(encoded))
+}
@@ -0,0 +1,6 @@
+package responses
+
+type ReceivableExistsResponse struct {
+	HasReceivable bool `json:"has_receivable" mapstructure:"has_receivable"`
+	Count         int  `json:"count" mapstructure:"count"`
+}
@@ -0,0 +1,18 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeReceivableExistsResponse(t *testing.T) {
+	encoded, err := json.Marshal(ReceivableExistsResponse{HasReceivable: true, Count: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"has_receivable\":true,\"count\":3}", string(encoded))
+
+	encoded, err = json.Marshal(ReceivableExistsResponse{})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"has_receivable\":false,\"count\":0}", string(encoded))
+}
@@ -0,0 +1,16 @@
+package responses
+
+type ReceivedBlock struct {
+	Hash         string `json:"hash"`
+	ReceivedHash string `json:"received_hash"`
+}
+
+type SkippedBlock struct {
+	Hash   string `json:"hash"`
+	Reason string `json:"reason"`
+}
+
+type ReceiveBatchResponse struct {
+	Received []ReceivedBlock `json:"received"`
+	Skipped  []SkippedBlock  `json:"skipped"`
+}
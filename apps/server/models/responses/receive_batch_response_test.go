@@ -0,0 +1,28 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeReceiveBatchResponse(t *testing.T) {
+	response := ReceiveBatchResponse{
+		Received: []ReceivedBlock{
+			{
+				Hash:         "ABCD",
+				ReceivedHash: "EFGH",
+			},
+		},
+		Skipped: []SkippedBlock{
+			{
+				Hash:   "1234",
+				Reason: "not_pending",
+			},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"received\":[{\"hash\":\"ABCD\",\"received_hash\":\"EFGH\"}],\"skipped\":[{\"hash\":\"1234\",\"reason\":\"not_pending\"}]}", string(encoded))
+}
@@ -0,0 +1,8 @@
+package responses
+
+type ReceiveMinimumResponse struct {
+	// In raw, the wallet's own or the config's receive_minimum
+	Amount string `json:"amount" mapstructure:"amount"`
+	// 1 if pending blocks of at least amount are received in the background, otherwise 0
+	AutoReceive string `json:"auto_receive" mapstructure:"auto_receive"`
+}
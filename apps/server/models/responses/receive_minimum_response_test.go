@@ -0,0 +1,18 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeReceiveMinimumResponse(t *testing.T) {
+	response := ReceiveMinimumResponse{
+		Amount:      "1000000000000000000000000",
+		AutoReceive: "1",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"amount\":\"1000000000000000000000000\",\"auto_receive\":\"1\"}", string(encoded))
+}
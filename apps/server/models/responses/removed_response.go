@@ -0,0 +1,5 @@
+package responses
+
+type RemovedResponse struct {
+	Removed string `json:"removed" mapstructure:"removed"`
+}
@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeRemovedResponse(t *testing.T) {
+	response := RemovedResponse{
+		Removed: "1",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"removed\":\"1\"}", string(encoded))
+}
@@ -0,0 +1,10 @@
+package responses
+
+// The voting weight of a representative, compared to the online weight from confirmation_quorum
+type RepresentativeInfoResponse struct {
+	Representative        string  `json:"representative" mapstructure:"representative"`
+	WeightRaw             string  `json:"weight_raw" mapstructure:"weight_raw"`
+	IsOnline              bool    `json:"is_online" mapstructure:"is_online"`
+	OnlineWeightRaw       string  `json:"online_weight_raw" mapstructure:"online_weight_raw"`
+	WeightPercentOfOnline float64 `json:"weight_percent_of_online" mapstructure:"weight_percent_of_online"`
+}
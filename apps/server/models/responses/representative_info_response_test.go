@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeRepresentativeInfoResponse(t *testing.T) {
+	response := RepresentativeInfoResponse{
+		Representative:        "nano_1",
+		WeightRaw:             "1000",
+		IsOnline:              true,
+		OnlineWeightRaw:       "8000",
+		WeightPercentOfOnline: 12.5,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"representative\":\"nano_1\",\"weight_raw\":\"1000\",\"is_online\":true,\"online_weight_raw\":\"8000\",\"weight_percent_of_online\":12.5}", string(encoded))
+}
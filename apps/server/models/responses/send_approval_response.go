@@ -0,0 +1,24 @@
+package responses
+
+// A send that needs approvals, what send, send_approve and send_reject return
+type SendApprovalResponse struct {
+	ApprovalID  string `json:"approval_id" mapstructure:"approval_id"`
+	Status      string `json:"status" mapstructure:"status"`
+	Source      string `json:"source" mapstructure:"source"`
+	Destination string `json:"destination" mapstructure:"destination"`
+	Amount      string `json:"amount" mapstructure:"amount"`
+	// How many approver keys approved it so far
+	Approvals         int    `json:"approvals" mapstructure:"approvals"`
+	ApprovalsRequired int    `json:"approvals_required" mapstructure:"approvals_required"`
+	Block             string `json:"block,omitempty" mapstructure:"block,omitempty"`
+	CreatedAt         int64  `json:"created_at" mapstructure:"created_at"`
+}
+
+type SendApprovalListResponse struct {
+	Approvals []SendApprovalResponse `json:"approvals" mapstructure:"approvals"`
+}
+
+type WalletApprovalPolicyResponse struct {
+	ApprovalsRequired int    `json:"approvals_required" mapstructure:"approvals_required"`
+	Threshold         string `json:"threshold,omitempty" mapstructure:"threshold,omitempty"`
+}
@@ -0,0 +1,40 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeSendApprovalResponse(t *testing.T) {
+	response := SendApprovalResponse{
+		ApprovalID:        "1234",
+		Status:            "pending",
+		Source:            "nano_1",
+		Destination:       "nano_2",
+		Amount:            "1000",
+		Approvals:         1,
+		ApprovalsRequired: 2,
+		CreatedAt:         1700000000,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"approval_id\":\"1234\",\"status\":\"pending\",\"source\":\"nano_1\",\"destination\":\"nano_2\",\"amount\":\"1000\",\"approvals\":1,\"approvals_required\":2,\"created_at\":1700000000}", string(encoded))
+
+	response.Status = "sent"
+	response.Block = "ABCD"
+	list := SendApprovalListResponse{Approvals: []SendApprovalResponse{response}}
+	encoded, err = json.Marshal(list)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"approvals\":[{\"approval_id\":\"1234\",\"status\":\"sent\",\"source\":\"nano_1\",\"destination\":\"nano_2\",\"amount\":\"1000\",\"approvals\":1,\"approvals_required\":2,\"block\":\"ABCD\",\"created_at\":1700000000}]}", string(encoded))
+}
+
+func TestEncodeWalletApprovalPolicyResponse(t *testing.T) {
+	encoded, err := json.Marshal(WalletApprovalPolicyResponse{ApprovalsRequired: 2, Threshold: "1000"})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"approvals_required\":2,\"threshold\":\"1000\"}", string(encoded))
+	encoded, err = json.Marshal(WalletApprovalPolicyResponse{})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"approvals_required\":0}", string(encoded))
+}
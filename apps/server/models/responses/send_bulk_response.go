@@ -0,0 +1,18 @@
+package responses
+
+// One send of send_bulk, with its block if it was sent, its error otherwise
+type BulkSendResult struct {
+	Destination string  `json:"destination"`
+	Amount      string  `json:"amount"`
+	ID          *string `json:"id,omitempty"`
+	Block       string  `json:"block,omitempty"`
+	Error       string  `json:"error,omitempty"`
+	ErrorCode   string  `json:"error_code,omitempty"`
+}
+
+// Results are in the order of the sends
+type SendBulkResponse struct {
+	Results []BulkSendResult `json:"results"`
+	Sent    int              `json:"sent"`
+	Failed  int              `json:"failed"`
+}
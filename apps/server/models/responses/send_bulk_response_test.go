@@ -0,0 +1,23 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeSendBulkResponse(t *testing.T) {
+	id := "a"
+	response := SendBulkResponse{
+		Results: []BulkSendResult{
+			{Destination: "nano_2", Amount: "1000", ID: &id, Block: "ABCD"},
+			{Destination: "nano_3", Amount: "2000", Error: "insufficient balance", ErrorCode: "INSUFFICIENT_BALANCE"},
+		},
+		Sent:   1,
+		Failed: 1,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"results":[{"destination":"nano_2","amount":"1000","id":"a","block":"ABCD"},{"destination":"nano_3","amount":"2000","error":"insufficient balance","error_code":"INSUFFICIENT_BALANCE"}],"sent":1,"failed":1}`, string(encoded))
+}
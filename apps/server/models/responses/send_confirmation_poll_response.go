@@ -0,0 +1,8 @@
+package responses
+
+// confirmations is 1 once the block is confirmed, confirmation is final in nano
+type SendConfirmationPollResponse struct {
+	Confirmed     bool  `json:"confirmed"`
+	Confirmations int   `json:"confirmations"`
+	Timestamp     int64 `json:"timestamp"`
+}
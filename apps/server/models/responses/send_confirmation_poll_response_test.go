@@ -0,0 +1,18 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeSendConfirmationPollResponse(t *testing.T) {
+	encoded, err := json.Marshal(SendConfirmationPollResponse{Confirmed: true, Confirmations: 1, Timestamp: 1700000000})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"confirmed\":true,\"confirmations\":1,\"timestamp\":1700000000}", string(encoded))
+
+	encoded, err = json.Marshal(SendConfirmationPollResponse{})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"confirmed\":false,\"confirmations\":0,\"timestamp\":0}", string(encoded))
+}
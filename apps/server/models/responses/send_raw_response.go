@@ -0,0 +1,5 @@
+package responses
+
+type SendRawResponse struct {
+	Hash string `json:"hash" mapstructure:"hash"`
+}
@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendRawResponse(t *testing.T) {
+	response := SendRawResponse{
+		Hash: "abc",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"hash\":\"abc\"}", string(encoded))
+}
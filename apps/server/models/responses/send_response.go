@@ -0,0 +1,7 @@
+package responses
+
+// destination_unopened is only there if the destination was never opened
+type SendResponse struct {
+	Block               string `json:"block"`
+	DestinationUnopened bool   `json:"destination_unopened,omitempty"`
+}
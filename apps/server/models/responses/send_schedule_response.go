@@ -0,0 +1,9 @@
+package responses
+
+type SendScheduleResponse struct {
+	ScheduleID string `json:"schedule_id" mapstructure:"schedule_id"`
+}
+
+type SendScheduleCancelResponse struct {
+	Cancelled string `json:"cancelled" mapstructure:"cancelled"`
+}
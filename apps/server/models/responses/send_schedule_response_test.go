@@ -0,0 +1,26 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeSendScheduleResponse(t *testing.T) {
+	response := SendScheduleResponse{
+		ScheduleID: "1234",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"schedule_id\":\"1234\"}", string(encoded))
+}
+
+func TestEncodeSendScheduleCancelResponse(t *testing.T) {
+	response := SendScheduleCancelResponse{
+		Cancelled: "1",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"cancelled\":\"1\"}", string(encoded))
+}
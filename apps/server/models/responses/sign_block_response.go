@@ -0,0 +1,9 @@
+package responses
+
+import "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+
+// The block as it was given with its signature, and the hash that was signed
+type SignBlockResponse struct {
+	Hash  string           `json:"hash"`
+	Block block.StateBlock `json:"block"`
+}
@@ -0,0 +1,27 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignBlockResponse(t *testing.T) {
+	response := SignBlockResponse{
+		Hash: "abc",
+		Block: block.StateBlock{
+			Account:   "nano_1",
+			Signature: "sig",
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	var decoded map[string]interface{}
+	json.Unmarshal(encoded, &decoded)
+	assert.Equal(t, "abc", decoded["hash"])
+	assert.Equal(t, "state", decoded["block"].(map[string]interface{})["type"])
+	assert.Equal(t, "nano_1", decoded["block"].(map[string]interface{})["account"])
+	assert.Equal(t, "sig", decoded["block"].(map[string]interface{})["signature"])
+}
@@ -0,0 +1,7 @@
+package responses
+
+// signature is 128 hex characters
+type SignMessageResponse struct {
+	Account   string `json:"account" mapstructure:"account"`
+	Signature string `json:"signature" mapstructure:"signature"`
+}
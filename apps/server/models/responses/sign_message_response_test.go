@@ -0,0 +1,20 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeSignMessageResponse(t *testing.T) {
+	encoded, err := json.Marshal(SignMessageResponse{Account: "nano_1", Signature: "ABCD"})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"account\":\"nano_1\",\"signature\":\"ABCD\"}", string(encoded))
+}
+
+func TestEncodeVerifyMessageResponse(t *testing.T) {
+	encoded, err := json.Marshal(VerifyMessageResponse{})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"valid\":false}", string(encoded))
+}
@@ -0,0 +1,18 @@
+package responses
+
+type Snapshot struct {
+	SnapshotID   string  `json:"snapshot_id" mapstructure:"snapshot_id"`
+	Label        *string `json:"label" mapstructure:"label"`
+	CreatedAt    int64   `json:"created_at" mapstructure:"created_at"`
+	AccountCount int     `json:"account_count" mapstructure:"account_count"`
+	TotalRaw     string  `json:"total_raw" mapstructure:"total_raw"`
+}
+
+type ListSnapshotsResponse struct {
+	Snapshots []Snapshot `json:"snapshots" mapstructure:"snapshots"`
+}
+
+type GetSnapshotResponse struct {
+	Snapshot `mapstructure:",squash"`
+	Balances map[string]string `json:"balances" mapstructure:"balances"`
+}
@@ -0,0 +1,48 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeSnapshot(t *testing.T) {
+	label := "2023"
+	response := Snapshot{
+		SnapshotID:   "1234",
+		Label:        &label,
+		CreatedAt:    1700000000,
+		AccountCount: 2,
+		TotalRaw:     "1000",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"snapshot_id\":\"1234\",\"label\":\"2023\",\"created_at\":1700000000,\"account_count\":2,\"total_raw\":\"1000\"}", string(encoded))
+
+	response.Label = nil
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"snapshot_id\":\"1234\",\"label\":null,\"created_at\":1700000000,\"account_count\":2,\"total_raw\":\"1000\"}", string(encoded))
+}
+
+func TestEncodeListSnapshotsResponse(t *testing.T) {
+	encoded, err := json.Marshal(ListSnapshotsResponse{Snapshots: []Snapshot{}})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"snapshots\":[]}", string(encoded))
+}
+
+func TestEncodeGetSnapshotResponse(t *testing.T) {
+	response := GetSnapshotResponse{
+		Snapshot: Snapshot{
+			SnapshotID:   "1234",
+			CreatedAt:    1700000000,
+			AccountCount: 1,
+			TotalRaw:     "1000",
+		},
+		Balances: map[string]string{"nano_1": "1000"},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"snapshot_id\":\"1234\",\"label\":null,\"created_at\":1700000000,\"account_count\":1,\"total_raw\":\"1000\",\"balances\":{\"nano_1\":\"1000\"}}", string(encoded))
+}
@@ -0,0 +1,15 @@
+package responses
+
+type NanoSupplyResponse struct {
+	AvailableRaw string `json:"available_raw" mapstructure:"available_raw"`
+	MaxSupplyRaw string `json:"max_supply_raw" mapstructure:"max_supply_raw"`
+	BurnedRaw    string `json:"burned_raw" mapstructure:"burned_raw"`
+}
+
+type CirculatingSupplyResponse struct {
+	CirculatingRaw string `json:"circulating_raw" mapstructure:"circulating_raw"`
+	MaxSupplyRaw   string `json:"max_supply_raw" mapstructure:"max_supply_raw"`
+	BurnedRaw      string `json:"burned_raw" mapstructure:"burned_raw"`
+	BurnAccount    string `json:"burn_account" mapstructure:"burn_account"`
+	BurnAccountRaw string `json:"burn_account_raw" mapstructure:"burn_account_raw"`
+}
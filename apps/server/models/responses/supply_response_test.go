@@ -0,0 +1,32 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeNanoSupplyResponse(t *testing.T) {
+	response := NanoSupplyResponse{
+		AvailableRaw: "70",
+		MaxSupplyRaw: "100",
+		BurnedRaw:    "30",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"available_raw\":\"70\",\"max_supply_raw\":\"100\",\"burned_raw\":\"30\"}", string(encoded))
+}
+
+func TestEncodeCirculatingSupplyResponse(t *testing.T) {
+	response := CirculatingSupplyResponse{
+		CirculatingRaw: "70",
+		MaxSupplyRaw:   "100",
+		BurnedRaw:      "30",
+		BurnAccount:    "nano_1",
+		BurnAccountRaw: "20",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"circulating_raw\":\"70\",\"max_supply_raw\":\"100\",\"burned_raw\":\"30\",\"burn_account\":\"nano_1\",\"burn_account_raw\":\"20\"}", string(encoded))
+}
@@ -0,0 +1,5 @@
+package responses
+
+type SweepToWalletResponse struct {
+	Blocks []string `json:"blocks" mapstructure:"blocks"`
+}
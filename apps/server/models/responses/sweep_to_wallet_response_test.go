@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeSweepToWalletResponse(t *testing.T) {
+	response := SweepToWalletResponse{
+		Blocks: []string{"1234", "5678"},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"blocks\":[\"1234\",\"5678\"]}", string(encoded))
+}
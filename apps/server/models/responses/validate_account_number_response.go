@@ -0,0 +1,7 @@
+package responses
+
+// reason is ok, invalid_prefix, invalid_length, invalid_base32 or invalid_checksum
+type ValidateAccountNumberResponse struct {
+	Valid  bool   `json:"valid" mapstructure:"valid"`
+	Reason string `json:"reason" mapstructure:"reason"`
+}
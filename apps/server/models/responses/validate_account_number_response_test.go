@@ -0,0 +1,18 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeValidateAccountNumberResponse(t *testing.T) {
+	response := ValidateAccountNumberResponse{
+		Valid:  false,
+		Reason: "invalid_checksum",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"valid\":false,\"reason\":\"invalid_checksum\"}", string(encoded))
+}
@@ -0,0 +1,5 @@
+package responses
+
+type VerifyMessageResponse struct {
+	Valid bool `json:"valid" mapstructure:"valid"`
+}
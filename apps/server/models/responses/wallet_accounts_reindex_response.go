@@ -0,0 +1,6 @@
+package responses
+
+type WalletAccountsReindexResponse struct {
+	Updated   int      `json:"updated" mapstructure:"updated"`
+	Unmatched []string `json:"unmatched" mapstructure:"unmatched"`
+}
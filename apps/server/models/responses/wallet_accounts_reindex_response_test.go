@@ -0,0 +1,18 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletAccountsReindexResponse(t *testing.T) {
+	response := WalletAccountsReindexResponse{
+		Updated:   2,
+		Unmatched: []string{"nano_1"},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"updated\":2,\"unmatched\":[\"nano_1\"]}", string(encoded))
+}
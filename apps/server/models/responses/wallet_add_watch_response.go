@@ -0,0 +1,7 @@
+package responses
+
+// success is always empty like the node's, accounts are the ones that weren't in the wallet yet
+type WalletAddWatchResponse struct {
+	Success  string   `json:"success" mapstructure:"success"`
+	Accounts []string `json:"accounts" mapstructure:"accounts"`
+}
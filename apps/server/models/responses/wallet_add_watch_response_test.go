@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletAddWatchResponse(t *testing.T) {
+	response := WalletAddWatchResponse{
+		Accounts: []string{"nano_1"},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"success\":\"\",\"accounts\":[\"nano_1\"]}", string(encoded))
+}
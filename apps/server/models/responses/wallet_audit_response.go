@@ -0,0 +1,18 @@
+package responses
+
+type WalletAuditResponse struct {
+	Records []AuditRecord `json:"records" mapstructure:"records"`
+}
+
+type AuditRecord struct {
+	Action     string                 `json:"action" mapstructure:"action"`
+	Wallet     *string                `json:"wallet,omitempty" mapstructure:"wallet,omitempty"`
+	IP         string                 `json:"ip" mapstructure:"ip"`
+	ApiKeyID   *string                `json:"api_key_id,omitempty" mapstructure:"api_key_id,omitempty"`
+	ApiKeyName *string                `json:"api_key_name,omitempty" mapstructure:"api_key_name,omitempty"`
+	Params     map[string]interface{} `json:"params" mapstructure:"params"`
+	Status     int                    `json:"status" mapstructure:"status"`
+	ErrorCode  *string                `json:"error_code,omitempty" mapstructure:"error_code,omitempty"`
+	// Unix timestamp
+	Time int64 `json:"time" mapstructure:"time"`
+}
@@ -0,0 +1,20 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletAuditResponse(t *testing.T) {
+	errorCode := "INSUFFICIENT_BALANCE"
+	response := WalletAuditResponse{
+		Records: []AuditRecord{
+			{Action: "send", IP: "10.0.0.1", Params: map[string]interface{}{"amount": "1"}, Status: 400, ErrorCode: &errorCode, Time: 1700000000},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"records\":[{\"action\":\"send\",\"ip\":\"10.0.0.1\",\"params\":{\"amount\":\"1\"},\"status\":400,\"error_code\":\"INSUFFICIENT_BALANCE\",\"time\":1700000000}]}", string(encoded))
+}
@@ -0,0 +1,5 @@
+package responses
+
+type WalletBackupCreateResponse struct {
+	Backup interface{} `json:"backup" mapstructure:"backup"`
+}
@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletBackupCreateResponse(t *testing.T) {
+	response := WalletBackupCreateResponse{
+		Backup: json.RawMessage(`{"version":1}`),
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"backup\":{\"version\":1}}", string(encoded))
+}
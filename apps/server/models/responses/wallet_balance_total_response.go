@@ -0,0 +1,15 @@
+package responses
+
+type WalletBalanceTotalResponse struct {
+	BalanceRaw string `json:"balance_raw" mapstructure:"balance_raw"`
+	PendingRaw string `json:"pending_raw" mapstructure:"pending_raw"`
+	TotalRaw   string `json:"total_raw" mapstructure:"total_raw"`
+	Balance    string `json:"balance" mapstructure:"balance"`
+	Pending    string `json:"pending" mapstructure:"pending"`
+	Total      string `json:"total" mapstructure:"total"`
+	// The unit of balance, pending and total if the request gave one
+	Unit string `json:"unit,omitempty" mapstructure:"unit,omitempty"`
+	// Fiat value of total, only with include_price when the price feed is available
+	ValueFiat *string `json:"value_fiat,omitempty" mapstructure:"value_fiat,omitempty"`
+	Currency  *string `json:"currency,omitempty" mapstructure:"currency,omitempty"`
+}
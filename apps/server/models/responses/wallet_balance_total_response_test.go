@@ -0,0 +1,37 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletBalanceTotalResponse(t *testing.T) {
+	response := WalletBalanceTotalResponse{
+		BalanceRaw: "1000000000000000000000000000000",
+		PendingRaw: "1",
+		TotalRaw:   "1000000000000000000000000000001",
+		Balance:    "1",
+		Pending:    "0.000000000000000000000000000001",
+		Total:      "1.000000000000000000000000000001",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"balance_raw\":\"1000000000000000000000000000000\",\"pending_raw\":\"1\",\"total_raw\":\"1000000000000000000000000000001\",\"balance\":\"1\",\"pending\":\"0.000000000000000000000000000001\",\"total\":\"1.000000000000000000000000000001\"}", string(encoded))
+
+	value := "0.91"
+	currency := "USD"
+	response.ValueFiat = &value
+	response.Currency = &currency
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"balance_raw\":\"1000000000000000000000000000000\",\"pending_raw\":\"1\",\"total_raw\":\"1000000000000000000000000000001\",\"balance\":\"1\",\"pending\":\"0.000000000000000000000000000001\",\"total\":\"1.000000000000000000000000000001\",\"value_fiat\":\"0.91\",\"currency\":\"USD\"}", string(encoded))
+
+	response.ValueFiat = nil
+	response.Currency = nil
+	response.Unit = "banoshi"
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"balance_raw\":\"1000000000000000000000000000000\",\"pending_raw\":\"1\",\"total_raw\":\"1000000000000000000000000000001\",\"balance\":\"1\",\"pending\":\"0.000000000000000000000000000001\",\"total\":\"1.000000000000000000000000000001\",\"unit\":\"banoshi\"}", string(encoded))
+}
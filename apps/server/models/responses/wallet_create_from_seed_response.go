@@ -0,0 +1,6 @@
+package responses
+
+type WalletCreateFromSeedResponse struct {
+	Wallet   string   `json:"wallet" mapstructure:"wallet"`
+	Accounts []string `json:"accounts" mapstructure:"accounts"`
+}
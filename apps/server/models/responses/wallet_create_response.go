@@ -2,4 +2,8 @@ package responses
 
 type WalletCreateResponse struct {
 	Wallet string `json:"wallet" mapstructure:"wallet"`
+	// Only set when the wallet was created with return_seed
+	Seed *string `json:"seed,omitempty" mapstructure:"seed,omitempty"`
+	// Only set when the wallet was created with scan, the first account and every one with history
+	Accounts []string `json:"accounts,omitempty" mapstructure:"accounts,omitempty"`
 }
@@ -14,4 +14,16 @@ func TestEncodeWalletCreateResponse(t *testing.T) {
 	encoded, err := json.Marshal(response)
 	assert.Nil(t, err)
 	assert.Equal(t, "{\"wallet\":\"wallet\"}", string(encoded))
+
+	seed := "seed"
+	response.Seed = &seed
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"wallet\":\"wallet\",\"seed\":\"seed\"}", string(encoded))
+
+	response.Seed = nil
+	response.Accounts = []string{"nano_1", "nano_2"}
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"wallet\":\"wallet\",\"accounts\":[\"nano_1\",\"nano_2\"]}", string(encoded))
 }
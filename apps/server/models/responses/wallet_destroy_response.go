@@ -3,3 +3,10 @@ package responses
 type WalletDestroyResponse struct {
 	Destroyed string `json:"destroyed" mapstructure:"destroyed"`
 }
+
+// Returned instead of destroying a wallet that still has funds, unless force is set
+type WalletHasFundsResponse struct {
+	Error      string `json:"error" mapstructure:"error"`
+	ErrorCode  string `json:"error_code" mapstructure:"error_code"`
+	BalanceRaw string `json:"balance_raw" mapstructure:"balance_raw"`
+}
@@ -15,3 +15,14 @@ func TestWalletDestroyResponse(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "{\"destroyed\":\"1\"}", string(encoded))
 }
+
+func TestWalletHasFundsResponse(t *testing.T) {
+	response := WalletHasFundsResponse{
+		Error:      "wallet_has_funds",
+		ErrorCode:  "WALLET_HAS_FUNDS",
+		BalanceRaw: "1000",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"error\":\"wallet_has_funds\",\"error_code\":\"WALLET_HAS_FUNDS\",\"balance_raw\":\"1000\"}", string(encoded))
+}
@@ -0,0 +1,7 @@
+package responses
+
+// Returned by wallet_freeze and wallet_unfreeze, frozen_at is only set while the wallet is frozen
+type WalletFreezeResponse struct {
+	Frozen   bool    `json:"frozen" mapstructure:"frozen"`
+	FrozenAt *string `json:"frozen_at,omitempty" mapstructure:"frozen_at,omitempty"`
+}
@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletFreezeResponse(t *testing.T) {
+	frozenAt := "2026-10-14T18:30:00Z"
+	encoded, err := json.Marshal(WalletFreezeResponse{Frozen: true, FrozenAt: &frozenAt})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"frozen\":true,\"frozen_at\":\"2026-10-14T18:30:00Z\"}", string(encoded))
+
+	encoded, err = json.Marshal(WalletFreezeResponse{})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"frozen\":false}", string(encoded))
+}
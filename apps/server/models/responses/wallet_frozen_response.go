@@ -0,0 +1,8 @@
+package responses
+
+// Returned instead of signing anything for a frozen wallet
+type WalletFrozenResponse struct {
+	Error     string `json:"error" mapstructure:"error"`
+	ErrorCode string `json:"error_code" mapstructure:"error_code"`
+	FrozenAt  string `json:"frozen_at" mapstructure:"frozen_at"`
+}
@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletFrozenResponse(t *testing.T) {
+	response := WalletFrozenResponse{
+		Error:     "wallet_frozen",
+		ErrorCode: "WALLET_FROZEN",
+		FrozenAt:  "2026-10-14T18:30:00Z",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"error\":\"wallet_frozen\",\"error_code\":\"WALLET_FROZEN\",\"frozen_at\":\"2026-10-14T18:30:00Z\"}", string(encoded))
+}
@@ -0,0 +1,14 @@
+package responses
+
+import rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+
+// An account_history entry, block_account is the wallet's account it's in
+type WalletHistoryEntry struct {
+	BlockAccount string `json:"block_account"`
+	rpcresponses.AccountHistoryEntry
+}
+
+// history is newest first across every account of the wallet
+type WalletHistoryResponse struct {
+	History []WalletHistoryEntry `json:"history"`
+}
@@ -0,0 +1,32 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletHistoryResponse(t *testing.T) {
+	response := WalletHistoryResponse{
+		History: []WalletHistoryEntry{},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"history\":[]}", string(encoded))
+
+	// The entry's fields are at the same level as block_account
+	response.History = append(response.History, WalletHistoryEntry{
+		BlockAccount:        "nano_1",
+		AccountHistoryEntry: rpcresponses.AccountHistoryEntry{Type: "send", Hash: "abc"},
+	})
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	var decoded map[string]interface{}
+	json.Unmarshal(encoded, &decoded)
+	entry := decoded["history"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "nano_1", entry["block_account"])
+	assert.Equal(t, "abc", entry["hash"])
+	assert.Equal(t, "send", entry["type"])
+}
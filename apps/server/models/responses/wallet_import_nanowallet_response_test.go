@@ -0,0 +1,18 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletImportNanoWalletResponse(t *testing.T) {
+	response := WalletImportNanoWalletResponse{
+		Wallet:   "1234",
+		Accounts: []string{"nano_1", "nano_2"},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"wallet\":\"1234\",\"accounts\":[\"nano_1\",\"nano_2\"]}", string(encoded))
+}
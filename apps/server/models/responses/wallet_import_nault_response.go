@@ -0,0 +1,5 @@
+package responses
+
+type WalletImportNaultResponse struct {
+	Wallets []string `json:"wallets" mapstructure:"wallets"`
+}
@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletImportNaultResponse(t *testing.T) {
+	response := WalletImportNaultResponse{
+		Wallets: []string{"1234", "5678"},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"wallets\":[\"1234\",\"5678\"]}", string(encoded))
+}
@@ -0,0 +1,26 @@
+package responses
+
+// current is the kdf of the config, wallets with another one are outdated
+type WalletKdfInfoResponse struct {
+	Current WalletKdf       `json:"current" mapstructure:"current"`
+	Wallets []WalletKdfInfo `json:"wallets" mapstructure:"wallets"`
+}
+
+// Argon2id's parameters, memory is in KiB
+type WalletKdf struct {
+	Algorithm   string `json:"algorithm" mapstructure:"algorithm"`
+	Memory      uint32 `json:"memory,omitempty" mapstructure:"memory,omitempty"`
+	Iterations  uint32 `json:"iterations,omitempty" mapstructure:"iterations,omitempty"`
+	Parallelism uint8  `json:"parallelism,omitempty" mapstructure:"parallelism,omitempty"`
+}
+
+// algorithm is argon2id, sha256 for wallets encrypted before it, or null if the wallet isn't encrypted
+type WalletKdfInfo struct {
+	Wallet      string  `json:"wallet" mapstructure:"wallet"`
+	Encrypted   bool    `json:"encrypted" mapstructure:"encrypted"`
+	Algorithm   *string `json:"algorithm" mapstructure:"algorithm"`
+	Memory      uint32  `json:"memory,omitempty" mapstructure:"memory,omitempty"`
+	Iterations  uint32  `json:"iterations,omitempty" mapstructure:"iterations,omitempty"`
+	Parallelism uint8   `json:"parallelism,omitempty" mapstructure:"parallelism,omitempty"`
+	Outdated    bool    `json:"outdated" mapstructure:"outdated"`
+}
@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletKdfInfoResponse(t *testing.T) {
+	sha256 := "sha256"
+	encoded, err := json.Marshal(WalletKdfInfoResponse{
+		Current: WalletKdf{Algorithm: "argon2id", Memory: 19456, Iterations: 2, Parallelism: 1},
+		Wallets: []WalletKdfInfo{
+			{Wallet: "1234", Encrypted: true, Algorithm: &sha256, Outdated: true},
+			{Wallet: "5678"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"current\":{\"algorithm\":\"argon2id\",\"memory\":19456,\"iterations\":2,\"parallelism\":1},\"wallets\":[{\"wallet\":\"1234\",\"encrypted\":true,\"algorithm\":\"sha256\",\"outdated\":true},{\"wallet\":\"5678\",\"encrypted\":false,\"algorithm\":null,\"outdated\":false}]}", string(encoded))
+}
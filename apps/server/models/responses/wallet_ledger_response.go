@@ -0,0 +1,21 @@
+package responses
+
+// Keyed by account, only opened accounts are in it
+type WalletLedgerResponse struct {
+	Accounts map[string]WalletLedgerItem `json:"accounts" mapstructure:"accounts"`
+}
+
+// representative, weight, pending and receivable are only there when they were asked for, label when the account has one
+type WalletLedgerItem struct {
+	Frontier            string `json:"frontier" mapstructure:"frontier"`
+	OpenBlock           string `json:"open_block" mapstructure:"open_block"`
+	RepresentativeBlock string `json:"representative_block" mapstructure:"representative_block"`
+	Balance             string `json:"balance" mapstructure:"balance"`
+	ModifiedTimestamp   string `json:"modified_timestamp" mapstructure:"modified_timestamp"`
+	BlockCount          string `json:"block_count" mapstructure:"block_count"`
+	Representative      string `json:"representative,omitempty" mapstructure:"representative,omitempty"`
+	Weight              string `json:"weight,omitempty" mapstructure:"weight,omitempty"`
+	Pending             string `json:"pending,omitempty" mapstructure:"pending,omitempty"`
+	Receivable          string `json:"receivable,omitempty" mapstructure:"receivable,omitempty"`
+	Label               string `json:"label,omitempty" mapstructure:"label,omitempty"`
+}
@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletLedgerResponse(t *testing.T) {
+	response := WalletLedgerResponse{
+		Accounts: map[string]WalletLedgerItem{
+			"nano_1": {Frontier: "FF84", OpenBlock: "991C", RepresentativeBlock: "991C", Balance: "1000", ModifiedTimestamp: "1606934662", BlockCount: "22", Weight: "0"},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"accounts\":{\"nano_1\":{\"frontier\":\"FF84\",\"open_block\":\"991C\",\"representative_block\":\"991C\",\"balance\":\"1000\",\"modified_timestamp\":\"1606934662\",\"block_count\":\"22\",\"weight\":\"0\"}}}", string(encoded))
+}
@@ -0,0 +1,13 @@
+package responses
+
+type WalletListItem struct {
+	WalletID     string  `json:"wallet_id" mapstructure:"wallet_id"`
+	Name         *string `json:"name" mapstructure:"name"`
+	AccountCount int     `json:"account_count" mapstructure:"account_count"`
+	WatchOnly    bool    `json:"watch_only" mapstructure:"watch_only"`
+	CreatedAt    int64   `json:"created_at" mapstructure:"created_at"`
+}
+
+type WalletListResponse struct {
+	Wallets []WalletListItem `json:"wallets" mapstructure:"wallets"`
+}
@@ -0,0 +1,31 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletListResponse(t *testing.T) {
+	name := "payroll"
+	response := WalletListResponse{
+		Wallets: []WalletListItem{
+			{
+				WalletID:     "1234",
+				Name:         &name,
+				AccountCount: 5,
+				CreatedAt:    1660000000,
+			},
+			{
+				WalletID:     "5678",
+				AccountCount: 1,
+				WatchOnly:    true,
+				CreatedAt:    1660000001,
+			},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"wallets\":[{\"wallet_id\":\"1234\",\"name\":\"payroll\",\"account_count\":5,\"watch_only\":false,\"created_at\":1660000000},{\"wallet_id\":\"5678\",\"name\":null,\"account_count\":1,\"watch_only\":true,\"created_at\":1660000001}]}", string(encoded))
+}
@@ -0,0 +1,5 @@
+package responses
+
+type WalletLockAllResponse struct {
+	Locked int `json:"locked" mapstructure:"locked"`
+}
@@ -0,0 +1,6 @@
+package responses
+
+type WalletNameSetResponse struct {
+	Wallet string  `json:"wallet" mapstructure:"wallet"`
+	Name   *string `json:"name" mapstructure:"name"`
+}
@@ -0,0 +1,18 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletNameSetResponse(t *testing.T) {
+	name := "payroll"
+	encoded, err := json.Marshal(WalletNameSetResponse{Wallet: "1234", Name: &name})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"wallet\":\"1234\",\"name\":\"payroll\"}", string(encoded))
+	encoded, err = json.Marshal(WalletNameSetResponse{Wallet: "1234"})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"wallet\":\"1234\",\"name\":null}", string(encoded))
+}
@@ -0,0 +1,38 @@
+package responses
+
+type WalletReconcileResponse struct {
+	AccountsChecked   int                 `json:"accounts_checked" mapstructure:"accounts_checked"`
+	SendsChecked      int                 `json:"sends_checked" mapstructure:"sends_checked"`
+	Unpocketed        []UnpocketedAccount `json:"unpocketed" mapstructure:"unpocketed"`
+	Forks             []ReconcileFork     `json:"forks" mapstructure:"forks"`
+	MissingSends      []MissingSend       `json:"missing_sends" mapstructure:"missing_sends"`
+	BalanceMismatches []BalanceMismatch   `json:"balance_mismatches" mapstructure:"balance_mismatches"`
+}
+
+type UnpocketedAccount struct {
+	Account string   `json:"account" mapstructure:"account"`
+	Blocks  []string `json:"blocks" mapstructure:"blocks"`
+}
+
+type ReconcileFork struct {
+	Account   string `json:"account" mapstructure:"account"`
+	SendID    string `json:"send_id" mapstructure:"send_id"`
+	Block     string `json:"block" mapstructure:"block"`
+	NodeBlock string `json:"node_block" mapstructure:"node_block"`
+}
+
+type MissingSend struct {
+	Account     string  `json:"account" mapstructure:"account"`
+	SendID      string  `json:"send_id" mapstructure:"send_id"`
+	Block       *string `json:"block,omitempty" mapstructure:"block,omitempty"`
+	Destination string  `json:"destination" mapstructure:"destination"`
+	AmountRaw   *string `json:"amount_raw,omitempty" mapstructure:"amount_raw,omitempty"`
+	Reason      string  `json:"reason" mapstructure:"reason"`
+}
+
+type BalanceMismatch struct {
+	Account        string `json:"account" mapstructure:"account"`
+	Frontier       string `json:"frontier" mapstructure:"frontier"`
+	BalanceRaw     string `json:"balance_raw" mapstructure:"balance_raw"`
+	NodeBalanceRaw string `json:"node_balance_raw" mapstructure:"node_balance_raw"`
+}
@@ -0,0 +1,14 @@
+package responses
+
+type WalletRepresentativeHistoryResponse struct {
+	History []RepresentativeHistoryEntry `json:"history" mapstructure:"history"`
+}
+
+type RepresentativeHistoryEntry struct {
+	Account           string `json:"account" mapstructure:"account"`
+	OldRepresentative string `json:"old_representative" mapstructure:"old_representative"`
+	NewRepresentative string `json:"new_representative" mapstructure:"new_representative"`
+	BlockHash         string `json:"block_hash" mapstructure:"block_hash"`
+	// Unix timestamp
+	ChangedAt int64 `json:"changed_at" mapstructure:"changed_at"`
+}
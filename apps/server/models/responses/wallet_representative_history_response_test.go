@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletRepresentativeHistoryResponse(t *testing.T) {
+	response := WalletRepresentativeHistoryResponse{
+		History: []RepresentativeHistoryEntry{
+			{Account: "nano_1", OldRepresentative: "nano_2", NewRepresentative: "nano_3", BlockHash: "abc", ChangedAt: 1700000000},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"history\":[{\"account\":\"nano_1\",\"old_representative\":\"nano_2\",\"new_representative\":\"nano_3\",\"block_hash\":\"abc\",\"changed_at\":1700000000}]}", string(encoded))
+}
@@ -0,0 +1,8 @@
+package responses
+
+// set is 1 once the wallet's representative is saved
+// With update_existing_accounts it also has the changed, skipped and failed accounts like accounts_representative_set
+type WalletRepresentativeSetResponse struct {
+	Set string `json:"set"`
+	*AccountsRepresentativeSetResponse
+}
@@ -0,0 +1,26 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletRepresentativeSetResponse(t *testing.T) {
+	response := WalletRepresentativeSetResponse{
+		Set: "1",
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"set\":\"1\"}", string(encoded))
+
+	response.AccountsRepresentativeSetResponse = &AccountsRepresentativeSetResponse{
+		Changed: []RepresentativeChange{{Account: "nano_1", BlockHash: "abc"}},
+		Skipped: []string{},
+		Failed:  []string{"nano_2"},
+	}
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"set\":\"1\",\"changed\":[{\"account\":\"nano_1\",\"block_hash\":\"abc\"}],\"skipped\":[],\"failed\":[\"nano_2\"]}", string(encoded))
+}
@@ -0,0 +1,5 @@
+package responses
+
+type WalletSeedResponse struct {
+	Seed *string `json:"seed" mapstructure:"seed"`
+}
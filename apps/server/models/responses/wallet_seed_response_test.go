@@ -0,0 +1,23 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletSeedResponse(t *testing.T) {
+	seed := "seed"
+	response := WalletSeedResponse{
+		Seed: &seed,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"seed\":\"seed\"}", string(encoded))
+
+	response.Seed = nil
+	encoded, err = json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"seed\":null}", string(encoded))
+}
@@ -0,0 +1,27 @@
+package responses
+
+type WalletStatisticsResponse struct {
+	Period string `json:"period" mapstructure:"period"`
+	// Unix timestamp the period started at
+	Since       int64           `json:"since" mapstructure:"since"`
+	Confirmed   BlockStatistics `json:"confirmed" mapstructure:"confirmed"`
+	Unconfirmed BlockStatistics `json:"unconfirmed" mapstructure:"unconfirmed"`
+}
+
+type BlockStatistics struct {
+	Sends                BlockTotals  `json:"sends" mapstructure:"sends"`
+	Receives             BlockTotals  `json:"receives" mapstructure:"receives"`
+	UniqueCounterparties int          `json:"unique_counterparties" mapstructure:"unique_counterparties"`
+	LargestSend          *LargestSend `json:"largest_send" mapstructure:"largest_send"`
+}
+
+type BlockTotals struct {
+	Count    int    `json:"count" mapstructure:"count"`
+	TotalRaw string `json:"total_raw" mapstructure:"total_raw"`
+}
+
+type LargestSend struct {
+	Hash      string `json:"hash" mapstructure:"hash"`
+	Account   string `json:"account" mapstructure:"account"`
+	AmountRaw string `json:"amount_raw" mapstructure:"amount_raw"`
+}
@@ -0,0 +1,32 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletStatisticsResponse(t *testing.T) {
+	response := WalletStatisticsResponse{
+		Period: "day",
+		Since:  1700000000,
+		Confirmed: BlockStatistics{
+			Sends:                BlockTotals{Count: 1, TotalRaw: "1000"},
+			Receives:             BlockTotals{Count: 2, TotalRaw: "50"},
+			UniqueCounterparties: 2,
+			LargestSend: &LargestSend{
+				Hash:      "abc",
+				Account:   "nano_1",
+				AmountRaw: "1000",
+			},
+		},
+		Unconfirmed: BlockStatistics{
+			Sends:    BlockTotals{Count: 0, TotalRaw: "0"},
+			Receives: BlockTotals{Count: 0, TotalRaw: "0"},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"period\":\"day\",\"since\":1700000000,\"confirmed\":{\"sends\":{\"count\":1,\"total_raw\":\"1000\"},\"receives\":{\"count\":2,\"total_raw\":\"50\"},\"unique_counterparties\":2,\"largest_send\":{\"hash\":\"abc\",\"account\":\"nano_1\",\"amount_raw\":\"1000\"}},\"unconfirmed\":{\"sends\":{\"count\":0,\"total_raw\":\"0\"},\"receives\":{\"count\":0,\"total_raw\":\"0\"},\"unique_counterparties\":0,\"largest_send\":null}}", string(encoded))
+}
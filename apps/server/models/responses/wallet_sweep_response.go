@@ -0,0 +1,18 @@
+package responses
+
+// Amounts are in raw, total is the sum of every account's balance and pending
+type WalletSweepResponse struct {
+	Destination string               `json:"destination" mapstructure:"destination"`
+	DryRun      bool                 `json:"dry_run" mapstructure:"dry_run"`
+	TotalRaw    string               `json:"total_raw" mapstructure:"total_raw"`
+	Accounts    []WalletSweepAccount `json:"accounts" mapstructure:"accounts"`
+}
+
+// balance_raw and pending_raw are what it had before the sweep, send is null if nothing was sent
+type WalletSweepAccount struct {
+	Account    string   `json:"account" mapstructure:"account"`
+	BalanceRaw string   `json:"balance_raw" mapstructure:"balance_raw"`
+	PendingRaw string   `json:"pending_raw" mapstructure:"pending_raw"`
+	Receives   []string `json:"receives" mapstructure:"receives"`
+	Send       *string  `json:"send" mapstructure:"send"`
+}
@@ -0,0 +1,22 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletSweepResponse(t *testing.T) {
+	send := "B2"
+	response := WalletSweepResponse{
+		Destination: "nano_1",
+		TotalRaw:    "1005",
+		Accounts: []WalletSweepAccount{
+			{Account: "nano_2", BalanceRaw: "5", PendingRaw: "1000", Receives: []string{"B1"}, Send: &send},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"destination\":\"nano_1\",\"dry_run\":false,\"total_raw\":\"1005\",\"accounts\":[{\"account\":\"nano_2\",\"balance_raw\":\"5\",\"pending_raw\":\"1000\",\"receives\":[\"B1\"],\"send\":\"B2\"}]}", string(encoded))
+}
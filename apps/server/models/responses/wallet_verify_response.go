@@ -0,0 +1,12 @@
+package responses
+
+type WalletVerifyResponse struct {
+	Mismatches []AccountMismatch `json:"mismatches" mapstructure:"mismatches"`
+	Locked     bool              `json:"locked" mapstructure:"locked"`
+}
+
+type AccountMismatch struct {
+	Account  string  `json:"account" mapstructure:"account"`
+	Reason   string  `json:"reason" mapstructure:"reason"`
+	Expected *string `json:"expected,omitempty" mapstructure:"expected,omitempty"`
+}
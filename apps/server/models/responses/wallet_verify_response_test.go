@@ -0,0 +1,22 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWalletVerifyResponse(t *testing.T) {
+	expected := "nano_2"
+	response := WalletVerifyResponse{
+		Mismatches: []AccountMismatch{
+			{Account: "nano_1", Reason: "address_mismatch", Expected: &expected},
+			{Account: "nano_3", Reason: "invalid_address"},
+		},
+		Locked: false,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"mismatches\":[{\"account\":\"nano_1\",\"reason\":\"address_mismatch\",\"expected\":\"nano_2\"},{\"account\":\"nano_3\",\"reason\":\"invalid_address\"}],\"locked\":false}", string(encoded))
+}
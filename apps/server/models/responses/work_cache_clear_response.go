@@ -0,0 +1,5 @@
+package responses
+
+type WorkCacheClearResponse struct {
+	Cleared int `json:"cleared" mapstructure:"cleared"`
+}
@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWorkCacheClearResponse(t *testing.T) {
+	response := WorkCacheClearResponse{
+		Cleared: 3,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"cleared\":3}", string(encoded))
+}
@@ -0,0 +1,5 @@
+package responses
+
+type WorkCancelAllResponse struct {
+	Cancelled int `json:"cancelled" mapstructure:"cancelled"`
+}
@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWorkCancelAllResponse(t *testing.T) {
+	response := WorkCancelAllResponse{
+		Cancelled: 5,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"cancelled\":5}", string(encoded))
+}
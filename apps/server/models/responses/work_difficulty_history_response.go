@@ -0,0 +1,12 @@
+package responses
+
+// Oldest first
+type WorkDifficultyHistoryResponse struct {
+	History []WorkDifficultySample `json:"history" mapstructure:"history"`
+}
+
+type WorkDifficultySample struct {
+	// Unix timestamp
+	Timestamp  int64   `json:"timestamp" mapstructure:"timestamp"`
+	Multiplier float64 `json:"multiplier" mapstructure:"multiplier"`
+}
@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWorkDifficultyHistoryResponse(t *testing.T) {
+	encoded, err := json.Marshal(WorkDifficultyHistoryResponse{
+		History: []WorkDifficultySample{
+			{Timestamp: 1700000000, Multiplier: 1},
+			{Timestamp: 1700000030, Multiplier: 1.5},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"history\":[{\"timestamp\":1700000000,\"multiplier\":1},{\"timestamp\":1700000030,\"multiplier\":1.5}]}", string(encoded))
+}
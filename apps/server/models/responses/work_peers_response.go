@@ -0,0 +1,20 @@
+package responses
+
+type WorkPeersResponse struct {
+	WorkPeers []WorkPeer `json:"work_peers" mapstructure:"work_peers"`
+}
+
+type WorkPeer struct {
+	Url string `json:"url" mapstructure:"url"`
+	// node or work_server, empty until work was requested from it
+	Protocol string `json:"protocol" mapstructure:"protocol"`
+	// Unix timestamps, null if the peer never succeeded or failed
+	LastSuccess *int64 `json:"last_success" mapstructure:"last_success"`
+	LastFailure *int64 `json:"last_failure" mapstructure:"last_failure"`
+	// Over its recent successful calls
+	AverageLatencyMs int64 `json:"average_latency_ms" mapstructure:"average_latency_ms"`
+	Successes        int   `json:"successes" mapstructure:"successes"`
+	// Including the invalid work
+	Failures    int `json:"failures" mapstructure:"failures"`
+	InvalidWork int `json:"invalid_work" mapstructure:"invalid_work"`
+}
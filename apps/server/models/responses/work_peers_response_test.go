@@ -0,0 +1,28 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWorkPeersResponse(t *testing.T) {
+	lastSuccess := int64(1700000000)
+	response := WorkPeersResponse{
+		WorkPeers: []WorkPeer{
+			{
+				Url:              "http://localhost:7000",
+				Protocol:         "work_server",
+				LastSuccess:      &lastSuccess,
+				AverageLatencyMs: 120,
+				Successes:        3,
+				Failures:         2,
+				InvalidWork:      1,
+			},
+		},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"work_peers\":[{\"url\":\"http://localhost:7000\",\"protocol\":\"work_server\",\"last_success\":1700000000,\"last_failure\":null,\"average_latency_ms\":120,\"successes\":3,\"failures\":2,\"invalid_work\":1}]}", string(encoded))
+}
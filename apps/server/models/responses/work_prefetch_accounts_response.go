@@ -0,0 +1,6 @@
+package responses
+
+type WorkPrefetchAccountsResponse struct {
+	// Accounts work is being generated for in the background
+	Queued int `json:"queued" mapstructure:"queued"`
+}
@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWorkPrefetchAccountsResponse(t *testing.T) {
+	response := WorkPrefetchAccountsResponse{
+		Queued: 3,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"queued\":3}", string(encoded))
+}
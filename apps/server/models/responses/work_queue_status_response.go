@@ -0,0 +1,10 @@
+package responses
+
+type WorkQueueStatusResponse struct {
+	Queued              int            `json:"queued" mapstructure:"queued"`
+	InProgress          int            `json:"in_progress" mapstructure:"in_progress"`
+	InProgressByAccount map[string]int `json:"in_progress_by_account" mapstructure:"in_progress_by_account"`
+	// Of the jobs that completed in the last minute
+	AverageWaitMs   int64    `json:"average_wait_ms" mapstructure:"average_wait_ms"`
+	BlockedAccounts []string `json:"blocked_accounts" mapstructure:"blocked_accounts"`
+}
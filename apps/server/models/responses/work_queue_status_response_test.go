@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeWorkQueueStatusResponse(t *testing.T) {
+	response := WorkQueueStatusResponse{
+		Queued:              2,
+		InProgress:          1,
+		InProgressByAccount: map[string]int{"nano_1": 1},
+		AverageWaitMs:       350,
+		BlockedAccounts:     []string{"nano_2"},
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"queued\":2,\"in_progress\":1,\"in_progress_by_account\":{\"nano_1\":1},\"average_wait_ms\":350,\"blocked_accounts\":[\"nano_2\"]}", string(encoded))
+}
@@ -0,0 +1,19 @@
+package responses
+
+// Sent to a /ws client when its subscribe or unsubscribe went through
+type WSAckResponse struct {
+	Ack    string `json:"ack" mapstructure:"ack"`
+	Wallet string `json:"wallet" mapstructure:"wallet"`
+}
+
+// An event of a subscribed wallet, time is a unix timestamp
+type WSEventResponse struct {
+	Event   string `json:"event" mapstructure:"event"`
+	Wallet  string `json:"wallet" mapstructure:"wallet"`
+	Account string `json:"account" mapstructure:"account"`
+	Hash    string `json:"hash" mapstructure:"hash"`
+	Subtype string `json:"subtype,omitempty" mapstructure:"subtype,omitempty"`
+	Amount  string `json:"amount,omitempty" mapstructure:"amount,omitempty"`
+	Source  string `json:"source,omitempty" mapstructure:"source,omitempty"`
+	Time    int64  `json:"time" mapstructure:"time"`
+}
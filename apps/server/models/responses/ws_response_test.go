@@ -0,0 +1,29 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWSAckResponse(t *testing.T) {
+	encoded, err := json.Marshal(WSAckResponse{Ack: "subscribe", Wallet: "1234"})
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"ack\":\"subscribe\",\"wallet\":\"1234\"}", string(encoded))
+}
+
+func TestWSEventResponse(t *testing.T) {
+	response := WSEventResponse{
+		Event:   "receivable",
+		Wallet:  "1234",
+		Account: "nano_1",
+		Hash:    "A1",
+		Amount:  "1000",
+		Source:  "nano_2",
+		Time:    1700000000,
+	}
+	encoded, err := json.Marshal(response)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"event\":\"receivable\",\"wallet\":\"1234\",\"account\":\"nano_1\",\"hash\":\"A1\",\"amount\":\"1000\",\"source\":\"nano_2\",\"time\":1700000000}", string(encoded))
+}
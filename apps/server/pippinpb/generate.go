@@ -0,0 +1,4 @@
+// Generated from pippin.proto with protoc-gen-go and protoc-gen-go-grpc
+package pippinpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative pippin.proto
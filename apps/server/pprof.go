@@ -0,0 +1,35 @@
+package server
+
+import (
+	"net/http"
+	"net/http/pprof"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/controller"
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/go-chi/chi/v5"
+)
+
+// Register the net/http/pprof handlers at pprof_path if pprof_enabled is set, behind the admin token
+func registerPprof(app chi.Router, conf *models.ServerConfig, hc *controller.HttpController) {
+	if !conf.PprofEnabled {
+		return
+	}
+	if hc.AdminToken == "" {
+		log.Warnf("pprof is enabled without PIPPIN_ADMIN_TOKEN, anyone who can reach %s can profile Pippin", conf.PprofPath)
+	}
+	app.Route(strings.TrimSuffix(conf.PprofPath, "/"), func(r chi.Router) {
+		r.Use(hc.AdminAuth)
+		r.Get("/", pprof.Index)
+		r.Get("/cmdline", pprof.Cmdline)
+		r.Get("/profile", pprof.Profile)
+		r.Get("/symbol", pprof.Symbol)
+		r.Post("/symbol", pprof.Symbol)
+		r.Get("/trace", pprof.Trace)
+		// pprof.Index only serves the profiles under /debug/pprof/, so they're served by name here
+		r.Get("/{profile}", func(w http.ResponseWriter, r *http.Request) {
+			pprof.Handler(chi.URLParam(r, "profile")).ServeHTTP(w, r)
+		})
+	})
+}
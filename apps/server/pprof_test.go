@@ -0,0 +1,65 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/controller"
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
+	"github.com/creasty/defaults"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// A server with the routes registerPprof adds for conf
+func servePprof(t *testing.T, conf *models.PippinConfig, adminToken string) *httptest.Server {
+	hc := &controller.HttpController{AdminToken: adminToken}
+	app := chi.NewRouter()
+	registerPprof(app, &conf.Server, hc)
+	ts := httptest.NewServer(app)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func getPprof(t *testing.T, url string, adminToken string) int {
+	req, err := http.NewRequest("GET", url, nil)
+	assert.Nil(t, err)
+	if adminToken != "" {
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestPprof(t *testing.T) {
+	var conf models.PippinConfig
+	defaults.Set(&conf)
+
+	// Off by default
+	ts := servePprof(t, &conf, "")
+	assert.Equal(t, http.StatusNotFound, getPprof(t, ts.URL+"/debug/pprof/", ""))
+
+	conf.Server.PprofEnabled = true
+	ts = servePprof(t, &conf, "")
+	assert.Equal(t, http.StatusOK, getPprof(t, ts.URL+"/debug/pprof/", ""))
+	assert.Equal(t, http.StatusOK, getPprof(t, ts.URL+"/debug/pprof/goroutine?debug=1", ""))
+	assert.Equal(t, http.StatusOK, getPprof(t, ts.URL+"/debug/pprof/cmdline", ""))
+	assert.Equal(t, http.StatusNotFound, getPprof(t, ts.URL+"/debug/pprof/nothing", ""))
+
+	// Behind the admin token once there is one
+	ts = servePprof(t, &conf, "secret")
+	assert.Equal(t, http.StatusUnauthorized, getPprof(t, ts.URL+"/debug/pprof/", ""))
+	assert.Equal(t, http.StatusUnauthorized, getPprof(t, ts.URL+"/debug/pprof/", "wrong"))
+	assert.Equal(t, http.StatusOK, getPprof(t, ts.URL+"/debug/pprof/", "secret"))
+	assert.Equal(t, http.StatusOK, getPprof(t, ts.URL+"/debug/pprof/heap", "secret"))
+
+	// At another path
+	conf.Server.PprofPath = "/internal/pprof/"
+	ts = servePprof(t, &conf, "")
+	assert.Equal(t, http.StatusOK, getPprof(t, ts.URL+"/internal/pprof/", ""))
+	assert.Equal(t, http.StatusOK, getPprof(t, ts.URL+"/internal/pprof/heap", ""))
+	assert.Equal(t, http.StatusNotFound, getPprof(t, ts.URL+"/debug/pprof/", ""))
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"os"
+	"sync"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/controller"
+	"github.com/appditto/pippin_nano_wallet/libs/config"
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc"
+	"golang.org/x/exp/slices"
+)
+
+// Config fields a SIGHUP or config_reload applies, anything else only changes with a restart
+var reloadableFields = []string{
+	"server.log_level",
+	"server.log_format",
+	"server.block_confirm_interval",
+	"server.node_rpc_url",
+	"server.node_rpc_fallback_urls",
+	"server.node_rpc_round_robin",
+	"server.rate_limit",
+	"server.rate_limit_burst",
+	"wallet.receive_minimum",
+	"wallet.callback_url",
+	"wallet.callback_retries",
+	"wallet.work_peers",
+	"wallet.work_sources",
+	"wallet.work_timeout",
+	"wallet.large_send_threshold",
+	"wallet.large_send_work_timeout",
+}
+
+// Re-reads the config file on SIGHUP or config_reload and applies the reloadable fields
+type configReloader struct {
+	// What the server was started with, the other fields are compared to it
+	started *models.PippinConfig
+	parse   func() (*models.PippinConfig, error)
+	hc      *controller.HttpController
+	pow     *pow.PippinPow
+	rpc     *rpc.RPCClient
+	// The TLS certificate, nil without TLS
+	certs *certReloader
+	// A signal and config_reload can come in at once
+	mu sync.Mutex
+}
+
+// Apply the reloadable fields of conf
+func (cr *configReloader) apply(conf *models.PippinConfig) {
+	if err := log.SetLevel(conf.Server.LogLevel); err != nil {
+		log.Errorf("Invalid log_level %s", err)
+	}
+	if err := log.SetFormat(conf.Server.LogFormat); err != nil {
+		log.Errorf("Invalid log_format %s", err)
+	}
+	cr.pow.SetWorkPeers(conf.Wallet.WorkPeers)
+	cr.pow.SetWorkSources(conf.Wallet.WorkSources)
+	cr.pow.SetTimeoutPolicy(pow.NewTimeoutPolicy(conf.Wallet.WorkTimeout, conf.Wallet.LargeSendThreshold, conf.Wallet.LargeSendWorkTimeout))
+	cr.pow.SetNodeRpcUrl(conf.Server.NodeRpcUrl)
+	if cr.rpc != nil {
+		cr.rpc.SetNodes(append([]string{conf.Server.NodeRpcUrl}, conf.Server.NodeRpcFallbackUrls...), conf.Server.NodeRpcRoundRobin)
+	}
+	cr.hc.ApplyConfig(conf)
+}
+
+// Parse the config file again, if it's valid apply it, otherwise nothing changes
+// Returns the changed fields that need a restart
+func (cr *configReloader) reload() ([]string, error) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	conf, err := cr.parse()
+	if err != nil {
+		log.Errorf("Not reloading config, it's invalid %s", err)
+		return nil, err
+	}
+	restartRequired := []string{}
+	for _, field := range config.ChangedFields(cr.started, conf) {
+		if !slices.Contains(reloadableFields, field) {
+			log.Warnf("Config %s changed, restart to apply it", field)
+			restartRequired = append(restartRequired, field)
+		}
+	}
+	cr.apply(conf)
+	if cr.certs != nil {
+		if err := cr.certs.reload(); err != nil {
+			log.Errorf("Not reloading the TLS certificate %s", err)
+		}
+	}
+	log.Info("Config reloaded")
+	return restartRequired, nil
+}
+
+// Reload for every signal until signals is closed
+func (cr *configReloader) watch(signals <-chan os.Signal) {
+	for range signals {
+		cr.reload()
+	}
+}
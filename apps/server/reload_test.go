@@ -0,0 +1,85 @@
+package server
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/controller"
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/creasty/defaults"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigReload(t *testing.T) {
+	var started models.PippinConfig
+	defaults.Set(&started)
+	started.Wallet.WorkPeers = []string{"http://localhost:5555"}
+
+	next := started
+	var parseErr error
+	ppow := pow.NewPippinPow(started.Wallet.WorkPeers, "", "", nil)
+	rpcClient := rpc.NewRPCClient(started.Server.NodeRpcUrl)
+	nanoWallet := &wallet.NanoWallet{Config: &started}
+	hc := &controller.HttpController{Wallet: nanoWallet, RateLimiter: controller.NewRateLimiter(0, 20)}
+	reloader := configReloader{
+		started: &started,
+		parse: func() (*models.PippinConfig, error) {
+			if parseErr != nil {
+				return nil, parseErr
+			}
+			conf := next
+			return &conf, nil
+		},
+		hc:  hc,
+		pow: ppow,
+		rpc: rpcClient,
+	}
+	assert.Equal(t, 10*time.Second, hc.BlockConfirmInterval())
+
+	signals := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	go func() {
+		reloader.watch(signals)
+		close(done)
+	}()
+
+	// The rate limit and work peers change, the port needs a restart
+	next.Server.BlockConfirmInterval = 30
+	next.Server.Port = 11339
+	next.Wallet.WorkPeers = []string{"http://localhost:6666"}
+	next.Wallet.WorkSources = []string{"peers", "local"}
+	next.Server.NodeRpcUrl = "http://[::1]:7077"
+	next.Server.NodeRpcFallbackUrls = []string{"http://[::1]:7078"}
+	next.Server.RateLimit = 5
+	next.Wallet.ReceiveMinimum = "1000"
+	signals <- syscall.SIGHUP
+	close(signals)
+	<-done
+	assert.Equal(t, 30*time.Second, hc.BlockConfirmInterval())
+	assert.Equal(t, []string{"http://localhost:6666"}, ppow.WorkPeers())
+	assert.Equal(t, []string{"peers", "local"}, ppow.WorkSources())
+	assert.Equal(t, 11338, hc.Wallet.Config.Server.Port)
+	assert.Equal(t, []rpc.NodeStatus{{Url: "http://[::1]:7077", Healthy: true}, {Url: "http://[::1]:7078", Healthy: true}}, rpcClient.Nodes())
+	rate, burst := hc.RateLimiter.Limits()
+	assert.Equal(t, float64(5), rate)
+	assert.Equal(t, float64(20), burst)
+	assert.Equal(t, "1000", nanoWallet.ReceiveMinimum(nil).String())
+
+	// The same as config_reload, which also returns what needs a restart
+	restartRequired, err := reloader.reload()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"server.port"}, restartRequired)
+
+	// An invalid config isn't applied
+	parseErr = errors.New("invalid node_rpc_url")
+	next.Server.BlockConfirmInterval = 60
+	_, err = reloader.reload()
+	assert.NotNil(t, err)
+	assert.Equal(t, 30*time.Second, hc.BlockConfirmInterval())
+}
@@ -2,32 +2,41 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/appditto/pippin_nano_wallet/apps/server/controller"
 	"github.com/appditto/pippin_nano_wallet/apps/server/middleware"
 	"github.com/appditto/pippin_nano_wallet/apps/server/net"
+	"github.com/appditto/pippin_nano_wallet/libs/cache"
 	"github.com/appditto/pippin_nano_wallet/libs/config"
 	"github.com/appditto/pippin_nano_wallet/libs/database"
 	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/price"
 	rpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/metrics"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet"
 	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
 )
 
-func StartPippinServer() {
+func StartPippinServer(build controller.BuildInfo) {
 	// Read yaml configuration
 	conf, err := config.ParsePippinConfig()
 	if err != nil {
 		log.Fatalf("Failed to parse config: %v", err)
 		os.Exit(1)
 	}
+	log.SetLevel(conf.Server.LogLevel)
+	log.SetFormat(conf.Server.LogFormat)
 
 	// Setup database conn
 	ctx := context.Background()
@@ -46,16 +55,44 @@ func StartPippinServer() {
 
 	// Run migrations
 	log.Info("🦋 Running migrations...")
-	if err := entClient.Schema.Create(ctx); err != nil {
+	if err := database.Migrate(ctx, dbconn, entClient); err != nil {
 		log.Fatalf("Failed to run migrations: %v", err)
 		os.Exit(1)
 	}
 
 	// Setup RPC handlers
-	rpcClient := rpc.NewRPCClient(conf.Server.NodeRpcUrl)
+	rpcClient := rpc.NewMultiNodeRPCClient(append([]string{conf.Server.NodeRpcUrl}, conf.Server.NodeRpcFallbackUrls...), conf.Server.NodeRpcRoundRobin)
+	if len(conf.Server.NodeRpcFallbackUrls) > 0 {
+		go rpcClient.StartHealthChecker(ctx, time.Duration(conf.Server.NodeHealthCheckInterval)*time.Second)
+	}
+
+	// Setup cache for node responses
+	cacheClient, err := cache.NewCacheClient(conf.Server.CacheBackend)
+	if err != nil {
+		log.Fatalf("Failed to create cache client: %v", err)
+		os.Exit(1)
+	}
+	// Responses the wallet asks the node for again and again, like account_info, are cached too if node_cache_ttls has them
+	if len(conf.Server.NodeCacheTTLs) > 0 {
+		ttls := map[string]time.Duration{}
+		for action, ttl := range conf.Server.NodeCacheTTLs {
+			ttls[action] = time.Duration(ttl) * time.Second
+		}
+		rpcClient.SetResponseCache(cacheClient, ttls)
+	}
 
 	// Setup pow client
-	pow := pow.NewPippinPow(conf.Wallet.WorkPeers, utils.GetEnv("BPOW_KEY", ""), utils.GetEnv("BPOW_URL", ""), conf.Wallet.WorkTimeout)
+	pow := pow.NewPippinPow(conf.Wallet.WorkPeers, utils.GetEnv("BPOW_KEY", conf.Wallet.BpowKey), utils.GetEnv("BPOW_URL", conf.Wallet.BpowUrl), pow.NewTimeoutPolicy(conf.Wallet.WorkTimeout, conf.Wallet.LargeSendThreshold, conf.Wallet.LargeSendWorkTimeout))
+	pow.Concurrent = conf.Wallet.WorkPeersConcurrent == nil || *conf.Wallet.WorkPeersConcurrent
+	// Validated with the config
+	pow.SetWorkSources(conf.Wallet.WorkSources)
+	// Work is reused for the same root by every instance sharing the cache_backend, work_cache_ttl 0 turns it off
+	if conf.Wallet.WorkCacheTTL > 0 {
+		pow.SetWorkCache(cache.NewWorkCache(cacheClient, time.Duration(conf.Wallet.WorkCacheTTL)*time.Second))
+	}
+	pow.SetNodeRpcUrl(conf.Server.NodeRpcUrl)
+	go pow.StartDifficultyUpdater(ctx, time.Duration(conf.Wallet.DifficultyUpdateInterval)*time.Second)
+	go pow.StartDifficultySampler(ctx)
 
 	// Setup nano wallet instance with DB, options, etc.
 	nanoWallet := wallet.NanoWallet{
@@ -65,10 +102,15 @@ func StartPippinServer() {
 		RpcClient:  rpcClient,
 		WorkClient: pow,
 		Config:     conf,
+		// Balance alert callbacks are signed with this
+		WebhookSecret: utils.GetEnv("PIPPIN_WEBHOOK_SECRET", ""),
 	}
 
 	// Setup nano WS client if configured
 	callbackChan := make(chan *net.WSCallbackMsg, 100)
+	metrics.NewGaugeFunc("pippin_auto_receive_queue_depth", "Confirmations from the node websocket waiting to be checked for auto-receive", func() float64 {
+		return float64(len(callbackChan))
+	})
 	if conf.Server.NodeWsUrl != "" {
 		go net.StartNanoWSClient(conf.Server.NodeWsUrl, &callbackChan)
 	}
@@ -76,9 +118,12 @@ func StartPippinServer() {
 	// Read channel to automatically receive blocks
 	go func() {
 		for msg := range callbackChan {
-			func() {
+			// Every instance streams the events to its own /ws clients
+			nanoWallet.PublishConfirmation(msg.Account, msg.Hash, msg.Block.Subtype, msg.Amount, msg.Block.LinkAsAccount)
+			// A shutdown waits for the receive, after it starts the auto receiver picks up what's missed
+			nanoWallet.Background(func() {
 				// Lock each callback so we don't handle them on multiple instances
-				lock, err := database.GetRedisDB().Locker.Obtain(ctx, fmt.Sprintf("blocklock:%s", msg.Hash), time.Second*30, nil)
+				lock, err := database.GetRedisDB().Obtain(ctx, fmt.Sprintf("blocklock:%s", msg.Hash), time.Second*30, nil)
 				if err != nil {
 					return
 				}
@@ -91,41 +136,170 @@ func StartPippinServer() {
 				if !ok {
 					return
 				}
-				// Compare to receive minimum
-				receiveMinimum, ok := big.NewInt(0).SetString(conf.Wallet.ReceiveMinimum, 10)
-				if !ok {
-					return
-				}
-				if amount.Cmp(receiveMinimum) < 0 {
-					return
-				}
 
 				// See if destination is in our wallet
 				dbAccount, err := nanoWallet.GetAccountByAddress(msg.Block.LinkAsAccount)
-				if err != nil {
+				if err != nil || dbAccount.WatchOnly {
 					return
 				}
 				wallet, err := dbAccount.QueryWallet().Only(ctx)
 				if err != nil {
 					return
 				}
+				// Compare to the wallet's receive minimum, unless it has auto receive off
+				if !nanoWallet.ShouldAutoReceive(wallet, amount) {
+					return
+				}
 
 				// Actually receive the block
 				nanoWallet.CreateAndPublishReceiveBlock(wallet, dbAccount.Address, msg.Hash, nil, nil)
-			}()
+			})
 
 		}
 	}()
 
+	// Deliver the receive callbacks the last shutdown didn't get to
+	if conf.Wallet.CallbackUrl != "" {
+		if resumed, err := nanoWallet.ResumeReceiveCallbacks(); err != nil {
+			log.Warnf("Unable to resume receive callbacks %s", err)
+		} else if resumed > 0 {
+			log.Infof("Resumed %d receive callbacks", resumed)
+		}
+	}
+
+	// Execute scheduled sends in the background
+	go nanoWallet.StartSendScheduler(nil, time.Second)
+
+	// Check balance alerts in the background, 0 disables them
+	if conf.Wallet.AlertPollInterval > 0 {
+		go nanoWallet.StartAlertPoller(nil, time.Duration(conf.Wallet.AlertPollInterval)*time.Second)
+	}
+
+	// Record balance snapshots in the background, 0 disables them
+	if conf.Wallet.BalanceSnapshotInterval > 0 {
+		go nanoWallet.StartBalanceSnapshotter(nil, time.Duration(conf.Wallet.BalanceSnapshotInterval)*time.Second)
+	}
+
+	// Poll for pending blocks to auto receive in the background, 0 only receives what the websocket sees
+	if conf.Wallet.AutoReceiveInterval > 0 {
+		go nanoWallet.StartAutoReceiver(time.Duration(conf.Wallet.AutoReceiveInterval) * time.Second)
+	}
+
+	// Keep work ready for every account in the background, 0 disables it
+	if conf.Wallet.WorkPrecacheInterval > 0 {
+		go nanoWallet.StartWorkPrecacher(time.Duration(conf.Wallet.WorkPrecacheInterval) * time.Second)
+	}
+
 	// Create app
 	app := chi.NewRouter()
 
 	// Setup controller
-	hc := controller.HttpController{Wallet: &nanoWallet, RpcClient: rpcClient, PowClient: pow}
+	hc := controller.HttpController{Wallet: &nanoWallet, RpcClient: rpcClient, PowClient: pow, Cache: cacheClient, AdminToken: utils.GetEnv("PIPPIN_ADMIN_TOKEN", ""), Build: build}
+	if hc.AdminToken == "" {
+		log.Info("PIPPIN_ADMIN_TOKEN is not set, admin actions are disabled")
+	}
+	if conf.Server.AuditLogPath != "" {
+		auditLogger, err := controller.NewFileAuditLogger(conf.Server.AuditLogPath)
+		if err != nil {
+			log.Fatalf("Failed to open audit log: %v", err)
+			os.Exit(1)
+		}
+		defer auditLogger.Close()
+		hc.AuditLogger = auditLogger
+	}
+	// Even without a rate_limit, so a reload can turn it on
+	hc.RateLimiter = controller.NewRateLimiter(conf.Server.RateLimit, conf.Server.RateLimitBurst)
+	if conf.Price.Enabled {
+		hc.PriceClient = price.NewPriceClient(conf.Price.Url, conf.Price.Currencies, conf.Wallet.Banano, time.Duration(conf.Price.CacheTTL)*time.Second)
+	}
+
+	// Apply config changes on SIGHUP or config_reload, without a restart
+	reloader := configReloader{started: conf, parse: config.ParsePippinConfig, hc: &hc, pow: pow, rpc: rpcClient}
+	hc.ReloadConfig = reloader.reload
+	reloadSignals := make(chan os.Signal, 1)
+	signal.Notify(reloadSignals, syscall.SIGHUP)
+	go reloader.watch(reloadSignals)
 
 	// HTTP Routes
+	// Already validated when the config was parsed
+	trustedProxies, _ := conf.Server.TrustedProxyNets()
+	app.Use(middleware.RequestID)
+	app.Use(middleware.RealIP(trustedProxies))
 	app.Use(middleware.Logger)
+	if len(conf.Server.CorsAllowedOrigins) > 0 {
+		app.Use(middleware.CORS(conf.Server.CorsAllowedOrigins, conf.Server.CorsAllowedMethods))
+	}
+	var stopTracing func(context.Context) error
+	if tracingEnabled(&conf.Server) {
+		stopTracing, err = startTracing(ctx, &conf.Server, build)
+		if err != nil {
+			log.Fatalf("Failed to start exporting traces: %v", err)
+			os.Exit(1)
+		}
+		app.Use(middleware.Trace)
+	}
 	app.Post("/", hc.Gateway)
+	app.Post("/admin", hc.AdminHandler)
+	app.Get("/openapi.json", hc.HandleOpenAPISpec)
+	app.Get("/health", hc.HandleHealth)
+	app.Get("/ready", hc.HandleReady)
+	app.Get("/ws", hc.HandleWebsocket)
+	app.Get("/metrics", hc.HandleMetrics)
+	registerPprof(app, &conf.Server, &hc)
 
-	http.ListenAndServe(fmt.Sprintf("%s:%d", conf.Server.Host, conf.Server.Port), app)
+	// Both servers use the same certificate, a SIGHUP loads it again
+	var certs *certReloader
+	if conf.Server.TLSCertFile != "" {
+		var checkInterval time.Duration
+		if conf.Server.TLSReload {
+			checkInterval = tlsReloadCheckInterval
+		}
+		certs, err = newCertReloader(conf.Server.TLSCertFile, conf.Server.TLSKeyFile, checkInterval)
+		if err != nil {
+			log.Fatalf("Failed to load the TLS certificate: %v", err)
+			os.Exit(1)
+		}
+		reloader.certs = certs
+	}
+
+	var grpcServer *grpc.Server
+	if conf.Server.GrpcPort > 0 {
+		grpcServer, err = newGrpcServer(&conf.Server, &hc, certs)
+		if err != nil {
+			log.Fatalf("Failed to create the gRPC server: %v", err)
+			os.Exit(1)
+		}
+		go func() {
+			log.Infof("Serving gRPC on %s:%d", conf.Server.Host, conf.Server.GrpcPort)
+			if err := serveGrpc(&conf.Server, grpcServer); err != nil {
+				log.Fatalf("gRPC server stopped: %v", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
+	server := newHTTPServer(&conf.Server, app)
+	go func() {
+		if err := listenAndServe(&conf.Server, server, certs); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Server stopped: %v", err)
+			os.Exit(1)
+		}
+	}()
+
+	// Let the requests and background work that are running finish before exiting
+	stopSignals := make(chan os.Signal, 1)
+	signal.Notify(stopSignals, syscall.SIGINT, syscall.SIGTERM)
+	<-stopSignals
+	log.Infof("Shutting down, waiting up to %d seconds for requests and background work", conf.Server.ShutdownTimeout)
+	if err := shutdown(time.Duration(conf.Server.ShutdownTimeout)*time.Second, server, grpcServer, &nanoWallet); err != nil {
+		log.Errorf("Shutdown didn't finish in time: %v", err)
+	}
+	if stopTracing != nil {
+		// Send the spans that are left
+		tracingCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := stopTracing(tracingCtx); err != nil {
+			log.Errorf("Unable to export the last traces: %v", err)
+		}
+	}
 }
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"google.golang.org/grpc"
+)
+
+// Stop accepting requests and wait for the ones in flight, then for the wallet's background work, for at most timeout
+// grpcServer is nil when gRPC isn't served
+func shutdown(timeout time.Duration, server *http.Server, grpcServer *grpc.Server, nanoWallet *wallet.NanoWallet) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	grpcStopped := make(chan struct{})
+	go func() {
+		if grpcServer != nil {
+			grpcServer.GracefulStop()
+		}
+		close(grpcStopped)
+	}()
+	err := server.Shutdown(ctx)
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		// Cuts off the calls that are still running
+		if grpcServer != nil {
+			grpcServer.Stop()
+		}
+	}
+
+	// Requests can start background work, so it stops after them
+	if walletErr := nanoWallet.Shutdown(ctx); err == nil {
+		err = walletErr
+	}
+	return err
+}
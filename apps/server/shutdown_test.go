@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/wallet"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShutdown(t *testing.T) {
+	started := make(chan bool)
+	release := make(chan bool)
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- true
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	go server.Serve(lis)
+
+	responses := make(chan int)
+	go func() {
+		resp, err := http.Post("http://"+lis.Addr().String(), "application/json", nil)
+		if err != nil {
+			responses <- 0
+			return
+		}
+		resp.Body.Close()
+		responses <- resp.StatusCode
+	}()
+	<-started
+
+	// The request in flight finishes before the wallet's background work stops
+	nanoWallet := &wallet.NanoWallet{}
+	shutdownErr := make(chan error)
+	go func() {
+		shutdownErr <- shutdown(5*time.Second, server, nil, nanoWallet)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	assert.False(t, nanoWallet.ShuttingDown())
+	release <- true
+	assert.Equal(t, http.StatusOK, <-responses)
+	assert.Nil(t, <-shutdownErr)
+	assert.True(t, nanoWallet.ShuttingDown())
+
+	// New requests aren't accepted
+	_, err = http.Post("http://"+lis.Addr().String(), "application/json", nil)
+	assert.NotNil(t, err)
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	release := make(chan bool)
+	defer close(release)
+	nanoWallet := &wallet.NanoWallet{}
+	started := make(chan bool)
+	go nanoWallet.Background(func() {
+		started <- true
+		<-release
+	})
+	<-started
+
+	server := &http.Server{}
+	assert.ErrorIs(t, shutdown(50*time.Millisecond, server, nil, nanoWallet), context.DeadlineExceeded)
+}
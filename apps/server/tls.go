@@ -0,0 +1,112 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+// With tls_reload, how often handshakes check whether the certificate files changed
+const tlsReloadCheckInterval = 10 * time.Second
+
+var ErrNoClientCAs = errors.New("no certificates in tls_client_ca_file")
+
+// The certificate of tls_cert_file and tls_key_file, for the gateway and gRPC
+// A rotated certificate is picked up by reload, or by handshakes with a check interval
+type certReloader struct {
+	certFile string
+	keyFile  string
+	// 0 only reloads when reload is called
+	checkInterval time.Duration
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	modTime   time.Time
+	lastCheck time.Time
+}
+
+func newCertReloader(certFile string, keyFile string, checkInterval time.Duration) (*certReloader, error) {
+	cr := &certReloader{certFile: certFile, keyFile: keyFile, checkInterval: checkInterval}
+	if err := cr.reload(); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+// The latest modification of the two files
+func (cr *certReloader) filesModTime() (time.Time, error) {
+	var latest time.Time
+	for _, file := range []string{cr.certFile, cr.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// Load the files again, if they're not a valid pair the old certificate is kept
+func (cr *certReloader) reload() error {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	return cr.load()
+}
+
+func (cr *certReloader) load() error {
+	modTime, err := cr.filesModTime()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(cr.certFile, cr.keyFile)
+	if err != nil {
+		return err
+	}
+	cr.cert = &cert
+	cr.modTime = modTime
+	return nil
+}
+
+// For tls.Config, a rotated certificate is loaded at most once per check interval
+func (cr *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	if cr.checkInterval > 0 && time.Since(cr.lastCheck) >= cr.checkInterval {
+		cr.lastCheck = time.Now()
+		if modTime, err := cr.filesModTime(); err == nil && modTime.After(cr.modTime) {
+			if err := cr.load(); err != nil {
+				log.Errorf("Not reloading the TLS certificate %s", err)
+			} else {
+				log.Info("TLS certificate reloaded")
+			}
+		}
+	}
+	return cr.cert, nil
+}
+
+// Serve the certificate of certs on tlsConf, and with tls_client_ca_file only to clients with a certificate of its CAs
+func configureTLS(tlsConf *tls.Config, conf *models.ServerConfig, certs *certReloader) error {
+	tlsConf.GetCertificate = certs.GetCertificate
+	if conf.TLSClientCAFile == "" {
+		return nil
+	}
+	pem, err := os.ReadFile(conf.TLSClientCAFile)
+	if err != nil {
+		return err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return ErrNoClientCAs
+	}
+	tlsConf.ClientCAs = pool
+	tlsConf.ClientAuth = tls.RequireAndVerifyClientCert
+	return nil
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// A certificate for 127.0.0.1 named name, signed by parent or self-signed if it's nil
+func newTestCert(t *testing.T, name string, parent *tls.Certificate, isCA bool) tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	signer, signerKey := template, interface{}(key)
+	if parent != nil {
+		signer, signerKey = parent.Leaf, parent.PrivateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	assert.Nil(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+// Write cert and its key as PEM, dated modTime
+func writeTestCert(t *testing.T, cert tls.Certificate, certFile string, keyFile string, modTime time.Time) {
+	t.Helper()
+	keyDer, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	assert.Nil(t, err)
+	assert.Nil(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0600))
+	assert.Nil(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	assert.Nil(t, os.Chtimes(certFile, modTime, modTime))
+	assert.Nil(t, os.Chtimes(keyFile, modTime, modTime))
+}
+
+func servedCommonName(t *testing.T, cr *certReloader) string {
+	t.Helper()
+	cert, err := cr.GetCertificate(nil)
+	assert.Nil(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	assert.Nil(t, err)
+	return leaf.Subject.CommonName
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	now := time.Now()
+
+	_, err := newCertReloader(certFile, keyFile, 0)
+	assert.NotNil(t, err)
+
+	writeTestCert(t, newTestCert(t, "first", nil, false), certFile, keyFile, now)
+	cr, err := newCertReloader(certFile, keyFile, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "first", servedCommonName(t, cr))
+
+	// Without a check interval only reload picks it up
+	writeTestCert(t, newTestCert(t, "second", nil, false), certFile, keyFile, now.Add(time.Minute))
+	assert.Equal(t, "first", servedCommonName(t, cr))
+	assert.Nil(t, cr.reload())
+	assert.Equal(t, "second", servedCommonName(t, cr))
+
+	// A broken pair keeps the old certificate
+	assert.Nil(t, os.WriteFile(keyFile, []byte("notakey"), 0600))
+	assert.NotNil(t, cr.reload())
+	assert.Equal(t, "second", servedCommonName(t, cr))
+
+	// Handshakes check the files once the interval has passed
+	cr.checkInterval = time.Hour
+	writeTestCert(t, newTestCert(t, "third", nil, false), certFile, keyFile, now.Add(2*time.Minute))
+	assert.Equal(t, "third", servedCommonName(t, cr))
+	writeTestCert(t, newTestCert(t, "fourth", nil, false), certFile, keyFile, now.Add(3*time.Minute))
+	assert.Equal(t, "third", servedCommonName(t, cr))
+	cr.lastCheck = time.Time{}
+	assert.Equal(t, "fourth", servedCommonName(t, cr))
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	caFile := filepath.Join(dir, "ca.pem")
+	ca := newTestCert(t, "clients", nil, true)
+	server := newTestCert(t, "server", nil, false)
+	writeTestCert(t, server, certFile, keyFile, time.Now())
+	writeTestCert(t, ca, caFile, filepath.Join(dir, "ca-key.pem"), time.Now())
+	certs, err := newCertReloader(certFile, keyFile, 0)
+	assert.Nil(t, err)
+
+	conf := models.ServerConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSClientCAFile: filepath.Join(dir, "missing.pem")}
+	assert.NotNil(t, configureTLS(&tls.Config{}, &conf, certs))
+	conf.TLSClientCAFile = keyFile
+	assert.ErrorIs(t, configureTLS(&tls.Config{}, &conf, certs), ErrNoClientCAs)
+
+	conf.TLSClientCAFile = caFile
+	tlsConf := &tls.Config{}
+	assert.Nil(t, configureTLS(tlsConf, &conf, certs))
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", tlsConf)
+	assert.Nil(t, err)
+	go http.Serve(lis, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(func() { lis.Close() })
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Leaf)
+	get := func(clientCerts ...tls.Certificate) error {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: clientCerts}}}
+		resp, err := client.Get("https://" + lis.Addr().String())
+		if err == nil {
+			resp.Body.Close()
+		}
+		return err
+	}
+	// Only clients with a certificate of the CA
+	assert.NotNil(t, get())
+	assert.NotNil(t, get(newTestCert(t, "stranger", nil, false)))
+	assert.Nil(t, get(newTestCert(t, "client", &ca, false)))
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/controller"
+)
+
+// Writes the OpenAPI spec for the gateway actions, served at GET /openapi.json
+// Run through go generate in the controller package
+func main() {
+	out := flag.String("o", "openapi.json", "Output file")
+	flag.Parse()
+
+	spec, err := controller.BuildOpenAPISpec()
+	if err != nil {
+		fmt.Printf("Failed to build spec: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*out, spec, 0644); err != nil {
+		fmt.Printf("Failed to write %s: %v\n", *out, err)
+		os.Exit(1)
+	}
+}
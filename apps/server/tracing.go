@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/apps/server/controller"
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Traces of the gateway requests, with the node requests and work generation they made, go to an OTLP/HTTP collector
+// otlp_endpoint turns it on, or the usual OTEL_EXPORTER_OTLP_ENDPOINT, the other OTEL_ variables like OTEL_SERVICE_NAME apply too
+
+// Whether traces are exported
+func tracingEnabled(conf *models.ServerConfig) bool {
+	return conf.OtlpEndpoint != "" || os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+// The collector's traces URL, /v1/traces on it unless otlp_endpoint has a path, like OTEL_EXPORTER_OTLP_ENDPOINT
+func otlpTracesURL(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || strings.Trim(u.Path, "/") != "" {
+		return endpoint
+	}
+	u.Path = "/v1/traces"
+	return u.String()
+}
+
+// Export the spans of every request, returns what sends the ones that are left on shutdown
+func startTracing(ctx context.Context, conf *models.ServerConfig, build controller.BuildInfo) (func(context.Context) error, error) {
+	opts := []otlptracehttp.Option{}
+	if conf.OtlpEndpoint != "" {
+		opts = append(opts, otlptracehttp.WithEndpointURL(otlpTracesURL(conf.OtlpEndpoint)))
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", "pippin"), attribute.String("service.version", build.Version)),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return provider.Shutdown, nil
+}
@@ -0,0 +1,23 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOtlpTracesURL(t *testing.T) {
+	assert.Equal(t, "http://localhost:4318/v1/traces", otlpTracesURL("http://localhost:4318"))
+	assert.Equal(t, "http://localhost:4318/v1/traces", otlpTracesURL("http://localhost:4318/"))
+	assert.Equal(t, "https://collector.example.com/otlp/v1/traces", otlpTracesURL("https://collector.example.com/otlp/v1/traces"))
+}
+
+func TestTracingEnabled(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	assert.False(t, tracingEnabled(&models.ServerConfig{}))
+	assert.True(t, tracingEnabled(&models.ServerConfig{OtlpEndpoint: "http://localhost:4318"}))
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4318")
+	assert.True(t, tracingEnabled(&models.ServerConfig{}))
+}
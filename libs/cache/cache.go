@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+// Supported values of server.cache_backend
+const (
+	BackendRedis     = "redis"
+	BackendMemcached = "memcached"
+	BackendMemory    = "memory"
+)
+
+var ErrCacheMiss = errors.New("cache miss")
+var ErrUnknownBackend = errors.New("unknown cache backend")
+var ErrDeletePrefixNotSupported = errors.New("cache backend can't delete keys by prefix")
+
+// Values that can be recomputed, like responses from the node
+// Locks and anything that has to survive aren't cached, they stay in redis
+type CacheClient interface {
+	// ErrCacheMiss if the key isn't set or expired
+	Get(key string) ([]byte, error)
+	// A ttl of 0 never expires
+	Set(key string, value []byte, ttl time.Duration) error
+	// Deleting a key that isn't set is not an error
+	Delete(key string) error
+}
+
+// Backends that can list their keys, memcached can't
+type PrefixDeleter interface {
+	// Delete every key starting with prefix, returns how many were deleted
+	DeletePrefix(prefix string) (int, error)
+}
+
+// Delete every key of c starting with prefix, if its backend can
+func DeletePrefix(c CacheClient, prefix string) (int, error) {
+	deleter, ok := c.(PrefixDeleter)
+	if !ok {
+		return 0, ErrDeletePrefixNotSupported
+	}
+	return deleter.DeletePrefix(prefix)
+}
+
+// Create the client for a cache backend
+// With MOCK_REDIS=true it's always the in-memory cache, so tests don't need a server
+func NewCacheClient(backend string) (CacheClient, error) {
+	if utils.GetEnv("MOCK_REDIS", "false") == "true" {
+		log.Infof("Using in-memory cache because MOCK_REDIS=true is set in environment")
+		return NewMemoryCache(), nil
+	}
+	switch backend {
+	case BackendRedis:
+		db := database.GetRedisDB()
+		return NewRedisCache(db.Client, db.Namespace), nil
+	case BackendMemcached:
+		addr := fmt.Sprintf("%s:%s", utils.GetEnv("MEMCACHED_HOST", "localhost"), utils.GetEnv("MEMCACHED_PORT", "11211"))
+		// Same namespace as the redis keys
+		return NewMemcachedCache(addr, utils.GetEnv("PIPPIN_REDIS_NAMESPACE", "pippin")), nil
+	case BackendMemory:
+		return NewMemoryCache(), nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownBackend, backend)
+}
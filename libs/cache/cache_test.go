@@ -0,0 +1,29 @@
+package cache
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCacheClient(t *testing.T) {
+	c, err := NewCacheClient(BackendMemory)
+	assert.Nil(t, err)
+	assert.IsType(t, &MemoryCache{}, c)
+
+	c, err = NewCacheClient(BackendMemcached)
+	assert.Nil(t, err)
+	assert.IsType(t, &MemcachedCache{}, c)
+
+	_, err = NewCacheClient("mongodb")
+	assert.ErrorIs(t, err, ErrUnknownBackend)
+}
+
+func TestNewCacheClientMock(t *testing.T) {
+	os.Setenv("MOCK_REDIS", "true")
+	defer os.Unsetenv("MOCK_REDIS")
+	c, err := NewCacheClient(BackendRedis)
+	assert.Nil(t, err)
+	assert.IsType(t, &MemoryCache{}, c)
+}
@@ -0,0 +1,13 @@
+module github.com/appditto/pippin_nano_wallet/libs/cache
+
+go 1.22.1
+
+require (
+	github.com/alicebob/miniredis/v2 v2.23.0
+	github.com/appditto/pippin_nano_wallet/libs/database v0.0.0-20220910042023-acfa16d6fdd9
+	github.com/appditto/pippin_nano_wallet/libs/log v0.0.0-20240625194645-fc95391f0316
+	github.com/appditto/pippin_nano_wallet/libs/utils v0.0.0-20220910042023-acfa16d6fdd9
+	github.com/bradfitz/gomemcache v0.0.0-20260422231931-4d751bb6e37c
+	github.com/go-redis/redis/v9 v9.0.0-beta.2
+	github.com/stretchr/testify v1.9.0
+)
@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/bradfitz/gomemcache/memcache"
+)
+
+// Memcached treats expirations over 30 days as a unix timestamp
+const memcachedMaxRelativeExpiration = 30 * 24 * time.Hour
+
+// Cache in a memcached server
+type MemcachedCache struct {
+	client    *memcache.Client
+	namespace string
+}
+
+// addr is host:port, keys are prefixed with namespace
+func NewMemcachedCache(addr string, namespace string) *MemcachedCache {
+	return &MemcachedCache{
+		client:    memcache.New(addr),
+		namespace: namespace,
+	}
+}
+
+func (c *MemcachedCache) key(key string) string {
+	return fmt.Sprintf("%s:%s", c.namespace, key)
+}
+
+// Memcached expirations are whole seconds, round up so a short ttl doesn't mean never
+func memcachedExpiration(ttl time.Duration) int32 {
+	if ttl <= 0 {
+		return 0
+	}
+	if ttl > memcachedMaxRelativeExpiration {
+		return int32(time.Now().Add(ttl).Unix())
+	}
+	return int32(math.Ceil(ttl.Seconds()))
+}
+
+func (c *MemcachedCache) Get(key string) ([]byte, error) {
+	item, err := c.client.Get(c.key(key))
+	if errors.Is(err, memcache.ErrCacheMiss) {
+		return nil, ErrCacheMiss
+	} else if err != nil {
+		return nil, err
+	}
+	return item.Value, nil
+}
+
+func (c *MemcachedCache) Set(key string, value []byte, ttl time.Duration) error {
+	return c.client.Set(&memcache.Item{
+		Key:        c.key(key),
+		Value:      value,
+		Expiration: memcachedExpiration(ttl),
+	})
+}
+
+func (c *MemcachedCache) Delete(key string) error {
+	err := c.client.Delete(c.key(key))
+	if errors.Is(err, memcache.ErrCacheMiss) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,22 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemcachedExpiration(t *testing.T) {
+	assert.Equal(t, int32(0), memcachedExpiration(0))
+	assert.Equal(t, int32(1), memcachedExpiration(100*time.Millisecond))
+	assert.Equal(t, int32(30), memcachedExpiration(30*time.Second))
+	// Past 30 days it's a unix timestamp
+	expiration := memcachedExpiration(31 * 24 * time.Hour)
+	assert.InDelta(t, time.Now().Add(31*24*time.Hour).Unix(), int64(expiration), 2)
+}
+
+func TestMemcachedKey(t *testing.T) {
+	c := NewMemcachedCache("localhost:11211", "pippin")
+	assert.Equal(t, "pippin:chain:abc", c.key("chain:abc"))
+}
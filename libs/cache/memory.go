@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+type memoryEntry struct {
+	value []byte
+	// Zero never expires
+	expires time.Time
+}
+
+// Cache in the process, nothing is shared between instances
+type MemoryCache struct {
+	entries map[string]memoryEntry
+	mutex   sync.Mutex
+}
+
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{
+		entries: make(map[string]memoryEntry),
+	}
+}
+
+func (c *MemoryCache) Get(key string) ([]byte, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, ErrCacheMiss
+	}
+	if !entry.expires.IsZero() && !time.Now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, ErrCacheMiss
+	}
+	return entry.value, nil
+}
+
+func (c *MemoryCache) Set(key string, value []byte, ttl time.Duration) error {
+	entry := memoryEntry{
+		// Copy it, the caller may reuse the slice
+		value: append([]byte(nil), value...),
+	}
+	if ttl > 0 {
+		entry.expires = time.Now().Add(ttl)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries[key] = entry
+	return nil
+}
+
+func (c *MemoryCache) Delete(key string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.entries, key)
+	return nil
+}
+
+// Expired keys are deleted without being counted
+func (c *MemoryCache) DeletePrefix(prefix string) (int, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	deleted := 0
+	now := time.Now()
+	for key, entry := range c.entries {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if entry.expires.IsZero() || now.Before(entry.expires) {
+			deleted++
+		}
+		delete(c.entries, key)
+	}
+	return deleted, nil
+}
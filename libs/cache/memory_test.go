@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryCache(t *testing.T) {
+	c := NewMemoryCache()
+
+	_, err := c.Get("missing")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+
+	value := []byte("v")
+	assert.Nil(t, c.Set("key", value, 0))
+	// Changing the slice doesn't change what's cached
+	value[0] = 'x'
+	val, err := c.Get("key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v"), val)
+
+	assert.Nil(t, c.Delete("key"))
+	_, err = c.Get("key")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+	// Already gone
+	assert.Nil(t, c.Delete("key"))
+}
+
+func TestMemoryCacheExpires(t *testing.T) {
+	c := NewMemoryCache()
+
+	assert.Nil(t, c.Set("key", []byte("v"), 50*time.Millisecond))
+	_, err := c.Get("key")
+	assert.Nil(t, err)
+
+	time.Sleep(60 * time.Millisecond)
+	_, err = c.Get("key")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+}
+
+func TestMemoryCacheDeletePrefix(t *testing.T) {
+	c := NewMemoryCache()
+	c.Set("a:1", []byte("v"), 0)
+	c.Set("a:2", []byte("v"), time.Millisecond)
+	c.Set("b:1", []byte("v"), 0)
+	time.Sleep(5 * time.Millisecond)
+
+	// The expired key is gone but isn't counted
+	deleted, err := c.DeletePrefix("a:")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, deleted)
+	_, err = c.Get("a:1")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+	_, err = c.Get("b:1")
+	assert.Nil(t, err)
+}
@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v9"
+)
+
+// Cache in the redis server pippin already uses for locks
+type RedisCache struct {
+	client    *redis.Client
+	namespace string
+}
+
+// Keys are prefixed with namespace, same as the rest of pippin's redis keys
+func NewRedisCache(client *redis.Client, namespace string) *RedisCache {
+	return &RedisCache{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+func (c *RedisCache) key(key string) string {
+	return fmt.Sprintf("%s:%s", c.namespace, key)
+}
+
+func (c *RedisCache) Get(key string) ([]byte, error) {
+	val, err := c.client.Get(context.Background(), c.key(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrCacheMiss
+	}
+	return val, err
+}
+
+func (c *RedisCache) Set(key string, value []byte, ttl time.Duration) error {
+	return c.client.Set(context.Background(), c.key(key), value, ttl).Err()
+}
+
+func (c *RedisCache) Delete(key string) error {
+	return c.client.Del(context.Background(), c.key(key)).Err()
+}
+
+func (c *RedisCache) DeletePrefix(prefix string) (int, error) {
+	ctx := context.Background()
+	deleted := 0
+	iter := c.client.Scan(ctx, 0, c.key(prefix)+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		n, err := c.client.Del(ctx, iter.Val()).Result()
+		if err != nil {
+			return deleted, err
+		}
+		deleted += int(n)
+	}
+	return deleted, iter.Err()
+}
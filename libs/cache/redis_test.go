@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedisCache(t *testing.T) {
+	mr := miniredis.RunT(t)
+	c := NewRedisCache(redis.NewClient(&redis.Options{Addr: mr.Addr()}), "pippin")
+
+	_, err := c.Get("missing")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+
+	assert.Nil(t, c.Set("key", []byte("v"), time.Minute))
+	val, err := c.Get("key")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v"), val)
+	// Stored under the namespace
+	stored, err := mr.Get("pippin:key")
+	assert.Nil(t, err)
+	assert.Equal(t, "v", stored)
+	assert.Equal(t, time.Minute, mr.TTL("pippin:key"))
+
+	assert.Nil(t, c.Delete("key"))
+	_, err = c.Get("key")
+	assert.ErrorIs(t, err, ErrCacheMiss)
+}
+
+func TestRedisCacheDeletePrefix(t *testing.T) {
+	mr := miniredis.RunT(t)
+	c := NewRedisCache(redis.NewClient(&redis.Options{Addr: mr.Addr()}), "pippin")
+	mr.Set("pippin:a:1", "v")
+	mr.Set("pippin:a:2", "v")
+	mr.Set("pippin:b:1", "v")
+	mr.Set("other:a:1", "v")
+
+	// Only in the namespace
+	deleted, err := DeletePrefix(c, "a:")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, deleted)
+	assert.False(t, mr.Exists("pippin:a:1"))
+	assert.True(t, mr.Exists("pippin:b:1"))
+	assert.True(t, mr.Exists("other:a:1"))
+}
@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Prefix of the cached work's keys, followed by the root
+const workCachePrefix = "work_cache:"
+
+// Work generated for a root, kept in the cache_backend so with redis or memcached every instance reuses it
+// Implements pow.WorkCache
+type WorkCache struct {
+	client CacheClient
+	ttl    time.Duration
+}
+
+// Work is kept in client for ttl
+func NewWorkCache(client CacheClient, ttl time.Duration) *WorkCache {
+	return &WorkCache{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// Stored as work:difficulty, both in hex
+func (c *WorkCache) Get(root string) (string, uint64, bool) {
+	val, err := c.client.Get(workCachePrefix + root)
+	if err != nil {
+		return "", 0, false
+	}
+	work, difficultyHex, ok := strings.Cut(string(val), ":")
+	if !ok {
+		return "", 0, false
+	}
+	difficulty, err := strconv.ParseUint(difficultyHex, 16, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return work, difficulty, true
+}
+
+func (c *WorkCache) Set(root string, work string, difficulty uint64) error {
+	return c.client.Set(workCachePrefix+root, []byte(fmt.Sprintf("%s:%s", work, strconv.FormatUint(difficulty, 16))), c.ttl)
+}
+
+// Delete every root's work, returns how many were deleted
+// ErrDeletePrefixNotSupported with memcached, its work expires after the ttl
+func (c *WorkCache) Clear() (int, error) {
+	return DeletePrefix(c.client, workCachePrefix)
+}
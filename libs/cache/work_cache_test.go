@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkCache(t *testing.T) {
+	mr := miniredis.RunT(t)
+	c := NewWorkCache(NewRedisCache(redis.NewClient(&redis.Options{Addr: mr.Addr()}), "pippin"), time.Hour)
+
+	_, _, ok := c.Get("ROOT1")
+	assert.False(t, ok)
+
+	assert.Nil(t, c.Set("ROOT1", "205452237a9b01f4", 0xfffffe1234567890))
+	work, difficulty, ok := c.Get("ROOT1")
+	assert.True(t, ok)
+	assert.Equal(t, "205452237a9b01f4", work)
+	assert.Equal(t, uint64(0xfffffe1234567890), difficulty)
+	// Stored under the namespace
+	stored, err := mr.Get("pippin:work_cache:ROOT1")
+	assert.Nil(t, err)
+	assert.Equal(t, "205452237a9b01f4:fffffe1234567890", stored)
+	assert.Equal(t, time.Hour, mr.TTL("pippin:work_cache:ROOT1"))
+
+	// Anything else isn't work
+	mr.Set("pippin:work_cache:ROOT2", "nodifficulty")
+	_, _, ok = c.Get("ROOT2")
+	assert.False(t, ok)
+
+	// Only the work is cleared
+	mr.Set("pippin:other", "v")
+	cleared, err := c.Clear()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, cleared)
+	_, _, ok = c.Get("ROOT1")
+	assert.False(t, ok)
+	assert.True(t, mr.Exists("pippin:other"))
+
+	cleared, err = c.Clear()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, cleared)
+}
+
+func TestWorkCacheBackends(t *testing.T) {
+	memory := NewMemoryCache()
+	c := NewWorkCache(memory, time.Hour)
+	assert.Nil(t, c.Set("ROOT1", "205452237a9b01f4", 0xfffffe1234567890))
+	work, _, ok := c.Get("ROOT1")
+	assert.True(t, ok)
+	assert.Equal(t, "205452237a9b01f4", work)
+	memory.Set("other", []byte("v"), 0)
+	cleared, err := c.Clear()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, cleared)
+	_, err = memory.Get("other")
+	assert.Nil(t, err)
+
+	// Memcached can't list its keys, the work expires instead
+	_, err = NewWorkCache(NewMemcachedCache("localhost:11211", "pippin"), time.Hour).Clear()
+	assert.ErrorIs(t, err, ErrDeletePrefixNotSupported)
+}
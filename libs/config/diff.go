@@ -0,0 +1,34 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
+)
+
+// The yaml path of every field that's different in b, e.g. server.port or wallet.work_peers
+func ChangedFields(a *models.PippinConfig, b *models.PippinConfig) []string {
+	changed := []string{}
+	av := reflect.ValueOf(a).Elem()
+	bv := reflect.ValueOf(b).Elem()
+	for i := 0; i < av.NumField(); i++ {
+		section := yamlName(av.Type().Field(i))
+		as := av.Field(i)
+		bs := bv.Field(i)
+		for j := 0; j < as.NumField(); j++ {
+			if !reflect.DeepEqual(as.Field(j).Interface(), bs.Field(j).Interface()) {
+				changed = append(changed, section+"."+yamlName(as.Type().Field(j)))
+			}
+		}
+	}
+	return changed
+}
+
+func yamlName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if name == "" {
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
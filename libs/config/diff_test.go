@@ -0,0 +1,28 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/config/models"
+	"github.com/creasty/defaults"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangedFields(t *testing.T) {
+	var a models.PippinConfig
+	defaults.Set(&a)
+	var b models.PippinConfig
+	defaults.Set(&b)
+	assert.Empty(t, ChangedFields(&a, &b))
+
+	b.Server.Port = 1234
+	b.Wallet.WorkPeers = []string{"http://localhost:5555"}
+	b.Price.Currencies = []string{"usd"}
+	assert.Equal(t, []string{"server.port", "wallet.work_peers", "price.currencies"}, ChangedFields(&a, &b))
+
+	// Pointers are compared by what they point to
+	autoReceive := *a.Wallet.AutoReceiveOnSend
+	b = a
+	b.Wallet.AutoReceiveOnSend = &autoReceive
+	assert.Empty(t, ChangedFields(&a, &b))
+}
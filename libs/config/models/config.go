@@ -2,9 +2,12 @@ package models
 
 import (
 	"errors"
+	"math"
 	"math/big"
 	"math/rand"
+	"net"
 	"net/url"
+	"strings"
 
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"golang.org/x/exp/slices"
@@ -13,10 +16,87 @@ import (
 // ! The old server also had:
 // log_file, log_to_stdout,
 type ServerConfig struct {
-	Host       string `yaml:"host" default:"127.0.0.1"`
-	Port       int    `yaml:"port" default:"11338"`
-	NodeRpcUrl string `yaml:"node_rpc_url"`
-	NodeWsUrl  string `yaml:"node_ws_url"`
+	Host               string `yaml:"host" default:"127.0.0.1"`
+	Port               int    `yaml:"port" default:"11338"`
+	NodeRpcUrl         string `yaml:"node_rpc_url"`
+	NodeWsUrl          string `yaml:"node_ws_url"`
+	WalletListMaxLimit int    `yaml:"wallet_list_max_limit" default:"100"`
+	// Tried in order when node_rpc_url can't be reached or doesn't return a 2xx
+	NodeRpcFallbackUrls []string `yaml:"node_rpc_fallback_urls"`
+	// Spread read-only actions like account_balance over node_rpc_url and the fallbacks
+	NodeRpcRoundRobin bool `yaml:"node_rpc_round_robin" default:"false"`
+	// Seconds between health checks of the nodes, only when there are fallbacks
+	NodeHealthCheckInterval int `yaml:"node_health_check_interval" default:"10"`
+	// Seconds to reuse the node's block_count for
+	BlockCountCacheTTL int `yaml:"block_count_cache_ttl" default:"10"`
+	// Seconds to reuse wallet_statistics for
+	WalletStatisticsCacheTTL int `yaml:"wallet_statistics_cache_ttl" default:"300"`
+	// JSON lines audit log of sensitive actions, empty disables it
+	AuditLogPath string `yaml:"audit_log_path"`
+	// One of debug, info, warn or error
+	LogLevel string `yaml:"log_level" default:"info"`
+	// text, or json for one JSON object per line
+	LogFormat string `yaml:"log_format" default:"text"`
+	// Export a trace of every gateway request to this OTLP/HTTP collector, e.g. http://localhost:4318, empty doesn't unless OTEL_EXPORTER_OTLP_ENDPOINT is set
+	OtlpEndpoint string `yaml:"otlp_endpoint"`
+	// Seconds before block_confirm can be called again for the same hash
+	BlockConfirmInterval int `yaml:"block_confirm_interval" default:"10"`
+	// How many block_info requests chain makes at once for include_block_info
+	BlockInfoConcurrency int `yaml:"block_info_concurrency" default:"4"`
+	// Most blocks account_history_all returns, max_blocks in the request can only lower it
+	AccountHistoryMaxBlocks int `yaml:"account_history_max_blocks" default:"100000"`
+	// Most blocks account_history_since reads looking for since_hash, max_depth in the request can only lower it
+	AccountHistorySinceMaxDepth int `yaml:"account_history_since_max_depth" default:"10000"`
+	// Most accounts wallet_create_watch_only accepts
+	WatchOnlyMaxAccounts int `yaml:"watch_only_max_accounts" default:"1000"`
+	// Most accounts account_list returns, count in the request can only lower it
+	AccountListMaxCount int `yaml:"account_list_max_count" default:"10000"`
+	// Most actions a pipeline request can run
+	PipelineMaxActions int `yaml:"pipeline_max_actions" default:"10"`
+	// Most sends a send_bulk request can make
+	SendBulkMaxSends int `yaml:"send_bulk_max_sends" default:"100"`
+	// Delegators per delegators call when the delegators action fetches every page
+	DelegatorsPageSize int `yaml:"delegators_page_size" default:"1000"`
+	// Origins browsers can call the gateway from, e.g. https://app.example.com or * for any, empty doesn't allow any
+	CorsAllowedOrigins []string `yaml:"cors_allowed_origins"`
+	// Methods those origins can use
+	CorsAllowedMethods []string `yaml:"cors_allowed_methods" default:"[\"GET\",\"POST\"]"`
+	// Largest request body the gateways read, in bytes
+	MaxBodyBytes int64 `yaml:"max_body_bytes" default:"1048576"`
+	// Refuse requests with fields their action doesn't have, instead of ignoring them
+	StrictRequests bool `yaml:"strict_requests" default:"false"`
+	// Where node responses are cached, one of redis, memcached or memory
+	CacheBackend string `yaml:"cache_backend" default:"redis"`
+	// IPs or CIDR ranges of reverse proxies whose X-Forwarded-For is trusted, empty trusts nobody
+	TrustedProxies []string `yaml:"trusted_proxies"`
+	// Whether control actions like wallet_destroy are served, false refuses them like a node without enable_control
+	EnableControl *bool `yaml:"enable_control" default:"true"`
+	// Serve HTTP/2, over TLS it's negotiated, without TLS it needs enable_h2c
+	EnableHTTP2 *bool `yaml:"enable_http2" default:"true"`
+	// Accept unencrypted HTTP/2 (h2c), with prior knowledge or upgraded from HTTP/1.1, needs enable_http2
+	EnableH2C *bool `yaml:"enable_h2c" default:"true"`
+	// Serve TLS with this certificate and key, both or neither have to be set
+	TLSCertFile string `yaml:"tls_cert_file"`
+	TLSKeyFile  string `yaml:"tls_key_file"`
+	// Require a client certificate signed by one of the CAs in this PEM file, needs tls_cert_file
+	TLSClientCAFile string `yaml:"tls_client_ca_file"`
+	// Load the certificate again when tls_cert_file or tls_key_file change, a SIGHUP always does
+	TLSReload bool `yaml:"tls_reload" default:"false"`
+	// Gateway requests per second per client IP, 0 doesn't limit them
+	RateLimit float64 `yaml:"rate_limit" default:"0"`
+	// How many requests a client IP can make at once before rate_limit applies
+	RateLimitBurst int `yaml:"rate_limit_burst" default:"20"`
+	// Serve net/http/pprof at pprof_path, behind the admin token if one is set
+	PprofEnabled bool   `yaml:"pprof_enabled" default:"false"`
+	PprofPath    string `yaml:"pprof_path" default:"/debug/pprof"`
+	// Refuse gateway and /ws requests without an API key, keys are managed with the apikey command
+	RequireApiKey bool `yaml:"require_api_key" default:"false"`
+	// Serve the gRPC WalletService on this port of host, with the TLS of the gateway, 0 doesn't serve it
+	GrpcPort int `yaml:"grpc_port" default:"0"`
+	// Seconds to cache the node's responses to each action for in cache_backend, e.g. account_info: 5, none are cached by default
+	NodeCacheTTLs map[string]int `yaml:"node_cache_ttls"`
+	// Seconds to wait on SIGTERM for requests and background work to finish before exiting
+	ShutdownTimeout int `yaml:"shutdown_timeout" default:"30"`
 }
 
 // ! The old server also had:
@@ -26,15 +106,55 @@ type WalletConfig struct {
 	PreconfiguredRepresentativesBanano []string `yaml:"preconfigured_representatives_banano" default:"[\"ban_1ka1ium4pfue3uxtntqsrib8mumxgazsjf58gidh1xeo5te3whsq8z476goo\",\"ban_1cake36ua5aqcq1c5i3dg7k8xtosw7r9r7qbbf5j15sk75csp9okesz87nfn\",\"ban_1fomoz167m7o38gw4rzt7hz67oq6itejpt4yocrfywujbpatd711cjew8gjj\"]"`
 	PreconfiguredRepresentativesNano   []string `yaml:"preconfigured_representatives_nano" default:"[\"nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs\",\"nano_1thingspmippfngcrtk1ofd3uwftffnu4qu9xkauo9zkiuep6iknzci3jxa6\",\"nano_1natrium1o3z5519ifou7xii8crpxpk8y65qmkih8e8bpsjri651oza8imdd\",\"nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj\"]"`
 	WorkPeers                          []string `yaml:"work_peers"`
+	WorkPeersConcurrent                *bool    `yaml:"work_peers_concurrent" default:"true"`
+	WorkSources                        []string `yaml:"work_sources"`
+	BpowKey                            string   `yaml:"bpow_key"`
+	BpowUrl                            string   `yaml:"bpow_url"`
 	NodeWorkGenerate                   bool     `yaml:"node_work_generate" default:"false"`
 	ReceiveMinimum                     string   `yaml:"receive_minimum"`
 	AutoReceiveOnSend                  *bool    `yaml:"auto_receive_on_send" default:"true"`
+	AutoReceiveInterval                int      `yaml:"auto_receive_interval" default:"0"`
 	WorkTimeout                        int      `yaml:"work_timeout" default:"30"`
+	LargeSendThreshold                 string   `yaml:"large_send_threshold"`
+	LargeSendWorkTimeout               int      `yaml:"large_send_work_timeout" default:"120"`
+	DifficultyUpdateInterval           int      `yaml:"difficulty_update_interval" default:"10"`
+	FrontierCacheSize                  int      `yaml:"frontier_cache_size" default:"1000"`
+	FrontierCacheTTL                   int      `yaml:"frontier_cache_ttl" default:"30"`
+	RepresentativeChangeConcurrency    int      `yaml:"representative_change_concurrency" default:"4"`
+	WorkPrefetchConcurrency            int      `yaml:"work_prefetch_concurrency" default:"4"`
+	WorkPrecacheInterval               int      `yaml:"work_precache_interval" default:"0"`
+	WorkCancelTimeout                  int      `yaml:"work_cancel_timeout" default:"5"`
+	WorkCacheTTL                       int      `yaml:"work_cache_ttl" default:"86400"`
+	AlertPollInterval                  int      `yaml:"alert_poll_interval" default:"30"`
+	IdempotencyKeyTTL                  int      `yaml:"idempotency_key_ttl" default:"86400"`
+	SendIDTTL                          int      `yaml:"send_id_ttl" default:"86400"`
+	JobTTL                             int      `yaml:"job_ttl" default:"86400"`
+	BalanceSnapshotInterval            int      `yaml:"balance_snapshot_interval" default:"3600"`
+	MinRepWeightPercent                float64  `yaml:"min_rep_weight_percent" default:"0.1"`
+	CallbackUrl                        string   `yaml:"callback_url"`
+	CallbackRetries                    int      `yaml:"callback_retries" default:"5"`
+	DailySendLimit                     string   `yaml:"daily_send_limit"`
+	LedgerDevice                       string   `yaml:"ledger_device"`
+	KdfMemory                          int      `yaml:"kdf_memory" default:"19456"`
+	KdfIterations                      int      `yaml:"kdf_iterations" default:"2"`
+	KdfParallelism                     int      `yaml:"kdf_parallelism" default:"1"`
+	RestoreGapLimit                    int      `yaml:"restore_gap_limit" default:"20"`
+	// Lock unlocked wallets after this many minutes without a request for them, 0 keeps them unlocked until wallet_lock
+	AutoLockMinutes int `yaml:"auto_lock_minutes" default:"0"`
+}
+
+// Fiat prices for balances, from a CoinGecko compatible simple price API
+type PriceConfig struct {
+	Enabled    bool     `yaml:"enabled" default:"false"`
+	Url        string   `yaml:"url" default:"https://api.coingecko.com/api/v3/simple/price"`
+	Currencies []string `yaml:"currencies" default:"[\"usd\",\"eur\"]"`
+	CacheTTL   int      `yaml:"cache_ttl" default:"60"`
 }
 
 type PippinConfig struct {
 	Server ServerConfig `yaml:"server"`
 	Wallet WalletConfig `yaml:"wallet"`
+	Price  PriceConfig  `yaml:"price"`
 }
 
 // Implements the interface from creasty package
@@ -58,8 +178,35 @@ func (c *PippinConfig) SetDefaults() {
 
 var ErrInvalidRpcUrl = errors.New("invalid node_rpc_url")
 var ErrInvalidWSUrl = errors.New("invalid node_ws_url")
+var ErrInvalidFallbackRpcUrl = errors.New("invalid node_rpc_fallback_urls, must be http or https urls")
+var ErrInvalidNodeHealthCheckInterval = errors.New("invalid node_health_check_interval, must be at least 1")
 var ErrInvalidPort = errors.New("invalid server port, out of range")
+var ErrInvalidPriceUrl = errors.New("invalid price url")
+var ErrInvalidLargeSendThreshold = errors.New("invalid large_send_threshold, must be an amount in raw")
+var ErrInvalidLogLevel = errors.New("invalid log_level, must be one of debug, info, warn or error")
+var ErrInvalidLogFormat = errors.New("invalid log_format, must be text or json")
+var ErrInvalidOtlpEndpoint = errors.New("invalid otlp_endpoint, must be an http or https url")
 var ErrInvalidReceiveMinimum = errors.New("invalid receive_minimum, must be between 1 and 133248290000000000000000000000000000000 (max supply)")
+var ErrInvalidMinRepWeightPercent = errors.New("invalid min_rep_weight_percent, must be between 0 and 100")
+var ErrInvalidCorsOrigin = errors.New("invalid cors_allowed_origins, must be * or origins like https://app.example.com, without a path")
+var ErrInvalidCorsMethod = errors.New("invalid cors_allowed_methods, must be GET, POST or OPTIONS")
+var ErrInvalidMaxBodyBytes = errors.New("invalid max_body_bytes, must be at least 1")
+var ErrInvalidCacheBackend = errors.New("invalid cache_backend, must be one of redis, memcached or memory")
+var ErrInvalidTrustedProxy = errors.New("invalid trusted_proxies, must be IPs or CIDR ranges")
+var ErrInvalidTLS = errors.New("invalid tls_cert_file and tls_key_file, both or neither must be set")
+var ErrInvalidTLSClientCA = errors.New("invalid tls_client_ca_file, needs tls_cert_file and tls_key_file")
+var ErrInvalidPprofPath = errors.New("invalid pprof_path, must start with /")
+var ErrInvalidCallbackUrl = errors.New("invalid callback_url, must be an http or https url")
+var ErrInvalidCallbackRetries = errors.New("invalid callback_retries, can't be negative")
+var ErrInvalidDailySendLimit = errors.New("invalid daily_send_limit, must be an amount in raw")
+var ErrInvalidWorkSources = errors.New("invalid work_sources, must be peers, boompow or local, each at most once")
+var ErrInvalidBpowUrl = errors.New("invalid bpow_url, must be an http or https url")
+var ErrInvalidGrpcPort = errors.New("invalid grpc_port, out of range or the same as port")
+var ErrInvalidNodeCacheTTLs = errors.New("invalid node_cache_ttls, actions must be one of account_balance, account_history, account_info, account_representative, block_info, blocks_info, pending or receivable, with at least 0 seconds")
+var ErrInvalidKdf = errors.New("invalid kdf_memory, kdf_iterations or kdf_parallelism, kdf_iterations must be at least 1, kdf_parallelism between 1 and 255 and kdf_memory at least 8 KiB per kdf_parallelism")
+var ErrInvalidRestoreGapLimit = errors.New("invalid restore_gap_limit, must be between 1 and 1000")
+var ErrInvalidAutoLockMinutes = errors.New("invalid auto_lock_minutes, can't be negative")
+var ErrInvalidRateLimit = errors.New("invalid rate_limit and rate_limit_burst, rate_limit can't be negative and rate_limit_burst must be at least 1")
 
 func (c *PippinConfig) Validate() error {
 	u, err := url.Parse(c.Server.NodeRpcUrl)
@@ -67,11 +214,31 @@ func (c *PippinConfig) Validate() error {
 		return ErrInvalidRpcUrl
 	}
 
+	for _, fallback := range c.Server.NodeRpcFallbackUrls {
+		u, err := url.Parse(fallback)
+		if err != nil || !slices.Contains([]string{"http", "https"}, u.Scheme) || u.Host == "" {
+			return ErrInvalidFallbackRpcUrl
+		}
+	}
+	if len(c.Server.NodeRpcFallbackUrls) > 0 && c.Server.NodeHealthCheckInterval < 1 {
+		return ErrInvalidNodeHealthCheckInterval
+	}
+
 	// Parse server port as int
 	if c.Server.Port < 1 || c.Server.Port > 65535 {
 		return ErrInvalidPort
 	}
 
+	if c.Server.GrpcPort < 0 || c.Server.GrpcPort > 65535 || c.Server.GrpcPort == c.Server.Port {
+		return ErrInvalidGrpcPort
+	}
+
+	for action, ttl := range c.Server.NodeCacheTTLs {
+		if ttl < 0 || !slices.Contains([]string{"account_balance", "account_history", "account_info", "account_representative", "block_info", "blocks_info", "pending", "receivable"}, action) {
+			return ErrInvalidNodeCacheTTLs
+		}
+	}
+
 	// Validate websocket URL if set
 	if c.Server.NodeWsUrl != "" {
 		u, err := url.Parse(c.Server.NodeWsUrl)
@@ -80,6 +247,61 @@ func (c *PippinConfig) Validate() error {
 		}
 	}
 
+	if !slices.Contains([]string{"debug", "info", "warn", "error"}, c.Server.LogLevel) {
+		return ErrInvalidLogLevel
+	}
+
+	if !slices.Contains([]string{"text", "json"}, c.Server.LogFormat) {
+		return ErrInvalidLogFormat
+	}
+
+	if c.Server.OtlpEndpoint != "" {
+		u, err := url.Parse(c.Server.OtlpEndpoint)
+		if err != nil || !slices.Contains([]string{"http", "https"}, u.Scheme) || u.Host == "" {
+			return ErrInvalidOtlpEndpoint
+		}
+	}
+
+	for _, origin := range c.Server.CorsAllowedOrigins {
+		if origin == "*" {
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || !slices.Contains([]string{"http", "https"}, u.Scheme) || u.Host == "" || strings.Trim(u.Path, "/") != "" || u.RawQuery != "" {
+			return ErrInvalidCorsOrigin
+		}
+	}
+	for _, method := range c.Server.CorsAllowedMethods {
+		if !slices.Contains([]string{"GET", "POST", "OPTIONS"}, method) {
+			return ErrInvalidCorsMethod
+		}
+	}
+	if c.Server.MaxBodyBytes < 1 {
+		return ErrInvalidMaxBodyBytes
+	}
+
+	if !slices.Contains([]string{"redis", "memcached", "memory"}, c.Server.CacheBackend) {
+		return ErrInvalidCacheBackend
+	}
+
+	if _, err := c.Server.TrustedProxyNets(); err != nil {
+		return err
+	}
+
+	if (c.Server.TLSCertFile == "") != (c.Server.TLSKeyFile == "") {
+		return ErrInvalidTLS
+	} else if c.Server.TLSClientCAFile != "" && c.Server.TLSCertFile == "" {
+		return ErrInvalidTLSClientCA
+	}
+
+	if c.Server.RateLimit < 0 || (c.Server.RateLimit > 0 && c.Server.RateLimitBurst < 1) {
+		return ErrInvalidRateLimit
+	}
+
+	if c.Server.PprofEnabled && (!strings.HasPrefix(c.Server.PprofPath, "/") || strings.Trim(c.Server.PprofPath, "/") == "") {
+		return ErrInvalidPprofPath
+	}
+
 	// Parse receive minimum as big int
 	minimum, ok := big.NewInt(0).SetString(c.Wallet.ReceiveMinimum, 10)
 	if !ok {
@@ -91,6 +313,55 @@ func (c *PippinConfig) Validate() error {
 		}
 	}
 
+	// Optional, sends above it get large_send_work_timeout
+	if c.Wallet.LargeSendThreshold != "" {
+		threshold, ok := big.NewInt(0).SetString(c.Wallet.LargeSendThreshold, 10)
+		if !ok || threshold.Sign() < 0 {
+			return ErrInvalidLargeSendThreshold
+		}
+	}
+
+	// Optional, each wallet can send this much in 24 hours
+	if c.Wallet.DailySendLimit != "" {
+		limit, ok := big.NewInt(0).SetString(c.Wallet.DailySendLimit, 10)
+		if !ok || limit.Sign() < 0 {
+			return ErrInvalidDailySendLimit
+		}
+	}
+
+	if c.Wallet.MinRepWeightPercent < 0 || c.Wallet.MinRepWeightPercent > 100 {
+		return ErrInvalidMinRepWeightPercent
+	}
+
+	if c.Wallet.CallbackUrl != "" {
+		u, err := url.Parse(c.Wallet.CallbackUrl)
+		if err != nil || !slices.Contains([]string{"http", "https"}, u.Scheme) || u.Host == "" {
+			return ErrInvalidCallbackUrl
+		}
+	}
+	if c.Wallet.CallbackRetries < 0 {
+		return ErrInvalidCallbackRetries
+	}
+
+	// Argon2id needs 8 KiB for every lane
+	if c.Wallet.KdfIterations < 1 || c.Wallet.KdfParallelism < 1 || c.Wallet.KdfParallelism > 255 || c.Wallet.KdfMemory < 8*c.Wallet.KdfParallelism || int64(c.Wallet.KdfMemory) > math.MaxUint32 {
+		return ErrInvalidKdf
+	}
+
+	if c.Wallet.RestoreGapLimit < 1 || c.Wallet.RestoreGapLimit > 1000 {
+		return ErrInvalidRestoreGapLimit
+	}
+	if c.Wallet.AutoLockMinutes < 0 {
+		return ErrInvalidAutoLockMinutes
+	}
+
+	if c.Price.Enabled {
+		u, err := url.Parse(c.Price.Url)
+		if err != nil || !slices.Contains([]string{"http", "https"}, u.Scheme) || u.Host == "" {
+			return ErrInvalidPriceUrl
+		}
+	}
+
 	// Validate all work peers
 	for _, peer := range c.Wallet.WorkPeers {
 		u, err := url.Parse(peer)
@@ -99,6 +370,19 @@ func (c *PippinConfig) Validate() error {
 		}
 	}
 
+	for i, source := range c.Wallet.WorkSources {
+		if !slices.Contains([]string{"peers", "boompow", "local"}, source) || slices.Contains(c.Wallet.WorkSources[:i], source) {
+			return ErrInvalidWorkSources
+		}
+	}
+
+	if c.Wallet.BpowUrl != "" {
+		u, err := url.Parse(c.Wallet.BpowUrl)
+		if err != nil || !slices.Contains([]string{"http", "https"}, u.Scheme) || u.Host == "" {
+			return ErrInvalidBpowUrl
+		}
+	}
+
 	// Validate representatives
 	if c.Wallet.Banano {
 		for _, rep := range c.Wallet.PreconfiguredRepresentativesBanano {
@@ -117,6 +401,32 @@ func (c *PippinConfig) Validate() error {
 	return err
 }
 
+// Parse trusted_proxies, a single IP is a range of just that IP
+func (c *ServerConfig) TrustedProxyNets() ([]net.IPNet, error) {
+	nets := []net.IPNet{}
+	for _, proxy := range c.TrustedProxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, ErrInvalidTrustedProxy
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 32
+			}
+			nets = append(nets, net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, ErrInvalidTrustedProxy
+		}
+		nets = append(nets, *ipNet)
+	}
+	return nets, nil
+}
+
 var ErrNoRepsConfigured = errors.New("no representatives configured")
 
 func (c *PippinConfig) GetRandomRep() (string, error) {
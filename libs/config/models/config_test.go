@@ -1,6 +1,7 @@
 package models
 
 import (
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -24,3 +25,19 @@ func TestGetRandomRep(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "ban_1", rep)
 }
+
+func TestTrustedProxyNets(t *testing.T) {
+	config := ServerConfig{TrustedProxies: []string{"10.0.0.0/8", "127.0.0.1", "::1"}}
+	nets, err := config.TrustedProxyNets()
+	assert.Nil(t, err)
+	assert.Len(t, nets, 3)
+	assert.Equal(t, "10.0.0.0/8", nets[0].String())
+	assert.Equal(t, "127.0.0.1/32", nets[1].String())
+	assert.Equal(t, "::1/128", nets[2].String())
+	assert.True(t, nets[1].Contains(net.ParseIP("127.0.0.1")))
+	assert.False(t, nets[1].Contains(net.ParseIP("127.0.0.2")))
+
+	config.TrustedProxies = []string{"not an ip"}
+	_, err = config.TrustedProxyNets()
+	assert.ErrorIs(t, err, ErrInvalidTrustedProxy)
+}
@@ -28,8 +28,49 @@ func TestParser(t *testing.T) {
 	assert.Equal(t, "127.0.0.1", config.Server.Host)
 	assert.Equal(t, "http://[::1]:7076", config.Server.NodeRpcUrl)
 	assert.Equal(t, "", config.Server.NodeWsUrl)
+	assert.Equal(t, 100, config.Server.WalletListMaxLimit)
+	assert.Equal(t, []string{}, config.Server.NodeRpcFallbackUrls)
+	assert.Equal(t, false, config.Server.NodeRpcRoundRobin)
+	assert.Equal(t, 10, config.Server.NodeHealthCheckInterval)
+	assert.Equal(t, "", config.Server.AuditLogPath)
+	assert.Equal(t, 10, config.Server.BlockCountCacheTTL)
+	assert.Equal(t, 300, config.Server.WalletStatisticsCacheTTL)
+	assert.Equal(t, "info", config.Server.LogLevel)
+	assert.Equal(t, "text", config.Server.LogFormat)
+	assert.Equal(t, "", config.Server.OtlpEndpoint)
+	assert.Empty(t, config.Server.CorsAllowedOrigins)
+	assert.Equal(t, []string{"GET", "POST"}, config.Server.CorsAllowedMethods)
+	assert.Equal(t, int64(1048576), config.Server.MaxBodyBytes)
+	assert.False(t, config.Server.StrictRequests)
+	assert.Equal(t, 10, config.Server.BlockConfirmInterval)
+	assert.Equal(t, 4, config.Server.BlockInfoConcurrency)
+	assert.Equal(t, 100000, config.Server.AccountHistoryMaxBlocks)
+	assert.Equal(t, 10000, config.Server.AccountHistorySinceMaxDepth)
+	assert.Equal(t, 1000, config.Server.WatchOnlyMaxAccounts)
+	assert.Equal(t, 10000, config.Server.AccountListMaxCount)
+	assert.Equal(t, 10, config.Server.PipelineMaxActions)
+	assert.Equal(t, 100, config.Server.SendBulkMaxSends)
+	assert.Equal(t, 1000, config.Server.DelegatorsPageSize)
+	assert.Equal(t, "redis", config.Server.CacheBackend)
+	assert.Equal(t, true, *config.Server.EnableControl)
+	assert.Equal(t, true, *config.Server.EnableHTTP2)
+	assert.Equal(t, true, *config.Server.EnableH2C)
+	assert.Equal(t, float64(0), config.Server.RateLimit)
+	assert.Equal(t, 20, config.Server.RateLimitBurst)
+	assert.Equal(t, false, config.Server.PprofEnabled)
+	assert.Equal(t, "/debug/pprof", config.Server.PprofPath)
+	assert.Equal(t, false, config.Server.RequireApiKey)
+	assert.Equal(t, 0, config.Server.GrpcPort)
+	assert.Equal(t, 30, config.Server.ShutdownTimeout)
+	assert.Empty(t, config.Server.NodeCacheTTLs)
+	assert.Equal(t, false, config.Server.TLSReload)
 	assert.Equal(t, false, config.Wallet.Banano)
 	assert.Equal(t, true, *config.Wallet.AutoReceiveOnSend)
+	assert.Equal(t, 0, config.Wallet.AutoReceiveInterval)
+	assert.Equal(t, true, *config.Wallet.WorkPeersConcurrent)
+	assert.Empty(t, config.Wallet.WorkSources)
+	assert.Equal(t, "", config.Wallet.BpowKey)
+	assert.Equal(t, "", config.Wallet.BpowUrl)
 	assert.Equal(t, false, config.Wallet.NodeWorkGenerate)
 	assert.Equal(t, []string{
 		"ban_1ka1ium4pfue3uxtntqsrib8mumxgazsjf58gidh1xeo5te3whsq8z476goo",
@@ -44,6 +85,34 @@ func TestParser(t *testing.T) {
 	}, config.Wallet.PreconfiguredRepresentativesNano)
 	assert.Equal(t, []string{}, config.Wallet.WorkPeers)
 	assert.Equal(t, "1000000000000000000000000", config.Wallet.ReceiveMinimum)
+	assert.Equal(t, 10, config.Wallet.DifficultyUpdateInterval)
+	assert.Equal(t, "", config.Wallet.LargeSendThreshold)
+	assert.Equal(t, 120, config.Wallet.LargeSendWorkTimeout)
+	assert.Equal(t, 1000, config.Wallet.FrontierCacheSize)
+	assert.Equal(t, 30, config.Wallet.FrontierCacheTTL)
+	assert.Equal(t, 4, config.Wallet.RepresentativeChangeConcurrency)
+	assert.Equal(t, 4, config.Wallet.WorkPrefetchConcurrency)
+	assert.Equal(t, 0, config.Wallet.WorkPrecacheInterval)
+	assert.Equal(t, 5, config.Wallet.WorkCancelTimeout)
+	assert.Equal(t, 86400, config.Wallet.WorkCacheTTL)
+	assert.Equal(t, 30, config.Wallet.AlertPollInterval)
+	assert.Equal(t, 86400, config.Wallet.IdempotencyKeyTTL)
+	assert.Equal(t, 86400, config.Wallet.SendIDTTL)
+	assert.Equal(t, 86400, config.Wallet.JobTTL)
+	assert.Equal(t, 3600, config.Wallet.BalanceSnapshotInterval)
+	assert.Equal(t, 0.1, config.Wallet.MinRepWeightPercent)
+	assert.Equal(t, "", config.Wallet.CallbackUrl)
+	assert.Equal(t, 5, config.Wallet.CallbackRetries)
+	assert.Equal(t, "", config.Wallet.DailySendLimit)
+	assert.Equal(t, 19456, config.Wallet.KdfMemory)
+	assert.Equal(t, 2, config.Wallet.KdfIterations)
+	assert.Equal(t, 1, config.Wallet.KdfParallelism)
+	assert.Equal(t, 20, config.Wallet.RestoreGapLimit)
+	assert.Equal(t, 0, config.Wallet.AutoLockMinutes)
+	assert.Equal(t, false, config.Price.Enabled)
+	assert.Equal(t, "https://api.coingecko.com/api/v3/simple/price", config.Price.Url)
+	assert.Equal(t, []string{"usd", "eur"}, config.Price.Currencies)
+	assert.Equal(t, 60, config.Price.CacheTTL)
 
 	// Copy testdata config 1
 	assert.Nil(t, os.Remove(path.Join(configRoot, "config.yaml")))
@@ -60,8 +129,12 @@ func TestParser(t *testing.T) {
 	assert.Equal(t, "0.0.0.0", config.Server.Host)
 	assert.Equal(t, "http://[::1]:7076", config.Server.NodeRpcUrl)
 	assert.Equal(t, "", config.Server.NodeWsUrl)
+	assert.Equal(t, true, *config.Server.EnableControl)
+	assert.Equal(t, true, *config.Server.EnableHTTP2)
+	assert.Equal(t, true, *config.Server.EnableH2C)
 	assert.Equal(t, false, config.Wallet.Banano)
 	assert.Equal(t, true, *config.Wallet.AutoReceiveOnSend)
+	assert.Equal(t, true, *config.Wallet.WorkPeersConcurrent)
 	assert.Equal(t, false, config.Wallet.NodeWorkGenerate)
 	assert.Equal(t, []string{
 		"ban_1ka1ium4pfue3uxtntqsrib8mumxgazsjf58gidh1xeo5te3whsq8z476goo",
@@ -92,8 +165,12 @@ func TestParser(t *testing.T) {
 	assert.Equal(t, "127.0.0.1", config.Server.Host)
 	assert.Equal(t, "http://[::1]:7072", config.Server.NodeRpcUrl)
 	assert.Equal(t, "", config.Server.NodeWsUrl)
+	assert.Equal(t, true, *config.Server.EnableControl)
+	assert.Equal(t, true, *config.Server.EnableHTTP2)
+	assert.Equal(t, true, *config.Server.EnableH2C)
 	assert.Equal(t, true, config.Wallet.Banano)
 	assert.Equal(t, true, *config.Wallet.AutoReceiveOnSend)
+	assert.Equal(t, true, *config.Wallet.WorkPeersConcurrent)
 	assert.Equal(t, false, config.Wallet.NodeWorkGenerate)
 	assert.Equal(t, []string{
 		"ban_1ka1ium4pfue3uxtntqsrib8mumxgazsjf58gidh1xeo5te3whsq8z476goo",
@@ -181,6 +258,178 @@ func TestConfigValidation(t *testing.T) {
 	assert.ErrorIs(t, config.Validate(), models.ErrInvalidWSUrl)
 	config.Server.NodeWsUrl = "ws://[::1]:7078"
 
+	// Check fallback nodes
+	config.Server.NodeRpcFallbackUrls = []string{"https://fallback.example.com/rpc", "ws://[::1]:7078"}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidFallbackRpcUrl)
+	config.Server.NodeRpcFallbackUrls = []string{"https://fallback.example.com/rpc"}
+	assert.Nil(t, config.Validate())
+	config.Server.NodeHealthCheckInterval = 0
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidNodeHealthCheckInterval)
+	config.Server.NodeRpcFallbackUrls = nil
+	assert.Nil(t, config.Validate())
+	config.Server.NodeHealthCheckInterval = 10
+
+	// Check log level
+	config.Server.LogLevel = "verbose"
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidLogLevel)
+	config.Server.LogLevel = "warn"
+	assert.Nil(t, config.Validate())
+
+	// Check log format
+	config.Server.LogFormat = "logfmt"
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidLogFormat)
+	config.Server.LogFormat = "json"
+	assert.Nil(t, config.Validate())
+
+	// Check otlp endpoint
+	config.Server.OtlpEndpoint = "localhost:4318"
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidOtlpEndpoint)
+	config.Server.OtlpEndpoint = "http://localhost:4318"
+	assert.Nil(t, config.Validate())
+
+	// Check cors
+	config.Server.CorsAllowedOrigins = []string{"https://a.com/path"}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidCorsOrigin)
+	config.Server.CorsAllowedOrigins = []string{"localhost"}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidCorsOrigin)
+	config.Server.CorsAllowedOrigins = []string{"*", "https://a.com", "http://localhost:3000"}
+	assert.Nil(t, config.Validate())
+	config.Server.CorsAllowedMethods = []string{"GET", "DELETE"}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidCorsMethod)
+	config.Server.CorsAllowedMethods = []string{"POST", "OPTIONS"}
+	assert.Nil(t, config.Validate())
+
+	// Check max body bytes
+	config.Server.MaxBodyBytes = 0
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidMaxBodyBytes)
+	config.Server.MaxBodyBytes = 4096
+	assert.Nil(t, config.Validate())
+
+	// Check cache backend
+	config.Server.CacheBackend = "mongodb"
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidCacheBackend)
+	config.Server.CacheBackend = "memcached"
+	assert.Nil(t, config.Validate())
+
+	// Check trusted proxies
+	config.Server.TrustedProxies = []string{"10.0.0.0/8", "127.0.0.1", "::1"}
+	assert.Nil(t, config.Validate())
+	config.Server.TrustedProxies = []string{"10.0.0.0/33"}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidTrustedProxy)
+	config.Server.TrustedProxies = []string{"localhost"}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidTrustedProxy)
+	config.Server.TrustedProxies = nil
+
+	// Check TLS
+	config.Server.TLSCertFile = "/etc/pippin/cert.pem"
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidTLS)
+	config.Server.TLSKeyFile = "/etc/pippin/key.pem"
+	assert.Nil(t, config.Validate())
+	config.Server.TLSClientCAFile = "/etc/pippin/clients.pem"
+	assert.Nil(t, config.Validate())
+	config.Server.TLSCertFile = ""
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidTLS)
+	config.Server.TLSKeyFile = ""
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidTLSClientCA)
+	config.Server.TLSClientCAFile = ""
+
+	// Check gRPC port
+	config.Server.GrpcPort = 11339
+	assert.Nil(t, config.Validate())
+	config.Server.GrpcPort = config.Server.Port
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidGrpcPort)
+	config.Server.GrpcPort = 70000
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidGrpcPort)
+	config.Server.GrpcPort = 0
+
+	// Check node cache TTLs
+	config.Server.NodeCacheTTLs = map[string]int{"account_info": 5, "blocks_info": 0}
+	assert.Nil(t, config.Validate())
+	config.Server.NodeCacheTTLs = map[string]int{"send": 5}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidNodeCacheTTLs)
+	config.Server.NodeCacheTTLs = map[string]int{"account_info": -1}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidNodeCacheTTLs)
+	config.Server.NodeCacheTTLs = nil
+
+	// Check rate limit
+	config.Server.RateLimit = -1
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidRateLimit)
+	config.Server.RateLimit = 5
+	config.Server.RateLimitBurst = 0
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidRateLimit)
+	config.Server.RateLimitBurst = 20
+	assert.Nil(t, config.Validate())
+	config.Server.RateLimit = 0
+
+	// Check callback url and retries
+	config.Wallet.CallbackUrl = "ftp://example.com"
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidCallbackUrl)
+	config.Wallet.CallbackUrl = "https://example.com/pocketed"
+	assert.Nil(t, config.Validate())
+	config.Wallet.CallbackRetries = -1
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidCallbackRetries)
+	config.Wallet.CallbackRetries = 5
+	config.Wallet.CallbackUrl = ""
+
+	// Check work sources and the BoomPoW url
+	config.Wallet.WorkSources = []string{"boompow", "gpu"}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidWorkSources)
+	config.Wallet.WorkSources = []string{"boompow", "peers", "boompow"}
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidWorkSources)
+	config.Wallet.WorkSources = []string{"boompow", "peers", "local"}
+	assert.Nil(t, config.Validate())
+	config.Wallet.WorkSources = nil
+	config.Wallet.BpowUrl = "boompow.banano.cc"
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidBpowUrl)
+	config.Wallet.BpowUrl = "https://boompow.banano.cc/graphql"
+	assert.Nil(t, config.Validate())
+	config.Wallet.BpowUrl = ""
+
+	// Check daily send limit
+	config.Wallet.DailySendLimit = "-1"
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidDailySendLimit)
+	config.Wallet.DailySendLimit = "1nano"
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidDailySendLimit)
+	config.Wallet.DailySendLimit = "1000000000000000000000000000000"
+	assert.Nil(t, config.Validate())
+	config.Wallet.DailySendLimit = ""
+
+	// Check kdf parameters
+	config.Wallet.KdfIterations = 0
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidKdf)
+	config.Wallet.KdfIterations = 2
+	config.Wallet.KdfParallelism = 256
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidKdf)
+	config.Wallet.KdfParallelism = 4
+	config.Wallet.KdfMemory = 31
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidKdf)
+	config.Wallet.KdfMemory = 65536
+	assert.Nil(t, config.Validate())
+
+	// Check restore gap limit
+	config.Wallet.RestoreGapLimit = 0
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidRestoreGapLimit)
+	config.Wallet.RestoreGapLimit = 1001
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidRestoreGapLimit)
+	config.Wallet.RestoreGapLimit = 20
+	config.Wallet.AutoLockMinutes = -1
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidAutoLockMinutes)
+	config.Wallet.AutoLockMinutes = 0
+	assert.Nil(t, config.Validate())
+	config.Wallet.KdfMemory = 19456
+	config.Wallet.KdfParallelism = 1
+
+	// Check pprof path, only when it's enabled
+	config.Server.PprofPath = "debug"
+	assert.Nil(t, config.Validate())
+	config.Server.PprofEnabled = true
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidPprofPath)
+	config.Server.PprofPath = "/"
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidPprofPath)
+	config.Server.PprofPath = "/debug/pprof"
+	assert.Nil(t, config.Validate())
+	config.Server.PprofEnabled = false
+
 	// Check receive minimum
 	config.Wallet.ReceiveMinimum = "0"
 	assert.NotNil(t, config.Validate())
@@ -191,6 +440,14 @@ func TestConfigValidation(t *testing.T) {
 	config.Wallet.ReceiveMinimum = "1"
 	assert.Nil(t, config.Validate())
 
+	// Check min rep weight percent
+	config.Wallet.MinRepWeightPercent = -1
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidMinRepWeightPercent)
+	config.Wallet.MinRepWeightPercent = 100.1
+	assert.ErrorIs(t, config.Validate(), models.ErrInvalidMinRepWeightPercent)
+	config.Wallet.MinRepWeightPercent = 0
+	assert.Nil(t, config.Validate())
+
 	// Check work peers
 	config.Wallet.WorkPeers = []string{"http://localhost:5555", "http://myotherworkpeer.com"}
 	assert.Nil(t, config.Validate())
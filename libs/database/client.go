@@ -3,8 +3,10 @@ package database
 import (
 	"database/sql"
 
+	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
 	_ "github.com/jackc/pgx/v4/stdlib"
 	_ "modernc.org/sqlite"
 )
@@ -14,7 +16,30 @@ func NewEntClient(connInfo SqlDBConn) (*ent.Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	applyPoolConfig(db, connInfo.PoolConfig())
 
-	drv := entsql.OpenDB(connInfo.Dialect(), db)
-	return ent.NewClient(ent.Driver(drv)), nil
+	var drv dialect.Driver = entsql.OpenDB(connInfo.Dialect(), db)
+	if connInfo.ReplicaDSN() != "" {
+		replica, err := sql.Open(connInfo.Driver(), connInfo.ReplicaDSN())
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+		applyPoolConfig(replica, connInfo.PoolConfig())
+		log.Info("Using read replica for queries")
+		drv = &replicaDriver{primary: drv, replica: entsql.OpenDB(connInfo.Dialect(), replica)}
+	}
+	return ent.NewClient(ent.Driver(&timedDriver{drv})), nil
+}
+
+func applyPoolConfig(db *sql.DB, pool PoolConfig) {
+	if pool.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(pool.MaxOpenConns)
+	}
+	if pool.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(pool.MaxIdleConns)
+	}
+	if pool.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(pool.ConnMaxLifetime)
+	}
 }
@@ -1,7 +1,10 @@
 package database
 
 import (
+	"context"
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -13,3 +16,30 @@ func TestNewEntClient(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, client)
 }
+
+func TestNewEntClientReadReplica(t *testing.T) {
+	client, err := NewEntClient(&SqliteConn{FileName: "client_primary", Mode: "memory", ReadReplicaDSN: (&SqliteConn{FileName: "client_replica", Mode: "memory"}).DSN()})
+	assert.Nil(t, err)
+	assert.NotNil(t, client)
+	defer client.Close()
+
+	// Migrations have to run on the primary
+	assert.Nil(t, client.Schema.Create(WithPrimary(context.Background())))
+	_, err = client.Wallet.Create().SetSeed("seed").Save(context.Background())
+	assert.Nil(t, err)
+	count, err := client.Wallet.Query().Count(WithPrimary(context.Background()))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestApplyPoolConfig(t *testing.T) {
+	db, err := sql.Open("sqlite", (&SqliteConn{FileName: "client_pool", Mode: "memory"}).DSN())
+	assert.Nil(t, err)
+	defer db.Close()
+
+	applyPoolConfig(db, PoolConfig{MaxOpenConns: 7, ConnMaxLifetime: time.Minute})
+	assert.Equal(t, 7, db.Stats().MaxOpenConnections)
+	// Zero leaves it alone
+	applyPoolConfig(db, PoolConfig{})
+	assert.Equal(t, 7, db.Stats().MaxOpenConnections)
+}
@@ -1,8 +1,12 @@
 package database
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"path"
+	"strconv"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"github.com/appditto/pippin_nano_wallet/libs/log"
@@ -13,18 +17,80 @@ type SqlDBConn interface {
 	DSN() string
 	Dialect() string
 	Driver() string
+	// Empty when there's no read replica
+	ReplicaDSN() string
+	// Applied to the primary and the read replica
+	PoolConfig() PoolConfig
+}
+
+// Limits of the connection pool, a zero value keeps database/sql's default
+type PoolConfig struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+}
+
+var ErrInvalidPoolConfig = errors.New("invalid DB_MAX_OPEN_CONNS, DB_MAX_IDLE_CONNS or DB_CONN_MAX_LIFETIME")
+
+// The pool limits from DB_MAX_OPEN_CONNS, DB_MAX_IDLE_CONNS and DB_CONN_MAX_LIFETIME (in seconds)
+func getPoolConfig() (PoolConfig, error) {
+	var pool PoolConfig
+	var err error
+	if pool.MaxOpenConns, err = strconv.Atoi(utils.GetEnv("DB_MAX_OPEN_CONNS", "0")); err != nil || pool.MaxOpenConns < 0 {
+		return pool, ErrInvalidPoolConfig
+	}
+	if pool.MaxIdleConns, err = strconv.Atoi(utils.GetEnv("DB_MAX_IDLE_CONNS", "0")); err != nil || pool.MaxIdleConns < 0 {
+		return pool, ErrInvalidPoolConfig
+	}
+	lifetime, err := strconv.Atoi(utils.GetEnv("DB_CONN_MAX_LIFETIME", "0"))
+	if err != nil || lifetime < 0 {
+		return pool, ErrInvalidPoolConfig
+	}
+	pool.ConnMaxLifetime = time.Duration(lifetime) * time.Second
+	return pool, nil
+}
+
+// Appends the non-empty params to dsn as its query string
+func withParams(dsn string, params map[string]string) string {
+	query := url.Values{}
+	for key, value := range params {
+		if value != "" {
+			query.Set(key, value)
+		}
+	}
+	if len(query) == 0 {
+		return dsn
+	}
+	return dsn + "?" + query.Encode()
 }
 
 type PostgresConn struct {
-	Host     string
-	Port     string
-	Password string
-	User     string
-	DBName   string
+	Host           string
+	Port           string
+	Password       string
+	User           string
+	DBName         string
+	ReadReplicaDSN string
+	// disable, require, verify-ca or verify-full, libpq's default when it's empty
+	SSLMode string
+	// The CA certificate for verify-ca and verify-full
+	SSLRootCert string
+	Pool        PoolConfig
 }
 
 func (c *PostgresConn) DSN() string {
-	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s", c.User, c.Password, c.Host, c.Port, c.DBName)
+	return withParams(fmt.Sprintf("postgres://%s:%s@%s:%s/%s", c.User, c.Password, c.Host, c.Port, c.DBName), map[string]string{
+		"sslmode":     c.SSLMode,
+		"sslrootcert": c.SSLRootCert,
+	})
+}
+
+func (c *PostgresConn) PoolConfig() PoolConfig {
+	return c.Pool
+}
+
+func (c *PostgresConn) ReplicaDSN() string {
+	return c.ReadReplicaDSN
 }
 
 func (c *PostgresConn) Dialect() string {
@@ -36,15 +102,29 @@ func (c *PostgresConn) Driver() string {
 }
 
 type MysqlConn struct {
-	Host     string
-	Port     string
-	Password string
-	User     string
-	DBName   string
+	Host           string
+	Port           string
+	Password       string
+	User           string
+	DBName         string
+	ReadReplicaDSN string
+	// true, false, skip-verify or preferred, the driver's tls param
+	TLS  string
+	Pool PoolConfig
 }
 
 func (c *MysqlConn) DSN() string {
-	return fmt.Sprintf("mysql://%s:%s@%s:%s/%s", c.User, c.Password, c.Host, c.Port, c.DBName)
+	return withParams(fmt.Sprintf("mysql://%s:%s@%s:%s/%s", c.User, c.Password, c.Host, c.Port, c.DBName), map[string]string{
+		"tls": c.TLS,
+	})
+}
+
+func (c *MysqlConn) PoolConfig() PoolConfig {
+	return c.Pool
+}
+
+func (c *MysqlConn) ReplicaDSN() string {
+	return c.ReadReplicaDSN
 }
 
 func (c *MysqlConn) Dialect() string {
@@ -54,8 +134,9 @@ func (c *MysqlConn) Dialect() string {
 func (c *MysqlConn) Driver() string { return c.Dialect() }
 
 type SqliteConn struct {
-	FileName string
-	Mode     string
+	FileName       string
+	Mode           string
+	ReadReplicaDSN string
 }
 
 func (c *SqliteConn) DSN() string {
@@ -63,6 +144,15 @@ func (c *SqliteConn) DSN() string {
 	return fmt.Sprintf("file:%s?cache=shared&mode=%s&_fk=1&_pragma=foreign_keys(1)", c.FileName, c.Mode)
 }
 
+func (c *SqliteConn) ReplicaDSN() string {
+	return c.ReadReplicaDSN
+}
+
+// SQLite isn't pooled like a server, database/sql's defaults are kept
+func (c *SqliteConn) PoolConfig() PoolConfig {
+	return PoolConfig{}
+}
+
 func (c *SqliteConn) Dialect() string {
 	return dialect.SQLite
 }
@@ -80,15 +170,24 @@ func GetSqlDbConn(mock bool) (SqlDBConn, error) {
 	postgresPassword := utils.GetEnv("POSTGRES_PASSWORD", "")
 	postgresHost := utils.GetEnv("POSTGRES_HOST", "127.0.0.1")
 	postgresPort := utils.GetEnv("POSTGRES_PORT", "5432")
+	postgresReplica := utils.GetEnv("POSTGRES_READ_REPLICA_DSN", "")
 
 	if postgresDb != "" && postgresUser != "" && postgresPassword != "" {
+		pool, err := getPoolConfig()
+		if err != nil {
+			return nil, err
+		}
 		log.Infof("Using PostgreSQL database %s@%s:%s", postgresUser, postgresHost, postgresPort)
 		return &PostgresConn{
-			Host:     postgresHost,
-			Port:     postgresPort,
-			Password: postgresPassword,
-			User:     postgresUser,
-			DBName:   postgresDb,
+			Host:           postgresHost,
+			Port:           postgresPort,
+			Password:       postgresPassword,
+			User:           postgresUser,
+			DBName:         postgresDb,
+			ReadReplicaDSN: postgresReplica,
+			SSLMode:        utils.GetEnv("POSTGRES_SSLMODE", ""),
+			SSLRootCert:    utils.GetEnv("POSTGRES_SSLROOTCERT", ""),
+			Pool:           pool,
 		}, nil
 	}
 
@@ -98,15 +197,23 @@ func GetSqlDbConn(mock bool) (SqlDBConn, error) {
 	mysqlPassword := utils.GetEnv("MYSQL_PASSWORD", "")
 	mysqlHost := utils.GetEnv("MYSQL_HOST", "127.0.0.1")
 	mysqlPort := utils.GetEnv("MYSQL_PORT", "3306")
+	mysqlReplica := utils.GetEnv("MYSQL_READ_REPLICA_DSN", "")
 
 	if mysqlDb != "" && mysqlUser != "" && mysqlPassword != "" {
+		pool, err := getPoolConfig()
+		if err != nil {
+			return nil, err
+		}
 		log.Infof("Using MySQL database %s@%s:%s", mysqlUser, mysqlHost, mysqlPort)
 		return &MysqlConn{
-			Host:     mysqlHost,
-			Port:     mysqlPort,
-			Password: mysqlPassword,
-			User:     mysqlUser,
-			DBName:   mysqlDb,
+			Host:           mysqlHost,
+			Port:           mysqlPort,
+			Password:       mysqlPassword,
+			User:           mysqlUser,
+			DBName:         mysqlDb,
+			ReadReplicaDSN: mysqlReplica,
+			TLS:            utils.GetEnv("MYSQL_TLS", ""),
+			Pool:           pool,
 		}, nil
 	}
 
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -26,8 +27,18 @@ type Account struct {
 	AccountIndex *int `json:"account_index,omitempty"`
 	// PrivateKey holds the value of the "private_key" field.
 	PrivateKey *string `json:"private_key,omitempty"`
+	// Seed holds the value of the "seed" field.
+	Seed *string `json:"seed,omitempty"`
+	// SeedIndex holds the value of the "seed_index" field.
+	SeedIndex *int `json:"seed_index,omitempty"`
 	// Work holds the value of the "work" field.
 	Work bool `json:"work,omitempty"`
+	// WatchOnly holds the value of the "watch_only" field.
+	WatchOnly bool `json:"watch_only,omitempty"`
+	// Label holds the value of the "label" field.
+	Label *string `json:"label,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -41,9 +52,13 @@ type AccountEdges struct {
 	Wallet *Wallet `json:"wallet,omitempty"`
 	// Blocks holds the value of the blocks edge.
 	Blocks []*Block `json:"blocks,omitempty"`
+	// BalanceSnapshots holds the value of the balance_snapshots edge.
+	BalanceSnapshots []*BalanceSnapshot `json:"balance_snapshots,omitempty"`
+	// RepresentativeHistory holds the value of the representative_history edge.
+	RepresentativeHistory []*RepresentativeHistory `json:"representative_history,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // WalletOrErr returns the Wallet value or an error if the edge
@@ -68,16 +83,36 @@ func (e AccountEdges) BlocksOrErr() ([]*Block, error) {
 	return nil, &NotLoadedError{edge: "blocks"}
 }
 
+// BalanceSnapshotsOrErr returns the BalanceSnapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) BalanceSnapshotsOrErr() ([]*BalanceSnapshot, error) {
+	if e.loadedTypes[2] {
+		return e.BalanceSnapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "balance_snapshots"}
+}
+
+// RepresentativeHistoryOrErr returns the RepresentativeHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) RepresentativeHistoryOrErr() ([]*RepresentativeHistory, error) {
+	if e.loadedTypes[3] {
+		return e.RepresentativeHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "representative_history"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Account) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case account.FieldWork:
+		case account.FieldMetadata:
+			values[i] = new([]byte)
+		case account.FieldWork, account.FieldWatchOnly:
 			values[i] = new(sql.NullBool)
-		case account.FieldAccountIndex:
+		case account.FieldAccountIndex, account.FieldSeedIndex:
 			values[i] = new(sql.NullInt64)
-		case account.FieldAddress, account.FieldPrivateKey:
+		case account.FieldAddress, account.FieldPrivateKey, account.FieldSeed, account.FieldLabel:
 			values[i] = new(sql.NullString)
 		case account.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -130,12 +165,47 @@ func (a *Account) assignValues(columns []string, values []interface{}) error {
 				a.PrivateKey = new(string)
 				*a.PrivateKey = value.String
 			}
+		case account.FieldSeed:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seed", values[i])
+			} else if value.Valid {
+				a.Seed = new(string)
+				*a.Seed = value.String
+			}
+		case account.FieldSeedIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seed_index", values[i])
+			} else if value.Valid {
+				a.SeedIndex = new(int)
+				*a.SeedIndex = int(value.Int64)
+			}
 		case account.FieldWork:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field work", values[i])
 			} else if value.Valid {
 				a.Work = value.Bool
 			}
+		case account.FieldWatchOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field watch_only", values[i])
+			} else if value.Valid {
+				a.WatchOnly = value.Bool
+			}
+		case account.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				a.Label = new(string)
+				*a.Label = value.String
+			}
+		case account.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
 		case account.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -157,6 +227,16 @@ func (a *Account) QueryBlocks() *BlockQuery {
 	return (&AccountClient{config: a.config}).QueryBlocks(a)
 }
 
+// QueryBalanceSnapshots queries the "balance_snapshots" edge of the Account entity.
+func (a *Account) QueryBalanceSnapshots() *BalanceSnapshotQuery {
+	return (&AccountClient{config: a.config}).QueryBalanceSnapshots(a)
+}
+
+// QueryRepresentativeHistory queries the "representative_history" edge of the Account entity.
+func (a *Account) QueryRepresentativeHistory() *RepresentativeHistoryQuery {
+	return (&AccountClient{config: a.config}).QueryRepresentativeHistory(a)
+}
+
 // Update returns a builder for updating this Account.
 // Note that you need to call Account.Unwrap() before calling this method if this Account
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -196,9 +276,30 @@ func (a *Account) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := a.Seed; v != nil {
+		builder.WriteString("seed=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := a.SeedIndex; v != nil {
+		builder.WriteString("seed_index=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("work=")
 	builder.WriteString(fmt.Sprintf("%v", a.Work))
 	builder.WriteString(", ")
+	builder.WriteString("watch_only=")
+	builder.WriteString(fmt.Sprintf("%v", a.WatchOnly))
+	builder.WriteString(", ")
+	if v := a.Label; v != nil {
+		builder.WriteString("label=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", a.Metadata))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
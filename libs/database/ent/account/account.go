@@ -21,14 +21,28 @@ const (
 	FieldAccountIndex = "account_index"
 	// FieldPrivateKey holds the string denoting the private_key field in the database.
 	FieldPrivateKey = "private_key"
+	// FieldSeed holds the string denoting the seed field in the database.
+	FieldSeed = "seed"
+	// FieldSeedIndex holds the string denoting the seed_index field in the database.
+	FieldSeedIndex = "seed_index"
 	// FieldWork holds the string denoting the work field in the database.
 	FieldWork = "work"
+	// FieldWatchOnly holds the string denoting the watch_only field in the database.
+	FieldWatchOnly = "watch_only"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "label"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeWallet holds the string denoting the wallet edge name in mutations.
 	EdgeWallet = "wallet"
 	// EdgeBlocks holds the string denoting the blocks edge name in mutations.
 	EdgeBlocks = "blocks"
+	// EdgeBalanceSnapshots holds the string denoting the balance_snapshots edge name in mutations.
+	EdgeBalanceSnapshots = "balance_snapshots"
+	// EdgeRepresentativeHistory holds the string denoting the representative_history edge name in mutations.
+	EdgeRepresentativeHistory = "representative_history"
 	// Table holds the table name of the account in the database.
 	Table = "accounts"
 	// WalletTable is the table that holds the wallet relation/edge.
@@ -45,6 +59,20 @@ const (
 	BlocksInverseTable = "blocks"
 	// BlocksColumn is the table column denoting the blocks relation/edge.
 	BlocksColumn = "account_id"
+	// BalanceSnapshotsTable is the table that holds the balance_snapshots relation/edge.
+	BalanceSnapshotsTable = "balance_snapshots"
+	// BalanceSnapshotsInverseTable is the table name for the BalanceSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "balancesnapshot" package.
+	BalanceSnapshotsInverseTable = "balance_snapshots"
+	// BalanceSnapshotsColumn is the table column denoting the balance_snapshots relation/edge.
+	BalanceSnapshotsColumn = "account_id"
+	// RepresentativeHistoryTable is the table that holds the representative_history relation/edge.
+	RepresentativeHistoryTable = "representative_history"
+	// RepresentativeHistoryInverseTable is the table name for the RepresentativeHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "representativehistory" package.
+	RepresentativeHistoryInverseTable = "representative_history"
+	// RepresentativeHistoryColumn is the table column denoting the representative_history relation/edge.
+	RepresentativeHistoryColumn = "account_id"
 )
 
 // Columns holds all SQL columns for account fields.
@@ -54,7 +82,12 @@ var Columns = []string{
 	FieldAddress,
 	FieldAccountIndex,
 	FieldPrivateKey,
+	FieldSeed,
+	FieldSeedIndex,
 	FieldWork,
+	FieldWatchOnly,
+	FieldLabel,
+	FieldMetadata,
 	FieldCreatedAt,
 }
 
@@ -73,8 +106,14 @@ var (
 	AddressValidator func(string) error
 	// PrivateKeyValidator is a validator for the "private_key" field. It is called by the builders before save.
 	PrivateKeyValidator func(string) error
+	// SeedValidator is a validator for the "seed" field. It is called by the builders before save.
+	SeedValidator func(string) error
 	// DefaultWork holds the default value on creation for the "work" field.
 	DefaultWork bool
+	// DefaultWatchOnly holds the default value on creation for the "watch_only" field.
+	DefaultWatchOnly bool
+	// LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	LabelValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
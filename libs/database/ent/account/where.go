@@ -110,6 +110,20 @@ func PrivateKey(v string) predicate.Account {
 	})
 }
 
+// Seed applies equality check predicate on the "seed" field. It's identical to SeedEQ.
+func Seed(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeed), v))
+	})
+}
+
+// SeedIndex applies equality check predicate on the "seed_index" field. It's identical to SeedIndexEQ.
+func SeedIndex(v int) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeedIndex), v))
+	})
+}
+
 // Work applies equality check predicate on the "work" field. It's identical to WorkEQ.
 func Work(v bool) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
@@ -117,6 +131,13 @@ func Work(v bool) predicate.Account {
 	})
 }
 
+// WatchOnly applies equality check predicate on the "watch_only" field. It's identical to WatchOnlyEQ.
+func WatchOnly(v bool) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWatchOnly), v))
+	})
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
@@ -450,6 +471,197 @@ func PrivateKeyContainsFold(v string) predicate.Account {
 	})
 }
 
+// SeedEQ applies the EQ predicate on the "seed" field.
+func SeedEQ(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeed), v))
+	})
+}
+
+// SeedNEQ applies the NEQ predicate on the "seed" field.
+func SeedNEQ(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSeed), v))
+	})
+}
+
+// SeedIn applies the In predicate on the "seed" field.
+func SeedIn(vs ...string) predicate.Account {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldSeed), v...))
+	})
+}
+
+// SeedNotIn applies the NotIn predicate on the "seed" field.
+func SeedNotIn(vs ...string) predicate.Account {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldSeed), v...))
+	})
+}
+
+// SeedGT applies the GT predicate on the "seed" field.
+func SeedGT(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSeed), v))
+	})
+}
+
+// SeedGTE applies the GTE predicate on the "seed" field.
+func SeedGTE(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSeed), v))
+	})
+}
+
+// SeedLT applies the LT predicate on the "seed" field.
+func SeedLT(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSeed), v))
+	})
+}
+
+// SeedLTE applies the LTE predicate on the "seed" field.
+func SeedLTE(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSeed), v))
+	})
+}
+
+// SeedContains applies the Contains predicate on the "seed" field.
+func SeedContains(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSeed), v))
+	})
+}
+
+// SeedHasPrefix applies the HasPrefix predicate on the "seed" field.
+func SeedHasPrefix(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSeed), v))
+	})
+}
+
+// SeedHasSuffix applies the HasSuffix predicate on the "seed" field.
+func SeedHasSuffix(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSeed), v))
+	})
+}
+
+// SeedIsNil applies the IsNil predicate on the "seed" field.
+func SeedIsNil() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSeed)))
+	})
+}
+
+// SeedNotNil applies the NotNil predicate on the "seed" field.
+func SeedNotNil() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSeed)))
+	})
+}
+
+// SeedEqualFold applies the EqualFold predicate on the "seed" field.
+func SeedEqualFold(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSeed), v))
+	})
+}
+
+// SeedContainsFold applies the ContainsFold predicate on the "seed" field.
+func SeedContainsFold(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSeed), v))
+	})
+}
+
+// SeedIndexEQ applies the EQ predicate on the "seed_index" field.
+func SeedIndexEQ(v int) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeedIndex), v))
+	})
+}
+
+// SeedIndexNEQ applies the NEQ predicate on the "seed_index" field.
+func SeedIndexNEQ(v int) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSeedIndex), v))
+	})
+}
+
+// SeedIndexIn applies the In predicate on the "seed_index" field.
+func SeedIndexIn(vs ...int) predicate.Account {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldSeedIndex), v...))
+	})
+}
+
+// SeedIndexNotIn applies the NotIn predicate on the "seed_index" field.
+func SeedIndexNotIn(vs ...int) predicate.Account {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldSeedIndex), v...))
+	})
+}
+
+// SeedIndexGT applies the GT predicate on the "seed_index" field.
+func SeedIndexGT(v int) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSeedIndex), v))
+	})
+}
+
+// SeedIndexGTE applies the GTE predicate on the "seed_index" field.
+func SeedIndexGTE(v int) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSeedIndex), v))
+	})
+}
+
+// SeedIndexLT applies the LT predicate on the "seed_index" field.
+func SeedIndexLT(v int) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSeedIndex), v))
+	})
+}
+
+// SeedIndexLTE applies the LTE predicate on the "seed_index" field.
+func SeedIndexLTE(v int) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSeedIndex), v))
+	})
+}
+
+// SeedIndexIsNil applies the IsNil predicate on the "seed_index" field.
+func SeedIndexIsNil() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSeedIndex)))
+	})
+}
+
+// SeedIndexNotNil applies the NotNil predicate on the "seed_index" field.
+func SeedIndexNotNil() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSeedIndex)))
+	})
+}
+
 // WorkEQ applies the EQ predicate on the "work" field.
 func WorkEQ(v bool) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
@@ -464,6 +676,147 @@ func WorkNEQ(v bool) predicate.Account {
 	})
 }
 
+// WatchOnlyEQ applies the EQ predicate on the "watch_only" field.
+func WatchOnlyEQ(v bool) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWatchOnly), v))
+	})
+}
+
+// WatchOnlyNEQ applies the NEQ predicate on the "watch_only" field.
+func WatchOnlyNEQ(v bool) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWatchOnly), v))
+	})
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLabel), v))
+	})
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLabel), v))
+	})
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.Account {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLabel), v...))
+	})
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.Account {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLabel), v...))
+	})
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLabel), v))
+	})
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLabel), v))
+	})
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLabel), v))
+	})
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLabel), v))
+	})
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLabel), v))
+	})
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLabel), v))
+	})
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLabel), v))
+	})
+}
+
+// LabelIsNil applies the IsNil predicate on the "label" field.
+func LabelIsNil() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLabel)))
+	})
+}
+
+// LabelNotNil applies the NotNil predicate on the "label" field.
+func LabelNotNil() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLabel)))
+	})
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLabel), v))
+	})
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLabel), v))
+	})
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMetadata)))
+	})
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMetadata)))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
@@ -584,6 +937,62 @@ func HasBlocksWith(preds ...predicate.Block) predicate.Account {
 	})
 }
 
+// HasBalanceSnapshots applies the HasEdge predicate on the "balance_snapshots" edge.
+func HasBalanceSnapshots() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BalanceSnapshotsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BalanceSnapshotsTable, BalanceSnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBalanceSnapshotsWith applies the HasEdge predicate on the "balance_snapshots" edge with a given conditions (other predicates).
+func HasBalanceSnapshotsWith(preds ...predicate.BalanceSnapshot) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BalanceSnapshotsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BalanceSnapshotsTable, BalanceSnapshotsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRepresentativeHistory applies the HasEdge predicate on the "representative_history" edge.
+func HasRepresentativeHistory() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RepresentativeHistoryTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RepresentativeHistoryTable, RepresentativeHistoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRepresentativeHistoryWith applies the HasEdge predicate on the "representative_history" edge with a given conditions (other predicates).
+func HasRepresentativeHistoryWith(preds ...predicate.RepresentativeHistory) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(RepresentativeHistoryInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RepresentativeHistoryTable, RepresentativeHistoryColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Account) predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
 	"github.com/google/uuid"
 )
@@ -63,6 +65,34 @@ func (ac *AccountCreate) SetNillablePrivateKey(s *string) *AccountCreate {
 	return ac
 }
 
+// SetSeed sets the "seed" field.
+func (ac *AccountCreate) SetSeed(s string) *AccountCreate {
+	ac.mutation.SetSeed(s)
+	return ac
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableSeed(s *string) *AccountCreate {
+	if s != nil {
+		ac.SetSeed(*s)
+	}
+	return ac
+}
+
+// SetSeedIndex sets the "seed_index" field.
+func (ac *AccountCreate) SetSeedIndex(i int) *AccountCreate {
+	ac.mutation.SetSeedIndex(i)
+	return ac
+}
+
+// SetNillableSeedIndex sets the "seed_index" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableSeedIndex(i *int) *AccountCreate {
+	if i != nil {
+		ac.SetSeedIndex(*i)
+	}
+	return ac
+}
+
 // SetWork sets the "work" field.
 func (ac *AccountCreate) SetWork(b bool) *AccountCreate {
 	ac.mutation.SetWork(b)
@@ -77,6 +107,40 @@ func (ac *AccountCreate) SetNillableWork(b *bool) *AccountCreate {
 	return ac
 }
 
+// SetWatchOnly sets the "watch_only" field.
+func (ac *AccountCreate) SetWatchOnly(b bool) *AccountCreate {
+	ac.mutation.SetWatchOnly(b)
+	return ac
+}
+
+// SetNillableWatchOnly sets the "watch_only" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableWatchOnly(b *bool) *AccountCreate {
+	if b != nil {
+		ac.SetWatchOnly(*b)
+	}
+	return ac
+}
+
+// SetLabel sets the "label" field.
+func (ac *AccountCreate) SetLabel(s string) *AccountCreate {
+	ac.mutation.SetLabel(s)
+	return ac
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (ac *AccountCreate) SetNillableLabel(s *string) *AccountCreate {
+	if s != nil {
+		ac.SetLabel(*s)
+	}
+	return ac
+}
+
+// SetMetadata sets the "metadata" field.
+func (ac *AccountCreate) SetMetadata(m map[string]string) *AccountCreate {
+	ac.mutation.SetMetadata(m)
+	return ac
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ac *AccountCreate) SetCreatedAt(t time.Time) *AccountCreate {
 	ac.mutation.SetCreatedAt(t)
@@ -125,6 +189,36 @@ func (ac *AccountCreate) AddBlocks(b ...*Block) *AccountCreate {
 	return ac.AddBlockIDs(ids...)
 }
 
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the BalanceSnapshot entity by IDs.
+func (ac *AccountCreate) AddBalanceSnapshotIDs(ids ...uuid.UUID) *AccountCreate {
+	ac.mutation.AddBalanceSnapshotIDs(ids...)
+	return ac
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the BalanceSnapshot entity.
+func (ac *AccountCreate) AddBalanceSnapshots(b ...*BalanceSnapshot) *AccountCreate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ac.AddBalanceSnapshotIDs(ids...)
+}
+
+// AddRepresentativeHistoryIDs adds the "representative_history" edge to the RepresentativeHistory entity by IDs.
+func (ac *AccountCreate) AddRepresentativeHistoryIDs(ids ...uuid.UUID) *AccountCreate {
+	ac.mutation.AddRepresentativeHistoryIDs(ids...)
+	return ac
+}
+
+// AddRepresentativeHistory adds the "representative_history" edges to the RepresentativeHistory entity.
+func (ac *AccountCreate) AddRepresentativeHistory(r ...*RepresentativeHistory) *AccountCreate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ac.AddRepresentativeHistoryIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (ac *AccountCreate) Mutation() *AccountMutation {
 	return ac.mutation
@@ -206,6 +300,10 @@ func (ac *AccountCreate) defaults() {
 		v := account.DefaultWork
 		ac.mutation.SetWork(v)
 	}
+	if _, ok := ac.mutation.WatchOnly(); !ok {
+		v := account.DefaultWatchOnly
+		ac.mutation.SetWatchOnly(v)
+	}
 	if _, ok := ac.mutation.CreatedAt(); !ok {
 		v := account.DefaultCreatedAt()
 		ac.mutation.SetCreatedAt(v)
@@ -234,9 +332,22 @@ func (ac *AccountCreate) check() error {
 			return &ValidationError{Name: "private_key", err: fmt.Errorf(`ent: validator failed for field "Account.private_key": %w`, err)}
 		}
 	}
+	if v, ok := ac.mutation.Seed(); ok {
+		if err := account.SeedValidator(v); err != nil {
+			return &ValidationError{Name: "seed", err: fmt.Errorf(`ent: validator failed for field "Account.seed": %w`, err)}
+		}
+	}
 	if _, ok := ac.mutation.Work(); !ok {
 		return &ValidationError{Name: "work", err: errors.New(`ent: missing required field "Account.work"`)}
 	}
+	if _, ok := ac.mutation.WatchOnly(); !ok {
+		return &ValidationError{Name: "watch_only", err: errors.New(`ent: missing required field "Account.watch_only"`)}
+	}
+	if v, ok := ac.mutation.Label(); ok {
+		if err := account.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "Account.label": %w`, err)}
+		}
+	}
 	if _, ok := ac.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Account.created_at"`)}
 	}
@@ -303,6 +414,22 @@ func (ac *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		})
 		_node.PrivateKey = &value
 	}
+	if value, ok := ac.mutation.Seed(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: account.FieldSeed,
+		})
+		_node.Seed = &value
+	}
+	if value, ok := ac.mutation.SeedIndex(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: account.FieldSeedIndex,
+		})
+		_node.SeedIndex = &value
+	}
 	if value, ok := ac.mutation.Work(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -311,6 +438,30 @@ func (ac *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		})
 		_node.Work = value
 	}
+	if value, ok := ac.mutation.WatchOnly(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: account.FieldWatchOnly,
+		})
+		_node.WatchOnly = value
+	}
+	if value, ok := ac.mutation.Label(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: account.FieldLabel,
+		})
+		_node.Label = &value
+	}
+	if value, ok := ac.mutation.Metadata(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: account.FieldMetadata,
+		})
+		_node.Metadata = value
+	}
 	if value, ok := ac.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -358,6 +509,44 @@ func (ac *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.RepresentativeHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RepresentativeHistoryTable,
+			Columns: []string{account.RepresentativeHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: representativehistory.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,8 +12,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
 	"github.com/google/uuid"
 )
@@ -21,14 +23,16 @@ import (
 // AccountQuery is the builder for querying Account entities.
 type AccountQuery struct {
 	config
-	limit      *int
-	offset     *int
-	unique     *bool
-	order      []OrderFunc
-	fields     []string
-	predicates []predicate.Account
-	withWallet *WalletQuery
-	withBlocks *BlockQuery
+	limit                     *int
+	offset                    *int
+	unique                    *bool
+	order                     []OrderFunc
+	fields                    []string
+	predicates                []predicate.Account
+	withWallet                *WalletQuery
+	withBlocks                *BlockQuery
+	withBalanceSnapshots      *BalanceSnapshotQuery
+	withRepresentativeHistory *RepresentativeHistoryQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -109,6 +113,50 @@ func (aq *AccountQuery) QueryBlocks() *BlockQuery {
 	return query
 }
 
+// QueryBalanceSnapshots chains the current query on the "balance_snapshots" edge.
+func (aq *AccountQuery) QueryBalanceSnapshots() *BalanceSnapshotQuery {
+	query := &BalanceSnapshotQuery{config: aq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(balancesnapshot.Table, balancesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BalanceSnapshotsTable, account.BalanceSnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRepresentativeHistory chains the current query on the "representative_history" edge.
+func (aq *AccountQuery) QueryRepresentativeHistory() *RepresentativeHistoryQuery {
+	query := &RepresentativeHistoryQuery{config: aq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(representativehistory.Table, representativehistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.RepresentativeHistoryTable, account.RepresentativeHistoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Account entity from the query.
 // Returns a *NotFoundError when no Account was found.
 func (aq *AccountQuery) First(ctx context.Context) (*Account, error) {
@@ -285,13 +333,15 @@ func (aq *AccountQuery) Clone() *AccountQuery {
 		return nil
 	}
 	return &AccountQuery{
-		config:     aq.config,
-		limit:      aq.limit,
-		offset:     aq.offset,
-		order:      append([]OrderFunc{}, aq.order...),
-		predicates: append([]predicate.Account{}, aq.predicates...),
-		withWallet: aq.withWallet.Clone(),
-		withBlocks: aq.withBlocks.Clone(),
+		config:                    aq.config,
+		limit:                     aq.limit,
+		offset:                    aq.offset,
+		order:                     append([]OrderFunc{}, aq.order...),
+		predicates:                append([]predicate.Account{}, aq.predicates...),
+		withWallet:                aq.withWallet.Clone(),
+		withBlocks:                aq.withBlocks.Clone(),
+		withBalanceSnapshots:      aq.withBalanceSnapshots.Clone(),
+		withRepresentativeHistory: aq.withRepresentativeHistory.Clone(),
 		// clone intermediate query.
 		sql:    aq.sql.Clone(),
 		path:   aq.path,
@@ -321,6 +371,28 @@ func (aq *AccountQuery) WithBlocks(opts ...func(*BlockQuery)) *AccountQuery {
 	return aq
 }
 
+// WithBalanceSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "balance_snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithBalanceSnapshots(opts ...func(*BalanceSnapshotQuery)) *AccountQuery {
+	query := &BalanceSnapshotQuery{config: aq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withBalanceSnapshots = query
+	return aq
+}
+
+// WithRepresentativeHistory tells the query-builder to eager-load the nodes that are connected to
+// the "representative_history" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AccountQuery) WithRepresentativeHistory(opts ...func(*RepresentativeHistoryQuery)) *AccountQuery {
+	query := &RepresentativeHistoryQuery{config: aq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withRepresentativeHistory = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -389,9 +461,11 @@ func (aq *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 	var (
 		nodes       = []*Account{}
 		_spec       = aq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			aq.withWallet != nil,
 			aq.withBlocks != nil,
+			aq.withBalanceSnapshots != nil,
+			aq.withRepresentativeHistory != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -425,6 +499,22 @@ func (aq *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 			return nil, err
 		}
 	}
+	if query := aq.withBalanceSnapshots; query != nil {
+		if err := aq.loadBalanceSnapshots(ctx, query, nodes,
+			func(n *Account) { n.Edges.BalanceSnapshots = []*BalanceSnapshot{} },
+			func(n *Account, e *BalanceSnapshot) { n.Edges.BalanceSnapshots = append(n.Edges.BalanceSnapshots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withRepresentativeHistory; query != nil {
+		if err := aq.loadRepresentativeHistory(ctx, query, nodes,
+			func(n *Account) { n.Edges.RepresentativeHistory = []*RepresentativeHistory{} },
+			func(n *Account, e *RepresentativeHistory) {
+				n.Edges.RepresentativeHistory = append(n.Edges.RepresentativeHistory, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -484,6 +574,60 @@ func (aq *AccountQuery) loadBlocks(ctx context.Context, query *BlockQuery, nodes
 	}
 	return nil
 }
+func (aq *AccountQuery) loadBalanceSnapshots(ctx context.Context, query *BalanceSnapshotQuery, nodes []*Account, init func(*Account), assign func(*Account, *BalanceSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(account.BalanceSnapshotsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *AccountQuery) loadRepresentativeHistory(ctx context.Context, query *RepresentativeHistoryQuery, nodes []*Account, init func(*Account), assign func(*Account, *RepresentativeHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(account.RepresentativeHistoryColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (aq *AccountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
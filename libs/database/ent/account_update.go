@@ -11,8 +11,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
 	"github.com/google/uuid"
 )
@@ -89,6 +91,53 @@ func (au *AccountUpdate) ClearPrivateKey() *AccountUpdate {
 	return au
 }
 
+// SetSeed sets the "seed" field.
+func (au *AccountUpdate) SetSeed(s string) *AccountUpdate {
+	au.mutation.SetSeed(s)
+	return au
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableSeed(s *string) *AccountUpdate {
+	if s != nil {
+		au.SetSeed(*s)
+	}
+	return au
+}
+
+// ClearSeed clears the value of the "seed" field.
+func (au *AccountUpdate) ClearSeed() *AccountUpdate {
+	au.mutation.ClearSeed()
+	return au
+}
+
+// SetSeedIndex sets the "seed_index" field.
+func (au *AccountUpdate) SetSeedIndex(i int) *AccountUpdate {
+	au.mutation.ResetSeedIndex()
+	au.mutation.SetSeedIndex(i)
+	return au
+}
+
+// SetNillableSeedIndex sets the "seed_index" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableSeedIndex(i *int) *AccountUpdate {
+	if i != nil {
+		au.SetSeedIndex(*i)
+	}
+	return au
+}
+
+// AddSeedIndex adds i to the "seed_index" field.
+func (au *AccountUpdate) AddSeedIndex(i int) *AccountUpdate {
+	au.mutation.AddSeedIndex(i)
+	return au
+}
+
+// ClearSeedIndex clears the value of the "seed_index" field.
+func (au *AccountUpdate) ClearSeedIndex() *AccountUpdate {
+	au.mutation.ClearSeedIndex()
+	return au
+}
+
 // SetWork sets the "work" field.
 func (au *AccountUpdate) SetWork(b bool) *AccountUpdate {
 	au.mutation.SetWork(b)
@@ -103,6 +152,52 @@ func (au *AccountUpdate) SetNillableWork(b *bool) *AccountUpdate {
 	return au
 }
 
+// SetWatchOnly sets the "watch_only" field.
+func (au *AccountUpdate) SetWatchOnly(b bool) *AccountUpdate {
+	au.mutation.SetWatchOnly(b)
+	return au
+}
+
+// SetNillableWatchOnly sets the "watch_only" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableWatchOnly(b *bool) *AccountUpdate {
+	if b != nil {
+		au.SetWatchOnly(*b)
+	}
+	return au
+}
+
+// SetLabel sets the "label" field.
+func (au *AccountUpdate) SetLabel(s string) *AccountUpdate {
+	au.mutation.SetLabel(s)
+	return au
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (au *AccountUpdate) SetNillableLabel(s *string) *AccountUpdate {
+	if s != nil {
+		au.SetLabel(*s)
+	}
+	return au
+}
+
+// ClearLabel clears the value of the "label" field.
+func (au *AccountUpdate) ClearLabel() *AccountUpdate {
+	au.mutation.ClearLabel()
+	return au
+}
+
+// SetMetadata sets the "metadata" field.
+func (au *AccountUpdate) SetMetadata(m map[string]string) *AccountUpdate {
+	au.mutation.SetMetadata(m)
+	return au
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (au *AccountUpdate) ClearMetadata() *AccountUpdate {
+	au.mutation.ClearMetadata()
+	return au
+}
+
 // SetWallet sets the "wallet" edge to the Wallet entity.
 func (au *AccountUpdate) SetWallet(w *Wallet) *AccountUpdate {
 	return au.SetWalletID(w.ID)
@@ -123,6 +218,36 @@ func (au *AccountUpdate) AddBlocks(b ...*Block) *AccountUpdate {
 	return au.AddBlockIDs(ids...)
 }
 
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the BalanceSnapshot entity by IDs.
+func (au *AccountUpdate) AddBalanceSnapshotIDs(ids ...uuid.UUID) *AccountUpdate {
+	au.mutation.AddBalanceSnapshotIDs(ids...)
+	return au
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the BalanceSnapshot entity.
+func (au *AccountUpdate) AddBalanceSnapshots(b ...*BalanceSnapshot) *AccountUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return au.AddBalanceSnapshotIDs(ids...)
+}
+
+// AddRepresentativeHistoryIDs adds the "representative_history" edge to the RepresentativeHistory entity by IDs.
+func (au *AccountUpdate) AddRepresentativeHistoryIDs(ids ...uuid.UUID) *AccountUpdate {
+	au.mutation.AddRepresentativeHistoryIDs(ids...)
+	return au
+}
+
+// AddRepresentativeHistory adds the "representative_history" edges to the RepresentativeHistory entity.
+func (au *AccountUpdate) AddRepresentativeHistory(r ...*RepresentativeHistory) *AccountUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return au.AddRepresentativeHistoryIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (au *AccountUpdate) Mutation() *AccountMutation {
 	return au.mutation
@@ -155,6 +280,48 @@ func (au *AccountUpdate) RemoveBlocks(b ...*Block) *AccountUpdate {
 	return au.RemoveBlockIDs(ids...)
 }
 
+// ClearBalanceSnapshots clears all "balance_snapshots" edges to the BalanceSnapshot entity.
+func (au *AccountUpdate) ClearBalanceSnapshots() *AccountUpdate {
+	au.mutation.ClearBalanceSnapshots()
+	return au
+}
+
+// RemoveBalanceSnapshotIDs removes the "balance_snapshots" edge to BalanceSnapshot entities by IDs.
+func (au *AccountUpdate) RemoveBalanceSnapshotIDs(ids ...uuid.UUID) *AccountUpdate {
+	au.mutation.RemoveBalanceSnapshotIDs(ids...)
+	return au
+}
+
+// RemoveBalanceSnapshots removes "balance_snapshots" edges to BalanceSnapshot entities.
+func (au *AccountUpdate) RemoveBalanceSnapshots(b ...*BalanceSnapshot) *AccountUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return au.RemoveBalanceSnapshotIDs(ids...)
+}
+
+// ClearRepresentativeHistory clears all "representative_history" edges to the RepresentativeHistory entity.
+func (au *AccountUpdate) ClearRepresentativeHistory() *AccountUpdate {
+	au.mutation.ClearRepresentativeHistory()
+	return au
+}
+
+// RemoveRepresentativeHistoryIDs removes the "representative_history" edge to RepresentativeHistory entities by IDs.
+func (au *AccountUpdate) RemoveRepresentativeHistoryIDs(ids ...uuid.UUID) *AccountUpdate {
+	au.mutation.RemoveRepresentativeHistoryIDs(ids...)
+	return au
+}
+
+// RemoveRepresentativeHistory removes "representative_history" edges to RepresentativeHistory entities.
+func (au *AccountUpdate) RemoveRepresentativeHistory(r ...*RepresentativeHistory) *AccountUpdate {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return au.RemoveRepresentativeHistoryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *AccountUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -227,6 +394,16 @@ func (au *AccountUpdate) check() error {
 			return &ValidationError{Name: "private_key", err: fmt.Errorf(`ent: validator failed for field "Account.private_key": %w`, err)}
 		}
 	}
+	if v, ok := au.mutation.Seed(); ok {
+		if err := account.SeedValidator(v); err != nil {
+			return &ValidationError{Name: "seed", err: fmt.Errorf(`ent: validator failed for field "Account.seed": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Label(); ok {
+		if err := account.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "Account.label": %w`, err)}
+		}
+	}
 	if _, ok := au.mutation.WalletID(); au.mutation.WalletCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Account.wallet"`)
 	}
@@ -291,6 +468,39 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: account.FieldPrivateKey,
 		})
 	}
+	if value, ok := au.mutation.Seed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: account.FieldSeed,
+		})
+	}
+	if au.mutation.SeedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: account.FieldSeed,
+		})
+	}
+	if value, ok := au.mutation.SeedIndex(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: account.FieldSeedIndex,
+		})
+	}
+	if value, ok := au.mutation.AddedSeedIndex(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: account.FieldSeedIndex,
+		})
+	}
+	if au.mutation.SeedIndexCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: account.FieldSeedIndex,
+		})
+	}
 	if value, ok := au.mutation.Work(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -298,6 +508,39 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: account.FieldWork,
 		})
 	}
+	if value, ok := au.mutation.WatchOnly(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: account.FieldWatchOnly,
+		})
+	}
+	if value, ok := au.mutation.Label(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: account.FieldLabel,
+		})
+	}
+	if au.mutation.LabelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: account.FieldLabel,
+		})
+	}
+	if value, ok := au.mutation.Metadata(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: account.FieldMetadata,
+		})
+	}
+	if au.mutation.MetadataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: account.FieldMetadata,
+		})
+	}
 	if au.mutation.WalletCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -387,6 +630,114 @@ func (au *AccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedBalanceSnapshotsIDs(); len(nodes) > 0 && !au.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.RepresentativeHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RepresentativeHistoryTable,
+			Columns: []string{account.RepresentativeHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: representativehistory.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedRepresentativeHistoryIDs(); len(nodes) > 0 && !au.mutation.RepresentativeHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RepresentativeHistoryTable,
+			Columns: []string{account.RepresentativeHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: representativehistory.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RepresentativeHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RepresentativeHistoryTable,
+			Columns: []string{account.RepresentativeHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: representativehistory.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{account.Label}
@@ -465,6 +816,53 @@ func (auo *AccountUpdateOne) ClearPrivateKey() *AccountUpdateOne {
 	return auo
 }
 
+// SetSeed sets the "seed" field.
+func (auo *AccountUpdateOne) SetSeed(s string) *AccountUpdateOne {
+	auo.mutation.SetSeed(s)
+	return auo
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableSeed(s *string) *AccountUpdateOne {
+	if s != nil {
+		auo.SetSeed(*s)
+	}
+	return auo
+}
+
+// ClearSeed clears the value of the "seed" field.
+func (auo *AccountUpdateOne) ClearSeed() *AccountUpdateOne {
+	auo.mutation.ClearSeed()
+	return auo
+}
+
+// SetSeedIndex sets the "seed_index" field.
+func (auo *AccountUpdateOne) SetSeedIndex(i int) *AccountUpdateOne {
+	auo.mutation.ResetSeedIndex()
+	auo.mutation.SetSeedIndex(i)
+	return auo
+}
+
+// SetNillableSeedIndex sets the "seed_index" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableSeedIndex(i *int) *AccountUpdateOne {
+	if i != nil {
+		auo.SetSeedIndex(*i)
+	}
+	return auo
+}
+
+// AddSeedIndex adds i to the "seed_index" field.
+func (auo *AccountUpdateOne) AddSeedIndex(i int) *AccountUpdateOne {
+	auo.mutation.AddSeedIndex(i)
+	return auo
+}
+
+// ClearSeedIndex clears the value of the "seed_index" field.
+func (auo *AccountUpdateOne) ClearSeedIndex() *AccountUpdateOne {
+	auo.mutation.ClearSeedIndex()
+	return auo
+}
+
 // SetWork sets the "work" field.
 func (auo *AccountUpdateOne) SetWork(b bool) *AccountUpdateOne {
 	auo.mutation.SetWork(b)
@@ -479,6 +877,52 @@ func (auo *AccountUpdateOne) SetNillableWork(b *bool) *AccountUpdateOne {
 	return auo
 }
 
+// SetWatchOnly sets the "watch_only" field.
+func (auo *AccountUpdateOne) SetWatchOnly(b bool) *AccountUpdateOne {
+	auo.mutation.SetWatchOnly(b)
+	return auo
+}
+
+// SetNillableWatchOnly sets the "watch_only" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableWatchOnly(b *bool) *AccountUpdateOne {
+	if b != nil {
+		auo.SetWatchOnly(*b)
+	}
+	return auo
+}
+
+// SetLabel sets the "label" field.
+func (auo *AccountUpdateOne) SetLabel(s string) *AccountUpdateOne {
+	auo.mutation.SetLabel(s)
+	return auo
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (auo *AccountUpdateOne) SetNillableLabel(s *string) *AccountUpdateOne {
+	if s != nil {
+		auo.SetLabel(*s)
+	}
+	return auo
+}
+
+// ClearLabel clears the value of the "label" field.
+func (auo *AccountUpdateOne) ClearLabel() *AccountUpdateOne {
+	auo.mutation.ClearLabel()
+	return auo
+}
+
+// SetMetadata sets the "metadata" field.
+func (auo *AccountUpdateOne) SetMetadata(m map[string]string) *AccountUpdateOne {
+	auo.mutation.SetMetadata(m)
+	return auo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (auo *AccountUpdateOne) ClearMetadata() *AccountUpdateOne {
+	auo.mutation.ClearMetadata()
+	return auo
+}
+
 // SetWallet sets the "wallet" edge to the Wallet entity.
 func (auo *AccountUpdateOne) SetWallet(w *Wallet) *AccountUpdateOne {
 	return auo.SetWalletID(w.ID)
@@ -499,6 +943,36 @@ func (auo *AccountUpdateOne) AddBlocks(b ...*Block) *AccountUpdateOne {
 	return auo.AddBlockIDs(ids...)
 }
 
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the BalanceSnapshot entity by IDs.
+func (auo *AccountUpdateOne) AddBalanceSnapshotIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	auo.mutation.AddBalanceSnapshotIDs(ids...)
+	return auo
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the BalanceSnapshot entity.
+func (auo *AccountUpdateOne) AddBalanceSnapshots(b ...*BalanceSnapshot) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return auo.AddBalanceSnapshotIDs(ids...)
+}
+
+// AddRepresentativeHistoryIDs adds the "representative_history" edge to the RepresentativeHistory entity by IDs.
+func (auo *AccountUpdateOne) AddRepresentativeHistoryIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	auo.mutation.AddRepresentativeHistoryIDs(ids...)
+	return auo
+}
+
+// AddRepresentativeHistory adds the "representative_history" edges to the RepresentativeHistory entity.
+func (auo *AccountUpdateOne) AddRepresentativeHistory(r ...*RepresentativeHistory) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return auo.AddRepresentativeHistoryIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (auo *AccountUpdateOne) Mutation() *AccountMutation {
 	return auo.mutation
@@ -531,6 +1005,48 @@ func (auo *AccountUpdateOne) RemoveBlocks(b ...*Block) *AccountUpdateOne {
 	return auo.RemoveBlockIDs(ids...)
 }
 
+// ClearBalanceSnapshots clears all "balance_snapshots" edges to the BalanceSnapshot entity.
+func (auo *AccountUpdateOne) ClearBalanceSnapshots() *AccountUpdateOne {
+	auo.mutation.ClearBalanceSnapshots()
+	return auo
+}
+
+// RemoveBalanceSnapshotIDs removes the "balance_snapshots" edge to BalanceSnapshot entities by IDs.
+func (auo *AccountUpdateOne) RemoveBalanceSnapshotIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	auo.mutation.RemoveBalanceSnapshotIDs(ids...)
+	return auo
+}
+
+// RemoveBalanceSnapshots removes "balance_snapshots" edges to BalanceSnapshot entities.
+func (auo *AccountUpdateOne) RemoveBalanceSnapshots(b ...*BalanceSnapshot) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return auo.RemoveBalanceSnapshotIDs(ids...)
+}
+
+// ClearRepresentativeHistory clears all "representative_history" edges to the RepresentativeHistory entity.
+func (auo *AccountUpdateOne) ClearRepresentativeHistory() *AccountUpdateOne {
+	auo.mutation.ClearRepresentativeHistory()
+	return auo
+}
+
+// RemoveRepresentativeHistoryIDs removes the "representative_history" edge to RepresentativeHistory entities by IDs.
+func (auo *AccountUpdateOne) RemoveRepresentativeHistoryIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	auo.mutation.RemoveRepresentativeHistoryIDs(ids...)
+	return auo
+}
+
+// RemoveRepresentativeHistory removes "representative_history" edges to RepresentativeHistory entities.
+func (auo *AccountUpdateOne) RemoveRepresentativeHistory(r ...*RepresentativeHistory) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return auo.RemoveRepresentativeHistoryIDs(ids...)
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (auo *AccountUpdateOne) Select(field string, fields ...string) *AccountUpdateOne {
@@ -616,6 +1132,16 @@ func (auo *AccountUpdateOne) check() error {
 			return &ValidationError{Name: "private_key", err: fmt.Errorf(`ent: validator failed for field "Account.private_key": %w`, err)}
 		}
 	}
+	if v, ok := auo.mutation.Seed(); ok {
+		if err := account.SeedValidator(v); err != nil {
+			return &ValidationError{Name: "seed", err: fmt.Errorf(`ent: validator failed for field "Account.seed": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Label(); ok {
+		if err := account.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "Account.label": %w`, err)}
+		}
+	}
 	if _, ok := auo.mutation.WalletID(); auo.mutation.WalletCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Account.wallet"`)
 	}
@@ -697,6 +1223,39 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 			Column: account.FieldPrivateKey,
 		})
 	}
+	if value, ok := auo.mutation.Seed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: account.FieldSeed,
+		})
+	}
+	if auo.mutation.SeedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: account.FieldSeed,
+		})
+	}
+	if value, ok := auo.mutation.SeedIndex(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: account.FieldSeedIndex,
+		})
+	}
+	if value, ok := auo.mutation.AddedSeedIndex(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: account.FieldSeedIndex,
+		})
+	}
+	if auo.mutation.SeedIndexCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: account.FieldSeedIndex,
+		})
+	}
 	if value, ok := auo.mutation.Work(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -704,6 +1263,39 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 			Column: account.FieldWork,
 		})
 	}
+	if value, ok := auo.mutation.WatchOnly(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: account.FieldWatchOnly,
+		})
+	}
+	if value, ok := auo.mutation.Label(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: account.FieldLabel,
+		})
+	}
+	if auo.mutation.LabelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: account.FieldLabel,
+		})
+	}
+	if value, ok := auo.mutation.Metadata(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: account.FieldMetadata,
+		})
+	}
+	if auo.mutation.MetadataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: account.FieldMetadata,
+		})
+	}
 	if auo.mutation.WalletCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -793,6 +1385,114 @@ func (auo *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedBalanceSnapshotsIDs(); len(nodes) > 0 && !auo.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.RepresentativeHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RepresentativeHistoryTable,
+			Columns: []string{account.RepresentativeHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: representativehistory.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedRepresentativeHistoryIDs(); len(nodes) > 0 && !auo.mutation.RepresentativeHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RepresentativeHistoryTable,
+			Columns: []string{account.RepresentativeHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: representativehistory.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RepresentativeHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.RepresentativeHistoryTable,
+			Columns: []string{account.RepresentativeHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: representativehistory.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Account{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,539 @@
+// Code generated by ent, DO NOT EDIT.
+
+package apikey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// KeyHash applies equality check predicate on the "key_hash" field. It's identical to KeyHashEQ.
+func KeyHash(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKeyHash), v))
+	})
+}
+
+// Approver applies equality check predicate on the "approver" field. It's identical to ApproverEQ.
+func Approver(v bool) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldApprover), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
+// KeyHashEQ applies the EQ predicate on the "key_hash" field.
+func KeyHashEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashNEQ applies the NEQ predicate on the "key_hash" field.
+func KeyHashNEQ(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashIn applies the In predicate on the "key_hash" field.
+func KeyHashIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldKeyHash), v...))
+	})
+}
+
+// KeyHashNotIn applies the NotIn predicate on the "key_hash" field.
+func KeyHashNotIn(vs ...string) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldKeyHash), v...))
+	})
+}
+
+// KeyHashGT applies the GT predicate on the "key_hash" field.
+func KeyHashGT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashGTE applies the GTE predicate on the "key_hash" field.
+func KeyHashGTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashLT applies the LT predicate on the "key_hash" field.
+func KeyHashLT(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashLTE applies the LTE predicate on the "key_hash" field.
+func KeyHashLTE(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashContains applies the Contains predicate on the "key_hash" field.
+func KeyHashContains(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashHasPrefix applies the HasPrefix predicate on the "key_hash" field.
+func KeyHashHasPrefix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashHasSuffix applies the HasSuffix predicate on the "key_hash" field.
+func KeyHashHasSuffix(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashEqualFold applies the EqualFold predicate on the "key_hash" field.
+func KeyHashEqualFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldKeyHash), v))
+	})
+}
+
+// KeyHashContainsFold applies the ContainsFold predicate on the "key_hash" field.
+func KeyHashContainsFold(v string) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldKeyHash), v))
+	})
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v Scope) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldScope), v))
+	})
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v Scope) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldScope), v))
+	})
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...Scope) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldScope), v...))
+	})
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...Scope) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldScope), v...))
+	})
+}
+
+// ApproverEQ applies the EQ predicate on the "approver" field.
+func ApproverEQ(v bool) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldApprover), v))
+	})
+}
+
+// ApproverNEQ applies the NEQ predicate on the "approver" field.
+func ApproverNEQ(v bool) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldApprover), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLastUsedAt), v...))
+	})
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.ApiKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLastUsedAt), v...))
+	})
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastUsedAt), v))
+	})
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastUsedAt)))
+	})
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastUsedAt)))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ApiKey) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ApiKey) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ApiKey) predicate.ApiKey {
+	return predicate.ApiKey(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
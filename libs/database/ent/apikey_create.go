@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/apikey"
+	"github.com/google/uuid"
+)
+
+// ApiKeyCreate is the builder for creating a ApiKey entity.
+type ApiKeyCreate struct {
+	config
+	mutation *ApiKeyMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (akc *ApiKeyCreate) SetName(s string) *ApiKeyCreate {
+	akc.mutation.SetName(s)
+	return akc
+}
+
+// SetKeyHash sets the "key_hash" field.
+func (akc *ApiKeyCreate) SetKeyHash(s string) *ApiKeyCreate {
+	akc.mutation.SetKeyHash(s)
+	return akc
+}
+
+// SetScope sets the "scope" field.
+func (akc *ApiKeyCreate) SetScope(a apikey.Scope) *ApiKeyCreate {
+	akc.mutation.SetScope(a)
+	return akc
+}
+
+// SetApprover sets the "approver" field.
+func (akc *ApiKeyCreate) SetApprover(b bool) *ApiKeyCreate {
+	akc.mutation.SetApprover(b)
+	return akc
+}
+
+// SetNillableApprover sets the "approver" field if the given value is not nil.
+func (akc *ApiKeyCreate) SetNillableApprover(b *bool) *ApiKeyCreate {
+	if b != nil {
+		akc.SetApprover(*b)
+	}
+	return akc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (akc *ApiKeyCreate) SetCreatedAt(t time.Time) *ApiKeyCreate {
+	akc.mutation.SetCreatedAt(t)
+	return akc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (akc *ApiKeyCreate) SetNillableCreatedAt(t *time.Time) *ApiKeyCreate {
+	if t != nil {
+		akc.SetCreatedAt(*t)
+	}
+	return akc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (akc *ApiKeyCreate) SetLastUsedAt(t time.Time) *ApiKeyCreate {
+	akc.mutation.SetLastUsedAt(t)
+	return akc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (akc *ApiKeyCreate) SetNillableLastUsedAt(t *time.Time) *ApiKeyCreate {
+	if t != nil {
+		akc.SetLastUsedAt(*t)
+	}
+	return akc
+}
+
+// SetID sets the "id" field.
+func (akc *ApiKeyCreate) SetID(u uuid.UUID) *ApiKeyCreate {
+	akc.mutation.SetID(u)
+	return akc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (akc *ApiKeyCreate) SetNillableID(u *uuid.UUID) *ApiKeyCreate {
+	if u != nil {
+		akc.SetID(*u)
+	}
+	return akc
+}
+
+// Mutation returns the ApiKeyMutation object of the builder.
+func (akc *ApiKeyCreate) Mutation() *ApiKeyMutation {
+	return akc.mutation
+}
+
+// Save creates the ApiKey in the database.
+func (akc *ApiKeyCreate) Save(ctx context.Context) (*ApiKey, error) {
+	var (
+		err  error
+		node *ApiKey
+	)
+	akc.defaults()
+	if len(akc.hooks) == 0 {
+		if err = akc.check(); err != nil {
+			return nil, err
+		}
+		node, err = akc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ApiKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = akc.check(); err != nil {
+				return nil, err
+			}
+			akc.mutation = mutation
+			if node, err = akc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(akc.hooks) - 1; i >= 0; i-- {
+			if akc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = akc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, akc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*ApiKey)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from ApiKeyMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (akc *ApiKeyCreate) SaveX(ctx context.Context) *ApiKey {
+	v, err := akc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (akc *ApiKeyCreate) Exec(ctx context.Context) error {
+	_, err := akc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akc *ApiKeyCreate) ExecX(ctx context.Context) {
+	if err := akc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (akc *ApiKeyCreate) defaults() {
+	if _, ok := akc.mutation.Approver(); !ok {
+		v := apikey.DefaultApprover
+		akc.mutation.SetApprover(v)
+	}
+	if _, ok := akc.mutation.CreatedAt(); !ok {
+		v := apikey.DefaultCreatedAt()
+		akc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := akc.mutation.ID(); !ok {
+		v := apikey.DefaultID()
+		akc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (akc *ApiKeyCreate) check() error {
+	if _, ok := akc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ApiKey.name"`)}
+	}
+	if v, ok := akc.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ApiKey.name": %w`, err)}
+		}
+	}
+	if _, ok := akc.mutation.KeyHash(); !ok {
+		return &ValidationError{Name: "key_hash", err: errors.New(`ent: missing required field "ApiKey.key_hash"`)}
+	}
+	if v, ok := akc.mutation.KeyHash(); ok {
+		if err := apikey.KeyHashValidator(v); err != nil {
+			return &ValidationError{Name: "key_hash", err: fmt.Errorf(`ent: validator failed for field "ApiKey.key_hash": %w`, err)}
+		}
+	}
+	if _, ok := akc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "ApiKey.scope"`)}
+	}
+	if v, ok := akc.mutation.Scope(); ok {
+		if err := apikey.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "ApiKey.scope": %w`, err)}
+		}
+	}
+	if _, ok := akc.mutation.Approver(); !ok {
+		return &ValidationError{Name: "approver", err: errors.New(`ent: missing required field "ApiKey.approver"`)}
+	}
+	if _, ok := akc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ApiKey.created_at"`)}
+	}
+	return nil
+}
+
+func (akc *ApiKeyCreate) sqlSave(ctx context.Context) (*ApiKey, error) {
+	_node, _spec := akc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, akc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (akc *ApiKeyCreate) createSpec() (*ApiKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ApiKey{config: akc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: apikey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: apikey.FieldID,
+			},
+		}
+	)
+	if id, ok := akc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := akc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := akc.mutation.KeyHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: apikey.FieldKeyHash,
+		})
+		_node.KeyHash = value
+	}
+	if value, ok := akc.mutation.Scope(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: apikey.FieldScope,
+		})
+		_node.Scope = value
+	}
+	if value, ok := akc.mutation.Approver(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: apikey.FieldApprover,
+		})
+		_node.Approver = value
+	}
+	if value, ok := akc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := akc.mutation.LastUsedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: apikey.FieldLastUsedAt,
+		})
+		_node.LastUsedAt = &value
+	}
+	return _node, _spec
+}
+
+// ApiKeyCreateBulk is the builder for creating many ApiKey entities in bulk.
+type ApiKeyCreateBulk struct {
+	config
+	builders []*ApiKeyCreate
+}
+
+// Save creates the ApiKey entities in the database.
+func (akcb *ApiKeyCreateBulk) Save(ctx context.Context) ([]*ApiKey, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(akcb.builders))
+	nodes := make([]*ApiKey, len(akcb.builders))
+	mutators := make([]Mutator, len(akcb.builders))
+	for i := range akcb.builders {
+		func(i int, root context.Context) {
+			builder := akcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ApiKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, akcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, akcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, akcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (akcb *ApiKeyCreateBulk) SaveX(ctx context.Context) []*ApiKey {
+	v, err := akcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (akcb *ApiKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := akcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akcb *ApiKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := akcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/auditrecord"
+	"github.com/google/uuid"
+)
+
+// AuditRecord is the model entity for the AuditRecord schema.
+type AuditRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Wallet holds the value of the "wallet" field.
+	Wallet *string `json:"wallet,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// APIKeyID holds the value of the "api_key_id" field.
+	APIKeyID *uuid.UUID `json:"api_key_id,omitempty"`
+	// APIKeyName holds the value of the "api_key_name" field.
+	APIKeyName *string `json:"api_key_name,omitempty"`
+	// Params holds the value of the "params" field.
+	Params map[string]interface{} `json:"params,omitempty"`
+	// Status holds the value of the "status" field.
+	Status int `json:"status,omitempty"`
+	// ErrorCode holds the value of the "error_code" field.
+	ErrorCode *string `json:"error_code,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditRecord) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditrecord.FieldAPIKeyID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case auditrecord.FieldParams:
+			values[i] = new([]byte)
+		case auditrecord.FieldStatus:
+			values[i] = new(sql.NullInt64)
+		case auditrecord.FieldAction, auditrecord.FieldWallet, auditrecord.FieldIP, auditrecord.FieldAPIKeyName, auditrecord.FieldErrorCode:
+			values[i] = new(sql.NullString)
+		case auditrecord.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case auditrecord.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AuditRecord", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditRecord fields.
+func (ar *AuditRecord) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditrecord.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ar.ID = *value
+			}
+		case auditrecord.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ar.CreatedAt = value.Time
+			}
+		case auditrecord.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ar.Action = value.String
+			}
+		case auditrecord.FieldWallet:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet", values[i])
+			} else if value.Valid {
+				ar.Wallet = new(string)
+				*ar.Wallet = value.String
+			}
+		case auditrecord.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				ar.IP = value.String
+			}
+		case auditrecord.FieldAPIKeyID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_id", values[i])
+			} else if value.Valid {
+				ar.APIKeyID = new(uuid.UUID)
+				*ar.APIKeyID = *value.S.(*uuid.UUID)
+			}
+		case auditrecord.FieldAPIKeyName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_name", values[i])
+			} else if value.Valid {
+				ar.APIKeyName = new(string)
+				*ar.APIKeyName = value.String
+			}
+		case auditrecord.FieldParams:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field params", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ar.Params); err != nil {
+					return fmt.Errorf("unmarshal field params: %w", err)
+				}
+			}
+		case auditrecord.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ar.Status = int(value.Int64)
+			}
+		case auditrecord.FieldErrorCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_code", values[i])
+			} else if value.Valid {
+				ar.ErrorCode = new(string)
+				*ar.ErrorCode = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AuditRecord.
+// Note that you need to call AuditRecord.Unwrap() before calling this method if this AuditRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ar *AuditRecord) Update() *AuditRecordUpdateOne {
+	return (&AuditRecordClient{config: ar.config}).UpdateOne(ar)
+}
+
+// Unwrap unwraps the AuditRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ar *AuditRecord) Unwrap() *AuditRecord {
+	_tx, ok := ar.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditRecord is not a transactional entity")
+	}
+	ar.config.driver = _tx.drv
+	return ar
+}
+
+// String implements the fmt.Stringer.
+func (ar *AuditRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ar.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ar.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(ar.Action)
+	builder.WriteString(", ")
+	if v := ar.Wallet; v != nil {
+		builder.WriteString("wallet=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(ar.IP)
+	builder.WriteString(", ")
+	if v := ar.APIKeyID; v != nil {
+		builder.WriteString("api_key_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ar.APIKeyName; v != nil {
+		builder.WriteString("api_key_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("params=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Params))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Status))
+	builder.WriteString(", ")
+	if v := ar.ErrorCode; v != nil {
+		builder.WriteString("error_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditRecords is a parsable slice of AuditRecord.
+type AuditRecords []*AuditRecord
+
+func (ar AuditRecords) config(cfg config) {
+	for _i := range ar {
+		ar[_i].config = cfg
+	}
+}
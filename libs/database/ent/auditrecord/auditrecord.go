@@ -0,0 +1,77 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditrecord
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the auditrecord type in the database.
+	Label = "audit_record"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldWallet holds the string denoting the wallet field in the database.
+	FieldWallet = "wallet"
+	// FieldIP holds the string denoting the ip field in the database.
+	FieldIP = "ip"
+	// FieldAPIKeyID holds the string denoting the api_key_id field in the database.
+	FieldAPIKeyID = "api_key_id"
+	// FieldAPIKeyName holds the string denoting the api_key_name field in the database.
+	FieldAPIKeyName = "api_key_name"
+	// FieldParams holds the string denoting the params field in the database.
+	FieldParams = "params"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldErrorCode holds the string denoting the error_code field in the database.
+	FieldErrorCode = "error_code"
+	// Table holds the table name of the auditrecord in the database.
+	Table = "audit_records"
+)
+
+// Columns holds all SQL columns for auditrecord fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldAction,
+	FieldWallet,
+	FieldIP,
+	FieldAPIKeyID,
+	FieldAPIKeyName,
+	FieldParams,
+	FieldStatus,
+	FieldErrorCode,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	ActionValidator func(string) error
+	// WalletValidator is a validator for the "wallet" field. It is called by the builders before save.
+	WalletValidator func(string) error
+	// IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	IPValidator func(string) error
+	// APIKeyNameValidator is a validator for the "api_key_name" field. It is called by the builders before save.
+	APIKeyNameValidator func(string) error
+	// ErrorCodeValidator is a validator for the "error_code" field. It is called by the builders before save.
+	ErrorCodeValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
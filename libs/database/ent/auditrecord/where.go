@@ -0,0 +1,913 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditrecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAction), v))
+	})
+}
+
+// Wallet applies equality check predicate on the "wallet" field. It's identical to WalletEQ.
+func Wallet(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWallet), v))
+	})
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIP), v))
+	})
+}
+
+// APIKeyID applies equality check predicate on the "api_key_id" field. It's identical to APIKeyIDEQ.
+func APIKeyID(v uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAPIKeyID), v))
+	})
+}
+
+// APIKeyName applies equality check predicate on the "api_key_name" field. It's identical to APIKeyNameEQ.
+func APIKeyName(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAPIKeyName), v))
+	})
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// ErrorCode applies equality check predicate on the "error_code" field. It's identical to ErrorCodeEQ.
+func ErrorCode(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldErrorCode), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAction), v))
+	})
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAction), v))
+	})
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAction), v...))
+	})
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAction), v...))
+	})
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAction), v))
+	})
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAction), v))
+	})
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAction), v))
+	})
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAction), v))
+	})
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAction), v))
+	})
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAction), v))
+	})
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAction), v))
+	})
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAction), v))
+	})
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAction), v))
+	})
+}
+
+// WalletEQ applies the EQ predicate on the "wallet" field.
+func WalletEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWallet), v))
+	})
+}
+
+// WalletNEQ applies the NEQ predicate on the "wallet" field.
+func WalletNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWallet), v))
+	})
+}
+
+// WalletIn applies the In predicate on the "wallet" field.
+func WalletIn(vs ...string) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldWallet), v...))
+	})
+}
+
+// WalletNotIn applies the NotIn predicate on the "wallet" field.
+func WalletNotIn(vs ...string) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldWallet), v...))
+	})
+}
+
+// WalletGT applies the GT predicate on the "wallet" field.
+func WalletGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWallet), v))
+	})
+}
+
+// WalletGTE applies the GTE predicate on the "wallet" field.
+func WalletGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWallet), v))
+	})
+}
+
+// WalletLT applies the LT predicate on the "wallet" field.
+func WalletLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWallet), v))
+	})
+}
+
+// WalletLTE applies the LTE predicate on the "wallet" field.
+func WalletLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWallet), v))
+	})
+}
+
+// WalletContains applies the Contains predicate on the "wallet" field.
+func WalletContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldWallet), v))
+	})
+}
+
+// WalletHasPrefix applies the HasPrefix predicate on the "wallet" field.
+func WalletHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldWallet), v))
+	})
+}
+
+// WalletHasSuffix applies the HasSuffix predicate on the "wallet" field.
+func WalletHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldWallet), v))
+	})
+}
+
+// WalletIsNil applies the IsNil predicate on the "wallet" field.
+func WalletIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldWallet)))
+	})
+}
+
+// WalletNotNil applies the NotNil predicate on the "wallet" field.
+func WalletNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldWallet)))
+	})
+}
+
+// WalletEqualFold applies the EqualFold predicate on the "wallet" field.
+func WalletEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldWallet), v))
+	})
+}
+
+// WalletContainsFold applies the ContainsFold predicate on the "wallet" field.
+func WalletContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldWallet), v))
+	})
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIP), v))
+	})
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIP), v))
+	})
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldIP), v...))
+	})
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldIP), v...))
+	})
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIP), v))
+	})
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIP), v))
+	})
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIP), v))
+	})
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIP), v))
+	})
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldIP), v))
+	})
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldIP), v))
+	})
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldIP), v))
+	})
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldIP), v))
+	})
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldIP), v))
+	})
+}
+
+// APIKeyIDEQ applies the EQ predicate on the "api_key_id" field.
+func APIKeyIDEQ(v uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAPIKeyID), v))
+	})
+}
+
+// APIKeyIDNEQ applies the NEQ predicate on the "api_key_id" field.
+func APIKeyIDNEQ(v uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAPIKeyID), v))
+	})
+}
+
+// APIKeyIDIn applies the In predicate on the "api_key_id" field.
+func APIKeyIDIn(vs ...uuid.UUID) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAPIKeyID), v...))
+	})
+}
+
+// APIKeyIDNotIn applies the NotIn predicate on the "api_key_id" field.
+func APIKeyIDNotIn(vs ...uuid.UUID) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAPIKeyID), v...))
+	})
+}
+
+// APIKeyIDGT applies the GT predicate on the "api_key_id" field.
+func APIKeyIDGT(v uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAPIKeyID), v))
+	})
+}
+
+// APIKeyIDGTE applies the GTE predicate on the "api_key_id" field.
+func APIKeyIDGTE(v uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAPIKeyID), v))
+	})
+}
+
+// APIKeyIDLT applies the LT predicate on the "api_key_id" field.
+func APIKeyIDLT(v uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAPIKeyID), v))
+	})
+}
+
+// APIKeyIDLTE applies the LTE predicate on the "api_key_id" field.
+func APIKeyIDLTE(v uuid.UUID) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAPIKeyID), v))
+	})
+}
+
+// APIKeyIDIsNil applies the IsNil predicate on the "api_key_id" field.
+func APIKeyIDIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAPIKeyID)))
+	})
+}
+
+// APIKeyIDNotNil applies the NotNil predicate on the "api_key_id" field.
+func APIKeyIDNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAPIKeyID)))
+	})
+}
+
+// APIKeyNameEQ applies the EQ predicate on the "api_key_name" field.
+func APIKeyNameEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAPIKeyName), v))
+	})
+}
+
+// APIKeyNameNEQ applies the NEQ predicate on the "api_key_name" field.
+func APIKeyNameNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAPIKeyName), v))
+	})
+}
+
+// APIKeyNameIn applies the In predicate on the "api_key_name" field.
+func APIKeyNameIn(vs ...string) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAPIKeyName), v...))
+	})
+}
+
+// APIKeyNameNotIn applies the NotIn predicate on the "api_key_name" field.
+func APIKeyNameNotIn(vs ...string) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAPIKeyName), v...))
+	})
+}
+
+// APIKeyNameGT applies the GT predicate on the "api_key_name" field.
+func APIKeyNameGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAPIKeyName), v))
+	})
+}
+
+// APIKeyNameGTE applies the GTE predicate on the "api_key_name" field.
+func APIKeyNameGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAPIKeyName), v))
+	})
+}
+
+// APIKeyNameLT applies the LT predicate on the "api_key_name" field.
+func APIKeyNameLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAPIKeyName), v))
+	})
+}
+
+// APIKeyNameLTE applies the LTE predicate on the "api_key_name" field.
+func APIKeyNameLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAPIKeyName), v))
+	})
+}
+
+// APIKeyNameContains applies the Contains predicate on the "api_key_name" field.
+func APIKeyNameContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAPIKeyName), v))
+	})
+}
+
+// APIKeyNameHasPrefix applies the HasPrefix predicate on the "api_key_name" field.
+func APIKeyNameHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAPIKeyName), v))
+	})
+}
+
+// APIKeyNameHasSuffix applies the HasSuffix predicate on the "api_key_name" field.
+func APIKeyNameHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAPIKeyName), v))
+	})
+}
+
+// APIKeyNameIsNil applies the IsNil predicate on the "api_key_name" field.
+func APIKeyNameIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAPIKeyName)))
+	})
+}
+
+// APIKeyNameNotNil applies the NotNil predicate on the "api_key_name" field.
+func APIKeyNameNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAPIKeyName)))
+	})
+}
+
+// APIKeyNameEqualFold applies the EqualFold predicate on the "api_key_name" field.
+func APIKeyNameEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAPIKeyName), v))
+	})
+}
+
+// APIKeyNameContainsFold applies the ContainsFold predicate on the "api_key_name" field.
+func APIKeyNameContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAPIKeyName), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...int) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...int) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStatus), v))
+	})
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v int) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStatus), v))
+	})
+}
+
+// ErrorCodeEQ applies the EQ predicate on the "error_code" field.
+func ErrorCodeEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldErrorCode), v))
+	})
+}
+
+// ErrorCodeNEQ applies the NEQ predicate on the "error_code" field.
+func ErrorCodeNEQ(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldErrorCode), v))
+	})
+}
+
+// ErrorCodeIn applies the In predicate on the "error_code" field.
+func ErrorCodeIn(vs ...string) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldErrorCode), v...))
+	})
+}
+
+// ErrorCodeNotIn applies the NotIn predicate on the "error_code" field.
+func ErrorCodeNotIn(vs ...string) predicate.AuditRecord {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldErrorCode), v...))
+	})
+}
+
+// ErrorCodeGT applies the GT predicate on the "error_code" field.
+func ErrorCodeGT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldErrorCode), v))
+	})
+}
+
+// ErrorCodeGTE applies the GTE predicate on the "error_code" field.
+func ErrorCodeGTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldErrorCode), v))
+	})
+}
+
+// ErrorCodeLT applies the LT predicate on the "error_code" field.
+func ErrorCodeLT(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldErrorCode), v))
+	})
+}
+
+// ErrorCodeLTE applies the LTE predicate on the "error_code" field.
+func ErrorCodeLTE(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldErrorCode), v))
+	})
+}
+
+// ErrorCodeContains applies the Contains predicate on the "error_code" field.
+func ErrorCodeContains(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldErrorCode), v))
+	})
+}
+
+// ErrorCodeHasPrefix applies the HasPrefix predicate on the "error_code" field.
+func ErrorCodeHasPrefix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldErrorCode), v))
+	})
+}
+
+// ErrorCodeHasSuffix applies the HasSuffix predicate on the "error_code" field.
+func ErrorCodeHasSuffix(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldErrorCode), v))
+	})
+}
+
+// ErrorCodeIsNil applies the IsNil predicate on the "error_code" field.
+func ErrorCodeIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldErrorCode)))
+	})
+}
+
+// ErrorCodeNotNil applies the NotNil predicate on the "error_code" field.
+func ErrorCodeNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldErrorCode)))
+	})
+}
+
+// ErrorCodeEqualFold applies the EqualFold predicate on the "error_code" field.
+func ErrorCodeEqualFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldErrorCode), v))
+	})
+}
+
+// ErrorCodeContainsFold applies the ContainsFold predicate on the "error_code" field.
+func ErrorCodeContainsFold(v string) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldErrorCode), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditRecord) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditRecord) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditRecord) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/auditrecord"
+	"github.com/google/uuid"
+)
+
+// AuditRecordCreate is the builder for creating a AuditRecord entity.
+type AuditRecordCreate struct {
+	config
+	mutation *AuditRecordMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (arc *AuditRecordCreate) SetCreatedAt(t time.Time) *AuditRecordCreate {
+	arc.mutation.SetCreatedAt(t)
+	return arc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (arc *AuditRecordCreate) SetNillableCreatedAt(t *time.Time) *AuditRecordCreate {
+	if t != nil {
+		arc.SetCreatedAt(*t)
+	}
+	return arc
+}
+
+// SetAction sets the "action" field.
+func (arc *AuditRecordCreate) SetAction(s string) *AuditRecordCreate {
+	arc.mutation.SetAction(s)
+	return arc
+}
+
+// SetWallet sets the "wallet" field.
+func (arc *AuditRecordCreate) SetWallet(s string) *AuditRecordCreate {
+	arc.mutation.SetWallet(s)
+	return arc
+}
+
+// SetNillableWallet sets the "wallet" field if the given value is not nil.
+func (arc *AuditRecordCreate) SetNillableWallet(s *string) *AuditRecordCreate {
+	if s != nil {
+		arc.SetWallet(*s)
+	}
+	return arc
+}
+
+// SetIP sets the "ip" field.
+func (arc *AuditRecordCreate) SetIP(s string) *AuditRecordCreate {
+	arc.mutation.SetIP(s)
+	return arc
+}
+
+// SetAPIKeyID sets the "api_key_id" field.
+func (arc *AuditRecordCreate) SetAPIKeyID(u uuid.UUID) *AuditRecordCreate {
+	arc.mutation.SetAPIKeyID(u)
+	return arc
+}
+
+// SetNillableAPIKeyID sets the "api_key_id" field if the given value is not nil.
+func (arc *AuditRecordCreate) SetNillableAPIKeyID(u *uuid.UUID) *AuditRecordCreate {
+	if u != nil {
+		arc.SetAPIKeyID(*u)
+	}
+	return arc
+}
+
+// SetAPIKeyName sets the "api_key_name" field.
+func (arc *AuditRecordCreate) SetAPIKeyName(s string) *AuditRecordCreate {
+	arc.mutation.SetAPIKeyName(s)
+	return arc
+}
+
+// SetNillableAPIKeyName sets the "api_key_name" field if the given value is not nil.
+func (arc *AuditRecordCreate) SetNillableAPIKeyName(s *string) *AuditRecordCreate {
+	if s != nil {
+		arc.SetAPIKeyName(*s)
+	}
+	return arc
+}
+
+// SetParams sets the "params" field.
+func (arc *AuditRecordCreate) SetParams(m map[string]interface{}) *AuditRecordCreate {
+	arc.mutation.SetParams(m)
+	return arc
+}
+
+// SetStatus sets the "status" field.
+func (arc *AuditRecordCreate) SetStatus(i int) *AuditRecordCreate {
+	arc.mutation.SetStatus(i)
+	return arc
+}
+
+// SetErrorCode sets the "error_code" field.
+func (arc *AuditRecordCreate) SetErrorCode(s string) *AuditRecordCreate {
+	arc.mutation.SetErrorCode(s)
+	return arc
+}
+
+// SetNillableErrorCode sets the "error_code" field if the given value is not nil.
+func (arc *AuditRecordCreate) SetNillableErrorCode(s *string) *AuditRecordCreate {
+	if s != nil {
+		arc.SetErrorCode(*s)
+	}
+	return arc
+}
+
+// SetID sets the "id" field.
+func (arc *AuditRecordCreate) SetID(u uuid.UUID) *AuditRecordCreate {
+	arc.mutation.SetID(u)
+	return arc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (arc *AuditRecordCreate) SetNillableID(u *uuid.UUID) *AuditRecordCreate {
+	if u != nil {
+		arc.SetID(*u)
+	}
+	return arc
+}
+
+// Mutation returns the AuditRecordMutation object of the builder.
+func (arc *AuditRecordCreate) Mutation() *AuditRecordMutation {
+	return arc.mutation
+}
+
+// Save creates the AuditRecord in the database.
+func (arc *AuditRecordCreate) Save(ctx context.Context) (*AuditRecord, error) {
+	var (
+		err  error
+		node *AuditRecord
+	)
+	arc.defaults()
+	if len(arc.hooks) == 0 {
+		if err = arc.check(); err != nil {
+			return nil, err
+		}
+		node, err = arc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditRecordMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = arc.check(); err != nil {
+				return nil, err
+			}
+			arc.mutation = mutation
+			if node, err = arc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(arc.hooks) - 1; i >= 0; i-- {
+			if arc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = arc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, arc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*AuditRecord)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from AuditRecordMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (arc *AuditRecordCreate) SaveX(ctx context.Context) *AuditRecord {
+	v, err := arc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arc *AuditRecordCreate) Exec(ctx context.Context) error {
+	_, err := arc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arc *AuditRecordCreate) ExecX(ctx context.Context) {
+	if err := arc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (arc *AuditRecordCreate) defaults() {
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		v := auditrecord.DefaultCreatedAt()
+		arc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := arc.mutation.ID(); !ok {
+		v := auditrecord.DefaultID()
+		arc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (arc *AuditRecordCreate) check() error {
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuditRecord.created_at"`)}
+	}
+	if _, ok := arc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AuditRecord.action"`)}
+	}
+	if v, ok := arc.mutation.Action(); ok {
+		if err := auditrecord.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.action": %w`, err)}
+		}
+	}
+	if v, ok := arc.mutation.Wallet(); ok {
+		if err := auditrecord.WalletValidator(v); err != nil {
+			return &ValidationError{Name: "wallet", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.wallet": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "AuditRecord.ip"`)}
+	}
+	if v, ok := arc.mutation.IP(); ok {
+		if err := auditrecord.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.ip": %w`, err)}
+		}
+	}
+	if v, ok := arc.mutation.APIKeyName(); ok {
+		if err := auditrecord.APIKeyNameValidator(v); err != nil {
+			return &ValidationError{Name: "api_key_name", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.api_key_name": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.Params(); !ok {
+		return &ValidationError{Name: "params", err: errors.New(`ent: missing required field "AuditRecord.params"`)}
+	}
+	if _, ok := arc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AuditRecord.status"`)}
+	}
+	if v, ok := arc.mutation.ErrorCode(); ok {
+		if err := auditrecord.ErrorCodeValidator(v); err != nil {
+			return &ValidationError{Name: "error_code", err: fmt.Errorf(`ent: validator failed for field "AuditRecord.error_code": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (arc *AuditRecordCreate) sqlSave(ctx context.Context) (*AuditRecord, error) {
+	_node, _spec := arc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, arc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (arc *AuditRecordCreate) createSpec() (*AuditRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditRecord{config: arc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: auditrecord.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: auditrecord.FieldID,
+			},
+		}
+	)
+	if id, ok := arc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := arc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditrecord.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := arc.mutation.Action(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditrecord.FieldAction,
+		})
+		_node.Action = value
+	}
+	if value, ok := arc.mutation.Wallet(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditrecord.FieldWallet,
+		})
+		_node.Wallet = &value
+	}
+	if value, ok := arc.mutation.IP(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditrecord.FieldIP,
+		})
+		_node.IP = value
+	}
+	if value, ok := arc.mutation.APIKeyID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: auditrecord.FieldAPIKeyID,
+		})
+		_node.APIKeyID = &value
+	}
+	if value, ok := arc.mutation.APIKeyName(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditrecord.FieldAPIKeyName,
+		})
+		_node.APIKeyName = &value
+	}
+	if value, ok := arc.mutation.Params(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: auditrecord.FieldParams,
+		})
+		_node.Params = value
+	}
+	if value, ok := arc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: auditrecord.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := arc.mutation.ErrorCode(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditrecord.FieldErrorCode,
+		})
+		_node.ErrorCode = &value
+	}
+	return _node, _spec
+}
+
+// AuditRecordCreateBulk is the builder for creating many AuditRecord entities in bulk.
+type AuditRecordCreateBulk struct {
+	config
+	builders []*AuditRecordCreate
+}
+
+// Save creates the AuditRecord entities in the database.
+func (arcb *AuditRecordCreateBulk) Save(ctx context.Context) ([]*AuditRecord, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(arcb.builders))
+	nodes := make([]*AuditRecord, len(arcb.builders))
+	mutators := make([]Mutator, len(arcb.builders))
+	for i := range arcb.builders {
+		func(i int, root context.Context) {
+			builder := arcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, arcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, arcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, arcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (arcb *AuditRecordCreateBulk) SaveX(ctx context.Context) []*AuditRecord {
+	v, err := arcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arcb *AuditRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := arcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arcb *AuditRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := arcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
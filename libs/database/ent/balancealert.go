@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancealert"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// BalanceAlert is the model entity for the BalanceAlert schema.
+type BalanceAlert struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WalletID holds the value of the "wallet_id" field.
+	WalletID uuid.UUID `json:"wallet_id,omitempty"`
+	// Account holds the value of the "account" field.
+	Account string `json:"account,omitempty"`
+	// Threshold holds the value of the "threshold" field.
+	Threshold string `json:"threshold,omitempty"`
+	// Direction holds the value of the "direction" field.
+	Direction balancealert.Direction `json:"direction,omitempty"`
+	// CallbackURL holds the value of the "callback_url" field.
+	CallbackURL string `json:"callback_url,omitempty"`
+	// Fired holds the value of the "fired" field.
+	Fired bool `json:"fired,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BalanceAlertQuery when eager-loading is set.
+	Edges BalanceAlertEdges `json:"edges"`
+}
+
+// BalanceAlertEdges holds the relations/edges for other nodes in the graph.
+type BalanceAlertEdges struct {
+	// Wallet holds the value of the wallet edge.
+	Wallet *Wallet `json:"wallet,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WalletOrErr returns the Wallet value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BalanceAlertEdges) WalletOrErr() (*Wallet, error) {
+	if e.loadedTypes[0] {
+		if e.Wallet == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: wallet.Label}
+		}
+		return e.Wallet, nil
+	}
+	return nil, &NotLoadedError{edge: "wallet"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BalanceAlert) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case balancealert.FieldFired:
+			values[i] = new(sql.NullBool)
+		case balancealert.FieldAccount, balancealert.FieldThreshold, balancealert.FieldDirection, balancealert.FieldCallbackURL:
+			values[i] = new(sql.NullString)
+		case balancealert.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case balancealert.FieldID, balancealert.FieldWalletID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type BalanceAlert", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BalanceAlert fields.
+func (ba *BalanceAlert) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case balancealert.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ba.ID = *value
+			}
+		case balancealert.FieldWalletID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_id", values[i])
+			} else if value != nil {
+				ba.WalletID = *value
+			}
+		case balancealert.FieldAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account", values[i])
+			} else if value.Valid {
+				ba.Account = value.String
+			}
+		case balancealert.FieldThreshold:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value.Valid {
+				ba.Threshold = value.String
+			}
+		case balancealert.FieldDirection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field direction", values[i])
+			} else if value.Valid {
+				ba.Direction = balancealert.Direction(value.String)
+			}
+		case balancealert.FieldCallbackURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field callback_url", values[i])
+			} else if value.Valid {
+				ba.CallbackURL = value.String
+			}
+		case balancealert.FieldFired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field fired", values[i])
+			} else if value.Valid {
+				ba.Fired = value.Bool
+			}
+		case balancealert.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ba.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryWallet queries the "wallet" edge of the BalanceAlert entity.
+func (ba *BalanceAlert) QueryWallet() *WalletQuery {
+	return (&BalanceAlertClient{config: ba.config}).QueryWallet(ba)
+}
+
+// Update returns a builder for updating this BalanceAlert.
+// Note that you need to call BalanceAlert.Unwrap() before calling this method if this BalanceAlert
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ba *BalanceAlert) Update() *BalanceAlertUpdateOne {
+	return (&BalanceAlertClient{config: ba.config}).UpdateOne(ba)
+}
+
+// Unwrap unwraps the BalanceAlert entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ba *BalanceAlert) Unwrap() *BalanceAlert {
+	_tx, ok := ba.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BalanceAlert is not a transactional entity")
+	}
+	ba.config.driver = _tx.drv
+	return ba
+}
+
+// String implements the fmt.Stringer.
+func (ba *BalanceAlert) String() string {
+	var builder strings.Builder
+	builder.WriteString("BalanceAlert(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ba.ID))
+	builder.WriteString("wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", ba.WalletID))
+	builder.WriteString(", ")
+	builder.WriteString("account=")
+	builder.WriteString(ba.Account)
+	builder.WriteString(", ")
+	builder.WriteString("threshold=")
+	builder.WriteString(ba.Threshold)
+	builder.WriteString(", ")
+	builder.WriteString("direction=")
+	builder.WriteString(fmt.Sprintf("%v", ba.Direction))
+	builder.WriteString(", ")
+	builder.WriteString("callback_url=")
+	builder.WriteString(ba.CallbackURL)
+	builder.WriteString(", ")
+	builder.WriteString("fired=")
+	builder.WriteString(fmt.Sprintf("%v", ba.Fired))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ba.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BalanceAlerts is a parsable slice of BalanceAlert.
+type BalanceAlerts []*BalanceAlert
+
+func (ba BalanceAlerts) config(cfg config) {
+	for _i := range ba {
+		ba[_i].config = cfg
+	}
+}
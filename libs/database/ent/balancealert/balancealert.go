@@ -0,0 +1,100 @@
+// Code generated by ent, DO NOT EDIT.
+
+package balancealert
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the balancealert type in the database.
+	Label = "balance_alert"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWalletID holds the string denoting the wallet_id field in the database.
+	FieldWalletID = "wallet_id"
+	// FieldAccount holds the string denoting the account field in the database.
+	FieldAccount = "account"
+	// FieldThreshold holds the string denoting the threshold field in the database.
+	FieldThreshold = "threshold"
+	// FieldDirection holds the string denoting the direction field in the database.
+	FieldDirection = "direction"
+	// FieldCallbackURL holds the string denoting the callback_url field in the database.
+	FieldCallbackURL = "callback_url"
+	// FieldFired holds the string denoting the fired field in the database.
+	FieldFired = "fired"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeWallet holds the string denoting the wallet edge name in mutations.
+	EdgeWallet = "wallet"
+	// Table holds the table name of the balancealert in the database.
+	Table = "balance_alerts"
+	// WalletTable is the table that holds the wallet relation/edge.
+	WalletTable = "balance_alerts"
+	// WalletInverseTable is the table name for the Wallet entity.
+	// It exists in this package in order to avoid circular dependency with the "wallet" package.
+	WalletInverseTable = "wallets"
+	// WalletColumn is the table column denoting the wallet relation/edge.
+	WalletColumn = "wallet_id"
+)
+
+// Columns holds all SQL columns for balancealert fields.
+var Columns = []string{
+	FieldID,
+	FieldWalletID,
+	FieldAccount,
+	FieldThreshold,
+	FieldDirection,
+	FieldCallbackURL,
+	FieldFired,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AccountValidator is a validator for the "account" field. It is called by the builders before save.
+	AccountValidator func(string) error
+	// ThresholdValidator is a validator for the "threshold" field. It is called by the builders before save.
+	ThresholdValidator func(string) error
+	// DefaultFired holds the default value on creation for the "fired" field.
+	DefaultFired bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Direction defines the type for the "direction" enum field.
+type Direction string
+
+// Direction values.
+const (
+	DirectionAbove Direction = "above"
+	DirectionBelow Direction = "below"
+)
+
+func (d Direction) String() string {
+	return string(d)
+}
+
+// DirectionValidator is a validator for the "direction" field enum values. It is called by the builders before save.
+func DirectionValidator(d Direction) error {
+	switch d {
+	case DirectionAbove, DirectionBelow:
+		return nil
+	default:
+		return fmt.Errorf("balancealert: invalid enum value for direction field: %q", d)
+	}
+}
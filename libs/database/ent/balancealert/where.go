@@ -0,0 +1,632 @@
+// Code generated by ent, DO NOT EDIT.
+
+package balancealert
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// WalletID applies equality check predicate on the "wallet_id" field. It's identical to WalletIDEQ.
+func WalletID(v uuid.UUID) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// Account applies equality check predicate on the "account" field. It's identical to AccountEQ.
+func Account(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAccount), v))
+	})
+}
+
+// Threshold applies equality check predicate on the "threshold" field. It's identical to ThresholdEQ.
+func Threshold(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldThreshold), v))
+	})
+}
+
+// CallbackURL applies equality check predicate on the "callback_url" field. It's identical to CallbackURLEQ.
+func CallbackURL(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCallbackURL), v))
+	})
+}
+
+// Fired applies equality check predicate on the "fired" field. It's identical to FiredEQ.
+func Fired(v bool) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFired), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// WalletIDEQ applies the EQ predicate on the "wallet_id" field.
+func WalletIDEQ(v uuid.UUID) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDNEQ applies the NEQ predicate on the "wallet_id" field.
+func WalletIDNEQ(v uuid.UUID) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDIn applies the In predicate on the "wallet_id" field.
+func WalletIDIn(vs ...uuid.UUID) predicate.BalanceAlert {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldWalletID), v...))
+	})
+}
+
+// WalletIDNotIn applies the NotIn predicate on the "wallet_id" field.
+func WalletIDNotIn(vs ...uuid.UUID) predicate.BalanceAlert {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldWalletID), v...))
+	})
+}
+
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAccount), v))
+	})
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAccount), v))
+	})
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...string) predicate.BalanceAlert {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAccount), v...))
+	})
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...string) predicate.BalanceAlert {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAccount), v...))
+	})
+}
+
+// AccountGT applies the GT predicate on the "account" field.
+func AccountGT(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAccount), v))
+	})
+}
+
+// AccountGTE applies the GTE predicate on the "account" field.
+func AccountGTE(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAccount), v))
+	})
+}
+
+// AccountLT applies the LT predicate on the "account" field.
+func AccountLT(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAccount), v))
+	})
+}
+
+// AccountLTE applies the LTE predicate on the "account" field.
+func AccountLTE(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAccount), v))
+	})
+}
+
+// AccountContains applies the Contains predicate on the "account" field.
+func AccountContains(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAccount), v))
+	})
+}
+
+// AccountHasPrefix applies the HasPrefix predicate on the "account" field.
+func AccountHasPrefix(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAccount), v))
+	})
+}
+
+// AccountHasSuffix applies the HasSuffix predicate on the "account" field.
+func AccountHasSuffix(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAccount), v))
+	})
+}
+
+// AccountEqualFold applies the EqualFold predicate on the "account" field.
+func AccountEqualFold(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAccount), v))
+	})
+}
+
+// AccountContainsFold applies the ContainsFold predicate on the "account" field.
+func AccountContainsFold(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAccount), v))
+	})
+}
+
+// ThresholdEQ applies the EQ predicate on the "threshold" field.
+func ThresholdEQ(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldThreshold), v))
+	})
+}
+
+// ThresholdNEQ applies the NEQ predicate on the "threshold" field.
+func ThresholdNEQ(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldThreshold), v))
+	})
+}
+
+// ThresholdIn applies the In predicate on the "threshold" field.
+func ThresholdIn(vs ...string) predicate.BalanceAlert {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldThreshold), v...))
+	})
+}
+
+// ThresholdNotIn applies the NotIn predicate on the "threshold" field.
+func ThresholdNotIn(vs ...string) predicate.BalanceAlert {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldThreshold), v...))
+	})
+}
+
+// ThresholdGT applies the GT predicate on the "threshold" field.
+func ThresholdGT(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldThreshold), v))
+	})
+}
+
+// ThresholdGTE applies the GTE predicate on the "threshold" field.
+func ThresholdGTE(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldThreshold), v))
+	})
+}
+
+// ThresholdLT applies the LT predicate on the "threshold" field.
+func ThresholdLT(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldThreshold), v))
+	})
+}
+
+// ThresholdLTE applies the LTE predicate on the "threshold" field.
+func ThresholdLTE(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldThreshold), v))
+	})
+}
+
+// ThresholdContains applies the Contains predicate on the "threshold" field.
+func ThresholdContains(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldThreshold), v))
+	})
+}
+
+// ThresholdHasPrefix applies the HasPrefix predicate on the "threshold" field.
+func ThresholdHasPrefix(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldThreshold), v))
+	})
+}
+
+// ThresholdHasSuffix applies the HasSuffix predicate on the "threshold" field.
+func ThresholdHasSuffix(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldThreshold), v))
+	})
+}
+
+// ThresholdEqualFold applies the EqualFold predicate on the "threshold" field.
+func ThresholdEqualFold(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldThreshold), v))
+	})
+}
+
+// ThresholdContainsFold applies the ContainsFold predicate on the "threshold" field.
+func ThresholdContainsFold(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldThreshold), v))
+	})
+}
+
+// DirectionEQ applies the EQ predicate on the "direction" field.
+func DirectionEQ(v Direction) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDirection), v))
+	})
+}
+
+// DirectionNEQ applies the NEQ predicate on the "direction" field.
+func DirectionNEQ(v Direction) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDirection), v))
+	})
+}
+
+// DirectionIn applies the In predicate on the "direction" field.
+func DirectionIn(vs ...Direction) predicate.BalanceAlert {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDirection), v...))
+	})
+}
+
+// DirectionNotIn applies the NotIn predicate on the "direction" field.
+func DirectionNotIn(vs ...Direction) predicate.BalanceAlert {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDirection), v...))
+	})
+}
+
+// CallbackURLEQ applies the EQ predicate on the "callback_url" field.
+func CallbackURLEQ(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCallbackURL), v))
+	})
+}
+
+// CallbackURLNEQ applies the NEQ predicate on the "callback_url" field.
+func CallbackURLNEQ(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCallbackURL), v))
+	})
+}
+
+// CallbackURLIn applies the In predicate on the "callback_url" field.
+func CallbackURLIn(vs ...string) predicate.BalanceAlert {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCallbackURL), v...))
+	})
+}
+
+// CallbackURLNotIn applies the NotIn predicate on the "callback_url" field.
+func CallbackURLNotIn(vs ...string) predicate.BalanceAlert {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCallbackURL), v...))
+	})
+}
+
+// CallbackURLGT applies the GT predicate on the "callback_url" field.
+func CallbackURLGT(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCallbackURL), v))
+	})
+}
+
+// CallbackURLGTE applies the GTE predicate on the "callback_url" field.
+func CallbackURLGTE(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCallbackURL), v))
+	})
+}
+
+// CallbackURLLT applies the LT predicate on the "callback_url" field.
+func CallbackURLLT(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCallbackURL), v))
+	})
+}
+
+// CallbackURLLTE applies the LTE predicate on the "callback_url" field.
+func CallbackURLLTE(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCallbackURL), v))
+	})
+}
+
+// CallbackURLContains applies the Contains predicate on the "callback_url" field.
+func CallbackURLContains(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCallbackURL), v))
+	})
+}
+
+// CallbackURLHasPrefix applies the HasPrefix predicate on the "callback_url" field.
+func CallbackURLHasPrefix(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCallbackURL), v))
+	})
+}
+
+// CallbackURLHasSuffix applies the HasSuffix predicate on the "callback_url" field.
+func CallbackURLHasSuffix(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCallbackURL), v))
+	})
+}
+
+// CallbackURLEqualFold applies the EqualFold predicate on the "callback_url" field.
+func CallbackURLEqualFold(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCallbackURL), v))
+	})
+}
+
+// CallbackURLContainsFold applies the ContainsFold predicate on the "callback_url" field.
+func CallbackURLContainsFold(v string) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCallbackURL), v))
+	})
+}
+
+// FiredEQ applies the EQ predicate on the "fired" field.
+func FiredEQ(v bool) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFired), v))
+	})
+}
+
+// FiredNEQ applies the NEQ predicate on the "fired" field.
+func FiredNEQ(v bool) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFired), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BalanceAlert {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BalanceAlert {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasWallet applies the HasEdge predicate on the "wallet" edge.
+func HasWallet() predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWalletWith applies the HasEdge predicate on the "wallet" edge with a given conditions (other predicates).
+func HasWalletWith(preds ...predicate.Wallet) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BalanceAlert) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BalanceAlert) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BalanceAlert) predicate.BalanceAlert {
+	return predicate.BalanceAlert(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,419 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancealert"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// BalanceAlertCreate is the builder for creating a BalanceAlert entity.
+type BalanceAlertCreate struct {
+	config
+	mutation *BalanceAlertMutation
+	hooks    []Hook
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (bac *BalanceAlertCreate) SetWalletID(u uuid.UUID) *BalanceAlertCreate {
+	bac.mutation.SetWalletID(u)
+	return bac
+}
+
+// SetAccount sets the "account" field.
+func (bac *BalanceAlertCreate) SetAccount(s string) *BalanceAlertCreate {
+	bac.mutation.SetAccount(s)
+	return bac
+}
+
+// SetThreshold sets the "threshold" field.
+func (bac *BalanceAlertCreate) SetThreshold(s string) *BalanceAlertCreate {
+	bac.mutation.SetThreshold(s)
+	return bac
+}
+
+// SetDirection sets the "direction" field.
+func (bac *BalanceAlertCreate) SetDirection(b balancealert.Direction) *BalanceAlertCreate {
+	bac.mutation.SetDirection(b)
+	return bac
+}
+
+// SetCallbackURL sets the "callback_url" field.
+func (bac *BalanceAlertCreate) SetCallbackURL(s string) *BalanceAlertCreate {
+	bac.mutation.SetCallbackURL(s)
+	return bac
+}
+
+// SetFired sets the "fired" field.
+func (bac *BalanceAlertCreate) SetFired(b bool) *BalanceAlertCreate {
+	bac.mutation.SetFired(b)
+	return bac
+}
+
+// SetNillableFired sets the "fired" field if the given value is not nil.
+func (bac *BalanceAlertCreate) SetNillableFired(b *bool) *BalanceAlertCreate {
+	if b != nil {
+		bac.SetFired(*b)
+	}
+	return bac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bac *BalanceAlertCreate) SetCreatedAt(t time.Time) *BalanceAlertCreate {
+	bac.mutation.SetCreatedAt(t)
+	return bac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bac *BalanceAlertCreate) SetNillableCreatedAt(t *time.Time) *BalanceAlertCreate {
+	if t != nil {
+		bac.SetCreatedAt(*t)
+	}
+	return bac
+}
+
+// SetID sets the "id" field.
+func (bac *BalanceAlertCreate) SetID(u uuid.UUID) *BalanceAlertCreate {
+	bac.mutation.SetID(u)
+	return bac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (bac *BalanceAlertCreate) SetNillableID(u *uuid.UUID) *BalanceAlertCreate {
+	if u != nil {
+		bac.SetID(*u)
+	}
+	return bac
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (bac *BalanceAlertCreate) SetWallet(w *Wallet) *BalanceAlertCreate {
+	return bac.SetWalletID(w.ID)
+}
+
+// Mutation returns the BalanceAlertMutation object of the builder.
+func (bac *BalanceAlertCreate) Mutation() *BalanceAlertMutation {
+	return bac.mutation
+}
+
+// Save creates the BalanceAlert in the database.
+func (bac *BalanceAlertCreate) Save(ctx context.Context) (*BalanceAlert, error) {
+	var (
+		err  error
+		node *BalanceAlert
+	)
+	bac.defaults()
+	if len(bac.hooks) == 0 {
+		if err = bac.check(); err != nil {
+			return nil, err
+		}
+		node, err = bac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BalanceAlertMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bac.check(); err != nil {
+				return nil, err
+			}
+			bac.mutation = mutation
+			if node, err = bac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bac.hooks) - 1; i >= 0; i-- {
+			if bac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bac.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, bac.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*BalanceAlert)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BalanceAlertMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bac *BalanceAlertCreate) SaveX(ctx context.Context) *BalanceAlert {
+	v, err := bac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bac *BalanceAlertCreate) Exec(ctx context.Context) error {
+	_, err := bac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bac *BalanceAlertCreate) ExecX(ctx context.Context) {
+	if err := bac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bac *BalanceAlertCreate) defaults() {
+	if _, ok := bac.mutation.Fired(); !ok {
+		v := balancealert.DefaultFired
+		bac.mutation.SetFired(v)
+	}
+	if _, ok := bac.mutation.CreatedAt(); !ok {
+		v := balancealert.DefaultCreatedAt()
+		bac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bac.mutation.ID(); !ok {
+		v := balancealert.DefaultID()
+		bac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bac *BalanceAlertCreate) check() error {
+	if _, ok := bac.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "BalanceAlert.wallet_id"`)}
+	}
+	if _, ok := bac.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required field "BalanceAlert.account"`)}
+	}
+	if v, ok := bac.mutation.Account(); ok {
+		if err := balancealert.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "BalanceAlert.account": %w`, err)}
+		}
+	}
+	if _, ok := bac.mutation.Threshold(); !ok {
+		return &ValidationError{Name: "threshold", err: errors.New(`ent: missing required field "BalanceAlert.threshold"`)}
+	}
+	if v, ok := bac.mutation.Threshold(); ok {
+		if err := balancealert.ThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "threshold", err: fmt.Errorf(`ent: validator failed for field "BalanceAlert.threshold": %w`, err)}
+		}
+	}
+	if _, ok := bac.mutation.Direction(); !ok {
+		return &ValidationError{Name: "direction", err: errors.New(`ent: missing required field "BalanceAlert.direction"`)}
+	}
+	if v, ok := bac.mutation.Direction(); ok {
+		if err := balancealert.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "BalanceAlert.direction": %w`, err)}
+		}
+	}
+	if _, ok := bac.mutation.CallbackURL(); !ok {
+		return &ValidationError{Name: "callback_url", err: errors.New(`ent: missing required field "BalanceAlert.callback_url"`)}
+	}
+	if _, ok := bac.mutation.Fired(); !ok {
+		return &ValidationError{Name: "fired", err: errors.New(`ent: missing required field "BalanceAlert.fired"`)}
+	}
+	if _, ok := bac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BalanceAlert.created_at"`)}
+	}
+	if _, ok := bac.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet", err: errors.New(`ent: missing required edge "BalanceAlert.wallet"`)}
+	}
+	return nil
+}
+
+func (bac *BalanceAlertCreate) sqlSave(ctx context.Context) (*BalanceAlert, error) {
+	_node, _spec := bac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (bac *BalanceAlertCreate) createSpec() (*BalanceAlert, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BalanceAlert{config: bac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: balancealert.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: balancealert.FieldID,
+			},
+		}
+	)
+	if id, ok := bac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := bac.mutation.Account(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: balancealert.FieldAccount,
+		})
+		_node.Account = value
+	}
+	if value, ok := bac.mutation.Threshold(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: balancealert.FieldThreshold,
+		})
+		_node.Threshold = value
+	}
+	if value, ok := bac.mutation.Direction(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: balancealert.FieldDirection,
+		})
+		_node.Direction = value
+	}
+	if value, ok := bac.mutation.CallbackURL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: balancealert.FieldCallbackURL,
+		})
+		_node.CallbackURL = value
+	}
+	if value, ok := bac.mutation.Fired(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: balancealert.FieldFired,
+		})
+		_node.Fired = value
+	}
+	if value, ok := bac.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: balancealert.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := bac.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancealert.WalletTable,
+			Columns: []string{balancealert.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WalletID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BalanceAlertCreateBulk is the builder for creating many BalanceAlert entities in bulk.
+type BalanceAlertCreateBulk struct {
+	config
+	builders []*BalanceAlertCreate
+}
+
+// Save creates the BalanceAlert entities in the database.
+func (bacb *BalanceAlertCreateBulk) Save(ctx context.Context) ([]*BalanceAlert, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bacb.builders))
+	nodes := make([]*BalanceAlert, len(bacb.builders))
+	mutators := make([]Mutator, len(bacb.builders))
+	for i := range bacb.builders {
+		func(i int, root context.Context) {
+			builder := bacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BalanceAlertMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bacb *BalanceAlertCreateBulk) SaveX(ctx context.Context) []*BalanceAlert {
+	v, err := bacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bacb *BalanceAlertCreateBulk) Exec(ctx context.Context) error {
+	_, err := bacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bacb *BalanceAlertCreateBulk) ExecX(ctx context.Context) {
+	if err := bacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancealert"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// BalanceAlertQuery is the builder for querying BalanceAlert entities.
+type BalanceAlertQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.BalanceAlert
+	withWallet *WalletQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BalanceAlertQuery builder.
+func (baq *BalanceAlertQuery) Where(ps ...predicate.BalanceAlert) *BalanceAlertQuery {
+	baq.predicates = append(baq.predicates, ps...)
+	return baq
+}
+
+// Limit adds a limit step to the query.
+func (baq *BalanceAlertQuery) Limit(limit int) *BalanceAlertQuery {
+	baq.limit = &limit
+	return baq
+}
+
+// Offset adds an offset step to the query.
+func (baq *BalanceAlertQuery) Offset(offset int) *BalanceAlertQuery {
+	baq.offset = &offset
+	return baq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (baq *BalanceAlertQuery) Unique(unique bool) *BalanceAlertQuery {
+	baq.unique = &unique
+	return baq
+}
+
+// Order adds an order step to the query.
+func (baq *BalanceAlertQuery) Order(o ...OrderFunc) *BalanceAlertQuery {
+	baq.order = append(baq.order, o...)
+	return baq
+}
+
+// QueryWallet chains the current query on the "wallet" edge.
+func (baq *BalanceAlertQuery) QueryWallet() *WalletQuery {
+	query := &WalletQuery{config: baq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := baq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := baq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(balancealert.Table, balancealert.FieldID, selector),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, balancealert.WalletTable, balancealert.WalletColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(baq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BalanceAlert entity from the query.
+// Returns a *NotFoundError when no BalanceAlert was found.
+func (baq *BalanceAlertQuery) First(ctx context.Context) (*BalanceAlert, error) {
+	nodes, err := baq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{balancealert.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (baq *BalanceAlertQuery) FirstX(ctx context.Context) *BalanceAlert {
+	node, err := baq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BalanceAlert ID from the query.
+// Returns a *NotFoundError when no BalanceAlert ID was found.
+func (baq *BalanceAlertQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = baq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{balancealert.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (baq *BalanceAlertQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := baq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BalanceAlert entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BalanceAlert entity is found.
+// Returns a *NotFoundError when no BalanceAlert entities are found.
+func (baq *BalanceAlertQuery) Only(ctx context.Context) (*BalanceAlert, error) {
+	nodes, err := baq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{balancealert.Label}
+	default:
+		return nil, &NotSingularError{balancealert.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (baq *BalanceAlertQuery) OnlyX(ctx context.Context) *BalanceAlert {
+	node, err := baq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BalanceAlert ID in the query.
+// Returns a *NotSingularError when more than one BalanceAlert ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (baq *BalanceAlertQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = baq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{balancealert.Label}
+	default:
+		err = &NotSingularError{balancealert.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (baq *BalanceAlertQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := baq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BalanceAlerts.
+func (baq *BalanceAlertQuery) All(ctx context.Context) ([]*BalanceAlert, error) {
+	if err := baq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return baq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (baq *BalanceAlertQuery) AllX(ctx context.Context) []*BalanceAlert {
+	nodes, err := baq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BalanceAlert IDs.
+func (baq *BalanceAlertQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := baq.Select(balancealert.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (baq *BalanceAlertQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := baq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (baq *BalanceAlertQuery) Count(ctx context.Context) (int, error) {
+	if err := baq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return baq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (baq *BalanceAlertQuery) CountX(ctx context.Context) int {
+	count, err := baq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (baq *BalanceAlertQuery) Exist(ctx context.Context) (bool, error) {
+	if err := baq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return baq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (baq *BalanceAlertQuery) ExistX(ctx context.Context) bool {
+	exist, err := baq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BalanceAlertQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (baq *BalanceAlertQuery) Clone() *BalanceAlertQuery {
+	if baq == nil {
+		return nil
+	}
+	return &BalanceAlertQuery{
+		config:     baq.config,
+		limit:      baq.limit,
+		offset:     baq.offset,
+		order:      append([]OrderFunc{}, baq.order...),
+		predicates: append([]predicate.BalanceAlert{}, baq.predicates...),
+		withWallet: baq.withWallet.Clone(),
+		// clone intermediate query.
+		sql:    baq.sql.Clone(),
+		path:   baq.path,
+		unique: baq.unique,
+	}
+}
+
+// WithWallet tells the query-builder to eager-load the nodes that are connected to
+// the "wallet" edge. The optional arguments are used to configure the query builder of the edge.
+func (baq *BalanceAlertQuery) WithWallet(opts ...func(*WalletQuery)) *BalanceAlertQuery {
+	query := &WalletQuery{config: baq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	baq.withWallet = query
+	return baq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BalanceAlert.Query().
+//		GroupBy(balancealert.FieldWalletID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (baq *BalanceAlertQuery) GroupBy(field string, fields ...string) *BalanceAlertGroupBy {
+	grbuild := &BalanceAlertGroupBy{config: baq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := baq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return baq.sqlQuery(ctx), nil
+	}
+	grbuild.label = balancealert.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//	}
+//
+//	client.BalanceAlert.Query().
+//		Select(balancealert.FieldWalletID).
+//		Scan(ctx, &v)
+func (baq *BalanceAlertQuery) Select(fields ...string) *BalanceAlertSelect {
+	baq.fields = append(baq.fields, fields...)
+	selbuild := &BalanceAlertSelect{BalanceAlertQuery: baq}
+	selbuild.label = balancealert.Label
+	selbuild.flds, selbuild.scan = &baq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (baq *BalanceAlertQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range baq.fields {
+		if !balancealert.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if baq.path != nil {
+		prev, err := baq.path(ctx)
+		if err != nil {
+			return err
+		}
+		baq.sql = prev
+	}
+	return nil
+}
+
+func (baq *BalanceAlertQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BalanceAlert, error) {
+	var (
+		nodes       = []*BalanceAlert{}
+		_spec       = baq.querySpec()
+		loadedTypes = [1]bool{
+			baq.withWallet != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*BalanceAlert).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &BalanceAlert{config: baq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, baq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := baq.withWallet; query != nil {
+		if err := baq.loadWallet(ctx, query, nodes, nil,
+			func(n *BalanceAlert, e *Wallet) { n.Edges.Wallet = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (baq *BalanceAlertQuery) loadWallet(ctx context.Context, query *WalletQuery, nodes []*BalanceAlert, init func(*BalanceAlert), assign func(*BalanceAlert, *Wallet)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BalanceAlert)
+	for i := range nodes {
+		fk := nodes[i].WalletID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(wallet.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (baq *BalanceAlertQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := baq.querySpec()
+	_spec.Node.Columns = baq.fields
+	if len(baq.fields) > 0 {
+		_spec.Unique = baq.unique != nil && *baq.unique
+	}
+	return sqlgraph.CountNodes(ctx, baq.driver, _spec)
+}
+
+func (baq *BalanceAlertQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := baq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (baq *BalanceAlertQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   balancealert.Table,
+			Columns: balancealert.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: balancealert.FieldID,
+			},
+		},
+		From:   baq.sql,
+		Unique: true,
+	}
+	if unique := baq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := baq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balancealert.FieldID)
+		for i := range fields {
+			if fields[i] != balancealert.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := baq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := baq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := baq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := baq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (baq *BalanceAlertQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(baq.driver.Dialect())
+	t1 := builder.Table(balancealert.Table)
+	columns := baq.fields
+	if len(columns) == 0 {
+		columns = balancealert.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if baq.sql != nil {
+		selector = baq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if baq.unique != nil && *baq.unique {
+		selector.Distinct()
+	}
+	for _, p := range baq.predicates {
+		p(selector)
+	}
+	for _, p := range baq.order {
+		p(selector)
+	}
+	if offset := baq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := baq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BalanceAlertGroupBy is the group-by builder for BalanceAlert entities.
+type BalanceAlertGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bagb *BalanceAlertGroupBy) Aggregate(fns ...AggregateFunc) *BalanceAlertGroupBy {
+	bagb.fns = append(bagb.fns, fns...)
+	return bagb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (bagb *BalanceAlertGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := bagb.path(ctx)
+	if err != nil {
+		return err
+	}
+	bagb.sql = query
+	return bagb.sqlScan(ctx, v)
+}
+
+func (bagb *BalanceAlertGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range bagb.fields {
+		if !balancealert.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := bagb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bagb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bagb *BalanceAlertGroupBy) sqlQuery() *sql.Selector {
+	selector := bagb.sql.Select()
+	aggregation := make([]string, 0, len(bagb.fns))
+	for _, fn := range bagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(bagb.fields)+len(bagb.fns))
+		for _, f := range bagb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(bagb.fields...)...)
+}
+
+// BalanceAlertSelect is the builder for selecting fields of BalanceAlert entities.
+type BalanceAlertSelect struct {
+	*BalanceAlertQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bas *BalanceAlertSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := bas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	bas.sql = bas.BalanceAlertQuery.sqlQuery(ctx)
+	return bas.sqlScan(ctx, v)
+}
+
+func (bas *BalanceAlertSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := bas.sql.Query()
+	if err := bas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,421 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancealert"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// BalanceAlertUpdate is the builder for updating BalanceAlert entities.
+type BalanceAlertUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BalanceAlertMutation
+}
+
+// Where appends a list predicates to the BalanceAlertUpdate builder.
+func (bau *BalanceAlertUpdate) Where(ps ...predicate.BalanceAlert) *BalanceAlertUpdate {
+	bau.mutation.Where(ps...)
+	return bau
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (bau *BalanceAlertUpdate) SetWalletID(u uuid.UUID) *BalanceAlertUpdate {
+	bau.mutation.SetWalletID(u)
+	return bau
+}
+
+// SetFired sets the "fired" field.
+func (bau *BalanceAlertUpdate) SetFired(b bool) *BalanceAlertUpdate {
+	bau.mutation.SetFired(b)
+	return bau
+}
+
+// SetNillableFired sets the "fired" field if the given value is not nil.
+func (bau *BalanceAlertUpdate) SetNillableFired(b *bool) *BalanceAlertUpdate {
+	if b != nil {
+		bau.SetFired(*b)
+	}
+	return bau
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (bau *BalanceAlertUpdate) SetWallet(w *Wallet) *BalanceAlertUpdate {
+	return bau.SetWalletID(w.ID)
+}
+
+// Mutation returns the BalanceAlertMutation object of the builder.
+func (bau *BalanceAlertUpdate) Mutation() *BalanceAlertMutation {
+	return bau.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (bau *BalanceAlertUpdate) ClearWallet() *BalanceAlertUpdate {
+	bau.mutation.ClearWallet()
+	return bau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bau *BalanceAlertUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(bau.hooks) == 0 {
+		if err = bau.check(); err != nil {
+			return 0, err
+		}
+		affected, err = bau.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BalanceAlertMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bau.check(); err != nil {
+				return 0, err
+			}
+			bau.mutation = mutation
+			affected, err = bau.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bau.hooks) - 1; i >= 0; i-- {
+			if bau.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bau.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bau.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bau *BalanceAlertUpdate) SaveX(ctx context.Context) int {
+	affected, err := bau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bau *BalanceAlertUpdate) Exec(ctx context.Context) error {
+	_, err := bau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bau *BalanceAlertUpdate) ExecX(ctx context.Context) {
+	if err := bau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bau *BalanceAlertUpdate) check() error {
+	if _, ok := bau.mutation.WalletID(); bau.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "BalanceAlert.wallet"`)
+	}
+	return nil
+}
+
+func (bau *BalanceAlertUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   balancealert.Table,
+			Columns: balancealert.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: balancealert.FieldID,
+			},
+		},
+	}
+	if ps := bau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bau.mutation.Fired(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: balancealert.FieldFired,
+		})
+	}
+	if bau.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancealert.WalletTable,
+			Columns: []string{balancealert.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bau.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancealert.WalletTable,
+			Columns: []string{balancealert.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancealert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BalanceAlertUpdateOne is the builder for updating a single BalanceAlert entity.
+type BalanceAlertUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BalanceAlertMutation
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (bauo *BalanceAlertUpdateOne) SetWalletID(u uuid.UUID) *BalanceAlertUpdateOne {
+	bauo.mutation.SetWalletID(u)
+	return bauo
+}
+
+// SetFired sets the "fired" field.
+func (bauo *BalanceAlertUpdateOne) SetFired(b bool) *BalanceAlertUpdateOne {
+	bauo.mutation.SetFired(b)
+	return bauo
+}
+
+// SetNillableFired sets the "fired" field if the given value is not nil.
+func (bauo *BalanceAlertUpdateOne) SetNillableFired(b *bool) *BalanceAlertUpdateOne {
+	if b != nil {
+		bauo.SetFired(*b)
+	}
+	return bauo
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (bauo *BalanceAlertUpdateOne) SetWallet(w *Wallet) *BalanceAlertUpdateOne {
+	return bauo.SetWalletID(w.ID)
+}
+
+// Mutation returns the BalanceAlertMutation object of the builder.
+func (bauo *BalanceAlertUpdateOne) Mutation() *BalanceAlertMutation {
+	return bauo.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (bauo *BalanceAlertUpdateOne) ClearWallet() *BalanceAlertUpdateOne {
+	bauo.mutation.ClearWallet()
+	return bauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bauo *BalanceAlertUpdateOne) Select(field string, fields ...string) *BalanceAlertUpdateOne {
+	bauo.fields = append([]string{field}, fields...)
+	return bauo
+}
+
+// Save executes the query and returns the updated BalanceAlert entity.
+func (bauo *BalanceAlertUpdateOne) Save(ctx context.Context) (*BalanceAlert, error) {
+	var (
+		err  error
+		node *BalanceAlert
+	)
+	if len(bauo.hooks) == 0 {
+		if err = bauo.check(); err != nil {
+			return nil, err
+		}
+		node, err = bauo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BalanceAlertMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bauo.check(); err != nil {
+				return nil, err
+			}
+			bauo.mutation = mutation
+			node, err = bauo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bauo.hooks) - 1; i >= 0; i-- {
+			if bauo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bauo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, bauo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*BalanceAlert)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BalanceAlertMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bauo *BalanceAlertUpdateOne) SaveX(ctx context.Context) *BalanceAlert {
+	node, err := bauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bauo *BalanceAlertUpdateOne) Exec(ctx context.Context) error {
+	_, err := bauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bauo *BalanceAlertUpdateOne) ExecX(ctx context.Context) {
+	if err := bauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bauo *BalanceAlertUpdateOne) check() error {
+	if _, ok := bauo.mutation.WalletID(); bauo.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "BalanceAlert.wallet"`)
+	}
+	return nil
+}
+
+func (bauo *BalanceAlertUpdateOne) sqlSave(ctx context.Context) (_node *BalanceAlert, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   balancealert.Table,
+			Columns: balancealert.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: balancealert.FieldID,
+			},
+		},
+	}
+	id, ok := bauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BalanceAlert.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balancealert.FieldID)
+		for _, f := range fields {
+			if !balancealert.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != balancealert.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bauo.mutation.Fired(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: balancealert.FieldFired,
+		})
+	}
+	if bauo.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancealert.WalletTable,
+			Columns: []string{balancealert.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bauo.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancealert.WalletTable,
+			Columns: []string{balancealert.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BalanceAlert{config: bauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancealert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
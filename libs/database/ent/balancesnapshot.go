@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/google/uuid"
+)
+
+// BalanceSnapshot is the model entity for the BalanceSnapshot schema.
+type BalanceSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID uuid.UUID `json:"account_id,omitempty"`
+	// BalanceRaw holds the value of the "balance_raw" field.
+	BalanceRaw string `json:"balance_raw,omitempty"`
+	// SnapshotAt holds the value of the "snapshot_at" field.
+	SnapshotAt time.Time `json:"snapshot_at,omitempty"`
+	// WalletSnapshotID holds the value of the "wallet_snapshot_id" field.
+	WalletSnapshotID *uuid.UUID `json:"wallet_snapshot_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BalanceSnapshotQuery when eager-loading is set.
+	Edges BalanceSnapshotEdges `json:"edges"`
+}
+
+// BalanceSnapshotEdges holds the relations/edges for other nodes in the graph.
+type BalanceSnapshotEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// WalletSnapshot holds the value of the wallet_snapshot edge.
+	WalletSnapshot *WalletSnapshot `json:"wallet_snapshot,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BalanceSnapshotEdges) AccountOrErr() (*Account, error) {
+	if e.loadedTypes[0] {
+		if e.Account == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: account.Label}
+		}
+		return e.Account, nil
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// WalletSnapshotOrErr returns the WalletSnapshot value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BalanceSnapshotEdges) WalletSnapshotOrErr() (*WalletSnapshot, error) {
+	if e.loadedTypes[1] {
+		if e.WalletSnapshot == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: walletsnapshot.Label}
+		}
+		return e.WalletSnapshot, nil
+	}
+	return nil, &NotLoadedError{edge: "wallet_snapshot"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BalanceSnapshot) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case balancesnapshot.FieldWalletSnapshotID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case balancesnapshot.FieldBalanceRaw:
+			values[i] = new(sql.NullString)
+		case balancesnapshot.FieldSnapshotAt:
+			values[i] = new(sql.NullTime)
+		case balancesnapshot.FieldID, balancesnapshot.FieldAccountID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type BalanceSnapshot", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BalanceSnapshot fields.
+func (bs *BalanceSnapshot) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case balancesnapshot.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				bs.ID = *value
+			}
+		case balancesnapshot.FieldAccountID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				bs.AccountID = *value
+			}
+		case balancesnapshot.FieldBalanceRaw:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_raw", values[i])
+			} else if value.Valid {
+				bs.BalanceRaw = value.String
+			}
+		case balancesnapshot.FieldSnapshotAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot_at", values[i])
+			} else if value.Valid {
+				bs.SnapshotAt = value.Time
+			}
+		case balancesnapshot.FieldWalletSnapshotID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_snapshot_id", values[i])
+			} else if value.Valid {
+				bs.WalletSnapshotID = new(uuid.UUID)
+				*bs.WalletSnapshotID = *value.S.(*uuid.UUID)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryAccount queries the "account" edge of the BalanceSnapshot entity.
+func (bs *BalanceSnapshot) QueryAccount() *AccountQuery {
+	return (&BalanceSnapshotClient{config: bs.config}).QueryAccount(bs)
+}
+
+// QueryWalletSnapshot queries the "wallet_snapshot" edge of the BalanceSnapshot entity.
+func (bs *BalanceSnapshot) QueryWalletSnapshot() *WalletSnapshotQuery {
+	return (&BalanceSnapshotClient{config: bs.config}).QueryWalletSnapshot(bs)
+}
+
+// Update returns a builder for updating this BalanceSnapshot.
+// Note that you need to call BalanceSnapshot.Unwrap() before calling this method if this BalanceSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bs *BalanceSnapshot) Update() *BalanceSnapshotUpdateOne {
+	return (&BalanceSnapshotClient{config: bs.config}).UpdateOne(bs)
+}
+
+// Unwrap unwraps the BalanceSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bs *BalanceSnapshot) Unwrap() *BalanceSnapshot {
+	_tx, ok := bs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BalanceSnapshot is not a transactional entity")
+	}
+	bs.config.driver = _tx.drv
+	return bs
+}
+
+// String implements the fmt.Stringer.
+func (bs *BalanceSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("BalanceSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bs.ID))
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", bs.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("balance_raw=")
+	builder.WriteString(bs.BalanceRaw)
+	builder.WriteString(", ")
+	builder.WriteString("snapshot_at=")
+	builder.WriteString(bs.SnapshotAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := bs.WalletSnapshotID; v != nil {
+		builder.WriteString("wallet_snapshot_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BalanceSnapshots is a parsable slice of BalanceSnapshot.
+type BalanceSnapshots []*BalanceSnapshot
+
+func (bs BalanceSnapshots) config(cfg config) {
+	for _i := range bs {
+		bs[_i].config = cfg
+	}
+}
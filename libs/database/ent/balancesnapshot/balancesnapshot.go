@@ -0,0 +1,68 @@
+// Code generated by ent, DO NOT EDIT.
+
+package balancesnapshot
+
+import (
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the balancesnapshot type in the database.
+	Label = "balance_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldBalanceRaw holds the string denoting the balance_raw field in the database.
+	FieldBalanceRaw = "balance_raw"
+	// FieldSnapshotAt holds the string denoting the snapshot_at field in the database.
+	FieldSnapshotAt = "snapshot_at"
+	// FieldWalletSnapshotID holds the string denoting the wallet_snapshot_id field in the database.
+	FieldWalletSnapshotID = "wallet_snapshot_id"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// EdgeWalletSnapshot holds the string denoting the wallet_snapshot edge name in mutations.
+	EdgeWalletSnapshot = "wallet_snapshot"
+	// Table holds the table name of the balancesnapshot in the database.
+	Table = "balance_snapshots"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "balance_snapshots"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+	// WalletSnapshotTable is the table that holds the wallet_snapshot relation/edge.
+	WalletSnapshotTable = "balance_snapshots"
+	// WalletSnapshotInverseTable is the table name for the WalletSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "walletsnapshot" package.
+	WalletSnapshotInverseTable = "wallet_snapshots"
+	// WalletSnapshotColumn is the table column denoting the wallet_snapshot relation/edge.
+	WalletSnapshotColumn = "wallet_snapshot_id"
+)
+
+// Columns holds all SQL columns for balancesnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldAccountID,
+	FieldBalanceRaw,
+	FieldSnapshotAt,
+	FieldWalletSnapshotID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// BalanceRawValidator is a validator for the "balance_raw" field. It is called by the builders before save.
+	BalanceRawValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package balancesnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAccountID), v))
+	})
+}
+
+// BalanceRaw applies equality check predicate on the "balance_raw" field. It's identical to BalanceRawEQ.
+func BalanceRaw(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBalanceRaw), v))
+	})
+}
+
+// SnapshotAt applies equality check predicate on the "snapshot_at" field. It's identical to SnapshotAtEQ.
+func SnapshotAt(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSnapshotAt), v))
+	})
+}
+
+// WalletSnapshotID applies equality check predicate on the "wallet_snapshot_id" field. It's identical to WalletSnapshotIDEQ.
+func WalletSnapshotID(v uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletSnapshotID), v))
+	})
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAccountID), v))
+	})
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAccountID), v))
+	})
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...uuid.UUID) predicate.BalanceSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAccountID), v...))
+	})
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...uuid.UUID) predicate.BalanceSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAccountID), v...))
+	})
+}
+
+// BalanceRawEQ applies the EQ predicate on the "balance_raw" field.
+func BalanceRawEQ(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBalanceRaw), v))
+	})
+}
+
+// BalanceRawNEQ applies the NEQ predicate on the "balance_raw" field.
+func BalanceRawNEQ(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBalanceRaw), v))
+	})
+}
+
+// BalanceRawIn applies the In predicate on the "balance_raw" field.
+func BalanceRawIn(vs ...string) predicate.BalanceSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldBalanceRaw), v...))
+	})
+}
+
+// BalanceRawNotIn applies the NotIn predicate on the "balance_raw" field.
+func BalanceRawNotIn(vs ...string) predicate.BalanceSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldBalanceRaw), v...))
+	})
+}
+
+// BalanceRawGT applies the GT predicate on the "balance_raw" field.
+func BalanceRawGT(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBalanceRaw), v))
+	})
+}
+
+// BalanceRawGTE applies the GTE predicate on the "balance_raw" field.
+func BalanceRawGTE(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBalanceRaw), v))
+	})
+}
+
+// BalanceRawLT applies the LT predicate on the "balance_raw" field.
+func BalanceRawLT(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBalanceRaw), v))
+	})
+}
+
+// BalanceRawLTE applies the LTE predicate on the "balance_raw" field.
+func BalanceRawLTE(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBalanceRaw), v))
+	})
+}
+
+// BalanceRawContains applies the Contains predicate on the "balance_raw" field.
+func BalanceRawContains(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldBalanceRaw), v))
+	})
+}
+
+// BalanceRawHasPrefix applies the HasPrefix predicate on the "balance_raw" field.
+func BalanceRawHasPrefix(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldBalanceRaw), v))
+	})
+}
+
+// BalanceRawHasSuffix applies the HasSuffix predicate on the "balance_raw" field.
+func BalanceRawHasSuffix(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldBalanceRaw), v))
+	})
+}
+
+// BalanceRawEqualFold applies the EqualFold predicate on the "balance_raw" field.
+func BalanceRawEqualFold(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldBalanceRaw), v))
+	})
+}
+
+// BalanceRawContainsFold applies the ContainsFold predicate on the "balance_raw" field.
+func BalanceRawContainsFold(v string) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldBalanceRaw), v))
+	})
+}
+
+// SnapshotAtEQ applies the EQ predicate on the "snapshot_at" field.
+func SnapshotAtEQ(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSnapshotAt), v))
+	})
+}
+
+// SnapshotAtNEQ applies the NEQ predicate on the "snapshot_at" field.
+func SnapshotAtNEQ(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSnapshotAt), v))
+	})
+}
+
+// SnapshotAtIn applies the In predicate on the "snapshot_at" field.
+func SnapshotAtIn(vs ...time.Time) predicate.BalanceSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldSnapshotAt), v...))
+	})
+}
+
+// SnapshotAtNotIn applies the NotIn predicate on the "snapshot_at" field.
+func SnapshotAtNotIn(vs ...time.Time) predicate.BalanceSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldSnapshotAt), v...))
+	})
+}
+
+// SnapshotAtGT applies the GT predicate on the "snapshot_at" field.
+func SnapshotAtGT(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSnapshotAt), v))
+	})
+}
+
+// SnapshotAtGTE applies the GTE predicate on the "snapshot_at" field.
+func SnapshotAtGTE(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSnapshotAt), v))
+	})
+}
+
+// SnapshotAtLT applies the LT predicate on the "snapshot_at" field.
+func SnapshotAtLT(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSnapshotAt), v))
+	})
+}
+
+// SnapshotAtLTE applies the LTE predicate on the "snapshot_at" field.
+func SnapshotAtLTE(v time.Time) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSnapshotAt), v))
+	})
+}
+
+// WalletSnapshotIDEQ applies the EQ predicate on the "wallet_snapshot_id" field.
+func WalletSnapshotIDEQ(v uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletSnapshotID), v))
+	})
+}
+
+// WalletSnapshotIDNEQ applies the NEQ predicate on the "wallet_snapshot_id" field.
+func WalletSnapshotIDNEQ(v uuid.UUID) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWalletSnapshotID), v))
+	})
+}
+
+// WalletSnapshotIDIn applies the In predicate on the "wallet_snapshot_id" field.
+func WalletSnapshotIDIn(vs ...uuid.UUID) predicate.BalanceSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldWalletSnapshotID), v...))
+	})
+}
+
+// WalletSnapshotIDNotIn applies the NotIn predicate on the "wallet_snapshot_id" field.
+func WalletSnapshotIDNotIn(vs ...uuid.UUID) predicate.BalanceSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldWalletSnapshotID), v...))
+	})
+}
+
+// WalletSnapshotIDIsNil applies the IsNil predicate on the "wallet_snapshot_id" field.
+func WalletSnapshotIDIsNil() predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldWalletSnapshotID)))
+	})
+}
+
+// WalletSnapshotIDNotNil applies the NotNil predicate on the "wallet_snapshot_id" field.
+func WalletSnapshotIDNotNil() predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldWalletSnapshotID)))
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AccountTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AccountInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWalletSnapshot applies the HasEdge predicate on the "wallet_snapshot" edge.
+func HasWalletSnapshot() predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletSnapshotTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletSnapshotTable, WalletSnapshotColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWalletSnapshotWith applies the HasEdge predicate on the "wallet_snapshot" edge with a given conditions (other predicates).
+func HasWalletSnapshotWith(preds ...predicate.WalletSnapshot) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletSnapshotInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletSnapshotTable, WalletSnapshotColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BalanceSnapshot) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BalanceSnapshot) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BalanceSnapshot) predicate.BalanceSnapshot {
+	return predicate.BalanceSnapshot(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,357 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/google/uuid"
+)
+
+// BalanceSnapshotCreate is the builder for creating a BalanceSnapshot entity.
+type BalanceSnapshotCreate struct {
+	config
+	mutation *BalanceSnapshotMutation
+	hooks    []Hook
+}
+
+// SetAccountID sets the "account_id" field.
+func (bsc *BalanceSnapshotCreate) SetAccountID(u uuid.UUID) *BalanceSnapshotCreate {
+	bsc.mutation.SetAccountID(u)
+	return bsc
+}
+
+// SetBalanceRaw sets the "balance_raw" field.
+func (bsc *BalanceSnapshotCreate) SetBalanceRaw(s string) *BalanceSnapshotCreate {
+	bsc.mutation.SetBalanceRaw(s)
+	return bsc
+}
+
+// SetSnapshotAt sets the "snapshot_at" field.
+func (bsc *BalanceSnapshotCreate) SetSnapshotAt(t time.Time) *BalanceSnapshotCreate {
+	bsc.mutation.SetSnapshotAt(t)
+	return bsc
+}
+
+// SetWalletSnapshotID sets the "wallet_snapshot_id" field.
+func (bsc *BalanceSnapshotCreate) SetWalletSnapshotID(u uuid.UUID) *BalanceSnapshotCreate {
+	bsc.mutation.SetWalletSnapshotID(u)
+	return bsc
+}
+
+// SetNillableWalletSnapshotID sets the "wallet_snapshot_id" field if the given value is not nil.
+func (bsc *BalanceSnapshotCreate) SetNillableWalletSnapshotID(u *uuid.UUID) *BalanceSnapshotCreate {
+	if u != nil {
+		bsc.SetWalletSnapshotID(*u)
+	}
+	return bsc
+}
+
+// SetID sets the "id" field.
+func (bsc *BalanceSnapshotCreate) SetID(u uuid.UUID) *BalanceSnapshotCreate {
+	bsc.mutation.SetID(u)
+	return bsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (bsc *BalanceSnapshotCreate) SetNillableID(u *uuid.UUID) *BalanceSnapshotCreate {
+	if u != nil {
+		bsc.SetID(*u)
+	}
+	return bsc
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (bsc *BalanceSnapshotCreate) SetAccount(a *Account) *BalanceSnapshotCreate {
+	return bsc.SetAccountID(a.ID)
+}
+
+// SetWalletSnapshot sets the "wallet_snapshot" edge to the WalletSnapshot entity.
+func (bsc *BalanceSnapshotCreate) SetWalletSnapshot(w *WalletSnapshot) *BalanceSnapshotCreate {
+	return bsc.SetWalletSnapshotID(w.ID)
+}
+
+// Mutation returns the BalanceSnapshotMutation object of the builder.
+func (bsc *BalanceSnapshotCreate) Mutation() *BalanceSnapshotMutation {
+	return bsc.mutation
+}
+
+// Save creates the BalanceSnapshot in the database.
+func (bsc *BalanceSnapshotCreate) Save(ctx context.Context) (*BalanceSnapshot, error) {
+	var (
+		err  error
+		node *BalanceSnapshot
+	)
+	bsc.defaults()
+	if len(bsc.hooks) == 0 {
+		if err = bsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = bsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BalanceSnapshotMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bsc.check(); err != nil {
+				return nil, err
+			}
+			bsc.mutation = mutation
+			if node, err = bsc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bsc.hooks) - 1; i >= 0; i-- {
+			if bsc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bsc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, bsc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*BalanceSnapshot)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BalanceSnapshotMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bsc *BalanceSnapshotCreate) SaveX(ctx context.Context) *BalanceSnapshot {
+	v, err := bsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bsc *BalanceSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := bsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsc *BalanceSnapshotCreate) ExecX(ctx context.Context) {
+	if err := bsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bsc *BalanceSnapshotCreate) defaults() {
+	if _, ok := bsc.mutation.ID(); !ok {
+		v := balancesnapshot.DefaultID()
+		bsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsc *BalanceSnapshotCreate) check() error {
+	if _, ok := bsc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "BalanceSnapshot.account_id"`)}
+	}
+	if _, ok := bsc.mutation.BalanceRaw(); !ok {
+		return &ValidationError{Name: "balance_raw", err: errors.New(`ent: missing required field "BalanceSnapshot.balance_raw"`)}
+	}
+	if v, ok := bsc.mutation.BalanceRaw(); ok {
+		if err := balancesnapshot.BalanceRawValidator(v); err != nil {
+			return &ValidationError{Name: "balance_raw", err: fmt.Errorf(`ent: validator failed for field "BalanceSnapshot.balance_raw": %w`, err)}
+		}
+	}
+	if _, ok := bsc.mutation.SnapshotAt(); !ok {
+		return &ValidationError{Name: "snapshot_at", err: errors.New(`ent: missing required field "BalanceSnapshot.snapshot_at"`)}
+	}
+	if _, ok := bsc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "BalanceSnapshot.account"`)}
+	}
+	return nil
+}
+
+func (bsc *BalanceSnapshotCreate) sqlSave(ctx context.Context) (*BalanceSnapshot, error) {
+	_node, _spec := bsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (bsc *BalanceSnapshotCreate) createSpec() (*BalanceSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BalanceSnapshot{config: bsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: balancesnapshot.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: balancesnapshot.FieldID,
+			},
+		}
+	)
+	if id, ok := bsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := bsc.mutation.BalanceRaw(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: balancesnapshot.FieldBalanceRaw,
+		})
+		_node.BalanceRaw = value
+	}
+	if value, ok := bsc.mutation.SnapshotAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: balancesnapshot.FieldSnapshotAt,
+		})
+		_node.SnapshotAt = value
+	}
+	if nodes := bsc.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancesnapshot.AccountTable,
+			Columns: []string{balancesnapshot.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bsc.mutation.WalletSnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancesnapshot.WalletSnapshotTable,
+			Columns: []string{balancesnapshot.WalletSnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletsnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WalletSnapshotID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BalanceSnapshotCreateBulk is the builder for creating many BalanceSnapshot entities in bulk.
+type BalanceSnapshotCreateBulk struct {
+	config
+	builders []*BalanceSnapshotCreate
+}
+
+// Save creates the BalanceSnapshot entities in the database.
+func (bscb *BalanceSnapshotCreateBulk) Save(ctx context.Context) ([]*BalanceSnapshot, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(bscb.builders))
+	nodes := make([]*BalanceSnapshot, len(bscb.builders))
+	mutators := make([]Mutator, len(bscb.builders))
+	for i := range bscb.builders {
+		func(i int, root context.Context) {
+			builder := bscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BalanceSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bscb *BalanceSnapshotCreateBulk) SaveX(ctx context.Context) []*BalanceSnapshot {
+	v, err := bscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bscb *BalanceSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := bscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bscb *BalanceSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := bscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/google/uuid"
+)
+
+// BalanceSnapshotQuery is the builder for querying BalanceSnapshot entities.
+type BalanceSnapshotQuery struct {
+	config
+	limit              *int
+	offset             *int
+	unique             *bool
+	order              []OrderFunc
+	fields             []string
+	predicates         []predicate.BalanceSnapshot
+	withAccount        *AccountQuery
+	withWalletSnapshot *WalletSnapshotQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BalanceSnapshotQuery builder.
+func (bsq *BalanceSnapshotQuery) Where(ps ...predicate.BalanceSnapshot) *BalanceSnapshotQuery {
+	bsq.predicates = append(bsq.predicates, ps...)
+	return bsq
+}
+
+// Limit adds a limit step to the query.
+func (bsq *BalanceSnapshotQuery) Limit(limit int) *BalanceSnapshotQuery {
+	bsq.limit = &limit
+	return bsq
+}
+
+// Offset adds an offset step to the query.
+func (bsq *BalanceSnapshotQuery) Offset(offset int) *BalanceSnapshotQuery {
+	bsq.offset = &offset
+	return bsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bsq *BalanceSnapshotQuery) Unique(unique bool) *BalanceSnapshotQuery {
+	bsq.unique = &unique
+	return bsq
+}
+
+// Order adds an order step to the query.
+func (bsq *BalanceSnapshotQuery) Order(o ...OrderFunc) *BalanceSnapshotQuery {
+	bsq.order = append(bsq.order, o...)
+	return bsq
+}
+
+// QueryAccount chains the current query on the "account" edge.
+func (bsq *BalanceSnapshotQuery) QueryAccount() *AccountQuery {
+	query := &AccountQuery{config: bsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(balancesnapshot.Table, balancesnapshot.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, balancesnapshot.AccountTable, balancesnapshot.AccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWalletSnapshot chains the current query on the "wallet_snapshot" edge.
+func (bsq *BalanceSnapshotQuery) QueryWalletSnapshot() *WalletSnapshotQuery {
+	query := &WalletSnapshotQuery{config: bsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(balancesnapshot.Table, balancesnapshot.FieldID, selector),
+			sqlgraph.To(walletsnapshot.Table, walletsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, balancesnapshot.WalletSnapshotTable, balancesnapshot.WalletSnapshotColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BalanceSnapshot entity from the query.
+// Returns a *NotFoundError when no BalanceSnapshot was found.
+func (bsq *BalanceSnapshotQuery) First(ctx context.Context) (*BalanceSnapshot, error) {
+	nodes, err := bsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{balancesnapshot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bsq *BalanceSnapshotQuery) FirstX(ctx context.Context) *BalanceSnapshot {
+	node, err := bsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BalanceSnapshot ID from the query.
+// Returns a *NotFoundError when no BalanceSnapshot ID was found.
+func (bsq *BalanceSnapshotQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = bsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{balancesnapshot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bsq *BalanceSnapshotQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := bsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BalanceSnapshot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BalanceSnapshot entity is found.
+// Returns a *NotFoundError when no BalanceSnapshot entities are found.
+func (bsq *BalanceSnapshotQuery) Only(ctx context.Context) (*BalanceSnapshot, error) {
+	nodes, err := bsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{balancesnapshot.Label}
+	default:
+		return nil, &NotSingularError{balancesnapshot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bsq *BalanceSnapshotQuery) OnlyX(ctx context.Context) *BalanceSnapshot {
+	node, err := bsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BalanceSnapshot ID in the query.
+// Returns a *NotSingularError when more than one BalanceSnapshot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bsq *BalanceSnapshotQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = bsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{balancesnapshot.Label}
+	default:
+		err = &NotSingularError{balancesnapshot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bsq *BalanceSnapshotQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := bsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BalanceSnapshots.
+func (bsq *BalanceSnapshotQuery) All(ctx context.Context) ([]*BalanceSnapshot, error) {
+	if err := bsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return bsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bsq *BalanceSnapshotQuery) AllX(ctx context.Context) []*BalanceSnapshot {
+	nodes, err := bsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BalanceSnapshot IDs.
+func (bsq *BalanceSnapshotQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := bsq.Select(balancesnapshot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bsq *BalanceSnapshotQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := bsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bsq *BalanceSnapshotQuery) Count(ctx context.Context) (int, error) {
+	if err := bsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return bsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bsq *BalanceSnapshotQuery) CountX(ctx context.Context) int {
+	count, err := bsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bsq *BalanceSnapshotQuery) Exist(ctx context.Context) (bool, error) {
+	if err := bsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return bsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bsq *BalanceSnapshotQuery) ExistX(ctx context.Context) bool {
+	exist, err := bsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BalanceSnapshotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bsq *BalanceSnapshotQuery) Clone() *BalanceSnapshotQuery {
+	if bsq == nil {
+		return nil
+	}
+	return &BalanceSnapshotQuery{
+		config:             bsq.config,
+		limit:              bsq.limit,
+		offset:             bsq.offset,
+		order:              append([]OrderFunc{}, bsq.order...),
+		predicates:         append([]predicate.BalanceSnapshot{}, bsq.predicates...),
+		withAccount:        bsq.withAccount.Clone(),
+		withWalletSnapshot: bsq.withWalletSnapshot.Clone(),
+		// clone intermediate query.
+		sql:    bsq.sql.Clone(),
+		path:   bsq.path,
+		unique: bsq.unique,
+	}
+}
+
+// WithAccount tells the query-builder to eager-load the nodes that are connected to
+// the "account" edge. The optional arguments are used to configure the query builder of the edge.
+func (bsq *BalanceSnapshotQuery) WithAccount(opts ...func(*AccountQuery)) *BalanceSnapshotQuery {
+	query := &AccountQuery{config: bsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bsq.withAccount = query
+	return bsq
+}
+
+// WithWalletSnapshot tells the query-builder to eager-load the nodes that are connected to
+// the "wallet_snapshot" edge. The optional arguments are used to configure the query builder of the edge.
+func (bsq *BalanceSnapshotQuery) WithWalletSnapshot(opts ...func(*WalletSnapshotQuery)) *BalanceSnapshotQuery {
+	query := &WalletSnapshotQuery{config: bsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bsq.withWalletSnapshot = query
+	return bsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AccountID uuid.UUID `json:"account_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BalanceSnapshot.Query().
+//		GroupBy(balancesnapshot.FieldAccountID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (bsq *BalanceSnapshotQuery) GroupBy(field string, fields ...string) *BalanceSnapshotGroupBy {
+	grbuild := &BalanceSnapshotGroupBy{config: bsq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := bsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return bsq.sqlQuery(ctx), nil
+	}
+	grbuild.label = balancesnapshot.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AccountID uuid.UUID `json:"account_id,omitempty"`
+//	}
+//
+//	client.BalanceSnapshot.Query().
+//		Select(balancesnapshot.FieldAccountID).
+//		Scan(ctx, &v)
+func (bsq *BalanceSnapshotQuery) Select(fields ...string) *BalanceSnapshotSelect {
+	bsq.fields = append(bsq.fields, fields...)
+	selbuild := &BalanceSnapshotSelect{BalanceSnapshotQuery: bsq}
+	selbuild.label = balancesnapshot.Label
+	selbuild.flds, selbuild.scan = &bsq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (bsq *BalanceSnapshotQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range bsq.fields {
+		if !balancesnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if bsq.path != nil {
+		prev, err := bsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bsq.sql = prev
+	}
+	return nil
+}
+
+func (bsq *BalanceSnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BalanceSnapshot, error) {
+	var (
+		nodes       = []*BalanceSnapshot{}
+		_spec       = bsq.querySpec()
+		loadedTypes = [2]bool{
+			bsq.withAccount != nil,
+			bsq.withWalletSnapshot != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*BalanceSnapshot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &BalanceSnapshot{config: bsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := bsq.withAccount; query != nil {
+		if err := bsq.loadAccount(ctx, query, nodes, nil,
+			func(n *BalanceSnapshot, e *Account) { n.Edges.Account = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bsq.withWalletSnapshot; query != nil {
+		if err := bsq.loadWalletSnapshot(ctx, query, nodes, nil,
+			func(n *BalanceSnapshot, e *WalletSnapshot) { n.Edges.WalletSnapshot = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (bsq *BalanceSnapshotQuery) loadAccount(ctx context.Context, query *AccountQuery, nodes []*BalanceSnapshot, init func(*BalanceSnapshot), assign func(*BalanceSnapshot, *Account)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BalanceSnapshot)
+	for i := range nodes {
+		fk := nodes[i].AccountID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bsq *BalanceSnapshotQuery) loadWalletSnapshot(ctx context.Context, query *WalletSnapshotQuery, nodes []*BalanceSnapshot, init func(*BalanceSnapshot), assign func(*BalanceSnapshot, *WalletSnapshot)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*BalanceSnapshot)
+	for i := range nodes {
+		if nodes[i].WalletSnapshotID == nil {
+			continue
+		}
+		fk := *nodes[i].WalletSnapshotID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(walletsnapshot.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_snapshot_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (bsq *BalanceSnapshotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bsq.querySpec()
+	_spec.Node.Columns = bsq.fields
+	if len(bsq.fields) > 0 {
+		_spec.Unique = bsq.unique != nil && *bsq.unique
+	}
+	return sqlgraph.CountNodes(ctx, bsq.driver, _spec)
+}
+
+func (bsq *BalanceSnapshotQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := bsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (bsq *BalanceSnapshotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   balancesnapshot.Table,
+			Columns: balancesnapshot.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: balancesnapshot.FieldID,
+			},
+		},
+		From:   bsq.sql,
+		Unique: true,
+	}
+	if unique := bsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := bsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balancesnapshot.FieldID)
+		for i := range fields {
+			if fields[i] != balancesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := bsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bsq *BalanceSnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bsq.driver.Dialect())
+	t1 := builder.Table(balancesnapshot.Table)
+	columns := bsq.fields
+	if len(columns) == 0 {
+		columns = balancesnapshot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bsq.sql != nil {
+		selector = bsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bsq.unique != nil && *bsq.unique {
+		selector.Distinct()
+	}
+	for _, p := range bsq.predicates {
+		p(selector)
+	}
+	for _, p := range bsq.order {
+		p(selector)
+	}
+	if offset := bsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BalanceSnapshotGroupBy is the group-by builder for BalanceSnapshot entities.
+type BalanceSnapshotGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bsgb *BalanceSnapshotGroupBy) Aggregate(fns ...AggregateFunc) *BalanceSnapshotGroupBy {
+	bsgb.fns = append(bsgb.fns, fns...)
+	return bsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (bsgb *BalanceSnapshotGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := bsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	bsgb.sql = query
+	return bsgb.sqlScan(ctx, v)
+}
+
+func (bsgb *BalanceSnapshotGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range bsgb.fields {
+		if !balancesnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := bsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bsgb *BalanceSnapshotGroupBy) sqlQuery() *sql.Selector {
+	selector := bsgb.sql.Select()
+	aggregation := make([]string, 0, len(bsgb.fns))
+	for _, fn := range bsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(bsgb.fields)+len(bsgb.fns))
+		for _, f := range bsgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(bsgb.fields...)...)
+}
+
+// BalanceSnapshotSelect is the builder for selecting fields of BalanceSnapshot entities.
+type BalanceSnapshotSelect struct {
+	*BalanceSnapshotQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bss *BalanceSnapshotSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := bss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	bss.sql = bss.BalanceSnapshotQuery.sqlQuery(ctx)
+	return bss.sqlScan(ctx, v)
+}
+
+func (bss *BalanceSnapshotSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := bss.sql.Query()
+	if err := bss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/google/uuid"
+)
+
+// BalanceSnapshotUpdate is the builder for updating BalanceSnapshot entities.
+type BalanceSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BalanceSnapshotMutation
+}
+
+// Where appends a list predicates to the BalanceSnapshotUpdate builder.
+func (bsu *BalanceSnapshotUpdate) Where(ps ...predicate.BalanceSnapshot) *BalanceSnapshotUpdate {
+	bsu.mutation.Where(ps...)
+	return bsu
+}
+
+// SetAccountID sets the "account_id" field.
+func (bsu *BalanceSnapshotUpdate) SetAccountID(u uuid.UUID) *BalanceSnapshotUpdate {
+	bsu.mutation.SetAccountID(u)
+	return bsu
+}
+
+// SetWalletSnapshotID sets the "wallet_snapshot_id" field.
+func (bsu *BalanceSnapshotUpdate) SetWalletSnapshotID(u uuid.UUID) *BalanceSnapshotUpdate {
+	bsu.mutation.SetWalletSnapshotID(u)
+	return bsu
+}
+
+// SetNillableWalletSnapshotID sets the "wallet_snapshot_id" field if the given value is not nil.
+func (bsu *BalanceSnapshotUpdate) SetNillableWalletSnapshotID(u *uuid.UUID) *BalanceSnapshotUpdate {
+	if u != nil {
+		bsu.SetWalletSnapshotID(*u)
+	}
+	return bsu
+}
+
+// ClearWalletSnapshotID clears the value of the "wallet_snapshot_id" field.
+func (bsu *BalanceSnapshotUpdate) ClearWalletSnapshotID() *BalanceSnapshotUpdate {
+	bsu.mutation.ClearWalletSnapshotID()
+	return bsu
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (bsu *BalanceSnapshotUpdate) SetAccount(a *Account) *BalanceSnapshotUpdate {
+	return bsu.SetAccountID(a.ID)
+}
+
+// SetWalletSnapshot sets the "wallet_snapshot" edge to the WalletSnapshot entity.
+func (bsu *BalanceSnapshotUpdate) SetWalletSnapshot(w *WalletSnapshot) *BalanceSnapshotUpdate {
+	return bsu.SetWalletSnapshotID(w.ID)
+}
+
+// Mutation returns the BalanceSnapshotMutation object of the builder.
+func (bsu *BalanceSnapshotUpdate) Mutation() *BalanceSnapshotMutation {
+	return bsu.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (bsu *BalanceSnapshotUpdate) ClearAccount() *BalanceSnapshotUpdate {
+	bsu.mutation.ClearAccount()
+	return bsu
+}
+
+// ClearWalletSnapshot clears the "wallet_snapshot" edge to the WalletSnapshot entity.
+func (bsu *BalanceSnapshotUpdate) ClearWalletSnapshot() *BalanceSnapshotUpdate {
+	bsu.mutation.ClearWalletSnapshot()
+	return bsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bsu *BalanceSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(bsu.hooks) == 0 {
+		if err = bsu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = bsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BalanceSnapshotMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bsu.check(); err != nil {
+				return 0, err
+			}
+			bsu.mutation = mutation
+			affected, err = bsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(bsu.hooks) - 1; i >= 0; i-- {
+			if bsu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bsu *BalanceSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := bsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bsu *BalanceSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := bsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsu *BalanceSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := bsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsu *BalanceSnapshotUpdate) check() error {
+	if _, ok := bsu.mutation.AccountID(); bsu.mutation.AccountCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "BalanceSnapshot.account"`)
+	}
+	return nil
+}
+
+func (bsu *BalanceSnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   balancesnapshot.Table,
+			Columns: balancesnapshot.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: balancesnapshot.FieldID,
+			},
+		},
+	}
+	if ps := bsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if bsu.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancesnapshot.AccountTable,
+			Columns: []string{balancesnapshot.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bsu.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancesnapshot.AccountTable,
+			Columns: []string{balancesnapshot.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bsu.mutation.WalletSnapshotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancesnapshot.WalletSnapshotTable,
+			Columns: []string{balancesnapshot.WalletSnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletsnapshot.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bsu.mutation.WalletSnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancesnapshot.WalletSnapshotTable,
+			Columns: []string{balancesnapshot.WalletSnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletsnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BalanceSnapshotUpdateOne is the builder for updating a single BalanceSnapshot entity.
+type BalanceSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BalanceSnapshotMutation
+}
+
+// SetAccountID sets the "account_id" field.
+func (bsuo *BalanceSnapshotUpdateOne) SetAccountID(u uuid.UUID) *BalanceSnapshotUpdateOne {
+	bsuo.mutation.SetAccountID(u)
+	return bsuo
+}
+
+// SetWalletSnapshotID sets the "wallet_snapshot_id" field.
+func (bsuo *BalanceSnapshotUpdateOne) SetWalletSnapshotID(u uuid.UUID) *BalanceSnapshotUpdateOne {
+	bsuo.mutation.SetWalletSnapshotID(u)
+	return bsuo
+}
+
+// SetNillableWalletSnapshotID sets the "wallet_snapshot_id" field if the given value is not nil.
+func (bsuo *BalanceSnapshotUpdateOne) SetNillableWalletSnapshotID(u *uuid.UUID) *BalanceSnapshotUpdateOne {
+	if u != nil {
+		bsuo.SetWalletSnapshotID(*u)
+	}
+	return bsuo
+}
+
+// ClearWalletSnapshotID clears the value of the "wallet_snapshot_id" field.
+func (bsuo *BalanceSnapshotUpdateOne) ClearWalletSnapshotID() *BalanceSnapshotUpdateOne {
+	bsuo.mutation.ClearWalletSnapshotID()
+	return bsuo
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (bsuo *BalanceSnapshotUpdateOne) SetAccount(a *Account) *BalanceSnapshotUpdateOne {
+	return bsuo.SetAccountID(a.ID)
+}
+
+// SetWalletSnapshot sets the "wallet_snapshot" edge to the WalletSnapshot entity.
+func (bsuo *BalanceSnapshotUpdateOne) SetWalletSnapshot(w *WalletSnapshot) *BalanceSnapshotUpdateOne {
+	return bsuo.SetWalletSnapshotID(w.ID)
+}
+
+// Mutation returns the BalanceSnapshotMutation object of the builder.
+func (bsuo *BalanceSnapshotUpdateOne) Mutation() *BalanceSnapshotMutation {
+	return bsuo.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (bsuo *BalanceSnapshotUpdateOne) ClearAccount() *BalanceSnapshotUpdateOne {
+	bsuo.mutation.ClearAccount()
+	return bsuo
+}
+
+// ClearWalletSnapshot clears the "wallet_snapshot" edge to the WalletSnapshot entity.
+func (bsuo *BalanceSnapshotUpdateOne) ClearWalletSnapshot() *BalanceSnapshotUpdateOne {
+	bsuo.mutation.ClearWalletSnapshot()
+	return bsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bsuo *BalanceSnapshotUpdateOne) Select(field string, fields ...string) *BalanceSnapshotUpdateOne {
+	bsuo.fields = append([]string{field}, fields...)
+	return bsuo
+}
+
+// Save executes the query and returns the updated BalanceSnapshot entity.
+func (bsuo *BalanceSnapshotUpdateOne) Save(ctx context.Context) (*BalanceSnapshot, error) {
+	var (
+		err  error
+		node *BalanceSnapshot
+	)
+	if len(bsuo.hooks) == 0 {
+		if err = bsuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = bsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BalanceSnapshotMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = bsuo.check(); err != nil {
+				return nil, err
+			}
+			bsuo.mutation = mutation
+			node, err = bsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(bsuo.hooks) - 1; i >= 0; i-- {
+			if bsuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = bsuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, bsuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*BalanceSnapshot)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from BalanceSnapshotMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bsuo *BalanceSnapshotUpdateOne) SaveX(ctx context.Context) *BalanceSnapshot {
+	node, err := bsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bsuo *BalanceSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := bsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bsuo *BalanceSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := bsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bsuo *BalanceSnapshotUpdateOne) check() error {
+	if _, ok := bsuo.mutation.AccountID(); bsuo.mutation.AccountCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "BalanceSnapshot.account"`)
+	}
+	return nil
+}
+
+func (bsuo *BalanceSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *BalanceSnapshot, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   balancesnapshot.Table,
+			Columns: balancesnapshot.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: balancesnapshot.FieldID,
+			},
+		},
+	}
+	id, ok := bsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BalanceSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balancesnapshot.FieldID)
+		for _, f := range fields {
+			if !balancesnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != balancesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if bsuo.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancesnapshot.AccountTable,
+			Columns: []string{balancesnapshot.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bsuo.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancesnapshot.AccountTable,
+			Columns: []string{balancesnapshot.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bsuo.mutation.WalletSnapshotCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancesnapshot.WalletSnapshotTable,
+			Columns: []string{balancesnapshot.WalletSnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletsnapshot.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bsuo.mutation.WalletSnapshotIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   balancesnapshot.WalletSnapshotTable,
+			Columns: []string{balancesnapshot.WalletSnapshotColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletsnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BalanceSnapshot{config: bsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
 	"github.com/google/uuid"
 )
 
@@ -25,6 +26,8 @@ type Block struct {
 	BlockHash string `json:"block_hash,omitempty"`
 	// Block holds the value of the "block" field.
 	Block map[string]interface{} `json:"block,omitempty"`
+	// WalletID holds the value of the "wallet_id" field.
+	WalletID *uuid.UUID `json:"wallet_id,omitempty"`
 	// SendID holds the value of the "send_id" field.
 	SendID *string `json:"send_id,omitempty"`
 	// Subtype holds the value of the "subtype" field.
@@ -40,9 +43,11 @@ type Block struct {
 type BlockEdges struct {
 	// Account holds the value of the account edge.
 	Account *Account `json:"account,omitempty"`
+	// Wallet holds the value of the wallet edge.
+	Wallet *Wallet `json:"wallet,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // AccountOrErr returns the Account value or an error if the edge
@@ -58,12 +63,25 @@ func (e BlockEdges) AccountOrErr() (*Account, error) {
 	return nil, &NotLoadedError{edge: "account"}
 }
 
+// WalletOrErr returns the Wallet value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlockEdges) WalletOrErr() (*Wallet, error) {
+	if e.loadedTypes[1] {
+		if e.Wallet == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: wallet.Label}
+		}
+		return e.Wallet, nil
+	}
+	return nil, &NotLoadedError{edge: "wallet"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Block) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case block.FieldAccountID:
+		case block.FieldAccountID, block.FieldWalletID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case block.FieldBlock:
 			values[i] = new([]byte)
@@ -115,6 +133,13 @@ func (b *Block) assignValues(columns []string, values []interface{}) error {
 					return fmt.Errorf("unmarshal field block: %w", err)
 				}
 			}
+		case block.FieldWalletID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_id", values[i])
+			} else if value.Valid {
+				b.WalletID = new(uuid.UUID)
+				*b.WalletID = *value.S.(*uuid.UUID)
+			}
 		case block.FieldSendID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field send_id", values[i])
@@ -144,6 +169,11 @@ func (b *Block) QueryAccount() *AccountQuery {
 	return (&BlockClient{config: b.config}).QueryAccount(b)
 }
 
+// QueryWallet queries the "wallet" edge of the Block entity.
+func (b *Block) QueryWallet() *WalletQuery {
+	return (&BlockClient{config: b.config}).QueryWallet(b)
+}
+
 // Update returns a builder for updating this Block.
 // Note that you need to call Block.Unwrap() before calling this method if this Block
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -178,6 +208,11 @@ func (b *Block) String() string {
 	builder.WriteString("block=")
 	builder.WriteString(fmt.Sprintf("%v", b.Block))
 	builder.WriteString(", ")
+	if v := b.WalletID; v != nil {
+		builder.WriteString("wallet_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := b.SendID; v != nil {
 		builder.WriteString("send_id=")
 		builder.WriteString(*v)
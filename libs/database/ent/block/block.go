@@ -19,6 +19,8 @@ const (
 	FieldBlockHash = "block_hash"
 	// FieldBlock holds the string denoting the block field in the database.
 	FieldBlock = "block"
+	// FieldWalletID holds the string denoting the wallet_id field in the database.
+	FieldWalletID = "wallet_id"
 	// FieldSendID holds the string denoting the send_id field in the database.
 	FieldSendID = "send_id"
 	// FieldSubtype holds the string denoting the subtype field in the database.
@@ -27,6 +29,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// EdgeAccount holds the string denoting the account edge name in mutations.
 	EdgeAccount = "account"
+	// EdgeWallet holds the string denoting the wallet edge name in mutations.
+	EdgeWallet = "wallet"
 	// Table holds the table name of the block in the database.
 	Table = "blocks"
 	// AccountTable is the table that holds the account relation/edge.
@@ -36,6 +40,13 @@ const (
 	AccountInverseTable = "accounts"
 	// AccountColumn is the table column denoting the account relation/edge.
 	AccountColumn = "account_id"
+	// WalletTable is the table that holds the wallet relation/edge.
+	WalletTable = "blocks"
+	// WalletInverseTable is the table name for the Wallet entity.
+	// It exists in this package in order to avoid circular dependency with the "wallet" package.
+	WalletInverseTable = "wallets"
+	// WalletColumn is the table column denoting the wallet relation/edge.
+	WalletColumn = "wallet_id"
 )
 
 // Columns holds all SQL columns for block fields.
@@ -44,6 +55,7 @@ var Columns = []string{
 	FieldAccountID,
 	FieldBlockHash,
 	FieldBlock,
+	FieldWalletID,
 	FieldSendID,
 	FieldSubtype,
 	FieldCreatedAt,
@@ -96,6 +96,13 @@ func BlockHash(v string) predicate.Block {
 	})
 }
 
+// WalletID applies equality check predicate on the "wallet_id" field. It's identical to WalletIDEQ.
+func WalletID(v uuid.UUID) predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
 // SendID applies equality check predicate on the "send_id" field. It's identical to SendIDEQ.
 func SendID(v string) predicate.Block {
 	return predicate.Block(func(s *sql.Selector) {
@@ -266,6 +273,56 @@ func BlockHashContainsFold(v string) predicate.Block {
 	})
 }
 
+// WalletIDEQ applies the EQ predicate on the "wallet_id" field.
+func WalletIDEQ(v uuid.UUID) predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDNEQ applies the NEQ predicate on the "wallet_id" field.
+func WalletIDNEQ(v uuid.UUID) predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDIn applies the In predicate on the "wallet_id" field.
+func WalletIDIn(vs ...uuid.UUID) predicate.Block {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Block(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldWalletID), v...))
+	})
+}
+
+// WalletIDNotIn applies the NotIn predicate on the "wallet_id" field.
+func WalletIDNotIn(vs ...uuid.UUID) predicate.Block {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Block(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldWalletID), v...))
+	})
+}
+
+// WalletIDIsNil applies the IsNil predicate on the "wallet_id" field.
+func WalletIDIsNil() predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldWalletID)))
+	})
+}
+
+// WalletIDNotNil applies the NotNil predicate on the "wallet_id" field.
+func WalletIDNotNil() predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldWalletID)))
+	})
+}
+
 // SendIDEQ applies the EQ predicate on the "send_id" field.
 func SendIDEQ(v string) predicate.Block {
 	return predicate.Block(func(s *sql.Selector) {
@@ -570,6 +627,34 @@ func HasAccountWith(preds ...predicate.Account) predicate.Block {
 	})
 }
 
+// HasWallet applies the HasEdge predicate on the "wallet" edge.
+func HasWallet() predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWalletWith applies the HasEdge predicate on the "wallet" edge with a given conditions (other predicates).
+func HasWalletWith(preds ...predicate.Wallet) predicate.Block {
+	return predicate.Block(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Block) predicate.Block {
 	return predicate.Block(func(s *sql.Selector) {
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
 	"github.com/google/uuid"
 )
 
@@ -48,6 +49,20 @@ func (bc *BlockCreate) SetBlock(m map[string]interface{}) *BlockCreate {
 	return bc
 }
 
+// SetWalletID sets the "wallet_id" field.
+func (bc *BlockCreate) SetWalletID(u uuid.UUID) *BlockCreate {
+	bc.mutation.SetWalletID(u)
+	return bc
+}
+
+// SetNillableWalletID sets the "wallet_id" field if the given value is not nil.
+func (bc *BlockCreate) SetNillableWalletID(u *uuid.UUID) *BlockCreate {
+	if u != nil {
+		bc.SetWalletID(*u)
+	}
+	return bc
+}
+
 // SetSendID sets the "send_id" field.
 func (bc *BlockCreate) SetSendID(s string) *BlockCreate {
 	bc.mutation.SetSendID(s)
@@ -101,6 +116,11 @@ func (bc *BlockCreate) SetAccount(a *Account) *BlockCreate {
 	return bc.SetAccountID(a.ID)
 }
 
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (bc *BlockCreate) SetWallet(w *Wallet) *BlockCreate {
+	return bc.SetWalletID(w.ID)
+}
+
 // Mutation returns the BlockMutation object of the builder.
 func (bc *BlockCreate) Mutation() *BlockMutation {
 	return bc.mutation
@@ -313,6 +333,26 @@ func (bc *BlockCreate) createSpec() (*Block, *sqlgraph.CreateSpec) {
 		_node.AccountID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := bc.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.WalletTable,
+			Columns: []string{block.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WalletID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
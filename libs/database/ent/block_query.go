@@ -13,6 +13,7 @@ import (
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
 	"github.com/google/uuid"
 )
 
@@ -26,6 +27,7 @@ type BlockQuery struct {
 	fields      []string
 	predicates  []predicate.Block
 	withAccount *AccountQuery
+	withWallet  *WalletQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +86,28 @@ func (bq *BlockQuery) QueryAccount() *AccountQuery {
 	return query
 }
 
+// QueryWallet chains the current query on the "wallet" edge.
+func (bq *BlockQuery) QueryWallet() *WalletQuery {
+	query := &WalletQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(block.Table, block.FieldID, selector),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, block.WalletTable, block.WalletColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Block entity from the query.
 // Returns a *NotFoundError when no Block was found.
 func (bq *BlockQuery) First(ctx context.Context) (*Block, error) {
@@ -266,6 +290,7 @@ func (bq *BlockQuery) Clone() *BlockQuery {
 		order:       append([]OrderFunc{}, bq.order...),
 		predicates:  append([]predicate.Block{}, bq.predicates...),
 		withAccount: bq.withAccount.Clone(),
+		withWallet:  bq.withWallet.Clone(),
 		// clone intermediate query.
 		sql:    bq.sql.Clone(),
 		path:   bq.path,
@@ -284,6 +309,17 @@ func (bq *BlockQuery) WithAccount(opts ...func(*AccountQuery)) *BlockQuery {
 	return bq
 }
 
+// WithWallet tells the query-builder to eager-load the nodes that are connected to
+// the "wallet" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BlockQuery) WithWallet(opts ...func(*WalletQuery)) *BlockQuery {
+	query := &WalletQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withWallet = query
+	return bq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -352,8 +388,9 @@ func (bq *BlockQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Block,
 	var (
 		nodes       = []*Block{}
 		_spec       = bq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			bq.withAccount != nil,
+			bq.withWallet != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -380,6 +417,12 @@ func (bq *BlockQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Block,
 			return nil, err
 		}
 	}
+	if query := bq.withWallet; query != nil {
+		if err := bq.loadWallet(ctx, query, nodes, nil,
+			func(n *Block, e *Wallet) { n.Edges.Wallet = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -412,6 +455,35 @@ func (bq *BlockQuery) loadAccount(ctx context.Context, query *AccountQuery, node
 	}
 	return nil
 }
+func (bq *BlockQuery) loadWallet(ctx context.Context, query *WalletQuery, nodes []*Block, init func(*Block), assign func(*Block, *Wallet)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Block)
+	for i := range nodes {
+		if nodes[i].WalletID == nil {
+			continue
+		}
+		fk := *nodes[i].WalletID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(wallet.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (bq *BlockQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := bq.querySpec()
@@ -13,6 +13,7 @@ import (
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
 	"github.com/google/uuid"
 )
 
@@ -49,6 +50,32 @@ func (bu *BlockUpdate) ClearAccountID() *BlockUpdate {
 	return bu
 }
 
+// SetBlockHash sets the "block_hash" field.
+func (bu *BlockUpdate) SetBlockHash(s string) *BlockUpdate {
+	bu.mutation.SetBlockHash(s)
+	return bu
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (bu *BlockUpdate) SetWalletID(u uuid.UUID) *BlockUpdate {
+	bu.mutation.SetWalletID(u)
+	return bu
+}
+
+// SetNillableWalletID sets the "wallet_id" field if the given value is not nil.
+func (bu *BlockUpdate) SetNillableWalletID(u *uuid.UUID) *BlockUpdate {
+	if u != nil {
+		bu.SetWalletID(*u)
+	}
+	return bu
+}
+
+// ClearWalletID clears the value of the "wallet_id" field.
+func (bu *BlockUpdate) ClearWalletID() *BlockUpdate {
+	bu.mutation.ClearWalletID()
+	return bu
+}
+
 // SetSubtype sets the "subtype" field.
 func (bu *BlockUpdate) SetSubtype(s string) *BlockUpdate {
 	bu.mutation.SetSubtype(s)
@@ -60,6 +87,11 @@ func (bu *BlockUpdate) SetAccount(a *Account) *BlockUpdate {
 	return bu.SetAccountID(a.ID)
 }
 
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (bu *BlockUpdate) SetWallet(w *Wallet) *BlockUpdate {
+	return bu.SetWalletID(w.ID)
+}
+
 // Mutation returns the BlockMutation object of the builder.
 func (bu *BlockUpdate) Mutation() *BlockMutation {
 	return bu.mutation
@@ -71,6 +103,12 @@ func (bu *BlockUpdate) ClearAccount() *BlockUpdate {
 	return bu
 }
 
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (bu *BlockUpdate) ClearWallet() *BlockUpdate {
+	bu.mutation.ClearWallet()
+	return bu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (bu *BlockUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -133,6 +171,11 @@ func (bu *BlockUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (bu *BlockUpdate) check() error {
+	if v, ok := bu.mutation.BlockHash(); ok {
+		if err := block.BlockHashValidator(v); err != nil {
+			return &ValidationError{Name: "block_hash", err: fmt.Errorf(`ent: validator failed for field "Block.block_hash": %w`, err)}
+		}
+	}
 	if v, ok := bu.mutation.Subtype(); ok {
 		if err := block.SubtypeValidator(v); err != nil {
 			return &ValidationError{Name: "subtype", err: fmt.Errorf(`ent: validator failed for field "Block.subtype": %w`, err)}
@@ -159,6 +202,13 @@ func (bu *BlockUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := bu.mutation.BlockHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: block.FieldBlockHash,
+		})
+	}
 	if bu.mutation.SendIDCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -207,6 +257,41 @@ func (bu *BlockUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if bu.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.WalletTable,
+			Columns: []string{block.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.WalletTable,
+			Columns: []string{block.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{block.Label}
@@ -246,6 +331,32 @@ func (buo *BlockUpdateOne) ClearAccountID() *BlockUpdateOne {
 	return buo
 }
 
+// SetBlockHash sets the "block_hash" field.
+func (buo *BlockUpdateOne) SetBlockHash(s string) *BlockUpdateOne {
+	buo.mutation.SetBlockHash(s)
+	return buo
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (buo *BlockUpdateOne) SetWalletID(u uuid.UUID) *BlockUpdateOne {
+	buo.mutation.SetWalletID(u)
+	return buo
+}
+
+// SetNillableWalletID sets the "wallet_id" field if the given value is not nil.
+func (buo *BlockUpdateOne) SetNillableWalletID(u *uuid.UUID) *BlockUpdateOne {
+	if u != nil {
+		buo.SetWalletID(*u)
+	}
+	return buo
+}
+
+// ClearWalletID clears the value of the "wallet_id" field.
+func (buo *BlockUpdateOne) ClearWalletID() *BlockUpdateOne {
+	buo.mutation.ClearWalletID()
+	return buo
+}
+
 // SetSubtype sets the "subtype" field.
 func (buo *BlockUpdateOne) SetSubtype(s string) *BlockUpdateOne {
 	buo.mutation.SetSubtype(s)
@@ -257,6 +368,11 @@ func (buo *BlockUpdateOne) SetAccount(a *Account) *BlockUpdateOne {
 	return buo.SetAccountID(a.ID)
 }
 
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (buo *BlockUpdateOne) SetWallet(w *Wallet) *BlockUpdateOne {
+	return buo.SetWalletID(w.ID)
+}
+
 // Mutation returns the BlockMutation object of the builder.
 func (buo *BlockUpdateOne) Mutation() *BlockMutation {
 	return buo.mutation
@@ -268,6 +384,12 @@ func (buo *BlockUpdateOne) ClearAccount() *BlockUpdateOne {
 	return buo
 }
 
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (buo *BlockUpdateOne) ClearWallet() *BlockUpdateOne {
+	buo.mutation.ClearWallet()
+	return buo
+}
+
 // Select allows selecting one or more fields (columns) of the returned entity.
 // The default is selecting all fields defined in the entity schema.
 func (buo *BlockUpdateOne) Select(field string, fields ...string) *BlockUpdateOne {
@@ -343,6 +465,11 @@ func (buo *BlockUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (buo *BlockUpdateOne) check() error {
+	if v, ok := buo.mutation.BlockHash(); ok {
+		if err := block.BlockHashValidator(v); err != nil {
+			return &ValidationError{Name: "block_hash", err: fmt.Errorf(`ent: validator failed for field "Block.block_hash": %w`, err)}
+		}
+	}
 	if v, ok := buo.mutation.Subtype(); ok {
 		if err := block.SubtypeValidator(v); err != nil {
 			return &ValidationError{Name: "subtype", err: fmt.Errorf(`ent: validator failed for field "Block.subtype": %w`, err)}
@@ -386,6 +513,13 @@ func (buo *BlockUpdateOne) sqlSave(ctx context.Context) (_node *Block, err error
 			}
 		}
 	}
+	if value, ok := buo.mutation.BlockHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: block.FieldBlockHash,
+		})
+	}
 	if buo.mutation.SendIDCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -434,6 +568,41 @@ func (buo *BlockUpdateOne) sqlSave(ctx context.Context) (_node *Block, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if buo.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.WalletTable,
+			Columns: []string{block.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   block.WalletTable,
+			Columns: []string{block.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Block{config: buo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -12,8 +12,20 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/apikey"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/auditrecord"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancealert"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotencykey"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendschedule"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -27,10 +39,34 @@ type Client struct {
 	Schema *migrate.Schema
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
+	// AuditRecord is the client for interacting with the AuditRecord builders.
+	AuditRecord *AuditRecordClient
+	// BalanceAlert is the client for interacting with the BalanceAlert builders.
+	BalanceAlert *BalanceAlertClient
+	// BalanceSnapshot is the client for interacting with the BalanceSnapshot builders.
+	BalanceSnapshot *BalanceSnapshotClient
 	// Block is the client for interacting with the Block builders.
 	Block *BlockClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// IdempotentSend is the client for interacting with the IdempotentSend builders.
+	IdempotentSend *IdempotentSendClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// RepresentativeHistory is the client for interacting with the RepresentativeHistory builders.
+	RepresentativeHistory *RepresentativeHistoryClient
+	// SendApproval is the client for interacting with the SendApproval builders.
+	SendApproval *SendApprovalClient
+	// SendSchedule is the client for interacting with the SendSchedule builders.
+	SendSchedule *SendScheduleClient
 	// Wallet is the client for interacting with the Wallet builders.
 	Wallet *WalletClient
+	// WalletSnapshot is the client for interacting with the WalletSnapshot builders.
+	WalletSnapshot *WalletSnapshotClient
+	// WalletSpend is the client for interacting with the WalletSpend builders.
+	WalletSpend *WalletSpendClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -45,8 +81,20 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Account = NewAccountClient(c.config)
+	c.ApiKey = NewApiKeyClient(c.config)
+	c.AuditRecord = NewAuditRecordClient(c.config)
+	c.BalanceAlert = NewBalanceAlertClient(c.config)
+	c.BalanceSnapshot = NewBalanceSnapshotClient(c.config)
 	c.Block = NewBlockClient(c.config)
+	c.IdempotencyKey = NewIdempotencyKeyClient(c.config)
+	c.IdempotentSend = NewIdempotentSendClient(c.config)
+	c.Job = NewJobClient(c.config)
+	c.RepresentativeHistory = NewRepresentativeHistoryClient(c.config)
+	c.SendApproval = NewSendApprovalClient(c.config)
+	c.SendSchedule = NewSendScheduleClient(c.config)
 	c.Wallet = NewWalletClient(c.config)
+	c.WalletSnapshot = NewWalletSnapshotClient(c.config)
+	c.WalletSpend = NewWalletSpendClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -78,11 +126,23 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Account: NewAccountClient(cfg),
-		Block:   NewBlockClient(cfg),
-		Wallet:  NewWalletClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		Account:               NewAccountClient(cfg),
+		ApiKey:                NewApiKeyClient(cfg),
+		AuditRecord:           NewAuditRecordClient(cfg),
+		BalanceAlert:          NewBalanceAlertClient(cfg),
+		BalanceSnapshot:       NewBalanceSnapshotClient(cfg),
+		Block:                 NewBlockClient(cfg),
+		IdempotencyKey:        NewIdempotencyKeyClient(cfg),
+		IdempotentSend:        NewIdempotentSendClient(cfg),
+		Job:                   NewJobClient(cfg),
+		RepresentativeHistory: NewRepresentativeHistoryClient(cfg),
+		SendApproval:          NewSendApprovalClient(cfg),
+		SendSchedule:          NewSendScheduleClient(cfg),
+		Wallet:                NewWalletClient(cfg),
+		WalletSnapshot:        NewWalletSnapshotClient(cfg),
+		WalletSpend:           NewWalletSpendClient(cfg),
 	}, nil
 }
 
@@ -100,11 +160,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Account: NewAccountClient(cfg),
-		Block:   NewBlockClient(cfg),
-		Wallet:  NewWalletClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		Account:               NewAccountClient(cfg),
+		ApiKey:                NewApiKeyClient(cfg),
+		AuditRecord:           NewAuditRecordClient(cfg),
+		BalanceAlert:          NewBalanceAlertClient(cfg),
+		BalanceSnapshot:       NewBalanceSnapshotClient(cfg),
+		Block:                 NewBlockClient(cfg),
+		IdempotencyKey:        NewIdempotencyKeyClient(cfg),
+		IdempotentSend:        NewIdempotentSendClient(cfg),
+		Job:                   NewJobClient(cfg),
+		RepresentativeHistory: NewRepresentativeHistoryClient(cfg),
+		SendApproval:          NewSendApprovalClient(cfg),
+		SendSchedule:          NewSendScheduleClient(cfg),
+		Wallet:                NewWalletClient(cfg),
+		WalletSnapshot:        NewWalletSnapshotClient(cfg),
+		WalletSpend:           NewWalletSpendClient(cfg),
 	}, nil
 }
 
@@ -134,8 +206,20 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	c.Account.Use(hooks...)
+	c.ApiKey.Use(hooks...)
+	c.AuditRecord.Use(hooks...)
+	c.BalanceAlert.Use(hooks...)
+	c.BalanceSnapshot.Use(hooks...)
 	c.Block.Use(hooks...)
+	c.IdempotencyKey.Use(hooks...)
+	c.IdempotentSend.Use(hooks...)
+	c.Job.Use(hooks...)
+	c.RepresentativeHistory.Use(hooks...)
+	c.SendApproval.Use(hooks...)
+	c.SendSchedule.Use(hooks...)
 	c.Wallet.Use(hooks...)
+	c.WalletSnapshot.Use(hooks...)
+	c.WalletSpend.Use(hooks...)
 }
 
 // AccountClient is a client for the Account schema.
@@ -255,11 +339,451 @@ func (c *AccountClient) QueryBlocks(a *Account) *BlockQuery {
 	return query
 }
 
+// QueryBalanceSnapshots queries the balance_snapshots edge of a Account.
+func (c *AccountClient) QueryBalanceSnapshots(a *Account) *BalanceSnapshotQuery {
+	query := &BalanceSnapshotQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(balancesnapshot.Table, balancesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BalanceSnapshotsTable, account.BalanceSnapshotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRepresentativeHistory queries the representative_history edge of a Account.
+func (c *AccountClient) QueryRepresentativeHistory(a *Account) *RepresentativeHistoryQuery {
+	query := &RepresentativeHistoryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(representativehistory.Table, representativehistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.RepresentativeHistoryTable, account.RepresentativeHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AccountClient) Hooks() []Hook {
 	return c.hooks.Account
 }
 
+// ApiKeyClient is a client for the ApiKey schema.
+type ApiKeyClient struct {
+	config
+}
+
+// NewApiKeyClient returns a client for the ApiKey from the given config.
+func NewApiKeyClient(c config) *ApiKeyClient {
+	return &ApiKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *ApiKeyClient) Use(hooks ...Hook) {
+	c.hooks.ApiKey = append(c.hooks.ApiKey, hooks...)
+}
+
+// Create returns a builder for creating a ApiKey entity.
+func (c *ApiKeyClient) Create() *ApiKeyCreate {
+	mutation := newApiKeyMutation(c.config, OpCreate)
+	return &ApiKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApiKey entities.
+func (c *ApiKeyClient) CreateBulk(builders ...*ApiKeyCreate) *ApiKeyCreateBulk {
+	return &ApiKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApiKey.
+func (c *ApiKeyClient) Update() *ApiKeyUpdate {
+	mutation := newApiKeyMutation(c.config, OpUpdate)
+	return &ApiKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApiKeyClient) UpdateOne(ak *ApiKey) *ApiKeyUpdateOne {
+	mutation := newApiKeyMutation(c.config, OpUpdateOne, withApiKey(ak))
+	return &ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApiKeyClient) UpdateOneID(id uuid.UUID) *ApiKeyUpdateOne {
+	mutation := newApiKeyMutation(c.config, OpUpdateOne, withApiKeyID(id))
+	return &ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApiKey.
+func (c *ApiKeyClient) Delete() *ApiKeyDelete {
+	mutation := newApiKeyMutation(c.config, OpDelete)
+	return &ApiKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ApiKeyClient) DeleteOne(ak *ApiKey) *ApiKeyDeleteOne {
+	return c.DeleteOneID(ak.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *ApiKeyClient) DeleteOneID(id uuid.UUID) *ApiKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApiKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for ApiKey.
+func (c *ApiKeyClient) Query() *ApiKeyQuery {
+	return &ApiKeyQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a ApiKey entity by its id.
+func (c *ApiKeyClient) Get(ctx context.Context, id uuid.UUID) (*ApiKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApiKeyClient) GetX(ctx context.Context, id uuid.UUID) *ApiKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ApiKeyClient) Hooks() []Hook {
+	return c.hooks.ApiKey
+}
+
+// AuditRecordClient is a client for the AuditRecord schema.
+type AuditRecordClient struct {
+	config
+}
+
+// NewAuditRecordClient returns a client for the AuditRecord from the given config.
+func NewAuditRecordClient(c config) *AuditRecordClient {
+	return &AuditRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditrecord.Hooks(f(g(h())))`.
+func (c *AuditRecordClient) Use(hooks ...Hook) {
+	c.hooks.AuditRecord = append(c.hooks.AuditRecord, hooks...)
+}
+
+// Create returns a builder for creating a AuditRecord entity.
+func (c *AuditRecordClient) Create() *AuditRecordCreate {
+	mutation := newAuditRecordMutation(c.config, OpCreate)
+	return &AuditRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditRecord entities.
+func (c *AuditRecordClient) CreateBulk(builders ...*AuditRecordCreate) *AuditRecordCreateBulk {
+	return &AuditRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditRecord.
+func (c *AuditRecordClient) Update() *AuditRecordUpdate {
+	mutation := newAuditRecordMutation(c.config, OpUpdate)
+	return &AuditRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditRecordClient) UpdateOne(ar *AuditRecord) *AuditRecordUpdateOne {
+	mutation := newAuditRecordMutation(c.config, OpUpdateOne, withAuditRecord(ar))
+	return &AuditRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditRecordClient) UpdateOneID(id uuid.UUID) *AuditRecordUpdateOne {
+	mutation := newAuditRecordMutation(c.config, OpUpdateOne, withAuditRecordID(id))
+	return &AuditRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditRecord.
+func (c *AuditRecordClient) Delete() *AuditRecordDelete {
+	mutation := newAuditRecordMutation(c.config, OpDelete)
+	return &AuditRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditRecordClient) DeleteOne(ar *AuditRecord) *AuditRecordDeleteOne {
+	return c.DeleteOneID(ar.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *AuditRecordClient) DeleteOneID(id uuid.UUID) *AuditRecordDeleteOne {
+	builder := c.Delete().Where(auditrecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditRecord.
+func (c *AuditRecordClient) Query() *AuditRecordQuery {
+	return &AuditRecordQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a AuditRecord entity by its id.
+func (c *AuditRecordClient) Get(ctx context.Context, id uuid.UUID) (*AuditRecord, error) {
+	return c.Query().Where(auditrecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditRecordClient) GetX(ctx context.Context, id uuid.UUID) *AuditRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditRecordClient) Hooks() []Hook {
+	return c.hooks.AuditRecord
+}
+
+// BalanceAlertClient is a client for the BalanceAlert schema.
+type BalanceAlertClient struct {
+	config
+}
+
+// NewBalanceAlertClient returns a client for the BalanceAlert from the given config.
+func NewBalanceAlertClient(c config) *BalanceAlertClient {
+	return &BalanceAlertClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `balancealert.Hooks(f(g(h())))`.
+func (c *BalanceAlertClient) Use(hooks ...Hook) {
+	c.hooks.BalanceAlert = append(c.hooks.BalanceAlert, hooks...)
+}
+
+// Create returns a builder for creating a BalanceAlert entity.
+func (c *BalanceAlertClient) Create() *BalanceAlertCreate {
+	mutation := newBalanceAlertMutation(c.config, OpCreate)
+	return &BalanceAlertCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BalanceAlert entities.
+func (c *BalanceAlertClient) CreateBulk(builders ...*BalanceAlertCreate) *BalanceAlertCreateBulk {
+	return &BalanceAlertCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BalanceAlert.
+func (c *BalanceAlertClient) Update() *BalanceAlertUpdate {
+	mutation := newBalanceAlertMutation(c.config, OpUpdate)
+	return &BalanceAlertUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BalanceAlertClient) UpdateOne(ba *BalanceAlert) *BalanceAlertUpdateOne {
+	mutation := newBalanceAlertMutation(c.config, OpUpdateOne, withBalanceAlert(ba))
+	return &BalanceAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BalanceAlertClient) UpdateOneID(id uuid.UUID) *BalanceAlertUpdateOne {
+	mutation := newBalanceAlertMutation(c.config, OpUpdateOne, withBalanceAlertID(id))
+	return &BalanceAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BalanceAlert.
+func (c *BalanceAlertClient) Delete() *BalanceAlertDelete {
+	mutation := newBalanceAlertMutation(c.config, OpDelete)
+	return &BalanceAlertDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BalanceAlertClient) DeleteOne(ba *BalanceAlert) *BalanceAlertDeleteOne {
+	return c.DeleteOneID(ba.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *BalanceAlertClient) DeleteOneID(id uuid.UUID) *BalanceAlertDeleteOne {
+	builder := c.Delete().Where(balancealert.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BalanceAlertDeleteOne{builder}
+}
+
+// Query returns a query builder for BalanceAlert.
+func (c *BalanceAlertClient) Query() *BalanceAlertQuery {
+	return &BalanceAlertQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a BalanceAlert entity by its id.
+func (c *BalanceAlertClient) Get(ctx context.Context, id uuid.UUID) (*BalanceAlert, error) {
+	return c.Query().Where(balancealert.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BalanceAlertClient) GetX(ctx context.Context, id uuid.UUID) *BalanceAlert {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWallet queries the wallet edge of a BalanceAlert.
+func (c *BalanceAlertClient) QueryWallet(ba *BalanceAlert) *WalletQuery {
+	query := &WalletQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ba.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(balancealert.Table, balancealert.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, balancealert.WalletTable, balancealert.WalletColumn),
+		)
+		fromV = sqlgraph.Neighbors(ba.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BalanceAlertClient) Hooks() []Hook {
+	return c.hooks.BalanceAlert
+}
+
+// BalanceSnapshotClient is a client for the BalanceSnapshot schema.
+type BalanceSnapshotClient struct {
+	config
+}
+
+// NewBalanceSnapshotClient returns a client for the BalanceSnapshot from the given config.
+func NewBalanceSnapshotClient(c config) *BalanceSnapshotClient {
+	return &BalanceSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `balancesnapshot.Hooks(f(g(h())))`.
+func (c *BalanceSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.BalanceSnapshot = append(c.hooks.BalanceSnapshot, hooks...)
+}
+
+// Create returns a builder for creating a BalanceSnapshot entity.
+func (c *BalanceSnapshotClient) Create() *BalanceSnapshotCreate {
+	mutation := newBalanceSnapshotMutation(c.config, OpCreate)
+	return &BalanceSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BalanceSnapshot entities.
+func (c *BalanceSnapshotClient) CreateBulk(builders ...*BalanceSnapshotCreate) *BalanceSnapshotCreateBulk {
+	return &BalanceSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BalanceSnapshot.
+func (c *BalanceSnapshotClient) Update() *BalanceSnapshotUpdate {
+	mutation := newBalanceSnapshotMutation(c.config, OpUpdate)
+	return &BalanceSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BalanceSnapshotClient) UpdateOne(bs *BalanceSnapshot) *BalanceSnapshotUpdateOne {
+	mutation := newBalanceSnapshotMutation(c.config, OpUpdateOne, withBalanceSnapshot(bs))
+	return &BalanceSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BalanceSnapshotClient) UpdateOneID(id uuid.UUID) *BalanceSnapshotUpdateOne {
+	mutation := newBalanceSnapshotMutation(c.config, OpUpdateOne, withBalanceSnapshotID(id))
+	return &BalanceSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BalanceSnapshot.
+func (c *BalanceSnapshotClient) Delete() *BalanceSnapshotDelete {
+	mutation := newBalanceSnapshotMutation(c.config, OpDelete)
+	return &BalanceSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BalanceSnapshotClient) DeleteOne(bs *BalanceSnapshot) *BalanceSnapshotDeleteOne {
+	return c.DeleteOneID(bs.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *BalanceSnapshotClient) DeleteOneID(id uuid.UUID) *BalanceSnapshotDeleteOne {
+	builder := c.Delete().Where(balancesnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BalanceSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for BalanceSnapshot.
+func (c *BalanceSnapshotClient) Query() *BalanceSnapshotQuery {
+	return &BalanceSnapshotQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a BalanceSnapshot entity by its id.
+func (c *BalanceSnapshotClient) Get(ctx context.Context, id uuid.UUID) (*BalanceSnapshot, error) {
+	return c.Query().Where(balancesnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BalanceSnapshotClient) GetX(ctx context.Context, id uuid.UUID) *BalanceSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a BalanceSnapshot.
+func (c *BalanceSnapshotClient) QueryAccount(bs *BalanceSnapshot) *AccountQuery {
+	query := &AccountQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := bs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(balancesnapshot.Table, balancesnapshot.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, balancesnapshot.AccountTable, balancesnapshot.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(bs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWalletSnapshot queries the wallet_snapshot edge of a BalanceSnapshot.
+func (c *BalanceSnapshotClient) QueryWalletSnapshot(bs *BalanceSnapshot) *WalletSnapshotQuery {
+	query := &WalletSnapshotQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := bs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(balancesnapshot.Table, balancesnapshot.FieldID, id),
+			sqlgraph.To(walletsnapshot.Table, walletsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, balancesnapshot.WalletSnapshotTable, balancesnapshot.WalletSnapshotColumn),
+		)
+		fromV = sqlgraph.Neighbors(bs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BalanceSnapshotClient) Hooks() []Hook {
+	return c.hooks.BalanceSnapshot
+}
+
 // BlockClient is a client for the Block schema.
 type BlockClient struct {
 	config
@@ -300,44 +824,696 @@ func (c *BlockClient) UpdateOne(b *Block) *BlockUpdateOne {
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BlockClient) UpdateOneID(id uuid.UUID) *BlockUpdateOne {
-	mutation := newBlockMutation(c.config, OpUpdateOne, withBlockID(id))
-	return &BlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BlockClient) UpdateOneID(id uuid.UUID) *BlockUpdateOne {
+	mutation := newBlockMutation(c.config, OpUpdateOne, withBlockID(id))
+	return &BlockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Block.
+func (c *BlockClient) Delete() *BlockDelete {
+	mutation := newBlockMutation(c.config, OpDelete)
+	return &BlockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BlockClient) DeleteOne(b *Block) *BlockDeleteOne {
+	return c.DeleteOneID(b.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *BlockClient) DeleteOneID(id uuid.UUID) *BlockDeleteOne {
+	builder := c.Delete().Where(block.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BlockDeleteOne{builder}
+}
+
+// Query returns a query builder for Block.
+func (c *BlockClient) Query() *BlockQuery {
+	return &BlockQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Block entity by its id.
+func (c *BlockClient) Get(ctx context.Context, id uuid.UUID) (*Block, error) {
+	return c.Query().Where(block.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BlockClient) GetX(ctx context.Context, id uuid.UUID) *Block {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a Block.
+func (c *BlockClient) QueryAccount(b *Block) *AccountQuery {
+	query := &AccountQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(block.Table, block.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, block.AccountTable, block.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWallet queries the wallet edge of a Block.
+func (c *BlockClient) QueryWallet(b *Block) *WalletQuery {
+	query := &WalletQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(block.Table, block.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, block.WalletTable, block.WalletColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BlockClient) Hooks() []Hook {
+	return c.hooks.Block
+}
+
+// IdempotencyKeyClient is a client for the IdempotencyKey schema.
+type IdempotencyKeyClient struct {
+	config
+}
+
+// NewIdempotencyKeyClient returns a client for the IdempotencyKey from the given config.
+func NewIdempotencyKeyClient(c config) *IdempotencyKeyClient {
+	return &IdempotencyKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotencykey.Hooks(f(g(h())))`.
+func (c *IdempotencyKeyClient) Use(hooks ...Hook) {
+	c.hooks.IdempotencyKey = append(c.hooks.IdempotencyKey, hooks...)
+}
+
+// Create returns a builder for creating a IdempotencyKey entity.
+func (c *IdempotencyKeyClient) Create() *IdempotencyKeyCreate {
+	mutation := newIdempotencyKeyMutation(c.config, OpCreate)
+	return &IdempotencyKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotencyKey entities.
+func (c *IdempotencyKeyClient) CreateBulk(builders ...*IdempotencyKeyCreate) *IdempotencyKeyCreateBulk {
+	return &IdempotencyKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Update() *IdempotencyKeyUpdate {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdate)
+	return &IdempotencyKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotencyKeyClient) UpdateOne(ik *IdempotencyKey) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKey(ik))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotencyKeyClient) UpdateOneID(id uuid.UUID) *IdempotencyKeyUpdateOne {
+	mutation := newIdempotencyKeyMutation(c.config, OpUpdateOne, withIdempotencyKeyID(id))
+	return &IdempotencyKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Delete() *IdempotencyKeyDelete {
+	mutation := newIdempotencyKeyMutation(c.config, OpDelete)
+	return &IdempotencyKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotencyKeyClient) DeleteOne(ik *IdempotencyKey) *IdempotencyKeyDeleteOne {
+	return c.DeleteOneID(ik.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *IdempotencyKeyClient) DeleteOneID(id uuid.UUID) *IdempotencyKeyDeleteOne {
+	builder := c.Delete().Where(idempotencykey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotencyKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotencyKey.
+func (c *IdempotencyKeyClient) Query() *IdempotencyKeyQuery {
+	return &IdempotencyKeyQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a IdempotencyKey entity by its id.
+func (c *IdempotencyKeyClient) Get(ctx context.Context, id uuid.UUID) (*IdempotencyKey, error) {
+	return c.Query().Where(idempotencykey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotencyKeyClient) GetX(ctx context.Context, id uuid.UUID) *IdempotencyKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWallet queries the wallet edge of a IdempotencyKey.
+func (c *IdempotencyKeyClient) QueryWallet(ik *IdempotencyKey) *WalletQuery {
+	query := &WalletQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ik.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(idempotencykey.Table, idempotencykey.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, idempotencykey.WalletTable, idempotencykey.WalletColumn),
+		)
+		fromV = sqlgraph.Neighbors(ik.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotencyKeyClient) Hooks() []Hook {
+	return c.hooks.IdempotencyKey
+}
+
+// IdempotentSendClient is a client for the IdempotentSend schema.
+type IdempotentSendClient struct {
+	config
+}
+
+// NewIdempotentSendClient returns a client for the IdempotentSend from the given config.
+func NewIdempotentSendClient(c config) *IdempotentSendClient {
+	return &IdempotentSendClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `idempotentsend.Hooks(f(g(h())))`.
+func (c *IdempotentSendClient) Use(hooks ...Hook) {
+	c.hooks.IdempotentSend = append(c.hooks.IdempotentSend, hooks...)
+}
+
+// Create returns a builder for creating a IdempotentSend entity.
+func (c *IdempotentSendClient) Create() *IdempotentSendCreate {
+	mutation := newIdempotentSendMutation(c.config, OpCreate)
+	return &IdempotentSendCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdempotentSend entities.
+func (c *IdempotentSendClient) CreateBulk(builders ...*IdempotentSendCreate) *IdempotentSendCreateBulk {
+	return &IdempotentSendCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdempotentSend.
+func (c *IdempotentSendClient) Update() *IdempotentSendUpdate {
+	mutation := newIdempotentSendMutation(c.config, OpUpdate)
+	return &IdempotentSendUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdempotentSendClient) UpdateOne(is *IdempotentSend) *IdempotentSendUpdateOne {
+	mutation := newIdempotentSendMutation(c.config, OpUpdateOne, withIdempotentSend(is))
+	return &IdempotentSendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdempotentSendClient) UpdateOneID(id uuid.UUID) *IdempotentSendUpdateOne {
+	mutation := newIdempotentSendMutation(c.config, OpUpdateOne, withIdempotentSendID(id))
+	return &IdempotentSendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdempotentSend.
+func (c *IdempotentSendClient) Delete() *IdempotentSendDelete {
+	mutation := newIdempotentSendMutation(c.config, OpDelete)
+	return &IdempotentSendDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdempotentSendClient) DeleteOne(is *IdempotentSend) *IdempotentSendDeleteOne {
+	return c.DeleteOneID(is.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *IdempotentSendClient) DeleteOneID(id uuid.UUID) *IdempotentSendDeleteOne {
+	builder := c.Delete().Where(idempotentsend.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdempotentSendDeleteOne{builder}
+}
+
+// Query returns a query builder for IdempotentSend.
+func (c *IdempotentSendClient) Query() *IdempotentSendQuery {
+	return &IdempotentSendQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a IdempotentSend entity by its id.
+func (c *IdempotentSendClient) Get(ctx context.Context, id uuid.UUID) (*IdempotentSend, error) {
+	return c.Query().Where(idempotentsend.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdempotentSendClient) GetX(ctx context.Context, id uuid.UUID) *IdempotentSend {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWallet queries the wallet edge of a IdempotentSend.
+func (c *IdempotentSendClient) QueryWallet(is *IdempotentSend) *WalletQuery {
+	query := &WalletQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := is.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(idempotentsend.Table, idempotentsend.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, idempotentsend.WalletTable, idempotentsend.WalletColumn),
+		)
+		fromV = sqlgraph.Neighbors(is.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IdempotentSendClient) Hooks() []Hook {
+	return c.hooks.IdempotentSend
+}
+
+// JobClient is a client for the Job schema.
+type JobClient struct {
+	config
+}
+
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
+}
+
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobClient) UpdateOne(j *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(j))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobClient) UpdateOneID(id uuid.UUID) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(j *Job) *JobDeleteOne {
+	return c.DeleteOneID(j.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id uuid.UUID) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id uuid.UUID) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id uuid.UUID) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWallet queries the wallet edge of a Job.
+func (c *JobClient) QueryWallet(j *Job) *WalletQuery {
+	query := &WalletQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := j.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, job.WalletTable, job.WalletColumn),
+		)
+		fromV = sqlgraph.Neighbors(j.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	return c.hooks.Job
+}
+
+// RepresentativeHistoryClient is a client for the RepresentativeHistory schema.
+type RepresentativeHistoryClient struct {
+	config
+}
+
+// NewRepresentativeHistoryClient returns a client for the RepresentativeHistory from the given config.
+func NewRepresentativeHistoryClient(c config) *RepresentativeHistoryClient {
+	return &RepresentativeHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `representativehistory.Hooks(f(g(h())))`.
+func (c *RepresentativeHistoryClient) Use(hooks ...Hook) {
+	c.hooks.RepresentativeHistory = append(c.hooks.RepresentativeHistory, hooks...)
+}
+
+// Create returns a builder for creating a RepresentativeHistory entity.
+func (c *RepresentativeHistoryClient) Create() *RepresentativeHistoryCreate {
+	mutation := newRepresentativeHistoryMutation(c.config, OpCreate)
+	return &RepresentativeHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RepresentativeHistory entities.
+func (c *RepresentativeHistoryClient) CreateBulk(builders ...*RepresentativeHistoryCreate) *RepresentativeHistoryCreateBulk {
+	return &RepresentativeHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RepresentativeHistory.
+func (c *RepresentativeHistoryClient) Update() *RepresentativeHistoryUpdate {
+	mutation := newRepresentativeHistoryMutation(c.config, OpUpdate)
+	return &RepresentativeHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RepresentativeHistoryClient) UpdateOne(rh *RepresentativeHistory) *RepresentativeHistoryUpdateOne {
+	mutation := newRepresentativeHistoryMutation(c.config, OpUpdateOne, withRepresentativeHistory(rh))
+	return &RepresentativeHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RepresentativeHistoryClient) UpdateOneID(id uuid.UUID) *RepresentativeHistoryUpdateOne {
+	mutation := newRepresentativeHistoryMutation(c.config, OpUpdateOne, withRepresentativeHistoryID(id))
+	return &RepresentativeHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RepresentativeHistory.
+func (c *RepresentativeHistoryClient) Delete() *RepresentativeHistoryDelete {
+	mutation := newRepresentativeHistoryMutation(c.config, OpDelete)
+	return &RepresentativeHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RepresentativeHistoryClient) DeleteOne(rh *RepresentativeHistory) *RepresentativeHistoryDeleteOne {
+	return c.DeleteOneID(rh.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *RepresentativeHistoryClient) DeleteOneID(id uuid.UUID) *RepresentativeHistoryDeleteOne {
+	builder := c.Delete().Where(representativehistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RepresentativeHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for RepresentativeHistory.
+func (c *RepresentativeHistoryClient) Query() *RepresentativeHistoryQuery {
+	return &RepresentativeHistoryQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a RepresentativeHistory entity by its id.
+func (c *RepresentativeHistoryClient) Get(ctx context.Context, id uuid.UUID) (*RepresentativeHistory, error) {
+	return c.Query().Where(representativehistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RepresentativeHistoryClient) GetX(ctx context.Context, id uuid.UUID) *RepresentativeHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a RepresentativeHistory.
+func (c *RepresentativeHistoryClient) QueryAccount(rh *RepresentativeHistory) *AccountQuery {
+	query := &AccountQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := rh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(representativehistory.Table, representativehistory.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, representativehistory.AccountTable, representativehistory.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(rh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RepresentativeHistoryClient) Hooks() []Hook {
+	return c.hooks.RepresentativeHistory
+}
+
+// SendApprovalClient is a client for the SendApproval schema.
+type SendApprovalClient struct {
+	config
+}
+
+// NewSendApprovalClient returns a client for the SendApproval from the given config.
+func NewSendApprovalClient(c config) *SendApprovalClient {
+	return &SendApprovalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sendapproval.Hooks(f(g(h())))`.
+func (c *SendApprovalClient) Use(hooks ...Hook) {
+	c.hooks.SendApproval = append(c.hooks.SendApproval, hooks...)
+}
+
+// Create returns a builder for creating a SendApproval entity.
+func (c *SendApprovalClient) Create() *SendApprovalCreate {
+	mutation := newSendApprovalMutation(c.config, OpCreate)
+	return &SendApprovalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SendApproval entities.
+func (c *SendApprovalClient) CreateBulk(builders ...*SendApprovalCreate) *SendApprovalCreateBulk {
+	return &SendApprovalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SendApproval.
+func (c *SendApprovalClient) Update() *SendApprovalUpdate {
+	mutation := newSendApprovalMutation(c.config, OpUpdate)
+	return &SendApprovalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SendApprovalClient) UpdateOne(sa *SendApproval) *SendApprovalUpdateOne {
+	mutation := newSendApprovalMutation(c.config, OpUpdateOne, withSendApproval(sa))
+	return &SendApprovalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SendApprovalClient) UpdateOneID(id uuid.UUID) *SendApprovalUpdateOne {
+	mutation := newSendApprovalMutation(c.config, OpUpdateOne, withSendApprovalID(id))
+	return &SendApprovalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SendApproval.
+func (c *SendApprovalClient) Delete() *SendApprovalDelete {
+	mutation := newSendApprovalMutation(c.config, OpDelete)
+	return &SendApprovalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SendApprovalClient) DeleteOne(sa *SendApproval) *SendApprovalDeleteOne {
+	return c.DeleteOneID(sa.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *SendApprovalClient) DeleteOneID(id uuid.UUID) *SendApprovalDeleteOne {
+	builder := c.Delete().Where(sendapproval.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SendApprovalDeleteOne{builder}
+}
+
+// Query returns a query builder for SendApproval.
+func (c *SendApprovalClient) Query() *SendApprovalQuery {
+	return &SendApprovalQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a SendApproval entity by its id.
+func (c *SendApprovalClient) Get(ctx context.Context, id uuid.UUID) (*SendApproval, error) {
+	return c.Query().Where(sendapproval.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SendApprovalClient) GetX(ctx context.Context, id uuid.UUID) *SendApproval {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWallet queries the wallet edge of a SendApproval.
+func (c *SendApprovalClient) QueryWallet(sa *SendApproval) *WalletQuery {
+	query := &WalletQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := sa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sendapproval.Table, sendapproval.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sendapproval.WalletTable, sendapproval.WalletColumn),
+		)
+		fromV = sqlgraph.Neighbors(sa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SendApprovalClient) Hooks() []Hook {
+	return c.hooks.SendApproval
+}
+
+// SendScheduleClient is a client for the SendSchedule schema.
+type SendScheduleClient struct {
+	config
+}
+
+// NewSendScheduleClient returns a client for the SendSchedule from the given config.
+func NewSendScheduleClient(c config) *SendScheduleClient {
+	return &SendScheduleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sendschedule.Hooks(f(g(h())))`.
+func (c *SendScheduleClient) Use(hooks ...Hook) {
+	c.hooks.SendSchedule = append(c.hooks.SendSchedule, hooks...)
+}
+
+// Create returns a builder for creating a SendSchedule entity.
+func (c *SendScheduleClient) Create() *SendScheduleCreate {
+	mutation := newSendScheduleMutation(c.config, OpCreate)
+	return &SendScheduleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SendSchedule entities.
+func (c *SendScheduleClient) CreateBulk(builders ...*SendScheduleCreate) *SendScheduleCreateBulk {
+	return &SendScheduleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SendSchedule.
+func (c *SendScheduleClient) Update() *SendScheduleUpdate {
+	mutation := newSendScheduleMutation(c.config, OpUpdate)
+	return &SendScheduleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SendScheduleClient) UpdateOne(ss *SendSchedule) *SendScheduleUpdateOne {
+	mutation := newSendScheduleMutation(c.config, OpUpdateOne, withSendSchedule(ss))
+	return &SendScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SendScheduleClient) UpdateOneID(id uuid.UUID) *SendScheduleUpdateOne {
+	mutation := newSendScheduleMutation(c.config, OpUpdateOne, withSendScheduleID(id))
+	return &SendScheduleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Block.
-func (c *BlockClient) Delete() *BlockDelete {
-	mutation := newBlockMutation(c.config, OpDelete)
-	return &BlockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SendSchedule.
+func (c *SendScheduleClient) Delete() *SendScheduleDelete {
+	mutation := newSendScheduleMutation(c.config, OpDelete)
+	return &SendScheduleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BlockClient) DeleteOne(b *Block) *BlockDeleteOne {
-	return c.DeleteOneID(b.ID)
+func (c *SendScheduleClient) DeleteOne(ss *SendSchedule) *SendScheduleDeleteOne {
+	return c.DeleteOneID(ss.ID)
 }
 
 // DeleteOne returns a builder for deleting the given entity by its id.
-func (c *BlockClient) DeleteOneID(id uuid.UUID) *BlockDeleteOne {
-	builder := c.Delete().Where(block.ID(id))
+func (c *SendScheduleClient) DeleteOneID(id uuid.UUID) *SendScheduleDeleteOne {
+	builder := c.Delete().Where(sendschedule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BlockDeleteOne{builder}
+	return &SendScheduleDeleteOne{builder}
 }
 
-// Query returns a query builder for Block.
-func (c *BlockClient) Query() *BlockQuery {
-	return &BlockQuery{
+// Query returns a query builder for SendSchedule.
+func (c *SendScheduleClient) Query() *SendScheduleQuery {
+	return &SendScheduleQuery{
 		config: c.config,
 	}
 }
 
-// Get returns a Block entity by its id.
-func (c *BlockClient) Get(ctx context.Context, id uuid.UUID) (*Block, error) {
-	return c.Query().Where(block.ID(id)).Only(ctx)
+// Get returns a SendSchedule entity by its id.
+func (c *SendScheduleClient) Get(ctx context.Context, id uuid.UUID) (*SendSchedule, error) {
+	return c.Query().Where(sendschedule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BlockClient) GetX(ctx context.Context, id uuid.UUID) *Block {
+func (c *SendScheduleClient) GetX(ctx context.Context, id uuid.UUID) *SendSchedule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -345,25 +1521,25 @@ func (c *BlockClient) GetX(ctx context.Context, id uuid.UUID) *Block {
 	return obj
 }
 
-// QueryAccount queries the account edge of a Block.
-func (c *BlockClient) QueryAccount(b *Block) *AccountQuery {
-	query := &AccountQuery{config: c.config}
+// QueryWallet queries the wallet edge of a SendSchedule.
+func (c *SendScheduleClient) QueryWallet(ss *SendSchedule) *WalletQuery {
+	query := &WalletQuery{config: c.config}
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := b.ID
+		id := ss.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(block.Table, block.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, block.AccountTable, block.AccountColumn),
+			sqlgraph.From(sendschedule.Table, sendschedule.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sendschedule.WalletTable, sendschedule.WalletColumn),
 		)
-		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(ss.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *BlockClient) Hooks() []Hook {
-	return c.hooks.Block
+func (c *SendScheduleClient) Hooks() []Hook {
+	return c.hooks.SendSchedule
 }
 
 // WalletClient is a client for the Wallet schema.
@@ -467,7 +1643,379 @@ func (c *WalletClient) QueryAccounts(w *Wallet) *AccountQuery {
 	return query
 }
 
+// QuerySendSchedules queries the send_schedules edge of a Wallet.
+func (c *WalletClient) QuerySendSchedules(w *Wallet) *SendScheduleQuery {
+	query := &SendScheduleQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(sendschedule.Table, sendschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.SendSchedulesTable, wallet.SendSchedulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBalanceAlerts queries the balance_alerts edge of a Wallet.
+func (c *WalletClient) QueryBalanceAlerts(w *Wallet) *BalanceAlertQuery {
+	query := &BalanceAlertQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(balancealert.Table, balancealert.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.BalanceAlertsTable, wallet.BalanceAlertsColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdempotencyKeys queries the idempotency_keys edge of a Wallet.
+func (c *WalletClient) QueryIdempotencyKeys(w *Wallet) *IdempotencyKeyQuery {
+	query := &IdempotencyKeyQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(idempotencykey.Table, idempotencykey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.IdempotencyKeysTable, wallet.IdempotencyKeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdempotentSends queries the idempotent_sends edge of a Wallet.
+func (c *WalletClient) QueryIdempotentSends(w *Wallet) *IdempotentSendQuery {
+	query := &IdempotentSendQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(idempotentsend.Table, idempotentsend.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.IdempotentSendsTable, wallet.IdempotentSendsColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWalletSnapshots queries the wallet_snapshots edge of a Wallet.
+func (c *WalletClient) QueryWalletSnapshots(w *Wallet) *WalletSnapshotQuery {
+	query := &WalletSnapshotQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(walletsnapshot.Table, walletsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.WalletSnapshotsTable, wallet.WalletSnapshotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryJobs queries the jobs edge of a Wallet.
+func (c *WalletClient) QueryJobs(w *Wallet) *JobQuery {
+	query := &JobQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.JobsTable, wallet.JobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySpends queries the spends edge of a Wallet.
+func (c *WalletClient) QuerySpends(w *Wallet) *WalletSpendQuery {
+	query := &WalletSpendQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(walletspend.Table, walletspend.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.SpendsTable, wallet.SpendsColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySendApprovals queries the send_approvals edge of a Wallet.
+func (c *WalletClient) QuerySendApprovals(w *Wallet) *SendApprovalQuery {
+	query := &SendApprovalQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(sendapproval.Table, sendapproval.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.SendApprovalsTable, wallet.SendApprovalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlocks queries the blocks edge of a Wallet.
+func (c *WalletClient) QueryBlocks(w *Wallet) *BlockQuery {
+	query := &BlockQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, id),
+			sqlgraph.To(block.Table, block.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.BlocksTable, wallet.BlocksColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *WalletClient) Hooks() []Hook {
 	return c.hooks.Wallet
 }
+
+// WalletSnapshotClient is a client for the WalletSnapshot schema.
+type WalletSnapshotClient struct {
+	config
+}
+
+// NewWalletSnapshotClient returns a client for the WalletSnapshot from the given config.
+func NewWalletSnapshotClient(c config) *WalletSnapshotClient {
+	return &WalletSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `walletsnapshot.Hooks(f(g(h())))`.
+func (c *WalletSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.WalletSnapshot = append(c.hooks.WalletSnapshot, hooks...)
+}
+
+// Create returns a builder for creating a WalletSnapshot entity.
+func (c *WalletSnapshotClient) Create() *WalletSnapshotCreate {
+	mutation := newWalletSnapshotMutation(c.config, OpCreate)
+	return &WalletSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WalletSnapshot entities.
+func (c *WalletSnapshotClient) CreateBulk(builders ...*WalletSnapshotCreate) *WalletSnapshotCreateBulk {
+	return &WalletSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WalletSnapshot.
+func (c *WalletSnapshotClient) Update() *WalletSnapshotUpdate {
+	mutation := newWalletSnapshotMutation(c.config, OpUpdate)
+	return &WalletSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WalletSnapshotClient) UpdateOne(ws *WalletSnapshot) *WalletSnapshotUpdateOne {
+	mutation := newWalletSnapshotMutation(c.config, OpUpdateOne, withWalletSnapshot(ws))
+	return &WalletSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WalletSnapshotClient) UpdateOneID(id uuid.UUID) *WalletSnapshotUpdateOne {
+	mutation := newWalletSnapshotMutation(c.config, OpUpdateOne, withWalletSnapshotID(id))
+	return &WalletSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WalletSnapshot.
+func (c *WalletSnapshotClient) Delete() *WalletSnapshotDelete {
+	mutation := newWalletSnapshotMutation(c.config, OpDelete)
+	return &WalletSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WalletSnapshotClient) DeleteOne(ws *WalletSnapshot) *WalletSnapshotDeleteOne {
+	return c.DeleteOneID(ws.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *WalletSnapshotClient) DeleteOneID(id uuid.UUID) *WalletSnapshotDeleteOne {
+	builder := c.Delete().Where(walletsnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WalletSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for WalletSnapshot.
+func (c *WalletSnapshotClient) Query() *WalletSnapshotQuery {
+	return &WalletSnapshotQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WalletSnapshot entity by its id.
+func (c *WalletSnapshotClient) Get(ctx context.Context, id uuid.UUID) (*WalletSnapshot, error) {
+	return c.Query().Where(walletsnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WalletSnapshotClient) GetX(ctx context.Context, id uuid.UUID) *WalletSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWallet queries the wallet edge of a WalletSnapshot.
+func (c *WalletSnapshotClient) QueryWallet(ws *WalletSnapshot) *WalletQuery {
+	query := &WalletQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ws.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(walletsnapshot.Table, walletsnapshot.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, walletsnapshot.WalletTable, walletsnapshot.WalletColumn),
+		)
+		fromV = sqlgraph.Neighbors(ws.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBalances queries the balances edge of a WalletSnapshot.
+func (c *WalletSnapshotClient) QueryBalances(ws *WalletSnapshot) *BalanceSnapshotQuery {
+	query := &BalanceSnapshotQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ws.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(walletsnapshot.Table, walletsnapshot.FieldID, id),
+			sqlgraph.To(balancesnapshot.Table, balancesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, walletsnapshot.BalancesTable, walletsnapshot.BalancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ws.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WalletSnapshotClient) Hooks() []Hook {
+	return c.hooks.WalletSnapshot
+}
+
+// WalletSpendClient is a client for the WalletSpend schema.
+type WalletSpendClient struct {
+	config
+}
+
+// NewWalletSpendClient returns a client for the WalletSpend from the given config.
+func NewWalletSpendClient(c config) *WalletSpendClient {
+	return &WalletSpendClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `walletspend.Hooks(f(g(h())))`.
+func (c *WalletSpendClient) Use(hooks ...Hook) {
+	c.hooks.WalletSpend = append(c.hooks.WalletSpend, hooks...)
+}
+
+// Create returns a builder for creating a WalletSpend entity.
+func (c *WalletSpendClient) Create() *WalletSpendCreate {
+	mutation := newWalletSpendMutation(c.config, OpCreate)
+	return &WalletSpendCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WalletSpend entities.
+func (c *WalletSpendClient) CreateBulk(builders ...*WalletSpendCreate) *WalletSpendCreateBulk {
+	return &WalletSpendCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WalletSpend.
+func (c *WalletSpendClient) Update() *WalletSpendUpdate {
+	mutation := newWalletSpendMutation(c.config, OpUpdate)
+	return &WalletSpendUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WalletSpendClient) UpdateOne(ws *WalletSpend) *WalletSpendUpdateOne {
+	mutation := newWalletSpendMutation(c.config, OpUpdateOne, withWalletSpend(ws))
+	return &WalletSpendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WalletSpendClient) UpdateOneID(id uuid.UUID) *WalletSpendUpdateOne {
+	mutation := newWalletSpendMutation(c.config, OpUpdateOne, withWalletSpendID(id))
+	return &WalletSpendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WalletSpend.
+func (c *WalletSpendClient) Delete() *WalletSpendDelete {
+	mutation := newWalletSpendMutation(c.config, OpDelete)
+	return &WalletSpendDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WalletSpendClient) DeleteOne(ws *WalletSpend) *WalletSpendDeleteOne {
+	return c.DeleteOneID(ws.ID)
+}
+
+// DeleteOne returns a builder for deleting the given entity by its id.
+func (c *WalletSpendClient) DeleteOneID(id uuid.UUID) *WalletSpendDeleteOne {
+	builder := c.Delete().Where(walletspend.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WalletSpendDeleteOne{builder}
+}
+
+// Query returns a query builder for WalletSpend.
+func (c *WalletSpendClient) Query() *WalletSpendQuery {
+	return &WalletSpendQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a WalletSpend entity by its id.
+func (c *WalletSpendClient) Get(ctx context.Context, id uuid.UUID) (*WalletSpend, error) {
+	return c.Query().Where(walletspend.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WalletSpendClient) GetX(ctx context.Context, id uuid.UUID) *WalletSpend {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWallet queries the wallet edge of a WalletSpend.
+func (c *WalletSpendClient) QueryWallet(ws *WalletSpend) *WalletQuery {
+	query := &WalletQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ws.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(walletspend.Table, walletspend.FieldID, id),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, walletspend.WalletTable, walletspend.WalletColumn),
+		)
+		fromV = sqlgraph.Neighbors(ws.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WalletSpendClient) Hooks() []Hook {
+	return c.hooks.WalletSpend
+}
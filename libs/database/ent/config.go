@@ -24,9 +24,21 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Account []ent.Hook
-	Block   []ent.Hook
-	Wallet  []ent.Hook
+	Account               []ent.Hook
+	ApiKey                []ent.Hook
+	AuditRecord           []ent.Hook
+	BalanceAlert          []ent.Hook
+	BalanceSnapshot       []ent.Hook
+	Block                 []ent.Hook
+	IdempotencyKey        []ent.Hook
+	IdempotentSend        []ent.Hook
+	Job                   []ent.Hook
+	RepresentativeHistory []ent.Hook
+	SendApproval          []ent.Hook
+	SendSchedule          []ent.Hook
+	Wallet                []ent.Hook
+	WalletSnapshot        []ent.Hook
+	WalletSpend           []ent.Hook
 }
 
 // Options applies the options on the config object.
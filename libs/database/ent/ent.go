@@ -11,8 +11,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/apikey"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/auditrecord"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancealert"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotencykey"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendschedule"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -33,9 +45,21 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		account.Table: account.ValidColumn,
-		block.Table:   block.ValidColumn,
-		wallet.Table:  wallet.ValidColumn,
+		account.Table:               account.ValidColumn,
+		apikey.Table:                apikey.ValidColumn,
+		auditrecord.Table:           auditrecord.ValidColumn,
+		balancealert.Table:          balancealert.ValidColumn,
+		balancesnapshot.Table:       balancesnapshot.ValidColumn,
+		block.Table:                 block.ValidColumn,
+		idempotencykey.Table:        idempotencykey.ValidColumn,
+		idempotentsend.Table:        idempotentsend.ValidColumn,
+		job.Table:                   job.ValidColumn,
+		representativehistory.Table: representativehistory.ValidColumn,
+		sendapproval.Table:          sendapproval.ValidColumn,
+		sendschedule.Table:          sendschedule.ValidColumn,
+		wallet.Table:                wallet.ValidColumn,
+		walletsnapshot.Table:        walletsnapshot.ValidColumn,
+		walletspend.Table:           walletspend.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
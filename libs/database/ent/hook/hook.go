@@ -22,6 +22,58 @@ func (f AccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return f(ctx, mv)
 }
 
+// The ApiKeyFunc type is an adapter to allow the use of ordinary
+// function as ApiKey mutator.
+type ApiKeyFunc func(context.Context, *ent.ApiKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ApiKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ApiKeyMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ApiKeyMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The AuditRecordFunc type is an adapter to allow the use of ordinary
+// function as AuditRecord mutator.
+type AuditRecordFunc func(context.Context, *ent.AuditRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AuditRecordMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditRecordMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The BalanceAlertFunc type is an adapter to allow the use of ordinary
+// function as BalanceAlert mutator.
+type BalanceAlertFunc func(context.Context, *ent.BalanceAlertMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BalanceAlertFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.BalanceAlertMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BalanceAlertMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The BalanceSnapshotFunc type is an adapter to allow the use of ordinary
+// function as BalanceSnapshot mutator.
+type BalanceSnapshotFunc func(context.Context, *ent.BalanceSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BalanceSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.BalanceSnapshotMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BalanceSnapshotMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The BlockFunc type is an adapter to allow the use of ordinary
 // function as Block mutator.
 type BlockFunc func(context.Context, *ent.BlockMutation) (ent.Value, error)
@@ -35,6 +87,84 @@ func (f BlockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return f(ctx, mv)
 }
 
+// The IdempotencyKeyFunc type is an adapter to allow the use of ordinary
+// function as IdempotencyKey mutator.
+type IdempotencyKeyFunc func(context.Context, *ent.IdempotencyKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotencyKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.IdempotencyKeyMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdempotencyKeyMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The IdempotentSendFunc type is an adapter to allow the use of ordinary
+// function as IdempotentSend mutator.
+type IdempotentSendFunc func(context.Context, *ent.IdempotentSendMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdempotentSendFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.IdempotentSendMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdempotentSendMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The JobFunc type is an adapter to allow the use of ordinary
+// function as Job mutator.
+type JobFunc func(context.Context, *ent.JobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.JobMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The RepresentativeHistoryFunc type is an adapter to allow the use of ordinary
+// function as RepresentativeHistory mutator.
+type RepresentativeHistoryFunc func(context.Context, *ent.RepresentativeHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RepresentativeHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.RepresentativeHistoryMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RepresentativeHistoryMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The SendApprovalFunc type is an adapter to allow the use of ordinary
+// function as SendApproval mutator.
+type SendApprovalFunc func(context.Context, *ent.SendApprovalMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SendApprovalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.SendApprovalMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SendApprovalMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The SendScheduleFunc type is an adapter to allow the use of ordinary
+// function as SendSchedule mutator.
+type SendScheduleFunc func(context.Context, *ent.SendScheduleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SendScheduleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.SendScheduleMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SendScheduleMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The WalletFunc type is an adapter to allow the use of ordinary
 // function as Wallet mutator.
 type WalletFunc func(context.Context, *ent.WalletMutation) (ent.Value, error)
@@ -48,6 +178,32 @@ func (f WalletFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return f(ctx, mv)
 }
 
+// The WalletSnapshotFunc type is an adapter to allow the use of ordinary
+// function as WalletSnapshot mutator.
+type WalletSnapshotFunc func(context.Context, *ent.WalletSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WalletSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.WalletSnapshotMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WalletSnapshotMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The WalletSpendFunc type is an adapter to allow the use of ordinary
+// function as WalletSpend mutator.
+type WalletSpendFunc func(context.Context, *ent.WalletSpendMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WalletSpendFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.WalletSpendMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WalletSpendMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
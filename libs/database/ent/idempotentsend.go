@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// IdempotentSend is the model entity for the IdempotentSend schema.
+type IdempotentSend struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WalletID holds the value of the "wallet_id" field.
+	WalletID uuid.UUID `json:"wallet_id,omitempty"`
+	// SendID holds the value of the "send_id" field.
+	SendID string `json:"send_id,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// Destination holds the value of the "destination" field.
+	Destination string `json:"destination,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount string `json:"amount,omitempty"`
+	// BlockHash holds the value of the "block_hash" field.
+	BlockHash *string `json:"block_hash,omitempty"`
+	// Status holds the value of the "status" field.
+	Status idempotentsend.Status `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IdempotentSendQuery when eager-loading is set.
+	Edges IdempotentSendEdges `json:"edges"`
+}
+
+// IdempotentSendEdges holds the relations/edges for other nodes in the graph.
+type IdempotentSendEdges struct {
+	// Wallet holds the value of the wallet edge.
+	Wallet *Wallet `json:"wallet,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WalletOrErr returns the Wallet value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IdempotentSendEdges) WalletOrErr() (*Wallet, error) {
+	if e.loadedTypes[0] {
+		if e.Wallet == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: wallet.Label}
+		}
+		return e.Wallet, nil
+	}
+	return nil, &NotLoadedError{edge: "wallet"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IdempotentSend) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case idempotentsend.FieldSendID, idempotentsend.FieldSource, idempotentsend.FieldDestination, idempotentsend.FieldAmount, idempotentsend.FieldBlockHash, idempotentsend.FieldStatus:
+			values[i] = new(sql.NullString)
+		case idempotentsend.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case idempotentsend.FieldID, idempotentsend.FieldWalletID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type IdempotentSend", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IdempotentSend fields.
+func (is *IdempotentSend) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case idempotentsend.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				is.ID = *value
+			}
+		case idempotentsend.FieldWalletID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_id", values[i])
+			} else if value != nil {
+				is.WalletID = *value
+			}
+		case idempotentsend.FieldSendID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field send_id", values[i])
+			} else if value.Valid {
+				is.SendID = value.String
+			}
+		case idempotentsend.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				is.Source = value.String
+			}
+		case idempotentsend.FieldDestination:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field destination", values[i])
+			} else if value.Valid {
+				is.Destination = value.String
+			}
+		case idempotentsend.FieldAmount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				is.Amount = value.String
+			}
+		case idempotentsend.FieldBlockHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field block_hash", values[i])
+			} else if value.Valid {
+				is.BlockHash = new(string)
+				*is.BlockHash = value.String
+			}
+		case idempotentsend.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				is.Status = idempotentsend.Status(value.String)
+			}
+		case idempotentsend.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				is.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryWallet queries the "wallet" edge of the IdempotentSend entity.
+func (is *IdempotentSend) QueryWallet() *WalletQuery {
+	return (&IdempotentSendClient{config: is.config}).QueryWallet(is)
+}
+
+// Update returns a builder for updating this IdempotentSend.
+// Note that you need to call IdempotentSend.Unwrap() before calling this method if this IdempotentSend
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (is *IdempotentSend) Update() *IdempotentSendUpdateOne {
+	return (&IdempotentSendClient{config: is.config}).UpdateOne(is)
+}
+
+// Unwrap unwraps the IdempotentSend entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (is *IdempotentSend) Unwrap() *IdempotentSend {
+	_tx, ok := is.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IdempotentSend is not a transactional entity")
+	}
+	is.config.driver = _tx.drv
+	return is
+}
+
+// String implements the fmt.Stringer.
+func (is *IdempotentSend) String() string {
+	var builder strings.Builder
+	builder.WriteString("IdempotentSend(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", is.ID))
+	builder.WriteString("wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", is.WalletID))
+	builder.WriteString(", ")
+	builder.WriteString("send_id=")
+	builder.WriteString(is.SendID)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(is.Source)
+	builder.WriteString(", ")
+	builder.WriteString("destination=")
+	builder.WriteString(is.Destination)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(is.Amount)
+	builder.WriteString(", ")
+	if v := is.BlockHash; v != nil {
+		builder.WriteString("block_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", is.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(is.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IdempotentSends is a parsable slice of IdempotentSend.
+type IdempotentSends []*IdempotentSend
+
+func (is IdempotentSends) config(cfg config) {
+	for _i := range is {
+		is[_i].config = cfg
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package idempotentsend
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the idempotentsend type in the database.
+	Label = "idempotent_send"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWalletID holds the string denoting the wallet_id field in the database.
+	FieldWalletID = "wallet_id"
+	// FieldSendID holds the string denoting the send_id field in the database.
+	FieldSendID = "send_id"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldDestination holds the string denoting the destination field in the database.
+	FieldDestination = "destination"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldBlockHash holds the string denoting the block_hash field in the database.
+	FieldBlockHash = "block_hash"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeWallet holds the string denoting the wallet edge name in mutations.
+	EdgeWallet = "wallet"
+	// Table holds the table name of the idempotentsend in the database.
+	Table = "idempotent_sends"
+	// WalletTable is the table that holds the wallet relation/edge.
+	WalletTable = "idempotent_sends"
+	// WalletInverseTable is the table name for the Wallet entity.
+	// It exists in this package in order to avoid circular dependency with the "wallet" package.
+	WalletInverseTable = "wallets"
+	// WalletColumn is the table column denoting the wallet relation/edge.
+	WalletColumn = "wallet_id"
+)
+
+// Columns holds all SQL columns for idempotentsend fields.
+var Columns = []string{
+	FieldID,
+	FieldWalletID,
+	FieldSendID,
+	FieldSource,
+	FieldDestination,
+	FieldAmount,
+	FieldBlockHash,
+	FieldStatus,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SendIDValidator is a validator for the "send_id" field. It is called by the builders before save.
+	SendIDValidator func(string) error
+	// SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	SourceValidator func(string) error
+	// DestinationValidator is a validator for the "destination" field. It is called by the builders before save.
+	DestinationValidator func(string) error
+	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	AmountValidator func(string) error
+	// BlockHashValidator is a validator for the "block_hash" field. It is called by the builders before save.
+	BlockHashValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending Status = "pending"
+	StatusSent    Status = "sent"
+	StatusFailed  Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusSent, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("idempotentsend: invalid enum value for status field: %q", s)
+	}
+}
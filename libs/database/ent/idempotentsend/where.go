@@ -0,0 +1,837 @@
+// Code generated by ent, DO NOT EDIT.
+
+package idempotentsend
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// WalletID applies equality check predicate on the "wallet_id" field. It's identical to WalletIDEQ.
+func WalletID(v uuid.UUID) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// SendID applies equality check predicate on the "send_id" field. It's identical to SendIDEQ.
+func SendID(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSendID), v))
+	})
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// Destination applies equality check predicate on the "destination" field. It's identical to DestinationEQ.
+func Destination(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDestination), v))
+	})
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// BlockHash applies equality check predicate on the "block_hash" field. It's identical to BlockHashEQ.
+func BlockHash(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBlockHash), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// WalletIDEQ applies the EQ predicate on the "wallet_id" field.
+func WalletIDEQ(v uuid.UUID) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDNEQ applies the NEQ predicate on the "wallet_id" field.
+func WalletIDNEQ(v uuid.UUID) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDIn applies the In predicate on the "wallet_id" field.
+func WalletIDIn(vs ...uuid.UUID) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldWalletID), v...))
+	})
+}
+
+// WalletIDNotIn applies the NotIn predicate on the "wallet_id" field.
+func WalletIDNotIn(vs ...uuid.UUID) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldWalletID), v...))
+	})
+}
+
+// SendIDEQ applies the EQ predicate on the "send_id" field.
+func SendIDEQ(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSendID), v))
+	})
+}
+
+// SendIDNEQ applies the NEQ predicate on the "send_id" field.
+func SendIDNEQ(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSendID), v))
+	})
+}
+
+// SendIDIn applies the In predicate on the "send_id" field.
+func SendIDIn(vs ...string) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldSendID), v...))
+	})
+}
+
+// SendIDNotIn applies the NotIn predicate on the "send_id" field.
+func SendIDNotIn(vs ...string) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldSendID), v...))
+	})
+}
+
+// SendIDGT applies the GT predicate on the "send_id" field.
+func SendIDGT(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSendID), v))
+	})
+}
+
+// SendIDGTE applies the GTE predicate on the "send_id" field.
+func SendIDGTE(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSendID), v))
+	})
+}
+
+// SendIDLT applies the LT predicate on the "send_id" field.
+func SendIDLT(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSendID), v))
+	})
+}
+
+// SendIDLTE applies the LTE predicate on the "send_id" field.
+func SendIDLTE(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSendID), v))
+	})
+}
+
+// SendIDContains applies the Contains predicate on the "send_id" field.
+func SendIDContains(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSendID), v))
+	})
+}
+
+// SendIDHasPrefix applies the HasPrefix predicate on the "send_id" field.
+func SendIDHasPrefix(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSendID), v))
+	})
+}
+
+// SendIDHasSuffix applies the HasSuffix predicate on the "send_id" field.
+func SendIDHasSuffix(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSendID), v))
+	})
+}
+
+// SendIDEqualFold applies the EqualFold predicate on the "send_id" field.
+func SendIDEqualFold(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSendID), v))
+	})
+}
+
+// SendIDContainsFold applies the ContainsFold predicate on the "send_id" field.
+func SendIDContainsFold(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSendID), v))
+	})
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldSource), v...))
+	})
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldSource), v...))
+	})
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSource), v))
+	})
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSource), v))
+	})
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSource), v))
+	})
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSource), v))
+	})
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSource), v))
+	})
+}
+
+// DestinationEQ applies the EQ predicate on the "destination" field.
+func DestinationEQ(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationNEQ applies the NEQ predicate on the "destination" field.
+func DestinationNEQ(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationIn applies the In predicate on the "destination" field.
+func DestinationIn(vs ...string) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDestination), v...))
+	})
+}
+
+// DestinationNotIn applies the NotIn predicate on the "destination" field.
+func DestinationNotIn(vs ...string) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDestination), v...))
+	})
+}
+
+// DestinationGT applies the GT predicate on the "destination" field.
+func DestinationGT(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationGTE applies the GTE predicate on the "destination" field.
+func DestinationGTE(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationLT applies the LT predicate on the "destination" field.
+func DestinationLT(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationLTE applies the LTE predicate on the "destination" field.
+func DestinationLTE(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationContains applies the Contains predicate on the "destination" field.
+func DestinationContains(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationHasPrefix applies the HasPrefix predicate on the "destination" field.
+func DestinationHasPrefix(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationHasSuffix applies the HasSuffix predicate on the "destination" field.
+func DestinationHasSuffix(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationEqualFold applies the EqualFold predicate on the "destination" field.
+func DestinationEqualFold(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationContainsFold applies the ContainsFold predicate on the "destination" field.
+func DestinationContainsFold(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDestination), v))
+	})
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...string) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...string) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAmount), v))
+	})
+}
+
+// AmountContains applies the Contains predicate on the "amount" field.
+func AmountContains(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAmount), v))
+	})
+}
+
+// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
+func AmountHasPrefix(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAmount), v))
+	})
+}
+
+// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
+func AmountHasSuffix(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAmount), v))
+	})
+}
+
+// AmountEqualFold applies the EqualFold predicate on the "amount" field.
+func AmountEqualFold(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAmount), v))
+	})
+}
+
+// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
+func AmountContainsFold(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAmount), v))
+	})
+}
+
+// BlockHashEQ applies the EQ predicate on the "block_hash" field.
+func BlockHashEQ(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashNEQ applies the NEQ predicate on the "block_hash" field.
+func BlockHashNEQ(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashIn applies the In predicate on the "block_hash" field.
+func BlockHashIn(vs ...string) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldBlockHash), v...))
+	})
+}
+
+// BlockHashNotIn applies the NotIn predicate on the "block_hash" field.
+func BlockHashNotIn(vs ...string) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldBlockHash), v...))
+	})
+}
+
+// BlockHashGT applies the GT predicate on the "block_hash" field.
+func BlockHashGT(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashGTE applies the GTE predicate on the "block_hash" field.
+func BlockHashGTE(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashLT applies the LT predicate on the "block_hash" field.
+func BlockHashLT(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashLTE applies the LTE predicate on the "block_hash" field.
+func BlockHashLTE(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashContains applies the Contains predicate on the "block_hash" field.
+func BlockHashContains(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashHasPrefix applies the HasPrefix predicate on the "block_hash" field.
+func BlockHashHasPrefix(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashHasSuffix applies the HasSuffix predicate on the "block_hash" field.
+func BlockHashHasSuffix(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashIsNil applies the IsNil predicate on the "block_hash" field.
+func BlockHashIsNil() predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldBlockHash)))
+	})
+}
+
+// BlockHashNotNil applies the NotNil predicate on the "block_hash" field.
+func BlockHashNotNil() predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldBlockHash)))
+	})
+}
+
+// BlockHashEqualFold applies the EqualFold predicate on the "block_hash" field.
+func BlockHashEqualFold(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashContainsFold applies the ContainsFold predicate on the "block_hash" field.
+func BlockHashContainsFold(v string) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldBlockHash), v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStatus), v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldStatus), v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldStatus), v...))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IdempotentSend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasWallet applies the HasEdge predicate on the "wallet" edge.
+func HasWallet() predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWalletWith applies the HasEdge predicate on the "wallet" edge with a given conditions (other predicates).
+func HasWalletWith(preds ...predicate.Wallet) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IdempotentSend) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IdempotentSend) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IdempotentSend) predicate.IdempotentSend {
+	return predicate.IdempotentSend(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
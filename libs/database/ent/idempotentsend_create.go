@@ -0,0 +1,456 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// IdempotentSendCreate is the builder for creating a IdempotentSend entity.
+type IdempotentSendCreate struct {
+	config
+	mutation *IdempotentSendMutation
+	hooks    []Hook
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (isc *IdempotentSendCreate) SetWalletID(u uuid.UUID) *IdempotentSendCreate {
+	isc.mutation.SetWalletID(u)
+	return isc
+}
+
+// SetSendID sets the "send_id" field.
+func (isc *IdempotentSendCreate) SetSendID(s string) *IdempotentSendCreate {
+	isc.mutation.SetSendID(s)
+	return isc
+}
+
+// SetSource sets the "source" field.
+func (isc *IdempotentSendCreate) SetSource(s string) *IdempotentSendCreate {
+	isc.mutation.SetSource(s)
+	return isc
+}
+
+// SetDestination sets the "destination" field.
+func (isc *IdempotentSendCreate) SetDestination(s string) *IdempotentSendCreate {
+	isc.mutation.SetDestination(s)
+	return isc
+}
+
+// SetAmount sets the "amount" field.
+func (isc *IdempotentSendCreate) SetAmount(s string) *IdempotentSendCreate {
+	isc.mutation.SetAmount(s)
+	return isc
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (isc *IdempotentSendCreate) SetBlockHash(s string) *IdempotentSendCreate {
+	isc.mutation.SetBlockHash(s)
+	return isc
+}
+
+// SetNillableBlockHash sets the "block_hash" field if the given value is not nil.
+func (isc *IdempotentSendCreate) SetNillableBlockHash(s *string) *IdempotentSendCreate {
+	if s != nil {
+		isc.SetBlockHash(*s)
+	}
+	return isc
+}
+
+// SetStatus sets the "status" field.
+func (isc *IdempotentSendCreate) SetStatus(i idempotentsend.Status) *IdempotentSendCreate {
+	isc.mutation.SetStatus(i)
+	return isc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (isc *IdempotentSendCreate) SetNillableStatus(i *idempotentsend.Status) *IdempotentSendCreate {
+	if i != nil {
+		isc.SetStatus(*i)
+	}
+	return isc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (isc *IdempotentSendCreate) SetCreatedAt(t time.Time) *IdempotentSendCreate {
+	isc.mutation.SetCreatedAt(t)
+	return isc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (isc *IdempotentSendCreate) SetNillableCreatedAt(t *time.Time) *IdempotentSendCreate {
+	if t != nil {
+		isc.SetCreatedAt(*t)
+	}
+	return isc
+}
+
+// SetID sets the "id" field.
+func (isc *IdempotentSendCreate) SetID(u uuid.UUID) *IdempotentSendCreate {
+	isc.mutation.SetID(u)
+	return isc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (isc *IdempotentSendCreate) SetNillableID(u *uuid.UUID) *IdempotentSendCreate {
+	if u != nil {
+		isc.SetID(*u)
+	}
+	return isc
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (isc *IdempotentSendCreate) SetWallet(w *Wallet) *IdempotentSendCreate {
+	return isc.SetWalletID(w.ID)
+}
+
+// Mutation returns the IdempotentSendMutation object of the builder.
+func (isc *IdempotentSendCreate) Mutation() *IdempotentSendMutation {
+	return isc.mutation
+}
+
+// Save creates the IdempotentSend in the database.
+func (isc *IdempotentSendCreate) Save(ctx context.Context) (*IdempotentSend, error) {
+	var (
+		err  error
+		node *IdempotentSend
+	)
+	isc.defaults()
+	if len(isc.hooks) == 0 {
+		if err = isc.check(); err != nil {
+			return nil, err
+		}
+		node, err = isc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IdempotentSendMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = isc.check(); err != nil {
+				return nil, err
+			}
+			isc.mutation = mutation
+			if node, err = isc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(isc.hooks) - 1; i >= 0; i-- {
+			if isc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = isc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, isc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*IdempotentSend)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from IdempotentSendMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (isc *IdempotentSendCreate) SaveX(ctx context.Context) *IdempotentSend {
+	v, err := isc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (isc *IdempotentSendCreate) Exec(ctx context.Context) error {
+	_, err := isc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isc *IdempotentSendCreate) ExecX(ctx context.Context) {
+	if err := isc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (isc *IdempotentSendCreate) defaults() {
+	if _, ok := isc.mutation.Status(); !ok {
+		v := idempotentsend.DefaultStatus
+		isc.mutation.SetStatus(v)
+	}
+	if _, ok := isc.mutation.CreatedAt(); !ok {
+		v := idempotentsend.DefaultCreatedAt()
+		isc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := isc.mutation.ID(); !ok {
+		v := idempotentsend.DefaultID()
+		isc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (isc *IdempotentSendCreate) check() error {
+	if _, ok := isc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "IdempotentSend.wallet_id"`)}
+	}
+	if _, ok := isc.mutation.SendID(); !ok {
+		return &ValidationError{Name: "send_id", err: errors.New(`ent: missing required field "IdempotentSend.send_id"`)}
+	}
+	if v, ok := isc.mutation.SendID(); ok {
+		if err := idempotentsend.SendIDValidator(v); err != nil {
+			return &ValidationError{Name: "send_id", err: fmt.Errorf(`ent: validator failed for field "IdempotentSend.send_id": %w`, err)}
+		}
+	}
+	if _, ok := isc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "IdempotentSend.source"`)}
+	}
+	if v, ok := isc.mutation.Source(); ok {
+		if err := idempotentsend.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "IdempotentSend.source": %w`, err)}
+		}
+	}
+	if _, ok := isc.mutation.Destination(); !ok {
+		return &ValidationError{Name: "destination", err: errors.New(`ent: missing required field "IdempotentSend.destination"`)}
+	}
+	if v, ok := isc.mutation.Destination(); ok {
+		if err := idempotentsend.DestinationValidator(v); err != nil {
+			return &ValidationError{Name: "destination", err: fmt.Errorf(`ent: validator failed for field "IdempotentSend.destination": %w`, err)}
+		}
+	}
+	if _, ok := isc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "IdempotentSend.amount"`)}
+	}
+	if v, ok := isc.mutation.Amount(); ok {
+		if err := idempotentsend.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "IdempotentSend.amount": %w`, err)}
+		}
+	}
+	if v, ok := isc.mutation.BlockHash(); ok {
+		if err := idempotentsend.BlockHashValidator(v); err != nil {
+			return &ValidationError{Name: "block_hash", err: fmt.Errorf(`ent: validator failed for field "IdempotentSend.block_hash": %w`, err)}
+		}
+	}
+	if _, ok := isc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "IdempotentSend.status"`)}
+	}
+	if v, ok := isc.mutation.Status(); ok {
+		if err := idempotentsend.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "IdempotentSend.status": %w`, err)}
+		}
+	}
+	if _, ok := isc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IdempotentSend.created_at"`)}
+	}
+	if _, ok := isc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet", err: errors.New(`ent: missing required edge "IdempotentSend.wallet"`)}
+	}
+	return nil
+}
+
+func (isc *IdempotentSendCreate) sqlSave(ctx context.Context) (*IdempotentSend, error) {
+	_node, _spec := isc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, isc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (isc *IdempotentSendCreate) createSpec() (*IdempotentSend, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IdempotentSend{config: isc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: idempotentsend.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: idempotentsend.FieldID,
+			},
+		}
+	)
+	if id, ok := isc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := isc.mutation.SendID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotentsend.FieldSendID,
+		})
+		_node.SendID = value
+	}
+	if value, ok := isc.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotentsend.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := isc.mutation.Destination(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotentsend.FieldDestination,
+		})
+		_node.Destination = value
+	}
+	if value, ok := isc.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotentsend.FieldAmount,
+		})
+		_node.Amount = value
+	}
+	if value, ok := isc.mutation.BlockHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotentsend.FieldBlockHash,
+		})
+		_node.BlockHash = &value
+	}
+	if value, ok := isc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: idempotentsend.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := isc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: idempotentsend.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := isc.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   idempotentsend.WalletTable,
+			Columns: []string{idempotentsend.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WalletID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IdempotentSendCreateBulk is the builder for creating many IdempotentSend entities in bulk.
+type IdempotentSendCreateBulk struct {
+	config
+	builders []*IdempotentSendCreate
+}
+
+// Save creates the IdempotentSend entities in the database.
+func (iscb *IdempotentSendCreateBulk) Save(ctx context.Context) ([]*IdempotentSend, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(iscb.builders))
+	nodes := make([]*IdempotentSend, len(iscb.builders))
+	mutators := make([]Mutator, len(iscb.builders))
+	for i := range iscb.builders {
+		func(i int, root context.Context) {
+			builder := iscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IdempotentSendMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iscb *IdempotentSendCreateBulk) SaveX(ctx context.Context) []*IdempotentSend {
+	v, err := iscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iscb *IdempotentSendCreateBulk) Exec(ctx context.Context) error {
+	_, err := iscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iscb *IdempotentSendCreateBulk) ExecX(ctx context.Context) {
+	if err := iscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+)
+
+// IdempotentSendDelete is the builder for deleting a IdempotentSend entity.
+type IdempotentSendDelete struct {
+	config
+	hooks    []Hook
+	mutation *IdempotentSendMutation
+}
+
+// Where appends a list predicates to the IdempotentSendDelete builder.
+func (isd *IdempotentSendDelete) Where(ps ...predicate.IdempotentSend) *IdempotentSendDelete {
+	isd.mutation.Where(ps...)
+	return isd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (isd *IdempotentSendDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(isd.hooks) == 0 {
+		affected, err = isd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IdempotentSendMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			isd.mutation = mutation
+			affected, err = isd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(isd.hooks) - 1; i >= 0; i-- {
+			if isd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = isd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, isd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isd *IdempotentSendDelete) ExecX(ctx context.Context) int {
+	n, err := isd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (isd *IdempotentSendDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: idempotentsend.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: idempotentsend.FieldID,
+			},
+		},
+	}
+	if ps := isd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, isd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// IdempotentSendDeleteOne is the builder for deleting a single IdempotentSend entity.
+type IdempotentSendDeleteOne struct {
+	isd *IdempotentSendDelete
+}
+
+// Exec executes the deletion query.
+func (isdo *IdempotentSendDeleteOne) Exec(ctx context.Context) error {
+	n, err := isdo.isd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{idempotentsend.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isdo *IdempotentSendDeleteOne) ExecX(ctx context.Context) {
+	isdo.isd.ExecX(ctx)
+}
@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// IdempotentSendQuery is the builder for querying IdempotentSend entities.
+type IdempotentSendQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.IdempotentSend
+	withWallet *WalletQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IdempotentSendQuery builder.
+func (isq *IdempotentSendQuery) Where(ps ...predicate.IdempotentSend) *IdempotentSendQuery {
+	isq.predicates = append(isq.predicates, ps...)
+	return isq
+}
+
+// Limit adds a limit step to the query.
+func (isq *IdempotentSendQuery) Limit(limit int) *IdempotentSendQuery {
+	isq.limit = &limit
+	return isq
+}
+
+// Offset adds an offset step to the query.
+func (isq *IdempotentSendQuery) Offset(offset int) *IdempotentSendQuery {
+	isq.offset = &offset
+	return isq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (isq *IdempotentSendQuery) Unique(unique bool) *IdempotentSendQuery {
+	isq.unique = &unique
+	return isq
+}
+
+// Order adds an order step to the query.
+func (isq *IdempotentSendQuery) Order(o ...OrderFunc) *IdempotentSendQuery {
+	isq.order = append(isq.order, o...)
+	return isq
+}
+
+// QueryWallet chains the current query on the "wallet" edge.
+func (isq *IdempotentSendQuery) QueryWallet() *WalletQuery {
+	query := &WalletQuery{config: isq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := isq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := isq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(idempotentsend.Table, idempotentsend.FieldID, selector),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, idempotentsend.WalletTable, idempotentsend.WalletColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(isq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first IdempotentSend entity from the query.
+// Returns a *NotFoundError when no IdempotentSend was found.
+func (isq *IdempotentSendQuery) First(ctx context.Context) (*IdempotentSend, error) {
+	nodes, err := isq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{idempotentsend.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (isq *IdempotentSendQuery) FirstX(ctx context.Context) *IdempotentSend {
+	node, err := isq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first IdempotentSend ID from the query.
+// Returns a *NotFoundError when no IdempotentSend ID was found.
+func (isq *IdempotentSendQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = isq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{idempotentsend.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (isq *IdempotentSendQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := isq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single IdempotentSend entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one IdempotentSend entity is found.
+// Returns a *NotFoundError when no IdempotentSend entities are found.
+func (isq *IdempotentSendQuery) Only(ctx context.Context) (*IdempotentSend, error) {
+	nodes, err := isq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{idempotentsend.Label}
+	default:
+		return nil, &NotSingularError{idempotentsend.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (isq *IdempotentSendQuery) OnlyX(ctx context.Context) *IdempotentSend {
+	node, err := isq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only IdempotentSend ID in the query.
+// Returns a *NotSingularError when more than one IdempotentSend ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (isq *IdempotentSendQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = isq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{idempotentsend.Label}
+	default:
+		err = &NotSingularError{idempotentsend.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (isq *IdempotentSendQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := isq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of IdempotentSends.
+func (isq *IdempotentSendQuery) All(ctx context.Context) ([]*IdempotentSend, error) {
+	if err := isq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return isq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (isq *IdempotentSendQuery) AllX(ctx context.Context) []*IdempotentSend {
+	nodes, err := isq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of IdempotentSend IDs.
+func (isq *IdempotentSendQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := isq.Select(idempotentsend.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (isq *IdempotentSendQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := isq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (isq *IdempotentSendQuery) Count(ctx context.Context) (int, error) {
+	if err := isq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return isq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (isq *IdempotentSendQuery) CountX(ctx context.Context) int {
+	count, err := isq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (isq *IdempotentSendQuery) Exist(ctx context.Context) (bool, error) {
+	if err := isq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return isq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (isq *IdempotentSendQuery) ExistX(ctx context.Context) bool {
+	exist, err := isq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IdempotentSendQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (isq *IdempotentSendQuery) Clone() *IdempotentSendQuery {
+	if isq == nil {
+		return nil
+	}
+	return &IdempotentSendQuery{
+		config:     isq.config,
+		limit:      isq.limit,
+		offset:     isq.offset,
+		order:      append([]OrderFunc{}, isq.order...),
+		predicates: append([]predicate.IdempotentSend{}, isq.predicates...),
+		withWallet: isq.withWallet.Clone(),
+		// clone intermediate query.
+		sql:    isq.sql.Clone(),
+		path:   isq.path,
+		unique: isq.unique,
+	}
+}
+
+// WithWallet tells the query-builder to eager-load the nodes that are connected to
+// the "wallet" edge. The optional arguments are used to configure the query builder of the edge.
+func (isq *IdempotentSendQuery) WithWallet(opts ...func(*WalletQuery)) *IdempotentSendQuery {
+	query := &WalletQuery{config: isq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	isq.withWallet = query
+	return isq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.IdempotentSend.Query().
+//		GroupBy(idempotentsend.FieldWalletID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (isq *IdempotentSendQuery) GroupBy(field string, fields ...string) *IdempotentSendGroupBy {
+	grbuild := &IdempotentSendGroupBy{config: isq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := isq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return isq.sqlQuery(ctx), nil
+	}
+	grbuild.label = idempotentsend.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//	}
+//
+//	client.IdempotentSend.Query().
+//		Select(idempotentsend.FieldWalletID).
+//		Scan(ctx, &v)
+func (isq *IdempotentSendQuery) Select(fields ...string) *IdempotentSendSelect {
+	isq.fields = append(isq.fields, fields...)
+	selbuild := &IdempotentSendSelect{IdempotentSendQuery: isq}
+	selbuild.label = idempotentsend.Label
+	selbuild.flds, selbuild.scan = &isq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (isq *IdempotentSendQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range isq.fields {
+		if !idempotentsend.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if isq.path != nil {
+		prev, err := isq.path(ctx)
+		if err != nil {
+			return err
+		}
+		isq.sql = prev
+	}
+	return nil
+}
+
+func (isq *IdempotentSendQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*IdempotentSend, error) {
+	var (
+		nodes       = []*IdempotentSend{}
+		_spec       = isq.querySpec()
+		loadedTypes = [1]bool{
+			isq.withWallet != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*IdempotentSend).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &IdempotentSend{config: isq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, isq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := isq.withWallet; query != nil {
+		if err := isq.loadWallet(ctx, query, nodes, nil,
+			func(n *IdempotentSend, e *Wallet) { n.Edges.Wallet = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (isq *IdempotentSendQuery) loadWallet(ctx context.Context, query *WalletQuery, nodes []*IdempotentSend, init func(*IdempotentSend), assign func(*IdempotentSend, *Wallet)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*IdempotentSend)
+	for i := range nodes {
+		fk := nodes[i].WalletID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(wallet.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (isq *IdempotentSendQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := isq.querySpec()
+	_spec.Node.Columns = isq.fields
+	if len(isq.fields) > 0 {
+		_spec.Unique = isq.unique != nil && *isq.unique
+	}
+	return sqlgraph.CountNodes(ctx, isq.driver, _spec)
+}
+
+func (isq *IdempotentSendQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := isq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (isq *IdempotentSendQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   idempotentsend.Table,
+			Columns: idempotentsend.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: idempotentsend.FieldID,
+			},
+		},
+		From:   isq.sql,
+		Unique: true,
+	}
+	if unique := isq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := isq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, idempotentsend.FieldID)
+		for i := range fields {
+			if fields[i] != idempotentsend.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := isq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := isq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := isq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := isq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (isq *IdempotentSendQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(isq.driver.Dialect())
+	t1 := builder.Table(idempotentsend.Table)
+	columns := isq.fields
+	if len(columns) == 0 {
+		columns = idempotentsend.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if isq.sql != nil {
+		selector = isq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if isq.unique != nil && *isq.unique {
+		selector.Distinct()
+	}
+	for _, p := range isq.predicates {
+		p(selector)
+	}
+	for _, p := range isq.order {
+		p(selector)
+	}
+	if offset := isq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := isq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IdempotentSendGroupBy is the group-by builder for IdempotentSend entities.
+type IdempotentSendGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (isgb *IdempotentSendGroupBy) Aggregate(fns ...AggregateFunc) *IdempotentSendGroupBy {
+	isgb.fns = append(isgb.fns, fns...)
+	return isgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (isgb *IdempotentSendGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := isgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	isgb.sql = query
+	return isgb.sqlScan(ctx, v)
+}
+
+func (isgb *IdempotentSendGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range isgb.fields {
+		if !idempotentsend.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := isgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := isgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (isgb *IdempotentSendGroupBy) sqlQuery() *sql.Selector {
+	selector := isgb.sql.Select()
+	aggregation := make([]string, 0, len(isgb.fns))
+	for _, fn := range isgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(isgb.fields)+len(isgb.fns))
+		for _, f := range isgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(isgb.fields...)...)
+}
+
+// IdempotentSendSelect is the builder for selecting fields of IdempotentSend entities.
+type IdempotentSendSelect struct {
+	*IdempotentSendQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iss *IdempotentSendSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := iss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	iss.sql = iss.IdempotentSendQuery.sqlQuery(ctx)
+	return iss.sqlScan(ctx, v)
+}
+
+func (iss *IdempotentSendSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := iss.sql.Query()
+	if err := iss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// IdempotentSendUpdate is the builder for updating IdempotentSend entities.
+type IdempotentSendUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IdempotentSendMutation
+}
+
+// Where appends a list predicates to the IdempotentSendUpdate builder.
+func (isu *IdempotentSendUpdate) Where(ps ...predicate.IdempotentSend) *IdempotentSendUpdate {
+	isu.mutation.Where(ps...)
+	return isu
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (isu *IdempotentSendUpdate) SetWalletID(u uuid.UUID) *IdempotentSendUpdate {
+	isu.mutation.SetWalletID(u)
+	return isu
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (isu *IdempotentSendUpdate) SetBlockHash(s string) *IdempotentSendUpdate {
+	isu.mutation.SetBlockHash(s)
+	return isu
+}
+
+// SetNillableBlockHash sets the "block_hash" field if the given value is not nil.
+func (isu *IdempotentSendUpdate) SetNillableBlockHash(s *string) *IdempotentSendUpdate {
+	if s != nil {
+		isu.SetBlockHash(*s)
+	}
+	return isu
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (isu *IdempotentSendUpdate) ClearBlockHash() *IdempotentSendUpdate {
+	isu.mutation.ClearBlockHash()
+	return isu
+}
+
+// SetStatus sets the "status" field.
+func (isu *IdempotentSendUpdate) SetStatus(i idempotentsend.Status) *IdempotentSendUpdate {
+	isu.mutation.SetStatus(i)
+	return isu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (isu *IdempotentSendUpdate) SetNillableStatus(i *idempotentsend.Status) *IdempotentSendUpdate {
+	if i != nil {
+		isu.SetStatus(*i)
+	}
+	return isu
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (isu *IdempotentSendUpdate) SetWallet(w *Wallet) *IdempotentSendUpdate {
+	return isu.SetWalletID(w.ID)
+}
+
+// Mutation returns the IdempotentSendMutation object of the builder.
+func (isu *IdempotentSendUpdate) Mutation() *IdempotentSendMutation {
+	return isu.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (isu *IdempotentSendUpdate) ClearWallet() *IdempotentSendUpdate {
+	isu.mutation.ClearWallet()
+	return isu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (isu *IdempotentSendUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(isu.hooks) == 0 {
+		if err = isu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = isu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IdempotentSendMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = isu.check(); err != nil {
+				return 0, err
+			}
+			isu.mutation = mutation
+			affected, err = isu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(isu.hooks) - 1; i >= 0; i-- {
+			if isu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = isu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, isu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (isu *IdempotentSendUpdate) SaveX(ctx context.Context) int {
+	affected, err := isu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (isu *IdempotentSendUpdate) Exec(ctx context.Context) error {
+	_, err := isu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isu *IdempotentSendUpdate) ExecX(ctx context.Context) {
+	if err := isu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (isu *IdempotentSendUpdate) check() error {
+	if v, ok := isu.mutation.BlockHash(); ok {
+		if err := idempotentsend.BlockHashValidator(v); err != nil {
+			return &ValidationError{Name: "block_hash", err: fmt.Errorf(`ent: validator failed for field "IdempotentSend.block_hash": %w`, err)}
+		}
+	}
+	if v, ok := isu.mutation.Status(); ok {
+		if err := idempotentsend.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "IdempotentSend.status": %w`, err)}
+		}
+	}
+	if _, ok := isu.mutation.WalletID(); isu.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "IdempotentSend.wallet"`)
+	}
+	return nil
+}
+
+func (isu *IdempotentSendUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   idempotentsend.Table,
+			Columns: idempotentsend.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: idempotentsend.FieldID,
+			},
+		},
+	}
+	if ps := isu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := isu.mutation.BlockHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotentsend.FieldBlockHash,
+		})
+	}
+	if isu.mutation.BlockHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: idempotentsend.FieldBlockHash,
+		})
+	}
+	if value, ok := isu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: idempotentsend.FieldStatus,
+		})
+	}
+	if isu.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   idempotentsend.WalletTable,
+			Columns: []string{idempotentsend.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := isu.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   idempotentsend.WalletTable,
+			Columns: []string{idempotentsend.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, isu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotentsend.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// IdempotentSendUpdateOne is the builder for updating a single IdempotentSend entity.
+type IdempotentSendUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IdempotentSendMutation
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (isuo *IdempotentSendUpdateOne) SetWalletID(u uuid.UUID) *IdempotentSendUpdateOne {
+	isuo.mutation.SetWalletID(u)
+	return isuo
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (isuo *IdempotentSendUpdateOne) SetBlockHash(s string) *IdempotentSendUpdateOne {
+	isuo.mutation.SetBlockHash(s)
+	return isuo
+}
+
+// SetNillableBlockHash sets the "block_hash" field if the given value is not nil.
+func (isuo *IdempotentSendUpdateOne) SetNillableBlockHash(s *string) *IdempotentSendUpdateOne {
+	if s != nil {
+		isuo.SetBlockHash(*s)
+	}
+	return isuo
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (isuo *IdempotentSendUpdateOne) ClearBlockHash() *IdempotentSendUpdateOne {
+	isuo.mutation.ClearBlockHash()
+	return isuo
+}
+
+// SetStatus sets the "status" field.
+func (isuo *IdempotentSendUpdateOne) SetStatus(i idempotentsend.Status) *IdempotentSendUpdateOne {
+	isuo.mutation.SetStatus(i)
+	return isuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (isuo *IdempotentSendUpdateOne) SetNillableStatus(i *idempotentsend.Status) *IdempotentSendUpdateOne {
+	if i != nil {
+		isuo.SetStatus(*i)
+	}
+	return isuo
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (isuo *IdempotentSendUpdateOne) SetWallet(w *Wallet) *IdempotentSendUpdateOne {
+	return isuo.SetWalletID(w.ID)
+}
+
+// Mutation returns the IdempotentSendMutation object of the builder.
+func (isuo *IdempotentSendUpdateOne) Mutation() *IdempotentSendMutation {
+	return isuo.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (isuo *IdempotentSendUpdateOne) ClearWallet() *IdempotentSendUpdateOne {
+	isuo.mutation.ClearWallet()
+	return isuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (isuo *IdempotentSendUpdateOne) Select(field string, fields ...string) *IdempotentSendUpdateOne {
+	isuo.fields = append([]string{field}, fields...)
+	return isuo
+}
+
+// Save executes the query and returns the updated IdempotentSend entity.
+func (isuo *IdempotentSendUpdateOne) Save(ctx context.Context) (*IdempotentSend, error) {
+	var (
+		err  error
+		node *IdempotentSend
+	)
+	if len(isuo.hooks) == 0 {
+		if err = isuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = isuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*IdempotentSendMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = isuo.check(); err != nil {
+				return nil, err
+			}
+			isuo.mutation = mutation
+			node, err = isuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(isuo.hooks) - 1; i >= 0; i-- {
+			if isuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = isuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, isuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*IdempotentSend)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from IdempotentSendMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (isuo *IdempotentSendUpdateOne) SaveX(ctx context.Context) *IdempotentSend {
+	node, err := isuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (isuo *IdempotentSendUpdateOne) Exec(ctx context.Context) error {
+	_, err := isuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (isuo *IdempotentSendUpdateOne) ExecX(ctx context.Context) {
+	if err := isuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (isuo *IdempotentSendUpdateOne) check() error {
+	if v, ok := isuo.mutation.BlockHash(); ok {
+		if err := idempotentsend.BlockHashValidator(v); err != nil {
+			return &ValidationError{Name: "block_hash", err: fmt.Errorf(`ent: validator failed for field "IdempotentSend.block_hash": %w`, err)}
+		}
+	}
+	if v, ok := isuo.mutation.Status(); ok {
+		if err := idempotentsend.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "IdempotentSend.status": %w`, err)}
+		}
+	}
+	if _, ok := isuo.mutation.WalletID(); isuo.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "IdempotentSend.wallet"`)
+	}
+	return nil
+}
+
+func (isuo *IdempotentSendUpdateOne) sqlSave(ctx context.Context) (_node *IdempotentSend, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   idempotentsend.Table,
+			Columns: idempotentsend.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: idempotentsend.FieldID,
+			},
+		},
+	}
+	id, ok := isuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IdempotentSend.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := isuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, idempotentsend.FieldID)
+		for _, f := range fields {
+			if !idempotentsend.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != idempotentsend.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := isuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := isuo.mutation.BlockHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: idempotentsend.FieldBlockHash,
+		})
+	}
+	if isuo.mutation.BlockHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: idempotentsend.FieldBlockHash,
+		})
+	}
+	if value, ok := isuo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: idempotentsend.FieldStatus,
+		})
+	}
+	if isuo.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   idempotentsend.WalletTable,
+			Columns: []string{idempotentsend.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := isuo.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   idempotentsend.WalletTable,
+			Columns: []string{idempotentsend.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &IdempotentSend{config: isuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, isuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{idempotentsend.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
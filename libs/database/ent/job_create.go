@@ -0,0 +1,457 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// JobCreate is the builder for creating a Job entity.
+type JobCreate struct {
+	config
+	mutation *JobMutation
+	hooks    []Hook
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (jc *JobCreate) SetWalletID(u uuid.UUID) *JobCreate {
+	jc.mutation.SetWalletID(u)
+	return jc
+}
+
+// SetAction sets the "action" field.
+func (jc *JobCreate) SetAction(s string) *JobCreate {
+	jc.mutation.SetAction(s)
+	return jc
+}
+
+// SetStatus sets the "status" field.
+func (jc *JobCreate) SetStatus(j job.Status) *JobCreate {
+	jc.mutation.SetStatus(j)
+	return jc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jc *JobCreate) SetNillableStatus(j *job.Status) *JobCreate {
+	if j != nil {
+		jc.SetStatus(*j)
+	}
+	return jc
+}
+
+// SetPercent sets the "percent" field.
+func (jc *JobCreate) SetPercent(i int) *JobCreate {
+	jc.mutation.SetPercent(i)
+	return jc
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (jc *JobCreate) SetNillablePercent(i *int) *JobCreate {
+	if i != nil {
+		jc.SetPercent(*i)
+	}
+	return jc
+}
+
+// SetResult sets the "result" field.
+func (jc *JobCreate) SetResult(m map[string]interface{}) *JobCreate {
+	jc.mutation.SetResult(m)
+	return jc
+}
+
+// SetError sets the "error" field.
+func (jc *JobCreate) SetError(s string) *JobCreate {
+	jc.mutation.SetError(s)
+	return jc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jc *JobCreate) SetNillableError(s *string) *JobCreate {
+	if s != nil {
+		jc.SetError(*s)
+	}
+	return jc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jc *JobCreate) SetCreatedAt(t time.Time) *JobCreate {
+	jc.mutation.SetCreatedAt(t)
+	return jc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableCreatedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetCreatedAt(*t)
+	}
+	return jc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (jc *JobCreate) SetUpdatedAt(t time.Time) *JobCreate {
+	jc.mutation.SetUpdatedAt(t)
+	return jc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (jc *JobCreate) SetNillableUpdatedAt(t *time.Time) *JobCreate {
+	if t != nil {
+		jc.SetUpdatedAt(*t)
+	}
+	return jc
+}
+
+// SetID sets the "id" field.
+func (jc *JobCreate) SetID(u uuid.UUID) *JobCreate {
+	jc.mutation.SetID(u)
+	return jc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (jc *JobCreate) SetNillableID(u *uuid.UUID) *JobCreate {
+	if u != nil {
+		jc.SetID(*u)
+	}
+	return jc
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (jc *JobCreate) SetWallet(w *Wallet) *JobCreate {
+	return jc.SetWalletID(w.ID)
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (jc *JobCreate) Mutation() *JobMutation {
+	return jc.mutation
+}
+
+// Save creates the Job in the database.
+func (jc *JobCreate) Save(ctx context.Context) (*Job, error) {
+	var (
+		err  error
+		node *Job
+	)
+	jc.defaults()
+	if len(jc.hooks) == 0 {
+		if err = jc.check(); err != nil {
+			return nil, err
+		}
+		node, err = jc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = jc.check(); err != nil {
+				return nil, err
+			}
+			jc.mutation = mutation
+			if node, err = jc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(jc.hooks) - 1; i >= 0; i-- {
+			if jc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = jc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, jc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Job)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from JobMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jc *JobCreate) SaveX(ctx context.Context) *Job {
+	v, err := jc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jc *JobCreate) Exec(ctx context.Context) error {
+	_, err := jc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jc *JobCreate) ExecX(ctx context.Context) {
+	if err := jc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jc *JobCreate) defaults() {
+	if _, ok := jc.mutation.Status(); !ok {
+		v := job.DefaultStatus
+		jc.mutation.SetStatus(v)
+	}
+	if _, ok := jc.mutation.Percent(); !ok {
+		v := job.DefaultPercent
+		jc.mutation.SetPercent(v)
+	}
+	if _, ok := jc.mutation.CreatedAt(); !ok {
+		v := job.DefaultCreatedAt()
+		jc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := jc.mutation.UpdatedAt(); !ok {
+		v := job.DefaultUpdatedAt()
+		jc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := jc.mutation.ID(); !ok {
+		v := job.DefaultID()
+		jc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jc *JobCreate) check() error {
+	if _, ok := jc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "Job.wallet_id"`)}
+	}
+	if _, ok := jc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "Job.action"`)}
+	}
+	if v, ok := jc.mutation.Action(); ok {
+		if err := job.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "Job.action": %w`, err)}
+		}
+	}
+	if _, ok := jc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Job.status"`)}
+	}
+	if v, ok := jc.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
+		}
+	}
+	if _, ok := jc.mutation.Percent(); !ok {
+		return &ValidationError{Name: "percent", err: errors.New(`ent: missing required field "Job.percent"`)}
+	}
+	if _, ok := jc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Job.created_at"`)}
+	}
+	if _, ok := jc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Job.updated_at"`)}
+	}
+	if _, ok := jc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet", err: errors.New(`ent: missing required edge "Job.wallet"`)}
+	}
+	return nil
+}
+
+func (jc *JobCreate) sqlSave(ctx context.Context) (*Job, error) {
+	_node, _spec := jc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (jc *JobCreate) createSpec() (*Job, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Job{config: jc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: job.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: job.FieldID,
+			},
+		}
+	)
+	if id, ok := jc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := jc.mutation.Action(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: job.FieldAction,
+		})
+		_node.Action = value
+	}
+	if value, ok := jc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: job.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := jc.mutation.Percent(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldPercent,
+		})
+		_node.Percent = value
+	}
+	if value, ok := jc.mutation.Result(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: job.FieldResult,
+		})
+		_node.Result = value
+	}
+	if value, ok := jc.mutation.Error(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: job.FieldError,
+		})
+		_node.Error = &value
+	}
+	if value, ok := jc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := jc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := jc.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.WalletTable,
+			Columns: []string{job.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WalletID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// JobCreateBulk is the builder for creating many Job entities in bulk.
+type JobCreateBulk struct {
+	config
+	builders []*JobCreate
+}
+
+// Save creates the Job entities in the database.
+func (jcb *JobCreateBulk) Save(ctx context.Context) ([]*Job, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(jcb.builders))
+	nodes := make([]*Job, len(jcb.builders))
+	mutators := make([]Mutator, len(jcb.builders))
+	for i := range jcb.builders {
+		func(i int, root context.Context) {
+			builder := jcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jcb *JobCreateBulk) SaveX(ctx context.Context) []*Job {
+	v, err := jcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jcb *JobCreateBulk) Exec(ctx context.Context) error {
+	_, err := jcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jcb *JobCreateBulk) ExecX(ctx context.Context) {
+	if err := jcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
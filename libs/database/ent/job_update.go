@@ -0,0 +1,662 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// JobUpdate is the builder for updating Job entities.
+type JobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobMutation
+}
+
+// Where appends a list predicates to the JobUpdate builder.
+func (ju *JobUpdate) Where(ps ...predicate.Job) *JobUpdate {
+	ju.mutation.Where(ps...)
+	return ju
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (ju *JobUpdate) SetWalletID(u uuid.UUID) *JobUpdate {
+	ju.mutation.SetWalletID(u)
+	return ju
+}
+
+// SetStatus sets the "status" field.
+func (ju *JobUpdate) SetStatus(j job.Status) *JobUpdate {
+	ju.mutation.SetStatus(j)
+	return ju
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableStatus(j *job.Status) *JobUpdate {
+	if j != nil {
+		ju.SetStatus(*j)
+	}
+	return ju
+}
+
+// SetPercent sets the "percent" field.
+func (ju *JobUpdate) SetPercent(i int) *JobUpdate {
+	ju.mutation.ResetPercent()
+	ju.mutation.SetPercent(i)
+	return ju
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (ju *JobUpdate) SetNillablePercent(i *int) *JobUpdate {
+	if i != nil {
+		ju.SetPercent(*i)
+	}
+	return ju
+}
+
+// AddPercent adds i to the "percent" field.
+func (ju *JobUpdate) AddPercent(i int) *JobUpdate {
+	ju.mutation.AddPercent(i)
+	return ju
+}
+
+// SetResult sets the "result" field.
+func (ju *JobUpdate) SetResult(m map[string]interface{}) *JobUpdate {
+	ju.mutation.SetResult(m)
+	return ju
+}
+
+// ClearResult clears the value of the "result" field.
+func (ju *JobUpdate) ClearResult() *JobUpdate {
+	ju.mutation.ClearResult()
+	return ju
+}
+
+// SetError sets the "error" field.
+func (ju *JobUpdate) SetError(s string) *JobUpdate {
+	ju.mutation.SetError(s)
+	return ju
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ju *JobUpdate) SetNillableError(s *string) *JobUpdate {
+	if s != nil {
+		ju.SetError(*s)
+	}
+	return ju
+}
+
+// ClearError clears the value of the "error" field.
+func (ju *JobUpdate) ClearError() *JobUpdate {
+	ju.mutation.ClearError()
+	return ju
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ju *JobUpdate) SetUpdatedAt(t time.Time) *JobUpdate {
+	ju.mutation.SetUpdatedAt(t)
+	return ju
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (ju *JobUpdate) SetWallet(w *Wallet) *JobUpdate {
+	return ju.SetWalletID(w.ID)
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (ju *JobUpdate) Mutation() *JobMutation {
+	return ju.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (ju *JobUpdate) ClearWallet() *JobUpdate {
+	ju.mutation.ClearWallet()
+	return ju
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ju *JobUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	ju.defaults()
+	if len(ju.hooks) == 0 {
+		if err = ju.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ju.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ju.check(); err != nil {
+				return 0, err
+			}
+			ju.mutation = mutation
+			affected, err = ju.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ju.hooks) - 1; i >= 0; i-- {
+			if ju.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ju.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ju.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ju *JobUpdate) SaveX(ctx context.Context) int {
+	affected, err := ju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ju *JobUpdate) Exec(ctx context.Context) error {
+	_, err := ju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ju *JobUpdate) ExecX(ctx context.Context) {
+	if err := ju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ju *JobUpdate) defaults() {
+	if _, ok := ju.mutation.UpdatedAt(); !ok {
+		v := job.UpdateDefaultUpdatedAt()
+		ju.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ju *JobUpdate) check() error {
+	if v, ok := ju.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
+		}
+	}
+	if _, ok := ju.mutation.WalletID(); ju.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Job.wallet"`)
+	}
+	return nil
+}
+
+func (ju *JobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   job.Table,
+			Columns: job.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: job.FieldID,
+			},
+		},
+	}
+	if ps := ju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ju.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: job.FieldStatus,
+		})
+	}
+	if value, ok := ju.mutation.Percent(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldPercent,
+		})
+	}
+	if value, ok := ju.mutation.AddedPercent(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldPercent,
+		})
+	}
+	if value, ok := ju.mutation.Result(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: job.FieldResult,
+		})
+	}
+	if ju.mutation.ResultCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: job.FieldResult,
+		})
+	}
+	if value, ok := ju.mutation.Error(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: job.FieldError,
+		})
+	}
+	if ju.mutation.ErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: job.FieldError,
+		})
+	}
+	if value, ok := ju.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldUpdatedAt,
+		})
+	}
+	if ju.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.WalletTable,
+			Columns: []string{job.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ju.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.WalletTable,
+			Columns: []string{job.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// JobUpdateOne is the builder for updating a single Job entity.
+type JobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobMutation
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (juo *JobUpdateOne) SetWalletID(u uuid.UUID) *JobUpdateOne {
+	juo.mutation.SetWalletID(u)
+	return juo
+}
+
+// SetStatus sets the "status" field.
+func (juo *JobUpdateOne) SetStatus(j job.Status) *JobUpdateOne {
+	juo.mutation.SetStatus(j)
+	return juo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableStatus(j *job.Status) *JobUpdateOne {
+	if j != nil {
+		juo.SetStatus(*j)
+	}
+	return juo
+}
+
+// SetPercent sets the "percent" field.
+func (juo *JobUpdateOne) SetPercent(i int) *JobUpdateOne {
+	juo.mutation.ResetPercent()
+	juo.mutation.SetPercent(i)
+	return juo
+}
+
+// SetNillablePercent sets the "percent" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillablePercent(i *int) *JobUpdateOne {
+	if i != nil {
+		juo.SetPercent(*i)
+	}
+	return juo
+}
+
+// AddPercent adds i to the "percent" field.
+func (juo *JobUpdateOne) AddPercent(i int) *JobUpdateOne {
+	juo.mutation.AddPercent(i)
+	return juo
+}
+
+// SetResult sets the "result" field.
+func (juo *JobUpdateOne) SetResult(m map[string]interface{}) *JobUpdateOne {
+	juo.mutation.SetResult(m)
+	return juo
+}
+
+// ClearResult clears the value of the "result" field.
+func (juo *JobUpdateOne) ClearResult() *JobUpdateOne {
+	juo.mutation.ClearResult()
+	return juo
+}
+
+// SetError sets the "error" field.
+func (juo *JobUpdateOne) SetError(s string) *JobUpdateOne {
+	juo.mutation.SetError(s)
+	return juo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (juo *JobUpdateOne) SetNillableError(s *string) *JobUpdateOne {
+	if s != nil {
+		juo.SetError(*s)
+	}
+	return juo
+}
+
+// ClearError clears the value of the "error" field.
+func (juo *JobUpdateOne) ClearError() *JobUpdateOne {
+	juo.mutation.ClearError()
+	return juo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (juo *JobUpdateOne) SetUpdatedAt(t time.Time) *JobUpdateOne {
+	juo.mutation.SetUpdatedAt(t)
+	return juo
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (juo *JobUpdateOne) SetWallet(w *Wallet) *JobUpdateOne {
+	return juo.SetWalletID(w.ID)
+}
+
+// Mutation returns the JobMutation object of the builder.
+func (juo *JobUpdateOne) Mutation() *JobMutation {
+	return juo.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (juo *JobUpdateOne) ClearWallet() *JobUpdateOne {
+	juo.mutation.ClearWallet()
+	return juo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (juo *JobUpdateOne) Select(field string, fields ...string) *JobUpdateOne {
+	juo.fields = append([]string{field}, fields...)
+	return juo
+}
+
+// Save executes the query and returns the updated Job entity.
+func (juo *JobUpdateOne) Save(ctx context.Context) (*Job, error) {
+	var (
+		err  error
+		node *Job
+	)
+	juo.defaults()
+	if len(juo.hooks) == 0 {
+		if err = juo.check(); err != nil {
+			return nil, err
+		}
+		node, err = juo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*JobMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = juo.check(); err != nil {
+				return nil, err
+			}
+			juo.mutation = mutation
+			node, err = juo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(juo.hooks) - 1; i >= 0; i-- {
+			if juo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = juo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, juo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Job)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from JobMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (juo *JobUpdateOne) SaveX(ctx context.Context) *Job {
+	node, err := juo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (juo *JobUpdateOne) Exec(ctx context.Context) error {
+	_, err := juo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (juo *JobUpdateOne) ExecX(ctx context.Context) {
+	if err := juo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (juo *JobUpdateOne) defaults() {
+	if _, ok := juo.mutation.UpdatedAt(); !ok {
+		v := job.UpdateDefaultUpdatedAt()
+		juo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (juo *JobUpdateOne) check() error {
+	if v, ok := juo.mutation.Status(); ok {
+		if err := job.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Job.status": %w`, err)}
+		}
+	}
+	if _, ok := juo.mutation.WalletID(); juo.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Job.wallet"`)
+	}
+	return nil
+}
+
+func (juo *JobUpdateOne) sqlSave(ctx context.Context) (_node *Job, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   job.Table,
+			Columns: job.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: job.FieldID,
+			},
+		},
+	}
+	id, ok := juo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Job.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := juo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, job.FieldID)
+		for _, f := range fields {
+			if !job.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != job.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := juo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := juo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: job.FieldStatus,
+		})
+	}
+	if value, ok := juo.mutation.Percent(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldPercent,
+		})
+	}
+	if value, ok := juo.mutation.AddedPercent(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: job.FieldPercent,
+		})
+	}
+	if value, ok := juo.mutation.Result(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: job.FieldResult,
+		})
+	}
+	if juo.mutation.ResultCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: job.FieldResult,
+		})
+	}
+	if value, ok := juo.mutation.Error(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: job.FieldError,
+		})
+	}
+	if juo.mutation.ErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: job.FieldError,
+		})
+	}
+	if value, ok := juo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: job.FieldUpdatedAt,
+		})
+	}
+	if juo.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.WalletTable,
+			Columns: []string{job.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := juo.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   job.WalletTable,
+			Columns: []string{job.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Job{config: juo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, juo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{job.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
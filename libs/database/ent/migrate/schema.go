@@ -15,7 +15,12 @@ var (
 		{Name: "address", Type: field.TypeString, Size: 65},
 		{Name: "account_index", Type: field.TypeInt, Nullable: true},
 		{Name: "private_key", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "seed", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "seed_index", Type: field.TypeInt, Nullable: true},
 		{Name: "work", Type: field.TypeBool, Default: true},
+		{Name: "watch_only", Type: field.TypeBool, Default: false},
+		{Name: "label", Type: field.TypeString, Nullable: true, Size: 256},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "wallet_id", Type: field.TypeUUID},
 	}
@@ -27,7 +32,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "accounts_wallets_accounts",
-				Columns:    []*schema.Column{AccountsColumns[6]},
+				Columns:    []*schema.Column{AccountsColumns[11]},
 				RefColumns: []*schema.Column{WalletsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -36,12 +41,126 @@ var (
 			{
 				Name:    "account_wallet_id",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[6]},
+				Columns: []*schema.Column{AccountsColumns[11]},
 			},
 			{
 				Name:    "account_wallet_id_address",
 				Unique:  true,
-				Columns: []*schema.Column{AccountsColumns[6], AccountsColumns[1]},
+				Columns: []*schema.Column{AccountsColumns[11], AccountsColumns[1]},
+			},
+		},
+	}
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "key_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"read", "send", "admin"}},
+		{Name: "approver", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+	}
+	// AuditRecordsColumns holds the columns for the "audit_records" table.
+	AuditRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "action", Type: field.TypeString, Size: 64},
+		{Name: "wallet", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "ip", Type: field.TypeString, Size: 64},
+		{Name: "api_key_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "api_key_name", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "params", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeInt},
+		{Name: "error_code", Type: field.TypeString, Nullable: true, Size: 64},
+	}
+	// AuditRecordsTable holds the schema information for the "audit_records" table.
+	AuditRecordsTable = &schema.Table{
+		Name:       "audit_records",
+		Columns:    AuditRecordsColumns,
+		PrimaryKey: []*schema.Column{AuditRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditrecord_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditRecordsColumns[1]},
+			},
+			{
+				Name:    "auditrecord_wallet_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditRecordsColumns[3], AuditRecordsColumns[1]},
+			},
+		},
+	}
+	// BalanceAlertsColumns holds the columns for the "balance_alerts" table.
+	BalanceAlertsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "account", Type: field.TypeString, Size: 65},
+		{Name: "threshold", Type: field.TypeString, Size: 64},
+		{Name: "direction", Type: field.TypeEnum, Enums: []string{"above", "below"}},
+		{Name: "callback_url", Type: field.TypeString},
+		{Name: "fired", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	// BalanceAlertsTable holds the schema information for the "balance_alerts" table.
+	BalanceAlertsTable = &schema.Table{
+		Name:       "balance_alerts",
+		Columns:    BalanceAlertsColumns,
+		PrimaryKey: []*schema.Column{BalanceAlertsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "balance_alerts_wallets_balance_alerts",
+				Columns:    []*schema.Column{BalanceAlertsColumns[7]},
+				RefColumns: []*schema.Column{WalletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "balancealert_wallet_id",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceAlertsColumns[7]},
+			},
+		},
+	}
+	// BalanceSnapshotsColumns holds the columns for the "balance_snapshots" table.
+	BalanceSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "balance_raw", Type: field.TypeString, Size: 64},
+		{Name: "snapshot_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeUUID},
+		{Name: "wallet_snapshot_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// BalanceSnapshotsTable holds the schema information for the "balance_snapshots" table.
+	BalanceSnapshotsTable = &schema.Table{
+		Name:       "balance_snapshots",
+		Columns:    BalanceSnapshotsColumns,
+		PrimaryKey: []*schema.Column{BalanceSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "balance_snapshots_accounts_balance_snapshots",
+				Columns:    []*schema.Column{BalanceSnapshotsColumns[3]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "balance_snapshots_wallet_snapshots_balances",
+				Columns:    []*schema.Column{BalanceSnapshotsColumns[4]},
+				RefColumns: []*schema.Column{WalletSnapshotsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "balancesnapshot_account_id_snapshot_at",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceSnapshotsColumns[3], BalanceSnapshotsColumns[2]},
 			},
 		},
 	}
@@ -54,6 +173,7 @@ var (
 		{Name: "subtype", Type: field.TypeString, Size: 10},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "account_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "wallet_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// BlocksTable holds the schema information for the "blocks" table.
 	BlocksTable = &schema.Table{
@@ -67,12 +187,229 @@ var (
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "blocks_wallets_blocks",
+				Columns:    []*schema.Column{BlocksColumns[7]},
+				RefColumns: []*schema.Column{WalletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "block_wallet_id_send_id",
+				Unique:  true,
+				Columns: []*schema.Column{BlocksColumns[7], BlocksColumns[3]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "accounts", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "idempotency_keys_wallets_idempotency_keys",
+				Columns:    []*schema.Column{IdempotencyKeysColumns[3]},
+				RefColumns: []*schema.Column{WalletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[2]},
+			},
+		},
+	}
+	// IdempotentSendsColumns holds the columns for the "idempotent_sends" table.
+	IdempotentSendsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "send_id", Type: field.TypeString, Size: 256},
+		{Name: "source", Type: field.TypeString, Size: 65},
+		{Name: "destination", Type: field.TypeString, Size: 65},
+		{Name: "amount", Type: field.TypeString, Size: 64},
+		{Name: "block_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent", "failed"}, Default: "pending"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	// IdempotentSendsTable holds the schema information for the "idempotent_sends" table.
+	IdempotentSendsTable = &schema.Table{
+		Name:       "idempotent_sends",
+		Columns:    IdempotentSendsColumns,
+		PrimaryKey: []*schema.Column{IdempotentSendsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "idempotent_sends_wallets_idempotent_sends",
+				Columns:    []*schema.Column{IdempotentSendsColumns[8]},
+				RefColumns: []*schema.Column{WalletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "block_account_id_send_id",
+				Name:    "idempotentsend_wallet_id_send_id",
 				Unique:  true,
-				Columns: []*schema.Column{BlocksColumns[6], BlocksColumns[3]},
+				Columns: []*schema.Column{IdempotentSendsColumns[8], IdempotentSendsColumns[1]},
+			},
+			{
+				Name:    "idempotentsend_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotentSendsColumns[7]},
+			},
+		},
+	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeString, Size: 64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "done", "failed"}, Default: "pending"},
+		{Name: "percent", Type: field.TypeInt, Default: 0},
+		{Name: "result", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "jobs_wallets_jobs",
+				Columns:    []*schema.Column{JobsColumns[8]},
+				RefColumns: []*schema.Column{WalletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{JobsColumns[6]},
+			},
+		},
+	}
+	// RepresentativeHistoryColumns holds the columns for the "representative_history" table.
+	RepresentativeHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "old_representative", Type: field.TypeString, Size: 65},
+		{Name: "new_representative", Type: field.TypeString, Size: 65},
+		{Name: "block_hash", Type: field.TypeString, Size: 64},
+		{Name: "changed_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeUUID},
+	}
+	// RepresentativeHistoryTable holds the schema information for the "representative_history" table.
+	RepresentativeHistoryTable = &schema.Table{
+		Name:       "representative_history",
+		Columns:    RepresentativeHistoryColumns,
+		PrimaryKey: []*schema.Column{RepresentativeHistoryColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "representative_history_accounts_representative_history",
+				Columns:    []*schema.Column{RepresentativeHistoryColumns[5]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "representativehistory_account_id_changed_at",
+				Unique:  false,
+				Columns: []*schema.Column{RepresentativeHistoryColumns[5], RepresentativeHistoryColumns[4]},
+			},
+		},
+	}
+	// SendApprovalsColumns holds the columns for the "send_approvals" table.
+	SendApprovalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "send_id", Type: field.TypeString, Nullable: true, Size: 256},
+		{Name: "source", Type: field.TypeString, Size: 65},
+		{Name: "destination", Type: field.TypeString, Size: 65},
+		{Name: "amount", Type: field.TypeString, Size: 64},
+		{Name: "work", Type: field.TypeString, Nullable: true, Size: 16},
+		{Name: "approvals_required", Type: field.TypeInt},
+		{Name: "approved_by", Type: field.TypeJSON, Nullable: true},
+		{Name: "requested_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent", "rejected"}, Default: "pending"},
+		{Name: "block_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	// SendApprovalsTable holds the schema information for the "send_approvals" table.
+	SendApprovalsTable = &schema.Table{
+		Name:       "send_approvals",
+		Columns:    SendApprovalsColumns,
+		PrimaryKey: []*schema.Column{SendApprovalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "send_approvals_wallets_send_approvals",
+				Columns:    []*schema.Column{SendApprovalsColumns[13]},
+				RefColumns: []*schema.Column{WalletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sendapproval_wallet_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{SendApprovalsColumns[13], SendApprovalsColumns[9]},
+			},
+			{
+				Name:    "sendapproval_wallet_id_send_id",
+				Unique:  false,
+				Columns: []*schema.Column{SendApprovalsColumns[13], SendApprovalsColumns[1]},
+			},
+		},
+	}
+	// SendSchedulesColumns holds the columns for the "send_schedules" table.
+	SendSchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "source", Type: field.TypeString, Size: 65},
+		{Name: "destination", Type: field.TypeString, Size: 65},
+		{Name: "amount", Type: field.TypeString, Size: 64},
+		{Name: "interval_seconds", Type: field.TypeInt},
+		{Name: "next_run_at", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "last_failed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	// SendSchedulesTable holds the schema information for the "send_schedules" table.
+	SendSchedulesTable = &schema.Table{
+		Name:       "send_schedules",
+		Columns:    SendSchedulesColumns,
+		PrimaryKey: []*schema.Column{SendSchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "send_schedules_wallets_send_schedules",
+				Columns:    []*schema.Column{SendSchedulesColumns[9]},
+				RefColumns: []*schema.Column{WalletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sendschedule_wallet_id",
+				Unique:  false,
+				Columns: []*schema.Column{SendSchedulesColumns[9]},
+			},
+			{
+				Name:    "sendschedule_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{SendSchedulesColumns[5]},
 			},
 		},
 	}
@@ -81,8 +418,17 @@ var (
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "seed", Type: field.TypeString, Unique: true, Size: 512},
 		{Name: "representative", Type: field.TypeString, Nullable: true, Size: 65},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 128},
 		{Name: "encrypted", Type: field.TypeBool, Default: false},
 		{Name: "work", Type: field.TypeBool, Default: true},
+		{Name: "watch_only", Type: field.TypeBool, Default: false},
+		{Name: "hardware", Type: field.TypeBool, Default: false},
+		{Name: "auto_receive", Type: field.TypeBool, Default: true},
+		{Name: "receive_minimum", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "frozen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "kdf", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "approvals_required", Type: field.TypeInt, Nullable: true},
+		{Name: "approval_threshold", Type: field.TypeString, Nullable: true, Size: 64},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// WalletsTable holds the schema information for the "wallets" table.
@@ -91,11 +437,82 @@ var (
 		Columns:    WalletsColumns,
 		PrimaryKey: []*schema.Column{WalletsColumns[0]},
 	}
+	// WalletSnapshotsColumns holds the columns for the "wallet_snapshots" table.
+	WalletSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "label", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "total_raw", Type: field.TypeString, Size: 64},
+		{Name: "account_count", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	// WalletSnapshotsTable holds the schema information for the "wallet_snapshots" table.
+	WalletSnapshotsTable = &schema.Table{
+		Name:       "wallet_snapshots",
+		Columns:    WalletSnapshotsColumns,
+		PrimaryKey: []*schema.Column{WalletSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wallet_snapshots_wallets_wallet_snapshots",
+				Columns:    []*schema.Column{WalletSnapshotsColumns[5]},
+				RefColumns: []*schema.Column{WalletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "walletsnapshot_wallet_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WalletSnapshotsColumns[5], WalletSnapshotsColumns[4]},
+			},
+		},
+	}
+	// WalletSpendsColumns holds the columns for the "wallet_spends" table.
+	WalletSpendsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "amount", Type: field.TypeString, Size: 64},
+		{Name: "block_hash", Type: field.TypeString, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	// WalletSpendsTable holds the schema information for the "wallet_spends" table.
+	WalletSpendsTable = &schema.Table{
+		Name:       "wallet_spends",
+		Columns:    WalletSpendsColumns,
+		PrimaryKey: []*schema.Column{WalletSpendsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wallet_spends_wallets_spends",
+				Columns:    []*schema.Column{WalletSpendsColumns[4]},
+				RefColumns: []*schema.Column{WalletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "walletspend_wallet_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WalletSpendsColumns[4], WalletSpendsColumns[3]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccountsTable,
+		APIKeysTable,
+		AuditRecordsTable,
+		BalanceAlertsTable,
+		BalanceSnapshotsTable,
 		BlocksTable,
+		IdempotencyKeysTable,
+		IdempotentSendsTable,
+		JobsTable,
+		RepresentativeHistoryTable,
+		SendApprovalsTable,
+		SendSchedulesTable,
 		WalletsTable,
+		WalletSnapshotsTable,
+		WalletSpendsTable,
 	}
 )
 
@@ -104,11 +521,59 @@ func init() {
 	AccountsTable.Annotation = &entsql.Annotation{
 		Table: "accounts",
 	}
+	APIKeysTable.Annotation = &entsql.Annotation{
+		Table: "api_keys",
+	}
+	AuditRecordsTable.Annotation = &entsql.Annotation{
+		Table: "audit_records",
+	}
+	BalanceAlertsTable.ForeignKeys[0].RefTable = WalletsTable
+	BalanceAlertsTable.Annotation = &entsql.Annotation{
+		Table: "balance_alerts",
+	}
+	BalanceSnapshotsTable.ForeignKeys[0].RefTable = AccountsTable
+	BalanceSnapshotsTable.ForeignKeys[1].RefTable = WalletSnapshotsTable
+	BalanceSnapshotsTable.Annotation = &entsql.Annotation{
+		Table: "balance_snapshots",
+	}
 	BlocksTable.ForeignKeys[0].RefTable = AccountsTable
+	BlocksTable.ForeignKeys[1].RefTable = WalletsTable
 	BlocksTable.Annotation = &entsql.Annotation{
 		Table: "blocks",
 	}
+	IdempotencyKeysTable.ForeignKeys[0].RefTable = WalletsTable
+	IdempotencyKeysTable.Annotation = &entsql.Annotation{
+		Table: "idempotency_keys",
+	}
+	IdempotentSendsTable.ForeignKeys[0].RefTable = WalletsTable
+	IdempotentSendsTable.Annotation = &entsql.Annotation{
+		Table: "idempotent_sends",
+	}
+	JobsTable.ForeignKeys[0].RefTable = WalletsTable
+	JobsTable.Annotation = &entsql.Annotation{
+		Table: "jobs",
+	}
+	RepresentativeHistoryTable.ForeignKeys[0].RefTable = AccountsTable
+	RepresentativeHistoryTable.Annotation = &entsql.Annotation{
+		Table: "representative_history",
+	}
+	SendApprovalsTable.ForeignKeys[0].RefTable = WalletsTable
+	SendApprovalsTable.Annotation = &entsql.Annotation{
+		Table: "send_approvals",
+	}
+	SendSchedulesTable.ForeignKeys[0].RefTable = WalletsTable
+	SendSchedulesTable.Annotation = &entsql.Annotation{
+		Table: "send_schedules",
+	}
 	WalletsTable.Annotation = &entsql.Annotation{
 		Table: "wallets",
 	}
+	WalletSnapshotsTable.ForeignKeys[0].RefTable = WalletsTable
+	WalletSnapshotsTable.Annotation = &entsql.Annotation{
+		Table: "wallet_snapshots",
+	}
+	WalletSpendsTable.ForeignKeys[0].RefTable = WalletsTable
+	WalletSpendsTable.Annotation = &entsql.Annotation{
+		Table: "wallet_spends",
+	}
 }
@@ -10,9 +10,21 @@ import (
 	"time"
 
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/apikey"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/auditrecord"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancealert"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotencykey"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendschedule"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
 	"github.com/google/uuid"
 
 	"entgo.io/ent"
@@ -27,32 +39,56 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAccount = "Account"
-	TypeBlock   = "Block"
-	TypeWallet  = "Wallet"
+	TypeAccount               = "Account"
+	TypeApiKey                = "ApiKey"
+	TypeAuditRecord           = "AuditRecord"
+	TypeBalanceAlert          = "BalanceAlert"
+	TypeBalanceSnapshot       = "BalanceSnapshot"
+	TypeBlock                 = "Block"
+	TypeIdempotencyKey        = "IdempotencyKey"
+	TypeIdempotentSend        = "IdempotentSend"
+	TypeJob                   = "Job"
+	TypeRepresentativeHistory = "RepresentativeHistory"
+	TypeSendApproval          = "SendApproval"
+	TypeSendSchedule          = "SendSchedule"
+	TypeWallet                = "Wallet"
+	TypeWalletSnapshot        = "WalletSnapshot"
+	TypeWalletSpend           = "WalletSpend"
 )
 
 // AccountMutation represents an operation that mutates the Account nodes in the graph.
 type AccountMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	address          *string
-	account_index    *int
-	addaccount_index *int
-	private_key      *string
-	work             *bool
-	created_at       *time.Time
-	clearedFields    map[string]struct{}
-	wallet           *uuid.UUID
-	clearedwallet    bool
-	blocks           map[uuid.UUID]struct{}
-	removedblocks    map[uuid.UUID]struct{}
-	clearedblocks    bool
-	done             bool
-	oldValue         func(context.Context) (*Account, error)
-	predicates       []predicate.Account
+	op                            Op
+	typ                           string
+	id                            *uuid.UUID
+	address                       *string
+	account_index                 *int
+	addaccount_index              *int
+	private_key                   *string
+	seed                          *string
+	seed_index                    *int
+	addseed_index                 *int
+	work                          *bool
+	watch_only                    *bool
+	label                         *string
+	metadata                      *map[string]string
+	created_at                    *time.Time
+	clearedFields                 map[string]struct{}
+	wallet                        *uuid.UUID
+	clearedwallet                 bool
+	blocks                        map[uuid.UUID]struct{}
+	removedblocks                 map[uuid.UUID]struct{}
+	clearedblocks                 bool
+	balance_snapshots             map[uuid.UUID]struct{}
+	removedbalance_snapshots      map[uuid.UUID]struct{}
+	clearedbalance_snapshots      bool
+	representative_history        map[uuid.UUID]struct{}
+	removedrepresentative_history map[uuid.UUID]struct{}
+	clearedrepresentative_history bool
+	done                          bool
+	oldValue                      func(context.Context) (*Account, error)
+	predicates                    []predicate.Account
 }
 
 var _ ent.Mutation = (*AccountMutation)(nil)
@@ -350,6 +386,125 @@ func (m *AccountMutation) ResetPrivateKey() {
 	delete(m.clearedFields, account.FieldPrivateKey)
 }
 
+// SetSeed sets the "seed" field.
+func (m *AccountMutation) SetSeed(s string) {
+	m.seed = &s
+}
+
+// Seed returns the value of the "seed" field in the mutation.
+func (m *AccountMutation) Seed() (r string, exists bool) {
+	v := m.seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeed returns the old "seed" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldSeed(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+	}
+	return oldValue.Seed, nil
+}
+
+// ClearSeed clears the value of the "seed" field.
+func (m *AccountMutation) ClearSeed() {
+	m.seed = nil
+	m.clearedFields[account.FieldSeed] = struct{}{}
+}
+
+// SeedCleared returns if the "seed" field was cleared in this mutation.
+func (m *AccountMutation) SeedCleared() bool {
+	_, ok := m.clearedFields[account.FieldSeed]
+	return ok
+}
+
+// ResetSeed resets all changes to the "seed" field.
+func (m *AccountMutation) ResetSeed() {
+	m.seed = nil
+	delete(m.clearedFields, account.FieldSeed)
+}
+
+// SetSeedIndex sets the "seed_index" field.
+func (m *AccountMutation) SetSeedIndex(i int) {
+	m.seed_index = &i
+	m.addseed_index = nil
+}
+
+// SeedIndex returns the value of the "seed_index" field in the mutation.
+func (m *AccountMutation) SeedIndex() (r int, exists bool) {
+	v := m.seed_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeedIndex returns the old "seed_index" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldSeedIndex(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeedIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeedIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeedIndex: %w", err)
+	}
+	return oldValue.SeedIndex, nil
+}
+
+// AddSeedIndex adds i to the "seed_index" field.
+func (m *AccountMutation) AddSeedIndex(i int) {
+	if m.addseed_index != nil {
+		*m.addseed_index += i
+	} else {
+		m.addseed_index = &i
+	}
+}
+
+// AddedSeedIndex returns the value that was added to the "seed_index" field in this mutation.
+func (m *AccountMutation) AddedSeedIndex() (r int, exists bool) {
+	v := m.addseed_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSeedIndex clears the value of the "seed_index" field.
+func (m *AccountMutation) ClearSeedIndex() {
+	m.seed_index = nil
+	m.addseed_index = nil
+	m.clearedFields[account.FieldSeedIndex] = struct{}{}
+}
+
+// SeedIndexCleared returns if the "seed_index" field was cleared in this mutation.
+func (m *AccountMutation) SeedIndexCleared() bool {
+	_, ok := m.clearedFields[account.FieldSeedIndex]
+	return ok
+}
+
+// ResetSeedIndex resets all changes to the "seed_index" field.
+func (m *AccountMutation) ResetSeedIndex() {
+	m.seed_index = nil
+	m.addseed_index = nil
+	delete(m.clearedFields, account.FieldSeedIndex)
+}
+
 // SetWork sets the "work" field.
 func (m *AccountMutation) SetWork(b bool) {
 	m.work = &b
@@ -386,6 +541,140 @@ func (m *AccountMutation) ResetWork() {
 	m.work = nil
 }
 
+// SetWatchOnly sets the "watch_only" field.
+func (m *AccountMutation) SetWatchOnly(b bool) {
+	m.watch_only = &b
+}
+
+// WatchOnly returns the value of the "watch_only" field in the mutation.
+func (m *AccountMutation) WatchOnly() (r bool, exists bool) {
+	v := m.watch_only
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWatchOnly returns the old "watch_only" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldWatchOnly(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWatchOnly is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWatchOnly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWatchOnly: %w", err)
+	}
+	return oldValue.WatchOnly, nil
+}
+
+// ResetWatchOnly resets all changes to the "watch_only" field.
+func (m *AccountMutation) ResetWatchOnly() {
+	m.watch_only = nil
+}
+
+// SetLabel sets the "label" field.
+func (m *AccountMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *AccountMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldLabel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ClearLabel clears the value of the "label" field.
+func (m *AccountMutation) ClearLabel() {
+	m.label = nil
+	m.clearedFields[account.FieldLabel] = struct{}{}
+}
+
+// LabelCleared returns if the "label" field was cleared in this mutation.
+func (m *AccountMutation) LabelCleared() bool {
+	_, ok := m.clearedFields[account.FieldLabel]
+	return ok
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *AccountMutation) ResetLabel() {
+	m.label = nil
+	delete(m.clearedFields, account.FieldLabel)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *AccountMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *AccountMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *AccountMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[account.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *AccountMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[account.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *AccountMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, account.FieldMetadata)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *AccountMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -479,7 +768,7 @@ func (m *AccountMutation) RemoveBlockIDs(ids ...uuid.UUID) {
 	}
 }
 
-// RemovedBlocksIDs returns the removed IDs of the "blocks" edge to the Block entity.
+// RemovedBlocks returns the removed IDs of the "blocks" edge to the Block entity.
 func (m *AccountMutation) RemovedBlocksIDs() (ids []uuid.UUID) {
 	for id := range m.removedblocks {
 		ids = append(ids, id)
@@ -502,6 +791,114 @@ func (m *AccountMutation) ResetBlocks() {
 	m.removedblocks = nil
 }
 
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the BalanceSnapshot entity by ids.
+func (m *AccountMutation) AddBalanceSnapshotIDs(ids ...uuid.UUID) {
+	if m.balance_snapshots == nil {
+		m.balance_snapshots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.balance_snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBalanceSnapshots clears the "balance_snapshots" edge to the BalanceSnapshot entity.
+func (m *AccountMutation) ClearBalanceSnapshots() {
+	m.clearedbalance_snapshots = true
+}
+
+// BalanceSnapshotsCleared reports if the "balance_snapshots" edge to the BalanceSnapshot entity was cleared.
+func (m *AccountMutation) BalanceSnapshotsCleared() bool {
+	return m.clearedbalance_snapshots
+}
+
+// RemoveBalanceSnapshotIDs removes the "balance_snapshots" edge to the BalanceSnapshot entity by IDs.
+func (m *AccountMutation) RemoveBalanceSnapshotIDs(ids ...uuid.UUID) {
+	if m.removedbalance_snapshots == nil {
+		m.removedbalance_snapshots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.balance_snapshots, ids[i])
+		m.removedbalance_snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBalanceSnapshots returns the removed IDs of the "balance_snapshots" edge to the BalanceSnapshot entity.
+func (m *AccountMutation) RemovedBalanceSnapshotsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbalance_snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BalanceSnapshotsIDs returns the "balance_snapshots" edge IDs in the mutation.
+func (m *AccountMutation) BalanceSnapshotsIDs() (ids []uuid.UUID) {
+	for id := range m.balance_snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBalanceSnapshots resets all changes to the "balance_snapshots" edge.
+func (m *AccountMutation) ResetBalanceSnapshots() {
+	m.balance_snapshots = nil
+	m.clearedbalance_snapshots = false
+	m.removedbalance_snapshots = nil
+}
+
+// AddRepresentativeHistoryIDs adds the "representative_history" edge to the RepresentativeHistory entity by ids.
+func (m *AccountMutation) AddRepresentativeHistoryIDs(ids ...uuid.UUID) {
+	if m.representative_history == nil {
+		m.representative_history = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.representative_history[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRepresentativeHistory clears the "representative_history" edge to the RepresentativeHistory entity.
+func (m *AccountMutation) ClearRepresentativeHistory() {
+	m.clearedrepresentative_history = true
+}
+
+// RepresentativeHistoryCleared reports if the "representative_history" edge to the RepresentativeHistory entity was cleared.
+func (m *AccountMutation) RepresentativeHistoryCleared() bool {
+	return m.clearedrepresentative_history
+}
+
+// RemoveRepresentativeHistoryIDs removes the "representative_history" edge to the RepresentativeHistory entity by IDs.
+func (m *AccountMutation) RemoveRepresentativeHistoryIDs(ids ...uuid.UUID) {
+	if m.removedrepresentative_history == nil {
+		m.removedrepresentative_history = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.representative_history, ids[i])
+		m.removedrepresentative_history[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRepresentativeHistory returns the removed IDs of the "representative_history" edge to the RepresentativeHistory entity.
+func (m *AccountMutation) RemovedRepresentativeHistoryIDs() (ids []uuid.UUID) {
+	for id := range m.removedrepresentative_history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepresentativeHistoryIDs returns the "representative_history" edge IDs in the mutation.
+func (m *AccountMutation) RepresentativeHistoryIDs() (ids []uuid.UUID) {
+	for id := range m.representative_history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRepresentativeHistory resets all changes to the "representative_history" edge.
+func (m *AccountMutation) ResetRepresentativeHistory() {
+	m.representative_history = nil
+	m.clearedrepresentative_history = false
+	m.removedrepresentative_history = nil
+}
+
 // Where appends a list predicates to the AccountMutation builder.
 func (m *AccountMutation) Where(ps ...predicate.Account) {
 	m.predicates = append(m.predicates, ps...)
@@ -521,7 +918,7 @@ func (m *AccountMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AccountMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 11)
 	if m.wallet != nil {
 		fields = append(fields, account.FieldWalletID)
 	}
@@ -534,9 +931,24 @@ func (m *AccountMutation) Fields() []string {
 	if m.private_key != nil {
 		fields = append(fields, account.FieldPrivateKey)
 	}
+	if m.seed != nil {
+		fields = append(fields, account.FieldSeed)
+	}
+	if m.seed_index != nil {
+		fields = append(fields, account.FieldSeedIndex)
+	}
 	if m.work != nil {
 		fields = append(fields, account.FieldWork)
 	}
+	if m.watch_only != nil {
+		fields = append(fields, account.FieldWatchOnly)
+	}
+	if m.label != nil {
+		fields = append(fields, account.FieldLabel)
+	}
+	if m.metadata != nil {
+		fields = append(fields, account.FieldMetadata)
+	}
 	if m.created_at != nil {
 		fields = append(fields, account.FieldCreatedAt)
 	}
@@ -556,8 +968,18 @@ func (m *AccountMutation) Field(name string) (ent.Value, bool) {
 		return m.AccountIndex()
 	case account.FieldPrivateKey:
 		return m.PrivateKey()
+	case account.FieldSeed:
+		return m.Seed()
+	case account.FieldSeedIndex:
+		return m.SeedIndex()
 	case account.FieldWork:
 		return m.Work()
+	case account.FieldWatchOnly:
+		return m.WatchOnly()
+	case account.FieldLabel:
+		return m.Label()
+	case account.FieldMetadata:
+		return m.Metadata()
 	case account.FieldCreatedAt:
 		return m.CreatedAt()
 	}
@@ -577,8 +999,18 @@ func (m *AccountMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldAccountIndex(ctx)
 	case account.FieldPrivateKey:
 		return m.OldPrivateKey(ctx)
+	case account.FieldSeed:
+		return m.OldSeed(ctx)
+	case account.FieldSeedIndex:
+		return m.OldSeedIndex(ctx)
 	case account.FieldWork:
 		return m.OldWork(ctx)
+	case account.FieldWatchOnly:
+		return m.OldWatchOnly(ctx)
+	case account.FieldLabel:
+		return m.OldLabel(ctx)
+	case account.FieldMetadata:
+		return m.OldMetadata(ctx)
 	case account.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
@@ -618,6 +1050,20 @@ func (m *AccountMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPrivateKey(v)
 		return nil
+	case account.FieldSeed:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case account.FieldSeedIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeedIndex(v)
+		return nil
 	case account.FieldWork:
 		v, ok := value.(bool)
 		if !ok {
@@ -625,6 +1071,27 @@ func (m *AccountMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetWork(v)
 		return nil
+	case account.FieldWatchOnly:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWatchOnly(v)
+		return nil
+	case account.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case account.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
 	case account.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -643,6 +1110,9 @@ func (m *AccountMutation) AddedFields() []string {
 	if m.addaccount_index != nil {
 		fields = append(fields, account.FieldAccountIndex)
 	}
+	if m.addseed_index != nil {
+		fields = append(fields, account.FieldSeedIndex)
+	}
 	return fields
 }
 
@@ -653,6 +1123,8 @@ func (m *AccountMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case account.FieldAccountIndex:
 		return m.AddedAccountIndex()
+	case account.FieldSeedIndex:
+		return m.AddedSeedIndex()
 	}
 	return nil, false
 }
@@ -669,6 +1141,13 @@ func (m *AccountMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddAccountIndex(v)
 		return nil
+	case account.FieldSeedIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeedIndex(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Account numeric field %s", name)
 }
@@ -683,6 +1162,18 @@ func (m *AccountMutation) ClearedFields() []string {
 	if m.FieldCleared(account.FieldPrivateKey) {
 		fields = append(fields, account.FieldPrivateKey)
 	}
+	if m.FieldCleared(account.FieldSeed) {
+		fields = append(fields, account.FieldSeed)
+	}
+	if m.FieldCleared(account.FieldSeedIndex) {
+		fields = append(fields, account.FieldSeedIndex)
+	}
+	if m.FieldCleared(account.FieldLabel) {
+		fields = append(fields, account.FieldLabel)
+	}
+	if m.FieldCleared(account.FieldMetadata) {
+		fields = append(fields, account.FieldMetadata)
+	}
 	return fields
 }
 
@@ -703,6 +1194,18 @@ func (m *AccountMutation) ClearField(name string) error {
 	case account.FieldPrivateKey:
 		m.ClearPrivateKey()
 		return nil
+	case account.FieldSeed:
+		m.ClearSeed()
+		return nil
+	case account.FieldSeedIndex:
+		m.ClearSeedIndex()
+		return nil
+	case account.FieldLabel:
+		m.ClearLabel()
+		return nil
+	case account.FieldMetadata:
+		m.ClearMetadata()
+		return nil
 	}
 	return fmt.Errorf("unknown Account nullable field %s", name)
 }
@@ -723,8 +1226,23 @@ func (m *AccountMutation) ResetField(name string) error {
 	case account.FieldPrivateKey:
 		m.ResetPrivateKey()
 		return nil
-	case account.FieldWork:
-		m.ResetWork()
+	case account.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case account.FieldSeedIndex:
+		m.ResetSeedIndex()
+		return nil
+	case account.FieldWork:
+		m.ResetWork()
+		return nil
+	case account.FieldWatchOnly:
+		m.ResetWatchOnly()
+		return nil
+	case account.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case account.FieldMetadata:
+		m.ResetMetadata()
 		return nil
 	case account.FieldCreatedAt:
 		m.ResetCreatedAt()
@@ -735,13 +1253,19 @@ func (m *AccountMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *AccountMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m.wallet != nil {
 		edges = append(edges, account.EdgeWallet)
 	}
 	if m.blocks != nil {
 		edges = append(edges, account.EdgeBlocks)
 	}
+	if m.balance_snapshots != nil {
+		edges = append(edges, account.EdgeBalanceSnapshots)
+	}
+	if m.representative_history != nil {
+		edges = append(edges, account.EdgeRepresentativeHistory)
+	}
 	return edges
 }
 
@@ -759,16 +1283,34 @@ func (m *AccountMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case account.EdgeBalanceSnapshots:
+		ids := make([]ent.Value, 0, len(m.balance_snapshots))
+		for id := range m.balance_snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeRepresentativeHistory:
+		ids := make([]ent.Value, 0, len(m.representative_history))
+		for id := range m.representative_history {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *AccountMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m.removedblocks != nil {
 		edges = append(edges, account.EdgeBlocks)
 	}
+	if m.removedbalance_snapshots != nil {
+		edges = append(edges, account.EdgeBalanceSnapshots)
+	}
+	if m.removedrepresentative_history != nil {
+		edges = append(edges, account.EdgeRepresentativeHistory)
+	}
 	return edges
 }
 
@@ -782,19 +1324,37 @@ func (m *AccountMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case account.EdgeBalanceSnapshots:
+		ids := make([]ent.Value, 0, len(m.removedbalance_snapshots))
+		for id := range m.removedbalance_snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeRepresentativeHistory:
+		ids := make([]ent.Value, 0, len(m.removedrepresentative_history))
+		for id := range m.removedrepresentative_history {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *AccountMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 4)
 	if m.clearedwallet {
 		edges = append(edges, account.EdgeWallet)
 	}
 	if m.clearedblocks {
 		edges = append(edges, account.EdgeBlocks)
 	}
+	if m.clearedbalance_snapshots {
+		edges = append(edges, account.EdgeBalanceSnapshots)
+	}
+	if m.clearedrepresentative_history {
+		edges = append(edges, account.EdgeRepresentativeHistory)
+	}
 	return edges
 }
 
@@ -806,6 +1366,10 @@ func (m *AccountMutation) EdgeCleared(name string) bool {
 		return m.clearedwallet
 	case account.EdgeBlocks:
 		return m.clearedblocks
+	case account.EdgeBalanceSnapshots:
+		return m.clearedbalance_snapshots
+	case account.EdgeRepresentativeHistory:
+		return m.clearedrepresentative_history
 	}
 	return false
 }
@@ -831,40 +1395,45 @@ func (m *AccountMutation) ResetEdge(name string) error {
 	case account.EdgeBlocks:
 		m.ResetBlocks()
 		return nil
+	case account.EdgeBalanceSnapshots:
+		m.ResetBalanceSnapshots()
+		return nil
+	case account.EdgeRepresentativeHistory:
+		m.ResetRepresentativeHistory()
+		return nil
 	}
 	return fmt.Errorf("unknown Account edge %s", name)
 }
 
-// BlockMutation represents an operation that mutates the Block nodes in the graph.
-type BlockMutation struct {
+// ApiKeyMutation represents an operation that mutates the ApiKey nodes in the graph.
+type ApiKeyMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	block_hash     *string
-	block          *map[string]interface{}
-	send_id        *string
-	subtype        *string
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	account        *uuid.UUID
-	clearedaccount bool
-	done           bool
-	oldValue       func(context.Context) (*Block, error)
-	predicates     []predicate.Block
-}
-
-var _ ent.Mutation = (*BlockMutation)(nil)
-
-// blockOption allows management of the mutation configuration using functional options.
-type blockOption func(*BlockMutation)
-
-// newBlockMutation creates new mutation for the Block entity.
-func newBlockMutation(c config, op Op, opts ...blockOption) *BlockMutation {
-	m := &BlockMutation{
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	key_hash      *string
+	scope         *apikey.Scope
+	approver      *bool
+	created_at    *time.Time
+	last_used_at  *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ApiKey, error)
+	predicates    []predicate.ApiKey
+}
+
+var _ ent.Mutation = (*ApiKeyMutation)(nil)
+
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*ApiKeyMutation)
+
+// newApiKeyMutation creates new mutation for the ApiKey entity.
+func newApiKeyMutation(c config, op Op, opts ...apikeyOption) *ApiKeyMutation {
+	m := &ApiKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBlock,
+		typ:           TypeApiKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -873,20 +1442,20 @@ func newBlockMutation(c config, op Op, opts ...blockOption) *BlockMutation {
 	return m
 }
 
-// withBlockID sets the ID field of the mutation.
-func withBlockID(id uuid.UUID) blockOption {
-	return func(m *BlockMutation) {
+// withApiKeyID sets the ID field of the mutation.
+func withApiKeyID(id uuid.UUID) apikeyOption {
+	return func(m *ApiKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Block
+			value *ApiKey
 		)
-		m.oldValue = func(ctx context.Context) (*Block, error) {
+		m.oldValue = func(ctx context.Context) (*ApiKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Block.Get(ctx, id)
+					value, err = m.Client().ApiKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -895,10 +1464,10 @@ func withBlockID(id uuid.UUID) blockOption {
 	}
 }
 
-// withBlock sets the old Block of the mutation.
-func withBlock(node *Block) blockOption {
-	return func(m *BlockMutation) {
-		m.oldValue = func(context.Context) (*Block, error) {
+// withApiKey sets the old ApiKey of the mutation.
+func withApiKey(node *ApiKey) apikeyOption {
+	return func(m *ApiKeyMutation) {
+		m.oldValue = func(context.Context) (*ApiKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -907,7 +1476,7 @@ func withBlock(node *Block) blockOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BlockMutation) Client() *Client {
+func (m ApiKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -915,7 +1484,7 @@ func (m BlockMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BlockMutation) Tx() (*Tx, error) {
+func (m ApiKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -925,14 +1494,14 @@ func (m BlockMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Block entities.
-func (m *BlockMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ApiKey entities.
+func (m *ApiKeyMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BlockMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ApiKeyMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -943,7 +1512,7 @@ func (m *BlockMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BlockMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ApiKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -952,317 +1521,278 @@ func (m *BlockMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Block.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ApiKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetAccountID sets the "account_id" field.
-func (m *BlockMutation) SetAccountID(u uuid.UUID) {
-	m.account = &u
+// SetName sets the "name" field.
+func (m *ApiKeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// AccountID returns the value of the "account_id" field in the mutation.
-func (m *BlockMutation) AccountID() (r uuid.UUID, exists bool) {
-	v := m.account
+// Name returns the value of the "name" field in the mutation.
+func (m *ApiKeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccountID returns the old "account_id" field's value of the Block entity.
-// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockMutation) OldAccountID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *ApiKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccountID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.AccountID, nil
-}
-
-// ClearAccountID clears the value of the "account_id" field.
-func (m *BlockMutation) ClearAccountID() {
-	m.account = nil
-	m.clearedFields[block.FieldAccountID] = struct{}{}
-}
-
-// AccountIDCleared returns if the "account_id" field was cleared in this mutation.
-func (m *BlockMutation) AccountIDCleared() bool {
-	_, ok := m.clearedFields[block.FieldAccountID]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetAccountID resets all changes to the "account_id" field.
-func (m *BlockMutation) ResetAccountID() {
-	m.account = nil
-	delete(m.clearedFields, block.FieldAccountID)
+// ResetName resets all changes to the "name" field.
+func (m *ApiKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetBlockHash sets the "block_hash" field.
-func (m *BlockMutation) SetBlockHash(s string) {
-	m.block_hash = &s
+// SetKeyHash sets the "key_hash" field.
+func (m *ApiKeyMutation) SetKeyHash(s string) {
+	m.key_hash = &s
 }
 
-// BlockHash returns the value of the "block_hash" field in the mutation.
-func (m *BlockMutation) BlockHash() (r string, exists bool) {
-	v := m.block_hash
+// KeyHash returns the value of the "key_hash" field in the mutation.
+func (m *ApiKeyMutation) KeyHash() (r string, exists bool) {
+	v := m.key_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBlockHash returns the old "block_hash" field's value of the Block entity.
-// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyHash returns the old "key_hash" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockMutation) OldBlockHash(ctx context.Context) (v string, err error) {
+func (m *ApiKeyMutation) OldKeyHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBlockHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBlockHash requires an ID field in the mutation")
+		return v, errors.New("OldKeyHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBlockHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldKeyHash: %w", err)
 	}
-	return oldValue.BlockHash, nil
+	return oldValue.KeyHash, nil
 }
 
-// ResetBlockHash resets all changes to the "block_hash" field.
-func (m *BlockMutation) ResetBlockHash() {
-	m.block_hash = nil
+// ResetKeyHash resets all changes to the "key_hash" field.
+func (m *ApiKeyMutation) ResetKeyHash() {
+	m.key_hash = nil
 }
 
-// SetBlock sets the "block" field.
-func (m *BlockMutation) SetBlock(value map[string]interface{}) {
-	m.block = &value
+// SetScope sets the "scope" field.
+func (m *ApiKeyMutation) SetScope(a apikey.Scope) {
+	m.scope = &a
 }
 
-// Block returns the value of the "block" field in the mutation.
-func (m *BlockMutation) Block() (r map[string]interface{}, exists bool) {
-	v := m.block
+// Scope returns the value of the "scope" field in the mutation.
+func (m *ApiKeyMutation) Scope() (r apikey.Scope, exists bool) {
+	v := m.scope
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBlock returns the old "block" field's value of the Block entity.
-// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// OldScope returns the old "scope" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockMutation) OldBlock(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *ApiKeyMutation) OldScope(ctx context.Context) (v apikey.Scope, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBlock is only allowed on UpdateOne operations")
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBlock requires an ID field in the mutation")
+		return v, errors.New("OldScope requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBlock: %w", err)
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
 	}
-	return oldValue.Block, nil
+	return oldValue.Scope, nil
 }
 
-// ResetBlock resets all changes to the "block" field.
-func (m *BlockMutation) ResetBlock() {
-	m.block = nil
+// ResetScope resets all changes to the "scope" field.
+func (m *ApiKeyMutation) ResetScope() {
+	m.scope = nil
 }
 
-// SetSendID sets the "send_id" field.
-func (m *BlockMutation) SetSendID(s string) {
-	m.send_id = &s
+// SetApprover sets the "approver" field.
+func (m *ApiKeyMutation) SetApprover(b bool) {
+	m.approver = &b
 }
 
-// SendID returns the value of the "send_id" field in the mutation.
-func (m *BlockMutation) SendID() (r string, exists bool) {
-	v := m.send_id
+// Approver returns the value of the "approver" field in the mutation.
+func (m *ApiKeyMutation) Approver() (r bool, exists bool) {
+	v := m.approver
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSendID returns the old "send_id" field's value of the Block entity.
-// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// OldApprover returns the old "approver" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockMutation) OldSendID(ctx context.Context) (v *string, err error) {
+func (m *ApiKeyMutation) OldApprover(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSendID is only allowed on UpdateOne operations")
+		return v, errors.New("OldApprover is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSendID requires an ID field in the mutation")
+		return v, errors.New("OldApprover requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSendID: %w", err)
+		return v, fmt.Errorf("querying old value for OldApprover: %w", err)
 	}
-	return oldValue.SendID, nil
-}
-
-// ClearSendID clears the value of the "send_id" field.
-func (m *BlockMutation) ClearSendID() {
-	m.send_id = nil
-	m.clearedFields[block.FieldSendID] = struct{}{}
-}
-
-// SendIDCleared returns if the "send_id" field was cleared in this mutation.
-func (m *BlockMutation) SendIDCleared() bool {
-	_, ok := m.clearedFields[block.FieldSendID]
-	return ok
+	return oldValue.Approver, nil
 }
 
-// ResetSendID resets all changes to the "send_id" field.
-func (m *BlockMutation) ResetSendID() {
-	m.send_id = nil
-	delete(m.clearedFields, block.FieldSendID)
+// ResetApprover resets all changes to the "approver" field.
+func (m *ApiKeyMutation) ResetApprover() {
+	m.approver = nil
 }
 
-// SetSubtype sets the "subtype" field.
-func (m *BlockMutation) SetSubtype(s string) {
-	m.subtype = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *ApiKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Subtype returns the value of the "subtype" field in the mutation.
-func (m *BlockMutation) Subtype() (r string, exists bool) {
-	v := m.subtype
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ApiKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubtype returns the old "subtype" field's value of the Block entity.
-// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockMutation) OldSubtype(ctx context.Context) (v string, err error) {
+func (m *ApiKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubtype is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubtype requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubtype: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Subtype, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSubtype resets all changes to the "subtype" field.
-func (m *BlockMutation) ResetSubtype() {
-	m.subtype = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ApiKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *BlockMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *ApiKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BlockMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *ApiKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Block entity.
-// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the ApiKey entity.
+// If the ApiKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BlockMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ApiKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BlockMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// ClearAccount clears the "account" edge to the Account entity.
-func (m *BlockMutation) ClearAccount() {
-	m.clearedaccount = true
+	return oldValue.LastUsedAt, nil
 }
 
-// AccountCleared reports if the "account" edge to the Account entity was cleared.
-func (m *BlockMutation) AccountCleared() bool {
-	return m.AccountIDCleared() || m.clearedaccount
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *ApiKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
 }
 
-// AccountIDs returns the "account" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AccountID instead. It exists only for internal usage by the builders.
-func (m *BlockMutation) AccountIDs() (ids []uuid.UUID) {
-	if id := m.account; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *ApiKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
+	return ok
 }
 
-// ResetAccount resets all changes to the "account" edge.
-func (m *BlockMutation) ResetAccount() {
-	m.account = nil
-	m.clearedaccount = false
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *ApiKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
 }
 
-// Where appends a list predicates to the BlockMutation builder.
-func (m *BlockMutation) Where(ps ...predicate.Block) {
+// Where appends a list predicates to the ApiKeyMutation builder.
+func (m *ApiKeyMutation) Where(ps ...predicate.ApiKey) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *BlockMutation) Op() Op {
+func (m *ApiKeyMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Block).
-func (m *BlockMutation) Type() string {
+// Type returns the node type of this mutation (ApiKey).
+func (m *ApiKeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BlockMutation) Fields() []string {
+func (m *ApiKeyMutation) Fields() []string {
 	fields := make([]string, 0, 6)
-	if m.account != nil {
-		fields = append(fields, block.FieldAccountID)
-	}
-	if m.block_hash != nil {
-		fields = append(fields, block.FieldBlockHash)
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
 	}
-	if m.block != nil {
-		fields = append(fields, block.FieldBlock)
+	if m.key_hash != nil {
+		fields = append(fields, apikey.FieldKeyHash)
 	}
-	if m.send_id != nil {
-		fields = append(fields, block.FieldSendID)
+	if m.scope != nil {
+		fields = append(fields, apikey.FieldScope)
 	}
-	if m.subtype != nil {
-		fields = append(fields, block.FieldSubtype)
+	if m.approver != nil {
+		fields = append(fields, apikey.FieldApprover)
 	}
 	if m.created_at != nil {
-		fields = append(fields, block.FieldCreatedAt)
+		fields = append(fields, apikey.FieldCreatedAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
 	}
 	return fields
 }
@@ -1270,20 +1800,20 @@ func (m *BlockMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BlockMutation) Field(name string) (ent.Value, bool) {
+func (m *ApiKeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case block.FieldAccountID:
-		return m.AccountID()
-	case block.FieldBlockHash:
-		return m.BlockHash()
-	case block.FieldBlock:
-		return m.Block()
-	case block.FieldSendID:
-		return m.SendID()
-	case block.FieldSubtype:
-		return m.Subtype()
-	case block.FieldCreatedAt:
+	case apikey.FieldName:
+		return m.Name()
+	case apikey.FieldKeyHash:
+		return m.KeyHash()
+	case apikey.FieldScope:
+		return m.Scope()
+	case apikey.FieldApprover:
+		return m.Approver()
+	case apikey.FieldCreatedAt:
 		return m.CreatedAt()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
 	}
 	return nil, false
 }
@@ -1291,264 +1821,232 @@ func (m *BlockMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BlockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ApiKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case block.FieldAccountID:
-		return m.OldAccountID(ctx)
-	case block.FieldBlockHash:
-		return m.OldBlockHash(ctx)
-	case block.FieldBlock:
-		return m.OldBlock(ctx)
-	case block.FieldSendID:
-		return m.OldSendID(ctx)
-	case block.FieldSubtype:
-		return m.OldSubtype(ctx)
-	case block.FieldCreatedAt:
+	case apikey.FieldName:
+		return m.OldName(ctx)
+	case apikey.FieldKeyHash:
+		return m.OldKeyHash(ctx)
+	case apikey.FieldScope:
+		return m.OldScope(ctx)
+	case apikey.FieldApprover:
+		return m.OldApprover(ctx)
+	case apikey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Block field %s", name)
+	return nil, fmt.Errorf("unknown ApiKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BlockMutation) SetField(name string, value ent.Value) error {
+func (m *ApiKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case block.FieldAccountID:
-		v, ok := value.(uuid.UUID)
+	case apikey.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccountID(v)
+		m.SetName(v)
 		return nil
-	case block.FieldBlockHash:
+	case apikey.FieldKeyHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBlockHash(v)
+		m.SetKeyHash(v)
 		return nil
-	case block.FieldBlock:
-		v, ok := value.(map[string]interface{})
+	case apikey.FieldScope:
+		v, ok := value.(apikey.Scope)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBlock(v)
+		m.SetScope(v)
 		return nil
-	case block.FieldSendID:
-		v, ok := value.(string)
+	case apikey.FieldApprover:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSendID(v)
+		m.SetApprover(v)
 		return nil
-	case block.FieldSubtype:
-		v, ok := value.(string)
+	case apikey.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubtype(v)
+		m.SetCreatedAt(v)
 		return nil
-	case block.FieldCreatedAt:
+	case apikey.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetLastUsedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Block field %s", name)
+	return fmt.Errorf("unknown ApiKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BlockMutation) AddedFields() []string {
+func (m *ApiKeyMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BlockMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ApiKeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BlockMutation) AddField(name string, value ent.Value) error {
+func (m *ApiKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Block numeric field %s", name)
+	return fmt.Errorf("unknown ApiKey numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BlockMutation) ClearedFields() []string {
+func (m *ApiKeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(block.FieldAccountID) {
-		fields = append(fields, block.FieldAccountID)
-	}
-	if m.FieldCleared(block.FieldSendID) {
-		fields = append(fields, block.FieldSendID)
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BlockMutation) FieldCleared(name string) bool {
+func (m *ApiKeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BlockMutation) ClearField(name string) error {
+func (m *ApiKeyMutation) ClearField(name string) error {
 	switch name {
-	case block.FieldAccountID:
-		m.ClearAccountID()
-		return nil
-	case block.FieldSendID:
-		m.ClearSendID()
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Block nullable field %s", name)
+	return fmt.Errorf("unknown ApiKey nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BlockMutation) ResetField(name string) error {
+func (m *ApiKeyMutation) ResetField(name string) error {
 	switch name {
-	case block.FieldAccountID:
-		m.ResetAccountID()
-		return nil
-	case block.FieldBlockHash:
-		m.ResetBlockHash()
+	case apikey.FieldName:
+		m.ResetName()
 		return nil
-	case block.FieldBlock:
-		m.ResetBlock()
+	case apikey.FieldKeyHash:
+		m.ResetKeyHash()
 		return nil
-	case block.FieldSendID:
-		m.ResetSendID()
+	case apikey.FieldScope:
+		m.ResetScope()
 		return nil
-	case block.FieldSubtype:
-		m.ResetSubtype()
+	case apikey.FieldApprover:
+		m.ResetApprover()
 		return nil
-	case block.FieldCreatedAt:
+	case apikey.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Block field %s", name)
+	return fmt.Errorf("unknown ApiKey field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BlockMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.account != nil {
-		edges = append(edges, block.EdgeAccount)
-	}
+func (m *ApiKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BlockMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case block.EdgeAccount:
-		if id := m.account; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *ApiKeyMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BlockMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *ApiKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BlockMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	}
+func (m *ApiKeyMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BlockMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedaccount {
-		edges = append(edges, block.EdgeAccount)
-	}
+func (m *ApiKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BlockMutation) EdgeCleared(name string) bool {
-	switch name {
-	case block.EdgeAccount:
-		return m.clearedaccount
-	}
+func (m *ApiKeyMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BlockMutation) ClearEdge(name string) error {
-	switch name {
-	case block.EdgeAccount:
-		m.ClearAccount()
-		return nil
-	}
-	return fmt.Errorf("unknown Block unique edge %s", name)
+func (m *ApiKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ApiKey unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BlockMutation) ResetEdge(name string) error {
-	switch name {
-	case block.EdgeAccount:
-		m.ResetAccount()
-		return nil
-	}
-	return fmt.Errorf("unknown Block edge %s", name)
+func (m *ApiKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ApiKey edge %s", name)
 }
 
-// WalletMutation represents an operation that mutates the Wallet nodes in the graph.
-type WalletMutation struct {
+// AuditRecordMutation represents an operation that mutates the AuditRecord nodes in the graph.
+type AuditRecordMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	seed            *string
-	representative  *string
-	encrypted       *bool
-	work            *bool
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	accounts        map[uuid.UUID]struct{}
-	removedaccounts map[uuid.UUID]struct{}
-	clearedaccounts bool
-	done            bool
-	oldValue        func(context.Context) (*Wallet, error)
-	predicates      []predicate.Wallet
-}
-
-var _ ent.Mutation = (*WalletMutation)(nil)
-
-// walletOption allows management of the mutation configuration using functional options.
-type walletOption func(*WalletMutation)
-
-// newWalletMutation creates new mutation for the Wallet entity.
-func newWalletMutation(c config, op Op, opts ...walletOption) *WalletMutation {
-	m := &WalletMutation{
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	action        *string
+	wallet        *string
+	ip            *string
+	api_key_id    *uuid.UUID
+	api_key_name  *string
+	params        *map[string]interface{}
+	status        *int
+	addstatus     *int
+	error_code    *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuditRecord, error)
+	predicates    []predicate.AuditRecord
+}
+
+var _ ent.Mutation = (*AuditRecordMutation)(nil)
+
+// auditrecordOption allows management of the mutation configuration using functional options.
+type auditrecordOption func(*AuditRecordMutation)
+
+// newAuditRecordMutation creates new mutation for the AuditRecord entity.
+func newAuditRecordMutation(c config, op Op, opts ...auditrecordOption) *AuditRecordMutation {
+	m := &AuditRecordMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeWallet,
+		typ:           TypeAuditRecord,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1557,20 +2055,20 @@ func newWalletMutation(c config, op Op, opts ...walletOption) *WalletMutation {
 	return m
 }
 
-// withWalletID sets the ID field of the mutation.
-func withWalletID(id uuid.UUID) walletOption {
-	return func(m *WalletMutation) {
+// withAuditRecordID sets the ID field of the mutation.
+func withAuditRecordID(id uuid.UUID) auditrecordOption {
+	return func(m *AuditRecordMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Wallet
+			value *AuditRecord
 		)
-		m.oldValue = func(ctx context.Context) (*Wallet, error) {
+		m.oldValue = func(ctx context.Context) (*AuditRecord, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Wallet.Get(ctx, id)
+					value, err = m.Client().AuditRecord.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1579,10 +2077,10 @@ func withWalletID(id uuid.UUID) walletOption {
 	}
 }
 
-// withWallet sets the old Wallet of the mutation.
-func withWallet(node *Wallet) walletOption {
-	return func(m *WalletMutation) {
-		m.oldValue = func(context.Context) (*Wallet, error) {
+// withAuditRecord sets the old AuditRecord of the mutation.
+func withAuditRecord(node *AuditRecord) auditrecordOption {
+	return func(m *AuditRecordMutation) {
+		m.oldValue = func(context.Context) (*AuditRecord, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1591,7 +2089,7 @@ func withWallet(node *Wallet) walletOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WalletMutation) Client() *Client {
+func (m AuditRecordMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1599,7 +2097,7 @@ func (m WalletMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m WalletMutation) Tx() (*Tx, error) {
+func (m AuditRecordMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1609,14 +2107,14 @@ func (m WalletMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Wallet entities.
-func (m *WalletMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AuditRecord entities.
+func (m *AuditRecordMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *WalletMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AuditRecordMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1627,7 +2125,7 @@ func (m *WalletMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *WalletMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AuditRecordMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1636,293 +2134,10652 @@ func (m *WalletMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Wallet.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditRecord.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSeed sets the "seed" field.
-func (m *WalletMutation) SetSeed(s string) {
-	m.seed = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditRecordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Seed returns the value of the "seed" field in the mutation.
-func (m *WalletMutation) Seed() (r string, exists bool) {
-	v := m.seed
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditRecordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeed returns the old "seed" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldSeed(ctx context.Context) (v string, err error) {
+func (m *AuditRecordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeed requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Seed, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetSeed resets all changes to the "seed" field.
-func (m *WalletMutation) ResetSeed() {
-	m.seed = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditRecordMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetRepresentative sets the "representative" field.
-func (m *WalletMutation) SetRepresentative(s string) {
-	m.representative = &s
+// SetAction sets the "action" field.
+func (m *AuditRecordMutation) SetAction(s string) {
+	m.action = &s
 }
 
-// Representative returns the value of the "representative" field in the mutation.
-func (m *WalletMutation) Representative() (r string, exists bool) {
-	v := m.representative
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditRecordMutation) Action() (r string, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRepresentative returns the old "representative" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldRepresentative(ctx context.Context) (v *string, err error) {
+func (m *AuditRecordMutation) OldAction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRepresentative is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRepresentative requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRepresentative: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.Representative, nil
-}
-
-// ClearRepresentative clears the value of the "representative" field.
-func (m *WalletMutation) ClearRepresentative() {
-	m.representative = nil
-	m.clearedFields[wallet.FieldRepresentative] = struct{}{}
-}
-
-// RepresentativeCleared returns if the "representative" field was cleared in this mutation.
-func (m *WalletMutation) RepresentativeCleared() bool {
-	_, ok := m.clearedFields[wallet.FieldRepresentative]
-	return ok
+	return oldValue.Action, nil
 }
 
-// ResetRepresentative resets all changes to the "representative" field.
-func (m *WalletMutation) ResetRepresentative() {
-	m.representative = nil
-	delete(m.clearedFields, wallet.FieldRepresentative)
+// ResetAction resets all changes to the "action" field.
+func (m *AuditRecordMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetEncrypted sets the "encrypted" field.
-func (m *WalletMutation) SetEncrypted(b bool) {
-	m.encrypted = &b
+// SetWallet sets the "wallet" field.
+func (m *AuditRecordMutation) SetWallet(s string) {
+	m.wallet = &s
 }
 
-// Encrypted returns the value of the "encrypted" field in the mutation.
-func (m *WalletMutation) Encrypted() (r bool, exists bool) {
-	v := m.encrypted
+// Wallet returns the value of the "wallet" field in the mutation.
+func (m *AuditRecordMutation) Wallet() (r string, exists bool) {
+	v := m.wallet
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncrypted returns the old "encrypted" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldWallet returns the old "wallet" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldEncrypted(ctx context.Context) (v bool, err error) {
+func (m *AuditRecordMutation) OldWallet(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncrypted is only allowed on UpdateOne operations")
+		return v, errors.New("OldWallet is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncrypted requires an ID field in the mutation")
+		return v, errors.New("OldWallet requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncrypted: %w", err)
+		return v, fmt.Errorf("querying old value for OldWallet: %w", err)
 	}
-	return oldValue.Encrypted, nil
+	return oldValue.Wallet, nil
 }
 
-// ResetEncrypted resets all changes to the "encrypted" field.
-func (m *WalletMutation) ResetEncrypted() {
-	m.encrypted = nil
+// ClearWallet clears the value of the "wallet" field.
+func (m *AuditRecordMutation) ClearWallet() {
+	m.wallet = nil
+	m.clearedFields[auditrecord.FieldWallet] = struct{}{}
 }
 
-// SetWork sets the "work" field.
-func (m *WalletMutation) SetWork(b bool) {
-	m.work = &b
+// WalletCleared returns if the "wallet" field was cleared in this mutation.
+func (m *AuditRecordMutation) WalletCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldWallet]
+	return ok
 }
 
-// Work returns the value of the "work" field in the mutation.
-func (m *WalletMutation) Work() (r bool, exists bool) {
-	v := m.work
+// ResetWallet resets all changes to the "wallet" field.
+func (m *AuditRecordMutation) ResetWallet() {
+	m.wallet = nil
+	delete(m.clearedFields, auditrecord.FieldWallet)
+}
+
+// SetIP sets the "ip" field.
+func (m *AuditRecordMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *AuditRecordMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWork returns the old "work" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldIP returns the old "ip" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldWork(ctx context.Context) (v bool, err error) {
+func (m *AuditRecordMutation) OldIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWork is only allowed on UpdateOne operations")
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWork requires an ID field in the mutation")
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWork: %w", err)
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	return oldValue.Work, nil
+	return oldValue.IP, nil
 }
 
-// ResetWork resets all changes to the "work" field.
-func (m *WalletMutation) ResetWork() {
-	m.work = nil
+// ResetIP resets all changes to the "ip" field.
+func (m *AuditRecordMutation) ResetIP() {
+	m.ip = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *WalletMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetAPIKeyID sets the "api_key_id" field.
+func (m *AuditRecordMutation) SetAPIKeyID(u uuid.UUID) {
+	m.api_key_id = &u
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *WalletMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// APIKeyID returns the value of the "api_key_id" field in the mutation.
+func (m *AuditRecordMutation) APIKeyID() (r uuid.UUID, exists bool) {
+	v := m.api_key_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Wallet entity.
-// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// OldAPIKeyID returns the old "api_key_id" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WalletMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditRecordMutation) OldAPIKeyID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAPIKeyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAPIKeyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAPIKeyID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.APIKeyID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *WalletMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearAPIKeyID clears the value of the "api_key_id" field.
+func (m *AuditRecordMutation) ClearAPIKeyID() {
+	m.api_key_id = nil
+	m.clearedFields[auditrecord.FieldAPIKeyID] = struct{}{}
 }
 
-// AddAccountIDs adds the "accounts" edge to the Account entity by ids.
+// APIKeyIDCleared returns if the "api_key_id" field was cleared in this mutation.
+func (m *AuditRecordMutation) APIKeyIDCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldAPIKeyID]
+	return ok
+}
+
+// ResetAPIKeyID resets all changes to the "api_key_id" field.
+func (m *AuditRecordMutation) ResetAPIKeyID() {
+	m.api_key_id = nil
+	delete(m.clearedFields, auditrecord.FieldAPIKeyID)
+}
+
+// SetAPIKeyName sets the "api_key_name" field.
+func (m *AuditRecordMutation) SetAPIKeyName(s string) {
+	m.api_key_name = &s
+}
+
+// APIKeyName returns the value of the "api_key_name" field in the mutation.
+func (m *AuditRecordMutation) APIKeyName() (r string, exists bool) {
+	v := m.api_key_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAPIKeyName returns the old "api_key_name" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldAPIKeyName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAPIKeyName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAPIKeyName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAPIKeyName: %w", err)
+	}
+	return oldValue.APIKeyName, nil
+}
+
+// ClearAPIKeyName clears the value of the "api_key_name" field.
+func (m *AuditRecordMutation) ClearAPIKeyName() {
+	m.api_key_name = nil
+	m.clearedFields[auditrecord.FieldAPIKeyName] = struct{}{}
+}
+
+// APIKeyNameCleared returns if the "api_key_name" field was cleared in this mutation.
+func (m *AuditRecordMutation) APIKeyNameCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldAPIKeyName]
+	return ok
+}
+
+// ResetAPIKeyName resets all changes to the "api_key_name" field.
+func (m *AuditRecordMutation) ResetAPIKeyName() {
+	m.api_key_name = nil
+	delete(m.clearedFields, auditrecord.FieldAPIKeyName)
+}
+
+// SetParams sets the "params" field.
+func (m *AuditRecordMutation) SetParams(value map[string]interface{}) {
+	m.params = &value
+}
+
+// Params returns the value of the "params" field in the mutation.
+func (m *AuditRecordMutation) Params() (r map[string]interface{}, exists bool) {
+	v := m.params
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParams returns the old "params" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldParams(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParams: %w", err)
+	}
+	return oldValue.Params, nil
+}
+
+// ResetParams resets all changes to the "params" field.
+func (m *AuditRecordMutation) ResetParams() {
+	m.params = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *AuditRecordMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *AuditRecordMutation) Status() (r int, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *AuditRecordMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *AuditRecordMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *AuditRecordMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetErrorCode sets the "error_code" field.
+func (m *AuditRecordMutation) SetErrorCode(s string) {
+	m.error_code = &s
+}
+
+// ErrorCode returns the value of the "error_code" field in the mutation.
+func (m *AuditRecordMutation) ErrorCode() (r string, exists bool) {
+	v := m.error_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorCode returns the old "error_code" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldErrorCode(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorCode: %w", err)
+	}
+	return oldValue.ErrorCode, nil
+}
+
+// ClearErrorCode clears the value of the "error_code" field.
+func (m *AuditRecordMutation) ClearErrorCode() {
+	m.error_code = nil
+	m.clearedFields[auditrecord.FieldErrorCode] = struct{}{}
+}
+
+// ErrorCodeCleared returns if the "error_code" field was cleared in this mutation.
+func (m *AuditRecordMutation) ErrorCodeCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldErrorCode]
+	return ok
+}
+
+// ResetErrorCode resets all changes to the "error_code" field.
+func (m *AuditRecordMutation) ResetErrorCode() {
+	m.error_code = nil
+	delete(m.clearedFields, auditrecord.FieldErrorCode)
+}
+
+// Where appends a list predicates to the AuditRecordMutation builder.
+func (m *AuditRecordMutation) Where(ps ...predicate.AuditRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *AuditRecordMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (AuditRecord).
+func (m *AuditRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditRecordMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, auditrecord.FieldCreatedAt)
+	}
+	if m.action != nil {
+		fields = append(fields, auditrecord.FieldAction)
+	}
+	if m.wallet != nil {
+		fields = append(fields, auditrecord.FieldWallet)
+	}
+	if m.ip != nil {
+		fields = append(fields, auditrecord.FieldIP)
+	}
+	if m.api_key_id != nil {
+		fields = append(fields, auditrecord.FieldAPIKeyID)
+	}
+	if m.api_key_name != nil {
+		fields = append(fields, auditrecord.FieldAPIKeyName)
+	}
+	if m.params != nil {
+		fields = append(fields, auditrecord.FieldParams)
+	}
+	if m.status != nil {
+		fields = append(fields, auditrecord.FieldStatus)
+	}
+	if m.error_code != nil {
+		fields = append(fields, auditrecord.FieldErrorCode)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuditRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case auditrecord.FieldCreatedAt:
+		return m.CreatedAt()
+	case auditrecord.FieldAction:
+		return m.Action()
+	case auditrecord.FieldWallet:
+		return m.Wallet()
+	case auditrecord.FieldIP:
+		return m.IP()
+	case auditrecord.FieldAPIKeyID:
+		return m.APIKeyID()
+	case auditrecord.FieldAPIKeyName:
+		return m.APIKeyName()
+	case auditrecord.FieldParams:
+		return m.Params()
+	case auditrecord.FieldStatus:
+		return m.Status()
+	case auditrecord.FieldErrorCode:
+		return m.ErrorCode()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuditRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case auditrecord.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case auditrecord.FieldAction:
+		return m.OldAction(ctx)
+	case auditrecord.FieldWallet:
+		return m.OldWallet(ctx)
+	case auditrecord.FieldIP:
+		return m.OldIP(ctx)
+	case auditrecord.FieldAPIKeyID:
+		return m.OldAPIKeyID(ctx)
+	case auditrecord.FieldAPIKeyName:
+		return m.OldAPIKeyName(ctx)
+	case auditrecord.FieldParams:
+		return m.OldParams(ctx)
+	case auditrecord.FieldStatus:
+		return m.OldStatus(ctx)
+	case auditrecord.FieldErrorCode:
+		return m.OldErrorCode(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case auditrecord.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case auditrecord.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditrecord.FieldWallet:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWallet(v)
+		return nil
+	case auditrecord.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case auditrecord.FieldAPIKeyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKeyID(v)
+		return nil
+	case auditrecord.FieldAPIKeyName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAPIKeyName(v)
+		return nil
+	case auditrecord.FieldParams:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParams(v)
+		return nil
+	case auditrecord.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case auditrecord.FieldErrorCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorCode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuditRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus != nil {
+		fields = append(fields, auditrecord.FieldStatus)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case auditrecord.FieldStatus:
+		return m.AddedStatus()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case auditrecord.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuditRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditRecordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditrecord.FieldWallet) {
+		fields = append(fields, auditrecord.FieldWallet)
+	}
+	if m.FieldCleared(auditrecord.FieldAPIKeyID) {
+		fields = append(fields, auditrecord.FieldAPIKeyID)
+	}
+	if m.FieldCleared(auditrecord.FieldAPIKeyName) {
+		fields = append(fields, auditrecord.FieldAPIKeyName)
+	}
+	if m.FieldCleared(auditrecord.FieldErrorCode) {
+		fields = append(fields, auditrecord.FieldErrorCode)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditRecordMutation) ClearField(name string) error {
+	switch name {
+	case auditrecord.FieldWallet:
+		m.ClearWallet()
+		return nil
+	case auditrecord.FieldAPIKeyID:
+		m.ClearAPIKeyID()
+		return nil
+	case auditrecord.FieldAPIKeyName:
+		m.ClearAPIKeyName()
+		return nil
+	case auditrecord.FieldErrorCode:
+		m.ClearErrorCode()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditRecordMutation) ResetField(name string) error {
+	switch name {
+	case auditrecord.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case auditrecord.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditrecord.FieldWallet:
+		m.ResetWallet()
+		return nil
+	case auditrecord.FieldIP:
+		m.ResetIP()
+		return nil
+	case auditrecord.FieldAPIKeyID:
+		m.ResetAPIKeyID()
+		return nil
+	case auditrecord.FieldAPIKeyName:
+		m.ResetAPIKeyName()
+		return nil
+	case auditrecord.FieldParams:
+		m.ResetParams()
+		return nil
+	case auditrecord.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case auditrecord.FieldErrorCode:
+		m.ResetErrorCode()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditRecordMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditRecordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditRecordMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditRecord edge %s", name)
+}
+
+// BalanceAlertMutation represents an operation that mutates the BalanceAlert nodes in the graph.
+type BalanceAlertMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	account       *string
+	threshold     *string
+	direction     *balancealert.Direction
+	callback_url  *string
+	fired         *bool
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	wallet        *uuid.UUID
+	clearedwallet bool
+	done          bool
+	oldValue      func(context.Context) (*BalanceAlert, error)
+	predicates    []predicate.BalanceAlert
+}
+
+var _ ent.Mutation = (*BalanceAlertMutation)(nil)
+
+// balancealertOption allows management of the mutation configuration using functional options.
+type balancealertOption func(*BalanceAlertMutation)
+
+// newBalanceAlertMutation creates new mutation for the BalanceAlert entity.
+func newBalanceAlertMutation(c config, op Op, opts ...balancealertOption) *BalanceAlertMutation {
+	m := &BalanceAlertMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBalanceAlert,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBalanceAlertID sets the ID field of the mutation.
+func withBalanceAlertID(id uuid.UUID) balancealertOption {
+	return func(m *BalanceAlertMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BalanceAlert
+		)
+		m.oldValue = func(ctx context.Context) (*BalanceAlert, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BalanceAlert.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBalanceAlert sets the old BalanceAlert of the mutation.
+func withBalanceAlert(node *BalanceAlert) balancealertOption {
+	return func(m *BalanceAlertMutation) {
+		m.oldValue = func(context.Context) (*BalanceAlert, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BalanceAlertMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BalanceAlertMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BalanceAlert entities.
+func (m *BalanceAlertMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BalanceAlertMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BalanceAlertMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BalanceAlert.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (m *BalanceAlertMutation) SetWalletID(u uuid.UUID) {
+	m.wallet = &u
+}
+
+// WalletID returns the value of the "wallet_id" field in the mutation.
+func (m *BalanceAlertMutation) WalletID() (r uuid.UUID, exists bool) {
+	v := m.wallet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalletID returns the old "wallet_id" field's value of the BalanceAlert entity.
+// If the BalanceAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceAlertMutation) OldWalletID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletID: %w", err)
+	}
+	return oldValue.WalletID, nil
+}
+
+// ResetWalletID resets all changes to the "wallet_id" field.
+func (m *BalanceAlertMutation) ResetWalletID() {
+	m.wallet = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *BalanceAlertMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *BalanceAlertMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the BalanceAlert entity.
+// If the BalanceAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceAlertMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *BalanceAlertMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetThreshold sets the "threshold" field.
+func (m *BalanceAlertMutation) SetThreshold(s string) {
+	m.threshold = &s
+}
+
+// Threshold returns the value of the "threshold" field in the mutation.
+func (m *BalanceAlertMutation) Threshold() (r string, exists bool) {
+	v := m.threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThreshold returns the old "threshold" field's value of the BalanceAlert entity.
+// If the BalanceAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceAlertMutation) OldThreshold(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThreshold: %w", err)
+	}
+	return oldValue.Threshold, nil
+}
+
+// ResetThreshold resets all changes to the "threshold" field.
+func (m *BalanceAlertMutation) ResetThreshold() {
+	m.threshold = nil
+}
+
+// SetDirection sets the "direction" field.
+func (m *BalanceAlertMutation) SetDirection(b balancealert.Direction) {
+	m.direction = &b
+}
+
+// Direction returns the value of the "direction" field in the mutation.
+func (m *BalanceAlertMutation) Direction() (r balancealert.Direction, exists bool) {
+	v := m.direction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDirection returns the old "direction" field's value of the BalanceAlert entity.
+// If the BalanceAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceAlertMutation) OldDirection(ctx context.Context) (v balancealert.Direction, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDirection is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDirection requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDirection: %w", err)
+	}
+	return oldValue.Direction, nil
+}
+
+// ResetDirection resets all changes to the "direction" field.
+func (m *BalanceAlertMutation) ResetDirection() {
+	m.direction = nil
+}
+
+// SetCallbackURL sets the "callback_url" field.
+func (m *BalanceAlertMutation) SetCallbackURL(s string) {
+	m.callback_url = &s
+}
+
+// CallbackURL returns the value of the "callback_url" field in the mutation.
+func (m *BalanceAlertMutation) CallbackURL() (r string, exists bool) {
+	v := m.callback_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCallbackURL returns the old "callback_url" field's value of the BalanceAlert entity.
+// If the BalanceAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceAlertMutation) OldCallbackURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCallbackURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCallbackURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCallbackURL: %w", err)
+	}
+	return oldValue.CallbackURL, nil
+}
+
+// ResetCallbackURL resets all changes to the "callback_url" field.
+func (m *BalanceAlertMutation) ResetCallbackURL() {
+	m.callback_url = nil
+}
+
+// SetFired sets the "fired" field.
+func (m *BalanceAlertMutation) SetFired(b bool) {
+	m.fired = &b
+}
+
+// Fired returns the value of the "fired" field in the mutation.
+func (m *BalanceAlertMutation) Fired() (r bool, exists bool) {
+	v := m.fired
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFired returns the old "fired" field's value of the BalanceAlert entity.
+// If the BalanceAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceAlertMutation) OldFired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFired: %w", err)
+	}
+	return oldValue.Fired, nil
+}
+
+// ResetFired resets all changes to the "fired" field.
+func (m *BalanceAlertMutation) ResetFired() {
+	m.fired = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BalanceAlertMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BalanceAlertMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BalanceAlert entity.
+// If the BalanceAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceAlertMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BalanceAlertMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (m *BalanceAlertMutation) ClearWallet() {
+	m.clearedwallet = true
+}
+
+// WalletCleared reports if the "wallet" edge to the Wallet entity was cleared.
+func (m *BalanceAlertMutation) WalletCleared() bool {
+	return m.clearedwallet
+}
+
+// WalletIDs returns the "wallet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WalletID instead. It exists only for internal usage by the builders.
+func (m *BalanceAlertMutation) WalletIDs() (ids []uuid.UUID) {
+	if id := m.wallet; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWallet resets all changes to the "wallet" edge.
+func (m *BalanceAlertMutation) ResetWallet() {
+	m.wallet = nil
+	m.clearedwallet = false
+}
+
+// Where appends a list predicates to the BalanceAlertMutation builder.
+func (m *BalanceAlertMutation) Where(ps ...predicate.BalanceAlert) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *BalanceAlertMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (BalanceAlert).
+func (m *BalanceAlertMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BalanceAlertMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.wallet != nil {
+		fields = append(fields, balancealert.FieldWalletID)
+	}
+	if m.account != nil {
+		fields = append(fields, balancealert.FieldAccount)
+	}
+	if m.threshold != nil {
+		fields = append(fields, balancealert.FieldThreshold)
+	}
+	if m.direction != nil {
+		fields = append(fields, balancealert.FieldDirection)
+	}
+	if m.callback_url != nil {
+		fields = append(fields, balancealert.FieldCallbackURL)
+	}
+	if m.fired != nil {
+		fields = append(fields, balancealert.FieldFired)
+	}
+	if m.created_at != nil {
+		fields = append(fields, balancealert.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BalanceAlertMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case balancealert.FieldWalletID:
+		return m.WalletID()
+	case balancealert.FieldAccount:
+		return m.Account()
+	case balancealert.FieldThreshold:
+		return m.Threshold()
+	case balancealert.FieldDirection:
+		return m.Direction()
+	case balancealert.FieldCallbackURL:
+		return m.CallbackURL()
+	case balancealert.FieldFired:
+		return m.Fired()
+	case balancealert.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BalanceAlertMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case balancealert.FieldWalletID:
+		return m.OldWalletID(ctx)
+	case balancealert.FieldAccount:
+		return m.OldAccount(ctx)
+	case balancealert.FieldThreshold:
+		return m.OldThreshold(ctx)
+	case balancealert.FieldDirection:
+		return m.OldDirection(ctx)
+	case balancealert.FieldCallbackURL:
+		return m.OldCallbackURL(ctx)
+	case balancealert.FieldFired:
+		return m.OldFired(ctx)
+	case balancealert.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BalanceAlert field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BalanceAlertMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case balancealert.FieldWalletID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletID(v)
+		return nil
+	case balancealert.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case balancealert.FieldThreshold:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThreshold(v)
+		return nil
+	case balancealert.FieldDirection:
+		v, ok := value.(balancealert.Direction)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDirection(v)
+		return nil
+	case balancealert.FieldCallbackURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCallbackURL(v)
+		return nil
+	case balancealert.FieldFired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFired(v)
+		return nil
+	case balancealert.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceAlert field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BalanceAlertMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BalanceAlertMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BalanceAlertMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BalanceAlert numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BalanceAlertMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BalanceAlertMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BalanceAlertMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BalanceAlert nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BalanceAlertMutation) ResetField(name string) error {
+	switch name {
+	case balancealert.FieldWalletID:
+		m.ResetWalletID()
+		return nil
+	case balancealert.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case balancealert.FieldThreshold:
+		m.ResetThreshold()
+		return nil
+	case balancealert.FieldDirection:
+		m.ResetDirection()
+		return nil
+	case balancealert.FieldCallbackURL:
+		m.ResetCallbackURL()
+		return nil
+	case balancealert.FieldFired:
+		m.ResetFired()
+		return nil
+	case balancealert.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceAlert field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BalanceAlertMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.wallet != nil {
+		edges = append(edges, balancealert.EdgeWallet)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BalanceAlertMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case balancealert.EdgeWallet:
+		if id := m.wallet; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BalanceAlertMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BalanceAlertMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BalanceAlertMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwallet {
+		edges = append(edges, balancealert.EdgeWallet)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BalanceAlertMutation) EdgeCleared(name string) bool {
+	switch name {
+	case balancealert.EdgeWallet:
+		return m.clearedwallet
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BalanceAlertMutation) ClearEdge(name string) error {
+	switch name {
+	case balancealert.EdgeWallet:
+		m.ClearWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceAlert unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BalanceAlertMutation) ResetEdge(name string) error {
+	switch name {
+	case balancealert.EdgeWallet:
+		m.ResetWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceAlert edge %s", name)
+}
+
+// BalanceSnapshotMutation represents an operation that mutates the BalanceSnapshot nodes in the graph.
+type BalanceSnapshotMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	balance_raw            *string
+	snapshot_at            *time.Time
+	clearedFields          map[string]struct{}
+	account                *uuid.UUID
+	clearedaccount         bool
+	wallet_snapshot        *uuid.UUID
+	clearedwallet_snapshot bool
+	done                   bool
+	oldValue               func(context.Context) (*BalanceSnapshot, error)
+	predicates             []predicate.BalanceSnapshot
+}
+
+var _ ent.Mutation = (*BalanceSnapshotMutation)(nil)
+
+// balancesnapshotOption allows management of the mutation configuration using functional options.
+type balancesnapshotOption func(*BalanceSnapshotMutation)
+
+// newBalanceSnapshotMutation creates new mutation for the BalanceSnapshot entity.
+func newBalanceSnapshotMutation(c config, op Op, opts ...balancesnapshotOption) *BalanceSnapshotMutation {
+	m := &BalanceSnapshotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBalanceSnapshot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBalanceSnapshotID sets the ID field of the mutation.
+func withBalanceSnapshotID(id uuid.UUID) balancesnapshotOption {
+	return func(m *BalanceSnapshotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BalanceSnapshot
+		)
+		m.oldValue = func(ctx context.Context) (*BalanceSnapshot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BalanceSnapshot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBalanceSnapshot sets the old BalanceSnapshot of the mutation.
+func withBalanceSnapshot(node *BalanceSnapshot) balancesnapshotOption {
+	return func(m *BalanceSnapshotMutation) {
+		m.oldValue = func(context.Context) (*BalanceSnapshot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BalanceSnapshotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BalanceSnapshotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BalanceSnapshot entities.
+func (m *BalanceSnapshotMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BalanceSnapshotMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BalanceSnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BalanceSnapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BalanceSnapshotMutation) SetAccountID(u uuid.UUID) {
+	m.account = &u
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BalanceSnapshotMutation) AccountID() (r uuid.UUID, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceSnapshotMutation) OldAccountID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BalanceSnapshotMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetBalanceRaw sets the "balance_raw" field.
+func (m *BalanceSnapshotMutation) SetBalanceRaw(s string) {
+	m.balance_raw = &s
+}
+
+// BalanceRaw returns the value of the "balance_raw" field in the mutation.
+func (m *BalanceSnapshotMutation) BalanceRaw() (r string, exists bool) {
+	v := m.balance_raw
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBalanceRaw returns the old "balance_raw" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceSnapshotMutation) OldBalanceRaw(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBalanceRaw is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBalanceRaw requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBalanceRaw: %w", err)
+	}
+	return oldValue.BalanceRaw, nil
+}
+
+// ResetBalanceRaw resets all changes to the "balance_raw" field.
+func (m *BalanceSnapshotMutation) ResetBalanceRaw() {
+	m.balance_raw = nil
+}
+
+// SetSnapshotAt sets the "snapshot_at" field.
+func (m *BalanceSnapshotMutation) SetSnapshotAt(t time.Time) {
+	m.snapshot_at = &t
+}
+
+// SnapshotAt returns the value of the "snapshot_at" field in the mutation.
+func (m *BalanceSnapshotMutation) SnapshotAt() (r time.Time, exists bool) {
+	v := m.snapshot_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnapshotAt returns the old "snapshot_at" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceSnapshotMutation) OldSnapshotAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnapshotAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnapshotAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnapshotAt: %w", err)
+	}
+	return oldValue.SnapshotAt, nil
+}
+
+// ResetSnapshotAt resets all changes to the "snapshot_at" field.
+func (m *BalanceSnapshotMutation) ResetSnapshotAt() {
+	m.snapshot_at = nil
+}
+
+// SetWalletSnapshotID sets the "wallet_snapshot_id" field.
+func (m *BalanceSnapshotMutation) SetWalletSnapshotID(u uuid.UUID) {
+	m.wallet_snapshot = &u
+}
+
+// WalletSnapshotID returns the value of the "wallet_snapshot_id" field in the mutation.
+func (m *BalanceSnapshotMutation) WalletSnapshotID() (r uuid.UUID, exists bool) {
+	v := m.wallet_snapshot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalletSnapshotID returns the old "wallet_snapshot_id" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BalanceSnapshotMutation) OldWalletSnapshotID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletSnapshotID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletSnapshotID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletSnapshotID: %w", err)
+	}
+	return oldValue.WalletSnapshotID, nil
+}
+
+// ClearWalletSnapshotID clears the value of the "wallet_snapshot_id" field.
+func (m *BalanceSnapshotMutation) ClearWalletSnapshotID() {
+	m.wallet_snapshot = nil
+	m.clearedFields[balancesnapshot.FieldWalletSnapshotID] = struct{}{}
+}
+
+// WalletSnapshotIDCleared returns if the "wallet_snapshot_id" field was cleared in this mutation.
+func (m *BalanceSnapshotMutation) WalletSnapshotIDCleared() bool {
+	_, ok := m.clearedFields[balancesnapshot.FieldWalletSnapshotID]
+	return ok
+}
+
+// ResetWalletSnapshotID resets all changes to the "wallet_snapshot_id" field.
+func (m *BalanceSnapshotMutation) ResetWalletSnapshotID() {
+	m.wallet_snapshot = nil
+	delete(m.clearedFields, balancesnapshot.FieldWalletSnapshotID)
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *BalanceSnapshotMutation) ClearAccount() {
+	m.clearedaccount = true
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *BalanceSnapshotMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *BalanceSnapshotMutation) AccountIDs() (ids []uuid.UUID) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *BalanceSnapshotMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// ClearWalletSnapshot clears the "wallet_snapshot" edge to the WalletSnapshot entity.
+func (m *BalanceSnapshotMutation) ClearWalletSnapshot() {
+	m.clearedwallet_snapshot = true
+}
+
+// WalletSnapshotCleared reports if the "wallet_snapshot" edge to the WalletSnapshot entity was cleared.
+func (m *BalanceSnapshotMutation) WalletSnapshotCleared() bool {
+	return m.WalletSnapshotIDCleared() || m.clearedwallet_snapshot
+}
+
+// WalletSnapshotIDs returns the "wallet_snapshot" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WalletSnapshotID instead. It exists only for internal usage by the builders.
+func (m *BalanceSnapshotMutation) WalletSnapshotIDs() (ids []uuid.UUID) {
+	if id := m.wallet_snapshot; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWalletSnapshot resets all changes to the "wallet_snapshot" edge.
+func (m *BalanceSnapshotMutation) ResetWalletSnapshot() {
+	m.wallet_snapshot = nil
+	m.clearedwallet_snapshot = false
+}
+
+// Where appends a list predicates to the BalanceSnapshotMutation builder.
+func (m *BalanceSnapshotMutation) Where(ps ...predicate.BalanceSnapshot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *BalanceSnapshotMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (BalanceSnapshot).
+func (m *BalanceSnapshotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BalanceSnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.account != nil {
+		fields = append(fields, balancesnapshot.FieldAccountID)
+	}
+	if m.balance_raw != nil {
+		fields = append(fields, balancesnapshot.FieldBalanceRaw)
+	}
+	if m.snapshot_at != nil {
+		fields = append(fields, balancesnapshot.FieldSnapshotAt)
+	}
+	if m.wallet_snapshot != nil {
+		fields = append(fields, balancesnapshot.FieldWalletSnapshotID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BalanceSnapshotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case balancesnapshot.FieldAccountID:
+		return m.AccountID()
+	case balancesnapshot.FieldBalanceRaw:
+		return m.BalanceRaw()
+	case balancesnapshot.FieldSnapshotAt:
+		return m.SnapshotAt()
+	case balancesnapshot.FieldWalletSnapshotID:
+		return m.WalletSnapshotID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BalanceSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case balancesnapshot.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case balancesnapshot.FieldBalanceRaw:
+		return m.OldBalanceRaw(ctx)
+	case balancesnapshot.FieldSnapshotAt:
+		return m.OldSnapshotAt(ctx)
+	case balancesnapshot.FieldWalletSnapshotID:
+		return m.OldWalletSnapshotID(ctx)
+	}
+	return nil, fmt.Errorf("unknown BalanceSnapshot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BalanceSnapshotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case balancesnapshot.FieldAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case balancesnapshot.FieldBalanceRaw:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBalanceRaw(v)
+		return nil
+	case balancesnapshot.FieldSnapshotAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnapshotAt(v)
+		return nil
+	case balancesnapshot.FieldWalletSnapshotID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletSnapshotID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceSnapshot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BalanceSnapshotMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BalanceSnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BalanceSnapshotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BalanceSnapshot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BalanceSnapshotMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(balancesnapshot.FieldWalletSnapshotID) {
+		fields = append(fields, balancesnapshot.FieldWalletSnapshotID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BalanceSnapshotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BalanceSnapshotMutation) ClearField(name string) error {
+	switch name {
+	case balancesnapshot.FieldWalletSnapshotID:
+		m.ClearWalletSnapshotID()
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceSnapshot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BalanceSnapshotMutation) ResetField(name string) error {
+	switch name {
+	case balancesnapshot.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case balancesnapshot.FieldBalanceRaw:
+		m.ResetBalanceRaw()
+		return nil
+	case balancesnapshot.FieldSnapshotAt:
+		m.ResetSnapshotAt()
+		return nil
+	case balancesnapshot.FieldWalletSnapshotID:
+		m.ResetWalletSnapshotID()
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceSnapshot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BalanceSnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.account != nil {
+		edges = append(edges, balancesnapshot.EdgeAccount)
+	}
+	if m.wallet_snapshot != nil {
+		edges = append(edges, balancesnapshot.EdgeWalletSnapshot)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BalanceSnapshotMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case balancesnapshot.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case balancesnapshot.EdgeWalletSnapshot:
+		if id := m.wallet_snapshot; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BalanceSnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BalanceSnapshotMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BalanceSnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedaccount {
+		edges = append(edges, balancesnapshot.EdgeAccount)
+	}
+	if m.clearedwallet_snapshot {
+		edges = append(edges, balancesnapshot.EdgeWalletSnapshot)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BalanceSnapshotMutation) EdgeCleared(name string) bool {
+	switch name {
+	case balancesnapshot.EdgeAccount:
+		return m.clearedaccount
+	case balancesnapshot.EdgeWalletSnapshot:
+		return m.clearedwallet_snapshot
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BalanceSnapshotMutation) ClearEdge(name string) error {
+	switch name {
+	case balancesnapshot.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	case balancesnapshot.EdgeWalletSnapshot:
+		m.ClearWalletSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceSnapshot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BalanceSnapshotMutation) ResetEdge(name string) error {
+	switch name {
+	case balancesnapshot.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case balancesnapshot.EdgeWalletSnapshot:
+		m.ResetWalletSnapshot()
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceSnapshot edge %s", name)
+}
+
+// BlockMutation represents an operation that mutates the Block nodes in the graph.
+type BlockMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	block_hash     *string
+	block          *map[string]interface{}
+	send_id        *string
+	subtype        *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	account        *uuid.UUID
+	clearedaccount bool
+	wallet         *uuid.UUID
+	clearedwallet  bool
+	done           bool
+	oldValue       func(context.Context) (*Block, error)
+	predicates     []predicate.Block
+}
+
+var _ ent.Mutation = (*BlockMutation)(nil)
+
+// blockOption allows management of the mutation configuration using functional options.
+type blockOption func(*BlockMutation)
+
+// newBlockMutation creates new mutation for the Block entity.
+func newBlockMutation(c config, op Op, opts ...blockOption) *BlockMutation {
+	m := &BlockMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBlock,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBlockID sets the ID field of the mutation.
+func withBlockID(id uuid.UUID) blockOption {
+	return func(m *BlockMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Block
+		)
+		m.oldValue = func(ctx context.Context) (*Block, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Block.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBlock sets the old Block of the mutation.
+func withBlock(node *Block) blockOption {
+	return func(m *BlockMutation) {
+		m.oldValue = func(context.Context) (*Block, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BlockMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BlockMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Block entities.
+func (m *BlockMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BlockMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BlockMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Block.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *BlockMutation) SetAccountID(u uuid.UUID) {
+	m.account = &u
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BlockMutation) AccountID() (r uuid.UUID, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockMutation) OldAccountID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ClearAccountID clears the value of the "account_id" field.
+func (m *BlockMutation) ClearAccountID() {
+	m.account = nil
+	m.clearedFields[block.FieldAccountID] = struct{}{}
+}
+
+// AccountIDCleared returns if the "account_id" field was cleared in this mutation.
+func (m *BlockMutation) AccountIDCleared() bool {
+	_, ok := m.clearedFields[block.FieldAccountID]
+	return ok
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BlockMutation) ResetAccountID() {
+	m.account = nil
+	delete(m.clearedFields, block.FieldAccountID)
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (m *BlockMutation) SetBlockHash(s string) {
+	m.block_hash = &s
+}
+
+// BlockHash returns the value of the "block_hash" field in the mutation.
+func (m *BlockMutation) BlockHash() (r string, exists bool) {
+	v := m.block_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHash returns the old "block_hash" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockMutation) OldBlockHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHash: %w", err)
+	}
+	return oldValue.BlockHash, nil
+}
+
+// ResetBlockHash resets all changes to the "block_hash" field.
+func (m *BlockMutation) ResetBlockHash() {
+	m.block_hash = nil
+}
+
+// SetBlock sets the "block" field.
+func (m *BlockMutation) SetBlock(value map[string]interface{}) {
+	m.block = &value
+}
+
+// Block returns the value of the "block" field in the mutation.
+func (m *BlockMutation) Block() (r map[string]interface{}, exists bool) {
+	v := m.block
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlock returns the old "block" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockMutation) OldBlock(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlock: %w", err)
+	}
+	return oldValue.Block, nil
+}
+
+// ResetBlock resets all changes to the "block" field.
+func (m *BlockMutation) ResetBlock() {
+	m.block = nil
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (m *BlockMutation) SetWalletID(u uuid.UUID) {
+	m.wallet = &u
+}
+
+// WalletID returns the value of the "wallet_id" field in the mutation.
+func (m *BlockMutation) WalletID() (r uuid.UUID, exists bool) {
+	v := m.wallet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalletID returns the old "wallet_id" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockMutation) OldWalletID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletID: %w", err)
+	}
+	return oldValue.WalletID, nil
+}
+
+// ClearWalletID clears the value of the "wallet_id" field.
+func (m *BlockMutation) ClearWalletID() {
+	m.wallet = nil
+	m.clearedFields[block.FieldWalletID] = struct{}{}
+}
+
+// WalletIDCleared returns if the "wallet_id" field was cleared in this mutation.
+func (m *BlockMutation) WalletIDCleared() bool {
+	_, ok := m.clearedFields[block.FieldWalletID]
+	return ok
+}
+
+// ResetWalletID resets all changes to the "wallet_id" field.
+func (m *BlockMutation) ResetWalletID() {
+	m.wallet = nil
+	delete(m.clearedFields, block.FieldWalletID)
+}
+
+// SetSendID sets the "send_id" field.
+func (m *BlockMutation) SetSendID(s string) {
+	m.send_id = &s
+}
+
+// SendID returns the value of the "send_id" field in the mutation.
+func (m *BlockMutation) SendID() (r string, exists bool) {
+	v := m.send_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSendID returns the old "send_id" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockMutation) OldSendID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSendID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSendID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSendID: %w", err)
+	}
+	return oldValue.SendID, nil
+}
+
+// ClearSendID clears the value of the "send_id" field.
+func (m *BlockMutation) ClearSendID() {
+	m.send_id = nil
+	m.clearedFields[block.FieldSendID] = struct{}{}
+}
+
+// SendIDCleared returns if the "send_id" field was cleared in this mutation.
+func (m *BlockMutation) SendIDCleared() bool {
+	_, ok := m.clearedFields[block.FieldSendID]
+	return ok
+}
+
+// ResetSendID resets all changes to the "send_id" field.
+func (m *BlockMutation) ResetSendID() {
+	m.send_id = nil
+	delete(m.clearedFields, block.FieldSendID)
+}
+
+// SetSubtype sets the "subtype" field.
+func (m *BlockMutation) SetSubtype(s string) {
+	m.subtype = &s
+}
+
+// Subtype returns the value of the "subtype" field in the mutation.
+func (m *BlockMutation) Subtype() (r string, exists bool) {
+	v := m.subtype
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubtype returns the old "subtype" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockMutation) OldSubtype(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtype is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtype requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtype: %w", err)
+	}
+	return oldValue.Subtype, nil
+}
+
+// ResetSubtype resets all changes to the "subtype" field.
+func (m *BlockMutation) ResetSubtype() {
+	m.subtype = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BlockMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BlockMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Block entity.
+// If the Block object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BlockMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *BlockMutation) ClearAccount() {
+	m.clearedaccount = true
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *BlockMutation) AccountCleared() bool {
+	return m.AccountIDCleared() || m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *BlockMutation) AccountIDs() (ids []uuid.UUID) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *BlockMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (m *BlockMutation) ClearWallet() {
+	m.clearedwallet = true
+}
+
+// WalletCleared reports if the "wallet" edge to the Wallet entity was cleared.
+func (m *BlockMutation) WalletCleared() bool {
+	return m.WalletIDCleared() || m.clearedwallet
+}
+
+// WalletIDs returns the "wallet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WalletID instead. It exists only for internal usage by the builders.
+func (m *BlockMutation) WalletIDs() (ids []uuid.UUID) {
+	if id := m.wallet; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWallet resets all changes to the "wallet" edge.
+func (m *BlockMutation) ResetWallet() {
+	m.wallet = nil
+	m.clearedwallet = false
+}
+
+// Where appends a list predicates to the BlockMutation builder.
+func (m *BlockMutation) Where(ps ...predicate.Block) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *BlockMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Block).
+func (m *BlockMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlockMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.account != nil {
+		fields = append(fields, block.FieldAccountID)
+	}
+	if m.block_hash != nil {
+		fields = append(fields, block.FieldBlockHash)
+	}
+	if m.block != nil {
+		fields = append(fields, block.FieldBlock)
+	}
+	if m.wallet != nil {
+		fields = append(fields, block.FieldWalletID)
+	}
+	if m.send_id != nil {
+		fields = append(fields, block.FieldSendID)
+	}
+	if m.subtype != nil {
+		fields = append(fields, block.FieldSubtype)
+	}
+	if m.created_at != nil {
+		fields = append(fields, block.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BlockMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case block.FieldAccountID:
+		return m.AccountID()
+	case block.FieldBlockHash:
+		return m.BlockHash()
+	case block.FieldBlock:
+		return m.Block()
+	case block.FieldWalletID:
+		return m.WalletID()
+	case block.FieldSendID:
+		return m.SendID()
+	case block.FieldSubtype:
+		return m.Subtype()
+	case block.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BlockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case block.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case block.FieldBlockHash:
+		return m.OldBlockHash(ctx)
+	case block.FieldBlock:
+		return m.OldBlock(ctx)
+	case block.FieldWalletID:
+		return m.OldWalletID(ctx)
+	case block.FieldSendID:
+		return m.OldSendID(ctx)
+	case block.FieldSubtype:
+		return m.OldSubtype(ctx)
+	case block.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Block field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlockMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case block.FieldAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case block.FieldBlockHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHash(v)
+		return nil
+	case block.FieldBlock:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlock(v)
+		return nil
+	case block.FieldWalletID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletID(v)
+		return nil
+	case block.FieldSendID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSendID(v)
+		return nil
+	case block.FieldSubtype:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtype(v)
+		return nil
+	case block.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Block field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BlockMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BlockMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlockMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Block numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BlockMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(block.FieldAccountID) {
+		fields = append(fields, block.FieldAccountID)
+	}
+	if m.FieldCleared(block.FieldWalletID) {
+		fields = append(fields, block.FieldWalletID)
+	}
+	if m.FieldCleared(block.FieldSendID) {
+		fields = append(fields, block.FieldSendID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BlockMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BlockMutation) ClearField(name string) error {
+	switch name {
+	case block.FieldAccountID:
+		m.ClearAccountID()
+		return nil
+	case block.FieldWalletID:
+		m.ClearWalletID()
+		return nil
+	case block.FieldSendID:
+		m.ClearSendID()
+		return nil
+	}
+	return fmt.Errorf("unknown Block nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlockMutation) ResetField(name string) error {
+	switch name {
+	case block.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case block.FieldBlockHash:
+		m.ResetBlockHash()
+		return nil
+	case block.FieldBlock:
+		m.ResetBlock()
+		return nil
+	case block.FieldWalletID:
+		m.ResetWalletID()
+		return nil
+	case block.FieldSendID:
+		m.ResetSendID()
+		return nil
+	case block.FieldSubtype:
+		m.ResetSubtype()
+		return nil
+	case block.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Block field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BlockMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.account != nil {
+		edges = append(edges, block.EdgeAccount)
+	}
+	if m.wallet != nil {
+		edges = append(edges, block.EdgeWallet)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BlockMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case block.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	case block.EdgeWallet:
+		if id := m.wallet; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BlockMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BlockMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BlockMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedaccount {
+		edges = append(edges, block.EdgeAccount)
+	}
+	if m.clearedwallet {
+		edges = append(edges, block.EdgeWallet)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BlockMutation) EdgeCleared(name string) bool {
+	switch name {
+	case block.EdgeAccount:
+		return m.clearedaccount
+	case block.EdgeWallet:
+		return m.clearedwallet
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BlockMutation) ClearEdge(name string) error {
+	switch name {
+	case block.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	case block.EdgeWallet:
+		m.ClearWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown Block unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BlockMutation) ResetEdge(name string) error {
+	switch name {
+	case block.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	case block.EdgeWallet:
+		m.ResetWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown Block edge %s", name)
+}
+
+// IdempotencyKeyMutation represents an operation that mutates the IdempotencyKey nodes in the graph.
+type IdempotencyKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	accounts      *[]string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	wallet        *uuid.UUID
+	clearedwallet bool
+	done          bool
+	oldValue      func(context.Context) (*IdempotencyKey, error)
+	predicates    []predicate.IdempotencyKey
+}
+
+var _ ent.Mutation = (*IdempotencyKeyMutation)(nil)
+
+// idempotencykeyOption allows management of the mutation configuration using functional options.
+type idempotencykeyOption func(*IdempotencyKeyMutation)
+
+// newIdempotencyKeyMutation creates new mutation for the IdempotencyKey entity.
+func newIdempotencyKeyMutation(c config, op Op, opts ...idempotencykeyOption) *IdempotencyKeyMutation {
+	m := &IdempotencyKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotencyKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotencyKeyID sets the ID field of the mutation.
+func withIdempotencyKeyID(id uuid.UUID) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotencyKey
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotencyKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotencyKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotencyKey sets the old IdempotencyKey of the mutation.
+func withIdempotencyKey(node *IdempotencyKey) idempotencykeyOption {
+	return func(m *IdempotencyKeyMutation) {
+		m.oldValue = func(context.Context) (*IdempotencyKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotencyKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotencyKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IdempotencyKey entities.
+func (m *IdempotencyKeyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotencyKeyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotencyKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotencyKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (m *IdempotencyKeyMutation) SetWalletID(u uuid.UUID) {
+	m.wallet = &u
+}
+
+// WalletID returns the value of the "wallet_id" field in the mutation.
+func (m *IdempotencyKeyMutation) WalletID() (r uuid.UUID, exists bool) {
+	v := m.wallet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalletID returns the old "wallet_id" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldWalletID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletID: %w", err)
+	}
+	return oldValue.WalletID, nil
+}
+
+// ResetWalletID resets all changes to the "wallet_id" field.
+func (m *IdempotencyKeyMutation) ResetWalletID() {
+	m.wallet = nil
+}
+
+// SetAccounts sets the "accounts" field.
+func (m *IdempotencyKeyMutation) SetAccounts(s []string) {
+	m.accounts = &s
+}
+
+// Accounts returns the value of the "accounts" field in the mutation.
+func (m *IdempotencyKeyMutation) Accounts() (r []string, exists bool) {
+	v := m.accounts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccounts returns the old "accounts" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldAccounts(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccounts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccounts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccounts: %w", err)
+	}
+	return oldValue.Accounts, nil
+}
+
+// ResetAccounts resets all changes to the "accounts" field.
+func (m *IdempotencyKeyMutation) ResetAccounts() {
+	m.accounts = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdempotencyKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdempotencyKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IdempotencyKey entity.
+// If the IdempotencyKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotencyKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdempotencyKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (m *IdempotencyKeyMutation) ClearWallet() {
+	m.clearedwallet = true
+}
+
+// WalletCleared reports if the "wallet" edge to the Wallet entity was cleared.
+func (m *IdempotencyKeyMutation) WalletCleared() bool {
+	return m.clearedwallet
+}
+
+// WalletIDs returns the "wallet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WalletID instead. It exists only for internal usage by the builders.
+func (m *IdempotencyKeyMutation) WalletIDs() (ids []uuid.UUID) {
+	if id := m.wallet; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWallet resets all changes to the "wallet" edge.
+func (m *IdempotencyKeyMutation) ResetWallet() {
+	m.wallet = nil
+	m.clearedwallet = false
+}
+
+// Where appends a list predicates to the IdempotencyKeyMutation builder.
+func (m *IdempotencyKeyMutation) Where(ps ...predicate.IdempotencyKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *IdempotencyKeyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (IdempotencyKey).
+func (m *IdempotencyKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotencyKeyMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.wallet != nil {
+		fields = append(fields, idempotencykey.FieldWalletID)
+	}
+	if m.accounts != nil {
+		fields = append(fields, idempotencykey.FieldAccounts)
+	}
+	if m.created_at != nil {
+		fields = append(fields, idempotencykey.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotencyKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotencykey.FieldWalletID:
+		return m.WalletID()
+	case idempotencykey.FieldAccounts:
+		return m.Accounts()
+	case idempotencykey.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotencyKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotencykey.FieldWalletID:
+		return m.OldWalletID(ctx)
+	case idempotencykey.FieldAccounts:
+		return m.OldAccounts(ctx)
+	case idempotencykey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotencykey.FieldWalletID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletID(v)
+		return nil
+	case idempotencykey.FieldAccounts:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccounts(v)
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotencyKeyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotencyKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotencyKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IdempotencyKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotencyKeyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotencyKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IdempotencyKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetField(name string) error {
+	switch name {
+	case idempotencykey.FieldWalletID:
+		m.ResetWalletID()
+		return nil
+	case idempotencykey.FieldAccounts:
+		m.ResetAccounts()
+		return nil
+	case idempotencykey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotencyKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.wallet != nil {
+		edges = append(edges, idempotencykey.EdgeWallet)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotencyKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case idempotencykey.EdgeWallet:
+		if id := m.wallet; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotencyKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotencyKeyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotencyKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwallet {
+		edges = append(edges, idempotencykey.EdgeWallet)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotencyKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case idempotencykey.EdgeWallet:
+		return m.clearedwallet
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case idempotencykey.EdgeWallet:
+		m.ClearWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotencyKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case idempotencykey.EdgeWallet:
+		m.ResetWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotencyKey edge %s", name)
+}
+
+// IdempotentSendMutation represents an operation that mutates the IdempotentSend nodes in the graph.
+type IdempotentSendMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	send_id       *string
+	source        *string
+	destination   *string
+	amount        *string
+	block_hash    *string
+	status        *idempotentsend.Status
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	wallet        *uuid.UUID
+	clearedwallet bool
+	done          bool
+	oldValue      func(context.Context) (*IdempotentSend, error)
+	predicates    []predicate.IdempotentSend
+}
+
+var _ ent.Mutation = (*IdempotentSendMutation)(nil)
+
+// idempotentsendOption allows management of the mutation configuration using functional options.
+type idempotentsendOption func(*IdempotentSendMutation)
+
+// newIdempotentSendMutation creates new mutation for the IdempotentSend entity.
+func newIdempotentSendMutation(c config, op Op, opts ...idempotentsendOption) *IdempotentSendMutation {
+	m := &IdempotentSendMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdempotentSend,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdempotentSendID sets the ID field of the mutation.
+func withIdempotentSendID(id uuid.UUID) idempotentsendOption {
+	return func(m *IdempotentSendMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IdempotentSend
+		)
+		m.oldValue = func(ctx context.Context) (*IdempotentSend, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IdempotentSend.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdempotentSend sets the old IdempotentSend of the mutation.
+func withIdempotentSend(node *IdempotentSend) idempotentsendOption {
+	return func(m *IdempotentSendMutation) {
+		m.oldValue = func(context.Context) (*IdempotentSend, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdempotentSendMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdempotentSendMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of IdempotentSend entities.
+func (m *IdempotentSendMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdempotentSendMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdempotentSendMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IdempotentSend.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (m *IdempotentSendMutation) SetWalletID(u uuid.UUID) {
+	m.wallet = &u
+}
+
+// WalletID returns the value of the "wallet_id" field in the mutation.
+func (m *IdempotentSendMutation) WalletID() (r uuid.UUID, exists bool) {
+	v := m.wallet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalletID returns the old "wallet_id" field's value of the IdempotentSend entity.
+// If the IdempotentSend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotentSendMutation) OldWalletID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletID: %w", err)
+	}
+	return oldValue.WalletID, nil
+}
+
+// ResetWalletID resets all changes to the "wallet_id" field.
+func (m *IdempotentSendMutation) ResetWalletID() {
+	m.wallet = nil
+}
+
+// SetSendID sets the "send_id" field.
+func (m *IdempotentSendMutation) SetSendID(s string) {
+	m.send_id = &s
+}
+
+// SendID returns the value of the "send_id" field in the mutation.
+func (m *IdempotentSendMutation) SendID() (r string, exists bool) {
+	v := m.send_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSendID returns the old "send_id" field's value of the IdempotentSend entity.
+// If the IdempotentSend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotentSendMutation) OldSendID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSendID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSendID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSendID: %w", err)
+	}
+	return oldValue.SendID, nil
+}
+
+// ResetSendID resets all changes to the "send_id" field.
+func (m *IdempotentSendMutation) ResetSendID() {
+	m.send_id = nil
+}
+
+// SetSource sets the "source" field.
+func (m *IdempotentSendMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *IdempotentSendMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the IdempotentSend entity.
+// If the IdempotentSend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotentSendMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *IdempotentSendMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetDestination sets the "destination" field.
+func (m *IdempotentSendMutation) SetDestination(s string) {
+	m.destination = &s
+}
+
+// Destination returns the value of the "destination" field in the mutation.
+func (m *IdempotentSendMutation) Destination() (r string, exists bool) {
+	v := m.destination
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDestination returns the old "destination" field's value of the IdempotentSend entity.
+// If the IdempotentSend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotentSendMutation) OldDestination(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestination is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestination requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestination: %w", err)
+	}
+	return oldValue.Destination, nil
+}
+
+// ResetDestination resets all changes to the "destination" field.
+func (m *IdempotentSendMutation) ResetDestination() {
+	m.destination = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *IdempotentSendMutation) SetAmount(s string) {
+	m.amount = &s
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *IdempotentSendMutation) Amount() (r string, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the IdempotentSend entity.
+// If the IdempotentSend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotentSendMutation) OldAmount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *IdempotentSendMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (m *IdempotentSendMutation) SetBlockHash(s string) {
+	m.block_hash = &s
+}
+
+// BlockHash returns the value of the "block_hash" field in the mutation.
+func (m *IdempotentSendMutation) BlockHash() (r string, exists bool) {
+	v := m.block_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHash returns the old "block_hash" field's value of the IdempotentSend entity.
+// If the IdempotentSend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotentSendMutation) OldBlockHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHash: %w", err)
+	}
+	return oldValue.BlockHash, nil
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (m *IdempotentSendMutation) ClearBlockHash() {
+	m.block_hash = nil
+	m.clearedFields[idempotentsend.FieldBlockHash] = struct{}{}
+}
+
+// BlockHashCleared returns if the "block_hash" field was cleared in this mutation.
+func (m *IdempotentSendMutation) BlockHashCleared() bool {
+	_, ok := m.clearedFields[idempotentsend.FieldBlockHash]
+	return ok
+}
+
+// ResetBlockHash resets all changes to the "block_hash" field.
+func (m *IdempotentSendMutation) ResetBlockHash() {
+	m.block_hash = nil
+	delete(m.clearedFields, idempotentsend.FieldBlockHash)
+}
+
+// SetStatus sets the "status" field.
+func (m *IdempotentSendMutation) SetStatus(i idempotentsend.Status) {
+	m.status = &i
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *IdempotentSendMutation) Status() (r idempotentsend.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the IdempotentSend entity.
+// If the IdempotentSend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotentSendMutation) OldStatus(ctx context.Context) (v idempotentsend.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *IdempotentSendMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdempotentSendMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdempotentSendMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the IdempotentSend entity.
+// If the IdempotentSend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdempotentSendMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdempotentSendMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (m *IdempotentSendMutation) ClearWallet() {
+	m.clearedwallet = true
+}
+
+// WalletCleared reports if the "wallet" edge to the Wallet entity was cleared.
+func (m *IdempotentSendMutation) WalletCleared() bool {
+	return m.clearedwallet
+}
+
+// WalletIDs returns the "wallet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WalletID instead. It exists only for internal usage by the builders.
+func (m *IdempotentSendMutation) WalletIDs() (ids []uuid.UUID) {
+	if id := m.wallet; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWallet resets all changes to the "wallet" edge.
+func (m *IdempotentSendMutation) ResetWallet() {
+	m.wallet = nil
+	m.clearedwallet = false
+}
+
+// Where appends a list predicates to the IdempotentSendMutation builder.
+func (m *IdempotentSendMutation) Where(ps ...predicate.IdempotentSend) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *IdempotentSendMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (IdempotentSend).
+func (m *IdempotentSendMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdempotentSendMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.wallet != nil {
+		fields = append(fields, idempotentsend.FieldWalletID)
+	}
+	if m.send_id != nil {
+		fields = append(fields, idempotentsend.FieldSendID)
+	}
+	if m.source != nil {
+		fields = append(fields, idempotentsend.FieldSource)
+	}
+	if m.destination != nil {
+		fields = append(fields, idempotentsend.FieldDestination)
+	}
+	if m.amount != nil {
+		fields = append(fields, idempotentsend.FieldAmount)
+	}
+	if m.block_hash != nil {
+		fields = append(fields, idempotentsend.FieldBlockHash)
+	}
+	if m.status != nil {
+		fields = append(fields, idempotentsend.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, idempotentsend.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdempotentSendMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case idempotentsend.FieldWalletID:
+		return m.WalletID()
+	case idempotentsend.FieldSendID:
+		return m.SendID()
+	case idempotentsend.FieldSource:
+		return m.Source()
+	case idempotentsend.FieldDestination:
+		return m.Destination()
+	case idempotentsend.FieldAmount:
+		return m.Amount()
+	case idempotentsend.FieldBlockHash:
+		return m.BlockHash()
+	case idempotentsend.FieldStatus:
+		return m.Status()
+	case idempotentsend.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdempotentSendMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case idempotentsend.FieldWalletID:
+		return m.OldWalletID(ctx)
+	case idempotentsend.FieldSendID:
+		return m.OldSendID(ctx)
+	case idempotentsend.FieldSource:
+		return m.OldSource(ctx)
+	case idempotentsend.FieldDestination:
+		return m.OldDestination(ctx)
+	case idempotentsend.FieldAmount:
+		return m.OldAmount(ctx)
+	case idempotentsend.FieldBlockHash:
+		return m.OldBlockHash(ctx)
+	case idempotentsend.FieldStatus:
+		return m.OldStatus(ctx)
+	case idempotentsend.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown IdempotentSend field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotentSendMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case idempotentsend.FieldWalletID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletID(v)
+		return nil
+	case idempotentsend.FieldSendID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSendID(v)
+		return nil
+	case idempotentsend.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case idempotentsend.FieldDestination:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDestination(v)
+		return nil
+	case idempotentsend.FieldAmount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case idempotentsend.FieldBlockHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHash(v)
+		return nil
+	case idempotentsend.FieldStatus:
+		v, ok := value.(idempotentsend.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case idempotentsend.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotentSend field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdempotentSendMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdempotentSendMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdempotentSendMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IdempotentSend numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdempotentSendMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(idempotentsend.FieldBlockHash) {
+		fields = append(fields, idempotentsend.FieldBlockHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdempotentSendMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdempotentSendMutation) ClearField(name string) error {
+	switch name {
+	case idempotentsend.FieldBlockHash:
+		m.ClearBlockHash()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotentSend nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdempotentSendMutation) ResetField(name string) error {
+	switch name {
+	case idempotentsend.FieldWalletID:
+		m.ResetWalletID()
+		return nil
+	case idempotentsend.FieldSendID:
+		m.ResetSendID()
+		return nil
+	case idempotentsend.FieldSource:
+		m.ResetSource()
+		return nil
+	case idempotentsend.FieldDestination:
+		m.ResetDestination()
+		return nil
+	case idempotentsend.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case idempotentsend.FieldBlockHash:
+		m.ResetBlockHash()
+		return nil
+	case idempotentsend.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case idempotentsend.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotentSend field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdempotentSendMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.wallet != nil {
+		edges = append(edges, idempotentsend.EdgeWallet)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdempotentSendMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case idempotentsend.EdgeWallet:
+		if id := m.wallet; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdempotentSendMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdempotentSendMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdempotentSendMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwallet {
+		edges = append(edges, idempotentsend.EdgeWallet)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdempotentSendMutation) EdgeCleared(name string) bool {
+	switch name {
+	case idempotentsend.EdgeWallet:
+		return m.clearedwallet
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdempotentSendMutation) ClearEdge(name string) error {
+	switch name {
+	case idempotentsend.EdgeWallet:
+		m.ClearWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotentSend unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdempotentSendMutation) ResetEdge(name string) error {
+	switch name {
+	case idempotentsend.EdgeWallet:
+		m.ResetWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown IdempotentSend edge %s", name)
+}
+
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	action        *string
+	status        *job.Status
+	percent       *int
+	addpercent    *int
+	result        *map[string]interface{}
+	error         *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	wallet        *uuid.UUID
+	clearedwallet bool
+	done          bool
+	oldValue      func(context.Context) (*Job, error)
+	predicates    []predicate.Job
+}
+
+var _ ent.Mutation = (*JobMutation)(nil)
+
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
+
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobID sets the ID field of the mutation.
+func withJobID(id uuid.UUID) jobOption {
+	return func(m *JobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Job
+		)
+		m.oldValue = func(ctx context.Context) (*Job, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Job.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Job entities.
+func (m *JobMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (m *JobMutation) SetWalletID(u uuid.UUID) {
+	m.wallet = &u
+}
+
+// WalletID returns the value of the "wallet_id" field in the mutation.
+func (m *JobMutation) WalletID() (r uuid.UUID, exists bool) {
+	v := m.wallet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalletID returns the old "wallet_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldWalletID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletID: %w", err)
+	}
+	return oldValue.WalletID, nil
+}
+
+// ResetWalletID resets all changes to the "wallet_id" field.
+func (m *JobMutation) ResetWalletID() {
+	m.wallet = nil
+}
+
+// SetAction sets the "action" field.
+func (m *JobMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *JobMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *JobMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *JobMutation) SetStatus(j job.Status) {
+	m.status = &j
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobMutation) Status() (r job.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStatus(ctx context.Context) (v job.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *JobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPercent sets the "percent" field.
+func (m *JobMutation) SetPercent(i int) {
+	m.percent = &i
+	m.addpercent = nil
+}
+
+// Percent returns the value of the "percent" field in the mutation.
+func (m *JobMutation) Percent() (r int, exists bool) {
+	v := m.percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPercent returns the old "percent" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldPercent(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPercent: %w", err)
+	}
+	return oldValue.Percent, nil
+}
+
+// AddPercent adds i to the "percent" field.
+func (m *JobMutation) AddPercent(i int) {
+	if m.addpercent != nil {
+		*m.addpercent += i
+	} else {
+		m.addpercent = &i
+	}
+}
+
+// AddedPercent returns the value that was added to the "percent" field in this mutation.
+func (m *JobMutation) AddedPercent() (r int, exists bool) {
+	v := m.addpercent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPercent resets all changes to the "percent" field.
+func (m *JobMutation) ResetPercent() {
+	m.percent = nil
+	m.addpercent = nil
+}
+
+// SetResult sets the "result" field.
+func (m *JobMutation) SetResult(value map[string]interface{}) {
+	m.result = &value
+}
+
+// Result returns the value of the "result" field in the mutation.
+func (m *JobMutation) Result() (r map[string]interface{}, exists bool) {
+	v := m.result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResult returns the old "result" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldResult(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+	}
+	return oldValue.Result, nil
+}
+
+// ClearResult clears the value of the "result" field.
+func (m *JobMutation) ClearResult() {
+	m.result = nil
+	m.clearedFields[job.FieldResult] = struct{}{}
+}
+
+// ResultCleared returns if the "result" field was cleared in this mutation.
+func (m *JobMutation) ResultCleared() bool {
+	_, ok := m.clearedFields[job.FieldResult]
+	return ok
+}
+
+// ResetResult resets all changes to the "result" field.
+func (m *JobMutation) ResetResult() {
+	m.result = nil
+	delete(m.clearedFields, job.FieldResult)
+}
+
+// SetError sets the "error" field.
+func (m *JobMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *JobMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *JobMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[job.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *JobMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[job.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *JobMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, job.FieldError)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *JobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *JobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *JobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (m *JobMutation) ClearWallet() {
+	m.clearedwallet = true
+}
+
+// WalletCleared reports if the "wallet" edge to the Wallet entity was cleared.
+func (m *JobMutation) WalletCleared() bool {
+	return m.clearedwallet
+}
+
+// WalletIDs returns the "wallet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WalletID instead. It exists only for internal usage by the builders.
+func (m *JobMutation) WalletIDs() (ids []uuid.UUID) {
+	if id := m.wallet; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWallet resets all changes to the "wallet" edge.
+func (m *JobMutation) ResetWallet() {
+	m.wallet = nil
+	m.clearedwallet = false
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.wallet != nil {
+		fields = append(fields, job.FieldWalletID)
+	}
+	if m.action != nil {
+		fields = append(fields, job.FieldAction)
+	}
+	if m.status != nil {
+		fields = append(fields, job.FieldStatus)
+	}
+	if m.percent != nil {
+		fields = append(fields, job.FieldPercent)
+	}
+	if m.result != nil {
+		fields = append(fields, job.FieldResult)
+	}
+	if m.error != nil {
+		fields = append(fields, job.FieldError)
+	}
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, job.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldWalletID:
+		return m.WalletID()
+	case job.FieldAction:
+		return m.Action()
+	case job.FieldStatus:
+		return m.Status()
+	case job.FieldPercent:
+		return m.Percent()
+	case job.FieldResult:
+		return m.Result()
+	case job.FieldError:
+		return m.Error()
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	case job.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldWalletID:
+		return m.OldWalletID(ctx)
+	case job.FieldAction:
+		return m.OldAction(ctx)
+	case job.FieldStatus:
+		return m.OldStatus(ctx)
+	case job.FieldPercent:
+		return m.OldPercent(ctx)
+	case job.FieldResult:
+		return m.OldResult(ctx)
+	case job.FieldError:
+		return m.OldError(ctx)
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case job.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldWalletID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletID(v)
+		return nil
+	case job.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case job.FieldStatus:
+		v, ok := value.(job.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case job.FieldPercent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPercent(v)
+		return nil
+	case job.FieldResult:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResult(v)
+		return nil
+	case job.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case job.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addpercent != nil {
+		fields = append(fields, job.FieldPercent)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldPercent:
+		return m.AddedPercent()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldPercent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPercent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(job.FieldResult) {
+		fields = append(fields, job.FieldResult)
+	}
+	if m.FieldCleared(job.FieldError) {
+		fields = append(fields, job.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldResult:
+		m.ClearResult()
+		return nil
+	case job.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldWalletID:
+		m.ResetWalletID()
+		return nil
+	case job.FieldAction:
+		m.ResetAction()
+		return nil
+	case job.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case job.FieldPercent:
+		m.ResetPercent()
+		return nil
+	case job.FieldResult:
+		m.ResetResult()
+		return nil
+	case job.FieldError:
+		m.ResetError()
+		return nil
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case job.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.wallet != nil {
+		edges = append(edges, job.EdgeWallet)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case job.EdgeWallet:
+		if id := m.wallet; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwallet {
+		edges = append(edges, job.EdgeWallet)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case job.EdgeWallet:
+		return m.clearedwallet
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	switch name {
+	case job.EdgeWallet:
+		m.ClearWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	switch name {
+	case job.EdgeWallet:
+		m.ResetWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// RepresentativeHistoryMutation represents an operation that mutates the RepresentativeHistory nodes in the graph.
+type RepresentativeHistoryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	old_representative *string
+	new_representative *string
+	block_hash         *string
+	changed_at         *time.Time
+	clearedFields      map[string]struct{}
+	account            *uuid.UUID
+	clearedaccount     bool
+	done               bool
+	oldValue           func(context.Context) (*RepresentativeHistory, error)
+	predicates         []predicate.RepresentativeHistory
+}
+
+var _ ent.Mutation = (*RepresentativeHistoryMutation)(nil)
+
+// representativehistoryOption allows management of the mutation configuration using functional options.
+type representativehistoryOption func(*RepresentativeHistoryMutation)
+
+// newRepresentativeHistoryMutation creates new mutation for the RepresentativeHistory entity.
+func newRepresentativeHistoryMutation(c config, op Op, opts ...representativehistoryOption) *RepresentativeHistoryMutation {
+	m := &RepresentativeHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRepresentativeHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRepresentativeHistoryID sets the ID field of the mutation.
+func withRepresentativeHistoryID(id uuid.UUID) representativehistoryOption {
+	return func(m *RepresentativeHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RepresentativeHistory
+		)
+		m.oldValue = func(ctx context.Context) (*RepresentativeHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RepresentativeHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRepresentativeHistory sets the old RepresentativeHistory of the mutation.
+func withRepresentativeHistory(node *RepresentativeHistory) representativehistoryOption {
+	return func(m *RepresentativeHistoryMutation) {
+		m.oldValue = func(context.Context) (*RepresentativeHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RepresentativeHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RepresentativeHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RepresentativeHistory entities.
+func (m *RepresentativeHistoryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RepresentativeHistoryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RepresentativeHistoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RepresentativeHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *RepresentativeHistoryMutation) SetAccountID(u uuid.UUID) {
+	m.account = &u
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *RepresentativeHistoryMutation) AccountID() (r uuid.UUID, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the RepresentativeHistory entity.
+// If the RepresentativeHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepresentativeHistoryMutation) OldAccountID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *RepresentativeHistoryMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetOldRepresentative sets the "old_representative" field.
+func (m *RepresentativeHistoryMutation) SetOldRepresentative(s string) {
+	m.old_representative = &s
+}
+
+// OldRepresentative returns the value of the "old_representative" field in the mutation.
+func (m *RepresentativeHistoryMutation) OldRepresentative() (r string, exists bool) {
+	v := m.old_representative
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOldRepresentative returns the old "old_representative" field's value of the RepresentativeHistory entity.
+// If the RepresentativeHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepresentativeHistoryMutation) OldOldRepresentative(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOldRepresentative is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOldRepresentative requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOldRepresentative: %w", err)
+	}
+	return oldValue.OldRepresentative, nil
+}
+
+// ResetOldRepresentative resets all changes to the "old_representative" field.
+func (m *RepresentativeHistoryMutation) ResetOldRepresentative() {
+	m.old_representative = nil
+}
+
+// SetNewRepresentative sets the "new_representative" field.
+func (m *RepresentativeHistoryMutation) SetNewRepresentative(s string) {
+	m.new_representative = &s
+}
+
+// NewRepresentative returns the value of the "new_representative" field in the mutation.
+func (m *RepresentativeHistoryMutation) NewRepresentative() (r string, exists bool) {
+	v := m.new_representative
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNewRepresentative returns the old "new_representative" field's value of the RepresentativeHistory entity.
+// If the RepresentativeHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepresentativeHistoryMutation) OldNewRepresentative(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNewRepresentative is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNewRepresentative requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNewRepresentative: %w", err)
+	}
+	return oldValue.NewRepresentative, nil
+}
+
+// ResetNewRepresentative resets all changes to the "new_representative" field.
+func (m *RepresentativeHistoryMutation) ResetNewRepresentative() {
+	m.new_representative = nil
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (m *RepresentativeHistoryMutation) SetBlockHash(s string) {
+	m.block_hash = &s
+}
+
+// BlockHash returns the value of the "block_hash" field in the mutation.
+func (m *RepresentativeHistoryMutation) BlockHash() (r string, exists bool) {
+	v := m.block_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHash returns the old "block_hash" field's value of the RepresentativeHistory entity.
+// If the RepresentativeHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepresentativeHistoryMutation) OldBlockHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHash: %w", err)
+	}
+	return oldValue.BlockHash, nil
+}
+
+// ResetBlockHash resets all changes to the "block_hash" field.
+func (m *RepresentativeHistoryMutation) ResetBlockHash() {
+	m.block_hash = nil
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (m *RepresentativeHistoryMutation) SetChangedAt(t time.Time) {
+	m.changed_at = &t
+}
+
+// ChangedAt returns the value of the "changed_at" field in the mutation.
+func (m *RepresentativeHistoryMutation) ChangedAt() (r time.Time, exists bool) {
+	v := m.changed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedAt returns the old "changed_at" field's value of the RepresentativeHistory entity.
+// If the RepresentativeHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepresentativeHistoryMutation) OldChangedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedAt: %w", err)
+	}
+	return oldValue.ChangedAt, nil
+}
+
+// ResetChangedAt resets all changes to the "changed_at" field.
+func (m *RepresentativeHistoryMutation) ResetChangedAt() {
+	m.changed_at = nil
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *RepresentativeHistoryMutation) ClearAccount() {
+	m.clearedaccount = true
+}
+
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *RepresentativeHistoryMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *RepresentativeHistoryMutation) AccountIDs() (ids []uuid.UUID) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *RepresentativeHistoryMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// Where appends a list predicates to the RepresentativeHistoryMutation builder.
+func (m *RepresentativeHistoryMutation) Where(ps ...predicate.RepresentativeHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *RepresentativeHistoryMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (RepresentativeHistory).
+func (m *RepresentativeHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RepresentativeHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.account != nil {
+		fields = append(fields, representativehistory.FieldAccountID)
+	}
+	if m.old_representative != nil {
+		fields = append(fields, representativehistory.FieldOldRepresentative)
+	}
+	if m.new_representative != nil {
+		fields = append(fields, representativehistory.FieldNewRepresentative)
+	}
+	if m.block_hash != nil {
+		fields = append(fields, representativehistory.FieldBlockHash)
+	}
+	if m.changed_at != nil {
+		fields = append(fields, representativehistory.FieldChangedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RepresentativeHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case representativehistory.FieldAccountID:
+		return m.AccountID()
+	case representativehistory.FieldOldRepresentative:
+		return m.OldRepresentative()
+	case representativehistory.FieldNewRepresentative:
+		return m.NewRepresentative()
+	case representativehistory.FieldBlockHash:
+		return m.BlockHash()
+	case representativehistory.FieldChangedAt:
+		return m.ChangedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RepresentativeHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case representativehistory.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case representativehistory.FieldOldRepresentative:
+		return m.OldOldRepresentative(ctx)
+	case representativehistory.FieldNewRepresentative:
+		return m.OldNewRepresentative(ctx)
+	case representativehistory.FieldBlockHash:
+		return m.OldBlockHash(ctx)
+	case representativehistory.FieldChangedAt:
+		return m.OldChangedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RepresentativeHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RepresentativeHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case representativehistory.FieldAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case representativehistory.FieldOldRepresentative:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldRepresentative(v)
+		return nil
+	case representativehistory.FieldNewRepresentative:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewRepresentative(v)
+		return nil
+	case representativehistory.FieldBlockHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHash(v)
+		return nil
+	case representativehistory.FieldChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RepresentativeHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RepresentativeHistoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RepresentativeHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RepresentativeHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RepresentativeHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RepresentativeHistoryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RepresentativeHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RepresentativeHistoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RepresentativeHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RepresentativeHistoryMutation) ResetField(name string) error {
+	switch name {
+	case representativehistory.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case representativehistory.FieldOldRepresentative:
+		m.ResetOldRepresentative()
+		return nil
+	case representativehistory.FieldNewRepresentative:
+		m.ResetNewRepresentative()
+		return nil
+	case representativehistory.FieldBlockHash:
+		m.ResetBlockHash()
+		return nil
+	case representativehistory.FieldChangedAt:
+		m.ResetChangedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RepresentativeHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RepresentativeHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.account != nil {
+		edges = append(edges, representativehistory.EdgeAccount)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RepresentativeHistoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case representativehistory.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RepresentativeHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RepresentativeHistoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RepresentativeHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedaccount {
+		edges = append(edges, representativehistory.EdgeAccount)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RepresentativeHistoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case representativehistory.EdgeAccount:
+		return m.clearedaccount
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RepresentativeHistoryMutation) ClearEdge(name string) error {
+	switch name {
+	case representativehistory.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown RepresentativeHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RepresentativeHistoryMutation) ResetEdge(name string) error {
+	switch name {
+	case representativehistory.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown RepresentativeHistory edge %s", name)
+}
+
+// SendApprovalMutation represents an operation that mutates the SendApproval nodes in the graph.
+type SendApprovalMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	send_id               *string
+	source                *string
+	destination           *string
+	amount                *string
+	work                  *string
+	approvals_required    *int
+	addapprovals_required *int
+	approved_by           *[]string
+	requested_by          *uuid.UUID
+	status                *sendapproval.Status
+	block_hash            *string
+	created_at            *time.Time
+	resolved_at           *time.Time
+	clearedFields         map[string]struct{}
+	wallet                *uuid.UUID
+	clearedwallet         bool
+	done                  bool
+	oldValue              func(context.Context) (*SendApproval, error)
+	predicates            []predicate.SendApproval
+}
+
+var _ ent.Mutation = (*SendApprovalMutation)(nil)
+
+// sendapprovalOption allows management of the mutation configuration using functional options.
+type sendapprovalOption func(*SendApprovalMutation)
+
+// newSendApprovalMutation creates new mutation for the SendApproval entity.
+func newSendApprovalMutation(c config, op Op, opts ...sendapprovalOption) *SendApprovalMutation {
+	m := &SendApprovalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSendApproval,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSendApprovalID sets the ID field of the mutation.
+func withSendApprovalID(id uuid.UUID) sendapprovalOption {
+	return func(m *SendApprovalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SendApproval
+		)
+		m.oldValue = func(ctx context.Context) (*SendApproval, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SendApproval.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSendApproval sets the old SendApproval of the mutation.
+func withSendApproval(node *SendApproval) sendapprovalOption {
+	return func(m *SendApprovalMutation) {
+		m.oldValue = func(context.Context) (*SendApproval, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SendApprovalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SendApprovalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SendApproval entities.
+func (m *SendApprovalMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SendApprovalMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SendApprovalMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SendApproval.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (m *SendApprovalMutation) SetWalletID(u uuid.UUID) {
+	m.wallet = &u
+}
+
+// WalletID returns the value of the "wallet_id" field in the mutation.
+func (m *SendApprovalMutation) WalletID() (r uuid.UUID, exists bool) {
+	v := m.wallet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalletID returns the old "wallet_id" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldWalletID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletID: %w", err)
+	}
+	return oldValue.WalletID, nil
+}
+
+// ResetWalletID resets all changes to the "wallet_id" field.
+func (m *SendApprovalMutation) ResetWalletID() {
+	m.wallet = nil
+}
+
+// SetSendID sets the "send_id" field.
+func (m *SendApprovalMutation) SetSendID(s string) {
+	m.send_id = &s
+}
+
+// SendID returns the value of the "send_id" field in the mutation.
+func (m *SendApprovalMutation) SendID() (r string, exists bool) {
+	v := m.send_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSendID returns the old "send_id" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldSendID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSendID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSendID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSendID: %w", err)
+	}
+	return oldValue.SendID, nil
+}
+
+// ClearSendID clears the value of the "send_id" field.
+func (m *SendApprovalMutation) ClearSendID() {
+	m.send_id = nil
+	m.clearedFields[sendapproval.FieldSendID] = struct{}{}
+}
+
+// SendIDCleared returns if the "send_id" field was cleared in this mutation.
+func (m *SendApprovalMutation) SendIDCleared() bool {
+	_, ok := m.clearedFields[sendapproval.FieldSendID]
+	return ok
+}
+
+// ResetSendID resets all changes to the "send_id" field.
+func (m *SendApprovalMutation) ResetSendID() {
+	m.send_id = nil
+	delete(m.clearedFields, sendapproval.FieldSendID)
+}
+
+// SetSource sets the "source" field.
+func (m *SendApprovalMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *SendApprovalMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *SendApprovalMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetDestination sets the "destination" field.
+func (m *SendApprovalMutation) SetDestination(s string) {
+	m.destination = &s
+}
+
+// Destination returns the value of the "destination" field in the mutation.
+func (m *SendApprovalMutation) Destination() (r string, exists bool) {
+	v := m.destination
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDestination returns the old "destination" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldDestination(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestination is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestination requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestination: %w", err)
+	}
+	return oldValue.Destination, nil
+}
+
+// ResetDestination resets all changes to the "destination" field.
+func (m *SendApprovalMutation) ResetDestination() {
+	m.destination = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *SendApprovalMutation) SetAmount(s string) {
+	m.amount = &s
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *SendApprovalMutation) Amount() (r string, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldAmount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *SendApprovalMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetWork sets the "work" field.
+func (m *SendApprovalMutation) SetWork(s string) {
+	m.work = &s
+}
+
+// Work returns the value of the "work" field in the mutation.
+func (m *SendApprovalMutation) Work() (r string, exists bool) {
+	v := m.work
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWork returns the old "work" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldWork(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWork: %w", err)
+	}
+	return oldValue.Work, nil
+}
+
+// ClearWork clears the value of the "work" field.
+func (m *SendApprovalMutation) ClearWork() {
+	m.work = nil
+	m.clearedFields[sendapproval.FieldWork] = struct{}{}
+}
+
+// WorkCleared returns if the "work" field was cleared in this mutation.
+func (m *SendApprovalMutation) WorkCleared() bool {
+	_, ok := m.clearedFields[sendapproval.FieldWork]
+	return ok
+}
+
+// ResetWork resets all changes to the "work" field.
+func (m *SendApprovalMutation) ResetWork() {
+	m.work = nil
+	delete(m.clearedFields, sendapproval.FieldWork)
+}
+
+// SetApprovalsRequired sets the "approvals_required" field.
+func (m *SendApprovalMutation) SetApprovalsRequired(i int) {
+	m.approvals_required = &i
+	m.addapprovals_required = nil
+}
+
+// ApprovalsRequired returns the value of the "approvals_required" field in the mutation.
+func (m *SendApprovalMutation) ApprovalsRequired() (r int, exists bool) {
+	v := m.approvals_required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovalsRequired returns the old "approvals_required" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldApprovalsRequired(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovalsRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovalsRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovalsRequired: %w", err)
+	}
+	return oldValue.ApprovalsRequired, nil
+}
+
+// AddApprovalsRequired adds i to the "approvals_required" field.
+func (m *SendApprovalMutation) AddApprovalsRequired(i int) {
+	if m.addapprovals_required != nil {
+		*m.addapprovals_required += i
+	} else {
+		m.addapprovals_required = &i
+	}
+}
+
+// AddedApprovalsRequired returns the value that was added to the "approvals_required" field in this mutation.
+func (m *SendApprovalMutation) AddedApprovalsRequired() (r int, exists bool) {
+	v := m.addapprovals_required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetApprovalsRequired resets all changes to the "approvals_required" field.
+func (m *SendApprovalMutation) ResetApprovalsRequired() {
+	m.approvals_required = nil
+	m.addapprovals_required = nil
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (m *SendApprovalMutation) SetApprovedBy(s []string) {
+	m.approved_by = &s
+}
+
+// ApprovedBy returns the value of the "approved_by" field in the mutation.
+func (m *SendApprovalMutation) ApprovedBy() (r []string, exists bool) {
+	v := m.approved_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovedBy returns the old "approved_by" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldApprovedBy(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovedBy: %w", err)
+	}
+	return oldValue.ApprovedBy, nil
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (m *SendApprovalMutation) ClearApprovedBy() {
+	m.approved_by = nil
+	m.clearedFields[sendapproval.FieldApprovedBy] = struct{}{}
+}
+
+// ApprovedByCleared returns if the "approved_by" field was cleared in this mutation.
+func (m *SendApprovalMutation) ApprovedByCleared() bool {
+	_, ok := m.clearedFields[sendapproval.FieldApprovedBy]
+	return ok
+}
+
+// ResetApprovedBy resets all changes to the "approved_by" field.
+func (m *SendApprovalMutation) ResetApprovedBy() {
+	m.approved_by = nil
+	delete(m.clearedFields, sendapproval.FieldApprovedBy)
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (m *SendApprovalMutation) SetRequestedBy(u uuid.UUID) {
+	m.requested_by = &u
+}
+
+// RequestedBy returns the value of the "requested_by" field in the mutation.
+func (m *SendApprovalMutation) RequestedBy() (r uuid.UUID, exists bool) {
+	v := m.requested_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedBy returns the old "requested_by" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldRequestedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedBy: %w", err)
+	}
+	return oldValue.RequestedBy, nil
+}
+
+// ClearRequestedBy clears the value of the "requested_by" field.
+func (m *SendApprovalMutation) ClearRequestedBy() {
+	m.requested_by = nil
+	m.clearedFields[sendapproval.FieldRequestedBy] = struct{}{}
+}
+
+// RequestedByCleared returns if the "requested_by" field was cleared in this mutation.
+func (m *SendApprovalMutation) RequestedByCleared() bool {
+	_, ok := m.clearedFields[sendapproval.FieldRequestedBy]
+	return ok
+}
+
+// ResetRequestedBy resets all changes to the "requested_by" field.
+func (m *SendApprovalMutation) ResetRequestedBy() {
+	m.requested_by = nil
+	delete(m.clearedFields, sendapproval.FieldRequestedBy)
+}
+
+// SetStatus sets the "status" field.
+func (m *SendApprovalMutation) SetStatus(s sendapproval.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SendApprovalMutation) Status() (r sendapproval.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldStatus(ctx context.Context) (v sendapproval.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SendApprovalMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (m *SendApprovalMutation) SetBlockHash(s string) {
+	m.block_hash = &s
+}
+
+// BlockHash returns the value of the "block_hash" field in the mutation.
+func (m *SendApprovalMutation) BlockHash() (r string, exists bool) {
+	v := m.block_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHash returns the old "block_hash" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldBlockHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHash: %w", err)
+	}
+	return oldValue.BlockHash, nil
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (m *SendApprovalMutation) ClearBlockHash() {
+	m.block_hash = nil
+	m.clearedFields[sendapproval.FieldBlockHash] = struct{}{}
+}
+
+// BlockHashCleared returns if the "block_hash" field was cleared in this mutation.
+func (m *SendApprovalMutation) BlockHashCleared() bool {
+	_, ok := m.clearedFields[sendapproval.FieldBlockHash]
+	return ok
+}
+
+// ResetBlockHash resets all changes to the "block_hash" field.
+func (m *SendApprovalMutation) ResetBlockHash() {
+	m.block_hash = nil
+	delete(m.clearedFields, sendapproval.FieldBlockHash)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SendApprovalMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SendApprovalMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SendApprovalMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *SendApprovalMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *SendApprovalMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the SendApproval entity.
+// If the SendApproval object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendApprovalMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *SendApprovalMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[sendapproval.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *SendApprovalMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[sendapproval.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *SendApprovalMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, sendapproval.FieldResolvedAt)
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (m *SendApprovalMutation) ClearWallet() {
+	m.clearedwallet = true
+}
+
+// WalletCleared reports if the "wallet" edge to the Wallet entity was cleared.
+func (m *SendApprovalMutation) WalletCleared() bool {
+	return m.clearedwallet
+}
+
+// WalletIDs returns the "wallet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WalletID instead. It exists only for internal usage by the builders.
+func (m *SendApprovalMutation) WalletIDs() (ids []uuid.UUID) {
+	if id := m.wallet; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWallet resets all changes to the "wallet" edge.
+func (m *SendApprovalMutation) ResetWallet() {
+	m.wallet = nil
+	m.clearedwallet = false
+}
+
+// Where appends a list predicates to the SendApprovalMutation builder.
+func (m *SendApprovalMutation) Where(ps ...predicate.SendApproval) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *SendApprovalMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (SendApproval).
+func (m *SendApprovalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SendApprovalMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.wallet != nil {
+		fields = append(fields, sendapproval.FieldWalletID)
+	}
+	if m.send_id != nil {
+		fields = append(fields, sendapproval.FieldSendID)
+	}
+	if m.source != nil {
+		fields = append(fields, sendapproval.FieldSource)
+	}
+	if m.destination != nil {
+		fields = append(fields, sendapproval.FieldDestination)
+	}
+	if m.amount != nil {
+		fields = append(fields, sendapproval.FieldAmount)
+	}
+	if m.work != nil {
+		fields = append(fields, sendapproval.FieldWork)
+	}
+	if m.approvals_required != nil {
+		fields = append(fields, sendapproval.FieldApprovalsRequired)
+	}
+	if m.approved_by != nil {
+		fields = append(fields, sendapproval.FieldApprovedBy)
+	}
+	if m.requested_by != nil {
+		fields = append(fields, sendapproval.FieldRequestedBy)
+	}
+	if m.status != nil {
+		fields = append(fields, sendapproval.FieldStatus)
+	}
+	if m.block_hash != nil {
+		fields = append(fields, sendapproval.FieldBlockHash)
+	}
+	if m.created_at != nil {
+		fields = append(fields, sendapproval.FieldCreatedAt)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, sendapproval.FieldResolvedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SendApprovalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sendapproval.FieldWalletID:
+		return m.WalletID()
+	case sendapproval.FieldSendID:
+		return m.SendID()
+	case sendapproval.FieldSource:
+		return m.Source()
+	case sendapproval.FieldDestination:
+		return m.Destination()
+	case sendapproval.FieldAmount:
+		return m.Amount()
+	case sendapproval.FieldWork:
+		return m.Work()
+	case sendapproval.FieldApprovalsRequired:
+		return m.ApprovalsRequired()
+	case sendapproval.FieldApprovedBy:
+		return m.ApprovedBy()
+	case sendapproval.FieldRequestedBy:
+		return m.RequestedBy()
+	case sendapproval.FieldStatus:
+		return m.Status()
+	case sendapproval.FieldBlockHash:
+		return m.BlockHash()
+	case sendapproval.FieldCreatedAt:
+		return m.CreatedAt()
+	case sendapproval.FieldResolvedAt:
+		return m.ResolvedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SendApprovalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sendapproval.FieldWalletID:
+		return m.OldWalletID(ctx)
+	case sendapproval.FieldSendID:
+		return m.OldSendID(ctx)
+	case sendapproval.FieldSource:
+		return m.OldSource(ctx)
+	case sendapproval.FieldDestination:
+		return m.OldDestination(ctx)
+	case sendapproval.FieldAmount:
+		return m.OldAmount(ctx)
+	case sendapproval.FieldWork:
+		return m.OldWork(ctx)
+	case sendapproval.FieldApprovalsRequired:
+		return m.OldApprovalsRequired(ctx)
+	case sendapproval.FieldApprovedBy:
+		return m.OldApprovedBy(ctx)
+	case sendapproval.FieldRequestedBy:
+		return m.OldRequestedBy(ctx)
+	case sendapproval.FieldStatus:
+		return m.OldStatus(ctx)
+	case sendapproval.FieldBlockHash:
+		return m.OldBlockHash(ctx)
+	case sendapproval.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case sendapproval.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SendApproval field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SendApprovalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sendapproval.FieldWalletID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletID(v)
+		return nil
+	case sendapproval.FieldSendID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSendID(v)
+		return nil
+	case sendapproval.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case sendapproval.FieldDestination:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDestination(v)
+		return nil
+	case sendapproval.FieldAmount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case sendapproval.FieldWork:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWork(v)
+		return nil
+	case sendapproval.FieldApprovalsRequired:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovalsRequired(v)
+		return nil
+	case sendapproval.FieldApprovedBy:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovedBy(v)
+		return nil
+	case sendapproval.FieldRequestedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedBy(v)
+		return nil
+	case sendapproval.FieldStatus:
+		v, ok := value.(sendapproval.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case sendapproval.FieldBlockHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHash(v)
+		return nil
+	case sendapproval.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case sendapproval.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SendApproval field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SendApprovalMutation) AddedFields() []string {
+	var fields []string
+	if m.addapprovals_required != nil {
+		fields = append(fields, sendapproval.FieldApprovalsRequired)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SendApprovalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sendapproval.FieldApprovalsRequired:
+		return m.AddedApprovalsRequired()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SendApprovalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sendapproval.FieldApprovalsRequired:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddApprovalsRequired(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SendApproval numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SendApprovalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sendapproval.FieldSendID) {
+		fields = append(fields, sendapproval.FieldSendID)
+	}
+	if m.FieldCleared(sendapproval.FieldWork) {
+		fields = append(fields, sendapproval.FieldWork)
+	}
+	if m.FieldCleared(sendapproval.FieldApprovedBy) {
+		fields = append(fields, sendapproval.FieldApprovedBy)
+	}
+	if m.FieldCleared(sendapproval.FieldRequestedBy) {
+		fields = append(fields, sendapproval.FieldRequestedBy)
+	}
+	if m.FieldCleared(sendapproval.FieldBlockHash) {
+		fields = append(fields, sendapproval.FieldBlockHash)
+	}
+	if m.FieldCleared(sendapproval.FieldResolvedAt) {
+		fields = append(fields, sendapproval.FieldResolvedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SendApprovalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SendApprovalMutation) ClearField(name string) error {
+	switch name {
+	case sendapproval.FieldSendID:
+		m.ClearSendID()
+		return nil
+	case sendapproval.FieldWork:
+		m.ClearWork()
+		return nil
+	case sendapproval.FieldApprovedBy:
+		m.ClearApprovedBy()
+		return nil
+	case sendapproval.FieldRequestedBy:
+		m.ClearRequestedBy()
+		return nil
+	case sendapproval.FieldBlockHash:
+		m.ClearBlockHash()
+		return nil
+	case sendapproval.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SendApproval nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SendApprovalMutation) ResetField(name string) error {
+	switch name {
+	case sendapproval.FieldWalletID:
+		m.ResetWalletID()
+		return nil
+	case sendapproval.FieldSendID:
+		m.ResetSendID()
+		return nil
+	case sendapproval.FieldSource:
+		m.ResetSource()
+		return nil
+	case sendapproval.FieldDestination:
+		m.ResetDestination()
+		return nil
+	case sendapproval.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case sendapproval.FieldWork:
+		m.ResetWork()
+		return nil
+	case sendapproval.FieldApprovalsRequired:
+		m.ResetApprovalsRequired()
+		return nil
+	case sendapproval.FieldApprovedBy:
+		m.ResetApprovedBy()
+		return nil
+	case sendapproval.FieldRequestedBy:
+		m.ResetRequestedBy()
+		return nil
+	case sendapproval.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case sendapproval.FieldBlockHash:
+		m.ResetBlockHash()
+		return nil
+	case sendapproval.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case sendapproval.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SendApproval field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SendApprovalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.wallet != nil {
+		edges = append(edges, sendapproval.EdgeWallet)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SendApprovalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sendapproval.EdgeWallet:
+		if id := m.wallet; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SendApprovalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SendApprovalMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SendApprovalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwallet {
+		edges = append(edges, sendapproval.EdgeWallet)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SendApprovalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sendapproval.EdgeWallet:
+		return m.clearedwallet
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SendApprovalMutation) ClearEdge(name string) error {
+	switch name {
+	case sendapproval.EdgeWallet:
+		m.ClearWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown SendApproval unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SendApprovalMutation) ResetEdge(name string) error {
+	switch name {
+	case sendapproval.EdgeWallet:
+		m.ResetWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown SendApproval edge %s", name)
+}
+
+// SendScheduleMutation represents an operation that mutates the SendSchedule nodes in the graph.
+type SendScheduleMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	source              *string
+	destination         *string
+	amount              *string
+	interval_seconds    *int
+	addinterval_seconds *int
+	next_run_at         *time.Time
+	last_error          *string
+	last_failed_at      *time.Time
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	wallet              *uuid.UUID
+	clearedwallet       bool
+	done                bool
+	oldValue            func(context.Context) (*SendSchedule, error)
+	predicates          []predicate.SendSchedule
+}
+
+var _ ent.Mutation = (*SendScheduleMutation)(nil)
+
+// sendscheduleOption allows management of the mutation configuration using functional options.
+type sendscheduleOption func(*SendScheduleMutation)
+
+// newSendScheduleMutation creates new mutation for the SendSchedule entity.
+func newSendScheduleMutation(c config, op Op, opts ...sendscheduleOption) *SendScheduleMutation {
+	m := &SendScheduleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSendSchedule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSendScheduleID sets the ID field of the mutation.
+func withSendScheduleID(id uuid.UUID) sendscheduleOption {
+	return func(m *SendScheduleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SendSchedule
+		)
+		m.oldValue = func(ctx context.Context) (*SendSchedule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SendSchedule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSendSchedule sets the old SendSchedule of the mutation.
+func withSendSchedule(node *SendSchedule) sendscheduleOption {
+	return func(m *SendScheduleMutation) {
+		m.oldValue = func(context.Context) (*SendSchedule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SendScheduleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SendScheduleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SendSchedule entities.
+func (m *SendScheduleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SendScheduleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SendScheduleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SendSchedule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (m *SendScheduleMutation) SetWalletID(u uuid.UUID) {
+	m.wallet = &u
+}
+
+// WalletID returns the value of the "wallet_id" field in the mutation.
+func (m *SendScheduleMutation) WalletID() (r uuid.UUID, exists bool) {
+	v := m.wallet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalletID returns the old "wallet_id" field's value of the SendSchedule entity.
+// If the SendSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendScheduleMutation) OldWalletID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletID: %w", err)
+	}
+	return oldValue.WalletID, nil
+}
+
+// ResetWalletID resets all changes to the "wallet_id" field.
+func (m *SendScheduleMutation) ResetWalletID() {
+	m.wallet = nil
+}
+
+// SetSource sets the "source" field.
+func (m *SendScheduleMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *SendScheduleMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the SendSchedule entity.
+// If the SendSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendScheduleMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *SendScheduleMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetDestination sets the "destination" field.
+func (m *SendScheduleMutation) SetDestination(s string) {
+	m.destination = &s
+}
+
+// Destination returns the value of the "destination" field in the mutation.
+func (m *SendScheduleMutation) Destination() (r string, exists bool) {
+	v := m.destination
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDestination returns the old "destination" field's value of the SendSchedule entity.
+// If the SendSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendScheduleMutation) OldDestination(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestination is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestination requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestination: %w", err)
+	}
+	return oldValue.Destination, nil
+}
+
+// ResetDestination resets all changes to the "destination" field.
+func (m *SendScheduleMutation) ResetDestination() {
+	m.destination = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *SendScheduleMutation) SetAmount(s string) {
+	m.amount = &s
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *SendScheduleMutation) Amount() (r string, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the SendSchedule entity.
+// If the SendSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendScheduleMutation) OldAmount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *SendScheduleMutation) ResetAmount() {
+	m.amount = nil
+}
+
+// SetIntervalSeconds sets the "interval_seconds" field.
+func (m *SendScheduleMutation) SetIntervalSeconds(i int) {
+	m.interval_seconds = &i
+	m.addinterval_seconds = nil
+}
+
+// IntervalSeconds returns the value of the "interval_seconds" field in the mutation.
+func (m *SendScheduleMutation) IntervalSeconds() (r int, exists bool) {
+	v := m.interval_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntervalSeconds returns the old "interval_seconds" field's value of the SendSchedule entity.
+// If the SendSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendScheduleMutation) OldIntervalSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntervalSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntervalSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntervalSeconds: %w", err)
+	}
+	return oldValue.IntervalSeconds, nil
+}
+
+// AddIntervalSeconds adds i to the "interval_seconds" field.
+func (m *SendScheduleMutation) AddIntervalSeconds(i int) {
+	if m.addinterval_seconds != nil {
+		*m.addinterval_seconds += i
+	} else {
+		m.addinterval_seconds = &i
+	}
+}
+
+// AddedIntervalSeconds returns the value that was added to the "interval_seconds" field in this mutation.
+func (m *SendScheduleMutation) AddedIntervalSeconds() (r int, exists bool) {
+	v := m.addinterval_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIntervalSeconds resets all changes to the "interval_seconds" field.
+func (m *SendScheduleMutation) ResetIntervalSeconds() {
+	m.interval_seconds = nil
+	m.addinterval_seconds = nil
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (m *SendScheduleMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
+}
+
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *SendScheduleMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRunAt returns the old "next_run_at" field's value of the SendSchedule entity.
+// If the SendSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendScheduleMutation) OldNextRunAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
+	}
+	return oldValue.NextRunAt, nil
+}
+
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *SendScheduleMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *SendScheduleMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *SendScheduleMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the SendSchedule entity.
+// If the SendSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendScheduleMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *SendScheduleMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[sendschedule.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *SendScheduleMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[sendschedule.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *SendScheduleMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, sendschedule.FieldLastError)
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (m *SendScheduleMutation) SetLastFailedAt(t time.Time) {
+	m.last_failed_at = &t
+}
+
+// LastFailedAt returns the value of the "last_failed_at" field in the mutation.
+func (m *SendScheduleMutation) LastFailedAt() (r time.Time, exists bool) {
+	v := m.last_failed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastFailedAt returns the old "last_failed_at" field's value of the SendSchedule entity.
+// If the SendSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendScheduleMutation) OldLastFailedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastFailedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastFailedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastFailedAt: %w", err)
+	}
+	return oldValue.LastFailedAt, nil
+}
+
+// ClearLastFailedAt clears the value of the "last_failed_at" field.
+func (m *SendScheduleMutation) ClearLastFailedAt() {
+	m.last_failed_at = nil
+	m.clearedFields[sendschedule.FieldLastFailedAt] = struct{}{}
+}
+
+// LastFailedAtCleared returns if the "last_failed_at" field was cleared in this mutation.
+func (m *SendScheduleMutation) LastFailedAtCleared() bool {
+	_, ok := m.clearedFields[sendschedule.FieldLastFailedAt]
+	return ok
+}
+
+// ResetLastFailedAt resets all changes to the "last_failed_at" field.
+func (m *SendScheduleMutation) ResetLastFailedAt() {
+	m.last_failed_at = nil
+	delete(m.clearedFields, sendschedule.FieldLastFailedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SendScheduleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SendScheduleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SendSchedule entity.
+// If the SendSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SendScheduleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SendScheduleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (m *SendScheduleMutation) ClearWallet() {
+	m.clearedwallet = true
+}
+
+// WalletCleared reports if the "wallet" edge to the Wallet entity was cleared.
+func (m *SendScheduleMutation) WalletCleared() bool {
+	return m.clearedwallet
+}
+
+// WalletIDs returns the "wallet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WalletID instead. It exists only for internal usage by the builders.
+func (m *SendScheduleMutation) WalletIDs() (ids []uuid.UUID) {
+	if id := m.wallet; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWallet resets all changes to the "wallet" edge.
+func (m *SendScheduleMutation) ResetWallet() {
+	m.wallet = nil
+	m.clearedwallet = false
+}
+
+// Where appends a list predicates to the SendScheduleMutation builder.
+func (m *SendScheduleMutation) Where(ps ...predicate.SendSchedule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *SendScheduleMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (SendSchedule).
+func (m *SendScheduleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SendScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.wallet != nil {
+		fields = append(fields, sendschedule.FieldWalletID)
+	}
+	if m.source != nil {
+		fields = append(fields, sendschedule.FieldSource)
+	}
+	if m.destination != nil {
+		fields = append(fields, sendschedule.FieldDestination)
+	}
+	if m.amount != nil {
+		fields = append(fields, sendschedule.FieldAmount)
+	}
+	if m.interval_seconds != nil {
+		fields = append(fields, sendschedule.FieldIntervalSeconds)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, sendschedule.FieldNextRunAt)
+	}
+	if m.last_error != nil {
+		fields = append(fields, sendschedule.FieldLastError)
+	}
+	if m.last_failed_at != nil {
+		fields = append(fields, sendschedule.FieldLastFailedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, sendschedule.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SendScheduleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sendschedule.FieldWalletID:
+		return m.WalletID()
+	case sendschedule.FieldSource:
+		return m.Source()
+	case sendschedule.FieldDestination:
+		return m.Destination()
+	case sendschedule.FieldAmount:
+		return m.Amount()
+	case sendschedule.FieldIntervalSeconds:
+		return m.IntervalSeconds()
+	case sendschedule.FieldNextRunAt:
+		return m.NextRunAt()
+	case sendschedule.FieldLastError:
+		return m.LastError()
+	case sendschedule.FieldLastFailedAt:
+		return m.LastFailedAt()
+	case sendschedule.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SendScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sendschedule.FieldWalletID:
+		return m.OldWalletID(ctx)
+	case sendschedule.FieldSource:
+		return m.OldSource(ctx)
+	case sendschedule.FieldDestination:
+		return m.OldDestination(ctx)
+	case sendschedule.FieldAmount:
+		return m.OldAmount(ctx)
+	case sendschedule.FieldIntervalSeconds:
+		return m.OldIntervalSeconds(ctx)
+	case sendschedule.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
+	case sendschedule.FieldLastError:
+		return m.OldLastError(ctx)
+	case sendschedule.FieldLastFailedAt:
+		return m.OldLastFailedAt(ctx)
+	case sendschedule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SendSchedule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SendScheduleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sendschedule.FieldWalletID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletID(v)
+		return nil
+	case sendschedule.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case sendschedule.FieldDestination:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDestination(v)
+		return nil
+	case sendschedule.FieldAmount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case sendschedule.FieldIntervalSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntervalSeconds(v)
+		return nil
+	case sendschedule.FieldNextRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunAt(v)
+		return nil
+	case sendschedule.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case sendschedule.FieldLastFailedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastFailedAt(v)
+		return nil
+	case sendschedule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SendSchedule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SendScheduleMutation) AddedFields() []string {
+	var fields []string
+	if m.addinterval_seconds != nil {
+		fields = append(fields, sendschedule.FieldIntervalSeconds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SendScheduleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sendschedule.FieldIntervalSeconds:
+		return m.AddedIntervalSeconds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SendScheduleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sendschedule.FieldIntervalSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntervalSeconds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SendSchedule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SendScheduleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sendschedule.FieldLastError) {
+		fields = append(fields, sendschedule.FieldLastError)
+	}
+	if m.FieldCleared(sendschedule.FieldLastFailedAt) {
+		fields = append(fields, sendschedule.FieldLastFailedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SendScheduleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SendScheduleMutation) ClearField(name string) error {
+	switch name {
+	case sendschedule.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case sendschedule.FieldLastFailedAt:
+		m.ClearLastFailedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SendSchedule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SendScheduleMutation) ResetField(name string) error {
+	switch name {
+	case sendschedule.FieldWalletID:
+		m.ResetWalletID()
+		return nil
+	case sendschedule.FieldSource:
+		m.ResetSource()
+		return nil
+	case sendschedule.FieldDestination:
+		m.ResetDestination()
+		return nil
+	case sendschedule.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case sendschedule.FieldIntervalSeconds:
+		m.ResetIntervalSeconds()
+		return nil
+	case sendschedule.FieldNextRunAt:
+		m.ResetNextRunAt()
+		return nil
+	case sendschedule.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case sendschedule.FieldLastFailedAt:
+		m.ResetLastFailedAt()
+		return nil
+	case sendschedule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SendSchedule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SendScheduleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.wallet != nil {
+		edges = append(edges, sendschedule.EdgeWallet)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SendScheduleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sendschedule.EdgeWallet:
+		if id := m.wallet; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SendScheduleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SendScheduleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SendScheduleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwallet {
+		edges = append(edges, sendschedule.EdgeWallet)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SendScheduleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sendschedule.EdgeWallet:
+		return m.clearedwallet
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SendScheduleMutation) ClearEdge(name string) error {
+	switch name {
+	case sendschedule.EdgeWallet:
+		m.ClearWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown SendSchedule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SendScheduleMutation) ResetEdge(name string) error {
+	switch name {
+	case sendschedule.EdgeWallet:
+		m.ResetWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown SendSchedule edge %s", name)
+}
+
+// WalletMutation represents an operation that mutates the Wallet nodes in the graph.
+type WalletMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	seed                    *string
+	representative          *string
+	name                    *string
+	encrypted               *bool
+	work                    *bool
+	watch_only              *bool
+	hardware                *bool
+	auto_receive            *bool
+	receive_minimum         *string
+	frozen_at               *time.Time
+	kdf                     *string
+	approvals_required      *int
+	addapprovals_required   *int
+	approval_threshold      *string
+	created_at              *time.Time
+	clearedFields           map[string]struct{}
+	accounts                map[uuid.UUID]struct{}
+	removedaccounts         map[uuid.UUID]struct{}
+	clearedaccounts         bool
+	send_schedules          map[uuid.UUID]struct{}
+	removedsend_schedules   map[uuid.UUID]struct{}
+	clearedsend_schedules   bool
+	balance_alerts          map[uuid.UUID]struct{}
+	removedbalance_alerts   map[uuid.UUID]struct{}
+	clearedbalance_alerts   bool
+	idempotency_keys        map[uuid.UUID]struct{}
+	removedidempotency_keys map[uuid.UUID]struct{}
+	clearedidempotency_keys bool
+	idempotent_sends        map[uuid.UUID]struct{}
+	removedidempotent_sends map[uuid.UUID]struct{}
+	clearedidempotent_sends bool
+	wallet_snapshots        map[uuid.UUID]struct{}
+	removedwallet_snapshots map[uuid.UUID]struct{}
+	clearedwallet_snapshots bool
+	jobs                    map[uuid.UUID]struct{}
+	removedjobs             map[uuid.UUID]struct{}
+	clearedjobs             bool
+	spends                  map[uuid.UUID]struct{}
+	removedspends           map[uuid.UUID]struct{}
+	clearedspends           bool
+	send_approvals          map[uuid.UUID]struct{}
+	removedsend_approvals   map[uuid.UUID]struct{}
+	clearedsend_approvals   bool
+	blocks                  map[uuid.UUID]struct{}
+	removedblocks           map[uuid.UUID]struct{}
+	clearedblocks           bool
+	done                    bool
+	oldValue                func(context.Context) (*Wallet, error)
+	predicates              []predicate.Wallet
+}
+
+var _ ent.Mutation = (*WalletMutation)(nil)
+
+// walletOption allows management of the mutation configuration using functional options.
+type walletOption func(*WalletMutation)
+
+// newWalletMutation creates new mutation for the Wallet entity.
+func newWalletMutation(c config, op Op, opts ...walletOption) *WalletMutation {
+	m := &WalletMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWallet,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWalletID sets the ID field of the mutation.
+func withWalletID(id uuid.UUID) walletOption {
+	return func(m *WalletMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Wallet
+		)
+		m.oldValue = func(ctx context.Context) (*Wallet, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Wallet.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWallet sets the old Wallet of the mutation.
+func withWallet(node *Wallet) walletOption {
+	return func(m *WalletMutation) {
+		m.oldValue = func(context.Context) (*Wallet, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WalletMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WalletMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Wallet entities.
+func (m *WalletMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WalletMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WalletMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Wallet.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSeed sets the "seed" field.
+func (m *WalletMutation) SetSeed(s string) {
+	m.seed = &s
+}
+
+// Seed returns the value of the "seed" field in the mutation.
+func (m *WalletMutation) Seed() (r string, exists bool) {
+	v := m.seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeed returns the old "seed" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldSeed(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+	}
+	return oldValue.Seed, nil
+}
+
+// ResetSeed resets all changes to the "seed" field.
+func (m *WalletMutation) ResetSeed() {
+	m.seed = nil
+}
+
+// SetRepresentative sets the "representative" field.
+func (m *WalletMutation) SetRepresentative(s string) {
+	m.representative = &s
+}
+
+// Representative returns the value of the "representative" field in the mutation.
+func (m *WalletMutation) Representative() (r string, exists bool) {
+	v := m.representative
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepresentative returns the old "representative" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldRepresentative(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepresentative is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepresentative requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepresentative: %w", err)
+	}
+	return oldValue.Representative, nil
+}
+
+// ClearRepresentative clears the value of the "representative" field.
+func (m *WalletMutation) ClearRepresentative() {
+	m.representative = nil
+	m.clearedFields[wallet.FieldRepresentative] = struct{}{}
+}
+
+// RepresentativeCleared returns if the "representative" field was cleared in this mutation.
+func (m *WalletMutation) RepresentativeCleared() bool {
+	_, ok := m.clearedFields[wallet.FieldRepresentative]
+	return ok
+}
+
+// ResetRepresentative resets all changes to the "representative" field.
+func (m *WalletMutation) ResetRepresentative() {
+	m.representative = nil
+	delete(m.clearedFields, wallet.FieldRepresentative)
+}
+
+// SetName sets the "name" field.
+func (m *WalletMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WalletMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *WalletMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[wallet.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *WalletMutation) NameCleared() bool {
+	_, ok := m.clearedFields[wallet.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WalletMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, wallet.FieldName)
+}
+
+// SetEncrypted sets the "encrypted" field.
+func (m *WalletMutation) SetEncrypted(b bool) {
+	m.encrypted = &b
+}
+
+// Encrypted returns the value of the "encrypted" field in the mutation.
+func (m *WalletMutation) Encrypted() (r bool, exists bool) {
+	v := m.encrypted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncrypted returns the old "encrypted" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldEncrypted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncrypted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncrypted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncrypted: %w", err)
+	}
+	return oldValue.Encrypted, nil
+}
+
+// ResetEncrypted resets all changes to the "encrypted" field.
+func (m *WalletMutation) ResetEncrypted() {
+	m.encrypted = nil
+}
+
+// SetWork sets the "work" field.
+func (m *WalletMutation) SetWork(b bool) {
+	m.work = &b
+}
+
+// Work returns the value of the "work" field in the mutation.
+func (m *WalletMutation) Work() (r bool, exists bool) {
+	v := m.work
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWork returns the old "work" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldWork(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWork: %w", err)
+	}
+	return oldValue.Work, nil
+}
+
+// ResetWork resets all changes to the "work" field.
+func (m *WalletMutation) ResetWork() {
+	m.work = nil
+}
+
+// SetWatchOnly sets the "watch_only" field.
+func (m *WalletMutation) SetWatchOnly(b bool) {
+	m.watch_only = &b
+}
+
+// WatchOnly returns the value of the "watch_only" field in the mutation.
+func (m *WalletMutation) WatchOnly() (r bool, exists bool) {
+	v := m.watch_only
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWatchOnly returns the old "watch_only" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldWatchOnly(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWatchOnly is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWatchOnly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWatchOnly: %w", err)
+	}
+	return oldValue.WatchOnly, nil
+}
+
+// ResetWatchOnly resets all changes to the "watch_only" field.
+func (m *WalletMutation) ResetWatchOnly() {
+	m.watch_only = nil
+}
+
+// SetHardware sets the "hardware" field.
+func (m *WalletMutation) SetHardware(b bool) {
+	m.hardware = &b
+}
+
+// Hardware returns the value of the "hardware" field in the mutation.
+func (m *WalletMutation) Hardware() (r bool, exists bool) {
+	v := m.hardware
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHardware returns the old "hardware" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldHardware(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHardware is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHardware requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHardware: %w", err)
+	}
+	return oldValue.Hardware, nil
+}
+
+// ResetHardware resets all changes to the "hardware" field.
+func (m *WalletMutation) ResetHardware() {
+	m.hardware = nil
+}
+
+// SetAutoReceive sets the "auto_receive" field.
+func (m *WalletMutation) SetAutoReceive(b bool) {
+	m.auto_receive = &b
+}
+
+// AutoReceive returns the value of the "auto_receive" field in the mutation.
+func (m *WalletMutation) AutoReceive() (r bool, exists bool) {
+	v := m.auto_receive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoReceive returns the old "auto_receive" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldAutoReceive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoReceive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoReceive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoReceive: %w", err)
+	}
+	return oldValue.AutoReceive, nil
+}
+
+// ResetAutoReceive resets all changes to the "auto_receive" field.
+func (m *WalletMutation) ResetAutoReceive() {
+	m.auto_receive = nil
+}
+
+// SetReceiveMinimum sets the "receive_minimum" field.
+func (m *WalletMutation) SetReceiveMinimum(s string) {
+	m.receive_minimum = &s
+}
+
+// ReceiveMinimum returns the value of the "receive_minimum" field in the mutation.
+func (m *WalletMutation) ReceiveMinimum() (r string, exists bool) {
+	v := m.receive_minimum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceiveMinimum returns the old "receive_minimum" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldReceiveMinimum(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceiveMinimum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceiveMinimum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceiveMinimum: %w", err)
+	}
+	return oldValue.ReceiveMinimum, nil
+}
+
+// ClearReceiveMinimum clears the value of the "receive_minimum" field.
+func (m *WalletMutation) ClearReceiveMinimum() {
+	m.receive_minimum = nil
+	m.clearedFields[wallet.FieldReceiveMinimum] = struct{}{}
+}
+
+// ReceiveMinimumCleared returns if the "receive_minimum" field was cleared in this mutation.
+func (m *WalletMutation) ReceiveMinimumCleared() bool {
+	_, ok := m.clearedFields[wallet.FieldReceiveMinimum]
+	return ok
+}
+
+// ResetReceiveMinimum resets all changes to the "receive_minimum" field.
+func (m *WalletMutation) ResetReceiveMinimum() {
+	m.receive_minimum = nil
+	delete(m.clearedFields, wallet.FieldReceiveMinimum)
+}
+
+// SetFrozenAt sets the "frozen_at" field.
+func (m *WalletMutation) SetFrozenAt(t time.Time) {
+	m.frozen_at = &t
+}
+
+// FrozenAt returns the value of the "frozen_at" field in the mutation.
+func (m *WalletMutation) FrozenAt() (r time.Time, exists bool) {
+	v := m.frozen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrozenAt returns the old "frozen_at" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldFrozenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrozenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrozenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrozenAt: %w", err)
+	}
+	return oldValue.FrozenAt, nil
+}
+
+// ClearFrozenAt clears the value of the "frozen_at" field.
+func (m *WalletMutation) ClearFrozenAt() {
+	m.frozen_at = nil
+	m.clearedFields[wallet.FieldFrozenAt] = struct{}{}
+}
+
+// FrozenAtCleared returns if the "frozen_at" field was cleared in this mutation.
+func (m *WalletMutation) FrozenAtCleared() bool {
+	_, ok := m.clearedFields[wallet.FieldFrozenAt]
+	return ok
+}
+
+// ResetFrozenAt resets all changes to the "frozen_at" field.
+func (m *WalletMutation) ResetFrozenAt() {
+	m.frozen_at = nil
+	delete(m.clearedFields, wallet.FieldFrozenAt)
+}
+
+// SetKdf sets the "kdf" field.
+func (m *WalletMutation) SetKdf(s string) {
+	m.kdf = &s
+}
+
+// Kdf returns the value of the "kdf" field in the mutation.
+func (m *WalletMutation) Kdf() (r string, exists bool) {
+	v := m.kdf
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKdf returns the old "kdf" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldKdf(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKdf is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKdf requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKdf: %w", err)
+	}
+	return oldValue.Kdf, nil
+}
+
+// ClearKdf clears the value of the "kdf" field.
+func (m *WalletMutation) ClearKdf() {
+	m.kdf = nil
+	m.clearedFields[wallet.FieldKdf] = struct{}{}
+}
+
+// KdfCleared returns if the "kdf" field was cleared in this mutation.
+func (m *WalletMutation) KdfCleared() bool {
+	_, ok := m.clearedFields[wallet.FieldKdf]
+	return ok
+}
+
+// ResetKdf resets all changes to the "kdf" field.
+func (m *WalletMutation) ResetKdf() {
+	m.kdf = nil
+	delete(m.clearedFields, wallet.FieldKdf)
+}
+
+// SetApprovalsRequired sets the "approvals_required" field.
+func (m *WalletMutation) SetApprovalsRequired(i int) {
+	m.approvals_required = &i
+	m.addapprovals_required = nil
+}
+
+// ApprovalsRequired returns the value of the "approvals_required" field in the mutation.
+func (m *WalletMutation) ApprovalsRequired() (r int, exists bool) {
+	v := m.approvals_required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovalsRequired returns the old "approvals_required" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldApprovalsRequired(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovalsRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovalsRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovalsRequired: %w", err)
+	}
+	return oldValue.ApprovalsRequired, nil
+}
+
+// AddApprovalsRequired adds i to the "approvals_required" field.
+func (m *WalletMutation) AddApprovalsRequired(i int) {
+	if m.addapprovals_required != nil {
+		*m.addapprovals_required += i
+	} else {
+		m.addapprovals_required = &i
+	}
+}
+
+// AddedApprovalsRequired returns the value that was added to the "approvals_required" field in this mutation.
+func (m *WalletMutation) AddedApprovalsRequired() (r int, exists bool) {
+	v := m.addapprovals_required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearApprovalsRequired clears the value of the "approvals_required" field.
+func (m *WalletMutation) ClearApprovalsRequired() {
+	m.approvals_required = nil
+	m.addapprovals_required = nil
+	m.clearedFields[wallet.FieldApprovalsRequired] = struct{}{}
+}
+
+// ApprovalsRequiredCleared returns if the "approvals_required" field was cleared in this mutation.
+func (m *WalletMutation) ApprovalsRequiredCleared() bool {
+	_, ok := m.clearedFields[wallet.FieldApprovalsRequired]
+	return ok
+}
+
+// ResetApprovalsRequired resets all changes to the "approvals_required" field.
+func (m *WalletMutation) ResetApprovalsRequired() {
+	m.approvals_required = nil
+	m.addapprovals_required = nil
+	delete(m.clearedFields, wallet.FieldApprovalsRequired)
+}
+
+// SetApprovalThreshold sets the "approval_threshold" field.
+func (m *WalletMutation) SetApprovalThreshold(s string) {
+	m.approval_threshold = &s
+}
+
+// ApprovalThreshold returns the value of the "approval_threshold" field in the mutation.
+func (m *WalletMutation) ApprovalThreshold() (r string, exists bool) {
+	v := m.approval_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovalThreshold returns the old "approval_threshold" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldApprovalThreshold(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApprovalThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApprovalThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovalThreshold: %w", err)
+	}
+	return oldValue.ApprovalThreshold, nil
+}
+
+// ClearApprovalThreshold clears the value of the "approval_threshold" field.
+func (m *WalletMutation) ClearApprovalThreshold() {
+	m.approval_threshold = nil
+	m.clearedFields[wallet.FieldApprovalThreshold] = struct{}{}
+}
+
+// ApprovalThresholdCleared returns if the "approval_threshold" field was cleared in this mutation.
+func (m *WalletMutation) ApprovalThresholdCleared() bool {
+	_, ok := m.clearedFields[wallet.FieldApprovalThreshold]
+	return ok
+}
+
+// ResetApprovalThreshold resets all changes to the "approval_threshold" field.
+func (m *WalletMutation) ResetApprovalThreshold() {
+	m.approval_threshold = nil
+	delete(m.clearedFields, wallet.FieldApprovalThreshold)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WalletMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WalletMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Wallet entity.
+// If the Wallet object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WalletMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddAccountIDs adds the "accounts" edge to the Account entity by ids.
 func (m *WalletMutation) AddAccountIDs(ids ...uuid.UUID) {
 	if m.accounts == nil {
 		m.accounts = make(map[uuid.UUID]struct{})
 	}
-	for i := range ids {
-		m.accounts[ids[i]] = struct{}{}
+	for i := range ids {
+		m.accounts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAccounts clears the "accounts" edge to the Account entity.
+func (m *WalletMutation) ClearAccounts() {
+	m.clearedaccounts = true
+}
+
+// AccountsCleared reports if the "accounts" edge to the Account entity was cleared.
+func (m *WalletMutation) AccountsCleared() bool {
+	return m.clearedaccounts
+}
+
+// RemoveAccountIDs removes the "accounts" edge to the Account entity by IDs.
+func (m *WalletMutation) RemoveAccountIDs(ids ...uuid.UUID) {
+	if m.removedaccounts == nil {
+		m.removedaccounts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.accounts, ids[i])
+		m.removedaccounts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAccounts returns the removed IDs of the "accounts" edge to the Account entity.
+func (m *WalletMutation) RemovedAccountsIDs() (ids []uuid.UUID) {
+	for id := range m.removedaccounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AccountsIDs returns the "accounts" edge IDs in the mutation.
+func (m *WalletMutation) AccountsIDs() (ids []uuid.UUID) {
+	for id := range m.accounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAccounts resets all changes to the "accounts" edge.
+func (m *WalletMutation) ResetAccounts() {
+	m.accounts = nil
+	m.clearedaccounts = false
+	m.removedaccounts = nil
+}
+
+// AddSendScheduleIDs adds the "send_schedules" edge to the SendSchedule entity by ids.
+func (m *WalletMutation) AddSendScheduleIDs(ids ...uuid.UUID) {
+	if m.send_schedules == nil {
+		m.send_schedules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.send_schedules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSendSchedules clears the "send_schedules" edge to the SendSchedule entity.
+func (m *WalletMutation) ClearSendSchedules() {
+	m.clearedsend_schedules = true
+}
+
+// SendSchedulesCleared reports if the "send_schedules" edge to the SendSchedule entity was cleared.
+func (m *WalletMutation) SendSchedulesCleared() bool {
+	return m.clearedsend_schedules
+}
+
+// RemoveSendScheduleIDs removes the "send_schedules" edge to the SendSchedule entity by IDs.
+func (m *WalletMutation) RemoveSendScheduleIDs(ids ...uuid.UUID) {
+	if m.removedsend_schedules == nil {
+		m.removedsend_schedules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.send_schedules, ids[i])
+		m.removedsend_schedules[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSendSchedules returns the removed IDs of the "send_schedules" edge to the SendSchedule entity.
+func (m *WalletMutation) RemovedSendSchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsend_schedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SendSchedulesIDs returns the "send_schedules" edge IDs in the mutation.
+func (m *WalletMutation) SendSchedulesIDs() (ids []uuid.UUID) {
+	for id := range m.send_schedules {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSendSchedules resets all changes to the "send_schedules" edge.
+func (m *WalletMutation) ResetSendSchedules() {
+	m.send_schedules = nil
+	m.clearedsend_schedules = false
+	m.removedsend_schedules = nil
+}
+
+// AddBalanceAlertIDs adds the "balance_alerts" edge to the BalanceAlert entity by ids.
+func (m *WalletMutation) AddBalanceAlertIDs(ids ...uuid.UUID) {
+	if m.balance_alerts == nil {
+		m.balance_alerts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.balance_alerts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBalanceAlerts clears the "balance_alerts" edge to the BalanceAlert entity.
+func (m *WalletMutation) ClearBalanceAlerts() {
+	m.clearedbalance_alerts = true
+}
+
+// BalanceAlertsCleared reports if the "balance_alerts" edge to the BalanceAlert entity was cleared.
+func (m *WalletMutation) BalanceAlertsCleared() bool {
+	return m.clearedbalance_alerts
+}
+
+// RemoveBalanceAlertIDs removes the "balance_alerts" edge to the BalanceAlert entity by IDs.
+func (m *WalletMutation) RemoveBalanceAlertIDs(ids ...uuid.UUID) {
+	if m.removedbalance_alerts == nil {
+		m.removedbalance_alerts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.balance_alerts, ids[i])
+		m.removedbalance_alerts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBalanceAlerts returns the removed IDs of the "balance_alerts" edge to the BalanceAlert entity.
+func (m *WalletMutation) RemovedBalanceAlertsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbalance_alerts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BalanceAlertsIDs returns the "balance_alerts" edge IDs in the mutation.
+func (m *WalletMutation) BalanceAlertsIDs() (ids []uuid.UUID) {
+	for id := range m.balance_alerts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBalanceAlerts resets all changes to the "balance_alerts" edge.
+func (m *WalletMutation) ResetBalanceAlerts() {
+	m.balance_alerts = nil
+	m.clearedbalance_alerts = false
+	m.removedbalance_alerts = nil
+}
+
+// AddIdempotencyKeyIDs adds the "idempotency_keys" edge to the IdempotencyKey entity by ids.
+func (m *WalletMutation) AddIdempotencyKeyIDs(ids ...uuid.UUID) {
+	if m.idempotency_keys == nil {
+		m.idempotency_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.idempotency_keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIdempotencyKeys clears the "idempotency_keys" edge to the IdempotencyKey entity.
+func (m *WalletMutation) ClearIdempotencyKeys() {
+	m.clearedidempotency_keys = true
+}
+
+// IdempotencyKeysCleared reports if the "idempotency_keys" edge to the IdempotencyKey entity was cleared.
+func (m *WalletMutation) IdempotencyKeysCleared() bool {
+	return m.clearedidempotency_keys
+}
+
+// RemoveIdempotencyKeyIDs removes the "idempotency_keys" edge to the IdempotencyKey entity by IDs.
+func (m *WalletMutation) RemoveIdempotencyKeyIDs(ids ...uuid.UUID) {
+	if m.removedidempotency_keys == nil {
+		m.removedidempotency_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.idempotency_keys, ids[i])
+		m.removedidempotency_keys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIdempotencyKeys returns the removed IDs of the "idempotency_keys" edge to the IdempotencyKey entity.
+func (m *WalletMutation) RemovedIdempotencyKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedidempotency_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IdempotencyKeysIDs returns the "idempotency_keys" edge IDs in the mutation.
+func (m *WalletMutation) IdempotencyKeysIDs() (ids []uuid.UUID) {
+	for id := range m.idempotency_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIdempotencyKeys resets all changes to the "idempotency_keys" edge.
+func (m *WalletMutation) ResetIdempotencyKeys() {
+	m.idempotency_keys = nil
+	m.clearedidempotency_keys = false
+	m.removedidempotency_keys = nil
+}
+
+// AddIdempotentSendIDs adds the "idempotent_sends" edge to the IdempotentSend entity by ids.
+func (m *WalletMutation) AddIdempotentSendIDs(ids ...uuid.UUID) {
+	if m.idempotent_sends == nil {
+		m.idempotent_sends = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.idempotent_sends[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIdempotentSends clears the "idempotent_sends" edge to the IdempotentSend entity.
+func (m *WalletMutation) ClearIdempotentSends() {
+	m.clearedidempotent_sends = true
+}
+
+// IdempotentSendsCleared reports if the "idempotent_sends" edge to the IdempotentSend entity was cleared.
+func (m *WalletMutation) IdempotentSendsCleared() bool {
+	return m.clearedidempotent_sends
+}
+
+// RemoveIdempotentSendIDs removes the "idempotent_sends" edge to the IdempotentSend entity by IDs.
+func (m *WalletMutation) RemoveIdempotentSendIDs(ids ...uuid.UUID) {
+	if m.removedidempotent_sends == nil {
+		m.removedidempotent_sends = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.idempotent_sends, ids[i])
+		m.removedidempotent_sends[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIdempotentSends returns the removed IDs of the "idempotent_sends" edge to the IdempotentSend entity.
+func (m *WalletMutation) RemovedIdempotentSendsIDs() (ids []uuid.UUID) {
+	for id := range m.removedidempotent_sends {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IdempotentSendsIDs returns the "idempotent_sends" edge IDs in the mutation.
+func (m *WalletMutation) IdempotentSendsIDs() (ids []uuid.UUID) {
+	for id := range m.idempotent_sends {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIdempotentSends resets all changes to the "idempotent_sends" edge.
+func (m *WalletMutation) ResetIdempotentSends() {
+	m.idempotent_sends = nil
+	m.clearedidempotent_sends = false
+	m.removedidempotent_sends = nil
+}
+
+// AddWalletSnapshotIDs adds the "wallet_snapshots" edge to the WalletSnapshot entity by ids.
+func (m *WalletMutation) AddWalletSnapshotIDs(ids ...uuid.UUID) {
+	if m.wallet_snapshots == nil {
+		m.wallet_snapshots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.wallet_snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWalletSnapshots clears the "wallet_snapshots" edge to the WalletSnapshot entity.
+func (m *WalletMutation) ClearWalletSnapshots() {
+	m.clearedwallet_snapshots = true
+}
+
+// WalletSnapshotsCleared reports if the "wallet_snapshots" edge to the WalletSnapshot entity was cleared.
+func (m *WalletMutation) WalletSnapshotsCleared() bool {
+	return m.clearedwallet_snapshots
+}
+
+// RemoveWalletSnapshotIDs removes the "wallet_snapshots" edge to the WalletSnapshot entity by IDs.
+func (m *WalletMutation) RemoveWalletSnapshotIDs(ids ...uuid.UUID) {
+	if m.removedwallet_snapshots == nil {
+		m.removedwallet_snapshots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.wallet_snapshots, ids[i])
+		m.removedwallet_snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWalletSnapshots returns the removed IDs of the "wallet_snapshots" edge to the WalletSnapshot entity.
+func (m *WalletMutation) RemovedWalletSnapshotsIDs() (ids []uuid.UUID) {
+	for id := range m.removedwallet_snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WalletSnapshotsIDs returns the "wallet_snapshots" edge IDs in the mutation.
+func (m *WalletMutation) WalletSnapshotsIDs() (ids []uuid.UUID) {
+	for id := range m.wallet_snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWalletSnapshots resets all changes to the "wallet_snapshots" edge.
+func (m *WalletMutation) ResetWalletSnapshots() {
+	m.wallet_snapshots = nil
+	m.clearedwallet_snapshots = false
+	m.removedwallet_snapshots = nil
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by ids.
+func (m *WalletMutation) AddJobIDs(ids ...uuid.UUID) {
+	if m.jobs == nil {
+		m.jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJobs clears the "jobs" edge to the Job entity.
+func (m *WalletMutation) ClearJobs() {
+	m.clearedjobs = true
+}
+
+// JobsCleared reports if the "jobs" edge to the Job entity was cleared.
+func (m *WalletMutation) JobsCleared() bool {
+	return m.clearedjobs
+}
+
+// RemoveJobIDs removes the "jobs" edge to the Job entity by IDs.
+func (m *WalletMutation) RemoveJobIDs(ids ...uuid.UUID) {
+	if m.removedjobs == nil {
+		m.removedjobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.jobs, ids[i])
+		m.removedjobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJobs returns the removed IDs of the "jobs" edge to the Job entity.
+func (m *WalletMutation) RemovedJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedjobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JobsIDs returns the "jobs" edge IDs in the mutation.
+func (m *WalletMutation) JobsIDs() (ids []uuid.UUID) {
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJobs resets all changes to the "jobs" edge.
+func (m *WalletMutation) ResetJobs() {
+	m.jobs = nil
+	m.clearedjobs = false
+	m.removedjobs = nil
+}
+
+// AddSpendIDs adds the "spends" edge to the WalletSpend entity by ids.
+func (m *WalletMutation) AddSpendIDs(ids ...uuid.UUID) {
+	if m.spends == nil {
+		m.spends = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.spends[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSpends clears the "spends" edge to the WalletSpend entity.
+func (m *WalletMutation) ClearSpends() {
+	m.clearedspends = true
+}
+
+// SpendsCleared reports if the "spends" edge to the WalletSpend entity was cleared.
+func (m *WalletMutation) SpendsCleared() bool {
+	return m.clearedspends
+}
+
+// RemoveSpendIDs removes the "spends" edge to the WalletSpend entity by IDs.
+func (m *WalletMutation) RemoveSpendIDs(ids ...uuid.UUID) {
+	if m.removedspends == nil {
+		m.removedspends = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.spends, ids[i])
+		m.removedspends[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSpends returns the removed IDs of the "spends" edge to the WalletSpend entity.
+func (m *WalletMutation) RemovedSpendsIDs() (ids []uuid.UUID) {
+	for id := range m.removedspends {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SpendsIDs returns the "spends" edge IDs in the mutation.
+func (m *WalletMutation) SpendsIDs() (ids []uuid.UUID) {
+	for id := range m.spends {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSpends resets all changes to the "spends" edge.
+func (m *WalletMutation) ResetSpends() {
+	m.spends = nil
+	m.clearedspends = false
+	m.removedspends = nil
+}
+
+// AddSendApprovalIDs adds the "send_approvals" edge to the SendApproval entity by ids.
+func (m *WalletMutation) AddSendApprovalIDs(ids ...uuid.UUID) {
+	if m.send_approvals == nil {
+		m.send_approvals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.send_approvals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSendApprovals clears the "send_approvals" edge to the SendApproval entity.
+func (m *WalletMutation) ClearSendApprovals() {
+	m.clearedsend_approvals = true
+}
+
+// SendApprovalsCleared reports if the "send_approvals" edge to the SendApproval entity was cleared.
+func (m *WalletMutation) SendApprovalsCleared() bool {
+	return m.clearedsend_approvals
+}
+
+// RemoveSendApprovalIDs removes the "send_approvals" edge to the SendApproval entity by IDs.
+func (m *WalletMutation) RemoveSendApprovalIDs(ids ...uuid.UUID) {
+	if m.removedsend_approvals == nil {
+		m.removedsend_approvals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.send_approvals, ids[i])
+		m.removedsend_approvals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSendApprovals returns the removed IDs of the "send_approvals" edge to the SendApproval entity.
+func (m *WalletMutation) RemovedSendApprovalsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsend_approvals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SendApprovalsIDs returns the "send_approvals" edge IDs in the mutation.
+func (m *WalletMutation) SendApprovalsIDs() (ids []uuid.UUID) {
+	for id := range m.send_approvals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSendApprovals resets all changes to the "send_approvals" edge.
+func (m *WalletMutation) ResetSendApprovals() {
+	m.send_approvals = nil
+	m.clearedsend_approvals = false
+	m.removedsend_approvals = nil
+}
+
+// AddBlockIDs adds the "blocks" edge to the Block entity by ids.
+func (m *WalletMutation) AddBlockIDs(ids ...uuid.UUID) {
+	if m.blocks == nil {
+		m.blocks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.blocks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlocks clears the "blocks" edge to the Block entity.
+func (m *WalletMutation) ClearBlocks() {
+	m.clearedblocks = true
+}
+
+// BlocksCleared reports if the "blocks" edge to the Block entity was cleared.
+func (m *WalletMutation) BlocksCleared() bool {
+	return m.clearedblocks
+}
+
+// RemoveBlockIDs removes the "blocks" edge to the Block entity by IDs.
+func (m *WalletMutation) RemoveBlockIDs(ids ...uuid.UUID) {
+	if m.removedblocks == nil {
+		m.removedblocks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.blocks, ids[i])
+		m.removedblocks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlocks returns the removed IDs of the "blocks" edge to the Block entity.
+func (m *WalletMutation) RemovedBlocksIDs() (ids []uuid.UUID) {
+	for id := range m.removedblocks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlocksIDs returns the "blocks" edge IDs in the mutation.
+func (m *WalletMutation) BlocksIDs() (ids []uuid.UUID) {
+	for id := range m.blocks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlocks resets all changes to the "blocks" edge.
+func (m *WalletMutation) ResetBlocks() {
+	m.blocks = nil
+	m.clearedblocks = false
+	m.removedblocks = nil
+}
+
+// Where appends a list predicates to the WalletMutation builder.
+func (m *WalletMutation) Where(ps ...predicate.Wallet) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *WalletMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Wallet).
+func (m *WalletMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WalletMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.seed != nil {
+		fields = append(fields, wallet.FieldSeed)
+	}
+	if m.representative != nil {
+		fields = append(fields, wallet.FieldRepresentative)
+	}
+	if m.name != nil {
+		fields = append(fields, wallet.FieldName)
+	}
+	if m.encrypted != nil {
+		fields = append(fields, wallet.FieldEncrypted)
+	}
+	if m.work != nil {
+		fields = append(fields, wallet.FieldWork)
+	}
+	if m.watch_only != nil {
+		fields = append(fields, wallet.FieldWatchOnly)
+	}
+	if m.hardware != nil {
+		fields = append(fields, wallet.FieldHardware)
+	}
+	if m.auto_receive != nil {
+		fields = append(fields, wallet.FieldAutoReceive)
+	}
+	if m.receive_minimum != nil {
+		fields = append(fields, wallet.FieldReceiveMinimum)
+	}
+	if m.frozen_at != nil {
+		fields = append(fields, wallet.FieldFrozenAt)
+	}
+	if m.kdf != nil {
+		fields = append(fields, wallet.FieldKdf)
+	}
+	if m.approvals_required != nil {
+		fields = append(fields, wallet.FieldApprovalsRequired)
+	}
+	if m.approval_threshold != nil {
+		fields = append(fields, wallet.FieldApprovalThreshold)
+	}
+	if m.created_at != nil {
+		fields = append(fields, wallet.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WalletMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case wallet.FieldSeed:
+		return m.Seed()
+	case wallet.FieldRepresentative:
+		return m.Representative()
+	case wallet.FieldName:
+		return m.Name()
+	case wallet.FieldEncrypted:
+		return m.Encrypted()
+	case wallet.FieldWork:
+		return m.Work()
+	case wallet.FieldWatchOnly:
+		return m.WatchOnly()
+	case wallet.FieldHardware:
+		return m.Hardware()
+	case wallet.FieldAutoReceive:
+		return m.AutoReceive()
+	case wallet.FieldReceiveMinimum:
+		return m.ReceiveMinimum()
+	case wallet.FieldFrozenAt:
+		return m.FrozenAt()
+	case wallet.FieldKdf:
+		return m.Kdf()
+	case wallet.FieldApprovalsRequired:
+		return m.ApprovalsRequired()
+	case wallet.FieldApprovalThreshold:
+		return m.ApprovalThreshold()
+	case wallet.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WalletMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case wallet.FieldSeed:
+		return m.OldSeed(ctx)
+	case wallet.FieldRepresentative:
+		return m.OldRepresentative(ctx)
+	case wallet.FieldName:
+		return m.OldName(ctx)
+	case wallet.FieldEncrypted:
+		return m.OldEncrypted(ctx)
+	case wallet.FieldWork:
+		return m.OldWork(ctx)
+	case wallet.FieldWatchOnly:
+		return m.OldWatchOnly(ctx)
+	case wallet.FieldHardware:
+		return m.OldHardware(ctx)
+	case wallet.FieldAutoReceive:
+		return m.OldAutoReceive(ctx)
+	case wallet.FieldReceiveMinimum:
+		return m.OldReceiveMinimum(ctx)
+	case wallet.FieldFrozenAt:
+		return m.OldFrozenAt(ctx)
+	case wallet.FieldKdf:
+		return m.OldKdf(ctx)
+	case wallet.FieldApprovalsRequired:
+		return m.OldApprovalsRequired(ctx)
+	case wallet.FieldApprovalThreshold:
+		return m.OldApprovalThreshold(ctx)
+	case wallet.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Wallet field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WalletMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case wallet.FieldSeed:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case wallet.FieldRepresentative:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepresentative(v)
+		return nil
+	case wallet.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case wallet.FieldEncrypted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncrypted(v)
+		return nil
+	case wallet.FieldWork:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWork(v)
+		return nil
+	case wallet.FieldWatchOnly:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWatchOnly(v)
+		return nil
+	case wallet.FieldHardware:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHardware(v)
+		return nil
+	case wallet.FieldAutoReceive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoReceive(v)
+		return nil
+	case wallet.FieldReceiveMinimum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceiveMinimum(v)
+		return nil
+	case wallet.FieldFrozenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrozenAt(v)
+		return nil
+	case wallet.FieldKdf:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKdf(v)
+		return nil
+	case wallet.FieldApprovalsRequired:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovalsRequired(v)
+		return nil
+	case wallet.FieldApprovalThreshold:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovalThreshold(v)
+		return nil
+	case wallet.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Wallet field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WalletMutation) AddedFields() []string {
+	var fields []string
+	if m.addapprovals_required != nil {
+		fields = append(fields, wallet.FieldApprovalsRequired)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WalletMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case wallet.FieldApprovalsRequired:
+		return m.AddedApprovalsRequired()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WalletMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case wallet.FieldApprovalsRequired:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddApprovalsRequired(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Wallet numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WalletMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(wallet.FieldRepresentative) {
+		fields = append(fields, wallet.FieldRepresentative)
+	}
+	if m.FieldCleared(wallet.FieldName) {
+		fields = append(fields, wallet.FieldName)
+	}
+	if m.FieldCleared(wallet.FieldReceiveMinimum) {
+		fields = append(fields, wallet.FieldReceiveMinimum)
+	}
+	if m.FieldCleared(wallet.FieldFrozenAt) {
+		fields = append(fields, wallet.FieldFrozenAt)
+	}
+	if m.FieldCleared(wallet.FieldKdf) {
+		fields = append(fields, wallet.FieldKdf)
+	}
+	if m.FieldCleared(wallet.FieldApprovalsRequired) {
+		fields = append(fields, wallet.FieldApprovalsRequired)
+	}
+	if m.FieldCleared(wallet.FieldApprovalThreshold) {
+		fields = append(fields, wallet.FieldApprovalThreshold)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WalletMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WalletMutation) ClearField(name string) error {
+	switch name {
+	case wallet.FieldRepresentative:
+		m.ClearRepresentative()
+		return nil
+	case wallet.FieldName:
+		m.ClearName()
+		return nil
+	case wallet.FieldReceiveMinimum:
+		m.ClearReceiveMinimum()
+		return nil
+	case wallet.FieldFrozenAt:
+		m.ClearFrozenAt()
+		return nil
+	case wallet.FieldKdf:
+		m.ClearKdf()
+		return nil
+	case wallet.FieldApprovalsRequired:
+		m.ClearApprovalsRequired()
+		return nil
+	case wallet.FieldApprovalThreshold:
+		m.ClearApprovalThreshold()
+		return nil
+	}
+	return fmt.Errorf("unknown Wallet nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WalletMutation) ResetField(name string) error {
+	switch name {
+	case wallet.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case wallet.FieldRepresentative:
+		m.ResetRepresentative()
+		return nil
+	case wallet.FieldName:
+		m.ResetName()
+		return nil
+	case wallet.FieldEncrypted:
+		m.ResetEncrypted()
+		return nil
+	case wallet.FieldWork:
+		m.ResetWork()
+		return nil
+	case wallet.FieldWatchOnly:
+		m.ResetWatchOnly()
+		return nil
+	case wallet.FieldHardware:
+		m.ResetHardware()
+		return nil
+	case wallet.FieldAutoReceive:
+		m.ResetAutoReceive()
+		return nil
+	case wallet.FieldReceiveMinimum:
+		m.ResetReceiveMinimum()
+		return nil
+	case wallet.FieldFrozenAt:
+		m.ResetFrozenAt()
+		return nil
+	case wallet.FieldKdf:
+		m.ResetKdf()
+		return nil
+	case wallet.FieldApprovalsRequired:
+		m.ResetApprovalsRequired()
+		return nil
+	case wallet.FieldApprovalThreshold:
+		m.ResetApprovalThreshold()
+		return nil
+	case wallet.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Wallet field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WalletMutation) AddedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.accounts != nil {
+		edges = append(edges, wallet.EdgeAccounts)
+	}
+	if m.send_schedules != nil {
+		edges = append(edges, wallet.EdgeSendSchedules)
+	}
+	if m.balance_alerts != nil {
+		edges = append(edges, wallet.EdgeBalanceAlerts)
+	}
+	if m.idempotency_keys != nil {
+		edges = append(edges, wallet.EdgeIdempotencyKeys)
+	}
+	if m.idempotent_sends != nil {
+		edges = append(edges, wallet.EdgeIdempotentSends)
+	}
+	if m.wallet_snapshots != nil {
+		edges = append(edges, wallet.EdgeWalletSnapshots)
+	}
+	if m.jobs != nil {
+		edges = append(edges, wallet.EdgeJobs)
+	}
+	if m.spends != nil {
+		edges = append(edges, wallet.EdgeSpends)
+	}
+	if m.send_approvals != nil {
+		edges = append(edges, wallet.EdgeSendApprovals)
+	}
+	if m.blocks != nil {
+		edges = append(edges, wallet.EdgeBlocks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WalletMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case wallet.EdgeAccounts:
+		ids := make([]ent.Value, 0, len(m.accounts))
+		for id := range m.accounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeSendSchedules:
+		ids := make([]ent.Value, 0, len(m.send_schedules))
+		for id := range m.send_schedules {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeBalanceAlerts:
+		ids := make([]ent.Value, 0, len(m.balance_alerts))
+		for id := range m.balance_alerts {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeIdempotencyKeys:
+		ids := make([]ent.Value, 0, len(m.idempotency_keys))
+		for id := range m.idempotency_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeIdempotentSends:
+		ids := make([]ent.Value, 0, len(m.idempotent_sends))
+		for id := range m.idempotent_sends {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeWalletSnapshots:
+		ids := make([]ent.Value, 0, len(m.wallet_snapshots))
+		for id := range m.wallet_snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.jobs))
+		for id := range m.jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeSpends:
+		ids := make([]ent.Value, 0, len(m.spends))
+		for id := range m.spends {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeSendApprovals:
+		ids := make([]ent.Value, 0, len(m.send_approvals))
+		for id := range m.send_approvals {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeBlocks:
+		ids := make([]ent.Value, 0, len(m.blocks))
+		for id := range m.blocks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WalletMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.removedaccounts != nil {
+		edges = append(edges, wallet.EdgeAccounts)
+	}
+	if m.removedsend_schedules != nil {
+		edges = append(edges, wallet.EdgeSendSchedules)
+	}
+	if m.removedbalance_alerts != nil {
+		edges = append(edges, wallet.EdgeBalanceAlerts)
+	}
+	if m.removedidempotency_keys != nil {
+		edges = append(edges, wallet.EdgeIdempotencyKeys)
+	}
+	if m.removedidempotent_sends != nil {
+		edges = append(edges, wallet.EdgeIdempotentSends)
+	}
+	if m.removedwallet_snapshots != nil {
+		edges = append(edges, wallet.EdgeWalletSnapshots)
+	}
+	if m.removedjobs != nil {
+		edges = append(edges, wallet.EdgeJobs)
+	}
+	if m.removedspends != nil {
+		edges = append(edges, wallet.EdgeSpends)
+	}
+	if m.removedsend_approvals != nil {
+		edges = append(edges, wallet.EdgeSendApprovals)
+	}
+	if m.removedblocks != nil {
+		edges = append(edges, wallet.EdgeBlocks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WalletMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case wallet.EdgeAccounts:
+		ids := make([]ent.Value, 0, len(m.removedaccounts))
+		for id := range m.removedaccounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeSendSchedules:
+		ids := make([]ent.Value, 0, len(m.removedsend_schedules))
+		for id := range m.removedsend_schedules {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeBalanceAlerts:
+		ids := make([]ent.Value, 0, len(m.removedbalance_alerts))
+		for id := range m.removedbalance_alerts {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeIdempotencyKeys:
+		ids := make([]ent.Value, 0, len(m.removedidempotency_keys))
+		for id := range m.removedidempotency_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeIdempotentSends:
+		ids := make([]ent.Value, 0, len(m.removedidempotent_sends))
+		for id := range m.removedidempotent_sends {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeWalletSnapshots:
+		ids := make([]ent.Value, 0, len(m.removedwallet_snapshots))
+		for id := range m.removedwallet_snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.removedjobs))
+		for id := range m.removedjobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeSpends:
+		ids := make([]ent.Value, 0, len(m.removedspends))
+		for id := range m.removedspends {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeSendApprovals:
+		ids := make([]ent.Value, 0, len(m.removedsend_approvals))
+		for id := range m.removedsend_approvals {
+			ids = append(ids, id)
+		}
+		return ids
+	case wallet.EdgeBlocks:
+		ids := make([]ent.Value, 0, len(m.removedblocks))
+		for id := range m.removedblocks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WalletMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.clearedaccounts {
+		edges = append(edges, wallet.EdgeAccounts)
+	}
+	if m.clearedsend_schedules {
+		edges = append(edges, wallet.EdgeSendSchedules)
+	}
+	if m.clearedbalance_alerts {
+		edges = append(edges, wallet.EdgeBalanceAlerts)
+	}
+	if m.clearedidempotency_keys {
+		edges = append(edges, wallet.EdgeIdempotencyKeys)
+	}
+	if m.clearedidempotent_sends {
+		edges = append(edges, wallet.EdgeIdempotentSends)
+	}
+	if m.clearedwallet_snapshots {
+		edges = append(edges, wallet.EdgeWalletSnapshots)
+	}
+	if m.clearedjobs {
+		edges = append(edges, wallet.EdgeJobs)
+	}
+	if m.clearedspends {
+		edges = append(edges, wallet.EdgeSpends)
+	}
+	if m.clearedsend_approvals {
+		edges = append(edges, wallet.EdgeSendApprovals)
+	}
+	if m.clearedblocks {
+		edges = append(edges, wallet.EdgeBlocks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WalletMutation) EdgeCleared(name string) bool {
+	switch name {
+	case wallet.EdgeAccounts:
+		return m.clearedaccounts
+	case wallet.EdgeSendSchedules:
+		return m.clearedsend_schedules
+	case wallet.EdgeBalanceAlerts:
+		return m.clearedbalance_alerts
+	case wallet.EdgeIdempotencyKeys:
+		return m.clearedidempotency_keys
+	case wallet.EdgeIdempotentSends:
+		return m.clearedidempotent_sends
+	case wallet.EdgeWalletSnapshots:
+		return m.clearedwallet_snapshots
+	case wallet.EdgeJobs:
+		return m.clearedjobs
+	case wallet.EdgeSpends:
+		return m.clearedspends
+	case wallet.EdgeSendApprovals:
+		return m.clearedsend_approvals
+	case wallet.EdgeBlocks:
+		return m.clearedblocks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WalletMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Wallet unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WalletMutation) ResetEdge(name string) error {
+	switch name {
+	case wallet.EdgeAccounts:
+		m.ResetAccounts()
+		return nil
+	case wallet.EdgeSendSchedules:
+		m.ResetSendSchedules()
+		return nil
+	case wallet.EdgeBalanceAlerts:
+		m.ResetBalanceAlerts()
+		return nil
+	case wallet.EdgeIdempotencyKeys:
+		m.ResetIdempotencyKeys()
+		return nil
+	case wallet.EdgeIdempotentSends:
+		m.ResetIdempotentSends()
+		return nil
+	case wallet.EdgeWalletSnapshots:
+		m.ResetWalletSnapshots()
+		return nil
+	case wallet.EdgeJobs:
+		m.ResetJobs()
+		return nil
+	case wallet.EdgeSpends:
+		m.ResetSpends()
+		return nil
+	case wallet.EdgeSendApprovals:
+		m.ResetSendApprovals()
+		return nil
+	case wallet.EdgeBlocks:
+		m.ResetBlocks()
+		return nil
+	}
+	return fmt.Errorf("unknown Wallet edge %s", name)
+}
+
+// WalletSnapshotMutation represents an operation that mutates the WalletSnapshot nodes in the graph.
+type WalletSnapshotMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	label            *string
+	total_raw        *string
+	account_count    *int
+	addaccount_count *int
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	wallet           *uuid.UUID
+	clearedwallet    bool
+	balances         map[uuid.UUID]struct{}
+	removedbalances  map[uuid.UUID]struct{}
+	clearedbalances  bool
+	done             bool
+	oldValue         func(context.Context) (*WalletSnapshot, error)
+	predicates       []predicate.WalletSnapshot
+}
+
+var _ ent.Mutation = (*WalletSnapshotMutation)(nil)
+
+// walletsnapshotOption allows management of the mutation configuration using functional options.
+type walletsnapshotOption func(*WalletSnapshotMutation)
+
+// newWalletSnapshotMutation creates new mutation for the WalletSnapshot entity.
+func newWalletSnapshotMutation(c config, op Op, opts ...walletsnapshotOption) *WalletSnapshotMutation {
+	m := &WalletSnapshotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWalletSnapshot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWalletSnapshotID sets the ID field of the mutation.
+func withWalletSnapshotID(id uuid.UUID) walletsnapshotOption {
+	return func(m *WalletSnapshotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WalletSnapshot
+		)
+		m.oldValue = func(ctx context.Context) (*WalletSnapshot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WalletSnapshot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWalletSnapshot sets the old WalletSnapshot of the mutation.
+func withWalletSnapshot(node *WalletSnapshot) walletsnapshotOption {
+	return func(m *WalletSnapshotMutation) {
+		m.oldValue = func(context.Context) (*WalletSnapshot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WalletSnapshotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WalletSnapshotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WalletSnapshot entities.
+func (m *WalletSnapshotMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WalletSnapshotMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WalletSnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WalletSnapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (m *WalletSnapshotMutation) SetWalletID(u uuid.UUID) {
+	m.wallet = &u
+}
+
+// WalletID returns the value of the "wallet_id" field in the mutation.
+func (m *WalletSnapshotMutation) WalletID() (r uuid.UUID, exists bool) {
+	v := m.wallet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalletID returns the old "wallet_id" field's value of the WalletSnapshot entity.
+// If the WalletSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletSnapshotMutation) OldWalletID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletID: %w", err)
+	}
+	return oldValue.WalletID, nil
+}
+
+// ResetWalletID resets all changes to the "wallet_id" field.
+func (m *WalletSnapshotMutation) ResetWalletID() {
+	m.wallet = nil
+}
+
+// SetLabel sets the "label" field.
+func (m *WalletSnapshotMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *WalletSnapshotMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the WalletSnapshot entity.
+// If the WalletSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletSnapshotMutation) OldLabel(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ClearLabel clears the value of the "label" field.
+func (m *WalletSnapshotMutation) ClearLabel() {
+	m.label = nil
+	m.clearedFields[walletsnapshot.FieldLabel] = struct{}{}
+}
+
+// LabelCleared returns if the "label" field was cleared in this mutation.
+func (m *WalletSnapshotMutation) LabelCleared() bool {
+	_, ok := m.clearedFields[walletsnapshot.FieldLabel]
+	return ok
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *WalletSnapshotMutation) ResetLabel() {
+	m.label = nil
+	delete(m.clearedFields, walletsnapshot.FieldLabel)
+}
+
+// SetTotalRaw sets the "total_raw" field.
+func (m *WalletSnapshotMutation) SetTotalRaw(s string) {
+	m.total_raw = &s
+}
+
+// TotalRaw returns the value of the "total_raw" field in the mutation.
+func (m *WalletSnapshotMutation) TotalRaw() (r string, exists bool) {
+	v := m.total_raw
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalRaw returns the old "total_raw" field's value of the WalletSnapshot entity.
+// If the WalletSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletSnapshotMutation) OldTotalRaw(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalRaw is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalRaw requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalRaw: %w", err)
+	}
+	return oldValue.TotalRaw, nil
+}
+
+// ResetTotalRaw resets all changes to the "total_raw" field.
+func (m *WalletSnapshotMutation) ResetTotalRaw() {
+	m.total_raw = nil
+}
+
+// SetAccountCount sets the "account_count" field.
+func (m *WalletSnapshotMutation) SetAccountCount(i int) {
+	m.account_count = &i
+	m.addaccount_count = nil
+}
+
+// AccountCount returns the value of the "account_count" field in the mutation.
+func (m *WalletSnapshotMutation) AccountCount() (r int, exists bool) {
+	v := m.account_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountCount returns the old "account_count" field's value of the WalletSnapshot entity.
+// If the WalletSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletSnapshotMutation) OldAccountCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountCount: %w", err)
+	}
+	return oldValue.AccountCount, nil
+}
+
+// AddAccountCount adds i to the "account_count" field.
+func (m *WalletSnapshotMutation) AddAccountCount(i int) {
+	if m.addaccount_count != nil {
+		*m.addaccount_count += i
+	} else {
+		m.addaccount_count = &i
+	}
+}
+
+// AddedAccountCount returns the value that was added to the "account_count" field in this mutation.
+func (m *WalletSnapshotMutation) AddedAccountCount() (r int, exists bool) {
+	v := m.addaccount_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAccountCount resets all changes to the "account_count" field.
+func (m *WalletSnapshotMutation) ResetAccountCount() {
+	m.account_count = nil
+	m.addaccount_count = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WalletSnapshotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WalletSnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WalletSnapshot entity.
+// If the WalletSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletSnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WalletSnapshotMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (m *WalletSnapshotMutation) ClearWallet() {
+	m.clearedwallet = true
+}
+
+// WalletCleared reports if the "wallet" edge to the Wallet entity was cleared.
+func (m *WalletSnapshotMutation) WalletCleared() bool {
+	return m.clearedwallet
+}
+
+// WalletIDs returns the "wallet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WalletID instead. It exists only for internal usage by the builders.
+func (m *WalletSnapshotMutation) WalletIDs() (ids []uuid.UUID) {
+	if id := m.wallet; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWallet resets all changes to the "wallet" edge.
+func (m *WalletSnapshotMutation) ResetWallet() {
+	m.wallet = nil
+	m.clearedwallet = false
+}
+
+// AddBalanceIDs adds the "balances" edge to the BalanceSnapshot entity by ids.
+func (m *WalletSnapshotMutation) AddBalanceIDs(ids ...uuid.UUID) {
+	if m.balances == nil {
+		m.balances = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.balances[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBalances clears the "balances" edge to the BalanceSnapshot entity.
+func (m *WalletSnapshotMutation) ClearBalances() {
+	m.clearedbalances = true
+}
+
+// BalancesCleared reports if the "balances" edge to the BalanceSnapshot entity was cleared.
+func (m *WalletSnapshotMutation) BalancesCleared() bool {
+	return m.clearedbalances
+}
+
+// RemoveBalanceIDs removes the "balances" edge to the BalanceSnapshot entity by IDs.
+func (m *WalletSnapshotMutation) RemoveBalanceIDs(ids ...uuid.UUID) {
+	if m.removedbalances == nil {
+		m.removedbalances = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.balances, ids[i])
+		m.removedbalances[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBalances returns the removed IDs of the "balances" edge to the BalanceSnapshot entity.
+func (m *WalletSnapshotMutation) RemovedBalancesIDs() (ids []uuid.UUID) {
+	for id := range m.removedbalances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BalancesIDs returns the "balances" edge IDs in the mutation.
+func (m *WalletSnapshotMutation) BalancesIDs() (ids []uuid.UUID) {
+	for id := range m.balances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBalances resets all changes to the "balances" edge.
+func (m *WalletSnapshotMutation) ResetBalances() {
+	m.balances = nil
+	m.clearedbalances = false
+	m.removedbalances = nil
+}
+
+// Where appends a list predicates to the WalletSnapshotMutation builder.
+func (m *WalletSnapshotMutation) Where(ps ...predicate.WalletSnapshot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *WalletSnapshotMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (WalletSnapshot).
+func (m *WalletSnapshotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WalletSnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.wallet != nil {
+		fields = append(fields, walletsnapshot.FieldWalletID)
+	}
+	if m.label != nil {
+		fields = append(fields, walletsnapshot.FieldLabel)
+	}
+	if m.total_raw != nil {
+		fields = append(fields, walletsnapshot.FieldTotalRaw)
+	}
+	if m.account_count != nil {
+		fields = append(fields, walletsnapshot.FieldAccountCount)
+	}
+	if m.created_at != nil {
+		fields = append(fields, walletsnapshot.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WalletSnapshotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case walletsnapshot.FieldWalletID:
+		return m.WalletID()
+	case walletsnapshot.FieldLabel:
+		return m.Label()
+	case walletsnapshot.FieldTotalRaw:
+		return m.TotalRaw()
+	case walletsnapshot.FieldAccountCount:
+		return m.AccountCount()
+	case walletsnapshot.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WalletSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case walletsnapshot.FieldWalletID:
+		return m.OldWalletID(ctx)
+	case walletsnapshot.FieldLabel:
+		return m.OldLabel(ctx)
+	case walletsnapshot.FieldTotalRaw:
+		return m.OldTotalRaw(ctx)
+	case walletsnapshot.FieldAccountCount:
+		return m.OldAccountCount(ctx)
+	case walletsnapshot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WalletSnapshot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WalletSnapshotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case walletsnapshot.FieldWalletID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalletID(v)
+		return nil
+	case walletsnapshot.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case walletsnapshot.FieldTotalRaw:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalRaw(v)
+		return nil
+	case walletsnapshot.FieldAccountCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountCount(v)
+		return nil
+	case walletsnapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WalletSnapshot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WalletSnapshotMutation) AddedFields() []string {
+	var fields []string
+	if m.addaccount_count != nil {
+		fields = append(fields, walletsnapshot.FieldAccountCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WalletSnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case walletsnapshot.FieldAccountCount:
+		return m.AddedAccountCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WalletSnapshotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case walletsnapshot.FieldAccountCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAccountCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WalletSnapshot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WalletSnapshotMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(walletsnapshot.FieldLabel) {
+		fields = append(fields, walletsnapshot.FieldLabel)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WalletSnapshotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WalletSnapshotMutation) ClearField(name string) error {
+	switch name {
+	case walletsnapshot.FieldLabel:
+		m.ClearLabel()
+		return nil
+	}
+	return fmt.Errorf("unknown WalletSnapshot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WalletSnapshotMutation) ResetField(name string) error {
+	switch name {
+	case walletsnapshot.FieldWalletID:
+		m.ResetWalletID()
+		return nil
+	case walletsnapshot.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case walletsnapshot.FieldTotalRaw:
+		m.ResetTotalRaw()
+		return nil
+	case walletsnapshot.FieldAccountCount:
+		m.ResetAccountCount()
+		return nil
+	case walletsnapshot.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WalletSnapshot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WalletSnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.wallet != nil {
+		edges = append(edges, walletsnapshot.EdgeWallet)
+	}
+	if m.balances != nil {
+		edges = append(edges, walletsnapshot.EdgeBalances)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WalletSnapshotMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case walletsnapshot.EdgeWallet:
+		if id := m.wallet; id != nil {
+			return []ent.Value{*id}
+		}
+	case walletsnapshot.EdgeBalances:
+		ids := make([]ent.Value, 0, len(m.balances))
+		for id := range m.balances {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WalletSnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedbalances != nil {
+		edges = append(edges, walletsnapshot.EdgeBalances)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WalletSnapshotMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case walletsnapshot.EdgeBalances:
+		ids := make([]ent.Value, 0, len(m.removedbalances))
+		for id := range m.removedbalances {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WalletSnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedwallet {
+		edges = append(edges, walletsnapshot.EdgeWallet)
+	}
+	if m.clearedbalances {
+		edges = append(edges, walletsnapshot.EdgeBalances)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WalletSnapshotMutation) EdgeCleared(name string) bool {
+	switch name {
+	case walletsnapshot.EdgeWallet:
+		return m.clearedwallet
+	case walletsnapshot.EdgeBalances:
+		return m.clearedbalances
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WalletSnapshotMutation) ClearEdge(name string) error {
+	switch name {
+	case walletsnapshot.EdgeWallet:
+		m.ClearWallet()
+		return nil
+	}
+	return fmt.Errorf("unknown WalletSnapshot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WalletSnapshotMutation) ResetEdge(name string) error {
+	switch name {
+	case walletsnapshot.EdgeWallet:
+		m.ResetWallet()
+		return nil
+	case walletsnapshot.EdgeBalances:
+		m.ResetBalances()
+		return nil
+	}
+	return fmt.Errorf("unknown WalletSnapshot edge %s", name)
+}
+
+// WalletSpendMutation represents an operation that mutates the WalletSpend nodes in the graph.
+type WalletSpendMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	amount        *string
+	block_hash    *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	wallet        *uuid.UUID
+	clearedwallet bool
+	done          bool
+	oldValue      func(context.Context) (*WalletSpend, error)
+	predicates    []predicate.WalletSpend
+}
+
+var _ ent.Mutation = (*WalletSpendMutation)(nil)
+
+// walletspendOption allows management of the mutation configuration using functional options.
+type walletspendOption func(*WalletSpendMutation)
+
+// newWalletSpendMutation creates new mutation for the WalletSpend entity.
+func newWalletSpendMutation(c config, op Op, opts ...walletspendOption) *WalletSpendMutation {
+	m := &WalletSpendMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWalletSpend,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWalletSpendID sets the ID field of the mutation.
+func withWalletSpendID(id uuid.UUID) walletspendOption {
+	return func(m *WalletSpendMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WalletSpend
+		)
+		m.oldValue = func(ctx context.Context) (*WalletSpend, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WalletSpend.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWalletSpend sets the old WalletSpend of the mutation.
+func withWalletSpend(node *WalletSpend) walletspendOption {
+	return func(m *WalletSpendMutation) {
+		m.oldValue = func(context.Context) (*WalletSpend, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WalletSpendMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WalletSpendMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WalletSpend entities.
+func (m *WalletSpendMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WalletSpendMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WalletSpendMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WalletSpend.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (m *WalletSpendMutation) SetWalletID(u uuid.UUID) {
+	m.wallet = &u
+}
+
+// WalletID returns the value of the "wallet_id" field in the mutation.
+func (m *WalletSpendMutation) WalletID() (r uuid.UUID, exists bool) {
+	v := m.wallet
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalletID returns the old "wallet_id" field's value of the WalletSpend entity.
+// If the WalletSpend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletSpendMutation) OldWalletID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletID: %w", err)
+	}
+	return oldValue.WalletID, nil
+}
+
+// ResetWalletID resets all changes to the "wallet_id" field.
+func (m *WalletSpendMutation) ResetWalletID() {
+	m.wallet = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *WalletSpendMutation) SetAmount(s string) {
+	m.amount = &s
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *WalletSpendMutation) Amount() (r string, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearAccounts clears the "accounts" edge to the Account entity.
-func (m *WalletMutation) ClearAccounts() {
-	m.clearedaccounts = true
+// OldAmount returns the old "amount" field's value of the WalletSpend entity.
+// If the WalletSpend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletSpendMutation) OldAmount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
 }
 
-// AccountsCleared reports if the "accounts" edge to the Account entity was cleared.
-func (m *WalletMutation) AccountsCleared() bool {
-	return m.clearedaccounts
+// ResetAmount resets all changes to the "amount" field.
+func (m *WalletSpendMutation) ResetAmount() {
+	m.amount = nil
 }
 
-// RemoveAccountIDs removes the "accounts" edge to the Account entity by IDs.
-func (m *WalletMutation) RemoveAccountIDs(ids ...uuid.UUID) {
-	if m.removedaccounts == nil {
-		m.removedaccounts = make(map[uuid.UUID]struct{})
+// SetBlockHash sets the "block_hash" field.
+func (m *WalletSpendMutation) SetBlockHash(s string) {
+	m.block_hash = &s
+}
+
+// BlockHash returns the value of the "block_hash" field in the mutation.
+func (m *WalletSpendMutation) BlockHash() (r string, exists bool) {
+	v := m.block_hash
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.accounts, ids[i])
-		m.removedaccounts[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldBlockHash returns the old "block_hash" field's value of the WalletSpend entity.
+// If the WalletSpend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletSpendMutation) OldBlockHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHash: %w", err)
 	}
+	return oldValue.BlockHash, nil
 }
 
-// RemovedAccountsIDs returns the removed IDs of the "accounts" edge to the Account entity.
-func (m *WalletMutation) RemovedAccountsIDs() (ids []uuid.UUID) {
-	for id := range m.removedaccounts {
-		ids = append(ids, id)
+// ResetBlockHash resets all changes to the "block_hash" field.
+func (m *WalletSpendMutation) ResetBlockHash() {
+	m.block_hash = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WalletSpendMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WalletSpendMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// AccountsIDs returns the "accounts" edge IDs in the mutation.
-func (m *WalletMutation) AccountsIDs() (ids []uuid.UUID) {
-	for id := range m.accounts {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the WalletSpend entity.
+// If the WalletSpend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WalletSpendMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WalletSpendMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (m *WalletSpendMutation) ClearWallet() {
+	m.clearedwallet = true
+}
+
+// WalletCleared reports if the "wallet" edge to the Wallet entity was cleared.
+func (m *WalletSpendMutation) WalletCleared() bool {
+	return m.clearedwallet
+}
+
+// WalletIDs returns the "wallet" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WalletID instead. It exists only for internal usage by the builders.
+func (m *WalletSpendMutation) WalletIDs() (ids []uuid.UUID) {
+	if id := m.wallet; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAccounts resets all changes to the "accounts" edge.
-func (m *WalletMutation) ResetAccounts() {
-	m.accounts = nil
-	m.clearedaccounts = false
-	m.removedaccounts = nil
+// ResetWallet resets all changes to the "wallet" edge.
+func (m *WalletSpendMutation) ResetWallet() {
+	m.wallet = nil
+	m.clearedwallet = false
 }
 
-// Where appends a list predicates to the WalletMutation builder.
-func (m *WalletMutation) Where(ps ...predicate.Wallet) {
+// Where appends a list predicates to the WalletSpendMutation builder.
+func (m *WalletSpendMutation) Where(ps ...predicate.WalletSpend) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *WalletMutation) Op() Op {
+func (m *WalletSpendMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Wallet).
-func (m *WalletMutation) Type() string {
+// Type returns the node type of this mutation (WalletSpend).
+func (m *WalletSpendMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WalletMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.seed != nil {
-		fields = append(fields, wallet.FieldSeed)
-	}
-	if m.representative != nil {
-		fields = append(fields, wallet.FieldRepresentative)
+func (m *WalletSpendMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.wallet != nil {
+		fields = append(fields, walletspend.FieldWalletID)
 	}
-	if m.encrypted != nil {
-		fields = append(fields, wallet.FieldEncrypted)
+	if m.amount != nil {
+		fields = append(fields, walletspend.FieldAmount)
 	}
-	if m.work != nil {
-		fields = append(fields, wallet.FieldWork)
+	if m.block_hash != nil {
+		fields = append(fields, walletspend.FieldBlockHash)
 	}
 	if m.created_at != nil {
-		fields = append(fields, wallet.FieldCreatedAt)
+		fields = append(fields, walletspend.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1930,17 +12787,15 @@ func (m *WalletMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WalletMutation) Field(name string) (ent.Value, bool) {
+func (m *WalletSpendMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case wallet.FieldSeed:
-		return m.Seed()
-	case wallet.FieldRepresentative:
-		return m.Representative()
-	case wallet.FieldEncrypted:
-		return m.Encrypted()
-	case wallet.FieldWork:
-		return m.Work()
-	case wallet.FieldCreatedAt:
+	case walletspend.FieldWalletID:
+		return m.WalletID()
+	case walletspend.FieldAmount:
+		return m.Amount()
+	case walletspend.FieldBlockHash:
+		return m.BlockHash()
+	case walletspend.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -1949,56 +12804,47 @@ func (m *WalletMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WalletMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WalletSpendMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case wallet.FieldSeed:
-		return m.OldSeed(ctx)
-	case wallet.FieldRepresentative:
-		return m.OldRepresentative(ctx)
-	case wallet.FieldEncrypted:
-		return m.OldEncrypted(ctx)
-	case wallet.FieldWork:
-		return m.OldWork(ctx)
-	case wallet.FieldCreatedAt:
+	case walletspend.FieldWalletID:
+		return m.OldWalletID(ctx)
+	case walletspend.FieldAmount:
+		return m.OldAmount(ctx)
+	case walletspend.FieldBlockHash:
+		return m.OldBlockHash(ctx)
+	case walletspend.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Wallet field %s", name)
+	return nil, fmt.Errorf("unknown WalletSpend field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WalletMutation) SetField(name string, value ent.Value) error {
+func (m *WalletSpendMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case wallet.FieldSeed:
-		v, ok := value.(string)
+	case walletspend.FieldWalletID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSeed(v)
+		m.SetWalletID(v)
 		return nil
-	case wallet.FieldRepresentative:
+	case walletspend.FieldAmount:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRepresentative(v)
-		return nil
-	case wallet.FieldEncrypted:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEncrypted(v)
+		m.SetAmount(v)
 		return nil
-	case wallet.FieldWork:
-		v, ok := value.(bool)
+	case walletspend.FieldBlockHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWork(v)
+		m.SetBlockHash(v)
 		return nil
-	case wallet.FieldCreatedAt:
+	case walletspend.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2006,162 +12852,142 @@ func (m *WalletMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Wallet field %s", name)
+	return fmt.Errorf("unknown WalletSpend field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WalletMutation) AddedFields() []string {
+func (m *WalletSpendMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WalletMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WalletSpendMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WalletMutation) AddField(name string, value ent.Value) error {
+func (m *WalletSpendMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Wallet numeric field %s", name)
+	return fmt.Errorf("unknown WalletSpend numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WalletMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(wallet.FieldRepresentative) {
-		fields = append(fields, wallet.FieldRepresentative)
-	}
-	return fields
+func (m *WalletSpendMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WalletMutation) FieldCleared(name string) bool {
+func (m *WalletSpendMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WalletMutation) ClearField(name string) error {
-	switch name {
-	case wallet.FieldRepresentative:
-		m.ClearRepresentative()
-		return nil
-	}
-	return fmt.Errorf("unknown Wallet nullable field %s", name)
+func (m *WalletSpendMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WalletSpend nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WalletMutation) ResetField(name string) error {
+func (m *WalletSpendMutation) ResetField(name string) error {
 	switch name {
-	case wallet.FieldSeed:
-		m.ResetSeed()
-		return nil
-	case wallet.FieldRepresentative:
-		m.ResetRepresentative()
+	case walletspend.FieldWalletID:
+		m.ResetWalletID()
 		return nil
-	case wallet.FieldEncrypted:
-		m.ResetEncrypted()
+	case walletspend.FieldAmount:
+		m.ResetAmount()
 		return nil
-	case wallet.FieldWork:
-		m.ResetWork()
+	case walletspend.FieldBlockHash:
+		m.ResetBlockHash()
 		return nil
-	case wallet.FieldCreatedAt:
+	case walletspend.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Wallet field %s", name)
+	return fmt.Errorf("unknown WalletSpend field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WalletMutation) AddedEdges() []string {
+func (m *WalletSpendMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.accounts != nil {
-		edges = append(edges, wallet.EdgeAccounts)
+	if m.wallet != nil {
+		edges = append(edges, walletspend.EdgeWallet)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WalletMutation) AddedIDs(name string) []ent.Value {
+func (m *WalletSpendMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case wallet.EdgeAccounts:
-		ids := make([]ent.Value, 0, len(m.accounts))
-		for id := range m.accounts {
-			ids = append(ids, id)
+	case walletspend.EdgeWallet:
+		if id := m.wallet; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WalletMutation) RemovedEdges() []string {
+func (m *WalletSpendMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedaccounts != nil {
-		edges = append(edges, wallet.EdgeAccounts)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WalletMutation) RemovedIDs(name string) []ent.Value {
+func (m *WalletSpendMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case wallet.EdgeAccounts:
-		ids := make([]ent.Value, 0, len(m.removedaccounts))
-		for id := range m.removedaccounts {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WalletMutation) ClearedEdges() []string {
+func (m *WalletSpendMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedaccounts {
-		edges = append(edges, wallet.EdgeAccounts)
+	if m.clearedwallet {
+		edges = append(edges, walletspend.EdgeWallet)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WalletMutation) EdgeCleared(name string) bool {
+func (m *WalletSpendMutation) EdgeCleared(name string) bool {
 	switch name {
-	case wallet.EdgeAccounts:
-		return m.clearedaccounts
+	case walletspend.EdgeWallet:
+		return m.clearedwallet
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WalletMutation) ClearEdge(name string) error {
+func (m *WalletSpendMutation) ClearEdge(name string) error {
 	switch name {
+	case walletspend.EdgeWallet:
+		m.ClearWallet()
+		return nil
 	}
-	return fmt.Errorf("unknown Wallet unique edge %s", name)
+	return fmt.Errorf("unknown WalletSpend unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WalletMutation) ResetEdge(name string) error {
+func (m *WalletSpendMutation) ResetEdge(name string) error {
 	switch name {
-	case wallet.EdgeAccounts:
-		m.ResetAccounts()
+	case walletspend.EdgeWallet:
+		m.ResetWallet()
 		return nil
 	}
-	return fmt.Errorf("unknown Wallet edge %s", name)
+	return fmt.Errorf("unknown WalletSpend edge %s", name)
 }
@@ -9,8 +9,44 @@ import (
 // Account is the predicate function for account builders.
 type Account func(*sql.Selector)
 
+// ApiKey is the predicate function for apikey builders.
+type ApiKey func(*sql.Selector)
+
+// AuditRecord is the predicate function for auditrecord builders.
+type AuditRecord func(*sql.Selector)
+
+// BalanceAlert is the predicate function for balancealert builders.
+type BalanceAlert func(*sql.Selector)
+
+// BalanceSnapshot is the predicate function for balancesnapshot builders.
+type BalanceSnapshot func(*sql.Selector)
+
 // Block is the predicate function for block builders.
 type Block func(*sql.Selector)
 
+// IdempotencyKey is the predicate function for idempotencykey builders.
+type IdempotencyKey func(*sql.Selector)
+
+// IdempotentSend is the predicate function for idempotentsend builders.
+type IdempotentSend func(*sql.Selector)
+
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
+// RepresentativeHistory is the predicate function for representativehistory builders.
+type RepresentativeHistory func(*sql.Selector)
+
+// SendApproval is the predicate function for sendapproval builders.
+type SendApproval func(*sql.Selector)
+
+// SendSchedule is the predicate function for sendschedule builders.
+type SendSchedule func(*sql.Selector)
+
 // Wallet is the predicate function for wallet builders.
 type Wallet func(*sql.Selector)
+
+// WalletSnapshot is the predicate function for walletsnapshot builders.
+type WalletSnapshot func(*sql.Selector)
+
+// WalletSpend is the predicate function for walletspend builders.
+type WalletSpend func(*sql.Selector)
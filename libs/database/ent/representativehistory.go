@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
+	"github.com/google/uuid"
+)
+
+// RepresentativeHistory is the model entity for the RepresentativeHistory schema.
+type RepresentativeHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID uuid.UUID `json:"account_id,omitempty"`
+	// OldRepresentative holds the value of the "old_representative" field.
+	OldRepresentative string `json:"old_representative,omitempty"`
+	// NewRepresentative holds the value of the "new_representative" field.
+	NewRepresentative string `json:"new_representative,omitempty"`
+	// BlockHash holds the value of the "block_hash" field.
+	BlockHash string `json:"block_hash,omitempty"`
+	// ChangedAt holds the value of the "changed_at" field.
+	ChangedAt time.Time `json:"changed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RepresentativeHistoryQuery when eager-loading is set.
+	Edges RepresentativeHistoryEdges `json:"edges"`
+}
+
+// RepresentativeHistoryEdges holds the relations/edges for other nodes in the graph.
+type RepresentativeHistoryEdges struct {
+	// Account holds the value of the account edge.
+	Account *Account `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RepresentativeHistoryEdges) AccountOrErr() (*Account, error) {
+	if e.loadedTypes[0] {
+		if e.Account == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: account.Label}
+		}
+		return e.Account, nil
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RepresentativeHistory) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case representativehistory.FieldOldRepresentative, representativehistory.FieldNewRepresentative, representativehistory.FieldBlockHash:
+			values[i] = new(sql.NullString)
+		case representativehistory.FieldChangedAt:
+			values[i] = new(sql.NullTime)
+		case representativehistory.FieldID, representativehistory.FieldAccountID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type RepresentativeHistory", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RepresentativeHistory fields.
+func (rh *RepresentativeHistory) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case representativehistory.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rh.ID = *value
+			}
+		case representativehistory.FieldAccountID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				rh.AccountID = *value
+			}
+		case representativehistory.FieldOldRepresentative:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field old_representative", values[i])
+			} else if value.Valid {
+				rh.OldRepresentative = value.String
+			}
+		case representativehistory.FieldNewRepresentative:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_representative", values[i])
+			} else if value.Valid {
+				rh.NewRepresentative = value.String
+			}
+		case representativehistory.FieldBlockHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field block_hash", values[i])
+			} else if value.Valid {
+				rh.BlockHash = value.String
+			}
+		case representativehistory.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				rh.ChangedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryAccount queries the "account" edge of the RepresentativeHistory entity.
+func (rh *RepresentativeHistory) QueryAccount() *AccountQuery {
+	return (&RepresentativeHistoryClient{config: rh.config}).QueryAccount(rh)
+}
+
+// Update returns a builder for updating this RepresentativeHistory.
+// Note that you need to call RepresentativeHistory.Unwrap() before calling this method if this RepresentativeHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rh *RepresentativeHistory) Update() *RepresentativeHistoryUpdateOne {
+	return (&RepresentativeHistoryClient{config: rh.config}).UpdateOne(rh)
+}
+
+// Unwrap unwraps the RepresentativeHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rh *RepresentativeHistory) Unwrap() *RepresentativeHistory {
+	_tx, ok := rh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RepresentativeHistory is not a transactional entity")
+	}
+	rh.config.driver = _tx.drv
+	return rh
+}
+
+// String implements the fmt.Stringer.
+func (rh *RepresentativeHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("RepresentativeHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rh.ID))
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", rh.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("old_representative=")
+	builder.WriteString(rh.OldRepresentative)
+	builder.WriteString(", ")
+	builder.WriteString("new_representative=")
+	builder.WriteString(rh.NewRepresentative)
+	builder.WriteString(", ")
+	builder.WriteString("block_hash=")
+	builder.WriteString(rh.BlockHash)
+	builder.WriteString(", ")
+	builder.WriteString("changed_at=")
+	builder.WriteString(rh.ChangedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RepresentativeHistories is a parsable slice of RepresentativeHistory.
+type RepresentativeHistories []*RepresentativeHistory
+
+func (rh RepresentativeHistories) config(cfg config) {
+	for _i := range rh {
+		rh[_i].config = cfg
+	}
+}
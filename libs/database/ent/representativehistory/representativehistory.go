@@ -0,0 +1,70 @@
+// Code generated by ent, DO NOT EDIT.
+
+package representativehistory
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the representativehistory type in the database.
+	Label = "representative_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldOldRepresentative holds the string denoting the old_representative field in the database.
+	FieldOldRepresentative = "old_representative"
+	// FieldNewRepresentative holds the string denoting the new_representative field in the database.
+	FieldNewRepresentative = "new_representative"
+	// FieldBlockHash holds the string denoting the block_hash field in the database.
+	FieldBlockHash = "block_hash"
+	// FieldChangedAt holds the string denoting the changed_at field in the database.
+	FieldChangedAt = "changed_at"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// Table holds the table name of the representativehistory in the database.
+	Table = "representative_history"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "representative_history"
+	// AccountInverseTable is the table name for the Account entity.
+	// It exists in this package in order to avoid circular dependency with the "account" package.
+	AccountInverseTable = "accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+)
+
+// Columns holds all SQL columns for representativehistory fields.
+var Columns = []string{
+	FieldID,
+	FieldAccountID,
+	FieldOldRepresentative,
+	FieldNewRepresentative,
+	FieldBlockHash,
+	FieldChangedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// OldRepresentativeValidator is a validator for the "old_representative" field. It is called by the builders before save.
+	OldRepresentativeValidator func(string) error
+	// NewRepresentativeValidator is a validator for the "new_representative" field. It is called by the builders before save.
+	NewRepresentativeValidator func(string) error
+	// BlockHashValidator is a validator for the "block_hash" field. It is called by the builders before save.
+	BlockHashValidator func(string) error
+	// DefaultChangedAt holds the default value on creation for the "changed_at" field.
+	DefaultChangedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
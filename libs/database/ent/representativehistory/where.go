@@ -0,0 +1,575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package representativehistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v uuid.UUID) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAccountID), v))
+	})
+}
+
+// OldRepresentative applies equality check predicate on the "old_representative" field. It's identical to OldRepresentativeEQ.
+func OldRepresentative(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOldRepresentative), v))
+	})
+}
+
+// NewRepresentative applies equality check predicate on the "new_representative" field. It's identical to NewRepresentativeEQ.
+func NewRepresentative(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNewRepresentative), v))
+	})
+}
+
+// BlockHash applies equality check predicate on the "block_hash" field. It's identical to BlockHashEQ.
+func BlockHash(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBlockHash), v))
+	})
+}
+
+// ChangedAt applies equality check predicate on the "changed_at" field. It's identical to ChangedAtEQ.
+func ChangedAt(v time.Time) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldChangedAt), v))
+	})
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v uuid.UUID) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAccountID), v))
+	})
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v uuid.UUID) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAccountID), v))
+	})
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...uuid.UUID) predicate.RepresentativeHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAccountID), v...))
+	})
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...uuid.UUID) predicate.RepresentativeHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAccountID), v...))
+	})
+}
+
+// OldRepresentativeEQ applies the EQ predicate on the "old_representative" field.
+func OldRepresentativeEQ(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOldRepresentative), v))
+	})
+}
+
+// OldRepresentativeNEQ applies the NEQ predicate on the "old_representative" field.
+func OldRepresentativeNEQ(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOldRepresentative), v))
+	})
+}
+
+// OldRepresentativeIn applies the In predicate on the "old_representative" field.
+func OldRepresentativeIn(vs ...string) predicate.RepresentativeHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldOldRepresentative), v...))
+	})
+}
+
+// OldRepresentativeNotIn applies the NotIn predicate on the "old_representative" field.
+func OldRepresentativeNotIn(vs ...string) predicate.RepresentativeHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldOldRepresentative), v...))
+	})
+}
+
+// OldRepresentativeGT applies the GT predicate on the "old_representative" field.
+func OldRepresentativeGT(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOldRepresentative), v))
+	})
+}
+
+// OldRepresentativeGTE applies the GTE predicate on the "old_representative" field.
+func OldRepresentativeGTE(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOldRepresentative), v))
+	})
+}
+
+// OldRepresentativeLT applies the LT predicate on the "old_representative" field.
+func OldRepresentativeLT(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOldRepresentative), v))
+	})
+}
+
+// OldRepresentativeLTE applies the LTE predicate on the "old_representative" field.
+func OldRepresentativeLTE(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOldRepresentative), v))
+	})
+}
+
+// OldRepresentativeContains applies the Contains predicate on the "old_representative" field.
+func OldRepresentativeContains(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldOldRepresentative), v))
+	})
+}
+
+// OldRepresentativeHasPrefix applies the HasPrefix predicate on the "old_representative" field.
+func OldRepresentativeHasPrefix(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldOldRepresentative), v))
+	})
+}
+
+// OldRepresentativeHasSuffix applies the HasSuffix predicate on the "old_representative" field.
+func OldRepresentativeHasSuffix(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldOldRepresentative), v))
+	})
+}
+
+// OldRepresentativeEqualFold applies the EqualFold predicate on the "old_representative" field.
+func OldRepresentativeEqualFold(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldOldRepresentative), v))
+	})
+}
+
+// OldRepresentativeContainsFold applies the ContainsFold predicate on the "old_representative" field.
+func OldRepresentativeContainsFold(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldOldRepresentative), v))
+	})
+}
+
+// NewRepresentativeEQ applies the EQ predicate on the "new_representative" field.
+func NewRepresentativeEQ(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNewRepresentative), v))
+	})
+}
+
+// NewRepresentativeNEQ applies the NEQ predicate on the "new_representative" field.
+func NewRepresentativeNEQ(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNewRepresentative), v))
+	})
+}
+
+// NewRepresentativeIn applies the In predicate on the "new_representative" field.
+func NewRepresentativeIn(vs ...string) predicate.RepresentativeHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldNewRepresentative), v...))
+	})
+}
+
+// NewRepresentativeNotIn applies the NotIn predicate on the "new_representative" field.
+func NewRepresentativeNotIn(vs ...string) predicate.RepresentativeHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldNewRepresentative), v...))
+	})
+}
+
+// NewRepresentativeGT applies the GT predicate on the "new_representative" field.
+func NewRepresentativeGT(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNewRepresentative), v))
+	})
+}
+
+// NewRepresentativeGTE applies the GTE predicate on the "new_representative" field.
+func NewRepresentativeGTE(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNewRepresentative), v))
+	})
+}
+
+// NewRepresentativeLT applies the LT predicate on the "new_representative" field.
+func NewRepresentativeLT(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNewRepresentative), v))
+	})
+}
+
+// NewRepresentativeLTE applies the LTE predicate on the "new_representative" field.
+func NewRepresentativeLTE(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNewRepresentative), v))
+	})
+}
+
+// NewRepresentativeContains applies the Contains predicate on the "new_representative" field.
+func NewRepresentativeContains(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNewRepresentative), v))
+	})
+}
+
+// NewRepresentativeHasPrefix applies the HasPrefix predicate on the "new_representative" field.
+func NewRepresentativeHasPrefix(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNewRepresentative), v))
+	})
+}
+
+// NewRepresentativeHasSuffix applies the HasSuffix predicate on the "new_representative" field.
+func NewRepresentativeHasSuffix(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNewRepresentative), v))
+	})
+}
+
+// NewRepresentativeEqualFold applies the EqualFold predicate on the "new_representative" field.
+func NewRepresentativeEqualFold(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNewRepresentative), v))
+	})
+}
+
+// NewRepresentativeContainsFold applies the ContainsFold predicate on the "new_representative" field.
+func NewRepresentativeContainsFold(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNewRepresentative), v))
+	})
+}
+
+// BlockHashEQ applies the EQ predicate on the "block_hash" field.
+func BlockHashEQ(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashNEQ applies the NEQ predicate on the "block_hash" field.
+func BlockHashNEQ(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashIn applies the In predicate on the "block_hash" field.
+func BlockHashIn(vs ...string) predicate.RepresentativeHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldBlockHash), v...))
+	})
+}
+
+// BlockHashNotIn applies the NotIn predicate on the "block_hash" field.
+func BlockHashNotIn(vs ...string) predicate.RepresentativeHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldBlockHash), v...))
+	})
+}
+
+// BlockHashGT applies the GT predicate on the "block_hash" field.
+func BlockHashGT(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashGTE applies the GTE predicate on the "block_hash" field.
+func BlockHashGTE(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashLT applies the LT predicate on the "block_hash" field.
+func BlockHashLT(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashLTE applies the LTE predicate on the "block_hash" field.
+func BlockHashLTE(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashContains applies the Contains predicate on the "block_hash" field.
+func BlockHashContains(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashHasPrefix applies the HasPrefix predicate on the "block_hash" field.
+func BlockHashHasPrefix(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashHasSuffix applies the HasSuffix predicate on the "block_hash" field.
+func BlockHashHasSuffix(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashEqualFold applies the EqualFold predicate on the "block_hash" field.
+func BlockHashEqualFold(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashContainsFold applies the ContainsFold predicate on the "block_hash" field.
+func BlockHashContainsFold(v string) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldBlockHash), v))
+	})
+}
+
+// ChangedAtEQ applies the EQ predicate on the "changed_at" field.
+func ChangedAtEQ(v time.Time) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldChangedAt), v))
+	})
+}
+
+// ChangedAtNEQ applies the NEQ predicate on the "changed_at" field.
+func ChangedAtNEQ(v time.Time) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldChangedAt), v))
+	})
+}
+
+// ChangedAtIn applies the In predicate on the "changed_at" field.
+func ChangedAtIn(vs ...time.Time) predicate.RepresentativeHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldChangedAt), v...))
+	})
+}
+
+// ChangedAtNotIn applies the NotIn predicate on the "changed_at" field.
+func ChangedAtNotIn(vs ...time.Time) predicate.RepresentativeHistory {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldChangedAt), v...))
+	})
+}
+
+// ChangedAtGT applies the GT predicate on the "changed_at" field.
+func ChangedAtGT(v time.Time) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldChangedAt), v))
+	})
+}
+
+// ChangedAtGTE applies the GTE predicate on the "changed_at" field.
+func ChangedAtGTE(v time.Time) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldChangedAt), v))
+	})
+}
+
+// ChangedAtLT applies the LT predicate on the "changed_at" field.
+func ChangedAtLT(v time.Time) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldChangedAt), v))
+	})
+}
+
+// ChangedAtLTE applies the LTE predicate on the "changed_at" field.
+func ChangedAtLTE(v time.Time) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldChangedAt), v))
+	})
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AccountTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.Account) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AccountInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RepresentativeHistory) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RepresentativeHistory) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RepresentativeHistory) predicate.RepresentativeHistory {
+	return predicate.RepresentativeHistory(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
+	"github.com/google/uuid"
+)
+
+// RepresentativeHistoryCreate is the builder for creating a RepresentativeHistory entity.
+type RepresentativeHistoryCreate struct {
+	config
+	mutation *RepresentativeHistoryMutation
+	hooks    []Hook
+}
+
+// SetAccountID sets the "account_id" field.
+func (rhc *RepresentativeHistoryCreate) SetAccountID(u uuid.UUID) *RepresentativeHistoryCreate {
+	rhc.mutation.SetAccountID(u)
+	return rhc
+}
+
+// SetOldRepresentative sets the "old_representative" field.
+func (rhc *RepresentativeHistoryCreate) SetOldRepresentative(s string) *RepresentativeHistoryCreate {
+	rhc.mutation.SetOldRepresentative(s)
+	return rhc
+}
+
+// SetNewRepresentative sets the "new_representative" field.
+func (rhc *RepresentativeHistoryCreate) SetNewRepresentative(s string) *RepresentativeHistoryCreate {
+	rhc.mutation.SetNewRepresentative(s)
+	return rhc
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (rhc *RepresentativeHistoryCreate) SetBlockHash(s string) *RepresentativeHistoryCreate {
+	rhc.mutation.SetBlockHash(s)
+	return rhc
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (rhc *RepresentativeHistoryCreate) SetChangedAt(t time.Time) *RepresentativeHistoryCreate {
+	rhc.mutation.SetChangedAt(t)
+	return rhc
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (rhc *RepresentativeHistoryCreate) SetNillableChangedAt(t *time.Time) *RepresentativeHistoryCreate {
+	if t != nil {
+		rhc.SetChangedAt(*t)
+	}
+	return rhc
+}
+
+// SetID sets the "id" field.
+func (rhc *RepresentativeHistoryCreate) SetID(u uuid.UUID) *RepresentativeHistoryCreate {
+	rhc.mutation.SetID(u)
+	return rhc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rhc *RepresentativeHistoryCreate) SetNillableID(u *uuid.UUID) *RepresentativeHistoryCreate {
+	if u != nil {
+		rhc.SetID(*u)
+	}
+	return rhc
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (rhc *RepresentativeHistoryCreate) SetAccount(a *Account) *RepresentativeHistoryCreate {
+	return rhc.SetAccountID(a.ID)
+}
+
+// Mutation returns the RepresentativeHistoryMutation object of the builder.
+func (rhc *RepresentativeHistoryCreate) Mutation() *RepresentativeHistoryMutation {
+	return rhc.mutation
+}
+
+// Save creates the RepresentativeHistory in the database.
+func (rhc *RepresentativeHistoryCreate) Save(ctx context.Context) (*RepresentativeHistory, error) {
+	var (
+		err  error
+		node *RepresentativeHistory
+	)
+	rhc.defaults()
+	if len(rhc.hooks) == 0 {
+		if err = rhc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rhc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RepresentativeHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rhc.check(); err != nil {
+				return nil, err
+			}
+			rhc.mutation = mutation
+			if node, err = rhc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rhc.hooks) - 1; i >= 0; i-- {
+			if rhc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rhc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rhc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*RepresentativeHistory)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from RepresentativeHistoryMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rhc *RepresentativeHistoryCreate) SaveX(ctx context.Context) *RepresentativeHistory {
+	v, err := rhc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rhc *RepresentativeHistoryCreate) Exec(ctx context.Context) error {
+	_, err := rhc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhc *RepresentativeHistoryCreate) ExecX(ctx context.Context) {
+	if err := rhc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rhc *RepresentativeHistoryCreate) defaults() {
+	if _, ok := rhc.mutation.ChangedAt(); !ok {
+		v := representativehistory.DefaultChangedAt()
+		rhc.mutation.SetChangedAt(v)
+	}
+	if _, ok := rhc.mutation.ID(); !ok {
+		v := representativehistory.DefaultID()
+		rhc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rhc *RepresentativeHistoryCreate) check() error {
+	if _, ok := rhc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "RepresentativeHistory.account_id"`)}
+	}
+	if _, ok := rhc.mutation.OldRepresentative(); !ok {
+		return &ValidationError{Name: "old_representative", err: errors.New(`ent: missing required field "RepresentativeHistory.old_representative"`)}
+	}
+	if v, ok := rhc.mutation.OldRepresentative(); ok {
+		if err := representativehistory.OldRepresentativeValidator(v); err != nil {
+			return &ValidationError{Name: "old_representative", err: fmt.Errorf(`ent: validator failed for field "RepresentativeHistory.old_representative": %w`, err)}
+		}
+	}
+	if _, ok := rhc.mutation.NewRepresentative(); !ok {
+		return &ValidationError{Name: "new_representative", err: errors.New(`ent: missing required field "RepresentativeHistory.new_representative"`)}
+	}
+	if v, ok := rhc.mutation.NewRepresentative(); ok {
+		if err := representativehistory.NewRepresentativeValidator(v); err != nil {
+			return &ValidationError{Name: "new_representative", err: fmt.Errorf(`ent: validator failed for field "RepresentativeHistory.new_representative": %w`, err)}
+		}
+	}
+	if _, ok := rhc.mutation.BlockHash(); !ok {
+		return &ValidationError{Name: "block_hash", err: errors.New(`ent: missing required field "RepresentativeHistory.block_hash"`)}
+	}
+	if v, ok := rhc.mutation.BlockHash(); ok {
+		if err := representativehistory.BlockHashValidator(v); err != nil {
+			return &ValidationError{Name: "block_hash", err: fmt.Errorf(`ent: validator failed for field "RepresentativeHistory.block_hash": %w`, err)}
+		}
+	}
+	if _, ok := rhc.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "RepresentativeHistory.changed_at"`)}
+	}
+	if _, ok := rhc.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "RepresentativeHistory.account"`)}
+	}
+	return nil
+}
+
+func (rhc *RepresentativeHistoryCreate) sqlSave(ctx context.Context) (*RepresentativeHistory, error) {
+	_node, _spec := rhc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rhc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (rhc *RepresentativeHistoryCreate) createSpec() (*RepresentativeHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RepresentativeHistory{config: rhc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: representativehistory.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: representativehistory.FieldID,
+			},
+		}
+	)
+	if id, ok := rhc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rhc.mutation.OldRepresentative(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: representativehistory.FieldOldRepresentative,
+		})
+		_node.OldRepresentative = value
+	}
+	if value, ok := rhc.mutation.NewRepresentative(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: representativehistory.FieldNewRepresentative,
+		})
+		_node.NewRepresentative = value
+	}
+	if value, ok := rhc.mutation.BlockHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: representativehistory.FieldBlockHash,
+		})
+		_node.BlockHash = value
+	}
+	if value, ok := rhc.mutation.ChangedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: representativehistory.FieldChangedAt,
+		})
+		_node.ChangedAt = value
+	}
+	if nodes := rhc.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   representativehistory.AccountTable,
+			Columns: []string{representativehistory.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RepresentativeHistoryCreateBulk is the builder for creating many RepresentativeHistory entities in bulk.
+type RepresentativeHistoryCreateBulk struct {
+	config
+	builders []*RepresentativeHistoryCreate
+}
+
+// Save creates the RepresentativeHistory entities in the database.
+func (rhcb *RepresentativeHistoryCreateBulk) Save(ctx context.Context) ([]*RepresentativeHistory, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rhcb.builders))
+	nodes := make([]*RepresentativeHistory, len(rhcb.builders))
+	mutators := make([]Mutator, len(rhcb.builders))
+	for i := range rhcb.builders {
+		func(i int, root context.Context) {
+			builder := rhcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RepresentativeHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rhcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rhcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rhcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rhcb *RepresentativeHistoryCreateBulk) SaveX(ctx context.Context) []*RepresentativeHistory {
+	v, err := rhcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rhcb *RepresentativeHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := rhcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhcb *RepresentativeHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := rhcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
+)
+
+// RepresentativeHistoryDelete is the builder for deleting a RepresentativeHistory entity.
+type RepresentativeHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *RepresentativeHistoryMutation
+}
+
+// Where appends a list predicates to the RepresentativeHistoryDelete builder.
+func (rhd *RepresentativeHistoryDelete) Where(ps ...predicate.RepresentativeHistory) *RepresentativeHistoryDelete {
+	rhd.mutation.Where(ps...)
+	return rhd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rhd *RepresentativeHistoryDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rhd.hooks) == 0 {
+		affected, err = rhd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RepresentativeHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rhd.mutation = mutation
+			affected, err = rhd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rhd.hooks) - 1; i >= 0; i-- {
+			if rhd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rhd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rhd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhd *RepresentativeHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := rhd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rhd *RepresentativeHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: representativehistory.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: representativehistory.FieldID,
+			},
+		},
+	}
+	if ps := rhd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rhd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// RepresentativeHistoryDeleteOne is the builder for deleting a single RepresentativeHistory entity.
+type RepresentativeHistoryDeleteOne struct {
+	rhd *RepresentativeHistoryDelete
+}
+
+// Exec executes the deletion query.
+func (rhdo *RepresentativeHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := rhdo.rhd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{representativehistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhdo *RepresentativeHistoryDeleteOne) ExecX(ctx context.Context) {
+	rhdo.rhd.ExecX(ctx)
+}
@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
+	"github.com/google/uuid"
+)
+
+// RepresentativeHistoryQuery is the builder for querying RepresentativeHistory entities.
+type RepresentativeHistoryQuery struct {
+	config
+	limit       *int
+	offset      *int
+	unique      *bool
+	order       []OrderFunc
+	fields      []string
+	predicates  []predicate.RepresentativeHistory
+	withAccount *AccountQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RepresentativeHistoryQuery builder.
+func (rhq *RepresentativeHistoryQuery) Where(ps ...predicate.RepresentativeHistory) *RepresentativeHistoryQuery {
+	rhq.predicates = append(rhq.predicates, ps...)
+	return rhq
+}
+
+// Limit adds a limit step to the query.
+func (rhq *RepresentativeHistoryQuery) Limit(limit int) *RepresentativeHistoryQuery {
+	rhq.limit = &limit
+	return rhq
+}
+
+// Offset adds an offset step to the query.
+func (rhq *RepresentativeHistoryQuery) Offset(offset int) *RepresentativeHistoryQuery {
+	rhq.offset = &offset
+	return rhq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rhq *RepresentativeHistoryQuery) Unique(unique bool) *RepresentativeHistoryQuery {
+	rhq.unique = &unique
+	return rhq
+}
+
+// Order adds an order step to the query.
+func (rhq *RepresentativeHistoryQuery) Order(o ...OrderFunc) *RepresentativeHistoryQuery {
+	rhq.order = append(rhq.order, o...)
+	return rhq
+}
+
+// QueryAccount chains the current query on the "account" edge.
+func (rhq *RepresentativeHistoryQuery) QueryAccount() *AccountQuery {
+	query := &AccountQuery{config: rhq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rhq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rhq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(representativehistory.Table, representativehistory.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, representativehistory.AccountTable, representativehistory.AccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rhq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RepresentativeHistory entity from the query.
+// Returns a *NotFoundError when no RepresentativeHistory was found.
+func (rhq *RepresentativeHistoryQuery) First(ctx context.Context) (*RepresentativeHistory, error) {
+	nodes, err := rhq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{representativehistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rhq *RepresentativeHistoryQuery) FirstX(ctx context.Context) *RepresentativeHistory {
+	node, err := rhq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RepresentativeHistory ID from the query.
+// Returns a *NotFoundError when no RepresentativeHistory ID was found.
+func (rhq *RepresentativeHistoryQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rhq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{representativehistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rhq *RepresentativeHistoryQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rhq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RepresentativeHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RepresentativeHistory entity is found.
+// Returns a *NotFoundError when no RepresentativeHistory entities are found.
+func (rhq *RepresentativeHistoryQuery) Only(ctx context.Context) (*RepresentativeHistory, error) {
+	nodes, err := rhq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{representativehistory.Label}
+	default:
+		return nil, &NotSingularError{representativehistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rhq *RepresentativeHistoryQuery) OnlyX(ctx context.Context) *RepresentativeHistory {
+	node, err := rhq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RepresentativeHistory ID in the query.
+// Returns a *NotSingularError when more than one RepresentativeHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rhq *RepresentativeHistoryQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rhq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{representativehistory.Label}
+	default:
+		err = &NotSingularError{representativehistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rhq *RepresentativeHistoryQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rhq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RepresentativeHistories.
+func (rhq *RepresentativeHistoryQuery) All(ctx context.Context) ([]*RepresentativeHistory, error) {
+	if err := rhq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return rhq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rhq *RepresentativeHistoryQuery) AllX(ctx context.Context) []*RepresentativeHistory {
+	nodes, err := rhq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RepresentativeHistory IDs.
+func (rhq *RepresentativeHistoryQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := rhq.Select(representativehistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rhq *RepresentativeHistoryQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rhq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rhq *RepresentativeHistoryQuery) Count(ctx context.Context) (int, error) {
+	if err := rhq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return rhq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rhq *RepresentativeHistoryQuery) CountX(ctx context.Context) int {
+	count, err := rhq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rhq *RepresentativeHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	if err := rhq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return rhq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rhq *RepresentativeHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := rhq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RepresentativeHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rhq *RepresentativeHistoryQuery) Clone() *RepresentativeHistoryQuery {
+	if rhq == nil {
+		return nil
+	}
+	return &RepresentativeHistoryQuery{
+		config:      rhq.config,
+		limit:       rhq.limit,
+		offset:      rhq.offset,
+		order:       append([]OrderFunc{}, rhq.order...),
+		predicates:  append([]predicate.RepresentativeHistory{}, rhq.predicates...),
+		withAccount: rhq.withAccount.Clone(),
+		// clone intermediate query.
+		sql:    rhq.sql.Clone(),
+		path:   rhq.path,
+		unique: rhq.unique,
+	}
+}
+
+// WithAccount tells the query-builder to eager-load the nodes that are connected to
+// the "account" edge. The optional arguments are used to configure the query builder of the edge.
+func (rhq *RepresentativeHistoryQuery) WithAccount(opts ...func(*AccountQuery)) *RepresentativeHistoryQuery {
+	query := &AccountQuery{config: rhq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rhq.withAccount = query
+	return rhq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AccountID uuid.UUID `json:"account_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RepresentativeHistory.Query().
+//		GroupBy(representativehistory.FieldAccountID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rhq *RepresentativeHistoryQuery) GroupBy(field string, fields ...string) *RepresentativeHistoryGroupBy {
+	grbuild := &RepresentativeHistoryGroupBy{config: rhq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rhq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rhq.sqlQuery(ctx), nil
+	}
+	grbuild.label = representativehistory.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AccountID uuid.UUID `json:"account_id,omitempty"`
+//	}
+//
+//	client.RepresentativeHistory.Query().
+//		Select(representativehistory.FieldAccountID).
+//		Scan(ctx, &v)
+func (rhq *RepresentativeHistoryQuery) Select(fields ...string) *RepresentativeHistorySelect {
+	rhq.fields = append(rhq.fields, fields...)
+	selbuild := &RepresentativeHistorySelect{RepresentativeHistoryQuery: rhq}
+	selbuild.label = representativehistory.Label
+	selbuild.flds, selbuild.scan = &rhq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (rhq *RepresentativeHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range rhq.fields {
+		if !representativehistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rhq.path != nil {
+		prev, err := rhq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rhq.sql = prev
+	}
+	return nil
+}
+
+func (rhq *RepresentativeHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RepresentativeHistory, error) {
+	var (
+		nodes       = []*RepresentativeHistory{}
+		_spec       = rhq.querySpec()
+		loadedTypes = [1]bool{
+			rhq.withAccount != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*RepresentativeHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &RepresentativeHistory{config: rhq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rhq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rhq.withAccount; query != nil {
+		if err := rhq.loadAccount(ctx, query, nodes, nil,
+			func(n *RepresentativeHistory, e *Account) { n.Edges.Account = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rhq *RepresentativeHistoryQuery) loadAccount(ctx context.Context, query *AccountQuery, nodes []*RepresentativeHistory, init func(*RepresentativeHistory), assign func(*RepresentativeHistory, *Account)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*RepresentativeHistory)
+	for i := range nodes {
+		fk := nodes[i].AccountID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "account_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rhq *RepresentativeHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rhq.querySpec()
+	_spec.Node.Columns = rhq.fields
+	if len(rhq.fields) > 0 {
+		_spec.Unique = rhq.unique != nil && *rhq.unique
+	}
+	return sqlgraph.CountNodes(ctx, rhq.driver, _spec)
+}
+
+func (rhq *RepresentativeHistoryQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := rhq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (rhq *RepresentativeHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   representativehistory.Table,
+			Columns: representativehistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: representativehistory.FieldID,
+			},
+		},
+		From:   rhq.sql,
+		Unique: true,
+	}
+	if unique := rhq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := rhq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, representativehistory.FieldID)
+		for i := range fields {
+			if fields[i] != representativehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rhq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rhq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rhq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rhq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rhq *RepresentativeHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rhq.driver.Dialect())
+	t1 := builder.Table(representativehistory.Table)
+	columns := rhq.fields
+	if len(columns) == 0 {
+		columns = representativehistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rhq.sql != nil {
+		selector = rhq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rhq.unique != nil && *rhq.unique {
+		selector.Distinct()
+	}
+	for _, p := range rhq.predicates {
+		p(selector)
+	}
+	for _, p := range rhq.order {
+		p(selector)
+	}
+	if offset := rhq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rhq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RepresentativeHistoryGroupBy is the group-by builder for RepresentativeHistory entities.
+type RepresentativeHistoryGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rhgb *RepresentativeHistoryGroupBy) Aggregate(fns ...AggregateFunc) *RepresentativeHistoryGroupBy {
+	rhgb.fns = append(rhgb.fns, fns...)
+	return rhgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (rhgb *RepresentativeHistoryGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rhgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rhgb.sql = query
+	return rhgb.sqlScan(ctx, v)
+}
+
+func (rhgb *RepresentativeHistoryGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range rhgb.fields {
+		if !representativehistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := rhgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rhgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rhgb *RepresentativeHistoryGroupBy) sqlQuery() *sql.Selector {
+	selector := rhgb.sql.Select()
+	aggregation := make([]string, 0, len(rhgb.fns))
+	for _, fn := range rhgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(rhgb.fields)+len(rhgb.fns))
+		for _, f := range rhgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(rhgb.fields...)...)
+}
+
+// RepresentativeHistorySelect is the builder for selecting fields of RepresentativeHistory entities.
+type RepresentativeHistorySelect struct {
+	*RepresentativeHistoryQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rhs *RepresentativeHistorySelect) Scan(ctx context.Context, v interface{}) error {
+	if err := rhs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	rhs.sql = rhs.RepresentativeHistoryQuery.sqlQuery(ctx)
+	return rhs.sqlScan(ctx, v)
+}
+
+func (rhs *RepresentativeHistorySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rhs.sql.Query()
+	if err := rhs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
+	"github.com/google/uuid"
+)
+
+// RepresentativeHistoryUpdate is the builder for updating RepresentativeHistory entities.
+type RepresentativeHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RepresentativeHistoryMutation
+}
+
+// Where appends a list predicates to the RepresentativeHistoryUpdate builder.
+func (rhu *RepresentativeHistoryUpdate) Where(ps ...predicate.RepresentativeHistory) *RepresentativeHistoryUpdate {
+	rhu.mutation.Where(ps...)
+	return rhu
+}
+
+// SetAccountID sets the "account_id" field.
+func (rhu *RepresentativeHistoryUpdate) SetAccountID(u uuid.UUID) *RepresentativeHistoryUpdate {
+	rhu.mutation.SetAccountID(u)
+	return rhu
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (rhu *RepresentativeHistoryUpdate) SetAccount(a *Account) *RepresentativeHistoryUpdate {
+	return rhu.SetAccountID(a.ID)
+}
+
+// Mutation returns the RepresentativeHistoryMutation object of the builder.
+func (rhu *RepresentativeHistoryUpdate) Mutation() *RepresentativeHistoryMutation {
+	return rhu.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (rhu *RepresentativeHistoryUpdate) ClearAccount() *RepresentativeHistoryUpdate {
+	rhu.mutation.ClearAccount()
+	return rhu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rhu *RepresentativeHistoryUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rhu.hooks) == 0 {
+		if err = rhu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = rhu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RepresentativeHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rhu.check(); err != nil {
+				return 0, err
+			}
+			rhu.mutation = mutation
+			affected, err = rhu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rhu.hooks) - 1; i >= 0; i-- {
+			if rhu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rhu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rhu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rhu *RepresentativeHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := rhu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rhu *RepresentativeHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := rhu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhu *RepresentativeHistoryUpdate) ExecX(ctx context.Context) {
+	if err := rhu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rhu *RepresentativeHistoryUpdate) check() error {
+	if _, ok := rhu.mutation.AccountID(); rhu.mutation.AccountCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RepresentativeHistory.account"`)
+	}
+	return nil
+}
+
+func (rhu *RepresentativeHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   representativehistory.Table,
+			Columns: representativehistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: representativehistory.FieldID,
+			},
+		},
+	}
+	if ps := rhu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rhu.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   representativehistory.AccountTable,
+			Columns: []string{representativehistory.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rhu.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   representativehistory.AccountTable,
+			Columns: []string{representativehistory.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rhu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{representativehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// RepresentativeHistoryUpdateOne is the builder for updating a single RepresentativeHistory entity.
+type RepresentativeHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RepresentativeHistoryMutation
+}
+
+// SetAccountID sets the "account_id" field.
+func (rhuo *RepresentativeHistoryUpdateOne) SetAccountID(u uuid.UUID) *RepresentativeHistoryUpdateOne {
+	rhuo.mutation.SetAccountID(u)
+	return rhuo
+}
+
+// SetAccount sets the "account" edge to the Account entity.
+func (rhuo *RepresentativeHistoryUpdateOne) SetAccount(a *Account) *RepresentativeHistoryUpdateOne {
+	return rhuo.SetAccountID(a.ID)
+}
+
+// Mutation returns the RepresentativeHistoryMutation object of the builder.
+func (rhuo *RepresentativeHistoryUpdateOne) Mutation() *RepresentativeHistoryMutation {
+	return rhuo.mutation
+}
+
+// ClearAccount clears the "account" edge to the Account entity.
+func (rhuo *RepresentativeHistoryUpdateOne) ClearAccount() *RepresentativeHistoryUpdateOne {
+	rhuo.mutation.ClearAccount()
+	return rhuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rhuo *RepresentativeHistoryUpdateOne) Select(field string, fields ...string) *RepresentativeHistoryUpdateOne {
+	rhuo.fields = append([]string{field}, fields...)
+	return rhuo
+}
+
+// Save executes the query and returns the updated RepresentativeHistory entity.
+func (rhuo *RepresentativeHistoryUpdateOne) Save(ctx context.Context) (*RepresentativeHistory, error) {
+	var (
+		err  error
+		node *RepresentativeHistory
+	)
+	if len(rhuo.hooks) == 0 {
+		if err = rhuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = rhuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RepresentativeHistoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rhuo.check(); err != nil {
+				return nil, err
+			}
+			rhuo.mutation = mutation
+			node, err = rhuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rhuo.hooks) - 1; i >= 0; i-- {
+			if rhuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = rhuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, rhuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*RepresentativeHistory)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from RepresentativeHistoryMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rhuo *RepresentativeHistoryUpdateOne) SaveX(ctx context.Context) *RepresentativeHistory {
+	node, err := rhuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rhuo *RepresentativeHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := rhuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rhuo *RepresentativeHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := rhuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rhuo *RepresentativeHistoryUpdateOne) check() error {
+	if _, ok := rhuo.mutation.AccountID(); rhuo.mutation.AccountCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "RepresentativeHistory.account"`)
+	}
+	return nil
+}
+
+func (rhuo *RepresentativeHistoryUpdateOne) sqlSave(ctx context.Context) (_node *RepresentativeHistory, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   representativehistory.Table,
+			Columns: representativehistory.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: representativehistory.FieldID,
+			},
+		},
+	}
+	id, ok := rhuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RepresentativeHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rhuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, representativehistory.FieldID)
+		for _, f := range fields {
+			if !representativehistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != representativehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rhuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rhuo.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   representativehistory.AccountTable,
+			Columns: []string{representativehistory.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rhuo.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   representativehistory.AccountTable,
+			Columns: []string{representativehistory.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RepresentativeHistory{config: rhuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rhuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{representativehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
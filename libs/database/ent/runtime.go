@@ -6,9 +6,21 @@ import (
 	"time"
 
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/apikey"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/auditrecord"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancealert"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotencykey"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/schema"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendschedule"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
 	"github.com/google/uuid"
 )
 
@@ -26,18 +38,114 @@ func init() {
 	accountDescPrivateKey := accountFields[4].Descriptor()
 	// account.PrivateKeyValidator is a validator for the "private_key" field. It is called by the builders before save.
 	account.PrivateKeyValidator = accountDescPrivateKey.Validators[0].(func(string) error)
+	// accountDescSeed is the schema descriptor for seed field.
+	accountDescSeed := accountFields[5].Descriptor()
+	// account.SeedValidator is a validator for the "seed" field. It is called by the builders before save.
+	account.SeedValidator = accountDescSeed.Validators[0].(func(string) error)
 	// accountDescWork is the schema descriptor for work field.
-	accountDescWork := accountFields[5].Descriptor()
+	accountDescWork := accountFields[7].Descriptor()
 	// account.DefaultWork holds the default value on creation for the work field.
 	account.DefaultWork = accountDescWork.Default.(bool)
+	// accountDescWatchOnly is the schema descriptor for watch_only field.
+	accountDescWatchOnly := accountFields[8].Descriptor()
+	// account.DefaultWatchOnly holds the default value on creation for the watch_only field.
+	account.DefaultWatchOnly = accountDescWatchOnly.Default.(bool)
+	// accountDescLabel is the schema descriptor for label field.
+	accountDescLabel := accountFields[9].Descriptor()
+	// account.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	account.LabelValidator = accountDescLabel.Validators[0].(func(string) error)
 	// accountDescCreatedAt is the schema descriptor for created_at field.
-	accountDescCreatedAt := accountFields[6].Descriptor()
+	accountDescCreatedAt := accountFields[11].Descriptor()
 	// account.DefaultCreatedAt holds the default value on creation for the created_at field.
 	account.DefaultCreatedAt = accountDescCreatedAt.Default.(func() time.Time)
 	// accountDescID is the schema descriptor for id field.
 	accountDescID := accountFields[0].Descriptor()
 	// account.DefaultID holds the default value on creation for the id field.
 	account.DefaultID = accountDescID.Default.(func() uuid.UUID)
+	apikeyFields := schema.ApiKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[1].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[2].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = apikeyDescKeyHash.Validators[0].(func(string) error)
+	// apikeyDescApprover is the schema descriptor for approver field.
+	apikeyDescApprover := apikeyFields[4].Descriptor()
+	// apikey.DefaultApprover holds the default value on creation for the approver field.
+	apikey.DefaultApprover = apikeyDescApprover.Default.(bool)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[5].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	auditrecordFields := schema.AuditRecord{}.Fields()
+	_ = auditrecordFields
+	// auditrecordDescCreatedAt is the schema descriptor for created_at field.
+	auditrecordDescCreatedAt := auditrecordFields[1].Descriptor()
+	// auditrecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditrecord.DefaultCreatedAt = auditrecordDescCreatedAt.Default.(func() time.Time)
+	// auditrecordDescAction is the schema descriptor for action field.
+	auditrecordDescAction := auditrecordFields[2].Descriptor()
+	// auditrecord.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditrecord.ActionValidator = auditrecordDescAction.Validators[0].(func(string) error)
+	// auditrecordDescWallet is the schema descriptor for wallet field.
+	auditrecordDescWallet := auditrecordFields[3].Descriptor()
+	// auditrecord.WalletValidator is a validator for the "wallet" field. It is called by the builders before save.
+	auditrecord.WalletValidator = auditrecordDescWallet.Validators[0].(func(string) error)
+	// auditrecordDescIP is the schema descriptor for ip field.
+	auditrecordDescIP := auditrecordFields[4].Descriptor()
+	// auditrecord.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	auditrecord.IPValidator = auditrecordDescIP.Validators[0].(func(string) error)
+	// auditrecordDescAPIKeyName is the schema descriptor for api_key_name field.
+	auditrecordDescAPIKeyName := auditrecordFields[6].Descriptor()
+	// auditrecord.APIKeyNameValidator is a validator for the "api_key_name" field. It is called by the builders before save.
+	auditrecord.APIKeyNameValidator = auditrecordDescAPIKeyName.Validators[0].(func(string) error)
+	// auditrecordDescErrorCode is the schema descriptor for error_code field.
+	auditrecordDescErrorCode := auditrecordFields[9].Descriptor()
+	// auditrecord.ErrorCodeValidator is a validator for the "error_code" field. It is called by the builders before save.
+	auditrecord.ErrorCodeValidator = auditrecordDescErrorCode.Validators[0].(func(string) error)
+	// auditrecordDescID is the schema descriptor for id field.
+	auditrecordDescID := auditrecordFields[0].Descriptor()
+	// auditrecord.DefaultID holds the default value on creation for the id field.
+	auditrecord.DefaultID = auditrecordDescID.Default.(func() uuid.UUID)
+	balancealertFields := schema.BalanceAlert{}.Fields()
+	_ = balancealertFields
+	// balancealertDescAccount is the schema descriptor for account field.
+	balancealertDescAccount := balancealertFields[2].Descriptor()
+	// balancealert.AccountValidator is a validator for the "account" field. It is called by the builders before save.
+	balancealert.AccountValidator = balancealertDescAccount.Validators[0].(func(string) error)
+	// balancealertDescThreshold is the schema descriptor for threshold field.
+	balancealertDescThreshold := balancealertFields[3].Descriptor()
+	// balancealert.ThresholdValidator is a validator for the "threshold" field. It is called by the builders before save.
+	balancealert.ThresholdValidator = balancealertDescThreshold.Validators[0].(func(string) error)
+	// balancealertDescFired is the schema descriptor for fired field.
+	balancealertDescFired := balancealertFields[6].Descriptor()
+	// balancealert.DefaultFired holds the default value on creation for the fired field.
+	balancealert.DefaultFired = balancealertDescFired.Default.(bool)
+	// balancealertDescCreatedAt is the schema descriptor for created_at field.
+	balancealertDescCreatedAt := balancealertFields[7].Descriptor()
+	// balancealert.DefaultCreatedAt holds the default value on creation for the created_at field.
+	balancealert.DefaultCreatedAt = balancealertDescCreatedAt.Default.(func() time.Time)
+	// balancealertDescID is the schema descriptor for id field.
+	balancealertDescID := balancealertFields[0].Descriptor()
+	// balancealert.DefaultID holds the default value on creation for the id field.
+	balancealert.DefaultID = balancealertDescID.Default.(func() uuid.UUID)
+	balancesnapshotFields := schema.BalanceSnapshot{}.Fields()
+	_ = balancesnapshotFields
+	// balancesnapshotDescBalanceRaw is the schema descriptor for balance_raw field.
+	balancesnapshotDescBalanceRaw := balancesnapshotFields[2].Descriptor()
+	// balancesnapshot.BalanceRawValidator is a validator for the "balance_raw" field. It is called by the builders before save.
+	balancesnapshot.BalanceRawValidator = balancesnapshotDescBalanceRaw.Validators[0].(func(string) error)
+	// balancesnapshotDescID is the schema descriptor for id field.
+	balancesnapshotDescID := balancesnapshotFields[0].Descriptor()
+	// balancesnapshot.DefaultID holds the default value on creation for the id field.
+	balancesnapshot.DefaultID = balancesnapshotDescID.Default.(func() uuid.UUID)
 	blockFields := schema.Block{}.Fields()
 	_ = blockFields
 	// blockDescBlockHash is the schema descriptor for block_hash field.
@@ -45,21 +153,167 @@ func init() {
 	// block.BlockHashValidator is a validator for the "block_hash" field. It is called by the builders before save.
 	block.BlockHashValidator = blockDescBlockHash.Validators[0].(func(string) error)
 	// blockDescSendID is the schema descriptor for send_id field.
-	blockDescSendID := blockFields[4].Descriptor()
+	blockDescSendID := blockFields[5].Descriptor()
 	// block.SendIDValidator is a validator for the "send_id" field. It is called by the builders before save.
 	block.SendIDValidator = blockDescSendID.Validators[0].(func(string) error)
 	// blockDescSubtype is the schema descriptor for subtype field.
-	blockDescSubtype := blockFields[5].Descriptor()
+	blockDescSubtype := blockFields[6].Descriptor()
 	// block.SubtypeValidator is a validator for the "subtype" field. It is called by the builders before save.
 	block.SubtypeValidator = blockDescSubtype.Validators[0].(func(string) error)
 	// blockDescCreatedAt is the schema descriptor for created_at field.
-	blockDescCreatedAt := blockFields[6].Descriptor()
+	blockDescCreatedAt := blockFields[7].Descriptor()
 	// block.DefaultCreatedAt holds the default value on creation for the created_at field.
 	block.DefaultCreatedAt = blockDescCreatedAt.Default.(func() time.Time)
 	// blockDescID is the schema descriptor for id field.
 	blockDescID := blockFields[0].Descriptor()
 	// block.DefaultID holds the default value on creation for the id field.
 	block.DefaultID = blockDescID.Default.(func() uuid.UUID)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	idempotentsendFields := schema.IdempotentSend{}.Fields()
+	_ = idempotentsendFields
+	// idempotentsendDescSendID is the schema descriptor for send_id field.
+	idempotentsendDescSendID := idempotentsendFields[2].Descriptor()
+	// idempotentsend.SendIDValidator is a validator for the "send_id" field. It is called by the builders before save.
+	idempotentsend.SendIDValidator = idempotentsendDescSendID.Validators[0].(func(string) error)
+	// idempotentsendDescSource is the schema descriptor for source field.
+	idempotentsendDescSource := idempotentsendFields[3].Descriptor()
+	// idempotentsend.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	idempotentsend.SourceValidator = idempotentsendDescSource.Validators[0].(func(string) error)
+	// idempotentsendDescDestination is the schema descriptor for destination field.
+	idempotentsendDescDestination := idempotentsendFields[4].Descriptor()
+	// idempotentsend.DestinationValidator is a validator for the "destination" field. It is called by the builders before save.
+	idempotentsend.DestinationValidator = idempotentsendDescDestination.Validators[0].(func(string) error)
+	// idempotentsendDescAmount is the schema descriptor for amount field.
+	idempotentsendDescAmount := idempotentsendFields[5].Descriptor()
+	// idempotentsend.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	idempotentsend.AmountValidator = idempotentsendDescAmount.Validators[0].(func(string) error)
+	// idempotentsendDescBlockHash is the schema descriptor for block_hash field.
+	idempotentsendDescBlockHash := idempotentsendFields[6].Descriptor()
+	// idempotentsend.BlockHashValidator is a validator for the "block_hash" field. It is called by the builders before save.
+	idempotentsend.BlockHashValidator = idempotentsendDescBlockHash.Validators[0].(func(string) error)
+	// idempotentsendDescCreatedAt is the schema descriptor for created_at field.
+	idempotentsendDescCreatedAt := idempotentsendFields[8].Descriptor()
+	// idempotentsend.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotentsend.DefaultCreatedAt = idempotentsendDescCreatedAt.Default.(func() time.Time)
+	// idempotentsendDescID is the schema descriptor for id field.
+	idempotentsendDescID := idempotentsendFields[0].Descriptor()
+	// idempotentsend.DefaultID holds the default value on creation for the id field.
+	idempotentsend.DefaultID = idempotentsendDescID.Default.(func() uuid.UUID)
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescAction is the schema descriptor for action field.
+	jobDescAction := jobFields[2].Descriptor()
+	// job.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	job.ActionValidator = jobDescAction.Validators[0].(func(string) error)
+	// jobDescPercent is the schema descriptor for percent field.
+	jobDescPercent := jobFields[4].Descriptor()
+	// job.DefaultPercent holds the default value on creation for the percent field.
+	job.DefaultPercent = jobDescPercent.Default.(int)
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobFields[7].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	// jobDescUpdatedAt is the schema descriptor for updated_at field.
+	jobDescUpdatedAt := jobFields[8].Descriptor()
+	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() time.Time)
+	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// jobDescID is the schema descriptor for id field.
+	jobDescID := jobFields[0].Descriptor()
+	// job.DefaultID holds the default value on creation for the id field.
+	job.DefaultID = jobDescID.Default.(func() uuid.UUID)
+	representativehistoryFields := schema.RepresentativeHistory{}.Fields()
+	_ = representativehistoryFields
+	// representativehistoryDescOldRepresentative is the schema descriptor for old_representative field.
+	representativehistoryDescOldRepresentative := representativehistoryFields[2].Descriptor()
+	// representativehistory.OldRepresentativeValidator is a validator for the "old_representative" field. It is called by the builders before save.
+	representativehistory.OldRepresentativeValidator = representativehistoryDescOldRepresentative.Validators[0].(func(string) error)
+	// representativehistoryDescNewRepresentative is the schema descriptor for new_representative field.
+	representativehistoryDescNewRepresentative := representativehistoryFields[3].Descriptor()
+	// representativehistory.NewRepresentativeValidator is a validator for the "new_representative" field. It is called by the builders before save.
+	representativehistory.NewRepresentativeValidator = representativehistoryDescNewRepresentative.Validators[0].(func(string) error)
+	// representativehistoryDescBlockHash is the schema descriptor for block_hash field.
+	representativehistoryDescBlockHash := representativehistoryFields[4].Descriptor()
+	// representativehistory.BlockHashValidator is a validator for the "block_hash" field. It is called by the builders before save.
+	representativehistory.BlockHashValidator = representativehistoryDescBlockHash.Validators[0].(func(string) error)
+	// representativehistoryDescChangedAt is the schema descriptor for changed_at field.
+	representativehistoryDescChangedAt := representativehistoryFields[5].Descriptor()
+	// representativehistory.DefaultChangedAt holds the default value on creation for the changed_at field.
+	representativehistory.DefaultChangedAt = representativehistoryDescChangedAt.Default.(func() time.Time)
+	// representativehistoryDescID is the schema descriptor for id field.
+	representativehistoryDescID := representativehistoryFields[0].Descriptor()
+	// representativehistory.DefaultID holds the default value on creation for the id field.
+	representativehistory.DefaultID = representativehistoryDescID.Default.(func() uuid.UUID)
+	sendapprovalFields := schema.SendApproval{}.Fields()
+	_ = sendapprovalFields
+	// sendapprovalDescSendID is the schema descriptor for send_id field.
+	sendapprovalDescSendID := sendapprovalFields[2].Descriptor()
+	// sendapproval.SendIDValidator is a validator for the "send_id" field. It is called by the builders before save.
+	sendapproval.SendIDValidator = sendapprovalDescSendID.Validators[0].(func(string) error)
+	// sendapprovalDescSource is the schema descriptor for source field.
+	sendapprovalDescSource := sendapprovalFields[3].Descriptor()
+	// sendapproval.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	sendapproval.SourceValidator = sendapprovalDescSource.Validators[0].(func(string) error)
+	// sendapprovalDescDestination is the schema descriptor for destination field.
+	sendapprovalDescDestination := sendapprovalFields[4].Descriptor()
+	// sendapproval.DestinationValidator is a validator for the "destination" field. It is called by the builders before save.
+	sendapproval.DestinationValidator = sendapprovalDescDestination.Validators[0].(func(string) error)
+	// sendapprovalDescAmount is the schema descriptor for amount field.
+	sendapprovalDescAmount := sendapprovalFields[5].Descriptor()
+	// sendapproval.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	sendapproval.AmountValidator = sendapprovalDescAmount.Validators[0].(func(string) error)
+	// sendapprovalDescWork is the schema descriptor for work field.
+	sendapprovalDescWork := sendapprovalFields[6].Descriptor()
+	// sendapproval.WorkValidator is a validator for the "work" field. It is called by the builders before save.
+	sendapproval.WorkValidator = sendapprovalDescWork.Validators[0].(func(string) error)
+	// sendapprovalDescApprovalsRequired is the schema descriptor for approvals_required field.
+	sendapprovalDescApprovalsRequired := sendapprovalFields[7].Descriptor()
+	// sendapproval.ApprovalsRequiredValidator is a validator for the "approvals_required" field. It is called by the builders before save.
+	sendapproval.ApprovalsRequiredValidator = sendapprovalDescApprovalsRequired.Validators[0].(func(int) error)
+	// sendapprovalDescBlockHash is the schema descriptor for block_hash field.
+	sendapprovalDescBlockHash := sendapprovalFields[11].Descriptor()
+	// sendapproval.BlockHashValidator is a validator for the "block_hash" field. It is called by the builders before save.
+	sendapproval.BlockHashValidator = sendapprovalDescBlockHash.Validators[0].(func(string) error)
+	// sendapprovalDescCreatedAt is the schema descriptor for created_at field.
+	sendapprovalDescCreatedAt := sendapprovalFields[12].Descriptor()
+	// sendapproval.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sendapproval.DefaultCreatedAt = sendapprovalDescCreatedAt.Default.(func() time.Time)
+	// sendapprovalDescID is the schema descriptor for id field.
+	sendapprovalDescID := sendapprovalFields[0].Descriptor()
+	// sendapproval.DefaultID holds the default value on creation for the id field.
+	sendapproval.DefaultID = sendapprovalDescID.Default.(func() uuid.UUID)
+	sendscheduleFields := schema.SendSchedule{}.Fields()
+	_ = sendscheduleFields
+	// sendscheduleDescSource is the schema descriptor for source field.
+	sendscheduleDescSource := sendscheduleFields[2].Descriptor()
+	// sendschedule.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	sendschedule.SourceValidator = sendscheduleDescSource.Validators[0].(func(string) error)
+	// sendscheduleDescDestination is the schema descriptor for destination field.
+	sendscheduleDescDestination := sendscheduleFields[3].Descriptor()
+	// sendschedule.DestinationValidator is a validator for the "destination" field. It is called by the builders before save.
+	sendschedule.DestinationValidator = sendscheduleDescDestination.Validators[0].(func(string) error)
+	// sendscheduleDescAmount is the schema descriptor for amount field.
+	sendscheduleDescAmount := sendscheduleFields[4].Descriptor()
+	// sendschedule.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	sendschedule.AmountValidator = sendscheduleDescAmount.Validators[0].(func(string) error)
+	// sendscheduleDescIntervalSeconds is the schema descriptor for interval_seconds field.
+	sendscheduleDescIntervalSeconds := sendscheduleFields[5].Descriptor()
+	// sendschedule.IntervalSecondsValidator is a validator for the "interval_seconds" field. It is called by the builders before save.
+	sendschedule.IntervalSecondsValidator = sendscheduleDescIntervalSeconds.Validators[0].(func(int) error)
+	// sendscheduleDescCreatedAt is the schema descriptor for created_at field.
+	sendscheduleDescCreatedAt := sendscheduleFields[9].Descriptor()
+	// sendschedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sendschedule.DefaultCreatedAt = sendscheduleDescCreatedAt.Default.(func() time.Time)
+	// sendscheduleDescID is the schema descriptor for id field.
+	sendscheduleDescID := sendscheduleFields[0].Descriptor()
+	// sendschedule.DefaultID holds the default value on creation for the id field.
+	sendschedule.DefaultID = sendscheduleDescID.Default.(func() uuid.UUID)
 	walletFields := schema.Wallet{}.Fields()
 	_ = walletFields
 	// walletDescSeed is the schema descriptor for seed field.
@@ -70,20 +324,88 @@ func init() {
 	walletDescRepresentative := walletFields[2].Descriptor()
 	// wallet.RepresentativeValidator is a validator for the "representative" field. It is called by the builders before save.
 	wallet.RepresentativeValidator = walletDescRepresentative.Validators[0].(func(string) error)
+	// walletDescName is the schema descriptor for name field.
+	walletDescName := walletFields[3].Descriptor()
+	// wallet.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	wallet.NameValidator = walletDescName.Validators[0].(func(string) error)
 	// walletDescEncrypted is the schema descriptor for encrypted field.
-	walletDescEncrypted := walletFields[3].Descriptor()
+	walletDescEncrypted := walletFields[4].Descriptor()
 	// wallet.DefaultEncrypted holds the default value on creation for the encrypted field.
 	wallet.DefaultEncrypted = walletDescEncrypted.Default.(bool)
 	// walletDescWork is the schema descriptor for work field.
-	walletDescWork := walletFields[4].Descriptor()
+	walletDescWork := walletFields[5].Descriptor()
 	// wallet.DefaultWork holds the default value on creation for the work field.
 	wallet.DefaultWork = walletDescWork.Default.(bool)
+	// walletDescWatchOnly is the schema descriptor for watch_only field.
+	walletDescWatchOnly := walletFields[6].Descriptor()
+	// wallet.DefaultWatchOnly holds the default value on creation for the watch_only field.
+	wallet.DefaultWatchOnly = walletDescWatchOnly.Default.(bool)
+	// walletDescHardware is the schema descriptor for hardware field.
+	walletDescHardware := walletFields[7].Descriptor()
+	// wallet.DefaultHardware holds the default value on creation for the hardware field.
+	wallet.DefaultHardware = walletDescHardware.Default.(bool)
+	// walletDescAutoReceive is the schema descriptor for auto_receive field.
+	walletDescAutoReceive := walletFields[8].Descriptor()
+	// wallet.DefaultAutoReceive holds the default value on creation for the auto_receive field.
+	wallet.DefaultAutoReceive = walletDescAutoReceive.Default.(bool)
+	// walletDescReceiveMinimum is the schema descriptor for receive_minimum field.
+	walletDescReceiveMinimum := walletFields[9].Descriptor()
+	// wallet.ReceiveMinimumValidator is a validator for the "receive_minimum" field. It is called by the builders before save.
+	wallet.ReceiveMinimumValidator = walletDescReceiveMinimum.Validators[0].(func(string) error)
+	// walletDescKdf is the schema descriptor for kdf field.
+	walletDescKdf := walletFields[11].Descriptor()
+	// wallet.KdfValidator is a validator for the "kdf" field. It is called by the builders before save.
+	wallet.KdfValidator = walletDescKdf.Validators[0].(func(string) error)
+	// walletDescApprovalsRequired is the schema descriptor for approvals_required field.
+	walletDescApprovalsRequired := walletFields[12].Descriptor()
+	// wallet.ApprovalsRequiredValidator is a validator for the "approvals_required" field. It is called by the builders before save.
+	wallet.ApprovalsRequiredValidator = walletDescApprovalsRequired.Validators[0].(func(int) error)
+	// walletDescApprovalThreshold is the schema descriptor for approval_threshold field.
+	walletDescApprovalThreshold := walletFields[13].Descriptor()
+	// wallet.ApprovalThresholdValidator is a validator for the "approval_threshold" field. It is called by the builders before save.
+	wallet.ApprovalThresholdValidator = walletDescApprovalThreshold.Validators[0].(func(string) error)
 	// walletDescCreatedAt is the schema descriptor for created_at field.
-	walletDescCreatedAt := walletFields[5].Descriptor()
+	walletDescCreatedAt := walletFields[14].Descriptor()
 	// wallet.DefaultCreatedAt holds the default value on creation for the created_at field.
 	wallet.DefaultCreatedAt = walletDescCreatedAt.Default.(func() time.Time)
 	// walletDescID is the schema descriptor for id field.
 	walletDescID := walletFields[0].Descriptor()
 	// wallet.DefaultID holds the default value on creation for the id field.
 	wallet.DefaultID = walletDescID.Default.(func() uuid.UUID)
+	walletsnapshotFields := schema.WalletSnapshot{}.Fields()
+	_ = walletsnapshotFields
+	// walletsnapshotDescLabel is the schema descriptor for label field.
+	walletsnapshotDescLabel := walletsnapshotFields[2].Descriptor()
+	// walletsnapshot.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	walletsnapshot.LabelValidator = walletsnapshotDescLabel.Validators[0].(func(string) error)
+	// walletsnapshotDescTotalRaw is the schema descriptor for total_raw field.
+	walletsnapshotDescTotalRaw := walletsnapshotFields[3].Descriptor()
+	// walletsnapshot.TotalRawValidator is a validator for the "total_raw" field. It is called by the builders before save.
+	walletsnapshot.TotalRawValidator = walletsnapshotDescTotalRaw.Validators[0].(func(string) error)
+	// walletsnapshotDescCreatedAt is the schema descriptor for created_at field.
+	walletsnapshotDescCreatedAt := walletsnapshotFields[5].Descriptor()
+	// walletsnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	walletsnapshot.DefaultCreatedAt = walletsnapshotDescCreatedAt.Default.(func() time.Time)
+	// walletsnapshotDescID is the schema descriptor for id field.
+	walletsnapshotDescID := walletsnapshotFields[0].Descriptor()
+	// walletsnapshot.DefaultID holds the default value on creation for the id field.
+	walletsnapshot.DefaultID = walletsnapshotDescID.Default.(func() uuid.UUID)
+	walletspendFields := schema.WalletSpend{}.Fields()
+	_ = walletspendFields
+	// walletspendDescAmount is the schema descriptor for amount field.
+	walletspendDescAmount := walletspendFields[2].Descriptor()
+	// walletspend.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	walletspend.AmountValidator = walletspendDescAmount.Validators[0].(func(string) error)
+	// walletspendDescBlockHash is the schema descriptor for block_hash field.
+	walletspendDescBlockHash := walletspendFields[3].Descriptor()
+	// walletspend.BlockHashValidator is a validator for the "block_hash" field. It is called by the builders before save.
+	walletspend.BlockHashValidator = walletspendDescBlockHash.Validators[0].(func(string) error)
+	// walletspendDescCreatedAt is the schema descriptor for created_at field.
+	walletspendDescCreatedAt := walletspendFields[4].Descriptor()
+	// walletspend.DefaultCreatedAt holds the default value on creation for the created_at field.
+	walletspend.DefaultCreatedAt = walletspendDescCreatedAt.Default.(func() time.Time)
+	// walletspendDescID is the schema descriptor for id field.
+	walletspendDescID := walletspendFields[0].Descriptor()
+	// walletspend.DefaultID holds the default value on creation for the id field.
+	walletspend.DefaultID = walletspendDescID.Default.(func() uuid.UUID)
 }
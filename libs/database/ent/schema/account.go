@@ -33,7 +33,15 @@ func (Account) Fields() []ent.Field {
 		field.String("address").MaxLen(65),
 		field.Int("account_index").Nillable().Optional(),
 		field.String("private_key").MaxLen(512).Nillable().Optional(),
+		// Set for accounts derived from another seed than the wallet's, they also have the derived private_key
+		field.String("seed").MaxLen(512).Nillable().Optional(),
+		field.Int("seed_index").Nillable().Optional(),
 		field.Bool("work").Default(true),
+		// Added with wallet_add_watch, there's no private key so nothing can be signed for it
+		field.Bool("watch_only").Default(false),
+		// Set with account_label_set, e.g. to map accounts to users
+		field.String("label").MaxLen(256).Nillable().Optional(),
+		field.JSON("metadata", map[string]string{}).Optional(),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
 }
@@ -50,6 +58,14 @@ func (Account) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		edge.To("balance_snapshots", BalanceSnapshot.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("representative_history", RepresentativeHistory.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
 	}
 }
 
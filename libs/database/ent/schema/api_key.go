@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ApiKey holds the schema definition for the ApiKey entity.
+type ApiKey struct {
+	ent.Schema
+}
+
+// Annotations of the ApiKey.
+func (ApiKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "api_keys"},
+	}
+}
+
+// Fields of the ApiKey.
+func (ApiKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.String("name").MaxLen(64),
+		// Hex SHA-256 of the key, the key itself is only shown when it's created
+		field.String("key_hash").MaxLen(64).Unique().Immutable().Sensitive(),
+		// read can only read balances and history, send can also sign and publish blocks, admin can do anything
+		field.Enum("scope").Values("read", "send", "admin"),
+		// Approver keys can approve or reject sends that need approvals, whatever their scope
+		field.Bool("approver").Default(false),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("last_used_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the ApiKey.
+func (ApiKey) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditRecord holds the schema definition for the AuditRecord entity.
+type AuditRecord struct {
+	ent.Schema
+}
+
+// Annotations of the AuditRecord.
+func (AuditRecord) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "audit_records"},
+	}
+}
+
+// Fields of the AuditRecord.
+// Records are only ever inserted, nothing updates or deletes them
+func (AuditRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.String("action").MaxLen(64).Immutable(),
+		// Not an edge, records outlive the wallets they're about
+		field.String("wallet").MaxLen(64).Optional().Nillable().Immutable(),
+		field.String("ip").MaxLen(64).Immutable(),
+		// The key the request was made with, with require_api_key
+		field.UUID("api_key_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.String("api_key_name").MaxLen(64).Optional().Nillable().Immutable(),
+		// The request with the secrets redacted
+		field.JSON("params", map[string]interface{}{}).Immutable(),
+		field.Int("status").Immutable(),
+		// The error_code of a failed request
+		field.String("error_code").MaxLen(64).Optional().Nillable().Immutable(),
+	}
+}
+
+// Edges of the AuditRecord.
+func (AuditRecord) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditRecord.
+func (AuditRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("wallet", "created_at"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// BalanceAlert holds the schema definition for the BalanceAlert entity.
+type BalanceAlert struct {
+	ent.Schema
+}
+
+// Annotations of the BalanceAlert.
+func (BalanceAlert) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "balance_alerts"},
+	}
+}
+
+// Fields of the BalanceAlert.
+func (BalanceAlert) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("wallet_id", uuid.UUID{}),
+		field.String("account").MaxLen(65).Immutable(),
+		// Raw amount, as a string since it can exceed 64 bits
+		field.String("threshold").MaxLen(64).Immutable(),
+		field.Enum("direction").Values("above", "below").Immutable(),
+		field.String("callback_url").Immutable(),
+		// Set when the callback for the current crossing was delivered, cleared when the balance crosses back
+		field.Bool("fired").Default(false),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the BalanceAlert.
+func (BalanceAlert) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("wallet", Wallet.Type).
+			Ref("balance_alerts").
+			Field("wallet_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the BalanceAlert.
+func (BalanceAlert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_id"),
+	}
+}
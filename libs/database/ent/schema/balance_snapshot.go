@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// BalanceSnapshot holds the schema definition for the BalanceSnapshot entity.
+type BalanceSnapshot struct {
+	ent.Schema
+}
+
+// Annotations of the BalanceSnapshot.
+func (BalanceSnapshot) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "balance_snapshots"},
+	}
+}
+
+// Fields of the BalanceSnapshot.
+func (BalanceSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("account_id", uuid.UUID{}),
+		// Confirmed balance, as a string since it can exceed 64 bits
+		field.String("balance_raw").MaxLen(64).Immutable(),
+		field.Time("snapshot_at").Immutable(),
+		// Set for the balances of a snapshot_balances snapshot, nil for periodic ones
+		field.UUID("wallet_snapshot_id", uuid.UUID{}).Nillable().Optional(),
+	}
+}
+
+// Edges of the BalanceSnapshot.
+func (BalanceSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("balance_snapshots").
+			Field("account_id").
+			Required().
+			Unique(),
+		edge.From("wallet_snapshot", WalletSnapshot.Type).
+			Ref("balances").
+			Field("wallet_snapshot_id").
+			Unique(),
+	}
+}
+
+// Indexes of the BalanceSnapshot.
+func (BalanceSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "snapshot_at"),
+	}
+}
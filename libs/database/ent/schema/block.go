@@ -31,9 +31,12 @@ func (Block) Fields() []ent.Field {
 			Default(uuid.New),
 		// account id from accounts
 		field.UUID("account_id", uuid.UUID{}).Nillable().Optional(),
-		field.String("block_hash").MaxLen(64).Unique().Immutable(),
+		// Sends with a send_id are saved before they're published, then set to the hash the node returned
+		field.String("block_hash").MaxLen(64).Unique(),
 		// TODO use a proper struct, not map[string]interface{}
 		field.JSON("block", map[string]interface{}{}).Immutable(),
+		// Sends with a send_id have their wallet, the id is unique in the wallet
+		field.UUID("wallet_id", uuid.UUID{}).Nillable().Optional(),
 		field.String("send_id").MaxLen(256).Nillable().Immutable().Optional(),
 		field.String("subtype").MaxLen(10),
 		field.Time("created_at").Default(time.Now).Immutable(),
@@ -47,12 +50,16 @@ func (Block) Edges() []ent.Edge {
 			Ref("blocks").
 			Field("account_id").
 			Unique(),
+		edge.From("wallet", Wallet.Type).
+			Ref("blocks").
+			Field("wallet_id").
+			Unique(),
 	}
 }
 
 // Indexes of the Block.
 func (Block) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("account_id", "send_id").Unique(),
+		index.Fields("wallet_id", "send_id").Unique(),
 	}
 }
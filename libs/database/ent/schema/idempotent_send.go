@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// IdempotentSend holds the schema definition for the IdempotentSend entity.
+type IdempotentSend struct {
+	ent.Schema
+}
+
+// Annotations of the IdempotentSend.
+func (IdempotentSend) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "idempotent_sends"},
+	}
+}
+
+// Fields of the IdempotentSend.
+func (IdempotentSend) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("wallet_id", uuid.UUID{}),
+		// Given by the client
+		field.String("send_id").MaxLen(256).Immutable(),
+		field.String("source").MaxLen(65).Immutable(),
+		field.String("destination").MaxLen(65).Immutable(),
+		// Raw amount, as a string since it can exceed 64 bits
+		field.String("amount").MaxLen(64).Immutable(),
+		field.String("block_hash").MaxLen(64).Nillable().Optional(),
+		// pending until the send either published or failed, also if Pippin stopped during it
+		field.Enum("status").Values("pending", "sent", "failed").Default("pending"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the IdempotentSend.
+func (IdempotentSend) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("wallet", Wallet.Type).
+			Ref("idempotent_sends").
+			Field("wallet_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the IdempotentSend.
+func (IdempotentSend) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_id", "send_id").Unique(),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Job holds the schema definition for the Job entity.
+type Job struct {
+	ent.Schema
+}
+
+// Annotations of the Job.
+func (Job) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "jobs"},
+	}
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("wallet_id", uuid.UUID{}),
+		// The action running in the background, e.g. receive_all
+		field.String("action").MaxLen(64).Immutable(),
+		// running until it's done or failed, also if Pippin stopped during it
+		field.Enum("status").Values("pending", "running", "done", "failed").Default("pending"),
+		field.Int("percent").Default(0),
+		// The response of the action, partial until the job is done
+		field.JSON("result", map[string]interface{}{}).Optional(),
+		field.String("error").Nillable().Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Job.
+func (Job) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("wallet", Wallet.Type).
+			Ref("jobs").
+			Field("wallet_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the Job.
+func (Job) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
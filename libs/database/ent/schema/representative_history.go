@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RepresentativeHistory holds the schema definition for the RepresentativeHistory entity.
+type RepresentativeHistory struct {
+	ent.Schema
+}
+
+// Annotations of the RepresentativeHistory.
+func (RepresentativeHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "representative_history"},
+	}
+}
+
+// Fields of the RepresentativeHistory.
+func (RepresentativeHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("account_id", uuid.UUID{}),
+		// The representative before the change, from the node's account_info
+		field.String("old_representative").MaxLen(65).Immutable(),
+		field.String("new_representative").MaxLen(65).Immutable(),
+		// The change block
+		field.String("block_hash").MaxLen(64).Immutable(),
+		field.Time("changed_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the RepresentativeHistory.
+func (RepresentativeHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("representative_history").
+			Field("account_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the RepresentativeHistory.
+func (RepresentativeHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "changed_at"),
+	}
+}
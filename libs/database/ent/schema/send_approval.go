@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SendApproval holds the schema definition for the SendApproval entity.
+type SendApproval struct {
+	ent.Schema
+}
+
+// Annotations of the SendApproval.
+func (SendApproval) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "send_approvals"},
+	}
+}
+
+// Fields of the SendApproval.
+func (SendApproval) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("wallet_id", uuid.UUID{}),
+		// The id of the send if it was given, otherwise the approval's id is used, so the block is only published once
+		field.String("send_id").MaxLen(256).Nillable().Optional().Immutable(),
+		field.String("source").MaxLen(65).Immutable(),
+		field.String("destination").MaxLen(65).Immutable(),
+		// Raw amount, as a string since it can exceed 64 bits
+		field.String("amount").MaxLen(64).Immutable(),
+		field.String("work").MaxLen(16).Nillable().Optional().Immutable(),
+		// The wallet's approvals_required when the send was made
+		field.Int("approvals_required").Positive().Immutable(),
+		// IDs of the approver API keys that approved it
+		field.Strings("approved_by").Optional(),
+		// The API key that made the send, it can't approve it
+		field.UUID("requested_by", uuid.UUID{}).Nillable().Optional().Immutable(),
+		field.Enum("status").Values("pending", "sent", "rejected").Default("pending"),
+		field.String("block_hash").MaxLen(64).Nillable().Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		// When it was sent or rejected
+		field.Time("resolved_at").Nillable().Optional(),
+	}
+}
+
+// Edges of the SendApproval.
+func (SendApproval) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("wallet", Wallet.Type).
+			Ref("send_approvals").
+			Field("wallet_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the SendApproval.
+func (SendApproval) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_id", "status"),
+		index.Fields("wallet_id", "send_id"),
+	}
+}
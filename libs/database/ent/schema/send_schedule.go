@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SendSchedule holds the schema definition for the SendSchedule entity.
+type SendSchedule struct {
+	ent.Schema
+}
+
+// Annotations of the SendSchedule.
+func (SendSchedule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "send_schedules"},
+	}
+}
+
+// Fields of the SendSchedule.
+func (SendSchedule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("wallet_id", uuid.UUID{}),
+		field.String("source").MaxLen(65).Immutable(),
+		field.String("destination").MaxLen(65).Immutable(),
+		// Raw amount, as a string since it can exceed 64 bits
+		field.String("amount").MaxLen(64).Immutable(),
+		field.Int("interval_seconds").Positive().Immutable(),
+		// The next time a send is due
+		field.Time("next_run_at"),
+		// Why the last send that failed failed and when, kept after later sends work
+		field.String("last_error").Nillable().Optional(),
+		field.Time("last_failed_at").Nillable().Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the SendSchedule.
+func (SendSchedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("wallet", Wallet.Type).
+			Ref("send_schedules").
+			Field("wallet_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the SendSchedule.
+func (SendSchedule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_id"),
+		index.Fields("next_run_at"),
+	}
+}
@@ -31,8 +31,24 @@ func (Wallet) Fields() []ent.Field {
 		// Large enough to store encrypted keys, which have more bits
 		field.String("seed").MaxLen(512).Unique(),
 		field.String("representative").MaxLen(65).Nillable().Optional(),
+		field.String("name").MaxLen(128).Nillable().Optional(),
 		field.Bool("encrypted").Default(false),
 		field.Bool("work").Default(true),
+		// Watch-only wallets only have accounts added by address, they can't sign, the seed is a placeholder since it's unique
+		field.Bool("watch_only").Default(false),
+		// Hardware wallets sign on a Ledger, their accounts only have an index, the seed is a placeholder like a watch-only wallet's
+		field.Bool("hardware").Default(false),
+		// Pending blocks of its accounts are received in the background, if they're at least its receive minimum
+		field.Bool("auto_receive").Default(true),
+		// In raw, the config's receive_minimum is used if it's not set
+		field.String("receive_minimum").MaxLen(64).Nillable().Optional(),
+		// Set while the wallet is frozen, nothing can be signed for it until it's unfrozen
+		field.Time("frozen_at").Nillable().Optional(),
+		// The Argon2id parameters and salt the key of an encrypted wallet is derived with, encrypted without it the key is the password's SHA-256
+		field.String("kdf").MaxLen(128).Nillable().Optional(),
+		// Sends of more than approval_threshold raw need this many approvals from approver API keys, no approvals are needed if it's not set
+		field.Int("approvals_required").Positive().Nillable().Optional(),
+		field.String("approval_threshold").MaxLen(64).Nillable().Optional(),
 		field.Time("created_at").Default(time.Now).Immutable(),
 	}
 }
@@ -44,5 +60,41 @@ func (Wallet) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		edge.To("send_schedules", SendSchedule.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("balance_alerts", BalanceAlert.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("idempotency_keys", IdempotencyKey.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("idempotent_sends", IdempotentSend.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("wallet_snapshots", WalletSnapshot.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("jobs", Job.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("spends", WalletSpend.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("send_approvals", SendApproval.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("blocks", Block.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
 	}
 }
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WalletSnapshot holds the schema definition for the WalletSnapshot entity.
+type WalletSnapshot struct {
+	ent.Schema
+}
+
+// Annotations of the WalletSnapshot.
+func (WalletSnapshot) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "wallet_snapshots"},
+	}
+}
+
+// Fields of the WalletSnapshot.
+func (WalletSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("wallet_id", uuid.UUID{}),
+		field.String("label").MaxLen(128).Nillable().Optional().Immutable(),
+		// Sum of the balances, as a string since it can exceed 64 bits
+		field.String("total_raw").MaxLen(64).Immutable(),
+		field.Int("account_count").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the WalletSnapshot.
+func (WalletSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("wallet", Wallet.Type).
+			Ref("wallet_snapshots").
+			Field("wallet_id").
+			Required().
+			Unique(),
+		// The balance of every account, the rows are in balance_snapshots with the periodic ones
+		edge.To("balances", BalanceSnapshot.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+// Indexes of the WalletSnapshot.
+func (WalletSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_id", "created_at"),
+	}
+}
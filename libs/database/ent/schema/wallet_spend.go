@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WalletSpend holds the schema definition for the WalletSpend entity.
+type WalletSpend struct {
+	ent.Schema
+}
+
+// Annotations of the WalletSpend.
+func (WalletSpend) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "wallet_spends"},
+	}
+}
+
+// Fields of the WalletSpend.
+func (WalletSpend) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New),
+		field.UUID("wallet_id", uuid.UUID{}),
+		// Raw amount of the send, as a string since it can exceed 64 bits
+		field.String("amount").MaxLen(64).Immutable(),
+		field.String("block_hash").MaxLen(64).Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the WalletSpend.
+func (WalletSpend) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("wallet", Wallet.Type).
+			Ref("spends").
+			Field("wallet_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the WalletSpend.
+func (WalletSpend) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("wallet_id", "created_at"),
+	}
+}
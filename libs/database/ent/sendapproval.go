@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// SendApproval is the model entity for the SendApproval schema.
+type SendApproval struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WalletID holds the value of the "wallet_id" field.
+	WalletID uuid.UUID `json:"wallet_id,omitempty"`
+	// SendID holds the value of the "send_id" field.
+	SendID *string `json:"send_id,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// Destination holds the value of the "destination" field.
+	Destination string `json:"destination,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount string `json:"amount,omitempty"`
+	// Work holds the value of the "work" field.
+	Work *string `json:"work,omitempty"`
+	// ApprovalsRequired holds the value of the "approvals_required" field.
+	ApprovalsRequired int `json:"approvals_required,omitempty"`
+	// ApprovedBy holds the value of the "approved_by" field.
+	ApprovedBy []string `json:"approved_by,omitempty"`
+	// RequestedBy holds the value of the "requested_by" field.
+	RequestedBy *uuid.UUID `json:"requested_by,omitempty"`
+	// Status holds the value of the "status" field.
+	Status sendapproval.Status `json:"status,omitempty"`
+	// BlockHash holds the value of the "block_hash" field.
+	BlockHash *string `json:"block_hash,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SendApprovalQuery when eager-loading is set.
+	Edges SendApprovalEdges `json:"edges"`
+}
+
+// SendApprovalEdges holds the relations/edges for other nodes in the graph.
+type SendApprovalEdges struct {
+	// Wallet holds the value of the wallet edge.
+	Wallet *Wallet `json:"wallet,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WalletOrErr returns the Wallet value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SendApprovalEdges) WalletOrErr() (*Wallet, error) {
+	if e.loadedTypes[0] {
+		if e.Wallet == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: wallet.Label}
+		}
+		return e.Wallet, nil
+	}
+	return nil, &NotLoadedError{edge: "wallet"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SendApproval) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sendapproval.FieldRequestedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case sendapproval.FieldApprovedBy:
+			values[i] = new([]byte)
+		case sendapproval.FieldApprovalsRequired:
+			values[i] = new(sql.NullInt64)
+		case sendapproval.FieldSendID, sendapproval.FieldSource, sendapproval.FieldDestination, sendapproval.FieldAmount, sendapproval.FieldWork, sendapproval.FieldStatus, sendapproval.FieldBlockHash:
+			values[i] = new(sql.NullString)
+		case sendapproval.FieldCreatedAt, sendapproval.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		case sendapproval.FieldID, sendapproval.FieldWalletID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type SendApproval", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SendApproval fields.
+func (sa *SendApproval) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sendapproval.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sa.ID = *value
+			}
+		case sendapproval.FieldWalletID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_id", values[i])
+			} else if value != nil {
+				sa.WalletID = *value
+			}
+		case sendapproval.FieldSendID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field send_id", values[i])
+			} else if value.Valid {
+				sa.SendID = new(string)
+				*sa.SendID = value.String
+			}
+		case sendapproval.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				sa.Source = value.String
+			}
+		case sendapproval.FieldDestination:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field destination", values[i])
+			} else if value.Valid {
+				sa.Destination = value.String
+			}
+		case sendapproval.FieldAmount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				sa.Amount = value.String
+			}
+		case sendapproval.FieldWork:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field work", values[i])
+			} else if value.Valid {
+				sa.Work = new(string)
+				*sa.Work = value.String
+			}
+		case sendapproval.FieldApprovalsRequired:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approvals_required", values[i])
+			} else if value.Valid {
+				sa.ApprovalsRequired = int(value.Int64)
+			}
+		case sendapproval.FieldApprovedBy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_by", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sa.ApprovedBy); err != nil {
+					return fmt.Errorf("unmarshal field approved_by: %w", err)
+				}
+			}
+		case sendapproval.FieldRequestedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value.Valid {
+				sa.RequestedBy = new(uuid.UUID)
+				*sa.RequestedBy = *value.S.(*uuid.UUID)
+			}
+		case sendapproval.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sa.Status = sendapproval.Status(value.String)
+			}
+		case sendapproval.FieldBlockHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field block_hash", values[i])
+			} else if value.Valid {
+				sa.BlockHash = new(string)
+				*sa.BlockHash = value.String
+			}
+		case sendapproval.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sa.CreatedAt = value.Time
+			}
+		case sendapproval.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				sa.ResolvedAt = new(time.Time)
+				*sa.ResolvedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryWallet queries the "wallet" edge of the SendApproval entity.
+func (sa *SendApproval) QueryWallet() *WalletQuery {
+	return (&SendApprovalClient{config: sa.config}).QueryWallet(sa)
+}
+
+// Update returns a builder for updating this SendApproval.
+// Note that you need to call SendApproval.Unwrap() before calling this method if this SendApproval
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sa *SendApproval) Update() *SendApprovalUpdateOne {
+	return (&SendApprovalClient{config: sa.config}).UpdateOne(sa)
+}
+
+// Unwrap unwraps the SendApproval entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sa *SendApproval) Unwrap() *SendApproval {
+	_tx, ok := sa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SendApproval is not a transactional entity")
+	}
+	sa.config.driver = _tx.drv
+	return sa
+}
+
+// String implements the fmt.Stringer.
+func (sa *SendApproval) String() string {
+	var builder strings.Builder
+	builder.WriteString("SendApproval(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sa.ID))
+	builder.WriteString("wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", sa.WalletID))
+	builder.WriteString(", ")
+	if v := sa.SendID; v != nil {
+		builder.WriteString("send_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(sa.Source)
+	builder.WriteString(", ")
+	builder.WriteString("destination=")
+	builder.WriteString(sa.Destination)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(sa.Amount)
+	builder.WriteString(", ")
+	if v := sa.Work; v != nil {
+		builder.WriteString("work=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("approvals_required=")
+	builder.WriteString(fmt.Sprintf("%v", sa.ApprovalsRequired))
+	builder.WriteString(", ")
+	builder.WriteString("approved_by=")
+	builder.WriteString(fmt.Sprintf("%v", sa.ApprovedBy))
+	builder.WriteString(", ")
+	if v := sa.RequestedBy; v != nil {
+		builder.WriteString("requested_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", sa.Status))
+	builder.WriteString(", ")
+	if v := sa.BlockHash; v != nil {
+		builder.WriteString("block_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := sa.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SendApprovals is a parsable slice of SendApproval.
+type SendApprovals []*SendApproval
+
+func (sa SendApprovals) config(cfg config) {
+	for _i := range sa {
+		sa[_i].config = cfg
+	}
+}
@@ -0,0 +1,130 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sendapproval
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the sendapproval type in the database.
+	Label = "send_approval"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWalletID holds the string denoting the wallet_id field in the database.
+	FieldWalletID = "wallet_id"
+	// FieldSendID holds the string denoting the send_id field in the database.
+	FieldSendID = "send_id"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldDestination holds the string denoting the destination field in the database.
+	FieldDestination = "destination"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldWork holds the string denoting the work field in the database.
+	FieldWork = "work"
+	// FieldApprovalsRequired holds the string denoting the approvals_required field in the database.
+	FieldApprovalsRequired = "approvals_required"
+	// FieldApprovedBy holds the string denoting the approved_by field in the database.
+	FieldApprovedBy = "approved_by"
+	// FieldRequestedBy holds the string denoting the requested_by field in the database.
+	FieldRequestedBy = "requested_by"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldBlockHash holds the string denoting the block_hash field in the database.
+	FieldBlockHash = "block_hash"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// EdgeWallet holds the string denoting the wallet edge name in mutations.
+	EdgeWallet = "wallet"
+	// Table holds the table name of the sendapproval in the database.
+	Table = "send_approvals"
+	// WalletTable is the table that holds the wallet relation/edge.
+	WalletTable = "send_approvals"
+	// WalletInverseTable is the table name for the Wallet entity.
+	// It exists in this package in order to avoid circular dependency with the "wallet" package.
+	WalletInverseTable = "wallets"
+	// WalletColumn is the table column denoting the wallet relation/edge.
+	WalletColumn = "wallet_id"
+)
+
+// Columns holds all SQL columns for sendapproval fields.
+var Columns = []string{
+	FieldID,
+	FieldWalletID,
+	FieldSendID,
+	FieldSource,
+	FieldDestination,
+	FieldAmount,
+	FieldWork,
+	FieldApprovalsRequired,
+	FieldApprovedBy,
+	FieldRequestedBy,
+	FieldStatus,
+	FieldBlockHash,
+	FieldCreatedAt,
+	FieldResolvedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SendIDValidator is a validator for the "send_id" field. It is called by the builders before save.
+	SendIDValidator func(string) error
+	// SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	SourceValidator func(string) error
+	// DestinationValidator is a validator for the "destination" field. It is called by the builders before save.
+	DestinationValidator func(string) error
+	// AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	AmountValidator func(string) error
+	// WorkValidator is a validator for the "work" field. It is called by the builders before save.
+	WorkValidator func(string) error
+	// ApprovalsRequiredValidator is a validator for the "approvals_required" field. It is called by the builders before save.
+	ApprovalsRequiredValidator func(int) error
+	// BlockHashValidator is a validator for the "block_hash" field. It is called by the builders before save.
+	BlockHashValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusSent     Status = "sent"
+	StatusRejected Status = "rejected"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusSent, StatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("sendapproval: invalid enum value for status field: %q", s)
+	}
+}
@@ -0,0 +1,568 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// SendApprovalCreate is the builder for creating a SendApproval entity.
+type SendApprovalCreate struct {
+	config
+	mutation *SendApprovalMutation
+	hooks    []Hook
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (sac *SendApprovalCreate) SetWalletID(u uuid.UUID) *SendApprovalCreate {
+	sac.mutation.SetWalletID(u)
+	return sac
+}
+
+// SetSendID sets the "send_id" field.
+func (sac *SendApprovalCreate) SetSendID(s string) *SendApprovalCreate {
+	sac.mutation.SetSendID(s)
+	return sac
+}
+
+// SetNillableSendID sets the "send_id" field if the given value is not nil.
+func (sac *SendApprovalCreate) SetNillableSendID(s *string) *SendApprovalCreate {
+	if s != nil {
+		sac.SetSendID(*s)
+	}
+	return sac
+}
+
+// SetSource sets the "source" field.
+func (sac *SendApprovalCreate) SetSource(s string) *SendApprovalCreate {
+	sac.mutation.SetSource(s)
+	return sac
+}
+
+// SetDestination sets the "destination" field.
+func (sac *SendApprovalCreate) SetDestination(s string) *SendApprovalCreate {
+	sac.mutation.SetDestination(s)
+	return sac
+}
+
+// SetAmount sets the "amount" field.
+func (sac *SendApprovalCreate) SetAmount(s string) *SendApprovalCreate {
+	sac.mutation.SetAmount(s)
+	return sac
+}
+
+// SetWork sets the "work" field.
+func (sac *SendApprovalCreate) SetWork(s string) *SendApprovalCreate {
+	sac.mutation.SetWork(s)
+	return sac
+}
+
+// SetNillableWork sets the "work" field if the given value is not nil.
+func (sac *SendApprovalCreate) SetNillableWork(s *string) *SendApprovalCreate {
+	if s != nil {
+		sac.SetWork(*s)
+	}
+	return sac
+}
+
+// SetApprovalsRequired sets the "approvals_required" field.
+func (sac *SendApprovalCreate) SetApprovalsRequired(i int) *SendApprovalCreate {
+	sac.mutation.SetApprovalsRequired(i)
+	return sac
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (sac *SendApprovalCreate) SetApprovedBy(s []string) *SendApprovalCreate {
+	sac.mutation.SetApprovedBy(s)
+	return sac
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (sac *SendApprovalCreate) SetRequestedBy(u uuid.UUID) *SendApprovalCreate {
+	sac.mutation.SetRequestedBy(u)
+	return sac
+}
+
+// SetNillableRequestedBy sets the "requested_by" field if the given value is not nil.
+func (sac *SendApprovalCreate) SetNillableRequestedBy(u *uuid.UUID) *SendApprovalCreate {
+	if u != nil {
+		sac.SetRequestedBy(*u)
+	}
+	return sac
+}
+
+// SetStatus sets the "status" field.
+func (sac *SendApprovalCreate) SetStatus(s sendapproval.Status) *SendApprovalCreate {
+	sac.mutation.SetStatus(s)
+	return sac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sac *SendApprovalCreate) SetNillableStatus(s *sendapproval.Status) *SendApprovalCreate {
+	if s != nil {
+		sac.SetStatus(*s)
+	}
+	return sac
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (sac *SendApprovalCreate) SetBlockHash(s string) *SendApprovalCreate {
+	sac.mutation.SetBlockHash(s)
+	return sac
+}
+
+// SetNillableBlockHash sets the "block_hash" field if the given value is not nil.
+func (sac *SendApprovalCreate) SetNillableBlockHash(s *string) *SendApprovalCreate {
+	if s != nil {
+		sac.SetBlockHash(*s)
+	}
+	return sac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sac *SendApprovalCreate) SetCreatedAt(t time.Time) *SendApprovalCreate {
+	sac.mutation.SetCreatedAt(t)
+	return sac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sac *SendApprovalCreate) SetNillableCreatedAt(t *time.Time) *SendApprovalCreate {
+	if t != nil {
+		sac.SetCreatedAt(*t)
+	}
+	return sac
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (sac *SendApprovalCreate) SetResolvedAt(t time.Time) *SendApprovalCreate {
+	sac.mutation.SetResolvedAt(t)
+	return sac
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (sac *SendApprovalCreate) SetNillableResolvedAt(t *time.Time) *SendApprovalCreate {
+	if t != nil {
+		sac.SetResolvedAt(*t)
+	}
+	return sac
+}
+
+// SetID sets the "id" field.
+func (sac *SendApprovalCreate) SetID(u uuid.UUID) *SendApprovalCreate {
+	sac.mutation.SetID(u)
+	return sac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sac *SendApprovalCreate) SetNillableID(u *uuid.UUID) *SendApprovalCreate {
+	if u != nil {
+		sac.SetID(*u)
+	}
+	return sac
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (sac *SendApprovalCreate) SetWallet(w *Wallet) *SendApprovalCreate {
+	return sac.SetWalletID(w.ID)
+}
+
+// Mutation returns the SendApprovalMutation object of the builder.
+func (sac *SendApprovalCreate) Mutation() *SendApprovalMutation {
+	return sac.mutation
+}
+
+// Save creates the SendApproval in the database.
+func (sac *SendApprovalCreate) Save(ctx context.Context) (*SendApproval, error) {
+	var (
+		err  error
+		node *SendApproval
+	)
+	sac.defaults()
+	if len(sac.hooks) == 0 {
+		if err = sac.check(); err != nil {
+			return nil, err
+		}
+		node, err = sac.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SendApprovalMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sac.check(); err != nil {
+				return nil, err
+			}
+			sac.mutation = mutation
+			if node, err = sac.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sac.hooks) - 1; i >= 0; i-- {
+			if sac.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sac.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, sac.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*SendApproval)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SendApprovalMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sac *SendApprovalCreate) SaveX(ctx context.Context) *SendApproval {
+	v, err := sac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sac *SendApprovalCreate) Exec(ctx context.Context) error {
+	_, err := sac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sac *SendApprovalCreate) ExecX(ctx context.Context) {
+	if err := sac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sac *SendApprovalCreate) defaults() {
+	if _, ok := sac.mutation.Status(); !ok {
+		v := sendapproval.DefaultStatus
+		sac.mutation.SetStatus(v)
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		v := sendapproval.DefaultCreatedAt()
+		sac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sac.mutation.ID(); !ok {
+		v := sendapproval.DefaultID()
+		sac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sac *SendApprovalCreate) check() error {
+	if _, ok := sac.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "SendApproval.wallet_id"`)}
+	}
+	if v, ok := sac.mutation.SendID(); ok {
+		if err := sendapproval.SendIDValidator(v); err != nil {
+			return &ValidationError{Name: "send_id", err: fmt.Errorf(`ent: validator failed for field "SendApproval.send_id": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "SendApproval.source"`)}
+	}
+	if v, ok := sac.mutation.Source(); ok {
+		if err := sendapproval.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "SendApproval.source": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.Destination(); !ok {
+		return &ValidationError{Name: "destination", err: errors.New(`ent: missing required field "SendApproval.destination"`)}
+	}
+	if v, ok := sac.mutation.Destination(); ok {
+		if err := sendapproval.DestinationValidator(v); err != nil {
+			return &ValidationError{Name: "destination", err: fmt.Errorf(`ent: validator failed for field "SendApproval.destination": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "SendApproval.amount"`)}
+	}
+	if v, ok := sac.mutation.Amount(); ok {
+		if err := sendapproval.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "SendApproval.amount": %w`, err)}
+		}
+	}
+	if v, ok := sac.mutation.Work(); ok {
+		if err := sendapproval.WorkValidator(v); err != nil {
+			return &ValidationError{Name: "work", err: fmt.Errorf(`ent: validator failed for field "SendApproval.work": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.ApprovalsRequired(); !ok {
+		return &ValidationError{Name: "approvals_required", err: errors.New(`ent: missing required field "SendApproval.approvals_required"`)}
+	}
+	if v, ok := sac.mutation.ApprovalsRequired(); ok {
+		if err := sendapproval.ApprovalsRequiredValidator(v); err != nil {
+			return &ValidationError{Name: "approvals_required", err: fmt.Errorf(`ent: validator failed for field "SendApproval.approvals_required": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SendApproval.status"`)}
+	}
+	if v, ok := sac.mutation.Status(); ok {
+		if err := sendapproval.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SendApproval.status": %w`, err)}
+		}
+	}
+	if v, ok := sac.mutation.BlockHash(); ok {
+		if err := sendapproval.BlockHashValidator(v); err != nil {
+			return &ValidationError{Name: "block_hash", err: fmt.Errorf(`ent: validator failed for field "SendApproval.block_hash": %w`, err)}
+		}
+	}
+	if _, ok := sac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SendApproval.created_at"`)}
+	}
+	if _, ok := sac.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet", err: errors.New(`ent: missing required edge "SendApproval.wallet"`)}
+	}
+	return nil
+}
+
+func (sac *SendApprovalCreate) sqlSave(ctx context.Context) (*SendApproval, error) {
+	_node, _spec := sac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (sac *SendApprovalCreate) createSpec() (*SendApproval, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SendApproval{config: sac.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: sendapproval.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: sendapproval.FieldID,
+			},
+		}
+	)
+	if id, ok := sac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sac.mutation.SendID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendapproval.FieldSendID,
+		})
+		_node.SendID = &value
+	}
+	if value, ok := sac.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendapproval.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := sac.mutation.Destination(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendapproval.FieldDestination,
+		})
+		_node.Destination = value
+	}
+	if value, ok := sac.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendapproval.FieldAmount,
+		})
+		_node.Amount = value
+	}
+	if value, ok := sac.mutation.Work(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendapproval.FieldWork,
+		})
+		_node.Work = &value
+	}
+	if value, ok := sac.mutation.ApprovalsRequired(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: sendapproval.FieldApprovalsRequired,
+		})
+		_node.ApprovalsRequired = value
+	}
+	if value, ok := sac.mutation.ApprovedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: sendapproval.FieldApprovedBy,
+		})
+		_node.ApprovedBy = value
+	}
+	if value, ok := sac.mutation.RequestedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: sendapproval.FieldRequestedBy,
+		})
+		_node.RequestedBy = &value
+	}
+	if value, ok := sac.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: sendapproval.FieldStatus,
+		})
+		_node.Status = value
+	}
+	if value, ok := sac.mutation.BlockHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendapproval.FieldBlockHash,
+		})
+		_node.BlockHash = &value
+	}
+	if value, ok := sac.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sendapproval.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := sac.mutation.ResolvedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sendapproval.FieldResolvedAt,
+		})
+		_node.ResolvedAt = &value
+	}
+	if nodes := sac.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sendapproval.WalletTable,
+			Columns: []string{sendapproval.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WalletID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SendApprovalCreateBulk is the builder for creating many SendApproval entities in bulk.
+type SendApprovalCreateBulk struct {
+	config
+	builders []*SendApprovalCreate
+}
+
+// Save creates the SendApproval entities in the database.
+func (sacb *SendApprovalCreateBulk) Save(ctx context.Context) ([]*SendApproval, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sacb.builders))
+	nodes := make([]*SendApproval, len(sacb.builders))
+	mutators := make([]Mutator, len(sacb.builders))
+	for i := range sacb.builders {
+		func(i int, root context.Context) {
+			builder := sacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SendApprovalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sacb *SendApprovalCreateBulk) SaveX(ctx context.Context) []*SendApproval {
+	v, err := sacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sacb *SendApprovalCreateBulk) Exec(ctx context.Context) error {
+	_, err := sacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sacb *SendApprovalCreateBulk) ExecX(ctx context.Context) {
+	if err := sacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+)
+
+// SendApprovalDelete is the builder for deleting a SendApproval entity.
+type SendApprovalDelete struct {
+	config
+	hooks    []Hook
+	mutation *SendApprovalMutation
+}
+
+// Where appends a list predicates to the SendApprovalDelete builder.
+func (sad *SendApprovalDelete) Where(ps ...predicate.SendApproval) *SendApprovalDelete {
+	sad.mutation.Where(ps...)
+	return sad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sad *SendApprovalDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sad.hooks) == 0 {
+		affected, err = sad.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SendApprovalMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sad.mutation = mutation
+			affected, err = sad.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sad.hooks) - 1; i >= 0; i-- {
+			if sad.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sad.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sad.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sad *SendApprovalDelete) ExecX(ctx context.Context) int {
+	n, err := sad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sad *SendApprovalDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: sendapproval.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: sendapproval.FieldID,
+			},
+		},
+	}
+	if ps := sad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// SendApprovalDeleteOne is the builder for deleting a single SendApproval entity.
+type SendApprovalDeleteOne struct {
+	sad *SendApprovalDelete
+}
+
+// Exec executes the deletion query.
+func (sado *SendApprovalDeleteOne) Exec(ctx context.Context) error {
+	n, err := sado.sad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{sendapproval.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sado *SendApprovalDeleteOne) ExecX(ctx context.Context) {
+	sado.sad.ExecX(ctx)
+}
@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// SendApprovalQuery is the builder for querying SendApproval entities.
+type SendApprovalQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.SendApproval
+	withWallet *WalletQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SendApprovalQuery builder.
+func (saq *SendApprovalQuery) Where(ps ...predicate.SendApproval) *SendApprovalQuery {
+	saq.predicates = append(saq.predicates, ps...)
+	return saq
+}
+
+// Limit adds a limit step to the query.
+func (saq *SendApprovalQuery) Limit(limit int) *SendApprovalQuery {
+	saq.limit = &limit
+	return saq
+}
+
+// Offset adds an offset step to the query.
+func (saq *SendApprovalQuery) Offset(offset int) *SendApprovalQuery {
+	saq.offset = &offset
+	return saq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (saq *SendApprovalQuery) Unique(unique bool) *SendApprovalQuery {
+	saq.unique = &unique
+	return saq
+}
+
+// Order adds an order step to the query.
+func (saq *SendApprovalQuery) Order(o ...OrderFunc) *SendApprovalQuery {
+	saq.order = append(saq.order, o...)
+	return saq
+}
+
+// QueryWallet chains the current query on the "wallet" edge.
+func (saq *SendApprovalQuery) QueryWallet() *WalletQuery {
+	query := &WalletQuery{config: saq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := saq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := saq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sendapproval.Table, sendapproval.FieldID, selector),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sendapproval.WalletTable, sendapproval.WalletColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(saq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SendApproval entity from the query.
+// Returns a *NotFoundError when no SendApproval was found.
+func (saq *SendApprovalQuery) First(ctx context.Context) (*SendApproval, error) {
+	nodes, err := saq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sendapproval.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (saq *SendApprovalQuery) FirstX(ctx context.Context) *SendApproval {
+	node, err := saq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SendApproval ID from the query.
+// Returns a *NotFoundError when no SendApproval ID was found.
+func (saq *SendApprovalQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = saq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sendapproval.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (saq *SendApprovalQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := saq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SendApproval entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SendApproval entity is found.
+// Returns a *NotFoundError when no SendApproval entities are found.
+func (saq *SendApprovalQuery) Only(ctx context.Context) (*SendApproval, error) {
+	nodes, err := saq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sendapproval.Label}
+	default:
+		return nil, &NotSingularError{sendapproval.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (saq *SendApprovalQuery) OnlyX(ctx context.Context) *SendApproval {
+	node, err := saq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SendApproval ID in the query.
+// Returns a *NotSingularError when more than one SendApproval ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (saq *SendApprovalQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = saq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sendapproval.Label}
+	default:
+		err = &NotSingularError{sendapproval.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (saq *SendApprovalQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := saq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SendApprovals.
+func (saq *SendApprovalQuery) All(ctx context.Context) ([]*SendApproval, error) {
+	if err := saq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return saq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (saq *SendApprovalQuery) AllX(ctx context.Context) []*SendApproval {
+	nodes, err := saq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SendApproval IDs.
+func (saq *SendApprovalQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := saq.Select(sendapproval.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (saq *SendApprovalQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := saq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (saq *SendApprovalQuery) Count(ctx context.Context) (int, error) {
+	if err := saq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return saq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (saq *SendApprovalQuery) CountX(ctx context.Context) int {
+	count, err := saq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (saq *SendApprovalQuery) Exist(ctx context.Context) (bool, error) {
+	if err := saq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return saq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (saq *SendApprovalQuery) ExistX(ctx context.Context) bool {
+	exist, err := saq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SendApprovalQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (saq *SendApprovalQuery) Clone() *SendApprovalQuery {
+	if saq == nil {
+		return nil
+	}
+	return &SendApprovalQuery{
+		config:     saq.config,
+		limit:      saq.limit,
+		offset:     saq.offset,
+		order:      append([]OrderFunc{}, saq.order...),
+		predicates: append([]predicate.SendApproval{}, saq.predicates...),
+		withWallet: saq.withWallet.Clone(),
+		// clone intermediate query.
+		sql:    saq.sql.Clone(),
+		path:   saq.path,
+		unique: saq.unique,
+	}
+}
+
+// WithWallet tells the query-builder to eager-load the nodes that are connected to
+// the "wallet" edge. The optional arguments are used to configure the query builder of the edge.
+func (saq *SendApprovalQuery) WithWallet(opts ...func(*WalletQuery)) *SendApprovalQuery {
+	query := &WalletQuery{config: saq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	saq.withWallet = query
+	return saq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SendApproval.Query().
+//		GroupBy(sendapproval.FieldWalletID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (saq *SendApprovalQuery) GroupBy(field string, fields ...string) *SendApprovalGroupBy {
+	grbuild := &SendApprovalGroupBy{config: saq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := saq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return saq.sqlQuery(ctx), nil
+	}
+	grbuild.label = sendapproval.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//	}
+//
+//	client.SendApproval.Query().
+//		Select(sendapproval.FieldWalletID).
+//		Scan(ctx, &v)
+func (saq *SendApprovalQuery) Select(fields ...string) *SendApprovalSelect {
+	saq.fields = append(saq.fields, fields...)
+	selbuild := &SendApprovalSelect{SendApprovalQuery: saq}
+	selbuild.label = sendapproval.Label
+	selbuild.flds, selbuild.scan = &saq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (saq *SendApprovalQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range saq.fields {
+		if !sendapproval.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if saq.path != nil {
+		prev, err := saq.path(ctx)
+		if err != nil {
+			return err
+		}
+		saq.sql = prev
+	}
+	return nil
+}
+
+func (saq *SendApprovalQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SendApproval, error) {
+	var (
+		nodes       = []*SendApproval{}
+		_spec       = saq.querySpec()
+		loadedTypes = [1]bool{
+			saq.withWallet != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*SendApproval).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &SendApproval{config: saq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, saq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := saq.withWallet; query != nil {
+		if err := saq.loadWallet(ctx, query, nodes, nil,
+			func(n *SendApproval, e *Wallet) { n.Edges.Wallet = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (saq *SendApprovalQuery) loadWallet(ctx context.Context, query *WalletQuery, nodes []*SendApproval, init func(*SendApproval), assign func(*SendApproval, *Wallet)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*SendApproval)
+	for i := range nodes {
+		fk := nodes[i].WalletID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(wallet.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (saq *SendApprovalQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := saq.querySpec()
+	_spec.Node.Columns = saq.fields
+	if len(saq.fields) > 0 {
+		_spec.Unique = saq.unique != nil && *saq.unique
+	}
+	return sqlgraph.CountNodes(ctx, saq.driver, _spec)
+}
+
+func (saq *SendApprovalQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := saq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (saq *SendApprovalQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sendapproval.Table,
+			Columns: sendapproval.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: sendapproval.FieldID,
+			},
+		},
+		From:   saq.sql,
+		Unique: true,
+	}
+	if unique := saq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := saq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sendapproval.FieldID)
+		for i := range fields {
+			if fields[i] != sendapproval.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := saq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := saq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := saq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := saq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (saq *SendApprovalQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(saq.driver.Dialect())
+	t1 := builder.Table(sendapproval.Table)
+	columns := saq.fields
+	if len(columns) == 0 {
+		columns = sendapproval.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if saq.sql != nil {
+		selector = saq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if saq.unique != nil && *saq.unique {
+		selector.Distinct()
+	}
+	for _, p := range saq.predicates {
+		p(selector)
+	}
+	for _, p := range saq.order {
+		p(selector)
+	}
+	if offset := saq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := saq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SendApprovalGroupBy is the group-by builder for SendApproval entities.
+type SendApprovalGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sagb *SendApprovalGroupBy) Aggregate(fns ...AggregateFunc) *SendApprovalGroupBy {
+	sagb.fns = append(sagb.fns, fns...)
+	return sagb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (sagb *SendApprovalGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := sagb.path(ctx)
+	if err != nil {
+		return err
+	}
+	sagb.sql = query
+	return sagb.sqlScan(ctx, v)
+}
+
+func (sagb *SendApprovalGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range sagb.fields {
+		if !sendapproval.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := sagb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sagb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (sagb *SendApprovalGroupBy) sqlQuery() *sql.Selector {
+	selector := sagb.sql.Select()
+	aggregation := make([]string, 0, len(sagb.fns))
+	for _, fn := range sagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(sagb.fields)+len(sagb.fns))
+		for _, f := range sagb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(sagb.fields...)...)
+}
+
+// SendApprovalSelect is the builder for selecting fields of SendApproval entities.
+type SendApprovalSelect struct {
+	*SendApprovalQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sas *SendApprovalSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := sas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	sas.sql = sas.SendApprovalQuery.sqlQuery(ctx)
+	return sas.sqlScan(ctx, v)
+}
+
+func (sas *SendApprovalSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := sas.sql.Query()
+	if err := sas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,660 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// SendApprovalUpdate is the builder for updating SendApproval entities.
+type SendApprovalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SendApprovalMutation
+}
+
+// Where appends a list predicates to the SendApprovalUpdate builder.
+func (sau *SendApprovalUpdate) Where(ps ...predicate.SendApproval) *SendApprovalUpdate {
+	sau.mutation.Where(ps...)
+	return sau
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (sau *SendApprovalUpdate) SetWalletID(u uuid.UUID) *SendApprovalUpdate {
+	sau.mutation.SetWalletID(u)
+	return sau
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (sau *SendApprovalUpdate) SetApprovedBy(s []string) *SendApprovalUpdate {
+	sau.mutation.SetApprovedBy(s)
+	return sau
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (sau *SendApprovalUpdate) ClearApprovedBy() *SendApprovalUpdate {
+	sau.mutation.ClearApprovedBy()
+	return sau
+}
+
+// SetStatus sets the "status" field.
+func (sau *SendApprovalUpdate) SetStatus(s sendapproval.Status) *SendApprovalUpdate {
+	sau.mutation.SetStatus(s)
+	return sau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sau *SendApprovalUpdate) SetNillableStatus(s *sendapproval.Status) *SendApprovalUpdate {
+	if s != nil {
+		sau.SetStatus(*s)
+	}
+	return sau
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (sau *SendApprovalUpdate) SetBlockHash(s string) *SendApprovalUpdate {
+	sau.mutation.SetBlockHash(s)
+	return sau
+}
+
+// SetNillableBlockHash sets the "block_hash" field if the given value is not nil.
+func (sau *SendApprovalUpdate) SetNillableBlockHash(s *string) *SendApprovalUpdate {
+	if s != nil {
+		sau.SetBlockHash(*s)
+	}
+	return sau
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (sau *SendApprovalUpdate) ClearBlockHash() *SendApprovalUpdate {
+	sau.mutation.ClearBlockHash()
+	return sau
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (sau *SendApprovalUpdate) SetResolvedAt(t time.Time) *SendApprovalUpdate {
+	sau.mutation.SetResolvedAt(t)
+	return sau
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (sau *SendApprovalUpdate) SetNillableResolvedAt(t *time.Time) *SendApprovalUpdate {
+	if t != nil {
+		sau.SetResolvedAt(*t)
+	}
+	return sau
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (sau *SendApprovalUpdate) ClearResolvedAt() *SendApprovalUpdate {
+	sau.mutation.ClearResolvedAt()
+	return sau
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (sau *SendApprovalUpdate) SetWallet(w *Wallet) *SendApprovalUpdate {
+	return sau.SetWalletID(w.ID)
+}
+
+// Mutation returns the SendApprovalMutation object of the builder.
+func (sau *SendApprovalUpdate) Mutation() *SendApprovalMutation {
+	return sau.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (sau *SendApprovalUpdate) ClearWallet() *SendApprovalUpdate {
+	sau.mutation.ClearWallet()
+	return sau
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sau *SendApprovalUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(sau.hooks) == 0 {
+		if err = sau.check(); err != nil {
+			return 0, err
+		}
+		affected, err = sau.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SendApprovalMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sau.check(); err != nil {
+				return 0, err
+			}
+			sau.mutation = mutation
+			affected, err = sau.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(sau.hooks) - 1; i >= 0; i-- {
+			if sau.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sau.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sau.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sau *SendApprovalUpdate) SaveX(ctx context.Context) int {
+	affected, err := sau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sau *SendApprovalUpdate) Exec(ctx context.Context) error {
+	_, err := sau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sau *SendApprovalUpdate) ExecX(ctx context.Context) {
+	if err := sau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sau *SendApprovalUpdate) check() error {
+	if v, ok := sau.mutation.Status(); ok {
+		if err := sendapproval.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SendApproval.status": %w`, err)}
+		}
+	}
+	if v, ok := sau.mutation.BlockHash(); ok {
+		if err := sendapproval.BlockHashValidator(v); err != nil {
+			return &ValidationError{Name: "block_hash", err: fmt.Errorf(`ent: validator failed for field "SendApproval.block_hash": %w`, err)}
+		}
+	}
+	if _, ok := sau.mutation.WalletID(); sau.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SendApproval.wallet"`)
+	}
+	return nil
+}
+
+func (sau *SendApprovalUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sendapproval.Table,
+			Columns: sendapproval.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: sendapproval.FieldID,
+			},
+		},
+	}
+	if ps := sau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if sau.mutation.SendIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: sendapproval.FieldSendID,
+		})
+	}
+	if sau.mutation.WorkCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: sendapproval.FieldWork,
+		})
+	}
+	if value, ok := sau.mutation.ApprovedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: sendapproval.FieldApprovedBy,
+		})
+	}
+	if sau.mutation.ApprovedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: sendapproval.FieldApprovedBy,
+		})
+	}
+	if sau.mutation.RequestedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: sendapproval.FieldRequestedBy,
+		})
+	}
+	if value, ok := sau.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: sendapproval.FieldStatus,
+		})
+	}
+	if value, ok := sau.mutation.BlockHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendapproval.FieldBlockHash,
+		})
+	}
+	if sau.mutation.BlockHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: sendapproval.FieldBlockHash,
+		})
+	}
+	if value, ok := sau.mutation.ResolvedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sendapproval.FieldResolvedAt,
+		})
+	}
+	if sau.mutation.ResolvedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: sendapproval.FieldResolvedAt,
+		})
+	}
+	if sau.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sendapproval.WalletTable,
+			Columns: []string{sendapproval.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sau.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sendapproval.WalletTable,
+			Columns: []string{sendapproval.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sendapproval.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SendApprovalUpdateOne is the builder for updating a single SendApproval entity.
+type SendApprovalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SendApprovalMutation
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (sauo *SendApprovalUpdateOne) SetWalletID(u uuid.UUID) *SendApprovalUpdateOne {
+	sauo.mutation.SetWalletID(u)
+	return sauo
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (sauo *SendApprovalUpdateOne) SetApprovedBy(s []string) *SendApprovalUpdateOne {
+	sauo.mutation.SetApprovedBy(s)
+	return sauo
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (sauo *SendApprovalUpdateOne) ClearApprovedBy() *SendApprovalUpdateOne {
+	sauo.mutation.ClearApprovedBy()
+	return sauo
+}
+
+// SetStatus sets the "status" field.
+func (sauo *SendApprovalUpdateOne) SetStatus(s sendapproval.Status) *SendApprovalUpdateOne {
+	sauo.mutation.SetStatus(s)
+	return sauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sauo *SendApprovalUpdateOne) SetNillableStatus(s *sendapproval.Status) *SendApprovalUpdateOne {
+	if s != nil {
+		sauo.SetStatus(*s)
+	}
+	return sauo
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (sauo *SendApprovalUpdateOne) SetBlockHash(s string) *SendApprovalUpdateOne {
+	sauo.mutation.SetBlockHash(s)
+	return sauo
+}
+
+// SetNillableBlockHash sets the "block_hash" field if the given value is not nil.
+func (sauo *SendApprovalUpdateOne) SetNillableBlockHash(s *string) *SendApprovalUpdateOne {
+	if s != nil {
+		sauo.SetBlockHash(*s)
+	}
+	return sauo
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (sauo *SendApprovalUpdateOne) ClearBlockHash() *SendApprovalUpdateOne {
+	sauo.mutation.ClearBlockHash()
+	return sauo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (sauo *SendApprovalUpdateOne) SetResolvedAt(t time.Time) *SendApprovalUpdateOne {
+	sauo.mutation.SetResolvedAt(t)
+	return sauo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (sauo *SendApprovalUpdateOne) SetNillableResolvedAt(t *time.Time) *SendApprovalUpdateOne {
+	if t != nil {
+		sauo.SetResolvedAt(*t)
+	}
+	return sauo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (sauo *SendApprovalUpdateOne) ClearResolvedAt() *SendApprovalUpdateOne {
+	sauo.mutation.ClearResolvedAt()
+	return sauo
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (sauo *SendApprovalUpdateOne) SetWallet(w *Wallet) *SendApprovalUpdateOne {
+	return sauo.SetWalletID(w.ID)
+}
+
+// Mutation returns the SendApprovalMutation object of the builder.
+func (sauo *SendApprovalUpdateOne) Mutation() *SendApprovalMutation {
+	return sauo.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (sauo *SendApprovalUpdateOne) ClearWallet() *SendApprovalUpdateOne {
+	sauo.mutation.ClearWallet()
+	return sauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sauo *SendApprovalUpdateOne) Select(field string, fields ...string) *SendApprovalUpdateOne {
+	sauo.fields = append([]string{field}, fields...)
+	return sauo
+}
+
+// Save executes the query and returns the updated SendApproval entity.
+func (sauo *SendApprovalUpdateOne) Save(ctx context.Context) (*SendApproval, error) {
+	var (
+		err  error
+		node *SendApproval
+	)
+	if len(sauo.hooks) == 0 {
+		if err = sauo.check(); err != nil {
+			return nil, err
+		}
+		node, err = sauo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SendApprovalMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = sauo.check(); err != nil {
+				return nil, err
+			}
+			sauo.mutation = mutation
+			node, err = sauo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(sauo.hooks) - 1; i >= 0; i-- {
+			if sauo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = sauo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, sauo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*SendApproval)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SendApprovalMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sauo *SendApprovalUpdateOne) SaveX(ctx context.Context) *SendApproval {
+	node, err := sauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sauo *SendApprovalUpdateOne) Exec(ctx context.Context) error {
+	_, err := sauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sauo *SendApprovalUpdateOne) ExecX(ctx context.Context) {
+	if err := sauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sauo *SendApprovalUpdateOne) check() error {
+	if v, ok := sauo.mutation.Status(); ok {
+		if err := sendapproval.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SendApproval.status": %w`, err)}
+		}
+	}
+	if v, ok := sauo.mutation.BlockHash(); ok {
+		if err := sendapproval.BlockHashValidator(v); err != nil {
+			return &ValidationError{Name: "block_hash", err: fmt.Errorf(`ent: validator failed for field "SendApproval.block_hash": %w`, err)}
+		}
+	}
+	if _, ok := sauo.mutation.WalletID(); sauo.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SendApproval.wallet"`)
+	}
+	return nil
+}
+
+func (sauo *SendApprovalUpdateOne) sqlSave(ctx context.Context) (_node *SendApproval, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sendapproval.Table,
+			Columns: sendapproval.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: sendapproval.FieldID,
+			},
+		},
+	}
+	id, ok := sauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SendApproval.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sendapproval.FieldID)
+		for _, f := range fields {
+			if !sendapproval.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sendapproval.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if sauo.mutation.SendIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: sendapproval.FieldSendID,
+		})
+	}
+	if sauo.mutation.WorkCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: sendapproval.FieldWork,
+		})
+	}
+	if value, ok := sauo.mutation.ApprovedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: sendapproval.FieldApprovedBy,
+		})
+	}
+	if sauo.mutation.ApprovedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: sendapproval.FieldApprovedBy,
+		})
+	}
+	if sauo.mutation.RequestedByCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: sendapproval.FieldRequestedBy,
+		})
+	}
+	if value, ok := sauo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: sendapproval.FieldStatus,
+		})
+	}
+	if value, ok := sauo.mutation.BlockHash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendapproval.FieldBlockHash,
+		})
+	}
+	if sauo.mutation.BlockHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: sendapproval.FieldBlockHash,
+		})
+	}
+	if value, ok := sauo.mutation.ResolvedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sendapproval.FieldResolvedAt,
+		})
+	}
+	if sauo.mutation.ResolvedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: sendapproval.FieldResolvedAt,
+		})
+	}
+	if sauo.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sendapproval.WalletTable,
+			Columns: []string{sendapproval.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sauo.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sendapproval.WalletTable,
+			Columns: []string{sendapproval.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SendApproval{config: sauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sendapproval.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendschedule"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// SendSchedule is the model entity for the SendSchedule schema.
+type SendSchedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WalletID holds the value of the "wallet_id" field.
+	WalletID uuid.UUID `json:"wallet_id,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// Destination holds the value of the "destination" field.
+	Destination string `json:"destination,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount string `json:"amount,omitempty"`
+	// IntervalSeconds holds the value of the "interval_seconds" field.
+	IntervalSeconds int `json:"interval_seconds,omitempty"`
+	// NextRunAt holds the value of the "next_run_at" field.
+	NextRunAt time.Time `json:"next_run_at,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError *string `json:"last_error,omitempty"`
+	// LastFailedAt holds the value of the "last_failed_at" field.
+	LastFailedAt *time.Time `json:"last_failed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SendScheduleQuery when eager-loading is set.
+	Edges SendScheduleEdges `json:"edges"`
+}
+
+// SendScheduleEdges holds the relations/edges for other nodes in the graph.
+type SendScheduleEdges struct {
+	// Wallet holds the value of the wallet edge.
+	Wallet *Wallet `json:"wallet,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WalletOrErr returns the Wallet value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SendScheduleEdges) WalletOrErr() (*Wallet, error) {
+	if e.loadedTypes[0] {
+		if e.Wallet == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: wallet.Label}
+		}
+		return e.Wallet, nil
+	}
+	return nil, &NotLoadedError{edge: "wallet"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SendSchedule) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sendschedule.FieldIntervalSeconds:
+			values[i] = new(sql.NullInt64)
+		case sendschedule.FieldSource, sendschedule.FieldDestination, sendschedule.FieldAmount, sendschedule.FieldLastError:
+			values[i] = new(sql.NullString)
+		case sendschedule.FieldNextRunAt, sendschedule.FieldLastFailedAt, sendschedule.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case sendschedule.FieldID, sendschedule.FieldWalletID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type SendSchedule", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SendSchedule fields.
+func (ss *SendSchedule) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sendschedule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ss.ID = *value
+			}
+		case sendschedule.FieldWalletID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_id", values[i])
+			} else if value != nil {
+				ss.WalletID = *value
+			}
+		case sendschedule.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				ss.Source = value.String
+			}
+		case sendschedule.FieldDestination:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field destination", values[i])
+			} else if value.Valid {
+				ss.Destination = value.String
+			}
+		case sendschedule.FieldAmount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				ss.Amount = value.String
+			}
+		case sendschedule.FieldIntervalSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interval_seconds", values[i])
+			} else if value.Valid {
+				ss.IntervalSeconds = int(value.Int64)
+			}
+		case sendschedule.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				ss.NextRunAt = value.Time
+			}
+		case sendschedule.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				ss.LastError = new(string)
+				*ss.LastError = value.String
+			}
+		case sendschedule.FieldLastFailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_failed_at", values[i])
+			} else if value.Valid {
+				ss.LastFailedAt = new(time.Time)
+				*ss.LastFailedAt = value.Time
+			}
+		case sendschedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ss.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryWallet queries the "wallet" edge of the SendSchedule entity.
+func (ss *SendSchedule) QueryWallet() *WalletQuery {
+	return (&SendScheduleClient{config: ss.config}).QueryWallet(ss)
+}
+
+// Update returns a builder for updating this SendSchedule.
+// Note that you need to call SendSchedule.Unwrap() before calling this method if this SendSchedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ss *SendSchedule) Update() *SendScheduleUpdateOne {
+	return (&SendScheduleClient{config: ss.config}).UpdateOne(ss)
+}
+
+// Unwrap unwraps the SendSchedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ss *SendSchedule) Unwrap() *SendSchedule {
+	_tx, ok := ss.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SendSchedule is not a transactional entity")
+	}
+	ss.config.driver = _tx.drv
+	return ss
+}
+
+// String implements the fmt.Stringer.
+func (ss *SendSchedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("SendSchedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ss.ID))
+	builder.WriteString("wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", ss.WalletID))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(ss.Source)
+	builder.WriteString(", ")
+	builder.WriteString("destination=")
+	builder.WriteString(ss.Destination)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(ss.Amount)
+	builder.WriteString(", ")
+	builder.WriteString("interval_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", ss.IntervalSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("next_run_at=")
+	builder.WriteString(ss.NextRunAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ss.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := ss.LastFailedAt; v != nil {
+		builder.WriteString("last_failed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ss.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SendSchedules is a parsable slice of SendSchedule.
+type SendSchedules []*SendSchedule
+
+func (ss SendSchedules) config(cfg config) {
+	for _i := range ss {
+		ss[_i].config = cfg
+	}
+}
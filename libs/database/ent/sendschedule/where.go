@@ -0,0 +1,922 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sendschedule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// WalletID applies equality check predicate on the "wallet_id" field. It's identical to WalletIDEQ.
+func WalletID(v uuid.UUID) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// Destination applies equality check predicate on the "destination" field. It's identical to DestinationEQ.
+func Destination(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDestination), v))
+	})
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// IntervalSeconds applies equality check predicate on the "interval_seconds" field. It's identical to IntervalSecondsEQ.
+func IntervalSeconds(v int) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIntervalSeconds), v))
+	})
+}
+
+// NextRunAt applies equality check predicate on the "next_run_at" field. It's identical to NextRunAtEQ.
+func NextRunAt(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNextRunAt), v))
+	})
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastError), v))
+	})
+}
+
+// LastFailedAt applies equality check predicate on the "last_failed_at" field. It's identical to LastFailedAtEQ.
+func LastFailedAt(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastFailedAt), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// WalletIDEQ applies the EQ predicate on the "wallet_id" field.
+func WalletIDEQ(v uuid.UUID) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDNEQ applies the NEQ predicate on the "wallet_id" field.
+func WalletIDNEQ(v uuid.UUID) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDIn applies the In predicate on the "wallet_id" field.
+func WalletIDIn(vs ...uuid.UUID) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldWalletID), v...))
+	})
+}
+
+// WalletIDNotIn applies the NotIn predicate on the "wallet_id" field.
+func WalletIDNotIn(vs ...uuid.UUID) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldWalletID), v...))
+	})
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSource), v))
+	})
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldSource), v...))
+	})
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldSource), v...))
+	})
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSource), v))
+	})
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSource), v))
+	})
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSource), v))
+	})
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSource), v))
+	})
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSource), v))
+	})
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSource), v))
+	})
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSource), v))
+	})
+}
+
+// DestinationEQ applies the EQ predicate on the "destination" field.
+func DestinationEQ(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationNEQ applies the NEQ predicate on the "destination" field.
+func DestinationNEQ(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationIn applies the In predicate on the "destination" field.
+func DestinationIn(vs ...string) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldDestination), v...))
+	})
+}
+
+// DestinationNotIn applies the NotIn predicate on the "destination" field.
+func DestinationNotIn(vs ...string) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldDestination), v...))
+	})
+}
+
+// DestinationGT applies the GT predicate on the "destination" field.
+func DestinationGT(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationGTE applies the GTE predicate on the "destination" field.
+func DestinationGTE(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationLT applies the LT predicate on the "destination" field.
+func DestinationLT(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationLTE applies the LTE predicate on the "destination" field.
+func DestinationLTE(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationContains applies the Contains predicate on the "destination" field.
+func DestinationContains(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationHasPrefix applies the HasPrefix predicate on the "destination" field.
+func DestinationHasPrefix(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationHasSuffix applies the HasSuffix predicate on the "destination" field.
+func DestinationHasSuffix(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationEqualFold applies the EqualFold predicate on the "destination" field.
+func DestinationEqualFold(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldDestination), v))
+	})
+}
+
+// DestinationContainsFold applies the ContainsFold predicate on the "destination" field.
+func DestinationContainsFold(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldDestination), v))
+	})
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...string) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...string) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAmount), v))
+	})
+}
+
+// AmountContains applies the Contains predicate on the "amount" field.
+func AmountContains(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAmount), v))
+	})
+}
+
+// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
+func AmountHasPrefix(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAmount), v))
+	})
+}
+
+// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
+func AmountHasSuffix(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAmount), v))
+	})
+}
+
+// AmountEqualFold applies the EqualFold predicate on the "amount" field.
+func AmountEqualFold(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAmount), v))
+	})
+}
+
+// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
+func AmountContainsFold(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAmount), v))
+	})
+}
+
+// IntervalSecondsEQ applies the EQ predicate on the "interval_seconds" field.
+func IntervalSecondsEQ(v int) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIntervalSeconds), v))
+	})
+}
+
+// IntervalSecondsNEQ applies the NEQ predicate on the "interval_seconds" field.
+func IntervalSecondsNEQ(v int) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIntervalSeconds), v))
+	})
+}
+
+// IntervalSecondsIn applies the In predicate on the "interval_seconds" field.
+func IntervalSecondsIn(vs ...int) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldIntervalSeconds), v...))
+	})
+}
+
+// IntervalSecondsNotIn applies the NotIn predicate on the "interval_seconds" field.
+func IntervalSecondsNotIn(vs ...int) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldIntervalSeconds), v...))
+	})
+}
+
+// IntervalSecondsGT applies the GT predicate on the "interval_seconds" field.
+func IntervalSecondsGT(v int) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIntervalSeconds), v))
+	})
+}
+
+// IntervalSecondsGTE applies the GTE predicate on the "interval_seconds" field.
+func IntervalSecondsGTE(v int) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIntervalSeconds), v))
+	})
+}
+
+// IntervalSecondsLT applies the LT predicate on the "interval_seconds" field.
+func IntervalSecondsLT(v int) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIntervalSeconds), v))
+	})
+}
+
+// IntervalSecondsLTE applies the LTE predicate on the "interval_seconds" field.
+func IntervalSecondsLTE(v int) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIntervalSeconds), v))
+	})
+}
+
+// NextRunAtEQ applies the EQ predicate on the "next_run_at" field.
+func NextRunAtEQ(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNextRunAt), v))
+	})
+}
+
+// NextRunAtNEQ applies the NEQ predicate on the "next_run_at" field.
+func NextRunAtNEQ(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNextRunAt), v))
+	})
+}
+
+// NextRunAtIn applies the In predicate on the "next_run_at" field.
+func NextRunAtIn(vs ...time.Time) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldNextRunAt), v...))
+	})
+}
+
+// NextRunAtNotIn applies the NotIn predicate on the "next_run_at" field.
+func NextRunAtNotIn(vs ...time.Time) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldNextRunAt), v...))
+	})
+}
+
+// NextRunAtGT applies the GT predicate on the "next_run_at" field.
+func NextRunAtGT(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNextRunAt), v))
+	})
+}
+
+// NextRunAtGTE applies the GTE predicate on the "next_run_at" field.
+func NextRunAtGTE(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNextRunAt), v))
+	})
+}
+
+// NextRunAtLT applies the LT predicate on the "next_run_at" field.
+func NextRunAtLT(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNextRunAt), v))
+	})
+}
+
+// NextRunAtLTE applies the LTE predicate on the "next_run_at" field.
+func NextRunAtLTE(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNextRunAt), v))
+	})
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLastError), v...))
+	})
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLastError), v...))
+	})
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastError)))
+	})
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastError)))
+	})
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLastError), v))
+	})
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLastError), v))
+	})
+}
+
+// LastFailedAtEQ applies the EQ predicate on the "last_failed_at" field.
+func LastFailedAtEQ(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastFailedAt), v))
+	})
+}
+
+// LastFailedAtNEQ applies the NEQ predicate on the "last_failed_at" field.
+func LastFailedAtNEQ(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastFailedAt), v))
+	})
+}
+
+// LastFailedAtIn applies the In predicate on the "last_failed_at" field.
+func LastFailedAtIn(vs ...time.Time) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLastFailedAt), v...))
+	})
+}
+
+// LastFailedAtNotIn applies the NotIn predicate on the "last_failed_at" field.
+func LastFailedAtNotIn(vs ...time.Time) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLastFailedAt), v...))
+	})
+}
+
+// LastFailedAtGT applies the GT predicate on the "last_failed_at" field.
+func LastFailedAtGT(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLastFailedAt), v))
+	})
+}
+
+// LastFailedAtGTE applies the GTE predicate on the "last_failed_at" field.
+func LastFailedAtGTE(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLastFailedAt), v))
+	})
+}
+
+// LastFailedAtLT applies the LT predicate on the "last_failed_at" field.
+func LastFailedAtLT(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLastFailedAt), v))
+	})
+}
+
+// LastFailedAtLTE applies the LTE predicate on the "last_failed_at" field.
+func LastFailedAtLTE(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLastFailedAt), v))
+	})
+}
+
+// LastFailedAtIsNil applies the IsNil predicate on the "last_failed_at" field.
+func LastFailedAtIsNil() predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastFailedAt)))
+	})
+}
+
+// LastFailedAtNotNil applies the NotNil predicate on the "last_failed_at" field.
+func LastFailedAtNotNil() predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastFailedAt)))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SendSchedule {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasWallet applies the HasEdge predicate on the "wallet" edge.
+func HasWallet() predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWalletWith applies the HasEdge predicate on the "wallet" edge with a given conditions (other predicates).
+func HasWalletWith(preds ...predicate.Wallet) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SendSchedule) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SendSchedule) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SendSchedule) predicate.SendSchedule {
+	return predicate.SendSchedule(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,456 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendschedule"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// SendScheduleCreate is the builder for creating a SendSchedule entity.
+type SendScheduleCreate struct {
+	config
+	mutation *SendScheduleMutation
+	hooks    []Hook
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (ssc *SendScheduleCreate) SetWalletID(u uuid.UUID) *SendScheduleCreate {
+	ssc.mutation.SetWalletID(u)
+	return ssc
+}
+
+// SetSource sets the "source" field.
+func (ssc *SendScheduleCreate) SetSource(s string) *SendScheduleCreate {
+	ssc.mutation.SetSource(s)
+	return ssc
+}
+
+// SetDestination sets the "destination" field.
+func (ssc *SendScheduleCreate) SetDestination(s string) *SendScheduleCreate {
+	ssc.mutation.SetDestination(s)
+	return ssc
+}
+
+// SetAmount sets the "amount" field.
+func (ssc *SendScheduleCreate) SetAmount(s string) *SendScheduleCreate {
+	ssc.mutation.SetAmount(s)
+	return ssc
+}
+
+// SetIntervalSeconds sets the "interval_seconds" field.
+func (ssc *SendScheduleCreate) SetIntervalSeconds(i int) *SendScheduleCreate {
+	ssc.mutation.SetIntervalSeconds(i)
+	return ssc
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (ssc *SendScheduleCreate) SetNextRunAt(t time.Time) *SendScheduleCreate {
+	ssc.mutation.SetNextRunAt(t)
+	return ssc
+}
+
+// SetLastError sets the "last_error" field.
+func (ssc *SendScheduleCreate) SetLastError(s string) *SendScheduleCreate {
+	ssc.mutation.SetLastError(s)
+	return ssc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ssc *SendScheduleCreate) SetNillableLastError(s *string) *SendScheduleCreate {
+	if s != nil {
+		ssc.SetLastError(*s)
+	}
+	return ssc
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (ssc *SendScheduleCreate) SetLastFailedAt(t time.Time) *SendScheduleCreate {
+	ssc.mutation.SetLastFailedAt(t)
+	return ssc
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (ssc *SendScheduleCreate) SetNillableLastFailedAt(t *time.Time) *SendScheduleCreate {
+	if t != nil {
+		ssc.SetLastFailedAt(*t)
+	}
+	return ssc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssc *SendScheduleCreate) SetCreatedAt(t time.Time) *SendScheduleCreate {
+	ssc.mutation.SetCreatedAt(t)
+	return ssc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssc *SendScheduleCreate) SetNillableCreatedAt(t *time.Time) *SendScheduleCreate {
+	if t != nil {
+		ssc.SetCreatedAt(*t)
+	}
+	return ssc
+}
+
+// SetID sets the "id" field.
+func (ssc *SendScheduleCreate) SetID(u uuid.UUID) *SendScheduleCreate {
+	ssc.mutation.SetID(u)
+	return ssc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ssc *SendScheduleCreate) SetNillableID(u *uuid.UUID) *SendScheduleCreate {
+	if u != nil {
+		ssc.SetID(*u)
+	}
+	return ssc
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (ssc *SendScheduleCreate) SetWallet(w *Wallet) *SendScheduleCreate {
+	return ssc.SetWalletID(w.ID)
+}
+
+// Mutation returns the SendScheduleMutation object of the builder.
+func (ssc *SendScheduleCreate) Mutation() *SendScheduleMutation {
+	return ssc.mutation
+}
+
+// Save creates the SendSchedule in the database.
+func (ssc *SendScheduleCreate) Save(ctx context.Context) (*SendSchedule, error) {
+	var (
+		err  error
+		node *SendSchedule
+	)
+	ssc.defaults()
+	if len(ssc.hooks) == 0 {
+		if err = ssc.check(); err != nil {
+			return nil, err
+		}
+		node, err = ssc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SendScheduleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ssc.check(); err != nil {
+				return nil, err
+			}
+			ssc.mutation = mutation
+			if node, err = ssc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ssc.hooks) - 1; i >= 0; i-- {
+			if ssc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ssc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ssc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*SendSchedule)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SendScheduleMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ssc *SendScheduleCreate) SaveX(ctx context.Context) *SendSchedule {
+	v, err := ssc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssc *SendScheduleCreate) Exec(ctx context.Context) error {
+	_, err := ssc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssc *SendScheduleCreate) ExecX(ctx context.Context) {
+	if err := ssc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssc *SendScheduleCreate) defaults() {
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		v := sendschedule.DefaultCreatedAt()
+		ssc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ssc.mutation.ID(); !ok {
+		v := sendschedule.DefaultID()
+		ssc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssc *SendScheduleCreate) check() error {
+	if _, ok := ssc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "SendSchedule.wallet_id"`)}
+	}
+	if _, ok := ssc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "SendSchedule.source"`)}
+	}
+	if v, ok := ssc.mutation.Source(); ok {
+		if err := sendschedule.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "SendSchedule.source": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.Destination(); !ok {
+		return &ValidationError{Name: "destination", err: errors.New(`ent: missing required field "SendSchedule.destination"`)}
+	}
+	if v, ok := ssc.mutation.Destination(); ok {
+		if err := sendschedule.DestinationValidator(v); err != nil {
+			return &ValidationError{Name: "destination", err: fmt.Errorf(`ent: validator failed for field "SendSchedule.destination": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "SendSchedule.amount"`)}
+	}
+	if v, ok := ssc.mutation.Amount(); ok {
+		if err := sendschedule.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "SendSchedule.amount": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.IntervalSeconds(); !ok {
+		return &ValidationError{Name: "interval_seconds", err: errors.New(`ent: missing required field "SendSchedule.interval_seconds"`)}
+	}
+	if v, ok := ssc.mutation.IntervalSeconds(); ok {
+		if err := sendschedule.IntervalSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "interval_seconds", err: fmt.Errorf(`ent: validator failed for field "SendSchedule.interval_seconds": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.NextRunAt(); !ok {
+		return &ValidationError{Name: "next_run_at", err: errors.New(`ent: missing required field "SendSchedule.next_run_at"`)}
+	}
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SendSchedule.created_at"`)}
+	}
+	if _, ok := ssc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet", err: errors.New(`ent: missing required edge "SendSchedule.wallet"`)}
+	}
+	return nil
+}
+
+func (ssc *SendScheduleCreate) sqlSave(ctx context.Context) (*SendSchedule, error) {
+	_node, _spec := ssc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ssc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (ssc *SendScheduleCreate) createSpec() (*SendSchedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SendSchedule{config: ssc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: sendschedule.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: sendschedule.FieldID,
+			},
+		}
+	)
+	if id, ok := ssc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ssc.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendschedule.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := ssc.mutation.Destination(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendschedule.FieldDestination,
+		})
+		_node.Destination = value
+	}
+	if value, ok := ssc.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendschedule.FieldAmount,
+		})
+		_node.Amount = value
+	}
+	if value, ok := ssc.mutation.IntervalSeconds(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: sendschedule.FieldIntervalSeconds,
+		})
+		_node.IntervalSeconds = value
+	}
+	if value, ok := ssc.mutation.NextRunAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sendschedule.FieldNextRunAt,
+		})
+		_node.NextRunAt = value
+	}
+	if value, ok := ssc.mutation.LastError(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendschedule.FieldLastError,
+		})
+		_node.LastError = &value
+	}
+	if value, ok := ssc.mutation.LastFailedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sendschedule.FieldLastFailedAt,
+		})
+		_node.LastFailedAt = &value
+	}
+	if value, ok := ssc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sendschedule.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := ssc.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sendschedule.WalletTable,
+			Columns: []string{sendschedule.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WalletID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SendScheduleCreateBulk is the builder for creating many SendSchedule entities in bulk.
+type SendScheduleCreateBulk struct {
+	config
+	builders []*SendScheduleCreate
+}
+
+// Save creates the SendSchedule entities in the database.
+func (sscb *SendScheduleCreateBulk) Save(ctx context.Context) ([]*SendSchedule, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(sscb.builders))
+	nodes := make([]*SendSchedule, len(sscb.builders))
+	mutators := make([]Mutator, len(sscb.builders))
+	for i := range sscb.builders {
+		func(i int, root context.Context) {
+			builder := sscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SendScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscb *SendScheduleCreateBulk) SaveX(ctx context.Context) []*SendSchedule {
+	v, err := sscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sscb *SendScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := sscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscb *SendScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := sscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
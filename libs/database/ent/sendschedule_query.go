@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendschedule"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// SendScheduleQuery is the builder for querying SendSchedule entities.
+type SendScheduleQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.SendSchedule
+	withWallet *WalletQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SendScheduleQuery builder.
+func (ssq *SendScheduleQuery) Where(ps ...predicate.SendSchedule) *SendScheduleQuery {
+	ssq.predicates = append(ssq.predicates, ps...)
+	return ssq
+}
+
+// Limit adds a limit step to the query.
+func (ssq *SendScheduleQuery) Limit(limit int) *SendScheduleQuery {
+	ssq.limit = &limit
+	return ssq
+}
+
+// Offset adds an offset step to the query.
+func (ssq *SendScheduleQuery) Offset(offset int) *SendScheduleQuery {
+	ssq.offset = &offset
+	return ssq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ssq *SendScheduleQuery) Unique(unique bool) *SendScheduleQuery {
+	ssq.unique = &unique
+	return ssq
+}
+
+// Order adds an order step to the query.
+func (ssq *SendScheduleQuery) Order(o ...OrderFunc) *SendScheduleQuery {
+	ssq.order = append(ssq.order, o...)
+	return ssq
+}
+
+// QueryWallet chains the current query on the "wallet" edge.
+func (ssq *SendScheduleQuery) QueryWallet() *WalletQuery {
+	query := &WalletQuery{config: ssq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ssq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ssq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sendschedule.Table, sendschedule.FieldID, selector),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, sendschedule.WalletTable, sendschedule.WalletColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ssq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SendSchedule entity from the query.
+// Returns a *NotFoundError when no SendSchedule was found.
+func (ssq *SendScheduleQuery) First(ctx context.Context) (*SendSchedule, error) {
+	nodes, err := ssq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{sendschedule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ssq *SendScheduleQuery) FirstX(ctx context.Context) *SendSchedule {
+	node, err := ssq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SendSchedule ID from the query.
+// Returns a *NotFoundError when no SendSchedule ID was found.
+func (ssq *SendScheduleQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ssq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{sendschedule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ssq *SendScheduleQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ssq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SendSchedule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SendSchedule entity is found.
+// Returns a *NotFoundError when no SendSchedule entities are found.
+func (ssq *SendScheduleQuery) Only(ctx context.Context) (*SendSchedule, error) {
+	nodes, err := ssq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{sendschedule.Label}
+	default:
+		return nil, &NotSingularError{sendschedule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ssq *SendScheduleQuery) OnlyX(ctx context.Context) *SendSchedule {
+	node, err := ssq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SendSchedule ID in the query.
+// Returns a *NotSingularError when more than one SendSchedule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ssq *SendScheduleQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ssq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{sendschedule.Label}
+	default:
+		err = &NotSingularError{sendschedule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ssq *SendScheduleQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ssq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SendSchedules.
+func (ssq *SendScheduleQuery) All(ctx context.Context) ([]*SendSchedule, error) {
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ssq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ssq *SendScheduleQuery) AllX(ctx context.Context) []*SendSchedule {
+	nodes, err := ssq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SendSchedule IDs.
+func (ssq *SendScheduleQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := ssq.Select(sendschedule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ssq *SendScheduleQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ssq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ssq *SendScheduleQuery) Count(ctx context.Context) (int, error) {
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ssq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ssq *SendScheduleQuery) CountX(ctx context.Context) int {
+	count, err := ssq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ssq *SendScheduleQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ssq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ssq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ssq *SendScheduleQuery) ExistX(ctx context.Context) bool {
+	exist, err := ssq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SendScheduleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ssq *SendScheduleQuery) Clone() *SendScheduleQuery {
+	if ssq == nil {
+		return nil
+	}
+	return &SendScheduleQuery{
+		config:     ssq.config,
+		limit:      ssq.limit,
+		offset:     ssq.offset,
+		order:      append([]OrderFunc{}, ssq.order...),
+		predicates: append([]predicate.SendSchedule{}, ssq.predicates...),
+		withWallet: ssq.withWallet.Clone(),
+		// clone intermediate query.
+		sql:    ssq.sql.Clone(),
+		path:   ssq.path,
+		unique: ssq.unique,
+	}
+}
+
+// WithWallet tells the query-builder to eager-load the nodes that are connected to
+// the "wallet" edge. The optional arguments are used to configure the query builder of the edge.
+func (ssq *SendScheduleQuery) WithWallet(opts ...func(*WalletQuery)) *SendScheduleQuery {
+	query := &WalletQuery{config: ssq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ssq.withWallet = query
+	return ssq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SendSchedule.Query().
+//		GroupBy(sendschedule.FieldWalletID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ssq *SendScheduleQuery) GroupBy(field string, fields ...string) *SendScheduleGroupBy {
+	grbuild := &SendScheduleGroupBy{config: ssq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ssq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ssq.sqlQuery(ctx), nil
+	}
+	grbuild.label = sendschedule.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//	}
+//
+//	client.SendSchedule.Query().
+//		Select(sendschedule.FieldWalletID).
+//		Scan(ctx, &v)
+func (ssq *SendScheduleQuery) Select(fields ...string) *SendScheduleSelect {
+	ssq.fields = append(ssq.fields, fields...)
+	selbuild := &SendScheduleSelect{SendScheduleQuery: ssq}
+	selbuild.label = sendschedule.Label
+	selbuild.flds, selbuild.scan = &ssq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (ssq *SendScheduleQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ssq.fields {
+		if !sendschedule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ssq.path != nil {
+		prev, err := ssq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ssq.sql = prev
+	}
+	return nil
+}
+
+func (ssq *SendScheduleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SendSchedule, error) {
+	var (
+		nodes       = []*SendSchedule{}
+		_spec       = ssq.querySpec()
+		loadedTypes = [1]bool{
+			ssq.withWallet != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*SendSchedule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &SendSchedule{config: ssq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ssq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ssq.withWallet; query != nil {
+		if err := ssq.loadWallet(ctx, query, nodes, nil,
+			func(n *SendSchedule, e *Wallet) { n.Edges.Wallet = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ssq *SendScheduleQuery) loadWallet(ctx context.Context, query *WalletQuery, nodes []*SendSchedule, init func(*SendSchedule), assign func(*SendSchedule, *Wallet)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*SendSchedule)
+	for i := range nodes {
+		fk := nodes[i].WalletID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(wallet.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ssq *SendScheduleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ssq.querySpec()
+	_spec.Node.Columns = ssq.fields
+	if len(ssq.fields) > 0 {
+		_spec.Unique = ssq.unique != nil && *ssq.unique
+	}
+	return sqlgraph.CountNodes(ctx, ssq.driver, _spec)
+}
+
+func (ssq *SendScheduleQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ssq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (ssq *SendScheduleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sendschedule.Table,
+			Columns: sendschedule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: sendschedule.FieldID,
+			},
+		},
+		From:   ssq.sql,
+		Unique: true,
+	}
+	if unique := ssq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := ssq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sendschedule.FieldID)
+		for i := range fields {
+			if fields[i] != sendschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ssq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ssq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ssq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ssq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ssq *SendScheduleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ssq.driver.Dialect())
+	t1 := builder.Table(sendschedule.Table)
+	columns := ssq.fields
+	if len(columns) == 0 {
+		columns = sendschedule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ssq.sql != nil {
+		selector = ssq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ssq.unique != nil && *ssq.unique {
+		selector.Distinct()
+	}
+	for _, p := range ssq.predicates {
+		p(selector)
+	}
+	for _, p := range ssq.order {
+		p(selector)
+	}
+	if offset := ssq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ssq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SendScheduleGroupBy is the group-by builder for SendSchedule entities.
+type SendScheduleGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ssgb *SendScheduleGroupBy) Aggregate(fns ...AggregateFunc) *SendScheduleGroupBy {
+	ssgb.fns = append(ssgb.fns, fns...)
+	return ssgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ssgb *SendScheduleGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ssgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ssgb.sql = query
+	return ssgb.sqlScan(ctx, v)
+}
+
+func (ssgb *SendScheduleGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ssgb.fields {
+		if !sendschedule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ssgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ssgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ssgb *SendScheduleGroupBy) sqlQuery() *sql.Selector {
+	selector := ssgb.sql.Select()
+	aggregation := make([]string, 0, len(ssgb.fns))
+	for _, fn := range ssgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(ssgb.fields)+len(ssgb.fns))
+		for _, f := range ssgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(ssgb.fields...)...)
+}
+
+// SendScheduleSelect is the builder for selecting fields of SendSchedule entities.
+type SendScheduleSelect struct {
+	*SendScheduleQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sss *SendScheduleSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := sss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	sss.sql = sss.SendScheduleQuery.sqlQuery(ctx)
+	return sss.sqlScan(ctx, v)
+}
+
+func (sss *SendScheduleSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := sss.sql.Query()
+	if err := sss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,538 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendschedule"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/google/uuid"
+)
+
+// SendScheduleUpdate is the builder for updating SendSchedule entities.
+type SendScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SendScheduleMutation
+}
+
+// Where appends a list predicates to the SendScheduleUpdate builder.
+func (ssu *SendScheduleUpdate) Where(ps ...predicate.SendSchedule) *SendScheduleUpdate {
+	ssu.mutation.Where(ps...)
+	return ssu
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (ssu *SendScheduleUpdate) SetWalletID(u uuid.UUID) *SendScheduleUpdate {
+	ssu.mutation.SetWalletID(u)
+	return ssu
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (ssu *SendScheduleUpdate) SetNextRunAt(t time.Time) *SendScheduleUpdate {
+	ssu.mutation.SetNextRunAt(t)
+	return ssu
+}
+
+// SetLastError sets the "last_error" field.
+func (ssu *SendScheduleUpdate) SetLastError(s string) *SendScheduleUpdate {
+	ssu.mutation.SetLastError(s)
+	return ssu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ssu *SendScheduleUpdate) SetNillableLastError(s *string) *SendScheduleUpdate {
+	if s != nil {
+		ssu.SetLastError(*s)
+	}
+	return ssu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (ssu *SendScheduleUpdate) ClearLastError() *SendScheduleUpdate {
+	ssu.mutation.ClearLastError()
+	return ssu
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (ssu *SendScheduleUpdate) SetLastFailedAt(t time.Time) *SendScheduleUpdate {
+	ssu.mutation.SetLastFailedAt(t)
+	return ssu
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (ssu *SendScheduleUpdate) SetNillableLastFailedAt(t *time.Time) *SendScheduleUpdate {
+	if t != nil {
+		ssu.SetLastFailedAt(*t)
+	}
+	return ssu
+}
+
+// ClearLastFailedAt clears the value of the "last_failed_at" field.
+func (ssu *SendScheduleUpdate) ClearLastFailedAt() *SendScheduleUpdate {
+	ssu.mutation.ClearLastFailedAt()
+	return ssu
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (ssu *SendScheduleUpdate) SetWallet(w *Wallet) *SendScheduleUpdate {
+	return ssu.SetWalletID(w.ID)
+}
+
+// Mutation returns the SendScheduleMutation object of the builder.
+func (ssu *SendScheduleUpdate) Mutation() *SendScheduleMutation {
+	return ssu.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (ssu *SendScheduleUpdate) ClearWallet() *SendScheduleUpdate {
+	ssu.mutation.ClearWallet()
+	return ssu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ssu *SendScheduleUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ssu.hooks) == 0 {
+		if err = ssu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ssu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SendScheduleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ssu.check(); err != nil {
+				return 0, err
+			}
+			ssu.mutation = mutation
+			affected, err = ssu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ssu.hooks) - 1; i >= 0; i-- {
+			if ssu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ssu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ssu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssu *SendScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := ssu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ssu *SendScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := ssu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssu *SendScheduleUpdate) ExecX(ctx context.Context) {
+	if err := ssu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssu *SendScheduleUpdate) check() error {
+	if _, ok := ssu.mutation.WalletID(); ssu.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SendSchedule.wallet"`)
+	}
+	return nil
+}
+
+func (ssu *SendScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sendschedule.Table,
+			Columns: sendschedule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: sendschedule.FieldID,
+			},
+		},
+	}
+	if ps := ssu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssu.mutation.NextRunAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sendschedule.FieldNextRunAt,
+		})
+	}
+	if value, ok := ssu.mutation.LastError(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendschedule.FieldLastError,
+		})
+	}
+	if ssu.mutation.LastErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: sendschedule.FieldLastError,
+		})
+	}
+	if value, ok := ssu.mutation.LastFailedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sendschedule.FieldLastFailedAt,
+		})
+	}
+	if ssu.mutation.LastFailedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: sendschedule.FieldLastFailedAt,
+		})
+	}
+	if ssu.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sendschedule.WalletTable,
+			Columns: []string{sendschedule.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssu.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sendschedule.WalletTable,
+			Columns: []string{sendschedule.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ssu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sendschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SendScheduleUpdateOne is the builder for updating a single SendSchedule entity.
+type SendScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SendScheduleMutation
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (ssuo *SendScheduleUpdateOne) SetWalletID(u uuid.UUID) *SendScheduleUpdateOne {
+	ssuo.mutation.SetWalletID(u)
+	return ssuo
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (ssuo *SendScheduleUpdateOne) SetNextRunAt(t time.Time) *SendScheduleUpdateOne {
+	ssuo.mutation.SetNextRunAt(t)
+	return ssuo
+}
+
+// SetLastError sets the "last_error" field.
+func (ssuo *SendScheduleUpdateOne) SetLastError(s string) *SendScheduleUpdateOne {
+	ssuo.mutation.SetLastError(s)
+	return ssuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (ssuo *SendScheduleUpdateOne) SetNillableLastError(s *string) *SendScheduleUpdateOne {
+	if s != nil {
+		ssuo.SetLastError(*s)
+	}
+	return ssuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (ssuo *SendScheduleUpdateOne) ClearLastError() *SendScheduleUpdateOne {
+	ssuo.mutation.ClearLastError()
+	return ssuo
+}
+
+// SetLastFailedAt sets the "last_failed_at" field.
+func (ssuo *SendScheduleUpdateOne) SetLastFailedAt(t time.Time) *SendScheduleUpdateOne {
+	ssuo.mutation.SetLastFailedAt(t)
+	return ssuo
+}
+
+// SetNillableLastFailedAt sets the "last_failed_at" field if the given value is not nil.
+func (ssuo *SendScheduleUpdateOne) SetNillableLastFailedAt(t *time.Time) *SendScheduleUpdateOne {
+	if t != nil {
+		ssuo.SetLastFailedAt(*t)
+	}
+	return ssuo
+}
+
+// ClearLastFailedAt clears the value of the "last_failed_at" field.
+func (ssuo *SendScheduleUpdateOne) ClearLastFailedAt() *SendScheduleUpdateOne {
+	ssuo.mutation.ClearLastFailedAt()
+	return ssuo
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (ssuo *SendScheduleUpdateOne) SetWallet(w *Wallet) *SendScheduleUpdateOne {
+	return ssuo.SetWalletID(w.ID)
+}
+
+// Mutation returns the SendScheduleMutation object of the builder.
+func (ssuo *SendScheduleUpdateOne) Mutation() *SendScheduleMutation {
+	return ssuo.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (ssuo *SendScheduleUpdateOne) ClearWallet() *SendScheduleUpdateOne {
+	ssuo.mutation.ClearWallet()
+	return ssuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ssuo *SendScheduleUpdateOne) Select(field string, fields ...string) *SendScheduleUpdateOne {
+	ssuo.fields = append([]string{field}, fields...)
+	return ssuo
+}
+
+// Save executes the query and returns the updated SendSchedule entity.
+func (ssuo *SendScheduleUpdateOne) Save(ctx context.Context) (*SendSchedule, error) {
+	var (
+		err  error
+		node *SendSchedule
+	)
+	if len(ssuo.hooks) == 0 {
+		if err = ssuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ssuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*SendScheduleMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ssuo.check(); err != nil {
+				return nil, err
+			}
+			ssuo.mutation = mutation
+			node, err = ssuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ssuo.hooks) - 1; i >= 0; i-- {
+			if ssuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = ssuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, ssuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*SendSchedule)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from SendScheduleMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssuo *SendScheduleUpdateOne) SaveX(ctx context.Context) *SendSchedule {
+	node, err := ssuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ssuo *SendScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := ssuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssuo *SendScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := ssuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssuo *SendScheduleUpdateOne) check() error {
+	if _, ok := ssuo.mutation.WalletID(); ssuo.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "SendSchedule.wallet"`)
+	}
+	return nil
+}
+
+func (ssuo *SendScheduleUpdateOne) sqlSave(ctx context.Context) (_node *SendSchedule, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   sendschedule.Table,
+			Columns: sendschedule.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: sendschedule.FieldID,
+			},
+		},
+	}
+	id, ok := ssuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SendSchedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ssuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sendschedule.FieldID)
+		for _, f := range fields {
+			if !sendschedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sendschedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ssuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssuo.mutation.NextRunAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sendschedule.FieldNextRunAt,
+		})
+	}
+	if value, ok := ssuo.mutation.LastError(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: sendschedule.FieldLastError,
+		})
+	}
+	if ssuo.mutation.LastErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: sendschedule.FieldLastError,
+		})
+	}
+	if value, ok := ssuo.mutation.LastFailedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: sendschedule.FieldLastFailedAt,
+		})
+	}
+	if ssuo.mutation.LastFailedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: sendschedule.FieldLastFailedAt,
+		})
+	}
+	if ssuo.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sendschedule.WalletTable,
+			Columns: []string{sendschedule.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssuo.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   sendschedule.WalletTable,
+			Columns: []string{sendschedule.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SendSchedule{config: ssuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ssuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sendschedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -14,10 +14,34 @@ type Tx struct {
 	config
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
+	// AuditRecord is the client for interacting with the AuditRecord builders.
+	AuditRecord *AuditRecordClient
+	// BalanceAlert is the client for interacting with the BalanceAlert builders.
+	BalanceAlert *BalanceAlertClient
+	// BalanceSnapshot is the client for interacting with the BalanceSnapshot builders.
+	BalanceSnapshot *BalanceSnapshotClient
 	// Block is the client for interacting with the Block builders.
 	Block *BlockClient
+	// IdempotencyKey is the client for interacting with the IdempotencyKey builders.
+	IdempotencyKey *IdempotencyKeyClient
+	// IdempotentSend is the client for interacting with the IdempotentSend builders.
+	IdempotentSend *IdempotentSendClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// RepresentativeHistory is the client for interacting with the RepresentativeHistory builders.
+	RepresentativeHistory *RepresentativeHistoryClient
+	// SendApproval is the client for interacting with the SendApproval builders.
+	SendApproval *SendApprovalClient
+	// SendSchedule is the client for interacting with the SendSchedule builders.
+	SendSchedule *SendScheduleClient
 	// Wallet is the client for interacting with the Wallet builders.
 	Wallet *WalletClient
+	// WalletSnapshot is the client for interacting with the WalletSnapshot builders.
+	WalletSnapshot *WalletSnapshotClient
+	// WalletSpend is the client for interacting with the WalletSpend builders.
+	WalletSpend *WalletSpendClient
 
 	// lazily loaded.
 	client     *Client
@@ -154,8 +178,20 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Account = NewAccountClient(tx.config)
+	tx.ApiKey = NewApiKeyClient(tx.config)
+	tx.AuditRecord = NewAuditRecordClient(tx.config)
+	tx.BalanceAlert = NewBalanceAlertClient(tx.config)
+	tx.BalanceSnapshot = NewBalanceSnapshotClient(tx.config)
 	tx.Block = NewBlockClient(tx.config)
+	tx.IdempotencyKey = NewIdempotencyKeyClient(tx.config)
+	tx.IdempotentSend = NewIdempotentSendClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
+	tx.RepresentativeHistory = NewRepresentativeHistoryClient(tx.config)
+	tx.SendApproval = NewSendApprovalClient(tx.config)
+	tx.SendSchedule = NewSendScheduleClient(tx.config)
 	tx.Wallet = NewWalletClient(tx.config)
+	tx.WalletSnapshot = NewWalletSnapshotClient(tx.config)
+	tx.WalletSpend = NewWalletSpendClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -21,10 +21,28 @@ type Wallet struct {
 	Seed string `json:"seed,omitempty"`
 	// Representative holds the value of the "representative" field.
 	Representative *string `json:"representative,omitempty"`
+	// Name holds the value of the "name" field.
+	Name *string `json:"name,omitempty"`
 	// Encrypted holds the value of the "encrypted" field.
 	Encrypted bool `json:"encrypted,omitempty"`
 	// Work holds the value of the "work" field.
 	Work bool `json:"work,omitempty"`
+	// WatchOnly holds the value of the "watch_only" field.
+	WatchOnly bool `json:"watch_only,omitempty"`
+	// Hardware holds the value of the "hardware" field.
+	Hardware bool `json:"hardware,omitempty"`
+	// AutoReceive holds the value of the "auto_receive" field.
+	AutoReceive bool `json:"auto_receive,omitempty"`
+	// ReceiveMinimum holds the value of the "receive_minimum" field.
+	ReceiveMinimum *string `json:"receive_minimum,omitempty"`
+	// FrozenAt holds the value of the "frozen_at" field.
+	FrozenAt *time.Time `json:"frozen_at,omitempty"`
+	// Kdf holds the value of the "kdf" field.
+	Kdf *string `json:"kdf,omitempty"`
+	// ApprovalsRequired holds the value of the "approvals_required" field.
+	ApprovalsRequired *int `json:"approvals_required,omitempty"`
+	// ApprovalThreshold holds the value of the "approval_threshold" field.
+	ApprovalThreshold *string `json:"approval_threshold,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -36,9 +54,27 @@ type Wallet struct {
 type WalletEdges struct {
 	// Accounts holds the value of the accounts edge.
 	Accounts []*Account `json:"accounts,omitempty"`
+	// SendSchedules holds the value of the send_schedules edge.
+	SendSchedules []*SendSchedule `json:"send_schedules,omitempty"`
+	// BalanceAlerts holds the value of the balance_alerts edge.
+	BalanceAlerts []*BalanceAlert `json:"balance_alerts,omitempty"`
+	// IdempotencyKeys holds the value of the idempotency_keys edge.
+	IdempotencyKeys []*IdempotencyKey `json:"idempotency_keys,omitempty"`
+	// IdempotentSends holds the value of the idempotent_sends edge.
+	IdempotentSends []*IdempotentSend `json:"idempotent_sends,omitempty"`
+	// WalletSnapshots holds the value of the wallet_snapshots edge.
+	WalletSnapshots []*WalletSnapshot `json:"wallet_snapshots,omitempty"`
+	// Jobs holds the value of the jobs edge.
+	Jobs []*Job `json:"jobs,omitempty"`
+	// Spends holds the value of the spends edge.
+	Spends []*WalletSpend `json:"spends,omitempty"`
+	// SendApprovals holds the value of the send_approvals edge.
+	SendApprovals []*SendApproval `json:"send_approvals,omitempty"`
+	// Blocks holds the value of the blocks edge.
+	Blocks []*Block `json:"blocks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [10]bool
 }
 
 // AccountsOrErr returns the Accounts value or an error if the edge
@@ -50,16 +86,99 @@ func (e WalletEdges) AccountsOrErr() ([]*Account, error) {
 	return nil, &NotLoadedError{edge: "accounts"}
 }
 
+// SendSchedulesOrErr returns the SendSchedules value or an error if the edge
+// was not loaded in eager-loading.
+func (e WalletEdges) SendSchedulesOrErr() ([]*SendSchedule, error) {
+	if e.loadedTypes[1] {
+		return e.SendSchedules, nil
+	}
+	return nil, &NotLoadedError{edge: "send_schedules"}
+}
+
+// BalanceAlertsOrErr returns the BalanceAlerts value or an error if the edge
+// was not loaded in eager-loading.
+func (e WalletEdges) BalanceAlertsOrErr() ([]*BalanceAlert, error) {
+	if e.loadedTypes[2] {
+		return e.BalanceAlerts, nil
+	}
+	return nil, &NotLoadedError{edge: "balance_alerts"}
+}
+
+// IdempotencyKeysOrErr returns the IdempotencyKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e WalletEdges) IdempotencyKeysOrErr() ([]*IdempotencyKey, error) {
+	if e.loadedTypes[3] {
+		return e.IdempotencyKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "idempotency_keys"}
+}
+
+// IdempotentSendsOrErr returns the IdempotentSends value or an error if the edge
+// was not loaded in eager-loading.
+func (e WalletEdges) IdempotentSendsOrErr() ([]*IdempotentSend, error) {
+	if e.loadedTypes[4] {
+		return e.IdempotentSends, nil
+	}
+	return nil, &NotLoadedError{edge: "idempotent_sends"}
+}
+
+// WalletSnapshotsOrErr returns the WalletSnapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e WalletEdges) WalletSnapshotsOrErr() ([]*WalletSnapshot, error) {
+	if e.loadedTypes[5] {
+		return e.WalletSnapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "wallet_snapshots"}
+}
+
+// JobsOrErr returns the Jobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e WalletEdges) JobsOrErr() ([]*Job, error) {
+	if e.loadedTypes[6] {
+		return e.Jobs, nil
+	}
+	return nil, &NotLoadedError{edge: "jobs"}
+}
+
+// SpendsOrErr returns the Spends value or an error if the edge
+// was not loaded in eager-loading.
+func (e WalletEdges) SpendsOrErr() ([]*WalletSpend, error) {
+	if e.loadedTypes[7] {
+		return e.Spends, nil
+	}
+	return nil, &NotLoadedError{edge: "spends"}
+}
+
+// SendApprovalsOrErr returns the SendApprovals value or an error if the edge
+// was not loaded in eager-loading.
+func (e WalletEdges) SendApprovalsOrErr() ([]*SendApproval, error) {
+	if e.loadedTypes[8] {
+		return e.SendApprovals, nil
+	}
+	return nil, &NotLoadedError{edge: "send_approvals"}
+}
+
+// BlocksOrErr returns the Blocks value or an error if the edge
+// was not loaded in eager-loading.
+func (e WalletEdges) BlocksOrErr() ([]*Block, error) {
+	if e.loadedTypes[9] {
+		return e.Blocks, nil
+	}
+	return nil, &NotLoadedError{edge: "blocks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Wallet) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case wallet.FieldEncrypted, wallet.FieldWork:
+		case wallet.FieldEncrypted, wallet.FieldWork, wallet.FieldWatchOnly, wallet.FieldHardware, wallet.FieldAutoReceive:
 			values[i] = new(sql.NullBool)
-		case wallet.FieldSeed, wallet.FieldRepresentative:
+		case wallet.FieldApprovalsRequired:
+			values[i] = new(sql.NullInt64)
+		case wallet.FieldSeed, wallet.FieldRepresentative, wallet.FieldName, wallet.FieldReceiveMinimum, wallet.FieldKdf, wallet.FieldApprovalThreshold:
 			values[i] = new(sql.NullString)
-		case wallet.FieldCreatedAt:
+		case wallet.FieldFrozenAt, wallet.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case wallet.FieldID:
 			values[i] = new(uuid.UUID)
@@ -97,6 +216,13 @@ func (w *Wallet) assignValues(columns []string, values []interface{}) error {
 				w.Representative = new(string)
 				*w.Representative = value.String
 			}
+		case wallet.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				w.Name = new(string)
+				*w.Name = value.String
+			}
 		case wallet.FieldEncrypted:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field encrypted", values[i])
@@ -109,6 +235,59 @@ func (w *Wallet) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				w.Work = value.Bool
 			}
+		case wallet.FieldWatchOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field watch_only", values[i])
+			} else if value.Valid {
+				w.WatchOnly = value.Bool
+			}
+		case wallet.FieldHardware:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hardware", values[i])
+			} else if value.Valid {
+				w.Hardware = value.Bool
+			}
+		case wallet.FieldAutoReceive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_receive", values[i])
+			} else if value.Valid {
+				w.AutoReceive = value.Bool
+			}
+		case wallet.FieldReceiveMinimum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field receive_minimum", values[i])
+			} else if value.Valid {
+				w.ReceiveMinimum = new(string)
+				*w.ReceiveMinimum = value.String
+			}
+		case wallet.FieldFrozenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field frozen_at", values[i])
+			} else if value.Valid {
+				w.FrozenAt = new(time.Time)
+				*w.FrozenAt = value.Time
+			}
+		case wallet.FieldKdf:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kdf", values[i])
+			} else if value.Valid {
+				w.Kdf = new(string)
+				*w.Kdf = value.String
+			}
+		case wallet.FieldApprovalsRequired:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approvals_required", values[i])
+			} else if value.Valid {
+				w.ApprovalsRequired = new(int)
+				*w.ApprovalsRequired = int(value.Int64)
+			}
+		case wallet.FieldApprovalThreshold:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_threshold", values[i])
+			} else if value.Valid {
+				w.ApprovalThreshold = new(string)
+				*w.ApprovalThreshold = value.String
+			}
 		case wallet.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -125,6 +304,51 @@ func (w *Wallet) QueryAccounts() *AccountQuery {
 	return (&WalletClient{config: w.config}).QueryAccounts(w)
 }
 
+// QuerySendSchedules queries the "send_schedules" edge of the Wallet entity.
+func (w *Wallet) QuerySendSchedules() *SendScheduleQuery {
+	return (&WalletClient{config: w.config}).QuerySendSchedules(w)
+}
+
+// QueryBalanceAlerts queries the "balance_alerts" edge of the Wallet entity.
+func (w *Wallet) QueryBalanceAlerts() *BalanceAlertQuery {
+	return (&WalletClient{config: w.config}).QueryBalanceAlerts(w)
+}
+
+// QueryIdempotencyKeys queries the "idempotency_keys" edge of the Wallet entity.
+func (w *Wallet) QueryIdempotencyKeys() *IdempotencyKeyQuery {
+	return (&WalletClient{config: w.config}).QueryIdempotencyKeys(w)
+}
+
+// QueryIdempotentSends queries the "idempotent_sends" edge of the Wallet entity.
+func (w *Wallet) QueryIdempotentSends() *IdempotentSendQuery {
+	return (&WalletClient{config: w.config}).QueryIdempotentSends(w)
+}
+
+// QueryWalletSnapshots queries the "wallet_snapshots" edge of the Wallet entity.
+func (w *Wallet) QueryWalletSnapshots() *WalletSnapshotQuery {
+	return (&WalletClient{config: w.config}).QueryWalletSnapshots(w)
+}
+
+// QueryJobs queries the "jobs" edge of the Wallet entity.
+func (w *Wallet) QueryJobs() *JobQuery {
+	return (&WalletClient{config: w.config}).QueryJobs(w)
+}
+
+// QuerySpends queries the "spends" edge of the Wallet entity.
+func (w *Wallet) QuerySpends() *WalletSpendQuery {
+	return (&WalletClient{config: w.config}).QuerySpends(w)
+}
+
+// QuerySendApprovals queries the "send_approvals" edge of the Wallet entity.
+func (w *Wallet) QuerySendApprovals() *SendApprovalQuery {
+	return (&WalletClient{config: w.config}).QuerySendApprovals(w)
+}
+
+// QueryBlocks queries the "blocks" edge of the Wallet entity.
+func (w *Wallet) QueryBlocks() *BlockQuery {
+	return (&WalletClient{config: w.config}).QueryBlocks(w)
+}
+
 // Update returns a builder for updating this Wallet.
 // Note that you need to call Wallet.Unwrap() before calling this method if this Wallet
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -156,12 +380,51 @@ func (w *Wallet) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := w.Name; v != nil {
+		builder.WriteString("name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("encrypted=")
 	builder.WriteString(fmt.Sprintf("%v", w.Encrypted))
 	builder.WriteString(", ")
 	builder.WriteString("work=")
 	builder.WriteString(fmt.Sprintf("%v", w.Work))
 	builder.WriteString(", ")
+	builder.WriteString("watch_only=")
+	builder.WriteString(fmt.Sprintf("%v", w.WatchOnly))
+	builder.WriteString(", ")
+	builder.WriteString("hardware=")
+	builder.WriteString(fmt.Sprintf("%v", w.Hardware))
+	builder.WriteString(", ")
+	builder.WriteString("auto_receive=")
+	builder.WriteString(fmt.Sprintf("%v", w.AutoReceive))
+	builder.WriteString(", ")
+	if v := w.ReceiveMinimum; v != nil {
+		builder.WriteString("receive_minimum=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := w.FrozenAt; v != nil {
+		builder.WriteString("frozen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := w.Kdf; v != nil {
+		builder.WriteString("kdf=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := w.ApprovalsRequired; v != nil {
+		builder.WriteString("approvals_required=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := w.ApprovalThreshold; v != nil {
+		builder.WriteString("approval_threshold=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(w.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
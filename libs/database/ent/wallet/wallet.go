@@ -17,14 +17,50 @@ const (
 	FieldSeed = "seed"
 	// FieldRepresentative holds the string denoting the representative field in the database.
 	FieldRepresentative = "representative"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
 	// FieldEncrypted holds the string denoting the encrypted field in the database.
 	FieldEncrypted = "encrypted"
 	// FieldWork holds the string denoting the work field in the database.
 	FieldWork = "work"
+	// FieldWatchOnly holds the string denoting the watch_only field in the database.
+	FieldWatchOnly = "watch_only"
+	// FieldHardware holds the string denoting the hardware field in the database.
+	FieldHardware = "hardware"
+	// FieldAutoReceive holds the string denoting the auto_receive field in the database.
+	FieldAutoReceive = "auto_receive"
+	// FieldReceiveMinimum holds the string denoting the receive_minimum field in the database.
+	FieldReceiveMinimum = "receive_minimum"
+	// FieldFrozenAt holds the string denoting the frozen_at field in the database.
+	FieldFrozenAt = "frozen_at"
+	// FieldKdf holds the string denoting the kdf field in the database.
+	FieldKdf = "kdf"
+	// FieldApprovalsRequired holds the string denoting the approvals_required field in the database.
+	FieldApprovalsRequired = "approvals_required"
+	// FieldApprovalThreshold holds the string denoting the approval_threshold field in the database.
+	FieldApprovalThreshold = "approval_threshold"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeAccounts holds the string denoting the accounts edge name in mutations.
 	EdgeAccounts = "accounts"
+	// EdgeSendSchedules holds the string denoting the send_schedules edge name in mutations.
+	EdgeSendSchedules = "send_schedules"
+	// EdgeBalanceAlerts holds the string denoting the balance_alerts edge name in mutations.
+	EdgeBalanceAlerts = "balance_alerts"
+	// EdgeIdempotencyKeys holds the string denoting the idempotency_keys edge name in mutations.
+	EdgeIdempotencyKeys = "idempotency_keys"
+	// EdgeIdempotentSends holds the string denoting the idempotent_sends edge name in mutations.
+	EdgeIdempotentSends = "idempotent_sends"
+	// EdgeWalletSnapshots holds the string denoting the wallet_snapshots edge name in mutations.
+	EdgeWalletSnapshots = "wallet_snapshots"
+	// EdgeJobs holds the string denoting the jobs edge name in mutations.
+	EdgeJobs = "jobs"
+	// EdgeSpends holds the string denoting the spends edge name in mutations.
+	EdgeSpends = "spends"
+	// EdgeSendApprovals holds the string denoting the send_approvals edge name in mutations.
+	EdgeSendApprovals = "send_approvals"
+	// EdgeBlocks holds the string denoting the blocks edge name in mutations.
+	EdgeBlocks = "blocks"
 	// Table holds the table name of the wallet in the database.
 	Table = "wallets"
 	// AccountsTable is the table that holds the accounts relation/edge.
@@ -34,6 +70,69 @@ const (
 	AccountsInverseTable = "accounts"
 	// AccountsColumn is the table column denoting the accounts relation/edge.
 	AccountsColumn = "wallet_id"
+	// SendSchedulesTable is the table that holds the send_schedules relation/edge.
+	SendSchedulesTable = "send_schedules"
+	// SendSchedulesInverseTable is the table name for the SendSchedule entity.
+	// It exists in this package in order to avoid circular dependency with the "sendschedule" package.
+	SendSchedulesInverseTable = "send_schedules"
+	// SendSchedulesColumn is the table column denoting the send_schedules relation/edge.
+	SendSchedulesColumn = "wallet_id"
+	// BalanceAlertsTable is the table that holds the balance_alerts relation/edge.
+	BalanceAlertsTable = "balance_alerts"
+	// BalanceAlertsInverseTable is the table name for the BalanceAlert entity.
+	// It exists in this package in order to avoid circular dependency with the "balancealert" package.
+	BalanceAlertsInverseTable = "balance_alerts"
+	// BalanceAlertsColumn is the table column denoting the balance_alerts relation/edge.
+	BalanceAlertsColumn = "wallet_id"
+	// IdempotencyKeysTable is the table that holds the idempotency_keys relation/edge.
+	IdempotencyKeysTable = "idempotency_keys"
+	// IdempotencyKeysInverseTable is the table name for the IdempotencyKey entity.
+	// It exists in this package in order to avoid circular dependency with the "idempotencykey" package.
+	IdempotencyKeysInverseTable = "idempotency_keys"
+	// IdempotencyKeysColumn is the table column denoting the idempotency_keys relation/edge.
+	IdempotencyKeysColumn = "wallet_id"
+	// IdempotentSendsTable is the table that holds the idempotent_sends relation/edge.
+	IdempotentSendsTable = "idempotent_sends"
+	// IdempotentSendsInverseTable is the table name for the IdempotentSend entity.
+	// It exists in this package in order to avoid circular dependency with the "idempotentsend" package.
+	IdempotentSendsInverseTable = "idempotent_sends"
+	// IdempotentSendsColumn is the table column denoting the idempotent_sends relation/edge.
+	IdempotentSendsColumn = "wallet_id"
+	// WalletSnapshotsTable is the table that holds the wallet_snapshots relation/edge.
+	WalletSnapshotsTable = "wallet_snapshots"
+	// WalletSnapshotsInverseTable is the table name for the WalletSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "walletsnapshot" package.
+	WalletSnapshotsInverseTable = "wallet_snapshots"
+	// WalletSnapshotsColumn is the table column denoting the wallet_snapshots relation/edge.
+	WalletSnapshotsColumn = "wallet_id"
+	// JobsTable is the table that holds the jobs relation/edge.
+	JobsTable = "jobs"
+	// JobsInverseTable is the table name for the Job entity.
+	// It exists in this package in order to avoid circular dependency with the "job" package.
+	JobsInverseTable = "jobs"
+	// JobsColumn is the table column denoting the jobs relation/edge.
+	JobsColumn = "wallet_id"
+	// SpendsTable is the table that holds the spends relation/edge.
+	SpendsTable = "wallet_spends"
+	// SpendsInverseTable is the table name for the WalletSpend entity.
+	// It exists in this package in order to avoid circular dependency with the "walletspend" package.
+	SpendsInverseTable = "wallet_spends"
+	// SpendsColumn is the table column denoting the spends relation/edge.
+	SpendsColumn = "wallet_id"
+	// SendApprovalsTable is the table that holds the send_approvals relation/edge.
+	SendApprovalsTable = "send_approvals"
+	// SendApprovalsInverseTable is the table name for the SendApproval entity.
+	// It exists in this package in order to avoid circular dependency with the "sendapproval" package.
+	SendApprovalsInverseTable = "send_approvals"
+	// SendApprovalsColumn is the table column denoting the send_approvals relation/edge.
+	SendApprovalsColumn = "wallet_id"
+	// BlocksTable is the table that holds the blocks relation/edge.
+	BlocksTable = "blocks"
+	// BlocksInverseTable is the table name for the Block entity.
+	// It exists in this package in order to avoid circular dependency with the "block" package.
+	BlocksInverseTable = "blocks"
+	// BlocksColumn is the table column denoting the blocks relation/edge.
+	BlocksColumn = "wallet_id"
 )
 
 // Columns holds all SQL columns for wallet fields.
@@ -41,8 +140,17 @@ var Columns = []string{
 	FieldID,
 	FieldSeed,
 	FieldRepresentative,
+	FieldName,
 	FieldEncrypted,
 	FieldWork,
+	FieldWatchOnly,
+	FieldHardware,
+	FieldAutoReceive,
+	FieldReceiveMinimum,
+	FieldFrozenAt,
+	FieldKdf,
+	FieldApprovalsRequired,
+	FieldApprovalThreshold,
 	FieldCreatedAt,
 }
 
@@ -61,10 +169,26 @@ var (
 	SeedValidator func(string) error
 	// RepresentativeValidator is a validator for the "representative" field. It is called by the builders before save.
 	RepresentativeValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
 	// DefaultEncrypted holds the default value on creation for the "encrypted" field.
 	DefaultEncrypted bool
 	// DefaultWork holds the default value on creation for the "work" field.
 	DefaultWork bool
+	// DefaultWatchOnly holds the default value on creation for the "watch_only" field.
+	DefaultWatchOnly bool
+	// DefaultHardware holds the default value on creation for the "hardware" field.
+	DefaultHardware bool
+	// DefaultAutoReceive holds the default value on creation for the "auto_receive" field.
+	DefaultAutoReceive bool
+	// ReceiveMinimumValidator is a validator for the "receive_minimum" field. It is called by the builders before save.
+	ReceiveMinimumValidator func(string) error
+	// KdfValidator is a validator for the "kdf" field. It is called by the builders before save.
+	KdfValidator func(string) error
+	// ApprovalsRequiredValidator is a validator for the "approvals_required" field. It is called by the builders before save.
+	ApprovalsRequiredValidator func(int) error
+	// ApprovalThresholdValidator is a validator for the "approval_threshold" field. It is called by the builders before save.
+	ApprovalThresholdValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
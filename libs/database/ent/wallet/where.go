@@ -96,6 +96,13 @@ func Representative(v string) predicate.Wallet {
 	})
 }
 
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
 // Encrypted applies equality check predicate on the "encrypted" field. It's identical to EncryptedEQ.
 func Encrypted(v bool) predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
@@ -110,6 +117,62 @@ func Work(v bool) predicate.Wallet {
 	})
 }
 
+// WatchOnly applies equality check predicate on the "watch_only" field. It's identical to WatchOnlyEQ.
+func WatchOnly(v bool) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWatchOnly), v))
+	})
+}
+
+// Hardware applies equality check predicate on the "hardware" field. It's identical to HardwareEQ.
+func Hardware(v bool) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHardware), v))
+	})
+}
+
+// AutoReceive applies equality check predicate on the "auto_receive" field. It's identical to AutoReceiveEQ.
+func AutoReceive(v bool) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAutoReceive), v))
+	})
+}
+
+// ReceiveMinimum applies equality check predicate on the "receive_minimum" field. It's identical to ReceiveMinimumEQ.
+func ReceiveMinimum(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReceiveMinimum), v))
+	})
+}
+
+// FrozenAt applies equality check predicate on the "frozen_at" field. It's identical to FrozenAtEQ.
+func FrozenAt(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFrozenAt), v))
+	})
+}
+
+// Kdf applies equality check predicate on the "kdf" field. It's identical to KdfEQ.
+func Kdf(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKdf), v))
+	})
+}
+
+// ApprovalsRequired applies equality check predicate on the "approvals_required" field. It's identical to ApprovalsRequiredEQ.
+func ApprovalsRequired(v int) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldApprovalsRequired), v))
+	})
+}
+
+// ApprovalThreshold applies equality check predicate on the "approval_threshold" field. It's identical to ApprovalThresholdEQ.
+func ApprovalThreshold(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldApprovalThreshold), v))
+	})
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
@@ -329,6 +392,119 @@ func RepresentativeContainsFold(v string) predicate.Wallet {
 	})
 }
 
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldName), v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldName), v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Wallet {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldName), v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Wallet {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldName), v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldName), v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldName), v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldName), v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldName), v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldName), v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldName), v))
+	})
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldName)))
+	})
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldName)))
+	})
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldName), v))
+	})
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldName), v))
+	})
+}
+
 // EncryptedEQ applies the EQ predicate on the "encrypted" field.
 func EncryptedEQ(v bool) predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
@@ -357,89 +533,878 @@ func WorkNEQ(v bool) predicate.Wallet {
 	})
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.Wallet {
+// WatchOnlyEQ applies the EQ predicate on the "watch_only" field.
+func WatchOnlyEQ(v bool) predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+		s.Where(sql.EQ(s.C(FieldWatchOnly), v))
 	})
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.Wallet {
+// WatchOnlyNEQ applies the NEQ predicate on the "watch_only" field.
+func WatchOnlyNEQ(v bool) predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+		s.Where(sql.NEQ(s.C(FieldWatchOnly), v))
 	})
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.Wallet {
+// HardwareEQ applies the EQ predicate on the "hardware" field.
+func HardwareEQ(v bool) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHardware), v))
+	})
+}
+
+// HardwareNEQ applies the NEQ predicate on the "hardware" field.
+func HardwareNEQ(v bool) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHardware), v))
+	})
+}
+
+// AutoReceiveEQ applies the EQ predicate on the "auto_receive" field.
+func AutoReceiveEQ(v bool) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAutoReceive), v))
+	})
+}
+
+// AutoReceiveNEQ applies the NEQ predicate on the "auto_receive" field.
+func AutoReceiveNEQ(v bool) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAutoReceive), v))
+	})
+}
+
+// ReceiveMinimumEQ applies the EQ predicate on the "receive_minimum" field.
+func ReceiveMinimumEQ(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldReceiveMinimum), v))
+	})
+}
+
+// ReceiveMinimumNEQ applies the NEQ predicate on the "receive_minimum" field.
+func ReceiveMinimumNEQ(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldReceiveMinimum), v))
+	})
+}
+
+// ReceiveMinimumIn applies the In predicate on the "receive_minimum" field.
+func ReceiveMinimumIn(vs ...string) predicate.Wallet {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
 	}
 	return predicate.Wallet(func(s *sql.Selector) {
-		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+		s.Where(sql.In(s.C(FieldReceiveMinimum), v...))
 	})
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.Wallet {
+// ReceiveMinimumNotIn applies the NotIn predicate on the "receive_minimum" field.
+func ReceiveMinimumNotIn(vs ...string) predicate.Wallet {
 	v := make([]interface{}, len(vs))
 	for i := range v {
 		v[i] = vs[i]
 	}
 	return predicate.Wallet(func(s *sql.Selector) {
-		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+		s.Where(sql.NotIn(s.C(FieldReceiveMinimum), v...))
 	})
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.Wallet {
+// ReceiveMinimumGT applies the GT predicate on the "receive_minimum" field.
+func ReceiveMinimumGT(v string) predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+		s.Where(sql.GT(s.C(FieldReceiveMinimum), v))
 	})
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.Wallet {
+// ReceiveMinimumGTE applies the GTE predicate on the "receive_minimum" field.
+func ReceiveMinimumGTE(v string) predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+		s.Where(sql.GTE(s.C(FieldReceiveMinimum), v))
 	})
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.Wallet {
+// ReceiveMinimumLT applies the LT predicate on the "receive_minimum" field.
+func ReceiveMinimumLT(v string) predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+		s.Where(sql.LT(s.C(FieldReceiveMinimum), v))
 	})
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.Wallet {
+// ReceiveMinimumLTE applies the LTE predicate on the "receive_minimum" field.
+func ReceiveMinimumLTE(v string) predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+		s.Where(sql.LTE(s.C(FieldReceiveMinimum), v))
 	})
 }
 
-// HasAccounts applies the HasEdge predicate on the "accounts" edge.
-func HasAccounts() predicate.Wallet {
+// ReceiveMinimumContains applies the Contains predicate on the "receive_minimum" field.
+func ReceiveMinimumContains(v string) predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(AccountsTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, AccountsTable, AccountsColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
+		s.Where(sql.Contains(s.C(FieldReceiveMinimum), v))
 	})
 }
 
-// HasAccountsWith applies the HasEdge predicate on the "accounts" edge with a given conditions (other predicates).
-func HasAccountsWith(preds ...predicate.Account) predicate.Wallet {
+// ReceiveMinimumHasPrefix applies the HasPrefix predicate on the "receive_minimum" field.
+func ReceiveMinimumHasPrefix(v string) predicate.Wallet {
 	return predicate.Wallet(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.To(AccountsInverseTable, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, AccountsTable, AccountsColumn),
+		s.Where(sql.HasPrefix(s.C(FieldReceiveMinimum), v))
+	})
+}
+
+// ReceiveMinimumHasSuffix applies the HasSuffix predicate on the "receive_minimum" field.
+func ReceiveMinimumHasSuffix(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldReceiveMinimum), v))
+	})
+}
+
+// ReceiveMinimumIsNil applies the IsNil predicate on the "receive_minimum" field.
+func ReceiveMinimumIsNil() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldReceiveMinimum)))
+	})
+}
+
+// ReceiveMinimumNotNil applies the NotNil predicate on the "receive_minimum" field.
+func ReceiveMinimumNotNil() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldReceiveMinimum)))
+	})
+}
+
+// ReceiveMinimumEqualFold applies the EqualFold predicate on the "receive_minimum" field.
+func ReceiveMinimumEqualFold(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldReceiveMinimum), v))
+	})
+}
+
+// ReceiveMinimumContainsFold applies the ContainsFold predicate on the "receive_minimum" field.
+func ReceiveMinimumContainsFold(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldReceiveMinimum), v))
+	})
+}
+
+// FrozenAtEQ applies the EQ predicate on the "frozen_at" field.
+func FrozenAtEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldFrozenAt), v))
+	})
+}
+
+// FrozenAtNEQ applies the NEQ predicate on the "frozen_at" field.
+func FrozenAtNEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldFrozenAt), v))
+	})
+}
+
+// FrozenAtIn applies the In predicate on the "frozen_at" field.
+func FrozenAtIn(vs ...time.Time) predicate.Wallet {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldFrozenAt), v...))
+	})
+}
+
+// FrozenAtNotIn applies the NotIn predicate on the "frozen_at" field.
+func FrozenAtNotIn(vs ...time.Time) predicate.Wallet {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldFrozenAt), v...))
+	})
+}
+
+// FrozenAtGT applies the GT predicate on the "frozen_at" field.
+func FrozenAtGT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldFrozenAt), v))
+	})
+}
+
+// FrozenAtGTE applies the GTE predicate on the "frozen_at" field.
+func FrozenAtGTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldFrozenAt), v))
+	})
+}
+
+// FrozenAtLT applies the LT predicate on the "frozen_at" field.
+func FrozenAtLT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldFrozenAt), v))
+	})
+}
+
+// FrozenAtLTE applies the LTE predicate on the "frozen_at" field.
+func FrozenAtLTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldFrozenAt), v))
+	})
+}
+
+// FrozenAtIsNil applies the IsNil predicate on the "frozen_at" field.
+func FrozenAtIsNil() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldFrozenAt)))
+	})
+}
+
+// FrozenAtNotNil applies the NotNil predicate on the "frozen_at" field.
+func FrozenAtNotNil() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldFrozenAt)))
+	})
+}
+
+// KdfEQ applies the EQ predicate on the "kdf" field.
+func KdfEQ(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKdf), v))
+	})
+}
+
+// KdfNEQ applies the NEQ predicate on the "kdf" field.
+func KdfNEQ(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldKdf), v))
+	})
+}
+
+// KdfIn applies the In predicate on the "kdf" field.
+func KdfIn(vs ...string) predicate.Wallet {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldKdf), v...))
+	})
+}
+
+// KdfNotIn applies the NotIn predicate on the "kdf" field.
+func KdfNotIn(vs ...string) predicate.Wallet {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldKdf), v...))
+	})
+}
+
+// KdfGT applies the GT predicate on the "kdf" field.
+func KdfGT(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldKdf), v))
+	})
+}
+
+// KdfGTE applies the GTE predicate on the "kdf" field.
+func KdfGTE(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldKdf), v))
+	})
+}
+
+// KdfLT applies the LT predicate on the "kdf" field.
+func KdfLT(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldKdf), v))
+	})
+}
+
+// KdfLTE applies the LTE predicate on the "kdf" field.
+func KdfLTE(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldKdf), v))
+	})
+}
+
+// KdfContains applies the Contains predicate on the "kdf" field.
+func KdfContains(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldKdf), v))
+	})
+}
+
+// KdfHasPrefix applies the HasPrefix predicate on the "kdf" field.
+func KdfHasPrefix(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldKdf), v))
+	})
+}
+
+// KdfHasSuffix applies the HasSuffix predicate on the "kdf" field.
+func KdfHasSuffix(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldKdf), v))
+	})
+}
+
+// KdfIsNil applies the IsNil predicate on the "kdf" field.
+func KdfIsNil() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldKdf)))
+	})
+}
+
+// KdfNotNil applies the NotNil predicate on the "kdf" field.
+func KdfNotNil() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldKdf)))
+	})
+}
+
+// KdfEqualFold applies the EqualFold predicate on the "kdf" field.
+func KdfEqualFold(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldKdf), v))
+	})
+}
+
+// KdfContainsFold applies the ContainsFold predicate on the "kdf" field.
+func KdfContainsFold(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldKdf), v))
+	})
+}
+
+// ApprovalsRequiredEQ applies the EQ predicate on the "approvals_required" field.
+func ApprovalsRequiredEQ(v int) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldApprovalsRequired), v))
+	})
+}
+
+// ApprovalsRequiredNEQ applies the NEQ predicate on the "approvals_required" field.
+func ApprovalsRequiredNEQ(v int) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldApprovalsRequired), v))
+	})
+}
+
+// ApprovalsRequiredIn applies the In predicate on the "approvals_required" field.
+func ApprovalsRequiredIn(vs ...int) predicate.Wallet {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldApprovalsRequired), v...))
+	})
+}
+
+// ApprovalsRequiredNotIn applies the NotIn predicate on the "approvals_required" field.
+func ApprovalsRequiredNotIn(vs ...int) predicate.Wallet {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldApprovalsRequired), v...))
+	})
+}
+
+// ApprovalsRequiredGT applies the GT predicate on the "approvals_required" field.
+func ApprovalsRequiredGT(v int) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldApprovalsRequired), v))
+	})
+}
+
+// ApprovalsRequiredGTE applies the GTE predicate on the "approvals_required" field.
+func ApprovalsRequiredGTE(v int) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldApprovalsRequired), v))
+	})
+}
+
+// ApprovalsRequiredLT applies the LT predicate on the "approvals_required" field.
+func ApprovalsRequiredLT(v int) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldApprovalsRequired), v))
+	})
+}
+
+// ApprovalsRequiredLTE applies the LTE predicate on the "approvals_required" field.
+func ApprovalsRequiredLTE(v int) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldApprovalsRequired), v))
+	})
+}
+
+// ApprovalsRequiredIsNil applies the IsNil predicate on the "approvals_required" field.
+func ApprovalsRequiredIsNil() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldApprovalsRequired)))
+	})
+}
+
+// ApprovalsRequiredNotNil applies the NotNil predicate on the "approvals_required" field.
+func ApprovalsRequiredNotNil() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldApprovalsRequired)))
+	})
+}
+
+// ApprovalThresholdEQ applies the EQ predicate on the "approval_threshold" field.
+func ApprovalThresholdEQ(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldApprovalThreshold), v))
+	})
+}
+
+// ApprovalThresholdNEQ applies the NEQ predicate on the "approval_threshold" field.
+func ApprovalThresholdNEQ(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldApprovalThreshold), v))
+	})
+}
+
+// ApprovalThresholdIn applies the In predicate on the "approval_threshold" field.
+func ApprovalThresholdIn(vs ...string) predicate.Wallet {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldApprovalThreshold), v...))
+	})
+}
+
+// ApprovalThresholdNotIn applies the NotIn predicate on the "approval_threshold" field.
+func ApprovalThresholdNotIn(vs ...string) predicate.Wallet {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldApprovalThreshold), v...))
+	})
+}
+
+// ApprovalThresholdGT applies the GT predicate on the "approval_threshold" field.
+func ApprovalThresholdGT(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldApprovalThreshold), v))
+	})
+}
+
+// ApprovalThresholdGTE applies the GTE predicate on the "approval_threshold" field.
+func ApprovalThresholdGTE(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldApprovalThreshold), v))
+	})
+}
+
+// ApprovalThresholdLT applies the LT predicate on the "approval_threshold" field.
+func ApprovalThresholdLT(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldApprovalThreshold), v))
+	})
+}
+
+// ApprovalThresholdLTE applies the LTE predicate on the "approval_threshold" field.
+func ApprovalThresholdLTE(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldApprovalThreshold), v))
+	})
+}
+
+// ApprovalThresholdContains applies the Contains predicate on the "approval_threshold" field.
+func ApprovalThresholdContains(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldApprovalThreshold), v))
+	})
+}
+
+// ApprovalThresholdHasPrefix applies the HasPrefix predicate on the "approval_threshold" field.
+func ApprovalThresholdHasPrefix(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldApprovalThreshold), v))
+	})
+}
+
+// ApprovalThresholdHasSuffix applies the HasSuffix predicate on the "approval_threshold" field.
+func ApprovalThresholdHasSuffix(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldApprovalThreshold), v))
+	})
+}
+
+// ApprovalThresholdIsNil applies the IsNil predicate on the "approval_threshold" field.
+func ApprovalThresholdIsNil() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldApprovalThreshold)))
+	})
+}
+
+// ApprovalThresholdNotNil applies the NotNil predicate on the "approval_threshold" field.
+func ApprovalThresholdNotNil() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldApprovalThreshold)))
+	})
+}
+
+// ApprovalThresholdEqualFold applies the EqualFold predicate on the "approval_threshold" field.
+func ApprovalThresholdEqualFold(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldApprovalThreshold), v))
+	})
+}
+
+// ApprovalThresholdContainsFold applies the ContainsFold predicate on the "approval_threshold" field.
+func ApprovalThresholdContainsFold(v string) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldApprovalThreshold), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Wallet {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Wallet {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasAccounts applies the HasEdge predicate on the "accounts" edge.
+func HasAccounts() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AccountsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccountsTable, AccountsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountsWith applies the HasEdge predicate on the "accounts" edge with a given conditions (other predicates).
+func HasAccountsWith(preds ...predicate.Account) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(AccountsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccountsTable, AccountsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSendSchedules applies the HasEdge predicate on the "send_schedules" edge.
+func HasSendSchedules() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SendSchedulesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SendSchedulesTable, SendSchedulesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSendSchedulesWith applies the HasEdge predicate on the "send_schedules" edge with a given conditions (other predicates).
+func HasSendSchedulesWith(preds ...predicate.SendSchedule) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SendSchedulesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SendSchedulesTable, SendSchedulesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBalanceAlerts applies the HasEdge predicate on the "balance_alerts" edge.
+func HasBalanceAlerts() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BalanceAlertsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BalanceAlertsTable, BalanceAlertsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBalanceAlertsWith applies the HasEdge predicate on the "balance_alerts" edge with a given conditions (other predicates).
+func HasBalanceAlertsWith(preds ...predicate.BalanceAlert) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BalanceAlertsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BalanceAlertsTable, BalanceAlertsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIdempotencyKeys applies the HasEdge predicate on the "idempotency_keys" edge.
+func HasIdempotencyKeys() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(IdempotencyKeysTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IdempotencyKeysTable, IdempotencyKeysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIdempotencyKeysWith applies the HasEdge predicate on the "idempotency_keys" edge with a given conditions (other predicates).
+func HasIdempotencyKeysWith(preds ...predicate.IdempotencyKey) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(IdempotencyKeysInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IdempotencyKeysTable, IdempotencyKeysColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIdempotentSends applies the HasEdge predicate on the "idempotent_sends" edge.
+func HasIdempotentSends() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(IdempotentSendsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IdempotentSendsTable, IdempotentSendsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIdempotentSendsWith applies the HasEdge predicate on the "idempotent_sends" edge with a given conditions (other predicates).
+func HasIdempotentSendsWith(preds ...predicate.IdempotentSend) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(IdempotentSendsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IdempotentSendsTable, IdempotentSendsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWalletSnapshots applies the HasEdge predicate on the "wallet_snapshots" edge.
+func HasWalletSnapshots() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletSnapshotsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WalletSnapshotsTable, WalletSnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWalletSnapshotsWith applies the HasEdge predicate on the "wallet_snapshots" edge with a given conditions (other predicates).
+func HasWalletSnapshotsWith(preds ...predicate.WalletSnapshot) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletSnapshotsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WalletSnapshotsTable, WalletSnapshotsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasJobs applies the HasEdge predicate on the "jobs" edge.
+func HasJobs() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(JobsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JobsTable, JobsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasJobsWith applies the HasEdge predicate on the "jobs" edge with a given conditions (other predicates).
+func HasJobsWith(preds ...predicate.Job) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(JobsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, JobsTable, JobsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSpends applies the HasEdge predicate on the "spends" edge.
+func HasSpends() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SpendsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SpendsTable, SpendsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSpendsWith applies the HasEdge predicate on the "spends" edge with a given conditions (other predicates).
+func HasSpendsWith(preds ...predicate.WalletSpend) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SpendsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SpendsTable, SpendsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSendApprovals applies the HasEdge predicate on the "send_approvals" edge.
+func HasSendApprovals() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SendApprovalsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SendApprovalsTable, SendApprovalsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSendApprovalsWith applies the HasEdge predicate on the "send_approvals" edge with a given conditions (other predicates).
+func HasSendApprovalsWith(preds ...predicate.SendApproval) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(SendApprovalsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SendApprovalsTable, SendApprovalsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBlocks applies the HasEdge predicate on the "blocks" edge.
+func HasBlocks() predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BlocksTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BlocksTable, BlocksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlocksWith applies the HasEdge predicate on the "blocks" edge with a given conditions (other predicates).
+func HasBlocksWith(preds ...predicate.Block) predicate.Wallet {
+	return predicate.Wallet(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BlocksInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BlocksTable, BlocksColumn),
 		)
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
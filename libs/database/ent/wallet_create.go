@@ -11,7 +11,16 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancealert"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotencykey"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendschedule"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
 	"github.com/google/uuid"
 )
 
@@ -42,6 +51,20 @@ func (wc *WalletCreate) SetNillableRepresentative(s *string) *WalletCreate {
 	return wc
 }
 
+// SetName sets the "name" field.
+func (wc *WalletCreate) SetName(s string) *WalletCreate {
+	wc.mutation.SetName(s)
+	return wc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableName(s *string) *WalletCreate {
+	if s != nil {
+		wc.SetName(*s)
+	}
+	return wc
+}
+
 // SetEncrypted sets the "encrypted" field.
 func (wc *WalletCreate) SetEncrypted(b bool) *WalletCreate {
 	wc.mutation.SetEncrypted(b)
@@ -70,6 +93,118 @@ func (wc *WalletCreate) SetNillableWork(b *bool) *WalletCreate {
 	return wc
 }
 
+// SetWatchOnly sets the "watch_only" field.
+func (wc *WalletCreate) SetWatchOnly(b bool) *WalletCreate {
+	wc.mutation.SetWatchOnly(b)
+	return wc
+}
+
+// SetNillableWatchOnly sets the "watch_only" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableWatchOnly(b *bool) *WalletCreate {
+	if b != nil {
+		wc.SetWatchOnly(*b)
+	}
+	return wc
+}
+
+// SetHardware sets the "hardware" field.
+func (wc *WalletCreate) SetHardware(b bool) *WalletCreate {
+	wc.mutation.SetHardware(b)
+	return wc
+}
+
+// SetNillableHardware sets the "hardware" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableHardware(b *bool) *WalletCreate {
+	if b != nil {
+		wc.SetHardware(*b)
+	}
+	return wc
+}
+
+// SetAutoReceive sets the "auto_receive" field.
+func (wc *WalletCreate) SetAutoReceive(b bool) *WalletCreate {
+	wc.mutation.SetAutoReceive(b)
+	return wc
+}
+
+// SetNillableAutoReceive sets the "auto_receive" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableAutoReceive(b *bool) *WalletCreate {
+	if b != nil {
+		wc.SetAutoReceive(*b)
+	}
+	return wc
+}
+
+// SetReceiveMinimum sets the "receive_minimum" field.
+func (wc *WalletCreate) SetReceiveMinimum(s string) *WalletCreate {
+	wc.mutation.SetReceiveMinimum(s)
+	return wc
+}
+
+// SetNillableReceiveMinimum sets the "receive_minimum" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableReceiveMinimum(s *string) *WalletCreate {
+	if s != nil {
+		wc.SetReceiveMinimum(*s)
+	}
+	return wc
+}
+
+// SetFrozenAt sets the "frozen_at" field.
+func (wc *WalletCreate) SetFrozenAt(t time.Time) *WalletCreate {
+	wc.mutation.SetFrozenAt(t)
+	return wc
+}
+
+// SetNillableFrozenAt sets the "frozen_at" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableFrozenAt(t *time.Time) *WalletCreate {
+	if t != nil {
+		wc.SetFrozenAt(*t)
+	}
+	return wc
+}
+
+// SetKdf sets the "kdf" field.
+func (wc *WalletCreate) SetKdf(s string) *WalletCreate {
+	wc.mutation.SetKdf(s)
+	return wc
+}
+
+// SetNillableKdf sets the "kdf" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableKdf(s *string) *WalletCreate {
+	if s != nil {
+		wc.SetKdf(*s)
+	}
+	return wc
+}
+
+// SetApprovalsRequired sets the "approvals_required" field.
+func (wc *WalletCreate) SetApprovalsRequired(i int) *WalletCreate {
+	wc.mutation.SetApprovalsRequired(i)
+	return wc
+}
+
+// SetNillableApprovalsRequired sets the "approvals_required" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableApprovalsRequired(i *int) *WalletCreate {
+	if i != nil {
+		wc.SetApprovalsRequired(*i)
+	}
+	return wc
+}
+
+// SetApprovalThreshold sets the "approval_threshold" field.
+func (wc *WalletCreate) SetApprovalThreshold(s string) *WalletCreate {
+	wc.mutation.SetApprovalThreshold(s)
+	return wc
+}
+
+// SetNillableApprovalThreshold sets the "approval_threshold" field if the given value is not nil.
+func (wc *WalletCreate) SetNillableApprovalThreshold(s *string) *WalletCreate {
+	if s != nil {
+		wc.SetApprovalThreshold(*s)
+	}
+	return wc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (wc *WalletCreate) SetCreatedAt(t time.Time) *WalletCreate {
 	wc.mutation.SetCreatedAt(t)
@@ -113,6 +248,141 @@ func (wc *WalletCreate) AddAccounts(a ...*Account) *WalletCreate {
 	return wc.AddAccountIDs(ids...)
 }
 
+// AddSendScheduleIDs adds the "send_schedules" edge to the SendSchedule entity by IDs.
+func (wc *WalletCreate) AddSendScheduleIDs(ids ...uuid.UUID) *WalletCreate {
+	wc.mutation.AddSendScheduleIDs(ids...)
+	return wc
+}
+
+// AddSendSchedules adds the "send_schedules" edges to the SendSchedule entity.
+func (wc *WalletCreate) AddSendSchedules(s ...*SendSchedule) *WalletCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wc.AddSendScheduleIDs(ids...)
+}
+
+// AddBalanceAlertIDs adds the "balance_alerts" edge to the BalanceAlert entity by IDs.
+func (wc *WalletCreate) AddBalanceAlertIDs(ids ...uuid.UUID) *WalletCreate {
+	wc.mutation.AddBalanceAlertIDs(ids...)
+	return wc
+}
+
+// AddBalanceAlerts adds the "balance_alerts" edges to the BalanceAlert entity.
+func (wc *WalletCreate) AddBalanceAlerts(b ...*BalanceAlert) *WalletCreate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wc.AddBalanceAlertIDs(ids...)
+}
+
+// AddIdempotencyKeyIDs adds the "idempotency_keys" edge to the IdempotencyKey entity by IDs.
+func (wc *WalletCreate) AddIdempotencyKeyIDs(ids ...uuid.UUID) *WalletCreate {
+	wc.mutation.AddIdempotencyKeyIDs(ids...)
+	return wc
+}
+
+// AddIdempotencyKeys adds the "idempotency_keys" edges to the IdempotencyKey entity.
+func (wc *WalletCreate) AddIdempotencyKeys(i ...*IdempotencyKey) *WalletCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wc.AddIdempotencyKeyIDs(ids...)
+}
+
+// AddIdempotentSendIDs adds the "idempotent_sends" edge to the IdempotentSend entity by IDs.
+func (wc *WalletCreate) AddIdempotentSendIDs(ids ...uuid.UUID) *WalletCreate {
+	wc.mutation.AddIdempotentSendIDs(ids...)
+	return wc
+}
+
+// AddIdempotentSends adds the "idempotent_sends" edges to the IdempotentSend entity.
+func (wc *WalletCreate) AddIdempotentSends(i ...*IdempotentSend) *WalletCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wc.AddIdempotentSendIDs(ids...)
+}
+
+// AddWalletSnapshotIDs adds the "wallet_snapshots" edge to the WalletSnapshot entity by IDs.
+func (wc *WalletCreate) AddWalletSnapshotIDs(ids ...uuid.UUID) *WalletCreate {
+	wc.mutation.AddWalletSnapshotIDs(ids...)
+	return wc
+}
+
+// AddWalletSnapshots adds the "wallet_snapshots" edges to the WalletSnapshot entity.
+func (wc *WalletCreate) AddWalletSnapshots(w ...*WalletSnapshot) *WalletCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddWalletSnapshotIDs(ids...)
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (wc *WalletCreate) AddJobIDs(ids ...uuid.UUID) *WalletCreate {
+	wc.mutation.AddJobIDs(ids...)
+	return wc
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (wc *WalletCreate) AddJobs(j ...*Job) *WalletCreate {
+	ids := make([]uuid.UUID, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return wc.AddJobIDs(ids...)
+}
+
+// AddSpendIDs adds the "spends" edge to the WalletSpend entity by IDs.
+func (wc *WalletCreate) AddSpendIDs(ids ...uuid.UUID) *WalletCreate {
+	wc.mutation.AddSpendIDs(ids...)
+	return wc
+}
+
+// AddSpends adds the "spends" edges to the WalletSpend entity.
+func (wc *WalletCreate) AddSpends(w ...*WalletSpend) *WalletCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddSpendIDs(ids...)
+}
+
+// AddSendApprovalIDs adds the "send_approvals" edge to the SendApproval entity by IDs.
+func (wc *WalletCreate) AddSendApprovalIDs(ids ...uuid.UUID) *WalletCreate {
+	wc.mutation.AddSendApprovalIDs(ids...)
+	return wc
+}
+
+// AddSendApprovals adds the "send_approvals" edges to the SendApproval entity.
+func (wc *WalletCreate) AddSendApprovals(s ...*SendApproval) *WalletCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wc.AddSendApprovalIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the Block entity by IDs.
+func (wc *WalletCreate) AddBlockIDs(ids ...uuid.UUID) *WalletCreate {
+	wc.mutation.AddBlockIDs(ids...)
+	return wc
+}
+
+// AddBlocks adds the "blocks" edges to the Block entity.
+func (wc *WalletCreate) AddBlocks(b ...*Block) *WalletCreate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wc.AddBlockIDs(ids...)
+}
+
 // Mutation returns the WalletMutation object of the builder.
 func (wc *WalletCreate) Mutation() *WalletMutation {
 	return wc.mutation
@@ -198,6 +468,18 @@ func (wc *WalletCreate) defaults() {
 		v := wallet.DefaultWork
 		wc.mutation.SetWork(v)
 	}
+	if _, ok := wc.mutation.WatchOnly(); !ok {
+		v := wallet.DefaultWatchOnly
+		wc.mutation.SetWatchOnly(v)
+	}
+	if _, ok := wc.mutation.Hardware(); !ok {
+		v := wallet.DefaultHardware
+		wc.mutation.SetHardware(v)
+	}
+	if _, ok := wc.mutation.AutoReceive(); !ok {
+		v := wallet.DefaultAutoReceive
+		wc.mutation.SetAutoReceive(v)
+	}
 	if _, ok := wc.mutation.CreatedAt(); !ok {
 		v := wallet.DefaultCreatedAt()
 		wc.mutation.SetCreatedAt(v)
@@ -223,12 +505,46 @@ func (wc *WalletCreate) check() error {
 			return &ValidationError{Name: "representative", err: fmt.Errorf(`ent: validator failed for field "Wallet.representative": %w`, err)}
 		}
 	}
+	if v, ok := wc.mutation.Name(); ok {
+		if err := wallet.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Wallet.name": %w`, err)}
+		}
+	}
 	if _, ok := wc.mutation.Encrypted(); !ok {
 		return &ValidationError{Name: "encrypted", err: errors.New(`ent: missing required field "Wallet.encrypted"`)}
 	}
 	if _, ok := wc.mutation.Work(); !ok {
 		return &ValidationError{Name: "work", err: errors.New(`ent: missing required field "Wallet.work"`)}
 	}
+	if _, ok := wc.mutation.WatchOnly(); !ok {
+		return &ValidationError{Name: "watch_only", err: errors.New(`ent: missing required field "Wallet.watch_only"`)}
+	}
+	if _, ok := wc.mutation.Hardware(); !ok {
+		return &ValidationError{Name: "hardware", err: errors.New(`ent: missing required field "Wallet.hardware"`)}
+	}
+	if _, ok := wc.mutation.AutoReceive(); !ok {
+		return &ValidationError{Name: "auto_receive", err: errors.New(`ent: missing required field "Wallet.auto_receive"`)}
+	}
+	if v, ok := wc.mutation.ReceiveMinimum(); ok {
+		if err := wallet.ReceiveMinimumValidator(v); err != nil {
+			return &ValidationError{Name: "receive_minimum", err: fmt.Errorf(`ent: validator failed for field "Wallet.receive_minimum": %w`, err)}
+		}
+	}
+	if v, ok := wc.mutation.Kdf(); ok {
+		if err := wallet.KdfValidator(v); err != nil {
+			return &ValidationError{Name: "kdf", err: fmt.Errorf(`ent: validator failed for field "Wallet.kdf": %w`, err)}
+		}
+	}
+	if v, ok := wc.mutation.ApprovalsRequired(); ok {
+		if err := wallet.ApprovalsRequiredValidator(v); err != nil {
+			return &ValidationError{Name: "approvals_required", err: fmt.Errorf(`ent: validator failed for field "Wallet.approvals_required": %w`, err)}
+		}
+	}
+	if v, ok := wc.mutation.ApprovalThreshold(); ok {
+		if err := wallet.ApprovalThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "approval_threshold", err: fmt.Errorf(`ent: validator failed for field "Wallet.approval_threshold": %w`, err)}
+		}
+	}
 	if _, ok := wc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Wallet.created_at"`)}
 	}
@@ -284,6 +600,14 @@ func (wc *WalletCreate) createSpec() (*Wallet, *sqlgraph.CreateSpec) {
 		})
 		_node.Representative = &value
 	}
+	if value, ok := wc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldName,
+		})
+		_node.Name = &value
+	}
 	if value, ok := wc.mutation.Encrypted(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -300,6 +624,70 @@ func (wc *WalletCreate) createSpec() (*Wallet, *sqlgraph.CreateSpec) {
 		})
 		_node.Work = value
 	}
+	if value, ok := wc.mutation.WatchOnly(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: wallet.FieldWatchOnly,
+		})
+		_node.WatchOnly = value
+	}
+	if value, ok := wc.mutation.Hardware(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: wallet.FieldHardware,
+		})
+		_node.Hardware = value
+	}
+	if value, ok := wc.mutation.AutoReceive(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: wallet.FieldAutoReceive,
+		})
+		_node.AutoReceive = value
+	}
+	if value, ok := wc.mutation.ReceiveMinimum(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldReceiveMinimum,
+		})
+		_node.ReceiveMinimum = &value
+	}
+	if value, ok := wc.mutation.FrozenAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: wallet.FieldFrozenAt,
+		})
+		_node.FrozenAt = &value
+	}
+	if value, ok := wc.mutation.Kdf(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldKdf,
+		})
+		_node.Kdf = &value
+	}
+	if value, ok := wc.mutation.ApprovalsRequired(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: wallet.FieldApprovalsRequired,
+		})
+		_node.ApprovalsRequired = &value
+	}
+	if value, ok := wc.mutation.ApprovalThreshold(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldApprovalThreshold,
+		})
+		_node.ApprovalThreshold = &value
+	}
 	if value, ok := wc.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -327,6 +715,177 @@ func (wc *WalletCreate) createSpec() (*Wallet, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := wc.mutation.SendSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendSchedulesTable,
+			Columns: []string{wallet.SendSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendschedule.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.BalanceAlertsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.BalanceAlertsTable,
+			Columns: []string{wallet.BalanceAlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancealert.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.IdempotencyKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotencyKeysTable,
+			Columns: []string{wallet.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotencykey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.IdempotentSendsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotentSendsTable,
+			Columns: []string{wallet.IdempotentSendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotentsend.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.WalletSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.WalletSnapshotsTable,
+			Columns: []string{wallet.WalletSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletsnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.JobsTable,
+			Columns: []string{wallet.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: job.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.SpendsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SpendsTable,
+			Columns: []string{wallet.SpendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletspend.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.SendApprovalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendApprovalsTable,
+			Columns: []string{wallet.SendApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendapproval.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.BlocksTable,
+			Columns: []string{wallet.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: block.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
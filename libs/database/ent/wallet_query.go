@@ -12,21 +12,39 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancealert"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotencykey"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendschedule"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
 	"github.com/google/uuid"
 )
 
 // WalletQuery is the builder for querying Wallet entities.
 type WalletQuery struct {
 	config
-	limit        *int
-	offset       *int
-	unique       *bool
-	order        []OrderFunc
-	fields       []string
-	predicates   []predicate.Wallet
-	withAccounts *AccountQuery
+	limit               *int
+	offset              *int
+	unique              *bool
+	order               []OrderFunc
+	fields              []string
+	predicates          []predicate.Wallet
+	withAccounts        *AccountQuery
+	withSendSchedules   *SendScheduleQuery
+	withBalanceAlerts   *BalanceAlertQuery
+	withIdempotencyKeys *IdempotencyKeyQuery
+	withIdempotentSends *IdempotentSendQuery
+	withWalletSnapshots *WalletSnapshotQuery
+	withJobs            *JobQuery
+	withSpends          *WalletSpendQuery
+	withSendApprovals   *SendApprovalQuery
+	withBlocks          *BlockQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -85,6 +103,204 @@ func (wq *WalletQuery) QueryAccounts() *AccountQuery {
 	return query
 }
 
+// QuerySendSchedules chains the current query on the "send_schedules" edge.
+func (wq *WalletQuery) QuerySendSchedules() *SendScheduleQuery {
+	query := &SendScheduleQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, selector),
+			sqlgraph.To(sendschedule.Table, sendschedule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.SendSchedulesTable, wallet.SendSchedulesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBalanceAlerts chains the current query on the "balance_alerts" edge.
+func (wq *WalletQuery) QueryBalanceAlerts() *BalanceAlertQuery {
+	query := &BalanceAlertQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, selector),
+			sqlgraph.To(balancealert.Table, balancealert.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.BalanceAlertsTable, wallet.BalanceAlertsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIdempotencyKeys chains the current query on the "idempotency_keys" edge.
+func (wq *WalletQuery) QueryIdempotencyKeys() *IdempotencyKeyQuery {
+	query := &IdempotencyKeyQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, selector),
+			sqlgraph.To(idempotencykey.Table, idempotencykey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.IdempotencyKeysTable, wallet.IdempotencyKeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIdempotentSends chains the current query on the "idempotent_sends" edge.
+func (wq *WalletQuery) QueryIdempotentSends() *IdempotentSendQuery {
+	query := &IdempotentSendQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, selector),
+			sqlgraph.To(idempotentsend.Table, idempotentsend.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.IdempotentSendsTable, wallet.IdempotentSendsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWalletSnapshots chains the current query on the "wallet_snapshots" edge.
+func (wq *WalletQuery) QueryWalletSnapshots() *WalletSnapshotQuery {
+	query := &WalletSnapshotQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, selector),
+			sqlgraph.To(walletsnapshot.Table, walletsnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.WalletSnapshotsTable, wallet.WalletSnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryJobs chains the current query on the "jobs" edge.
+func (wq *WalletQuery) QueryJobs() *JobQuery {
+	query := &JobQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, selector),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.JobsTable, wallet.JobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySpends chains the current query on the "spends" edge.
+func (wq *WalletQuery) QuerySpends() *WalletSpendQuery {
+	query := &WalletSpendQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, selector),
+			sqlgraph.To(walletspend.Table, walletspend.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.SpendsTable, wallet.SpendsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySendApprovals chains the current query on the "send_approvals" edge.
+func (wq *WalletQuery) QuerySendApprovals() *SendApprovalQuery {
+	query := &SendApprovalQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, selector),
+			sqlgraph.To(sendapproval.Table, sendapproval.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.SendApprovalsTable, wallet.SendApprovalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlocks chains the current query on the "blocks" edge.
+func (wq *WalletQuery) QueryBlocks() *BlockQuery {
+	query := &BlockQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wallet.Table, wallet.FieldID, selector),
+			sqlgraph.To(block.Table, block.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wallet.BlocksTable, wallet.BlocksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Wallet entity from the query.
 // Returns a *NotFoundError when no Wallet was found.
 func (wq *WalletQuery) First(ctx context.Context) (*Wallet, error) {
@@ -261,12 +477,21 @@ func (wq *WalletQuery) Clone() *WalletQuery {
 		return nil
 	}
 	return &WalletQuery{
-		config:       wq.config,
-		limit:        wq.limit,
-		offset:       wq.offset,
-		order:        append([]OrderFunc{}, wq.order...),
-		predicates:   append([]predicate.Wallet{}, wq.predicates...),
-		withAccounts: wq.withAccounts.Clone(),
+		config:              wq.config,
+		limit:               wq.limit,
+		offset:              wq.offset,
+		order:               append([]OrderFunc{}, wq.order...),
+		predicates:          append([]predicate.Wallet{}, wq.predicates...),
+		withAccounts:        wq.withAccounts.Clone(),
+		withSendSchedules:   wq.withSendSchedules.Clone(),
+		withBalanceAlerts:   wq.withBalanceAlerts.Clone(),
+		withIdempotencyKeys: wq.withIdempotencyKeys.Clone(),
+		withIdempotentSends: wq.withIdempotentSends.Clone(),
+		withWalletSnapshots: wq.withWalletSnapshots.Clone(),
+		withJobs:            wq.withJobs.Clone(),
+		withSpends:          wq.withSpends.Clone(),
+		withSendApprovals:   wq.withSendApprovals.Clone(),
+		withBlocks:          wq.withBlocks.Clone(),
 		// clone intermediate query.
 		sql:    wq.sql.Clone(),
 		path:   wq.path,
@@ -285,6 +510,105 @@ func (wq *WalletQuery) WithAccounts(opts ...func(*AccountQuery)) *WalletQuery {
 	return wq
 }
 
+// WithSendSchedules tells the query-builder to eager-load the nodes that are connected to
+// the "send_schedules" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WalletQuery) WithSendSchedules(opts ...func(*SendScheduleQuery)) *WalletQuery {
+	query := &SendScheduleQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withSendSchedules = query
+	return wq
+}
+
+// WithBalanceAlerts tells the query-builder to eager-load the nodes that are connected to
+// the "balance_alerts" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WalletQuery) WithBalanceAlerts(opts ...func(*BalanceAlertQuery)) *WalletQuery {
+	query := &BalanceAlertQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withBalanceAlerts = query
+	return wq
+}
+
+// WithIdempotencyKeys tells the query-builder to eager-load the nodes that are connected to
+// the "idempotency_keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WalletQuery) WithIdempotencyKeys(opts ...func(*IdempotencyKeyQuery)) *WalletQuery {
+	query := &IdempotencyKeyQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withIdempotencyKeys = query
+	return wq
+}
+
+// WithIdempotentSends tells the query-builder to eager-load the nodes that are connected to
+// the "idempotent_sends" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WalletQuery) WithIdempotentSends(opts ...func(*IdempotentSendQuery)) *WalletQuery {
+	query := &IdempotentSendQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withIdempotentSends = query
+	return wq
+}
+
+// WithWalletSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "wallet_snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WalletQuery) WithWalletSnapshots(opts ...func(*WalletSnapshotQuery)) *WalletQuery {
+	query := &WalletSnapshotQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withWalletSnapshots = query
+	return wq
+}
+
+// WithJobs tells the query-builder to eager-load the nodes that are connected to
+// the "jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WalletQuery) WithJobs(opts ...func(*JobQuery)) *WalletQuery {
+	query := &JobQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withJobs = query
+	return wq
+}
+
+// WithSpends tells the query-builder to eager-load the nodes that are connected to
+// the "spends" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WalletQuery) WithSpends(opts ...func(*WalletSpendQuery)) *WalletQuery {
+	query := &WalletSpendQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withSpends = query
+	return wq
+}
+
+// WithSendApprovals tells the query-builder to eager-load the nodes that are connected to
+// the "send_approvals" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WalletQuery) WithSendApprovals(opts ...func(*SendApprovalQuery)) *WalletQuery {
+	query := &SendApprovalQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withSendApprovals = query
+	return wq
+}
+
+// WithBlocks tells the query-builder to eager-load the nodes that are connected to
+// the "blocks" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WalletQuery) WithBlocks(opts ...func(*BlockQuery)) *WalletQuery {
+	query := &BlockQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withBlocks = query
+	return wq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -353,8 +677,17 @@ func (wq *WalletQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Walle
 	var (
 		nodes       = []*Wallet{}
 		_spec       = wq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [10]bool{
 			wq.withAccounts != nil,
+			wq.withSendSchedules != nil,
+			wq.withBalanceAlerts != nil,
+			wq.withIdempotencyKeys != nil,
+			wq.withIdempotentSends != nil,
+			wq.withWalletSnapshots != nil,
+			wq.withJobs != nil,
+			wq.withSpends != nil,
+			wq.withSendApprovals != nil,
+			wq.withBlocks != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -382,6 +715,69 @@ func (wq *WalletQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Walle
 			return nil, err
 		}
 	}
+	if query := wq.withSendSchedules; query != nil {
+		if err := wq.loadSendSchedules(ctx, query, nodes,
+			func(n *Wallet) { n.Edges.SendSchedules = []*SendSchedule{} },
+			func(n *Wallet, e *SendSchedule) { n.Edges.SendSchedules = append(n.Edges.SendSchedules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wq.withBalanceAlerts; query != nil {
+		if err := wq.loadBalanceAlerts(ctx, query, nodes,
+			func(n *Wallet) { n.Edges.BalanceAlerts = []*BalanceAlert{} },
+			func(n *Wallet, e *BalanceAlert) { n.Edges.BalanceAlerts = append(n.Edges.BalanceAlerts, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wq.withIdempotencyKeys; query != nil {
+		if err := wq.loadIdempotencyKeys(ctx, query, nodes,
+			func(n *Wallet) { n.Edges.IdempotencyKeys = []*IdempotencyKey{} },
+			func(n *Wallet, e *IdempotencyKey) { n.Edges.IdempotencyKeys = append(n.Edges.IdempotencyKeys, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wq.withIdempotentSends; query != nil {
+		if err := wq.loadIdempotentSends(ctx, query, nodes,
+			func(n *Wallet) { n.Edges.IdempotentSends = []*IdempotentSend{} },
+			func(n *Wallet, e *IdempotentSend) { n.Edges.IdempotentSends = append(n.Edges.IdempotentSends, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wq.withWalletSnapshots; query != nil {
+		if err := wq.loadWalletSnapshots(ctx, query, nodes,
+			func(n *Wallet) { n.Edges.WalletSnapshots = []*WalletSnapshot{} },
+			func(n *Wallet, e *WalletSnapshot) { n.Edges.WalletSnapshots = append(n.Edges.WalletSnapshots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wq.withJobs; query != nil {
+		if err := wq.loadJobs(ctx, query, nodes,
+			func(n *Wallet) { n.Edges.Jobs = []*Job{} },
+			func(n *Wallet, e *Job) { n.Edges.Jobs = append(n.Edges.Jobs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wq.withSpends; query != nil {
+		if err := wq.loadSpends(ctx, query, nodes,
+			func(n *Wallet) { n.Edges.Spends = []*WalletSpend{} },
+			func(n *Wallet, e *WalletSpend) { n.Edges.Spends = append(n.Edges.Spends, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wq.withSendApprovals; query != nil {
+		if err := wq.loadSendApprovals(ctx, query, nodes,
+			func(n *Wallet) { n.Edges.SendApprovals = []*SendApproval{} },
+			func(n *Wallet, e *SendApproval) { n.Edges.SendApprovals = append(n.Edges.SendApprovals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wq.withBlocks; query != nil {
+		if err := wq.loadBlocks(ctx, query, nodes,
+			func(n *Wallet) { n.Edges.Blocks = []*Block{} },
+			func(n *Wallet, e *Block) { n.Edges.Blocks = append(n.Edges.Blocks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -412,6 +808,252 @@ func (wq *WalletQuery) loadAccounts(ctx context.Context, query *AccountQuery, no
 	}
 	return nil
 }
+func (wq *WalletQuery) loadSendSchedules(ctx context.Context, query *SendScheduleQuery, nodes []*Wallet, init func(*Wallet), assign func(*Wallet, *SendSchedule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Wallet)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.SendSchedule(func(s *sql.Selector) {
+		s.Where(sql.InValues(wallet.SendSchedulesColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WalletID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (wq *WalletQuery) loadBalanceAlerts(ctx context.Context, query *BalanceAlertQuery, nodes []*Wallet, init func(*Wallet), assign func(*Wallet, *BalanceAlert)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Wallet)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.BalanceAlert(func(s *sql.Selector) {
+		s.Where(sql.InValues(wallet.BalanceAlertsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WalletID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (wq *WalletQuery) loadIdempotencyKeys(ctx context.Context, query *IdempotencyKeyQuery, nodes []*Wallet, init func(*Wallet), assign func(*Wallet, *IdempotencyKey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Wallet)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.IdempotencyKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(wallet.IdempotencyKeysColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WalletID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (wq *WalletQuery) loadIdempotentSends(ctx context.Context, query *IdempotentSendQuery, nodes []*Wallet, init func(*Wallet), assign func(*Wallet, *IdempotentSend)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Wallet)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.IdempotentSend(func(s *sql.Selector) {
+		s.Where(sql.InValues(wallet.IdempotentSendsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WalletID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (wq *WalletQuery) loadWalletSnapshots(ctx context.Context, query *WalletSnapshotQuery, nodes []*Wallet, init func(*Wallet), assign func(*Wallet, *WalletSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Wallet)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(wallet.WalletSnapshotsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WalletID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (wq *WalletQuery) loadJobs(ctx context.Context, query *JobQuery, nodes []*Wallet, init func(*Wallet), assign func(*Wallet, *Job)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Wallet)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.Job(func(s *sql.Selector) {
+		s.Where(sql.InValues(wallet.JobsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WalletID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (wq *WalletQuery) loadSpends(ctx context.Context, query *WalletSpendQuery, nodes []*Wallet, init func(*Wallet), assign func(*Wallet, *WalletSpend)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Wallet)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.InValues(wallet.SpendsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WalletID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (wq *WalletQuery) loadSendApprovals(ctx context.Context, query *SendApprovalQuery, nodes []*Wallet, init func(*Wallet), assign func(*Wallet, *SendApproval)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Wallet)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.SendApproval(func(s *sql.Selector) {
+		s.Where(sql.InValues(wallet.SendApprovalsColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WalletID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (wq *WalletQuery) loadBlocks(ctx context.Context, query *BlockQuery, nodes []*Wallet, init func(*Wallet), assign func(*Wallet, *Block)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Wallet)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.Block(func(s *sql.Selector) {
+		s.Where(sql.InValues(wallet.BlocksColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WalletID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "wallet_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (wq *WalletQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := wq.querySpec()
@@ -6,13 +6,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancealert"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotencykey"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendschedule"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
 	"github.com/google/uuid"
 )
 
@@ -55,6 +65,26 @@ func (wu *WalletUpdate) ClearRepresentative() *WalletUpdate {
 	return wu
 }
 
+// SetName sets the "name" field.
+func (wu *WalletUpdate) SetName(s string) *WalletUpdate {
+	wu.mutation.SetName(s)
+	return wu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableName(s *string) *WalletUpdate {
+	if s != nil {
+		wu.SetName(*s)
+	}
+	return wu
+}
+
+// ClearName clears the value of the "name" field.
+func (wu *WalletUpdate) ClearName() *WalletUpdate {
+	wu.mutation.ClearName()
+	return wu
+}
+
 // SetEncrypted sets the "encrypted" field.
 func (wu *WalletUpdate) SetEncrypted(b bool) *WalletUpdate {
 	wu.mutation.SetEncrypted(b)
@@ -83,6 +113,155 @@ func (wu *WalletUpdate) SetNillableWork(b *bool) *WalletUpdate {
 	return wu
 }
 
+// SetWatchOnly sets the "watch_only" field.
+func (wu *WalletUpdate) SetWatchOnly(b bool) *WalletUpdate {
+	wu.mutation.SetWatchOnly(b)
+	return wu
+}
+
+// SetNillableWatchOnly sets the "watch_only" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableWatchOnly(b *bool) *WalletUpdate {
+	if b != nil {
+		wu.SetWatchOnly(*b)
+	}
+	return wu
+}
+
+// SetHardware sets the "hardware" field.
+func (wu *WalletUpdate) SetHardware(b bool) *WalletUpdate {
+	wu.mutation.SetHardware(b)
+	return wu
+}
+
+// SetNillableHardware sets the "hardware" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableHardware(b *bool) *WalletUpdate {
+	if b != nil {
+		wu.SetHardware(*b)
+	}
+	return wu
+}
+
+// SetAutoReceive sets the "auto_receive" field.
+func (wu *WalletUpdate) SetAutoReceive(b bool) *WalletUpdate {
+	wu.mutation.SetAutoReceive(b)
+	return wu
+}
+
+// SetNillableAutoReceive sets the "auto_receive" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableAutoReceive(b *bool) *WalletUpdate {
+	if b != nil {
+		wu.SetAutoReceive(*b)
+	}
+	return wu
+}
+
+// SetReceiveMinimum sets the "receive_minimum" field.
+func (wu *WalletUpdate) SetReceiveMinimum(s string) *WalletUpdate {
+	wu.mutation.SetReceiveMinimum(s)
+	return wu
+}
+
+// SetNillableReceiveMinimum sets the "receive_minimum" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableReceiveMinimum(s *string) *WalletUpdate {
+	if s != nil {
+		wu.SetReceiveMinimum(*s)
+	}
+	return wu
+}
+
+// ClearReceiveMinimum clears the value of the "receive_minimum" field.
+func (wu *WalletUpdate) ClearReceiveMinimum() *WalletUpdate {
+	wu.mutation.ClearReceiveMinimum()
+	return wu
+}
+
+// SetFrozenAt sets the "frozen_at" field.
+func (wu *WalletUpdate) SetFrozenAt(t time.Time) *WalletUpdate {
+	wu.mutation.SetFrozenAt(t)
+	return wu
+}
+
+// SetNillableFrozenAt sets the "frozen_at" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableFrozenAt(t *time.Time) *WalletUpdate {
+	if t != nil {
+		wu.SetFrozenAt(*t)
+	}
+	return wu
+}
+
+// ClearFrozenAt clears the value of the "frozen_at" field.
+func (wu *WalletUpdate) ClearFrozenAt() *WalletUpdate {
+	wu.mutation.ClearFrozenAt()
+	return wu
+}
+
+// SetKdf sets the "kdf" field.
+func (wu *WalletUpdate) SetKdf(s string) *WalletUpdate {
+	wu.mutation.SetKdf(s)
+	return wu
+}
+
+// SetNillableKdf sets the "kdf" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableKdf(s *string) *WalletUpdate {
+	if s != nil {
+		wu.SetKdf(*s)
+	}
+	return wu
+}
+
+// ClearKdf clears the value of the "kdf" field.
+func (wu *WalletUpdate) ClearKdf() *WalletUpdate {
+	wu.mutation.ClearKdf()
+	return wu
+}
+
+// SetApprovalsRequired sets the "approvals_required" field.
+func (wu *WalletUpdate) SetApprovalsRequired(i int) *WalletUpdate {
+	wu.mutation.ResetApprovalsRequired()
+	wu.mutation.SetApprovalsRequired(i)
+	return wu
+}
+
+// SetNillableApprovalsRequired sets the "approvals_required" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableApprovalsRequired(i *int) *WalletUpdate {
+	if i != nil {
+		wu.SetApprovalsRequired(*i)
+	}
+	return wu
+}
+
+// AddApprovalsRequired adds i to the "approvals_required" field.
+func (wu *WalletUpdate) AddApprovalsRequired(i int) *WalletUpdate {
+	wu.mutation.AddApprovalsRequired(i)
+	return wu
+}
+
+// ClearApprovalsRequired clears the value of the "approvals_required" field.
+func (wu *WalletUpdate) ClearApprovalsRequired() *WalletUpdate {
+	wu.mutation.ClearApprovalsRequired()
+	return wu
+}
+
+// SetApprovalThreshold sets the "approval_threshold" field.
+func (wu *WalletUpdate) SetApprovalThreshold(s string) *WalletUpdate {
+	wu.mutation.SetApprovalThreshold(s)
+	return wu
+}
+
+// SetNillableApprovalThreshold sets the "approval_threshold" field if the given value is not nil.
+func (wu *WalletUpdate) SetNillableApprovalThreshold(s *string) *WalletUpdate {
+	if s != nil {
+		wu.SetApprovalThreshold(*s)
+	}
+	return wu
+}
+
+// ClearApprovalThreshold clears the value of the "approval_threshold" field.
+func (wu *WalletUpdate) ClearApprovalThreshold() *WalletUpdate {
+	wu.mutation.ClearApprovalThreshold()
+	return wu
+}
+
 // AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
 func (wu *WalletUpdate) AddAccountIDs(ids ...uuid.UUID) *WalletUpdate {
 	wu.mutation.AddAccountIDs(ids...)
@@ -98,6 +277,141 @@ func (wu *WalletUpdate) AddAccounts(a ...*Account) *WalletUpdate {
 	return wu.AddAccountIDs(ids...)
 }
 
+// AddSendScheduleIDs adds the "send_schedules" edge to the SendSchedule entity by IDs.
+func (wu *WalletUpdate) AddSendScheduleIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.AddSendScheduleIDs(ids...)
+	return wu
+}
+
+// AddSendSchedules adds the "send_schedules" edges to the SendSchedule entity.
+func (wu *WalletUpdate) AddSendSchedules(s ...*SendSchedule) *WalletUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wu.AddSendScheduleIDs(ids...)
+}
+
+// AddBalanceAlertIDs adds the "balance_alerts" edge to the BalanceAlert entity by IDs.
+func (wu *WalletUpdate) AddBalanceAlertIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.AddBalanceAlertIDs(ids...)
+	return wu
+}
+
+// AddBalanceAlerts adds the "balance_alerts" edges to the BalanceAlert entity.
+func (wu *WalletUpdate) AddBalanceAlerts(b ...*BalanceAlert) *WalletUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wu.AddBalanceAlertIDs(ids...)
+}
+
+// AddIdempotencyKeyIDs adds the "idempotency_keys" edge to the IdempotencyKey entity by IDs.
+func (wu *WalletUpdate) AddIdempotencyKeyIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.AddIdempotencyKeyIDs(ids...)
+	return wu
+}
+
+// AddIdempotencyKeys adds the "idempotency_keys" edges to the IdempotencyKey entity.
+func (wu *WalletUpdate) AddIdempotencyKeys(i ...*IdempotencyKey) *WalletUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wu.AddIdempotencyKeyIDs(ids...)
+}
+
+// AddIdempotentSendIDs adds the "idempotent_sends" edge to the IdempotentSend entity by IDs.
+func (wu *WalletUpdate) AddIdempotentSendIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.AddIdempotentSendIDs(ids...)
+	return wu
+}
+
+// AddIdempotentSends adds the "idempotent_sends" edges to the IdempotentSend entity.
+func (wu *WalletUpdate) AddIdempotentSends(i ...*IdempotentSend) *WalletUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wu.AddIdempotentSendIDs(ids...)
+}
+
+// AddWalletSnapshotIDs adds the "wallet_snapshots" edge to the WalletSnapshot entity by IDs.
+func (wu *WalletUpdate) AddWalletSnapshotIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.AddWalletSnapshotIDs(ids...)
+	return wu
+}
+
+// AddWalletSnapshots adds the "wallet_snapshots" edges to the WalletSnapshot entity.
+func (wu *WalletUpdate) AddWalletSnapshots(w ...*WalletSnapshot) *WalletUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddWalletSnapshotIDs(ids...)
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (wu *WalletUpdate) AddJobIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.AddJobIDs(ids...)
+	return wu
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (wu *WalletUpdate) AddJobs(j ...*Job) *WalletUpdate {
+	ids := make([]uuid.UUID, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return wu.AddJobIDs(ids...)
+}
+
+// AddSpendIDs adds the "spends" edge to the WalletSpend entity by IDs.
+func (wu *WalletUpdate) AddSpendIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.AddSpendIDs(ids...)
+	return wu
+}
+
+// AddSpends adds the "spends" edges to the WalletSpend entity.
+func (wu *WalletUpdate) AddSpends(w ...*WalletSpend) *WalletUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddSpendIDs(ids...)
+}
+
+// AddSendApprovalIDs adds the "send_approvals" edge to the SendApproval entity by IDs.
+func (wu *WalletUpdate) AddSendApprovalIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.AddSendApprovalIDs(ids...)
+	return wu
+}
+
+// AddSendApprovals adds the "send_approvals" edges to the SendApproval entity.
+func (wu *WalletUpdate) AddSendApprovals(s ...*SendApproval) *WalletUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wu.AddSendApprovalIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the Block entity by IDs.
+func (wu *WalletUpdate) AddBlockIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.AddBlockIDs(ids...)
+	return wu
+}
+
+// AddBlocks adds the "blocks" edges to the Block entity.
+func (wu *WalletUpdate) AddBlocks(b ...*Block) *WalletUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wu.AddBlockIDs(ids...)
+}
+
 // Mutation returns the WalletMutation object of the builder.
 func (wu *WalletUpdate) Mutation() *WalletMutation {
 	return wu.mutation
@@ -124,6 +438,195 @@ func (wu *WalletUpdate) RemoveAccounts(a ...*Account) *WalletUpdate {
 	return wu.RemoveAccountIDs(ids...)
 }
 
+// ClearSendSchedules clears all "send_schedules" edges to the SendSchedule entity.
+func (wu *WalletUpdate) ClearSendSchedules() *WalletUpdate {
+	wu.mutation.ClearSendSchedules()
+	return wu
+}
+
+// RemoveSendScheduleIDs removes the "send_schedules" edge to SendSchedule entities by IDs.
+func (wu *WalletUpdate) RemoveSendScheduleIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.RemoveSendScheduleIDs(ids...)
+	return wu
+}
+
+// RemoveSendSchedules removes "send_schedules" edges to SendSchedule entities.
+func (wu *WalletUpdate) RemoveSendSchedules(s ...*SendSchedule) *WalletUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wu.RemoveSendScheduleIDs(ids...)
+}
+
+// ClearBalanceAlerts clears all "balance_alerts" edges to the BalanceAlert entity.
+func (wu *WalletUpdate) ClearBalanceAlerts() *WalletUpdate {
+	wu.mutation.ClearBalanceAlerts()
+	return wu
+}
+
+// RemoveBalanceAlertIDs removes the "balance_alerts" edge to BalanceAlert entities by IDs.
+func (wu *WalletUpdate) RemoveBalanceAlertIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.RemoveBalanceAlertIDs(ids...)
+	return wu
+}
+
+// RemoveBalanceAlerts removes "balance_alerts" edges to BalanceAlert entities.
+func (wu *WalletUpdate) RemoveBalanceAlerts(b ...*BalanceAlert) *WalletUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wu.RemoveBalanceAlertIDs(ids...)
+}
+
+// ClearIdempotencyKeys clears all "idempotency_keys" edges to the IdempotencyKey entity.
+func (wu *WalletUpdate) ClearIdempotencyKeys() *WalletUpdate {
+	wu.mutation.ClearIdempotencyKeys()
+	return wu
+}
+
+// RemoveIdempotencyKeyIDs removes the "idempotency_keys" edge to IdempotencyKey entities by IDs.
+func (wu *WalletUpdate) RemoveIdempotencyKeyIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.RemoveIdempotencyKeyIDs(ids...)
+	return wu
+}
+
+// RemoveIdempotencyKeys removes "idempotency_keys" edges to IdempotencyKey entities.
+func (wu *WalletUpdate) RemoveIdempotencyKeys(i ...*IdempotencyKey) *WalletUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wu.RemoveIdempotencyKeyIDs(ids...)
+}
+
+// ClearIdempotentSends clears all "idempotent_sends" edges to the IdempotentSend entity.
+func (wu *WalletUpdate) ClearIdempotentSends() *WalletUpdate {
+	wu.mutation.ClearIdempotentSends()
+	return wu
+}
+
+// RemoveIdempotentSendIDs removes the "idempotent_sends" edge to IdempotentSend entities by IDs.
+func (wu *WalletUpdate) RemoveIdempotentSendIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.RemoveIdempotentSendIDs(ids...)
+	return wu
+}
+
+// RemoveIdempotentSends removes "idempotent_sends" edges to IdempotentSend entities.
+func (wu *WalletUpdate) RemoveIdempotentSends(i ...*IdempotentSend) *WalletUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wu.RemoveIdempotentSendIDs(ids...)
+}
+
+// ClearWalletSnapshots clears all "wallet_snapshots" edges to the WalletSnapshot entity.
+func (wu *WalletUpdate) ClearWalletSnapshots() *WalletUpdate {
+	wu.mutation.ClearWalletSnapshots()
+	return wu
+}
+
+// RemoveWalletSnapshotIDs removes the "wallet_snapshots" edge to WalletSnapshot entities by IDs.
+func (wu *WalletUpdate) RemoveWalletSnapshotIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.RemoveWalletSnapshotIDs(ids...)
+	return wu
+}
+
+// RemoveWalletSnapshots removes "wallet_snapshots" edges to WalletSnapshot entities.
+func (wu *WalletUpdate) RemoveWalletSnapshots(w ...*WalletSnapshot) *WalletUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveWalletSnapshotIDs(ids...)
+}
+
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (wu *WalletUpdate) ClearJobs() *WalletUpdate {
+	wu.mutation.ClearJobs()
+	return wu
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (wu *WalletUpdate) RemoveJobIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.RemoveJobIDs(ids...)
+	return wu
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (wu *WalletUpdate) RemoveJobs(j ...*Job) *WalletUpdate {
+	ids := make([]uuid.UUID, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return wu.RemoveJobIDs(ids...)
+}
+
+// ClearSpends clears all "spends" edges to the WalletSpend entity.
+func (wu *WalletUpdate) ClearSpends() *WalletUpdate {
+	wu.mutation.ClearSpends()
+	return wu
+}
+
+// RemoveSpendIDs removes the "spends" edge to WalletSpend entities by IDs.
+func (wu *WalletUpdate) RemoveSpendIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.RemoveSpendIDs(ids...)
+	return wu
+}
+
+// RemoveSpends removes "spends" edges to WalletSpend entities.
+func (wu *WalletUpdate) RemoveSpends(w ...*WalletSpend) *WalletUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveSpendIDs(ids...)
+}
+
+// ClearSendApprovals clears all "send_approvals" edges to the SendApproval entity.
+func (wu *WalletUpdate) ClearSendApprovals() *WalletUpdate {
+	wu.mutation.ClearSendApprovals()
+	return wu
+}
+
+// RemoveSendApprovalIDs removes the "send_approvals" edge to SendApproval entities by IDs.
+func (wu *WalletUpdate) RemoveSendApprovalIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.RemoveSendApprovalIDs(ids...)
+	return wu
+}
+
+// RemoveSendApprovals removes "send_approvals" edges to SendApproval entities.
+func (wu *WalletUpdate) RemoveSendApprovals(s ...*SendApproval) *WalletUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wu.RemoveSendApprovalIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the Block entity.
+func (wu *WalletUpdate) ClearBlocks() *WalletUpdate {
+	wu.mutation.ClearBlocks()
+	return wu
+}
+
+// RemoveBlockIDs removes the "blocks" edge to Block entities by IDs.
+func (wu *WalletUpdate) RemoveBlockIDs(ids ...uuid.UUID) *WalletUpdate {
+	wu.mutation.RemoveBlockIDs(ids...)
+	return wu
+}
+
+// RemoveBlocks removes "blocks" edges to Block entities.
+func (wu *WalletUpdate) RemoveBlocks(b ...*Block) *WalletUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wu.RemoveBlockIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (wu *WalletUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -196,6 +699,31 @@ func (wu *WalletUpdate) check() error {
 			return &ValidationError{Name: "representative", err: fmt.Errorf(`ent: validator failed for field "Wallet.representative": %w`, err)}
 		}
 	}
+	if v, ok := wu.mutation.Name(); ok {
+		if err := wallet.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Wallet.name": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.ReceiveMinimum(); ok {
+		if err := wallet.ReceiveMinimumValidator(v); err != nil {
+			return &ValidationError{Name: "receive_minimum", err: fmt.Errorf(`ent: validator failed for field "Wallet.receive_minimum": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.Kdf(); ok {
+		if err := wallet.KdfValidator(v); err != nil {
+			return &ValidationError{Name: "kdf", err: fmt.Errorf(`ent: validator failed for field "Wallet.kdf": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.ApprovalsRequired(); ok {
+		if err := wallet.ApprovalsRequiredValidator(v); err != nil {
+			return &ValidationError{Name: "approvals_required", err: fmt.Errorf(`ent: validator failed for field "Wallet.approvals_required": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.ApprovalThreshold(); ok {
+		if err := wallet.ApprovalThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "approval_threshold", err: fmt.Errorf(`ent: validator failed for field "Wallet.approval_threshold": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -237,6 +765,19 @@ func (wu *WalletUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: wallet.FieldRepresentative,
 		})
 	}
+	if value, ok := wu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldName,
+		})
+	}
+	if wu.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: wallet.FieldName,
+		})
+	}
 	if value, ok := wu.mutation.Encrypted(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -251,17 +792,110 @@ func (wu *WalletUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: wallet.FieldWork,
 		})
 	}
-	if wu.mutation.AccountsCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: false,
-			Table:   wallet.AccountsTable,
-			Columns: []string{wallet.AccountsColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: &sqlgraph.FieldSpec{
-					Type:   field.TypeUUID,
-					Column: account.FieldID,
+	if value, ok := wu.mutation.WatchOnly(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: wallet.FieldWatchOnly,
+		})
+	}
+	if value, ok := wu.mutation.Hardware(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: wallet.FieldHardware,
+		})
+	}
+	if value, ok := wu.mutation.AutoReceive(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: wallet.FieldAutoReceive,
+		})
+	}
+	if value, ok := wu.mutation.ReceiveMinimum(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldReceiveMinimum,
+		})
+	}
+	if wu.mutation.ReceiveMinimumCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: wallet.FieldReceiveMinimum,
+		})
+	}
+	if value, ok := wu.mutation.FrozenAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: wallet.FieldFrozenAt,
+		})
+	}
+	if wu.mutation.FrozenAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: wallet.FieldFrozenAt,
+		})
+	}
+	if value, ok := wu.mutation.Kdf(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldKdf,
+		})
+	}
+	if wu.mutation.KdfCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: wallet.FieldKdf,
+		})
+	}
+	if value, ok := wu.mutation.ApprovalsRequired(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: wallet.FieldApprovalsRequired,
+		})
+	}
+	if value, ok := wu.mutation.AddedApprovalsRequired(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: wallet.FieldApprovalsRequired,
+		})
+	}
+	if wu.mutation.ApprovalsRequiredCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: wallet.FieldApprovalsRequired,
+		})
+	}
+	if value, ok := wu.mutation.ApprovalThreshold(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldApprovalThreshold,
+		})
+	}
+	if wu.mutation.ApprovalThresholdCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: wallet.FieldApprovalThreshold,
+		})
+	}
+	if wu.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.AccountsTable,
+			Columns: []string{wallet.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
 				},
 			},
 		}
@@ -305,122 +939,1101 @@ func (wu *WalletUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{wallet.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if wu.mutation.SendSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendSchedulesTable,
+			Columns: []string{wallet.SendSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendschedule.FieldID,
+				},
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return n, nil
-}
-
-// WalletUpdateOne is the builder for updating a single Wallet entity.
-type WalletUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *WalletMutation
-}
-
-// SetSeed sets the "seed" field.
-func (wuo *WalletUpdateOne) SetSeed(s string) *WalletUpdateOne {
-	wuo.mutation.SetSeed(s)
-	return wuo
-}
-
-// SetRepresentative sets the "representative" field.
-func (wuo *WalletUpdateOne) SetRepresentative(s string) *WalletUpdateOne {
-	wuo.mutation.SetRepresentative(s)
-	return wuo
-}
-
-// SetNillableRepresentative sets the "representative" field if the given value is not nil.
-func (wuo *WalletUpdateOne) SetNillableRepresentative(s *string) *WalletUpdateOne {
-	if s != nil {
-		wuo.SetRepresentative(*s)
+	if nodes := wu.mutation.RemovedSendSchedulesIDs(); len(nodes) > 0 && !wu.mutation.SendSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendSchedulesTable,
+			Columns: []string{wallet.SendSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendschedule.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return wuo
-}
-
-// ClearRepresentative clears the value of the "representative" field.
-func (wuo *WalletUpdateOne) ClearRepresentative() *WalletUpdateOne {
-	wuo.mutation.ClearRepresentative()
-	return wuo
-}
-
-// SetEncrypted sets the "encrypted" field.
-func (wuo *WalletUpdateOne) SetEncrypted(b bool) *WalletUpdateOne {
-	wuo.mutation.SetEncrypted(b)
-	return wuo
-}
-
-// SetNillableEncrypted sets the "encrypted" field if the given value is not nil.
-func (wuo *WalletUpdateOne) SetNillableEncrypted(b *bool) *WalletUpdateOne {
-	if b != nil {
-		wuo.SetEncrypted(*b)
+	if nodes := wu.mutation.SendSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendSchedulesTable,
+			Columns: []string{wallet.SendSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendschedule.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return wuo
-}
-
-// SetWork sets the "work" field.
-func (wuo *WalletUpdateOne) SetWork(b bool) *WalletUpdateOne {
-	wuo.mutation.SetWork(b)
-	return wuo
-}
-
-// SetNillableWork sets the "work" field if the given value is not nil.
-func (wuo *WalletUpdateOne) SetNillableWork(b *bool) *WalletUpdateOne {
-	if b != nil {
-		wuo.SetWork(*b)
+	if wu.mutation.BalanceAlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.BalanceAlertsTable,
+			Columns: []string{wallet.BalanceAlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancealert.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return wuo
-}
-
-// AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
-func (wuo *WalletUpdateOne) AddAccountIDs(ids ...uuid.UUID) *WalletUpdateOne {
-	wuo.mutation.AddAccountIDs(ids...)
-	return wuo
-}
-
-// AddAccounts adds the "accounts" edges to the Account entity.
-func (wuo *WalletUpdateOne) AddAccounts(a ...*Account) *WalletUpdateOne {
-	ids := make([]uuid.UUID, len(a))
-	for i := range a {
-		ids[i] = a[i].ID
+	if nodes := wu.mutation.RemovedBalanceAlertsIDs(); len(nodes) > 0 && !wu.mutation.BalanceAlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.BalanceAlertsTable,
+			Columns: []string{wallet.BalanceAlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancealert.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return wuo.AddAccountIDs(ids...)
-}
-
-// Mutation returns the WalletMutation object of the builder.
-func (wuo *WalletUpdateOne) Mutation() *WalletMutation {
-	return wuo.mutation
-}
-
-// ClearAccounts clears all "accounts" edges to the Account entity.
-func (wuo *WalletUpdateOne) ClearAccounts() *WalletUpdateOne {
-	wuo.mutation.ClearAccounts()
-	return wuo
-}
-
-// RemoveAccountIDs removes the "accounts" edge to Account entities by IDs.
-func (wuo *WalletUpdateOne) RemoveAccountIDs(ids ...uuid.UUID) *WalletUpdateOne {
-	wuo.mutation.RemoveAccountIDs(ids...)
-	return wuo
-}
-
-// RemoveAccounts removes "accounts" edges to Account entities.
-func (wuo *WalletUpdateOne) RemoveAccounts(a ...*Account) *WalletUpdateOne {
-	ids := make([]uuid.UUID, len(a))
-	for i := range a {
-		ids[i] = a[i].ID
+	if nodes := wu.mutation.BalanceAlertsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.BalanceAlertsTable,
+			Columns: []string{wallet.BalanceAlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancealert.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return wuo.RemoveAccountIDs(ids...)
-}
-
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
+	if wu.mutation.IdempotencyKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotencyKeysTable,
+			Columns: []string{wallet.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotencykey.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedIdempotencyKeysIDs(); len(nodes) > 0 && !wu.mutation.IdempotencyKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotencyKeysTable,
+			Columns: []string{wallet.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotencykey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.IdempotencyKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotencyKeysTable,
+			Columns: []string{wallet.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotencykey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.IdempotentSendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotentSendsTable,
+			Columns: []string{wallet.IdempotentSendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotentsend.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedIdempotentSendsIDs(); len(nodes) > 0 && !wu.mutation.IdempotentSendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotentSendsTable,
+			Columns: []string{wallet.IdempotentSendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotentsend.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.IdempotentSendsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotentSendsTable,
+			Columns: []string{wallet.IdempotentSendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotentsend.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.WalletSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.WalletSnapshotsTable,
+			Columns: []string{wallet.WalletSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletsnapshot.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedWalletSnapshotsIDs(); len(nodes) > 0 && !wu.mutation.WalletSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.WalletSnapshotsTable,
+			Columns: []string{wallet.WalletSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletsnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.WalletSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.WalletSnapshotsTable,
+			Columns: []string{wallet.WalletSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletsnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.JobsTable,
+			Columns: []string{wallet.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: job.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedJobsIDs(); len(nodes) > 0 && !wu.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.JobsTable,
+			Columns: []string{wallet.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: job.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.JobsTable,
+			Columns: []string{wallet.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: job.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.SpendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SpendsTable,
+			Columns: []string{wallet.SpendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletspend.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedSpendsIDs(); len(nodes) > 0 && !wu.mutation.SpendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SpendsTable,
+			Columns: []string{wallet.SpendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletspend.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.SpendsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SpendsTable,
+			Columns: []string{wallet.SpendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletspend.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.SendApprovalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendApprovalsTable,
+			Columns: []string{wallet.SendApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendapproval.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedSendApprovalsIDs(); len(nodes) > 0 && !wu.mutation.SendApprovalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendApprovalsTable,
+			Columns: []string{wallet.SendApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendapproval.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.SendApprovalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendApprovalsTable,
+			Columns: []string{wallet.SendApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendapproval.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wu.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.BlocksTable,
+			Columns: []string{wallet.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: block.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !wu.mutation.BlocksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.BlocksTable,
+			Columns: []string{wallet.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: block.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.BlocksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.BlocksTable,
+			Columns: []string{wallet.BlocksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: block.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wallet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WalletUpdateOne is the builder for updating a single Wallet entity.
+type WalletUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WalletMutation
+}
+
+// SetSeed sets the "seed" field.
+func (wuo *WalletUpdateOne) SetSeed(s string) *WalletUpdateOne {
+	wuo.mutation.SetSeed(s)
+	return wuo
+}
+
+// SetRepresentative sets the "representative" field.
+func (wuo *WalletUpdateOne) SetRepresentative(s string) *WalletUpdateOne {
+	wuo.mutation.SetRepresentative(s)
+	return wuo
+}
+
+// SetNillableRepresentative sets the "representative" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableRepresentative(s *string) *WalletUpdateOne {
+	if s != nil {
+		wuo.SetRepresentative(*s)
+	}
+	return wuo
+}
+
+// ClearRepresentative clears the value of the "representative" field.
+func (wuo *WalletUpdateOne) ClearRepresentative() *WalletUpdateOne {
+	wuo.mutation.ClearRepresentative()
+	return wuo
+}
+
+// SetName sets the "name" field.
+func (wuo *WalletUpdateOne) SetName(s string) *WalletUpdateOne {
+	wuo.mutation.SetName(s)
+	return wuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableName(s *string) *WalletUpdateOne {
+	if s != nil {
+		wuo.SetName(*s)
+	}
+	return wuo
+}
+
+// ClearName clears the value of the "name" field.
+func (wuo *WalletUpdateOne) ClearName() *WalletUpdateOne {
+	wuo.mutation.ClearName()
+	return wuo
+}
+
+// SetEncrypted sets the "encrypted" field.
+func (wuo *WalletUpdateOne) SetEncrypted(b bool) *WalletUpdateOne {
+	wuo.mutation.SetEncrypted(b)
+	return wuo
+}
+
+// SetNillableEncrypted sets the "encrypted" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableEncrypted(b *bool) *WalletUpdateOne {
+	if b != nil {
+		wuo.SetEncrypted(*b)
+	}
+	return wuo
+}
+
+// SetWork sets the "work" field.
+func (wuo *WalletUpdateOne) SetWork(b bool) *WalletUpdateOne {
+	wuo.mutation.SetWork(b)
+	return wuo
+}
+
+// SetNillableWork sets the "work" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableWork(b *bool) *WalletUpdateOne {
+	if b != nil {
+		wuo.SetWork(*b)
+	}
+	return wuo
+}
+
+// SetWatchOnly sets the "watch_only" field.
+func (wuo *WalletUpdateOne) SetWatchOnly(b bool) *WalletUpdateOne {
+	wuo.mutation.SetWatchOnly(b)
+	return wuo
+}
+
+// SetNillableWatchOnly sets the "watch_only" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableWatchOnly(b *bool) *WalletUpdateOne {
+	if b != nil {
+		wuo.SetWatchOnly(*b)
+	}
+	return wuo
+}
+
+// SetHardware sets the "hardware" field.
+func (wuo *WalletUpdateOne) SetHardware(b bool) *WalletUpdateOne {
+	wuo.mutation.SetHardware(b)
+	return wuo
+}
+
+// SetNillableHardware sets the "hardware" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableHardware(b *bool) *WalletUpdateOne {
+	if b != nil {
+		wuo.SetHardware(*b)
+	}
+	return wuo
+}
+
+// SetAutoReceive sets the "auto_receive" field.
+func (wuo *WalletUpdateOne) SetAutoReceive(b bool) *WalletUpdateOne {
+	wuo.mutation.SetAutoReceive(b)
+	return wuo
+}
+
+// SetNillableAutoReceive sets the "auto_receive" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableAutoReceive(b *bool) *WalletUpdateOne {
+	if b != nil {
+		wuo.SetAutoReceive(*b)
+	}
+	return wuo
+}
+
+// SetReceiveMinimum sets the "receive_minimum" field.
+func (wuo *WalletUpdateOne) SetReceiveMinimum(s string) *WalletUpdateOne {
+	wuo.mutation.SetReceiveMinimum(s)
+	return wuo
+}
+
+// SetNillableReceiveMinimum sets the "receive_minimum" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableReceiveMinimum(s *string) *WalletUpdateOne {
+	if s != nil {
+		wuo.SetReceiveMinimum(*s)
+	}
+	return wuo
+}
+
+// ClearReceiveMinimum clears the value of the "receive_minimum" field.
+func (wuo *WalletUpdateOne) ClearReceiveMinimum() *WalletUpdateOne {
+	wuo.mutation.ClearReceiveMinimum()
+	return wuo
+}
+
+// SetFrozenAt sets the "frozen_at" field.
+func (wuo *WalletUpdateOne) SetFrozenAt(t time.Time) *WalletUpdateOne {
+	wuo.mutation.SetFrozenAt(t)
+	return wuo
+}
+
+// SetNillableFrozenAt sets the "frozen_at" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableFrozenAt(t *time.Time) *WalletUpdateOne {
+	if t != nil {
+		wuo.SetFrozenAt(*t)
+	}
+	return wuo
+}
+
+// ClearFrozenAt clears the value of the "frozen_at" field.
+func (wuo *WalletUpdateOne) ClearFrozenAt() *WalletUpdateOne {
+	wuo.mutation.ClearFrozenAt()
+	return wuo
+}
+
+// SetKdf sets the "kdf" field.
+func (wuo *WalletUpdateOne) SetKdf(s string) *WalletUpdateOne {
+	wuo.mutation.SetKdf(s)
+	return wuo
+}
+
+// SetNillableKdf sets the "kdf" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableKdf(s *string) *WalletUpdateOne {
+	if s != nil {
+		wuo.SetKdf(*s)
+	}
+	return wuo
+}
+
+// ClearKdf clears the value of the "kdf" field.
+func (wuo *WalletUpdateOne) ClearKdf() *WalletUpdateOne {
+	wuo.mutation.ClearKdf()
+	return wuo
+}
+
+// SetApprovalsRequired sets the "approvals_required" field.
+func (wuo *WalletUpdateOne) SetApprovalsRequired(i int) *WalletUpdateOne {
+	wuo.mutation.ResetApprovalsRequired()
+	wuo.mutation.SetApprovalsRequired(i)
+	return wuo
+}
+
+// SetNillableApprovalsRequired sets the "approvals_required" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableApprovalsRequired(i *int) *WalletUpdateOne {
+	if i != nil {
+		wuo.SetApprovalsRequired(*i)
+	}
+	return wuo
+}
+
+// AddApprovalsRequired adds i to the "approvals_required" field.
+func (wuo *WalletUpdateOne) AddApprovalsRequired(i int) *WalletUpdateOne {
+	wuo.mutation.AddApprovalsRequired(i)
+	return wuo
+}
+
+// ClearApprovalsRequired clears the value of the "approvals_required" field.
+func (wuo *WalletUpdateOne) ClearApprovalsRequired() *WalletUpdateOne {
+	wuo.mutation.ClearApprovalsRequired()
+	return wuo
+}
+
+// SetApprovalThreshold sets the "approval_threshold" field.
+func (wuo *WalletUpdateOne) SetApprovalThreshold(s string) *WalletUpdateOne {
+	wuo.mutation.SetApprovalThreshold(s)
+	return wuo
+}
+
+// SetNillableApprovalThreshold sets the "approval_threshold" field if the given value is not nil.
+func (wuo *WalletUpdateOne) SetNillableApprovalThreshold(s *string) *WalletUpdateOne {
+	if s != nil {
+		wuo.SetApprovalThreshold(*s)
+	}
+	return wuo
+}
+
+// ClearApprovalThreshold clears the value of the "approval_threshold" field.
+func (wuo *WalletUpdateOne) ClearApprovalThreshold() *WalletUpdateOne {
+	wuo.mutation.ClearApprovalThreshold()
+	return wuo
+}
+
+// AddAccountIDs adds the "accounts" edge to the Account entity by IDs.
+func (wuo *WalletUpdateOne) AddAccountIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.AddAccountIDs(ids...)
+	return wuo
+}
+
+// AddAccounts adds the "accounts" edges to the Account entity.
+func (wuo *WalletUpdateOne) AddAccounts(a ...*Account) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return wuo.AddAccountIDs(ids...)
+}
+
+// AddSendScheduleIDs adds the "send_schedules" edge to the SendSchedule entity by IDs.
+func (wuo *WalletUpdateOne) AddSendScheduleIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.AddSendScheduleIDs(ids...)
+	return wuo
+}
+
+// AddSendSchedules adds the "send_schedules" edges to the SendSchedule entity.
+func (wuo *WalletUpdateOne) AddSendSchedules(s ...*SendSchedule) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wuo.AddSendScheduleIDs(ids...)
+}
+
+// AddBalanceAlertIDs adds the "balance_alerts" edge to the BalanceAlert entity by IDs.
+func (wuo *WalletUpdateOne) AddBalanceAlertIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.AddBalanceAlertIDs(ids...)
+	return wuo
+}
+
+// AddBalanceAlerts adds the "balance_alerts" edges to the BalanceAlert entity.
+func (wuo *WalletUpdateOne) AddBalanceAlerts(b ...*BalanceAlert) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wuo.AddBalanceAlertIDs(ids...)
+}
+
+// AddIdempotencyKeyIDs adds the "idempotency_keys" edge to the IdempotencyKey entity by IDs.
+func (wuo *WalletUpdateOne) AddIdempotencyKeyIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.AddIdempotencyKeyIDs(ids...)
+	return wuo
+}
+
+// AddIdempotencyKeys adds the "idempotency_keys" edges to the IdempotencyKey entity.
+func (wuo *WalletUpdateOne) AddIdempotencyKeys(i ...*IdempotencyKey) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wuo.AddIdempotencyKeyIDs(ids...)
+}
+
+// AddIdempotentSendIDs adds the "idempotent_sends" edge to the IdempotentSend entity by IDs.
+func (wuo *WalletUpdateOne) AddIdempotentSendIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.AddIdempotentSendIDs(ids...)
+	return wuo
+}
+
+// AddIdempotentSends adds the "idempotent_sends" edges to the IdempotentSend entity.
+func (wuo *WalletUpdateOne) AddIdempotentSends(i ...*IdempotentSend) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wuo.AddIdempotentSendIDs(ids...)
+}
+
+// AddWalletSnapshotIDs adds the "wallet_snapshots" edge to the WalletSnapshot entity by IDs.
+func (wuo *WalletUpdateOne) AddWalletSnapshotIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.AddWalletSnapshotIDs(ids...)
+	return wuo
+}
+
+// AddWalletSnapshots adds the "wallet_snapshots" edges to the WalletSnapshot entity.
+func (wuo *WalletUpdateOne) AddWalletSnapshots(w ...*WalletSnapshot) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddWalletSnapshotIDs(ids...)
+}
+
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (wuo *WalletUpdateOne) AddJobIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.AddJobIDs(ids...)
+	return wuo
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (wuo *WalletUpdateOne) AddJobs(j ...*Job) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return wuo.AddJobIDs(ids...)
+}
+
+// AddSpendIDs adds the "spends" edge to the WalletSpend entity by IDs.
+func (wuo *WalletUpdateOne) AddSpendIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.AddSpendIDs(ids...)
+	return wuo
+}
+
+// AddSpends adds the "spends" edges to the WalletSpend entity.
+func (wuo *WalletUpdateOne) AddSpends(w ...*WalletSpend) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddSpendIDs(ids...)
+}
+
+// AddSendApprovalIDs adds the "send_approvals" edge to the SendApproval entity by IDs.
+func (wuo *WalletUpdateOne) AddSendApprovalIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.AddSendApprovalIDs(ids...)
+	return wuo
+}
+
+// AddSendApprovals adds the "send_approvals" edges to the SendApproval entity.
+func (wuo *WalletUpdateOne) AddSendApprovals(s ...*SendApproval) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wuo.AddSendApprovalIDs(ids...)
+}
+
+// AddBlockIDs adds the "blocks" edge to the Block entity by IDs.
+func (wuo *WalletUpdateOne) AddBlockIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.AddBlockIDs(ids...)
+	return wuo
+}
+
+// AddBlocks adds the "blocks" edges to the Block entity.
+func (wuo *WalletUpdateOne) AddBlocks(b ...*Block) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wuo.AddBlockIDs(ids...)
+}
+
+// Mutation returns the WalletMutation object of the builder.
+func (wuo *WalletUpdateOne) Mutation() *WalletMutation {
+	return wuo.mutation
+}
+
+// ClearAccounts clears all "accounts" edges to the Account entity.
+func (wuo *WalletUpdateOne) ClearAccounts() *WalletUpdateOne {
+	wuo.mutation.ClearAccounts()
+	return wuo
+}
+
+// RemoveAccountIDs removes the "accounts" edge to Account entities by IDs.
+func (wuo *WalletUpdateOne) RemoveAccountIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.RemoveAccountIDs(ids...)
+	return wuo
+}
+
+// RemoveAccounts removes "accounts" edges to Account entities.
+func (wuo *WalletUpdateOne) RemoveAccounts(a ...*Account) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return wuo.RemoveAccountIDs(ids...)
+}
+
+// ClearSendSchedules clears all "send_schedules" edges to the SendSchedule entity.
+func (wuo *WalletUpdateOne) ClearSendSchedules() *WalletUpdateOne {
+	wuo.mutation.ClearSendSchedules()
+	return wuo
+}
+
+// RemoveSendScheduleIDs removes the "send_schedules" edge to SendSchedule entities by IDs.
+func (wuo *WalletUpdateOne) RemoveSendScheduleIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.RemoveSendScheduleIDs(ids...)
+	return wuo
+}
+
+// RemoveSendSchedules removes "send_schedules" edges to SendSchedule entities.
+func (wuo *WalletUpdateOne) RemoveSendSchedules(s ...*SendSchedule) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wuo.RemoveSendScheduleIDs(ids...)
+}
+
+// ClearBalanceAlerts clears all "balance_alerts" edges to the BalanceAlert entity.
+func (wuo *WalletUpdateOne) ClearBalanceAlerts() *WalletUpdateOne {
+	wuo.mutation.ClearBalanceAlerts()
+	return wuo
+}
+
+// RemoveBalanceAlertIDs removes the "balance_alerts" edge to BalanceAlert entities by IDs.
+func (wuo *WalletUpdateOne) RemoveBalanceAlertIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.RemoveBalanceAlertIDs(ids...)
+	return wuo
+}
+
+// RemoveBalanceAlerts removes "balance_alerts" edges to BalanceAlert entities.
+func (wuo *WalletUpdateOne) RemoveBalanceAlerts(b ...*BalanceAlert) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wuo.RemoveBalanceAlertIDs(ids...)
+}
+
+// ClearIdempotencyKeys clears all "idempotency_keys" edges to the IdempotencyKey entity.
+func (wuo *WalletUpdateOne) ClearIdempotencyKeys() *WalletUpdateOne {
+	wuo.mutation.ClearIdempotencyKeys()
+	return wuo
+}
+
+// RemoveIdempotencyKeyIDs removes the "idempotency_keys" edge to IdempotencyKey entities by IDs.
+func (wuo *WalletUpdateOne) RemoveIdempotencyKeyIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.RemoveIdempotencyKeyIDs(ids...)
+	return wuo
+}
+
+// RemoveIdempotencyKeys removes "idempotency_keys" edges to IdempotencyKey entities.
+func (wuo *WalletUpdateOne) RemoveIdempotencyKeys(i ...*IdempotencyKey) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wuo.RemoveIdempotencyKeyIDs(ids...)
+}
+
+// ClearIdempotentSends clears all "idempotent_sends" edges to the IdempotentSend entity.
+func (wuo *WalletUpdateOne) ClearIdempotentSends() *WalletUpdateOne {
+	wuo.mutation.ClearIdempotentSends()
+	return wuo
+}
+
+// RemoveIdempotentSendIDs removes the "idempotent_sends" edge to IdempotentSend entities by IDs.
+func (wuo *WalletUpdateOne) RemoveIdempotentSendIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.RemoveIdempotentSendIDs(ids...)
+	return wuo
+}
+
+// RemoveIdempotentSends removes "idempotent_sends" edges to IdempotentSend entities.
+func (wuo *WalletUpdateOne) RemoveIdempotentSends(i ...*IdempotentSend) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return wuo.RemoveIdempotentSendIDs(ids...)
+}
+
+// ClearWalletSnapshots clears all "wallet_snapshots" edges to the WalletSnapshot entity.
+func (wuo *WalletUpdateOne) ClearWalletSnapshots() *WalletUpdateOne {
+	wuo.mutation.ClearWalletSnapshots()
+	return wuo
+}
+
+// RemoveWalletSnapshotIDs removes the "wallet_snapshots" edge to WalletSnapshot entities by IDs.
+func (wuo *WalletUpdateOne) RemoveWalletSnapshotIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.RemoveWalletSnapshotIDs(ids...)
+	return wuo
+}
+
+// RemoveWalletSnapshots removes "wallet_snapshots" edges to WalletSnapshot entities.
+func (wuo *WalletUpdateOne) RemoveWalletSnapshots(w ...*WalletSnapshot) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveWalletSnapshotIDs(ids...)
+}
+
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (wuo *WalletUpdateOne) ClearJobs() *WalletUpdateOne {
+	wuo.mutation.ClearJobs()
+	return wuo
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (wuo *WalletUpdateOne) RemoveJobIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.RemoveJobIDs(ids...)
+	return wuo
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (wuo *WalletUpdateOne) RemoveJobs(j ...*Job) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return wuo.RemoveJobIDs(ids...)
+}
+
+// ClearSpends clears all "spends" edges to the WalletSpend entity.
+func (wuo *WalletUpdateOne) ClearSpends() *WalletUpdateOne {
+	wuo.mutation.ClearSpends()
+	return wuo
+}
+
+// RemoveSpendIDs removes the "spends" edge to WalletSpend entities by IDs.
+func (wuo *WalletUpdateOne) RemoveSpendIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.RemoveSpendIDs(ids...)
+	return wuo
+}
+
+// RemoveSpends removes "spends" edges to WalletSpend entities.
+func (wuo *WalletUpdateOne) RemoveSpends(w ...*WalletSpend) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveSpendIDs(ids...)
+}
+
+// ClearSendApprovals clears all "send_approvals" edges to the SendApproval entity.
+func (wuo *WalletUpdateOne) ClearSendApprovals() *WalletUpdateOne {
+	wuo.mutation.ClearSendApprovals()
+	return wuo
+}
+
+// RemoveSendApprovalIDs removes the "send_approvals" edge to SendApproval entities by IDs.
+func (wuo *WalletUpdateOne) RemoveSendApprovalIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.RemoveSendApprovalIDs(ids...)
+	return wuo
+}
+
+// RemoveSendApprovals removes "send_approvals" edges to SendApproval entities.
+func (wuo *WalletUpdateOne) RemoveSendApprovals(s ...*SendApproval) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return wuo.RemoveSendApprovalIDs(ids...)
+}
+
+// ClearBlocks clears all "blocks" edges to the Block entity.
+func (wuo *WalletUpdateOne) ClearBlocks() *WalletUpdateOne {
+	wuo.mutation.ClearBlocks()
+	return wuo
+}
+
+// RemoveBlockIDs removes the "blocks" edge to Block entities by IDs.
+func (wuo *WalletUpdateOne) RemoveBlockIDs(ids ...uuid.UUID) *WalletUpdateOne {
+	wuo.mutation.RemoveBlockIDs(ids...)
+	return wuo
+}
+
+// RemoveBlocks removes "blocks" edges to Block entities.
+func (wuo *WalletUpdateOne) RemoveBlocks(b ...*Block) *WalletUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wuo.RemoveBlockIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
 func (wuo *WalletUpdateOne) Select(field string, fields ...string) *WalletUpdateOne {
 	wuo.fields = append([]string{field}, fields...)
 	return wuo
@@ -451,158 +2064,775 @@ func (wuo *WalletUpdateOne) Save(ctx context.Context) (*Wallet, error) {
 			mutation.done = true
 			return node, err
 		})
-		for i := len(wuo.hooks) - 1; i >= 0; i-- {
-			if wuo.hooks[i] == nil {
-				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
-			}
-			mut = wuo.hooks[i](mut)
+		for i := len(wuo.hooks) - 1; i >= 0; i-- {
+			if wuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*Wallet)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WalletMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WalletUpdateOne) SaveX(ctx context.Context) *Wallet {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WalletUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WalletUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuo *WalletUpdateOne) check() error {
+	if v, ok := wuo.mutation.Seed(); ok {
+		if err := wallet.SeedValidator(v); err != nil {
+			return &ValidationError{Name: "seed", err: fmt.Errorf(`ent: validator failed for field "Wallet.seed": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.Representative(); ok {
+		if err := wallet.RepresentativeValidator(v); err != nil {
+			return &ValidationError{Name: "representative", err: fmt.Errorf(`ent: validator failed for field "Wallet.representative": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.Name(); ok {
+		if err := wallet.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Wallet.name": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.ReceiveMinimum(); ok {
+		if err := wallet.ReceiveMinimumValidator(v); err != nil {
+			return &ValidationError{Name: "receive_minimum", err: fmt.Errorf(`ent: validator failed for field "Wallet.receive_minimum": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.Kdf(); ok {
+		if err := wallet.KdfValidator(v); err != nil {
+			return &ValidationError{Name: "kdf", err: fmt.Errorf(`ent: validator failed for field "Wallet.kdf": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.ApprovalsRequired(); ok {
+		if err := wallet.ApprovalsRequiredValidator(v); err != nil {
+			return &ValidationError{Name: "approvals_required", err: fmt.Errorf(`ent: validator failed for field "Wallet.approvals_required": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.ApprovalThreshold(); ok {
+		if err := wallet.ApprovalThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "approval_threshold", err: fmt.Errorf(`ent: validator failed for field "Wallet.approval_threshold": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wuo *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   wallet.Table,
+			Columns: wallet.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: wallet.FieldID,
+			},
+		},
+	}
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Wallet.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wallet.FieldID)
+		for _, f := range fields {
+			if !wallet.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wallet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.Seed(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldSeed,
+		})
+	}
+	if value, ok := wuo.mutation.Representative(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldRepresentative,
+		})
+	}
+	if wuo.mutation.RepresentativeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: wallet.FieldRepresentative,
+		})
+	}
+	if value, ok := wuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldName,
+		})
+	}
+	if wuo.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: wallet.FieldName,
+		})
+	}
+	if value, ok := wuo.mutation.Encrypted(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: wallet.FieldEncrypted,
+		})
+	}
+	if value, ok := wuo.mutation.Work(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: wallet.FieldWork,
+		})
+	}
+	if value, ok := wuo.mutation.WatchOnly(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: wallet.FieldWatchOnly,
+		})
+	}
+	if value, ok := wuo.mutation.Hardware(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: wallet.FieldHardware,
+		})
+	}
+	if value, ok := wuo.mutation.AutoReceive(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: wallet.FieldAutoReceive,
+		})
+	}
+	if value, ok := wuo.mutation.ReceiveMinimum(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldReceiveMinimum,
+		})
+	}
+	if wuo.mutation.ReceiveMinimumCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: wallet.FieldReceiveMinimum,
+		})
+	}
+	if value, ok := wuo.mutation.FrozenAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: wallet.FieldFrozenAt,
+		})
+	}
+	if wuo.mutation.FrozenAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: wallet.FieldFrozenAt,
+		})
+	}
+	if value, ok := wuo.mutation.Kdf(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldKdf,
+		})
+	}
+	if wuo.mutation.KdfCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: wallet.FieldKdf,
+		})
+	}
+	if value, ok := wuo.mutation.ApprovalsRequired(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: wallet.FieldApprovalsRequired,
+		})
+	}
+	if value, ok := wuo.mutation.AddedApprovalsRequired(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: wallet.FieldApprovalsRequired,
+		})
+	}
+	if wuo.mutation.ApprovalsRequiredCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: wallet.FieldApprovalsRequired,
+		})
+	}
+	if value, ok := wuo.mutation.ApprovalThreshold(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: wallet.FieldApprovalThreshold,
+		})
+	}
+	if wuo.mutation.ApprovalThresholdCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: wallet.FieldApprovalThreshold,
+		})
+	}
+	if wuo.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.AccountsTable,
+			Columns: []string{wallet.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedAccountsIDs(); len(nodes) > 0 && !wuo.mutation.AccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.AccountsTable,
+			Columns: []string{wallet.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.AccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.AccountsTable,
+			Columns: []string{wallet.AccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: account.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wuo.mutation.SendSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendSchedulesTable,
+			Columns: []string{wallet.SendSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendschedule.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedSendSchedulesIDs(); len(nodes) > 0 && !wuo.mutation.SendSchedulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendSchedulesTable,
+			Columns: []string{wallet.SendSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendschedule.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.SendSchedulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendSchedulesTable,
+			Columns: []string{wallet.SendSchedulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendschedule.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wuo.mutation.BalanceAlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.BalanceAlertsTable,
+			Columns: []string{wallet.BalanceAlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancealert.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedBalanceAlertsIDs(); len(nodes) > 0 && !wuo.mutation.BalanceAlertsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.BalanceAlertsTable,
+			Columns: []string{wallet.BalanceAlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancealert.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.BalanceAlertsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.BalanceAlertsTable,
+			Columns: []string{wallet.BalanceAlertsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancealert.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wuo.mutation.IdempotencyKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotencyKeysTable,
+			Columns: []string{wallet.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotencykey.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedIdempotencyKeysIDs(); len(nodes) > 0 && !wuo.mutation.IdempotencyKeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotencyKeysTable,
+			Columns: []string{wallet.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotencykey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.IdempotencyKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotencyKeysTable,
+			Columns: []string{wallet.IdempotencyKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotencykey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wuo.mutation.IdempotentSendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotentSendsTable,
+			Columns: []string{wallet.IdempotentSendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotentsend.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedIdempotentSendsIDs(); len(nodes) > 0 && !wuo.mutation.IdempotentSendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotentSendsTable,
+			Columns: []string{wallet.IdempotentSendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotentsend.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.IdempotentSendsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.IdempotentSendsTable,
+			Columns: []string{wallet.IdempotentSendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: idempotentsend.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wuo.mutation.WalletSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.WalletSnapshotsTable,
+			Columns: []string{wallet.WalletSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletsnapshot.FieldID,
+				},
+			},
 		}
-		v, err := mut.Mutate(ctx, wuo.mutation)
-		if err != nil {
-			return nil, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedWalletSnapshotsIDs(); len(nodes) > 0 && !wuo.mutation.WalletSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.WalletSnapshotsTable,
+			Columns: []string{wallet.WalletSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletsnapshot.FieldID,
+				},
+			},
 		}
-		nv, ok := v.(*Wallet)
-		if !ok {
-			return nil, fmt.Errorf("unexpected node type %T returned from WalletMutation", v)
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		node = nv
-	}
-	return node, err
-}
-
-// SaveX is like Save, but panics if an error occurs.
-func (wuo *WalletUpdateOne) SaveX(ctx context.Context) *Wallet {
-	node, err := wuo.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return node
-}
-
-// Exec executes the query on the entity.
-func (wuo *WalletUpdateOne) Exec(ctx context.Context) error {
-	_, err := wuo.Save(ctx)
-	return err
-}
-
-// ExecX is like Exec, but panics if an error occurs.
-func (wuo *WalletUpdateOne) ExecX(ctx context.Context) {
-	if err := wuo.Exec(ctx); err != nil {
-		panic(err)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-}
-
-// check runs all checks and user-defined validators on the builder.
-func (wuo *WalletUpdateOne) check() error {
-	if v, ok := wuo.mutation.Seed(); ok {
-		if err := wallet.SeedValidator(v); err != nil {
-			return &ValidationError{Name: "seed", err: fmt.Errorf(`ent: validator failed for field "Wallet.seed": %w`, err)}
+	if nodes := wuo.mutation.WalletSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.WalletSnapshotsTable,
+			Columns: []string{wallet.WalletSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletsnapshot.FieldID,
+				},
+			},
 		}
-	}
-	if v, ok := wuo.mutation.Representative(); ok {
-		if err := wallet.RepresentativeValidator(v); err != nil {
-			return &ValidationError{Name: "representative", err: fmt.Errorf(`ent: validator failed for field "Wallet.representative": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return nil
-}
-
-func (wuo *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err error) {
-	_spec := &sqlgraph.UpdateSpec{
-		Node: &sqlgraph.NodeSpec{
-			Table:   wallet.Table,
-			Columns: wallet.Columns,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeUUID,
-				Column: wallet.FieldID,
+	if wuo.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.JobsTable,
+			Columns: []string{wallet.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: job.FieldID,
+				},
 			},
-		},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	id, ok := wuo.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Wallet.id" for update`)}
+	if nodes := wuo.mutation.RemovedJobsIDs(); len(nodes) > 0 && !wuo.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.JobsTable,
+			Columns: []string{wallet.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: job.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := wuo.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, wallet.FieldID)
-		for _, f := range fields {
-			if !wallet.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != wallet.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := wuo.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.JobsTable,
+			Columns: []string{wallet.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: job.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ps := wuo.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if wuo.mutation.SpendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SpendsTable,
+			Columns: []string{wallet.SpendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletspend.FieldID,
+				},
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := wuo.mutation.Seed(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: wallet.FieldSeed,
-		})
+	if nodes := wuo.mutation.RemovedSpendsIDs(); len(nodes) > 0 && !wuo.mutation.SpendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SpendsTable,
+			Columns: []string{wallet.SpendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletspend.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := wuo.mutation.Representative(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Value:  value,
-			Column: wallet.FieldRepresentative,
-		})
+	if nodes := wuo.mutation.SpendsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SpendsTable,
+			Columns: []string{wallet.SpendsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: walletspend.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if wuo.mutation.RepresentativeCleared() {
-		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
-			Type:   field.TypeString,
-			Column: wallet.FieldRepresentative,
-		})
+	if wuo.mutation.SendApprovalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendApprovalsTable,
+			Columns: []string{wallet.SendApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendapproval.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := wuo.mutation.Encrypted(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeBool,
-			Value:  value,
-			Column: wallet.FieldEncrypted,
-		})
+	if nodes := wuo.mutation.RemovedSendApprovalsIDs(); len(nodes) > 0 && !wuo.mutation.SendApprovalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendApprovalsTable,
+			Columns: []string{wallet.SendApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendapproval.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := wuo.mutation.Work(); ok {
-		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeBool,
-			Value:  value,
-			Column: wallet.FieldWork,
-		})
+	if nodes := wuo.mutation.SendApprovalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wallet.SendApprovalsTable,
+			Columns: []string{wallet.SendApprovalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: sendapproval.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if wuo.mutation.AccountsCleared() {
+	if wuo.mutation.BlocksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   wallet.AccountsTable,
-			Columns: []string{wallet.AccountsColumn},
+			Table:   wallet.BlocksTable,
+			Columns: []string{wallet.BlocksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: account.FieldID,
+					Column: block.FieldID,
 				},
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := wuo.mutation.RemovedAccountsIDs(); len(nodes) > 0 && !wuo.mutation.AccountsCleared() {
+	if nodes := wuo.mutation.RemovedBlocksIDs(); len(nodes) > 0 && !wuo.mutation.BlocksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   wallet.AccountsTable,
-			Columns: []string{wallet.AccountsColumn},
+			Table:   wallet.BlocksTable,
+			Columns: []string{wallet.BlocksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: account.FieldID,
+					Column: block.FieldID,
 				},
 			},
 		}
@@ -611,17 +2841,17 @@ func (wuo *WalletUpdateOne) sqlSave(ctx context.Context) (_node *Wallet, err err
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := wuo.mutation.AccountsIDs(); len(nodes) > 0 {
+	if nodes := wuo.mutation.BlocksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   wallet.AccountsTable,
-			Columns: []string{wallet.AccountsColumn},
+			Table:   wallet.BlocksTable,
+			Columns: []string{wallet.BlocksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: &sqlgraph.FieldSpec{
 					Type:   field.TypeUUID,
-					Column: account.FieldID,
+					Column: block.FieldID,
 				},
 			},
 		}
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/google/uuid"
+)
+
+// WalletSnapshot is the model entity for the WalletSnapshot schema.
+type WalletSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WalletID holds the value of the "wallet_id" field.
+	WalletID uuid.UUID `json:"wallet_id,omitempty"`
+	// Label holds the value of the "label" field.
+	Label *string `json:"label,omitempty"`
+	// TotalRaw holds the value of the "total_raw" field.
+	TotalRaw string `json:"total_raw,omitempty"`
+	// AccountCount holds the value of the "account_count" field.
+	AccountCount int `json:"account_count,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WalletSnapshotQuery when eager-loading is set.
+	Edges WalletSnapshotEdges `json:"edges"`
+}
+
+// WalletSnapshotEdges holds the relations/edges for other nodes in the graph.
+type WalletSnapshotEdges struct {
+	// Wallet holds the value of the wallet edge.
+	Wallet *Wallet `json:"wallet,omitempty"`
+	// Balances holds the value of the balances edge.
+	Balances []*BalanceSnapshot `json:"balances,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// WalletOrErr returns the Wallet value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WalletSnapshotEdges) WalletOrErr() (*Wallet, error) {
+	if e.loadedTypes[0] {
+		if e.Wallet == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: wallet.Label}
+		}
+		return e.Wallet, nil
+	}
+	return nil, &NotLoadedError{edge: "wallet"}
+}
+
+// BalancesOrErr returns the Balances value or an error if the edge
+// was not loaded in eager-loading.
+func (e WalletSnapshotEdges) BalancesOrErr() ([]*BalanceSnapshot, error) {
+	if e.loadedTypes[1] {
+		return e.Balances, nil
+	}
+	return nil, &NotLoadedError{edge: "balances"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WalletSnapshot) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case walletsnapshot.FieldAccountCount:
+			values[i] = new(sql.NullInt64)
+		case walletsnapshot.FieldLabel, walletsnapshot.FieldTotalRaw:
+			values[i] = new(sql.NullString)
+		case walletsnapshot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case walletsnapshot.FieldID, walletsnapshot.FieldWalletID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WalletSnapshot", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WalletSnapshot fields.
+func (ws *WalletSnapshot) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case walletsnapshot.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ws.ID = *value
+			}
+		case walletsnapshot.FieldWalletID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_id", values[i])
+			} else if value != nil {
+				ws.WalletID = *value
+			}
+		case walletsnapshot.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				ws.Label = new(string)
+				*ws.Label = value.String
+			}
+		case walletsnapshot.FieldTotalRaw:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field total_raw", values[i])
+			} else if value.Valid {
+				ws.TotalRaw = value.String
+			}
+		case walletsnapshot.FieldAccountCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field account_count", values[i])
+			} else if value.Valid {
+				ws.AccountCount = int(value.Int64)
+			}
+		case walletsnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ws.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryWallet queries the "wallet" edge of the WalletSnapshot entity.
+func (ws *WalletSnapshot) QueryWallet() *WalletQuery {
+	return (&WalletSnapshotClient{config: ws.config}).QueryWallet(ws)
+}
+
+// QueryBalances queries the "balances" edge of the WalletSnapshot entity.
+func (ws *WalletSnapshot) QueryBalances() *BalanceSnapshotQuery {
+	return (&WalletSnapshotClient{config: ws.config}).QueryBalances(ws)
+}
+
+// Update returns a builder for updating this WalletSnapshot.
+// Note that you need to call WalletSnapshot.Unwrap() before calling this method if this WalletSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ws *WalletSnapshot) Update() *WalletSnapshotUpdateOne {
+	return (&WalletSnapshotClient{config: ws.config}).UpdateOne(ws)
+}
+
+// Unwrap unwraps the WalletSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ws *WalletSnapshot) Unwrap() *WalletSnapshot {
+	_tx, ok := ws.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WalletSnapshot is not a transactional entity")
+	}
+	ws.config.driver = _tx.drv
+	return ws
+}
+
+// String implements the fmt.Stringer.
+func (ws *WalletSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("WalletSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ws.ID))
+	builder.WriteString("wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", ws.WalletID))
+	builder.WriteString(", ")
+	if v := ws.Label; v != nil {
+		builder.WriteString("label=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("total_raw=")
+	builder.WriteString(ws.TotalRaw)
+	builder.WriteString(", ")
+	builder.WriteString("account_count=")
+	builder.WriteString(fmt.Sprintf("%v", ws.AccountCount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ws.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WalletSnapshots is a parsable slice of WalletSnapshot.
+type WalletSnapshots []*WalletSnapshot
+
+func (ws WalletSnapshots) config(cfg config) {
+	for _i := range ws {
+		ws[_i].config = cfg
+	}
+}
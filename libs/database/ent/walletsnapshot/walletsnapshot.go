@@ -0,0 +1,77 @@
+// Code generated by ent, DO NOT EDIT.
+
+package walletsnapshot
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the walletsnapshot type in the database.
+	Label = "wallet_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWalletID holds the string denoting the wallet_id field in the database.
+	FieldWalletID = "wallet_id"
+	// FieldLabel holds the string denoting the label field in the database.
+	FieldLabel = "label"
+	// FieldTotalRaw holds the string denoting the total_raw field in the database.
+	FieldTotalRaw = "total_raw"
+	// FieldAccountCount holds the string denoting the account_count field in the database.
+	FieldAccountCount = "account_count"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeWallet holds the string denoting the wallet edge name in mutations.
+	EdgeWallet = "wallet"
+	// EdgeBalances holds the string denoting the balances edge name in mutations.
+	EdgeBalances = "balances"
+	// Table holds the table name of the walletsnapshot in the database.
+	Table = "wallet_snapshots"
+	// WalletTable is the table that holds the wallet relation/edge.
+	WalletTable = "wallet_snapshots"
+	// WalletInverseTable is the table name for the Wallet entity.
+	// It exists in this package in order to avoid circular dependency with the "wallet" package.
+	WalletInverseTable = "wallets"
+	// WalletColumn is the table column denoting the wallet relation/edge.
+	WalletColumn = "wallet_id"
+	// BalancesTable is the table that holds the balances relation/edge.
+	BalancesTable = "balance_snapshots"
+	// BalancesInverseTable is the table name for the BalanceSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "balancesnapshot" package.
+	BalancesInverseTable = "balance_snapshots"
+	// BalancesColumn is the table column denoting the balances relation/edge.
+	BalancesColumn = "wallet_snapshot_id"
+)
+
+// Columns holds all SQL columns for walletsnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldWalletID,
+	FieldLabel,
+	FieldTotalRaw,
+	FieldAccountCount,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	LabelValidator func(string) error
+	// TotalRawValidator is a validator for the "total_raw" field. It is called by the builders before save.
+	TotalRawValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
@@ -0,0 +1,575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package walletsnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// WalletID applies equality check predicate on the "wallet_id" field. It's identical to WalletIDEQ.
+func WalletID(v uuid.UUID) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// TotalRaw applies equality check predicate on the "total_raw" field. It's identical to TotalRawEQ.
+func TotalRaw(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotalRaw), v))
+	})
+}
+
+// AccountCount applies equality check predicate on the "account_count" field. It's identical to AccountCountEQ.
+func AccountCount(v int) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAccountCount), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// WalletIDEQ applies the EQ predicate on the "wallet_id" field.
+func WalletIDEQ(v uuid.UUID) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDNEQ applies the NEQ predicate on the "wallet_id" field.
+func WalletIDNEQ(v uuid.UUID) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDIn applies the In predicate on the "wallet_id" field.
+func WalletIDIn(vs ...uuid.UUID) predicate.WalletSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldWalletID), v...))
+	})
+}
+
+// WalletIDNotIn applies the NotIn predicate on the "wallet_id" field.
+func WalletIDNotIn(vs ...uuid.UUID) predicate.WalletSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldWalletID), v...))
+	})
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLabel), v))
+	})
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLabel), v))
+	})
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.WalletSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldLabel), v...))
+	})
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.WalletSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldLabel), v...))
+	})
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldLabel), v))
+	})
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldLabel), v))
+	})
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldLabel), v))
+	})
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldLabel), v))
+	})
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldLabel), v))
+	})
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldLabel), v))
+	})
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldLabel), v))
+	})
+}
+
+// LabelIsNil applies the IsNil predicate on the "label" field.
+func LabelIsNil() predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLabel)))
+	})
+}
+
+// LabelNotNil applies the NotNil predicate on the "label" field.
+func LabelNotNil() predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLabel)))
+	})
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldLabel), v))
+	})
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldLabel), v))
+	})
+}
+
+// TotalRawEQ applies the EQ predicate on the "total_raw" field.
+func TotalRawEQ(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTotalRaw), v))
+	})
+}
+
+// TotalRawNEQ applies the NEQ predicate on the "total_raw" field.
+func TotalRawNEQ(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTotalRaw), v))
+	})
+}
+
+// TotalRawIn applies the In predicate on the "total_raw" field.
+func TotalRawIn(vs ...string) predicate.WalletSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldTotalRaw), v...))
+	})
+}
+
+// TotalRawNotIn applies the NotIn predicate on the "total_raw" field.
+func TotalRawNotIn(vs ...string) predicate.WalletSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldTotalRaw), v...))
+	})
+}
+
+// TotalRawGT applies the GT predicate on the "total_raw" field.
+func TotalRawGT(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTotalRaw), v))
+	})
+}
+
+// TotalRawGTE applies the GTE predicate on the "total_raw" field.
+func TotalRawGTE(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTotalRaw), v))
+	})
+}
+
+// TotalRawLT applies the LT predicate on the "total_raw" field.
+func TotalRawLT(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTotalRaw), v))
+	})
+}
+
+// TotalRawLTE applies the LTE predicate on the "total_raw" field.
+func TotalRawLTE(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTotalRaw), v))
+	})
+}
+
+// TotalRawContains applies the Contains predicate on the "total_raw" field.
+func TotalRawContains(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldTotalRaw), v))
+	})
+}
+
+// TotalRawHasPrefix applies the HasPrefix predicate on the "total_raw" field.
+func TotalRawHasPrefix(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldTotalRaw), v))
+	})
+}
+
+// TotalRawHasSuffix applies the HasSuffix predicate on the "total_raw" field.
+func TotalRawHasSuffix(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldTotalRaw), v))
+	})
+}
+
+// TotalRawEqualFold applies the EqualFold predicate on the "total_raw" field.
+func TotalRawEqualFold(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldTotalRaw), v))
+	})
+}
+
+// TotalRawContainsFold applies the ContainsFold predicate on the "total_raw" field.
+func TotalRawContainsFold(v string) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldTotalRaw), v))
+	})
+}
+
+// AccountCountEQ applies the EQ predicate on the "account_count" field.
+func AccountCountEQ(v int) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAccountCount), v))
+	})
+}
+
+// AccountCountNEQ applies the NEQ predicate on the "account_count" field.
+func AccountCountNEQ(v int) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAccountCount), v))
+	})
+}
+
+// AccountCountIn applies the In predicate on the "account_count" field.
+func AccountCountIn(vs ...int) predicate.WalletSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAccountCount), v...))
+	})
+}
+
+// AccountCountNotIn applies the NotIn predicate on the "account_count" field.
+func AccountCountNotIn(vs ...int) predicate.WalletSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAccountCount), v...))
+	})
+}
+
+// AccountCountGT applies the GT predicate on the "account_count" field.
+func AccountCountGT(v int) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAccountCount), v))
+	})
+}
+
+// AccountCountGTE applies the GTE predicate on the "account_count" field.
+func AccountCountGTE(v int) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAccountCount), v))
+	})
+}
+
+// AccountCountLT applies the LT predicate on the "account_count" field.
+func AccountCountLT(v int) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAccountCount), v))
+	})
+}
+
+// AccountCountLTE applies the LTE predicate on the "account_count" field.
+func AccountCountLTE(v int) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAccountCount), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WalletSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WalletSnapshot {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasWallet applies the HasEdge predicate on the "wallet" edge.
+func HasWallet() predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWalletWith applies the HasEdge predicate on the "wallet" edge with a given conditions (other predicates).
+func HasWalletWith(preds ...predicate.Wallet) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBalances applies the HasEdge predicate on the "balances" edge.
+func HasBalances() predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BalancesTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BalancesTable, BalancesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBalancesWith applies the HasEdge predicate on the "balances" edge with a given conditions (other predicates).
+func HasBalancesWith(preds ...predicate.BalanceSnapshot) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(BalancesInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BalancesTable, BalancesColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WalletSnapshot) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WalletSnapshot) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WalletSnapshot) predicate.WalletSnapshot {
+	return predicate.WalletSnapshot(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
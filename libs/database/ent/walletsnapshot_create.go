@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/google/uuid"
+)
+
+// WalletSnapshotCreate is the builder for creating a WalletSnapshot entity.
+type WalletSnapshotCreate struct {
+	config
+	mutation *WalletSnapshotMutation
+	hooks    []Hook
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (wsc *WalletSnapshotCreate) SetWalletID(u uuid.UUID) *WalletSnapshotCreate {
+	wsc.mutation.SetWalletID(u)
+	return wsc
+}
+
+// SetLabel sets the "label" field.
+func (wsc *WalletSnapshotCreate) SetLabel(s string) *WalletSnapshotCreate {
+	wsc.mutation.SetLabel(s)
+	return wsc
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (wsc *WalletSnapshotCreate) SetNillableLabel(s *string) *WalletSnapshotCreate {
+	if s != nil {
+		wsc.SetLabel(*s)
+	}
+	return wsc
+}
+
+// SetTotalRaw sets the "total_raw" field.
+func (wsc *WalletSnapshotCreate) SetTotalRaw(s string) *WalletSnapshotCreate {
+	wsc.mutation.SetTotalRaw(s)
+	return wsc
+}
+
+// SetAccountCount sets the "account_count" field.
+func (wsc *WalletSnapshotCreate) SetAccountCount(i int) *WalletSnapshotCreate {
+	wsc.mutation.SetAccountCount(i)
+	return wsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wsc *WalletSnapshotCreate) SetCreatedAt(t time.Time) *WalletSnapshotCreate {
+	wsc.mutation.SetCreatedAt(t)
+	return wsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wsc *WalletSnapshotCreate) SetNillableCreatedAt(t *time.Time) *WalletSnapshotCreate {
+	if t != nil {
+		wsc.SetCreatedAt(*t)
+	}
+	return wsc
+}
+
+// SetID sets the "id" field.
+func (wsc *WalletSnapshotCreate) SetID(u uuid.UUID) *WalletSnapshotCreate {
+	wsc.mutation.SetID(u)
+	return wsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wsc *WalletSnapshotCreate) SetNillableID(u *uuid.UUID) *WalletSnapshotCreate {
+	if u != nil {
+		wsc.SetID(*u)
+	}
+	return wsc
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (wsc *WalletSnapshotCreate) SetWallet(w *Wallet) *WalletSnapshotCreate {
+	return wsc.SetWalletID(w.ID)
+}
+
+// AddBalanceIDs adds the "balances" edge to the BalanceSnapshot entity by IDs.
+func (wsc *WalletSnapshotCreate) AddBalanceIDs(ids ...uuid.UUID) *WalletSnapshotCreate {
+	wsc.mutation.AddBalanceIDs(ids...)
+	return wsc
+}
+
+// AddBalances adds the "balances" edges to the BalanceSnapshot entity.
+func (wsc *WalletSnapshotCreate) AddBalances(b ...*BalanceSnapshot) *WalletSnapshotCreate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wsc.AddBalanceIDs(ids...)
+}
+
+// Mutation returns the WalletSnapshotMutation object of the builder.
+func (wsc *WalletSnapshotCreate) Mutation() *WalletSnapshotMutation {
+	return wsc.mutation
+}
+
+// Save creates the WalletSnapshot in the database.
+func (wsc *WalletSnapshotCreate) Save(ctx context.Context) (*WalletSnapshot, error) {
+	var (
+		err  error
+		node *WalletSnapshot
+	)
+	wsc.defaults()
+	if len(wsc.hooks) == 0 {
+		if err = wsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WalletSnapshotMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wsc.check(); err != nil {
+				return nil, err
+			}
+			wsc.mutation = mutation
+			if node, err = wsc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wsc.hooks) - 1; i >= 0; i-- {
+			if wsc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wsc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WalletSnapshot)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WalletSnapshotMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wsc *WalletSnapshotCreate) SaveX(ctx context.Context) *WalletSnapshot {
+	v, err := wsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wsc *WalletSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := wsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsc *WalletSnapshotCreate) ExecX(ctx context.Context) {
+	if err := wsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsc *WalletSnapshotCreate) defaults() {
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		v := walletsnapshot.DefaultCreatedAt()
+		wsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wsc.mutation.ID(); !ok {
+		v := walletsnapshot.DefaultID()
+		wsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsc *WalletSnapshotCreate) check() error {
+	if _, ok := wsc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "WalletSnapshot.wallet_id"`)}
+	}
+	if v, ok := wsc.mutation.Label(); ok {
+		if err := walletsnapshot.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "WalletSnapshot.label": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.TotalRaw(); !ok {
+		return &ValidationError{Name: "total_raw", err: errors.New(`ent: missing required field "WalletSnapshot.total_raw"`)}
+	}
+	if v, ok := wsc.mutation.TotalRaw(); ok {
+		if err := walletsnapshot.TotalRawValidator(v); err != nil {
+			return &ValidationError{Name: "total_raw", err: fmt.Errorf(`ent: validator failed for field "WalletSnapshot.total_raw": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.AccountCount(); !ok {
+		return &ValidationError{Name: "account_count", err: errors.New(`ent: missing required field "WalletSnapshot.account_count"`)}
+	}
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WalletSnapshot.created_at"`)}
+	}
+	if _, ok := wsc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet", err: errors.New(`ent: missing required edge "WalletSnapshot.wallet"`)}
+	}
+	return nil
+}
+
+func (wsc *WalletSnapshotCreate) sqlSave(ctx context.Context) (*WalletSnapshot, error) {
+	_node, _spec := wsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (wsc *WalletSnapshotCreate) createSpec() (*WalletSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WalletSnapshot{config: wsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: walletsnapshot.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: walletsnapshot.FieldID,
+			},
+		}
+	)
+	if id, ok := wsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wsc.mutation.Label(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: walletsnapshot.FieldLabel,
+		})
+		_node.Label = &value
+	}
+	if value, ok := wsc.mutation.TotalRaw(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: walletsnapshot.FieldTotalRaw,
+		})
+		_node.TotalRaw = value
+	}
+	if value, ok := wsc.mutation.AccountCount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: walletsnapshot.FieldAccountCount,
+		})
+		_node.AccountCount = value
+	}
+	if value, ok := wsc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: walletsnapshot.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := wsc.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   walletsnapshot.WalletTable,
+			Columns: []string{walletsnapshot.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WalletID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wsc.mutation.BalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   walletsnapshot.BalancesTable,
+			Columns: []string{walletsnapshot.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WalletSnapshotCreateBulk is the builder for creating many WalletSnapshot entities in bulk.
+type WalletSnapshotCreateBulk struct {
+	config
+	builders []*WalletSnapshotCreate
+}
+
+// Save creates the WalletSnapshot entities in the database.
+func (wscb *WalletSnapshotCreateBulk) Save(ctx context.Context) ([]*WalletSnapshot, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wscb.builders))
+	nodes := make([]*WalletSnapshot, len(wscb.builders))
+	mutators := make([]Mutator, len(wscb.builders))
+	for i := range wscb.builders {
+		func(i int, root context.Context) {
+			builder := wscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WalletSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wscb *WalletSnapshotCreateBulk) SaveX(ctx context.Context) []*WalletSnapshot {
+	v, err := wscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wscb *WalletSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := wscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wscb *WalletSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := wscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
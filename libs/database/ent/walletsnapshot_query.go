@@ -0,0 +1,675 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/google/uuid"
+)
+
+// WalletSnapshotQuery is the builder for querying WalletSnapshot entities.
+type WalletSnapshotQuery struct {
+	config
+	limit        *int
+	offset       *int
+	unique       *bool
+	order        []OrderFunc
+	fields       []string
+	predicates   []predicate.WalletSnapshot
+	withWallet   *WalletQuery
+	withBalances *BalanceSnapshotQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WalletSnapshotQuery builder.
+func (wsq *WalletSnapshotQuery) Where(ps ...predicate.WalletSnapshot) *WalletSnapshotQuery {
+	wsq.predicates = append(wsq.predicates, ps...)
+	return wsq
+}
+
+// Limit adds a limit step to the query.
+func (wsq *WalletSnapshotQuery) Limit(limit int) *WalletSnapshotQuery {
+	wsq.limit = &limit
+	return wsq
+}
+
+// Offset adds an offset step to the query.
+func (wsq *WalletSnapshotQuery) Offset(offset int) *WalletSnapshotQuery {
+	wsq.offset = &offset
+	return wsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wsq *WalletSnapshotQuery) Unique(unique bool) *WalletSnapshotQuery {
+	wsq.unique = &unique
+	return wsq
+}
+
+// Order adds an order step to the query.
+func (wsq *WalletSnapshotQuery) Order(o ...OrderFunc) *WalletSnapshotQuery {
+	wsq.order = append(wsq.order, o...)
+	return wsq
+}
+
+// QueryWallet chains the current query on the "wallet" edge.
+func (wsq *WalletSnapshotQuery) QueryWallet() *WalletQuery {
+	query := &WalletQuery{config: wsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(walletsnapshot.Table, walletsnapshot.FieldID, selector),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, walletsnapshot.WalletTable, walletsnapshot.WalletColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBalances chains the current query on the "balances" edge.
+func (wsq *WalletSnapshotQuery) QueryBalances() *BalanceSnapshotQuery {
+	query := &BalanceSnapshotQuery{config: wsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(walletsnapshot.Table, walletsnapshot.FieldID, selector),
+			sqlgraph.To(balancesnapshot.Table, balancesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, walletsnapshot.BalancesTable, walletsnapshot.BalancesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WalletSnapshot entity from the query.
+// Returns a *NotFoundError when no WalletSnapshot was found.
+func (wsq *WalletSnapshotQuery) First(ctx context.Context) (*WalletSnapshot, error) {
+	nodes, err := wsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{walletsnapshot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wsq *WalletSnapshotQuery) FirstX(ctx context.Context) *WalletSnapshot {
+	node, err := wsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WalletSnapshot ID from the query.
+// Returns a *NotFoundError when no WalletSnapshot ID was found.
+func (wsq *WalletSnapshotQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{walletsnapshot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wsq *WalletSnapshotQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WalletSnapshot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WalletSnapshot entity is found.
+// Returns a *NotFoundError when no WalletSnapshot entities are found.
+func (wsq *WalletSnapshotQuery) Only(ctx context.Context) (*WalletSnapshot, error) {
+	nodes, err := wsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{walletsnapshot.Label}
+	default:
+		return nil, &NotSingularError{walletsnapshot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wsq *WalletSnapshotQuery) OnlyX(ctx context.Context) *WalletSnapshot {
+	node, err := wsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WalletSnapshot ID in the query.
+// Returns a *NotSingularError when more than one WalletSnapshot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wsq *WalletSnapshotQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{walletsnapshot.Label}
+	default:
+		err = &NotSingularError{walletsnapshot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wsq *WalletSnapshotQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WalletSnapshots.
+func (wsq *WalletSnapshotQuery) All(ctx context.Context) ([]*WalletSnapshot, error) {
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wsq *WalletSnapshotQuery) AllX(ctx context.Context) []*WalletSnapshot {
+	nodes, err := wsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WalletSnapshot IDs.
+func (wsq *WalletSnapshotQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := wsq.Select(walletsnapshot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wsq *WalletSnapshotQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wsq *WalletSnapshotQuery) Count(ctx context.Context) (int, error) {
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wsq *WalletSnapshotQuery) CountX(ctx context.Context) int {
+	count, err := wsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wsq *WalletSnapshotQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wsq *WalletSnapshotQuery) ExistX(ctx context.Context) bool {
+	exist, err := wsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WalletSnapshotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wsq *WalletSnapshotQuery) Clone() *WalletSnapshotQuery {
+	if wsq == nil {
+		return nil
+	}
+	return &WalletSnapshotQuery{
+		config:       wsq.config,
+		limit:        wsq.limit,
+		offset:       wsq.offset,
+		order:        append([]OrderFunc{}, wsq.order...),
+		predicates:   append([]predicate.WalletSnapshot{}, wsq.predicates...),
+		withWallet:   wsq.withWallet.Clone(),
+		withBalances: wsq.withBalances.Clone(),
+		// clone intermediate query.
+		sql:    wsq.sql.Clone(),
+		path:   wsq.path,
+		unique: wsq.unique,
+	}
+}
+
+// WithWallet tells the query-builder to eager-load the nodes that are connected to
+// the "wallet" edge. The optional arguments are used to configure the query builder of the edge.
+func (wsq *WalletSnapshotQuery) WithWallet(opts ...func(*WalletQuery)) *WalletSnapshotQuery {
+	query := &WalletQuery{config: wsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wsq.withWallet = query
+	return wsq
+}
+
+// WithBalances tells the query-builder to eager-load the nodes that are connected to
+// the "balances" edge. The optional arguments are used to configure the query builder of the edge.
+func (wsq *WalletSnapshotQuery) WithBalances(opts ...func(*BalanceSnapshotQuery)) *WalletSnapshotQuery {
+	query := &BalanceSnapshotQuery{config: wsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wsq.withBalances = query
+	return wsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WalletSnapshot.Query().
+//		GroupBy(walletsnapshot.FieldWalletID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wsq *WalletSnapshotQuery) GroupBy(field string, fields ...string) *WalletSnapshotGroupBy {
+	grbuild := &WalletSnapshotGroupBy{config: wsq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wsq.sqlQuery(ctx), nil
+	}
+	grbuild.label = walletsnapshot.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//	}
+//
+//	client.WalletSnapshot.Query().
+//		Select(walletsnapshot.FieldWalletID).
+//		Scan(ctx, &v)
+func (wsq *WalletSnapshotQuery) Select(fields ...string) *WalletSnapshotSelect {
+	wsq.fields = append(wsq.fields, fields...)
+	selbuild := &WalletSnapshotSelect{WalletSnapshotQuery: wsq}
+	selbuild.label = walletsnapshot.Label
+	selbuild.flds, selbuild.scan = &wsq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (wsq *WalletSnapshotQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wsq.fields {
+		if !walletsnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wsq.path != nil {
+		prev, err := wsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wsq.sql = prev
+	}
+	return nil
+}
+
+func (wsq *WalletSnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WalletSnapshot, error) {
+	var (
+		nodes       = []*WalletSnapshot{}
+		_spec       = wsq.querySpec()
+		loadedTypes = [2]bool{
+			wsq.withWallet != nil,
+			wsq.withBalances != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*WalletSnapshot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &WalletSnapshot{config: wsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wsq.withWallet; query != nil {
+		if err := wsq.loadWallet(ctx, query, nodes, nil,
+			func(n *WalletSnapshot, e *Wallet) { n.Edges.Wallet = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := wsq.withBalances; query != nil {
+		if err := wsq.loadBalances(ctx, query, nodes,
+			func(n *WalletSnapshot) { n.Edges.Balances = []*BalanceSnapshot{} },
+			func(n *WalletSnapshot, e *BalanceSnapshot) { n.Edges.Balances = append(n.Edges.Balances, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wsq *WalletSnapshotQuery) loadWallet(ctx context.Context, query *WalletQuery, nodes []*WalletSnapshot, init func(*WalletSnapshot), assign func(*WalletSnapshot, *Wallet)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*WalletSnapshot)
+	for i := range nodes {
+		fk := nodes[i].WalletID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(wallet.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (wsq *WalletSnapshotQuery) loadBalances(ctx context.Context, query *BalanceSnapshotQuery, nodes []*WalletSnapshot, init func(*WalletSnapshot), assign func(*WalletSnapshot, *BalanceSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*WalletSnapshot)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.Where(predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(walletsnapshot.BalancesColumn, fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WalletSnapshotID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "wallet_snapshot_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_snapshot_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (wsq *WalletSnapshotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wsq.querySpec()
+	_spec.Node.Columns = wsq.fields
+	if len(wsq.fields) > 0 {
+		_spec.Unique = wsq.unique != nil && *wsq.unique
+	}
+	return sqlgraph.CountNodes(ctx, wsq.driver, _spec)
+}
+
+func (wsq *WalletSnapshotQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wsq *WalletSnapshotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   walletsnapshot.Table,
+			Columns: walletsnapshot.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: walletsnapshot.FieldID,
+			},
+		},
+		From:   wsq.sql,
+		Unique: true,
+	}
+	if unique := wsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, walletsnapshot.FieldID)
+		for i := range fields {
+			if fields[i] != walletsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wsq *WalletSnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wsq.driver.Dialect())
+	t1 := builder.Table(walletsnapshot.Table)
+	columns := wsq.fields
+	if len(columns) == 0 {
+		columns = walletsnapshot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wsq.sql != nil {
+		selector = wsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wsq.unique != nil && *wsq.unique {
+		selector.Distinct()
+	}
+	for _, p := range wsq.predicates {
+		p(selector)
+	}
+	for _, p := range wsq.order {
+		p(selector)
+	}
+	if offset := wsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WalletSnapshotGroupBy is the group-by builder for WalletSnapshot entities.
+type WalletSnapshotGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wsgb *WalletSnapshotGroupBy) Aggregate(fns ...AggregateFunc) *WalletSnapshotGroupBy {
+	wsgb.fns = append(wsgb.fns, fns...)
+	return wsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wsgb *WalletSnapshotGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wsgb.sql = query
+	return wsgb.sqlScan(ctx, v)
+}
+
+func (wsgb *WalletSnapshotGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wsgb.fields {
+		if !walletsnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wsgb *WalletSnapshotGroupBy) sqlQuery() *sql.Selector {
+	selector := wsgb.sql.Select()
+	aggregation := make([]string, 0, len(wsgb.fns))
+	for _, fn := range wsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(wsgb.fields)+len(wsgb.fns))
+		for _, f := range wsgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(wsgb.fields...)...)
+}
+
+// WalletSnapshotSelect is the builder for selecting fields of WalletSnapshot entities.
+type WalletSnapshotSelect struct {
+	*WalletSnapshotQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wss *WalletSnapshotSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := wss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wss.sql = wss.WalletSnapshotQuery.sqlQuery(ctx)
+	return wss.sqlScan(ctx, v)
+}
+
+func (wss *WalletSnapshotSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wss.sql.Query()
+	if err := wss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
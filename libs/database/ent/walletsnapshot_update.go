@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/google/uuid"
+)
+
+// WalletSnapshotUpdate is the builder for updating WalletSnapshot entities.
+type WalletSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WalletSnapshotMutation
+}
+
+// Where appends a list predicates to the WalletSnapshotUpdate builder.
+func (wsu *WalletSnapshotUpdate) Where(ps ...predicate.WalletSnapshot) *WalletSnapshotUpdate {
+	wsu.mutation.Where(ps...)
+	return wsu
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (wsu *WalletSnapshotUpdate) SetWalletID(u uuid.UUID) *WalletSnapshotUpdate {
+	wsu.mutation.SetWalletID(u)
+	return wsu
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (wsu *WalletSnapshotUpdate) SetWallet(w *Wallet) *WalletSnapshotUpdate {
+	return wsu.SetWalletID(w.ID)
+}
+
+// AddBalanceIDs adds the "balances" edge to the BalanceSnapshot entity by IDs.
+func (wsu *WalletSnapshotUpdate) AddBalanceIDs(ids ...uuid.UUID) *WalletSnapshotUpdate {
+	wsu.mutation.AddBalanceIDs(ids...)
+	return wsu
+}
+
+// AddBalances adds the "balances" edges to the BalanceSnapshot entity.
+func (wsu *WalletSnapshotUpdate) AddBalances(b ...*BalanceSnapshot) *WalletSnapshotUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wsu.AddBalanceIDs(ids...)
+}
+
+// Mutation returns the WalletSnapshotMutation object of the builder.
+func (wsu *WalletSnapshotUpdate) Mutation() *WalletSnapshotMutation {
+	return wsu.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (wsu *WalletSnapshotUpdate) ClearWallet() *WalletSnapshotUpdate {
+	wsu.mutation.ClearWallet()
+	return wsu
+}
+
+// ClearBalances clears all "balances" edges to the BalanceSnapshot entity.
+func (wsu *WalletSnapshotUpdate) ClearBalances() *WalletSnapshotUpdate {
+	wsu.mutation.ClearBalances()
+	return wsu
+}
+
+// RemoveBalanceIDs removes the "balances" edge to BalanceSnapshot entities by IDs.
+func (wsu *WalletSnapshotUpdate) RemoveBalanceIDs(ids ...uuid.UUID) *WalletSnapshotUpdate {
+	wsu.mutation.RemoveBalanceIDs(ids...)
+	return wsu
+}
+
+// RemoveBalances removes "balances" edges to BalanceSnapshot entities.
+func (wsu *WalletSnapshotUpdate) RemoveBalances(b ...*BalanceSnapshot) *WalletSnapshotUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wsu.RemoveBalanceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wsu *WalletSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wsu.hooks) == 0 {
+		if err = wsu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = wsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WalletSnapshotMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wsu.check(); err != nil {
+				return 0, err
+			}
+			wsu.mutation = mutation
+			affected, err = wsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wsu.hooks) - 1; i >= 0; i-- {
+			if wsu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsu *WalletSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := wsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wsu *WalletSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := wsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsu *WalletSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := wsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsu *WalletSnapshotUpdate) check() error {
+	if _, ok := wsu.mutation.WalletID(); wsu.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "WalletSnapshot.wallet"`)
+	}
+	return nil
+}
+
+func (wsu *WalletSnapshotUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   walletsnapshot.Table,
+			Columns: walletsnapshot.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: walletsnapshot.FieldID,
+			},
+		},
+	}
+	if ps := wsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if wsu.mutation.LabelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: walletsnapshot.FieldLabel,
+		})
+	}
+	if wsu.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   walletsnapshot.WalletTable,
+			Columns: []string{walletsnapshot.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   walletsnapshot.WalletTable,
+			Columns: []string{walletsnapshot.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wsu.mutation.BalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   walletsnapshot.BalancesTable,
+			Columns: []string{walletsnapshot.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.RemovedBalancesIDs(); len(nodes) > 0 && !wsu.mutation.BalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   walletsnapshot.BalancesTable,
+			Columns: []string{walletsnapshot.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.BalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   walletsnapshot.BalancesTable,
+			Columns: []string{walletsnapshot.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{walletsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WalletSnapshotUpdateOne is the builder for updating a single WalletSnapshot entity.
+type WalletSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WalletSnapshotMutation
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (wsuo *WalletSnapshotUpdateOne) SetWalletID(u uuid.UUID) *WalletSnapshotUpdateOne {
+	wsuo.mutation.SetWalletID(u)
+	return wsuo
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (wsuo *WalletSnapshotUpdateOne) SetWallet(w *Wallet) *WalletSnapshotUpdateOne {
+	return wsuo.SetWalletID(w.ID)
+}
+
+// AddBalanceIDs adds the "balances" edge to the BalanceSnapshot entity by IDs.
+func (wsuo *WalletSnapshotUpdateOne) AddBalanceIDs(ids ...uuid.UUID) *WalletSnapshotUpdateOne {
+	wsuo.mutation.AddBalanceIDs(ids...)
+	return wsuo
+}
+
+// AddBalances adds the "balances" edges to the BalanceSnapshot entity.
+func (wsuo *WalletSnapshotUpdateOne) AddBalances(b ...*BalanceSnapshot) *WalletSnapshotUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wsuo.AddBalanceIDs(ids...)
+}
+
+// Mutation returns the WalletSnapshotMutation object of the builder.
+func (wsuo *WalletSnapshotUpdateOne) Mutation() *WalletSnapshotMutation {
+	return wsuo.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (wsuo *WalletSnapshotUpdateOne) ClearWallet() *WalletSnapshotUpdateOne {
+	wsuo.mutation.ClearWallet()
+	return wsuo
+}
+
+// ClearBalances clears all "balances" edges to the BalanceSnapshot entity.
+func (wsuo *WalletSnapshotUpdateOne) ClearBalances() *WalletSnapshotUpdateOne {
+	wsuo.mutation.ClearBalances()
+	return wsuo
+}
+
+// RemoveBalanceIDs removes the "balances" edge to BalanceSnapshot entities by IDs.
+func (wsuo *WalletSnapshotUpdateOne) RemoveBalanceIDs(ids ...uuid.UUID) *WalletSnapshotUpdateOne {
+	wsuo.mutation.RemoveBalanceIDs(ids...)
+	return wsuo
+}
+
+// RemoveBalances removes "balances" edges to BalanceSnapshot entities.
+func (wsuo *WalletSnapshotUpdateOne) RemoveBalances(b ...*BalanceSnapshot) *WalletSnapshotUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return wsuo.RemoveBalanceIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wsuo *WalletSnapshotUpdateOne) Select(field string, fields ...string) *WalletSnapshotUpdateOne {
+	wsuo.fields = append([]string{field}, fields...)
+	return wsuo
+}
+
+// Save executes the query and returns the updated WalletSnapshot entity.
+func (wsuo *WalletSnapshotUpdateOne) Save(ctx context.Context) (*WalletSnapshot, error) {
+	var (
+		err  error
+		node *WalletSnapshot
+	)
+	if len(wsuo.hooks) == 0 {
+		if err = wsuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = wsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WalletSnapshotMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wsuo.check(); err != nil {
+				return nil, err
+			}
+			wsuo.mutation = mutation
+			node, err = wsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wsuo.hooks) - 1; i >= 0; i-- {
+			if wsuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wsuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WalletSnapshot)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WalletSnapshotMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsuo *WalletSnapshotUpdateOne) SaveX(ctx context.Context) *WalletSnapshot {
+	node, err := wsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wsuo *WalletSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := wsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsuo *WalletSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := wsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsuo *WalletSnapshotUpdateOne) check() error {
+	if _, ok := wsuo.mutation.WalletID(); wsuo.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "WalletSnapshot.wallet"`)
+	}
+	return nil
+}
+
+func (wsuo *WalletSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *WalletSnapshot, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   walletsnapshot.Table,
+			Columns: walletsnapshot.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: walletsnapshot.FieldID,
+			},
+		},
+	}
+	id, ok := wsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WalletSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, walletsnapshot.FieldID)
+		for _, f := range fields {
+			if !walletsnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != walletsnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if wsuo.mutation.LabelCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: walletsnapshot.FieldLabel,
+		})
+	}
+	if wsuo.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   walletsnapshot.WalletTable,
+			Columns: []string{walletsnapshot.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   walletsnapshot.WalletTable,
+			Columns: []string{walletsnapshot.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if wsuo.mutation.BalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   walletsnapshot.BalancesTable,
+			Columns: []string{walletsnapshot.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.RemovedBalancesIDs(); len(nodes) > 0 && !wsuo.mutation.BalancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   walletsnapshot.BalancesTable,
+			Columns: []string{walletsnapshot.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.BalancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   walletsnapshot.BalancesTable,
+			Columns: []string{walletsnapshot.BalancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: balancesnapshot.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WalletSnapshot{config: wsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{walletsnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
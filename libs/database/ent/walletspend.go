@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
+	"github.com/google/uuid"
+)
+
+// WalletSpend is the model entity for the WalletSpend schema.
+type WalletSpend struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WalletID holds the value of the "wallet_id" field.
+	WalletID uuid.UUID `json:"wallet_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount string `json:"amount,omitempty"`
+	// BlockHash holds the value of the "block_hash" field.
+	BlockHash string `json:"block_hash,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WalletSpendQuery when eager-loading is set.
+	Edges WalletSpendEdges `json:"edges"`
+}
+
+// WalletSpendEdges holds the relations/edges for other nodes in the graph.
+type WalletSpendEdges struct {
+	// Wallet holds the value of the wallet edge.
+	Wallet *Wallet `json:"wallet,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WalletOrErr returns the Wallet value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WalletSpendEdges) WalletOrErr() (*Wallet, error) {
+	if e.loadedTypes[0] {
+		if e.Wallet == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: wallet.Label}
+		}
+		return e.Wallet, nil
+	}
+	return nil, &NotLoadedError{edge: "wallet"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WalletSpend) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case walletspend.FieldAmount, walletspend.FieldBlockHash:
+			values[i] = new(sql.NullString)
+		case walletspend.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case walletspend.FieldID, walletspend.FieldWalletID:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type WalletSpend", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WalletSpend fields.
+func (ws *WalletSpend) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case walletspend.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ws.ID = *value
+			}
+		case walletspend.FieldWalletID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field wallet_id", values[i])
+			} else if value != nil {
+				ws.WalletID = *value
+			}
+		case walletspend.FieldAmount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				ws.Amount = value.String
+			}
+		case walletspend.FieldBlockHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field block_hash", values[i])
+			} else if value.Valid {
+				ws.BlockHash = value.String
+			}
+		case walletspend.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ws.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// QueryWallet queries the "wallet" edge of the WalletSpend entity.
+func (ws *WalletSpend) QueryWallet() *WalletQuery {
+	return (&WalletSpendClient{config: ws.config}).QueryWallet(ws)
+}
+
+// Update returns a builder for updating this WalletSpend.
+// Note that you need to call WalletSpend.Unwrap() before calling this method if this WalletSpend
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ws *WalletSpend) Update() *WalletSpendUpdateOne {
+	return (&WalletSpendClient{config: ws.config}).UpdateOne(ws)
+}
+
+// Unwrap unwraps the WalletSpend entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ws *WalletSpend) Unwrap() *WalletSpend {
+	_tx, ok := ws.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WalletSpend is not a transactional entity")
+	}
+	ws.config.driver = _tx.drv
+	return ws
+}
+
+// String implements the fmt.Stringer.
+func (ws *WalletSpend) String() string {
+	var builder strings.Builder
+	builder.WriteString("WalletSpend(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ws.ID))
+	builder.WriteString("wallet_id=")
+	builder.WriteString(fmt.Sprintf("%v", ws.WalletID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(ws.Amount)
+	builder.WriteString(", ")
+	builder.WriteString("block_hash=")
+	builder.WriteString(ws.BlockHash)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ws.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WalletSpends is a parsable slice of WalletSpend.
+type WalletSpends []*WalletSpend
+
+func (ws WalletSpends) config(cfg config) {
+	for _i := range ws {
+		ws[_i].config = cfg
+	}
+}
@@ -0,0 +1,469 @@
+// Code generated by ent, DO NOT EDIT.
+
+package walletspend
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// WalletID applies equality check predicate on the "wallet_id" field. It's identical to WalletIDEQ.
+func WalletID(v uuid.UUID) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// BlockHash applies equality check predicate on the "block_hash" field. It's identical to BlockHashEQ.
+func BlockHash(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBlockHash), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// WalletIDEQ applies the EQ predicate on the "wallet_id" field.
+func WalletIDEQ(v uuid.UUID) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDNEQ applies the NEQ predicate on the "wallet_id" field.
+func WalletIDNEQ(v uuid.UUID) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWalletID), v))
+	})
+}
+
+// WalletIDIn applies the In predicate on the "wallet_id" field.
+func WalletIDIn(vs ...uuid.UUID) predicate.WalletSpend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldWalletID), v...))
+	})
+}
+
+// WalletIDNotIn applies the NotIn predicate on the "wallet_id" field.
+func WalletIDNotIn(vs ...uuid.UUID) predicate.WalletSpend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldWalletID), v...))
+	})
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAmount), v))
+	})
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...string) predicate.WalletSpend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...string) predicate.WalletSpend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldAmount), v...))
+	})
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAmount), v))
+	})
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAmount), v))
+	})
+}
+
+// AmountContains applies the Contains predicate on the "amount" field.
+func AmountContains(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAmount), v))
+	})
+}
+
+// AmountHasPrefix applies the HasPrefix predicate on the "amount" field.
+func AmountHasPrefix(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAmount), v))
+	})
+}
+
+// AmountHasSuffix applies the HasSuffix predicate on the "amount" field.
+func AmountHasSuffix(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAmount), v))
+	})
+}
+
+// AmountEqualFold applies the EqualFold predicate on the "amount" field.
+func AmountEqualFold(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAmount), v))
+	})
+}
+
+// AmountContainsFold applies the ContainsFold predicate on the "amount" field.
+func AmountContainsFold(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAmount), v))
+	})
+}
+
+// BlockHashEQ applies the EQ predicate on the "block_hash" field.
+func BlockHashEQ(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashNEQ applies the NEQ predicate on the "block_hash" field.
+func BlockHashNEQ(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashIn applies the In predicate on the "block_hash" field.
+func BlockHashIn(vs ...string) predicate.WalletSpend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldBlockHash), v...))
+	})
+}
+
+// BlockHashNotIn applies the NotIn predicate on the "block_hash" field.
+func BlockHashNotIn(vs ...string) predicate.WalletSpend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldBlockHash), v...))
+	})
+}
+
+// BlockHashGT applies the GT predicate on the "block_hash" field.
+func BlockHashGT(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashGTE applies the GTE predicate on the "block_hash" field.
+func BlockHashGTE(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashLT applies the LT predicate on the "block_hash" field.
+func BlockHashLT(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashLTE applies the LTE predicate on the "block_hash" field.
+func BlockHashLTE(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashContains applies the Contains predicate on the "block_hash" field.
+func BlockHashContains(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashHasPrefix applies the HasPrefix predicate on the "block_hash" field.
+func BlockHashHasPrefix(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashHasSuffix applies the HasSuffix predicate on the "block_hash" field.
+func BlockHashHasSuffix(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashEqualFold applies the EqualFold predicate on the "block_hash" field.
+func BlockHashEqualFold(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldBlockHash), v))
+	})
+}
+
+// BlockHashContainsFold applies the ContainsFold predicate on the "block_hash" field.
+func BlockHashContainsFold(v string) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldBlockHash), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WalletSpend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WalletSpend {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasWallet applies the HasEdge predicate on the "wallet" edge.
+func HasWallet() predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWalletWith applies the HasEdge predicate on the "wallet" edge with a given conditions (other predicates).
+func HasWalletWith(preds ...predicate.Wallet) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(WalletInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WalletTable, WalletColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WalletSpend) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WalletSpend) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WalletSpend) predicate.WalletSpend {
+	return predicate.WalletSpend(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,351 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
+	"github.com/google/uuid"
+)
+
+// WalletSpendCreate is the builder for creating a WalletSpend entity.
+type WalletSpendCreate struct {
+	config
+	mutation *WalletSpendMutation
+	hooks    []Hook
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (wsc *WalletSpendCreate) SetWalletID(u uuid.UUID) *WalletSpendCreate {
+	wsc.mutation.SetWalletID(u)
+	return wsc
+}
+
+// SetAmount sets the "amount" field.
+func (wsc *WalletSpendCreate) SetAmount(s string) *WalletSpendCreate {
+	wsc.mutation.SetAmount(s)
+	return wsc
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (wsc *WalletSpendCreate) SetBlockHash(s string) *WalletSpendCreate {
+	wsc.mutation.SetBlockHash(s)
+	return wsc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wsc *WalletSpendCreate) SetCreatedAt(t time.Time) *WalletSpendCreate {
+	wsc.mutation.SetCreatedAt(t)
+	return wsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wsc *WalletSpendCreate) SetNillableCreatedAt(t *time.Time) *WalletSpendCreate {
+	if t != nil {
+		wsc.SetCreatedAt(*t)
+	}
+	return wsc
+}
+
+// SetID sets the "id" field.
+func (wsc *WalletSpendCreate) SetID(u uuid.UUID) *WalletSpendCreate {
+	wsc.mutation.SetID(u)
+	return wsc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wsc *WalletSpendCreate) SetNillableID(u *uuid.UUID) *WalletSpendCreate {
+	if u != nil {
+		wsc.SetID(*u)
+	}
+	return wsc
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (wsc *WalletSpendCreate) SetWallet(w *Wallet) *WalletSpendCreate {
+	return wsc.SetWalletID(w.ID)
+}
+
+// Mutation returns the WalletSpendMutation object of the builder.
+func (wsc *WalletSpendCreate) Mutation() *WalletSpendMutation {
+	return wsc.mutation
+}
+
+// Save creates the WalletSpend in the database.
+func (wsc *WalletSpendCreate) Save(ctx context.Context) (*WalletSpend, error) {
+	var (
+		err  error
+		node *WalletSpend
+	)
+	wsc.defaults()
+	if len(wsc.hooks) == 0 {
+		if err = wsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = wsc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WalletSpendMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wsc.check(); err != nil {
+				return nil, err
+			}
+			wsc.mutation = mutation
+			if node, err = wsc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wsc.hooks) - 1; i >= 0; i-- {
+			if wsc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsc.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wsc.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WalletSpend)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WalletSpendMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wsc *WalletSpendCreate) SaveX(ctx context.Context) *WalletSpend {
+	v, err := wsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wsc *WalletSpendCreate) Exec(ctx context.Context) error {
+	_, err := wsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsc *WalletSpendCreate) ExecX(ctx context.Context) {
+	if err := wsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wsc *WalletSpendCreate) defaults() {
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		v := walletspend.DefaultCreatedAt()
+		wsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wsc.mutation.ID(); !ok {
+		v := walletspend.DefaultID()
+		wsc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsc *WalletSpendCreate) check() error {
+	if _, ok := wsc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet_id", err: errors.New(`ent: missing required field "WalletSpend.wallet_id"`)}
+	}
+	if _, ok := wsc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "WalletSpend.amount"`)}
+	}
+	if v, ok := wsc.mutation.Amount(); ok {
+		if err := walletspend.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "WalletSpend.amount": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.BlockHash(); !ok {
+		return &ValidationError{Name: "block_hash", err: errors.New(`ent: missing required field "WalletSpend.block_hash"`)}
+	}
+	if v, ok := wsc.mutation.BlockHash(); ok {
+		if err := walletspend.BlockHashValidator(v); err != nil {
+			return &ValidationError{Name: "block_hash", err: fmt.Errorf(`ent: validator failed for field "WalletSpend.block_hash": %w`, err)}
+		}
+	}
+	if _, ok := wsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WalletSpend.created_at"`)}
+	}
+	if _, ok := wsc.mutation.WalletID(); !ok {
+		return &ValidationError{Name: "wallet", err: errors.New(`ent: missing required edge "WalletSpend.wallet"`)}
+	}
+	return nil
+}
+
+func (wsc *WalletSpendCreate) sqlSave(ctx context.Context) (*WalletSpend, error) {
+	_node, _spec := wsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	return _node, nil
+}
+
+func (wsc *WalletSpendCreate) createSpec() (*WalletSpend, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WalletSpend{config: wsc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: walletspend.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: walletspend.FieldID,
+			},
+		}
+	)
+	if id, ok := wsc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wsc.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: walletspend.FieldAmount,
+		})
+		_node.Amount = value
+	}
+	if value, ok := wsc.mutation.BlockHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: walletspend.FieldBlockHash,
+		})
+		_node.BlockHash = value
+	}
+	if value, ok := wsc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: walletspend.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := wsc.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   walletspend.WalletTable,
+			Columns: []string{walletspend.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WalletID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WalletSpendCreateBulk is the builder for creating many WalletSpend entities in bulk.
+type WalletSpendCreateBulk struct {
+	config
+	builders []*WalletSpendCreate
+}
+
+// Save creates the WalletSpend entities in the database.
+func (wscb *WalletSpendCreateBulk) Save(ctx context.Context) ([]*WalletSpend, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(wscb.builders))
+	nodes := make([]*WalletSpend, len(wscb.builders))
+	mutators := make([]Mutator, len(wscb.builders))
+	for i := range wscb.builders {
+		func(i int, root context.Context) {
+			builder := wscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WalletSpendMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wscb *WalletSpendCreateBulk) SaveX(ctx context.Context) []*WalletSpend {
+	v, err := wscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wscb *WalletSpendCreateBulk) Exec(ctx context.Context) error {
+	_, err := wscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wscb *WalletSpendCreateBulk) ExecX(ctx context.Context) {
+	if err := wscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
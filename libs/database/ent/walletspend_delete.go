@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
+)
+
+// WalletSpendDelete is the builder for deleting a WalletSpend entity.
+type WalletSpendDelete struct {
+	config
+	hooks    []Hook
+	mutation *WalletSpendMutation
+}
+
+// Where appends a list predicates to the WalletSpendDelete builder.
+func (wsd *WalletSpendDelete) Where(ps ...predicate.WalletSpend) *WalletSpendDelete {
+	wsd.mutation.Where(ps...)
+	return wsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wsd *WalletSpendDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wsd.hooks) == 0 {
+		affected, err = wsd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WalletSpendMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wsd.mutation = mutation
+			affected, err = wsd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wsd.hooks) - 1; i >= 0; i-- {
+			if wsd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wsd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsd *WalletSpendDelete) ExecX(ctx context.Context) int {
+	n, err := wsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wsd *WalletSpendDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: walletspend.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: walletspend.FieldID,
+			},
+		},
+	}
+	if ps := wsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	return affected, err
+}
+
+// WalletSpendDeleteOne is the builder for deleting a single WalletSpend entity.
+type WalletSpendDeleteOne struct {
+	wsd *WalletSpendDelete
+}
+
+// Exec executes the deletion query.
+func (wsdo *WalletSpendDeleteOne) Exec(ctx context.Context) error {
+	n, err := wsdo.wsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{walletspend.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsdo *WalletSpendDeleteOne) ExecX(ctx context.Context) {
+	wsdo.wsd.ExecX(ctx)
+}
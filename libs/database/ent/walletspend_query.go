@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
+	"github.com/google/uuid"
+)
+
+// WalletSpendQuery is the builder for querying WalletSpend entities.
+type WalletSpendQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.WalletSpend
+	withWallet *WalletQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WalletSpendQuery builder.
+func (wsq *WalletSpendQuery) Where(ps ...predicate.WalletSpend) *WalletSpendQuery {
+	wsq.predicates = append(wsq.predicates, ps...)
+	return wsq
+}
+
+// Limit adds a limit step to the query.
+func (wsq *WalletSpendQuery) Limit(limit int) *WalletSpendQuery {
+	wsq.limit = &limit
+	return wsq
+}
+
+// Offset adds an offset step to the query.
+func (wsq *WalletSpendQuery) Offset(offset int) *WalletSpendQuery {
+	wsq.offset = &offset
+	return wsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wsq *WalletSpendQuery) Unique(unique bool) *WalletSpendQuery {
+	wsq.unique = &unique
+	return wsq
+}
+
+// Order adds an order step to the query.
+func (wsq *WalletSpendQuery) Order(o ...OrderFunc) *WalletSpendQuery {
+	wsq.order = append(wsq.order, o...)
+	return wsq
+}
+
+// QueryWallet chains the current query on the "wallet" edge.
+func (wsq *WalletSpendQuery) QueryWallet() *WalletQuery {
+	query := &WalletQuery{config: wsq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wsq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(walletspend.Table, walletspend.FieldID, selector),
+			sqlgraph.To(wallet.Table, wallet.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, walletspend.WalletTable, walletspend.WalletColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wsq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WalletSpend entity from the query.
+// Returns a *NotFoundError when no WalletSpend was found.
+func (wsq *WalletSpendQuery) First(ctx context.Context) (*WalletSpend, error) {
+	nodes, err := wsq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{walletspend.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wsq *WalletSpendQuery) FirstX(ctx context.Context) *WalletSpend {
+	node, err := wsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WalletSpend ID from the query.
+// Returns a *NotFoundError when no WalletSpend ID was found.
+func (wsq *WalletSpendQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wsq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{walletspend.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wsq *WalletSpendQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := wsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WalletSpend entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WalletSpend entity is found.
+// Returns a *NotFoundError when no WalletSpend entities are found.
+func (wsq *WalletSpendQuery) Only(ctx context.Context) (*WalletSpend, error) {
+	nodes, err := wsq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{walletspend.Label}
+	default:
+		return nil, &NotSingularError{walletspend.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wsq *WalletSpendQuery) OnlyX(ctx context.Context) *WalletSpend {
+	node, err := wsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WalletSpend ID in the query.
+// Returns a *NotSingularError when more than one WalletSpend ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wsq *WalletSpendQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wsq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{walletspend.Label}
+	default:
+		err = &NotSingularError{walletspend.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wsq *WalletSpendQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := wsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WalletSpends.
+func (wsq *WalletSpendQuery) All(ctx context.Context) ([]*WalletSpend, error) {
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wsq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wsq *WalletSpendQuery) AllX(ctx context.Context) []*WalletSpend {
+	nodes, err := wsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WalletSpend IDs.
+func (wsq *WalletSpendQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := wsq.Select(walletspend.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wsq *WalletSpendQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wsq *WalletSpendQuery) Count(ctx context.Context) (int, error) {
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wsq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wsq *WalletSpendQuery) CountX(ctx context.Context) int {
+	count, err := wsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wsq *WalletSpendQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wsq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wsq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wsq *WalletSpendQuery) ExistX(ctx context.Context) bool {
+	exist, err := wsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WalletSpendQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wsq *WalletSpendQuery) Clone() *WalletSpendQuery {
+	if wsq == nil {
+		return nil
+	}
+	return &WalletSpendQuery{
+		config:     wsq.config,
+		limit:      wsq.limit,
+		offset:     wsq.offset,
+		order:      append([]OrderFunc{}, wsq.order...),
+		predicates: append([]predicate.WalletSpend{}, wsq.predicates...),
+		withWallet: wsq.withWallet.Clone(),
+		// clone intermediate query.
+		sql:    wsq.sql.Clone(),
+		path:   wsq.path,
+		unique: wsq.unique,
+	}
+}
+
+// WithWallet tells the query-builder to eager-load the nodes that are connected to
+// the "wallet" edge. The optional arguments are used to configure the query builder of the edge.
+func (wsq *WalletSpendQuery) WithWallet(opts ...func(*WalletQuery)) *WalletSpendQuery {
+	query := &WalletQuery{config: wsq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wsq.withWallet = query
+	return wsq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WalletSpend.Query().
+//		GroupBy(walletspend.FieldWalletID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wsq *WalletSpendQuery) GroupBy(field string, fields ...string) *WalletSpendGroupBy {
+	grbuild := &WalletSpendGroupBy{config: wsq.config}
+	grbuild.fields = append([]string{field}, fields...)
+	grbuild.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wsq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wsq.sqlQuery(ctx), nil
+	}
+	grbuild.label = walletspend.Label
+	grbuild.flds, grbuild.scan = &grbuild.fields, grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WalletID uuid.UUID `json:"wallet_id,omitempty"`
+//	}
+//
+//	client.WalletSpend.Query().
+//		Select(walletspend.FieldWalletID).
+//		Scan(ctx, &v)
+func (wsq *WalletSpendQuery) Select(fields ...string) *WalletSpendSelect {
+	wsq.fields = append(wsq.fields, fields...)
+	selbuild := &WalletSpendSelect{WalletSpendQuery: wsq}
+	selbuild.label = walletspend.Label
+	selbuild.flds, selbuild.scan = &wsq.fields, selbuild.Scan
+	return selbuild
+}
+
+func (wsq *WalletSpendQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range wsq.fields {
+		if !walletspend.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wsq.path != nil {
+		prev, err := wsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wsq.sql = prev
+	}
+	return nil
+}
+
+func (wsq *WalletSpendQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WalletSpend, error) {
+	var (
+		nodes       = []*WalletSpend{}
+		_spec       = wsq.querySpec()
+		loadedTypes = [1]bool{
+			wsq.withWallet != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		return (*WalletSpend).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		node := &WalletSpend{config: wsq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wsq.withWallet; query != nil {
+		if err := wsq.loadWallet(ctx, query, nodes, nil,
+			func(n *WalletSpend, e *Wallet) { n.Edges.Wallet = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wsq *WalletSpendQuery) loadWallet(ctx context.Context, query *WalletQuery, nodes []*WalletSpend, init func(*WalletSpend), assign func(*WalletSpend, *Wallet)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*WalletSpend)
+	for i := range nodes {
+		fk := nodes[i].WalletID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	query.Where(wallet.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wallet_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (wsq *WalletSpendQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wsq.querySpec()
+	_spec.Node.Columns = wsq.fields
+	if len(wsq.fields) > 0 {
+		_spec.Unique = wsq.unique != nil && *wsq.unique
+	}
+	return sqlgraph.CountNodes(ctx, wsq.driver, _spec)
+}
+
+func (wsq *WalletSpendQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wsq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (wsq *WalletSpendQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   walletspend.Table,
+			Columns: walletspend.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: walletspend.FieldID,
+			},
+		},
+		From:   wsq.sql,
+		Unique: true,
+	}
+	if unique := wsq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := wsq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, walletspend.FieldID)
+		for i := range fields {
+			if fields[i] != walletspend.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := wsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wsq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wsq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wsq *WalletSpendQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wsq.driver.Dialect())
+	t1 := builder.Table(walletspend.Table)
+	columns := wsq.fields
+	if len(columns) == 0 {
+		columns = walletspend.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wsq.sql != nil {
+		selector = wsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wsq.unique != nil && *wsq.unique {
+		selector.Distinct()
+	}
+	for _, p := range wsq.predicates {
+		p(selector)
+	}
+	for _, p := range wsq.order {
+		p(selector)
+	}
+	if offset := wsq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wsq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WalletSpendGroupBy is the group-by builder for WalletSpend entities.
+type WalletSpendGroupBy struct {
+	config
+	selector
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wsgb *WalletSpendGroupBy) Aggregate(fns ...AggregateFunc) *WalletSpendGroupBy {
+	wsgb.fns = append(wsgb.fns, fns...)
+	return wsgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (wsgb *WalletSpendGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wsgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wsgb.sql = query
+	return wsgb.sqlScan(ctx, v)
+}
+
+func (wsgb *WalletSpendGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range wsgb.fields {
+		if !walletspend.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := wsgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wsgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wsgb *WalletSpendGroupBy) sqlQuery() *sql.Selector {
+	selector := wsgb.sql.Select()
+	aggregation := make([]string, 0, len(wsgb.fns))
+	for _, fn := range wsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(wsgb.fields)+len(wsgb.fns))
+		for _, f := range wsgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(wsgb.fields...)...)
+}
+
+// WalletSpendSelect is the builder for selecting fields of WalletSpend entities.
+type WalletSpendSelect struct {
+	*WalletSpendQuery
+	selector
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wss *WalletSpendSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := wss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	wss.sql = wss.WalletSpendQuery.sqlQuery(ctx)
+	return wss.sqlScan(ctx, v)
+}
+
+func (wss *WalletSpendSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wss.sql.Query()
+	if err := wss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
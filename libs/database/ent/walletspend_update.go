@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
+	"github.com/google/uuid"
+)
+
+// WalletSpendUpdate is the builder for updating WalletSpend entities.
+type WalletSpendUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WalletSpendMutation
+}
+
+// Where appends a list predicates to the WalletSpendUpdate builder.
+func (wsu *WalletSpendUpdate) Where(ps ...predicate.WalletSpend) *WalletSpendUpdate {
+	wsu.mutation.Where(ps...)
+	return wsu
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (wsu *WalletSpendUpdate) SetWalletID(u uuid.UUID) *WalletSpendUpdate {
+	wsu.mutation.SetWalletID(u)
+	return wsu
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (wsu *WalletSpendUpdate) SetWallet(w *Wallet) *WalletSpendUpdate {
+	return wsu.SetWalletID(w.ID)
+}
+
+// Mutation returns the WalletSpendMutation object of the builder.
+func (wsu *WalletSpendUpdate) Mutation() *WalletSpendMutation {
+	return wsu.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (wsu *WalletSpendUpdate) ClearWallet() *WalletSpendUpdate {
+	wsu.mutation.ClearWallet()
+	return wsu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wsu *WalletSpendUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(wsu.hooks) == 0 {
+		if err = wsu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = wsu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WalletSpendMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wsu.check(); err != nil {
+				return 0, err
+			}
+			wsu.mutation = mutation
+			affected, err = wsu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(wsu.hooks) - 1; i >= 0; i-- {
+			if wsu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsu *WalletSpendUpdate) SaveX(ctx context.Context) int {
+	affected, err := wsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wsu *WalletSpendUpdate) Exec(ctx context.Context) error {
+	_, err := wsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsu *WalletSpendUpdate) ExecX(ctx context.Context) {
+	if err := wsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsu *WalletSpendUpdate) check() error {
+	if _, ok := wsu.mutation.WalletID(); wsu.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "WalletSpend.wallet"`)
+	}
+	return nil
+}
+
+func (wsu *WalletSpendUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   walletspend.Table,
+			Columns: walletspend.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: walletspend.FieldID,
+			},
+		},
+	}
+	if ps := wsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if wsu.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   walletspend.WalletTable,
+			Columns: []string{walletspend.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsu.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   walletspend.WalletTable,
+			Columns: []string{walletspend.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{walletspend.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WalletSpendUpdateOne is the builder for updating a single WalletSpend entity.
+type WalletSpendUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WalletSpendMutation
+}
+
+// SetWalletID sets the "wallet_id" field.
+func (wsuo *WalletSpendUpdateOne) SetWalletID(u uuid.UUID) *WalletSpendUpdateOne {
+	wsuo.mutation.SetWalletID(u)
+	return wsuo
+}
+
+// SetWallet sets the "wallet" edge to the Wallet entity.
+func (wsuo *WalletSpendUpdateOne) SetWallet(w *Wallet) *WalletSpendUpdateOne {
+	return wsuo.SetWalletID(w.ID)
+}
+
+// Mutation returns the WalletSpendMutation object of the builder.
+func (wsuo *WalletSpendUpdateOne) Mutation() *WalletSpendMutation {
+	return wsuo.mutation
+}
+
+// ClearWallet clears the "wallet" edge to the Wallet entity.
+func (wsuo *WalletSpendUpdateOne) ClearWallet() *WalletSpendUpdateOne {
+	wsuo.mutation.ClearWallet()
+	return wsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wsuo *WalletSpendUpdateOne) Select(field string, fields ...string) *WalletSpendUpdateOne {
+	wsuo.fields = append([]string{field}, fields...)
+	return wsuo
+}
+
+// Save executes the query and returns the updated WalletSpend entity.
+func (wsuo *WalletSpendUpdateOne) Save(ctx context.Context) (*WalletSpend, error) {
+	var (
+		err  error
+		node *WalletSpend
+	)
+	if len(wsuo.hooks) == 0 {
+		if err = wsuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = wsuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WalletSpendMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = wsuo.check(); err != nil {
+				return nil, err
+			}
+			wsuo.mutation = mutation
+			node, err = wsuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(wsuo.hooks) - 1; i >= 0; i-- {
+			if wsuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = wsuo.hooks[i](mut)
+		}
+		v, err := mut.Mutate(ctx, wsuo.mutation)
+		if err != nil {
+			return nil, err
+		}
+		nv, ok := v.(*WalletSpend)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type %T returned from WalletSpendMutation", v)
+		}
+		node = nv
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wsuo *WalletSpendUpdateOne) SaveX(ctx context.Context) *WalletSpend {
+	node, err := wsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wsuo *WalletSpendUpdateOne) Exec(ctx context.Context) error {
+	_, err := wsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wsuo *WalletSpendUpdateOne) ExecX(ctx context.Context) {
+	if err := wsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wsuo *WalletSpendUpdateOne) check() error {
+	if _, ok := wsuo.mutation.WalletID(); wsuo.mutation.WalletCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "WalletSpend.wallet"`)
+	}
+	return nil
+}
+
+func (wsuo *WalletSpendUpdateOne) sqlSave(ctx context.Context) (_node *WalletSpend, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   walletspend.Table,
+			Columns: walletspend.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: walletspend.FieldID,
+			},
+		},
+	}
+	id, ok := wsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WalletSpend.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, walletspend.FieldID)
+		for _, f := range fields {
+			if !walletspend.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != walletspend.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if wsuo.mutation.WalletCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   walletspend.WalletTable,
+			Columns: []string{walletspend.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wsuo.mutation.WalletIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   walletspend.WalletTable,
+			Columns: []string{walletspend.WalletColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: wallet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WalletSpend{config: wsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{walletspend.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/metrics"
+)
+
+var queryDuration = metrics.NewHistogramVec("pippin_database_query_duration_seconds", "Seconds database statements took, by op: exec or query", metrics.DefaultBuckets, "op")
+
+// Records how long every statement takes, including the ones in transactions
+type timedDriver struct {
+	dialect.Driver
+}
+
+func (d *timedDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	defer queryDuration.ObserveSince(time.Now(), "exec")
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *timedDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	defer queryDuration.ObserveSince(time.Now(), "query")
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *timedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &timedTx{tx}, nil
+}
+
+// Called by ent when the driver supports it, to pass transaction options
+func (d *timedDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return d.Tx(ctx)
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &timedTx{tx}, nil
+}
+
+type timedTx struct {
+	dialect.Tx
+}
+
+func (tx *timedTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	defer queryDuration.ObserveSince(time.Now(), "exec")
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+func (tx *timedTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	defer queryDuration.ObserveSince(time.Now(), "query")
+	return tx.Tx.Query(ctx, query, args, v)
+}
@@ -0,0 +1,34 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryDuration(t *testing.T) {
+	ctx := context.Background()
+	client, err := NewEntClient(&SqliteConn{FileName: "metrics", Mode: "memory"})
+	assert.Nil(t, err)
+	defer client.Close()
+	assert.Nil(t, client.Schema.Create(ctx))
+
+	execs := queryDuration.Count("exec")
+	queries := queryDuration.Count("query")
+	_, err = client.Wallet.Create().SetSeed("seed").Save(ctx)
+	assert.Nil(t, err)
+	_, err = client.Wallet.Query().Count(ctx)
+	assert.Nil(t, err)
+	assert.Greater(t, queryDuration.Count("exec")+queryDuration.Count("query"), execs+queries+1)
+	assert.Greater(t, queryDuration.Count("query"), queries)
+
+	// Statements in transactions too
+	queries = queryDuration.Count("query")
+	tx, err := client.Tx(ctx)
+	assert.Nil(t, err)
+	_, err = tx.Wallet.Query().Count(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, tx.Commit())
+	assert.Equal(t, queries+1, queryDuration.Count("query"))
+}
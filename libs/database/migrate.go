@@ -0,0 +1,376 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/migrate"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+// The schema is changed by numbered migrations, applied in order on startup and recorded in schema_migrations
+// A migration is recorded once it's applied, so one that fails halfway is run again next time, they have to be safe to re-run
+// Databases from before migrations have the tables but no schema_migrations, the first migration adds what's missing to them
+
+var ErrIrreversibleMigration = errors.New("migration can't be rolled back")
+var ErrUnknownMigration = errors.New("database has migrations this version of pippin doesn't know, it was migrated by a newer one")
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, client *ent.Client, db *sql.DB) error
+	// Nil if it can't be rolled back
+	Down func(ctx context.Context, client *ent.Client, db *sql.DB) error
+}
+
+// Add new ones at the end with the next version, never change or remove one that was released
+// Each one changes the tables by itself, not with client.Schema.Create, so it does the same thing whatever the ent schema is now
+var Migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "initial_schema",
+		// Creates the tables, or adds what's missing to the ones from before migrations
+		Up: func(ctx context.Context, client *ent.Client, db *sql.DB) error {
+			return migrate.Create(ctx, client.Schema, initialTables())
+		},
+		// Children first, so no foreign key points to a dropped table
+		Down: func(ctx context.Context, client *ent.Client, db *sql.DB) error {
+			return dropTables(ctx, db, "balance_snapshots", "representative_history", "blocks", "wallet_snapshots", "accounts", "balance_alerts", "idempotency_keys", "idempotent_sends", "jobs", "send_approvals", "send_schedules", "wallet_spends", "wallets", "api_keys", "audit_records")
+		},
+	},
+	{
+		Version: 2,
+		Name:    "block_send_id_per_wallet",
+		// Saved sends get the wallet of their account, their send_id is unique in the wallet instead of the account
+		Up: func(ctx context.Context, client *ent.Client, db *sql.DB) error {
+			if err := migrate.Create(ctx, client.Schema, blockSendIDPerWalletTables(), migrate.WithDropIndex(true)); err != nil {
+				return err
+			}
+			return setBlockWallets(ctx, client)
+		},
+		// Drops blocks.wallet_id and makes send_id unique per account again
+		Down: func(ctx context.Context, client *ent.Client, db *sql.DB) error {
+			if err := releaseAccountSendIDs(ctx, client, db); err != nil {
+				return err
+			}
+			tables := initialTables()
+			return migrate.Create(ctx, client.Schema, []*schema.Table{findTable(tables, "wallets"), findTable(tables, "accounts"), findTable(tables, "blocks")}, migrate.WithDropIndex(true), migrate.WithDropColumn(true))
+		},
+	},
+	{
+		Version: 3,
+		Name:    "send_schedule_last_failure",
+		Up: func(ctx context.Context, client *ent.Client, db *sql.DB) error {
+			return migrate.Create(ctx, client.Schema, sendScheduleFailureTables())
+		},
+		Down: func(ctx context.Context, client *ent.Client, db *sql.DB) error {
+			tables := initialTables()
+			return migrate.Create(ctx, client.Schema, []*schema.Table{findTable(tables, "wallets"), findTable(tables, "send_schedules")}, migrate.WithDropColumn(true))
+		},
+	},
+}
+
+func dropTables(ctx context.Context, db *sql.DB, tables ...string) error {
+	for _, table := range tables {
+		if _, err := db.ExecContext(ctx, "DROP TABLE IF EXISTS "+table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Set the wallet of saved sends that don't have one
+// A send_id that more than one account of a wallet used is left on the first, the others keep theirs per account
+func setBlockWallets(ctx context.Context, client *ent.Client) error {
+	blocks, err := client.Block.Query().Where(block.SendIDNotNil(), block.WalletIDIsNil(), block.AccountIDNotNil()).WithAccount().Order(ent.Asc(block.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, b := range blocks {
+		if b.Edges.Account == nil {
+			continue
+		}
+		err := b.Update().SetWalletID(b.Edges.Account.WalletID).Exec(ctx)
+		if ent.IsConstraintError(err) {
+			log.Warnf("send_id %s of block %s is used by another account of wallet %s", *b.SendID, b.BlockHash, b.Edges.Account.WalletID)
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// An account moved to another wallet can have sends with the same send_id, one per wallet
+// The one in its current wallet keeps it, the others lose theirs so send_id can be unique per account
+func releaseAccountSendIDs(ctx context.Context, client *ent.Client, db *sql.DB) error {
+	blocks, err := client.Block.Query().Where(block.SendIDNotNil(), block.AccountIDNotNil()).Order(ent.Asc(block.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return err
+	}
+	kept := map[string]*ent.Block{}
+	for _, b := range blocks {
+		key := b.AccountID.String() + "/" + *b.SendID
+		prev, ok := kept[key]
+		if !ok {
+			kept[key] = b
+			continue
+		}
+		release := b
+		if prev.WalletID == nil && b.WalletID != nil {
+			kept[key], release = b, prev
+		}
+		log.Warnf("send_id %s of block %s is used by another send of account %s, it's removed", *release.SendID, release.BlockHash, *b.AccountID)
+		// send_id is immutable in the ent schema, the ID is a UUID so there's nothing to escape
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("UPDATE blocks SET send_id = NULL WHERE id = '%s'", release.ID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A migration and when it was applied, nil if it's pending
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	client *ent.Client
+	db     *sql.DB
+	conn   SqlDBConn
+	// The package's Migrations, unless others are set
+	Migrations []Migration
+	// Where SQLite backups are written, next to the database by default
+	BackupDir string
+}
+
+// A migrator for the database of connInfo, client has to use the same database
+func NewMigrator(connInfo SqlDBConn, client *ent.Client) (*Migrator, error) {
+	db, err := sql.Open(connInfo.Driver(), connInfo.DSN())
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{client: client, db: db, conn: connInfo, Migrations: Migrations}, nil
+}
+
+// Apply the pending migrations to the database of connInfo, like on startup
+func Migrate(ctx context.Context, connInfo SqlDBConn, client *ent.Client) error {
+	migrator, err := NewMigrator(connInfo, client)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+	_, err = migrator.Up(ctx)
+	return err
+}
+
+func (m *Migrator) Close() error {
+	return m.db.Close()
+}
+
+// Postgres numbers its placeholders, the others don't
+func (m *Migrator) placeholder(n int) string {
+	if m.conn.Dialect() == dialect.Postgres {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+func (m *Migrator) createTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY, name VARCHAR(255) NOT NULL, applied_at BIGINT NOT NULL)")
+	return err
+}
+
+// The applied versions and when, in unix seconds
+func (m *Migrator) applied(ctx context.Context) (map[int]int64, error) {
+	if err := m.createTable(ctx); err != nil {
+		return nil, err
+	}
+	rows, err := m.db.QueryContext(ctx, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	applied := map[int]int64{}
+	for rows.Next() {
+		var version int
+		var appliedAt int64
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+func (m *Migrator) known(version int) bool {
+	for _, migration := range m.Migrations {
+		if migration.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// Every known migration and any applied ones that aren't known, by version
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := []MigrationStatus{}
+	for _, migration := range m.Migrations {
+		s := MigrationStatus{Version: migration.Version, Name: migration.Name}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			s.AppliedAt = toTime(appliedAt)
+		}
+		status = append(status, s)
+	}
+	for version, appliedAt := range applied {
+		if !m.known(version) {
+			status = append(status, MigrationStatus{Version: version, Name: "unknown", AppliedAt: toTime(appliedAt)})
+		}
+	}
+	sort.SliceStable(status, func(i, j int) bool {
+		return status[i].Version < status[j].Version
+	})
+	return status, nil
+}
+
+func toTime(unix int64) *time.Time {
+	t := time.Unix(unix, 0)
+	return &t
+}
+
+// The highest applied version, 0 if none are
+func (m *Migrator) Version(ctx context.Context) (int, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	version := 0
+	for v := range applied {
+		version = max(version, v)
+	}
+	return version, nil
+}
+
+// Apply the pending migrations, returns how many were applied
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for version := range applied {
+		if !m.known(version) {
+			return 0, fmt.Errorf("%w: version %d", ErrUnknownMigration, version)
+		}
+	}
+	pending := []Migration{}
+	for _, migration := range m.Migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	if len(pending) == 0 {
+		return 0, nil
+	}
+	if err := m.backup(ctx); err != nil {
+		return 0, err
+	}
+	for i, migration := range pending {
+		log.Infof("Applying migration %d %s", migration.Version, migration.Name)
+		if err := migration.Up(WithPrimary(ctx), m.client, m.db); err != nil {
+			return i, fmt.Errorf("migration %d %s: %w", migration.Version, migration.Name, err)
+		}
+		if _, err := m.db.ExecContext(ctx, fmt.Sprintf("INSERT INTO schema_migrations (version, name, applied_at) VALUES (%s, %s, %s)", m.placeholder(1), m.placeholder(2), m.placeholder(3)), migration.Version, migration.Name, time.Now().Unix()); err != nil {
+			return i, err
+		}
+	}
+	return len(pending), nil
+}
+
+// Roll back the last steps applied migrations, newest first, returns how many were rolled back
+// Stops at the first one that can't be rolled back
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for version := range applied {
+		if !m.known(version) {
+			return 0, fmt.Errorf("%w: version %d", ErrUnknownMigration, version)
+		}
+	}
+	toRollBack := []Migration{}
+	for i := len(m.Migrations) - 1; i >= 0 && len(toRollBack) < steps; i-- {
+		if _, ok := applied[m.Migrations[i].Version]; ok {
+			toRollBack = append(toRollBack, m.Migrations[i])
+		}
+	}
+	if len(toRollBack) == 0 {
+		return 0, nil
+	}
+	if toRollBack[0].Down == nil {
+		return 0, fmt.Errorf("%w: %d %s", ErrIrreversibleMigration, toRollBack[0].Version, toRollBack[0].Name)
+	}
+	if err := m.backup(ctx); err != nil {
+		return 0, err
+	}
+	for i, migration := range toRollBack {
+		if migration.Down == nil {
+			return i, fmt.Errorf("%w: %d %s", ErrIrreversibleMigration, migration.Version, migration.Name)
+		}
+		log.Infof("Rolling back migration %d %s", migration.Version, migration.Name)
+		if err := migration.Down(WithPrimary(ctx), m.client, m.db); err != nil {
+			return i, fmt.Errorf("migration %d %s: %w", migration.Version, migration.Name, err)
+		}
+		if _, err := m.db.ExecContext(ctx, fmt.Sprintf("DELETE FROM schema_migrations WHERE version = %s", m.placeholder(1)), migration.Version); err != nil {
+			return i, err
+		}
+	}
+	return len(toRollBack), nil
+}
+
+// Copy a SQLite database file before it's migrated, e.g. pippingo.db.backup-v1-1712345678
+// Nothing is copied for in-memory databases, new ones without tables yet, or postgres and mysql, those are backed up the usual way
+func (m *Migrator) backup(ctx context.Context) error {
+	sqlite, ok := m.conn.(*SqliteConn)
+	if !ok || sqlite.Mode == "memory" {
+		return nil
+	}
+	var tables int
+	if err := m.db.QueryRowContext(ctx, "SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name != 'schema_migrations'").Scan(&tables); err != nil {
+		return err
+	} else if tables == 0 {
+		return nil
+	}
+	version, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+	backupPath := fmt.Sprintf("%s.backup-v%d-%d", sqlite.FileName, version, time.Now().Unix())
+	if m.BackupDir != "" {
+		backupPath = filepath.Join(m.BackupDir, filepath.Base(backupPath))
+	}
+	if _, err := os.Stat(backupPath); err == nil {
+		return fmt.Errorf("backup %s already exists", backupPath)
+	}
+	// A consistent copy even while something else has the database open
+	if _, err := m.db.ExecContext(ctx, "VACUUM INTO ?", backupPath); err != nil {
+		return fmt.Errorf("unable to back up the database to %s: %w", backupPath, err)
+	}
+	log.Infof("Backed up the database to %s", backupPath)
+	return nil
+}
@@ -0,0 +1,542 @@
+package database
+
+import (
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// The tables of migration 1, as ent generated them then
+// Frozen so changing the ent schema doesn't change what migration 1 creates, changes go in new migrations
+func initialTables() []*schema.Table {
+	accountsColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "address", Type: field.TypeString, Size: 65},
+		{Name: "account_index", Type: field.TypeInt, Nullable: true},
+		{Name: "private_key", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "seed", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "seed_index", Type: field.TypeInt, Nullable: true},
+		{Name: "work", Type: field.TypeBool, Default: true},
+		{Name: "watch_only", Type: field.TypeBool, Default: false},
+		{Name: "label", Type: field.TypeString, Nullable: true, Size: 256},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	apiKeysColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "key_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"read", "send", "admin"}},
+		{Name: "approver", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+	}
+	auditRecordsColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "action", Type: field.TypeString, Size: 64},
+		{Name: "wallet", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "ip", Type: field.TypeString, Size: 64},
+		{Name: "api_key_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "api_key_name", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "params", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeInt},
+		{Name: "error_code", Type: field.TypeString, Nullable: true, Size: 64},
+	}
+	balanceAlertsColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "account", Type: field.TypeString, Size: 65},
+		{Name: "threshold", Type: field.TypeString, Size: 64},
+		{Name: "direction", Type: field.TypeEnum, Enums: []string{"above", "below"}},
+		{Name: "callback_url", Type: field.TypeString},
+		{Name: "fired", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	balanceSnapshotsColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "balance_raw", Type: field.TypeString, Size: 64},
+		{Name: "snapshot_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeUUID},
+		{Name: "wallet_snapshot_id", Type: field.TypeUUID, Nullable: true},
+	}
+	blocksColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "block_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "block", Type: field.TypeJSON},
+		{Name: "send_id", Type: field.TypeString, Nullable: true, Size: 256},
+		{Name: "subtype", Type: field.TypeString, Size: 10},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeUUID, Nullable: true},
+	}
+	idempotencyKeysColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "accounts", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	idempotentSendsColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "send_id", Type: field.TypeString, Size: 256},
+		{Name: "source", Type: field.TypeString, Size: 65},
+		{Name: "destination", Type: field.TypeString, Size: 65},
+		{Name: "amount", Type: field.TypeString, Size: 64},
+		{Name: "block_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent", "failed"}, Default: "pending"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	jobsColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "action", Type: field.TypeString, Size: 64},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "done", "failed"}, Default: "pending"},
+		{Name: "percent", Type: field.TypeInt, Default: 0},
+		{Name: "result", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	representativeHistoryColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "old_representative", Type: field.TypeString, Size: 65},
+		{Name: "new_representative", Type: field.TypeString, Size: 65},
+		{Name: "block_hash", Type: field.TypeString, Size: 64},
+		{Name: "changed_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeUUID},
+	}
+	sendApprovalsColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "send_id", Type: field.TypeString, Nullable: true, Size: 256},
+		{Name: "source", Type: field.TypeString, Size: 65},
+		{Name: "destination", Type: field.TypeString, Size: 65},
+		{Name: "amount", Type: field.TypeString, Size: 64},
+		{Name: "work", Type: field.TypeString, Nullable: true, Size: 16},
+		{Name: "approvals_required", Type: field.TypeInt},
+		{Name: "approved_by", Type: field.TypeJSON, Nullable: true},
+		{Name: "requested_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "sent", "rejected"}, Default: "pending"},
+		{Name: "block_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	sendSchedulesColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "source", Type: field.TypeString, Size: 65},
+		{Name: "destination", Type: field.TypeString, Size: 65},
+		{Name: "amount", Type: field.TypeString, Size: 64},
+		{Name: "interval_seconds", Type: field.TypeInt},
+		{Name: "next_run_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	walletsColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "seed", Type: field.TypeString, Unique: true, Size: 512},
+		{Name: "representative", Type: field.TypeString, Nullable: true, Size: 65},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "encrypted", Type: field.TypeBool, Default: false},
+		{Name: "work", Type: field.TypeBool, Default: true},
+		{Name: "watch_only", Type: field.TypeBool, Default: false},
+		{Name: "hardware", Type: field.TypeBool, Default: false},
+		{Name: "auto_receive", Type: field.TypeBool, Default: true},
+		{Name: "receive_minimum", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "frozen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "kdf", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "approvals_required", Type: field.TypeInt, Nullable: true},
+		{Name: "approval_threshold", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	walletSnapshotsColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "label", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "total_raw", Type: field.TypeString, Size: 64},
+		{Name: "account_count", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	walletSpendsColumns := []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "amount", Type: field.TypeString, Size: 64},
+		{Name: "block_hash", Type: field.TypeString, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wallet_id", Type: field.TypeUUID},
+	}
+	accountsTable := &schema.Table{
+		Name:       "accounts",
+		Columns:    accountsColumns,
+		PrimaryKey: []*schema.Column{accountsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "accounts_wallets_accounts",
+				Columns:    []*schema.Column{accountsColumns[11]},
+				RefColumns: []*schema.Column{walletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "account_wallet_id",
+				Unique:  false,
+				Columns: []*schema.Column{accountsColumns[11]},
+			},
+			{
+				Name:    "account_wallet_id_address",
+				Unique:  true,
+				Columns: []*schema.Column{accountsColumns[11], accountsColumns[1]},
+			},
+		},
+	}
+	apiKeysTable := &schema.Table{
+		Name:       "api_keys",
+		Columns:    apiKeysColumns,
+		PrimaryKey: []*schema.Column{apiKeysColumns[0]},
+	}
+	auditRecordsTable := &schema.Table{
+		Name:       "audit_records",
+		Columns:    auditRecordsColumns,
+		PrimaryKey: []*schema.Column{auditRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditrecord_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{auditRecordsColumns[1]},
+			},
+			{
+				Name:    "auditrecord_wallet_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{auditRecordsColumns[3], auditRecordsColumns[1]},
+			},
+		},
+	}
+	balanceAlertsTable := &schema.Table{
+		Name:       "balance_alerts",
+		Columns:    balanceAlertsColumns,
+		PrimaryKey: []*schema.Column{balanceAlertsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "balance_alerts_wallets_balance_alerts",
+				Columns:    []*schema.Column{balanceAlertsColumns[7]},
+				RefColumns: []*schema.Column{walletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "balancealert_wallet_id",
+				Unique:  false,
+				Columns: []*schema.Column{balanceAlertsColumns[7]},
+			},
+		},
+	}
+	balanceSnapshotsTable := &schema.Table{
+		Name:       "balance_snapshots",
+		Columns:    balanceSnapshotsColumns,
+		PrimaryKey: []*schema.Column{balanceSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "balance_snapshots_accounts_balance_snapshots",
+				Columns:    []*schema.Column{balanceSnapshotsColumns[3]},
+				RefColumns: []*schema.Column{accountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "balance_snapshots_wallet_snapshots_balances",
+				Columns:    []*schema.Column{balanceSnapshotsColumns[4]},
+				RefColumns: []*schema.Column{walletSnapshotsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "balancesnapshot_account_id_snapshot_at",
+				Unique:  false,
+				Columns: []*schema.Column{balanceSnapshotsColumns[3], balanceSnapshotsColumns[2]},
+			},
+		},
+	}
+	blocksTable := &schema.Table{
+		Name:       "blocks",
+		Columns:    blocksColumns,
+		PrimaryKey: []*schema.Column{blocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blocks_accounts_blocks",
+				Columns:    []*schema.Column{blocksColumns[6]},
+				RefColumns: []*schema.Column{accountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "block_account_id_send_id",
+				Unique:  true,
+				Columns: []*schema.Column{blocksColumns[6], blocksColumns[3]},
+			},
+		},
+	}
+	idempotencyKeysTable := &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    idempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{idempotencyKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "idempotency_keys_wallets_idempotency_keys",
+				Columns:    []*schema.Column{idempotencyKeysColumns[3]},
+				RefColumns: []*schema.Column{walletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{idempotencyKeysColumns[2]},
+			},
+		},
+	}
+	idempotentSendsTable := &schema.Table{
+		Name:       "idempotent_sends",
+		Columns:    idempotentSendsColumns,
+		PrimaryKey: []*schema.Column{idempotentSendsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "idempotent_sends_wallets_idempotent_sends",
+				Columns:    []*schema.Column{idempotentSendsColumns[8]},
+				RefColumns: []*schema.Column{walletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotentsend_wallet_id_send_id",
+				Unique:  true,
+				Columns: []*schema.Column{idempotentSendsColumns[8], idempotentSendsColumns[1]},
+			},
+			{
+				Name:    "idempotentsend_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{idempotentSendsColumns[7]},
+			},
+		},
+	}
+	jobsTable := &schema.Table{
+		Name:       "jobs",
+		Columns:    jobsColumns,
+		PrimaryKey: []*schema.Column{jobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "jobs_wallets_jobs",
+				Columns:    []*schema.Column{jobsColumns[8]},
+				RefColumns: []*schema.Column{walletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "job_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{jobsColumns[6]},
+			},
+		},
+	}
+	representativeHistoryTable := &schema.Table{
+		Name:       "representative_history",
+		Columns:    representativeHistoryColumns,
+		PrimaryKey: []*schema.Column{representativeHistoryColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "representative_history_accounts_representative_history",
+				Columns:    []*schema.Column{representativeHistoryColumns[5]},
+				RefColumns: []*schema.Column{accountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "representativehistory_account_id_changed_at",
+				Unique:  false,
+				Columns: []*schema.Column{representativeHistoryColumns[5], representativeHistoryColumns[4]},
+			},
+		},
+	}
+	sendApprovalsTable := &schema.Table{
+		Name:       "send_approvals",
+		Columns:    sendApprovalsColumns,
+		PrimaryKey: []*schema.Column{sendApprovalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "send_approvals_wallets_send_approvals",
+				Columns:    []*schema.Column{sendApprovalsColumns[13]},
+				RefColumns: []*schema.Column{walletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sendapproval_wallet_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{sendApprovalsColumns[13], sendApprovalsColumns[9]},
+			},
+			{
+				Name:    "sendapproval_wallet_id_send_id",
+				Unique:  false,
+				Columns: []*schema.Column{sendApprovalsColumns[13], sendApprovalsColumns[1]},
+			},
+		},
+	}
+	sendSchedulesTable := &schema.Table{
+		Name:       "send_schedules",
+		Columns:    sendSchedulesColumns,
+		PrimaryKey: []*schema.Column{sendSchedulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "send_schedules_wallets_send_schedules",
+				Columns:    []*schema.Column{sendSchedulesColumns[7]},
+				RefColumns: []*schema.Column{walletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sendschedule_wallet_id",
+				Unique:  false,
+				Columns: []*schema.Column{sendSchedulesColumns[7]},
+			},
+			{
+				Name:    "sendschedule_next_run_at",
+				Unique:  false,
+				Columns: []*schema.Column{sendSchedulesColumns[5]},
+			},
+		},
+	}
+	walletsTable := &schema.Table{
+		Name:       "wallets",
+		Columns:    walletsColumns,
+		PrimaryKey: []*schema.Column{walletsColumns[0]},
+	}
+	walletSnapshotsTable := &schema.Table{
+		Name:       "wallet_snapshots",
+		Columns:    walletSnapshotsColumns,
+		PrimaryKey: []*schema.Column{walletSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wallet_snapshots_wallets_wallet_snapshots",
+				Columns:    []*schema.Column{walletSnapshotsColumns[5]},
+				RefColumns: []*schema.Column{walletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "walletsnapshot_wallet_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{walletSnapshotsColumns[5], walletSnapshotsColumns[4]},
+			},
+		},
+	}
+	walletSpendsTable := &schema.Table{
+		Name:       "wallet_spends",
+		Columns:    walletSpendsColumns,
+		PrimaryKey: []*schema.Column{walletSpendsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wallet_spends_wallets_spends",
+				Columns:    []*schema.Column{walletSpendsColumns[4]},
+				RefColumns: []*schema.Column{walletsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "walletspend_wallet_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{walletSpendsColumns[4], walletSpendsColumns[3]},
+			},
+		},
+	}
+	accountsTable.ForeignKeys[0].RefTable = walletsTable
+	balanceAlertsTable.ForeignKeys[0].RefTable = walletsTable
+	balanceSnapshotsTable.ForeignKeys[0].RefTable = accountsTable
+	balanceSnapshotsTable.ForeignKeys[1].RefTable = walletSnapshotsTable
+	blocksTable.ForeignKeys[0].RefTable = accountsTable
+	idempotencyKeysTable.ForeignKeys[0].RefTable = walletsTable
+	idempotentSendsTable.ForeignKeys[0].RefTable = walletsTable
+	jobsTable.ForeignKeys[0].RefTable = walletsTable
+	representativeHistoryTable.ForeignKeys[0].RefTable = accountsTable
+	sendApprovalsTable.ForeignKeys[0].RefTable = walletsTable
+	sendSchedulesTable.ForeignKeys[0].RefTable = walletsTable
+	walletSnapshotsTable.ForeignKeys[0].RefTable = walletsTable
+	walletSpendsTable.ForeignKeys[0].RefTable = walletsTable
+	return []*schema.Table{
+		accountsTable,
+		apiKeysTable,
+		auditRecordsTable,
+		balanceAlertsTable,
+		balanceSnapshotsTable,
+		blocksTable,
+		idempotencyKeysTable,
+		idempotentSendsTable,
+		jobsTable,
+		representativeHistoryTable,
+		sendApprovalsTable,
+		sendSchedulesTable,
+		walletsTable,
+		walletSnapshotsTable,
+		walletSpendsTable,
+	}
+}
+
+// The tables of migration 2, blocks get a wallet and send_id is unique in it instead of the account
+// Only the tables blocks references, the others don't change
+func blockSendIDPerWalletTables() []*schema.Table {
+	tables := initialTables()
+	wallets, accounts, blocks := findTable(tables, "wallets"), findTable(tables, "accounts"), findTable(tables, "blocks")
+	walletID := &schema.Column{Name: "wallet_id", Type: field.TypeUUID, Nullable: true}
+	blocks.Columns = append(blocks.Columns, walletID)
+	blocks.ForeignKeys = append(blocks.ForeignKeys, &schema.ForeignKey{
+		Symbol:     "blocks_wallets_blocks",
+		Columns:    []*schema.Column{walletID},
+		RefColumns: []*schema.Column{wallets.Columns[0]},
+		RefTable:   wallets,
+		OnDelete:   schema.Cascade,
+	})
+	blocks.Indexes = []*schema.Index{
+		{
+			Name:    "block_wallet_id_send_id",
+			Unique:  true,
+			Columns: []*schema.Column{walletID, findColumn(blocks, "send_id")},
+		},
+	}
+	return []*schema.Table{wallets, accounts, blocks}
+}
+
+// The tables of migration 3, send schedules record their last failure
+func sendScheduleFailureTables() []*schema.Table {
+	tables := initialTables()
+	wallets, schedules := findTable(tables, "wallets"), findTable(tables, "send_schedules")
+	schedules.Columns = append(schedules.Columns,
+		&schema.Column{Name: "last_error", Type: field.TypeString, Nullable: true},
+		&schema.Column{Name: "last_failed_at", Type: field.TypeTime, Nullable: true},
+	)
+	return []*schema.Table{wallets, schedules}
+}
+
+func findTable(tables []*schema.Table, name string) *schema.Table {
+	for _, t := range tables {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+func findColumn(table *schema.Table, name string) *schema.Column {
+	for _, c := range table.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+// A migration that adds a table and drops it when it's rolled back
+func tableMigration(version int, table string) Migration {
+	return Migration{
+		Version: version,
+		Name:    "create_" + table,
+		Up: func(ctx context.Context, client *ent.Client, db *sql.DB) error {
+			_, err := db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+table+" (id INTEGER)")
+			return err
+		},
+		Down: func(ctx context.Context, client *ent.Client, db *sql.DB) error {
+			_, err := db.ExecContext(ctx, "DROP TABLE "+table)
+			return err
+		},
+	}
+}
+
+func tableExists(t *testing.T, db *sql.DB, table string) bool {
+	var count int
+	assert.Nil(t, db.QueryRow("SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&count))
+	return count > 0
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	conn := &SqliteConn{FileName: "migrator", Mode: "memory"}
+	client, err := NewEntClient(conn)
+	assert.Nil(t, err)
+	defer client.Close()
+	migrator, err := NewMigrator(conn, client)
+	assert.Nil(t, err)
+	defer migrator.Close()
+	n := len(Migrations)
+	migrator.Migrations = append(Migrations, tableMigration(n+1, "migrator_first"), tableMigration(n+2, "migrator_second"))
+
+	version, err := migrator.Version(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, version)
+	status, err := migrator.Status(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, status, n+2)
+	assert.Equal(t, "initial_schema", status[0].Name)
+	assert.Nil(t, status[0].AppliedAt)
+
+	// Everything pending is applied, once
+	applied, err := migrator.Up(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, n+2, applied)
+	_, err = client.Wallet.Create().SetSeed("seed").Save(ctx)
+	assert.Nil(t, err)
+	assert.True(t, tableExists(t, migrator.db, "migrator_second"))
+	applied, err = migrator.Up(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, applied)
+	version, err = migrator.Version(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, n+2, version)
+	status, err = migrator.Status(ctx)
+	assert.Nil(t, err)
+	for _, s := range status {
+		assert.NotNil(t, s.AppliedAt)
+	}
+
+	// Rolled back newest first
+	rolledBack, err := migrator.Down(ctx, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, rolledBack)
+	assert.False(t, tableExists(t, migrator.db, "migrator_second"))
+	assert.True(t, tableExists(t, migrator.db, "migrator_first"))
+	version, _ = migrator.Version(ctx)
+	assert.Equal(t, n+1, version)
+	// Stops at one that can't be rolled back
+	migrator.Migrations[n].Down = nil
+	rolledBack, err = migrator.Down(ctx, 5)
+	assert.ErrorIs(t, err, ErrIrreversibleMigration)
+	assert.Equal(t, 0, rolledBack)
+	version, _ = migrator.Version(ctx)
+	assert.Equal(t, n+1, version)
+	count, err := client.Wallet.Query().Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	migrator.Migrations[n] = tableMigration(n+1, "migrator_first")
+	rolledBack, err = migrator.Down(ctx, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, rolledBack)
+
+	// And applied again
+	applied, err = migrator.Up(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, applied)
+
+	// An older pippin doesn't touch a database migrated by a newer one
+	migrator.Migrations = Migrations
+	_, err = migrator.Up(ctx)
+	assert.ErrorIs(t, err, ErrUnknownMigration)
+	_, err = migrator.Down(ctx, 1)
+	assert.ErrorIs(t, err, ErrUnknownMigration)
+	status, err = migrator.Status(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, status, n+2)
+	assert.Equal(t, "unknown", status[n+1].Name)
+}
+
+func TestMigratorBackup(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	conn := &SqliteConn{FileName: filepath.Join(dir, "pippin.db"), Mode: "rwc"}
+	client, err := NewEntClient(conn)
+	assert.Nil(t, err)
+	defer client.Close()
+	migrator, err := NewMigrator(conn, client)
+	assert.Nil(t, err)
+	defer migrator.Close()
+
+	// Nothing to back up in a new database
+	_, err = migrator.Up(ctx)
+	assert.Nil(t, err)
+	backups, _ := filepath.Glob(filepath.Join(dir, "pippin.db.backup-*"))
+	assert.Len(t, backups, 0)
+
+	_, err = client.Wallet.Create().SetSeed("seed").Save(ctx)
+	assert.Nil(t, err)
+	migrator.Migrations = append(Migrations, tableMigration(len(Migrations)+1, "backup_table"))
+	migrator.BackupDir = filepath.Join(dir, "backups")
+	assert.Nil(t, os.Mkdir(migrator.BackupDir, 0700))
+	_, err = migrator.Up(ctx)
+	assert.Nil(t, err)
+	backups, _ = filepath.Glob(filepath.Join(dir, "backups", fmt.Sprintf("pippin.db.backup-v%d-*", len(Migrations))))
+	assert.Len(t, backups, 1)
+
+	// The backup is the database before the migration
+	backup, err := sql.Open("sqlite", backups[0])
+	assert.Nil(t, err)
+	defer backup.Close()
+	var seeds int
+	assert.Nil(t, backup.QueryRow("SELECT count(*) FROM wallets").Scan(&seeds))
+	assert.Equal(t, 1, seeds)
+	assert.False(t, tableExists(t, backup, "backup_table"))
+	assert.True(t, tableExists(t, migrator.db, "backup_table"))
+}
+
+func TestSetBlockWallets(t *testing.T) {
+	ctx := context.Background()
+	conn := &SqliteConn{FileName: "block_wallets", Mode: "memory"}
+	client, err := NewEntClient(conn)
+	assert.Nil(t, err)
+	defer client.Close()
+	assert.Nil(t, client.Schema.Create(ctx))
+
+	wallet, err := client.Wallet.Create().SetSeed("block_wallets").Save(ctx)
+	assert.Nil(t, err)
+	first, err := client.Account.Create().SetWallet(wallet).SetAddress("nano_first").Save(ctx)
+	assert.Nil(t, err)
+	second, err := client.Account.Create().SetWallet(wallet).SetAddress("nano_second").Save(ctx)
+	assert.Nil(t, err)
+	// Saved before send ids were per wallet
+	saved, err := client.Block.Create().SetAccount(first).SetBlockHash("A").SetBlock(map[string]interface{}{}).SetSubtype("send").SetSendID("payout").Save(ctx)
+	assert.Nil(t, err)
+	reused, err := client.Block.Create().SetAccount(second).SetBlockHash("B").SetBlock(map[string]interface{}{}).SetSubtype("send").SetSendID("payout").Save(ctx)
+	assert.Nil(t, err)
+	other, err := client.Block.Create().SetAccount(second).SetBlockHash("C").SetBlock(map[string]interface{}{}).SetSubtype("send").SetSendID("refund").Save(ctx)
+	assert.Nil(t, err)
+
+	assert.Nil(t, setBlockWallets(ctx, client))
+	saved = client.Block.GetX(ctx, saved.ID)
+	assert.Equal(t, wallet.ID, *saved.WalletID)
+	other = client.Block.GetX(ctx, other.ID)
+	assert.Equal(t, wallet.ID, *other.WalletID)
+	// The id was already the first account's
+	reused = client.Block.GetX(ctx, reused.ID)
+	assert.Nil(t, reused.WalletID)
+
+	// A send with the id in the wallet is refused now
+	_, err = client.Block.Create().SetAccount(second).SetWallet(wallet).SetBlockHash("D").SetBlock(map[string]interface{}{}).SetSubtype("send").SetSendID("refund").Save(ctx)
+	assert.True(t, ent.IsConstraintError(err))
+}
+
+func indexExists(t *testing.T, db *sql.DB, index string) bool {
+	var count int
+	assert.Nil(t, db.QueryRow("SELECT count(*) FROM sqlite_master WHERE type = 'index' AND name = ?", index).Scan(&count))
+	return count > 0
+}
+
+func columnExists(t *testing.T, db *sql.DB, table string, column string) bool {
+	var count int
+	assert.Nil(t, db.QueryRow("SELECT count(*) FROM pragma_table_info(?) WHERE name = ?", table, column).Scan(&count))
+	return count > 0
+}
+
+func TestMigrationsUpDownUp(t *testing.T) {
+	ctx := context.Background()
+	conn := &SqliteConn{FileName: "up_down_up", Mode: "memory"}
+	client, err := NewEntClient(conn)
+	assert.Nil(t, err)
+	defer client.Close()
+	migrator, err := NewMigrator(conn, client)
+	assert.Nil(t, err)
+	defer migrator.Close()
+
+	applied, err := migrator.Up(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, len(Migrations), applied)
+	// The migrations end up with the ent schema, there's nothing left for it to change
+	var plan bytes.Buffer
+	assert.Nil(t, client.Schema.WriteTo(ctx, &plan))
+	assert.NotContains(t, plan.String(), "CREATE")
+	assert.NotContains(t, plan.String(), "ALTER")
+
+	wallet, err := client.Wallet.Create().SetSeed("up_down_up").Save(ctx)
+	assert.Nil(t, err)
+	acc, err := client.Account.Create().SetWallet(wallet).SetAddress("nano_up_down_up").Save(ctx)
+	assert.Nil(t, err)
+	// Moved to another wallet and sent again with the same id
+	moved, err := client.Block.Create().SetAccount(acc).SetBlockHash("A").SetBlock(map[string]interface{}{}).SetSubtype("send").SetSendID("payout").Save(ctx)
+	assert.Nil(t, err)
+	sent, err := client.Block.Create().SetAccount(acc).SetWallet(wallet).SetBlockHash("B").SetBlock(map[string]interface{}{}).SetSubtype("send").SetSendID("payout").Save(ctx)
+	assert.Nil(t, err)
+
+	// Back to 1, send_id is unique per account again
+	rolledBack, err := migrator.Down(ctx, len(Migrations)-1)
+	assert.Nil(t, err)
+	assert.Equal(t, len(Migrations)-1, rolledBack)
+	assert.False(t, columnExists(t, migrator.db, "send_schedules", "last_error"))
+	assert.False(t, columnExists(t, migrator.db, "blocks", "wallet_id"))
+	assert.False(t, indexExists(t, migrator.db, "block_wallet_id_send_id"))
+	assert.True(t, indexExists(t, migrator.db, "block_account_id_send_id"))
+	var sendID sql.NullString
+	assert.Nil(t, migrator.db.QueryRow("SELECT send_id FROM blocks WHERE id = ?", sent.ID.String()).Scan(&sendID))
+	assert.Equal(t, "payout", sendID.String)
+	assert.Nil(t, migrator.db.QueryRow("SELECT send_id FROM blocks WHERE id = ?", moved.ID.String()).Scan(&sendID))
+	assert.False(t, sendID.Valid)
+
+	// 1 drops every table
+	rolledBack, err = migrator.Down(ctx, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, rolledBack)
+	assert.False(t, tableExists(t, migrator.db, "wallets"))
+	assert.False(t, tableExists(t, migrator.db, "blocks"))
+	version, err := migrator.Version(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, version)
+
+	// And everything is applied again
+	applied, err = migrator.Up(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, len(Migrations), applied)
+	assert.True(t, columnExists(t, migrator.db, "blocks", "wallet_id"))
+	assert.True(t, columnExists(t, migrator.db, "send_schedules", "last_error"))
+	assert.True(t, indexExists(t, migrator.db, "block_wallet_id_send_id"))
+	assert.False(t, indexExists(t, migrator.db, "block_account_id_send_id"))
+	_, err = client.Wallet.Create().SetSeed("up_down_up").Save(ctx)
+	assert.Nil(t, err)
+}
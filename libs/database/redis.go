@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,17 +19,19 @@ import (
 
 var ctx = context.Background()
 
-// Prefix for all keys
-const keyPrefix = "pippin"
+// Default prefix for all keys, so multiple instances can share a redis server with different namespaces
+const defaultNamespace = "pippin"
 
 // Singleton to keep assets loaded in memory
 type redisManager struct {
-	Client *redis.Client
-	Locker *redislock.Client
-	Mock   bool
+	Client    *redis.Client
+	Locker    *redislock.Client
+	Mock      bool
+	Namespace string
 }
 
 var ErrLockNotObtained = errors.New("couldn't obtain lock")
+var ErrKeyOutsideNamespace = errors.New("redis key is outside of the namespace")
 
 // Retry every 100ms, for up-to 3x
 var LockRetryStrategy = redislock.Options{
@@ -40,18 +43,14 @@ var once sync.Once
 
 func GetRedisDB() *redisManager {
 	once.Do(func() {
+		namespace := utils.GetEnv("PIPPIN_REDIS_NAMESPACE", defaultNamespace)
 		if utils.GetEnv("MOCK_REDIS", "false") == "true" {
 			log.Infof("Using mock redis client because MOCK_REDIS=true is set in environment")
 			mr, _ := miniredis.Run()
 			client := redis.NewClient(&redis.Options{
 				Addr: mr.Addr(),
 			})
-			locker := redislock.New(client)
-			singleton = &redisManager{
-				Client: client,
-				Locker: locker,
-				Mock:   true,
-			}
+			singleton = newMockRedisManager(client, namespace)
 		} else {
 			redis_port, err := strconv.Atoi(utils.GetEnv("REDIS_PORT", "6379"))
 			if err != nil {
@@ -67,61 +66,164 @@ func GetRedisDB() *redisManager {
 				Addr: fmt.Sprintf("%s:%d", utils.GetEnv("REDIS_HOST", "localhost"), redis_port),
 				DB:   redis_db,
 			})
-			locker := redislock.New(client)
 			singleton = &redisManager{
-				Client: client,
-				Locker: locker,
-				Mock:   false,
+				Client:    client,
+				Locker:    redislock.New(client),
+				Mock:      false,
+				Namespace: namespace,
 			}
 		}
 	})
 	return singleton
 }
 
+// The mock rejects any command on a key outside of its namespace, so tests catch keys that skip the prefix
+func newMockRedisManager(client *redis.Client, namespace string) *redisManager {
+	client.AddHook(namespaceHook{prefix: namespace + ":"})
+	return &redisManager{
+		Client:    client,
+		Locker:    redislock.New(client),
+		Mock:      true,
+		Namespace: namespace,
+	}
+}
+
+// Prefix a key with the namespace
+func (r *redisManager) Key(key string) string {
+	return fmt.Sprintf("%s:%s", r.Namespace, key)
+}
+
+// Obtain a lock on a key in the namespace
+func (r *redisManager) Obtain(ctx context.Context, key string, ttl time.Duration, opt *redislock.Options) (*redislock.Lock, error) {
+	return r.Locker.Obtain(ctx, r.Key(key), ttl, opt)
+}
+
+type namespaceHook struct {
+	prefix string
+}
+
+// Keys of a command, for the commands we use
+func commandKeys(cmd redis.Cmder) []interface{} {
+	args := cmd.Args()
+	switch cmd.Name() {
+	case "eval", "evalsha":
+		// eval script numkeys key [key ...] arg [arg ...]
+		if len(args) < 3 {
+			return nil
+		}
+		numKeys, ok := args[2].(int)
+		if !ok || len(args) < 3+numKeys {
+			return nil
+		}
+		return args[3 : 3+numKeys]
+	case "ping", "script", "hello", "client", "select":
+		return nil
+	case "scan":
+		// scan cursor [match pattern] [count count], the pattern has to be in the namespace
+		for i := 2; i < len(args)-1; i++ {
+			if arg, ok := args[i].(string); ok && strings.EqualFold(arg, "match") {
+				return args[i+1 : i+2]
+			}
+		}
+		return []interface{}{nil}
+	}
+	if len(args) < 2 {
+		return nil
+	}
+	return args[1:2]
+}
+
+func (h namespaceHook) validate(cmd redis.Cmder) error {
+	for _, key := range commandKeys(cmd) {
+		if k, ok := key.(string); !ok || !strings.HasPrefix(k, h.prefix) {
+			return fmt.Errorf("%w: %v %v", ErrKeyOutsideNamespace, cmd.Name(), key)
+		}
+	}
+	return nil
+}
+
+func (h namespaceHook) BeforeProcess(ctx context.Context, cmd redis.Cmder) (context.Context, error) {
+	return ctx, h.validate(cmd)
+}
+
+func (h namespaceHook) AfterProcess(ctx context.Context, cmd redis.Cmder) error {
+	return nil
+}
+
+func (h namespaceHook) BeforeProcessPipeline(ctx context.Context, cmds []redis.Cmder) (context.Context, error) {
+	for _, cmd := range cmds {
+		if err := h.validate(cmd); err != nil {
+			return ctx, err
+		}
+	}
+	return ctx, nil
+}
+
+func (h namespaceHook) AfterProcessPipeline(ctx context.Context, cmds []redis.Cmder) error {
+	return nil
+}
+
+// ping - Redis PING, whether the server can be reached
+func (r *redisManager) Ping(ctx context.Context) error {
+	return r.Client.Ping(ctx).Err()
+}
+
 // del - Redis DEL
 func (r *redisManager) Del(key string) (int64, error) {
-	val, err := r.Client.Del(ctx, key).Result()
+	val, err := r.Client.Del(ctx, r.Key(key)).Result()
 	return val, err
 }
 
 // get - Redis GET
 func (r *redisManager) Get(key string) (string, error) {
-	val, err := r.Client.Get(ctx, key).Result()
+	val, err := r.Client.Get(ctx, r.Key(key)).Result()
 	return val, err
 }
 
 // set - Redis SET
 func (r *redisManager) Set(key string, value string, expiry time.Duration) error {
-	err := r.Client.Set(ctx, key, value, expiry).Err()
+	err := r.Client.Set(ctx, r.Key(key), value, expiry).Err()
 	return err
 }
 
+// setnx - Redis SETNX, returns true if the key was set
+func (r *redisManager) SetNX(key string, value string, expiry time.Duration) (bool, error) {
+	val, err := r.Client.SetNX(ctx, r.Key(key), value, expiry).Result()
+	return val, err
+}
+
+// expire - Redis EXPIRE, false if the key doesn't exist
+func (r *redisManager) Expire(key string, expiry time.Duration) (bool, error) {
+	val, err := r.Client.Expire(ctx, r.Key(key), expiry).Result()
+	return val, err
+}
+
 // hlen - Redis HLEN
 func (r *redisManager) Hlen(key string) (int64, error) {
-	val, err := r.Client.HLen(ctx, key).Result()
+	val, err := r.Client.HLen(ctx, r.Key(key)).Result()
 	return val, err
 }
 
 // hget - Redis HGET
 func (r *redisManager) Hget(key string, field string) (string, error) {
-	val, err := r.Client.HGet(ctx, key, field).Result()
+	val, err := r.Client.HGet(ctx, r.Key(key), field).Result()
 	return val, err
 }
 
 // hgetall - Redis HGETALL
 func (r *redisManager) Hgetall(key string) (map[string]string, error) {
-	val, err := r.Client.HGetAll(ctx, key).Result()
+	val, err := r.Client.HGetAll(ctx, r.Key(key)).Result()
 	return val, err
 }
 
 // hset - Redis HSET
 func (r *redisManager) Hset(key string, field string, values interface{}) error {
-	err := r.Client.HSet(ctx, key, field, values).Err()
+	err := r.Client.HSet(ctx, r.Key(key), field, values).Err()
 	return err
 }
 
 // hdel - Redis HDEL
 func (r *redisManager) Hdel(key string, field string) error {
-	err := r.Client.HDel(ctx, key, field).Err()
+	err := r.Client.HDel(ctx, r.Key(key), field).Err()
 	return err
 }
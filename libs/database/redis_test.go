@@ -1,9 +1,12 @@
 package database
 
 import (
+	"context"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/go-redis/redis/v9"
 	"github.com/stretchr/testify/assert"
 )
@@ -40,6 +43,22 @@ func TestGet(t *testing.T) {
 	assert.Equal(t, v, val)
 }
 
+func TestSetNX(t *testing.T) {
+	// Mock redis client
+	os.Setenv("MOCK_REDIS", "true")
+	defer os.Unsetenv("MOCK_REDIS")
+	k := "setnxkey"
+	set, err := GetRedisDB().SetNX(k, "v", 0)
+	assert.Equal(t, nil, err)
+	assert.True(t, set)
+	set, err = GetRedisDB().SetNX(k, "v2", 0)
+	assert.Equal(t, nil, err)
+	assert.False(t, set)
+	val, err := GetRedisDB().Get(k)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "v", val)
+}
+
 func TestDel(t *testing.T) {
 	// Mock redis client
 	os.Setenv("MOCK_REDIS", "true")
@@ -53,6 +72,24 @@ func TestDel(t *testing.T) {
 	assert.Equal(t, int64(1), count)
 }
 
+func TestExpire(t *testing.T) {
+	// Mock redis client
+	os.Setenv("MOCK_REDIS", "true")
+	defer os.Unsetenv("MOCK_REDIS")
+	k := "expirekey"
+	err := GetRedisDB().Set(k, "v", 0)
+	assert.Equal(t, nil, err)
+	set, err := GetRedisDB().Expire(k, time.Minute)
+	assert.Equal(t, nil, err)
+	assert.True(t, set)
+	ttl, err := GetRedisDB().Client.TTL(context.Background(), GetRedisDB().Key(k)).Result()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, time.Minute, ttl)
+	set, err = GetRedisDB().Expire("notakey", time.Minute)
+	assert.Equal(t, nil, err)
+	assert.False(t, set)
+}
+
 func TestHset(t *testing.T) {
 	// Mock redis client
 	os.Setenv("MOCK_REDIS", "true")
@@ -131,3 +168,81 @@ func TestHgetall(t *testing.T) {
 		assert.Contains(t, []string{v, v2}, val)
 	}
 }
+
+func TestNamespace(t *testing.T) {
+	// Mock redis client
+	os.Setenv("MOCK_REDIS", "true")
+	defer os.Unsetenv("MOCK_REDIS")
+	assert.Equal(t, "pippin", GetRedisDB().Namespace)
+	assert.Equal(t, "pippin:key", GetRedisDB().Key("key"))
+
+	// Keys are stored with the prefix
+	err := GetRedisDB().Set("nskey", "v", 0)
+	assert.Equal(t, nil, err)
+	val, err := GetRedisDB().Client.Get(context.Background(), "pippin:nskey").Result()
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "v", val)
+
+	// Locks too
+	lock, err := GetRedisDB().Obtain(context.Background(), "nslock", time.Second, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "pippin:nslock", lock.Key())
+	assert.Equal(t, nil, lock.Release(context.Background()))
+}
+
+func TestMockRejectsKeysOutsideNamespace(t *testing.T) {
+	// Mock redis client
+	os.Setenv("MOCK_REDIS", "true")
+	defer os.Unsetenv("MOCK_REDIS")
+	err := GetRedisDB().Client.Set(context.Background(), "nskey", "v", 0).Err()
+	assert.ErrorIs(t, err, ErrKeyOutsideNamespace)
+	_, err = GetRedisDB().Client.HGet(context.Background(), "other:nskey", "f").Result()
+	assert.ErrorIs(t, err, ErrKeyOutsideNamespace)
+	_, err = GetRedisDB().Locker.Obtain(context.Background(), "nslock", time.Second, nil)
+	assert.ErrorIs(t, err, ErrKeyOutsideNamespace)
+	// A scan has to match keys in the namespace
+	err = GetRedisDB().Client.Scan(context.Background(), 0, "*", 10).Err()
+	assert.ErrorIs(t, err, ErrKeyOutsideNamespace)
+	err = GetRedisDB().Client.Scan(context.Background(), 0, "", 10).Err()
+	assert.ErrorIs(t, err, ErrKeyOutsideNamespace)
+	err = GetRedisDB().Client.Scan(context.Background(), 0, GetRedisDB().Key("*"), 10).Err()
+	assert.Nil(t, err)
+}
+
+func TestNamespaceIsolation(t *testing.T) {
+	mr := miniredis.RunT(t)
+	a := newMockRedisManager(redis.NewClient(&redis.Options{Addr: mr.Addr()}), "a")
+	b := newMockRedisManager(redis.NewClient(&redis.Options{Addr: mr.Addr()}), "b")
+
+	assert.Equal(t, nil, a.Set("key", "from a", 0))
+	assert.Equal(t, nil, b.Set("key", "from b", 0))
+	val, err := a.Get("key")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "from a", val)
+	val, err = b.Get("key")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "from b", val)
+
+	_, err = a.Del("key")
+	assert.Equal(t, nil, err)
+	_, err = a.Get("key")
+	assert.Equal(t, redis.Nil, err)
+	val, err = b.Get("key")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "from b", val)
+
+	// Same lock name doesn't conflict across namespaces
+	lockA, err := a.Obtain(context.Background(), "lock", time.Second, nil)
+	assert.Equal(t, nil, err)
+	lockB, err := b.Obtain(context.Background(), "lock", time.Second, nil)
+	assert.Equal(t, nil, err)
+	lockA.Release(context.Background())
+	lockB.Release(context.Background())
+}
+
+func TestPing(t *testing.T) {
+	// Mock redis client
+	os.Setenv("MOCK_REDIS", "true")
+	defer os.Unsetenv("MOCK_REDIS")
+	assert.Nil(t, GetRedisDB().Ping(context.Background()))
+}
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"entgo.io/ent/dialect"
+)
+
+type primaryKey struct{}
+
+// Force the queries made with the returned context to the primary, e.g. a read that a write depends on
+// Without a read replica everything goes to the primary already
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+func usePrimary(ctx context.Context) bool {
+	primary, _ := ctx.Value(primaryKey{}).(bool)
+	return primary
+}
+
+// Sends SELECT statements to the read replica and everything else to the primary
+// Transactions always run on the primary, even the reads in them
+type replicaDriver struct {
+	primary dialect.Driver
+	replica dialect.Driver
+}
+
+func isSelect(query string) bool {
+	query = strings.TrimSpace(query)
+	return len(query) >= 6 && strings.EqualFold(query[:6], "SELECT")
+}
+
+func (d *replicaDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return d.primary.Exec(ctx, query, args, v)
+}
+
+func (d *replicaDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	if !usePrimary(ctx) && isSelect(query) {
+		return d.replica.Query(ctx, query, args, v)
+	}
+	return d.primary.Query(ctx, query, args, v)
+}
+
+func (d *replicaDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.primary.Tx(ctx)
+}
+
+// Called by ent when the driver supports it, to pass transaction options
+func (d *replicaDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	if drv, ok := d.primary.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}); ok {
+		return drv.BeginTx(ctx, opts)
+	}
+	return d.primary.Tx(ctx)
+}
+
+func (d *replicaDriver) Close() error {
+	if err := d.replica.Close(); err != nil {
+		d.primary.Close()
+		return err
+	}
+	return d.primary.Close()
+}
+
+func (d *replicaDriver) Dialect() string {
+	return d.primary.Dialect()
+}
@@ -0,0 +1,108 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+// Records the statements that reach a driver
+type callTrace struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (c *callTrace) log(ctx context.Context, v ...interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, fmt.Sprint(v...))
+}
+
+func (c *callTrace) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = nil
+}
+
+func (c *callTrace) count(prefix string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := 0
+	for _, call := range c.calls {
+		if strings.HasPrefix(call, prefix) {
+			n++
+		}
+	}
+	return n
+}
+
+func openTracedSqlite(t *testing.T, name string, trace *callTrace) dialect.Driver {
+	conn := &SqliteConn{FileName: name, Mode: "memory"}
+	db, err := sql.Open(conn.Driver(), conn.DSN())
+	assert.Nil(t, err)
+	drv := entsql.OpenDB(conn.Dialect(), db)
+	// Both sides need the tables, a real replica gets them from the primary
+	assert.Nil(t, ent.NewClient(ent.Driver(drv)).Schema.Create(context.Background()))
+	return dialect.DebugWithContext(drv, trace.log)
+}
+
+func TestReplicaDriverRouting(t *testing.T) {
+	ctx := context.Background()
+	primaryTrace := &callTrace{}
+	replicaTrace := &callTrace{}
+	drv := &replicaDriver{
+		primary: openTracedSqlite(t, "routing_primary", primaryTrace),
+		replica: openTracedSqlite(t, "routing_replica", replicaTrace),
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+
+	// Writes go to the primary
+	_, err := client.Wallet.Create().SetSeed("seed").Save(ctx)
+	assert.Nil(t, err)
+	assert.Empty(t, replicaTrace.calls)
+	assert.NotEmpty(t, primaryTrace.calls)
+
+	// Reads go to the replica, which doesn't have the wallet since nothing replicates it in the test
+	primaryTrace.reset()
+	count, err := client.Wallet.Query().Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+	assert.Equal(t, 1, replicaTrace.count("driver.Query"))
+	assert.Empty(t, primaryTrace.calls)
+
+	// Unless they're forced to the primary
+	replicaTrace.reset()
+	count, err = client.Wallet.Query().Count(WithPrimary(ctx))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, 1, primaryTrace.count("driver.Query"))
+	assert.Empty(t, replicaTrace.calls)
+
+	// Transactions run on the primary, reads in them too
+	primaryTrace.reset()
+	tx, err := client.Tx(ctx)
+	assert.Nil(t, err)
+	count, err = tx.Wallet.Query().Count(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Nil(t, tx.Commit())
+	assert.Equal(t, 1, primaryTrace.count("driver.Tx"))
+	assert.Empty(t, replicaTrace.calls)
+}
+
+func TestIsSelect(t *testing.T) {
+	assert.True(t, isSelect("SELECT * FROM `wallets`"))
+	assert.True(t, isSelect("  select count(*) from wallets"))
+	assert.False(t, isSelect("INSERT INTO `wallets` (`seed`) VALUES (?)"))
+	assert.False(t, isSelect("UPDATE `wallets` SET `seed` = ?"))
+	assert.False(t, isSelect("SEL"))
+}
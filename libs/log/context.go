@@ -0,0 +1,75 @@
+package log
+
+import (
+	"context"
+	"fmt"
+)
+
+// Every gateway request has an ID, it's returned in this header and sent along to the node and work peers
+// Logs of the request have it as request_id, so everything a failed send did can be found with it
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// ctx of the request with id
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// The ID of the request ctx is for, empty if it isn't for one
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Logs with the request_id of a context
+type CtxLogger struct {
+	keyvals []interface{}
+}
+
+// A logger that adds the request ID of ctx to every message, like the package's functions without one
+func Ctx(ctx context.Context) *CtxLogger {
+	if id := RequestID(ctx); id != "" {
+		return &CtxLogger{keyvals: []interface{}{"request_id", id}}
+	}
+	return &CtxLogger{}
+}
+
+func (l *CtxLogger) with(keyvals []interface{}) []interface{} {
+	return append(append([]interface{}{}, keyvals...), l.keyvals...)
+}
+
+func (l *CtxLogger) Debug(msg interface{}, keyvals ...interface{}) {
+	Debug(msg, l.with(keyvals)...)
+}
+
+func (l *CtxLogger) Debugf(format string, args ...any) {
+	Debug(fmt.Sprintf(format, args...), l.keyvals...)
+}
+
+func (l *CtxLogger) Info(msg interface{}, keyvals ...interface{}) {
+	Info(msg, l.with(keyvals)...)
+}
+
+func (l *CtxLogger) Infof(format string, args ...any) {
+	Info(fmt.Sprintf(format, args...), l.keyvals...)
+}
+
+func (l *CtxLogger) Warn(msg interface{}, keyvals ...interface{}) {
+	Warn(msg, l.with(keyvals)...)
+}
+
+func (l *CtxLogger) Warnf(format string, args ...any) {
+	Warn(fmt.Sprintf(format, args...), l.keyvals...)
+}
+
+func (l *CtxLogger) Error(msg interface{}, keyvals ...interface{}) {
+	Error(msg, l.with(keyvals)...)
+}
+
+func (l *CtxLogger) Errorf(format string, args ...any) {
+	Error(fmt.Sprintf(format, args...), l.keyvals...)
+}
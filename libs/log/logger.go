@@ -3,69 +3,174 @@ package log
 import (
 	"fmt"
 	"os"
+	"sync/atomic"
 
 	"github.com/charmbracelet/log"
 )
 
+var debugLogger *log.Logger
 var infoLogger *log.Logger
 var warnLogger *log.Logger
 var errorLogger *log.Logger
 var fatalLogger *log.Logger
 
+// Messages below this are dropped, fatal ones never are
+var minLevel atomic.Int32
+
+// Log JSON lines instead of text, see SetFormat
+var jsonFormat atomic.Bool
+
+var prefixes = map[log.Level]string{
+	log.DebugLevel: "⬜",
+	log.InfoLevel:  "🟦",
+	log.WarnLevel:  "🟨",
+	log.ErrorLevel: "🟥",
+	log.FatalLevel: "☠️🟥☠️",
+}
+
+// Set the lowest level that's logged, one of debug, info, warn or error
+// Safe to call while other goroutines are logging
+func SetLevel(level string) error {
+	parsed, err := log.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	minLevel.Store(int32(parsed))
+	return nil
+}
+
+// Set how messages are written, text or json, one JSON object per line with time, level, msg and the keyvals
+// Safe to call while other goroutines are logging
+func SetFormat(format string) error {
+	switch format {
+	case "text":
+		jsonFormat.Store(false)
+	case "json":
+		jsonFormat.Store(true)
+	default:
+		return fmt.Errorf("invalid log format %s, must be text or json", format)
+	}
+	for level, logger := range map[log.Level]*log.Logger{log.DebugLevel: debugLogger, log.InfoLevel: infoLogger, log.WarnLevel: warnLogger, log.ErrorLevel: errorLogger, log.FatalLevel: fatalLogger} {
+		if logger != nil {
+			applyFormat(logger, level)
+		}
+	}
+	return nil
+}
+
+// Whether messages are written as JSON lines
+func IsJSON() bool {
+	return jsonFormat.Load()
+}
+
+func applyFormat(logger *log.Logger, level log.Level) {
+	// The level is in every line already, the emoji would only be noise in JSON
+	if jsonFormat.Load() {
+		logger.SetFormatter(log.JSONFormatter)
+		logger.SetPrefix("")
+	} else {
+		logger.SetFormatter(log.TextFormatter)
+		logger.SetPrefix(prefixes[level])
+	}
+}
+
+func enabled(level log.Level) bool {
+	return int32(level) >= minLevel.Load()
+}
+
+func newLogger(level log.Level) *log.Logger {
+	logger := log.New(os.Stderr)
+	logger.SetReportTimestamp(true)
+	// minLevel decides what's dropped, the logger's own level defaults to info
+	logger.SetLevel(log.DebugLevel)
+	applyFormat(logger, level)
+	return logger
+}
+
 func getLogger(level log.Level) *log.Logger {
 	if level == log.FatalLevel {
 		if fatalLogger == nil {
-			fatalLogger = log.New(os.Stderr)
-			fatalLogger.SetPrefix("☠️🟥☠️")
-			fatalLogger.SetReportTimestamp(true)
+			fatalLogger = newLogger(level)
 		}
 		return fatalLogger
 	}
 	if level == log.ErrorLevel {
 		if errorLogger == nil {
-			errorLogger = log.New(os.Stderr)
-			errorLogger.SetPrefix("🟥")
-			errorLogger.SetReportTimestamp(true)
+			errorLogger = newLogger(level)
 		}
 		return errorLogger
 	}
 	if level == log.WarnLevel {
 		if warnLogger == nil {
-			warnLogger = log.New(os.Stderr)
-			warnLogger.SetPrefix("🟨")
-			warnLogger.SetReportTimestamp(true)
+			warnLogger = newLogger(level)
 		}
 		return warnLogger
 	}
+	if level == log.DebugLevel {
+		if debugLogger == nil {
+			debugLogger = newLogger(level)
+		}
+		return debugLogger
+	}
 	if infoLogger == nil {
-		infoLogger = log.New(os.Stderr)
-		infoLogger.SetPrefix("🟦")
-		infoLogger.SetReportTimestamp(true)
+		infoLogger = newLogger(log.InfoLevel)
 	}
 	return infoLogger
 }
 
+func Debug(msg interface{}, keyvals ...interface{}) {
+	if !enabled(log.DebugLevel) {
+		return
+	}
+	getLogger(log.DebugLevel).Debug(msg, keyvals...)
+}
+
+func Debugf(format string, args ...any) {
+	if !enabled(log.DebugLevel) {
+		return
+	}
+	getLogger(log.DebugLevel).Debug(fmt.Sprintf(format, args...))
+}
+
 func Info(msg interface{}, keyvals ...interface{}) {
+	if !enabled(log.InfoLevel) {
+		return
+	}
 	getLogger(log.InfoLevel).Info(msg, keyvals...)
 }
 
 func Infof(format string, args ...any) {
+	if !enabled(log.InfoLevel) {
+		return
+	}
 	getLogger(log.InfoLevel).Info(fmt.Sprintf(format, args...))
 }
 
 func Error(msg interface{}, keyvals ...interface{}) {
+	if !enabled(log.ErrorLevel) {
+		return
+	}
 	getLogger(log.ErrorLevel).Error(msg, keyvals...)
 }
 
 func Errorf(format string, args ...any) {
+	if !enabled(log.ErrorLevel) {
+		return
+	}
 	getLogger(log.ErrorLevel).Error(fmt.Sprintf(format, args...))
 }
 
 func Warn(msg interface{}, keyvals ...interface{}) {
+	if !enabled(log.WarnLevel) {
+		return
+	}
 	getLogger(log.WarnLevel).Warn(msg, keyvals...)
 }
 
 func Warnf(format string, args ...any) {
+	if !enabled(log.WarnLevel) {
+		return
+	}
 	getLogger(log.WarnLevel).Warn(fmt.Sprintf(format, args...))
 }
 
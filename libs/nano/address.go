@@ -0,0 +1,53 @@
+package nano
+
+import (
+	"errors"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+var ErrInvalidPrefix = errors.New("invalid address prefix")
+var ErrInvalidLength = errors.New("invalid address length")
+var ErrInvalidBase32 = errors.New("invalid address encoding")
+var ErrInvalidChecksum = errors.New("invalid address checksum")
+
+// ValidateAddress checks an address and returns its public key
+// Banano addresses start with ban_, nano addresses with nano_ or the legacy xrb_
+func ValidateAddress(address string, banano bool) ([]byte, error) {
+	var encoded string
+	switch {
+	case banano && len(address) >= 4 && address[:4] == "ban_":
+		encoded = address[4:]
+	case !banano && len(address) >= 4 && address[:4] == "xrb_":
+		encoded = address[4:]
+	case !banano && len(address) >= 5 && address[:5] == "nano_":
+		encoded = address[5:]
+	default:
+		return nil, ErrInvalidPrefix
+	}
+	// 52 characters of public key followed by 8 of checksum
+	if len(encoded) != 60 {
+		return nil, ErrInvalidLength
+	}
+
+	// The key is 256 bits, pad it to 280 so it falls on a byte boundary
+	// (zeros are encoded as 1 in nano's alphabet)
+	keyBytes, err := utils.NanoEncoding.DecodeString("1111" + encoded[:52])
+	if err != nil {
+		return nil, ErrInvalidBase32
+	}
+	checksum, err := utils.NanoEncoding.DecodeString(encoded[52:])
+	if err != nil {
+		return nil, ErrInvalidBase32
+	}
+	// Strip the padding, the 4 unused bits before the key must be zero as well
+	if keyBytes[0] != 0 || keyBytes[1] != 0 || keyBytes[2] != 0 {
+		return nil, ErrInvalidBase32
+	}
+	keyBytes = keyBytes[3:]
+
+	if string(utils.GetAddressChecksum(keyBytes)) != string(checksum) {
+		return nil, ErrInvalidChecksum
+	}
+	return keyBytes, nil
+}
@@ -0,0 +1,54 @@
+package nano
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAddress(t *testing.T) {
+	// Valid
+	pub, err := ValidateAddress("nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "dba12a8ed2702404483f5519c2b24e3c2f9cfc92310ab43255f3f6369e27a527", hex.EncodeToString(pub))
+	_, err = ValidateAddress("xrb_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j", false)
+	assert.Nil(t, err)
+	_, err = ValidateAddress("ban_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j", true)
+	assert.Nil(t, err)
+
+	// Prefix
+	_, err = ValidateAddress("ban_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j", false)
+	assert.ErrorIs(t, err, ErrInvalidPrefix)
+	_, err = ValidateAddress("nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j", true)
+	assert.ErrorIs(t, err, ErrInvalidPrefix)
+	_, err = ValidateAddress("xrb_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j", true)
+	assert.ErrorIs(t, err, ErrInvalidPrefix)
+	_, err = ValidateAddress("nan", false)
+	assert.ErrorIs(t, err, ErrInvalidPrefix)
+
+	// Length
+	_, err = ValidateAddress("nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51", false)
+	assert.ErrorIs(t, err, ErrInvalidLength)
+	_, err = ValidateAddress("nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51jj", false)
+	assert.ErrorIs(t, err, ErrInvalidLength)
+	_, err = ValidateAddress("nano_", false)
+	assert.ErrorIs(t, err, ErrInvalidLength)
+
+	// Base32, 0 l v and 2 aren't in the alphabet
+	_, err = ValidateAddress("nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba50j", false)
+	assert.ErrorIs(t, err, ErrInvalidBase32)
+	_, err = ValidateAddress("nano_3pl37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j", false)
+	assert.ErrorIs(t, err, ErrInvalidBase32)
+	_, err = ValidateAddress("nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgbav1j", false)
+	assert.ErrorIs(t, err, ErrInvalidBase32)
+	// The first character can only carry one bit of the key
+	_, err = ValidateAddress("nano_5px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j", false)
+	assert.ErrorIs(t, err, ErrInvalidBase32)
+
+	// Checksum
+	_, err = ValidateAddress("nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba511", false)
+	assert.ErrorIs(t, err, ErrInvalidChecksum)
+	_, err = ValidateAddress("nano_1px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j", false)
+	assert.ErrorIs(t, err, ErrInvalidChecksum)
+}
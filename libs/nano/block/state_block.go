@@ -0,0 +1,163 @@
+package block
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Every block hash starts with the state block preamble, 31 zero bytes followed by 6
+var statePreamble = [32]byte{31: 6}
+
+var ErrInvalidBalance = errors.New("invalid balance")
+var ErrInvalidPrevious = errors.New("invalid previous")
+var ErrInvalidLink = errors.New("invalid link")
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// StateBlock is a block from the nano protocol
+// See: https://docs.nano.org/integration-guides/the-basics/#blocks-specifications
+type StateBlock struct {
+	Type           string `json:"type" mapstructure:"type"`
+	Account        string `json:"account" mapstructure:"account"`
+	Previous       string `json:"previous" mapstructure:"previous"`
+	Representative string `json:"representative" mapstructure:"representative"`
+	Balance        string `json:"balance" mapstructure:"balance"`
+	Link           string `json:"link" mapstructure:"link"`
+	LinkAsAccount  string `json:"link_as_account,omitempty" mapstructure:"link_as_account,omitempty"`
+	Work           string `json:"work" mapstructure:"work"`
+	Signature      string `json:"signature" mapstructure:"signature"`
+	Banano         bool   `json:"-" mapstructure:"-"`
+}
+
+// The decoded fields that make up the hash
+type hashables struct {
+	account        []byte
+	previous       []byte
+	representative []byte
+	balance        []byte
+	link           []byte
+}
+
+func (b *StateBlock) hashables() (*hashables, error) {
+	account, err := utils.AddressToPub(b.Account, b.Banano)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := hex.DecodeString(b.Previous)
+	if err != nil || len(previous) != 32 {
+		return nil, ErrInvalidPrevious
+	}
+	representative, err := utils.AddressToPub(b.Representative, b.Banano)
+	if err != nil {
+		return nil, err
+	}
+	balance, ok := big.NewInt(0).SetString(b.Balance, 10)
+	if !ok || balance.Sign() < 0 || balance.BitLen() > 128 {
+		return nil, ErrInvalidBalance
+	}
+	link, err := hex.DecodeString(b.Link)
+	if err != nil || len(link) != 32 {
+		return nil, ErrInvalidLink
+	}
+	return &hashables{
+		account:        account,
+		previous:       previous,
+		representative: representative,
+		balance:        balance.FillBytes(make([]byte, 16)),
+		link:           link,
+	}, nil
+}
+
+// Validate the fields that make up the hash, blocks that pass can be hashed and signed
+func (b *StateBlock) Validate() error {
+	_, err := b.hashables()
+	return err
+}
+
+// Hash of the block, this is what gets signed
+// Returns the zero hash if the block doesn't pass Validate
+func (b *StateBlock) Hash() [32]byte {
+	var hash [32]byte
+	fields, err := b.hashables()
+	if err != nil {
+		return hash
+	}
+	h, _ := blake2b.New256(nil)
+	h.Write(statePreamble[:])
+	h.Write(fields.account)
+	h.Write(fields.previous)
+	h.Write(fields.representative)
+	h.Write(fields.balance)
+	h.Write(fields.link)
+	copy(hash[:], h.Sum(nil))
+	return hash
+}
+
+// Sign the block with the 32 byte private key of the account
+func (b *StateBlock) Sign(privateKey [32]byte) error {
+	if err := b.Validate(); err != nil {
+		return err
+	}
+	priv, err := ed25519.NewKeyFromSeed(privateKey[:])
+	if err != nil {
+		return err
+	}
+	hash := b.Hash()
+	b.Signature = hex.EncodeToString(ed25519.Sign(priv, hash[:]))
+	return nil
+}
+
+// Check that the block was signed by its account
+func (b *StateBlock) VerifySignature() error {
+	fields, err := b.hashables()
+	if err != nil {
+		return err
+	}
+	signature, err := hex.DecodeString(b.Signature)
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return ErrInvalidSignature
+	}
+	hash := b.Hash()
+	if !ed25519.Verify(ed25519.PublicKey(fields.account), hash[:], signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// MarshalJSON encodes the block the way the node expects it in process
+func (b StateBlock) MarshalJSON() ([]byte, error) {
+	blockType := b.Type
+	if blockType == "" {
+		blockType = "state"
+	}
+	linkAsAccount := b.LinkAsAccount
+	if link, err := hex.DecodeString(b.Link); linkAsAccount == "" && err == nil && len(link) == 32 {
+		linkAsAccount = utils.PubKeyToAddress(link, b.Banano)
+	}
+	return json.Marshal(struct {
+		Type           string `json:"type"`
+		Account        string `json:"account"`
+		Previous       string `json:"previous"`
+		Representative string `json:"representative"`
+		Balance        string `json:"balance"`
+		Link           string `json:"link"`
+		LinkAsAccount  string `json:"link_as_account,omitempty"`
+		Signature      string `json:"signature"`
+		Work           string `json:"work"`
+	}{
+		Type:           blockType,
+		Account:        b.Account,
+		Previous:       b.Previous,
+		Representative: b.Representative,
+		Balance:        b.Balance,
+		Link:           b.Link,
+		LinkAsAccount:  linkAsAccount,
+		Signature:      b.Signature,
+		Work:           b.Work,
+	})
+}
@@ -0,0 +1,258 @@
+package block
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testSeed(t *testing.T) [32]byte {
+	_, priv, err := ed25519.GenerateKey(strings.NewReader("9f729340e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040b"))
+	assert.Nil(t, err)
+	var seed [32]byte
+	copy(seed[:], priv.Seed())
+	return seed
+}
+
+func TestComputeBlockHash(t *testing.T) {
+	sb := StateBlock{
+		Account:        "xrb_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Previous:       "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		Representative: "xrb_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Balance:        "1000000000000000000000000000000",
+		Link:           "d9dd06646f96474a46c57c13677812305120be228f39964e222c06ab89f63745",
+		Banano:         false,
+	}
+
+	// Hash
+	assert.Nil(t, sb.Validate())
+	hash := sb.Hash()
+	assert.Equal(t, "8ebeb9534a14e0b17b3cd4639721387dedac80789278b540ddbde2a0b267b6d0", hex.EncodeToString(hash[:]))
+}
+
+func TestSignBlock(t *testing.T) {
+	sb := StateBlock{
+		Account:        "xrb_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Previous:       "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		Representative: "xrb_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Balance:        "1000000000000000000000000000000",
+		Link:           "d9dd06646f96474a46c57c13677812305120be228f39964e222c06ab89f63745",
+		Banano:         false,
+	}
+
+	err := sb.Sign(testSeed(t))
+	assert.Nil(t, err)
+	hash := sb.Hash()
+	assert.Equal(t, "8ebeb9534a14e0b17b3cd4639721387dedac80789278b540ddbde2a0b267b6d0", hex.EncodeToString(hash[:]))
+	assert.Equal(t, "b580fa76c0b763aa8a8a90af8592155c9478554ce04c87b5fb115baae624eafa116e04fffb273405c0ffcff6dfb021526292ac4418f3988d7684e15e486f1409", sb.Signature)
+}
+
+func TestComputeBlockHashBanano(t *testing.T) {
+	sb := StateBlock{
+		Account:        "ban_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Previous:       "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		Representative: "ban_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Balance:        "1000000000000000000000000000000",
+		Link:           "d9dd06646f96474a46c57c13677812305120be228f39964e222c06ab89f63745",
+		Banano:         true,
+	}
+
+	// Hash
+	assert.Nil(t, sb.Validate())
+	hash := sb.Hash()
+	assert.Equal(t, "8ebeb9534a14e0b17b3cd4639721387dedac80789278b540ddbde2a0b267b6d0", hex.EncodeToString(hash[:]))
+}
+
+func TestSignBlockBanano(t *testing.T) {
+	sb := StateBlock{
+		Account:        "ban_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Previous:       "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		Representative: "ban_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Balance:        "1000000000000000000000000000000",
+		Link:           "d9dd06646f96474a46c57c13677812305120be228f39964e222c06ab89f63745",
+		Banano:         true,
+	}
+
+	err := sb.Sign(testSeed(t))
+	assert.Nil(t, err)
+	hash := sb.Hash()
+	assert.Equal(t, "8ebeb9534a14e0b17b3cd4639721387dedac80789278b540ddbde2a0b267b6d0", hex.EncodeToString(hash[:]))
+	assert.Equal(t, "b580fa76c0b763aa8a8a90af8592155c9478554ce04c87b5fb115baae624eafa116e04fffb273405c0ffcff6dfb021526292ac4418f3988d7684e15e486f1409", sb.Signature)
+}
+
+func TestSignatureVerifies(t *testing.T) {
+	seed := testSeed(t)
+	priv, err := ed25519.NewKeyFromSeed(seed[:])
+	assert.Nil(t, err)
+
+	sb := StateBlock{
+		Account:        "nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Previous:       "0000000000000000000000000000000000000000000000000000000000000000",
+		Representative: "nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Balance:        "0",
+		Link:           "d9dd06646f96474a46c57c13677812305120be228f39964e222c06ab89f63745",
+	}
+	assert.Nil(t, sb.Sign(seed))
+
+	hash := sb.Hash()
+	sig, err := hex.DecodeString(sb.Signature)
+	assert.Nil(t, err)
+	assert.True(t, ed25519.Verify(priv.Public().(ed25519.PublicKey), hash[:], sig))
+
+	// Changing any hashed field invalidates the signature
+	sb.Balance = "1"
+	hash = sb.Hash()
+	assert.False(t, ed25519.Verify(priv.Public().(ed25519.PublicKey), hash[:], sig))
+}
+
+func TestVerifySignature(t *testing.T) {
+	seed := testSeed(t)
+	priv, err := ed25519.NewKeyFromSeed(seed[:])
+	assert.Nil(t, err)
+	account := utils.PubKeyToAddress(priv.Public().(ed25519.PublicKey), false)
+
+	sb := StateBlock{
+		Account:        account,
+		Previous:       "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		Representative: "nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Balance:        "1000000000000000000000000000000",
+		Link:           "d9dd06646f96474a46c57c13677812305120be228f39964e222c06ab89f63745",
+	}
+	assert.ErrorIs(t, sb.VerifySignature(), ErrInvalidSignature)
+	assert.Nil(t, sb.Sign(seed))
+	assert.Nil(t, sb.VerifySignature())
+
+	// Work isn't signed
+	sb.Work = "205452237a9b01f4"
+	assert.Nil(t, sb.VerifySignature())
+
+	// Tampered blocks
+	tampered := sb
+	tampered.Balance = "1"
+	assert.ErrorIs(t, tampered.VerifySignature(), ErrInvalidSignature)
+	tampered = sb
+	tampered.Link = "0000000000000000000000000000000000000000000000000000000000000000"
+	assert.ErrorIs(t, tampered.VerifySignature(), ErrInvalidSignature)
+	tampered = sb
+	tampered.Signature = "1" + sb.Signature[1:]
+	assert.ErrorIs(t, tampered.VerifySignature(), ErrInvalidSignature)
+	tampered = sb
+	tampered.Signature = "abcd"
+	assert.ErrorIs(t, tampered.VerifySignature(), ErrInvalidSignature)
+
+	// Signed by another account
+	tampered = sb
+	tampered.Account = "nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs"
+	assert.ErrorIs(t, tampered.VerifySignature(), ErrInvalidSignature)
+
+	tampered = sb
+	tampered.Previous = "1234"
+	assert.ErrorIs(t, tampered.VerifySignature(), ErrInvalidPrevious)
+}
+
+func TestValidateBlock(t *testing.T) {
+	valid := StateBlock{
+		Account:        "nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Previous:       "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		Representative: "nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Balance:        "1000000000000000000000000000000",
+		Link:           "d9dd06646f96474a46c57c13677812305120be228f39964e222c06ab89f63745",
+	}
+	assert.Nil(t, valid.Validate())
+
+	sb := valid
+	sb.Account = "nano_1234"
+	assert.NotNil(t, sb.Validate())
+	assert.Equal(t, [32]byte{}, sb.Hash())
+	assert.NotNil(t, sb.Sign(testSeed(t)))
+	assert.Equal(t, "", sb.Signature)
+
+	sb = valid
+	sb.Previous = "1234"
+	assert.ErrorIs(t, sb.Validate(), ErrInvalidPrevious)
+
+	sb = valid
+	sb.Balance = "-1"
+	assert.ErrorIs(t, sb.Validate(), ErrInvalidBalance)
+	sb.Balance = "340282366920938463463374607431768211456"
+	assert.ErrorIs(t, sb.Validate(), ErrInvalidBalance)
+	sb.Balance = "340282366920938463463374607431768211455"
+	assert.Nil(t, sb.Validate())
+
+	sb = valid
+	sb.Link = "zz"
+	assert.ErrorIs(t, sb.Validate(), ErrInvalidLink)
+}
+
+func TestMapstructureDecodeStateBlock(t *testing.T) {
+	request := map[string]interface{}{
+		"type":           "state",
+		"account":        "1",
+		"previous":       "2",
+		"representative": "3",
+		"balance":        "4",
+		"link":           "5",
+	}
+	var decoded StateBlock
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "state", decoded.Type)
+	assert.Equal(t, "1", decoded.Account)
+	assert.Equal(t, "2", decoded.Previous)
+	assert.Equal(t, "3", decoded.Representative)
+	assert.Equal(t, "4", decoded.Balance)
+	assert.Equal(t, "5", decoded.Link)
+	assert.Equal(t, "", decoded.Signature)
+	assert.Equal(t, "", decoded.Work)
+}
+
+func TestJsonDecodeStateBlock(t *testing.T) {
+	encoded := "{\n\t\t\"type\":           \"state\",\n\t\t\"account\":        \"1\",\n\t\t\"previous\":       \"2\",\n\t\t\"representative\": \"3\",\n\t\t\"balance\":        \"4\",\n\t\t\"link\":           \"5\"\n\t}"
+	var decoded StateBlock
+	err := json.Unmarshal([]byte(encoded), &decoded)
+	assert.Nil(t, err)
+	assert.Equal(t, "state", decoded.Type)
+	assert.Equal(t, "1", decoded.Account)
+	assert.Equal(t, "2", decoded.Previous)
+	assert.Equal(t, "3", decoded.Representative)
+	assert.Equal(t, "4", decoded.Balance)
+	assert.Equal(t, "5", decoded.Link)
+	assert.Equal(t, "", decoded.Signature)
+	assert.Equal(t, "", decoded.Work)
+}
+
+func TestJsonEncodeStateBlock(t *testing.T) {
+	stateBlock := StateBlock{
+		Type:           "state",
+		Account:        "1",
+		Previous:       "2",
+		Representative: "3",
+		Balance:        "4",
+		Link:           "5",
+	}
+	encoded, err := json.Marshal(stateBlock)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"type":"state","account":"1","previous":"2","representative":"3","balance":"4","link":"5","signature":"","work":""}`, string(encoded))
+}
+
+func TestJsonEncodeStateBlockNodeFormat(t *testing.T) {
+	stateBlock := StateBlock{
+		Account:        "nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Previous:       "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		Representative: "nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j",
+		Balance:        "1000000000000000000000000000000",
+		Link:           "0000000000000000000000000000000000000000000000000000000000000000",
+		Work:           "205452237a9b01f4",
+		Signature:      "abcd",
+	}
+	// Type defaults to state and link_as_account is filled in from link
+	encoded, err := json.Marshal(&stateBlock)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"type":"state","account":"nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j","previous":"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855","representative":"nano_3px37c9f6w361j65yoasrcs6wh3hmmyb6eacpis7dwzp8th4hbb9izgba51j","balance":"1000000000000000000000000000000","link":"0000000000000000000000000000000000000000000000000000000000000000","link_as_account":"nano_1111111111111111111111111111111111111111111111111111hifc8npp","signature":"abcd","work":"205452237a9b01f4"}`, string(encoded))
+}
@@ -0,0 +1,17 @@
+module github.com/appditto/pippin_nano_wallet/libs/nano
+
+go 1.22
+
+require (
+	github.com/appditto/pippin_nano_wallet/libs/utils v0.0.0-20220911213744-8822c2a7556c
+	github.com/mitchellh/mapstructure v1.5.0
+	github.com/stretchr/testify v1.8.0
+	golang.org/x/crypto v0.24.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.21.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
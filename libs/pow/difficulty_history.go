@@ -0,0 +1,133 @@
+package pow
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// How far back DifficultyHistory looks
+const difficultyHistoryWindow = time.Hour
+
+// How often StartDifficultySampler records the multiplier, and how many it keeps, 24 hours of them
+const difficultySampleInterval = 30 * time.Second
+const difficultySamplesMax = 2880
+
+// One active_difficulty multiplier from UpdateDifficulty
+type difficultySample struct {
+	at         time.Time
+	multiplier float64
+}
+
+// The last multipliers UpdateDifficulty got from the node, oldest ones are overwritten once it's full
+type difficultyHistory struct {
+	samples []difficultySample
+	next    int
+	count   int
+	mutex   sync.Mutex
+}
+
+// Keep size samples, the ones already there are dropped
+func (h *difficultyHistory) resize(size int) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.samples = make([]difficultySample, max(size, 1))
+	h.next = 0
+	h.count = 0
+}
+
+func (h *difficultyHistory) add(at time.Time, multiplier float64) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if h.samples == nil {
+		// An hour at the default interval, until the updater sizes it for its own
+		h.samples = make([]difficultySample, int(difficultyHistoryWindow/(10*time.Second)))
+	}
+	h.samples[h.next] = difficultySample{at: at, multiplier: multiplier}
+	h.next = (h.next + 1) % len(h.samples)
+	h.count = min(h.count+1, len(h.samples))
+}
+
+// The average, lowest and highest active_difficulty multiplier of the last hour
+type DifficultyStats struct {
+	Average float64
+	Min     float64
+	Max     float64
+	Samples int
+}
+
+// The multipliers UpdateDifficulty got in the hour before now, nil if it didn't get any
+// Failed updates aren't samples, so an hour the node was down for has none
+func (p *PippinPow) DifficultyHistory(now time.Time) *DifficultyStats {
+	h := &p.difficultyHistory
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	var stats *DifficultyStats
+	total := 0.0
+	for i := 0; i < h.count; i++ {
+		sample := h.samples[i]
+		if now.Sub(sample.at) > difficultyHistoryWindow || sample.at.After(now) {
+			continue
+		}
+		if stats == nil {
+			stats = &DifficultyStats{Min: sample.multiplier, Max: sample.multiplier}
+		}
+		stats.Min = min(stats.Min, sample.multiplier)
+		stats.Max = max(stats.Max, sample.multiplier)
+		stats.Samples++
+		total += sample.multiplier
+	}
+	if stats != nil {
+		stats.Average = total / float64(stats.Samples)
+	}
+	return stats
+}
+
+// One multiplier recorded by StartDifficultySampler
+type DifficultySample struct {
+	Timestamp  time.Time
+	Multiplier float64
+}
+
+// Record the multiplier of the last UpdateDifficulty as of at, nothing while the node can't be reached
+func (p *PippinPow) SampleDifficulty(at time.Time) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.networkDifficulty == 0 {
+		return
+	}
+	p.difficultySamples.add(at, p.networkMultiplier)
+}
+
+// Record the multiplier every 30 seconds until ctx is done, DifficultySamples keeps the last 24 hours of them
+func (p *PippinPow) StartDifficultySampler(ctx context.Context) {
+	ticker := time.NewTicker(difficultySampleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case at := <-ticker.C:
+			p.SampleDifficulty(at)
+		}
+	}
+}
+
+// The samples StartDifficultySampler recorded in the window before now, oldest first
+func (p *PippinPow) DifficultySamples(now time.Time, window time.Duration) []DifficultySample {
+	h := &p.difficultySamples
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	ret := []DifficultySample{}
+	for i := 0; i < h.count; i++ {
+		// The oldest is at next once it's full
+		sample := h.samples[(h.next-h.count+i+len(h.samples))%len(h.samples)]
+		if now.Sub(sample.at) > window || sample.at.After(now) {
+			continue
+		}
+		ret = append(ret, DifficultySample{Timestamp: sample.at, Multiplier: sample.multiplier})
+	}
+	return ret
+}
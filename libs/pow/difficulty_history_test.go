@@ -0,0 +1,123 @@
+package pow
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDifficultyHistory(t *testing.T) {
+	ppow := NewPippinPow([]string{}, "", "", nil)
+	now := time.Unix(1700000000, 0)
+
+	// Nothing sampled yet
+	assert.Nil(t, ppow.DifficultyHistory(now))
+
+	ppow.difficultyHistory.resize(4)
+	ppow.difficultyHistory.add(now.Add(-2*time.Hour), 9)
+	ppow.difficultyHistory.add(now.Add(-30*time.Minute), 1)
+	ppow.difficultyHistory.add(now.Add(-20*time.Minute), 2)
+	ppow.difficultyHistory.add(now.Add(-10*time.Minute), 4.5)
+
+	// Only the last hour counts
+	stats := ppow.DifficultyHistory(now)
+	assert.Equal(t, &DifficultyStats{Average: 2.5, Min: 1, Max: 4.5, Samples: 3}, stats)
+
+	// Full, the oldest is overwritten
+	ppow.difficultyHistory.add(now.Add(-5*time.Minute), 0.5)
+	stats = ppow.DifficultyHistory(now)
+	assert.Equal(t, &DifficultyStats{Average: 2, Min: 0.5, Max: 4.5, Samples: 4}, stats)
+	ppow.difficultyHistory.add(now.Add(-time.Minute), 8)
+	stats = ppow.DifficultyHistory(now)
+	assert.Equal(t, &DifficultyStats{Average: 3.75, Min: 0.5, Max: 8, Samples: 4}, stats)
+
+	// An hour later none are left
+	assert.Nil(t, ppow.DifficultyHistory(now.Add(2*time.Hour)))
+}
+
+func TestUpdateDifficultyRecordsHistory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	multiplier := "1.5"
+	httpmock.RegisterResponder("POST", "http://fakenode",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"multiplier":      multiplier,
+				"network_current": "fffffffaaaaaaaab",
+			})
+		},
+	)
+
+	ppow := NewPippinPow([]string{}, "", "", nil)
+	ppow.NodeRpcUrl = "http://fakenode"
+	assert.Nil(t, ppow.UpdateDifficulty(context.Background()))
+	multiplier = "2.5"
+	assert.Nil(t, ppow.UpdateDifficulty(context.Background()))
+
+	// Failed updates aren't samples
+	multiplier = "abc"
+	assert.NotNil(t, ppow.UpdateDifficulty(context.Background()))
+
+	assert.Equal(t, &DifficultyStats{Average: 2, Min: 1.5, Max: 2.5, Samples: 2}, ppow.DifficultyHistory(time.Now()))
+}
+
+func TestDifficultySamples(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://fakenode",
+		httpmock.NewJsonResponderOrPanic(200, map[string]interface{}{
+			"multiplier":      "1.5",
+			"network_current": "fffffffaaaaaaaab",
+		}),
+	)
+
+	ppow := NewPippinPow([]string{}, "", "", nil)
+	now := time.Unix(1700000000, 0)
+
+	// Nothing is recorded until the node was reached
+	ppow.SampleDifficulty(now)
+	assert.Equal(t, []DifficultySample{}, ppow.DifficultySamples(now, 24*time.Hour))
+
+	ppow.NodeRpcUrl = "http://fakenode"
+	assert.Nil(t, ppow.UpdateDifficulty(context.Background()))
+	ppow.SampleDifficulty(now)
+	assert.Equal(t, []DifficultySample{{Timestamp: now, Multiplier: 1.5}}, ppow.DifficultySamples(now, time.Hour))
+
+	// Oldest first, only the ones in the window
+	ppow.difficultySamples.resize(difficultySamplesMax)
+	ppow.difficultySamples.add(now.Add(-7*time.Hour), 3)
+	ppow.difficultySamples.add(now.Add(-2*time.Hour), 2)
+	ppow.difficultySamples.add(now.Add(-30*time.Minute), 1)
+	assert.Equal(t, []DifficultySample{
+		{Timestamp: now.Add(-30 * time.Minute), Multiplier: 1},
+	}, ppow.DifficultySamples(now, time.Hour))
+	assert.Equal(t, []DifficultySample{
+		{Timestamp: now.Add(-2 * time.Hour), Multiplier: 2},
+		{Timestamp: now.Add(-30 * time.Minute), Multiplier: 1},
+	}, ppow.DifficultySamples(now, 6*time.Hour))
+	assert.Len(t, ppow.DifficultySamples(now, 24*time.Hour), 3)
+}
+
+func TestDifficultySamplesBounded(t *testing.T) {
+	ppow := NewPippinPow([]string{}, "", "", nil)
+	now := time.Unix(1700000000, 0)
+
+	// A sample every 30 seconds for 25 hours, only the last 24 hours are kept
+	start := now.Add(-25 * time.Hour)
+	for at := start; !at.After(now); at = at.Add(difficultySampleInterval) {
+		ppow.difficultySamples.add(at, float64(at.Sub(start)/difficultySampleInterval))
+	}
+	samples := ppow.DifficultySamples(now, 48*time.Hour)
+	assert.Len(t, samples, difficultySamplesMax)
+	assert.Equal(t, now.Add(-24*time.Hour+difficultySampleInterval), samples[0].Timestamp)
+	assert.Equal(t, now, samples[len(samples)-1].Timestamp)
+	for i := 1; i < len(samples); i++ {
+		assert.True(t, samples[i].Timestamp.After(samples[i-1].Timestamp))
+	}
+}
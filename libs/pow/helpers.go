@@ -3,6 +3,7 @@ package pow
 import (
 	"encoding/binary"
 	"encoding/hex"
+	"math"
 	"strconv"
 
 	"github.com/bbedward/nanopow"
@@ -14,6 +15,31 @@ const (
 	baseDifficulty = baseMaxUint64 - uint64(0xfffffe0000000000)
 )
 
+// Work thresholds as multipliers of the base difficulty
+// Nano's sends and changes need 64x and its receives 1x, every Banano block only needs 1x
+const (
+	NanoSendMultiplier      = 64
+	NanoReceiveMultiplier   = 1
+	BananoSendMultiplier    = 1
+	BananoReceiveMultiplier = 1
+)
+
+// The multiplier a send or change needs, it's enough for a receive too
+func SendMultiplier(banano bool) int {
+	if banano {
+		return BananoSendMultiplier
+	}
+	return NanoSendMultiplier
+}
+
+// The multiplier a receive needs
+func ReceiveMultiplier(banano bool) int {
+	if banano {
+		return BananoReceiveMultiplier
+	}
+	return NanoReceiveMultiplier
+}
+
 // This is a helper to convert work multiplier to difficulty string representation
 // BoomPoW takes a multiplier while the node/other work servers take the string
 // Our base is banano or nano's receive, which would be 1x
@@ -37,20 +63,42 @@ func MultiplierFromDifficulty(difficulty uint64) int {
 	return int(baseDifficulty / (baseMaxUint64 - difficulty))
 }
 
+// The multiplier of a threshold multiplier times harder than the one of baseMultiplier, rounded up
+func ScaleMultiplier(baseMultiplier int, multiplier float64) int {
+	return int(math.Ceil(float64(baseMultiplier) * multiplier))
+}
+
+// The smallest multiplier whose threshold reaches difficulty, MultiplierFromDifficulty rounds down instead
+func MultiplierReaching(difficulty uint64) int {
+	if difficulty == baseMaxUint64 {
+		return math.MaxInt
+	}
+	multiplier := max(1, int(baseDifficulty/(baseMaxUint64-difficulty)))
+	for DifficultyFromMultiplier(multiplier) < difficulty {
+		multiplier++
+	}
+	return multiplier
+}
+
 func IsWorkValid(previous string, difficultyMultiplier int, w string) bool {
-	difficult := DifficultyFromMultiplier(difficultyMultiplier)
+	difficulty, ok := WorkDifficulty(previous, w)
+	return ok && difficulty >= DifficultyFromMultiplier(difficultyMultiplier)
+}
+
+// The difficulty work reaches for previous, false if either isn't hex
+func WorkDifficulty(previous string, w string) (uint64, bool) {
 	previousEnc, err := hex.DecodeString(previous)
 	if err != nil {
-		return false
+		return 0, false
 	}
 	wEnc, err := hex.DecodeString(w)
 	if err != nil {
-		return false
+		return 0, false
 	}
 
 	hash, err := blake2b.New(8, nil)
 	if err != nil {
-		return false
+		return 0, false
 	}
 
 	n := make([]byte, 8)
@@ -60,7 +108,7 @@ func IsWorkValid(previous string, difficultyMultiplier int, w string) bool {
 	hash.Write(n)
 	hash.Write(previousEnc[:])
 
-	return binary.LittleEndian.Uint64(hash.Sum(nil)) >= difficult
+	return binary.LittleEndian.Uint64(hash.Sum(nil)), true
 }
 
 func reverse(v []byte) {
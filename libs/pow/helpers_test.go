@@ -13,6 +13,14 @@ func TestDifficultyFromMultiplier(t *testing.T) {
 	assert.Equal(t, uint64(0xfffffff800000000), DifficultyFromMultiplier(64))
 }
 
+func TestThresholdMultipliers(t *testing.T) {
+	assert.Equal(t, 64, SendMultiplier(false))
+	assert.Equal(t, 1, ReceiveMultiplier(false))
+	assert.Equal(t, 1, SendMultiplier(true))
+	assert.Equal(t, 1, ReceiveMultiplier(true))
+	assert.Equal(t, "fffffe0000000000", DifficultyToString(DifficultyFromMultiplier(SendMultiplier(true))))
+}
+
 func TestDifficultToString(t *testing.T) {
 	assert.Equal(t, "fffffe0000000000", DifficultyToString(uint64(0xfffffe0000000000)))
 	assert.Equal(t, "fffffff800000000", DifficultyToString(uint64(0xfffffff800000000)))
@@ -61,3 +69,32 @@ func TestMultiplierFromDifficulty(t *testing.T) {
 	assert.Equal(t, 1, MultiplierFromDifficulty(uint64(0xfffffe0000000000)))
 	assert.Equal(t, 64, MultiplierFromDifficulty(uint64(0xfffffff800000000)))
 }
+
+func TestScaleMultiplier(t *testing.T) {
+	assert.Equal(t, 64, ScaleMultiplier(64, 1))
+	assert.Equal(t, 96, ScaleMultiplier(64, 1.5))
+	// Rounded up, so it's at least as hard
+	assert.Equal(t, 2, ScaleMultiplier(1, 1.1))
+}
+
+func TestMultiplierReaching(t *testing.T) {
+	assert.Equal(t, 1, MultiplierReaching(0))
+	assert.Equal(t, 1, MultiplierReaching(0xfffffe0000000000))
+	assert.Equal(t, 64, MultiplierReaching(0xfffffff800000000))
+	// Between two multipliers it's the harder one
+	assert.Equal(t, 65, MultiplierReaching(0xfffffff800000001))
+	assert.GreaterOrEqual(t, DifficultyFromMultiplier(MultiplierReaching(0xfffffffaaaaaaaab)), uint64(0xfffffffaaaaaaaab))
+	assert.GreaterOrEqual(t, DifficultyFromMultiplier(MultiplierReaching(0xffffffffffff0000)), uint64(0xffffffffffff0000))
+}
+
+func TestWorkDifficulty(t *testing.T) {
+	difficulty, ok := WorkDifficulty("3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3", "205452237a9b01f4")
+	assert.True(t, ok)
+	assert.GreaterOrEqual(t, difficulty, DifficultyFromMultiplier(1))
+	assert.Less(t, difficulty, DifficultyFromMultiplier(800))
+
+	_, ok = WorkDifficulty("notahash", "205452237a9b01f4")
+	assert.False(t, ok)
+	_, ok = WorkDifficulty("3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3", "notwork")
+	assert.False(t, ok)
+}
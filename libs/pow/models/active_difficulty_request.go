@@ -0,0 +1,5 @@
+package models
+
+type ActiveDifficultyRequest struct {
+	Action string `json:"action" mapstructure:"action"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeActiveDifficultyRequest(t *testing.T) {
+	encoded := `{"action":"active_difficulty"}`
+	req := ActiveDifficultyRequest{
+		Action: "active_difficulty",
+	}
+	encodedActual, _ := json.Marshal(&req)
+	assert.Equal(t, encoded, string(encodedActual))
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeActiveDifficultyResponse(t *testing.T) {
+	encoded := `{"multiplier":"1.5","network_current":"fffffffaaaaaaaab","network_minimum":"fffffff800000000","network_receive_current":"fffffff07c1f07c2","network_receive_minimum":"fffffe0000000000"}`
+	var decoded ActiveDifficultyResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "1.5", decoded.Multiplier)
+	assert.Equal(t, "fffffffaaaaaaaab", decoded.NetworkCurrent)
+	assert.Equal(t, "fffffff800000000", decoded.NetworkMinimum)
+	assert.Equal(t, "fffffff07c1f07c2", decoded.NetworkReceiveCurrent)
+	assert.Equal(t, "fffffe0000000000", decoded.NetworkReceiveMinimum)
+}
+
+func TestMapStructureDecodeActiveDifficultyResponse(t *testing.T) {
+	request := map[string]interface{}{
+		"multiplier":      "1.5",
+		"network_current": "fffffffaaaaaaaab",
+	}
+	var decoded ActiveDifficultyResponse
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "1.5", decoded.Multiplier)
+	assert.Equal(t, "fffffffaaaaaaaab", decoded.NetworkCurrent)
+	assert.Equal(t, "", decoded.NetworkMinimum)
+}
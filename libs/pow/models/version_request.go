@@ -0,0 +1,5 @@
+package models
+
+type VersionRequest struct {
+	Action string `json:"action" mapstructure:"action"`
+}
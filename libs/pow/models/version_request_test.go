@@ -0,0 +1,17 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeVersionRequest(t *testing.T) {
+	encoded := `{"action":"version"}`
+	req := VersionRequest{
+		Action: "version",
+	}
+	encodedActual, _ := json.Marshal(&req)
+	assert.Equal(t, encoded, string(encodedActual))
+}
@@ -0,0 +1,6 @@
+package models
+
+// Only the fields that tell a nano node from a work server
+type VersionResponse struct {
+	NodeVendor string `json:"node_vendor" mapstructure:"node_vendor"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeVersionResponse(t *testing.T) {
+	encoded := `{"rpc_version":"1","store_version":"21","protocol_version":"19","node_vendor":"Nano V25.1","network":"live"}`
+	var decoded VersionResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "Nano V25.1", decoded.NodeVendor)
+}
+
+func TestMapStructureDecodeVersionResponse(t *testing.T) {
+	request := map[string]interface{}{
+		"node_vendor": "Nano V25.1",
+	}
+	var decoded VersionResponse
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "Nano V25.1", decoded.NodeVendor)
+}
@@ -3,4 +3,6 @@ package models
 type WorkGenerateRequest struct {
 	WorkBaseRequest
 	Difficulty string `json:"difficulty" mapstructure:"difficulty"`
+	// Only nano nodes know the work version, e.g. work_1
+	Version string `json:"version,omitempty" mapstructure:"version,omitempty"`
 }
@@ -20,3 +20,17 @@ func TestEncodeWorkGenerateRequest(t *testing.T) {
 	encodedActual, _ := json.Marshal(&req)
 	assert.Equal(t, encoded, string(encodedActual))
 }
+
+func TestEncodeWorkGenerateRequestWithVersion(t *testing.T) {
+	encoded := `{"action":"work_generate","hash":"abc","difficulty":"def","version":"work_1"}`
+	req := WorkGenerateRequest{
+		WorkBaseRequest: WorkBaseRequest{
+			Action: "work_generate",
+			Hash:   "abc",
+		},
+		Difficulty: "def",
+		Version:    "work_1",
+	}
+	encodedActual, _ := json.Marshal(&req)
+	assert.Equal(t, encoded, string(encodedActual))
+}
@@ -16,49 +16,54 @@ import (
 func MakeRequest(ctx context.Context, url string, request interface{}, authorization string) ([]byte, error) {
 	requestBody, _ := json.Marshal(request)
 	// HTTP post
-	httpRequest, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(requestBody))
+	// The request is aborted when ctx is done, e.g. when another peer returned work first
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(requestBody))
 	if err != nil {
-		log.Errorf("Error building request %s", err)
+		log.Ctx(ctx).Errorf("Error building request %s", err)
 		return nil, err
 	}
 	httpRequest.Header.Add("Content-Type", "application/json")
+	if id := log.RequestID(ctx); id != "" {
+		httpRequest.Header.Add(log.RequestIDHeader, id)
+	}
 	if authorization != "" {
 		httpRequest.Header.Add("Authorization", authorization)
 	}
-	httpRequest.WithContext(ctx)
 	client := &http.Client{}
 	resp, err := client.Do(httpRequest)
 	if err != nil {
-		log.Errorf("Error making RPC request %s", err)
+		log.Ctx(ctx).Errorf("Error making RPC request %s", err)
 		return nil, err
 	}
 	defer resp.Body.Close()
 	// Try to decode+deserialize
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		log.Errorf("Error decoding response body %s", err)
+		log.Ctx(ctx).Errorf("Error decoding response body %s", err)
 		return nil, err
 	}
 	return body, nil
 }
 
-func MakeWorkGenerateRequest(ctx context.Context, url string, hash string, difficulty string) (*models.WorkGenerateResponse, error) {
+// version is only sent if it's set, work servers don't know it
+func MakeWorkGenerateRequest(ctx context.Context, url string, hash string, difficulty string, version string) (*models.WorkGenerateResponse, error) {
 	request := models.WorkGenerateRequest{
 		WorkBaseRequest: models.WorkBaseRequest{
 			Action: "work_generate",
 			Hash:   hash,
 		},
 		Difficulty: difficulty,
+		Version:    version,
 	}
 	response, err := MakeRequest(ctx, url, request, "")
 	if err != nil {
-		log.Errorf("Error making request %s", err)
+		log.Ctx(ctx).Errorf("Error making request %s", err)
 		return nil, err
 	}
 	var resp models.WorkGenerateResponse
 	err = json.Unmarshal(response, &resp)
 	if err != nil {
-		log.Errorf("Error unmarshalling response %s", err)
+		log.Ctx(ctx).Errorf("Error unmarshalling response %s", err)
 		return nil, err
 	}
 	// Check that it's not empty
@@ -69,6 +74,22 @@ func MakeWorkGenerateRequest(ctx context.Context, url string, hash string, diffi
 	return &resp, nil
 }
 
+// Ask a work peer for its version, only nano nodes answer with a node_vendor
+// Work servers answer with an error or something that isn't JSON, that's an empty response, not an error
+func MakeVersionRequest(ctx context.Context, url string) (*models.VersionResponse, error) {
+	request := models.VersionRequest{
+		Action: "version",
+	}
+	response, err := MakeRequest(ctx, url, request, "")
+	if err != nil {
+		log.Ctx(ctx).Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp models.VersionResponse
+	json.Unmarshal(response, &resp)
+	return &resp, nil
+}
+
 // We don't care about the response for work cancel
 func MakeWorkCancelRequest(ctx context.Context, url string, hash string) error {
 	request := models.WorkBaseRequest{
@@ -77,7 +98,7 @@ func MakeWorkCancelRequest(ctx context.Context, url string, hash string) error {
 	}
 	_, err := MakeRequest(ctx, url, request, "")
 	if err != nil {
-		log.Errorf("Error making request %s", err)
+		log.Ctx(ctx).Errorf("Error making request %s", err)
 		return err
 	}
 	return nil
@@ -94,13 +115,13 @@ func MakeBoompowWorkGenerateRequest(ctx context.Context, url string, bpowKey str
 	}
 	response, err := MakeRequest(ctx, url, request, bpowKey)
 	if err != nil {
-		log.Errorf("Error making request %s", err)
+		log.Ctx(ctx).Errorf("Error making request %s", err)
 		return "", err
 	}
 	var resp models.BoompowResponse
 	err = json.Unmarshal(response, &resp)
 	if err != nil {
-		log.Errorf("Error unmarshalling response %s", err)
+		log.Ctx(ctx).Errorf("Error unmarshalling response %s", err)
 		return "", err
 	}
 	// Check that it's not empty
@@ -110,3 +131,26 @@ func MakeBoompowWorkGenerateRequest(ctx context.Context, url string, bpowKey str
 
 	return resp.Data.WorkGenerate, nil
 }
+
+func MakeActiveDifficultyRequest(ctx context.Context, url string) (*models.ActiveDifficultyResponse, error) {
+	request := models.ActiveDifficultyRequest{
+		Action: "active_difficulty",
+	}
+	response, err := MakeRequest(ctx, url, request, "")
+	if err != nil {
+		log.Ctx(ctx).Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp models.ActiveDifficultyResponse
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		log.Ctx(ctx).Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// Check that it's not empty
+	if resp.NetworkCurrent == "" {
+		return nil, errors.New("Unable to get active difficulty")
+	}
+
+	return &resp, nil
+}
@@ -32,7 +32,7 @@ func TestWorkGenerate(t *testing.T) {
 		},
 	)
 
-	resp, err := MakeWorkGenerateRequest(context.TODO(), "https://workurl.com", "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3", "ffffffffffff")
+	resp, err := MakeWorkGenerateRequest(context.TODO(), "https://workurl.com", "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3", "ffffffffffff", "")
 	assert.Nil(t, err)
 	assert.Equal(t, "abcd1234", resp.Work)
 
@@ -46,11 +46,35 @@ func TestWorkGenerate(t *testing.T) {
 		},
 	)
 
-	resp, err = MakeWorkGenerateRequest(context.TODO(), "https://workurl.com", "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3", "ffffffffffff")
+	resp, err = MakeWorkGenerateRequest(context.TODO(), "https://workurl.com", "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3", "ffffffffffff", "")
 	assert.NotNil(t, err)
 	assert.ErrorContains(t, err, "Unable to generate work")
 }
 
+func TestVersion(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "https://nodeurl.com",
+		httpmock.NewStringResponder(200, `{"node_vendor":"Nano V25.1"}`))
+	httpmock.RegisterResponder("POST", "https://workurl.com",
+		httpmock.NewStringResponder(200, `{"error":"Unknown action"}`))
+	httpmock.RegisterResponder("POST", "https://gpuurl.com",
+		httpmock.NewStringResponder(404, `Not Found`))
+
+	resp, err := MakeVersionRequest(context.TODO(), "https://nodeurl.com")
+	assert.Nil(t, err)
+	assert.Equal(t, "Nano V25.1", resp.NodeVendor)
+	resp, err = MakeVersionRequest(context.TODO(), "https://workurl.com")
+	assert.Nil(t, err)
+	assert.Equal(t, "", resp.NodeVendor)
+	resp, err = MakeVersionRequest(context.TODO(), "https://gpuurl.com")
+	assert.Nil(t, err)
+	assert.Equal(t, "", resp.NodeVendor)
+	_, err = MakeVersionRequest(context.TODO(), "https://unreachable.com")
+	assert.NotNil(t, err)
+}
+
 func TestWorkCancel(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -97,3 +121,38 @@ func TestBoompowWorkGenerate(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "abcd1234", resp)
 }
+
+func TestActiveDifficulty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "https://nodeurl.com",
+		func(req *http.Request) (*http.Response, error) {
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"multiplier":      "1.5",
+				"network_current": "fffffffaaaaaaaab",
+				"network_minimum": "fffffff800000000",
+			})
+			return resp, err
+		},
+	)
+
+	resp, err := MakeActiveDifficultyRequest(context.TODO(), "https://nodeurl.com")
+	assert.Nil(t, err)
+	assert.Equal(t, "fffffffaaaaaaaab", resp.NetworkCurrent)
+	assert.Equal(t, "1.5", resp.Multiplier)
+
+	// Error
+	httpmock.RegisterResponder("POST", "https://nodeurl.com",
+		func(req *http.Request) (*http.Response, error) {
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "abcd1234",
+			})
+			return resp, err
+		},
+	)
+
+	resp, err = MakeActiveDifficultyRequest(context.TODO(), "https://nodeurl.com")
+	assert.NotNil(t, err)
+	assert.ErrorContains(t, err, "Unable to get active difficulty")
+}
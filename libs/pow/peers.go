@@ -0,0 +1,209 @@
+package pow
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow/net"
+)
+
+var ErrInvalidWorkPeer = errors.New("invalid work peer")
+var ErrWorkPeerExists = errors.New("work peer already exists")
+var ErrWorkPeerNotFound = errors.New("work peer not found")
+
+// The average latency of a peer is over this many of its last successful calls
+const peerLatencySamples = 20
+
+// Work peers are nano nodes or work servers like nano-work-server, they're told apart with a version call the first time work is requested from them
+// Both are sent the difficulty, nodes are also sent the work version so they don't generate work of another one
+const (
+	PeerProtocolUnknown    = ""
+	PeerProtocolNode       = "node"
+	PeerProtocolWorkServer = "work_server"
+)
+
+const nodeWorkVersion = "work_1"
+
+// What happened the last times work was requested from a peer
+type peerHealth struct {
+	protocol    string
+	lastSuccess time.Time
+	lastFailure time.Time
+	latencies   []time.Duration
+	successes   int
+	failures    int
+	invalidWork int
+}
+
+// The health of a work peer, times are nil if it never succeeded or failed
+// Protocol is PeerProtocolUnknown until work was requested from it
+// Failures include the InvalidWork, work that wasn't enough for the difficulty
+type WorkPeerHealth struct {
+	URL            string
+	Protocol       string
+	LastSuccess    *time.Time
+	LastFailure    *time.Time
+	AverageLatency time.Duration
+	Successes      int
+	Failures       int
+	InvalidWork    int
+}
+
+// The URLs work_generate requests are sent to
+func (p *PippinPow) WorkPeers() []string {
+	p.peersMutex.RLock()
+	defer p.peersMutex.RUnlock()
+	return slices.Clone(p.workPeers)
+}
+
+// Replace the work peers, requests already running keep the old ones
+// The health of peers that are kept stays
+func (p *PippinPow) SetWorkPeers(workPeers []string) {
+	p.peersMutex.Lock()
+	defer p.peersMutex.Unlock()
+	p.workPeers = slices.Clone(workPeers)
+	for peer := range p.peerHealth {
+		if !slices.Contains(p.workPeers, peer) {
+			delete(p.peerHealth, peer)
+		}
+	}
+}
+
+// Add a work peer, it's used from the next work request on
+// Peers added this way are replaced when the config is reloaded
+func (p *PippinPow) AddWorkPeer(peer string) error {
+	parsed, err := url.Parse(peer)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ErrInvalidWorkPeer
+	}
+	p.peersMutex.Lock()
+	defer p.peersMutex.Unlock()
+	if slices.Contains(p.workPeers, peer) {
+		return ErrWorkPeerExists
+	}
+	p.workPeers = append(slices.Clone(p.workPeers), peer)
+	return nil
+}
+
+// Remove a work peer, requests already running still wait for it
+func (p *PippinPow) RemoveWorkPeer(peer string) error {
+	p.peersMutex.Lock()
+	defer p.peersMutex.Unlock()
+	idx := slices.Index(p.workPeers, peer)
+	if idx < 0 {
+		return ErrWorkPeerNotFound
+	}
+	p.workPeers = slices.Delete(slices.Clone(p.workPeers), idx, idx+1)
+	delete(p.peerHealth, peer)
+	return nil
+}
+
+// The health of every work peer, in the order they were configured
+func (p *PippinPow) WorkPeersHealth() []WorkPeerHealth {
+	p.peersMutex.RLock()
+	defer p.peersMutex.RUnlock()
+	health := make([]WorkPeerHealth, len(p.workPeers))
+	for i, peer := range p.workPeers {
+		health[i].URL = peer
+		h, ok := p.peerHealth[peer]
+		if !ok {
+			continue
+		}
+		health[i].Protocol = h.protocol
+		health[i].Successes = h.successes
+		health[i].Failures = h.failures
+		health[i].InvalidWork = h.invalidWork
+		if !h.lastSuccess.IsZero() {
+			lastSuccess := h.lastSuccess
+			health[i].LastSuccess = &lastSuccess
+		}
+		if !h.lastFailure.IsZero() {
+			lastFailure := h.lastFailure
+			health[i].LastFailure = &lastFailure
+		}
+		if len(h.latencies) > 0 {
+			var total time.Duration
+			for _, latency := range h.latencies {
+				total += latency
+			}
+			health[i].AverageLatency = total / time.Duration(len(h.latencies))
+		}
+	}
+	return health
+}
+
+// Health of a peer that's still configured, nil if it was removed while its request was running
+func (p *PippinPow) healthOf(peer string) *peerHealth {
+	if !slices.Contains(p.workPeers, peer) {
+		return nil
+	}
+	if p.peerHealth == nil {
+		p.peerHealth = map[string]*peerHealth{}
+	}
+	h, ok := p.peerHealth[peer]
+	if !ok {
+		h = &peerHealth{}
+		p.peerHealth[peer] = h
+	}
+	return h
+}
+
+func (p *PippinPow) recordPeerSuccess(peer string, latency time.Duration) {
+	p.peersMutex.Lock()
+	defer p.peersMutex.Unlock()
+	h := p.healthOf(peer)
+	if h == nil {
+		return
+	}
+	h.lastSuccess = time.Now()
+	h.successes++
+	h.latencies = append(h.latencies, latency)
+	if len(h.latencies) > peerLatencySamples {
+		h.latencies = h.latencies[len(h.latencies)-peerLatencySamples:]
+	}
+}
+
+// invalidWork if the peer returned work that wasn't enough
+func (p *PippinPow) recordPeerFailure(peer string, invalidWork bool) {
+	p.peersMutex.Lock()
+	defer p.peersMutex.Unlock()
+	h := p.healthOf(peer)
+	if h == nil {
+		return
+	}
+	h.lastFailure = time.Now()
+	h.failures++
+	if invalidWork {
+		h.invalidWork++
+	}
+}
+
+// The protocol of a peer, asked for with a version call if we don't know it yet
+// It stays unknown if the peer can't be reached, it's asked again the next time
+func (p *PippinPow) peerProtocol(ctx context.Context, peer string) string {
+	p.peersMutex.RLock()
+	h, ok := p.peerHealth[peer]
+	if ok && h.protocol != PeerProtocolUnknown {
+		p.peersMutex.RUnlock()
+		return h.protocol
+	}
+	p.peersMutex.RUnlock()
+
+	resp, err := net.MakeVersionRequest(ctx, peer)
+	if err != nil {
+		return PeerProtocolUnknown
+	}
+	protocol := PeerProtocolWorkServer
+	if resp.NodeVendor != "" {
+		protocol = PeerProtocolNode
+	}
+	p.peersMutex.Lock()
+	defer p.peersMutex.Unlock()
+	if h := p.healthOf(peer); h != nil {
+		h.protocol = protocol
+	}
+	return protocol
+}
@@ -0,0 +1,155 @@
+package pow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddAndRemoveWorkPeer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	calls := 0
+	httpmock.RegisterResponder("POST", "https://addedpeer.com",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "work_generate" {
+				calls++
+			}
+			return httpmock.NewStringResponse(200, `{"work":"addedwork"}`), nil
+		},
+	)
+
+	ppow := NewPippinPow([]string{"https://workerurl1.com"}, "", "", DefaultTimeoutPolicy{Timeout: 50 * time.Millisecond})
+	assert.ErrorIs(t, ppow.AddWorkPeer("addedpeer.com"), ErrInvalidWorkPeer)
+	assert.ErrorIs(t, ppow.AddWorkPeer("ftp://addedpeer.com"), ErrInvalidWorkPeer)
+	assert.ErrorIs(t, ppow.AddWorkPeer("https://workerurl1.com"), ErrWorkPeerExists)
+	assert.ErrorIs(t, ppow.RemoveWorkPeer("https://addedpeer.com"), ErrWorkPeerNotFound)
+
+	// The added peer is asked on the next request
+	assert.Nil(t, ppow.AddWorkPeer("https://addedpeer.com"))
+	assert.Nil(t, ppow.RemoveWorkPeer("https://workerurl1.com"))
+	assert.Equal(t, []string{"https://addedpeer.com"}, ppow.WorkPeers())
+	work, err := ppow.WorkGenerateMeta("abcdef", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "addedwork", work)
+	assert.Equal(t, 1, calls)
+
+	// Removed, so it falls back to local work, which can't work on an invalid hash
+	assert.Nil(t, ppow.RemoveWorkPeer("https://addedpeer.com"))
+	assert.Len(t, ppow.WorkPeers(), 0)
+	_, err = ppow.WorkGenerateMeta("abcdef", 1, false, false, "")
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestWorkPeersHealth(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "https://goodpeer.com",
+		httpmock.NewStringResponder(200, `{"work":"goodwork"}`))
+	httpmock.RegisterResponder("POST", "https://badpeer.com",
+		httpmock.NewStringResponder(200, `{"error":"error"}`))
+	httpmock.RegisterResponder("POST", "https://canceledpeer.com",
+		func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		},
+	)
+
+	ppow := NewPippinPow([]string{"https://goodpeer.com", "https://badpeer.com", "https://canceledpeer.com"}, "", "", nil)
+	health := ppow.WorkPeersHealth()
+	assert.Len(t, health, 3)
+	assert.Equal(t, WorkPeerHealth{URL: "https://goodpeer.com"}, health[0])
+
+	out := make(chan *string, 1)
+	ppow.workGenerateAPIRequest(context.Background(), "https://goodpeer.com", "abcdef", 1, "", false, out)
+	assert.Equal(t, "goodwork", *<-out)
+	ppow.workGenerateAPIRequest(context.Background(), "https://badpeer.com", "abcdef", 1, "", false, out)
+	// Another peer was faster
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	ppow.workGenerateAPIRequest(ctx, "https://canceledpeer.com", "abcdef", 1, "", false, out)
+
+	health = ppow.WorkPeersHealth()
+	assert.Equal(t, "https://goodpeer.com", health[0].URL)
+	assert.NotNil(t, health[0].LastSuccess)
+	assert.Nil(t, health[0].LastFailure)
+	assert.Greater(t, health[0].AverageLatency, time.Duration(0))
+	assert.Nil(t, health[1].LastSuccess)
+	assert.NotNil(t, health[1].LastFailure)
+	assert.Equal(t, time.Duration(0), health[1].AverageLatency)
+	assert.Equal(t, WorkPeerHealth{URL: "https://canceledpeer.com"}, health[2])
+
+	// Only the recent calls count for the latency
+	for i := 0; i < peerLatencySamples+5; i++ {
+		ppow.recordPeerSuccess("https://goodpeer.com", time.Second)
+	}
+	assert.Equal(t, time.Second, ppow.WorkPeersHealth()[0].AverageLatency)
+
+	// Kept by a reload that keeps the peer, dropped with it
+	ppow.SetWorkPeers([]string{"https://goodpeer.com"})
+	assert.NotNil(t, ppow.WorkPeersHealth()[0].LastSuccess)
+	ppow.SetWorkPeers([]string{"https://badpeer.com"})
+	assert.Equal(t, []WorkPeerHealth{{URL: "https://badpeer.com"}}, ppow.WorkPeersHealth())
+}
+
+func TestWorkPeerProtocol(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// The version each peer got its work_generate with
+	versions := map[string]interface{}{}
+	responder := func(url string, nodeVendor string) httpmock.Responder {
+		return func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "version" {
+				if nodeVendor == "" {
+					return httpmock.NewStringResponse(200, `{"error":"Unknown action"}`), nil
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"node_vendor": nodeVendor})
+			}
+			versions[url] = pr["version"]
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"work": "badwork"})
+		}
+	}
+	httpmock.RegisterResponder("POST", "https://nodepeer.com", responder("https://nodepeer.com", "Nano V25.1"))
+	httpmock.RegisterResponder("POST", "https://workserver.com", responder("https://workserver.com", ""))
+
+	ppow := NewPippinPow([]string{"https://nodepeer.com", "https://workserver.com"}, "", "", nil)
+	out := make(chan *string, 1)
+	ppow.workGenerateAPIRequest(context.Background(), "https://nodepeer.com", "abcdef", 1, "ffffffc000000000", false, out)
+	assert.Equal(t, "badwork", *<-out)
+	// Not enough for the difficulty
+	ppow.workGenerateAPIRequest(context.Background(), "https://workserver.com", "abcdef", 1, "ffffffc000000000", true, out)
+	assert.Empty(t, out)
+	assert.Equal(t, "work_1", versions["https://nodepeer.com"])
+	assert.Nil(t, versions["https://workserver.com"])
+
+	// The version is only asked for once
+	assert.Equal(t, 2, httpmock.GetCallCountInfo()["POST https://nodepeer.com"])
+	ppow.workGenerateAPIRequest(context.Background(), "https://nodepeer.com", "abcdef", 1, "ffffffc000000000", false, out)
+	<-out
+	assert.Equal(t, 3, httpmock.GetCallCountInfo()["POST https://nodepeer.com"])
+
+	health := ppow.WorkPeersHealth()
+	assert.Equal(t, PeerProtocolNode, health[0].Protocol)
+	assert.Equal(t, 2, health[0].Successes)
+	assert.Equal(t, 0, health[0].Failures)
+	assert.Equal(t, PeerProtocolWorkServer, health[1].Protocol)
+	assert.Equal(t, 0, health[1].Successes)
+	assert.Equal(t, 1, health[1].Failures)
+	assert.Equal(t, 1, health[1].InvalidWork)
+}
@@ -5,22 +5,59 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/pow/net"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/metrics"
 	"github.com/bbedward/nanopow"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
+var ErrWorkCancelled = errors.New("work generation was cancelled")
+
+// Where work came from, for workDuration
+const (
+	workSourceLocal   = "local"
+	workSourcePeer    = "peer"
+	workSourceBoompow = "boompow"
+)
+
+var workDuration = metrics.NewHistogramVec("pippin_work_generate_duration_seconds", "Seconds to generate valid work, by source: local, peer or boompow", []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 120}, "source")
+
 type PippinPow struct {
-	WorkPeers        []string
+	// Node to get active_difficulty from, if empty the static thresholds are always used
+	// Use SetNodeRpcUrl once the difficulty updater is running
+	NodeRpcUrl string
+	// Send work_generate to every work peer at once and use the first response
+	// Otherwise the peers are tried one at a time, in order, until one returns work
+	Concurrent       bool
+	workPeers        []string
+	peerHealth       map[string]*peerHealth
+	peersMutex       sync.RWMutex
 	workPeersFailing bool
-	bpowKey          string
-	bpowUrl          string
-	timeout          time.Duration
-	mutex            sync.Mutex
+	// Tried one at a time in this order, if empty the peers and BoomPoW are asked at once
+	workSources       []string
+	bpowKey           string
+	bpowUrl           string
+	timeoutPolicy     TimeoutPolicy
+	networkDifficulty uint64
+	// network_minimum and network_receive_current, 0 if the node didn't return them
+	networkMinimum           uint64
+	networkReceiveDifficulty uint64
+	networkMultiplier        float64
+	difficultyHistory        difficultyHistory
+	difficultySamples        difficultyHistory
+	queue                    workQueue
+	// Generated work is reused from it, nil if it isn't set
+	workCache WorkCache
+	mutex     sync.Mutex
 }
 
 func (p *PippinPow) WorkPeersFailing() bool {
@@ -35,42 +72,226 @@ func (p *PippinPow) SetWorkPeersFailing(failing bool) {
 	p.workPeersFailing = failing
 }
 
+// Replace the node active_difficulty comes from
+func (p *PippinPow) SetNodeRpcUrl(url string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.NodeRpcUrl = url
+}
+
+func (p *PippinPow) nodeRpcUrl() string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.NodeRpcUrl
+}
+
+// Replace the timeout policy, nil is the DefaultWorkTimeout for everything
+func (p *PippinPow) SetTimeoutPolicy(timeoutPolicy TimeoutPolicy) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if timeoutPolicy == nil {
+		timeoutPolicy = DefaultTimeoutPolicy{}
+	}
+	p.timeoutPolicy = timeoutPolicy
+}
+
+func (p *PippinPow) getTimeoutPolicy() TimeoutPolicy {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.timeoutPolicy
+}
+
+// The network's current difficulty as of the last UpdateDifficulty
+// Falls back to the static base threshold if the node hasn't been reachable
+func (p *PippinPow) CurrentDifficulty() uint64 {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.networkDifficulty == 0 {
+		return DifficultyFromMultiplier(1)
+	}
+	return p.networkDifficulty
+}
+
+// Raise a static multiplier to what the network needs now
+// active_difficulty's multiplier is for work at network_minimum, the send threshold, so it only scales work at least that hard
+// Easier work is for receives, it's raised to network_receive_current instead, if the node returns it
+func (p *PippinPow) networkAdjustedMultiplier(difficultyMultiplier int) int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if DifficultyFromMultiplier(difficultyMultiplier) < p.networkMinimum {
+		if p.networkReceiveDifficulty == 0 {
+			return difficultyMultiplier
+		}
+		return max(difficultyMultiplier, MultiplierReaching(p.networkReceiveDifficulty))
+	}
+	if p.networkMultiplier <= 1 {
+		return difficultyMultiplier
+	}
+	return int(math.Ceil(float64(difficultyMultiplier) * p.networkMultiplier))
+}
+
+// Refresh the cached difficulty from the node's active_difficulty
+// If the node can't be reached the cache is cleared, so work falls back to the static thresholds
+func (p *PippinPow) UpdateDifficulty(ctx context.Context) error {
+	url := p.nodeRpcUrl()
+	if url == "" {
+		return errors.New("no node configured for active_difficulty")
+	}
+	active, err := p.fetchActiveDifficulty(ctx, url)
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if err != nil {
+		p.networkDifficulty = 0
+		p.networkMinimum = 0
+		p.networkReceiveDifficulty = 0
+		p.networkMultiplier = 0
+		return err
+	}
+	p.networkDifficulty = active.current
+	p.networkMinimum = active.minimum
+	p.networkReceiveDifficulty = active.receiveCurrent
+	p.networkMultiplier = active.multiplier
+	p.difficultyHistory.add(time.Now(), active.multiplier)
+	return nil
+}
+
+// active_difficulty's thresholds, minimum and receiveCurrent are 0 if the node doesn't return them
+type activeDifficulty struct {
+	current        uint64
+	minimum        uint64
+	receiveCurrent uint64
+	multiplier     float64
+}
+
+func (p *PippinPow) fetchActiveDifficulty(ctx context.Context, url string) (*activeDifficulty, error) {
+	resp, err := net.MakeActiveDifficultyRequest(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	active := &activeDifficulty{}
+	if active.current, err = strconv.ParseUint(resp.NetworkCurrent, 16, 64); err != nil {
+		return nil, err
+	}
+	if resp.NetworkMinimum != "" {
+		if active.minimum, err = strconv.ParseUint(resp.NetworkMinimum, 16, 64); err != nil {
+			return nil, err
+		}
+	}
+	if resp.NetworkReceiveCurrent != "" {
+		if active.receiveCurrent, err = strconv.ParseUint(resp.NetworkReceiveCurrent, 16, 64); err != nil {
+			return nil, err
+		}
+	}
+	if active.multiplier, err = strconv.ParseFloat(resp.Multiplier, 64); err != nil {
+		return nil, err
+	}
+	return active, nil
+}
+
+// Call UpdateDifficulty every interval until ctx is done
+// DifficultyHistory keeps an hour of its samples
+func (p *PippinPow) StartDifficultyUpdater(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	p.difficultyHistory.resize(int(difficultyHistoryWindow / interval))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := p.UpdateDifficulty(ctx); err != nil {
+			log.Warnf("Unable to get active difficulty, using static thresholds %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 // workPeers is an array of URLs to send work_generate requests to
 // bpowKey and bpowUrl are optional, bpowUrl will default to boompow.banano.cc/graphql
-func NewPippinPow(workPeers []string, bpowKey string, bpowUrl string, workTimeout int) *PippinPow {
+// timeoutPolicy decides how long to wait for work, nil is the DefaultWorkTimeout for everything
+func NewPippinPow(workPeers []string, bpowKey string, bpowUrl string, timeoutPolicy TimeoutPolicy) *PippinPow {
 	if bpowUrl == "" {
 		bpowUrl = "https://boompow.banano.cc/graphql"
 	}
-	return &PippinPow{
-		WorkPeers: workPeers,
+	if timeoutPolicy == nil {
+		timeoutPolicy = DefaultTimeoutPolicy{}
+	}
+	p := &PippinPow{
+		workPeers: workPeers,
 		// If peers are failing we will generate local pow no matter what
 		workPeersFailing: false,
 		bpowUrl:          bpowUrl,
 		bpowKey:          bpowKey,
-		timeout:          time.Duration(workTimeout) * time.Second,
+		timeoutPolicy:    timeoutPolicy,
+		Concurrent:       true,
 	}
+	p.difficultySamples.resize(difficultySamplesMax)
+	return p
 }
 
-// Makes a request to configured array of work peers
-func (p *PippinPow) workGenerateAPIRequest(ctx context.Context, url string, hash string, difficultyMultiplier int, difficulty string, validate bool, out chan *string) {
-	resp, err := net.MakeWorkGenerateRequest(ctx, url, hash, difficulty)
+// Makes a request to one work peer, returns false if it didn't return valid work
+func (p *PippinPow) peerWorkGenerate(ctx context.Context, url string, hash string, difficultyMultiplier int, difficulty string, validate bool) (string, bool) {
+	start := time.Now()
+	version := ""
+	if p.peerProtocol(ctx, url) == PeerProtocolNode {
+		version = nodeWorkVersion
+	}
+	resp, err := net.MakeWorkGenerateRequest(ctx, url, hash, difficulty, version)
 	if err == nil && resp.Work != "" {
 		// Validate work
 		if IsWorkValid(hash, difficultyMultiplier, resp.Work) || !validate {
+			workDuration.ObserveSince(start, workSourcePeer)
+			p.recordPeerSuccess(url, time.Since(start))
 			p.SetWorkPeersFailing(false)
-			WriteChannelSafe(out, resp.Work)
-		} else {
-			log.Errorf("Received invalid work %s for %s from %s", resp.Work, hash, url)
+			return resp.Work, true
+		}
+		p.recordPeerFailure(url, true)
+		log.Errorf("Received invalid work %s for %s from %s", resp.Work, hash, url)
+	} else if !errors.Is(err, context.Canceled) {
+		// Canceled means another peer was faster, that's not a failure
+		p.recordPeerFailure(url, false)
+	}
+	return "", false
+}
+
+// Makes a request to configured array of work peers
+func (p *PippinPow) workGenerateAPIRequest(ctx context.Context, url string, hash string, difficultyMultiplier int, difficulty string, validate bool, out chan *string) {
+	if work, ok := p.peerWorkGenerate(ctx, url, hash, difficultyMultiplier, difficulty, validate); ok {
+		WriteChannelSafe(out, work)
+	}
+}
+
+// Makes a request to each work peer in order until one returns work
+// Each peer gets an equal share of the time that's left, so one that hangs doesn't use up the whole timeout
+func (p *PippinPow) workGenerateSequentialAPIRequest(ctx context.Context, urls []string, hash string, difficultyMultiplier int, difficulty string, validate bool, out chan *string) {
+	for i, url := range urls {
+		peerCtx, cancel := ctx, context.CancelFunc(func() {})
+		if deadline, ok := ctx.Deadline(); ok {
+			peerCtx, cancel = context.WithTimeout(ctx, time.Until(deadline)/time.Duration(len(urls)-i))
+		}
+		work, ok := p.peerWorkGenerate(peerCtx, url, hash, difficultyMultiplier, difficulty, validate)
+		cancel()
+		if ok {
+			WriteChannelSafe(out, work)
+			return
+		}
+		if ctx.Err() != nil {
+			return
 		}
 	}
 }
 
 // Makes a request to BoomPoW
 func (p *PippinPow) workGenerateBpowRequest(ctx context.Context, hash string, difficulty int, validate bool, blockAward bool, bpowKey string, out chan *string) {
+	start := time.Now()
 	resp, err := net.MakeBoompowWorkGenerateRequest(ctx, p.bpowUrl, bpowKey, hash, difficulty, blockAward)
 	if err == nil && resp != "" {
 		// Validate work
 		if IsWorkValid(hash, difficulty, resp) || !validate {
+			workDuration.ObserveSince(start, workSourceBoompow)
 			p.SetWorkPeersFailing(false)
 			WriteChannelSafe(out, resp)
 		} else {
@@ -81,6 +302,7 @@ func (p *PippinPow) workGenerateBpowRequest(ctx context.Context, hash string, di
 
 // Use GPU or CPU to generate work
 func (p *PippinPow) generateWorkLocally(hash string, difficultyMultiplier int) (string, error) {
+	start := time.Now()
 	// Generate work locally
 	if !utils.Validate64HexHash(hash) {
 		return "", errors.New("invalid hash")
@@ -94,11 +316,21 @@ func (p *PippinPow) generateWorkLocally(hash string, difficultyMultiplier int) (
 	if err != nil {
 		return "", err
 	}
+	workDuration.ObserveSince(start, workSourceLocal)
 	return WorkToString(res), nil
 }
 
 // Will use OpenCL if compiled with -tags cl, otherwise pure golang implementation
-func (p *PippinPow) workGenerateLocal(ctx context.Context, hash string, difficultyMultiplier int, validate bool, out chan *string) {
+// Waits in the queue until no other job is generating work locally
+func (p *PippinPow) workGenerateLocal(ctx context.Context, job *workJob, hash string, difficultyMultiplier int, validate bool, out chan *string) {
+	if !p.queue.acquireLocal(ctx) {
+		return
+	}
+	defer p.queue.releaseLocal()
+	if ctx.Err() != nil {
+		return
+	}
+	p.queue.start(job)
 	// ! TODO - work out a way to cancel
 	work, err := p.generateWorkLocally(hash, difficultyMultiplier)
 	if err == nil {
@@ -117,21 +349,71 @@ func WorkCancelAPIRequest(url string, hash string) {
 
 // The main entry point for Pippin WorkGenerate
 // Invokes work_generate requests to every peer simultaneously including BoomPoW, depending on configuration
-// Returns the first valid work response, sends cancel to everybody else
+// Without Concurrent the peers are tried one after the other, alongside BoomPoW
+// With work sources set they're tried one at a time in that order instead, see SetWorkSources
+// Returns the first valid work response, the other requests are cancelled and the peers are sent work_cancel
 // If no peers or boompow configured, uses local PoW
 // If all peers fail, will use local PoW until peers are responsive again
 func (p *PippinPow) WorkGenerateMeta(hash string, difficultyMultiplier int, validate bool, blockAward bool, bpowKey string) (string, error) {
+	return p.WorkGenerateForAccount("", nil, hash, difficultyMultiplier, validate, blockAward, bpowKey)
+}
 
+// Same as WorkGenerateMeta, the timeout comes from the TimeoutPolicy for account and amount
+// amount is what a send block sends, nil for other blocks
+func (p *PippinPow) WorkGenerateForAccount(account string, amount *big.Int, hash string, difficultyMultiplier int, validate bool, blockAward bool, bpowKey string) (string, error) {
+	return p.WorkGenerateForAccountWithContext(context.Background(), account, amount, hash, difficultyMultiplier, validate, blockAward, bpowKey)
+}
+
+// Same as WorkGenerateForAccount, for the request of ctx, the peers get its request ID
+// Generation is given up on when ctx is done, like with WorkCancelAll
+func (p *PippinPow) WorkGenerateForAccountWithContext(ctx context.Context, account string, amount *big.Int, hash string, difficultyMultiplier int, validate bool, blockAward bool, bpowKey string) (string, error) {
+	// Ask for more work when the network is saturated
+	return p.WorkGenerateAtMultiplierWithContext(ctx, account, amount, hash, p.networkAdjustedMultiplier(difficultyMultiplier), validate, blockAward, bpowKey)
+}
+
+// Same as WorkGenerateForAccount, but difficultyMultiplier isn't raised for the network, for a difficulty a request asked for
+func (p *PippinPow) WorkGenerateAtMultiplier(account string, amount *big.Int, hash string, difficultyMultiplier int, validate bool, blockAward bool, bpowKey string) (string, error) {
+	return p.WorkGenerateAtMultiplierWithContext(context.Background(), account, amount, hash, difficultyMultiplier, validate, blockAward, bpowKey)
+}
+
+// Same as WorkGenerateAtMultiplier, for the request of ctx
+func (p *PippinPow) WorkGenerateAtMultiplierWithContext(ctx context.Context, account string, amount *big.Int, hash string, difficultyMultiplier int, validate bool, blockAward bool, bpowKey string) (work string, err error) {
 	// 1 hard coded valid work is just for higher level integration tests so we don't need to calculate real work
 	if hash == "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3" {
 		return "205452237a9b01f4", nil
 	}
 
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
+	// Work generated for the root before is reused if it's enough
+	if work, ok := p.cachedWork(hash, DifficultyFromMultiplier(difficultyMultiplier)); ok {
+		return work, nil
+	}
+	ctx, span := startSpan(ctx, "work_generate", attribute.String("pippin.hash", hash), attribute.Int("pippin.multiplier", difficultyMultiplier))
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+	work, err = p.workGenerate(ctx, account, amount, hash, difficultyMultiplier, validate, blockAward, bpowKey)
+	if err == nil {
+		p.cacheWork(hash, work)
+	} else {
+		log.Ctx(ctx).Warn("Unable to generate work", "hash", hash, "err", err)
+	}
+	return work, err
+}
+
+// Generate work without looking at the cache, difficultyMultiplier is already adjusted to the network
+func (p *PippinPow) workGenerate(parent context.Context, account string, amount *big.Int, hash string, difficultyMultiplier int, validate bool, blockAward bool, bpowKey string) (string, error) {
+	policy := p.getTimeoutPolicy()
+	if policy == nil {
+		policy = DefaultTimeoutPolicy{}
+	}
+	ctx, cancel := context.WithTimeout(parent, policy.TimeoutFor(account, amount))
 	defer cancel()
 
-	chanSize := len(p.WorkPeers)
+	workPeers := p.WorkPeers()
+	chanSize := len(workPeers)
 	if p.bpowUrl != "" && (bpowKey != "" || p.bpowKey != "") {
 		chanSize++
 	}
@@ -142,41 +424,58 @@ func (p *PippinPow) WorkGenerateMeta(hash string, difficultyMultiplier int, vali
 	difficultyStr := DifficultyToString(difficultyUint)
 	runningLocally := false
 
-	if (len(p.WorkPeers) < 1 && p.bpowKey == "" && bpowKey == "") || p.WorkPeersFailing() {
+	// Only local pow can wait in the queue, peers start right away
+	localOnly := len(workPeers) < 1 && p.bpowKey == "" && bpowKey == ""
+	job := p.queue.submit(account, localOnly, cancel)
+	defer p.queue.complete(job)
+
+	key := bpowKey
+	if key == "" {
+		key = p.bpowKey
+	}
+	if sources := p.WorkSources(); len(sources) > 0 {
+		return p.workGeneratePrioritized(ctx, job, sources, workPeers, hash, difficultyMultiplier, difficultyStr, validate, blockAward, key)
+	}
+
+	if localOnly || p.WorkPeersFailing() {
 		// Local pow
 		runningLocally = true
-		go p.workGenerateLocal(ctx, hash, difficultyMultiplier, validate, resultChan)
-	}
-	for _, peer := range p.WorkPeers {
-		go p.workGenerateAPIRequest(ctx, peer, hash, difficultyMultiplier, difficultyStr, validate, resultChan)
+		go p.workGenerateLocal(ctx, job, hash, difficultyMultiplier, validate, resultChan)
 	}
-	if p.bpowUrl != "" {
-		key := bpowKey
-		if key == "" && p.bpowKey != "" {
-			key = p.bpowKey
-		}
-		if key != "" {
-			go p.workGenerateBpowRequest(ctx, hash, difficultyMultiplier, validate, blockAward, key, resultChan)
+	if p.Concurrent {
+		for _, peer := range workPeers {
+			go p.workGenerateAPIRequest(ctx, peer, hash, difficultyMultiplier, difficultyStr, validate, resultChan)
 		}
+	} else if len(workPeers) > 0 {
+		go p.workGenerateSequentialAPIRequest(ctx, workPeers, hash, difficultyMultiplier, difficultyStr, validate, resultChan)
+	}
+	if p.bpowUrl != "" && key != "" {
+		go p.workGenerateBpowRequest(ctx, hash, difficultyMultiplier, validate, blockAward, key, resultChan)
 	}
 
 	select {
 	case result := <-resultChan:
 		// Send work cancel
-		for _, peer := range p.WorkPeers {
+		for _, peer := range workPeers {
 			go WorkCancelAPIRequest(peer, hash)
 		}
 		return *result, nil
-	case <-time.After(p.timeout):
+	case <-ctx.Done():
 		// Send work cancel
-		for _, peer := range p.WorkPeers {
+		for _, peer := range workPeers {
 			go WorkCancelAPIRequest(peer, hash)
 		}
+		// Cancelled with WorkCancelAll, it didn't time out
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return "", ErrWorkCancelled
+		}
 		// See if our peers are failing
 		// Generate local pow if it didnt run locally
 		if !runningLocally {
 			p.SetWorkPeersFailing(true)
+			p.queue.acquireLocal(context.Background())
 			work, err := p.generateWorkLocally(hash, difficultyMultiplier)
+			p.queue.releaseLocal()
 			if err == nil {
 				return work, nil
 			}
@@ -185,6 +484,24 @@ func (p *PippinPow) WorkGenerateMeta(hash string, difficultyMultiplier int, vali
 	}
 }
 
+// Cancel every work job that's queued or in progress, they return ErrWorkCancelled
+// Waits up to timeout for the jobs to return, returns how many were cancelled
+// Local PoW that already started can't be stopped, it finishes in the background but its job returns right away
+func (p *PippinPow) WorkCancelAll(timeout time.Duration) int {
+	jobs := p.queue.cancelAll()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for _, job := range jobs {
+		select {
+		case <-job.done:
+		case <-deadline.C:
+			log.Warnf("Cancelled %d work jobs, timed out waiting for them to return", len(jobs))
+			return len(jobs)
+		}
+	}
+	return len(jobs)
+}
+
 // Recovers from writing to close channel
 func WriteChannelSafe(out chan *string, msg string) (err error) {
 	defer func() {
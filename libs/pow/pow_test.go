@@ -1,11 +1,18 @@
 package pow
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
@@ -30,16 +37,18 @@ func testMainWrapper(m *testing.M) int {
 		},
 		utils.GetEnv("BPOW_KEY", ""),
 		utils.GetEnv("BPOW_URL", ""),
-		30,
+		nil,
 	)
 	return m.Run()
 }
 
 func TestWorkGenerateLocal(t *testing.T) {
 	// Test local pow generation
+	generated := workDuration.Count(workSourceLocal)
 	work, err := PPow.generateWorkLocally("09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8", 1)
 	assert.Nil(t, err)
 	assert.Len(t, work, 16)
+	assert.Equal(t, generated+1, workDuration.Count(workSourceLocal))
 
 	work, err = PPow.generateWorkLocally("abcdefg", 1)
 	assert.NotNil(t, err)
@@ -186,11 +195,350 @@ func TestWorkGenerateMeta(t *testing.T) {
 
 	// Test with local pow (no peers, no boompow configured)
 	ppow := &PippinPow{
-		WorkPeers: []string{},
-		timeout:   30 * time.Second,
+		workPeers:     []string{},
+		timeoutPolicy: DefaultTimeoutPolicy{},
 	}
 
 	result, err = ppow.WorkGenerateMeta("09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8", 1, true, true, "")
 	assert.Nil(t, err)
 	assert.Len(t, result, 16)
 }
+
+func TestUpdateDifficulty(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	active := map[string]interface{}{
+		"multiplier":      "1.5",
+		"network_current": "fffffffaaaaaaaab",
+		"network_minimum": "fffffff800000000",
+	}
+	httpmock.RegisterResponder("POST", "http://fakenode",
+		func(req *http.Request) (*http.Response, error) {
+			resp, err := httpmock.NewJsonResponse(200, active)
+			return resp, err
+		},
+	)
+
+	ppow := NewPippinPow([]string{}, "", "", nil)
+	ppow.NodeRpcUrl = "http://fakenode"
+
+	// Nothing fetched yet
+	assert.Equal(t, DifficultyFromMultiplier(1), ppow.CurrentDifficulty())
+	assert.Equal(t, 64, ppow.networkAdjustedMultiplier(64))
+
+	assert.Nil(t, ppow.UpdateDifficulty(context.Background()))
+	assert.Equal(t, uint64(0xfffffffaaaaaaaab), ppow.CurrentDifficulty())
+	assert.Equal(t, 96, ppow.networkAdjustedMultiplier(64))
+	// The multiplier is for sends, receives don't need more without network_receive_current
+	assert.Equal(t, 1, ppow.networkAdjustedMultiplier(1))
+
+	// Receives are raised to network_receive_current
+	active["network_receive_current"] = "fffffeaaaaaaaaab"
+	assert.Nil(t, ppow.UpdateDifficulty(context.Background()))
+	assert.Equal(t, 96, ppow.networkAdjustedMultiplier(64))
+	assert.Equal(t, 2, ppow.networkAdjustedMultiplier(1))
+	active["network_receive_current"] = "fffffe0000000000"
+	assert.Nil(t, ppow.UpdateDifficulty(context.Background()))
+	assert.Equal(t, 1, ppow.networkAdjustedMultiplier(1))
+
+	// Banano's minimum is 1x, so every block is scaled
+	active["network_current"] = "fffffeaaaaaaaaab"
+	active["network_minimum"] = "fffffe0000000000"
+	delete(active, "network_receive_current")
+	assert.Nil(t, ppow.UpdateDifficulty(context.Background()))
+	assert.Equal(t, 2, ppow.networkAdjustedMultiplier(1))
+
+	// Without a node there's nothing to update from
+	ppow.SetNodeRpcUrl("")
+	assert.NotNil(t, ppow.UpdateDifficulty(context.Background()))
+}
+
+func TestWorkGenerateAtMultiplier(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://fakenode",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"multiplier":      "2",
+				"network_current": "fffffffc00000000",
+				"network_minimum": "fffffff800000000",
+			})
+		},
+	)
+	difficulties := []string{}
+	httpmock.RegisterResponder("POST", "https://atmultiplierpeer.com",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] != "work_generate" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+			}
+			difficulties = append(difficulties, pr["difficulty"].(string))
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"work": "205452237a9b01f4"})
+		},
+	)
+
+	ppow := NewPippinPow([]string{"https://atmultiplierpeer.com"}, "", "", nil)
+	ppow.NodeRpcUrl = "http://fakenode"
+	assert.Nil(t, ppow.UpdateDifficulty(context.Background()))
+
+	// The network's multiplier is applied on top of the block's threshold, not on top of one that was asked for
+	_, err := ppow.WorkGenerateForAccount("", nil, "abcdef", 64, false, false, "")
+	assert.Nil(t, err)
+	_, err = ppow.WorkGenerateAtMultiplier("", nil, "abcdef", 64, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{DifficultyToString(DifficultyFromMultiplier(128)), DifficultyToString(DifficultyFromMultiplier(64))}, difficulties)
+}
+
+func TestWorkGenerateWithContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	requestIDs := []string{}
+	httpmock.RegisterResponder("POST", "https://contextpeer.com",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] != "work_generate" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+			}
+			requestIDs = append(requestIDs, req.Header.Get("X-Request-ID"))
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"work": "205452237a9b01f4"})
+		},
+	)
+
+	// The peers get the ID of the request the work is for
+	ppow := NewPippinPow([]string{"https://contextpeer.com"}, "", "", nil)
+	_, err := ppow.WorkGenerateForAccountWithContext(log.WithRequestID(context.Background(), "req-1"), "", nil, "abcdef", 1, false, false, "")
+	assert.Nil(t, err)
+	_, err = ppow.WorkGenerateAtMultiplierWithContext(log.WithRequestID(context.Background(), "req-2"), "", nil, "abcdef", 64, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"req-1", "req-2"}, requestIDs)
+
+	// It's given up on with the context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = ppow.WorkGenerateAtMultiplierWithContext(ctx, "", nil, "fedcba", 64, false, false, "")
+	assert.ErrorIs(t, err, ErrWorkCancelled)
+}
+
+func TestUpdateDifficultyFallback(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://fakenode",
+		func(req *http.Request) (*http.Response, error) {
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"multiplier":      "2",
+				"network_current": "fffffffc00000000",
+			})
+			return resp, err
+		},
+	)
+
+	ppow := NewPippinPow([]string{}, "", "", nil)
+
+	// No node configured
+	assert.NotNil(t, ppow.UpdateDifficulty(context.Background()))
+	assert.Equal(t, DifficultyFromMultiplier(1), ppow.CurrentDifficulty())
+
+	ppow.NodeRpcUrl = "http://fakenode"
+	assert.Nil(t, ppow.UpdateDifficulty(context.Background()))
+	assert.Equal(t, uint64(0xfffffffc00000000), ppow.CurrentDifficulty())
+
+	// Node goes away, back to the static threshold
+	httpmock.RegisterResponder("POST", "http://fakenode", httpmock.NewErrorResponder(errors.New("connection refused")))
+	assert.NotNil(t, ppow.UpdateDifficulty(context.Background()))
+	assert.Equal(t, DifficultyFromMultiplier(1), ppow.CurrentDifficulty())
+	assert.Equal(t, 64, ppow.networkAdjustedMultiplier(64))
+
+	// Garbage from the node is treated the same
+	httpmock.RegisterResponder("POST", "http://fakenode",
+		func(req *http.Request) (*http.Response, error) {
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"multiplier":      "abc",
+				"network_current": "xyz",
+			})
+			return resp, err
+		},
+	)
+	assert.NotNil(t, ppow.UpdateDifficulty(context.Background()))
+	assert.Equal(t, DifficultyFromMultiplier(1), ppow.CurrentDifficulty())
+}
+
+func TestWorkCancelAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// The peer never answers until the request is cancelled
+	httpmock.RegisterResponder("POST", "https://cancelpeer.com",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "work_generate" {
+				<-req.Context().Done()
+				return nil, req.Context().Err()
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{})
+		},
+	)
+
+	ppow := NewPippinPow([]string{"https://cancelpeer.com"}, "", "", nil)
+	assert.Equal(t, 0, ppow.WorkCancelAll(time.Second))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := ppow.WorkGenerateForAccount("nano_1", nil, "cancelhash", 1, false, false, "")
+			errs <- err
+		}()
+	}
+	assert.Eventually(t, func() bool { return ppow.WorkQueueStatus().InProgress == 5 }, 5*time.Second, 10*time.Millisecond)
+
+	start := time.Now()
+	assert.Equal(t, 5, ppow.WorkCancelAll(time.Second))
+	wg.Wait()
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+	close(errs)
+	for err := range errs {
+		assert.ErrorIs(t, err, ErrWorkCancelled)
+	}
+	assert.Equal(t, 0, ppow.WorkQueueStatus().InProgress)
+	// Cancelling isn't a timeout, peers aren't considered failing
+	assert.False(t, ppow.WorkPeersFailing())
+
+	// New jobs start normally
+	httpmock.RegisterResponder("POST", "https://cancelpeer.com",
+		httpmock.NewJsonResponderOrPanic(200, map[string]interface{}{"work": "abcd1234"}))
+	work, err := ppow.WorkGenerateForAccount("nano_1", nil, "cancelhash", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "abcd1234", work)
+}
+
+// A work server that answers work_generate after latency, unless the request is cancelled first
+type latencyWorkServer struct {
+	*httptest.Server
+	calls     int32
+	cancelled int32
+}
+
+func newLatencyWorkServer(latency time.Duration, work string) *latencyWorkServer {
+	s := &latencyWorkServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var pr map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&pr)
+		if pr["action"] != "work_generate" {
+			json.NewEncoder(w).Encode(map[string]interface{}{})
+			return
+		}
+		atomic.AddInt32(&s.calls, 1)
+		select {
+		case <-time.After(latency):
+			json.NewEncoder(w).Encode(map[string]interface{}{"work": work})
+		case <-r.Context().Done():
+			atomic.AddInt32(&s.cancelled, 1)
+		}
+	}))
+	return s
+}
+
+func TestWorkGenerateConcurrent(t *testing.T) {
+	fast := newLatencyWorkServer(10*time.Millisecond, "fastwork")
+	defer fast.Close()
+	slow := newLatencyWorkServer(5*time.Second, "slowwork")
+	defer slow.Close()
+
+	ppow := NewPippinPow([]string{slow.URL, fast.URL}, "", "", nil)
+	assert.True(t, ppow.Concurrent)
+	generated := workDuration.Count(workSourcePeer)
+	work, err := ppow.WorkGenerateForAccount("", nil, "concurrenthash", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "fastwork", work)
+	// Only the peer that returned work
+	assert.Equal(t, generated+1, workDuration.Count(workSourcePeer))
+	// The slow request is really aborted, not left running
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&slow.cancelled) == 1 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&slow.calls))
+}
+
+func TestWorkGenerateSequential(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var calls []string
+	var mu sync.Mutex
+	for _, peer := range []string{"https://failingpeer.com", "https://workingpeer.com", "https://unusedpeer.com"} {
+		peer := peer
+		httpmock.RegisterResponder("POST", peer,
+			func(req *http.Request) (*http.Response, error) {
+				var pr map[string]interface{}
+				json.NewDecoder(req.Body).Decode(&pr)
+				if pr["action"] != "work_generate" {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{})
+				}
+				mu.Lock()
+				calls = append(calls, peer)
+				mu.Unlock()
+				if peer == "https://failingpeer.com" {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "failed"})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"work": peer})
+			},
+		)
+	}
+
+	ppow := NewPippinPow([]string{"https://failingpeer.com", "https://workingpeer.com", "https://unusedpeer.com"}, "", "", nil)
+	ppow.Concurrent = false
+	work, err := ppow.WorkGenerateForAccount("", nil, "sequentialhash", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://workingpeer.com", work)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"https://failingpeer.com", "https://workingpeer.com"}, calls)
+}
+
+func TestWorkGenerateSequentialSplitsTimeout(t *testing.T) {
+	// The first peer hangs, the second still gets its share of the timeout
+	hanging := newLatencyWorkServer(time.Minute, "hangingwork")
+	defer hanging.Close()
+	working := newLatencyWorkServer(10*time.Millisecond, "work")
+	defer working.Close()
+
+	ppow := NewPippinPow([]string{hanging.URL, working.URL}, "", "", DefaultTimeoutPolicy{Timeout: 400 * time.Millisecond})
+	ppow.Concurrent = false
+	work, err := ppow.WorkGenerateForAccount("", nil, "sequentialhash", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "work", work)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hanging.cancelled))
+}
+
+func benchmarkWorkGenerate(b *testing.B, concurrent bool) {
+	// In the order they're configured, slowest first
+	var peers []string
+	for _, latency := range []time.Duration{200 * time.Millisecond, 50 * time.Millisecond, 10 * time.Millisecond} {
+		server := newLatencyWorkServer(latency, "work")
+		defer server.Close()
+		peers = append(peers, server.URL)
+	}
+	ppow := NewPippinPow(peers, "", "", nil)
+	ppow.Concurrent = concurrent
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := ppow.WorkGenerateForAccount("", nil, "benchmarkhash", 1, false, false, ""); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkWorkGenerateSequential(b *testing.B) {
+	benchmarkWorkGenerate(b, false)
+}
+
+func BenchmarkWorkGenerateConcurrent(b *testing.B) {
+	benchmarkWorkGenerate(b, true)
+}
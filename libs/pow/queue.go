@@ -0,0 +1,214 @@
+package pow
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Average wait times are over the jobs that completed in this window
+const workWaitWindow = time.Minute
+
+// Work requested from WorkGenerateForAccount, account is empty when it's not for a wallet account
+type workJob struct {
+	account   string
+	submitted time.Time
+	queued    bool
+	completed bool
+	// Cancels the job's context, done is closed when it completes
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// A completed job, to average the wait of recent jobs
+type workWait struct {
+	completed time.Time
+	wait      time.Duration
+}
+
+// Jobs of an account that haven't completed yet
+type accountJobs struct {
+	queued  int
+	running int
+}
+
+// Every work request is a job, from when it's requested until it has work or gives up
+// Local PoW uses every core (or the GPU), so local jobs run one at a time, the others wait in the queue
+type workQueue struct {
+	queued  atomic.Int64
+	running atomic.Int64
+	// Held by the local job that's running
+	localSlot chan struct{}
+	slotOnce  sync.Once
+	mutex     sync.Mutex
+	accounts  map[string]*accountJobs
+	waits     []workWait
+	// Jobs that haven't completed yet, to cancel them
+	jobs map[*workJob]struct{}
+}
+
+// The state of the work queue, accounts are only known for jobs of wallet accounts
+type WorkQueueStatus struct {
+	Queued     int
+	InProgress int
+	// In progress jobs of each account
+	InProgressByAccount map[string]int
+	// Of jobs that completed in the last minute, 0 if there were none
+	AverageWait time.Duration
+	// Accounts with a job waiting in the queue, sorted
+	BlockedAccounts []string
+}
+
+func (q *workQueue) slot() chan struct{} {
+	q.slotOnce.Do(func() {
+		q.localSlot = make(chan struct{}, 1)
+	})
+	return q.localSlot
+}
+
+// Start tracking a job, a queued job only waits for local PoW
+// cancel is called if the job is cancelled with cancelAll
+func (q *workQueue) submit(account string, queued bool, cancel context.CancelFunc) *workJob {
+	job := &workJob{account: account, submitted: time.Now(), queued: queued, cancel: cancel, done: make(chan struct{})}
+	if queued {
+		q.queued.Add(1)
+	} else {
+		q.running.Add(1)
+	}
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.jobs == nil {
+		q.jobs = map[*workJob]struct{}{}
+	}
+	q.jobs[job] = struct{}{}
+	if account == "" {
+		return job
+	}
+	if q.accounts == nil {
+		q.accounts = map[string]*accountJobs{}
+	}
+	jobs, ok := q.accounts[account]
+	if !ok {
+		jobs = &accountJobs{}
+		q.accounts[account] = jobs
+	}
+	if queued {
+		jobs.queued++
+	} else {
+		jobs.running++
+	}
+	return job
+}
+
+// Move a queued job to in progress, it got the local slot
+func (q *workQueue) start(job *workJob) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if !job.queued || job.completed {
+		return
+	}
+	job.queued = false
+	q.queued.Add(-1)
+	q.running.Add(1)
+	if jobs, ok := q.accounts[job.account]; ok {
+		jobs.queued--
+		jobs.running++
+	}
+}
+
+// Stop tracking a job, whether it got work or not
+func (q *workQueue) complete(job *workJob) {
+	now := time.Now()
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	job.completed = true
+	delete(q.jobs, job)
+	close(job.done)
+	if job.queued {
+		q.queued.Add(-1)
+	} else {
+		q.running.Add(-1)
+	}
+	if jobs, ok := q.accounts[job.account]; ok {
+		if job.queued {
+			jobs.queued--
+		} else {
+			jobs.running--
+		}
+		if jobs.queued == 0 && jobs.running == 0 {
+			delete(q.accounts, job.account)
+		}
+	}
+	q.waits = append(recentWaits(q.waits, now), workWait{completed: now, wait: now.Sub(job.submitted)})
+}
+
+// Cancel every job that hasn't completed, queued or in progress, and return them
+func (q *workQueue) cancelAll() []*workJob {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	jobs := make([]*workJob, 0, len(q.jobs))
+	for job := range q.jobs {
+		if job.cancel != nil {
+			job.cancel()
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
+// Get the local slot, false if ctx is done first
+func (q *workQueue) acquireLocal(ctx context.Context) bool {
+	select {
+	case q.slot() <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (q *workQueue) releaseLocal() {
+	<-q.slot()
+}
+
+// Drop the waits that are older than workWaitWindow
+func recentWaits(waits []workWait, now time.Time) []workWait {
+	idx := 0
+	for idx < len(waits) && now.Sub(waits[idx].completed) > workWaitWindow {
+		idx++
+	}
+	return waits[idx:]
+}
+
+// The current state of the work queue
+func (p *PippinPow) WorkQueueStatus() WorkQueueStatus {
+	q := &p.queue
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	status := WorkQueueStatus{
+		Queued:              int(q.queued.Load()),
+		InProgress:          int(q.running.Load()),
+		InProgressByAccount: map[string]int{},
+		BlockedAccounts:     []string{},
+	}
+	for account, jobs := range q.accounts {
+		if jobs.running > 0 {
+			status.InProgressByAccount[account] = jobs.running
+		}
+		if jobs.queued > 0 {
+			status.BlockedAccounts = append(status.BlockedAccounts, account)
+		}
+	}
+	slices.Sort(status.BlockedAccounts)
+
+	q.waits = recentWaits(q.waits, time.Now())
+	if len(q.waits) > 0 {
+		var total time.Duration
+		for _, w := range q.waits {
+			total += w.wait
+		}
+		status.AverageWait = total / time.Duration(len(q.waits))
+	}
+	return status
+}
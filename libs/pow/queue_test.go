@@ -0,0 +1,103 @@
+package pow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkQueueStatusPeers(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// The peer holds every work_generate until it's released
+	release := make(chan struct{})
+	var cancels atomic.Int64
+	httpmock.RegisterResponder("POST", "https://queuepeer.com",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "work_generate" {
+				<-release
+			} else if pr["action"] == "work_cancel" {
+				cancels.Add(1)
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"work": "abcd1234",
+			})
+		},
+	)
+
+	ppow := NewPippinPow([]string{"https://queuepeer.com"}, "", "", nil)
+	assert.Equal(t, WorkQueueStatus{
+		InProgressByAccount: map[string]int{},
+		BlockedAccounts:     []string{},
+	}, ppow.WorkQueueStatus())
+
+	var wg sync.WaitGroup
+	for _, account := range []string{"nano_1", "nano_1", "nano_2", ""} {
+		wg.Add(1)
+		go func(account string) {
+			defer wg.Done()
+			work, err := ppow.WorkGenerateForAccount(account, nil, "queuehash", 1, false, false, "")
+			assert.Nil(t, err)
+			assert.Equal(t, "abcd1234", work)
+		}(account)
+	}
+
+	// Peers start right away, nothing waits in the queue
+	assert.Eventually(t, func() bool { return ppow.WorkQueueStatus().InProgress == 4 }, 5*time.Second, 10*time.Millisecond)
+	status := ppow.WorkQueueStatus()
+	assert.Equal(t, 0, status.Queued)
+	assert.Equal(t, map[string]int{"nano_1": 2, "nano_2": 1}, status.InProgressByAccount)
+	assert.Empty(t, status.BlockedAccounts)
+	assert.Zero(t, status.AverageWait)
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	status = ppow.WorkQueueStatus()
+	assert.Equal(t, 0, status.InProgress)
+	assert.Empty(t, status.InProgressByAccount)
+	assert.GreaterOrEqual(t, status.AverageWait, 50*time.Millisecond)
+	// Let the work_cancel requests finish before the mock goes away
+	assert.Eventually(t, func() bool { return cancels.Load() == 4 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWorkQueueStatusLocal(t *testing.T) {
+	ppow := NewPippinPow([]string{}, "", "", DefaultTimeoutPolicy{Timeout: time.Second})
+
+	// Another job is generating work locally, so these wait for it
+	assert.True(t, ppow.queue.acquireLocal(context.Background()))
+	var wg sync.WaitGroup
+	for _, account := range []string{"nano_2", "nano_1", "nano_2"} {
+		wg.Add(1)
+		go func(account string) {
+			defer wg.Done()
+			_, err := ppow.WorkGenerateForAccount(account, nil, "09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8", 1, true, false, "")
+			assert.NotNil(t, err)
+		}(account)
+	}
+
+	assert.Eventually(t, func() bool { return ppow.WorkQueueStatus().Queued == 3 }, 5*time.Second, 10*time.Millisecond)
+	status := ppow.WorkQueueStatus()
+	assert.Equal(t, 0, status.InProgress)
+	assert.Empty(t, status.InProgressByAccount)
+	assert.Equal(t, []string{"nano_1", "nano_2"}, status.BlockedAccounts)
+
+	// They give up when they time out
+	wg.Wait()
+	status = ppow.WorkQueueStatus()
+	assert.Equal(t, 0, status.Queued)
+	assert.Empty(t, status.BlockedAccounts)
+	assert.GreaterOrEqual(t, status.AverageWait, time.Second)
+	ppow.queue.releaseLocal()
+}
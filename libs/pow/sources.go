@@ -0,0 +1,136 @@
+package pow
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+var ErrInvalidWorkSource = errors.New("invalid work source")
+
+// Where work can come from, in the order SetWorkSources tries them
+const (
+	WorkSourcePeers   = "peers"
+	WorkSourceBoompow = "boompow"
+	WorkSourceLocal   = "local"
+)
+
+// Check a work source order, each source can only be in it once
+func ValidateWorkSources(sources []string) error {
+	for i, source := range sources {
+		if !slices.Contains([]string{WorkSourcePeers, WorkSourceBoompow, WorkSourceLocal}, source) {
+			return ErrInvalidWorkSource
+		}
+		if slices.Contains(sources[:i], source) {
+			return ErrInvalidWorkSource
+		}
+	}
+	return nil
+}
+
+// The order work sources are tried in, empty if they're all asked at once
+func (p *PippinPow) WorkSources() []string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return slices.Clone(p.workSources)
+}
+
+// Try the work sources one at a time in this order, the next only if the previous one fails
+// Empty asks the peers and BoomPoW at once, like when it was never set
+func (p *PippinPow) SetWorkSources(sources []string) error {
+	if err := ValidateWorkSources(sources); err != nil {
+		return err
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.workSources = slices.Clone(sources)
+	return nil
+}
+
+// Ask one work source, returns when it returned work or gave up
+func (p *PippinPow) sourceWorkGenerate(ctx context.Context, job *workJob, source string, workPeers []string, hash string, difficultyMultiplier int, difficulty string, validate bool, blockAward bool, bpowKey string, out chan *string) {
+	switch source {
+	case WorkSourcePeers:
+		if !p.Concurrent {
+			p.workGenerateSequentialAPIRequest(ctx, workPeers, hash, difficultyMultiplier, difficulty, validate, out)
+			return
+		}
+		var wg sync.WaitGroup
+		for _, peer := range workPeers {
+			wg.Add(1)
+			go func(peer string) {
+				defer wg.Done()
+				p.workGenerateAPIRequest(ctx, peer, hash, difficultyMultiplier, difficulty, validate, out)
+			}(peer)
+		}
+		wg.Wait()
+	case WorkSourceBoompow:
+		p.workGenerateBpowRequest(ctx, hash, difficultyMultiplier, validate, blockAward, bpowKey, out)
+	case WorkSourceLocal:
+		p.workGenerateLocal(ctx, job, hash, difficultyMultiplier, validate, out)
+	}
+}
+
+// Try each source in order until one returns work
+// Each source gets an equal share of the time that's left, sources that aren't configured are skipped
+// If none of them return work it's generated locally, unless local was already tried
+func (p *PippinPow) workGeneratePrioritized(ctx context.Context, job *workJob, sources []string, workPeers []string, hash string, difficultyMultiplier int, difficulty string, validate bool, blockAward bool, bpowKey string) (string, error) {
+	available := []string{}
+	for _, source := range sources {
+		if (source == WorkSourcePeers && len(workPeers) < 1) || (source == WorkSourceBoompow && (p.bpowUrl == "" || bpowKey == "")) {
+			continue
+		}
+		available = append(available, source)
+	}
+	if len(workPeers) > 0 && slices.Contains(available, WorkSourcePeers) {
+		defer func() {
+			for _, peer := range workPeers {
+				go WorkCancelAPIRequest(peer, hash)
+			}
+		}()
+	}
+
+	for i, source := range available {
+		sourceCtx, cancel := ctx, context.CancelFunc(func() {})
+		if deadline, ok := ctx.Deadline(); ok {
+			sourceCtx, cancel = context.WithTimeout(ctx, time.Until(deadline)/time.Duration(len(available)-i))
+		}
+		out := make(chan *string, len(workPeers)+1)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			p.sourceWorkGenerate(sourceCtx, job, source, workPeers, hash, difficultyMultiplier, difficulty, validate, blockAward, bpowKey, out)
+		}()
+		select {
+		case result := <-out:
+			cancel()
+			return *result, nil
+		case <-done:
+			// It may have written its work right before returning
+			select {
+			case result := <-out:
+				cancel()
+				return *result, nil
+			default:
+			}
+		case <-sourceCtx.Done():
+		}
+		cancel()
+		// Cancelled with WorkCancelAll, it didn't fail
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return "", ErrWorkCancelled
+		}
+	}
+
+	if !slices.Contains(available, WorkSourceLocal) {
+		p.queue.acquireLocal(context.Background())
+		work, err := p.generateWorkLocally(hash, difficultyMultiplier)
+		p.queue.releaseLocal()
+		if err == nil {
+			return work, nil
+		}
+	}
+	return "", errors.New("Unable to generate work - timed out")
+}
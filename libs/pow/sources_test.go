@@ -0,0 +1,100 @@
+package pow
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWorkSources(t *testing.T) {
+	assert.Nil(t, ValidateWorkSources(nil))
+	assert.Nil(t, ValidateWorkSources([]string{"boompow", "peers", "local"}))
+	assert.ErrorIs(t, ValidateWorkSources([]string{"peers", "gpu"}), ErrInvalidWorkSource)
+	assert.ErrorIs(t, ValidateWorkSources([]string{"peers", "boompow", "peers"}), ErrInvalidWorkSource)
+
+	ppow := NewPippinPow(nil, "", "", nil)
+	assert.ErrorIs(t, ppow.SetWorkSources([]string{"gpu"}), ErrInvalidWorkSource)
+	assert.Empty(t, ppow.WorkSources())
+	assert.Nil(t, ppow.SetWorkSources([]string{"local", "peers"}))
+	assert.Equal(t, []string{"local", "peers"}, ppow.WorkSources())
+}
+
+func TestWorkGeneratePrioritized(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var calls []string
+	var mu sync.Mutex
+	boompowWorks := true
+	httpmock.RegisterResponder("POST", "https://prioritizedboompow.com",
+		func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls = append(calls, "boompow")
+			if !boompowWorks {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"errors": []string{"failed"}})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"data": map[string]interface{}{"workGenerate": "boompowwork"},
+			})
+		},
+	)
+	httpmock.RegisterResponder("POST", "https://prioritizedpeer.com",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] != "work_generate" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{})
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			calls = append(calls, "peers")
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"work": "peerwork"})
+		},
+	)
+
+	ppow := NewPippinPow([]string{"https://prioritizedpeer.com"}, "bpowkey", "https://prioritizedboompow.com", nil)
+	assert.Nil(t, ppow.SetWorkSources([]string{"boompow", "peers"}))
+	work, err := ppow.WorkGenerateForAccount("", nil, "prioritizedhash", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "boompowwork", work)
+	assert.Equal(t, []string{"boompow"}, calls)
+
+	// BoomPoW failing falls back to the next source
+	calls = nil
+	boompowWorks = false
+	work, err = ppow.WorkGenerateForAccount("", nil, "prioritizedhash", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "peerwork", work)
+	assert.Equal(t, []string{"boompow", "peers"}, calls)
+
+	// Without a BoomPoW key it's skipped
+	calls = nil
+	assert.Nil(t, ppow.SetWorkSources([]string{"peers", "boompow"}))
+	ppow.bpowKey = ""
+	work, err = ppow.WorkGenerateForAccount("", nil, "prioritizedhash", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "peerwork", work)
+	assert.Equal(t, []string{"peers"}, calls)
+}
+
+func TestWorkGeneratePrioritizedLocalFallback(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "https://failingboompow.com",
+		httpmock.NewStringResponder(200, `{"errors":["failed"]}`))
+
+	// Everything failed, so it's generated locally even if local isn't one of the sources
+	ppow := NewPippinPow(nil, "bpowkey", "https://failingboompow.com", nil)
+	assert.Nil(t, ppow.SetWorkSources([]string{"boompow"}))
+	hash := "09263b65752d05ce4df5aeed849ffc2be5bf47026abb4fa5879359ae571ba9c8"
+	work, err := ppow.WorkGenerateForAccount("", nil, hash, 1, true, false, "")
+	assert.Nil(t, err)
+	assert.True(t, IsWorkValid(hash, 1, work))
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,56 @@
+package pow
+
+import (
+	"math/big"
+	"time"
+)
+
+// The work timeout when nothing else is configured
+const DefaultWorkTimeout = 30 * time.Second
+
+// How long WorkGenerateMeta waits for work before giving up on the peers
+// account and amount are the account the block is for and the amount it sends, amount is nil for anything but sends
+type TimeoutPolicy interface {
+	TimeoutFor(account string, amount *big.Int) time.Duration
+}
+
+// The same timeout for every block
+type DefaultTimeoutPolicy struct {
+	Timeout time.Duration
+}
+
+func (p DefaultTimeoutPolicy) TimeoutFor(account string, amount *big.Int) time.Duration {
+	if p.Timeout <= 0 {
+		return DefaultWorkTimeout
+	}
+	return p.Timeout
+}
+
+// Waits longer for sends above Threshold, LargeTimeout instead of Timeout
+type AmountBasedTimeoutPolicy struct {
+	Timeout      time.Duration
+	LargeTimeout time.Duration
+	Threshold    *big.Int
+}
+
+func (p AmountBasedTimeoutPolicy) TimeoutFor(account string, amount *big.Int) time.Duration {
+	if amount != nil && p.Threshold != nil && amount.Cmp(p.Threshold) > 0 && p.LargeTimeout > 0 {
+		return p.LargeTimeout
+	}
+	return DefaultTimeoutPolicy{Timeout: p.Timeout}.TimeoutFor(account, amount)
+}
+
+// The policy for the work_timeout settings, both in seconds
+// largeSendThreshold is in raw, if it's empty or invalid every block gets workTimeout
+func NewTimeoutPolicy(workTimeout int, largeSendThreshold string, largeSendWorkTimeout int) TimeoutPolicy {
+	timeout := time.Duration(workTimeout) * time.Second
+	threshold, ok := big.NewInt(0).SetString(largeSendThreshold, 10)
+	if !ok {
+		return DefaultTimeoutPolicy{Timeout: timeout}
+	}
+	return AmountBasedTimeoutPolicy{
+		Timeout:      timeout,
+		LargeTimeout: time.Duration(largeSendWorkTimeout) * time.Second,
+		Threshold:    threshold,
+	}
+}
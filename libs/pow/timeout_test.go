@@ -0,0 +1,142 @@
+package pow
+
+import (
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultTimeoutPolicy(t *testing.T) {
+	assert.Equal(t, DefaultWorkTimeout, DefaultTimeoutPolicy{}.TimeoutFor("", nil))
+	assert.Equal(t, 10*time.Second, DefaultTimeoutPolicy{Timeout: 10 * time.Second}.TimeoutFor("nano_1", big.NewInt(1)))
+}
+
+func TestAmountBasedTimeoutPolicy(t *testing.T) {
+	policy := AmountBasedTimeoutPolicy{
+		Timeout:      30 * time.Second,
+		LargeTimeout: 120 * time.Second,
+		Threshold:    big.NewInt(1000),
+	}
+	assert.Equal(t, 30*time.Second, policy.TimeoutFor("nano_1", nil))
+	assert.Equal(t, 30*time.Second, policy.TimeoutFor("nano_1", big.NewInt(1000)))
+	assert.Equal(t, 120*time.Second, policy.TimeoutFor("nano_1", big.NewInt(1001)))
+}
+
+func TestNewTimeoutPolicy(t *testing.T) {
+	assert.Equal(t, DefaultTimeoutPolicy{Timeout: 30 * time.Second}, NewTimeoutPolicy(30, "", 120))
+	assert.Equal(t, DefaultTimeoutPolicy{Timeout: 30 * time.Second}, NewTimeoutPolicy(30, "notanumber", 120))
+	assert.Equal(t, AmountBasedTimeoutPolicy{
+		Timeout:      30 * time.Second,
+		LargeTimeout: 120 * time.Second,
+		Threshold:    big.NewInt(1000),
+	}, NewTimeoutPolicy(30, "1000", 120))
+}
+
+type recordingTimeoutPolicy struct {
+	AmountBasedTimeoutPolicy
+	accounts []string
+}
+
+func (p *recordingTimeoutPolicy) TimeoutFor(account string, amount *big.Int) time.Duration {
+	p.accounts = append(p.accounts, account)
+	return p.AmountBasedTimeoutPolicy.TimeoutFor(account, amount)
+}
+
+func TestWorkGenerateUsesTimeoutPolicy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// The peer gets the deadline from the policy
+	var deadline time.Time
+	httpmock.RegisterResponder("POST", "https://deadlinepeer.com",
+		func(req *http.Request) (*http.Response, error) {
+			deadline, _ = req.Context().Deadline()
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"work": "205452237a9b01f4",
+			})
+		},
+	)
+
+	policy := &recordingTimeoutPolicy{
+		AmountBasedTimeoutPolicy: AmountBasedTimeoutPolicy{
+			Timeout:      30 * time.Second,
+			LargeTimeout: 120 * time.Second,
+			Threshold:    big.NewInt(1000),
+		},
+	}
+	ppow := NewPippinPow([]string{"https://deadlinepeer.com"}, "", "", policy)
+
+	start := time.Now()
+	work, err := ppow.WorkGenerateForAccount("nano_1", big.NewInt(1001), "abcdef", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "205452237a9b01f4", work)
+	assert.WithinDuration(t, start.Add(120*time.Second), deadline, 5*time.Second)
+
+	start = time.Now()
+	work, err = ppow.WorkGenerateForAccount("nano_2", nil, "abcdef", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "205452237a9b01f4", work)
+	assert.WithinDuration(t, start.Add(30*time.Second), deadline, 5*time.Second)
+
+	// WorkGenerateMeta doesn't know the account
+	_, err = ppow.WorkGenerateMeta("abcdef", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"nano_1", "nano_2", ""}, policy.accounts)
+}
+
+func TestWorkGenerateTimesOut(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// Never answers before the deadline
+	httpmock.RegisterResponder("POST", "https://slowpeer.com",
+		func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		},
+	)
+
+	ppow := NewPippinPow([]string{"https://slowpeer.com"}, "", "", DefaultTimeoutPolicy{Timeout: 50 * time.Millisecond})
+	start := time.Now()
+	// Falls back to local work, which can't work on an invalid hash
+	_, err := ppow.WorkGenerateMeta("abcdef", 1, false, false, "")
+	assert.ErrorContains(t, err, "timed out")
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.True(t, ppow.WorkPeersFailing())
+}
+
+func TestSetWorkPeersAndTimeoutPolicy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "https://slowpeer.com",
+		func(req *http.Request) (*http.Response, error) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		},
+	)
+	httpmock.RegisterResponder("POST", "https://fastpeer.com",
+		httpmock.NewStringResponder(200, `{"work":"fastwork"}`))
+
+	ppow := NewPippinPow([]string{"https://slowpeer.com"}, "", "", DefaultTimeoutPolicy{Timeout: time.Minute})
+	ppow.SetTimeoutPolicy(DefaultTimeoutPolicy{Timeout: 50 * time.Millisecond})
+	start := time.Now()
+	_, err := ppow.WorkGenerateMeta("abcdef", 1, false, false, "")
+	assert.ErrorContains(t, err, "timed out")
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	ppow.SetWorkPeersFailing(false)
+	ppow.SetWorkPeers([]string{"https://fastpeer.com"})
+	assert.Equal(t, []string{"https://fastpeer.com"}, ppow.WorkPeers())
+	work, err := ppow.WorkGenerateMeta("abcdef", 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "fastwork", work)
+
+	// nil is the default policy
+	ppow.SetTimeoutPolicy(nil)
+	assert.Equal(t, DefaultWorkTimeout, ppow.getTimeoutPolicy().TimeoutFor("", nil))
+}
@@ -0,0 +1,20 @@
+package pow
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Work generation is a span of the request it's for, when the server exports traces
+var tracer = otel.Tracer("github.com/appditto/pippin_nano_wallet/libs/pow")
+
+// A span in the trace of ctx, work that isn't generated for a request like the precacher's isn't traced
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
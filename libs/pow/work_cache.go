@@ -0,0 +1,81 @@
+package pow
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/metrics"
+)
+
+var ErrWorkCacheDisabled = errors.New("work cache is disabled")
+
+// Results of looking up work in the cache, for workCacheRequests
+const (
+	workCacheHit  = "hit"
+	workCacheMiss = "miss"
+)
+
+var workCacheRequests = metrics.NewCounterVec("pippin_work_cache_requests_total", "Work requests looked up in the work cache, by result: hit or miss", "result")
+
+// Where generated work is kept by root, so a later request for the same root doesn't generate it again
+// difficulty is what the work reaches, which can be more than it was generated for
+type WorkCache interface {
+	// ok is false if there's no work for root
+	Get(root string) (work string, difficulty uint64, ok bool)
+	Set(root string, work string, difficulty uint64) error
+	// Remove all of the work, returns how many roots had work
+	Clear() (int, error)
+}
+
+// Keep generated work in cache, nil stops caching it
+func (p *PippinPow) SetWorkCache(cache WorkCache) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.workCache = cache
+}
+
+func (p *PippinPow) getWorkCache() WorkCache {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.workCache
+}
+
+// Work cached for root if it reaches difficulty
+func (p *PippinPow) cachedWork(root string, difficulty uint64) (string, bool) {
+	cache := p.getWorkCache()
+	if cache == nil {
+		return "", false
+	}
+	work, cached, ok := cache.Get(strings.ToUpper(root))
+	if !ok || cached < difficulty {
+		workCacheRequests.Inc(workCacheMiss)
+		return "", false
+	}
+	workCacheRequests.Inc(workCacheHit)
+	return work, true
+}
+
+// Keep work for root with the difficulty it actually reaches
+func (p *PippinPow) cacheWork(root string, work string) {
+	cache := p.getWorkCache()
+	if cache == nil {
+		return
+	}
+	difficulty, ok := WorkDifficulty(root, work)
+	if !ok {
+		return
+	}
+	if err := cache.Set(strings.ToUpper(root), work, difficulty); err != nil {
+		log.Warnf("Unable to cache work for %s %s", root, err)
+	}
+}
+
+// Remove all of the cached work, returns how many roots had work
+func (p *PippinPow) WorkCacheClear() (int, error) {
+	cache := p.getWorkCache()
+	if cache == nil {
+		return 0, ErrWorkCacheDisabled
+	}
+	return cache.Clear()
+}
@@ -0,0 +1,113 @@
+package pow
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// A WorkCache in a map
+type mapWorkCache struct {
+	work map[string]string
+	diff map[string]uint64
+	mu   sync.Mutex
+}
+
+func newMapWorkCache() *mapWorkCache {
+	return &mapWorkCache{work: map[string]string{}, diff: map[string]uint64{}}
+}
+
+func (c *mapWorkCache) Get(root string) (string, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	work, ok := c.work[root]
+	return work, c.diff[root], ok
+}
+
+func (c *mapWorkCache) Set(root string, work string, difficulty uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.work[root] = work
+	c.diff[root] = difficulty
+	return nil
+}
+
+func (c *mapWorkCache) Clear() (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cleared := len(c.work)
+	c.work = map[string]string{}
+	c.diff = map[string]uint64{}
+	return cleared, nil
+}
+
+func TestWorkCache(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	root := "B5E5F6C3A9B0D1E2F3A4B5C6D7E8F9A0B1C2D3E4F5A6B7C8D9E0F1A2B3C4D5E6"
+	work, err := PPow.generateWorkLocally(root, 1)
+	assert.Nil(t, err)
+	difficulty, ok := WorkDifficulty(root, work)
+	assert.True(t, ok)
+
+	requests := 0
+	httpmock.RegisterResponder("POST", "https://workcachepeer.com",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] != "work_generate" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+			}
+			requests++
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"work": work})
+		},
+	)
+
+	p := NewPippinPow([]string{"https://workcachepeer.com"}, "", "", nil)
+	_, err = p.WorkCacheClear()
+	assert.ErrorIs(t, err, ErrWorkCacheDisabled)
+
+	cache := newMapWorkCache()
+	p.SetWorkCache(cache)
+	hits, misses := workCacheRequests.Value(workCacheHit), workCacheRequests.Value(workCacheMiss)
+
+	generated, err := p.WorkGenerateMeta(root, 1, true, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, work, generated)
+	assert.Equal(t, 1, requests)
+	cached, cachedDifficulty, ok := cache.Get(root)
+	assert.True(t, ok)
+	assert.Equal(t, work, cached)
+	assert.Equal(t, difficulty, cachedDifficulty)
+	assert.Equal(t, misses+1, workCacheRequests.Value(workCacheMiss))
+
+	// Reused, whatever case the root is in
+	generated, err = p.WorkGenerateMeta(root, 1, true, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, work, generated)
+	generated, err = p.WorkGenerateMeta(strings.ToLower(root), 1, true, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, work, generated)
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, hits+2, workCacheRequests.Value(workCacheHit))
+
+	// Work that doesn't reach the difficulty is generated again
+	cache.Set(root, work, DifficultyFromMultiplier(1)-1)
+	_, err = p.WorkGenerateMeta(root, 1, false, false, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, requests)
+	_, cachedDifficulty, _ = cache.Get(root)
+	assert.Equal(t, difficulty, cachedDifficulty)
+
+	cleared, err := p.WorkCacheClear()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, cleared)
+	_, _, ok = cache.Get(root)
+	assert.False(t, ok)
+}
@@ -0,0 +1,163 @@
+package price
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+var ErrUnsupportedCurrency = errors.New("unsupported currency")
+var ErrPriceUnavailable = errors.New("price unavailable")
+
+// 1 NANO is 10^30 raw, 1 BANANO is 10^29 raw
+const nanoDecimals = 30
+const bananoDecimals = 29
+
+// Gets the NANO or BANANO price from a CoinGecko compatible simple price API
+// e.g. https://api.coingecko.com/api/v3/simple/price?ids=nano&vs_currencies=usd,eur
+// Prices for every configured currency are fetched together and cached for the TTL
+type PriceClient struct {
+	Url string
+	// Lowercase, e.g. usd
+	Currencies []string
+	Banano     bool
+	ttl        time.Duration
+	httpClient *http.Client
+	prices     map[string]*big.Rat
+	fetchedAt  time.Time
+	mutex      sync.Mutex
+}
+
+func NewPriceClient(url string, currencies []string, banano bool, ttl time.Duration) *PriceClient {
+	lower := make([]string, len(currencies))
+	for i, currency := range currencies {
+		lower[i] = strings.ToLower(currency)
+	}
+	return &PriceClient{
+		Url:        url,
+		Currencies: lower,
+		Banano:     banano,
+		ttl:        ttl,
+		httpClient: &http.Client{Timeout: time.Second * 5},
+	}
+}
+
+func (c *PriceClient) coinID() string {
+	if c.Banano {
+		return "banano"
+	}
+	return "nano"
+}
+
+// The first configured currency, used when a request doesn't have one
+func (c *PriceClient) DefaultCurrency() string {
+	if len(c.Currencies) == 0 {
+		return ""
+	}
+	return c.Currencies[0]
+}
+
+func (c *PriceClient) supports(currency string) bool {
+	for _, supported := range c.Currencies {
+		if supported == currency {
+			return true
+		}
+	}
+	return false
+}
+
+// Price of 1 NANO (or BANANO) in currency
+func (c *PriceClient) Price(ctx context.Context, currency string) (*big.Rat, error) {
+	currency = strings.ToLower(currency)
+	if !c.supports(currency) {
+		return nil, ErrUnsupportedCurrency
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// Failures are cached too, so a feed that's down doesn't slow down every request
+	if c.fetchedAt.IsZero() || time.Since(c.fetchedAt) >= c.ttl {
+		prices, err := c.fetchPrices(ctx)
+		c.prices = prices
+		c.fetchedAt = time.Now()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	price, ok := c.prices[currency]
+	if !ok {
+		return nil, ErrPriceUnavailable
+	}
+	return price, nil
+}
+
+// Value of a raw amount in currency, with 2 decimals
+func (c *PriceClient) FiatValue(ctx context.Context, raw *big.Int, currency string) (string, error) {
+	price, err := c.Price(ctx, currency)
+	if err != nil {
+		return "", err
+	}
+	decimals := nanoDecimals
+	if c.Banano {
+		decimals = bananoDecimals
+	}
+	amount := new(big.Rat).SetFrac(raw, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	return amount.Mul(amount, price).FloatString(2), nil
+}
+
+func (c *PriceClient) fetchPrices(ctx context.Context) (map[string]*big.Rat, error) {
+	query := url.Values{}
+	query.Set("ids", c.coinID())
+	query.Set("vs_currencies", strings.Join(c.Currencies, ","))
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s?%s", c.Url, query.Encode()), nil)
+	if err != nil {
+		log.Errorf("Error building price request %s", err)
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(httpRequest)
+	if err != nil {
+		log.Errorf("Error making price request %s", err)
+		return nil, ErrPriceUnavailable
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		log.Errorf("Price feed returned status %d", resp.StatusCode)
+		return nil, ErrPriceUnavailable
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Errorf("Error decoding price response body %s", err)
+		return nil, ErrPriceUnavailable
+	}
+
+	// {"nano":{"usd":0.91,"eur":0.84}}
+	var decoded map[string]map[string]json.Number
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		log.Errorf("Error unmarshalling price response %s", err)
+		return nil, ErrPriceUnavailable
+	}
+	prices := make(map[string]*big.Rat)
+	for currency, value := range decoded[c.coinID()] {
+		price, ok := new(big.Rat).SetString(value.String())
+		if !ok {
+			continue
+		}
+		prices[strings.ToLower(currency)] = price
+	}
+	if len(prices) == 0 {
+		return nil, ErrPriceUnavailable
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,98 @@
+package price
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mockPriceServer(t *testing.T, calls *int, body string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestPrice(t *testing.T) {
+	calls := 0
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		query = r.URL.RawQuery
+		w.Write([]byte(`{"nano":{"usd":0.91,"eur":0.845}}`))
+	}))
+	defer server.Close()
+
+	client := NewPriceClient(server.URL, []string{"USD", "eur"}, false, time.Minute)
+	assert.Equal(t, "usd", client.DefaultCurrency())
+
+	price, err := client.Price(context.Background(), "usd")
+	assert.Nil(t, err)
+	assert.Equal(t, "0.91", price.FloatString(2))
+	assert.Equal(t, "ids=nano&vs_currencies=usd%2Ceur", query)
+
+	// Cached
+	price, err = client.Price(context.Background(), "EUR")
+	assert.Nil(t, err)
+	assert.Equal(t, "0.845", price.FloatString(3))
+	assert.Equal(t, 1, calls)
+
+	_, err = client.Price(context.Background(), "gbp")
+	assert.ErrorIs(t, err, ErrUnsupportedCurrency)
+	assert.Equal(t, 1, calls)
+
+	// Expired
+	client.fetchedAt = time.Now().Add(-time.Minute)
+	_, err = client.Price(context.Background(), "usd")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestFiatValue(t *testing.T) {
+	calls := 0
+	server := mockPriceServer(t, &calls, `{"nano":{"usd":0.91},"banano":{"usd":0.005}}`)
+
+	client := NewPriceClient(server.URL, []string{"usd"}, false, time.Minute)
+	raw, _ := big.NewInt(0).SetString("1500000000000000000000000000000", 10)
+	value, err := client.FiatValue(context.Background(), raw, "usd")
+	assert.Nil(t, err)
+	assert.Equal(t, "1.37", value)
+
+	value, err = client.FiatValue(context.Background(), big.NewInt(0), "usd")
+	assert.Nil(t, err)
+	assert.Equal(t, "0.00", value)
+
+	banano := NewPriceClient(server.URL, []string{"usd"}, true, time.Minute)
+	raw, _ = big.NewInt(0).SetString("100000000000000000000000000000000", 10)
+	value, err = banano.FiatValue(context.Background(), raw, "usd")
+	assert.Nil(t, err)
+	assert.Equal(t, "5.00", value)
+}
+
+func TestPriceUnavailable(t *testing.T) {
+	calls := 0
+	server := mockPriceServer(t, &calls, `{"error":"rate limited"}`)
+
+	client := NewPriceClient(server.URL, []string{"usd"}, false, time.Minute)
+	_, err := client.Price(context.Background(), "usd")
+	assert.ErrorIs(t, err, ErrPriceUnavailable)
+
+	// The failure is cached too
+	_, err = client.Price(context.Background(), "usd")
+	assert.ErrorIs(t, err, ErrPriceUnavailable)
+	assert.Equal(t, 1, calls)
+
+	// Nothing listening
+	server.Close()
+	client = NewPriceClient(server.URL, []string{"usd"}, false, time.Minute)
+	_, err = client.Price(context.Background(), "usd")
+	assert.ErrorIs(t, err, ErrPriceUnavailable)
+}
@@ -0,0 +1,171 @@
+package rpc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/metrics"
+)
+
+// Responses to read-only actions can be cached, each action for as long as its TTL
+// Responses about an account are cached per generation of the account, a process request for a block of the account starts a new one
+// The destination of a send gets a new generation too, the block's link is taken as an account whatever the subtype
+// Errors like "Account not found" aren't cached
+
+// Actions whose responses can be cached
+var CACHEABLE_ACTIONS = []string{
+	"account_balance",
+	"account_history",
+	"account_info",
+	"account_representative",
+	"block_info",
+	"blocks_info",
+	"pending",
+	"receivable",
+}
+
+// Results of looking up a response in the cache, for nodeCacheRequests
+const (
+	nodeCacheHit  = "hit"
+	nodeCacheMiss = "miss"
+)
+
+var nodeCacheRequests = metrics.NewCounterVec("pippin_node_rpc_cache_requests_total", "Node RPC requests looked up in the response cache, by result: hit or miss", "result")
+
+// Where responses are cached, cache.CacheClient is one
+type ResponseCache interface {
+	// An error if the key isn't set or expired
+	Get(key string) ([]byte, error)
+	Set(key string, value []byte, ttl time.Duration) error
+}
+
+// Cache the responses of the actions in ttls for that long, a nil cache or no ttls stops caching them
+// Actions that aren't in CACHEABLE_ACTIONS are never cached
+func (client *RPCClient) SetResponseCache(cache ResponseCache, ttls map[string]time.Duration) {
+	client.cacheMutex.Lock()
+	defer client.cacheMutex.Unlock()
+	client.cache = cache
+	client.cacheTTLs = map[string]time.Duration{}
+	client.generationTTL = 0
+	for action, ttl := range ttls {
+		if ttl <= 0 || !slices.Contains(CACHEABLE_ACTIONS, action) {
+			continue
+		}
+		client.cacheTTLs[action] = ttl
+		// A generation has to outlive every response cached with it
+		client.generationTTL = max(client.generationTTL, ttl)
+	}
+}
+
+// The cache and how long to cache the action's responses, nil if they aren't cached
+func (client *RPCClient) responseCache(action string) (ResponseCache, time.Duration) {
+	client.cacheMutex.RLock()
+	defer client.cacheMutex.RUnlock()
+	ttl, ok := client.cacheTTLs[action]
+	if client.cache == nil || !ok {
+		return nil, 0
+	}
+	return client.cache, ttl
+}
+
+// The same account with any prefix, nano_, xrb_ or ban_
+func accountGenerationKey(account string) string {
+	if i := strings.LastIndex(account, "_"); i >= 0 {
+		account = account[i+1:]
+	}
+	return fmt.Sprintf("node_rpc_generation:%s", account)
+}
+
+// The account's current generation, empty if it never had a block published
+func (client *RPCClient) accountGeneration(cache ResponseCache, account string) string {
+	if account == "" {
+		return ""
+	}
+	generation, err := cache.Get(accountGenerationKey(account))
+	if err != nil {
+		return ""
+	}
+	return string(generation)
+}
+
+// Make the responses cached about the accounts stale
+func (client *RPCClient) InvalidateAccounts(accounts ...string) {
+	client.cacheMutex.RLock()
+	cache, ttl := client.cache, client.generationTTL
+	client.cacheMutex.RUnlock()
+	if cache == nil || ttl <= 0 {
+		return
+	}
+	generation := []byte(strconv.FormatInt(time.Now().UnixNano(), 10))
+	for _, account := range accounts {
+		if account == "" {
+			continue
+		}
+		if err := cache.Set(accountGenerationKey(account), generation, ttl); err != nil {
+			log.Warnf("Unable to invalidate the node responses cached for %s %s", account, err)
+		}
+	}
+}
+
+// Make the responses about the account of a block in a process request stale, and about the destination of a send
+// The block can be JSON or a string of it, like the node accepts
+func (client *RPCClient) invalidateProcessed(body []byte) {
+	var request struct {
+		Block json.RawMessage `json:"block"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil || len(request.Block) < 1 {
+		return
+	}
+	blockJson := []byte(request.Block)
+	var blockStr string
+	if json.Unmarshal(request.Block, &blockStr) == nil {
+		blockJson = []byte(blockStr)
+	}
+	var block struct {
+		Account string `json:"account"`
+		Link    string `json:"link"`
+	}
+	if err := json.Unmarshal(blockJson, &block); err != nil {
+		return
+	}
+	accounts := []string{block.Account}
+	if link, err := hex.DecodeString(block.Link); err == nil && len(link) == 32 {
+		// The prefix doesn't matter, see accountGenerationKey
+		accounts = append(accounts, utils.PubKeyToAddress(link, false))
+	}
+	client.InvalidateAccounts(accounts...)
+}
+
+// Make the request, or return the response cached for it
+func (client *RPCClient) cachedRequest(cache ResponseCache, ttl time.Duration, account string, body []byte, request func() ([]byte, error)) ([]byte, error) {
+	sum := sha256.Sum256(body)
+	key := fmt.Sprintf("node_rpc:%s:%s", client.accountGeneration(cache, account), hex.EncodeToString(sum[:]))
+	if cached, err := cache.Get(key); err == nil {
+		nodeCacheRequests.Inc(nodeCacheHit)
+		return cached, nil
+	}
+	nodeCacheRequests.Inc(nodeCacheMiss)
+
+	response, err := request()
+	if err != nil {
+		return response, err
+	}
+	var decoded map[string]json.RawMessage
+	if json.Unmarshal(response, &decoded) != nil {
+		return response, nil
+	} else if _, ok := decoded["error"]; ok {
+		return response, nil
+	}
+	if err := cache.Set(key, response, ttl); err != nil {
+		log.Warnf("Unable to cache the node response %s", err)
+	}
+	return response, nil
+}
@@ -0,0 +1,133 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// A ResponseCache that never expires anything
+type mapResponseCache struct {
+	mu     sync.Mutex
+	values map[string][]byte
+}
+
+func (c *mapResponseCache) Get(key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.values[key]
+	if !ok {
+		return nil, errors.New("cache miss")
+	}
+	return value, nil
+}
+
+func (c *mapResponseCache) Set(key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = value
+	return nil
+}
+
+func TestResponseCache(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	account := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	destination := "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"
+	calls := map[string]int{}
+	httpmock.RegisterResponder("POST", "http://cachenode",
+		func(req *http.Request) (*http.Response, error) {
+			var request map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&request)
+			action := request["action"].(string)
+			calls[action]++
+			switch action {
+			case "process":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"hash": "ABC"})
+			case "account_info":
+				if request["account"] == "nano_1111111111111111111111111111111111111111111111111111hifc8npp" {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Account not found"})
+				}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"balance": "1", "pending": "0", "receivable": "0", "frontier": "ABC"})
+		},
+	)
+
+	client := NewRPCClient("http://cachenode")
+	client.SetResponseCache(&mapResponseCache{values: map[string][]byte{}}, map[string]time.Duration{
+		"account_info":    time.Minute,
+		"account_balance": time.Minute,
+		// Changes something, never cached
+		"send": time.Minute,
+	})
+
+	// Only the first one goes to the node
+	for i := 0; i < 3; i++ {
+		resp, err := client.MakeAccountInfoRequest(account)
+		assert.Nil(t, err)
+		assert.Equal(t, "ABC", resp.Frontier)
+	}
+	assert.Equal(t, 1, calls["account_info"])
+	// Other accounts and actions aren't the same request
+	_, err := client.MakeAccountInfoRequest(destination)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls["account_info"])
+	_, err = client.MakeAccountBalanceRequest(account)
+	assert.Nil(t, err)
+	_, err = client.MakeAccountBalanceRequest(account)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls["account_balance"])
+	// Without a TTL it isn't cached
+	client.MakeBlockCountRequest()
+	client.MakeBlockCountRequest()
+	assert.Equal(t, 2, calls["block_count"])
+	client.MakeRequest(map[string]interface{}{"action": "send"})
+	client.MakeRequest(map[string]interface{}{"action": "send"})
+	assert.Equal(t, 2, calls["send"])
+
+	// Errors aren't cached
+	for i := 0; i < 2; i++ {
+		_, err = client.MakeAccountInfoRequest("nano_1111111111111111111111111111111111111111111111111111hifc8npp")
+		assert.ErrorIs(t, err, ErrAccountNotFound)
+	}
+	assert.Equal(t, 4, calls["account_info"])
+
+	// Publishing a send makes the account and the destination stale
+	pub, err := utils.AddressToPub(destination, false)
+	assert.Nil(t, err)
+	_, err = client.MakeProcessRequest(requests.ProcessRequest{
+		BaseRequest: requests.BaseRequest{Action: "process"},
+		JsonBlock:   true,
+		Block:       block.StateBlock{Type: "state", Account: account, Link: hex.EncodeToString(pub)},
+	})
+	assert.Nil(t, err)
+	client.MakeAccountInfoRequest(account)
+	client.MakeAccountInfoRequest(destination)
+	assert.Equal(t, 6, calls["account_info"])
+	client.MakeAccountInfoRequest(account)
+	client.MakeAccountInfoRequest(destination)
+	assert.Equal(t, 6, calls["account_info"])
+
+	// A block as a string, with another prefix
+	blockJson, _ := json.Marshal(block.StateBlock{Type: "state", Account: "xrb_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"})
+	client.MakeRequest(map[string]interface{}{"action": "process", "block": string(blockJson)})
+	client.MakeAccountInfoRequest(account)
+	client.MakeAccountInfoRequest(destination)
+	assert.Equal(t, 7, calls["account_info"])
+
+	// Turned off
+	client.SetResponseCache(nil, nil)
+	client.MakeAccountInfoRequest(account)
+	assert.Equal(t, 8, calls["account_info"])
+}
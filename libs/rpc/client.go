@@ -1,57 +1,118 @@
 package rpc
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
-	"io"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
 	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/metrics"
 	"github.com/mitchellh/mapstructure"
 )
 
 var ErrAccountNotFound = errors.New("Account not found")
+var ErrBlockNotFound = errors.New("Block not found")
+
+// process answered with an error, so the node definitely didn't take the block
+// A request that failed or timed out returns another error, the node could have taken the block then
+type ProcessRejectedError struct {
+	// The node's error, e.g. Fork
+	Reason string
+}
+
+func (e *ProcessRejectedError) Error() string {
+	return e.Reason
+}
+
+var nodeRequests = metrics.NewCounterVec("pippin_node_rpc_requests_total", "Requests made to the node RPC")
+var nodeErrors = metrics.NewCounterVec("pippin_node_rpc_errors_total", "Node RPC requests that failed, by reason: transport or status", "reason")
 
 type RPCClient struct {
+	*clientState
+	// What requests are made with, unless MakeRequestWithContext is given one, see WithContext
+	ctx context.Context
+}
+
+// Shared by a client and the ones WithContext returns
+type clientState struct {
+	// The primary node
 	Url        string
 	httpClient *http.Client
+	// Guarded by nodesMutex, SetNodes can replace them while requests are made
+	nodes      []*node
+	roundRobin bool
+	nodesMutex sync.RWMutex
+	// The node the next round robin request starts at
+	next atomic.Uint32
+	// Guarded by cacheMutex, see SetResponseCache
+	cache         ResponseCache
+	cacheTTLs     map[string]time.Duration
+	generationTTL time.Duration
+	cacheMutex    sync.RWMutex
 }
 
 func NewRPCClient(url string) *RPCClient {
 	return &RPCClient{
-		Url: url,
-		httpClient: &http.Client{
-			Timeout: time.Second * 30, // Set a timeout for all requests
+		clientState: &clientState{
+			Url: url,
+			httpClient: &http.Client{
+				Timeout: time.Second * 30, // Set a timeout for all requests
+			},
+			nodes: []*node{{url: url}},
 		},
 	}
 }
 
+// The same client, with the same nodes and cache, whose requests are made with ctx
+// The node and the logs get ctx's request ID, e.g. for everything a gateway request asks the node
+func (client *RPCClient) WithContext(ctx context.Context) *RPCClient {
+	return &RPCClient{clientState: client.clientState, ctx: ctx}
+}
+
+// Logs with the request ID of the client's context
+func (client *RPCClient) logger() *log.CtxLogger {
+	return log.Ctx(client.ctx)
+}
+
 // Base request
 func (client *RPCClient) MakeRequest(request interface{}) ([]byte, error) {
+	ctx := client.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return client.MakeRequestWithContext(ctx, request)
+}
+
+// Base request, given up on when ctx is done
+func (client *RPCClient) MakeRequestWithContext(ctx context.Context, request interface{}) ([]byte, error) {
 	requestBody, err := json.Marshal(request)
 	if err != nil {
-		log.Errorf("Error marshalling request %s", err)
+		log.Ctx(ctx).Errorf("Error marshalling request %s", err)
 		return nil, err
 	}
-	// HTTP post
-	resp, err := client.httpClient.Post(client.Url, "application/json", bytes.NewBuffer(requestBody))
-	if err != nil {
-		log.Errorf("Error making RPC request %s", err)
-		return nil, err
+	var parsed struct {
+		Action  string `json:"action"`
+		Account string `json:"account"`
 	}
-	defer resp.Body.Close()
-	// Try to decode+deserialize
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		log.Errorf("Error decoding response body %s", err)
-		return nil, err
+	json.Unmarshal(requestBody, &parsed)
+	if cache, ttl := client.responseCache(parsed.Action); cache != nil {
+		return client.cachedRequest(cache, ttl, parsed.Account, requestBody, func() ([]byte, error) {
+			return client.failoverRequest(ctx, requestBody)
+		})
+	}
+	response, err := client.failoverRequest(ctx, requestBody)
+	// Even if it failed, it could have been the cache's fault
+	if parsed.Action == "process" {
+		client.invalidateProcessed(requestBody)
 	}
-	return body, nil
+	return response, err
 }
 
 func (client *RPCClient) MakeAccountsBalancesRequest(accounts []string) (*responses.AccountsBalancesResponse, error) {
@@ -63,13 +124,13 @@ func (client *RPCClient) MakeAccountsBalancesRequest(accounts []string) (*respon
 	}
 	response, err := client.MakeRequest(request)
 	if err != nil {
-		log.Errorf("Error making request %s", err)
+		client.logger().Errorf("Error making request %s", err)
 		return nil, err
 	}
 	var resp map[string]interface{}
 	err = json.Unmarshal(response, &resp)
 	if err != nil {
-		log.Errorf("Error unmarshalling response %s", err)
+		client.logger().Errorf("Error unmarshalling response %s", err)
 		return nil, err
 	}
 	// See if contains an error
@@ -83,7 +144,7 @@ func (client *RPCClient) MakeAccountsBalancesRequest(accounts []string) (*respon
 	var decoded responses.AccountsBalancesResponse
 	err = mapstructure.Decode(resp, &decoded)
 	if err != nil {
-		log.Errorf("Error decoding response %s", err)
+		client.logger().Errorf("Error decoding response %s", err)
 		return nil, err
 	}
 
@@ -103,13 +164,13 @@ func (client *RPCClient) MakeAccountBalanceRequest(account string) (*responses.A
 	}
 	response, err := client.MakeRequest(request)
 	if err != nil {
-		log.Errorf("Error making request %s", err)
+		client.logger().Errorf("Error making request %s", err)
 		return nil, err
 	}
 	var resp map[string]interface{}
 	err = json.Unmarshal(response, &resp)
 	if err != nil {
-		log.Errorf("Error unmarshalling response %s", err)
+		client.logger().Errorf("Error unmarshalling response %s", err)
 		return nil, err
 	}
 	// See if contains an error
@@ -123,7 +184,7 @@ func (client *RPCClient) MakeAccountBalanceRequest(account string) (*responses.A
 	var decoded responses.AccountBalanceItem
 	err = mapstructure.Decode(resp, &decoded)
 	if err != nil {
-		log.Errorf("Error decoding response %s", err)
+		client.logger().Errorf("Error decoding response %s", err)
 		return nil, err
 	}
 
@@ -143,13 +204,13 @@ func (client *RPCClient) MakeAccountsFrontiersRequest(accounts []string) (*respo
 	}
 	response, err := client.MakeRequest(request)
 	if err != nil {
-		log.Errorf("Error making request %s", err)
+		client.logger().Errorf("Error making request %s", err)
 		return nil, err
 	}
 	var resp map[string]interface{}
 	err = json.Unmarshal(response, &resp)
 	if err != nil {
-		log.Errorf("Error unmarshalling response %s", err)
+		client.logger().Errorf("Error unmarshalling response %s", err)
 		return nil, err
 	}
 	// See if contains an error
@@ -160,10 +221,14 @@ func (client *RPCClient) MakeAccountsFrontiersRequest(accounts []string) (*respo
 		}
 		return nil, errors.New("Unknown error")
 	}
+	// The node returns an empty string when none of the accounts have a frontier
+	if val, ok := resp["frontiers"].(string); ok && val == "" {
+		resp["frontiers"] = map[string]string{}
+	}
 	var decoded responses.AccountsFrontiersResponse
 	err = mapstructure.Decode(resp, &decoded)
 	if err != nil {
-		log.Errorf("Error decoding response %s", err)
+		client.logger().Errorf("Error decoding response %s", err)
 		return nil, err
 	}
 	// Check that it'
@@ -183,13 +248,13 @@ func (client *RPCClient) MakeAccountsPendingRequest(accounts []string) (*respons
 	}
 	response, err := client.MakeRequest(request)
 	if err != nil {
-		log.Errorf("Error making request %s", err)
+		client.logger().Errorf("Error making request %s", err)
 		return nil, err
 	}
 	var resp map[string]interface{}
 	err = json.Unmarshal(response, &resp)
 	if err != nil {
-		log.Errorf("Error unmarshalling response %s", err)
+		client.logger().Errorf("Error unmarshalling response %s", err)
 		return nil, err
 	}
 	// See if contains an error
@@ -215,7 +280,7 @@ func (client *RPCClient) MakeAccountsPendingRequest(accounts []string) (*respons
 	var decoded responses.AccountsPendingResponse
 	err = mapstructure.Decode(resp, &decoded)
 	if err != nil {
-		log.Errorf("Error unmarshalling response %s", err)
+		client.logger().Errorf("Error unmarshalling response %s", err)
 		return nil, err
 	}
 	// Check that it'
@@ -236,19 +301,22 @@ func (client *RPCClient) MakeBlockInfoRequest(hash string) (*responses.BlockInfo
 	}
 	response, err := client.MakeRequest(request)
 	if err != nil {
-		log.Errorf("Error making request %s", err)
+		client.logger().Errorf("Error making request %s", err)
 		return nil, err
 	}
 	var resp map[string]interface{}
 	err = json.Unmarshal(response, &resp)
 	if err != nil {
-		log.Errorf("Error unmarshalling response %s", err)
+		client.logger().Errorf("Error unmarshalling response %s", err)
 		return nil, err
 	}
 	// See if contains an error
 	if val, ok := resp["error"]; ok {
 		errStr, ok := val.(string)
 		if ok {
+			if strings.ToLower(errStr) == "block not found" {
+				return nil, ErrBlockNotFound
+			}
 			return nil, errors.New(errStr)
 		}
 		return nil, errors.New("Unknown error")
@@ -256,7 +324,7 @@ func (client *RPCClient) MakeBlockInfoRequest(hash string) (*responses.BlockInfo
 	var decoded responses.BlockInfoResponse
 	err = mapstructure.Decode(resp, &decoded)
 	if err != nil {
-		log.Errorf("Error decoding response %s", err)
+		client.logger().Errorf("Error decoding response %s", err)
 		return nil, err
 	}
 
@@ -266,13 +334,13 @@ func (client *RPCClient) MakeBlockInfoRequest(hash string) (*responses.BlockInfo
 func (client *RPCClient) MakeProcessRequest(request requests.ProcessRequest) (*responses.ProcessResponse, error) {
 	response, err := client.MakeRequest(request)
 	if err != nil {
-		log.Errorf("Error making request %s", err)
+		client.logger().Errorf("Error making request %s", err)
 		return nil, err
 	}
 	var resp map[string]interface{}
 	err = json.Unmarshal(response, &resp)
 	if err != nil {
-		log.Errorf("Error unmarshalling response %s", err)
+		client.logger().Errorf("Error unmarshalling response %s", err)
 		return nil, err
 	}
 	if val, ok := resp["hash"]; ok {
@@ -289,7 +357,7 @@ func (client *RPCClient) MakeProcessRequest(request requests.ProcessRequest) (*r
 		if !ok {
 			return nil, errors.New("Error response is not a string")
 		}
-		return nil, errors.New(err)
+		return nil, &ProcessRejectedError{Reason: err}
 	}
 
 	return nil, errors.New("No hash or error returned")
@@ -312,13 +380,13 @@ func (client *RPCClient) MakeAccountInfoRequest(account string) (*responses.Acco
 	}
 	response, err := client.MakeRequest(request)
 	if err != nil {
-		log.Errorf("Error making request %s", err)
+		client.logger().Errorf("Error making request %s", err)
 		return nil, err
 	}
 	var resp map[string]interface{}
 	err = json.Unmarshal(response, &resp)
 	if err != nil {
-		log.Errorf("Error unmarshalling response %s", err)
+		client.logger().Errorf("Error unmarshalling response %s", err)
 		return nil, err
 	}
 	// See if contains an error
@@ -335,7 +403,7 @@ func (client *RPCClient) MakeAccountInfoRequest(account string) (*responses.Acco
 	var decoded responses.AccountInfoResponse
 	err = mapstructure.Decode(resp, &decoded)
 	if err != nil {
-		log.Errorf("Error decoding response %s", err)
+		client.logger().Errorf("Error decoding response %s", err)
 		return nil, err
 	}
 
@@ -343,6 +411,11 @@ func (client *RPCClient) MakeAccountInfoRequest(account string) (*responses.Acco
 }
 
 func (client *RPCClient) MakeReceivableRequest(account string, threshold string) (*responses.ReceivableResponse, error) {
+	return client.MakeReceivableRequestWithCount(account, threshold, 0)
+}
+
+// Like MakeReceivableRequest, but at most count blocks
+func (client *RPCClient) MakeReceivableRequestWithCount(account string, threshold string, count int) (*responses.ReceivableResponse, error) {
 	request := requests.ReceivableRequest{
 		BaseRequest: requests.BaseRequest{
 			Action: "receivable",
@@ -350,16 +423,17 @@ func (client *RPCClient) MakeReceivableRequest(account string, threshold string)
 		Account:              account,
 		Threshold:            threshold,
 		IncludeOnlyConfirmed: true,
+		Count:                count,
 	}
 	response, err := client.MakeRequest(request)
 	if err != nil {
-		log.Errorf("Error making request %s", err)
+		client.logger().Errorf("Error making request %s", err)
 		return nil, err
 	}
 	var resp map[string]interface{}
 	err = json.Unmarshal(response, &resp)
 	if err != nil {
-		log.Errorf("Error unmarshalling response %s", err)
+		client.logger().Errorf("Error unmarshalling response %s", err)
 		return nil, err
 	}
 	// See if contains an error
@@ -396,9 +470,660 @@ func (client *RPCClient) MakeReceivableRequest(account string, threshold string)
 	var decoded responses.ReceivableResponse
 	err = mapstructure.Decode(resp, &decoded)
 	if err != nil {
-		log.Errorf("Error decoding response %s", err)
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+
+	return &decoded, nil
+}
+
+// If hash is a send that hasn't been received yet
+func (client *RPCClient) MakeReceivableExistsRequest(hash string) (bool, error) {
+	request := requests.ReceivableExistsRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "receivable_exists",
+		},
+		Hash: hash,
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return false, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return false, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			if strings.ToLower(errStr) == "block not found" {
+				return false, ErrBlockNotFound
+			}
+			return false, errors.New(errStr)
+		}
+		return false, errors.New("Unknown error")
+	}
+	var decoded responses.ReceivableExistsResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return false, err
+	}
+
+	return decoded.Exists == "1", nil
+}
+
+func (client *RPCClient) MakeAccountRepresentativeRequest(account string) (*responses.AccountRepresentativeResponse, error) {
+	request := requests.AccountRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "account_representative",
+		},
+		Account: account,
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			if strings.ToLower(errStr) == "account not found" {
+				return nil, ErrAccountNotFound
+			}
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	var decoded responses.AccountRepresentativeResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+
+	return &decoded, nil
+}
+
+func (client *RPCClient) MakeBlockCountRequest() (*responses.BlockCountResponse, error) {
+	request := requests.BaseRequest{
+		Action: "block_count",
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	var decoded responses.BlockCountResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+
+	return &decoded, nil
+}
+
+// Peers of the node, empty if it has none
+func (client *RPCClient) MakePeersRequest() (*responses.PeersResponse, error) {
+	request := requests.BaseRequest{
+		Action: "peers",
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	// The node returns an empty string when there are no peers
+	if val, ok := resp["peers"].(string); ok && val == "" {
+		return &responses.PeersResponse{
+			Peers: make(map[string]interface{}),
+		}, nil
+	}
+	var decoded responses.PeersResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+
+	return &decoded, nil
+}
+
+// With difficulty_trend
+func (client *RPCClient) MakeActiveDifficultyRequest() (*responses.ActiveDifficultyResponse, error) {
+	request := requests.ActiveDifficultyRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "active_difficulty",
+		},
+		IncludeTrend: true,
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	var decoded responses.ActiveDifficultyResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+
+	return &decoded, nil
+}
+
+func (client *RPCClient) MakeConfirmationQuorumRequest() (*responses.ConfirmationQuorumResponse, error) {
+	request := requests.BaseRequest{
+		Action: "confirmation_quorum",
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	var decoded responses.ConfirmationQuorumResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+
+	return &decoded, nil
+}
+
+// The hashes from block back to the account's open block, offset skips the first ones
+func (client *RPCClient) MakeChainRequest(block string, count int, offset *int) (*responses.ChainResponse, error) {
+	request := requests.ChainRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "chain",
+		},
+		Block:  block,
+		Count:  count,
+		Offset: offset,
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	// The node returns an empty string when there are no blocks
+	if val, ok := resp["blocks"].(string); ok && val == "" {
+		return &responses.ChainResponse{
+			Blocks: []string{},
+		}, nil
+	}
+	var decoded responses.ChainResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+
+	return &decoded, nil
+}
+
+func (client *RPCClient) MakeAccountsRepresentativesRequest(accounts []string) (*responses.AccountsRepresentativesResponse, error) {
+	request := requests.AccountsRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "accounts_representatives",
+		},
+		Accounts: accounts,
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	// The node returns an empty string when none of the accounts have a representative
+	if val, ok := resp["representatives"].(string); ok && val == "" {
+		resp["representatives"] = map[string]string{}
+	}
+	var decoded responses.AccountsRepresentativesResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+	if decoded.Representatives == nil {
+		return nil, errors.New("No representatives returned")
+	}
+
+	return &decoded, nil
+}
+
+// Account info of several accounts at once, representative, weight and pending are only included if asked for
+func (client *RPCClient) MakeAccountsInfoRequest(accounts []string, representative bool, weight bool, pending bool) (*responses.AccountsInfoResponse, error) {
+	request := requests.AccountsInfoRequest{
+		AccountsRequest: requests.AccountsRequest{
+			BaseRequest: requests.BaseRequest{
+				Action: "accounts_info",
+			},
+			Accounts: accounts,
+		},
+	}
+	if representative {
+		request.Representative = &representative
+	}
+	if weight {
+		request.Weight = &weight
+	}
+	if pending {
+		request.Pending = &pending
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	// The node returns an empty string when none of the accounts are opened
+	if val, ok := resp["infos"].(string); ok && val == "" {
+		resp["infos"] = map[string]interface{}{}
+	}
+	var decoded responses.AccountsInfoResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+	if decoded.Infos == nil {
+		return nil, errors.New("No infos returned")
+	}
+
+	return &decoded, nil
+}
+
+// The representatives the node has seen voting recently, without their weight
+func (client *RPCClient) MakeRepresentativesOnlineRequest() (*responses.RepresentativesOnlineResponse, error) {
+	request := requests.BaseRequest{
+		Action: "representatives_online",
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	// The node returns an empty string when no representative is online
+	if val, ok := resp["representatives"].(string); ok && val == "" {
+		resp["representatives"] = []string{}
+	}
+	var decoded responses.RepresentativesOnlineResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+	if decoded.Representatives == nil {
+		return nil, errors.New("No representatives returned")
+	}
+
+	return &decoded, nil
+}
+
+// Raw amount not in the genesis, landing, faucet or burn accounts
+func (client *RPCClient) MakeAvailableSupplyRequest() (*responses.AvailableSupplyResponse, error) {
+	request := requests.BaseRequest{
+		Action: "available_supply",
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	var decoded responses.AvailableSupplyResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+	if decoded.Available == "" {
+		return nil, errors.New("No available supply returned")
+	}
+
+	return &decoded, nil
+}
+
+// The node's version, vendor and network
+func (client *RPCClient) MakeVersionRequest() (*responses.VersionResponse, error) {
+	request := requests.BaseRequest{
+		Action: "version",
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	var decoded responses.VersionResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+
+	return &decoded, nil
+}
+
+// Up to count send and receive blocks of an account, newest first, starting at head if it's set
+func (client *RPCClient) MakeAccountHistoryRequest(account string, count int, head *string) (*responses.AccountHistoryResponse, error) {
+	request := requests.AccountHistoryRequest{
+		AccountRequest: requests.AccountRequest{
+			BaseRequest: requests.BaseRequest{
+				Action: "account_history",
+			},
+			Account: account,
+		},
+		Count: count,
+		Head:  head,
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	// The node returns an empty string when there is no history
+	if val, ok := resp["history"].(string); ok && val == "" {
+		resp["history"] = []interface{}{}
+	}
+	var decoded responses.AccountHistoryResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+
+	return &decoded, nil
+}
+
+// Up to count accounts delegating to account with their balance, in account order after start if it's set
+func (client *RPCClient) MakeDelegatorsRequest(account string, threshold *string, count int, start *string) (*responses.DelegatorsResponse, error) {
+	request := requests.DelegatorsRequest{
+		AccountRequest: requests.AccountRequest{
+			BaseRequest: requests.BaseRequest{
+				Action: "delegators",
+			},
+			Account: account,
+		},
+		Threshold: threshold,
+		Count:     count,
+		Start:     start,
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	// The node returns an empty string when there are no delegators
+	if val, ok := resp["delegators"].(string); ok && val == "" {
+		resp["delegators"] = map[string]string{}
+	}
+	var decoded responses.DelegatorsResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
 		return nil, err
 	}
+	if decoded.Delegators == nil {
+		return nil, errors.New("No delegators returned")
+	}
+
+	return &decoded, nil
+}
+
+// How many accounts delegate to account, regardless of their balance
+func (client *RPCClient) MakeDelegatorsCountRequest(account string) (*responses.DelegatorsCountResponse, error) {
+	request := requests.AccountRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "delegators_count",
+		},
+		Account: account,
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	var decoded responses.DelegatorsCountResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+	if decoded.Count == "" {
+		return nil, errors.New("No count returned")
+	}
+
+	return &decoded, nil
+}
+
+// The voting weight delegated to account, including from its own balance
+func (client *RPCClient) MakeAccountWeightRequest(account string) (*responses.AccountWeightResponse, error) {
+	request := requests.AccountRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "account_weight",
+		},
+		Account: account,
+	}
+	response, err := client.MakeRequest(request)
+	if err != nil {
+		client.logger().Errorf("Error making request %s", err)
+		return nil, err
+	}
+	var resp map[string]interface{}
+	err = json.Unmarshal(response, &resp)
+	if err != nil {
+		client.logger().Errorf("Error unmarshalling response %s", err)
+		return nil, err
+	}
+	// See if contains an error
+	if val, ok := resp["error"]; ok {
+		errStr, ok := val.(string)
+		if ok {
+			return nil, errors.New(errStr)
+		}
+		return nil, errors.New("Unknown error")
+	}
+	var decoded responses.AccountWeightResponse
+	err = mapstructure.Decode(resp, &decoded)
+	if err != nil {
+		client.logger().Errorf("Error decoding response %s", err)
+		return nil, err
+	}
+	if decoded.Weight == "" {
+		return nil, errors.New("No weight returned")
+	}
 
 	return &decoded, nil
 }
@@ -1,14 +1,16 @@
 package rpc
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"os"
 	"testing"
 
+	"github.com/appditto/pippin_nano_wallet/libs/nano/block"
 	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
 	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
-	walletmodels "github.com/appditto/pippin_nano_wallet/libs/wallet/models"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
@@ -84,6 +86,22 @@ func TestGetAccountsFrontiers(t *testing.T) {
 	assert.Equal(t, "6A32397F4E95AF025DE29D9BF1ACE864D5404362258E06489FABDBA9DCCC046F", frontiers["nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7"])
 }
 
+func TestGetAccountsFrontiersNoneOpened(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(200, `{"frontiers": "", "errors": {"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3": "Account not found"}}`), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeAccountsFrontiersRequest([]string{"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"})
+	assert.Nil(t, err)
+	assert.Len(t, *resp.Frontiers, 0)
+	assert.Equal(t, "Account not found", (*resp.Errors)["nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"])
+}
+
 func TestGetAccountsPending(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -131,7 +149,7 @@ func TestMakeProcessRequest(t *testing.T) {
 		func(req *http.Request) (*http.Response, error) {
 			var pr requests.ProcessRequest
 			json.NewDecoder(req.Body).Decode(&pr)
-			if pr.Block.Hash == "abcd1234" {
+			if pr.Block.Account == "abcd1234" {
 				var js map[string]interface{}
 				json.Unmarshal([]byte(mocks.ProcessResponseStr), &js)
 				resp, err := httpmock.NewJsonResponse(200, js)
@@ -149,8 +167,8 @@ func TestMakeProcessRequest(t *testing.T) {
 			Action: "process",
 		},
 		JsonBlock: true,
-		Block: walletmodels.StateBlock{
-			Hash: "abcd1234",
+		Block: block.StateBlock{
+			Account: "abcd1234",
 		},
 	})
 
@@ -163,12 +181,60 @@ func TestMakeProcessRequest(t *testing.T) {
 			Action: "process",
 		},
 		JsonBlock: true,
-		Block: walletmodels.StateBlock{
-			Hash: "notabcd1234",
+		Block: block.StateBlock{
+			Account: "notabcd1234",
 		},
 	})
 	assert.NotNil(t, err)
 	assert.Equal(t, "bad input", err.Error())
+	var rejected *ProcessRejectedError
+	assert.ErrorAs(t, err, &rejected)
+
+	// Nothing came back, the node could have taken it
+	httpmock.RegisterResponder("POST", "http://localhost:123456", httpmock.NewErrorResponder(errors.New("timeout")))
+	_, err = MockRpcClient.MakeProcessRequest(requests.ProcessRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "process",
+		},
+		JsonBlock: true,
+		Block: block.StateBlock{
+			Account: "abcd1234",
+		},
+	})
+	assert.NotNil(t, err)
+	assert.False(t, errors.As(err, &rejected))
+}
+
+func TestMakeReceivableExistsRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.ReceivableExistsRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr.Hash {
+			case "abcd1234":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.ReceivableExistsResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "abcd1235":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"exists": "0"})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Block not found"})
+		},
+	)
+
+	exists, err := MockRpcClient.MakeReceivableExistsRequest("abcd1234")
+	assert.Nil(t, err)
+	assert.True(t, exists)
+
+	exists, err = MockRpcClient.MakeReceivableExistsRequest("abcd1235")
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	_, err = MockRpcClient.MakeReceivableExistsRequest("abcd1236")
+	assert.ErrorIs(t, err, ErrBlockNotFound)
 }
 
 func TestMakeBlockInfoRequest(t *testing.T) {
@@ -185,6 +251,9 @@ func TestMakeBlockInfoRequest(t *testing.T) {
 				resp, err := httpmock.NewJsonResponse(200, js)
 				return resp, err
 			}
+			if pr.Hash == "abcd1235" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Block not found"})
+			}
 			var js map[string]interface{}
 			json.Unmarshal([]byte(mocks.ErrorResponseStr), &js)
 			resp, err := httpmock.NewJsonResponse(200, js)
@@ -217,6 +286,9 @@ func TestMakeBlockInfoRequest(t *testing.T) {
 	resp, err = MockRpcClient.MakeBlockInfoRequest("def")
 	assert.NotNil(t, err)
 	assert.Equal(t, "bad input", err.Error())
+
+	_, err = MockRpcClient.MakeBlockInfoRequest("abcd1235")
+	assert.ErrorIs(t, err, ErrBlockNotFound)
 }
 
 func TestMakeAccountInfoRequest(t *testing.T) {
@@ -294,3 +366,509 @@ func TestMakeReceivableRequest(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Len(t, resp.Blocks, 0)
 }
+
+func TestMakeReceivableRequestWithCount(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.ReceivableRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Count == 1 {
+				return httpmock.NewStringResponse(200, mocks.ReceivableResponseStr), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeReceivableRequestWithCount("abcd1234", "", 1)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Blocks, 1)
+
+	_, err = MockRpcClient.MakeReceivableRequestWithCount("abcd1234", "", 2)
+	assert.NotNil(t, err)
+}
+
+func TestMakeAccountRepresentativeRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.AccountRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_representative" && pr.Account == "abcd1234" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountRepresentativeResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "Account not found",
+			})
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeAccountRepresentativeRequest("abcd1234")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", resp.Representative)
+
+	// Make an error req
+	resp, err = MockRpcClient.MakeAccountRepresentativeRequest("def")
+	assert.NotNil(t, err)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+}
+
+func TestMakeBlockCountRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "block_count" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockCountResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			var js map[string]interface{}
+			json.Unmarshal([]byte(mocks.ErrorResponseStr), &js)
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeBlockCountRequest()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "1000", resp.Count)
+	assert.Equal(t, "10", resp.Unchecked)
+	assert.Equal(t, "25", resp.Cemented)
+}
+
+func TestMakePeersRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	peers := mocks.PeersResponseStr
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "peers" {
+				return httpmock.NewStringResponse(200, peers), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakePeersRequest()
+	assert.Nil(t, err)
+	assert.Len(t, resp.Peers, 3)
+	assert.Equal(t, "19", resp.Peers["[::ffff:172.17.0.1]:32841"])
+
+	peers = `{"peers": ""}`
+	resp, err = MockRpcClient.MakePeersRequest()
+	assert.Nil(t, err)
+	assert.Len(t, resp.Peers, 0)
+
+	peers = mocks.ErrorResponseStr
+	_, err = MockRpcClient.MakePeersRequest()
+	assert.NotNil(t, err)
+}
+
+func TestMakeActiveDifficultyRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.ActiveDifficultyRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "active_difficulty" && pr.IncludeTrend {
+				return httpmock.NewStringResponse(200, mocks.ActiveDifficultyResponseStr), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeActiveDifficultyRequest()
+	assert.Nil(t, err)
+	assert.Equal(t, "fffffff800000000", resp.NetworkMinimum)
+	assert.Equal(t, "fffffe0000000000", resp.NetworkReceiveCurrent)
+	assert.Equal(t, "1", resp.Multiplier)
+	assert.Equal(t, []string{"1", "1.156096135149775"}, resp.DifficultyTrend)
+}
+
+func TestMakeConfirmationQuorumRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	quorum := mocks.ConfirmationQuorumResponseStr
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "confirmation_quorum" {
+				return httpmock.NewStringResponse(200, quorum), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeConfirmationQuorumRequest()
+	assert.Nil(t, err)
+	assert.Equal(t, "41469707173777717318245825935516662250", resp.QuorumDelta)
+	assert.Equal(t, "50", resp.OnlineWeightQuorumPercent)
+	assert.Equal(t, "82939414347555434636491651871033324568", resp.OnlineStakeTotal)
+	assert.Equal(t, "69026910610720098597176027400951402360", resp.PeersStakeTotal)
+
+	quorum = mocks.ErrorResponseStr
+	_, err = MockRpcClient.MakeConfirmationQuorumRequest()
+	assert.NotNil(t, err)
+}
+
+func TestMakeChainRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	chain := mocks.ChainResponseStr
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.ChainRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "chain" && pr.Count == 2 {
+				return httpmock.NewStringResponse(200, chain), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeChainRequest("8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72", 2, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"000D1BAEC8EC208142C99059B393051BAC8380F9B5A2E6B2489A277D81789F3F", "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72"}, resp.Blocks)
+
+	chain = `{"blocks": ""}`
+	resp, err = MockRpcClient.MakeChainRequest("8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72", 2, nil)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Blocks, 0)
+
+	_, err = MockRpcClient.MakeChainRequest("8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72", 3, nil)
+	assert.NotNil(t, err)
+}
+
+func TestMakeAccountsRepresentativesRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	representatives := mocks.AccountsRepresentativesResponseStr
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "accounts_representatives" {
+				return httpmock.NewStringResponse(200, representatives), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeAccountsRepresentativesRequest([]string{"nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5", "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k"})
+	assert.Nil(t, err)
+	assert.Len(t, *resp.Representatives, 2)
+	assert.Equal(t, "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k", (*resp.Representatives)["nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5"])
+	assert.Nil(t, resp.Errors)
+
+	// None of them opened
+	representatives = `{"representatives": "", "errors": {"nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5": "Account not found"}}`
+	resp, err = MockRpcClient.MakeAccountsRepresentativesRequest([]string{"nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5"})
+	assert.Nil(t, err)
+	assert.Len(t, *resp.Representatives, 0)
+	assert.Equal(t, "Account not found", (*resp.Errors)["nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5"])
+
+	representatives = mocks.ErrorResponseStr
+	_, err = MockRpcClient.MakeAccountsRepresentativesRequest([]string{"nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5"})
+	assert.NotNil(t, err)
+}
+
+func TestMakeAccountsInfoRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	infos := mocks.AccountsInfoResponseStr
+	var lastRequest requests.AccountsInfoRequest
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			json.NewDecoder(req.Body).Decode(&lastRequest)
+			if lastRequest.Action == "accounts_info" {
+				return httpmock.NewStringResponse(200, infos), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeAccountsInfoRequest([]string{"nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5", "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k"}, true, false, false)
+	assert.Nil(t, err)
+	assert.True(t, *lastRequest.Representative)
+	assert.Nil(t, lastRequest.Weight)
+	assert.Nil(t, lastRequest.Pending)
+	assert.Len(t, *resp.Infos, 1)
+	info := (*resp.Infos)["nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5"]
+	assert.Equal(t, "FF84533A571D953A596EA401FD41743AC85D04F406E76FDE4408EAED50B473C5", info.Frontier)
+	assert.Equal(t, "22", info.BlockCount)
+	assert.Equal(t, "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k", info.Representative)
+	assert.Equal(t, "Account not found", (*resp.Errors)["nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k"])
+
+	// None of them opened
+	infos = `{"infos": "", "errors": {"nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5": "Account not found"}}`
+	resp, err = MockRpcClient.MakeAccountsInfoRequest([]string{"nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5"}, false, true, true)
+	assert.Nil(t, err)
+	assert.True(t, *lastRequest.Weight)
+	assert.True(t, *lastRequest.Pending)
+	assert.Len(t, *resp.Infos, 0)
+
+	infos = mocks.ErrorResponseStr
+	_, err = MockRpcClient.MakeAccountsInfoRequest([]string{"nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5"}, false, false, false)
+	assert.NotNil(t, err)
+}
+
+func TestMakeRepresentativesOnlineRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	online := mocks.RepresentativesOnlineResponseStr
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "representatives_online" {
+				return httpmock.NewStringResponse(200, online), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeRepresentativesOnlineRequest()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"nano_1111111111111111111111111111111111111111111111111117353trpda", "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k"}, resp.Representatives)
+
+	// None online
+	online = `{"representatives": ""}`
+	resp, err = MockRpcClient.MakeRepresentativesOnlineRequest()
+	assert.Nil(t, err)
+	assert.Len(t, resp.Representatives, 0)
+
+	online = mocks.ErrorResponseStr
+	_, err = MockRpcClient.MakeRepresentativesOnlineRequest()
+	assert.NotNil(t, err)
+}
+
+func TestMakeAvailableSupplyRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "available_supply" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AvailableSupplyResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			var js map[string]interface{}
+			json.Unmarshal([]byte(mocks.ErrorResponseStr), &js)
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeAvailableSupplyRequest()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "133248061996216572282917317807824970865", resp.Available)
+}
+
+func TestMakeVersionRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "version" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.VersionResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			var js map[string]interface{}
+			json.Unmarshal([]byte(mocks.ErrorResponseStr), &js)
+			resp, err := httpmock.NewJsonResponse(200, js)
+			return resp, err
+		},
+	)
+
+	resp, err := MockRpcClient.MakeVersionRequest()
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Nano V25.1", resp.NodeVendor)
+	assert.Equal(t, "1", resp.RPCVersion)
+	assert.Equal(t, "live", resp.Network)
+}
+
+func TestMakeAccountHistoryRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.AccountHistoryRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action != "account_history" || pr.Count != 1 {
+				return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+			}
+			if pr.Head != nil {
+				return httpmock.NewStringResponse(200, `{"account": "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", "history": ""}`), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.AccountHistoryResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeAccountHistoryRequest("nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", 1, nil)
+	assert.Nil(t, err)
+	assert.Len(t, resp.History, 1)
+	assert.Equal(t, "send", resp.History[0].Type)
+	assert.Equal(t, "80000000000000000000000000000000000", resp.History[0].Amount)
+	assert.Equal(t, "true", resp.History[0].Confirmed)
+	assert.Equal(t, "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72", resp.Previous)
+
+	// No more history
+	resp, err = MockRpcClient.MakeAccountHistoryRequest("nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", 1, &resp.Previous)
+	assert.Nil(t, err)
+	assert.Len(t, resp.History, 0)
+	assert.Equal(t, "", resp.Previous)
+
+	_, err = MockRpcClient.MakeAccountHistoryRequest("nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", 2, nil)
+	assert.NotNil(t, err)
+}
+
+func TestMakeDelegatorsRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.DelegatorsRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action != "delegators" || pr.Count != 2 {
+				return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+			}
+			if pr.Start != nil {
+				return httpmock.NewStringResponse(200, `{"delegators": ""}`), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.DelegatorsResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeDelegatorsRequest("nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", nil, 2, nil)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Delegators, 2)
+	assert.Equal(t, "500000000000000000000000000000000000", resp.Delegators["nano_13bqhi1cdqq8yb9szneoc38qk899d58i5rcrgdk5mkdm86hekpoez3zxw5sd"])
+
+	// No more delegators
+	start := "nano_17k6ug685154an8gri9whhe5kb5z1mf5w6y39gokc1657sh95fegm8ixc6fh"
+	resp, err = MockRpcClient.MakeDelegatorsRequest("nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", nil, 2, &start)
+	assert.Nil(t, err)
+	assert.Len(t, resp.Delegators, 0)
+
+	_, err = MockRpcClient.MakeDelegatorsRequest("nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", nil, 3, nil)
+	assert.NotNil(t, err)
+}
+
+func TestMakeDelegatorsCountRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.AccountRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "delegators_count" {
+				return httpmock.NewStringResponse(200, mocks.DelegatorsCountResponseStr), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeDelegatorsCountRequest("nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", resp.Count)
+}
+
+func TestMakeAccountWeightRequest(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.AccountRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_weight" && pr.Account == "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est" {
+				return httpmock.NewStringResponse(200, mocks.AccountWeightResponseStr), nil
+			}
+			return httpmock.NewStringResponse(200, mocks.ErrorResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeAccountWeightRequest("nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est")
+	assert.Nil(t, err)
+	assert.Equal(t, "10000000000000000000000000000000000", resp.Weight)
+
+	_, err = MockRpcClient.MakeAccountWeightRequest("nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3")
+	assert.ErrorContains(t, err, "bad input")
+}
+
+func TestMakeRequestWithContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+			return httpmock.NewStringResponse(200, mocks.TelemetryResponseStr), nil
+		},
+	)
+
+	resp, err := MockRpcClient.MakeRequestWithContext(context.Background(), requests.BaseRequest{Action: "telemetry"})
+	assert.Nil(t, err)
+	assert.Equal(t, mocks.TelemetryResponseStr, string(resp))
+}
+
+func TestNodeRPCMetrics(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	made := nodeRequests.Value()
+	statusErrors := nodeErrors.Value("status")
+	transportErrors := nodeErrors.Value("transport")
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		httpmock.NewStringResponder(502, "Bad Gateway"))
+	_, err := MockRpcClient.MakeRequest(map[string]interface{}{"action": "block_count"})
+	assert.Nil(t, err)
+	assert.Equal(t, made+1, nodeRequests.Value())
+	assert.Equal(t, statusErrors+1, nodeErrors.Value("status"))
+
+	httpmock.Reset()
+	_, err = MockRpcClient.MakeRequest(map[string]interface{}{"action": "block_count"})
+	assert.NotNil(t, err)
+	assert.Equal(t, made+2, nodeRequests.Value())
+	assert.Equal(t, transportErrors+1, nodeErrors.Value("transport"))
+}
@@ -8,8 +8,26 @@ var AccountsPendingResponseStr = "{\n  \"blocks\" : {\n    \"nano_11111111111111
 var AccountsPendingResponseEmptyStr = `{
   "blocks": ""
 }`
+var AccountRepresentativeResponseStr = "{\n  \"representative\": \"nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5\"\n}"
 var BlockInfoResponseStr = "{\n  \"block_account\": \"nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est\",\n  \"amount\": \"30000000000000000000000000000000000\",\n  \"balance\": \"5606157000000000000000000000000000000\",\n  \"height\": \"58\",\n  \"local_timestamp\": \"0\",\n  \"successor\": \"8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72\",\n  \"confirmed\": \"true\",\n  \"contents\": {\n    \"type\": \"state\",\n    \"account\": \"nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est\",\n    \"previous\": \"CE898C131AAEE25E05362F247760F8A3ACF34A9796A5AE0D9204E86B0637965E\",\n    \"representative\": \"nano_1stofnrxuz3cai7ze75o174bpm7scwj9jn3nxsn8ntzg784jf1gzn1jjdkou\",\n    \"balance\": \"5606157000000000000000000000000000000\",\n    \"link\": \"5D1AA8A45F8736519D707FCB375976A7F9AF795091021D7E9C7548D6F45DD8D5\",\n    \"link_as_account\": \"nano_1qato4k7z3spc8gq1zyd8xeqfbzsoxwo36a45ozbrxcatut7up8ohyardu1z\",\n    \"signature\": \"82D41BC16F313E4B2243D14DFFA2FB04679C540C2095FEE7EAE0F2F26880AD56DD48D87A7CC5DD760C5B2D76EE2C205506AA557BF00B60D8DEE312EC7343A501\",\n    \"work\": \"8a142e07a10996d5\"\n  },\n  \"subtype\": \"send\"\n}"
 var ReceivableResponseStr = "{\n  \"blocks\" : {\n    \"000D1BAEC8EC208142C99059B393051BAC8380F9B5A2E6B2489A277D81789F3F\": \"6000000000000000000000000000000\"\n  }\n}"
 var ReceivableResponseEmptyStr = "{\"blocks\" : \"\"}"
+var BlockCountResponseStr = "{\n  \"count\": \"1000\",\n  \"unchecked\": \"10\",\n  \"cemented\": \"25\"\n}"
+var PeersResponseStr = "{\n  \"peers\": {\n    \"[::ffff:172.17.0.1]:32841\": \"19\",\n    \"[::ffff:172.17.0.2]:7075\": \"19\",\n    \"[::1]:7075\": \"19\"\n  }\n}"
+var ReceivableExistsResponseStr = "{\n  \"exists\": \"1\"\n}"
+var ActiveDifficultyResponseStr = "{\n  \"deprecated\": \"1\",\n  \"network_minimum\": \"fffffff800000000\",\n  \"network_receive_minimum\": \"fffffe0000000000\",\n  \"network_current\": \"fffffff800000000\",\n  \"network_receive_current\": \"fffffe0000000000\",\n  \"multiplier\": \"1\",\n  \"difficulty_trend\": [\n    \"1\",\n    \"1.156096135149775\"\n  ]\n}"
+var ConfirmationQuorumResponseStr = "{\n  \"quorum_delta\": \"41469707173777717318245825935516662250\",\n  \"online_weight_quorum_percent\": \"50\",\n  \"online_weight_minimum\": \"60000000000000000000000000000000000000\",\n  \"online_stake_total\": \"82939414347555434636491651871033324568\",\n  \"trended_stake_total\": \"81939414347555434636491651871033324568\",\n  \"peers_stake_total\": \"69026910610720098597176027400951402360\"\n}"
+var ChainResponseStr = "{\n  \"blocks\" : [\n    \"000D1BAEC8EC208142C99059B393051BAC8380F9B5A2E6B2489A277D81789F3F\",\n    \"8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72\"\n  ]\n}"
+var AccountsRepresentativesResponseStr = "{\n  \"representatives\" : {\n    \"nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5\": \"nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k\",\n    \"nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k\": \"nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k\"\n  }\n}"
+var AccountsInfoResponseStr = "{\n  \"infos\": {\n    \"nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5\": {\n      \"frontier\": \"FF84533A571D953A596EA401FD41743AC85D04F406E76FDE4408EAED50B473C5\",\n      \"open_block\": \"991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948\",\n      \"representative_block\": \"991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948\",\n      \"balance\": \"11999999999999999918751838129509869131\",\n      \"modified_timestamp\": \"1606934662\",\n      \"block_count\": \"22\",\n      \"account_version\": \"1\",\n      \"representative\": \"nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k\"\n    }\n  },\n  \"errors\": {\n    \"nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k\": \"Account not found\"\n  }\n}"
+var RepresentativesOnlineResponseStr = "{\n  \"representatives\": [\n    \"nano_1111111111111111111111111111111111111111111111111117353trpda\",\n    \"nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k\"\n  ]\n}"
+var AvailableSupplyResponseStr = "{\n  \"available\": \"133248061996216572282917317807824970865\"\n}"
 var ProcessResponseStr = "{\n  \"hash\": \"E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3\"\n}"
+var VersionResponseStr = "{\n  \"rpc_version\": \"1\",\n  \"store_version\": \"21\",\n  \"protocol_version\": \"19\",\n  \"node_vendor\": \"Nano V25.1\",\n  \"store_vendor\": \"LMDB 0.9.25\",\n  \"network\": \"live\",\n  \"network_identifier\": \"991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948\",\n  \"build_info\": \"abc1234\"\n}"
+var DelegatorsResponseStr = "{\n  \"delegators\": {\n    \"nano_13bqhi1cdqq8yb9szneoc38qk899d58i5rcrgdk5mkdm86hekpoez3zxw5sd\": \"500000000000000000000000000000000000\",\n    \"nano_17k6ug685154an8gri9whhe5kb5z1mf5w6y39gokc1657sh95fegm8ixc6fh\": \"961647970820730000000000000000000000\"\n  }\n}"
+var DelegatorsCountResponseStr = "{\n  \"count\": \"2\"\n}"
+var AccountWeightResponseStr = "{\n  \"weight\": \"10000000000000000000000000000000000\"\n}"
+var TelemetryResponseStr = "{\n  \"block_count\": \"5\",\n  \"cemented_count\": \"1\",\n  \"unchecked_count\": \"0\",\n  \"account_count\": \"1\",\n  \"bandwidth_cap\": \"1572864\",\n  \"peer_count\": \"1\",\n  \"protocol_version\": \"18\",\n  \"uptime\": \"556\",\n  \"genesis_block\": \"F824C697633FAB78B703D75189B7A7E18DA438A2ED5FFE7495F02F681CD56D41\",\n  \"major_version\": \"21\",\n  \"minor_version\": \"0\",\n  \"patch_version\": \"0\",\n  \"pre_release_version\": \"0\",\n  \"maker\": \"0\",\n  \"timestamp\": \"1587055945990\",\n  \"active_difficulty\": \"ffffffcdbf40aa45\"\n}"
 var ErrorResponseStr = "{\n  \"error\": \"bad input\"\n}"
+
+var AccountHistoryResponseStr = "{\n  \"account\": \"nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est\",\n  \"history\": [\n    {\n      \"type\": \"send\",\n      \"account\": \"nano_38ztgpejb7yrm7rr586nenkn597s3a1sqiy3m3uyqjicht7kzuhnihdk6zpz\",\n      \"amount\": \"80000000000000000000000000000000000\",\n      \"local_timestamp\": \"1551532723\",\n      \"height\": \"60\",\n      \"hash\": \"80392607E85E73CC3E94B4126F24488EBDFEB174944B890C97E8F36D89591DC5\",\n      \"confirmed\": \"true\"\n    }\n  ],\n  \"previous\": \"8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72\"\n}"
@@ -0,0 +1,8 @@
+package requests
+
+type AccountHistoryRequest struct {
+	AccountRequest `mapstructure:",squash"`
+	Count          int `json:"count" mapstructure:"count"`
+	// Start from this block instead of the frontier, the previous of a response is the head of the next page
+	Head *string `json:"head,omitempty" mapstructure:"head,omitempty"`
+}
@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeAccountHistoryRequest(t *testing.T) {
+	request := AccountHistoryRequest{
+		AccountRequest: AccountRequest{
+			BaseRequest: BaseRequest{
+				Action: "account_history",
+			},
+			Account: "abc",
+		},
+		Count: 10,
+	}
+	encoded, err := json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"account_history\",\"account\":\"abc\",\"count\":10}", string(encoded))
+
+	head := "1234"
+	request.Head = &head
+	encoded, err = json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"account_history\",\"account\":\"abc\",\"count\":10,\"head\":\"1234\"}", string(encoded))
+}
@@ -0,0 +1,8 @@
+package requests
+
+type AccountsInfoRequest struct {
+	AccountsRequest `mapstructure:",squash"`
+	Representative  *bool `json:"representative,omitempty" mapstructure:"representative,omitempty"`
+	Weight          *bool `json:"weight,omitempty" mapstructure:"weight,omitempty"`
+	Pending         *bool `json:"pending,omitempty" mapstructure:"pending,omitempty"`
+}
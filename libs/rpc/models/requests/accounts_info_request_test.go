@@ -0,0 +1,48 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountsInfoRequest(t *testing.T) {
+	encoded := `{"action":"accounts_info","accounts":["abc","def"],"weight":true}`
+	var decoded AccountsInfoRequest
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "accounts_info", decoded.Action)
+	assert.Equal(t, []string{"abc", "def"}, decoded.Accounts)
+	assert.True(t, *decoded.Weight)
+	assert.Nil(t, decoded.Representative)
+}
+
+func TestMapStructureDecodeAccountsInfoRequest(t *testing.T) {
+	request := map[string]interface{}{
+		"action":   "accounts_info",
+		"accounts": []string{"abc", "def"},
+		"weight":   true,
+	}
+	var decoded AccountsInfoRequest
+	mapstructure.Decode(request, &decoded)
+	assert.Equal(t, "accounts_info", decoded.Action)
+	assert.Equal(t, []string{"abc", "def"}, decoded.Accounts)
+	assert.True(t, *decoded.Weight)
+	assert.Nil(t, decoded.Representative)
+}
+
+// Test encoding
+func TestEncodeAccountsInfoRequest(t *testing.T) {
+	encoded := `{"action":"accounts_info","accounts":["abc"],"pending":true}`
+	pending := true
+	req := AccountsInfoRequest{
+		AccountsRequest: AccountsRequest{
+			BaseRequest: BaseRequest{Action: "accounts_info"},
+			Accounts:    []string{"abc"},
+		},
+		Pending: &pending,
+	}
+	encodedActual, _ := json.Marshal(&req)
+	assert.Equal(t, encoded, string(encodedActual))
+}
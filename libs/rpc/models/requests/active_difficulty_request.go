@@ -0,0 +1,6 @@
+package requests
+
+type ActiveDifficultyRequest struct {
+	BaseRequest  `mapstructure:",squash"`
+	IncludeTrend bool `json:"include_trend" mapstructure:"include_trend"`
+}
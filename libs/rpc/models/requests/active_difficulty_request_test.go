@@ -0,0 +1,20 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeActiveDifficultyRequest(t *testing.T) {
+	request := ActiveDifficultyRequest{
+		BaseRequest: BaseRequest{
+			Action: "active_difficulty",
+		},
+		IncludeTrend: true,
+	}
+	encoded, err := json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"active_difficulty\",\"include_trend\":true}", string(encoded))
+}
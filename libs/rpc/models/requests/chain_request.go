@@ -0,0 +1,8 @@
+package requests
+
+type ChainRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Block       string `json:"block" mapstructure:"block"`
+	Count       int    `json:"count" mapstructure:"count"`
+	Offset      *int   `json:"offset,omitempty" mapstructure:"offset,omitempty"`
+}
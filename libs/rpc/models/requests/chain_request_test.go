@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeChainRequest(t *testing.T) {
+	request := ChainRequest{
+		BaseRequest: BaseRequest{
+			Action: "chain",
+		},
+		Block: "1234",
+		Count: 10,
+	}
+	encoded, err := json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"chain\",\"block\":\"1234\",\"count\":10}", string(encoded))
+
+	offset := 5
+	request.Offset = &offset
+	encoded, err = json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"chain\",\"block\":\"1234\",\"count\":10,\"offset\":5}", string(encoded))
+}
@@ -0,0 +1,10 @@
+package requests
+
+type DelegatorsRequest struct {
+	AccountRequest `mapstructure:",squash"`
+	// Raw, delegators with a lower balance are left out
+	Threshold *string `json:"threshold,omitempty" mapstructure:"threshold,omitempty"`
+	Count     int     `json:"count" mapstructure:"count"`
+	// Return the delegators after this account, the last account of a page is the start of the next
+	Start *string `json:"start,omitempty" mapstructure:"start,omitempty"`
+}
@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDelegatorsRequest(t *testing.T) {
+	request := DelegatorsRequest{
+		AccountRequest: AccountRequest{
+			BaseRequest: BaseRequest{
+				Action: "delegators",
+			},
+			Account: "abc",
+		},
+		Count: 10,
+	}
+	encoded, err := json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"delegators\",\"account\":\"abc\",\"count\":10}", string(encoded))
+
+	threshold := "1000"
+	start := "def"
+	request.Threshold = &threshold
+	request.Start = &start
+	encoded, err = json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"delegators\",\"account\":\"abc\",\"threshold\":\"1000\",\"count\":10,\"start\":\"def\"}", string(encoded))
+}
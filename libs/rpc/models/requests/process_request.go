@@ -1,10 +1,10 @@
 package requests
 
-import walletmodels "github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+import "github.com/appditto/pippin_nano_wallet/libs/nano/block"
 
 type ProcessRequest struct {
 	BaseRequest `mapstructure:",squash"`
-	JsonBlock   bool                    `json:"json_block" mapstructure:"json_block"`
-	Subtype     *string                 `json:"subtype,omitempty" mapstructure:"subtype,omitempty"`
-	Block       walletmodels.StateBlock `json:"block" mapstructure:"block"`
+	JsonBlock   bool             `json:"json_block" mapstructure:"json_block"`
+	Subtype     *string          `json:"subtype,omitempty" mapstructure:"subtype,omitempty"`
+	Block       block.StateBlock `json:"block" mapstructure:"block"`
 }
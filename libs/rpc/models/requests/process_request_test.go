@@ -4,15 +4,14 @@ import (
 	"encoding/json"
 	"testing"
 
-	walletmodels "github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/appditto/pippin_nano_wallet/libs/nano/block"
 	"github.com/mitchellh/mapstructure"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestEncodeProcessRequest(t *testing.T) {
-	stateBlock := walletmodels.StateBlock{
+	stateBlock := block.StateBlock{
 		Type:           "state",
-		Hash:           "1234",
 		Account:        "1",
 		Previous:       "2",
 		Representative: "3",
@@ -28,18 +27,17 @@ func TestEncodeProcessRequest(t *testing.T) {
 	}
 	encoded, err := json.Marshal(request)
 	assert.Nil(t, err)
-	assert.Equal(t, `{"action":"process","json_block":true,"block":{"type":"state","hash":"1234","account":"1","previous":"2","representative":"3","balance":"4","link":"5","work":"","signature":""}}`, string(encoded))
+	assert.Equal(t, `{"action":"process","json_block":true,"block":{"type":"state","account":"1","previous":"2","representative":"3","balance":"4","link":"5","signature":"","work":""}}`, string(encoded))
 }
 
 func TestDecodeProcessRequest(t *testing.T) {
-	encoded := `{"action":"process","json_block":true,"block":{"type":"state","hash":"1234","account":"1","previous":"2","representative":"3","balance":"4","link":"5","work":"","signature":""}}`
+	encoded := `{"action":"process","json_block":true,"block":{"type":"state","account":"1","previous":"2","representative":"3","balance":"4","link":"5","work":"","signature":""}}`
 	var request ProcessRequest
 	err := json.Unmarshal([]byte(encoded), &request)
 	assert.Nil(t, err)
 	assert.Equal(t, "process", request.Action)
 	assert.Equal(t, true, request.JsonBlock)
 	assert.Equal(t, "state", request.Block.Type)
-	assert.Equal(t, "1234", request.Block.Hash)
 	assert.Equal(t, "1", request.Block.Account)
 	assert.Equal(t, "2", request.Block.Previous)
 	assert.Equal(t, "3", request.Block.Representative)
@@ -55,7 +53,6 @@ func TestMapStructureDecodeProcessRequest(t *testing.T) {
 		"json_block": true,
 		"block": map[string]interface{}{
 			"type":           "state",
-			"hash":           "1234",
 			"account":        "1",
 			"previous":       "2",
 			"representative": "3",
@@ -70,7 +67,6 @@ func TestMapStructureDecodeProcessRequest(t *testing.T) {
 	assert.Equal(t, "process", decoded.Action)
 	assert.Equal(t, true, decoded.JsonBlock)
 	assert.Equal(t, "state", decoded.Block.Type)
-	assert.Equal(t, "1234", decoded.Block.Hash)
 	assert.Equal(t, "1", decoded.Block.Account)
 	assert.Equal(t, "2", decoded.Block.Previous)
 	assert.Equal(t, "3", decoded.Block.Representative)
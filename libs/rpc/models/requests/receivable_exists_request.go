@@ -0,0 +1,6 @@
+package requests
+
+type ReceivableExistsRequest struct {
+	BaseRequest `mapstructure:",squash"`
+	Hash        string `json:"hash" mapstructure:"hash"`
+}
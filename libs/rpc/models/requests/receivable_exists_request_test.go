@@ -0,0 +1,20 @@
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeReceivableExistsRequest(t *testing.T) {
+	request := ReceivableExistsRequest{
+		BaseRequest: BaseRequest{
+			Action: "receivable_exists",
+		},
+		Hash: "1234",
+	}
+	encoded, err := json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"receivable_exists\",\"hash\":\"1234\"}", string(encoded))
+}
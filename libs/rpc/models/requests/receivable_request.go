@@ -5,4 +5,6 @@ type ReceivableRequest struct {
 	Account              string `json:"account" mapstructure:"account"`
 	Threshold            string `json:"threshold" mapstructure:"threshold"`
 	IncludeOnlyConfirmed bool   `json:"include_only_confirmed" mapstructure:"include_only_confirmed"`
+	// At most this many blocks, all of them if it's 0
+	Count int `json:"count,omitempty" mapstructure:"count,omitempty"`
 }
@@ -20,6 +20,11 @@ func TestEncodeReceivableRequest(t *testing.T) {
 	encoded, err := json.Marshal(request)
 	assert.Nil(t, err)
 	assert.Equal(t, "{\"action\":\"receivable\",\"account\":\"abcd\",\"threshold\":\"1234\",\"include_only_confirmed\":true}", string(encoded))
+
+	request.Count = 1
+	encoded, err = json.Marshal(request)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"action\":\"receivable\",\"account\":\"abcd\",\"threshold\":\"1234\",\"include_only_confirmed\":true,\"count\":1}", string(encoded))
 }
 
 func TestDecodeReceivableRequest(t *testing.T) {
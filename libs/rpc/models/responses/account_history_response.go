@@ -0,0 +1,35 @@
+package responses
+
+//	{
+//	  "account": "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est",
+//	  "history": [
+//	    {
+//	      "type": "send",
+//	      "account": "nano_38ztgpejb7yrm7rr586nenkn597s3a1sqiy3m3uyqjicht7kzuhnihdk6zpz",
+//	      "amount": "80000000000000000000000000000000000",
+//	      "local_timestamp": "1551532723",
+//	      "height": "60",
+//	      "hash": "80392607E85E73CC3E94B4126F24488EBDFEB174944B890C97E8F36D89591DC5",
+//	      "confirmed": "true"
+//	    }
+//	  ],
+//	  "previous": "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72"
+//	}
+//
+// previous is only there if the account has older blocks than the ones returned
+type AccountHistoryResponse struct {
+	Account  string                `json:"account" mapstructure:"account"`
+	History  []AccountHistoryEntry `json:"history" mapstructure:"history"`
+	Previous string                `json:"previous,omitempty" mapstructure:"previous,omitempty"`
+}
+
+// send and receive (including open) blocks, account is the other side of them
+type AccountHistoryEntry struct {
+	Type           string `json:"type" mapstructure:"type"`
+	Account        string `json:"account" mapstructure:"account"`
+	Amount         string `json:"amount" mapstructure:"amount"`
+	LocalTimestamp string `json:"local_timestamp" mapstructure:"local_timestamp"`
+	Height         string `json:"height" mapstructure:"height"`
+	Hash           string `json:"hash" mapstructure:"hash"`
+	Confirmed      string `json:"confirmed" mapstructure:"confirmed"`
+}
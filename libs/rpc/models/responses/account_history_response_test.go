@@ -0,0 +1,25 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountHistoryResponse(t *testing.T) {
+	encoded := "{\"account\":\"nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est\",\"history\":[{\"type\":\"send\",\"account\":\"nano_38ztgpejb7yrm7rr586nenkn597s3a1sqiy3m3uyqjicht7kzuhnihdk6zpz\",\"amount\":\"80000000000000000000000000000000000\",\"local_timestamp\":\"1551532723\",\"height\":\"60\",\"hash\":\"80392607E85E73CC3E94B4126F24488EBDFEB174944B890C97E8F36D89591DC5\",\"confirmed\":\"true\"}],\"previous\":\"8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72\"}"
+
+	var decoded AccountHistoryResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", decoded.Account)
+	assert.Len(t, decoded.History, 1)
+	assert.Equal(t, "send", decoded.History[0].Type)
+	assert.Equal(t, "nano_38ztgpejb7yrm7rr586nenkn597s3a1sqiy3m3uyqjicht7kzuhnihdk6zpz", decoded.History[0].Account)
+	assert.Equal(t, "80000000000000000000000000000000000", decoded.History[0].Amount)
+	assert.Equal(t, "1551532723", decoded.History[0].LocalTimestamp)
+	assert.Equal(t, "60", decoded.History[0].Height)
+	assert.Equal(t, "80392607E85E73CC3E94B4126F24488EBDFEB174944B890C97E8F36D89591DC5", decoded.History[0].Hash)
+	assert.Equal(t, "true", decoded.History[0].Confirmed)
+	assert.Equal(t, "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72", decoded.Previous)
+}
@@ -0,0 +1,5 @@
+package responses
+
+type AccountRepresentativeResponse struct {
+	Representative string `json:"representative" mapstructure:"representative"`
+}
@@ -0,0 +1,16 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountRepresentativeResponse(t *testing.T) {
+	encoded := "{\"representative\":\"nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5\"}"
+
+	var decoded AccountRepresentativeResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", decoded.Representative)
+}
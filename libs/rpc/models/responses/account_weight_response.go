@@ -0,0 +1,6 @@
+package responses
+
+// The voting weight delegated to an account, in raw
+type AccountWeightResponse struct {
+	Weight string `json:"weight" mapstructure:"weight"`
+}
@@ -0,0 +1,16 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountWeightResponse(t *testing.T) {
+	encoded := "{\"weight\":\"10000000000000000000000000000000000\"}"
+
+	var decoded AccountWeightResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "10000000000000000000000000000000000", decoded.Weight)
+}
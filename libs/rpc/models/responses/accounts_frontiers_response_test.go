@@ -22,3 +22,11 @@ func TestDecodeAccountsFrontiersResponseError(t *testing.T) {
 	json.Unmarshal([]byte(encoded), &decoded)
 	assert.Nil(t, decoded.Frontiers)
 }
+
+func TestDecodeAccountsFrontiersResponseErrors(t *testing.T) {
+	encoded := "{\"frontiers\": {\"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3\": \"791AF413173EEE674A6FCF633B5DFC0F3C33F397F0DA08E987D9E0741D40D81A\"}, \"errors\": {\"nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7\": \"Account not found\"}}"
+	var decoded AccountsFrontiersResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Len(t, *decoded.Frontiers, 1)
+	assert.Equal(t, "Account not found", (*decoded.Errors)["nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7"])
+}
@@ -0,0 +1,24 @@
+package responses
+
+//	{
+//	  "infos": {
+//	    "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3": {
+//	      "frontier": "FF84533A571D953A596EA401FD41743AC85D04F406E76FDE4408EAED50B473C5",
+//	      "open_block": "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948",
+//	      "representative_block": "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948",
+//	      "balance": "11999999999999999918751838129509869131",
+//	      "modified_timestamp": "1606934662",
+//	      "block_count": "22",
+//	      "account_version": "1"
+//	    }
+//	  },
+//	  "errors": {
+//	    "nano_1hrts7hcoozxccnffoq9hqhngnn9jz783usapejm57ejtqcyz9dpso1bibuy": "Account not found"
+//	  }
+//	}
+//
+// representative, weight and pending are only in the infos when they were requested
+type AccountsInfoResponse struct {
+	Infos  *map[string]AccountInfoResponse `json:"infos,omitempty" mapstructure:"infos,omitempty"`
+	Errors *map[string]string              `json:"errors,omitempty" mapstructure:"errors,omitempty"`
+}
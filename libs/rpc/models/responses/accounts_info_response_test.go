@@ -0,0 +1,29 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountsInfoResponse(t *testing.T) {
+	encoded := "{\n  \"infos\": {\n    \"nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3\": {\n      \"frontier\": \"FF84533A571D953A596EA401FD41743AC85D04F406E76FDE4408EAED50B473C5\",\n      \"open_block\": \"991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948\",\n      \"representative_block\": \"991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948\",\n      \"balance\": \"11999999999999999918751838129509869131\",\n      \"modified_timestamp\": \"1606934662\",\n      \"block_count\": \"22\",\n      \"weight\": \"1000\"\n    }\n  },\n  \"errors\": {\n    \"nano_1hrts7hcoozxccnffoq9hqhngnn9jz783usapejm57ejtqcyz9dpso1bibuy\": \"Account not found\"\n  }\n}"
+	var decoded AccountsInfoResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	info := (*decoded.Infos)["nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"]
+	assert.Equal(t, "FF84533A571D953A596EA401FD41743AC85D04F406E76FDE4408EAED50B473C5", info.Frontier)
+	assert.Equal(t, "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948", info.OpenBlock)
+	assert.Equal(t, "1606934662", info.ModifiedTimestamp)
+	assert.Equal(t, "22", info.BlockCount)
+	assert.Equal(t, "1000", info.Weight)
+	assert.Equal(t, "", info.Representative)
+	assert.Equal(t, "Account not found", (*decoded.Errors)["nano_1hrts7hcoozxccnffoq9hqhngnn9jz783usapejm57ejtqcyz9dpso1bibuy"])
+}
+
+func TestDecodeAccountsInfoResponseError(t *testing.T) {
+	encoded := "{\"error\": \"Bad account number\"}"
+	var decoded AccountsInfoResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Nil(t, decoded.Infos)
+}
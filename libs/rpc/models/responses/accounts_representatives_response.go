@@ -0,0 +1,17 @@
+package responses
+
+//	{
+//	  "representatives" : {
+//	    "nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5": "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k",
+//	    "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k": "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k"
+//	  },
+//	  "errors" : {
+//	    "nano_1hrts7hcoozxccnffoq9hqhngnn9jz783usapejm57ejtqcyz9dpso1bibuy": "Account not found"
+//	  }
+//	}
+//
+// errors is only there if some accounts have no representative, e.g. they were never opened
+type AccountsRepresentativesResponse struct {
+	Representatives *map[string]string `json:"representatives,omitempty" mapstructure:"representatives,omitempty"`
+	Errors          *map[string]string `json:"errors,omitempty" mapstructure:"errors,omitempty"`
+}
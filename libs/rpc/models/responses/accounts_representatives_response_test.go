@@ -0,0 +1,23 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAccountsRepresentativesResponse(t *testing.T) {
+	encoded := "{\n  \"representatives\" : {\n    \"nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5\": \"nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k\"\n  },\n  \"errors\" : {\n    \"nano_1hrts7hcoozxccnffoq9hqhngnn9jz783usapejm57ejtqcyz9dpso1bibuy\": \"Account not found\"\n  }\n}"
+	var decoded AccountsRepresentativesResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k", (*decoded.Representatives)["nano_16u1uufyoig8777y6r8iqjtrw8sg8maqrm36zzcm95jmbd9i9aj5i8abr8u5"])
+	assert.Equal(t, "Account not found", (*decoded.Errors)["nano_1hrts7hcoozxccnffoq9hqhngnn9jz783usapejm57ejtqcyz9dpso1bibuy"])
+}
+
+func TestDecodeAccountsRepresentativesResponseError(t *testing.T) {
+	encoded := "{\"error\": \"Bad account number\"}"
+	var decoded AccountsRepresentativesResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Nil(t, decoded.Representatives)
+}
@@ -0,0 +1,20 @@
+package responses
+
+//	{
+//	  "network_minimum": "fffffff800000000",
+//	  "network_receive_minimum": "fffffe0000000000",
+//	  "network_current": "fffffff800000000",
+//	  "network_receive_current": "fffffe0000000000",
+//	  "multiplier": "1",
+//	  "difficulty_trend": ["1"]
+//	}
+//
+// difficulty_trend is only there with include_trend
+type ActiveDifficultyResponse struct {
+	NetworkMinimum        string   `json:"network_minimum" mapstructure:"network_minimum"`
+	NetworkReceiveMinimum string   `json:"network_receive_minimum" mapstructure:"network_receive_minimum"`
+	NetworkCurrent        string   `json:"network_current" mapstructure:"network_current"`
+	NetworkReceiveCurrent string   `json:"network_receive_current" mapstructure:"network_receive_current"`
+	Multiplier            string   `json:"multiplier" mapstructure:"multiplier"`
+	DifficultyTrend       []string `json:"difficulty_trend" mapstructure:"difficulty_trend"`
+}
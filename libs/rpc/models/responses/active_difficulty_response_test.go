@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeActiveDifficultyResponse(t *testing.T) {
+	encoded := "{\"network_minimum\":\"fffffff800000000\",\"network_receive_minimum\":\"fffffe0000000000\",\"network_current\":\"fffffff900000000\",\"network_receive_current\":\"fffffe1000000000\",\"multiplier\":\"1.14\",\"difficulty_trend\":[\"1.14\",\"1\"]}"
+
+	var decoded ActiveDifficultyResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "fffffff800000000", decoded.NetworkMinimum)
+	assert.Equal(t, "fffffe0000000000", decoded.NetworkReceiveMinimum)
+	assert.Equal(t, "fffffff900000000", decoded.NetworkCurrent)
+	assert.Equal(t, "fffffe1000000000", decoded.NetworkReceiveCurrent)
+	assert.Equal(t, "1.14", decoded.Multiplier)
+	assert.Equal(t, []string{"1.14", "1"}, decoded.DifficultyTrend)
+}
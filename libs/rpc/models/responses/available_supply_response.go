@@ -0,0 +1,5 @@
+package responses
+
+type AvailableSupplyResponse struct {
+	Available string `json:"available" mapstructure:"available"`
+}
@@ -0,0 +1,16 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeAvailableSupplyResponse(t *testing.T) {
+	encoded := "{\"available\":\"133248061996216572282917317807824970865\"}"
+
+	var decoded AvailableSupplyResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "133248061996216572282917317807824970865", decoded.Available)
+}
@@ -0,0 +1,7 @@
+package responses
+
+type BlockCountResponse struct {
+	Count     string `json:"count" mapstructure:"count"`
+	Unchecked string `json:"unchecked" mapstructure:"unchecked"`
+	Cemented  string `json:"cemented" mapstructure:"cemented"`
+}
@@ -0,0 +1,18 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeBlockCountResponse(t *testing.T) {
+	encoded := "{\"count\":\"1000\",\"unchecked\":\"10\",\"cemented\":\"25\"}"
+
+	var decoded BlockCountResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "1000", decoded.Count)
+	assert.Equal(t, "10", decoded.Unchecked)
+	assert.Equal(t, "25", decoded.Cemented)
+}
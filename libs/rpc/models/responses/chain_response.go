@@ -0,0 +1,10 @@
+package responses
+
+//	{
+//	  "blocks" : [
+//	    "000D1BAEC8EC208142C99059B393051BAC8380F9B5A2E6B2489A277D81789F3F"
+//	  ]
+//	}
+type ChainResponse struct {
+	Blocks []string `json:"blocks" mapstructure:"blocks"`
+}
@@ -0,0 +1,16 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeChainResponse(t *testing.T) {
+	encoded := "{\"blocks\":[\"000D1BAEC8EC208142C99059B393051BAC8380F9B5A2E6B2489A277D81789F3F\",\"8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72\"]}"
+
+	var decoded ChainResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, []string{"000D1BAEC8EC208142C99059B393051BAC8380F9B5A2E6B2489A277D81789F3F", "8D3AB98B301224253750D448B4BD997132400CEDD0A8432F775724F2D9821C72"}, decoded.Blocks)
+}
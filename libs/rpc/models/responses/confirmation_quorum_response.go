@@ -0,0 +1,18 @@
+package responses
+
+//	{
+//	  "quorum_delta": "41469707173777717318245825935516662250",
+//	  "online_weight_quorum_percent": "50",
+//	  "online_weight_minimum": "60000000000000000000000000000000000000",
+//	  "online_stake_total": "82939414347555434636491651871033324568",
+//	  "trended_stake_total": "81939414347555434636491651871033324568",
+//	  "peers_stake_total": "69026910610720098597176027400951402360"
+//	}
+type ConfirmationQuorumResponse struct {
+	QuorumDelta               string `json:"quorum_delta" mapstructure:"quorum_delta"`
+	OnlineWeightQuorumPercent string `json:"online_weight_quorum_percent" mapstructure:"online_weight_quorum_percent"`
+	OnlineWeightMinimum       string `json:"online_weight_minimum" mapstructure:"online_weight_minimum"`
+	OnlineStakeTotal          string `json:"online_stake_total" mapstructure:"online_stake_total"`
+	TrendedStakeTotal         string `json:"trended_stake_total" mapstructure:"trended_stake_total"`
+	PeersStakeTotal           string `json:"peers_stake_total" mapstructure:"peers_stake_total"`
+}
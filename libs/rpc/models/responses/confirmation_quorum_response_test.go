@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeConfirmationQuorumResponse(t *testing.T) {
+	encoded := "{\"quorum_delta\":\"4146\",\"online_weight_quorum_percent\":\"50\",\"online_weight_minimum\":\"6000\",\"online_stake_total\":\"8293\",\"trended_stake_total\":\"8193\",\"peers_stake_total\":\"6902\"}"
+
+	var decoded ConfirmationQuorumResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "4146", decoded.QuorumDelta)
+	assert.Equal(t, "50", decoded.OnlineWeightQuorumPercent)
+	assert.Equal(t, "6000", decoded.OnlineWeightMinimum)
+	assert.Equal(t, "8293", decoded.OnlineStakeTotal)
+	assert.Equal(t, "8193", decoded.TrendedStakeTotal)
+	assert.Equal(t, "6902", decoded.PeersStakeTotal)
+}
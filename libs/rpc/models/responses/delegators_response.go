@@ -0,0 +1,17 @@
+package responses
+
+//	{
+//	  "delegators": {
+//	    "nano_13bqhi1cdqq8yb9szneoc38qk899d58i5rcrgdk5mkdm86hekpoez3zxw5sd": "500000000000000000000000000000000000",
+//	    "nano_17k6ug685154an8gri9whhe5kb5z1mf5w6y39gokc1657sh95fegm8ixc6fh": "961647970820730000000000000000000000"
+//	  }
+//	}
+//
+// The balance of every delegator, keyed by account
+type DelegatorsResponse struct {
+	Delegators map[string]string `json:"delegators" mapstructure:"delegators"`
+}
+
+type DelegatorsCountResponse struct {
+	Count string `json:"count" mapstructure:"count"`
+}
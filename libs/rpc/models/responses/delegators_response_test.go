@@ -0,0 +1,26 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeDelegatorsResponse(t *testing.T) {
+	encoded := "{\"delegators\":{\"nano_13bqhi1cdqq8yb9szneoc38qk899d58i5rcrgdk5mkdm86hekpoez3zxw5sd\":\"500000000000000000000000000000000000\",\"nano_17k6ug685154an8gri9whhe5kb5z1mf5w6y39gokc1657sh95fegm8ixc6fh\":\"961647970820730000000000000000000000\"}}"
+
+	var decoded DelegatorsResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Len(t, decoded.Delegators, 2)
+	assert.Equal(t, "500000000000000000000000000000000000", decoded.Delegators["nano_13bqhi1cdqq8yb9szneoc38qk899d58i5rcrgdk5mkdm86hekpoez3zxw5sd"])
+	assert.Equal(t, "961647970820730000000000000000000000", decoded.Delegators["nano_17k6ug685154an8gri9whhe5kb5z1mf5w6y39gokc1657sh95fegm8ixc6fh"])
+}
+
+func TestDecodeDelegatorsCountResponse(t *testing.T) {
+	encoded := "{\"count\":\"2\"}"
+
+	var decoded DelegatorsCountResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "2", decoded.Count)
+}
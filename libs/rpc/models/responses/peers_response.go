@@ -0,0 +1,12 @@
+package responses
+
+//	{
+//	  "peers": {
+//	    "[::ffff:172.17.0.1]:32841": "19"
+//	  }
+//	}
+//
+// Each peer is its protocol version, or an object with peer_details
+type PeersResponse struct {
+	Peers map[string]interface{} `json:"peers" mapstructure:"peers"`
+}
@@ -0,0 +1,17 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodePeersResponse(t *testing.T) {
+	encoded := "{\"peers\":{\"[::ffff:172.17.0.1]:32841\":\"19\",\"[::ffff:172.17.0.2]:7075\":\"19\"}}"
+
+	var decoded PeersResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Len(t, decoded.Peers, 2)
+	assert.Equal(t, "19", decoded.Peers["[::ffff:172.17.0.1]:32841"])
+}
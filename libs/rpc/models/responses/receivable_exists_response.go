@@ -0,0 +1,8 @@
+package responses
+
+//	{
+//	  "exists" : "1"
+//	}
+type ReceivableExistsResponse struct {
+	Exists string `json:"exists" mapstructure:"exists"`
+}
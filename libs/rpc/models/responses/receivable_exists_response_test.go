@@ -0,0 +1,16 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeReceivableExistsResponse(t *testing.T) {
+	encoded := "{\"exists\":\"1\"}"
+
+	var decoded ReceivableExistsResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "1", decoded.Exists)
+}
@@ -0,0 +1,11 @@
+package responses
+
+//	{
+//	  "representatives": [
+//	    "nano_1111111111111111111111111111111111111111111111111117353trpda",
+//	    "nano_1111111111111111111111111111111111111111111111111awsq94gtecn"
+//	  ]
+//	}
+type RepresentativesOnlineResponse struct {
+	Representatives []string `json:"representatives" mapstructure:"representatives"`
+}
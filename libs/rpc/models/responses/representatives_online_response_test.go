@@ -0,0 +1,15 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeRepresentativesOnlineResponse(t *testing.T) {
+	encoded := "{\n  \"representatives\": [\n    \"nano_1111111111111111111111111111111111111111111111111117353trpda\",\n    \"nano_1111111111111111111111111111111111111111111111111awsq94gtecn\"\n  ]\n}"
+	var decoded RepresentativesOnlineResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, []string{"nano_1111111111111111111111111111111111111111111111111117353trpda", "nano_1111111111111111111111111111111111111111111111111awsq94gtecn"}, decoded.Representatives)
+}
@@ -0,0 +1,25 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeTelemetryResponse(t *testing.T) {
+	encoded := "{\"block_count\":\"5\",\"cemented_count\":\"1\",\"unchecked_count\":\"0\",\"account_count\":\"1\",\"bandwidth_cap\":\"1572864\",\"peer_count\":\"1\",\"protocol_version\":\"18\",\"uptime\":\"556\",\"major_version\":\"21\",\"timestamp\":\"1587055945990\"}"
+
+	var decoded TelemetryResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "5", decoded.BlockCount)
+	assert.Equal(t, "1", decoded.CementedCount)
+	assert.Equal(t, "0", decoded.UncheckedCount)
+	assert.Equal(t, "1", decoded.AccountCount)
+	assert.Equal(t, "1572864", decoded.BandwidthCap)
+	assert.Equal(t, "1", decoded.PeerCount)
+	assert.Equal(t, "18", decoded.ProtocolVersion)
+	assert.Equal(t, "556", decoded.Uptime)
+	assert.Equal(t, "21", decoded.MajorVersion)
+	assert.Equal(t, "1587055945990", decoded.Timestamp)
+}
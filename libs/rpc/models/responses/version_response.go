@@ -0,0 +1,12 @@
+package responses
+
+type VersionResponse struct {
+	RPCVersion        string `json:"rpc_version" mapstructure:"rpc_version"`
+	StoreVersion      string `json:"store_version" mapstructure:"store_version"`
+	ProtocolVersion   string `json:"protocol_version" mapstructure:"protocol_version"`
+	NodeVendor        string `json:"node_vendor" mapstructure:"node_vendor"`
+	StoreVendor       string `json:"store_vendor" mapstructure:"store_vendor"`
+	Network           string `json:"network" mapstructure:"network"`
+	NetworkIdentifier string `json:"network_identifier" mapstructure:"network_identifier"`
+	BuildInfo         string `json:"build_info" mapstructure:"build_info"`
+}
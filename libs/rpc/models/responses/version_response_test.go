@@ -0,0 +1,23 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeVersionResponse(t *testing.T) {
+	encoded := "{\"rpc_version\":\"1\",\"store_version\":\"21\",\"protocol_version\":\"19\",\"node_vendor\":\"Nano V25.1\",\"store_vendor\":\"LMDB 0.9.25\",\"network\":\"live\",\"network_identifier\":\"991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948\",\"build_info\":\"abc1234\"}"
+
+	var decoded VersionResponse
+	json.Unmarshal([]byte(encoded), &decoded)
+	assert.Equal(t, "1", decoded.RPCVersion)
+	assert.Equal(t, "21", decoded.StoreVersion)
+	assert.Equal(t, "19", decoded.ProtocolVersion)
+	assert.Equal(t, "Nano V25.1", decoded.NodeVendor)
+	assert.Equal(t, "LMDB 0.9.25", decoded.StoreVendor)
+	assert.Equal(t, "live", decoded.Network)
+	assert.Equal(t, "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948", decoded.NetworkIdentifier)
+	assert.Equal(t, "abc1234", decoded.BuildInfo)
+}
@@ -0,0 +1,266 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// A client can have more than one node, they're tried in order until one of them answers
+// A node that can't be reached or doesn't return a 2xx is skipped until it's healthy again
+// With round robin, read-only actions are spread over every healthy node instead of going to the first one
+
+// Actions that don't change anything on the node, any node can answer them
+var READ_ONLY_ACTIONS = []string{
+	"account_balance",
+	"accounts_balances",
+	"account_info",
+	"account_history",
+	"account_representative",
+	"accounts_frontiers",
+	"accounts_pending",
+	"accounts_receivable",
+	"accounts_representatives",
+	"available_supply",
+	"block_account",
+	"block_count",
+	"block_info",
+	"blocks",
+	"blocks_info",
+	"chain",
+	"delegators",
+	"delegators_count",
+	"frontier_count",
+	"pending",
+	"pending_exists",
+	"receivable",
+	"receivable_exists",
+	"representatives_online",
+	"successors",
+}
+
+// How long a node that failed is skipped for when nothing checks its health
+const nodeRetryAfter = 30 * time.Second
+
+var ErrNoNodes = errors.New("no node could be reached")
+
+type node struct {
+	url string
+	mu  sync.Mutex
+	// When a failed node is tried again, zero while it's healthy
+	retryAt time.Time
+}
+
+func (n *node) healthy(now time.Time) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return !now.Before(n.retryAt)
+}
+
+func (n *node) markFailed(now time.Time) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.retryAt = now.Add(nodeRetryAfter)
+}
+
+func (n *node) markHealthy() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.retryAt = time.Time{}
+}
+
+// Whether a node and its URL are healthy
+type NodeStatus struct {
+	Url     string
+	Healthy bool
+}
+
+// A client for the nodes at urls, the first one is the primary
+// With roundRobin read-only actions are spread over the healthy nodes
+func NewMultiNodeRPCClient(urls []string, roundRobin bool) *RPCClient {
+	client := NewRPCClient(urls[0])
+	client.nodes = make([]*node, len(urls))
+	for i, url := range urls {
+		client.nodes[i] = &node{url: url}
+	}
+	client.roundRobin = roundRobin
+	return client
+}
+
+// Replace the nodes, for a config reload, the first one is the primary
+// Nodes that are still there keep their health
+func (client *RPCClient) SetNodes(urls []string, roundRobin bool) {
+	client.nodesMutex.Lock()
+	defer client.nodesMutex.Unlock()
+	nodes := make([]*node, len(urls))
+	for i, url := range urls {
+		nodes[i] = &node{url: url}
+		for _, existing := range client.nodes {
+			if existing.url == url {
+				nodes[i] = existing
+				break
+			}
+		}
+	}
+	client.Url = urls[0]
+	client.nodes = nodes
+	client.roundRobin = roundRobin
+}
+
+// The current nodes and whether to round robin over them
+func (client *RPCClient) nodeList() ([]*node, bool) {
+	client.nodesMutex.RLock()
+	defer client.nodesMutex.RUnlock()
+	return client.nodes, client.roundRobin
+}
+
+// The nodes in the order to try them for action, healthy ones first
+func (client *RPCClient) nodeOrder(action string) []*node {
+	nodes, roundRobin := client.nodeList()
+	start := 0
+	if roundRobin && slices.Contains(READ_ONLY_ACTIONS, action) {
+		start = int(client.next.Add(1)-1) % len(nodes)
+	}
+	now := time.Now()
+	healthy := make([]*node, 0, len(nodes))
+	unhealthy := []*node{}
+	for i := range nodes {
+		n := nodes[(start+i)%len(nodes)]
+		if n.healthy(now) {
+			healthy = append(healthy, n)
+		} else {
+			unhealthy = append(unhealthy, n)
+		}
+	}
+	// If every node failed, they're still tried rather than failing right away
+	return append(healthy, unhealthy...)
+}
+
+// POST body to one node, an error if it can't be reached or doesn't return a 2xx
+func (client *RPCClient) postToNode(ctx context.Context, n *node, action string, body []byte) (respBody []byte, err error) {
+	ctx, span := startSpan(ctx, "node "+action, attribute.String("pippin.node", n.url))
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if id := log.RequestID(ctx); id != "" {
+		req.Header.Set(log.RequestIDHeader, id)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	nodeRequests.Inc()
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		nodeErrors.Inc("transport")
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err = io.ReadAll(resp.Body)
+	if err != nil {
+		nodeErrors.Inc("transport")
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		nodeErrors.Inc("status")
+		return respBody, fmt.Errorf("node returned status %d", resp.StatusCode)
+	}
+	return respBody, nil
+}
+
+// Send body to the nodes in order until one of them answers
+// With a single node its response is returned even if it isn't a 2xx, the node may have explained the error in it
+func (client *RPCClient) failoverRequest(ctx context.Context, body []byte) ([]byte, error) {
+	var request struct {
+		Action string `json:"action"`
+	}
+	json.Unmarshal(body, &request)
+
+	var lastErr error
+	var lastBody []byte
+	order := client.nodeOrder(request.Action)
+	for _, n := range order {
+		respBody, err := client.postToNode(ctx, n, request.Action, body)
+		if err == nil {
+			n.markHealthy()
+			return respBody, nil
+		}
+		n.markFailed(time.Now())
+		lastErr = err
+		lastBody = respBody
+		// Given up on, not the node's fault
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if len(order) > 1 {
+			log.Ctx(ctx).Warnf("Node %s failed for %s, trying the next one: %s", n.url, request.Action, err)
+		}
+	}
+	if len(order) == 1 && lastBody != nil {
+		return lastBody, nil
+	}
+	log.Ctx(ctx).Errorf("Error making RPC request %s", lastErr)
+	if len(order) > 1 {
+		return nil, fmt.Errorf("%w: %v", ErrNoNodes, lastErr)
+	}
+	return nil, lastErr
+}
+
+// Ask every node for its version, healthy if it answers
+func (client *RPCClient) CheckNodes(ctx context.Context) {
+	body, _ := json.Marshal(map[string]string{"action": "version"})
+	nodes, _ := client.nodeList()
+	for _, n := range nodes {
+		nodeCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		_, err := client.postToNode(nodeCtx, n, "version", body)
+		cancel()
+		if err != nil {
+			if n.healthy(time.Now()) {
+				log.Warnf("Node %s is unhealthy: %s", n.url, err)
+			}
+			n.markFailed(time.Now())
+		} else {
+			n.markHealthy()
+		}
+	}
+}
+
+// Call CheckNodes every interval until ctx is done
+func (client *RPCClient) StartHealthChecker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			client.CheckNodes(ctx)
+		}
+	}
+}
+
+// The health of every node, in the order they're configured
+func (client *RPCClient) Nodes() []NodeStatus {
+	now := time.Now()
+	nodes, _ := client.nodeList()
+	statuses := make([]NodeStatus, len(nodes))
+	for i, n := range nodes {
+		statuses[i] = NodeStatus{Url: n.url, Healthy: n.healthy(now)}
+	}
+	return statuses
+}
@@ -0,0 +1,146 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// Counts the requests each node gets, the ones in down fail
+func mockNodes(down map[string]bool) map[string]int {
+	calls := map[string]int{}
+	for _, url := range []string{"http://node1", "http://node2", "http://node3"} {
+		url := url
+		httpmock.RegisterResponder("POST", url,
+			func(req *http.Request) (*http.Response, error) {
+				calls[url]++
+				if down[url] {
+					return nil, errors.New("connection refused")
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"balance": "1", "receivable": "0", "pending": "0", "node": url,
+				})
+			},
+		)
+	}
+	return calls
+}
+
+func TestNodeFailover(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	down := map[string]bool{"http://node1": true}
+	calls := mockNodes(down)
+
+	client := NewMultiNodeRPCClient([]string{"http://node1", "http://node2", "http://node3"}, false)
+	assert.Equal(t, "http://node1", client.Url)
+	resp, err := client.MakeRequest(map[string]interface{}{"action": "process"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(resp), "http://node2")
+	assert.Equal(t, []NodeStatus{{Url: "http://node1", Healthy: false}, {Url: "http://node2", Healthy: true}, {Url: "http://node3", Healthy: true}}, client.Nodes())
+
+	// The failed node is skipped until it's healthy again
+	_, err = client.MakeRequest(map[string]interface{}{"action": "process"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls["http://node1"])
+	assert.Equal(t, 2, calls["http://node2"])
+	down["http://node1"] = false
+	client.CheckNodes(context.Background())
+	assert.True(t, client.Nodes()[0].Healthy)
+	resp, err = client.MakeRequest(map[string]interface{}{"action": "process"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(resp), "http://node1")
+
+	// Every node is still tried when they all failed
+	for url := range calls {
+		down[url] = true
+	}
+	_, err = client.MakeRequest(map[string]interface{}{"action": "process"})
+	assert.ErrorIs(t, err, ErrNoNodes)
+	down["http://node3"] = false
+	resp, err = client.MakeRequest(map[string]interface{}{"action": "process"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(resp), "http://node3")
+}
+
+func TestNodeRoundRobin(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	calls := mockNodes(map[string]bool{})
+
+	client := NewMultiNodeRPCClient([]string{"http://node1", "http://node2", "http://node3"}, true)
+	for i := 0; i < 6; i++ {
+		_, err := client.MakeAccountBalanceRequest("nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5")
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, map[string]int{"http://node1": 2, "http://node2": 2, "http://node3": 2}, calls)
+
+	// Actions that change something go to the primary
+	_, err := client.MakeRequest(map[string]interface{}{"action": "process"})
+	assert.Nil(t, err)
+	_, err = client.MakeRequest(map[string]interface{}{"action": "work_generate"})
+	assert.Nil(t, err)
+	assert.Equal(t, 4, calls["http://node1"])
+}
+
+func TestSetNodes(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	down := map[string]bool{"http://node2": true}
+	calls := mockNodes(down)
+
+	client := NewMultiNodeRPCClient([]string{"http://node1", "http://node2"}, false)
+	down["http://node1"] = true
+	_, err := client.MakeRequest(map[string]interface{}{"action": "process"})
+	assert.ErrorIs(t, err, ErrNoNodes)
+
+	// node2 is still unhealthy, node3 is new
+	client.SetNodes([]string{"http://node3", "http://node2"}, true)
+	assert.Equal(t, "http://node3", client.Url)
+	assert.Equal(t, []NodeStatus{{Url: "http://node3", Healthy: true}, {Url: "http://node2", Healthy: false}}, client.Nodes())
+	resp, err := client.MakeRequest(map[string]interface{}{"action": "process"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(resp), "http://node3")
+	assert.Equal(t, 1, calls["http://node1"])
+}
+
+func TestSingleNodeErrorStatus(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "http://localhost:123456",
+		httpmock.NewStringResponder(500, `{"error": "Internal server error in RPC"}`))
+
+	// Without other nodes the body is still returned
+	resp, err := MockRpcClient.MakeRequest(map[string]interface{}{"action": "version"})
+	assert.Nil(t, err)
+	assert.Equal(t, `{"error": "Internal server error in RPC"}`, string(resp))
+}
+
+func TestRequestIDForwarded(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	requestIDs := []string{}
+	httpmock.RegisterResponder("POST", "http://node1",
+		func(req *http.Request) (*http.Response, error) {
+			requestIDs = append(requestIDs, req.Header.Get("X-Request-ID"))
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"balance": "1", "receivable": "0", "pending": "0"})
+		},
+	)
+
+	client := NewRPCClient("http://node1")
+	_, err := client.MakeAccountBalanceRequest("nano_1")
+	assert.Nil(t, err)
+	// A client for a request sends its ID, it shares the nodes of the client it's from
+	scoped := client.WithContext(log.WithRequestID(context.Background(), "req-1"))
+	_, err = scoped.MakeAccountBalanceRequest("nano_1")
+	assert.Nil(t, err)
+	_, err = client.MakeRequestWithContext(log.WithRequestID(context.Background(), "req-2"), map[string]interface{}{"action": "version"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"", "req-1", "req-2"}, requestIDs)
+	assert.Equal(t, client.Nodes(), scoped.Nodes())
+}
@@ -0,0 +1,20 @@
+package rpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Node requests are spans of the request they're made for, when the server exports traces
+var tracer = otel.Tracer("github.com/appditto/pippin_nano_wallet/libs/rpc")
+
+// A span in the trace of ctx, requests that aren't part of one like the health checks aren't traced
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+	return tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
@@ -50,3 +50,19 @@ func ToBool(val interface{}) (bool, error) {
 	return asBool, nil
 
 }
+
+func ToFloat(val interface{}) (float64, error) {
+	switch v := val.(type) {
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case string:
+		asFloat, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, errors.New("not a float")
+		}
+		return asFloat, nil
+	}
+	return 0, errors.New("not a float")
+}
@@ -57,3 +57,22 @@ func TestToBool(t *testing.T) {
 	asBool, err = ToBool(val)
 	assert.ErrorContains(t, err, "not a bool")
 }
+
+func TestToFloat(t *testing.T) {
+	asFloat, err := ToFloat(1.5)
+	assert.Nil(t, err)
+	assert.Equal(t, 1.5, asFloat)
+
+	asFloat, err = ToFloat("1.5")
+	assert.Nil(t, err)
+	assert.Equal(t, 1.5, asFloat)
+
+	asFloat, err = ToFloat(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2.0, asFloat)
+
+	_, err = ToFloat("hi")
+	assert.ErrorContains(t, err, "not a float")
+	_, err = ToFloat(true)
+	assert.ErrorContains(t, err, "not a float")
+}
@@ -6,8 +6,11 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+
+	"golang.org/x/crypto/argon2"
 )
 
 const salt = "61606982"
@@ -23,6 +26,55 @@ func NewAesCrypt(key string) *AESCrypt {
 	return &AESCrypt{SecretKey: hex.EncodeToString(h.Sum(nil))}
 }
 
+// Argon2id parameters of a password key, memory is in KiB
+type KdfParams struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	Salt        []byte
+}
+
+var ErrInvalidKdfParams = errors.New("invalid kdf parameters")
+
+// Parameters with a new random salt
+func NewKdfParams(memory uint32, iterations uint32, parallelism uint8) (*KdfParams, error) {
+	salt := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	return &KdfParams{Memory: memory, Iterations: iterations, Parallelism: parallelism, Salt: salt}, nil
+}
+
+// argon2id$m=65536,t=3,p=4$<salt hex>
+func (p *KdfParams) String() string {
+	return fmt.Sprintf("argon2id$m=%d,t=%d,p=%d$%x", p.Memory, p.Iterations, p.Parallelism, p.Salt)
+}
+
+func ParseKdfParams(encoded string) (*KdfParams, error) {
+	var p KdfParams
+	var salt string
+	if _, err := fmt.Sscanf(encoded, "argon2id$m=%d,t=%d,p=%d$%s", &p.Memory, &p.Iterations, &p.Parallelism, &salt); err != nil {
+		return nil, ErrInvalidKdfParams
+	}
+	decoded, err := hex.DecodeString(salt)
+	if err != nil || len(decoded) == 0 || p.Iterations < 1 || p.Parallelism < 1 {
+		return nil, ErrInvalidKdfParams
+	}
+	p.Salt = decoded
+	return &p, nil
+}
+
+// Whether p costs the same as the given parameters, whatever the salt
+func (p *KdfParams) SameCost(memory uint32, iterations uint32, parallelism uint8) bool {
+	return p.Memory == memory && p.Iterations == iterations && p.Parallelism == parallelism
+}
+
+// Like NewAesCrypt, with the key derived from the password with Argon2id instead of a plain SHA-256
+func NewArgon2Crypt(password string, params *KdfParams) *AESCrypt {
+	key := argon2.IDKey([]byte(password), params.Salt, params.Iterations, params.Memory, params.Parallelism, 32)
+	return &AESCrypt{SecretKey: hex.EncodeToString(key)}
+}
+
 func (a *AESCrypt) Encrypt(input string) (string, error) {
 
 	//Since the key is in string, we need to convert decode it to bytes
@@ -38,3 +38,41 @@ func TestDecodeMessageNotEqualDifferentKeys(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, "", decryptedTwo)
 }
+
+func TestKdfParams(t *testing.T) {
+	params, err := NewKdfParams(64, 1, 2)
+	assert.Nil(t, err)
+	assert.Len(t, params.Salt, 16)
+	other, _ := NewKdfParams(64, 1, 2)
+	assert.NotEqual(t, params.Salt, other.Salt)
+
+	parsed, err := ParseKdfParams(params.String())
+	assert.Nil(t, err)
+	assert.Equal(t, params, parsed)
+	assert.True(t, parsed.SameCost(64, 1, 2))
+	assert.False(t, parsed.SameCost(128, 1, 2))
+
+	assert.Equal(t, "argon2id$m=64,t=1,p=2$0a0b", (&KdfParams{Memory: 64, Iterations: 1, Parallelism: 2, Salt: []byte{10, 11}}).String())
+	for _, invalid := range []string{"", "sha256", "argon2id$m=64,t=1,p=2$", "argon2id$m=64,t=1,p=2$zz", "argon2id$m=64,t=0,p=2$0a0b", "argon2i$m=64,t=1,p=2$0a0b"} {
+		_, err = ParseKdfParams(invalid)
+		assert.ErrorIs(t, err, ErrInvalidKdfParams, invalid)
+	}
+}
+
+func TestArgon2Crypt(t *testing.T) {
+	params, _ := NewKdfParams(64, 1, 1)
+	crypter := NewArgon2Crypt("mypassword", params)
+	assert.NotEqual(t, aesCrypt.SecretKey, crypter.SecretKey)
+	encrypted, err := crypter.Encrypt("my message")
+	assert.Nil(t, err)
+	decrypted, err := NewArgon2Crypt("mypassword", params).Decrypt(encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, "my message", decrypted)
+
+	// Another password or salt is another key
+	_, err = NewArgon2Crypt("mysecondpassword", params).Decrypt(encrypted)
+	assert.NotNil(t, err)
+	otherSalt, _ := NewKdfParams(64, 1, 1)
+	_, err = NewArgon2Crypt("mypassword", otherSalt).Decrypt(encrypted)
+	assert.NotNil(t, err)
+}
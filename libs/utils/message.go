@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// Messages are signed like blocks, but what's signed is the hash of a prefix and the message instead of a block hash
+// A block hash is the blake2b of the block's preamble and fields, which never start with the prefix,
+// so a message signature can't be passed off as a block's, that would take a blake2b collision
+// The prefix is the network's, a Nano signature isn't valid for BANANO either
+
+const nanoMessagePrefix = "Nano Signed Message:\n"
+const bananoMessagePrefix = "Banano Signed Message:\n"
+
+// The hash that's signed for message
+func MessageHash(message string, banano bool) []byte {
+	prefix := nanoMessagePrefix
+	if banano {
+		prefix = bananoMessagePrefix
+	}
+	hash := blake2b.Sum256(append([]byte(prefix), message...))
+	return hash[:]
+}
+
+// Sign message with an account's private key
+func SignMessage(priv ed25519.PrivateKey, message string, banano bool) []byte {
+	return ed25519.Sign(priv, MessageHash(message, banano))
+}
+
+// Whether signature is SignMessage's for message with the private key of pub
+func VerifyMessage(pub ed25519.PublicKey, message string, signature []byte, banano bool) bool {
+	if len(pub) != ed25519.PublicKeySize || len(signature) != ed25519.SignatureSize {
+		return false
+	}
+	return ed25519.Verify(pub, MessageHash(message, banano), signature)
+}
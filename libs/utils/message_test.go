@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignMessage(t *testing.T) {
+	pub, priv, err := KeypairFromSeed("E11A48D701EA1F8A66A4EB587CDC8808D726FE75B325DF204F62CA2B43F9ADA1", 0)
+	assert.Nil(t, err)
+	message := "Deposit address of order 1234"
+
+	signature := SignMessage(priv, message, false)
+	assert.Len(t, signature, ed25519.SignatureSize)
+	assert.True(t, VerifyMessage(pub, message, signature, false))
+	// Always the same signature
+	assert.Equal(t, signature, SignMessage(priv, message, false))
+
+	assert.False(t, VerifyMessage(pub, message+".", signature, false))
+	assert.False(t, VerifyMessage(pub, message, signature, true))
+	otherPub, _, _ := KeypairFromSeed("E11A48D701EA1F8A66A4EB587CDC8808D726FE75B325DF204F62CA2B43F9ADA1", 1)
+	assert.False(t, VerifyMessage(otherPub, message, signature, false))
+	assert.False(t, VerifyMessage(pub, message, signature[:32], false))
+	assert.False(t, VerifyMessage(pub[:16], message, signature, false))
+}
+
+func TestSignMessageNotABlock(t *testing.T) {
+	pub, priv, _ := KeypairFromSeed("E11A48D701EA1F8A66A4EB587CDC8808D726FE75B325DF204F62CA2B43F9ADA1", 0)
+	// A message that's a block hash isn't signed as the block
+	blockHash, _ := hex.DecodeString("E2FB233EF4554077A7BF1AA85851D5BF0B36965D2B0FB504B2BC778AB89917D3")
+	signature := SignMessage(priv, string(blockHash), false)
+	assert.False(t, ed25519.Verify(pub, blockHash, signature))
+	assert.True(t, VerifyMessage(pub, string(blockHash), signature, false))
+
+	assert.NotEqual(t, MessageHash("message", false), MessageHash("message", true))
+	assert.Equal(t, MessageHash("message", false), MessageHash("message", false))
+}
@@ -0,0 +1,267 @@
+// Package metrics has counters, gauges and histograms written in the Prometheus text format.
+// Metrics are registered once, in package variables, and are safe to use from any goroutine.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Buckets in seconds for things that usually take milliseconds, like a request
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+type metric interface {
+	write(w io.Writer) error
+}
+
+var registry = struct {
+	mu      sync.Mutex
+	metrics map[string]metric
+}{metrics: map[string]metric{}}
+
+func register(name string, m metric) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	if _, ok := registry.metrics[name]; ok {
+		panic(fmt.Sprintf("metric %s is already registered", name))
+	}
+	registry.metrics[name] = m
+}
+
+// Write every metric in the Prometheus text format, sorted by name
+func WriteText(w io.Writer) error {
+	registry.mu.Lock()
+	names := make([]string, 0, len(registry.metrics))
+	for name := range registry.metrics {
+		names = append(names, name)
+	}
+	metrics := make([]metric, len(names))
+	sort.Strings(names)
+	for i, name := range names {
+		metrics[i] = registry.metrics[name]
+	}
+	registry.mu.Unlock()
+
+	for _, m := range metrics {
+		if err := m.write(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeHeader(w io.Writer, name string, help string, kind string) error {
+	_, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help), name, kind)
+	return err
+}
+
+// {a="1",b="2"} for the label names and values, empty without labels
+func formatLabels(names []string, values []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = fmt.Sprintf(`%s="%s"`, name, escape.Replace(values[i]))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatFloat(v float64) string {
+	if math.IsInf(v, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// The series of a metric, by their label values
+type series[T any] struct {
+	labels []string
+	mu     sync.Mutex
+	values map[string]T
+	// The label values of each key, to write them
+	keys map[string][]string
+}
+
+func newSeries[T any](labels []string) series[T] {
+	return series[T]{labels: labels, values: map[string]T{}, keys: map[string][]string{}}
+}
+
+// The value for the label values, created with create if there isn't one, the caller holds mu
+func (s *series[T]) get(values []string, create func() T) T {
+	if len(values) != len(s.labels) {
+		panic(fmt.Sprintf("expected %d label values, got %d", len(s.labels), len(values)))
+	}
+	key := strings.Join(values, "\xff")
+	v, ok := s.values[key]
+	if !ok {
+		v = create()
+		s.values[key] = v
+		s.keys[key] = append([]string{}, values...)
+	}
+	return v
+}
+
+// Every key sorted, the caller holds mu
+func (s *series[T]) sortedKeys() []string {
+	keys := make([]string, 0, len(s.values))
+	for key := range s.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// A counter for every combination of label values
+type CounterVec struct {
+	name   string
+	help   string
+	series series[*float64]
+}
+
+func NewCounterVec(name string, help string, labels ...string) *CounterVec {
+	c := &CounterVec{name: name, help: help, series: newSeries[*float64](labels)}
+	register(name, c)
+	return c
+}
+
+func (c *CounterVec) Inc(values ...string) {
+	c.Add(1, values...)
+}
+
+func (c *CounterVec) Add(v float64, values ...string) {
+	c.series.mu.Lock()
+	defer c.series.mu.Unlock()
+	*c.series.get(values, func() *float64 { return new(float64) }) += v
+}
+
+// The count for the label values, 0 if it was never incremented
+func (c *CounterVec) Value(values ...string) float64 {
+	c.series.mu.Lock()
+	defer c.series.mu.Unlock()
+	if v, ok := c.series.values[strings.Join(values, "\xff")]; ok {
+		return *v
+	}
+	return 0
+}
+
+func (c *CounterVec) write(w io.Writer) error {
+	if err := writeHeader(w, c.name, c.help, "counter"); err != nil {
+		return err
+	}
+	c.series.mu.Lock()
+	defer c.series.mu.Unlock()
+	for _, key := range c.series.sortedKeys() {
+		if _, err := fmt.Fprintf(w, "%s%s %s\n", c.name, formatLabels(c.series.labels, c.series.keys[key]), formatFloat(*c.series.values[key])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A gauge read when the metrics are written, e.g. the length of a queue
+type GaugeFunc struct {
+	name string
+	help string
+	fn   func() float64
+}
+
+func NewGaugeFunc(name string, help string, fn func() float64) *GaugeFunc {
+	g := &GaugeFunc{name: name, help: help, fn: fn}
+	register(name, g)
+	return g
+}
+
+func (g *GaugeFunc) write(w io.Writer) error {
+	if err := writeHeader(w, g.name, g.help, "gauge"); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%s %s\n", g.name, formatFloat(g.fn()))
+	return err
+}
+
+type histogram struct {
+	// Observations up to each bucket, not cumulative
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+// A histogram for every combination of label values
+type HistogramVec struct {
+	name    string
+	help    string
+	buckets []float64
+	series  series[*histogram]
+}
+
+// buckets are the upper bounds, in increasing order, +Inf is added
+func NewHistogramVec(name string, help string, buckets []float64, labels ...string) *HistogramVec {
+	h := &HistogramVec{name: name, help: help, buckets: buckets, series: newSeries[*histogram](labels)}
+	register(name, h)
+	return h
+}
+
+func (h *HistogramVec) Observe(v float64, values ...string) {
+	h.series.mu.Lock()
+	defer h.series.mu.Unlock()
+	hist := h.series.get(values, func() *histogram { return &histogram{counts: make([]uint64, len(h.buckets))} })
+	hist.count++
+	hist.sum += v
+	for i, bound := range h.buckets {
+		if v <= bound {
+			hist.counts[i]++
+			break
+		}
+	}
+}
+
+// Observe the seconds since start
+func (h *HistogramVec) ObserveSince(start time.Time, values ...string) {
+	h.Observe(time.Since(start).Seconds(), values...)
+}
+
+// How many observations there were for the label values
+func (h *HistogramVec) Count(values ...string) uint64 {
+	h.series.mu.Lock()
+	defer h.series.mu.Unlock()
+	if hist, ok := h.series.values[strings.Join(values, "\xff")]; ok {
+		return hist.count
+	}
+	return 0
+}
+
+func (h *HistogramVec) write(w io.Writer) error {
+	if err := writeHeader(w, h.name, h.help, "histogram"); err != nil {
+		return err
+	}
+	h.series.mu.Lock()
+	defer h.series.mu.Unlock()
+	labels := append(append([]string{}, h.series.labels...), "le")
+	for _, key := range h.series.sortedKeys() {
+		hist := h.series.values[key]
+		values := h.series.keys[key]
+		cumulative := uint64(0)
+		for i, bound := range append(append([]float64{}, h.buckets...), math.Inf(1)) {
+			if i < len(hist.counts) {
+				cumulative += hist.counts[i]
+			} else {
+				cumulative = hist.count
+			}
+			if _, err := fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, formatLabels(labels, append(append([]string{}, values...), formatFloat(bound))), cumulative); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "%s_sum%s %s\n%s_count%s %d\n", h.name, formatLabels(h.series.labels, values), formatFloat(hist.sum), h.name, formatLabels(h.series.labels, values), hist.count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCounterVec(t *testing.T) {
+	counter := NewCounterVec("test_requests_total", "Requests by action", "action")
+	counter.Inc("send")
+	counter.Add(2, "send")
+	counter.Inc(`odd"action`)
+	assert.Equal(t, float64(3), counter.Value("send"))
+	assert.Equal(t, float64(0), counter.Value("receive"))
+	assert.Panics(t, func() { counter.Inc() })
+	assert.Panics(t, func() { NewCounterVec("test_requests_total", "Again") })
+
+	var buf bytes.Buffer
+	assert.Nil(t, counter.write(&buf))
+	assert.Equal(t, "# HELP test_requests_total Requests by action\n"+
+		"# TYPE test_requests_total counter\n"+
+		"test_requests_total{action=\"odd\\\"action\"} 1\n"+
+		"test_requests_total{action=\"send\"} 3\n", buf.String())
+}
+
+func TestHistogramVec(t *testing.T) {
+	hist := NewHistogramVec("test_duration_seconds", "Durations", []float64{0.1, 1}, "source")
+	hist.Observe(0.05, "local")
+	hist.Observe(0.5, "local")
+	hist.Observe(5, "local")
+	assert.Equal(t, uint64(3), hist.Count("local"))
+	assert.Equal(t, uint64(0), hist.Count("peer"))
+
+	var buf bytes.Buffer
+	assert.Nil(t, hist.write(&buf))
+	assert.Equal(t, "# HELP test_duration_seconds Durations\n"+
+		"# TYPE test_duration_seconds histogram\n"+
+		"test_duration_seconds_bucket{source=\"local\",le=\"0.1\"} 1\n"+
+		"test_duration_seconds_bucket{source=\"local\",le=\"1\"} 2\n"+
+		"test_duration_seconds_bucket{source=\"local\",le=\"+Inf\"} 3\n"+
+		"test_duration_seconds_sum{source=\"local\"} 5.55\n"+
+		"test_duration_seconds_count{source=\"local\"} 3\n", buf.String())
+}
+
+func TestWriteText(t *testing.T) {
+	depth := 4
+	NewGaugeFunc("test_queue_depth", "Queue depth", func() float64 { return float64(depth) })
+	NewCounterVec("test_a_total", "Unlabeled").Inc()
+
+	var buf bytes.Buffer
+	assert.Nil(t, WriteText(&buf))
+	out := buf.String()
+	assert.Contains(t, out, "# TYPE test_queue_depth gauge\ntest_queue_depth 4\n")
+	assert.Contains(t, out, "test_a_total 1\n")
+	// Sorted by name
+	assert.Less(t, bytes.Index(buf.Bytes(), []byte("test_a_total")), bytes.Index(buf.Bytes(), []byte("test_queue_depth")))
+}
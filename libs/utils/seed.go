@@ -12,18 +12,29 @@ import (
 	"golang.org/x/crypto/blake2b"
 )
 
-// Generates a seed, if rand is nil will use crypto/rand to generate secure seeds
+// Generates a seed from 32 bytes of rand, if rand is nil it's GenerateSecureSeed
+// Only tests should pass their own rand, to get predictable seeds
 func GenerateSeed(rand io.Reader) (string, error) {
 	if rand == nil {
-		rand = cryptorand.Reader
+		return GenerateSecureSeed()
 	}
 	bytes := make([]byte, 32)
-	if _, err := rand.Read(bytes); err != nil {
+	if _, err := io.ReadFull(rand, bytes); err != nil {
 		return "", err
 	}
 	return hex.EncodeToString(bytes), nil
 }
 
+// Generates a seed from exactly 32 bytes of crypto/rand, as 64 lowercase hex characters
+// It only fails if the OS random source does, nothing should carry on without it
+func GenerateSecureSeed() (string, error) {
+	var bytes [32]byte
+	if _, err := io.ReadFull(cryptorand.Reader, bytes[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes[:]), nil
+}
+
 // Generate a keypair from a seed at specified index
 func KeypairFromSeed(seed string, index uint32) (ed25519.PublicKey, ed25519.PrivateKey, error) {
 	hash, err := blake2b.New(32, nil)
@@ -51,3 +62,17 @@ func KeypairFromSeed(seed string, index uint32) (ed25519.PublicKey, ed25519.Priv
 
 	return pub, priv, nil
 }
+
+// A short hash of a seed, to tell seeds apart in logs without revealing them
+func SeedFingerprint(seed string) (string, error) {
+	seedData, err := hex.DecodeString(seed)
+	if err != nil {
+		return "", err
+	}
+	hash, err := blake2b.New(4, nil)
+	if err != nil {
+		return "", err
+	}
+	hash.Write(seedData)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
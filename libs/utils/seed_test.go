@@ -18,6 +18,22 @@ func TestGenerateSeed(t *testing.T) {
 	assert.Equal(t, "3966373239333430653037656565363961626163303439633266646434613363", seed)
 }
 
+func TestGenerateSecureSeed(t *testing.T) {
+	seeds := make(map[string]bool, 10000)
+	for i := 0; i < 10000; i++ {
+		seed, err := GenerateSecureSeed()
+		assert.Nil(t, err)
+		assert.Regexp(t, "^[0-9a-f]{64}$", seed)
+		assert.False(t, seeds[seed])
+		seeds[seed] = true
+	}
+	assert.Len(t, seeds, 10000)
+
+	// Not enough bytes is an error, not a short seed
+	_, err := GenerateSeed(strings.NewReader("1234"))
+	assert.NotNil(t, err)
+}
+
 func TestKeypairFromSeed(t *testing.T) {
 	seed := "E11A48D701EA1F8A66A4EB587CDC8808D726FE75B325DF204F62CA2B43F9ADA1"
 	pub, priv, err := KeypairFromSeed(seed, 0)
@@ -30,3 +46,17 @@ func TestKeypairFromSeed(t *testing.T) {
 	pubHex := hex.EncodeToString(pub)
 	assert.Equal(t, strings.ToUpper(privHex[64:]), strings.ToUpper(pubHex))
 }
+
+func TestSeedFingerprint(t *testing.T) {
+	fingerprint, err := SeedFingerprint("E11A48D701EA1F8A66A4EB587CDC8808D726FE75B325DF204F62CA2B43F9ADA1")
+	assert.Nil(t, err)
+	assert.Len(t, fingerprint, 8)
+	// Case doesn't matter
+	lower, _ := SeedFingerprint("e11a48d701ea1f8a66a4eb587cdc8808d726fe75b325df204f62ca2b43f9ada1")
+	assert.Equal(t, fingerprint, lower)
+	other, _ := SeedFingerprint("E11A48D701EA1F8A66A4EB587CDC8808D726FE75B325DF204F62CA2B43F9ADA2")
+	assert.NotEqual(t, fingerprint, other)
+
+	_, err = SeedFingerprint("not a seed")
+	assert.NotNil(t, err)
+}
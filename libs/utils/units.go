@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+var ErrInvalidUnit = errors.New("invalid unit, must be raw or nano, or raw, banano or banoshi in banano mode")
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// 1 NANO is 10^30 raw, 1 BANANO is 10^29 raw and 1 banoshi is 1/100 BANANO
+const nanoDecimals = 30
+const bananoDecimals = 29
+const banoshiDecimals = 27
+
+// The units amounts can be given in, by how many decimals they have
+var nanoUnits = map[string]int{"raw": 0, "nano": nanoDecimals}
+var bananoUnits = map[string]int{"raw": 0, "banano": bananoDecimals, "banoshi": banoshiDecimals}
+
+// The decimals of unit, the units of the other currency aren't valid
+func unitDecimals(unit string, banano bool) (int, error) {
+	units := nanoUnits
+	if banano {
+		units = bananoUnits
+	}
+	decimals, ok := units[strings.ToLower(unit)]
+	if !ok {
+		return 0, ErrInvalidUnit
+	}
+	return decimals, nil
+}
+
+// Convert an amount in unit to raw, e.g. "1.5" banano is 150000000000000000000000000000 raw
+// It can't be negative or smaller than 1 raw
+func UnitToRaw(amount string, unit string, banano bool) (*big.Int, error) {
+	decimals, err := unitDecimals(unit, banano)
+	if err != nil {
+		return nil, err
+	}
+	whole, fraction, hasFraction := strings.Cut(amount, ".")
+	if whole == "" || (hasFraction && fraction == "") || len(fraction) > decimals {
+		return nil, ErrInvalidAmount
+	}
+	for _, c := range whole + fraction {
+		if c < '0' || c > '9' {
+			return nil, ErrInvalidAmount
+		}
+	}
+	raw, ok := big.NewInt(0).SetString(whole+fraction+strings.Repeat("0", decimals-len(fraction)), 10)
+	if !ok {
+		return nil, ErrInvalidAmount
+	}
+	return raw, nil
+}
+
+// Convert a raw amount to unit, e.g. 100 banoshi is "1" banano
+func RawToUnit(raw *big.Int, unit string, banano bool) (string, error) {
+	decimals, err := unitDecimals(unit, banano)
+	if err != nil {
+		return "", err
+	}
+	return rawToDecimals(raw, decimals), nil
+}
+
+// Convert a raw amount to a NANO or BANANO amount, e.g. 1000000000000000000000000000000 raw is "1" NANO
+func RawToReadable(raw *big.Int, banano bool) string {
+	if banano {
+		return rawToDecimals(raw, bananoDecimals)
+	}
+	return rawToDecimals(raw, nanoDecimals)
+}
+
+func rawToDecimals(raw *big.Int, decimals int) string {
+	if decimals == 0 {
+		return raw.String()
+	}
+
+	sign := ""
+	abs := new(big.Int).Abs(raw)
+	if raw.Sign() < 0 {
+		sign = "-"
+	}
+
+	digits := abs.String()
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+	whole := digits[:len(digits)-decimals]
+	fraction := strings.TrimRight(digits[len(digits)-decimals:], "0")
+	if fraction == "" {
+		return sign + whole
+	}
+	return sign + whole + "." + fraction
+}
@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawToReadable(t *testing.T) {
+	raw, _ := big.NewInt(0).SetString("1000000000000000000000000000000", 10)
+	assert.Equal(t, "1", RawToReadable(raw, false))
+	assert.Equal(t, "10", RawToReadable(raw, true))
+
+	raw, _ = big.NewInt(0).SetString("11999999999999999918751838129509869131", 10)
+	assert.Equal(t, "11999999.999999999918751838129509869131", RawToReadable(raw, false))
+
+	assert.Equal(t, "0", RawToReadable(big.NewInt(0), false))
+	assert.Equal(t, "0.000000000000000000000000000001", RawToReadable(big.NewInt(1), false))
+	assert.Equal(t, "0.00000000000000000000000000001", RawToReadable(big.NewInt(1), true))
+	assert.Equal(t, "-0.0000000000000000000000000015", RawToReadable(big.NewInt(-1500), false))
+}
+
+func TestUnitToRaw(t *testing.T) {
+	raw, err := UnitToRaw("1.5", "banano", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "150000000000000000000000000000", raw.String())
+	raw, err = UnitToRaw("150", "BANOSHI", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "150000000000000000000000000000", raw.String())
+	raw, err = UnitToRaw("0.000000000000000000000000000001", "nano", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", raw.String())
+	raw, err = UnitToRaw("42", "raw", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "42", raw.String())
+
+	// Units of the other currency
+	_, err = UnitToRaw("1", "nano", true)
+	assert.ErrorIs(t, err, ErrInvalidUnit)
+	_, err = UnitToRaw("1", "banoshi", false)
+	assert.ErrorIs(t, err, ErrInvalidUnit)
+
+	for _, amount := range []string{"", "-1", "1.", ".5", "1e5", "1.5.5", "0.5"} {
+		_, err = UnitToRaw(amount, "raw", true)
+		assert.ErrorIs(t, err, ErrInvalidAmount, amount)
+	}
+	// Less than 1 raw
+	_, err = UnitToRaw("0.0000000000000000000000000000001", "banano", true)
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+}
+
+func TestRawToUnit(t *testing.T) {
+	raw, _ := big.NewInt(0).SetString("150000000000000000000000000000", 10)
+	amount, err := RawToUnit(raw, "banano", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "1.5", amount)
+	amount, err = RawToUnit(raw, "banoshi", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "150", amount)
+	amount, err = RawToUnit(raw, "raw", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "150000000000000000000000000000", amount)
+	amount, err = RawToUnit(raw, "nano", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "0.15", amount)
+
+	_, err = RawToUnit(raw, "nano", true)
+	assert.ErrorIs(t, err, ErrInvalidUnit)
+	_, err = RawToUnit(raw, "banano", false)
+	assert.ErrorIs(t, err, ErrInvalidUnit)
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"context"
+	cryptorand "crypto/rand"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+)
+
+var ErrInvalidVanityPattern = errors.New("invalid vanity pattern")
+var ErrVanityNotFound = errors.New("no matching address was found in time")
+
+// What a vanity address has to look like, after the nano_ or ban_
+// The first character is always 1 or 3, a prefix that doesn't start with one of them matches after it
+type VanityPattern struct {
+	Prefix string
+	Suffix string
+}
+
+// Lowercase the pattern and check it only has characters an address can have
+// A prefix can be given with nano_ or xrb_ in front of it, or ban_ in banano mode
+func NewVanityPattern(prefix string, suffix string, banano bool) (VanityPattern, error) {
+	prefix, suffix = strings.ToLower(prefix), strings.ToLower(suffix)
+	starts := []string{"nano_", "xrb_"}
+	if banano {
+		starts = []string{"ban_"}
+	}
+	for _, start := range starts {
+		prefix = strings.TrimPrefix(prefix, start)
+	}
+	if prefix == "" && suffix == "" {
+		return VanityPattern{}, ErrInvalidVanityPattern
+	}
+	if len(prefix) > 60 || len(suffix) > 60 {
+		return VanityPattern{}, ErrInvalidVanityPattern
+	}
+	for _, c := range prefix + suffix {
+		if !strings.ContainsRune(EncodeNano, c) {
+			return VanityPattern{}, ErrInvalidVanityPattern
+		}
+	}
+	return VanityPattern{Prefix: prefix, Suffix: suffix}, nil
+}
+
+// Whether address, with its nano_ or ban_, matches
+func (p VanityPattern) Matches(address string) bool {
+	address = address[strings.IndexByte(address, '_')+1:]
+	if !strings.HasSuffix(address, p.Suffix) {
+		return false
+	}
+	// The first character only has 1 bit of the public key
+	if p.Prefix != "" && p.Prefix[0] != '1' && p.Prefix[0] != '3' {
+		return strings.HasPrefix(address[1:], p.Prefix)
+	}
+	return strings.HasPrefix(address, p.Prefix)
+}
+
+// A key with an address that matches a vanity pattern, after Attempts keys were tried
+type VanityKey struct {
+	PrivateKey ed25519.PrivateKey
+	Address    string
+	Attempts   uint64
+}
+
+// Try random keys on workers goroutines until one matches pattern, ErrVanityNotFound once ctx is done
+// Every character of the pattern makes it about 32 times slower to find
+func VanitySearch(ctx context.Context, pattern VanityPattern, banano bool, workers int) (*VanityKey, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if workers < 1 {
+		workers = 1
+	}
+
+	var attempts atomic.Uint64
+	var found *VanityKey
+	var once sync.Once
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			seed := make([]byte, ed25519.SeedSize)
+			for ctx.Err() == nil {
+				if _, err := cryptorand.Read(seed); err != nil {
+					return
+				}
+				priv, err := ed25519.NewKeyFromSeed(seed)
+				if err != nil {
+					return
+				}
+				attempts.Add(1)
+				address := PubKeyToAddress(priv.Public().(ed25519.PublicKey), banano)
+				if pattern.Matches(address) {
+					once.Do(func() {
+						found = &VanityKey{PrivateKey: priv, Address: address}
+						cancel()
+					})
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if found == nil {
+		return nil, ErrVanityNotFound
+	}
+	found.Attempts = attempts.Load()
+	return found, nil
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVanityPattern(t *testing.T) {
+	pattern, err := NewVanityPattern("NANO_1Pip", "", false)
+	assert.Nil(t, err)
+	assert.Equal(t, VanityPattern{Prefix: "1pip"}, pattern)
+	pattern, err = NewVanityPattern("ban_x", "ab", true)
+	assert.Nil(t, err)
+	assert.Equal(t, VanityPattern{Prefix: "x", Suffix: "ab"}, pattern)
+
+	for _, tc := range [][2]string{{"", ""}, {"nano_", ""}, {"0", ""}, {"", "l"}, {"1pip_", ""}, {strings.Repeat("1", 61), ""}, {"ban_x", ""}} {
+		_, err = NewVanityPattern(tc[0], tc[1], false)
+		assert.ErrorIs(t, err, ErrInvalidVanityPattern, tc)
+	}
+	// Only ban_ in banano mode
+	for _, prefix := range []string{"nano_x", "xrb_x"} {
+		_, err = NewVanityPattern(prefix, "", true)
+		assert.ErrorIs(t, err, ErrInvalidVanityPattern, prefix)
+	}
+}
+
+func TestVanityPatternMatches(t *testing.T) {
+	address := "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"
+	for _, tc := range []struct {
+		prefix  string
+		suffix  string
+		matches bool
+	}{
+		{"3t6k", "", true},
+		{"t6k", "", true},
+		{"1t6k", "", false},
+		{"6k", "", false},
+		{"", "ohr3", true},
+		{"3t6", "hr3", true},
+		{"3t6", "hr4", false},
+	} {
+		pattern, err := NewVanityPattern(tc.prefix, tc.suffix, false)
+		assert.Nil(t, err)
+		assert.Equal(t, tc.matches, pattern.Matches(address), tc)
+		assert.Equal(t, tc.matches, pattern.Matches("ban_"+address[5:]), tc)
+	}
+}
+
+func TestVanitySearch(t *testing.T) {
+	pattern, err := NewVanityPattern("1a", "b", false)
+	assert.Nil(t, err)
+	found, err := VanitySearch(context.Background(), pattern, false, 4)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(found.Address, "nano_1a"))
+	assert.True(t, strings.HasSuffix(found.Address, "b"))
+	assert.Equal(t, found.Address, PubKeyToAddress(found.PrivateKey.Public().(ed25519.PublicKey), false))
+	assert.NotZero(t, found.Attempts)
+
+	// Far too long to find
+	pattern, err = NewVanityPattern("1pippinpippin", "", false)
+	assert.Nil(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = VanitySearch(ctx, pattern, true, 2)
+	assert.ErrorIs(t, err, ErrVanityNotFound)
+}
@@ -1,21 +1,29 @@
 package wallet
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
+	"slices"
 	"time"
 
 	"github.com/appditto/pippin_nano_wallet/libs/database"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotencykey"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/google/uuid"
 )
 
 var ErrAccountNotFound = errors.New("account not found")
 var ErrAccountExists = errors.New("account already exists")
 var ErrUnableToCreateAccount = errors.New("unable to create account")
+var ErrAccountHasBalance = errors.New("account has a balance")
+var ErrLastAccount = errors.New("cannot remove the last deterministic account")
+var ErrIdempotencyKeyInUse = errors.New("idempotency key was used with another wallet")
 
 // Retrieve an account or adhoc account for a wallet
 func (w *NanoWallet) GetAccount(wallet *ent.Wallet, address string) (*ent.Account, error) {
@@ -42,8 +50,8 @@ func (w *NanoWallet) GetAccount(wallet *ent.Wallet, address string) (*ent.Accoun
 }
 
 func (w *NanoWallet) GetAccountByAddress(address string) (*ent.Account, error) {
-	// Check if account exists
-	acc, err := w.DB.Account.Query().Where(account.Address(address)).First(w.Ctx)
+	// Check if account exists, if it's also watched in another wallet the one with the private key is first
+	acc, err := w.DB.Account.Query().Where(account.Address(address)).Order(ent.Asc(account.FieldWatchOnly)).First(w.Ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, ErrAccountNotFound
@@ -58,10 +66,13 @@ func (w *NanoWallet) GetAccountByAddress(address string) (*ent.Account, error) {
 func (w *NanoWallet) AccountCreate(wallet *ent.Wallet, index *int) (*ent.Account, error) {
 	if wallet == nil {
 		return nil, ErrInvalidWallet
+	} else if wallet.WatchOnly {
+		// Nothing to derive them from
+		return nil, ErrWalletWatchOnly
 	}
 
 	// Obtain a lock, prevent concurrent calls
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
 	if err != nil {
 		return nil, database.ErrLockNotObtained
 	}
@@ -75,11 +86,10 @@ func (w *NanoWallet) AccountCreate(wallet *ent.Wallet, index *int) (*ent.Account
 
 	if index != nil {
 		// See if account exists at index
-		pub, priv, err := utils.KeypairFromSeed(seed, uint32(*index))
+		address, err := w.deriveAddress(wallet, seed, *index)
 		if err != nil {
 			return nil, err
 		}
-		address := utils.PubKeyToAddress(pub, w.Banano)
 		exists, err := w.AccountExists(wallet, address)
 		if err != nil {
 			return nil, err
@@ -87,7 +97,12 @@ func (w *NanoWallet) AccountCreate(wallet *ent.Wallet, index *int) (*ent.Account
 		if exists {
 			return nil, ErrAccountExists
 		}
+		if wallet.Hardware {
+			// The key stays on the Ledger
+			return w.DB.Account.Create().SetWallet(wallet).SetAddress(address).SetAccountIndex(*index).Save(w.Ctx)
+		}
 		// Create it as an adhoc
+		_, priv, _ := utils.KeypairFromSeed(seed, uint32(*index))
 		acc, err := w.DB.Account.Create().SetWallet(wallet).SetAddress(address).SetPrivateKey(hex.EncodeToString(priv)).Save(w.Ctx)
 		if err != nil {
 			return nil, err
@@ -95,37 +110,52 @@ func (w *NanoWallet) AccountCreate(wallet *ent.Wallet, index *int) (*ent.Account
 		return acc, nil
 	}
 
-	account, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Order(ent.Desc(account.FieldAccountIndex)).First(w.Ctx)
-
+	// Read from the primary, a lagging replica could hand out an index that's already taken
+	runningIndex, address, err := w.nextAccountIndex(database.WithPrimary(w.Ctx), wallet, seed)
 	if err != nil {
 		return nil, err
 	}
+	return w.DB.Account.Create().SetWallet(wallet).SetAccountIndex(runningIndex).SetAddress(address).Save(w.Ctx)
+}
+
+// The index AccountCreate would create the next account at and its address, nothing is created
+func (w *NanoWallet) AccountCreateNext(wallet *ent.Wallet) (int, string, error) {
+	if wallet == nil {
+		return 0, "", ErrInvalidWallet
+	} else if wallet.WatchOnly {
+		return 0, "", ErrWalletWatchOnly
+	}
+	seed, err := GetDecryptedKeyFromStorage(wallet, "seed")
+	if err != nil {
+		return 0, "", err
+	}
+	return w.nextAccountIndex(w.Ctx, wallet, seed)
+}
+
+// The index after the highest one of the wallet's seed that doesn't have an account yet, and its address
+func (w *NanoWallet) nextAccountIndex(ctx context.Context, wallet *ent.Wallet, seed string) (int, string, error) {
+	latest, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Order(ent.Desc(account.FieldAccountIndex)).First(ctx)
+	if err != nil {
+		return 0, "", err
+	}
 
 	// We repeat as many times as necessary to avoid collisions
-	runningIndex := *account.AccountIndex + 1
-	for true {
+	runningIndex := *latest.AccountIndex + 1
+	for {
 		// Derive next account
-		pub, _, err := utils.KeypairFromSeed(seed, uint32(runningIndex))
+		address, err := w.deriveAddress(wallet, seed, runningIndex)
 		if err != nil {
-			return nil, err
+			return 0, "", err
 		}
-		address := utils.PubKeyToAddress(pub, w.Banano)
 		exists, err := w.AccountExists(wallet, address)
 		if err != nil {
-			return nil, err
-		}
-		if exists {
-			runningIndex++
-			continue
+			return 0, "", err
 		}
-		newAcc, err := w.DB.Account.Create().SetWallet(wallet).SetAccountIndex(runningIndex).SetAddress(address).Save(w.Ctx)
-		if err != nil {
-			return nil, err
+		if !exists {
+			return runningIndex, address, nil
 		}
-
-		return newAcc, nil
+		runningIndex++
 	}
-	return nil, ErrUnableToCreateAccount
 }
 
 func (w *NanoWallet) AccountsCreate(wallet *ent.Wallet, count int) ([]*ent.Account, error) {
@@ -136,54 +166,141 @@ func (w *NanoWallet) AccountsCreate(wallet *ent.Wallet, count int) ([]*ent.Accou
 	}
 
 	// Obtain a lock, prevent concurrent calls
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
 	if err != nil {
 		return nil, database.ErrLockNotObtained
 	}
 	defer lock.Release(w.Ctx)
 
-	// Get seed
-	seed, err := GetDecryptedKeyFromStorage(wallet, "seed")
+	return w.createAccounts(wallet, count, nil)
+}
+
+// Like AccountsCreate, but if key was already used on this wallet the addresses created then are returned, and nothing is created
+// The key is saved with the accounts, so a call that failed part way can be retried with it
+// Keys are forgotten after idempotency_key_ttl seconds
+func (w *NanoWallet) AccountsCreateIdempotent(wallet *ent.Wallet, count int, key uuid.UUID) ([]string, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if count < 1 {
+		return nil, ErrInvalidAccountCount
+	}
+
+	// Obtain a lock, prevent concurrent calls
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
 	if err != nil {
+		return nil, database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	// Forget expired keys
+	expiry := time.Now().Add(-time.Duration(w.Config.Wallet.IdempotencyKeyTTL) * time.Second)
+	if _, err := w.DB.IdempotencyKey.Delete().Where(idempotencykey.CreatedAtLT(expiry)).Exec(w.Ctx); err != nil {
 		return nil, err
 	}
 
-	acc, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Order(ent.Desc(account.FieldAccountIndex)).First(w.Ctx)
+	existing, err := w.DB.IdempotencyKey.Get(w.Ctx, key)
+	if err == nil {
+		if existing.WalletID != wallet.ID {
+			return nil, ErrIdempotencyKeyInUse
+		}
+		return existing.Accounts, nil
+	} else if !ent.IsNotFound(err) {
+		return nil, err
+	}
 
+	accounts, err := w.createAccounts(wallet, count, &key)
 	if err != nil {
 		return nil, err
 	}
+	addresses := make([]string, len(accounts))
+	for i, acct := range accounts {
+		addresses[i] = acct.Address
+	}
+	return addresses, nil
+}
+
+// Whether AccountsCreateIdempotent already created accounts for key on this wallet, and wouldn't create them again
+func (w *NanoWallet) IdempotencyKeyUsed(wallet *ent.Wallet, key uuid.UUID) (bool, error) {
+	if wallet == nil {
+		return false, ErrInvalidWallet
+	}
+	expiry := time.Now().Add(-time.Duration(w.Config.Wallet.IdempotencyKeyTTL) * time.Second)
+	return w.DB.IdempotencyKey.Query().Where(idempotencykey.ID(key), idempotencykey.WalletID(wallet.ID), idempotencykey.CreatedAtGTE(expiry)).Exist(w.Ctx)
+}
 
-	nextIndex := *acc.AccountIndex + 1
+// How many accounts createAccounts derives, checks and inserts at once
+const accountsCreateBatch = 100
+
+// Create count accounts at the next indexes of the wallet's seed, in one transaction
+// If key isn't nil it's saved in the same transaction, with the created addresses
+// The wallet lock must be held
+func (w *NanoWallet) createAccounts(wallet *ent.Wallet, count int, key *uuid.UUID) ([]*ent.Account, error) {
+	if wallet.WatchOnly {
+		return nil, ErrWalletWatchOnly
+	}
+	// Get seed
+	seed, err := GetDecryptedKeyFromStorage(wallet, "seed")
+	if err != nil {
+		return nil, err
+	}
 
 	tx, err := w.DB.Tx(w.Ctx)
-	var accounts []*ent.Account
-	for i := 0; i < count; i++ {
-		// Derive next account
-		pub, _, err := utils.KeypairFromSeed(seed, uint32(nextIndex))
+	if err != nil {
+		return nil, err
+	}
+	latest, err := tx.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Order(ent.Desc(account.FieldAccountIndex)).First(w.Ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	nextIndex := *latest.AccountIndex + 1
+	accounts := make([]*ent.Account, 0, count)
+	for len(accounts) < count {
+		// Derive the next batch of indexes, and look up which of them are already in the wallet, e.g. as adhoc accounts, at once
+		batch := min(count-len(accounts), accountsCreateBatch)
+		addresses := make([]string, batch)
+		for i := range addresses {
+			addresses[i], err = w.deriveAddress(wallet, seed, nextIndex+i)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+		existing, err := tx.Account.Query().Where(account.WalletID(wallet.ID), account.AddressIn(addresses...)).Select(account.FieldAddress).Strings(w.Ctx)
 		if err != nil {
 			tx.Rollback()
 			return nil, err
 		}
-		address := utils.PubKeyToAddress(pub, w.Banano)
-		count, err := tx.Account.Query().Where(account.WalletID(wallet.ID), account.Address(address)).Count(w.Ctx)
+		creates := make([]*ent.AccountCreate, 0, batch)
+		for i, address := range addresses {
+			if slices.Contains(existing, address) {
+				// Skip the index, the batch after this one makes up for it
+				continue
+			}
+			creates = append(creates, tx.Account.Create().SetWalletID(wallet.ID).SetAccountIndex(nextIndex+i).SetAddress(address))
+		}
+		nextIndex += batch
+		if len(creates) == 0 {
+			continue
+		}
+		created, err := tx.Account.CreateBulk(creates...).Save(w.Ctx)
 		if err != nil {
 			tx.Rollback()
 			return nil, err
 		}
-		if count > 0 {
-			// Iterate target index and roll back iteration count
-			nextIndex++
-			i--
-			continue
+		accounts = append(accounts, created...)
+	}
+	if key != nil {
+		addresses := make([]string, len(accounts))
+		for i, acct := range accounts {
+			addresses[i] = acct.Address
 		}
-		acct, err := tx.Account.Create().SetWallet(wallet).SetAccountIndex(nextIndex).SetAddress(address).Save(w.Ctx)
+		_, err = tx.IdempotencyKey.Create().SetID(*key).SetWallet(wallet).SetAccounts(addresses).Save(w.Ctx)
 		if err != nil {
 			tx.Rollback()
 			return nil, err
 		}
-		accounts = append(accounts, acct)
-		nextIndex++
 	}
 	err = tx.Commit()
 	if err != nil {
@@ -193,6 +310,60 @@ func (w *NanoWallet) AccountsCreate(wallet *ent.Wallet, count int) ([]*ent.Accou
 	return accounts, nil
 }
 
+// Create an account derived from another seed than the wallet's, e.g. one that has a vanity address
+// It's derived at index, or at the first index of seed whose account isn't in the wallet yet
+// The seed and index are kept with the account, it's signed for with the derived private key like an adhoc account
+func (w *NanoWallet) AccountCreateFromSeed(wallet *ent.Wallet, seed string, index *int) (*ent.Account, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if !utils.Validate64HexHash(seed) {
+		return nil, ErrInvalidSeed
+	}
+
+	// Obtain a lock, prevent concurrent calls
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	// Determine if wallet is locked or not
+	_, err = GetDecryptedKeyFromStorage(wallet, "seed")
+	if err != nil {
+		return nil, err
+	}
+
+	runningIndex := 0
+	if index != nil {
+		runningIndex = *index
+	}
+	for true {
+		pub, priv, err := utils.KeypairFromSeed(seed, uint32(runningIndex))
+		if err != nil {
+			return nil, err
+		}
+		address := utils.PubKeyToAddress(pub, w.Banano)
+		exists, err := w.AccountExists(wallet, address)
+		if err != nil {
+			return nil, err
+		}
+		if exists && index != nil {
+			return nil, ErrAccountExists
+		} else if exists {
+			runningIndex++
+			continue
+		}
+
+		acc, err := w.DB.Account.Create().SetWallet(wallet).SetAddress(address).SetPrivateKey(hex.EncodeToString(priv)).SetSeed(seed).SetSeedIndex(runningIndex).Save(w.Ctx)
+		if err != nil {
+			return nil, err
+		}
+		return acc, nil
+	}
+
+	return nil, ErrUnableToCreateAccount
+}
+
 // Create an adhoc account. Returns adhocaccount or account if already exists
 // Already existing account may be adhoc or non-adhoc
 func (w *NanoWallet) AdhocAccountCreate(wallet *ent.Wallet, privKey ed25519.PrivateKey) (*ent.Account, error) {
@@ -204,7 +375,7 @@ func (w *NanoWallet) AdhocAccountCreate(wallet *ent.Wallet, privKey ed25519.Priv
 	}
 
 	// Obtain a lock, prevent concurrent calls
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
 	if err != nil {
 		return nil, database.ErrLockNotObtained
 	}
@@ -221,8 +392,11 @@ func (w *NanoWallet) AdhocAccountCreate(wallet *ent.Wallet, privKey ed25519.Priv
 	address := utils.PubKeyToAddress(pub, w.Banano)
 
 	// See if account already exists
-	acct, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.Address(address)).First(w.Ctx)
-	if err == nil {
+	acct, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.Address(address)).First(database.WithPrimary(w.Ctx))
+	if err == nil && acct.WatchOnly {
+		// Now it has its private key
+		return acct.Update().SetPrivateKey(hex.EncodeToString(privKey)).SetWatchOnly(false).Save(w.Ctx)
+	} else if err == nil {
 		return acct, nil
 	} else if !ent.IsNotFound(err) {
 		// Some unknown error we didn't expect
@@ -273,6 +447,29 @@ func (w *NanoWallet) AccountsList(wallet *ent.Wallet, limit int) ([]*ent.Account
 	return accounts, addresses, nil
 }
 
+// A page of the wallet's accounts for account_list, limit of them after the first offset, oldest first
+// New accounts go on the last page, so paging through while accounts are created doesn't skip or repeat any
+func (w *NanoWallet) AccountsPage(wallet *ent.Wallet, offset int, limit int) ([]*ent.Account, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+
+	// Determine if wallet is locked or not
+	if _, err := GetDecryptedKeyFromStorage(wallet, "seed"); err != nil {
+		return nil, err
+	}
+
+	return w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Order(ent.Asc(account.FieldCreatedAt), ent.Asc(account.FieldID)).Offset(offset).Limit(limit).All(w.Ctx)
+}
+
+// How many accounts the wallet has
+func (w *NanoWallet) AccountsCount(wallet *ent.Wallet) (int, error) {
+	if wallet == nil {
+		return 0, ErrInvalidWallet
+	}
+	return w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Count(w.Ctx)
+}
+
 func (w *NanoWallet) AccountExists(wallet *ent.Wallet, address string) (bool, error) {
 	if wallet == nil {
 		return false, ErrInvalidWallet
@@ -292,3 +489,55 @@ func (w *NanoWallet) AccountExists(wallet *ent.Wallet, address string) (bool, er
 
 	return count > 0, nil
 }
+
+// Remove an account from a wallet
+// Refuses to remove accounts that still have a balance or pending balance, unless force is set
+// The seed and the derivation of the remaining accounts are unaffected
+func (w *NanoWallet) AccountRemove(wallet *ent.Wallet, address string, force bool) error {
+	if wallet == nil {
+		return ErrInvalidWallet
+	}
+
+	// Obtain a lock, prevent concurrent calls
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+	if err != nil {
+		return database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	acc, err := w.GetAccount(wallet, address)
+	if err != nil {
+		return err
+	}
+
+	// The next account index is derived from the highest remaining one, so keep at least one
+	if acc.AccountIndex != nil {
+		count, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).Count(database.WithPrimary(w.Ctx))
+		if err != nil {
+			return err
+		}
+		if count < 2 {
+			return ErrLastAccount
+		}
+	}
+
+	if !force {
+		bal, err := w.RpcClient.MakeAccountBalanceRequest(acc.Address)
+		if err != nil {
+			return err
+		}
+		balance, ok := big.NewInt(0).SetString(bal.Balance, 10)
+		if !ok {
+			return errors.New("Unable to parse balance")
+		}
+		pending, ok := big.NewInt(0).SetString(bal.Pending, 10)
+		if !ok {
+			return errors.New("Unable to parse pending balance")
+		}
+		if balance.Sign() != 0 || pending.Sign() != 0 {
+			return ErrAccountHasBalance
+		}
+	}
+
+	return w.DB.Account.DeleteOne(acc).Exec(w.Ctx)
+}
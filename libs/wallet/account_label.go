@@ -0,0 +1,75 @@
+package wallet
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+)
+
+// Limits of what can be attached to an account, so a label can't be used to store anything
+const (
+	accountLabelMaxLen         = 256
+	accountMetadataMaxKeys     = 32
+	accountMetadataKeyMaxLen   = 64
+	accountMetadataValueMaxLen = 256
+)
+
+var ErrInvalidLabel = errors.New("invalid label")
+var ErrInvalidMetadata = errors.New("invalid metadata")
+
+func validateMetadata(metadata map[string]string) error {
+	if len(metadata) > accountMetadataMaxKeys {
+		return ErrInvalidMetadata
+	}
+	for key, value := range metadata {
+		if key == "" || utf8.RuneCountInString(key) > accountMetadataKeyMaxLen || utf8.RuneCountInString(value) > accountMetadataValueMaxLen {
+			return ErrInvalidMetadata
+		}
+	}
+	return nil
+}
+
+// Set the label and metadata of an account, nil leaves them as they are
+// An empty label removes it, metadata replaces what was there so an empty map removes it
+func (w *NanoWallet) AccountLabelSet(wallet *ent.Wallet, address string, label *string, metadata map[string]string) (*ent.Account, error) {
+	if label != nil && utf8.RuneCountInString(*label) > accountLabelMaxLen {
+		return nil, ErrInvalidLabel
+	} else if err := validateMetadata(metadata); err != nil {
+		return nil, err
+	}
+	acc, err := w.GetAccount(wallet, address)
+	if err != nil {
+		return nil, err
+	}
+
+	update := acc.Update()
+	if label != nil && *label == "" {
+		update.ClearLabel()
+	} else if label != nil {
+		update.SetLabel(*label)
+	}
+	if metadata != nil && len(metadata) == 0 {
+		update.ClearMetadata()
+	} else if metadata != nil {
+		update.SetMetadata(metadata)
+	}
+	return update.Save(w.Ctx)
+}
+
+// The labels of a wallet's accounts by address, accounts without one are left out
+func (w *NanoWallet) AccountLabels(wallet *ent.Wallet) (map[string]string, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.LabelNotNil()).All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	labels := make(map[string]string, len(accounts))
+	for _, acc := range accounts {
+		labels[acc.Address] = *acc.Label
+	}
+	return labels, nil
+}
@@ -0,0 +1,58 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountLabelSet(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("c3e8a1f6d2b9470e5a8c1f4d7b0e3a69f2c5b8e1d4a7c0f3b6e9d2a5c8f1b4e7"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	created, err := MockWallet.AccountsCreate(wallet, 1)
+	assert.Nil(t, err)
+	address := created[0].Address
+
+	labels, err := MockWallet.AccountLabels(wallet)
+	assert.Nil(t, err)
+	assert.Empty(t, labels)
+
+	label := "user 42"
+	acc, err := MockWallet.AccountLabelSet(wallet, address, &label, map[string]string{"user_id": "42"})
+	assert.Nil(t, err)
+	assert.Equal(t, label, *acc.Label)
+	assert.Equal(t, map[string]string{"user_id": "42"}, acc.Metadata)
+
+	// nil leaves them as they are
+	acc, err = MockWallet.AccountLabelSet(wallet, address, nil, map[string]string{"user_id": "43", "tier": "2"})
+	assert.Nil(t, err)
+	assert.Equal(t, label, *acc.Label)
+	assert.Equal(t, map[string]string{"user_id": "43", "tier": "2"}, acc.Metadata)
+	labels, err = MockWallet.AccountLabels(wallet)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{address: label}, labels)
+
+	// Empty removes them
+	empty := ""
+	acc, err = MockWallet.AccountLabelSet(wallet, address, &empty, map[string]string{})
+	assert.Nil(t, err)
+	assert.Nil(t, acc.Label)
+	assert.Empty(t, acc.Metadata)
+	labels, err = MockWallet.AccountLabels(wallet)
+	assert.Nil(t, err)
+	assert.Empty(t, labels)
+
+	// errors
+	long := strings.Repeat("a", 257)
+	_, err = MockWallet.AccountLabelSet(wallet, address, &long, nil)
+	assert.ErrorIs(t, err, ErrInvalidLabel)
+	_, err = MockWallet.AccountLabelSet(wallet, address, nil, map[string]string{"": "42"})
+	assert.ErrorIs(t, err, ErrInvalidMetadata)
+	_, err = MockWallet.AccountLabelSet(wallet, address, nil, map[string]string{"user_id": long})
+	assert.ErrorIs(t, err, ErrInvalidMetadata)
+	_, err = MockWallet.AccountLabelSet(wallet, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", &label, nil)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+}
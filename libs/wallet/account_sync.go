@@ -0,0 +1,66 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
+)
+
+// An account brought up to date with the node by AccountSync
+type AccountSyncResult struct {
+	// Receive blocks that were published, in the order they were
+	Received []string
+	// Frontier after the receives, empty if the account is still unopened
+	Frontier string
+}
+
+// Bring an account of the wallet up to date with the node, e.g. after receives were missed while auto receive was off
+// The frontier is read from the node, not the frontier cache, which is set to it, then everything receivable is received one after another
+// If a receive fails the ones before it are returned with the error
+func (w *NanoWallet) AccountSync(wallet *ent.Wallet, address string, bpowKey *string) (*AccountSyncResult, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if wallet.FrozenAt != nil {
+		return nil, ErrWalletFrozen
+	}
+
+	acc, err := w.GetAccount(wallet, address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Obtain lock, as long as receive all's
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*300, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	result := &AccountSyncResult{
+		Received: []string{},
+	}
+	accountInfo, err := w.RpcClient.MakeAccountInfoRequest(acc.Address)
+	if errors.Is(err, nanorpc.ErrAccountNotFound) {
+		w.frontiers().Invalidate(acc.Address)
+	} else if err != nil {
+		return nil, err
+	} else {
+		w.frontiers().Set(acc.Address, accountInfo.Frontier, accountInfo.Balance)
+		result.Frontier = accountInfo.Frontier
+	}
+
+	hashes, err := w.receiveAllHashes(wallet, acc, bpowKey)
+	result.Received = append(result.Received, hashes...)
+	if len(hashes) > 0 {
+		result.Frontier = hashes[len(hashes)-1]
+	}
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
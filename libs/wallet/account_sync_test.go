@@ -0,0 +1,106 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountSync(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	frontier := "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3"
+	pending := "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE"
+	opened := true
+	receivable := map[string]interface{}{pending: "1000000000000000000000000000000"}
+	var published []nanoblock.StateBlock
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "account_info":
+				if !opened {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Account not found"})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       frontier,
+					"balance":        "5",
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			case "receivable":
+				assert.Equal(t, MockWallet.Config.Wallet.ReceiveMinimum, pr["threshold"])
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": receivable})
+			case "block_info":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "process":
+				var sb nanoblock.StateBlock
+				block, _ := json.Marshal(pr["block"])
+				json.Unmarshal(block, &sb)
+				published = append(published, sb)
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%X", sb.Hash()),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	_, err := MockWallet.AccountSync(nil, "", nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("e6b9c2f5a8d1e4b7c0f3a6d9e2b5c8f1a4d7e0b3c6f9a2d5e8b1c4f8c4f7a0d3"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	_, err = MockWallet.AccountSync(wallet, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", nil)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	// A stale cached frontier is replaced by the node's before receiving
+	MockWallet.frontiers().Set(acc.Address, "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", "0")
+	result, err := MockWallet.AccountSync(wallet, acc.Address, nil)
+	assert.Nil(t, err)
+	assert.Len(t, published, 1)
+	assert.Equal(t, frontier, published[0].Previous)
+	assert.Equal(t, pending, published[0].Link)
+	expected := fmt.Sprintf("%X", published[0].Hash())
+	assert.Equal(t, []string{expected}, result.Received)
+	assert.Equal(t, expected, result.Frontier)
+	cached, ok := MockWallet.frontiers().Get(acc.Address)
+	assert.True(t, ok)
+	assert.Equal(t, expected, cached.frontier)
+
+	// Nothing receivable, the node's frontier is returned
+	receivable = map[string]interface{}{}
+	result, err = MockWallet.AccountSync(wallet, acc.Address, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, result.Received)
+	assert.Equal(t, frontier, result.Frontier)
+	cached, _ = MockWallet.frontiers().Get(acc.Address)
+	assert.Equal(t, frontier, cached.frontier)
+
+	// An unopened account with nothing receivable has no frontier
+	opened = false
+	result, err = MockWallet.AccountSync(wallet, acc.Address, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, result.Received)
+	assert.Equal(t, "", result.Frontier)
+	_, ok = MockWallet.frontiers().Get(acc.Address)
+	assert.False(t, ok)
+	assert.Len(t, published, 1)
+}
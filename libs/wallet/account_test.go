@@ -1,12 +1,20 @@
 package wallet
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
 	"strings"
 	"testing"
 
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -93,12 +101,107 @@ func TestAccountCreate(t *testing.T) {
 	assert.ErrorIs(t, ErrWalletLocked, err)
 }
 
+func TestAccountCreateNext(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("0ef4a2e1a0c6a0e0d8ac3f5c1c07b1e1ee47bd4b8a35e0e4f8d3e6a4f3b0c2d1"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+
+	// The same account create makes next, nothing is created before it
+	for i := 1; i <= 3; i++ {
+		index, address, err := MockWallet.AccountCreateNext(wallet)
+		assert.Nil(t, err)
+		assert.Equal(t, i, index)
+		index, address2, err := MockWallet.AccountCreateNext(wallet)
+		assert.Nil(t, err)
+		assert.Equal(t, i, index)
+		assert.Equal(t, address, address2)
+		count, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID)).Count(MockWallet.Ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, i, count)
+
+		acct, err := MockWallet.AccountCreate(wallet, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, i, *acct.AccountIndex)
+		assert.Equal(t, address, acct.Address)
+	}
+
+	// The index of an account added at an index is skipped
+	idx := 4
+	_, err = MockWallet.AccountCreate(wallet, &idx)
+	assert.Nil(t, err)
+	index, _, err := MockWallet.AccountCreateNext(wallet)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, index)
+
+	MockWallet.EncryptWallet(wallet, "password")
+	_, _, err = MockWallet.AccountCreateNext(wallet)
+	assert.ErrorIs(t, err, ErrWalletLocked)
+}
+
 func TestAccountCreateBadInput(t *testing.T) {
 	// Empty seed
 	_, err := MockWallet.AccountCreate(nil, nil)
 	assert.ErrorIs(t, ErrInvalidWallet, err)
 }
 
+func TestAccountCreateFromSeed(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("c4e1a7d3f9b2e6c8a0d5f1b7e3c9a4d2f8b6e0c1a3d7f5b9e2c4a6d8f0b1e373"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+
+	// Same seed as TestAccountsCreate, so the addresses are known
+	externalSeed, _ := utils.GenerateSeed(strings.NewReader("9f729340e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040e"))
+
+	index := 1
+	acct, err := MockWallet.AccountCreateFromSeed(wallet, externalSeed, &index)
+	assert.Nil(t, err)
+	assert.Equal(t, "nano_3hntkbk1q6pn1n8481shemojcmtpxxpjbojm7h5h5p6jz53bahjuif6d8j4f", acct.Address)
+	assert.Equal(t, externalSeed, *acct.Seed)
+	assert.Equal(t, 1, *acct.SeedIndex)
+	assert.Nil(t, acct.AccountIndex)
+	_, priv, _ := utils.KeypairFromSeed(externalSeed, 1)
+	assert.Equal(t, hex.EncodeToString(priv), *acct.PrivateKey)
+
+	_, err = MockWallet.AccountCreateFromSeed(wallet, externalSeed, &index)
+	assert.ErrorIs(t, err, ErrAccountExists)
+
+	// Without an index it's the first one that isn't in the wallet
+	acct, err = MockWallet.AccountCreateFromSeed(wallet, externalSeed, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, *acct.SeedIndex)
+	acct, err = MockWallet.AccountCreateFromSeed(wallet, externalSeed, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, *acct.SeedIndex)
+	assert.Equal(t, "nano_1dh7j8bw1pi8xur1zste3c7oqc1ef3yttory4snhufb5haj1ctzxapgpqhzq", acct.Address)
+
+	// The wallet's own accounts are still derived from its seed
+	acct, err = MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, *acct.AccountIndex)
+	assert.Nil(t, acct.Seed)
+
+	// The external seed is encrypted with the wallet
+	external, _ := MockWallet.GetAccount(wallet, "nano_1dh7j8bw1pi8xur1zste3c7oqc1ef3yttory4snhufb5haj1ctzxapgpqhzq")
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+	MockWallet.UnlockWallet(wallet, "password")
+	external, _ = MockWallet.GetAccount(wallet, external.Address)
+	assert.NotEqual(t, externalSeed, *external.Seed)
+	decrypted, err := GetDecryptedKeyFromStorage(wallet, accountSeedKey(external.Address))
+	assert.Nil(t, err)
+	assert.Equal(t, externalSeed, decrypted)
+
+	_, err = MockWallet.EncryptWallet(wallet, "")
+	assert.Nil(t, err)
+	external, _ = MockWallet.GetAccount(wallet, external.Address)
+	assert.Equal(t, externalSeed, *external.Seed)
+
+	_, err = MockWallet.AccountCreateFromSeed(wallet, "1234", nil)
+	assert.ErrorIs(t, err, ErrInvalidSeed)
+	_, err = MockWallet.AccountCreateFromSeed(nil, externalSeed, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+}
+
 func TestAdhocAccountCreate(t *testing.T) {
 	// Predictable seed
 	seed, _ := utils.GenerateSeed(strings.NewReader("aa729340e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040d"))
@@ -203,6 +306,60 @@ func TestAccountsCreate(t *testing.T) {
 	assert.ErrorIs(t, ErrWalletLocked, err)
 }
 
+func TestAccountsCreateIdempotent(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("7c1e4a9d2f5b8e0c3a6d9f2b5e8c1a4d7f0b3e6c9a2d5f8b1e4c7a0d3f6b9e2c"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+
+	key := uuid.New()
+	addresses, err := MockWallet.AccountsCreateIdempotent(wallet, 3, key)
+	assert.Nil(t, err)
+	assert.Len(t, addresses, 3)
+
+	// Same key returns the same accounts, without creating more
+	again, err := MockWallet.AccountsCreateIdempotent(wallet, 3, key)
+	assert.Nil(t, err)
+	assert.Equal(t, addresses, again)
+	count, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID)).Count(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, count)
+	used, err := MockWallet.IdempotencyKeyUsed(wallet, key)
+	assert.Nil(t, err)
+	assert.True(t, used)
+	used, err = MockWallet.IdempotencyKeyUsed(wallet, uuid.New())
+	assert.Nil(t, err)
+	assert.False(t, used)
+
+	// Another key creates more
+	other, err := MockWallet.AccountsCreateIdempotent(wallet, 2, uuid.New())
+	assert.Nil(t, err)
+	assert.Len(t, other, 2)
+	assert.NotContains(t, addresses, other[0])
+
+	// Can't be used with another wallet
+	otherSeed, _ := utils.GenerateSeed(strings.NewReader("8c1e4a9d2f5b8e0c3a6d9f2b5e8c1a4d7f0b3e6c9a2d5f8b1e4c7a0d3f6b9e2c"))
+	otherWallet, err := MockWallet.WalletCreate(otherSeed)
+	assert.Nil(t, err)
+	_, err = MockWallet.AccountsCreateIdempotent(otherWallet, 3, key)
+	assert.ErrorIs(t, err, ErrIdempotencyKeyInUse)
+	used, err = MockWallet.IdempotencyKeyUsed(otherWallet, key)
+	assert.Nil(t, err)
+	assert.False(t, used)
+
+	// Expired keys are forgotten
+	conf := *MockWallet.Config
+	conf.Wallet.IdempotencyKeyTTL = 0
+	expiringWallet := &NanoWallet{
+		DB:     MockWallet.DB,
+		Ctx:    MockWallet.Ctx,
+		Config: &conf,
+	}
+	expired, err := expiringWallet.AccountsCreateIdempotent(wallet, 3, key)
+	assert.Nil(t, err)
+	assert.Len(t, expired, 3)
+	assert.NotEqual(t, addresses, expired)
+}
+
 func TestAccountsCreateBadInput(t *testing.T) {
 	_, err := MockWallet.AccountsCreate(nil, 10)
 	assert.ErrorIs(t, ErrInvalidWallet, err)
@@ -264,6 +421,46 @@ func TestAccountsList(t *testing.T) {
 	assert.ErrorIs(t, ErrWalletLocked, err)
 }
 
+func TestAccountsPage(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("5b0e6c3f9a1d4e7b2c8f0a6d3e9b1c4f7a2d5e8b0c3f6a9d1e4b7c2f5a8d0e3b"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+
+	// More than one batch
+	accts, err := MockWallet.AccountsCreate(wallet, 250)
+	assert.Nil(t, err)
+	assert.Len(t, accts, 250)
+	for i, acct := range accts {
+		assert.Equal(t, i+1, *acct.AccountIndex)
+	}
+	pub, _, _ := utils.KeypairFromSeed(seed, 250)
+	assert.Equal(t, utils.PubKeyToAddress(pub, false), accts[249].Address)
+
+	count, err := MockWallet.AccountsCount(wallet)
+	assert.Nil(t, err)
+	assert.Equal(t, 251, count)
+
+	// Every account once
+	seen := map[string]bool{}
+	for offset := 0; offset < count; offset += 100 {
+		page, err := MockWallet.AccountsPage(wallet, offset, 100)
+		assert.Nil(t, err)
+		assert.Len(t, page, min(100, count-offset))
+		for _, acct := range page {
+			assert.False(t, seen[acct.Address])
+			seen[acct.Address] = true
+		}
+	}
+	assert.Len(t, seen, 251)
+	page, err := MockWallet.AccountsPage(wallet, 300, 100)
+	assert.Nil(t, err)
+	assert.Len(t, page, 0)
+
+	MockWallet.EncryptWallet(wallet, "password")
+	_, err = MockWallet.AccountsPage(wallet, 0, 100)
+	assert.ErrorIs(t, err, ErrWalletLocked)
+}
+
 func TestAccountExists(t *testing.T) {
 	// Create a test wallet
 	seed, _ := utils.GenerateSeed(strings.NewReader("cd92b5cf58554077ccd450a09ccbefaea04229c7d9bc54abba06dcaf7ed01af9"))
@@ -289,3 +486,84 @@ func TestAccountExists(t *testing.T) {
 	exists, err = MockWallet.AccountExists(wallet, "nano_1pidkij46sqyf7gan8fugj693z5ornpf449tikop83dwsuosy1o5164p1jry")
 	assert.True(t, exists)
 }
+
+func TestAccountRemove(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var emptyAccount string
+
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.AccountRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_balance" {
+				if pr.Account == emptyAccount {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{
+						"balance":    "0",
+						"pending":    "0",
+						"receivable": "0",
+					})
+				}
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountBalanceResponseStr), &js)
+				resp, err := httpmock.NewJsonResponse(200, js)
+				return resp, err
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+			return resp, err
+		},
+	)
+
+	err := MockWallet.AccountRemove(nil, "", false)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	// Create a test wallet, index 0 is created automatically
+	seed, _ := utils.GenerateSeed(strings.NewReader("6f9a2b57c8e0475d31b8f2a4e9c1d06b7a35e8f4c2d9b1a06e7f3c5d8b2a4e91"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+
+	// Can't remove the only deterministic account
+	first, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID)).First(MockWallet.Ctx)
+	assert.Nil(t, err)
+	err = MockWallet.AccountRemove(wallet, first.Address, true)
+	assert.ErrorIs(t, err, ErrLastAccount)
+
+	// Account at index 1 has no balance
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	emptyAccount = acc.Address
+	// Account at index 2 has a balance
+	funded, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	err = MockWallet.AccountRemove(wallet, "nano_1111111111111111111111111111111111111111111111111117353trpda", false)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	// Balance blocks removal unless forced
+	err = MockWallet.AccountRemove(wallet, funded.Address, false)
+	assert.ErrorIs(t, err, ErrAccountHasBalance)
+	exists, err := MockWallet.AccountExists(wallet, funded.Address)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+
+	err = MockWallet.AccountRemove(wallet, funded.Address, true)
+	assert.Nil(t, err)
+	exists, err = MockWallet.AccountExists(wallet, funded.Address)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	err = MockWallet.AccountRemove(wallet, acc.Address, false)
+	assert.Nil(t, err)
+	exists, err = MockWallet.AccountExists(wallet, acc.Address)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	// Derivation is unaffected, the next account picks up after the highest remaining index
+	next, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, *next.AccountIndex)
+	assert.Equal(t, emptyAccount, next.Address)
+}
@@ -0,0 +1,99 @@
+package wallet
+
+import (
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+	"golang.org/x/sync/errgroup"
+)
+
+// What the node knows about an account, see AccountsInfo
+type AccountInfo struct {
+	Address string
+	// Whether the account has a frontier, unopened accounts have no frontier or representative
+	Opened         bool
+	BalanceRaw     string
+	PendingRaw     string
+	Frontier       *string
+	Representative *string
+	// The account index of accounts derived from the wallet seed, only set when a wallet is given
+	DerivationIndex *int
+}
+
+// The balance, frontier and representative of every account of a wallet, or of addresses if wallet is nil
+// accounts_frontiers, accounts_balances and accounts_representatives are called at the same time
+func (w *NanoWallet) AccountsInfo(wallet *ent.Wallet, addresses []string) ([]*AccountInfo, error) {
+	infos := []*AccountInfo{}
+	if wallet != nil {
+		// Fails if the wallet is locked
+		if _, err := GetDecryptedKeyFromStorage(wallet, "seed"); err != nil {
+			return nil, err
+		}
+		accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Order(ent.Asc(account.FieldCreatedAt)).All(w.Ctx)
+		if err != nil {
+			return nil, err
+		}
+		addresses = make([]string, len(accounts))
+		for i, acc := range accounts {
+			addresses[i] = acc.Address
+			infos = append(infos, &AccountInfo{Address: acc.Address, DerivationIndex: acc.AccountIndex})
+		}
+	} else {
+		for _, address := range addresses {
+			infos = append(infos, &AccountInfo{Address: address})
+		}
+	}
+	if len(addresses) == 0 {
+		return infos, nil
+	}
+
+	var frontiers *rpcresponses.AccountsFrontiersResponse
+	var balances *rpcresponses.AccountsBalancesResponse
+	var representatives *rpcresponses.AccountsRepresentativesResponse
+	var g errgroup.Group
+	g.Go(func() (err error) {
+		frontiers, err = w.RpcClient.MakeAccountsFrontiersRequest(addresses)
+		return err
+	})
+	g.Go(func() (err error) {
+		balances, err = w.RpcClient.MakeAccountsBalancesRequest(addresses)
+		return err
+	})
+	g.Go(func() (err error) {
+		representatives, err = w.RpcClient.MakeAccountsRepresentativesRequest(addresses)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		info.BalanceRaw = "0"
+		info.PendingRaw = "0"
+		if balances.Balances != nil {
+			if item, ok := (*balances.Balances)[info.Address]; ok {
+				if item.Balance != "" {
+					info.BalanceRaw = item.Balance
+				}
+				// Newer nodes call it receivable
+				if item.Receivable != "" {
+					info.PendingRaw = item.Receivable
+				} else if item.Pending != "" {
+					info.PendingRaw = item.Pending
+				}
+			}
+		}
+		if frontiers.Frontiers != nil {
+			if frontier, ok := (*frontiers.Frontiers)[info.Address]; ok {
+				info.Opened = true
+				info.Frontier = &frontier
+			}
+		}
+		if representatives.Representatives != nil {
+			if representative, ok := (*representatives.Representatives)[info.Address]; ok {
+				info.Representative = &representative
+			}
+		}
+	}
+	return infos, nil
+}
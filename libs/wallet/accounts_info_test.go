@@ -0,0 +1,107 @@
+package wallet
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountsInfo(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("4e7c0f3a6d9b2e5c8f1a4d7b0e3c6f9a2d5b8e1c4f7a0d3b6e9c2f5a8d1b4e1b"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	created, err := MockWallet.AccountsCreate(wallet, 1)
+	assert.Nil(t, err)
+	pub, _, _ := utils.KeypairFromSeed(seed, 0)
+	opened := utils.PubKeyToAddress(pub, false)
+	unopened := created[0].Address
+	_, adhocPriv, _ := utils.KeypairFromSeed(seed, 100)
+	adhoc, err := MockWallet.AdhocAccountCreate(wallet, adhocPriv)
+	assert.Nil(t, err)
+
+	rep := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	frontier := "791AF413173EEE674A6FCF633B5DFC0F3C33F397F0DA08E987D9E0741D40D81A"
+	var mutex sync.Mutex
+	calls := map[string]int{}
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var ar requests.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&ar)
+			// The calls are made at the same time
+			mutex.Lock()
+			calls[ar.Action]++
+			mutex.Unlock()
+			switch ar.Action {
+			case "accounts_frontiers":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontiers": map[string]string{opened: frontier, adhoc.Address: frontier},
+					"errors":    map[string]string{unopened: "Account not found"},
+				})
+			case "accounts_balances":
+				resp := map[string]interface{}{}
+				for _, account := range ar.Accounts {
+					resp[account] = map[string]interface{}{"balance": "0", "pending": "0", "receivable": "0"}
+				}
+				resp[opened] = map[string]interface{}{"balance": "1000", "pending": "5", "receivable": "5"}
+				resp[unopened] = map[string]interface{}{"balance": "0", "pending": "7"}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"balances": resp})
+			case "accounts_representatives":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"representatives": map[string]string{opened: rep, adhoc.Address: rep},
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+
+	// Every account of the wallet, oldest first
+	infos, err := MockWallet.AccountsInfo(wallet, nil)
+	assert.Nil(t, err)
+	assert.Len(t, infos, 3)
+	assert.Equal(t, opened, infos[0].Address)
+	assert.True(t, infos[0].Opened)
+	assert.Equal(t, "1000", infos[0].BalanceRaw)
+	assert.Equal(t, "5", infos[0].PendingRaw)
+	assert.Equal(t, frontier, *infos[0].Frontier)
+	assert.Equal(t, rep, *infos[0].Representative)
+	assert.Equal(t, 0, *infos[0].DerivationIndex)
+	assert.Equal(t, unopened, infos[1].Address)
+	assert.False(t, infos[1].Opened)
+	assert.Equal(t, "0", infos[1].BalanceRaw)
+	assert.Equal(t, "7", infos[1].PendingRaw)
+	assert.Nil(t, infos[1].Frontier)
+	assert.Nil(t, infos[1].Representative)
+	assert.Equal(t, 1, *infos[1].DerivationIndex)
+	// Ad-hoc accounts aren't derived from the seed
+	assert.Equal(t, adhoc.Address, infos[2].Address)
+	assert.True(t, infos[2].Opened)
+	assert.Nil(t, infos[2].DerivationIndex)
+	assert.Equal(t, map[string]int{"accounts_frontiers": 1, "accounts_balances": 1, "accounts_representatives": 1}, calls)
+
+	// Given accounts, without a wallet there's no derivation index
+	infos, err = MockWallet.AccountsInfo(nil, []string{unopened, opened})
+	assert.Nil(t, err)
+	assert.Len(t, infos, 2)
+	assert.Equal(t, unopened, infos[0].Address)
+	assert.False(t, infos[0].Opened)
+	assert.Equal(t, opened, infos[1].Address)
+	assert.True(t, infos[1].Opened)
+	assert.Nil(t, infos[1].DerivationIndex)
+
+	// Locked wallets
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+	assert.Nil(t, MockWallet.LockWallet(wallet))
+	_, err = MockWallet.AccountsInfo(wallet, nil)
+	assert.ErrorIs(t, err, ErrWalletLocked)
+}
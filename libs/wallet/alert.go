@@ -0,0 +1,241 @@
+package wallet
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancealert"
+	"github.com/appditto/pippin_nano_wallet/libs/nano"
+	"github.com/google/uuid"
+)
+
+var ErrAlertNotFound = errors.New("alert not found")
+var ErrInvalidThreshold = errors.New("invalid threshold")
+var ErrInvalidDirection = errors.New("invalid direction")
+var ErrInvalidCallbackUrl = errors.New("invalid callback url")
+
+// Balance alerts, POST to a callback when an account's balance goes above or below a threshold
+// An alert fires once per crossing, it's re-armed when the balance crosses back
+
+// Header with the hex HMAC-SHA256 of the callback body, keyed with the webhook secret
+const WebhookSignatureHeader = "X-Pippin-Signature"
+
+// The body POSTed to an alert's callback_url
+type AlertCallback struct {
+	AlertID      string `json:"alert_id"`
+	Wallet       string `json:"wallet"`
+	Account      string `json:"account"`
+	Direction    string `json:"direction"`
+	ThresholdRaw string `json:"threshold_raw"`
+	BalanceRaw   string `json:"balance_raw"`
+	Timestamp    int64  `json:"timestamp"`
+}
+
+var webhookClient = &http.Client{Timeout: time.Second * 10}
+
+// Register an alert on an account in the wallet
+func (w *NanoWallet) AlertRegister(wallet *ent.Wallet, account string, threshold string, direction string, callbackUrl string) (*ent.BalanceAlert, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	thresholdRaw, ok := big.NewInt(0).SetString(threshold, 10)
+	if !ok || thresholdRaw.Sign() < 0 {
+		return nil, ErrInvalidThreshold
+	}
+	if balancealert.DirectionValidator(balancealert.Direction(direction)) != nil {
+		return nil, ErrInvalidDirection
+	}
+	if u, err := url.Parse(callbackUrl); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrInvalidCallbackUrl
+	}
+	if _, err := nano.ValidateAddress(account, w.Config.Wallet.Banano); err != nil {
+		return nil, ErrInvalidAccount
+	}
+
+	// Account must be in this wallet
+	acc, err := w.GetAccount(wallet, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.DB.BalanceAlert.Create().
+		SetWalletID(wallet.ID).
+		SetAccount(acc.Address).
+		SetThreshold(thresholdRaw.String()).
+		SetDirection(balancealert.Direction(direction)).
+		SetCallbackURL(callbackUrl).
+		Save(w.Ctx)
+}
+
+// Every alert in the wallet, oldest first
+func (w *NanoWallet) AlertList(wallet *ent.Wallet) ([]*ent.BalanceAlert, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	return w.DB.BalanceAlert.Query().Where(balancealert.WalletID(wallet.ID)).Order(ent.Asc(balancealert.FieldCreatedAt)).All(w.Ctx)
+}
+
+// Delete an alert, it must belong to the given wallet
+func (w *NanoWallet) AlertDelete(wallet *ent.Wallet, alertID string) error {
+	if wallet == nil {
+		return ErrInvalidWallet
+	}
+	id, err := uuid.Parse(alertID)
+	if err != nil {
+		return ErrAlertNotFound
+	}
+
+	deleted, err := w.DB.BalanceAlert.Delete().Where(balancealert.ID(id), balancealert.WalletID(wallet.ID)).Exec(w.Ctx)
+	if err != nil {
+		return err
+	} else if deleted < 1 {
+		return ErrAlertNotFound
+	}
+
+	return nil
+}
+
+// Check the balance of every alerted account, returns the number of callbacks delivered
+// A callback that can't be delivered leaves the alert unfired, so it's retried on the next check
+func (w *NanoWallet) CheckBalanceAlerts(now time.Time) (int, error) {
+	// Only one instance checks at a time, so a crossing isn't reported twice
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, "balance_alerts", time.Second*30, nil)
+	if err != nil {
+		return 0, nil
+	}
+	defer lock.Release(w.Ctx)
+
+	alerts, err := w.DB.BalanceAlert.Query().All(w.Ctx)
+	if err != nil || len(alerts) == 0 {
+		return 0, err
+	}
+
+	accounts := []string{}
+	seen := make(map[string]bool)
+	for _, alert := range alerts {
+		if !seen[alert.Account] {
+			seen[alert.Account] = true
+			accounts = append(accounts, alert.Account)
+		}
+	}
+	balancesResp, err := w.RpcClient.MakeAccountsBalancesRequest(accounts)
+	if err != nil {
+		return 0, err
+	}
+	balances := map[string]string{}
+	if balancesResp.Balances != nil {
+		for account, item := range *balancesResp.Balances {
+			balances[account] = item.Balance
+		}
+	}
+
+	delivered := 0
+	for _, alert := range alerts {
+		balance, ok := big.NewInt(0).SetString(balances[alert.Account], 10)
+		if !ok {
+			// Unopened accounts have no balance
+			balance = big.NewInt(0)
+		}
+		threshold, _ := big.NewInt(0).SetString(alert.Threshold, 10)
+		crossed := balance.Cmp(threshold) > 0
+		if alert.Direction == balancealert.DirectionBelow {
+			crossed = balance.Cmp(threshold) < 0
+		}
+
+		if !crossed && alert.Fired {
+			// Back on the other side, fire again next time it crosses
+			if _, err := alert.Update().SetFired(false).Save(w.Ctx); err != nil {
+				return delivered, err
+			}
+		} else if crossed && !alert.Fired {
+			if err := w.postAlertCallback(alert, balance.String(), now); err != nil {
+				continue
+			}
+			if _, err := alert.Update().SetFired(true).Save(w.Ctx); err != nil {
+				return delivered, err
+			}
+			delivered++
+		}
+	}
+
+	return delivered, nil
+}
+
+// Sign a webhook body, empty if there is no secret
+func SignWebhook(secret string, body []byte) string {
+	if secret == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (w *NanoWallet) postAlertCallback(alert *ent.BalanceAlert, balance string, now time.Time) error {
+	body, err := json.Marshal(AlertCallback{
+		AlertID:      alert.ID.String(),
+		Wallet:       alert.WalletID.String(),
+		Account:      alert.Account,
+		Direction:    alert.Direction.String(),
+		ThresholdRaw: alert.Threshold,
+		BalanceRaw:   balance,
+		Timestamp:    now.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	return w.postWebhook(alert.CallbackURL, body)
+}
+
+// POST a signed webhook body to callbackUrl, an error unless it returns a 2xx
+func (w *NanoWallet) postWebhook(callbackUrl string, body []byte) error {
+	request, err := http.NewRequestWithContext(w.Ctx, http.MethodPost, callbackUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if signature := SignWebhook(w.WebhookSecret, body); signature != "" {
+		request.Header.Set(WebhookSignatureHeader, signature)
+	}
+	resp, err := webhookClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("callback returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Check balance alerts every tick until the wallet context is done
+// If clock is nil the system clock is used
+func (w *NanoWallet) StartAlertPoller(clock Clock, tick time.Duration) {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.Ctx.Done():
+			return
+		case <-w.stopped():
+			return
+		case <-ticker.C:
+			w.Background(func() { w.CheckBalanceAlerts(clock.Now()) })
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package wallet
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertRegisterListDelete(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("2b7e0d5a8c3f6e1b4d9a2c7f0e5b8d3a6c1f4e9b2d7a0c5f8e3b6d1a4c9f2e57"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	callback := "https://example.com/alert"
+
+	_, err = MockWallet.AlertRegister(nil, acc.Address, "1", "above", callback)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+	_, err = MockWallet.AlertRegister(wallet, acc.Address, "-1", "above", callback)
+	assert.ErrorIs(t, err, ErrInvalidThreshold)
+	_, err = MockWallet.AlertRegister(wallet, acc.Address, "1", "sideways", callback)
+	assert.ErrorIs(t, err, ErrInvalidDirection)
+	_, err = MockWallet.AlertRegister(wallet, acc.Address, "1", "above", "ftp://example.com")
+	assert.ErrorIs(t, err, ErrInvalidCallbackUrl)
+	_, err = MockWallet.AlertRegister(wallet, "nano_1234", "1", "above", callback)
+	assert.ErrorIs(t, err, ErrInvalidAccount)
+	_, err = MockWallet.AlertRegister(wallet, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", "1", "above", callback)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	alert, err := MockWallet.AlertRegister(wallet, acc.Address, "1000", "below", callback)
+	assert.Nil(t, err)
+	assert.Equal(t, wallet.ID, alert.WalletID)
+	assert.Equal(t, "1000", alert.Threshold)
+	assert.False(t, alert.Fired)
+
+	alerts, err := MockWallet.AlertList(wallet)
+	assert.Nil(t, err)
+	assert.Len(t, alerts, 1)
+	assert.Equal(t, alert.ID, alerts[0].ID)
+
+	// Another wallet can't delete it
+	otherSeed, _ := utils.GenerateSeed(strings.NewReader("8d3a6c1f4e9b2d7a0c5f8e3b6d1a4c9f2e572b7e0d5a8c3f6e1b4d9a2c7f0e5b"))
+	otherWallet, err := MockWallet.WalletCreate(otherSeed)
+	assert.Nil(t, err)
+	assert.ErrorIs(t, MockWallet.AlertDelete(otherWallet, alert.ID.String()), ErrAlertNotFound)
+	assert.ErrorIs(t, MockWallet.AlertDelete(wallet, "notauuid"), ErrAlertNotFound)
+
+	assert.Nil(t, MockWallet.AlertDelete(wallet, alert.ID.String()))
+	assert.ErrorIs(t, MockWallet.AlertDelete(wallet, alert.ID.String()), ErrAlertNotFound)
+	alerts, err = MockWallet.AlertList(wallet)
+	assert.Nil(t, err)
+	assert.Len(t, alerts, 0)
+}
+
+func TestCheckBalanceAlerts(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	// The callback server is real
+	httpmock.RegisterNoResponder(httpmock.InitialTransport.RoundTrip)
+
+	balance := "500"
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var ar requests.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&ar)
+			resp := map[string]interface{}{}
+			for _, account := range ar.Accounts {
+				resp[account] = map[string]interface{}{"balance": balance, "pending": "0", "receivable": "0"}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"balances": resp,
+			})
+		},
+	)
+
+	var callbacks []AlertCallback
+	var signatures []string
+	callbackStatus := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var callback AlertCallback
+		json.Unmarshal(body, &callback)
+		if callbackStatus == http.StatusOK {
+			callbacks = append(callbacks, callback)
+			signatures = append(signatures, r.Header.Get(WebhookSignatureHeader))
+			assert.Equal(t, SignWebhook("secret", body), r.Header.Get(WebhookSignatureHeader))
+		}
+		w.WriteHeader(callbackStatus)
+	}))
+	defer server.Close()
+
+	alertWallet := &NanoWallet{
+		DB:            MockWallet.DB,
+		Ctx:           MockWallet.Ctx,
+		RpcClient:     MockWallet.RpcClient,
+		WorkClient:    MockWallet.WorkClient,
+		Config:        MockWallet.Config,
+		WebhookSecret: "secret",
+	}
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("f1c4e7a0d3b6f9c2e5a8d1b4f7c0e3a6d9b2f5c8e1a4d7b0f3c6e9a2d5b8f1c4"))
+	wallet, err := alertWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := alertWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	below, err := alertWallet.AlertRegister(wallet, acc.Address, "100", "below", server.URL)
+	assert.Nil(t, err)
+	above, err := alertWallet.AlertRegister(wallet, acc.Address, "1000", "above", server.URL)
+	assert.Nil(t, err)
+	now := time.Unix(1700000000, 0)
+
+	// Between the thresholds
+	delivered, err := alertWallet.CheckBalanceAlerts(now)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, delivered)
+
+	// Drops below, fires once
+	balance = "99"
+	delivered, err = alertWallet.CheckBalanceAlerts(now)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, delivered)
+	delivered, err = alertWallet.CheckBalanceAlerts(now)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Len(t, callbacks, 1)
+	assert.Equal(t, AlertCallback{
+		AlertID:      below.ID.String(),
+		Wallet:       wallet.ID.String(),
+		Account:      acc.Address,
+		Direction:    "below",
+		ThresholdRaw: "100",
+		BalanceRaw:   "99",
+		Timestamp:    now.Unix(),
+	}, callbacks[0])
+	assert.NotEqual(t, "", signatures[0])
+
+	// Goes above, the other alert fires but the callback fails so it's retried
+	balance = "1001"
+	callbackStatus = http.StatusInternalServerError
+	delivered, err = alertWallet.CheckBalanceAlerts(now)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, delivered)
+	callbackStatus = http.StatusOK
+	delivered, err = alertWallet.CheckBalanceAlerts(now)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Len(t, callbacks, 2)
+	assert.Equal(t, above.ID.String(), callbacks[1].AlertID)
+
+	// The below alert was re-armed when it went back up
+	balance = "50"
+	delivered, err = alertWallet.CheckBalanceAlerts(now)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Len(t, callbacks, 3)
+	assert.Equal(t, below.ID.String(), callbacks[2].AlertID)
+
+	alerts, err := alertWallet.AlertList(wallet)
+	assert.Nil(t, err)
+	assert.True(t, alerts[0].Fired)
+	assert.False(t, alerts[1].Fired)
+
+	// Clean up so other tests don't check them
+	assert.Nil(t, alertWallet.AlertDelete(wallet, below.ID.String()))
+	assert.Nil(t, alertWallet.AlertDelete(wallet, above.ID.String()))
+}
@@ -0,0 +1,114 @@
+package wallet
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/apikey"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/google/uuid"
+)
+
+var ErrApiKeyNotFound = errors.New("api key not found")
+var ErrInvalidApiKeyScope = errors.New("invalid scope, must be one of read, send or admin")
+var ErrInvalidApiKeyName = errors.New("invalid name, must be 1 to 64 characters")
+
+// API keys for the gateway, only their SHA-256 is stored so a leaked database doesn't leak them
+// Each key has a scope, a scope includes the ones below it: read < send < admin
+
+// Prefixed so keys are easy to spot, e.g. in a config file that shouldn't have one
+const apiKeyPrefix = "pippin_"
+
+// last_used_at is only written when it's older than this, so a busy key isn't a write on every request
+const apiKeyLastUsedInterval = time.Minute
+
+func hashApiKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+// Whether a key with scope can be used for something that needs required
+func ApiKeyScopeAllows(scope apikey.Scope, required apikey.Scope) bool {
+	rank := map[apikey.Scope]int{apikey.ScopeRead: 1, apikey.ScopeSend: 2, apikey.ScopeAdmin: 3}
+	return rank[scope] > 0 && rank[scope] >= rank[required]
+}
+
+// Create a key, it's returned with the stored record and can't be recovered later
+func (w *NanoWallet) ApiKeyCreate(name string, scope string) (*ent.ApiKey, string, error) {
+	if name == "" || len(name) > 64 {
+		return nil, "", ErrInvalidApiKeyName
+	}
+	if apikey.ScopeValidator(apikey.Scope(scope)) != nil {
+		return nil, "", ErrInvalidApiKeyScope
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	key := apiKeyPrefix + hex.EncodeToString(secret)
+
+	created, err := w.DB.ApiKey.Create().
+		SetName(name).
+		SetKeyHash(hashApiKey(key)).
+		SetScope(apikey.Scope(scope)).
+		Save(w.Ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return created, key, nil
+}
+
+// Every key, oldest first
+func (w *NanoWallet) ApiKeyList() ([]*ent.ApiKey, error) {
+	return w.DB.ApiKey.Query().Order(ent.Asc(apikey.FieldCreatedAt)).All(w.Ctx)
+}
+
+// Delete a key, requests with it are refused from then on
+func (w *NanoWallet) ApiKeyRevoke(id string) error {
+	keyID, err := uuid.Parse(id)
+	if err != nil {
+		return ErrApiKeyNotFound
+	}
+	deleted, err := w.DB.ApiKey.Delete().Where(apikey.ID(keyID)).Exec(w.Ctx)
+	if err != nil {
+		return err
+	} else if deleted < 1 {
+		return ErrApiKeyNotFound
+	}
+	return nil
+}
+
+// Let the key approve or reject sends that need approvals, or stop it
+func (w *NanoWallet) ApiKeySetApprover(id string, approver bool) (*ent.ApiKey, error) {
+	keyID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrApiKeyNotFound
+	}
+	updated, err := w.DB.ApiKey.UpdateOneID(keyID).SetApprover(approver).Save(w.Ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrApiKeyNotFound
+	}
+	return updated, err
+}
+
+// The stored record for a key, ErrApiKeyNotFound if it was never created or was revoked
+func (w *NanoWallet) ApiKeyVerify(key string) (*ent.ApiKey, error) {
+	found, err := w.DB.ApiKey.Query().Where(apikey.KeyHash(hashApiKey(key))).Only(w.Ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrApiKeyNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	// Only for showing in the key list, a failure doesn't refuse the key
+	now := time.Now()
+	if found.LastUsedAt == nil || now.Sub(*found.LastUsedAt) >= apiKeyLastUsedInterval {
+		if err := w.DB.ApiKey.UpdateOneID(found.ID).SetLastUsedAt(now).Exec(w.Ctx); err != nil {
+			log.Warnf("Error updating last_used_at of api key %s: %s", found.ID, err)
+		}
+	}
+	return found, nil
+}
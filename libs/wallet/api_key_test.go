@@ -0,0 +1,83 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/apikey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiKeyCreateVerifyRevoke(t *testing.T) {
+	_, _, err := MockWallet.ApiKeyCreate("", "read")
+	assert.ErrorIs(t, err, ErrInvalidApiKeyName)
+	_, _, err = MockWallet.ApiKeyCreate(strings.Repeat("a", 65), "read")
+	assert.ErrorIs(t, err, ErrInvalidApiKeyName)
+	_, _, err = MockWallet.ApiKeyCreate("exchange", "everything")
+	assert.ErrorIs(t, err, ErrInvalidApiKeyScope)
+
+	created, key, err := MockWallet.ApiKeyCreate("exchange", "send")
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(key, "pippin_"))
+	assert.Len(t, key, len("pippin_")+64)
+	// Only the hash is stored
+	assert.Equal(t, hashApiKey(key), created.KeyHash)
+	assert.Nil(t, created.LastUsedAt)
+
+	found, err := MockWallet.ApiKeyVerify(key)
+	assert.Nil(t, err)
+	assert.Equal(t, created.ID, found.ID)
+	assert.Equal(t, apikey.ScopeSend, found.Scope)
+	_, err = MockWallet.ApiKeyVerify(key + "0")
+	assert.ErrorIs(t, err, ErrApiKeyNotFound)
+
+	keys, err := MockWallet.ApiKeyList()
+	assert.Nil(t, err)
+	var listed bool
+	for _, k := range keys {
+		if k.ID == created.ID {
+			listed = true
+			assert.NotNil(t, k.LastUsedAt)
+		}
+	}
+	assert.True(t, listed)
+
+	// last_used_at isn't written again within a minute
+	usedAt := time.Now().Add(-30 * time.Second).Round(time.Second)
+	assert.Nil(t, MockWallet.DB.ApiKey.UpdateOneID(created.ID).SetLastUsedAt(usedAt).Exec(MockWallet.Ctx))
+	_, err = MockWallet.ApiKeyVerify(key)
+	assert.Nil(t, err)
+	stored, err := MockWallet.DB.ApiKey.Get(MockWallet.Ctx, created.ID)
+	assert.Nil(t, err)
+	assert.True(t, usedAt.Equal(*stored.LastUsedAt))
+	usedAt = usedAt.Add(-time.Minute)
+	assert.Nil(t, MockWallet.DB.ApiKey.UpdateOneID(created.ID).SetLastUsedAt(usedAt).Exec(MockWallet.Ctx))
+	_, err = MockWallet.ApiKeyVerify(key)
+	assert.Nil(t, err)
+	stored, err = MockWallet.DB.ApiKey.Get(MockWallet.Ctx, created.ID)
+	assert.Nil(t, err)
+	assert.True(t, stored.LastUsedAt.After(usedAt.Add(time.Minute)))
+
+	assert.False(t, created.Approver)
+	approver, err := MockWallet.ApiKeySetApprover(created.ID.String(), true)
+	assert.Nil(t, err)
+	assert.True(t, approver.Approver)
+	_, err = MockWallet.ApiKeySetApprover("notauuid", true)
+	assert.ErrorIs(t, err, ErrApiKeyNotFound)
+
+	assert.ErrorIs(t, MockWallet.ApiKeyRevoke("notauuid"), ErrApiKeyNotFound)
+	assert.Nil(t, MockWallet.ApiKeyRevoke(created.ID.String()))
+	assert.ErrorIs(t, MockWallet.ApiKeyRevoke(created.ID.String()), ErrApiKeyNotFound)
+	_, err = MockWallet.ApiKeyVerify(key)
+	assert.ErrorIs(t, err, ErrApiKeyNotFound)
+}
+
+func TestApiKeyScopeAllows(t *testing.T) {
+	assert.True(t, ApiKeyScopeAllows(apikey.ScopeRead, apikey.ScopeRead))
+	assert.False(t, ApiKeyScopeAllows(apikey.ScopeRead, apikey.ScopeSend))
+	assert.True(t, ApiKeyScopeAllows(apikey.ScopeSend, apikey.ScopeRead))
+	assert.False(t, ApiKeyScopeAllows(apikey.ScopeSend, apikey.ScopeAdmin))
+	assert.True(t, ApiKeyScopeAllows(apikey.ScopeAdmin, apikey.ScopeSend))
+	assert.False(t, ApiKeyScopeAllows(apikey.Scope(""), apikey.ScopeRead))
+}
@@ -0,0 +1,53 @@
+package wallet
+
+import (
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/auditrecord"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+// The audit table, who made every state-changing request, with what and how it went
+// Records are only ever inserted, nothing in Pippin updates or deletes them
+
+func (w *NanoWallet) AuditRecordCreate(record models.AuditRecord) (*ent.AuditRecord, error) {
+	return w.DB.AuditRecord.Create().
+		SetAction(record.Action).
+		SetNillableWallet(record.Wallet).
+		SetIP(record.IP).
+		SetNillableAPIKeyID(record.ApiKeyID).
+		SetNillableAPIKeyName(record.ApiKeyName).
+		SetParams(record.Params).
+		SetStatus(record.Status).
+		SetNillableErrorCode(record.ErrorCode).
+		Save(w.Ctx)
+}
+
+// The records filter matches, newest first
+func (w *NanoWallet) AuditRecords(filter models.AuditFilter) ([]*ent.AuditRecord, error) {
+	if filter.Start != nil && filter.End != nil && !filter.End.After(*filter.Start) {
+		return nil, ErrInvalidDateRange
+	}
+
+	where := []predicate.AuditRecord{}
+	if filter.Wallet != "" {
+		where = append(where, auditrecord.Wallet(filter.Wallet))
+	}
+	if filter.Action != "" {
+		where = append(where, auditrecord.Action(filter.Action))
+	}
+	if filter.Start != nil {
+		where = append(where, auditrecord.CreatedAtGTE(*filter.Start))
+	}
+	if filter.End != nil {
+		where = append(where, auditrecord.CreatedAtLT(*filter.End))
+	}
+
+	query := w.DB.AuditRecord.Query().
+		Where(where...).
+		Order(ent.Desc(auditrecord.FieldCreatedAt))
+	if filter.Count > 0 {
+		query = query.Limit(filter.Count)
+	}
+	return query.All(w.Ctx)
+}
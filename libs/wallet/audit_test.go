@@ -0,0 +1,67 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditRecords(t *testing.T) {
+	wallet := uuid.New().String()
+	keyID := uuid.New()
+	keyName := "sender"
+	errorCode := "INSUFFICIENT_BALANCE"
+	start := time.Now()
+
+	_, err := MockWallet.AuditRecordCreate(models.AuditRecord{
+		Action: "receive",
+		Wallet: &wallet,
+		IP:     "10.0.0.1",
+		Params: map[string]interface{}{"action": "receive", "wallet": wallet, "block": "A1"},
+		Status: 200,
+	})
+	assert.Nil(t, err)
+	record, err := MockWallet.AuditRecordCreate(models.AuditRecord{
+		Action:     "send",
+		Wallet:     &wallet,
+		IP:         "10.0.0.2",
+		ApiKeyID:   &keyID,
+		ApiKeyName: &keyName,
+		Params:     map[string]interface{}{"action": "send", "wallet": wallet, "amount": "1"},
+		Status:     400,
+		ErrorCode:  &errorCode,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, keyID, *record.APIKeyID)
+	assert.Equal(t, "1", record.Params["amount"])
+
+	// Newest first
+	records, err := MockWallet.AuditRecords(models.AuditFilter{Wallet: wallet})
+	assert.Nil(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "send", records[0].Action)
+	assert.Equal(t, errorCode, *records[0].ErrorCode)
+	assert.Nil(t, records[1].APIKeyID)
+
+	records, err = MockWallet.AuditRecords(models.AuditFilter{Wallet: wallet, Count: 1})
+	assert.Nil(t, err)
+	assert.Len(t, records, 1)
+	records, err = MockWallet.AuditRecords(models.AuditFilter{Wallet: wallet, Action: "receive"})
+	assert.Nil(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "10.0.0.1", records[0].IP)
+
+	future := start.Add(time.Hour)
+	records, err = MockWallet.AuditRecords(models.AuditFilter{Wallet: wallet, Start: &future})
+	assert.Nil(t, err)
+	assert.Empty(t, records)
+	past := start.Add(-time.Hour)
+	records, err = MockWallet.AuditRecords(models.AuditFilter{Wallet: wallet, Start: &past, End: &future})
+	assert.Nil(t, err)
+	assert.Len(t, records, 2)
+	_, err = MockWallet.AuditRecords(models.AuditFilter{Start: &future, End: &past})
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+}
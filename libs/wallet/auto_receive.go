@@ -0,0 +1,127 @@
+package wallet
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	entwallet "github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+var ErrInvalidReceiveMinimum = errors.New("invalid receive minimum")
+
+// Pending blocks of at least the wallet's receive minimum are received without asking
+// Confirmations from the node websocket are received as they arrive, with auto_receive_interval the accounts are also polled for anything that was missed
+// Wallets that have auto receive turned off, are locked, frozen or watch-only are skipped, and so are watch-only accounts
+// Hardware wallets are skipped too, every receive has to be confirmed on the Ledger
+
+// Receive minimums can't be more than the max supply
+var maxSupply, _ = big.NewInt(0).SetString("133248290000000000000000000000000000000", 10)
+
+// The smallest amount received for the wallet, its own receive minimum or the config's receive_minimum
+func (w *NanoWallet) ReceiveMinimum(wallet *ent.Wallet) *big.Int {
+	if wallet != nil && wallet.ReceiveMinimum != nil {
+		if minimum, ok := big.NewInt(0).SetString(*wallet.ReceiveMinimum, 10); ok {
+			return minimum
+		}
+	}
+	minimum, ok := big.NewInt(0).SetString(w.liveConfig().Wallet.ReceiveMinimum, 10)
+	if !ok {
+		return big.NewInt(1)
+	}
+	return minimum
+}
+
+// Set the wallet's receive minimum in raw, between 1 and the max supply
+func (w *NanoWallet) SetReceiveMinimum(wallet *ent.Wallet, amount string) (*ent.Wallet, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	minimum, ok := big.NewInt(0).SetString(amount, 10)
+	if !ok || minimum.Sign() < 1 || minimum.Cmp(maxSupply) > 0 {
+		return nil, ErrInvalidReceiveMinimum
+	}
+	return w.DB.Wallet.UpdateOne(wallet).SetReceiveMinimum(minimum.String()).Save(w.Ctx)
+}
+
+// Turn receiving the wallet's pending blocks in the background on or off
+func (w *NanoWallet) SetAutoReceive(wallet *ent.Wallet, enabled bool) (*ent.Wallet, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	return w.DB.Wallet.UpdateOne(wallet).SetAutoReceive(enabled).Save(w.Ctx)
+}
+
+// Whether a block of amount sent to the wallet is received automatically
+func (w *NanoWallet) ShouldAutoReceive(wallet *ent.Wallet, amount *big.Int) bool {
+	if wallet == nil || !wallet.AutoReceive || wallet.WatchOnly || wallet.Hardware || wallet.FrozenAt != nil {
+		return false
+	}
+	return amount.Cmp(w.ReceiveMinimum(wallet)) >= 0
+}
+
+// Receive the pending blocks of every wallet with auto receive on, returns how many were received
+func (w *NanoWallet) AutoReceive() (int, error) {
+	wallets, err := w.DB.Wallet.Query().Where(entwallet.AutoReceive(true), entwallet.WatchOnly(false), entwallet.Hardware(false), entwallet.FrozenAtIsNil()).All(w.Ctx)
+	if err != nil {
+		return 0, err
+	}
+	received := 0
+	for _, wallet := range wallets {
+		// What's left is still pending when we start again
+		if w.ShuttingDown() {
+			break
+		}
+		// Fails if the wallet is locked
+		accounts, _, err := w.AccountsList(wallet, 0)
+		if err != nil {
+			continue
+		}
+		addresses := SigningAddresses(accounts)
+		if len(addresses) < 1 {
+			continue
+		}
+		// Only the accounts with something pending are asked for their blocks over the receive minimum
+		pending, err := w.RpcClient.MakeAccountsPendingRequest(addresses)
+		if err != nil || pending.Blocks == nil {
+			log.Warnf("Unable to get pending blocks to auto receive for wallet %s %s", wallet.ID, err)
+			continue
+		}
+		for address, blocks := range *pending.Blocks {
+			if len(blocks) < 1 || w.ShuttingDown() {
+				continue
+			}
+			count, err := w.ReceiveAllBlocks(wallet, address, nil)
+			if err != nil {
+				log.Errorf("Error auto receiving for %s %s", address, err)
+			}
+			received += count
+		}
+	}
+	return received, nil
+}
+
+// Auto receive every tick until the wallet context is done or we're shutting down
+func (w *NanoWallet) StartAutoReceiver(tick time.Duration) {
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	for {
+		ran := w.Background(func() {
+			if _, err := w.AutoReceive(); err != nil {
+				log.Errorf("Error auto receiving %s", err)
+			}
+		})
+		if !ran {
+			return
+		}
+		select {
+		case <-w.Ctx.Done():
+			return
+		case <-w.stopped():
+			return
+		case <-ticker.C:
+		}
+	}
+}
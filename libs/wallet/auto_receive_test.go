@@ -0,0 +1,130 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReceiveMinimum(t *testing.T) {
+	_, err := MockWallet.SetReceiveMinimum(nil, "1")
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("7d2a5f8c1e4b7a0d3f6c9e2b5a8d1f4c7e0b3a6d9f2c5e8b1a4d7f0c3e6b9a2d"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	assert.True(t, wallet.AutoReceive)
+	assert.Equal(t, MockWallet.Config.Wallet.ReceiveMinimum, MockWallet.ReceiveMinimum(wallet).String())
+
+	for _, amount := range []string{"0", "-5", "abc", "133248290000000000000000000000000000001"} {
+		_, err = MockWallet.SetReceiveMinimum(wallet, amount)
+		assert.ErrorIs(t, err, ErrInvalidReceiveMinimum)
+	}
+	wallet, err = MockWallet.SetReceiveMinimum(wallet, "1000000000000000000000000000000")
+	assert.Nil(t, err)
+	assert.Equal(t, "1000000000000000000000000000000", MockWallet.ReceiveMinimum(wallet).String())
+
+	below, _ := big.NewInt(0).SetString("999999999999999999999999999999", 10)
+	assert.False(t, MockWallet.ShouldAutoReceive(wallet, below))
+	assert.True(t, MockWallet.ShouldAutoReceive(wallet, MockWallet.ReceiveMinimum(wallet)))
+
+	wallet, err = MockWallet.SetAutoReceive(wallet, false)
+	assert.Nil(t, err)
+	assert.False(t, MockWallet.ShouldAutoReceive(wallet, MockWallet.ReceiveMinimum(wallet)))
+}
+
+func TestReceiveMinimumReload(t *testing.T) {
+	conf := *MockWallet.Config
+	reloadWallet := &NanoWallet{DB: MockWallet.DB, Ctx: MockWallet.Ctx, Config: &conf}
+	assert.Equal(t, conf.Wallet.ReceiveMinimum, reloadWallet.ReceiveMinimum(nil).String())
+
+	// The config it was started with doesn't change
+	reloaded := conf
+	reloaded.Wallet.ReceiveMinimum = "1000"
+	reloadWallet.ApplyConfig(&reloaded)
+	assert.Equal(t, "1000", reloadWallet.ReceiveMinimum(nil).String())
+	assert.Equal(t, MockWallet.Config.Wallet.ReceiveMinimum, reloadWallet.Config.Wallet.ReceiveMinimum)
+}
+
+func TestAutoReceive(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("2f5c8e1b4d7a0c3f6e9b2d5a8c1f4e7b0d3a6c9f2e5b8d1a4c7f0e3b6d9a2c5f"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	wallet, err = MockWallet.SetReceiveMinimum(wallet, "1000000000000000000000000000000")
+	assert.Nil(t, err)
+	// Has something pending too, but auto receive is off
+	offSeed, _ := utils.GenerateSeed(strings.NewReader("8b1e4a7d0c3f6b9e2a5d8c1f4b7e0a3d6c9f2b5e8a1d4c7f0b3e6a9d2c5f8b1e"))
+	offWallet, err := MockWallet.WalletCreate(offSeed)
+	assert.Nil(t, err)
+	offAcc, err := MockWallet.AccountCreate(offWallet, nil)
+	assert.Nil(t, err)
+	_, err = MockWallet.SetAutoReceive(offWallet, false)
+	assert.Nil(t, err)
+
+	pending := "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE"
+	var published []nanoblock.StateBlock
+	var receivableAccounts []string
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "accounts_pending":
+				// Other tests' wallets are in the same database, they have nothing pending
+				found := map[string][]string{}
+				for _, address := range pr["accounts"].([]interface{}) {
+					if address == acc.Address || address == offAcc.Address {
+						found[address.(string)] = []string{pending}
+					}
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": found})
+			case "receivable":
+				receivableAccounts = append(receivableAccounts, pr["account"].(string))
+				assert.Equal(t, "1000000000000000000000000000000", pr["threshold"])
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": map[string]interface{}{pending: "1000000000000000000000000000000"}})
+			case "account_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "5",
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			case "block_info":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "process":
+				var sb nanoblock.StateBlock
+				block, _ := json.Marshal(pr["block"])
+				json.Unmarshal(block, &sb)
+				published = append(published, sb)
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%X", sb.Hash()),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	received, err := MockWallet.AutoReceive()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, received)
+	assert.Equal(t, []string{acc.Address}, receivableAccounts)
+	assert.Len(t, published, 1)
+	assert.Equal(t, pending, published[0].Link)
+}
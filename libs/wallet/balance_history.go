@@ -0,0 +1,152 @@
+package wallet
+
+import (
+	"errors"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+var ErrInvalidPeriod = errors.New("invalid period")
+var ErrInvalidDateRange = errors.New("invalid date range")
+
+// Balance history, the confirmed balance of every account is recorded periodically
+// History is returned per hour or day, with the last balance recorded in each
+
+const (
+	PeriodHourly = "hourly"
+	PeriodDaily  = "daily"
+)
+
+// Accounts per accounts_balances call
+const balanceSnapshotBatchSize = 1000
+
+// Record the balance of every account, returns the number of snapshots recorded
+// Accounts the node doesn't have a balance for are unopened, they're recorded with a balance of 0
+func (w *NanoWallet) RecordBalanceSnapshots(now time.Time) (int, error) {
+	// Only one instance records at a time
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, "balance_snapshots", time.Second*300, nil)
+	if err != nil {
+		return 0, nil
+	}
+	defer lock.Release(w.Ctx)
+
+	accounts, err := w.DB.Account.Query().All(w.Ctx)
+	if err != nil || len(accounts) == 0 {
+		return 0, err
+	}
+
+	recorded := 0
+	for start := 0; start < len(accounts); start += balanceSnapshotBatchSize {
+		batch := accounts[start:min(start+balanceSnapshotBatchSize, len(accounts))]
+		addresses := make([]string, len(batch))
+		for i, acct := range batch {
+			addresses[i] = acct.Address
+		}
+		balancesResp, err := w.RpcClient.MakeAccountsBalancesRequest(addresses)
+		if err != nil {
+			return recorded, err
+		}
+		balances := map[string]string{}
+		if balancesResp.Balances != nil {
+			for address, item := range *balancesResp.Balances {
+				balances[address] = item.Balance
+			}
+		}
+
+		creates := make([]*ent.BalanceSnapshotCreate, len(batch))
+		for i, acct := range batch {
+			balance, ok := balances[acct.Address]
+			if !ok || balance == "" {
+				balance = "0"
+			}
+			creates[i] = w.DB.BalanceSnapshot.Create().SetAccountID(acct.ID).SetBalanceRaw(balance).SetSnapshotAt(now)
+		}
+		if _, err := w.DB.BalanceSnapshot.CreateBulk(creates...).Save(w.Ctx); err != nil {
+			return recorded, err
+		}
+		recorded += len(batch)
+	}
+
+	return recorded, nil
+}
+
+// Record balance snapshots every tick until the wallet context is done
+// If clock is nil the system clock is used
+func (w *NanoWallet) StartBalanceSnapshotter(clock Clock, tick time.Duration) {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.Ctx.Done():
+			return
+		case <-w.stopped():
+			return
+		case <-ticker.C:
+			w.Background(func() { w.RecordBalanceSnapshots(clock.Now()) })
+		}
+	}
+}
+
+// The balance history of an account in the wallet, from start up to but not including end, oldest first
+// Periods without a snapshot are left out
+func (w *NanoWallet) AccountBalanceHistory(wallet *ent.Wallet, address string, period string, start time.Time, end time.Time) ([]models.BalanceHistoryEntry, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	if period != PeriodHourly && period != PeriodDaily {
+		return nil, ErrInvalidPeriod
+	}
+	if !end.After(start) {
+		return nil, ErrInvalidDateRange
+	}
+
+	// Account must be in this wallet, this also fails if the wallet is locked
+	acc, err := w.GetAccount(wallet, address)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := w.DB.BalanceSnapshot.Query().
+		Where(
+			balancesnapshot.AccountID(acc.ID),
+			balancesnapshot.SnapshotAtGTE(start),
+			balancesnapshot.SnapshotAtLT(end),
+		).
+		Order(ent.Asc(balancesnapshot.FieldSnapshotAt)).
+		All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	history := []models.BalanceHistoryEntry{}
+	for _, snapshot := range snapshots {
+		periodStart := periodStartOf(snapshot.SnapshotAt, period)
+		// Ordered by time, so a later snapshot in the same period replaces the last entry
+		if len(history) > 0 && history[len(history)-1].PeriodStart.Equal(periodStart) {
+			history = history[:len(history)-1]
+		}
+		history = append(history, models.BalanceHistoryEntry{
+			PeriodStart: periodStart,
+			BalanceRaw:  snapshot.BalanceRaw,
+			SnapshotAt:  snapshot.SnapshotAt,
+		})
+	}
+
+	return history, nil
+}
+
+// Start of the hour or day t is in, in UTC
+func periodStartOf(t time.Time, period string) time.Time {
+	t = t.UTC()
+	if period == PeriodDaily {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(time.Hour)
+}
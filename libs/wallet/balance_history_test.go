@@ -0,0 +1,115 @@
+package wallet
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/balancesnapshot"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordBalanceSnapshots(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("a4d7b0e3c6f9a2d5b8e1c4f7a0d3b6e9c2f5a8d1b4e7c0f3a6d9b2e5c8f1a4d7"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	opened, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	unopened, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var ar requests.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&ar)
+			resp := map[string]interface{}{}
+			for _, account := range ar.Accounts {
+				if account != unopened.Address {
+					resp[account] = map[string]interface{}{"balance": "1000", "pending": "5", "receivable": "5"}
+				}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"balances": resp,
+			})
+		},
+	)
+
+	now := time.Unix(1700000000, 0)
+	recorded, err := MockWallet.RecordBalanceSnapshots(now)
+	assert.Nil(t, err)
+	accounts, _ := MockWallet.DB.Account.Query().Count(MockWallet.Ctx)
+	assert.Equal(t, accounts, recorded)
+
+	snapshot, err := MockWallet.DB.BalanceSnapshot.Query().Where(balancesnapshot.AccountID(opened.ID)).Only(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "1000", snapshot.BalanceRaw)
+	assert.True(t, now.Equal(snapshot.SnapshotAt))
+	snapshot, err = MockWallet.DB.BalanceSnapshot.Query().Where(balancesnapshot.AccountID(unopened.ID)).Only(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "0", snapshot.BalanceRaw)
+}
+
+func TestAccountBalanceHistory(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("d7b0e3c6f9a2d5b8e1c4f7a0d3b6e9c2f5a8d1b4e7c0f3a6d9b2e5c8f1a4d7b0"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	for _, snapshot := range []struct {
+		at      time.Time
+		balance string
+	}{
+		{day.Add(10 * time.Minute), "1"},
+		{day.Add(50 * time.Minute), "2"},
+		{day.Add(2*time.Hour + 5*time.Minute), "3"},
+		{day.Add(26 * time.Hour), "4"},
+		{day.Add(47 * time.Hour), "5"},
+		// Outside the range
+		{day.Add(-time.Minute), "6"},
+		{day.Add(48 * time.Hour), "7"},
+	} {
+		_, err := MockWallet.DB.BalanceSnapshot.Create().SetAccountID(acc.ID).SetBalanceRaw(snapshot.balance).SetSnapshotAt(snapshot.at).Save(MockWallet.Ctx)
+		assert.Nil(t, err)
+	}
+	end := day.Add(48 * time.Hour)
+
+	history, err := MockWallet.AccountBalanceHistory(wallet, acc.Address, PeriodHourly, day, end)
+	assert.Nil(t, err)
+	assert.Len(t, history, 4)
+	assert.Equal(t, models.BalanceHistoryEntry{PeriodStart: day, BalanceRaw: "2", SnapshotAt: day.Add(50 * time.Minute)}, history[0])
+	assert.True(t, day.Add(2*time.Hour).Equal(history[1].PeriodStart))
+	assert.Equal(t, "3", history[1].BalanceRaw)
+	assert.True(t, day.Add(26*time.Hour).Equal(history[2].PeriodStart))
+	assert.True(t, day.Add(47*time.Hour).Equal(history[3].PeriodStart))
+
+	history, err = MockWallet.AccountBalanceHistory(wallet, acc.Address, PeriodDaily, day, end)
+	assert.Nil(t, err)
+	assert.Len(t, history, 2)
+	assert.True(t, day.Equal(history[0].PeriodStart))
+	assert.Equal(t, "3", history[0].BalanceRaw)
+	assert.True(t, day.Add(24*time.Hour).Equal(history[1].PeriodStart))
+	assert.Equal(t, "5", history[1].BalanceRaw)
+
+	// Nothing in the range
+	history, err = MockWallet.AccountBalanceHistory(wallet, acc.Address, PeriodDaily, end.Add(time.Hour), end.Add(2*time.Hour))
+	assert.Nil(t, err)
+	assert.Len(t, history, 0)
+
+	_, err = MockWallet.AccountBalanceHistory(wallet, acc.Address, "weekly", day, end)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+	_, err = MockWallet.AccountBalanceHistory(wallet, acc.Address, PeriodDaily, end, day)
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+	_, err = MockWallet.AccountBalanceHistory(wallet, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", PeriodDaily, day, end)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+}
@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+)
+
+// A block built like it would be published, nothing was published or saved
+type BlockPreview struct {
+	Block   *nanoblock.StateBlock
+	Hash    string
+	Subtype string
+	// Of the account once the block is published
+	Balance string
+	// Sent or received, empty for a change
+	Amount string
+	// The threshold the work has to reach, the block's work is only set if it was given or already prefetched
+	Difficulty string
+	// Blocks of hardware wallets aren't signed
+	Signed bool
+}
+
+func (w *NanoWallet) blockPreview(sb *nanoblock.StateBlock, subtype string, amount string, difficulty int) *BlockPreview {
+	hash := sb.Hash()
+	return &BlockPreview{
+		Block:      sb,
+		Hash:       strings.ToUpper(hex.EncodeToString(hash[:])),
+		Subtype:    subtype,
+		Balance:    sb.Balance,
+		Amount:     amount,
+		Difficulty: pow.DifficultyToString(pow.DifficultyFromMultiplier(difficulty)),
+		Signed:     sb.Signature != "",
+	}
+}
+
+// The send block CreateAndPublishSendBlock would publish, the balance has to be there already, nothing is received for it
+func (w *NanoWallet) PreviewSendBlock(wallet *ent.Wallet, amount string, source string, destination string, work *string) (*BlockPreview, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	acc, err := w.GetAccount(wallet, source)
+	if err != nil {
+		return nil, err
+	}
+	sb, err := w.createSendBlock(wallet, acc, amount, destination, work, nil, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	return w.blockPreview(sb, "send", amount, w.sendDifficulty()), nil
+}
+
+// The block CreateAndPublishReceiveBlock would publish to receive hash
+func (w *NanoWallet) PreviewReceiveBlock(wallet *ent.Wallet, source string, hash string, work *string) (*BlockPreview, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	acc, err := w.GetAccount(wallet, source)
+	if err != nil {
+		return nil, err
+	}
+	sb, amount, err := w.createReceiveBlock(wallet, acc, hash, work, nil, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	return w.blockPreview(sb, "receive", amount, pow.ReceiveMultiplier(w.Config.Wallet.Banano)), nil
+}
+
+// The change block CreateAndPublishChangeBlock would publish
+func (w *NanoWallet) PreviewChangeBlock(wallet *ent.Wallet, address string, representative string, work *string) (*BlockPreview, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	acc, err := w.GetAccount(wallet, address)
+	if err != nil {
+		return nil, err
+	}
+	sb, _, err := w.createChangeBlock(wallet, acc, representative, work, nil, false, true)
+	if err != nil {
+		return nil, err
+	}
+	return w.blockPreview(sb, "change", "", w.sendDifficulty()), nil
+}
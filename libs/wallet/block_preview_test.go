@@ -0,0 +1,92 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockPreview(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// Anything but block_info and account_info would be publishing or receiving
+	var unexpected []string
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			var js map[string]interface{}
+			if pr.Action == "block_info" {
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "account_info" {
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			}
+			unexpected = append(unexpected, pr.Action)
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+
+	_, err := MockWallet.PreviewSendBlock(nil, "1", "", "", nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("d3a6c9f2e5b8d1a4c7f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e7b0d3a6"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	wallet.Representative = utils.ToPtr("nano_1x7biz69cem95oo7gxkrw6kzhfywq4x5dupw4z1bdzkb74dk9kpxwzjbdhhs")
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	preview, err := MockWallet.PreviewSendBlock(wallet, "1", acc.Address, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "send", preview.Subtype)
+	assert.Equal(t, "1", preview.Amount)
+	assert.Equal(t, "11999999999999999918751838129509869130", preview.Balance)
+	assert.Equal(t, preview.Balance, preview.Block.Balance)
+	assert.Equal(t, "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", preview.Block.Previous)
+	assert.Equal(t, "fffffff800000000", preview.Difficulty)
+	// No work was generated, but it's signed
+	assert.Equal(t, "", preview.Block.Work)
+	assert.True(t, preview.Signed)
+	assert.NotEqual(t, "", preview.Block.Signature)
+	hash := preview.Block.Hash()
+	assert.Equal(t, strings.ToUpper(hex.EncodeToString(hash[:])), preview.Hash)
+
+	// Given work is kept
+	work := "0000000000000000"
+	preview, err = MockWallet.PreviewSendBlock(wallet, "1", acc.Address, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", &work)
+	assert.Nil(t, err)
+	assert.Equal(t, work, preview.Block.Work)
+
+	// Nothing is received to make up for it
+	_, err = MockWallet.PreviewSendBlock(wallet, "11999999999999999918751838129509869132", acc.Address, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", nil)
+	assert.ErrorIs(t, err, ErrInsufficientBalance)
+
+	preview, err = MockWallet.PreviewReceiveBlock(wallet, acc.Address, "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "receive", preview.Subtype)
+	assert.Equal(t, "30000000000000000000000000000000000", preview.Amount)
+	assert.Equal(t, "12029999999999999918751838129509869131", preview.Balance)
+	assert.Equal(t, "fffffe0000000000", preview.Difficulty)
+	assert.Equal(t, "", preview.Block.Work)
+
+	preview, err = MockWallet.PreviewChangeBlock(wallet, acc.Address, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "change", preview.Subtype)
+	assert.Equal(t, "", preview.Amount)
+	assert.Equal(t, "11999999999999999918751838129509869131", preview.Balance)
+	assert.Equal(t, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", preview.Block.Representative)
+	assert.Equal(t, "fffffff800000000", preview.Difficulty)
+
+	assert.Empty(t, unexpected)
+}
@@ -12,6 +12,11 @@ import (
 	"github.com/appditto/pippin_nano_wallet/libs/database"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
 	entblock "github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/nano"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
 	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
 	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
@@ -23,6 +28,7 @@ import (
 var ErrBlockNotFound = errors.New("block not found")
 var ErrInsufficientBalance = errors.New("insufficient balance")
 var ErrSameRepresentative = errors.New("same representative")
+var ErrWorkDifficultyTooLow = errors.New("work difficulty is below the threshold of the block")
 
 // The core that creates and publishes send, receive, and change blocks
 // See: https://docs.nano.org/protocol-design/blocks/
@@ -60,18 +66,47 @@ func (w *NanoWallet) GetBlockFromDatabase(wallet *ent.Wallet, address string, se
 	return block, nil
 }
 
+// The send block made with id by any account of the wallet, ids are unique per wallet
+func (w *NanoWallet) sendBlockForID(wallet *ent.Wallet, id string) (*ent.Block, error) {
+	block, err := w.DB.Block.Query().Where(entblock.WalletID(wallet.ID), entblock.SendID(id)).First(database.WithPrimary(w.Ctx))
+	if ent.IsNotFound(err) {
+		return nil, ErrBlockNotFound
+	}
+	return block, err
+}
+
+// The multiplier to generate work for and whether a request asked for it, the block's threshold unless workMultiplier is set
+// A block with work below its threshold isn't accepted by the node
+func workThreshold(threshold int, workMultiplier int) (int, bool, error) {
+	if workMultiplier == 0 {
+		return threshold, false, nil
+	} else if workMultiplier < threshold {
+		return 0, false, ErrWorkDifficultyTooLow
+	}
+	return workMultiplier, true, nil
+}
+
 // ** Low level block creations, not intended for use by the user **
-func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Account, hash string, precomputedWork *string, bpowKey *string) (*models.StateBlock, error) {
+// The receive block and the amount it receives
+// With preview no work is generated, and blocks of hardware wallets aren't signed
+// workMultiplier is the work threshold a request asked for, 0 for the block's own raised for the network
+func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Account, hash string, precomputedWork *string, bpowKey *string, workMultiplier int, preview bool) (*nanoblock.StateBlock, string, error) {
 	if wallet == nil {
-		return nil, ErrInvalidWallet
+		return nil, "", ErrInvalidWallet
 	} else if receiver == nil {
-		return nil, ErrInvalidAccount
+		return nil, "", ErrInvalidAccount
+	} else if wallet.WatchOnly {
+		return nil, "", ErrWalletWatchOnly
+	} else if receiver.WatchOnly {
+		return nil, "", ErrNoPrivateKey
+	} else if wallet.FrozenAt != nil {
+		return nil, "", ErrWalletFrozen
 	}
 	blockInfo, err := w.RpcClient.MakeBlockInfoRequest(hash)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	} else if blockInfo == nil {
-		return nil, ErrBlockNotFound
+		return nil, "", ErrBlockNotFound
 	}
 	// Get account info
 	isOpen := true
@@ -79,7 +114,7 @@ func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Accoun
 	if errors.Is(err, nanorpc.ErrAccountNotFound) {
 		isOpen = false
 	} else if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	var workbase string
@@ -88,7 +123,7 @@ func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Accoun
 	} else {
 		pub, err := utils.AddressToPub(receiver.Address, w.Config.Wallet.Banano)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		workbase = hex.EncodeToString(pub)
 	}
@@ -111,7 +146,7 @@ func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Accoun
 		} else {
 			rep, err := w.Config.GetRandomRep()
 			if err != nil {
-				return nil, err
+				return nil, "", err
 			}
 			representative = rep
 		}
@@ -120,33 +155,39 @@ func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Accoun
 	var balance *big.Int
 	receiveAmount, ok := big.NewInt(0).SetString(blockInfo.Amount, 10)
 	if !ok {
-		return nil, errors.New("Unable to parse balance")
+		return nil, "", errors.New("Unable to parse balance")
 	}
 	if !isOpen {
 		balance = receiveAmount
 	} else {
 		currentBalance, ok := big.NewInt(0).SetString(accountInfo.Balance, 10)
 		if !ok {
-			return nil, errors.New("Unable to parse balance")
+			return nil, "", errors.New("Unable to parse balance")
 		}
 		balance = big.NewInt(0).Add(receiveAmount, currentBalance)
 	}
 
+	difficulty, requested, err := workThreshold(pow.ReceiveMultiplier(w.Config.Wallet.Banano), workMultiplier)
+	if err != nil {
+		return nil, "", err
+	}
 	var work string
-	if precomputedWork == nil {
+	if precomputedWork != nil {
+		work = *precomputedWork
+	} else if prefetched, ok := w.prefetchedWork(receiver.Address, workbase, difficulty); ok {
+		work = prefetched
+	} else if !preview {
 		key := ""
 		if bpowKey != nil {
 			key = *bpowKey
 		}
-		work, err = w.WorkClient.WorkGenerateMeta(workbase, 1, true, false, key)
+		work, err = w.generateWork(wallet.ID, receiver.Address, nil, workbase, difficulty, requested, key)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
-	} else {
-		work = *precomputedWork
 	}
 
-	stateBlock := &models.StateBlock{
+	stateBlock := &nanoblock.StateBlock{
 		Type:           "state",
 		Account:        receiver.Address,
 		Previous:       previous,
@@ -157,78 +198,97 @@ func (w *NanoWallet) createReceiveBlock(wallet *ent.Wallet, receiver *ent.Accoun
 		Banano:         w.Config.Wallet.Banano,
 	}
 
-	// Get the private key for this account
-	var priv ed25519.PrivateKey
-	if receiver.PrivateKey != nil {
-		decoded, err := hex.DecodeString(*receiver.PrivateKey)
-		if err != nil {
-			return nil, err
-		}
-		priv = ed25519.PrivateKey(decoded)
-	} else {
-		sd, err := GetDecryptedKeyFromStorage(wallet, "seed")
-		if err != nil {
-			return nil, err
-		}
-		_, priv, _ = utils.KeypairFromSeed(sd, uint32(*receiver.AccountIndex))
-	}
-
-	// Sign the block
-	err = stateBlock.Sign(priv)
-	if err != nil {
-		return nil, err
+	if err := w.signBlock(wallet, receiver, stateBlock, preview); err != nil {
+		return nil, "", err
 	}
 
-	return stateBlock, nil
+	return stateBlock, receiveAmount.String(), nil
 }
 
 // Receive all without locking the wallet
 func (w *NanoWallet) receiveAll(wallet *ent.Wallet, acc *ent.Account, bpowKey *string) (int, error) {
+	hashes, err := w.receiveAllHashes(wallet, acc, bpowKey)
+	return len(hashes), err
+}
+
+// Like receiveAll, but returns the hashes of the receive blocks that were published
+func (w *NanoWallet) receiveAllHashes(wallet *ent.Wallet, acc *ent.Account, bpowKey *string) ([]string, error) {
 	if wallet == nil {
-		return 0, ErrInvalidWallet
+		return nil, ErrInvalidWallet
 	} else if acc == nil {
-		return 0, ErrInvalidAccount
+		return nil, ErrInvalidAccount
 	}
-	receivedCount := 0
+	hashes := []string{}
 	// Get pending
-	pending, err := w.RpcClient.MakeReceivableRequest(acc.Address, w.Config.Wallet.ReceiveMinimum)
+	pending, err := w.RpcClient.MakeReceivableRequest(acc.Address, w.ReceiveMinimum(wallet).String())
 	if err != nil {
-		return receivedCount, err
+		return hashes, err
 	}
 	if len(pending.Blocks) == 0 {
-		return receivedCount, nil
+		return hashes, nil
 	}
 
 	// Create and publish blocks
 	for hash := range pending.Blocks {
-		sb, err := w.createReceiveBlock(wallet, acc, hash, nil, bpowKey)
-		if err != nil {
-			return receivedCount, err
+		receiveHash, err := w.publishReceive(wallet, acc, hash, nil, bpowKey, 0)
+		if err != nil || receiveHash == "" {
+			return hashes, err
 		}
+		hashes = append(hashes, receiveHash)
+	}
+	return hashes, nil
+}
 
-		// Publish block
-		subtype := "receive"
-		resp, err := w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
-			BaseRequest: requests.BaseRequest{
-				Action: "process",
-			},
-			Subtype:   &subtype,
-			JsonBlock: true,
-			Block:     *sb,
-		})
-		if err != nil || !utils.Validate64HexHash(resp.Hash) {
-			return receivedCount, err
-		}
-		receivedCount++
+// Create and publish the block receiving hash, the caller holds the account lock
+// The hash is empty if the node didn't return a valid one
+func (w *NanoWallet) publishReceive(wallet *ent.Wallet, acc *ent.Account, hash string, work *string, bpowKey *string, workMultiplier int) (string, error) {
+	sb, amount, err := w.createReceiveBlock(wallet, acc, hash, work, bpowKey, workMultiplier, false)
+	if err != nil {
+		return "", err
+	}
+
+	// Publish block
+	subtype := "receive"
+	resp, err := w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "process",
+		},
+		Subtype:   &subtype,
+		JsonBlock: true,
+		Block:     *sb,
+	})
+	if err != nil || !utils.Validate64HexHash(resp.Hash) {
+		// Our frontier may be out of date, e.g. a fork, so get it from the node next time
+		w.frontiers().Invalidate(acc.Address)
+		return "", err
 	}
-	return receivedCount, nil
+	w.frontiers().Set(acc.Address, resp.Hash, sb.Balance)
+	w.precacheNextWork(wallet, acc.Address, resp.Hash)
+	w.publishEvent(models.WalletEvent{
+		Event:   models.WalletEventPocketed,
+		Wallet:  wallet.ID.String(),
+		Account: acc.Address,
+		Hash:    resp.Hash,
+		Amount:  amount,
+		Source:  hash,
+	})
+	w.notifyReceive(wallet, acc, resp.Hash, hash, amount, sb.Balance)
+	return resp.Hash, nil
 }
 
-func (w *NanoWallet) createSendBlock(wallet *ent.Wallet, sender *ent.Account, amount string, destination string, precomputedWork *string, bpowKey *string) (*models.StateBlock, error) {
+// With preview nothing is received to make up the balance, no work is generated, and blocks of hardware wallets aren't signed
+// workMultiplier is the work threshold a request asked for, 0 for the block's own raised for the network
+func (w *NanoWallet) createSendBlock(wallet *ent.Wallet, sender *ent.Account, amount string, destination string, precomputedWork *string, bpowKey *string, workMultiplier int, preview bool) (*nanoblock.StateBlock, error) {
 	if wallet == nil {
 		return nil, ErrInvalidWallet
 	} else if sender == nil {
 		return nil, ErrInvalidAccount
+	} else if wallet.WatchOnly {
+		return nil, ErrWalletWatchOnly
+	} else if sender.WatchOnly {
+		return nil, ErrNoPrivateKey
+	} else if wallet.FrozenAt != nil {
+		return nil, ErrWalletFrozen
 	}
 
 	sendAmount, ok := big.NewInt(0).SetString(amount, 10)
@@ -236,10 +296,10 @@ func (w *NanoWallet) createSendBlock(wallet *ent.Wallet, sender *ent.Account, am
 		return nil, errors.New("Unable to parse send amount")
 	}
 
-	// Get account info
-	accountInfo, err := w.RpcClient.MakeAccountInfoRequest(sender.Address)
+	// Get account info, the frontier cache has it if we published the last block for this account
+	accountInfo, err := w.accountFrontier(sender.Address)
 	if errors.Is(err, nanorpc.ErrAccountNotFound) {
-		if w.Config.Wallet.AutoReceiveOnSend == nil || !*w.Config.Wallet.AutoReceiveOnSend {
+		if preview || w.Config.Wallet.AutoReceiveOnSend == nil || !*w.Config.Wallet.AutoReceiveOnSend {
 			return nil, ErrInsufficientBalance
 		}
 		// See if account has a pending balance to open the accountt
@@ -278,7 +338,7 @@ func (w *NanoWallet) createSendBlock(wallet *ent.Wallet, sender *ent.Account, am
 
 	// Check if balance is sufficient
 	if sendAmount.Cmp(balanceBigInt) > 0 {
-		if w.Config.Wallet.AutoReceiveOnSend == nil || !*w.Config.Wallet.AutoReceiveOnSend {
+		if preview || w.Config.Wallet.AutoReceiveOnSend == nil || !*w.Config.Wallet.AutoReceiveOnSend {
 			return nil, ErrInsufficientBalance
 		}
 		// Automatically receive blocks to see if we can make up the difference
@@ -320,31 +380,33 @@ func (w *NanoWallet) createSendBlock(wallet *ent.Wallet, sender *ent.Account, am
 	// Calculate new balance, subtracing sendAmount from balanceBigInt
 	newBalance := balanceBigInt.Sub(balanceBigInt, sendAmount)
 
+	difficulty, requested, err := workThreshold(pow.SendMultiplier(w.Config.Wallet.Banano), workMultiplier)
+	if err != nil {
+		return nil, err
+	}
 	var work string
-	if precomputedWork == nil {
+	if precomputedWork != nil {
+		work = *precomputedWork
+	} else if prefetched, ok := w.prefetchedWork(sender.Address, workbase, difficulty); ok {
+		work = prefetched
+	} else if !preview {
 		key := ""
 		if bpowKey != nil {
 			key = *bpowKey
 		}
-		difficulty := 1
-		if !w.Config.Wallet.Banano {
-			difficulty = 64
-		}
-		work, err = w.WorkClient.WorkGenerateMeta(workbase, difficulty, true, false, key)
+		work, err = w.generateWork(wallet.ID, sender.Address, sendAmount, workbase, difficulty, requested, key)
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		work = *precomputedWork
 	}
 
 	// Link is pubkey of destination
-	link, err := utils.AddressToPub(destination, w.Config.Wallet.Banano)
+	link, err := nano.ValidateAddress(destination, w.Config.Wallet.Banano)
 	if err != nil {
 		return nil, errors.New("Invalid destination address")
 	}
 
-	stateBlock := &models.StateBlock{
+	stateBlock := &nanoblock.StateBlock{
 		Type:           "state",
 		Account:        sender.Address,
 		Previous:       previous,
@@ -355,46 +417,36 @@ func (w *NanoWallet) createSendBlock(wallet *ent.Wallet, sender *ent.Account, am
 		Banano:         w.Config.Wallet.Banano,
 	}
 
-	// Get the private key for this account
-	var priv ed25519.PrivateKey
-	if sender.PrivateKey != nil {
-		decoded, err := hex.DecodeString(*sender.PrivateKey)
-		if err != nil {
-			return nil, err
-		}
-		priv = ed25519.PrivateKey(decoded)
-	} else {
-		sd, err := GetDecryptedKeyFromStorage(wallet, "seed")
-		if err != nil {
-			return nil, err
-		}
-		_, priv, _ = utils.KeypairFromSeed(sd, uint32(*sender.AccountIndex))
-	}
-
-	// Sign the block
-	err = stateBlock.Sign(priv)
-	if err != nil {
+	if err := w.signBlock(wallet, sender, stateBlock, preview); err != nil {
 		return nil, err
 	}
 
 	return stateBlock, nil
 }
 
-func (w *NanoWallet) createChangeBlock(wallet *ent.Wallet, changer *ent.Account, representative string, precomputedWork *string, bpowKey *string, onlyIfDifferent bool) (*models.StateBlock, error) {
+// Also returns the representative the account has before the change
+// With preview no work is generated, and blocks of hardware wallets aren't signed
+func (w *NanoWallet) createChangeBlock(wallet *ent.Wallet, changer *ent.Account, representative string, precomputedWork *string, bpowKey *string, onlyIfDifferent bool, preview bool) (*nanoblock.StateBlock, string, error) {
 	if wallet == nil {
-		return nil, ErrInvalidWallet
+		return nil, "", ErrInvalidWallet
 	} else if changer == nil {
-		return nil, ErrInvalidAccount
+		return nil, "", ErrInvalidAccount
+	} else if wallet.WatchOnly {
+		return nil, "", ErrWalletWatchOnly
+	} else if changer.WatchOnly {
+		return nil, "", ErrNoPrivateKey
+	} else if wallet.FrozenAt != nil {
+		return nil, "", ErrWalletFrozen
 	}
 
 	// Get account info
 	accountInfo, err := w.RpcClient.MakeAccountInfoRequest(changer.Address)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if onlyIfDifferent && accountInfo.Representative == representative {
-		return nil, ErrSameRepresentative
+		return nil, "", ErrSameRepresentative
 	}
 
 	workbase := accountInfo.Frontier
@@ -402,25 +454,24 @@ func (w *NanoWallet) createChangeBlock(wallet *ent.Wallet, changer *ent.Account,
 	// Build other block fields
 	previous := accountInfo.Frontier
 
+	difficulty := pow.SendMultiplier(w.Config.Wallet.Banano)
 	var work string
-	if precomputedWork == nil {
+	if precomputedWork != nil {
+		work = *precomputedWork
+	} else if prefetched, ok := w.prefetchedWork(changer.Address, workbase, difficulty); ok {
+		work = prefetched
+	} else if !preview {
 		key := ""
 		if bpowKey != nil {
 			key = *bpowKey
 		}
-		difficulty := 1
-		if !w.Config.Wallet.Banano {
-			difficulty = 64
-		}
-		work, err = w.WorkClient.WorkGenerateMeta(workbase, difficulty, true, false, key)
+		work, err = w.generateWork(wallet.ID, changer.Address, nil, workbase, difficulty, false, key)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
-	} else {
-		work = *precomputedWork
 	}
 
-	stateBlock := &models.StateBlock{
+	stateBlock := &nanoblock.StateBlock{
 		Type:           "state",
 		Account:        changer.Address,
 		Previous:       previous,
@@ -431,29 +482,24 @@ func (w *NanoWallet) createChangeBlock(wallet *ent.Wallet, changer *ent.Account,
 		Banano:         w.Config.Wallet.Banano,
 	}
 
-	// Get the private key for this account
-	var priv ed25519.PrivateKey
-	if changer.PrivateKey != nil {
-		decoded, err := hex.DecodeString(*changer.PrivateKey)
-		if err != nil {
-			return nil, err
-		}
-		priv = ed25519.PrivateKey(decoded)
-	} else {
-		sd, err := GetDecryptedKeyFromStorage(wallet, "seed")
-		if err != nil {
-			return nil, err
-		}
-		_, priv, _ = utils.KeypairFromSeed(sd, uint32(*changer.AccountIndex))
+	if err := w.signBlock(wallet, changer, stateBlock, preview); err != nil {
+		return nil, "", err
 	}
 
-	// Sign the block
-	err = stateBlock.Sign(priv)
+	return stateBlock, accountInfo.Representative, nil
+}
+
+// Sign the block, on the Ledger for hardware wallets
+// A preview of a hardware wallet's block is left unsigned, nobody is there to confirm it on the device
+func (w *NanoWallet) signBlock(wallet *ent.Wallet, acc *ent.Account, stateBlock *nanoblock.StateBlock, preview bool) error {
+	if preview && wallet.Hardware {
+		return nil
+	}
+	signer, err := w.accountSigner(wallet, acc)
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	return stateBlock, nil
+	return signer.SignBlock(stateBlock)
 }
 
 // The user facing APIs intended to be  for block creation/publishing
@@ -461,8 +507,15 @@ func (w *NanoWallet) createChangeBlock(wallet *ent.Wallet, changer *ent.Account,
 
 // Receive single block
 func (w *NanoWallet) CreateAndPublishReceiveBlock(wallet *ent.Wallet, source string, hash string, work *string, bpowKey *string) (string, error) {
+	return w.CreateAndPublishReceiveBlockAt(wallet, source, hash, work, bpowKey, 0)
+}
+
+// Same as CreateAndPublishReceiveBlock, generating work for workMultiplier instead of the receive threshold, 0 for the threshold
+func (w *NanoWallet) CreateAndPublishReceiveBlockAt(wallet *ent.Wallet, source string, hash string, work *string, bpowKey *string, workMultiplier int) (string, error) {
 	if wallet == nil {
 		return "", ErrInvalidWallet
+	} else if wallet.FrozenAt != nil {
+		return "", ErrWalletFrozen
 	}
 
 	acc, err := w.GetAccount(wallet, source)
@@ -471,37 +524,21 @@ func (w *NanoWallet) CreateAndPublishReceiveBlock(wallet *ent.Wallet, source str
 	}
 
 	// Obtain lock
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
 	if err != nil {
 		return "", database.ErrLockNotObtained
 	}
 	defer lock.Release(w.Ctx)
 
-	sb, err := w.createReceiveBlock(wallet, acc, hash, work, bpowKey)
-	if err != nil {
-		return "", err
-	}
-
-	// Publish block
-	subtype := "receive"
-	resp, err := w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
-		BaseRequest: requests.BaseRequest{
-			Action: "process",
-		},
-		Subtype:   &subtype,
-		JsonBlock: true,
-		Block:     *sb,
-	})
-	if err != nil || !utils.Validate64HexHash(resp.Hash) {
-		return "", err
-	}
-	return resp.Hash, nil
+	return w.publishReceive(wallet, acc, hash, work, bpowKey, workMultiplier)
 }
 
 // Receive all blocks in all accounts on wallet, respecting receive minimum
 func (w *NanoWallet) ReceiveAllBlocks(wallet *ent.Wallet, source string, bpowKey *string) (int, error) {
 	if wallet == nil {
 		return 0, ErrInvalidWallet
+	} else if wallet.FrozenAt != nil {
+		return 0, ErrWalletFrozen
 	}
 
 	acc, err := w.GetAccount(wallet, source)
@@ -511,7 +548,7 @@ func (w *NanoWallet) ReceiveAllBlocks(wallet *ent.Wallet, source string, bpowKey
 
 	// Obtain lock
 	// Longer lock since this culd be long running
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*300, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*300, &database.LockRetryStrategy)
 	if err != nil {
 		return 0, database.ErrLockNotObtained
 	}
@@ -520,9 +557,97 @@ func (w *NanoWallet) ReceiveAllBlocks(wallet *ent.Wallet, source string, bpowKey
 	return w.receiveAll(wallet, acc, bpowKey)
 }
 
+// Reason a block of ReceiveBatch was skipped
+const SkipNotPending = "not_pending"
+
+// Result of ReceiveBatch, in the order the blocks were given
+type ReceiveBatchResult struct {
+	Received []ReceivedBlock
+	Skipped  []SkippedBlock
+}
+
+type ReceivedBlock struct {
+	Hash         string
+	ReceivedHash string
+}
+
+type SkippedBlock struct {
+	Hash   string
+	Reason string
+}
+
+// Receive the given pending blocks on an account, in order, each receive block follows the one before it
+// Blocks that aren't pending for the account are skipped, receive_minimum doesn't apply since they're chosen explicitly
+// If publishing fails the blocks received until then are returned with the error
+func (w *NanoWallet) ReceiveBatch(wallet *ent.Wallet, source string, hashes []string, bpowKey *string) (*ReceiveBatchResult, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if wallet.FrozenAt != nil {
+		return nil, ErrWalletFrozen
+	}
+
+	acc, err := w.GetAccount(wallet, source)
+	if err != nil {
+		return nil, err
+	}
+
+	// Obtain lock
+	// Longer lock since this culd be long running
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*300, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	pending, err := w.RpcClient.MakeReceivableRequest(acc.Address, "")
+	if err != nil {
+		return nil, err
+	}
+	isPending := make(map[string]bool, len(pending.Blocks))
+	for hash := range pending.Blocks {
+		isPending[strings.ToUpper(hash)] = true
+	}
+
+	result := &ReceiveBatchResult{
+		Received: []ReceivedBlock{},
+		Skipped:  []SkippedBlock{},
+	}
+	for _, hash := range hashes {
+		// A hash that's given twice isn't pending anymore the second time
+		if !isPending[strings.ToUpper(hash)] {
+			result.Skipped = append(result.Skipped, SkippedBlock{Hash: hash, Reason: SkipNotPending})
+			continue
+		}
+		receivedHash, err := w.publishReceive(wallet, acc, hash, nil, bpowKey, 0)
+		if err == nil && receivedHash == "" {
+			err = errors.New("Unable to publish receive block")
+		}
+		if err != nil {
+			return result, err
+		}
+		delete(isPending, strings.ToUpper(hash))
+		result.Received = append(result.Received, ReceivedBlock{Hash: hash, ReceivedHash: receivedHash})
+	}
+
+	return result, nil
+}
+
 func (w *NanoWallet) CreateAndPublishSendBlock(wallet *ent.Wallet, amount string, source string, destination string, id *string, work *string, bpowKey *string) (string, error) {
+	return w.CreateAndPublishSendBlockAt(wallet, amount, source, destination, id, work, bpowKey, 0)
+}
+
+// Same as CreateAndPublishSendBlock, generating work for workMultiplier instead of the send threshold, 0 for the threshold
+// Sends of more than the wallet's approval threshold are refused, they're made with SendApprovalCreate
+func (w *NanoWallet) CreateAndPublishSendBlockAt(wallet *ent.Wallet, amount string, source string, destination string, id *string, work *string, bpowKey *string, workMultiplier int) (string, error) {
+	return w.createAndPublishSendBlock(wallet, amount, source, destination, id, work, bpowKey, workMultiplier, false)
+}
+
+// approved is set for sends that were approved, they aren't checked against the approval threshold
+func (w *NanoWallet) createAndPublishSendBlock(wallet *ent.Wallet, amount string, source string, destination string, id *string, work *string, bpowKey *string, workMultiplier int, approved bool) (string, error) {
 	if wallet == nil {
 		return "", ErrInvalidWallet
+	} else if wallet.FrozenAt != nil {
+		return "", ErrWalletFrozen
 	}
 	acc, err := w.GetAccount(wallet, source)
 	if err != nil {
@@ -530,42 +655,66 @@ func (w *NanoWallet) CreateAndPublishSendBlock(wallet *ent.Wallet, amount string
 	}
 
 	// Obtain lock
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
 	if err != nil {
 		return "", database.ErrLockNotObtained
 	}
 	defer lock.Release(w.Ctx)
 
-	// This is our idempotent send test, we don't create a new send block if a send with this ID has already been created from this account
+	return w.publishSend(wallet, acc, amount, destination, id, work, bpowKey, workMultiplier, approved)
+}
+
+// Create and publish a send from acc, the caller holds its account lock
+func (w *NanoWallet) publishSend(wallet *ent.Wallet, acc *ent.Account, amount string, destination string, id *string, work *string, bpowKey *string, workMultiplier int, approved bool) (string, error) {
+	if !approved && w.RequiresApproval(wallet, amount) {
+		return "", ErrApprovalRequired
+	}
+
+	// This is our idempotent send test, we don't create a new send block if a send with this ID has already been created in this wallet
 	if id != nil {
-		block, err := w.GetBlockFromDatabase(wallet, source, *id)
+		// The id is the wallet's, while the account lock only stops sends from acc
+		idLock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("send_block_id:%s:%s", wallet.ID, *id), time.Second*30, &database.LockRetryStrategy)
+		if err != nil {
+			return "", database.ErrLockNotObtained
+		}
+		defer idLock.Release(w.Ctx)
+		block, err := w.sendBlockForID(wallet, *id)
 		if !errors.Is(err, ErrBlockNotFound) && err != nil {
 			return "", err
 		} else if block != nil {
-			// Now we can just republish...
-			var sb models.StateBlock
-			if err := mapstructure.Decode(block.Block, &sb); err != nil {
-				return "", err
+			hash, published, err := w.republishSend(wallet, block)
+			if err != nil || published {
+				return hash, err
 			}
-			subtype := "send"
-			// Call process with same block
-			w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
-				BaseRequest: requests.BaseRequest{
-					Action: "process",
-				},
-				Subtype:   &subtype,
-				JsonBlock: true,
-				Block:     sb,
-			})
-			return strings.ToUpper(sb.Hash), nil
+			// The node refused it, so it's sent again
 		}
 	}
 
-	sb, err := w.createSendBlock(wallet, acc, amount, destination, work, bpowKey)
+	release, err := w.obtainDailySend(wallet, amount)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	sb, err := w.createSendBlock(wallet, acc, amount, destination, work, bpowKey, workMultiplier, false)
 	if err != nil {
 		return "", err
 	}
 
+	// If the ID is set save the block before publishing it, if Pippin stops while publishing a retry republishes it instead of sending again
+	blockHash := sb.Hash()
+	hash := strings.ToUpper(hex.EncodeToString(blockHash[:]))
+	var saved *ent.Block
+	if id != nil {
+		var asInterface map[string]interface{}
+		inrec, _ := json.Marshal(sb)
+		json.Unmarshal(inrec, &asInterface)
+		saved, err = w.DB.Block.Create().SetAccount(acc).SetBlock(asInterface).SetBlockHash(hash).SetSubtype("send").SetWalletID(wallet.ID).SetSendID(*id).Save(w.Ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	// Publish block
 	subtype := "send"
 	resp, err := w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
@@ -576,17 +725,34 @@ func (w *NanoWallet) CreateAndPublishSendBlock(wallet *ent.Wallet, amount string
 		JsonBlock: true,
 		Block:     *sb,
 	})
-	if err != nil || !utils.Validate64HexHash(resp.Hash) {
+	if err == nil && !utils.Validate64HexHash(resp.Hash) {
+		err = errors.New("Unable to publish send block")
+	}
+	if err != nil {
+		w.frontiers().Invalidate(acc.Address)
+		var rejected *nanorpc.ProcessRejectedError
+		if errors.As(err, &rejected) {
+			// The node refused it, so the send can be made again
+			if saved != nil {
+				if deleteErr := w.DB.Block.DeleteOne(saved).Exec(w.Ctx); deleteErr != nil {
+					return "", deleteErr
+				}
+			}
+			return "", err
+		}
+		// The node may have taken it, so it counts against the limit and a send with the id republishes it
+		if w.dailySendLimit() != nil {
+			w.recordSpend(wallet, amount, hash)
+		}
 		return "", err
 	}
-
-	// If the ID is set save it in database for indempotency
-	if id != nil {
-		var asInterface map[string]interface{}
-		inrec, _ := json.Marshal(sb)
-		json.Unmarshal(inrec, &asInterface)
-		_, err := w.DB.Block.Create().SetAccount(acc).SetBlock(asInterface).SetBlockHash(resp.Hash).SetSubtype("send").SetSendID(*id).Save(w.Ctx)
-		if err != nil {
+	w.frontiers().Set(acc.Address, resp.Hash, sb.Balance)
+	w.precacheNextWork(wallet, acc.Address, resp.Hash)
+	if w.dailySendLimit() != nil {
+		w.recordSpend(wallet, amount, resp.Hash)
+	}
+	if saved != nil && !strings.EqualFold(saved.BlockHash, resp.Hash) {
+		if _, err := saved.Update().SetBlockHash(resp.Hash).Save(w.Ctx); err != nil {
 			return "", err
 		}
 	}
@@ -594,9 +760,55 @@ func (w *NanoWallet) CreateAndPublishSendBlock(wallet *ent.Wallet, amount string
 	return resp.Hash, nil
 }
 
+// Publish a send that was saved with an id again, it's already counted against the daily limit
+// It's only forgotten if the node refuses it and doesn't have it, false is returned then so it can be sent again
+// Anything else is an error, the send stays saved for the next retry
+func (w *NanoWallet) republishSend(wallet *ent.Wallet, block *ent.Block) (string, bool, error) {
+	sb := nanoblock.StateBlock{Banano: w.Config.Wallet.Banano}
+	if err := mapstructure.Decode(block.Block, &sb); err != nil {
+		return "", false, err
+	}
+	hash := strings.ToUpper(block.BlockHash)
+	subtype := "send"
+	resp, err := w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "process",
+		},
+		Subtype:   &subtype,
+		JsonBlock: true,
+		Block:     sb,
+	})
+	if err == nil && utils.Validate64HexHash(resp.Hash) {
+		return hash, true, nil
+	}
+	var rejected *nanorpc.ProcessRejectedError
+	if !errors.As(err, &rejected) {
+		if err == nil {
+			err = errors.New("Unable to publish send block")
+		}
+		return "", false, err
+	}
+
+	// A block the node already has is refused too
+	if _, err := w.RpcClient.MakeBlockInfoRequest(hash); err == nil {
+		return hash, true, nil
+	} else if !errors.Is(err, nanorpc.ErrBlockNotFound) {
+		return "", false, err
+	}
+	if err := w.DB.Block.DeleteOne(block).Exec(w.Ctx); err != nil {
+		return "", false, err
+	}
+	if _, err := w.DB.WalletSpend.Delete().Where(walletspend.WalletID(wallet.ID), walletspend.BlockHashEqualFold(hash)).Exec(w.Ctx); err != nil {
+		return "", false, err
+	}
+	return "", false, nil
+}
+
 func (w *NanoWallet) CreateAndPublishChangeBlock(wallet *ent.Wallet, address string, representative string, work *string, bpowKey *string, onlyIfDifferent bool) (string, error) {
 	if wallet == nil {
 		return "", ErrInvalidWallet
+	} else if wallet.FrozenAt != nil {
+		return "", ErrWalletFrozen
 	}
 	acc, err := w.GetAccount(wallet, address)
 	if err != nil {
@@ -604,13 +816,13 @@ func (w *NanoWallet) CreateAndPublishChangeBlock(wallet *ent.Wallet, address str
 	}
 
 	// Obtain lock
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
 	if err != nil {
 		return "", database.ErrLockNotObtained
 	}
 	defer lock.Release(w.Ctx)
 
-	sb, err := w.createChangeBlock(wallet, acc, representative, work, bpowKey, onlyIfDifferent)
+	sb, oldRepresentative, err := w.createChangeBlock(wallet, acc, representative, work, bpowKey, onlyIfDifferent, false)
 	if err != nil {
 		return "", err
 	}
@@ -626,8 +838,23 @@ func (w *NanoWallet) CreateAndPublishChangeBlock(wallet *ent.Wallet, address str
 		Block:     *sb,
 	})
 	if err != nil || !utils.Validate64HexHash(resp.Hash) {
+		w.frontiers().Invalidate(acc.Address)
 		return "", err
 	}
+	w.frontiers().Set(acc.Address, resp.Hash, sb.Balance)
+	w.precacheNextWork(wallet, acc.Address, resp.Hash)
+
+	// The block is published either way, a change that isn't recorded is only missing from the history
+	if _, err := w.DB.RepresentativeHistory.Create().SetAccountID(acc.ID).SetOldRepresentative(oldRepresentative).SetNewRepresentative(representative).SetBlockHash(resp.Hash).Save(w.Ctx); err != nil {
+		log.Ctx(w.Ctx).Errorf("Error recording representative change of %s %s", acc.Address, err)
+	}
 
 	return resp.Hash, nil
 }
+
+// Blocks are signed with the 32 byte seed form of the private key
+func privateKeySeed(priv ed25519.PrivateKey) [32]byte {
+	var seed [32]byte
+	copy(seed[:], priv.Seed())
+	return seed
+}
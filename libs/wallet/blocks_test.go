@@ -1,12 +1,18 @@
 package wallet
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	entblock "github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
 	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
 	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
@@ -81,9 +87,9 @@ func TestReceiveBlockCreate(t *testing.T) {
 		},
 	)
 
-	_, err := MockWallet.createReceiveBlock(nil, nil, "", nil, nil)
+	_, _, err := MockWallet.createReceiveBlock(nil, nil, "", nil, nil, 0, false)
 	assert.ErrorIs(t, err, ErrInvalidWallet)
-	_, err = MockWallet.createReceiveBlock(&ent.Wallet{}, nil, "", nil, nil)
+	_, _, err = MockWallet.createReceiveBlock(&ent.Wallet{}, nil, "", nil, nil, 0, false)
 	assert.ErrorIs(t, err, ErrInvalidAccount)
 
 	// Create a wallet
@@ -99,14 +105,16 @@ func TestReceiveBlockCreate(t *testing.T) {
 
 	// Receive a block
 	work := "0000000000000000"
-	block, err := MockWallet.createReceiveBlock(wallet, acc, "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE", &work, nil)
+	block, amount, err := MockWallet.createReceiveBlock(wallet, acc, "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE", &work, nil, 0, false)
 	assert.Nil(t, err)
-	assert.Equal(t, "84ee43f56904a239e4bdd9f3e0835b0bc233416d7122e69fadddc1dba3e82cbe", block.Hash)
+	hash := block.Hash()
+	assert.Equal(t, "84ee43f56904a239e4bdd9f3e0835b0bc233416d7122e69fadddc1dba3e82cbe", hex.EncodeToString(hash[:]))
 	assert.Equal(t, "state", block.Type)
 	assert.Equal(t, acc.Address, block.Account)
 	assert.Equal(t, "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE", block.Link)
 	assert.Equal(t, "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", block.Previous)
 	assert.Equal(t, "0000000000000000", block.Work)
+	assert.Equal(t, "30000000000000000000000000000000000", amount)
 }
 
 func TestSendBlockCreate(t *testing.T) {
@@ -135,9 +143,9 @@ func TestSendBlockCreate(t *testing.T) {
 		},
 	)
 
-	_, err := MockWallet.createSendBlock(nil, nil, "", "", nil, nil)
+	_, err := MockWallet.createSendBlock(nil, nil, "", "", nil, nil, 0, false)
 	assert.ErrorIs(t, err, ErrInvalidWallet)
-	_, err = MockWallet.createSendBlock(&ent.Wallet{}, nil, "", "", nil, nil)
+	_, err = MockWallet.createSendBlock(&ent.Wallet{}, nil, "", "", nil, nil, 0, false)
 	assert.ErrorIs(t, err, ErrInvalidAccount)
 
 	// Create a wallet
@@ -153,14 +161,180 @@ func TestSendBlockCreate(t *testing.T) {
 
 	// Receive a block
 	work := "0000000000000000"
-	block, err := MockWallet.createSendBlock(wallet, acc, "1", "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", &work, nil)
+	block, err := MockWallet.createSendBlock(wallet, acc, "1", "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", &work, nil, 0, false)
 	assert.Nil(t, err)
-	assert.Equal(t, "dd255940694bb18f525f827d8cc4ef2bf569a40a1afe6948c0c14e7aabc7a27f", block.Hash)
+	hash := block.Hash()
+	assert.Equal(t, "dd255940694bb18f525f827d8cc4ef2bf569a40a1afe6948c0c14e7aabc7a27f", hex.EncodeToString(hash[:]))
 	assert.Equal(t, "state", block.Type)
 	assert.Equal(t, acc.Address, block.Account)
 	assert.Equal(t, "d4bbfa50649d80e5f63fc396c6f4cf6321cabd7c1480e964c2701d56aafeb5e3", block.Link)
 	assert.Equal(t, "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", block.Previous)
 	assert.Equal(t, "0000000000000000", block.Work)
+
+	// Work can't be asked for below the send threshold
+	_, err = MockWallet.createSendBlock(wallet, acc, "1", "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", nil, nil, 1, false)
+	assert.ErrorIs(t, err, ErrWorkDifficultyTooLow)
+}
+
+func TestWorkThreshold(t *testing.T) {
+	multiplier, requested, err := workThreshold(64, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 64, multiplier)
+	assert.False(t, requested)
+	multiplier, requested, err = workThreshold(64, 128)
+	assert.Nil(t, err)
+	assert.Equal(t, 128, multiplier)
+	assert.True(t, requested)
+	_, _, err = workThreshold(64, 63)
+	assert.ErrorIs(t, err, ErrWorkDifficultyTooLow)
+}
+
+func TestSendWithIdempotentID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processed := 0
+	processFails := false
+	processTimesOut := false
+	nodeHasBlock := false
+	savedBeforePublish := false
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "process" {
+				processed++
+				// The block is already in the database while it's published
+				_, err := MockWallet.DB.Block.Query().Where(entblock.SendID("payout-1")).Only(MockWallet.Ctx)
+				savedBeforePublish = err == nil
+				if processTimesOut {
+					return nil, errors.New("timeout")
+				} else if processFails {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Fork"})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", 0xC0000+processed),
+				})
+			} else if pr.Action == "block_info" && nodeHasBlock {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "block_info" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Block not found"})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("7e1a4d9c2f6b0e3a8d5c1f7b4e9a2d6c0f3b8e5a1d7c4f9b2e6a0d3c8f5b1e7a"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	otherAcc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	work := "0000000000000000"
+	id := "payout-1"
+
+	// A send the node refuses isn't kept, so it can be made again
+	processFails = true
+	_, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, &id, &work, nil)
+	assert.NotNil(t, err)
+	assert.True(t, savedBeforePublish)
+	count, _ := MockWallet.DB.Block.Query().Where(entblock.SendID(id)).Count(MockWallet.Ctx)
+	assert.Equal(t, 0, count)
+
+	// Without an answer the node may have it, so it's kept and a retry publishes the same block
+	processFails = false
+	processTimesOut = true
+	_, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, &id, &work, nil)
+	assert.NotNil(t, err)
+	block, err := MockWallet.DB.Block.Query().Where(entblock.SendID(id)).Only(MockWallet.Ctx)
+	assert.Nil(t, err)
+	// Still unanswered
+	_, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, &id, &work, nil)
+	assert.NotNil(t, err)
+	processTimesOut = false
+	published := processed
+	hash, err := MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, &id, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, block.BlockHash, hash)
+	assert.Equal(t, published+1, processed)
+	count, _ = MockWallet.DB.Block.Query().Where(entblock.SendID(id)).Count(MockWallet.Ctx)
+	assert.Equal(t, 1, count)
+
+	// The node refuses a block it already has, it's still sent
+	processFails = true
+	nodeHasBlock = true
+	hash, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, &id, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, block.BlockHash, hash)
+
+	// The id is per wallet, another of its accounts doesn't send again
+	processFails = false
+	hash, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", otherAcc.Address, destination, &id, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, block.BlockHash, hash)
+	count, _ = MockWallet.DB.Block.Query().Where(entblock.SendID(id)).Count(MockWallet.Ctx)
+	assert.Equal(t, 1, count)
+
+	// One the node refuses and doesn't have is forgotten and sent again
+	processFails = true
+	nodeHasBlock = false
+	_, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, &id, &work, nil)
+	assert.NotNil(t, err)
+	count, _ = MockWallet.DB.Block.Query().Where(entblock.SendID(id)).Count(MockWallet.Ctx)
+	assert.Equal(t, 0, count)
+	processFails = false
+	hash, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, &id, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("%064X", 0xC0000+processed), hash)
+	block, err = MockWallet.DB.Block.Query().Where(entblock.SendID(id)).Only(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, hash, block.BlockHash)
+	assert.Equal(t, wallet.ID, *block.WalletID)
+
+	// Two accounts sending with the same id at once make one send
+	id = "payout-2"
+	hashes := make([]string, 2)
+	var wg sync.WaitGroup
+	for i, source := range []string{acc.Address, otherAcc.Address} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hashes[i], _ = MockWallet.CreateAndPublishSendBlock(wallet, "1000", source, destination, &id, &work, nil)
+		}()
+	}
+	wg.Wait()
+	assert.NotEmpty(t, hashes[0])
+	assert.Equal(t, hashes[0], hashes[1])
+	count, _ = MockWallet.DB.Block.Query().Where(entblock.SendID(id)).Count(MockWallet.Ctx)
+	assert.Equal(t, 1, count)
+
+	// A moved account's sends don't hold their ids in either wallet
+	id = "payout-1"
+	otherSeed, _ := utils.GenerateSeed(strings.NewReader("9c2f6b0e3a8d5c1f7b4e9a2d6c0f3b8e5a1d7c4f9b2e6a0d3c8f5b1e7a7e1a4d"))
+	otherWallet, err := MockWallet.WalletCreate(otherSeed)
+	assert.Nil(t, err)
+	assert.Nil(t, MockWallet.AccountMove(wallet, otherWallet, []string{acc.Address}))
+	moved, err := MockWallet.DB.Block.Query().Where(entblock.BlockHash(block.BlockHash)).Only(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, moved.WalletID)
+	hash, err = MockWallet.CreateAndPublishSendBlock(otherWallet, "1000", acc.Address, destination, &id, &work, nil)
+	assert.Nil(t, err)
+	assert.NotEqual(t, block.BlockHash, hash)
+	hash, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", otherAcc.Address, destination, &id, &work, nil)
+	assert.Nil(t, err)
+	assert.NotEqual(t, block.BlockHash, hash)
+	count, _ = MockWallet.DB.Block.Query().Where(entblock.SendID(id)).Count(MockWallet.Ctx)
+	assert.Equal(t, 3, count)
 }
 
 func TestChangeBlockCreate(t *testing.T) {
@@ -189,9 +363,9 @@ func TestChangeBlockCreate(t *testing.T) {
 		},
 	)
 
-	_, err := MockWallet.createChangeBlock(nil, nil, "", nil, nil, true)
+	_, _, err := MockWallet.createChangeBlock(nil, nil, "", nil, nil, true, false)
 	assert.ErrorIs(t, err, ErrInvalidWallet)
-	_, err = MockWallet.createChangeBlock(&ent.Wallet{}, nil, "", nil, nil, true)
+	_, _, err = MockWallet.createChangeBlock(&ent.Wallet{}, nil, "", nil, nil, true, false)
 	assert.ErrorIs(t, err, ErrInvalidAccount)
 
 	// Create a wallet
@@ -206,17 +380,108 @@ func TestChangeBlockCreate(t *testing.T) {
 
 	// Receive a block
 	work := "0000000000000000"
-	block, err := MockWallet.createChangeBlock(wallet, acc, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", &work, nil, false)
+	block, oldRepresentative, err := MockWallet.createChangeBlock(wallet, acc, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", &work, nil, false, false)
 	assert.Nil(t, err)
-	assert.Equal(t, "61595310547a16b7b7240eb65b09eb1b6994143ba74596f6e64883c5e3342150", block.Hash)
+	hash := block.Hash()
+	assert.Equal(t, "61595310547a16b7b7240eb65b09eb1b6994143ba74596f6e64883c5e3342150", hex.EncodeToString(hash[:]))
 	assert.Equal(t, "state", block.Type)
 	assert.Equal(t, acc.Address, block.Account)
 	assert.Equal(t, "0000000000000000000000000000000000000000000000000000000000000000", block.Link)
 	assert.Equal(t, "80A6745762493FA21A22718ABFA4F635656A707B48B3324198AC7F3938DE6D4F", block.Previous)
 	assert.Equal(t, "0000000000000000", block.Work)
+	assert.Equal(t, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", oldRepresentative)
 
 	// Test only if different
 	// nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5
-	block, err = MockWallet.createChangeBlock(wallet, acc, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", &work, nil, true)
+	_, _, err = MockWallet.createChangeBlock(wallet, acc, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", &work, nil, true, false)
 	assert.ErrorIs(t, err, ErrSameRepresentative)
 }
+
+func TestReceiveBatch(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	pendingA := "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE"
+	pendingB := "000D1BAEC8EC208142C99059B393051BAC8380F9B5A2E6B2489A277D81789F3F"
+	notPending := "0000000000000000000000000000000000000000000000000000000000000001"
+	var published []nanoblock.StateBlock
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "receivable":
+				// An explicit list of blocks doesn't use receive_minimum
+				assert.Equal(t, "", pr["threshold"])
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"blocks": map[string]interface{}{
+						pendingA: "1000000000000000000000000000000",
+						pendingB: "2000000000000000000000000000000",
+					},
+				})
+			case "block_info":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "account_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "5",
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			case "process":
+				var sb nanoblock.StateBlock
+				block, _ := json.Marshal(pr["block"])
+				json.Unmarshal(block, &sb)
+				published = append(published, sb)
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", len(published)),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	// The pow client only has work for the mocked frontier, so don't cache the ones we publish
+	conf := *MockWallet.Config
+	conf.Wallet.FrontierCacheSize = 0
+	batchWallet := &NanoWallet{
+		DB:         MockWallet.DB,
+		Ctx:        MockWallet.Ctx,
+		RpcClient:  MockWallet.RpcClient,
+		WorkClient: MockWallet.WorkClient,
+		Config:     &conf,
+	}
+
+	_, err := batchWallet.ReceiveBatch(nil, "", nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("c4f7a0d3e6b9c2f5a8d1e4b7c0f3a6d9e2b5c8f1a4d7e0b3c6f9a2d5e8b1c4f8"))
+	wallet, err := batchWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := batchWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	_, err = batchWallet.ReceiveBatch(wallet, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", []string{pendingA}, nil)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	result, err := batchWallet.ReceiveBatch(wallet, acc.Address, []string{pendingB, notPending, strings.ToLower(pendingA), pendingB}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []ReceivedBlock{
+		{Hash: pendingB, ReceivedHash: fmt.Sprintf("%064X", 1)},
+		{Hash: strings.ToLower(pendingA), ReceivedHash: fmt.Sprintf("%064X", 2)},
+	}, result.Received)
+	assert.Equal(t, []SkippedBlock{
+		{Hash: notPending, Reason: SkipNotPending},
+		{Hash: pendingB, Reason: SkipNotPending},
+	}, result.Skipped)
+
+	// Published in the order they were given
+	assert.Len(t, published, 2)
+	assert.Equal(t, acc.Address, published[0].Account)
+	assert.Equal(t, pendingB, published[0].Link)
+	assert.Equal(t, acc.Address, published[1].Account)
+	assert.Equal(t, strings.ToLower(pendingA), published[1].Link)
+}
@@ -2,10 +2,12 @@ package wallet
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/appditto/pippin_nano_wallet/libs/database"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/go-redis/redis/v9"
 )
@@ -48,7 +50,7 @@ func (w *NanoWallet) EncryptWallet(wallet *ent.Wallet, password string) (bool, e
 		if err != nil {
 			return false, err
 		}
-		_, err = tx.Wallet.UpdateOne(wallet).SetEncrypted(false).SetSeed(seed).Save(w.Ctx)
+		_, err = tx.Wallet.UpdateOne(wallet).SetEncrypted(false).SetSeed(seed).ClearKdf().Save(w.Ctx)
 		if err != nil {
 			tx.Rollback()
 			return false, err
@@ -64,7 +66,16 @@ func (w *NanoWallet) EncryptWallet(wallet *ent.Wallet, password string) (bool, e
 				tx.Rollback()
 				return false, err
 			}
-			_, err = tx.Account.UpdateOne(acct).SetPrivateKey(key).Save(w.Ctx)
+			update := tx.Account.UpdateOne(acct).SetPrivateKey(key)
+			if acct.Seed != nil {
+				seed, err := GetDecryptedKeyFromStorage(wallet, accountSeedKey(acct.Address))
+				if err != nil {
+					tx.Rollback()
+					return false, err
+				}
+				update.SetSeed(seed)
+			}
+			_, err = update.Save(w.Ctx)
 			if err != nil {
 				tx.Rollback()
 				return false, err
@@ -76,22 +87,28 @@ func (w *NanoWallet) EncryptWallet(wallet *ent.Wallet, password string) (bool, e
 		}
 		wallet.Encrypted = false
 		wallet.Seed = seed
+		wallet.Kdf = nil
 		database.GetRedisDB().Del(wallet.ID.String())
 		return true, nil
 	}
 
-	// We are updating the password
-	crypter := utils.NewAesCrypt(password)
+	// We are updating the password, with a new salt and the current kdf parameters
+	params, err := w.newKdfParams()
+	if err != nil {
+		return false, err
+	}
+	crypter := utils.NewArgon2Crypt(password, params)
 	encryptedSeed, err := crypter.Encrypt(seed)
 	if err != nil {
 		return false, err
 	}
+	kdf := params.String()
 
 	tx, err := w.DB.Tx(w.Ctx)
 	if err != nil {
 		return false, err
 	}
-	_, err = tx.Wallet.UpdateOne(wallet).SetEncrypted(true).SetSeed(encryptedSeed).Save(w.Ctx)
+	_, err = tx.Wallet.UpdateOne(wallet).SetEncrypted(true).SetSeed(encryptedSeed).SetKdf(kdf).Save(w.Ctx)
 	if err != nil {
 		tx.Rollback()
 		return false, err
@@ -103,11 +120,32 @@ func (w *NanoWallet) EncryptWallet(wallet *ent.Wallet, password string) (bool, e
 		return false, err
 	}
 	for _, acct := range adhocAccts {
-		encryptedKey, err := crypter.Encrypt(*acct.PrivateKey)
+		// They're encrypted with the old password if the wallet already has one
+		key, err := adhocKeyToEncrypt(wallet, acct.Address, acct.PrivateKey)
 		if err != nil {
+			tx.Rollback()
 			return false, err
 		}
-		_, err = tx.Account.UpdateOne(acct).SetPrivateKey(encryptedKey).Save(w.Ctx)
+		encryptedKey, err := crypter.Encrypt(key)
+		if err != nil {
+			tx.Rollback()
+			return false, err
+		}
+		update := tx.Account.UpdateOne(acct).SetPrivateKey(encryptedKey)
+		if acct.Seed != nil {
+			acctSeed, err := adhocKeyToEncrypt(wallet, accountSeedKey(acct.Address), acct.Seed)
+			if err != nil {
+				tx.Rollback()
+				return false, err
+			}
+			encryptedAcctSeed, err := crypter.Encrypt(acctSeed)
+			if err != nil {
+				tx.Rollback()
+				return false, err
+			}
+			update.SetSeed(encryptedAcctSeed)
+		}
+		_, err = update.Save(w.Ctx)
 		if err != nil {
 			tx.Rollback()
 			return false, err
@@ -119,9 +157,18 @@ func (w *NanoWallet) EncryptWallet(wallet *ent.Wallet, password string) (bool, e
 	}
 	wallet.Encrypted = true
 	wallet.Seed = encryptedSeed
+	wallet.Kdf = &kdf
 	return true, nil
 }
 
+// The decrypted key of an adhoc account, from storage if the wallet is encrypted
+func adhocKeyToEncrypt(wallet *ent.Wallet, key string, stored *string) (string, error) {
+	if wallet.Encrypted {
+		return GetDecryptedKeyFromStorage(wallet, key)
+	}
+	return *stored, nil
+}
+
 func (w *NanoWallet) LockWallet(wallet *ent.Wallet) error {
 	if wallet == nil {
 		return ErrInvalidWallet
@@ -130,6 +177,7 @@ func (w *NanoWallet) LockWallet(wallet *ent.Wallet) error {
 	}
 
 	database.GetRedisDB().Del(wallet.ID.String())
+	database.GetRedisDB().Del(unlockSessionsKey(wallet))
 	return nil
 }
 
@@ -140,7 +188,10 @@ func (w *NanoWallet) UnlockWallet(wallet *ent.Wallet, password string) (bool, er
 		return false, ErrWalletNotLocked
 	}
 
-	crypter := utils.NewAesCrypt(password)
+	crypter, err := walletCrypter(wallet, password)
+	if err != nil {
+		return false, err
+	}
 	seed, err := crypter.Decrypt(wallet.Seed)
 	if err != nil {
 		return false, ErrBadPassword
@@ -165,15 +216,58 @@ func (w *NanoWallet) UnlockWallet(wallet *ent.Wallet, password string) (bool, er
 		if err != nil {
 			return false, err
 		}
+		if acct.Seed != nil {
+			acctSeed, err := crypter.Decrypt(*acct.Seed)
+			if err != nil {
+				return false, err
+			}
+			err = SetDecryptedKeyToStorage(wallet, accountSeedKey(acct.Address), acctSeed)
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+
+	// Now that we have the password, wallets with a weaker kdf than the config's get the current one
+	if w.KdfOutdated(wallet) {
+		if _, err := w.EncryptWallet(wallet, password); err != nil {
+			log.Errorf("Error upgrading the kdf of wallet %s %v", wallet.ID, err)
+		} else {
+			log.Infof("Upgraded the kdf of wallet %s to %s", wallet.ID, w.kdfName())
+		}
 	}
 
+	if err := w.refreshAutoLock(wallet); err != nil {
+		return false, err
+	}
 	return true, nil
 }
 
+// The crypter for the wallet's password, with the kdf it was encrypted with
+func walletCrypter(wallet *ent.Wallet, password string) (*utils.AESCrypt, error) {
+	if wallet.Kdf == nil {
+		// Encrypted before Argon2id
+		return utils.NewAesCrypt(password), nil
+	}
+	params, err := utils.ParseKdfParams(*wallet.Kdf)
+	if err != nil {
+		return nil, err
+	}
+	return utils.NewArgon2Crypt(password, params), nil
+}
+
+// Where the decrypted seed of an account derived from another seed is stored
+func accountSeedKey(address string) string {
+	return fmt.Sprintf("%s:seed", address)
+}
+
 // Retrieve decrypted key from storage if it exists
 func GetDecryptedKeyFromStorage(wallet *ent.Wallet, key string) (string, error) {
 	if wallet == nil {
 		return "", ErrInvalidWallet
+	} else if (wallet.WatchOnly || wallet.Hardware) && key == "seed" {
+		// Watch-only and hardware wallets don't have a seed
+		return "", nil
 	} else if !wallet.Encrypted {
 		return wallet.Seed, nil
 	}
@@ -36,6 +36,7 @@ func TestEncryptWallet(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, wallet.Encrypted)
 	assert.NotEqual(t, seed, wallet.Seed)
+	assert.True(t, strings.HasPrefix(*wallet.Kdf, "argon2id$m=19456,t=2,p=1$"))
 
 	// Ensure adhoc keys are encrypted
 	adhocs, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID), account.PrivateKeyNotNil()).All(MockWallet.Ctx)
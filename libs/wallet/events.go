@@ -0,0 +1,209 @@
+package wallet
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/google/uuid"
+)
+
+// Wallet events go to the subscriptions of this instance, e.g. the /ws connections
+// They aren't stored, a subscription only gets what happened while it was subscribed
+// A subscription that doesn't keep up misses events rather than holding up receives and sends
+
+// How many events a subscription holds before it starts missing them
+const eventSubscriptionBuffer = 100
+
+type eventHub struct {
+	mu            sync.Mutex
+	subscriptions map[*EventSubscription]struct{}
+}
+
+// The events of the wallets a subscriber added, until it's closed
+type EventSubscription struct {
+	hub    *eventHub
+	events chan models.WalletEvent
+	mu     sync.Mutex
+	// The kinds of events of each wallet, nil for all of them
+	wallets map[string]map[string]bool
+	closed  bool
+}
+
+func (w *NanoWallet) eventHub() *eventHub {
+	root := w.root()
+	root.eventHubOnce.Do(func() {
+		root.events = &eventHub{subscriptions: map[*EventSubscription]struct{}{}}
+	})
+	return root.events
+}
+
+// Start a subscription, it has no wallets until they're added
+func (w *NanoWallet) SubscribeEvents() *EventSubscription {
+	hub := w.eventHub()
+	sub := &EventSubscription{
+		hub:     hub,
+		events:  make(chan models.WalletEvent, eventSubscriptionBuffer),
+		wallets: map[string]map[string]bool{},
+	}
+	hub.mu.Lock()
+	hub.subscriptions[sub] = struct{}{}
+	hub.mu.Unlock()
+	return sub
+}
+
+// The events of every added wallet, closed by Close
+func (s *EventSubscription) Events() <-chan models.WalletEvent {
+	return s.events
+}
+
+// Get the events of walletID, only the given kinds if there are any, replacing the kinds it had
+func (s *EventSubscription) Add(walletID string, kinds []string) {
+	var filter map[string]bool
+	if len(kinds) > 0 {
+		filter = map[string]bool{}
+		for _, kind := range kinds {
+			filter[kind] = true
+		}
+	}
+	s.mu.Lock()
+	s.wallets[walletID] = filter
+	s.mu.Unlock()
+}
+
+// Stop getting the events of walletID
+func (s *EventSubscription) Remove(walletID string) {
+	s.mu.Lock()
+	delete(s.wallets, walletID)
+	s.mu.Unlock()
+}
+
+// Whether walletID was added
+func (s *EventSubscription) Subscribed(walletID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.wallets[walletID]
+	return ok
+}
+
+// How many wallets were added
+func (s *EventSubscription) Wallets() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.wallets)
+}
+
+func (s *EventSubscription) Close() {
+	s.hub.mu.Lock()
+	delete(s.hub.subscriptions, s)
+	s.hub.mu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.events)
+	}
+}
+
+// Send event if it's for one of the wallets, without waiting for a subscriber that's behind
+func (s *EventSubscription) deliver(event models.WalletEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	filter, ok := s.wallets[event.Wallet]
+	if s.closed || !ok || (filter != nil && !filter[event.Event]) {
+		return
+	}
+	select {
+	case s.events <- event:
+	default:
+		log.Warnf("Event subscription is full, dropped %s event for %s", event.Event, event.Account)
+	}
+}
+
+// Whether there's anybody to publish events to
+func (w *NanoWallet) hasEventSubscribers() bool {
+	hub := w.eventHub()
+	hub.mu.Lock()
+	defer hub.mu.Unlock()
+	return len(hub.subscriptions) > 0
+}
+
+func (w *NanoWallet) publishEvent(event models.WalletEvent) {
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	hub := w.eventHub()
+	hub.mu.Lock()
+	subscriptions := make([]*EventSubscription, 0, len(hub.subscriptions))
+	for sub := range hub.subscriptions {
+		subscriptions = append(subscriptions, sub)
+	}
+	hub.mu.Unlock()
+	for _, sub := range subscriptions {
+		sub.deliver(event)
+	}
+}
+
+// Generate work for the block after root of an account of walletID, its subscribers get a work event
+// A difficulty a request asked for isn't raised for the network
+func (w *NanoWallet) generateWork(walletID uuid.UUID, address string, amount *big.Int, root string, difficulty int, requested bool, bpowKey string) (string, error) {
+	generate := w.WorkClient.WorkGenerateForAccountWithContext
+	if requested {
+		generate = w.WorkClient.WorkGenerateAtMultiplierWithContext
+	}
+	work, err := generate(w.Ctx, address, amount, root, difficulty, true, false, bpowKey)
+	if err != nil {
+		return "", err
+	}
+	w.publishEvent(models.WalletEvent{
+		Event:   models.WalletEventWork,
+		Wallet:  walletID.String(),
+		Account: address,
+		Hash:    root,
+	})
+	return work, nil
+}
+
+// Publish the events of a block the node confirmed, from its websocket
+// A confirmation of a block of a wallet account, and a send to a wallet account can be received
+// Nothing is looked up without subscribers, most confirmations on the network aren't for wallet accounts
+func (w *NanoWallet) PublishConfirmation(address string, hash string, subtype string, amount string, linkAsAccount string) {
+	if !w.hasEventSubscribers() {
+		return
+	}
+	addresses := []string{address}
+	if subtype == "send" && linkAsAccount != "" && linkAsAccount != address {
+		addresses = append(addresses, linkAsAccount)
+	}
+	// An address can be in more than one wallet
+	accounts, err := w.DB.Account.Query().Where(account.AddressIn(addresses...)).All(w.Ctx)
+	if err != nil {
+		log.Errorf("Error looking up accounts of confirmation %s: %v", hash, err)
+		return
+	}
+	for _, acc := range accounts {
+		if acc.Address == address {
+			w.publishEvent(models.WalletEvent{
+				Event:   models.WalletEventConfirmation,
+				Wallet:  acc.WalletID.String(),
+				Account: acc.Address,
+				Hash:    hash,
+				Subtype: subtype,
+				Amount:  amount,
+			})
+		}
+		if subtype == "send" && acc.Address == linkAsAccount {
+			w.publishEvent(models.WalletEvent{
+				Event:   models.WalletEventReceivable,
+				Wallet:  acc.WalletID.String(),
+				Account: acc.Address,
+				Hash:    hash,
+				Amount:  amount,
+				Source:  address,
+			})
+		}
+	}
+}
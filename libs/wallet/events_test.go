@@ -0,0 +1,190 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// The events waiting in sub, without blocking
+func pendingEvents(sub *EventSubscription) []models.WalletEvent {
+	events := []models.WalletEvent{}
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestEventSubscription(t *testing.T) {
+	eventWallet := &NanoWallet{Config: MockWallet.Config}
+	assert.False(t, eventWallet.hasEventSubscribers())
+
+	sub := eventWallet.SubscribeEvents()
+	other := eventWallet.SubscribeEvents()
+	assert.True(t, eventWallet.hasEventSubscribers())
+	sub.Add("wallet-a", nil)
+	sub.Add("wallet-b", []string{models.WalletEventPocketed})
+	other.Add("wallet-b", nil)
+	assert.Equal(t, 2, sub.Wallets())
+	assert.True(t, sub.Subscribed("wallet-b"))
+	assert.False(t, other.Subscribed("wallet-a"))
+
+	eventWallet.publishEvent(models.WalletEvent{Event: models.WalletEventWork, Wallet: "wallet-a", Account: "nano_1"})
+	eventWallet.publishEvent(models.WalletEvent{Event: models.WalletEventWork, Wallet: "wallet-b", Account: "nano_2"})
+	eventWallet.publishEvent(models.WalletEvent{Event: models.WalletEventPocketed, Wallet: "wallet-b", Account: "nano_2"})
+	eventWallet.publishEvent(models.WalletEvent{Event: models.WalletEventPocketed, Wallet: "wallet-c", Account: "nano_3"})
+
+	events := pendingEvents(sub)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "nano_1", events[0].Account)
+	assert.False(t, events[0].Time.IsZero())
+	assert.Equal(t, models.WalletEventPocketed, events[1].Event)
+	assert.Len(t, pendingEvents(other), 2)
+
+	// A subscription that isn't read misses what doesn't fit
+	sub.Remove("wallet-b")
+	for i := 0; i < eventSubscriptionBuffer+5; i++ {
+		eventWallet.publishEvent(models.WalletEvent{Event: models.WalletEventWork, Wallet: "wallet-a"})
+		eventWallet.publishEvent(models.WalletEvent{Event: models.WalletEventWork, Wallet: "wallet-b"})
+	}
+	assert.Len(t, pendingEvents(sub), eventSubscriptionBuffer)
+
+	sub.Close()
+	sub.Close()
+	eventWallet.publishEvent(models.WalletEvent{Event: models.WalletEventWork, Wallet: "wallet-a"})
+	_, ok := <-sub.Events()
+	assert.False(t, ok)
+	other.Close()
+	assert.False(t, eventWallet.hasEventSubscribers())
+}
+
+func TestPublishConfirmation(t *testing.T) {
+	eventWallet := &NanoWallet{DB: MockWallet.DB, Ctx: MockWallet.Ctx, Config: MockWallet.Config}
+	senderSeed, _ := utils.GenerateSeed(strings.NewReader("3a6d9c2f5b8e1d4a7c0f3b6e9d2a5c8f1b4e7d0a3c6f9b2e5d8a1c4f7b0e3d6a"))
+	senderWallet, err := eventWallet.WalletCreate(senderSeed)
+	assert.Nil(t, err)
+	sender, err := eventWallet.AccountCreate(senderWallet, nil)
+	assert.Nil(t, err)
+	receiverSeed, _ := utils.GenerateSeed(strings.NewReader("d9c2f5b8e1d4a7c0f3b6e9d2a5c8f1b4e7d0a3c6f9b2e5d8a1c4f7b0e3d6a9c2"))
+	receiverWallet, err := eventWallet.WalletCreate(receiverSeed)
+	assert.Nil(t, err)
+	receiver, err := eventWallet.AccountCreate(receiverWallet, nil)
+	assert.Nil(t, err)
+
+	// Without subscribers it's a no-op
+	eventWallet.PublishConfirmation(sender.Address, "A1", "send", "1000", receiver.Address)
+
+	sub := eventWallet.SubscribeEvents()
+	defer sub.Close()
+	sub.Add(senderWallet.ID.String(), nil)
+	sub.Add(receiverWallet.ID.String(), nil)
+
+	eventWallet.PublishConfirmation(sender.Address, "A2", "send", "1000", receiver.Address)
+	events := pendingEvents(sub)
+	assert.Len(t, events, 2)
+	for i := range events {
+		events[i].Time = events[0].Time
+	}
+	assert.ElementsMatch(t, []models.WalletEvent{
+		{Event: models.WalletEventConfirmation, Wallet: senderWallet.ID.String(), Account: sender.Address, Hash: "A2", Subtype: "send", Amount: "1000", Time: events[0].Time},
+		{Event: models.WalletEventReceivable, Wallet: receiverWallet.ID.String(), Account: receiver.Address, Hash: "A2", Amount: "1000", Source: sender.Address, Time: events[0].Time},
+	}, events)
+
+	// Only a confirmation for the receive, and nothing for accounts that aren't in a wallet
+	eventWallet.PublishConfirmation(receiver.Address, "A3", "receive", "1000", "A2")
+	eventWallet.PublishConfirmation("nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", "A4", "send", "1000", "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj")
+	events = pendingEvents(sub)
+	assert.Len(t, events, 1)
+	assert.Equal(t, models.WalletEventConfirmation, events[0].Event)
+	assert.Equal(t, "receive", events[0].Subtype)
+	assert.Equal(t, receiver.Address, events[0].Account)
+}
+
+func TestReceiveEvents(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	pending := "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE"
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "block_info":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "account_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "5",
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			case "process":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", 1),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	// The pow client only has work for the mocked frontier, so don't cache the ones we publish
+	conf := *MockWallet.Config
+	conf.Wallet.FrontierCacheSize = 0
+	eventWallet := &NanoWallet{
+		DB:         MockWallet.DB,
+		Ctx:        MockWallet.Ctx,
+		RpcClient:  MockWallet.RpcClient,
+		WorkClient: MockWallet.WorkClient,
+		Config:     &conf,
+	}
+	seed, _ := utils.GenerateSeed(strings.NewReader("6d9c2f5b8e1d4a7c0f3b6e9d2a5c8f1b4e7d0a3c6f9b2e5d8a1c4f7b0e3d6a9c"))
+	wallet, err := eventWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := eventWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	sub := eventWallet.SubscribeEvents()
+	defer sub.Close()
+	sub.Add(wallet.ID.String(), nil)
+
+	hash, err := eventWallet.CreateAndPublishReceiveBlock(wallet, acc.Address, pending, nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("%064X", 1), hash)
+	events := pendingEvents(sub)
+	assert.Len(t, events, 2)
+	assert.Equal(t, models.WalletEventWork, events[0].Event)
+	assert.Equal(t, acc.Address, events[0].Account)
+	assert.Equal(t, "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3", events[0].Hash)
+	assert.Equal(t, models.WalletEventPocketed, events[1].Event)
+	assert.Equal(t, wallet.ID.String(), events[1].Wallet)
+	assert.Equal(t, acc.Address, events[1].Account)
+	assert.Equal(t, fmt.Sprintf("%064X", 1), events[1].Hash)
+	assert.Equal(t, pending, events[1].Source)
+
+	// With the work given none is generated
+	work := "0000000000000000"
+	_, err = eventWallet.CreateAndPublishReceiveBlock(wallet, acc.Address, pending, &work, nil)
+	assert.Nil(t, err)
+	events = pendingEvents(sub)
+	assert.Len(t, events, 1)
+	assert.Equal(t, models.WalletEventPocketed, events[0].Event)
+}
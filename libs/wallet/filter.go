@@ -0,0 +1,129 @@
+package wallet
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+var ErrInvalidBalanceFilter = errors.New("invalid balance filter")
+
+// Criteria for AccountsFilter, nil fields aren't filtered on and the rest are ANDed
+type AccountsFilter struct {
+	// Raw amounts, inclusive
+	MinBalance *string
+	MaxBalance *string
+	// Accounts that aren't opened have no representative, so they never match
+	Representative *string
+}
+
+// An account that matched a filter
+type FilteredAccount struct {
+	Address    string
+	BalanceRaw string
+	// Only set when filtering on representative
+	Representative *string
+}
+
+// Find the accounts of a wallet matching filter, oldest first
+// Balances come from one accounts_balances call, representatives from one accounts_representatives call if they're filtered on
+func (w *NanoWallet) AccountsFilter(wallet *ent.Wallet, filter AccountsFilter) ([]FilteredAccount, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	var err error
+	var minBalance, maxBalance *big.Int
+	if filter.MinBalance != nil {
+		parsed, ok := big.NewInt(0).SetString(*filter.MinBalance, 10)
+		if !ok || parsed.Sign() < 0 {
+			return nil, ErrInvalidBalanceFilter
+		}
+		minBalance = parsed
+	}
+	if filter.MaxBalance != nil {
+		parsed, ok := big.NewInt(0).SetString(*filter.MaxBalance, 10)
+		if !ok || parsed.Sign() < 0 || (minBalance != nil && parsed.Cmp(minBalance) < 0) {
+			return nil, ErrInvalidBalanceFilter
+		}
+		maxBalance = parsed
+	}
+
+	var representativePub []byte
+	if filter.Representative != nil {
+		representativePub, err = utils.AddressToPub(*filter.Representative, w.Banano)
+		if err != nil {
+			return nil, ErrInvalidAccount
+		}
+	}
+
+	// Fails if the wallet is locked
+	_, err = GetDecryptedKeyFromStorage(wallet, "seed")
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Order(ent.Asc(account.FieldCreatedAt)).All(w.Ctx)
+	if err != nil || len(accounts) == 0 {
+		return []FilteredAccount{}, err
+	}
+	addresses := make([]string, len(accounts))
+	for i, acc := range accounts {
+		addresses[i] = acc.Address
+	}
+
+	balancesResp, err := w.RpcClient.MakeAccountsBalancesRequest(addresses)
+	if err != nil {
+		return nil, err
+	}
+	var representatives map[string]string
+	if filter.Representative != nil {
+		representativesResp, err := w.RpcClient.MakeAccountsRepresentativesRequest(addresses)
+		if err != nil {
+			return nil, err
+		}
+		representatives = *representativesResp.Representatives
+	}
+
+	matches := []FilteredAccount{}
+	for _, address := range addresses {
+		// Unopened accounts have no balance
+		balance := "0"
+		if item, ok := (*balancesResp.Balances)[address]; ok && item.Balance != "" {
+			balance = item.Balance
+		}
+		balanceRaw, ok := big.NewInt(0).SetString(balance, 10)
+		if !ok {
+			return nil, errors.New("Unable to parse balance")
+		}
+		if minBalance != nil && balanceRaw.Cmp(minBalance) < 0 {
+			continue
+		}
+		if maxBalance != nil && balanceRaw.Cmp(maxBalance) > 0 {
+			continue
+		}
+
+		match := FilteredAccount{
+			Address:    address,
+			BalanceRaw: balanceRaw.String(),
+		}
+		if filter.Representative != nil {
+			// Compared by public key, so nano_ and xrb_ addresses match
+			representative, ok := representatives[address]
+			if !ok {
+				continue
+			}
+			pub, err := utils.AddressToPub(representative, w.Banano)
+			if err != nil || !bytes.Equal(pub, representativePub) {
+				continue
+			}
+			match.Representative = &representative
+		}
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
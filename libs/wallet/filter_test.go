@@ -0,0 +1,120 @@
+package wallet
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountsFilter(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("1b4e7c0f3a6d9b2e5c8f1a4d7b0e3c6f9a2d5b8e1c4f7a0d3b6e9c2f5a8d1b4e"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	created, err := MockWallet.AccountsCreate(wallet, 3)
+	assert.Nil(t, err)
+	pub, _, _ := utils.KeypairFromSeed(seed, 0)
+	first := utils.PubKeyToAddress(pub, false)
+	rich, poor, unopened := created[0].Address, created[1].Address, created[2].Address
+
+	repA := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	repB := "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k"
+	balances := map[string]string{first: "500", rich: "10000", poor: "5"}
+	representatives := map[string]string{first: repA, rich: repA, poor: repB}
+	calls := map[string]int{}
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var ar requests.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&ar)
+			calls[ar.Action]++
+			switch ar.Action {
+			case "accounts_balances":
+				resp := map[string]interface{}{}
+				for _, account := range ar.Accounts {
+					if balance, ok := balances[account]; ok {
+						resp[account] = map[string]interface{}{"balance": balance, "pending": "0", "receivable": "0"}
+					}
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"balances": resp})
+			case "accounts_representatives":
+				resp := map[string]string{}
+				for _, account := range ar.Accounts {
+					if representative, ok := representatives[account]; ok {
+						resp[account] = representative
+					}
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"representatives": resp})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+	addressesOf := func(matches []FilteredAccount) []string {
+		addresses := []string{}
+		for _, match := range matches {
+			addresses = append(addresses, match.Address)
+		}
+		return addresses
+	}
+	str := func(s string) *string { return &s }
+
+	// No filters, everything with its balance, unopened is 0
+	matches, err := MockWallet.AccountsFilter(wallet, AccountsFilter{})
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{first, rich, poor, unopened}, addressesOf(matches))
+	for _, match := range matches {
+		if match.Address == unopened {
+			assert.Equal(t, "0", match.BalanceRaw)
+		}
+		assert.Nil(t, match.Representative)
+	}
+	assert.Equal(t, 0, calls["accounts_representatives"])
+
+	// Minimum only, inclusive
+	matches, err = MockWallet.AccountsFilter(wallet, AccountsFilter{MinBalance: str("500")})
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{first, rich}, addressesOf(matches))
+
+	// Maximum only, inclusive
+	matches, err = MockWallet.AccountsFilter(wallet, AccountsFilter{MaxBalance: str("500")})
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{first, poor, unopened}, addressesOf(matches))
+
+	// Representative only, unopened accounts have none, an xrb_ address is the same
+	matches, err = MockWallet.AccountsFilter(wallet, AccountsFilter{Representative: str("xrb" + repA[4:])})
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{first, rich}, addressesOf(matches))
+	assert.Equal(t, repA, *matches[0].Representative)
+	assert.Equal(t, 1, calls["accounts_representatives"])
+
+	// Combined
+	matches, err = MockWallet.AccountsFilter(wallet, AccountsFilter{MinBalance: str("1"), MaxBalance: str("1000"), Representative: str(repA)})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{first}, addressesOf(matches))
+	assert.Equal(t, "500", matches[0].BalanceRaw)
+	matches, err = MockWallet.AccountsFilter(wallet, AccountsFilter{MaxBalance: str("1000"), Representative: str(repB)})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{poor}, addressesOf(matches))
+
+	// One call per lookup
+	assert.Equal(t, 6, calls["accounts_balances"])
+
+	// Bad filters
+	_, err = MockWallet.AccountsFilter(wallet, AccountsFilter{MinBalance: str("-1")})
+	assert.ErrorIs(t, err, ErrInvalidBalanceFilter)
+	_, err = MockWallet.AccountsFilter(wallet, AccountsFilter{MinBalance: str("10"), MaxBalance: str("5")})
+	assert.ErrorIs(t, err, ErrInvalidBalanceFilter)
+	_, err = MockWallet.AccountsFilter(wallet, AccountsFilter{MaxBalance: str("1.5")})
+	assert.ErrorIs(t, err, ErrInvalidBalanceFilter)
+	_, err = MockWallet.AccountsFilter(wallet, AccountsFilter{Representative: str("nano_1234")})
+	assert.ErrorIs(t, err, ErrInvalidAccount)
+	_, err = MockWallet.AccountsFilter(nil, AccountsFilter{})
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+}
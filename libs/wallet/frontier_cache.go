@@ -0,0 +1,206 @@
+package wallet
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+)
+
+// An in-memory LRU of account frontiers and balances, so sends don't have to ask the node for account_info every time
+// It's updated with every block we publish and invalidated when publishing fails
+// Entries expire after the TTL, so a frontier that changed somewhere else (e.g. another instance) isn't used for long
+// Work pre-generated for a frontier is kept past the TTL, it's only used while it's for the frontier we're building on
+
+type cachedFrontier struct {
+	address  string
+	frontier string
+	balance  string
+	// Pre-generated work for frontier, if there is any
+	work    string
+	expires time.Time
+}
+
+type frontierCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	clock   Clock
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// Returns nil if size or ttl is < 1, a nil cache is valid and never has anything in it
+func newFrontierCache(size int, ttl time.Duration, clock Clock) *frontierCache {
+	if size < 1 || ttl <= 0 {
+		return nil
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &frontierCache{
+		size:    size,
+		ttl:     ttl,
+		clock:   clock,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (c *frontierCache) Get(address string) (*cachedFrontier, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[address]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*cachedFrontier)
+	if !c.clock.Now().Before(entry.expires) {
+		// Keep the work, it's still good if the frontier didn't change
+		if entry.work == "" {
+			c.order.Remove(el)
+			delete(c.entries, address)
+		}
+		return nil, false
+	}
+	// Only work, the balance has to come from the node
+	if entry.balance == "" {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return entry, true
+}
+
+// Pre-generated work for the account's frontier, the TTL doesn't matter since it's only returned for the same frontier
+func (c *frontierCache) Work(address string, frontier string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[address]
+	if !ok {
+		return "", false
+	}
+	entry := el.Value.(*cachedFrontier)
+	if entry.work == "" || entry.frontier != frontier {
+		return "", false
+	}
+	c.order.MoveToFront(el)
+	return entry.work, true
+}
+
+func (c *frontierCache) Set(address string, frontier string, balance string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cachedFrontier{
+		address:  address,
+		frontier: frontier,
+		balance:  balance,
+		expires:  c.clock.Now().Add(c.ttl),
+	}
+	if el, ok := c.entries[address]; ok {
+		// Work for the same frontier is still good
+		if old := el.Value.(*cachedFrontier); old.frontier == frontier {
+			entry.work = old.work
+		}
+		el.Value = entry
+		c.order.MoveToFront(el)
+		return
+	}
+	c.push(entry)
+}
+
+// Store work generated for frontier, the frontier and balance we already have for the account are kept if it's the same frontier
+func (c *frontierCache) SetWork(address string, frontier string, work string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[address]; ok {
+		entry := el.Value.(*cachedFrontier)
+		if entry.frontier == frontier {
+			entry.work = work
+		} else {
+			el.Value = &cachedFrontier{
+				address:  address,
+				frontier: frontier,
+				work:     work,
+			}
+		}
+		c.order.MoveToFront(el)
+		return
+	}
+	c.push(&cachedFrontier{
+		address:  address,
+		frontier: frontier,
+		work:     work,
+	})
+}
+
+// Add a new entry, c.mu must be held
+func (c *frontierCache) push(entry *cachedFrontier) {
+	c.entries[entry.address] = c.order.PushFront(entry)
+
+	// Evict the least recently used
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedFrontier).address)
+	}
+}
+
+func (c *frontierCache) Invalidate(address string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[address]; ok {
+		c.order.Remove(el)
+		delete(c.entries, address)
+	}
+}
+
+// The frontier cache is created on first use from the wallet config
+func (w *NanoWallet) frontiers() *frontierCache {
+	root := w.root()
+	root.frontierCacheOnce.Do(func() {
+		root.frontierCache = newFrontierCache(root.Config.Wallet.FrontierCacheSize, time.Duration(root.Config.Wallet.FrontierCacheTTL)*time.Second, nil)
+	})
+	return root.frontierCache
+}
+
+// Frontier and balance of an account, from the cache if we have them
+func (w *NanoWallet) accountFrontier(address string) (*responses.AccountInfoResponse, error) {
+	if cached, ok := w.frontiers().Get(address); ok {
+		return &responses.AccountInfoResponse{
+			Frontier: cached.frontier,
+			Balance:  cached.balance,
+		}, nil
+	}
+	return w.RpcClient.MakeAccountInfoRequest(address)
+}
+
+// Work from WorkPrefetch for the frontier we're building on, if it's enough for difficulty
+func (w *NanoWallet) prefetchedWork(address string, frontier string, difficulty int) (string, bool) {
+	work, ok := w.frontiers().Work(address, frontier)
+	if !ok || !pow.IsWorkValid(frontier, difficulty, work) {
+		return "", false
+	}
+	return work, true
+}
@@ -0,0 +1,170 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrontierCache(t *testing.T) {
+	clock := &mockClock{now: time.Unix(1700000000, 0)}
+	cache := newFrontierCache(2, time.Second*30, clock)
+
+	cache.Set("nano_1", "frontier1", "1")
+	cache.Set("nano_2", "frontier2", "2")
+	entry, ok := cache.Get("nano_1")
+	assert.True(t, ok)
+	assert.Equal(t, "frontier1", entry.frontier)
+	assert.Equal(t, "1", entry.balance)
+
+	// nano_2 is the least recently used
+	cache.Set("nano_3", "frontier3", "3")
+	_, ok = cache.Get("nano_2")
+	assert.False(t, ok)
+	_, ok = cache.Get("nano_1")
+	assert.True(t, ok)
+	_, ok = cache.Get("nano_3")
+	assert.True(t, ok)
+
+	// Updating replaces the entry
+	cache.Set("nano_1", "frontier4", "4")
+	entry, ok = cache.Get("nano_1")
+	assert.True(t, ok)
+	assert.Equal(t, "frontier4", entry.frontier)
+
+	cache.Invalidate("nano_1")
+	_, ok = cache.Get("nano_1")
+	assert.False(t, ok)
+
+	// Expired
+	clock.Advance(time.Second * 30)
+	_, ok = cache.Get("nano_3")
+	assert.False(t, ok)
+
+	// Disabled
+	assert.Nil(t, newFrontierCache(0, time.Second*30, nil))
+	assert.Nil(t, newFrontierCache(10, 0, nil))
+	var disabled *frontierCache
+	disabled.Set("nano_1", "frontier1", "1")
+	_, ok = disabled.Get("nano_1")
+	assert.False(t, ok)
+	disabled.Invalidate("nano_1")
+}
+
+func TestFrontierCacheWork(t *testing.T) {
+	clock := &mockClock{now: time.Unix(1700000000, 0)}
+	cache := newFrontierCache(2, time.Second*30, clock)
+
+	// Work alone isn't a frontier and balance we can build on
+	cache.SetWork("nano_1", "frontier1", "work1")
+	_, ok := cache.Get("nano_1")
+	assert.False(t, ok)
+	work, ok := cache.Work("nano_1", "frontier1")
+	assert.True(t, ok)
+	assert.Equal(t, "work1", work)
+	_, ok = cache.Work("nano_1", "frontier2")
+	assert.False(t, ok)
+
+	// Publishing on the same frontier keeps the work, a new frontier drops it
+	cache.Set("nano_1", "frontier1", "1")
+	work, ok = cache.Work("nano_1", "frontier1")
+	assert.True(t, ok)
+	assert.Equal(t, "work1", work)
+	cache.Set("nano_1", "frontier2", "2")
+	_, ok = cache.Work("nano_1", "frontier2")
+	assert.False(t, ok)
+
+	// The work outlives the TTL
+	cache.SetWork("nano_1", "frontier2", "work2")
+	clock.Advance(time.Second * 30)
+	_, ok = cache.Get("nano_1")
+	assert.False(t, ok)
+	work, ok = cache.Work("nano_1", "frontier2")
+	assert.True(t, ok)
+	assert.Equal(t, "work2", work)
+
+	cache.Invalidate("nano_1")
+	_, ok = cache.Work("nano_1", "frontier2")
+	assert.False(t, ok)
+
+	var disabled *frontierCache
+	disabled.SetWork("nano_1", "frontier1", "work1")
+	_, ok = disabled.Work("nano_1", "frontier1")
+	assert.False(t, ok)
+}
+
+func TestSendUsesCachedFrontier(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	accountInfoCalls := 0
+	processed := 0
+	fork := false
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				accountInfoCalls++
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "process" && fork {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"error": "Fork",
+				})
+			} else if pr.Action == "process" {
+				processed++
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", processed),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("6a1d4f7b0e3c9a2d5f8b1e4a7c0d3f6b9e2a5c8d1f4b7e0a3c6d9f2b5e8a1c47"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	work := "0000000000000000"
+
+	_, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, nil, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, accountInfoCalls)
+
+	// The second send builds on the block we just published without asking the node
+	_, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, nil, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, accountInfoCalls)
+	cached, ok := MockWallet.frontiers().Get(acc.Address)
+	assert.True(t, ok)
+	assert.Equal(t, fmt.Sprintf("%064X", 2), cached.frontier)
+	assert.Equal(t, "11999999999999999918751838129509867131", cached.balance)
+
+	// A failed publish invalidates it
+	fork = true
+	_, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, nil, &work, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, accountInfoCalls)
+	_, ok = MockWallet.frontiers().Get(acc.Address)
+	assert.False(t, ok)
+
+	fork = false
+	_, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, nil, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, accountInfoCalls)
+}
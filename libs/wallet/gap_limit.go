@@ -0,0 +1,53 @@
+package wallet
+
+import (
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+)
+
+// How many accounts of the wallet's seed in a row, from the highest index down, have never been opened
+// Only the last limit accounts are looked up with accounts_frontiers, so it's at most limit
+// Adhoc accounts and accounts of another seed aren't part of the sequence
+func (w *NanoWallet) AccountsGap(wallet *ent.Wallet, limit int) (int, error) {
+	if wallet == nil {
+		return 0, ErrInvalidWallet
+	} else if limit < 1 {
+		return 0, nil
+	}
+
+	// Fails if the wallet is locked
+	if _, err := GetDecryptedKeyFromStorage(wallet, "seed"); err != nil {
+		return 0, err
+	}
+
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil(), account.PrivateKeyIsNil(), account.SeedIsNil()).Order(ent.Desc(account.FieldAccountIndex)).Limit(limit).All(w.Ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(accounts) < 1 {
+		return 0, nil
+	}
+	addresses := make([]string, len(accounts))
+	for i, acct := range accounts {
+		addresses[i] = acct.Address
+	}
+
+	// Accounts that were never opened are in errors as "Account not found"
+	resp, err := w.RpcClient.MakeAccountsFrontiersRequest(addresses)
+	if err != nil {
+		return 0, err
+	}
+	frontiers := map[string]string{}
+	if resp.Frontiers != nil {
+		frontiers = *resp.Frontiers
+	}
+
+	gap := 0
+	for _, address := range addresses {
+		if _, ok := frontiers[address]; ok {
+			break
+		}
+		gap++
+	}
+	return gap, nil
+}
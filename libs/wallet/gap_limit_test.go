@@ -0,0 +1,89 @@
+package wallet
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountsGap(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("9e2b5d8a1c4f7e0b3d6a9c2f5e8b1d4a7c0f3e6b9d2a5c8f1e4b7d0a3c6f9e2b"))
+	address := func(index uint32) string {
+		pub, _, _ := utils.KeypairFromSeed(seed, index)
+		return utils.PubKeyToAddress(pub, false)
+	}
+
+	opened := map[string]bool{}
+	var requested []string
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] != "accounts_frontiers" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+			}
+			requested = []string{}
+			frontiers := map[string]string{}
+			errors := map[string]string{}
+			for _, acc := range pr["accounts"].([]interface{}) {
+				requested = append(requested, acc.(string))
+				if opened[acc.(string)] {
+					frontiers[acc.(string)] = "791AF413173EEE674A6FCF633B5DFC0F3C33F397F0DA08E987D9E0741D40D81A"
+				} else {
+					errors[acc.(string)] = "Account not found"
+				}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"frontiers": frontiers, "errors": errors})
+		},
+	)
+
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	_, err = MockWallet.AccountsCreate(wallet, 4)
+	assert.Nil(t, err)
+	// Not part of the seed's sequence
+	_, priv, _ := ed25519.GenerateKey(strings.NewReader("1d4a7c0f3e6b9d2a5c8f1e4b7d0a3c6f9e2b5d8a1c4f7e0b3d6a9c2f5e8b1d4a"))
+	_, err = MockWallet.AdhocAccountCreate(wallet, priv)
+	assert.Nil(t, err)
+
+	// Nothing opened, every account counts
+	gap, err := MockWallet.AccountsGap(wallet, 20)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, gap)
+	assert.Equal(t, []string{address(4), address(3), address(2), address(1), address(0)}, requested)
+
+	// Counted from the highest index down to the first opened account
+	opened[address(1)] = true
+	gap, err = MockWallet.AccountsGap(wallet, 20)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, gap)
+
+	opened[address(4)] = true
+	gap, err = MockWallet.AccountsGap(wallet, 20)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, gap)
+
+	// Only the last limit accounts are looked up
+	delete(opened, address(4))
+	gap, err = MockWallet.AccountsGap(wallet, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, gap)
+	assert.Len(t, requested, 2)
+
+	_, err = MockWallet.AccountsGap(nil, 20)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	// Needs the wallet to be unlocked
+	MockWallet.EncryptWallet(wallet, "password")
+	_, err = MockWallet.AccountsGap(wallet, 20)
+	assert.ErrorIs(t, err, ErrWalletLocked)
+}
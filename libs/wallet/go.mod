@@ -14,6 +14,7 @@ require (
 	github.com/mitchellh/mapstructure v1.5.0
 	github.com/stretchr/testify v1.8.0
 	golang.org/x/crypto v0.24.0
+	golang.org/x/sync v0.7.0
 )
 
 require (
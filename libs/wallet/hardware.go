@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/google/uuid"
+)
+
+// Hardware wallets sign on a Ledger with the Nano app open, only the index and address of their accounts are stored
+// Accounts added with their private key (wallet_add) are still signed in process
+
+// The Ledger is created on first use from ledger_device, unless it was set
+func (w *NanoWallet) ledger() (*Ledger, error) {
+	root := w.root()
+	root.ledgerOnce.Do(func() {
+		if root.Ledger == nil && root.Config.Wallet.LedgerDevice != "" {
+			root.Ledger = &Ledger{Transport: &LedgerHID{Path: root.Config.Wallet.LedgerDevice}, Banano: root.Banano}
+		}
+	})
+	if root.Ledger == nil {
+		return nil, ErrLedgerNotConfigured
+	}
+	return root.Ledger, nil
+}
+
+// Create a hardware wallet for the Ledger, with its account at index 0
+func (w *NanoWallet) WalletCreateHardware(name *string) (*ent.Wallet, error) {
+	if name != nil && (*name == "" || len(*name) > 128) {
+		return nil, ErrInvalidWalletName
+	}
+	ledger, err := w.ledger()
+	if err != nil {
+		return nil, err
+	}
+	pub, err := ledger.PublicKey(0)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := w.DB.Tx(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	// There's no seed here, the wallet's ID keeps the unique seed column unique
+	id := uuid.New()
+	wallet, err := tx.Wallet.Create().SetID(id).SetSeed(hardwareSeed(id)).SetHardware(true).SetNillableName(name).Save(w.Ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = tx.Account.Create().SetWallet(wallet).SetAddress(utils.PubKeyToAddress(pub, w.Banano)).SetAccountIndex(0).Save(w.Ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return wallet, nil
+}
+
+// What's stored as the seed of a hardware wallet, it's never returned as one
+func hardwareSeed(id uuid.UUID) string {
+	return "hardware:" + id.String()
+}
+
+// The address at index of the wallet's seed, hardware wallets ask the Ledger
+func (w *NanoWallet) deriveAddress(wallet *ent.Wallet, seed string, index int) (string, error) {
+	if wallet.Hardware {
+		ledger, err := w.ledger()
+		if err != nil {
+			return "", err
+		}
+		pub, err := ledger.PublicKey(uint32(index))
+		if err != nil {
+			return "", err
+		}
+		return utils.PubKeyToAddress(pub, w.Banano), nil
+	}
+	pub, _, err := utils.KeypairFromSeed(seed, uint32(index))
+	if err != nil {
+		return "", err
+	}
+	return utils.PubKeyToAddress(pub, w.Banano), nil
+}
@@ -0,0 +1,73 @@
+package wallet
+
+import (
+	"math/big"
+	"testing"
+
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHardwareWallet(t *testing.T) {
+	// Nothing to sign with
+	_, err := MockWallet.WalletCreateHardware(nil)
+	assert.ErrorIs(t, err, ErrLedgerNotConfigured)
+
+	seed := "C2F5B8E1A4D7C0F3B6E9A2D5C8F1B4E7A0D3C6F9B2E5A8D1C4F7B0E3A6D9C2F5"
+	device := &emulatedLedger{seed: seed}
+	address := func(index uint32) string {
+		pub, _, _ := utils.KeypairFromSeed(seed, index)
+		return utils.PubKeyToAddress(pub, false)
+	}
+	MockWallet.Ledger = &Ledger{Transport: device}
+	defer func() {
+		MockWallet.Ledger = nil
+	}()
+
+	wallet, err := MockWallet.WalletCreateHardware(nil)
+	assert.Nil(t, err)
+	assert.True(t, wallet.Hardware)
+	first, err := MockWallet.GetAccount(wallet, address(0))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, *first.AccountIndex)
+	assert.Nil(t, first.PrivateKey)
+
+	// Addresses come from the Ledger
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, *acc.AccountIndex)
+	assert.Equal(t, address(1), acc.Address)
+	index := 5
+	acc, err = MockWallet.AccountCreate(wallet, &index)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, *acc.AccountIndex)
+	assert.Nil(t, acc.PrivateKey)
+	accounts, err := MockWallet.AccountsCreate(wallet, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, *accounts[0].AccountIndex)
+	assert.Equal(t, address(7), accounts[1].Address)
+
+	// Signed on the Ledger
+	signed, err := MockWallet.SignBlock(wallet, nanoblock.StateBlock{
+		Account:        acc.Address,
+		Previous:       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		Representative: "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+		Balance:        "600",
+		Link:           "D4BBFA50649D80E5F63FC396C6F4CF6321CABD7C1480E964C2701D56AAFEB5E3",
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, signed.VerifySignature())
+	assert.Equal(t, ledgerInsSignBlock, device.apdus[len(device.apdus)-1][1])
+	device.rejected = true
+	_, err = MockWallet.SignBlock(wallet, *signed)
+	assert.ErrorIs(t, err, ErrLedgerRejected)
+
+	// The keys never leave the Ledger
+	_, err = accountPrivateKey(wallet, acc)
+	assert.ErrorIs(t, err, ErrNoPrivateKey)
+	stored, err := GetDecryptedKeyFromStorage(wallet, "seed")
+	assert.Nil(t, err)
+	assert.Empty(t, stored)
+	assert.False(t, MockWallet.ShouldAutoReceive(wallet, big.NewInt(1000000000000000000)))
+}
@@ -0,0 +1,50 @@
+package wallet
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+)
+
+var ErrHashNotFoundInChain = errors.New("hash not found in chain")
+
+// Blocks per account_history call of AccountHistorySince
+const historySincePageSize = 100
+
+// The history of an account in the wallet after sinceHash, newest first, for clients that poll for new blocks
+// The chain is read from the frontier back until sinceHash, at most maxDepth blocks
+// If sinceHash isn't in those, e.g. because the chain forked, it's ErrHashNotFoundInChain
+// account_history only has sends and receives, so sinceHash has to be one of those
+func (w *NanoWallet) AccountHistorySince(wallet *ent.Wallet, address string, sinceHash string, maxDepth int) ([]rpcresponses.AccountHistoryEntry, error) {
+	// Fails if the wallet is locked
+	acc, err := w.GetAccount(wallet, address)
+	if err != nil {
+		return nil, err
+	}
+
+	history := []rpcresponses.AccountHistoryEntry{}
+	read := 0
+	var head *string
+	for read < maxDepth {
+		resp, err := w.RpcClient.MakeAccountHistoryRequest(acc.Address, min(historySincePageSize, maxDepth-read), head)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range resp.History {
+			if strings.EqualFold(entry.Hash, sinceHash) {
+				return history, nil
+			}
+			history = append(history, entry)
+		}
+		read += len(resp.History)
+		// No previous means this page ended at the open block
+		if resp.Previous == "" || len(resp.History) == 0 {
+			break
+		}
+		head = &resp.Previous
+	}
+
+	return nil, ErrHashNotFoundInChain
+}
@@ -0,0 +1,98 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountHistorySince(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("7c2f5b0e3d8a1c6f9b4e7d2a5c0f3b8e3b8e1d6a9c4f7b2e5d0a3c8f1b6e9d4a"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	// A chain of 250 blocks, the frontier is at height 250
+	hash := func(height int) string {
+		return fmt.Sprintf("%064X", height)
+	}
+	var requested []requests.AccountHistoryRequest
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var hr requests.AccountHistoryRequest
+			json.NewDecoder(req.Body).Decode(&hr)
+			requested = append(requested, hr)
+			height := 250
+			if hr.Head != nil {
+				fmt.Sscanf(*hr.Head, "%X", &height)
+			}
+			history := []interface{}{}
+			for ; height > 0 && len(history) < hr.Count; height-- {
+				history = append(history, map[string]interface{}{
+					"type":      "receive",
+					"account":   "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est",
+					"amount":    "1",
+					"height":    fmt.Sprint(height),
+					"hash":      hash(height),
+					"confirmed": "true",
+				})
+			}
+			resp := map[string]interface{}{"account": hr.Account, "history": history}
+			if height > 0 {
+				resp["previous"] = hash(height)
+			}
+			return httpmock.NewJsonResponse(200, resp)
+		},
+	)
+
+	// Only the blocks after since_hash
+	history, err := MockWallet.AccountHistorySince(wallet, acc.Address, hash(247), 1000)
+	assert.Nil(t, err)
+	assert.Len(t, history, 3)
+	assert.Equal(t, hash(250), history[0].Hash)
+	assert.Equal(t, hash(248), history[2].Hash)
+	assert.Len(t, requested, 1)
+	assert.Equal(t, acc.Address, requested[0].Account)
+
+	// Nothing new since the frontier
+	history, err = MockWallet.AccountHistorySince(wallet, acc.Address, hash(250), 1000)
+	assert.Nil(t, err)
+	assert.Empty(t, history)
+
+	// Further back it's read page by page, hashes match in any case
+	requested = nil
+	history, err = MockWallet.AccountHistorySince(wallet, acc.Address, strings.ToLower(hash(10)), 1000)
+	assert.Nil(t, err)
+	assert.Len(t, history, 240)
+	assert.Equal(t, hash(11), history[239].Hash)
+	assert.Len(t, requested, 3)
+	assert.Equal(t, hash(150), *requested[1].Head)
+
+	// A hash that isn't in the chain
+	_, err = MockWallet.AccountHistorySince(wallet, acc.Address, "80392607E85E73CC3E94B4126F24488EBDFEB174944B890C97E8F36D89591DC5", 1000)
+	assert.ErrorIs(t, err, ErrHashNotFoundInChain)
+
+	// Or is deeper than max depth
+	requested = nil
+	_, err = MockWallet.AccountHistorySince(wallet, acc.Address, hash(10), 120)
+	assert.ErrorIs(t, err, ErrHashNotFoundInChain)
+	assert.Len(t, requested, 2)
+	assert.Equal(t, 20, requested[1].Count)
+
+	// The account has to be in the wallet
+	_, err = MockWallet.AccountHistorySince(wallet, "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", hash(10), 1000)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+	_, err = MockWallet.AccountHistorySince(nil, acc.Address, hash(10), 1000)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+}
@@ -0,0 +1,114 @@
+package wallet
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/google/uuid"
+)
+
+var ErrJobNotFound = errors.New("job not found")
+
+// Jobs run an action in the background, its state is kept in the database so it can be polled
+// Jobs are forgotten job_ttl seconds after they were started
+
+// Reports a job's progress, result is what the action returned so far
+type JobProgress func(percent int, result interface{})
+
+// The work of a job, returns the action's result, or what it did before an error
+type JobFunc func(progress JobProgress) (interface{}, error)
+
+// Start run in the background as a job of the wallet, it's pending until it starts running
+func (w *NanoWallet) JobStart(wallet *ent.Wallet, action string, run JobFunc) (*ent.Job, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+
+	if err := w.deleteExpiredJobs(); err != nil {
+		return nil, err
+	}
+
+	// Shutdown waits for it
+	if !w.begin() {
+		return nil, ErrShuttingDown
+	}
+	dbJob, err := w.DB.Job.Create().SetWalletID(wallet.ID).SetAction(action).Save(w.Ctx)
+	if err != nil {
+		w.done()
+		return nil, err
+	}
+
+	go func() {
+		defer w.done()
+		w.runJob(dbJob.ID, run)
+	}()
+
+	return dbJob, nil
+}
+
+// The current state of a job
+func (w *NanoWallet) JobGet(id uuid.UUID) (*ent.Job, error) {
+	if err := w.deleteExpiredJobs(); err != nil {
+		return nil, err
+	}
+
+	dbJob, err := w.DB.Job.Get(w.Ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, ErrJobNotFound
+	}
+	return dbJob, err
+}
+
+func (w *NanoWallet) runJob(id uuid.UUID, run JobFunc) {
+	w.trackJob(id, true)
+	defer w.trackJob(id, false)
+	if err := w.DB.Job.UpdateOneID(id).SetStatus(job.StatusRunning).Exec(w.Ctx); err != nil {
+		log.Errorf("Error starting job %s %s", id, err)
+		return
+	}
+
+	var partial map[string]interface{}
+	result, err := run(func(percent int, result interface{}) {
+		partial = jobResult(result)
+		if err := w.DB.Job.UpdateOneID(id).SetPercent(percent).SetResult(partial).Exec(w.Ctx); err != nil {
+			log.Errorf("Error updating job %s %s", id, err)
+		}
+	})
+	if err != nil {
+		// Keep what it did until it failed
+		update := w.DB.Job.UpdateOneID(id).SetStatus(job.StatusFailed).SetError(err.Error())
+		if result != nil {
+			update.SetResult(jobResult(result))
+		} else if partial != nil {
+			update.SetResult(partial)
+		}
+		if err := update.Exec(w.Ctx); err != nil {
+			log.Errorf("Error failing job %s %s", id, err)
+		}
+		return
+	}
+	if err := w.DB.Job.UpdateOneID(id).SetStatus(job.StatusDone).SetPercent(100).SetResult(jobResult(result)).Exec(w.Ctx); err != nil {
+		log.Errorf("Error finishing job %s %s", id, err)
+	}
+}
+
+// A result as it's stored, the same as it would be encoded in a response
+func jobResult(result interface{}) map[string]interface{} {
+	stored := map[string]interface{}{}
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return stored
+	}
+	json.Unmarshal(encoded, &stored)
+	return stored
+}
+
+func (w *NanoWallet) deleteExpiredJobs() error {
+	expiry := time.Now().Add(-time.Duration(w.Config.Wallet.JobTTL) * time.Second)
+	_, err := w.DB.Job.Delete().Where(job.CreatedAtLT(expiry)).Exec(w.Ctx)
+	return err
+}
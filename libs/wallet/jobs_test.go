@@ -0,0 +1,94 @@
+package wallet
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func waitForJob(t *testing.T, id uuid.UUID, status job.Status) *ent.Job {
+	t.Helper()
+	var dbJob *ent.Job
+	assert.Eventually(t, func() bool {
+		var err error
+		dbJob, err = MockWallet.JobGet(id)
+		return err == nil && dbJob.Status == status
+	}, 5*time.Second, 10*time.Millisecond)
+	return dbJob
+}
+
+func TestJobLifecycle(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("2f7c4a9e1b6d3f8a5c0e7b2d9f4a1c6e3b8d5f0a7c2e9b4d1f6a3c8e5b0d7f2c"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+
+	step := make(chan bool)
+	dbJob, err := MockWallet.JobStart(wallet, "receive_all", func(progress JobProgress) (interface{}, error) {
+		<-step
+		progress(50, map[string]interface{}{"received": 1})
+		<-step
+		return map[string]interface{}{"received": 2}, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "receive_all", dbJob.Action)
+	assert.Equal(t, wallet.ID, dbJob.WalletID)
+
+	dbJob = waitForJob(t, dbJob.ID, job.StatusRunning)
+	assert.Equal(t, 0, dbJob.Percent)
+	assert.Nil(t, dbJob.Result)
+
+	// Partial results while it's running
+	step <- true
+	assert.Eventually(t, func() bool {
+		dbJob, _ = MockWallet.JobGet(dbJob.ID)
+		return dbJob.Percent == 50
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, job.StatusRunning, dbJob.Status)
+	assert.Equal(t, map[string]interface{}{"received": float64(1)}, dbJob.Result)
+
+	step <- true
+	dbJob = waitForJob(t, dbJob.ID, job.StatusDone)
+	assert.Equal(t, 100, dbJob.Percent)
+	assert.Equal(t, map[string]interface{}{"received": float64(2)}, dbJob.Result)
+	assert.Nil(t, dbJob.Error)
+
+	// Failed jobs keep their partial result
+	dbJob, err = MockWallet.JobStart(wallet, "accounts_create", func(progress JobProgress) (interface{}, error) {
+		progress(10, map[string]interface{}{"accounts": []string{"nano_1"}})
+		return nil, errors.New("node unavailable")
+	})
+	assert.Nil(t, err)
+	dbJob = waitForJob(t, dbJob.ID, job.StatusFailed)
+	assert.Equal(t, 10, dbJob.Percent)
+	assert.Equal(t, map[string]interface{}{"accounts": []interface{}{"nano_1"}}, dbJob.Result)
+	assert.Equal(t, "node unavailable", *dbJob.Error)
+
+	_, err = MockWallet.JobGet(uuid.New())
+	assert.ErrorIs(t, err, ErrJobNotFound)
+
+	_, err = MockWallet.JobStart(nil, "receive_all", nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+}
+
+func TestJobExpiry(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("9a4d1f6c3b8e5a0d7f2c9b4e1a6d3f8c5b0e7a2d9f4c1b6e3a8d5f0c7b2e9a4d"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+
+	expired, err := MockWallet.DB.Job.Create().SetWalletID(wallet.ID).SetAction("receive_all").SetCreatedAt(time.Now().Add(-time.Duration(MockWallet.Config.Wallet.JobTTL+1) * time.Second)).Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+	recent, err := MockWallet.DB.Job.Create().SetWalletID(wallet.ID).SetAction("receive_all").Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+
+	_, err = MockWallet.JobGet(expired.ID)
+	assert.ErrorIs(t, err, ErrJobNotFound)
+	_, err = MockWallet.JobGet(recent.ID)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,134 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"sync"
+
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+)
+
+var ErrLedgerNotConfigured = errors.New("ledger_device isn't set")
+var ErrLedgerRejected = errors.New("rejected on the ledger")
+var ErrLedgerLocked = errors.New("ledger is locked")
+var ErrLedgerAppNotOpen = errors.New("nano app isn't open on the ledger")
+var ErrLedgerResponse = errors.New("unexpected response from the ledger")
+
+// The Nano Ledger app's APDUs
+const (
+	ledgerCla           byte = 0xa1
+	ledgerInsGetAddress byte = 0x02
+	ledgerInsSignBlock  byte = 0x04
+)
+
+// Status words at the end of every response
+const (
+	ledgerStatusOK       = 0x9000
+	ledgerStatusLocked   = 0x6982
+	ledgerStatusRejected = 0x6985
+	ledgerStatusBadIns   = 0x6d00
+	ledgerStatusBadCla   = 0x6e00
+)
+
+const ledgerHardened uint32 = 0x80000000
+
+// Sends a command APDU to a Ledger and returns its response, with the status word
+type LedgerTransport interface {
+	Exchange(apdu []byte) ([]byte, error)
+}
+
+// A Ledger running the Nano app, the keys are derived on the device at 44'/165'/index' (198' for BANANO) and never leave it
+type Ledger struct {
+	Transport LedgerTransport
+	Banano    bool
+	// The device handles one command at a time
+	mu sync.Mutex
+}
+
+// The BIP32 path of the account at index, its length then every element
+func ledgerPath(index uint32, banano bool) []byte {
+	coin := uint32(165)
+	if banano {
+		coin = 198
+	}
+	path := []byte{3}
+	for _, element := range []uint32{44, coin, index} {
+		path = binary.BigEndian.AppendUint32(path, element|ledgerHardened)
+	}
+	return path
+}
+
+func (l *Ledger) exchange(ins byte, data []byte) ([]byte, error) {
+	apdu := append([]byte{ledgerCla, ins, 0x00, 0x00, byte(len(data))}, data...)
+	l.mu.Lock()
+	resp, err := l.Transport.Exchange(apdu)
+	l.mu.Unlock()
+	if err != nil {
+		return nil, err
+	} else if len(resp) < 2 {
+		return nil, ErrLedgerResponse
+	}
+	switch binary.BigEndian.Uint16(resp[len(resp)-2:]) {
+	case ledgerStatusOK:
+		return resp[:len(resp)-2], nil
+	case ledgerStatusLocked:
+		return nil, ErrLedgerLocked
+	case ledgerStatusRejected:
+		return nil, ErrLedgerRejected
+	case ledgerStatusBadIns, ledgerStatusBadCla:
+		return nil, ErrLedgerAppNotOpen
+	default:
+		return nil, ErrLedgerResponse
+	}
+}
+
+// The public key of the account at index, it isn't shown on the device
+func (l *Ledger) PublicKey(index uint32) (ed25519.PublicKey, error) {
+	resp, err := l.exchange(ledgerInsGetAddress, ledgerPath(index, l.Banano))
+	if err != nil {
+		return nil, err
+	} else if len(resp) < ed25519.PublicKeySize {
+		return nil, ErrLedgerResponse
+	}
+	return ed25519.PublicKey(resp[:ed25519.PublicKeySize]), nil
+}
+
+// Sign a state block with the account at index, the user confirms it on the device
+// The device hashes the block itself, it has to be the same hash and signed by the block's account
+func (l *Ledger) SignBlock(index uint32, sb *nanoblock.StateBlock) error {
+	if err := sb.Validate(); err != nil {
+		return err
+	}
+	// Validate already checked these
+	previous, _ := hex.DecodeString(sb.Previous)
+	link, _ := hex.DecodeString(sb.Link)
+	representative, _ := utils.AddressToPub(sb.Representative, sb.Banano)
+	balance, _ := big.NewInt(0).SetString(sb.Balance, 10)
+
+	data := ledgerPath(index, l.Banano)
+	data = append(data, previous...)
+	data = append(data, link...)
+	data = append(data, representative...)
+	data = append(data, balance.FillBytes(make([]byte, 16))...)
+	resp, err := l.exchange(ledgerInsSignBlock, data)
+	if err != nil {
+		return err
+	} else if len(resp) < 32+ed25519.SignatureSize {
+		return ErrLedgerResponse
+	}
+	hash := sb.Hash()
+	if !bytes.Equal(resp[:32], hash[:]) {
+		return ErrLedgerResponse
+	}
+	sb.Signature = hex.EncodeToString(resp[32 : 32+ed25519.SignatureSize])
+	if err := sb.VerifySignature(); err != nil {
+		sb.Signature = ""
+		return ErrInvalidSignature
+	}
+	return nil
+}
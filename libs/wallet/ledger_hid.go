@@ -0,0 +1,88 @@
+package wallet
+
+import (
+	"encoding/binary"
+	"os"
+)
+
+// Ledger's HID framing, every packet starts with the channel, the tag and its sequence number
+const (
+	ledgerHIDChannel    = 0x0101
+	ledgerHIDTag        = 0x05
+	ledgerHIDPacketSize = 64
+)
+
+// A Ledger plugged into this machine, through its Linux hidraw device, e.g. /dev/hidraw0
+// The device is opened on the first exchange and again after one fails, so it can be unplugged and plugged back in
+type LedgerHID struct {
+	Path string
+	file *os.File
+}
+
+// Split an APDU into HID packets, the first one has its length
+func ledgerHIDPackets(apdu []byte) [][]byte {
+	data := binary.BigEndian.AppendUint16(nil, uint16(len(apdu)))
+	data = append(data, apdu...)
+	packets := [][]byte{}
+	for seq := 0; len(data) > 0; seq++ {
+		packet := make([]byte, ledgerHIDPacketSize)
+		binary.BigEndian.PutUint16(packet, ledgerHIDChannel)
+		packet[2] = ledgerHIDTag
+		binary.BigEndian.PutUint16(packet[3:], uint16(seq))
+		n := copy(packet[5:], data)
+		data = data[n:]
+		packets = append(packets, packet)
+	}
+	return packets
+}
+
+// Put a response back together from the packets read
+func ledgerHIDResponse(read func() ([]byte, error)) ([]byte, error) {
+	var resp []byte
+	length := -1
+	for seq := 0; length < 0 || len(resp) < length; seq++ {
+		packet, err := read()
+		if err != nil {
+			return nil, err
+		} else if len(packet) < 7 || binary.BigEndian.Uint16(packet) != ledgerHIDChannel || packet[2] != ledgerHIDTag || int(binary.BigEndian.Uint16(packet[3:])) != seq {
+			return nil, ErrLedgerResponse
+		}
+		if seq == 0 {
+			length = int(binary.BigEndian.Uint16(packet[5:]))
+			resp = append(resp, packet[7:]...)
+		} else {
+			resp = append(resp, packet[5:]...)
+		}
+	}
+	return resp[:length], nil
+}
+
+func (h *LedgerHID) Exchange(apdu []byte) ([]byte, error) {
+	if h.file == nil {
+		file, err := os.OpenFile(h.Path, os.O_RDWR, 0)
+		if err != nil {
+			return nil, err
+		}
+		h.file = file
+	}
+	resp, err := h.exchange(apdu)
+	if err != nil {
+		h.file.Close()
+		h.file = nil
+	}
+	return resp, err
+}
+
+func (h *LedgerHID) exchange(apdu []byte) ([]byte, error) {
+	for _, packet := range ledgerHIDPackets(apdu) {
+		// hidraw wants the report number first, Ledgers don't number theirs
+		if _, err := h.file.Write(append([]byte{0x00}, packet...)); err != nil {
+			return nil, err
+		}
+	}
+	return ledgerHIDResponse(func() ([]byte, error) {
+		packet := make([]byte, ledgerHIDPacketSize)
+		n, err := h.file.Read(packet)
+		return packet[:n], err
+	})
+}
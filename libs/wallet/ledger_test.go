@@ -0,0 +1,125 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// Answers like the Nano app on a Ledger with seed would
+type emulatedLedger struct {
+	seed     string
+	closed   bool
+	rejected bool
+	apdus    [][]byte
+}
+
+func (e *emulatedLedger) Exchange(apdu []byte) ([]byte, error) {
+	e.apdus = append(e.apdus, apdu)
+	if e.closed || apdu[0] != ledgerCla {
+		return []byte{0x6e, 0x00}, nil
+	} else if len(apdu) < 5+13 || int(apdu[4]) != len(apdu)-5 {
+		return nil, errors.New("malformed apdu")
+	}
+	data := apdu[5:]
+	index := binary.BigEndian.Uint32(data[9:13]) &^ ledgerHardened
+	pub, priv, _ := utils.KeypairFromSeed(e.seed, index)
+	switch apdu[1] {
+	case ledgerInsGetAddress:
+		address := utils.PubKeyToAddress(pub, false)
+		resp := append([]byte{}, pub...)
+		resp = append(resp, byte(len(address)))
+		resp = append(resp, address...)
+		return append(resp, 0x90, 0x00), nil
+	case ledgerInsSignBlock:
+		if e.rejected {
+			return []byte{0x69, 0x85}, nil
+		}
+		fields := data[13:]
+		sb := nanoblock.StateBlock{
+			Account:        utils.PubKeyToAddress(pub, false),
+			Previous:       hex.EncodeToString(fields[:32]),
+			Link:           hex.EncodeToString(fields[32:64]),
+			Representative: utils.PubKeyToAddress(fields[64:96], false),
+			Balance:        big.NewInt(0).SetBytes(fields[96:112]).String(),
+		}
+		if err := sb.Sign(privateKeySeed(priv)); err != nil {
+			return nil, err
+		}
+		hash := sb.Hash()
+		signature, _ := hex.DecodeString(sb.Signature)
+		resp := append(hash[:], signature...)
+		return append(resp, 0x90, 0x00), nil
+	}
+	return []byte{0x6d, 0x00}, nil
+}
+
+func TestLedgerHIDPackets(t *testing.T) {
+	apdu := bytes.Repeat([]byte{0xab}, 150)
+	packets := ledgerHIDPackets(apdu)
+	// 57 bytes in the first packet after the length, 59 in the others
+	assert.Len(t, packets, 3)
+	for seq, packet := range packets {
+		assert.Len(t, packet, ledgerHIDPacketSize)
+		assert.Equal(t, []byte{0x01, 0x01, 0x05, 0x00, byte(seq)}, packet[:5])
+	}
+	assert.Equal(t, []byte{0x00, 150}, packets[0][5:7])
+
+	// Responses are framed the same way
+	next := 0
+	resp, err := ledgerHIDResponse(func() ([]byte, error) {
+		next++
+		return packets[next-1], nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, apdu, resp)
+
+	// Out of order
+	_, err = ledgerHIDResponse(func() ([]byte, error) {
+		return packets[1], nil
+	})
+	assert.ErrorIs(t, err, ErrLedgerResponse)
+}
+
+func TestLedger(t *testing.T) {
+	seed := "A8D1F4B7E0C3A6D9F2B5E8C1A4D7F0B3E6C9A2D5F8B1E4C7A0D3F6B9E2C5A8D1"
+	device := &emulatedLedger{seed: seed}
+	ledger := &Ledger{Transport: device}
+
+	// 44'/165'/3'
+	pub, err := ledger.PublicKey(3)
+	assert.Nil(t, err)
+	expected, _, _ := utils.KeypairFromSeed(seed, 3)
+	assert.Equal(t, expected, pub)
+	assert.Equal(t, []byte{ledgerCla, ledgerInsGetAddress, 0x00, 0x00, 13, 3, 0x80, 0, 0, 44, 0x80, 0, 0, 165, 0x80, 0, 0, 3}, device.apdus[0])
+	assert.Equal(t, []byte{3, 0x80, 0, 0, 44, 0x80, 0, 0, 198, 0x80, 0, 0, 3}, ledgerPath(3, true))
+
+	sb := &nanoblock.StateBlock{
+		Type:           "state",
+		Account:        utils.PubKeyToAddress(pub, false),
+		Previous:       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		Representative: "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+		Balance:        "600",
+		Link:           "D4BBFA50649D80E5F63FC396C6F4CF6321CABD7C1480E964C2701D56AAFEB5E3",
+	}
+	assert.Nil(t, ledger.SignBlock(3, sb))
+	assert.Nil(t, sb.VerifySignature())
+
+	// The Ledger hashes it with another account
+	sb.Signature = ""
+	assert.ErrorIs(t, ledger.SignBlock(4, sb), ErrLedgerResponse)
+	assert.Empty(t, sb.Signature)
+
+	device.rejected = true
+	assert.ErrorIs(t, ledger.SignBlock(3, sb), ErrLedgerRejected)
+	device.closed = true
+	_, err = ledger.PublicKey(3)
+	assert.ErrorIs(t, err, ErrLedgerAppNotOpen)
+}
@@ -0,0 +1,9 @@
+package models
+
+// Result of matching the accounts of a wallet to the indexes of its seed again
+type AccountsReindex struct {
+	// Accounts whose account_index was missing or wrong and is now set
+	Updated int
+	// Accounts that aren't derived from the wallet seed at any index we looked at, they're kept as they are
+	Unmatched []string
+}
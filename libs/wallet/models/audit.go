@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// A state-changing action as the gateway handled it, its secrets already redacted from Params
+type AuditRecord struct {
+	Action     string
+	Wallet     *string
+	IP         string
+	ApiKeyID   *uuid.UUID
+	ApiKeyName *string
+	Params     map[string]interface{}
+	Status     int
+	ErrorCode  *string
+}
+
+// Which audit records to list, empty fields don't filter
+type AuditFilter struct {
+	Wallet string
+	Action string
+	// From Start up to but not including End
+	Start *time.Time
+	End   *time.Time
+	// Only the newest Count, 0 for all of them
+	Count int
+}
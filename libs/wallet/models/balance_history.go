@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// Balance of an account at the end of an hour or day
+type BalanceHistoryEntry struct {
+	// Start of the hour or day, in UTC
+	PeriodStart time.Time
+	// From the last snapshot in the period
+	BalanceRaw string
+	SnapshotAt time.Time
+}
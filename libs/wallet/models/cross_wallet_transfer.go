@@ -0,0 +1,11 @@
+package models
+
+// Hashes of the blocks a transfer between two wallets published, in order
+type CrossWalletTransfer struct {
+	// Receives of what was pending on the source accounts
+	SourceReceives []string
+	// One send of its whole balance per source account that had one
+	Sends []string
+	// Receives of the sends on the destination account
+	DestinationReceives []string
+}
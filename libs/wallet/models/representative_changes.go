@@ -0,0 +1,15 @@
+package models
+
+// Result of changing the representative of every account in a wallet
+type RepresentativeChanges struct {
+	Changed []RepresentativeChange
+	// Accounts that already have the representative, or have no blocks yet
+	Skipped []string
+	// Accounts where the change block couldn't be created or published
+	Failed []string
+}
+
+type RepresentativeChange struct {
+	Account   string
+	BlockHash string
+}
@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// A representative change published by Pippin
+type RepresentativeHistoryEntry struct {
+	Account           string
+	OldRepresentative string
+	NewRepresentative string
+	BlockHash         string
+	ChangedAt         time.Time
+}
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// Kinds of WalletEvent
+const (
+	// A block of a wallet account was confirmed
+	WalletEventConfirmation = "confirmation"
+	// A send to a wallet account was confirmed, it can be received
+	WalletEventReceivable = "receivable"
+	// Pippin published a receive for a wallet account
+	WalletEventPocketed = "pocketed"
+	// Work was generated for the next block of a wallet account
+	WalletEventWork = "work"
+)
+
+// Something that happened to an account of a wallet, for the wallet's subscribers
+type WalletEvent struct {
+	Event   string
+	Wallet  string
+	Account string
+	// The confirmed, receivable or pocketed block, or the root work was generated for
+	Hash string
+	// The subtype of a confirmed block
+	Subtype string
+	// Of a confirmed or receivable block
+	Amount string
+	// The sender of a receivable block, the send a pocketed block received
+	Source string
+	Time   time.Time
+}
@@ -0,0 +1,17 @@
+package models
+
+import "github.com/google/uuid"
+
+// How the key of a wallet is derived from its password
+type WalletKdf struct {
+	WalletID  uuid.UUID
+	Encrypted bool
+	// argon2id, or sha256 if it was encrypted before Argon2id, empty if it isn't encrypted
+	Algorithm string
+	// Argon2id's parameters, memory is in KiB
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	// It's upgraded to the config's parameters the next time it's unlocked
+	Outdated bool
+}
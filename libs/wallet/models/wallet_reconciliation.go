@@ -0,0 +1,48 @@
+package models
+
+// What the database says about a wallet compared with the node, see WalletReconcile
+type WalletReconciliation struct {
+	AccountsChecked int
+	SendsChecked    int
+	// Accounts with blocks they haven't received
+	Unpocketed []UnpocketedAccount
+	// Recorded sends that were replaced by another block on the node
+	Forks []ReconcileFork
+	// Recorded sends the node doesn't have
+	MissingSends []MissingSend
+	// Accounts whose frontier is the send we recorded, but with another balance
+	BalanceMismatches []BalanceMismatch
+}
+
+type UnpocketedAccount struct {
+	Account string
+	Blocks  []string
+}
+
+type ReconcileFork struct {
+	Account string
+	SendID  string
+	// Our block and the node's block with the same previous
+	Block     string
+	NodeBlock string
+}
+
+type MissingSend struct {
+	Account string
+	SendID  string
+	// Nil if the send never got as far as a block
+	Block       *string
+	Destination string
+	// Only known for send_with_id
+	AmountRaw *string
+	// not_published or interrupted
+	Reason string
+}
+
+type BalanceMismatch struct {
+	Account    string
+	Frontier   string
+	BalanceRaw string
+	// What the node has
+	NodeBalanceRaw string
+}
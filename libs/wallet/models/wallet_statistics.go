@@ -0,0 +1,32 @@
+package models
+
+import (
+	"math/big"
+	"time"
+)
+
+// Sends and receives of the accounts of a wallet since a time
+// Confirmed and unconfirmed blocks are counted apart
+type WalletStatistics struct {
+	Since       time.Time
+	Confirmed   BlockStatistics
+	Unconfirmed BlockStatistics
+}
+
+type BlockStatistics struct {
+	SendCount    int
+	SendTotal    *big.Int
+	ReceiveCount int
+	ReceiveTotal *big.Int
+	// Accounts sent to or received from, each counted once
+	UniqueCounterparties int
+	// nil if there were no sends
+	LargestSend *LargestSend
+}
+
+type LargestSend struct {
+	Hash string
+	// The destination
+	Account string
+	Amount  *big.Int
+}
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Public facing summary of a wallet, never includes the seed
+type WalletSummary struct {
+	ID           uuid.UUID
+	Name         *string
+	AccountCount int
+	WatchOnly    bool
+	CreatedAt    time.Time
+}
@@ -0,0 +1,19 @@
+package models
+
+// The accounts of a wallet that wallet_sweep moves everything out of
+type WalletSweep struct {
+	Accounts []WalletSweepAccount
+	// Sum of the balances and pending amounts of the accounts, in raw
+	TotalRaw string
+}
+
+// Balance and pending are what the node had before the sweep, in raw
+type WalletSweepAccount struct {
+	Address    string
+	BalanceRaw string
+	PendingRaw string
+	// Blocks published for it, none on a dry run
+	Receives []string
+	// Empty if nothing was sent
+	Send string
+}
@@ -0,0 +1,16 @@
+package models
+
+// Result of re-deriving every account of a wallet from its stored keys
+type WalletVerification struct {
+	// Accounts whose stored address doesn't match
+	Mismatches []AccountMismatch
+	// The wallet is locked, only the address formats were checked
+	Locked bool
+}
+
+type AccountMismatch struct {
+	Account string
+	Reason  string
+	// The address the stored key derives to, if it could be derived
+	Expected *string
+}
@@ -0,0 +1,126 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	entblock "github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+)
+
+var ErrDestinationEncrypted = errors.New("destination wallet is encrypted")
+
+// Move accounts from source to destination, like the node's account_move, either all of them are moved or none are
+// Accounts derived from the source seed get their private key stored, they're adhoc accounts in destination
+// Accounts derived from another seed keep it and their index, the blocks of every account move with it
+// Their sends' ids are released, so neither wallet finds them for send_with_id
+// The keys can't be encrypted without destination's password, so it can't be encrypted
+func (w *NanoWallet) AccountMove(source *ent.Wallet, destination *ent.Wallet, addresses []string) error {
+	if source == nil || destination == nil {
+		return ErrInvalidWallet
+	} else if source.ID == destination.ID {
+		return ErrSameWallet
+	} else if source.WatchOnly || destination.WatchOnly {
+		return ErrWalletWatchOnly
+	} else if destination.Encrypted {
+		return ErrDestinationEncrypted
+	}
+
+	// Lock both wallets, in the same order every time so two moves the other way around don't wait on each other
+	first, second := source, destination
+	if second.ID.String() < first.ID.String() {
+		first, second = second, first
+	}
+	for _, wallet := range []*ent.Wallet{first, second} {
+		lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+		if err != nil {
+			return database.ErrLockNotObtained
+		}
+		defer lock.Release(w.Ctx)
+	}
+
+	// Every key is read before anything is moved, this also fails if source is locked or frozen
+	var accounts []*ent.Account
+	privateKeys := map[string]string{}
+	seeds := map[string]string{}
+	for _, address := range addresses {
+		if _, ok := privateKeys[address]; ok {
+			continue
+		}
+		acc, err := w.GetAccount(source, address)
+		if err != nil {
+			return err
+		}
+		exists, err := w.AccountExists(destination, address)
+		if err != nil {
+			return err
+		} else if exists {
+			return ErrAccountExists
+		}
+		priv, err := accountPrivateKey(source, acc)
+		if err != nil {
+			return err
+		}
+		if acc.Seed != nil {
+			seeds[address], err = storedAccountKey(source, accountSeedKey(address), *acc.Seed)
+			if err != nil {
+				return err
+			}
+		}
+		privateKeys[address] = hex.EncodeToString(priv)
+		accounts = append(accounts, acc)
+	}
+
+	// The next account index is derived from the highest remaining one, so keep at least one, like AccountRemove
+	deterministic := 0
+	for _, acc := range accounts {
+		if acc.AccountIndex != nil {
+			deterministic++
+		}
+	}
+	if deterministic > 0 {
+		count, err := w.DB.Account.Query().Where(account.WalletID(source.ID), account.AccountIndexNotNil()).Count(database.WithPrimary(w.Ctx))
+		if err != nil {
+			return err
+		} else if count <= deterministic {
+			return ErrLastAccount
+		}
+	}
+
+	tx, err := w.DB.Tx(w.Ctx)
+	if err != nil {
+		return err
+	}
+	for _, acc := range accounts {
+		update := tx.Account.UpdateOne(acc).SetWalletID(destination.ID).ClearAccountIndex().SetPrivateKey(privateKeys[acc.Address])
+		if seed, ok := seeds[acc.Address]; ok {
+			update.SetSeed(seed)
+		}
+		if _, err := update.Save(w.Ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+		// Send ids are per wallet, the account's sends don't hold ids in either wallet anymore
+		if _, err := tx.Block.Update().Where(entblock.AccountID(acc.ID), entblock.WalletIDNotNil()).ClearWalletID().Save(w.Ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	// The decrypted keys of an unlocked source aren't needed anymore
+	if source.Encrypted {
+		for _, acc := range accounts {
+			database.GetRedisDB().Hdel(source.ID.String(), acc.Address)
+			database.GetRedisDB().Hdel(source.ID.String(), accountSeedKey(acc.Address))
+		}
+	}
+
+	return nil
+}
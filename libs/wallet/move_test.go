@@ -0,0 +1,88 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountMove(t *testing.T) {
+	sourceSeed, _ := utils.GenerateSeed(strings.NewReader("2c5f8b1e4d7a0c3f6b9e2d5a8c1f4b7e0d3a6c9f2b5e8d1a4c7f0b3e6d9a2c5f"))
+	source, err := MockWallet.WalletCreate(sourceSeed)
+	assert.Nil(t, err)
+	destinationSeed, _ := utils.GenerateSeed(strings.NewReader("5f8b1e4d7a0c3f6b9e2d5a8c1f4b7e0d3a6c9f2b5e8d1a4c7f0b3e6d9a2c5f8b"))
+	destination, err := MockWallet.WalletCreate(destinationSeed)
+	assert.Nil(t, err)
+
+	// One account of every kind
+	sourceAccounts, _, err := MockWallet.AccountsList(source, 0)
+	assert.Nil(t, err)
+	first := sourceAccounts[0]
+	derived, err := MockWallet.AccountCreate(source, nil)
+	assert.Nil(t, err)
+	_, adhocKey, _ := utils.KeypairFromSeed("8b1e4d7a0c3f6b9e2d5a8c1f4b7e0d3a6c9f2b5e8d1a4c7f0b3e6d9a2c5f8b1e", 0)
+	adhoc, err := MockWallet.AdhocAccountCreate(source, adhocKey)
+	assert.Nil(t, err)
+	otherSeed := "1e4d7a0c3f6b9e2d5a8c1f4b7e0d3a6c9f2b5e8d1a4c7f0b3e6d9a2c5f8b1e4d"
+	seedIndex := 3
+	fromSeed, err := MockWallet.AccountCreateFromSeed(source, otherSeed, &seedIndex)
+	assert.Nil(t, err)
+
+	assert.ErrorIs(t, MockWallet.AccountMove(source, source, []string{derived.Address}), ErrSameWallet)
+	// The last account derived from the seed has to stay
+	assert.ErrorIs(t, MockWallet.AccountMove(source, destination, []string{first.Address, derived.Address}), ErrLastAccount)
+	// Nothing is moved if one of them can't be
+	assert.ErrorIs(t, MockWallet.AccountMove(source, destination, []string{derived.Address, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"}), ErrAccountNotFound)
+	_, err = MockWallet.AdhocAccountCreate(destination, adhocKey)
+	assert.Nil(t, err)
+	assert.ErrorIs(t, MockWallet.AccountMove(source, destination, []string{derived.Address, adhoc.Address}), ErrAccountExists)
+	exists, err := MockWallet.AccountExists(source, derived.Address)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+	assert.Nil(t, MockWallet.AccountRemove(destination, adhoc.Address, true))
+
+	err = MockWallet.AccountMove(source, destination, []string{derived.Address, adhoc.Address, fromSeed.Address, derived.Address})
+	assert.Nil(t, err)
+	_, addresses, err := MockWallet.AccountsList(source, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{first.Address}, addresses)
+
+	// Every account can still be signed for in destination, with the same key
+	for _, moved := range []*ent.Account{derived, adhoc, fromSeed} {
+		acc, err := MockWallet.GetAccount(destination, moved.Address)
+		assert.Nil(t, err)
+		assert.Nil(t, acc.AccountIndex)
+		assert.NotNil(t, acc.PrivateKey)
+		assert.Equal(t, moved.ID, acc.ID)
+		priv, err := accountPrivateKey(destination, acc)
+		assert.Nil(t, err)
+		assert.Equal(t, moved.Address, utils.PubKeyToAddress(priv.Public().(ed25519.PublicKey), false))
+	}
+	acc, err := MockWallet.GetAccount(destination, fromSeed.Address)
+	assert.Nil(t, err)
+	assert.Equal(t, otherSeed, *acc.Seed)
+	assert.Equal(t, 3, *acc.SeedIndex)
+	// The destination seed carries on from its own accounts
+	next, err := MockWallet.AccountCreate(destination, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, *next.AccountIndex)
+
+	// Back from an unlocked encrypted wallet, not into one
+	_, err = MockWallet.EncryptWallet(destination, "password")
+	assert.Nil(t, err)
+	assert.ErrorIs(t, MockWallet.AccountMove(source, destination, []string{first.Address}), ErrDestinationEncrypted)
+	_, err = MockWallet.UnlockWallet(destination, "password")
+	assert.Nil(t, err)
+	assert.Nil(t, MockWallet.AccountMove(destination, source, []string{adhoc.Address}))
+	acc, err = MockWallet.GetAccount(source, adhoc.Address)
+	assert.Nil(t, err)
+	priv, err := accountPrivateKey(source, acc)
+	assert.Nil(t, err)
+	assert.Equal(t, adhocKey, priv)
+	assert.Nil(t, MockWallet.LockWallet(destination))
+	assert.ErrorIs(t, MockWallet.AccountMove(destination, source, []string{derived.Address}), ErrWalletLocked)
+}
@@ -0,0 +1,159 @@
+package wallet
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+var ErrInvalidBackup = errors.New("invalid backup")
+var ErrDecryptionFailed = errors.New("decryption failed")
+
+// Importing wallets from NanoWallet (the legacy Electron wallet) JSON exports
+// Only version 1 exports are supported, the seed is encrypted with AES-256-CBC and a key derived with PBKDF2-SHA256:
+// {"version": 1, "salt": hex, "iv": hex, "iterations": int, "seed": hex ciphertext, "accounts": [{"index": int, "account": address}]}
+
+const NanoWalletBackupVersion = 1
+
+// An account in a backup, it's imported at its index
+type BackupAccount struct {
+	Index   int    `json:"index"`
+	Account string `json:"account"`
+}
+
+type NanoWalletBackup struct {
+	Version    int             `json:"version"`
+	Salt       string          `json:"salt"`
+	IV         string          `json:"iv"`
+	Iterations int             `json:"iterations"`
+	Seed       string          `json:"seed"`
+	Accounts   []BackupAccount `json:"accounts"`
+}
+
+// Parse a NanoWallet export and decrypt its seed, returns the seed and the account indexes in the backup
+func DecryptNanoWalletBackup(data []byte, passphrase string, banano bool) (string, []int, error) {
+	var backup NanoWalletBackup
+	if err := json.Unmarshal(data, &backup); err != nil {
+		return "", nil, ErrInvalidBackup
+	} else if backup.Version != NanoWalletBackupVersion || backup.Iterations < 1 {
+		return "", nil, ErrInvalidBackup
+	}
+	salt, err := hex.DecodeString(backup.Salt)
+	if err != nil || len(salt) == 0 {
+		return "", nil, ErrInvalidBackup
+	}
+	iv, err := hex.DecodeString(backup.IV)
+	if err != nil || len(iv) != aes.BlockSize {
+		return "", nil, ErrInvalidBackup
+	}
+	ciphertext, err := hex.DecodeString(backup.Seed)
+	if err != nil || len(ciphertext) == 0 || len(ciphertext)%aes.BlockSize != 0 {
+		return "", nil, ErrInvalidBackup
+	}
+
+	key := pbkdf2.Key([]byte(passphrase), salt, backup.Iterations, 32, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", nil, err
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+
+	// A wrong passphrase almost never gives valid padding, and never a valid seed
+	padding := int(plaintext[len(plaintext)-1])
+	if padding < 1 || padding > aes.BlockSize || !bytes.Equal(plaintext[len(plaintext)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+		return "", nil, ErrDecryptionFailed
+	}
+	seed := strings.ToLower(string(plaintext[:len(plaintext)-padding]))
+	if !utils.Validate64HexHash(seed) {
+		return "", nil, ErrDecryptionFailed
+	}
+
+	indexes, err := backupAccountIndexes(seed, backup.Accounts, banano)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return seed, indexes, nil
+}
+
+// The indexes of the accounts in a backup, they have to be the ones derived from the seed
+func backupAccountIndexes(seed string, accounts []BackupAccount, banano bool) ([]int, error) {
+	indexes := []int{}
+	for _, acc := range accounts {
+		if acc.Index < 0 {
+			return nil, ErrInvalidBackup
+		}
+		pub, _, err := utils.KeypairFromSeed(seed, uint32(acc.Index))
+		if err != nil {
+			return nil, err
+		}
+		backupPub, err := utils.AddressToPub(acc.Account, banano)
+		if err != nil || !bytes.Equal(pub, backupPub) {
+			return nil, ErrInvalidBackup
+		}
+		indexes = append(indexes, acc.Index)
+	}
+	return indexes, nil
+}
+
+// Create a wallet from a NanoWallet export, with every account that was in the backup
+func (w *NanoWallet) WalletImportNanoWallet(data []byte, passphrase string) (*ent.Wallet, []*ent.Account, error) {
+	seed, indexes, err := DecryptNanoWalletBackup(data, passphrase, w.Banano)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := w.DB.Tx(w.Ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	wallet, accounts, err := w.createBackupWallet(tx, seed, indexes)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return wallet, accounts, nil
+}
+
+// Create an imported wallet and its accounts in tx
+func (w *NanoWallet) createBackupWallet(tx *ent.Tx, seed string, indexes []int) (*ent.Wallet, []*ent.Account, error) {
+	// Like WalletCreate the first account is always there
+	indexes = append([]int{0}, indexes...)
+	slices.Sort(indexes)
+	indexes = slices.Compact(indexes)
+
+	wallet, err := tx.Wallet.Create().SetSeed(seed).Save(w.Ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var accounts []*ent.Account
+	for _, index := range indexes {
+		pub, _, err := utils.KeypairFromSeed(seed, uint32(index))
+		if err != nil {
+			return nil, nil, err
+		}
+		acc, err := tx.Account.Create().SetWallet(wallet).SetAccountIndex(index).SetAddress(utils.PubKeyToAddress(pub, w.Banano)).Save(w.Ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		accounts = append(accounts, acc)
+	}
+
+	return wallet, accounts, nil
+}
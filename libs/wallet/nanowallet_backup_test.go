@@ -0,0 +1,57 @@
+package wallet
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+// The fixture is a version 1 backup of a throwaway seed, encrypted with "correct horse battery staple"
+const nanoWalletBackupPassphrase = "correct horse battery staple"
+
+func TestDecryptNanoWalletBackup(t *testing.T) {
+	data, err := os.ReadFile("testdata/nanowallet_backup_v1.json")
+	assert.Nil(t, err)
+
+	seed, indexes, err := DecryptNanoWalletBackup(data, nanoWalletBackupPassphrase, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "3363396532613566386231643465376130633366366239643265356138633166", seed)
+	assert.Equal(t, []int{0, 1, 5}, indexes)
+
+	_, _, err = DecryptNanoWalletBackup(data, "wrong passphrase", false)
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+
+	_, _, err = DecryptNanoWalletBackup([]byte("not json"), nanoWalletBackupPassphrase, false)
+	assert.ErrorIs(t, err, ErrInvalidBackup)
+
+	_, _, err = DecryptNanoWalletBackup([]byte(strings.Replace(string(data), `"version": 1`, `"version": 2`, 1)), nanoWalletBackupPassphrase, false)
+	assert.ErrorIs(t, err, ErrInvalidBackup)
+
+	// An account that doesn't belong to the seed
+	_, _, err = DecryptNanoWalletBackup([]byte(strings.Replace(string(data), "nano_31mqdf4w9tgy7jjknmm6c3zgxnwttbud3w78c8kokx3qpiwzyxs6rftsjsbx", "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", 1)), nanoWalletBackupPassphrase, false)
+	assert.ErrorIs(t, err, ErrInvalidBackup)
+}
+
+func TestWalletImportNanoWallet(t *testing.T) {
+	data, err := os.ReadFile("testdata/nanowallet_backup_v1.json")
+	assert.Nil(t, err)
+
+	_, _, err = MockWallet.WalletImportNanoWallet(data, "wrong passphrase")
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+
+	wallet, accounts, err := MockWallet.WalletImportNanoWallet(data, nanoWalletBackupPassphrase)
+	assert.Nil(t, err)
+	assert.Equal(t, "3363396532613566386231643465376130633366366239643265356138633166", wallet.Seed)
+	assert.Len(t, accounts, 3)
+	assert.Equal(t, "nano_3pdaccf89w1aewqi78443i99pkcrtudjpihhksjs1ip1aum9eou3krz1nks9", accounts[0].Address)
+	assert.Equal(t, "nano_347pq7obkgbs1ji6dtwh3yq14ijf1aqgfb1mfwm5wz36dh9s4yfwccds6q8s", accounts[1].Address)
+	assert.Equal(t, "nano_31mqdf4w9tgy7jjknmm6c3zgxnwttbud3w78c8kokx3qpiwzyxs6rftsjsbx", accounts[2].Address)
+	assert.Equal(t, 5, *accounts[2].AccountIndex)
+
+	// Already imported
+	_, _, err = MockWallet.WalletImportNanoWallet(data, nanoWalletBackupPassphrase)
+	assert.True(t, ent.IsConstraintError(err))
+}
@@ -0,0 +1,129 @@
+package wallet
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// Importing wallets from Nault backups, a backup can have several wallets
+// Only version 1 backups are supported, the wallets are a JSON document encrypted with AES-256-GCM and a key derived with PBKDF2-SHA256:
+// {"version": 1, "salt": hex, "iterations": int, "nonce": hex, "data": hex ciphertext with the tag appended}
+// The decrypted data is {"wallets": [{"name": string, "seed": hex, "accounts": [{"index": int, "account": address}]}]}
+
+const NaultBackupVersion = 1
+
+type NaultBackup struct {
+	Version    int    `json:"version"`
+	Salt       string `json:"salt"`
+	Iterations int    `json:"iterations"`
+	Nonce      string `json:"nonce"`
+	Data       string `json:"data"`
+}
+
+type NaultBackupWallet struct {
+	Name     string          `json:"name"`
+	Seed     string          `json:"seed"`
+	Accounts []BackupAccount `json:"accounts"`
+}
+
+type NaultBackupData struct {
+	Wallets []NaultBackupWallet `json:"wallets"`
+}
+
+// Parse a Nault backup and decrypt its wallets, the accounts of each wallet are checked against its seed
+func DecryptNaultBackup(data []byte, passphrase string, banano bool) ([]NaultBackupWallet, error) {
+	var backup NaultBackup
+	if err := json.Unmarshal(data, &backup); err != nil {
+		return nil, ErrInvalidBackup
+	} else if backup.Version != NaultBackupVersion || backup.Iterations < 1 {
+		return nil, ErrInvalidBackup
+	}
+	salt, err := hex.DecodeString(backup.Salt)
+	if err != nil || len(salt) == 0 {
+		return nil, ErrInvalidBackup
+	}
+	nonce, err := hex.DecodeString(backup.Nonce)
+	if err != nil {
+		return nil, ErrInvalidBackup
+	}
+	ciphertext, err := hex.DecodeString(backup.Data)
+	if err != nil || len(ciphertext) == 0 {
+		return nil, ErrInvalidBackup
+	}
+
+	key := pbkdf2.Key([]byte(passphrase), salt, backup.Iterations, 32, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aesGCM, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aesGCM.NonceSize() {
+		return nil, ErrInvalidBackup
+	}
+
+	// The tag doesn't match with a wrong passphrase
+	plaintext, err := aesGCM.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+
+	var decrypted NaultBackupData
+	if err := json.Unmarshal(plaintext, &decrypted); err != nil || len(decrypted.Wallets) == 0 {
+		return nil, ErrInvalidBackup
+	}
+	for i, wallet := range decrypted.Wallets {
+		seed := strings.ToLower(wallet.Seed)
+		if !utils.Validate64HexHash(seed) {
+			return nil, ErrInvalidBackup
+		}
+		if _, err := backupAccountIndexes(seed, wallet.Accounts, banano); err != nil {
+			return nil, err
+		}
+		decrypted.Wallets[i].Seed = seed
+	}
+
+	return decrypted.Wallets, nil
+}
+
+// Create a wallet for every wallet in a Nault backup, if any of them can't be created none are
+func (w *NanoWallet) WalletImportNault(data []byte, passphrase string) ([]*ent.Wallet, error) {
+	backupWallets, err := DecryptNaultBackup(data, passphrase, w.Banano)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := w.DB.Tx(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	var wallets []*ent.Wallet
+	for _, backupWallet := range backupWallets {
+		indexes := []int{}
+		for _, acc := range backupWallet.Accounts {
+			indexes = append(indexes, acc.Index)
+		}
+		wallet, _, err := w.createBackupWallet(tx, backupWallet.Seed, indexes)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		wallets = append(wallets, wallet)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return wallets, nil
+}
@@ -0,0 +1,62 @@
+package wallet
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+// The fixture is a version 1 Nault backup of two throwaway wallets, encrypted with "nault backup passphrase"
+const naultBackupPassphrase = "nault backup passphrase"
+
+func TestDecryptNaultBackup(t *testing.T) {
+	data, err := os.ReadFile("testdata/nault_backup_v1.json")
+	assert.Nil(t, err)
+
+	wallets, err := DecryptNaultBackup(data, naultBackupPassphrase, false)
+	assert.Nil(t, err)
+	assert.Len(t, wallets, 2)
+	assert.Equal(t, "Savings", wallets[0].Name)
+	assert.Equal(t, "3765316134643963326635623865306133643663396631623465376132643563", wallets[0].Seed)
+	assert.Equal(t, "Spending", wallets[1].Name)
+	assert.Equal(t, "6134633766306233653664396132633566386231653464376130633366366239", wallets[1].Seed)
+	assert.Equal(t, 3, wallets[1].Accounts[1].Index)
+
+	_, err = DecryptNaultBackup(data, "wrong passphrase", false)
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+
+	_, err = DecryptNaultBackup([]byte("not json"), naultBackupPassphrase, false)
+	assert.ErrorIs(t, err, ErrInvalidBackup)
+
+	_, err = DecryptNaultBackup([]byte(strings.Replace(string(data), `"version": 1`, `"version": 2`, 1)), naultBackupPassphrase, false)
+	assert.ErrorIs(t, err, ErrInvalidBackup)
+}
+
+func TestWalletImportNault(t *testing.T) {
+	data, err := os.ReadFile("testdata/nault_backup_v1.json")
+	assert.Nil(t, err)
+
+	_, err = MockWallet.WalletImportNault(data, "wrong passphrase")
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+
+	wallets, err := MockWallet.WalletImportNault(data, naultBackupPassphrase)
+	assert.Nil(t, err)
+	assert.Len(t, wallets, 2)
+
+	_, accounts, err := MockWallet.AccountsList(wallets[0], 0)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"nano_3mgng4tbj5s3petks8s31ydryes7ecc7jnahzhiond3mxmkghharr6npft7f", "nano_3asq3999yyarc4bwumd91zxibaxnanj8isc1chfqk7rfdb5uouu3oun17oux"}, accounts)
+	_, accounts, err = MockWallet.AccountsList(wallets[1], 0)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"nano_1onrr87fjmj3wxbiwq4tjiqa6jw6neid316kbakp5ee97i4km1wob15ud6bg", "nano_1a71kie71h1xo93cf5eyeyt1ag5349w5rnh49gixaip8dxyn1huo5mxnmibm"}, accounts)
+
+	// Already imported, nothing is created
+	count, _ := MockWallet.DB.Wallet.Query().Count(MockWallet.Ctx)
+	_, err = MockWallet.WalletImportNault(data, naultBackupPassphrase)
+	assert.True(t, ent.IsConstraintError(err))
+	newCount, _ := MockWallet.DB.Wallet.Query().Count(MockWallet.Ctx)
+	assert.Equal(t, count, newCount)
+}
@@ -0,0 +1,144 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+var ErrInvalidAccountState = errors.New("invalid account state, frontier must be a hash or empty, balance a raw amount and representative an address")
+
+// Blocks built on a machine that can't reach the node, from the account's frontier, balance and representative as another machine got them
+// They're signed like SignBlock signs, the node isn't asked for anything and nothing is published or saved
+// Without work the machine that publishes them has to add it, the signature doesn't cover the work
+
+// Previous of an open block and link of a change
+const zeroHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// An account as the node has it, e.g. from account_info
+type AccountState struct {
+	// Empty if the account isn't opened yet
+	Frontier string
+	// In raw
+	Balance        string
+	Representative string
+}
+
+func (s AccountState) previous() string {
+	if s.Frontier == "" {
+		return zeroHash
+	}
+	return s.Frontier
+}
+
+func (w *NanoWallet) validAccountState(state AccountState) (*big.Int, error) {
+	balance, ok := big.NewInt(0).SetString(state.Balance, 10)
+	if !ok || balance.Sign() < 0 || balance.Cmp(maxSupply) > 0 {
+		return nil, ErrInvalidAccountState
+	} else if state.Frontier != "" && !utils.Validate64HexHash(state.Frontier) {
+		return nil, ErrInvalidAccountState
+	} else if _, err := utils.AddressToPub(state.Representative, w.Config.Wallet.Banano); err != nil {
+		return nil, ErrInvalidAccountState
+	}
+	return balance, nil
+}
+
+func parseAmount(amount string) (*big.Int, error) {
+	parsed, ok := big.NewInt(0).SetString(amount, 10)
+	if !ok || parsed.Sign() < 1 || parsed.Cmp(maxSupply) > 0 {
+		return nil, ErrInvalidAmount
+	}
+	return parsed, nil
+}
+
+// Sign sb with the key of its account and describe it like a preview
+func (w *NanoWallet) signOfflineBlock(wallet *ent.Wallet, sb nanoblock.StateBlock, work *string, subtype string, amount string, difficulty int) (*BlockPreview, error) {
+	if work != nil {
+		sb.Work = *work
+	}
+	signed, err := w.signUncheckedBlock(wallet, sb)
+	if err != nil {
+		return nil, err
+	}
+	return w.blockPreview(signed, subtype, amount, difficulty), nil
+}
+
+// Sign a send of amount raw from source to destination, source has state
+func (w *NanoWallet) OfflineSendBlock(wallet *ent.Wallet, source string, destination string, amount string, state AccountState, work *string) (*BlockPreview, error) {
+	balance, err := w.validAccountState(state)
+	if err != nil {
+		return nil, err
+	}
+	sendAmount, err := parseAmount(amount)
+	if err != nil {
+		return nil, err
+	} else if sendAmount.Cmp(balance) > 0 {
+		return nil, ErrInsufficientBalance
+	}
+	if err := w.checkSignedSend(wallet, sendAmount); err != nil {
+		return nil, err
+	}
+	link, err := utils.AddressToPub(destination, w.Config.Wallet.Banano)
+	if err != nil {
+		return nil, ErrInvalidAccount
+	}
+	return w.signOfflineBlock(wallet, nanoblock.StateBlock{
+		Type:           "state",
+		Account:        source,
+		Previous:       state.previous(),
+		Representative: state.Representative,
+		Balance:        balance.Sub(balance, sendAmount).String(),
+		Link:           hex.EncodeToString(link),
+	}, work, "send", amount, pow.SendMultiplier(w.Config.Wallet.Banano))
+}
+
+// Sign a receive of the send hash of amount raw, an open block if account has no frontier
+func (w *NanoWallet) OfflineReceiveBlock(wallet *ent.Wallet, account string, hash string, amount string, state AccountState, work *string) (*BlockPreview, error) {
+	balance, err := w.validAccountState(state)
+	if err != nil {
+		return nil, err
+	}
+	receiveAmount, err := parseAmount(amount)
+	if err != nil {
+		return nil, err
+	}
+	if !utils.Validate64HexHash(hash) {
+		return nil, ErrInvalidBlock
+	}
+	return w.signOfflineBlock(wallet, nanoblock.StateBlock{
+		Type:           "state",
+		Account:        account,
+		Previous:       state.previous(),
+		Representative: state.Representative,
+		Balance:        balance.Add(balance, receiveAmount).String(),
+		Link:           hash,
+	}, work, "receive", amount, pow.ReceiveMultiplier(w.Config.Wallet.Banano))
+}
+
+// Sign a change of account's representative, the account has to be opened
+func (w *NanoWallet) OfflineChangeBlock(wallet *ent.Wallet, account string, representative string, state AccountState, work *string) (*BlockPreview, error) {
+	balance, err := w.validAccountState(state)
+	if err != nil {
+		return nil, err
+	} else if state.Frontier == "" {
+		return nil, ErrInvalidAccountState
+	} else if representative == state.Representative {
+		return nil, ErrSameRepresentative
+	}
+	if _, err := utils.AddressToPub(representative, w.Config.Wallet.Banano); err != nil {
+		return nil, ErrInvalidAccount
+	}
+	return w.signOfflineBlock(wallet, nanoblock.StateBlock{
+		Type:           "state",
+		Account:        account,
+		Previous:       state.Frontier,
+		Representative: representative,
+		Balance:        balance.String(),
+		Link:           zeroHash,
+	}, work, "change", "", pow.SendMultiplier(w.Config.Wallet.Banano))
+}
@@ -0,0 +1,84 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOfflineBlocks(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("b2e9d4f1a6c3e8b5d0f7a2c7a1c4e9b2d6f3a8c5e0b7d2f9a4c1e6b3d8f5a0c7"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	rep := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	destination := "nano_3t6k35gi95xu6tergt6p69ck76ogmitsa8mnijtpxm9fkcm736xtoncuohr3"
+	frontier := "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3"
+	state := AccountState{Frontier: frontier, Balance: "1000", Representative: rep}
+
+	// Send, without work
+	send, err := MockWallet.OfflineSendBlock(wallet, acc.Address, destination, "400", state, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "send", send.Subtype)
+	assert.Equal(t, "600", send.Balance)
+	assert.Equal(t, "400", send.Amount)
+	assert.True(t, send.Signed)
+	assert.Equal(t, frontier, send.Block.Previous)
+	assert.Equal(t, "", send.Block.Work)
+	pub, _ := utils.AddressToPub(destination, false)
+	assert.Equal(t, hex.EncodeToString(pub), send.Block.Link)
+	assert.Nil(t, send.Block.VerifySignature())
+	hash := send.Block.Hash()
+	assert.Equal(t, strings.ToUpper(hex.EncodeToString(hash[:])), send.Hash)
+
+	_, err = MockWallet.OfflineSendBlock(wallet, acc.Address, destination, "1001", state, nil)
+	assert.ErrorIs(t, err, ErrInsufficientBalance)
+	_, err = MockWallet.OfflineSendBlock(wallet, acc.Address, destination, "0", state, nil)
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+	_, err = MockWallet.OfflineSendBlock(wallet, acc.Address, "nano_123", "1", state, nil)
+	assert.ErrorIs(t, err, ErrInvalidAccount)
+	_, err = MockWallet.OfflineSendBlock(wallet, acc.Address, destination, "1", AccountState{Frontier: "ABC", Balance: "1000", Representative: rep}, nil)
+	assert.ErrorIs(t, err, ErrInvalidAccountState)
+	_, err = MockWallet.OfflineSendBlock(wallet, acc.Address, destination, "1", AccountState{Frontier: frontier, Balance: "-1", Representative: rep}, nil)
+	assert.ErrorIs(t, err, ErrInvalidAccountState)
+	// The account has to be in the wallet
+	_, err = MockWallet.OfflineSendBlock(wallet, destination, acc.Address, "1", state, nil)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	// Open block, with work
+	work := "205452237a9b01f4"
+	open, err := MockWallet.OfflineReceiveBlock(wallet, acc.Address, send.Hash, "400", AccountState{Balance: "0", Representative: rep}, &work)
+	assert.Nil(t, err)
+	assert.Equal(t, "receive", open.Subtype)
+	assert.Equal(t, "400", open.Balance)
+	assert.Equal(t, zeroHash, open.Block.Previous)
+	assert.Equal(t, send.Hash, open.Block.Link)
+	assert.Equal(t, work, open.Block.Work)
+	assert.Nil(t, open.Block.VerifySignature())
+	_, err = MockWallet.OfflineReceiveBlock(wallet, acc.Address, "nothash", "400", state, nil)
+	assert.ErrorIs(t, err, ErrInvalidBlock)
+
+	// Change
+	change, err := MockWallet.OfflineChangeBlock(wallet, acc.Address, destination, state, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "change", change.Subtype)
+	assert.Equal(t, "1000", change.Balance)
+	assert.Equal(t, destination, change.Block.Representative)
+	assert.Equal(t, zeroHash, change.Block.Link)
+	assert.Nil(t, change.Block.VerifySignature())
+	_, err = MockWallet.OfflineChangeBlock(wallet, acc.Address, rep, state, nil)
+	assert.ErrorIs(t, err, ErrSameRepresentative)
+	_, err = MockWallet.OfflineChangeBlock(wallet, acc.Address, destination, AccountState{Balance: "0", Representative: rep}, nil)
+	assert.ErrorIs(t, err, ErrInvalidAccountState)
+
+	// Locked wallets can't sign
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+	_, err = MockWallet.OfflineSendBlock(wallet, acc.Address, destination, "400", state, nil)
+	assert.ErrorIs(t, err, ErrWalletLocked)
+}
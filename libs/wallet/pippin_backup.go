@@ -0,0 +1,307 @@
+package wallet
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// Pippin backups, everything in a wallet so it can be restored on another instance
+// They're wrapped like Nault backups, a JSON document encrypted with AES-256-GCM and a key derived with PBKDF2-SHA256:
+// {"version": 1, "salt": hex, "iterations": int, "nonce": hex, "data": hex ciphertext with the tag appended}
+// The keys in the document are never encrypted with the wallet's password, a restored wallet is unencrypted
+
+const PippinBackupVersion = 1
+
+// Iterations of PBKDF2 for new backups, restoring uses the ones in the backup
+const pippinBackupIterations = 600000
+
+type PippinBackup struct {
+	Version    int    `json:"version"`
+	Salt       string `json:"salt"`
+	Iterations int    `json:"iterations"`
+	Nonce      string `json:"nonce"`
+	Data       string `json:"data"`
+}
+
+// An account in a Pippin backup, the ones derived from the wallet's seed only have their index
+type PippinBackupAccount struct {
+	Address    string  `json:"address"`
+	Index      *int    `json:"index,omitempty"`
+	PrivateKey *string `json:"private_key,omitempty"`
+	Seed       *string `json:"seed,omitempty"`
+	SeedIndex  *int    `json:"seed_index,omitempty"`
+	Work       bool    `json:"work"`
+	WatchOnly  bool    `json:"watch_only"`
+}
+
+// The decrypted data of a Pippin backup, there's no seed for watch-only and hardware wallets
+type PippinBackupWallet struct {
+	ID             uuid.UUID             `json:"id"`
+	Seed           *string               `json:"seed,omitempty"`
+	Name           *string               `json:"name,omitempty"`
+	Representative *string               `json:"representative,omitempty"`
+	Work           bool                  `json:"work"`
+	WatchOnly      bool                  `json:"watch_only"`
+	Hardware       bool                  `json:"hardware"`
+	AutoReceive    bool                  `json:"auto_receive"`
+	ReceiveMinimum *string               `json:"receive_minimum,omitempty"`
+	Accounts       []PippinBackupAccount `json:"accounts"`
+}
+
+// Export the wallet as an encrypted backup, the wallet has to be unlocked
+func (w *NanoWallet) WalletBackupCreate(wallet *ent.Wallet, passphrase string) ([]byte, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if passphrase == "" {
+		return nil, ErrBadPassword
+	}
+
+	backup := PippinBackupWallet{
+		ID:             wallet.ID,
+		Name:           wallet.Name,
+		Representative: wallet.Representative,
+		Work:           wallet.Work,
+		WatchOnly:      wallet.WatchOnly,
+		Hardware:       wallet.Hardware,
+		AutoReceive:    wallet.AutoReceive,
+		ReceiveMinimum: wallet.ReceiveMinimum,
+		Accounts:       []PippinBackupAccount{},
+	}
+	if !wallet.WatchOnly && !wallet.Hardware {
+		seed, err := GetDecryptedKeyFromStorage(wallet, "seed")
+		if err != nil {
+			return nil, err
+		}
+		backup.Seed = &seed
+	}
+
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Order(ent.Asc(account.FieldCreatedAt)).All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, acct := range accounts {
+		item := PippinBackupAccount{
+			Address:   acct.Address,
+			Index:     acct.AccountIndex,
+			SeedIndex: acct.SeedIndex,
+			Work:      acct.Work,
+			WatchOnly: acct.WatchOnly,
+		}
+		if acct.PrivateKey != nil {
+			key, err := storedAccountKey(wallet, acct.Address, *acct.PrivateKey)
+			if err != nil {
+				return nil, err
+			}
+			item.PrivateKey = &key
+		}
+		if acct.Seed != nil {
+			acctSeed, err := storedAccountKey(wallet, accountSeedKey(acct.Address), *acct.Seed)
+			if err != nil {
+				return nil, err
+			}
+			item.Seed = &acctSeed
+		}
+		backup.Accounts = append(backup.Accounts, item)
+	}
+
+	return sealPippinBackup(backup, passphrase)
+}
+
+func sealPippinBackup(backup PippinBackupWallet, passphrase string) ([]byte, error) {
+	plaintext, err := json.Marshal(backup)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	key := pbkdf2.Key([]byte(passphrase), salt, pippinBackupIterations, 32, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aesGCM, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aesGCM.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(PippinBackup{
+		Version:    PippinBackupVersion,
+		Salt:       hex.EncodeToString(salt),
+		Iterations: pippinBackupIterations,
+		Nonce:      hex.EncodeToString(nonce),
+		Data:       hex.EncodeToString(aesGCM.Seal(nil, nonce, plaintext, nil)),
+	})
+}
+
+// Parse a Pippin backup and decrypt it, every account is checked against its keys
+func DecryptPippinBackup(data []byte, passphrase string, banano bool) (*PippinBackupWallet, error) {
+	var backup PippinBackup
+	if err := json.Unmarshal(data, &backup); err != nil {
+		return nil, ErrInvalidBackup
+	} else if backup.Version != PippinBackupVersion || backup.Iterations < 1 {
+		return nil, ErrInvalidBackup
+	}
+	salt, err := hex.DecodeString(backup.Salt)
+	if err != nil || len(salt) == 0 {
+		return nil, ErrInvalidBackup
+	}
+	nonce, err := hex.DecodeString(backup.Nonce)
+	if err != nil {
+		return nil, ErrInvalidBackup
+	}
+	ciphertext, err := hex.DecodeString(backup.Data)
+	if err != nil || len(ciphertext) == 0 {
+		return nil, ErrInvalidBackup
+	}
+
+	key := pbkdf2.Key([]byte(passphrase), salt, backup.Iterations, 32, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aesGCM, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aesGCM.NonceSize() {
+		return nil, ErrInvalidBackup
+	}
+	plaintext, err := aesGCM.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+
+	var restored PippinBackupWallet
+	if err := json.Unmarshal(plaintext, &restored); err != nil {
+		return nil, ErrInvalidBackup
+	}
+	if err := validatePippinBackup(&restored, banano); err != nil {
+		return nil, err
+	}
+	return &restored, nil
+}
+
+func validatePippinBackup(backup *PippinBackupWallet, banano bool) error {
+	if backup.ID == uuid.Nil || (backup.WatchOnly && backup.Hardware) {
+		return ErrInvalidBackup
+	} else if (backup.Seed == nil) != (backup.WatchOnly || backup.Hardware) {
+		return ErrInvalidBackup
+	} else if backup.Seed != nil && !utils.Validate64HexHash(*backup.Seed) {
+		return ErrInvalidBackup
+	}
+	for _, acct := range backup.Accounts {
+		pub, err := utils.AddressToPub(acct.Address, banano)
+		if err != nil {
+			return ErrInvalidBackup
+		}
+		var expected []byte
+		switch {
+		case acct.WatchOnly:
+			continue
+		case acct.Seed != nil && acct.SeedIndex != nil:
+			if !utils.Validate64HexHash(*acct.Seed) || *acct.SeedIndex < 0 {
+				return ErrInvalidBackup
+			}
+			expected, _, err = utils.KeypairFromSeed(*acct.Seed, uint32(*acct.SeedIndex))
+		case acct.PrivateKey != nil:
+			decoded, decodeErr := hex.DecodeString(*acct.PrivateKey)
+			if decodeErr != nil || len(decoded) != ed25519.PrivateKeySize {
+				return ErrInvalidBackup
+			}
+			expected = ed25519.PrivateKey(decoded).Public().(ed25519.PublicKey)
+		case acct.Index != nil && *acct.Index >= 0:
+			// The Ledger isn't asked, it might not be the one the backup came from
+			if backup.Hardware {
+				continue
+			}
+			expected, _, err = utils.KeypairFromSeed(*backup.Seed, uint32(*acct.Index))
+		default:
+			return ErrInvalidBackup
+		}
+		if err != nil {
+			return err
+		} else if !bytes.Equal(pub, expected) {
+			return ErrInvalidBackup
+		}
+	}
+	return nil
+}
+
+// Restore a Pippin backup, the wallet keeps its ID and it's unencrypted
+func (w *NanoWallet) WalletBackupRestore(data []byte, passphrase string) (*ent.Wallet, []*ent.Account, error) {
+	backup, err := DecryptPippinBackup(data, passphrase, w.Banano)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var seed string
+	if backup.WatchOnly {
+		seed = watchOnlySeed(backup.ID)
+	} else if backup.Hardware {
+		seed = hardwareSeed(backup.ID)
+	} else {
+		seed = *backup.Seed
+	}
+
+	tx, err := w.DB.Tx(w.Ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	wallet, err := tx.Wallet.Create().
+		SetID(backup.ID).
+		SetSeed(seed).
+		SetNillableName(backup.Name).
+		SetNillableRepresentative(backup.Representative).
+		SetWork(backup.Work).
+		SetWatchOnly(backup.WatchOnly).
+		SetHardware(backup.Hardware).
+		SetAutoReceive(backup.AutoReceive).
+		SetNillableReceiveMinimum(backup.ReceiveMinimum).
+		Save(w.Ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+	var accounts []*ent.Account
+	for _, acct := range backup.Accounts {
+		created, err := tx.Account.Create().
+			SetWallet(wallet).
+			SetAddress(acct.Address).
+			SetNillableAccountIndex(acct.Index).
+			SetNillablePrivateKey(acct.PrivateKey).
+			SetNillableSeed(acct.Seed).
+			SetNillableSeedIndex(acct.SeedIndex).
+			SetWork(acct.Work).
+			SetWatchOnly(acct.WatchOnly).
+			Save(w.Ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, nil, err
+		}
+		accounts = append(accounts, created)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return wallet, accounts, nil
+}
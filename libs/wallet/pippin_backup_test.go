@@ -0,0 +1,97 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPippinBackup(t *testing.T) {
+	_, err := MockWallet.WalletBackupCreate(nil, "passphrase")
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("5b9e2c7f1a4d8e3b6c0f9a2d5e8b1c4f7a0d3e6b9c2f5a8d1e4b7c0f3a6d9e2b"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	_, err = MockWallet.WalletBackupCreate(wallet, "")
+	assert.ErrorIs(t, err, ErrBadPassword)
+
+	_, priv, _ := ed25519.GenerateKey(strings.NewReader("7c2f9a4e1b6d3a8f5c2e9b1d6e1b8d3f0a5c7e2b9d4f1a6c3e8b5d0f7a2c9e4b"))
+	adhoc, err := MockWallet.AdhocAccountCreate(wallet, priv)
+	assert.Nil(t, err)
+	watched := "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7"
+	_, err = MockWallet.WalletAddWatch(wallet, []string{watched})
+	assert.Nil(t, err)
+	derived, err := MockWallet.AccountsCreate(wallet, 1)
+	assert.Nil(t, err)
+
+	// Keys come from storage while it's encrypted
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+	MockWallet.LockWallet(wallet)
+	_, err = MockWallet.WalletBackupCreate(wallet, "passphrase")
+	assert.ErrorIs(t, err, ErrWalletLocked)
+	MockWallet.UnlockWallet(wallet, "password")
+	backup, err := MockWallet.WalletBackupCreate(wallet, "passphrase")
+	assert.Nil(t, err)
+	assert.NotContains(t, string(backup), seed)
+
+	// Nothing's restored over the wallet that's still there
+	_, _, err = MockWallet.WalletBackupRestore(backup, "wrong")
+	assert.ErrorIs(t, err, ErrDecryptionFailed)
+	_, _, err = MockWallet.WalletBackupRestore(backup, "passphrase")
+	assert.NotNil(t, err)
+	_, _, err = MockWallet.WalletBackupRestore([]byte(`{"version": 2}`), "passphrase")
+	assert.ErrorIs(t, err, ErrInvalidBackup)
+
+	assert.Nil(t, MockWallet.WalletDestroy(wallet))
+	restored, accounts, err := MockWallet.WalletBackupRestore(backup, "passphrase")
+	assert.Nil(t, err)
+	assert.Equal(t, wallet.ID, restored.ID)
+	assert.Equal(t, seed, restored.Seed)
+	assert.False(t, restored.Encrypted)
+	assert.Len(t, accounts, 4)
+	for _, acct := range accounts {
+		switch acct.Address {
+		case adhoc.Address:
+			assert.Equal(t, *adhoc.PrivateKey, *acct.PrivateKey)
+		case watched:
+			assert.True(t, acct.WatchOnly)
+		case derived[0].Address:
+			assert.Equal(t, 1, *acct.AccountIndex)
+			assert.Nil(t, acct.PrivateKey)
+		default:
+			assert.Equal(t, 0, *acct.AccountIndex)
+		}
+	}
+	_, err = MockWallet.GetAccount(restored, adhoc.Address)
+	assert.Nil(t, err)
+}
+
+func TestPippinBackupValidation(t *testing.T) {
+	seed := "A3F6C9E2B5D8A1F4C7E0B3D6A9F2C5E8B1D4A7F0C3E6B9D2A5F8C1E4B7D0A3F6"
+	pub, _, _ := utils.KeypairFromSeed(seed, 1)
+	index := 1
+	backup := PippinBackupWallet{
+		ID:       uuid.New(),
+		Seed:     &seed,
+		Accounts: []PippinBackupAccount{{Address: utils.PubKeyToAddress(pub, false), Index: &index}},
+	}
+	assert.Nil(t, validatePippinBackup(&backup, false))
+
+	// The address has to be the one at its index
+	index = 2
+	assert.ErrorIs(t, validatePippinBackup(&backup, false), ErrInvalidBackup)
+
+	// Watch-only wallets don't have a seed
+	backup.WatchOnly = true
+	assert.ErrorIs(t, validatePippinBackup(&backup, false), ErrInvalidBackup)
+	backup.Seed = nil
+	backup.Accounts[0].Index = nil
+	backup.Accounts[0].WatchOnly = true
+	assert.Nil(t, validatePippinBackup(&backup, false))
+}
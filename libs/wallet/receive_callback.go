@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+// Receive callbacks, POST to the callback_url of the config when a wallet account pockets a block
+// A callback that doesn't return a 2xx is retried callback_retries times, waiting twice as long before each retry
+
+// How long to wait before the first retry, for tests
+var receiveCallbackRetryDelay = time.Second
+
+// The body POSTed to the callback_url
+type ReceiveCallback struct {
+	Wallet     string `json:"wallet"`
+	Account    string `json:"account"`
+	Hash       string `json:"hash"`
+	Source     string `json:"source"`
+	AmountRaw  string `json:"amount_raw"`
+	BalanceRaw string `json:"balance_raw"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// POST a receive callback in the background, if there's a callback_url
+func (w *NanoWallet) notifyReceive(wallet *ent.Wallet, acc *ent.Account, hash string, source string, amount string, balance string) {
+	if w.liveConfig().Wallet.CallbackUrl == "" {
+		return
+	}
+	w.deliverReceiveCallback(ReceiveCallback{
+		Wallet:     wallet.ID.String(),
+		Account:    acc.Address,
+		Hash:       hash,
+		Source:     source,
+		AmountRaw:  amount,
+		BalanceRaw: balance,
+		Timestamp:  time.Now().Unix(),
+	})
+}
+
+// POST callback in the background, or keep it for the next start if we're shutting down
+func (w *NanoWallet) deliverReceiveCallback(callback ReceiveCallback) {
+	if !w.begin() {
+		w.queueReceiveCallback(callback)
+		return
+	}
+	go func() {
+		defer w.done()
+		w.postReceiveCallback(callback)
+	}()
+}
+
+// Deliver callback, retrying with exponential backoff until it's delivered, the retries run out, the wallet context is done or we're shutting down
+func (w *NanoWallet) postReceiveCallback(callback ReceiveCallback) error {
+	body, err := json.Marshal(callback)
+	if err != nil {
+		return err
+	}
+
+	delay := receiveCallbackRetryDelay
+	for attempt := 0; ; attempt++ {
+		// A reload can change them between attempts
+		conf := w.liveConfig()
+		err = w.postWebhook(conf.Wallet.CallbackUrl, body)
+		if err == nil {
+			return nil
+		} else if attempt >= conf.Wallet.CallbackRetries {
+			log.Errorf("Giving up on the receive callback for %s after %d attempts: %v", callback.Hash, attempt+1, err)
+			return err
+		}
+		log.Warnf("Receive callback for %s failed, retrying in %s: %v", callback.Hash, delay, err)
+		select {
+		case <-w.Ctx.Done():
+			return w.Ctx.Err()
+		case <-w.stopped():
+			// Retried by the next instance that starts
+			w.queueReceiveCallback(callback)
+			return ErrShuttingDown
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
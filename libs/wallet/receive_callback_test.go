@@ -0,0 +1,134 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostReceiveCallback(t *testing.T) {
+	receiveCallbackRetryDelay = time.Millisecond
+	defer func() { receiveCallbackRetryDelay = time.Second }()
+
+	attempts := 0
+	failures := 2
+	var delivered []ReceiveCallback
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts <= failures {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, SignWebhook("secret", body), r.Header.Get(WebhookSignatureHeader))
+		var callback ReceiveCallback
+		json.Unmarshal(body, &callback)
+		delivered = append(delivered, callback)
+	}))
+	defer server.Close()
+
+	conf := *MockWallet.Config
+	conf.Wallet.CallbackUrl = server.URL
+	conf.Wallet.CallbackRetries = 2
+	callbackWallet := &NanoWallet{Ctx: MockWallet.Ctx, Config: &conf, WebhookSecret: "secret"}
+	callback := ReceiveCallback{Wallet: "1234", Account: "nano_1", Hash: "A1", Source: "B1", AmountRaw: "5", BalanceRaw: "10", Timestamp: 1700000000}
+
+	// Delivered on the last retry
+	assert.Nil(t, callbackWallet.postReceiveCallback(callback))
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, []ReceiveCallback{callback}, delivered)
+
+	// One more failure than there are retries
+	attempts = 0
+	failures = 3
+	assert.NotNil(t, callbackWallet.postReceiveCallback(callback))
+	assert.Equal(t, 3, attempts)
+	assert.Len(t, delivered, 1)
+}
+
+func TestReceiveCallback(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	// The callback server is real
+	httpmock.RegisterNoResponder(httpmock.InitialTransport.RoundTrip)
+
+	pending := "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE"
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "block_info":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "account_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "5",
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			case "process":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", 2),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	callbacks := make(chan ReceiveCallback, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var callback ReceiveCallback
+		json.NewDecoder(r.Body).Decode(&callback)
+		callbacks <- callback
+	}))
+	defer server.Close()
+
+	conf := *MockWallet.Config
+	conf.Wallet.CallbackUrl = server.URL
+	conf.Wallet.FrontierCacheSize = 0
+	callbackWallet := &NanoWallet{
+		DB:         MockWallet.DB,
+		Ctx:        MockWallet.Ctx,
+		RpcClient:  MockWallet.RpcClient,
+		WorkClient: MockWallet.WorkClient,
+		Config:     &conf,
+	}
+	seed, _ := utils.GenerateSeed(strings.NewReader("4e7d0a3c6f9b2e5d8a1c4f7b0e3d6a9c2f5b8e1d4a7c0f3b6e9d2a5c8f1b4e7d"))
+	wallet, err := callbackWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := callbackWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	work := "0000000000000000"
+	hash, err := callbackWallet.CreateAndPublishReceiveBlock(wallet, acc.Address, pending, &work, nil)
+	assert.Nil(t, err)
+	select {
+	case callback := <-callbacks:
+		assert.NotZero(t, callback.Timestamp)
+		callback.Timestamp = 0
+		assert.Equal(t, ReceiveCallback{
+			Wallet:     wallet.ID.String(),
+			Account:    acc.Address,
+			Hash:       hash,
+			Source:     pending,
+			AmountRaw:  "30000000000000000000000000000000000",
+			BalanceRaw: "30000000000000000000000000000000005",
+		}, callback)
+	case <-time.After(5 * time.Second):
+		t.Fatal("No receive callback")
+	}
+}
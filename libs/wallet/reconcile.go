@@ -0,0 +1,265 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	entblock "github.com/appditto/pippin_nano_wallet/libs/database/ent/block"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
+	rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/mitchellh/mapstructure"
+	"golang.org/x/sync/errgroup"
+)
+
+// Reasons a recorded send isn't on the node
+const (
+	// The block was made but the node doesn't have it, retrying with the same id publishes it again
+	MissingSendNotPublished = "not_published"
+	// send_with_id stopped before it knew whether the send was published
+	MissingSendInterrupted = "interrupted"
+)
+
+// Accounts per accounts_info and accounts_pending call
+const reconcileBatch = 1000
+
+// A send with an id, from the blocks we saved and the send_with_id records
+type recordedSend struct {
+	account string
+	sendID  string
+	// Empty if send_with_id never saved a block
+	hash        string
+	previous    string
+	balance     string
+	destination string
+	amount      *string
+	// Of the send_with_id record, empty without one
+	status idempotentsend.Status
+}
+
+// Compare what the database has for a wallet with the node: every account is checked for blocks it hasn't received,
+// and every send made with an id since since (all of them if it's nil) is looked up with block_info
+// A send the node doesn't have is a fork if the node has another block after its previous, otherwise it's missing
+// A send that's still the frontier of its account should have the same balance as the node has
+// Nothing is signed, so it works while the wallet is locked
+func (w *NanoWallet) WalletReconcile(wallet *ent.Wallet, since *time.Time) (*models.WalletReconciliation, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Order(ent.Asc(account.FieldCreatedAt)).All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	infos, pending, err := w.reconcileNodeState(accounts)
+	if err != nil {
+		return nil, err
+	}
+	sends, err := w.recordedSends(wallet, since)
+	if err != nil {
+		return nil, err
+	}
+
+	reconciliation := &models.WalletReconciliation{
+		AccountsChecked:   len(accounts),
+		SendsChecked:      len(sends),
+		Unpocketed:        []models.UnpocketedAccount{},
+		Forks:             []models.ReconcileFork{},
+		MissingSends:      []models.MissingSend{},
+		BalanceMismatches: []models.BalanceMismatch{},
+	}
+	for _, acc := range accounts {
+		if blocks := pending[acc.Address]; len(blocks) > 0 {
+			reconciliation.Unpocketed = append(reconciliation.Unpocketed, models.UnpocketedAccount{Account: acc.Address, Blocks: blocks})
+		}
+	}
+
+	// Each send is its own block_info, or two for one that isn't on the node
+	forks := make([]string, len(sends))
+	found := make([]bool, len(sends))
+	var g errgroup.Group
+	g.SetLimit(max(w.Config.Server.BlockInfoConcurrency, 1))
+	for i, send := range sends {
+		if send.hash == "" {
+			continue
+		}
+		g.Go(func() (err error) {
+			found[i], forks[i], err = w.reconcileSend(send)
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	for i, send := range sends {
+		if send.hash == "" {
+			reconciliation.MissingSends = append(reconciliation.MissingSends, missingSend(send, MissingSendInterrupted))
+		} else if forks[i] != "" {
+			reconciliation.Forks = append(reconciliation.Forks, models.ReconcileFork{Account: send.account, SendID: send.sendID, Block: send.hash, NodeBlock: forks[i]})
+		} else if !found[i] {
+			reason := MissingSendNotPublished
+			if send.status == idempotentsend.StatusPending {
+				reason = MissingSendInterrupted
+			}
+			reconciliation.MissingSends = append(reconciliation.MissingSends, missingSend(send, reason))
+		} else if info, ok := infos[send.account]; ok && send.balance != "" && strings.EqualFold(info.Frontier, send.hash) && info.Balance != send.balance {
+			reconciliation.BalanceMismatches = append(reconciliation.BalanceMismatches, models.BalanceMismatch{
+				Account:        send.account,
+				Frontier:       info.Frontier,
+				BalanceRaw:     send.balance,
+				NodeBalanceRaw: info.Balance,
+			})
+		}
+	}
+	return reconciliation, nil
+}
+
+func missingSend(send recordedSend, reason string) models.MissingSend {
+	missing := models.MissingSend{
+		Account:     send.account,
+		SendID:      send.sendID,
+		Destination: send.destination,
+		AmountRaw:   send.amount,
+		Reason:      reason,
+	}
+	if send.hash != "" {
+		missing.Block = &send.hash
+	}
+	return missing
+}
+
+// The accounts_info and receivable blocks of accounts, unopened accounts have no info
+func (w *NanoWallet) reconcileNodeState(accounts []*ent.Account) (map[string]rpcresponses.AccountInfoResponse, map[string][]string, error) {
+	infos := map[string]rpcresponses.AccountInfoResponse{}
+	pending := map[string][]string{}
+	for start := 0; start < len(accounts); start += reconcileBatch {
+		batch := accounts[start:min(start+reconcileBatch, len(accounts))]
+		addresses := make([]string, len(batch))
+		for i, acc := range batch {
+			addresses[i] = acc.Address
+		}
+
+		var batchInfos *rpcresponses.AccountsInfoResponse
+		var batchPending *rpcresponses.AccountsPendingResponse
+		var g errgroup.Group
+		g.Go(func() (err error) {
+			batchInfos, err = w.RpcClient.MakeAccountsInfoRequest(addresses, false, false, false)
+			return err
+		})
+		g.Go(func() (err error) {
+			batchPending, err = w.RpcClient.MakeAccountsPendingRequest(addresses)
+			return err
+		})
+		if err := g.Wait(); err != nil {
+			return nil, nil, err
+		}
+		for address, info := range *batchInfos.Infos {
+			infos[address] = info
+		}
+		for address, blocks := range *batchPending.Blocks {
+			pending[address] = blocks
+		}
+	}
+	return infos, pending, nil
+}
+
+// The wallet's sends with an id since since, oldest first, with the send_with_id records that never saved a block last
+func (w *NanoWallet) recordedSends(wallet *ent.Wallet, since *time.Time) ([]recordedSend, error) {
+	blockQuery := w.DB.Block.Query().Where(entblock.SendIDNotNil(), entblock.HasAccountWith(account.WalletID(wallet.ID)))
+	recordQuery := w.DB.IdempotentSend.Query().Where(idempotentsend.WalletID(wallet.ID), idempotentsend.StatusNEQ(idempotentsend.StatusFailed))
+	if since != nil {
+		blockQuery = blockQuery.Where(entblock.CreatedAtGTE(*since))
+		recordQuery = recordQuery.Where(idempotentsend.CreatedAtGTE(*since))
+	}
+	blocks, err := blockQuery.WithAccount().Order(ent.Asc(entblock.FieldCreatedAt)).All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	records, err := recordQuery.Order(ent.Asc(idempotentsend.FieldCreatedAt)).All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	bySendID := make(map[string]*ent.IdempotentSend, len(records))
+	for _, record := range records {
+		bySendID[record.SendID] = record
+	}
+
+	sends := []recordedSend{}
+	for _, block := range blocks {
+		if block.Edges.Account == nil {
+			continue
+		}
+		var sb nanoblock.StateBlock
+		if err := mapstructure.Decode(block.Block, &sb); err != nil {
+			return nil, err
+		}
+		send := recordedSend{
+			account:  block.Edges.Account.Address,
+			sendID:   *block.SendID,
+			hash:     strings.ToUpper(block.BlockHash),
+			previous: sb.Previous,
+			balance:  sb.Balance,
+		}
+		if link, err := hex.DecodeString(sb.Link); err == nil && len(link) == 32 {
+			send.destination = utils.PubKeyToAddress(link, w.Banano)
+		}
+		if record, ok := bySendID[send.sendID]; ok && record.Source == send.account {
+			send.amount = &record.Amount
+			send.status = record.Status
+			delete(bySendID, send.sendID)
+		}
+		sends = append(sends, send)
+	}
+
+	// Interrupted before the block was saved, or the block is from before since
+	for _, record := range records {
+		if _, ok := bySendID[record.SendID]; !ok {
+			continue
+		}
+		send := recordedSend{
+			account:     record.Source,
+			sendID:      record.SendID,
+			destination: record.Destination,
+			amount:      &record.Amount,
+			status:      record.Status,
+		}
+		if record.BlockHash != nil {
+			send.hash = strings.ToUpper(*record.BlockHash)
+		}
+		sends = append(sends, send)
+	}
+	return sends, nil
+}
+
+// Whether the node has the send, if it doesn't the block the node has in its place, if there is one
+func (w *NanoWallet) reconcileSend(send recordedSend) (bool, string, error) {
+	_, err := w.RpcClient.MakeBlockInfoRequest(send.hash)
+	if err == nil {
+		return true, "", nil
+	} else if !errors.Is(err, nanorpc.ErrBlockNotFound) {
+		return false, "", err
+	}
+
+	// A send is never an account's first block, so it always has a previous
+	if send.previous == "" || send.previous == zeroHash {
+		return false, "", nil
+	}
+	previous, err := w.RpcClient.MakeBlockInfoRequest(send.previous)
+	if errors.Is(err, nanorpc.ErrBlockNotFound) {
+		return false, "", nil
+	} else if err != nil {
+		return false, "", err
+	}
+	if previous.Successor != "" && previous.Successor != zeroHash && !strings.EqualFold(previous.Successor, send.hash) {
+		return false, strings.ToUpper(previous.Successor), nil
+	}
+	return false, "", nil
+}
@@ -0,0 +1,128 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletReconcile(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("6a1d4f7b0e3c6a9d2f5b8e1c4a7d0f3b6e9c2a5d8f1b4e7c0a3d6f9b2e5c8a1d"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	created, err := MockWallet.AccountsCreate(wallet, 1)
+	assert.Nil(t, err)
+	source, err := MockWallet.GetAccount(wallet, created[0].Address)
+	assert.Nil(t, err)
+	pub, _, _ := utils.KeypairFromSeed(seed, 0)
+	receiver := utils.PubKeyToAddress(pub, false)
+
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	destinationPub, _ := utils.AddressToPub(destination, false)
+	hash := func(c string) string { return strings.Repeat(c, 64) }
+	saveSend := func(sendID string, blockHash string, previous string, balance string) {
+		_, err := MockWallet.DB.Block.Create().SetAccount(source).SetWallet(wallet).SetBlockHash(blockHash).SetSubtype("send").SetSendID(sendID).SetBlock(map[string]interface{}{
+			"type":     "state",
+			"account":  source.Address,
+			"previous": previous,
+			"balance":  balance,
+			"link":     strings.ToUpper(hex.EncodeToString(destinationPub)),
+		}).Save(MockWallet.Ctx)
+		assert.Nil(t, err)
+	}
+	// On the node and the frontier, but the node has another balance
+	saveSend("matches", hash("A"), hash("1"), "100")
+	// Another block came after its previous
+	saveSend("forked", hash("B"), hash("2"), "100")
+	// Nothing came after its previous, and send_with_id never finished
+	saveSend("lost", hash("C"), hash("3"), "100")
+	_, err = MockWallet.DB.IdempotentSend.Create().SetWallet(wallet).SetSendID("lost").SetSource(source.Address).SetDestination(destination).SetAmount("5").Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+	// Stopped before the block was saved
+	_, err = MockWallet.DB.IdempotentSend.Create().SetWallet(wallet).SetSendID("early").SetSource(source.Address).SetDestination(destination).SetAmount("7").Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+	// Failed sends were never published
+	_, err = MockWallet.DB.IdempotentSend.Create().SetWallet(wallet).SetSendID("failed").SetSource(source.Address).SetDestination(destination).SetAmount("9").SetStatus(idempotentsend.StatusFailed).Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "accounts_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"infos":  map[string]interface{}{source.Address: map[string]string{"frontier": hash("A"), "open_block": hash("0"), "balance": "90"}},
+					"errors": map[string]string{receiver: "Account not found"},
+				})
+			case "accounts_pending":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"blocks": map[string][]string{receiver: {hash("D")}, source.Address: {}},
+				})
+			case "block_info":
+				switch pr["hash"] {
+				case hash("A"):
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"successor": hash("0")})
+				case hash("2"):
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"successor": hash("E")})
+				case hash("3"):
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"successor": hash("0")})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Block not found"})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+
+	_, err = MockWallet.WalletReconcile(nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	reconciliation, err := MockWallet.WalletReconcile(wallet, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, reconciliation.AccountsChecked)
+	assert.Equal(t, 4, reconciliation.SendsChecked)
+	assert.Equal(t, []models.UnpocketedAccount{{Account: receiver, Blocks: []string{hash("D")}}}, reconciliation.Unpocketed)
+	assert.Equal(t, []models.ReconcileFork{{Account: source.Address, SendID: "forked", Block: hash("B"), NodeBlock: hash("E")}}, reconciliation.Forks)
+	assert.Equal(t, []models.BalanceMismatch{{Account: source.Address, Frontier: hash("A"), BalanceRaw: "100", NodeBalanceRaw: "90"}}, reconciliation.BalanceMismatches)
+	assert.Len(t, reconciliation.MissingSends, 2)
+	lost := reconciliation.MissingSends[0]
+	assert.Equal(t, "lost", lost.SendID)
+	assert.Equal(t, hash("C"), *lost.Block)
+	assert.Equal(t, destination, lost.Destination)
+	assert.Equal(t, "5", *lost.AmountRaw)
+	assert.Equal(t, MissingSendInterrupted, lost.Reason)
+	early := reconciliation.MissingSends[1]
+	assert.Equal(t, "early", early.SendID)
+	assert.Nil(t, early.Block)
+	assert.Equal(t, "7", *early.AmountRaw)
+	assert.Equal(t, MissingSendInterrupted, early.Reason)
+
+	// A saved block without a send_with_id record that isn't on the node was never published
+	_, err = MockWallet.DB.IdempotentSend.Delete().Where(idempotentsend.WalletID(wallet.ID)).Exec(MockWallet.Ctx)
+	assert.Nil(t, err)
+	reconciliation, err = MockWallet.WalletReconcile(wallet, nil)
+	assert.Nil(t, err)
+	assert.Len(t, reconciliation.MissingSends, 1)
+	assert.Equal(t, MissingSendNotPublished, reconciliation.MissingSends[0].Reason)
+	assert.Nil(t, reconciliation.MissingSends[0].AmountRaw)
+
+	// Only sends since the given time
+	since := time.Now().Add(time.Hour)
+	reconciliation, err = MockWallet.WalletReconcile(wallet, &since)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, reconciliation.SendsChecked)
+	assert.Empty(t, reconciliation.Forks)
+	assert.Len(t, reconciliation.Unpocketed, 1)
+}
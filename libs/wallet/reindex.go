@@ -0,0 +1,83 @@
+package wallet
+
+import (
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+// How many indexes past the highest we know of are derived looking for accounts
+const reindexLookahead = 1000
+
+// Set account_index of every account that's derived from the wallet seed but doesn't have it, or has the wrong one
+// The seed is derived from index 0 until every account is matched, or reindexLookahead past the highest index
+// Adhoc accounts and accounts of another seed have their own keys and are left alone
+// Anything else that doesn't match is returned in Unmatched, it isn't removed
+func (w *NanoWallet) WalletAccountsReindex(wallet *ent.Wallet) (*models.AccountsReindex, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+
+	seed, err := GetDecryptedKeyFromStorage(wallet, "seed")
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.PrivateKeyIsNil(), account.SeedIsNil()).Order(ent.Asc(account.FieldCreatedAt)).All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byAddress := make(map[string]*ent.Account, len(accounts))
+	highest := len(accounts)
+	for _, acct := range accounts {
+		byAddress[acct.Address] = acct
+		if acct.AccountIndex != nil && *acct.AccountIndex > highest {
+			highest = *acct.AccountIndex
+		}
+	}
+
+	// Watch-only wallets don't have a seed to derive from
+	matches := map[string]int{}
+	if utils.Validate64HexHash(seed) {
+		for index := 0; index <= highest+reindexLookahead && len(matches) < len(byAddress); index++ {
+			pub, _, err := utils.KeypairFromSeed(seed, uint32(index))
+			if err != nil {
+				return nil, err
+			}
+			address := utils.PubKeyToAddress(pub, w.Banano)
+			if _, ok := byAddress[address]; ok {
+				matches[address] = index
+			}
+		}
+	}
+
+	reindex := &models.AccountsReindex{
+		Unmatched: []string{},
+	}
+	tx, err := w.DB.Tx(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, acct := range accounts {
+		index, ok := matches[acct.Address]
+		if !ok {
+			reindex.Unmatched = append(reindex.Unmatched, acct.Address)
+			continue
+		}
+		if acct.AccountIndex != nil && *acct.AccountIndex == index {
+			continue
+		}
+		if _, err := tx.Account.UpdateOne(acct).SetAccountIndex(index).Save(w.Ctx); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		reindex.Updated++
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return reindex, nil
+}
@@ -0,0 +1,74 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletAccountsReindex(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("4b7e0a3d6c9f2b5e8a1d4c7f0b3e6a9d2c5f8b1e4a7d0c3f6b9e2a5d8c1f4b7e"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	_, err = MockWallet.AccountsCreate(wallet, 2)
+	assert.Nil(t, err)
+	_, priv, _ := ed25519.GenerateKey(strings.NewReader("9f2b5e8a1d4c7f0b3e6a9d2c5f8b1e4a7d0c3f6b9e2a5d8c1f4b7e4b7e0a3d6c"))
+	adhoc, err := MockWallet.AdhocAccountCreate(wallet, priv)
+	assert.Nil(t, err)
+
+	_, err = MockWallet.WalletAccountsReindex(nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	// Nothing to fix
+	reindex, err := MockWallet.WalletAccountsReindex(wallet)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, reindex.Updated)
+	assert.Len(t, reindex.Unmatched, 0)
+
+	// Accounts from before the index was stored, one with the wrong index and one from another seed without keys
+	index1, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndex(1)).Only(MockWallet.Ctx)
+	assert.Nil(t, err)
+	_, err = MockWallet.DB.Account.UpdateOne(index1).ClearAccountIndex().Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+	index2, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndex(2)).Only(MockWallet.Ctx)
+	assert.Nil(t, err)
+	_, err = MockWallet.DB.Account.UpdateOne(index2).SetAccountIndex(7).Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+	pub, _, _ := utils.KeypairFromSeed(seed, 12)
+	index12, err := MockWallet.DB.Account.Create().SetWallet(wallet).SetAddress(utils.PubKeyToAddress(pub, false)).Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+	otherSeed, _ := utils.GenerateSeed(strings.NewReader("d4c7f0b3e6a9d2c5f8b1e4a7d0c3f6b9e2a5d8c1f4b7e4b7e0a3d6c9f2b5e8a1"))
+	strangerPub, _, _ := utils.KeypairFromSeed(otherSeed, 0)
+	stranger := utils.PubKeyToAddress(strangerPub, false)
+	_, err = MockWallet.DB.Account.Create().SetWallet(wallet).SetAddress(stranger).Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+
+	reindex, err = MockWallet.WalletAccountsReindex(wallet)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, reindex.Updated)
+	assert.Equal(t, []string{stranger}, reindex.Unmatched)
+
+	for address, expected := range map[string]int{index1.Address: 1, index2.Address: 2, index12.Address: 12} {
+		acct, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID), account.Address(address)).Only(MockWallet.Ctx)
+		assert.Nil(t, err)
+		assert.NotNil(t, acct.AccountIndex)
+		assert.Equal(t, expected, *acct.AccountIndex)
+	}
+	// Flagged, not removed, and the adhoc account is left alone
+	exists, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID), account.Address(stranger)).Exist(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.True(t, exists)
+	acct, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID), account.Address(adhoc.Address)).Only(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, acct.AccountIndex)
+
+	// Running it again changes nothing
+	reindex, err = MockWallet.WalletAccountsReindex(wallet)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, reindex.Updated)
+	assert.Equal(t, []string{stranger}, reindex.Unmatched)
+}
@@ -0,0 +1,68 @@
+package wallet
+
+import (
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/predicate"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/representativehistory"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+// The representative changes of the wallet's accounts, or only of address if it isn't empty, oldest first
+// Only changes from start up to but not including end, either can be nil to not limit that side
+// Every change block CreateAndPublishChangeBlock publishes is recorded
+func (w *NanoWallet) WalletRepresentativeHistory(wallet *ent.Wallet, address string, start *time.Time, end *time.Time) ([]models.RepresentativeHistoryEntry, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	if start != nil && end != nil && !end.After(*start) {
+		return nil, ErrInvalidDateRange
+	}
+
+	// Determine if wallet is locked or not
+	if _, err := GetDecryptedKeyFromStorage(wallet, "seed"); err != nil {
+		return nil, err
+	}
+
+	where := []predicate.RepresentativeHistory{}
+	if address != "" {
+		// Account must be in this wallet
+		acc, err := w.GetAccount(wallet, address)
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, representativehistory.AccountID(acc.ID))
+	} else {
+		where = append(where, representativehistory.HasAccountWith(account.WalletID(wallet.ID)))
+	}
+	if start != nil {
+		where = append(where, representativehistory.ChangedAtGTE(*start))
+	}
+	if end != nil {
+		where = append(where, representativehistory.ChangedAtLT(*end))
+	}
+
+	changes, err := w.DB.RepresentativeHistory.Query().
+		Where(where...).
+		WithAccount().
+		Order(ent.Asc(representativehistory.FieldChangedAt)).
+		All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	history := []models.RepresentativeHistoryEntry{}
+	for _, change := range changes {
+		history = append(history, models.RepresentativeHistoryEntry{
+			Account:           change.Edges.Account.Address,
+			OldRepresentative: change.OldRepresentative,
+			NewRepresentative: change.NewRepresentative,
+			BlockHash:         change.BlockHash,
+			ChangedAt:         change.ChangedAt,
+		})
+	}
+
+	return history, nil
+}
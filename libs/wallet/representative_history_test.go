@@ -0,0 +1,114 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletRepresentativeHistory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("e2a5c8f1b4d7e0a3c6f9b2d5e8a1c4f7b0d3e6a9c2f5b8d1e4a7c0f3b6d9e2a5"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	accounts, err := MockWallet.AccountsCreate(wallet, 2)
+	assert.Nil(t, err)
+	acc := accounts[0]
+	other := accounts[1]
+	first := "nano_1efa1gxbitary1urzix9h13nkzadtz71n3auyj7uztb8i4qbtipu8cxz61ee"
+	second := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+
+	// The node has whatever representative the last processed block set
+	representatives := map[string]string{}
+	processed := 0
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "account_info":
+				rep, ok := representatives[pr["account"].(string)]
+				if !ok {
+					rep = "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+				}
+				// The frontier has hard coded work in the pow client
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "11999999999999999918751838129509869131",
+					"representative": rep,
+				})
+			case "process":
+				block := pr["block"].(map[string]interface{})
+				representatives[block["account"].(string)] = block["representative"].(string)
+				processed++
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", processed),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	before := time.Now().Add(-time.Second)
+	_, err = MockWallet.CreateAndPublishChangeBlock(wallet, acc.Address, first, nil, nil, true)
+	assert.Nil(t, err)
+	_, err = MockWallet.CreateAndPublishChangeBlock(wallet, acc.Address, second, nil, nil, true)
+	assert.Nil(t, err)
+	_, err = MockWallet.CreateAndPublishChangeBlock(wallet, other.Address, first, nil, nil, true)
+	assert.Nil(t, err)
+	// Nothing is published, so nothing is recorded
+	_, err = MockWallet.CreateAndPublishChangeBlock(wallet, acc.Address, second, nil, nil, true)
+	assert.ErrorIs(t, err, ErrSameRepresentative)
+
+	history, err := MockWallet.WalletRepresentativeHistory(wallet, acc.Address, nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, history, 2)
+	assert.Equal(t, acc.Address, history[0].Account)
+	assert.Equal(t, "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5", history[0].OldRepresentative)
+	assert.Equal(t, first, history[0].NewRepresentative)
+	assert.Equal(t, fmt.Sprintf("%064X", 1), history[0].BlockHash)
+	assert.Equal(t, first, history[1].OldRepresentative)
+	assert.Equal(t, second, history[1].NewRepresentative)
+	assert.Equal(t, fmt.Sprintf("%064X", 2), history[1].BlockHash)
+	assert.False(t, history[1].ChangedAt.Before(history[0].ChangedAt))
+
+	// Every account of the wallet
+	history, err = MockWallet.WalletRepresentativeHistory(wallet, "", nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, history, 3)
+	assert.Equal(t, other.Address, history[2].Account)
+
+	// Date range
+	after := time.Now().Add(time.Second)
+	history, err = MockWallet.WalletRepresentativeHistory(wallet, "", &before, &after)
+	assert.Nil(t, err)
+	assert.Len(t, history, 3)
+	history, err = MockWallet.WalletRepresentativeHistory(wallet, "", &after, nil)
+	assert.Nil(t, err)
+	assert.Len(t, history, 0)
+	history, err = MockWallet.WalletRepresentativeHistory(wallet, "", nil, &before)
+	assert.Nil(t, err)
+	assert.Len(t, history, 0)
+
+	// errors
+	_, err = MockWallet.WalletRepresentativeHistory(nil, "", nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+	_, err = MockWallet.WalletRepresentativeHistory(wallet, "", &after, &before)
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+	_, err = MockWallet.WalletRepresentativeHistory(wallet, "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", nil, nil)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+	MockWallet.EncryptWallet(wallet, "password")
+	_, err = MockWallet.WalletRepresentativeHistory(wallet, "", nil, nil)
+	assert.ErrorIs(t, err, ErrWalletLocked)
+}
@@ -0,0 +1,146 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+var ErrInvalidGapLimit = errors.New("invalid gap limit")
+
+// The indexes of seed's accounts that were opened or have something to receive, in order
+// They're looked up gapLimit at a time with accounts_frontiers and accounts_pending, until gapLimit in a row have neither
+func (w *NanoWallet) SeedScan(seed string, gapLimit int) ([]int, error) {
+	if !utils.Validate64HexHash(seed) {
+		return nil, ErrInvalidSeed
+	} else if gapLimit < 1 {
+		return nil, ErrInvalidGapLimit
+	}
+
+	used := []int{}
+	gap := 0
+	for start := 0; gap < gapLimit; start += gapLimit {
+		addresses := make([]string, gapLimit)
+		for i := range addresses {
+			pub, _, err := utils.KeypairFromSeed(seed, uint32(start+i))
+			if err != nil {
+				return nil, err
+			}
+			addresses[i] = utils.PubKeyToAddress(pub, w.Banano)
+		}
+
+		frontiersResp, err := w.RpcClient.MakeAccountsFrontiersRequest(addresses)
+		if err != nil {
+			return nil, err
+		}
+		pendingResp, err := w.RpcClient.MakeAccountsPendingRequest(addresses)
+		if err != nil {
+			return nil, err
+		}
+		frontiers := map[string]string{}
+		if frontiersResp.Frontiers != nil {
+			frontiers = *frontiersResp.Frontiers
+		}
+		pending := map[string][]string{}
+		if pendingResp.Blocks != nil {
+			pending = *pendingResp.Blocks
+		}
+
+		for i, address := range addresses {
+			if _, ok := frontiers[address]; ok || len(pending[address]) > 0 {
+				used = append(used, start+i)
+				gap = 0
+			} else if gap++; gap >= gapLimit {
+				break
+			}
+		}
+	}
+	return used, nil
+}
+
+// Create a wallet from seed with its first account and every account SeedScan finds
+// The node is asked first, nothing is created unless all of it is
+func (w *NanoWallet) WalletCreateScanned(seed string, gapLimit int) (*ent.Wallet, []*ent.Account, error) {
+	indexes, err := w.SeedScan(seed, gapLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := w.DB.Tx(w.Ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	wallet, accounts, err := w.createBackupWallet(tx, seed, indexes)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return wallet, accounts, nil
+}
+
+// Change the seed like WalletChangeSeed, then create the accounts of the new seed SeedScan finds that the wallet doesn't have
+// The node is asked before anything changes, returns the newest account and the ones that were created
+func (w *NanoWallet) WalletChangeSeedScanned(wallet *ent.Wallet, newSeed string, gapLimit int) (*ent.Account, []*ent.Account, error) {
+	if wallet == nil {
+		return nil, nil, ErrInvalidWallet
+	} else if _, err := GetDecryptedKeyFromStorage(wallet, "seed"); err != nil {
+		// Fails if the wallet is locked
+		return nil, nil, err
+	}
+	indexes, err := w.SeedScan(newSeed, gapLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newest, err := w.WalletChangeSeed(wallet, newSeed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Like AccountCreate, so the indexes don't race with it
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, nil, database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	existing, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).All(w.Ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	existingIndexes := make([]int, len(existing))
+	for i, acc := range existing {
+		existingIndexes[i] = *acc.AccountIndex
+	}
+
+	created := []*ent.Account{}
+	for _, index := range indexes {
+		if slices.Contains(existingIndexes, index) {
+			continue
+		}
+		pub, _, err := utils.KeypairFromSeed(newSeed, uint32(index))
+		if err != nil {
+			return nil, nil, err
+		}
+		acc, err := w.DB.Account.Create().SetWallet(wallet).SetAccountIndex(index).SetAddress(utils.PubKeyToAddress(pub, w.Banano)).Save(w.Ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		created = append(created, acc)
+		if index > *newest.AccountIndex {
+			newest = acc
+		}
+	}
+	return newest, created, nil
+}
@@ -0,0 +1,158 @@
+package wallet
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// Answer accounts_frontiers with the opened accounts and accounts_pending with the receivable ones
+func mockSeedScan(opened map[string]bool, receivable map[string]bool) *int {
+	requests := 0
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			requests++
+			switch pr["action"] {
+			case "accounts_frontiers":
+				frontiers := map[string]string{}
+				errors := map[string]string{}
+				for _, acc := range pr["accounts"].([]interface{}) {
+					if opened[acc.(string)] {
+						frontiers[acc.(string)] = "791AF413173EEE674A6FCF633B5DFC0F3C33F397F0DA08E987D9E0741D40D81A"
+					} else {
+						errors[acc.(string)] = "Account not found"
+					}
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"frontiers": frontiers, "errors": errors})
+			case "accounts_pending":
+				blocks := map[string][]string{}
+				for _, acc := range pr["accounts"].([]interface{}) {
+					if receivable[acc.(string)] {
+						blocks[acc.(string)] = []string{"4C1FEEF0BEA7F50BE35489A1233FE002B212DEA554B55B1B470D78BD8F210C74"}
+					}
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": blocks})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+	return &requests
+}
+
+func TestSeedScan(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("5b8e1d4a7c0f3e6b9d2a5c8f1e4b7d0a3c6f9e2b5d8a1c4f7e0b3d6a9c2f5e8b"))
+	address := func(index uint32) string {
+		pub, _, _ := utils.KeypairFromSeed(seed, index)
+		return utils.PubKeyToAddress(pub, false)
+	}
+
+	// 50 is past 20 unused ones in a row, so it's never reached
+	opened := map[string]bool{address(1): true, address(24): true, address(50): true}
+	receivable := map[string]bool{address(5): true}
+	requests := mockSeedScan(opened, receivable)
+
+	indexes, err := MockWallet.SeedScan(seed, 20)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 5, 24}, indexes)
+	// 0-19, 20-39 and 40-59, with both actions
+	assert.Equal(t, 6, *requests)
+
+	// A bigger gap gets there
+	indexes, err = MockWallet.SeedScan(seed, 30)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 5, 24, 50}, indexes)
+
+	indexes, err = MockWallet.SeedScan(seed, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1}, indexes)
+
+	_, err = MockWallet.SeedScan(seed, 0)
+	assert.ErrorIs(t, err, ErrInvalidGapLimit)
+	_, err = MockWallet.SeedScan("1234", 20)
+	assert.ErrorIs(t, err, ErrInvalidSeed)
+
+	// Nothing is created when the node can't be asked
+	httpmock.Reset()
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint", httpmock.NewStringResponder(500, "error"))
+	before, err := MockWallet.DB.Wallet.Query().Count(MockWallet.Ctx)
+	assert.Nil(t, err)
+	_, _, err = MockWallet.WalletCreateScanned(seed, 20)
+	assert.NotNil(t, err)
+	after, err := MockWallet.DB.Wallet.Query().Count(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, before, after)
+
+	httpmock.Reset()
+	mockSeedScan(opened, receivable)
+	wallet, accounts, err := MockWallet.WalletCreateScanned(seed, 20)
+	assert.Nil(t, err)
+	assert.Len(t, accounts, 4)
+	for i, index := range []int{0, 1, 5, 24} {
+		assert.Equal(t, index, *accounts[i].AccountIndex)
+		assert.Equal(t, address(uint32(index)), accounts[i].Address)
+	}
+	count, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID)).Count(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, count)
+}
+
+func TestWalletChangeSeedScanned(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	oldSeed, _ := utils.GenerateSeed(strings.NewReader("6e1b4d7a0c3f6e9b2d5a8c1f4e7b0d3a5c8f1e4b7d0a3c6f9e2b5d8a1c4f7e0b"))
+	newSeed, _ := utils.GenerateSeed(strings.NewReader("4f2a9c7e1b5d83060a1c4e7b2d5f8a3c6e9b1d4f7a0c3e6b9d2f5a8c1e4b7d0a"))
+	address := func(index uint32) string {
+		pub, _, _ := utils.KeypairFromSeed(newSeed, index)
+		return utils.PubKeyToAddress(pub, false)
+	}
+
+	wallet, err := MockWallet.WalletCreate(oldSeed)
+	assert.Nil(t, err)
+	_, err = MockWallet.AccountsCreate(wallet, 2)
+	assert.Nil(t, err)
+
+	// The node is asked first, a failure leaves the seed alone
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint", httpmock.NewStringResponder(500, "error"))
+	_, _, err = MockWallet.WalletChangeSeedScanned(wallet, newSeed, 20)
+	assert.NotNil(t, err)
+	wallet, err = MockWallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+	assert.Equal(t, oldSeed, wallet.Seed)
+
+	httpmock.Reset()
+	mockSeedScan(map[string]bool{address(1): true, address(7): true}, map[string]bool{address(12): true})
+	newest, created, err := MockWallet.WalletChangeSeedScanned(wallet, newSeed, 20)
+	assert.Nil(t, err)
+	assert.Equal(t, address(12), newest.Address)
+	assert.Equal(t, 12, *newest.AccountIndex)
+	// 0 to 2 were there already
+	assert.Len(t, created, 2)
+	assert.Equal(t, address(7), created[0].Address)
+	assert.Equal(t, address(12), created[1].Address)
+
+	wallet, err = MockWallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+	assert.Equal(t, newSeed, wallet.Seed)
+	accounts, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID)).Order(ent.Asc(account.FieldAccountIndex)).All(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Len(t, accounts, 5)
+	for i, index := range []int{0, 1, 2, 7, 12} {
+		assert.Equal(t, address(uint32(index)), accounts[i].Address)
+	}
+
+	_, _, err = MockWallet.WalletChangeSeedScanned(nil, newSeed, 20)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+}
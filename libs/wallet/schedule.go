@@ -0,0 +1,172 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendschedule"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/nano"
+	"github.com/google/uuid"
+)
+
+var ErrScheduleNotFound = errors.New("schedule not found")
+var ErrInvalidInterval = errors.New("invalid interval")
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// Recurring sends, each schedule sends a fixed amount from source to destination every interval
+
+// Clock is the time source used by the send scheduler, so tests can control when intervals fire
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Create a new send schedule, the first send is due at startAt
+func (w *NanoWallet) SendScheduleCreate(wallet *ent.Wallet, source string, destination string, amount string, intervalSeconds int, startAt time.Time) (*ent.SendSchedule, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	if intervalSeconds < 1 {
+		return nil, ErrInvalidInterval
+	}
+	sendAmount, ok := big.NewInt(0).SetString(amount, 10)
+	if !ok || sendAmount.Sign() <= 0 {
+		return nil, ErrInvalidAmount
+	}
+	if _, err := nano.ValidateAddress(destination, w.Config.Wallet.Banano); err != nil {
+		return nil, ErrInvalidAccount
+	}
+	// Nobody would be there to approve them
+	if w.RequiresApproval(wallet, sendAmount.String()) {
+		return nil, ErrApprovalRequired
+	}
+
+	// Source must be in this wallet, this also fails if the wallet is locked
+	acc, err := w.GetAccount(wallet, source)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.DB.SendSchedule.Create().
+		SetWalletID(wallet.ID).
+		SetSource(acc.Address).
+		SetDestination(destination).
+		SetAmount(sendAmount.String()).
+		SetIntervalSeconds(intervalSeconds).
+		SetNextRunAt(startAt).
+		Save(w.Ctx)
+}
+
+// Cancel a send schedule, it must belong to the given wallet
+func (w *NanoWallet) SendScheduleCancel(wallet *ent.Wallet, scheduleID string) error {
+	if wallet == nil {
+		return ErrInvalidWallet
+	}
+	id, err := uuid.Parse(scheduleID)
+	if err != nil {
+		return ErrScheduleNotFound
+	}
+
+	deleted, err := w.DB.SendSchedule.Delete().Where(sendschedule.ID(id), sendschedule.WalletID(wallet.ID)).Exec(w.Ctx)
+	if err != nil {
+		return err
+	} else if deleted < 1 {
+		return ErrScheduleNotFound
+	}
+
+	return nil
+}
+
+// Execute every schedule that is due at now, returns the number of sends published
+// A schedule sends at most once per call, intervals that were missed (e.g. the server was down) are skipped, not replayed
+func (w *NanoWallet) RunDueSendSchedules(now time.Time) (int, error) {
+	due, err := w.DB.SendSchedule.Query().Where(sendschedule.NextRunAtLTE(now)).All(w.Ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, schedule := range due {
+		// The rest are still due when we start again
+		if w.ShuttingDown() {
+			break
+		}
+		ok, err := w.runSendSchedule(schedule.ID, now)
+		if err != nil {
+			return sent, err
+		} else if ok {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+func (w *NanoWallet) runSendSchedule(id uuid.UUID, now time.Time) (bool, error) {
+	// Lock each schedule so we don't handle them on multiple instances, don't wait if someone else has it
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("schedule:%s", id.String()), time.Second*30, nil)
+	if err != nil {
+		return false, nil
+	}
+	defer lock.Release(w.Ctx)
+
+	// Re-read now that we hold the lock, it may have been cancelled or already run
+	schedule, err := w.DB.SendSchedule.Query().Where(sendschedule.ID(id)).WithWallet().Only(database.WithPrimary(w.Ctx))
+	if ent.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	} else if schedule.NextRunAt.After(now) {
+		return false, nil
+	}
+
+	// The send ID is unique per interval, so a send that was published but not recorded as run is only republished
+	sendID := fmt.Sprintf("schedule:%s:%d", schedule.ID.String(), schedule.NextRunAt.Unix())
+	_, sendErr := w.CreateAndPublishSendBlock(schedule.Edges.Wallet, schedule.Amount, schedule.Source, schedule.Destination, &sendID, nil, nil)
+
+	// Advance to the next interval after now whether or not the send worked, failed intervals are skipped like missed ones
+	// A failure is logged and kept on the schedule
+	interval := time.Duration(schedule.IntervalSeconds) * time.Second
+	elapsed := now.Sub(schedule.NextRunAt)
+	nextRunAt := schedule.NextRunAt.Add(interval * (elapsed/interval + 1))
+	update := schedule.Update().SetNextRunAt(nextRunAt)
+	if sendErr != nil {
+		log.Errorf("Error sending for schedule %s %s", schedule.ID, sendErr)
+		update.SetLastError(sendErr.Error()).SetLastFailedAt(now)
+	}
+	if _, err := update.Save(w.Ctx); err != nil {
+		return false, err
+	}
+
+	return sendErr == nil, nil
+}
+
+// Run due send schedules every tick until the wallet context is done
+// If clock is nil the system clock is used
+func (w *NanoWallet) StartSendScheduler(clock Clock, tick time.Duration) {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.Ctx.Done():
+			return
+		case <-w.stopped():
+			return
+		case <-ticker.C:
+			w.Background(func() { w.RunDueSendSchedules(clock.Now()) })
+		}
+	}
+}
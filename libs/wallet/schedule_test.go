@@ -0,0 +1,176 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockClock struct {
+	now time.Time
+}
+
+func (c *mockClock) Now() time.Time {
+	return c.now
+}
+
+func (c *mockClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestSendScheduleCreateAndCancel(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("0c2f6a8e1b4d7390e5a2c8f6b1d4e7a3093b5d8f2a6c1e4b7d0f3a5c8e2b6d19"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	start := time.Unix(1700000000, 0)
+
+	_, err = MockWallet.SendScheduleCreate(nil, acc.Address, destination, "1", 60, start)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+	_, err = MockWallet.SendScheduleCreate(wallet, acc.Address, destination, "1", 0, start)
+	assert.ErrorIs(t, err, ErrInvalidInterval)
+	_, err = MockWallet.SendScheduleCreate(wallet, acc.Address, destination, "-1", 60, start)
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+	_, err = MockWallet.SendScheduleCreate(wallet, acc.Address, "nano_1234", "1", 60, start)
+	assert.ErrorIs(t, err, ErrInvalidAccount)
+	_, err = MockWallet.SendScheduleCreate(wallet, destination, acc.Address, "1", 60, start)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	schedule, err := MockWallet.SendScheduleCreate(wallet, acc.Address, destination, "1000", 60, start)
+	assert.Nil(t, err)
+	assert.Equal(t, wallet.ID, schedule.WalletID)
+	assert.Equal(t, "1000", schedule.Amount)
+	assert.Equal(t, 60, schedule.IntervalSeconds)
+	assert.Equal(t, start.Unix(), schedule.NextRunAt.Unix())
+
+	// Another wallet can't cancel it
+	otherSeed, _ := utils.GenerateSeed(strings.NewReader("5b8e1d4a7c0f3269a6d9c2f5b8e1a4d7063c9f2b5e8a1d4c7f0b3e6a9d2c5f87"))
+	otherWallet, err := MockWallet.WalletCreate(otherSeed)
+	assert.Nil(t, err)
+	assert.ErrorIs(t, MockWallet.SendScheduleCancel(otherWallet, schedule.ID.String()), ErrScheduleNotFound)
+	assert.ErrorIs(t, MockWallet.SendScheduleCancel(wallet, "notauuid"), ErrScheduleNotFound)
+
+	assert.Nil(t, MockWallet.SendScheduleCancel(wallet, schedule.ID.String()))
+	assert.ErrorIs(t, MockWallet.SendScheduleCancel(wallet, schedule.ID.String()), ErrScheduleNotFound)
+}
+
+func TestRunDueSendSchedules(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processed := 0
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				// The frontier has hard coded work in the pow client
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "11999999999999999918751838129509869131",
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			} else if pr.Action == "process" {
+				processed++
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", processed),
+				})
+			}
+			resp, err := httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+			return resp, err
+		},
+	)
+
+	// The pow client only has work for the mocked frontier, so don't cache the frontiers of our sends
+	conf := *MockWallet.Config
+	conf.Wallet.FrontierCacheSize = 0
+	scheduleWallet := &NanoWallet{
+		DB:         MockWallet.DB,
+		Ctx:        MockWallet.Ctx,
+		RpcClient:  MockWallet.RpcClient,
+		WorkClient: MockWallet.WorkClient,
+		Config:     &conf,
+	}
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("e4a7d0c3f6b9e2a5d8c1f4b7e0a3d6c9f2b5e8a1d4c7f0b3e6a9d2c5f8b1e470"))
+	wallet, err := scheduleWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := scheduleWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	clock := &mockClock{now: time.Unix(1700000000, 0)}
+	schedule, err := scheduleWallet.SendScheduleCreate(wallet, acc.Address, "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj", "1000", 60, clock.Now().Add(time.Second*30))
+	assert.Nil(t, err)
+
+	// Not due yet
+	sent, err := scheduleWallet.RunDueSendSchedules(clock.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 0, processed)
+
+	// First interval fires
+	clock.Advance(time.Second * 30)
+	sent, err = scheduleWallet.RunDueSendSchedules(clock.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, 1, processed)
+
+	// Doesn't fire again within the same interval
+	clock.Advance(time.Second * 59)
+	sent, err = scheduleWallet.RunDueSendSchedules(clock.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, sent)
+
+	// Second interval
+	clock.Advance(time.Second)
+	sent, err = scheduleWallet.RunDueSendSchedules(clock.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, 2, processed)
+
+	// Simulate downtime across several intervals, they are skipped and only one send goes out
+	clock.Advance(time.Second * 330)
+	sent, err = scheduleWallet.RunDueSendSchedules(clock.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, 3, processed)
+	updated, err := scheduleWallet.DB.SendSchedule.Get(scheduleWallet.Ctx, schedule.ID)
+	assert.Nil(t, err)
+	// Stays aligned to the original start time
+	assert.Equal(t, clock.Now().Add(time.Second*30).Unix(), updated.NextRunAt.Unix())
+
+	// A failed send is logged and kept on the schedule, the interval is still skipped
+	_, err = scheduleWallet.WalletFreeze(wallet)
+	assert.Nil(t, err)
+	clock.Advance(time.Second * 30)
+	sent, err = scheduleWallet.RunDueSendSchedules(clock.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 3, processed)
+	updated, err = scheduleWallet.DB.SendSchedule.Get(scheduleWallet.Ctx, schedule.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrWalletFrozen.Error(), *updated.LastError)
+	assert.Equal(t, clock.Now().Unix(), updated.LastFailedAt.Unix())
+	assert.Equal(t, clock.Now().Add(time.Second*60).Unix(), updated.NextRunAt.Unix())
+
+	// Cancelled schedules stop firing
+	assert.Nil(t, scheduleWallet.SendScheduleCancel(wallet, schedule.ID.String()))
+	clock.Advance(time.Hour)
+	sent, err = scheduleWallet.RunDueSendSchedules(clock.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 3, processed)
+}
@@ -0,0 +1,220 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidApprovalPolicy = errors.New("invalid approval policy, approvals_required must be between 0 and 10 and threshold a raw amount")
+var ErrSendApprovalNotFound = errors.New("send approval not found")
+var ErrSendApprovalResolved = errors.New("send was already sent or rejected")
+var ErrSendAlreadyApproved = errors.New("send was already approved with this key")
+var ErrApproverIsRequester = errors.New("the key that made the send can't approve it")
+var ErrInvalidSendApprovalStatus = errors.New("invalid status, must be one of pending, sent or rejected")
+var ErrApprovalRequired = errors.New("send needs approvals")
+
+// Sends of more than a wallet's approval threshold aren't published right away
+// They wait in the database until approvals_required approver API keys approved them, or one rejected them
+// Every other way of sending refuses them with ErrApprovalRequired, blocks that are signed or published raw included
+
+// Most approvals a wallet can require
+const maxApprovalsRequired = 10
+
+// Set how many approvals sends of more than threshold raw need, 0 approvals turns it off
+func (w *NanoWallet) SetApprovalPolicy(wallet *ent.Wallet, approvalsRequired int, threshold string) (*ent.Wallet, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if approvalsRequired < 0 || approvalsRequired > maxApprovalsRequired {
+		return nil, ErrInvalidApprovalPolicy
+	}
+	if approvalsRequired == 0 {
+		return w.DB.Wallet.UpdateOne(wallet).ClearApprovalsRequired().ClearApprovalThreshold().Save(w.Ctx)
+	}
+	amount, ok := big.NewInt(0).SetString(threshold, 10)
+	if !ok || amount.Sign() < 0 || amount.Cmp(maxSupply) > 0 {
+		return nil, ErrInvalidApprovalPolicy
+	}
+	return w.DB.Wallet.UpdateOne(wallet).SetApprovalsRequired(approvalsRequired).SetApprovalThreshold(amount.String()).Save(w.Ctx)
+}
+
+// Whether a send of amount from the wallet has to be approved first
+func (w *NanoWallet) RequiresApproval(wallet *ent.Wallet, amount string) bool {
+	if wallet == nil || wallet.ApprovalsRequired == nil || wallet.ApprovalThreshold == nil {
+		return false
+	}
+	threshold, ok := big.NewInt(0).SetString(*wallet.ApprovalThreshold, 10)
+	if !ok {
+		return true
+	}
+	sendAmount, ok := big.NewInt(0).SetString(amount, 10)
+	// Amounts that don't parse fail when they're sent anyway
+	return !ok || sendAmount.Cmp(threshold) > 0
+}
+
+// How much sb sends, from the balance of its previous on the node, nil if it doesn't lower the balance
+func (w *NanoWallet) blockSendAmount(sb *nanoblock.StateBlock) (*big.Int, error) {
+	// Open blocks are always receives
+	if strings.Trim(sb.Previous, "0") == "" {
+		return nil, nil
+	}
+	previous, err := w.RpcClient.MakeBlockInfoRequest(sb.Previous)
+	if err != nil {
+		return nil, err
+	}
+	previousBalance, ok := big.NewInt(0).SetString(previous.Balance, 10)
+	if !ok {
+		return nil, errors.New("Unable to parse balance")
+	}
+	balance, ok := big.NewInt(0).SetString(sb.Balance, 10)
+	if !ok {
+		return nil, ErrInvalidBlock
+	}
+	if balance.Cmp(previousBalance) >= 0 {
+		return nil, nil
+	}
+	return previousBalance.Sub(previousBalance, balance), nil
+}
+
+// Store a send to publish once it's approved, requestedBy is the API key that made it if there was one
+// A send with an id that's already waiting or was sent returns that approval instead
+func (w *NanoWallet) SendApprovalCreate(wallet *ent.Wallet, source string, destination string, amount string, id *string, work *string, requestedBy *uuid.UUID) (*ent.SendApproval, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if wallet.ApprovalsRequired == nil {
+		return nil, ErrInvalidApprovalPolicy
+	}
+	if _, err := w.GetAccount(wallet, source); err != nil {
+		return nil, err
+	}
+	if id != nil {
+		existing, err := w.DB.SendApproval.Query().Where(sendapproval.WalletID(wallet.ID), sendapproval.SendID(*id), sendapproval.StatusNEQ(sendapproval.StatusRejected)).First(w.Ctx)
+		if err == nil {
+			return existing, nil
+		} else if !ent.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return w.DB.SendApproval.Create().
+		SetWalletID(wallet.ID).
+		SetNillableSendID(id).
+		SetSource(source).
+		SetDestination(destination).
+		SetAmount(amount).
+		SetNillableWork(work).
+		SetApprovalsRequired(*wallet.ApprovalsRequired).
+		SetApprovedBy([]string{}).
+		SetNillableRequestedBy(requestedBy).
+		Save(w.Ctx)
+}
+
+// The wallet's sends with status, newest first, every one if status is empty
+func (w *NanoWallet) SendApprovalList(wallet *ent.Wallet, status string) ([]*ent.SendApproval, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	query := w.DB.SendApproval.Query().Where(sendapproval.WalletID(wallet.ID))
+	if status != "" {
+		if sendapproval.StatusValidator(sendapproval.Status(status)) != nil {
+			return nil, ErrInvalidSendApprovalStatus
+		}
+		query = query.Where(sendapproval.StatusEQ(sendapproval.Status(status)))
+	}
+	return query.Order(ent.Desc(sendapproval.FieldCreatedAt)).All(w.Ctx)
+}
+
+// One of the wallet's sends
+func (w *NanoWallet) getSendApproval(wallet *ent.Wallet, id string) (*ent.SendApproval, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	approvalID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrSendApprovalNotFound
+	}
+	approval, err := w.DB.SendApproval.Query().Where(sendapproval.ID(approvalID), sendapproval.WalletID(wallet.ID)).Only(w.Ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrSendApprovalNotFound
+	}
+	return approval, err
+}
+
+// Approve a send with the approver key, it's published with the approval that reaches approvals_required
+// If publishing fails the approvals are kept, and any approver that approved it can try again
+func (w *NanoWallet) SendApprove(wallet *ent.Wallet, id string, approver uuid.UUID, bpowKey *string) (*ent.SendApproval, error) {
+	approval, err := w.getSendApproval(wallet, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Two approvals at once could both publish it
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("send_approval:%s", approval.ID), time.Second*30, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+	approval, err = w.DB.SendApproval.Get(w.Ctx, approval.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if approval.Status != sendapproval.StatusPending {
+		return nil, ErrSendApprovalResolved
+	} else if approval.RequestedBy != nil && *approval.RequestedBy == approver {
+		return nil, ErrApproverIsRequester
+	}
+	if slices.Contains(approval.ApprovedBy, approver.String()) {
+		if len(approval.ApprovedBy) < approval.ApprovalsRequired {
+			return nil, ErrSendAlreadyApproved
+		}
+	} else {
+		approval, err = w.DB.SendApproval.UpdateOne(approval).SetApprovedBy(append(slices.Clone(approval.ApprovedBy), approver.String())).Save(w.Ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(approval.ApprovedBy) < approval.ApprovalsRequired {
+		return approval, nil
+	}
+
+	sendID := approval.ID.String()
+	if approval.SendID != nil {
+		sendID = *approval.SendID
+	}
+	hash, err := w.createAndPublishSendBlock(wallet, approval.Amount, approval.Source, approval.Destination, &sendID, approval.Work, bpowKey, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	return w.DB.SendApproval.UpdateOne(approval).SetStatus(sendapproval.StatusSent).SetBlockHash(hash).SetResolvedAt(time.Now()).Save(w.Ctx)
+}
+
+// Reject a send, it's never published, one approver is enough
+func (w *NanoWallet) SendReject(wallet *ent.Wallet, id string) (*ent.SendApproval, error) {
+	approval, err := w.getSendApproval(wallet, id)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("send_approval:%s", approval.ID), time.Second*30, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	updated, err := w.DB.SendApproval.Update().Where(sendapproval.ID(approval.ID), sendapproval.StatusEQ(sendapproval.StatusPending)).SetStatus(sendapproval.StatusRejected).SetResolvedAt(time.Now()).Save(w.Ctx)
+	if err != nil {
+		return nil, err
+	} else if updated < 1 {
+		return nil, ErrSendApprovalResolved
+	}
+	return w.DB.SendApproval.Get(w.Ctx, approval.ID)
+}
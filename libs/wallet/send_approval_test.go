@@ -0,0 +1,251 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/sendapproval"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApprovalPolicy(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("e7c2a9f41b6d3085c2e7a9f4b1d63850e2c7a4f9d1b6e3a08c5f2d7b4e9a1c63"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	wallet, err = MockWallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+	assert.False(t, MockWallet.RequiresApproval(wallet, "1000000"))
+
+	_, err = MockWallet.SetApprovalPolicy(wallet, 11, "1000")
+	assert.ErrorIs(t, err, ErrInvalidApprovalPolicy)
+	_, err = MockWallet.SetApprovalPolicy(wallet, 2, "-1")
+	assert.ErrorIs(t, err, ErrInvalidApprovalPolicy)
+	_, err = MockWallet.SetApprovalPolicy(wallet, 2, "abc")
+	assert.ErrorIs(t, err, ErrInvalidApprovalPolicy)
+
+	wallet, err = MockWallet.SetApprovalPolicy(wallet, 2, "1000")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, *wallet.ApprovalsRequired)
+	assert.Equal(t, "1000", *wallet.ApprovalThreshold)
+	// More than the threshold
+	assert.False(t, MockWallet.RequiresApproval(wallet, "1000"))
+	assert.True(t, MockWallet.RequiresApproval(wallet, "1001"))
+
+	wallet, err = MockWallet.SetApprovalPolicy(wallet, 0, "")
+	assert.Nil(t, err)
+	assert.Nil(t, wallet.ApprovalsRequired)
+	assert.Nil(t, wallet.ApprovalThreshold)
+	assert.False(t, MockWallet.RequiresApproval(wallet, "1001"))
+}
+
+func TestSendApproval(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processed := 0
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "process" {
+				processed++
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("5A%062X", processed),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("f1a4c7e0b3d6a9c2f5e8b1d4a7c0e3f6b9d2a5c8e1f4b7d0a3c6e9f2b5d8a1c4"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	wallet, err = MockWallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	work := "0000000000000000"
+
+	// Nothing to approve without a policy
+	_, err = MockWallet.SendApprovalCreate(wallet, acc.Address, destination, "5000", nil, &work, nil)
+	assert.ErrorIs(t, err, ErrInvalidApprovalPolicy)
+
+	wallet, err = MockWallet.SetApprovalPolicy(wallet, 2, "1000")
+	assert.Nil(t, err)
+	requester, first, second := uuid.New(), uuid.New(), uuid.New()
+	approval, err := MockWallet.SendApprovalCreate(wallet, acc.Address, destination, "5000", nil, &work, &requester)
+	assert.Nil(t, err)
+	assert.Equal(t, sendapproval.StatusPending, approval.Status)
+	assert.Equal(t, 2, approval.ApprovalsRequired)
+
+	_, err = MockWallet.SendApprove(wallet, approval.ID.String(), requester, nil)
+	assert.ErrorIs(t, err, ErrApproverIsRequester)
+	approval, err = MockWallet.SendApprove(wallet, approval.ID.String(), first, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{first.String()}, approval.ApprovedBy)
+	assert.Equal(t, sendapproval.StatusPending, approval.Status)
+	_, err = MockWallet.SendApprove(wallet, approval.ID.String(), first, nil)
+	assert.ErrorIs(t, err, ErrSendAlreadyApproved)
+	assert.Equal(t, 0, processed)
+
+	// The second one publishes it
+	approval, err = MockWallet.SendApprove(wallet, approval.ID.String(), second, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, sendapproval.StatusSent, approval.Status)
+	assert.Equal(t, fmt.Sprintf("5A%062X", 1), *approval.BlockHash)
+	assert.NotNil(t, approval.ResolvedAt)
+	assert.Equal(t, 1, processed)
+	_, err = MockWallet.SendApprove(wallet, approval.ID.String(), uuid.New(), nil)
+	assert.ErrorIs(t, err, ErrSendApprovalResolved)
+	_, err = MockWallet.SendReject(wallet, approval.ID.String())
+	assert.ErrorIs(t, err, ErrSendApprovalResolved)
+
+	// A send with an id is only stored once
+	id := "payout-42"
+	withID, err := MockWallet.SendApprovalCreate(wallet, acc.Address, destination, "5000", &id, &work, nil)
+	assert.Nil(t, err)
+	again, err := MockWallet.SendApprovalCreate(wallet, acc.Address, destination, "5000", &id, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, withID.ID, again.ID)
+
+	rejected, err := MockWallet.SendReject(wallet, withID.ID.String())
+	assert.Nil(t, err)
+	assert.Equal(t, sendapproval.StatusRejected, rejected.Status)
+	_, err = MockWallet.SendApprove(wallet, withID.ID.String(), first, nil)
+	assert.ErrorIs(t, err, ErrSendApprovalResolved)
+	assert.Equal(t, 1, processed)
+
+	pending, err := MockWallet.SendApprovalList(wallet, "pending")
+	assert.Nil(t, err)
+	assert.Len(t, pending, 0)
+	all, err := MockWallet.SendApprovalList(wallet, "")
+	assert.Nil(t, err)
+	assert.Len(t, all, 2)
+	assert.Equal(t, withID.ID, all[0].ID)
+	_, err = MockWallet.SendApprovalList(wallet, "approved")
+	assert.ErrorIs(t, err, ErrInvalidSendApprovalStatus)
+
+	_, err = MockWallet.SendApprove(wallet, "notauuid", first, nil)
+	assert.ErrorIs(t, err, ErrSendApprovalNotFound)
+	_, err = MockWallet.SendReject(wallet, uuid.New().String())
+	assert.ErrorIs(t, err, ErrSendApprovalNotFound)
+	// Only the wallet's own
+	otherSeed, _ := utils.GenerateSeed(strings.NewReader("a8d3f6b1e4c7a0d2f5b8e1c4a7d0f3b6e9c2a5d8f1b4e7c0a3d6f9b2e5c8a1d4"))
+	other, err := MockWallet.WalletCreate(otherSeed)
+	assert.Nil(t, err)
+	_, err = MockWallet.SendReject(other, withID.ID.String())
+	assert.ErrorIs(t, err, ErrSendApprovalNotFound)
+}
+
+func TestApprovalThresholdEverySend(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processed := 0
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "block_info" {
+				// The previous of raw blocks
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"balance": "1000000"})
+			} else if pr.Action == "process" {
+				processed++
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("5B%062X", processed),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("c4a7d0f3b6e9c2a5d8f1b4e7c0a3d6f9b2e5c8a1d4a8d3f6b1e4c7a0d2f5b8e1"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	wallet, err = MockWallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	work := "0000000000000000"
+	// From before the policy
+	schedule, err := MockWallet.SendScheduleCreate(wallet, acc.Address, destination, "5000", 60, time.Now().Add(-time.Minute))
+	assert.Nil(t, err)
+	wallet, err = MockWallet.SetApprovalPolicy(wallet, 1, "1000")
+	assert.Nil(t, err)
+
+	_, err = MockWallet.CreateAndPublishSendBlock(wallet, "5000", acc.Address, destination, nil, &work, nil)
+	assert.ErrorIs(t, err, ErrApprovalRequired)
+	_, err = MockWallet.SendWithID(wallet, "approval-1", acc.Address, destination, "5000", &work, nil)
+	assert.ErrorIs(t, err, ErrApprovalRequired)
+	results, err := MockWallet.SendBulk(wallet, acc.Address, []BulkSend{{Destination: destination, Amount: "5000", Work: &work}}, nil)
+	assert.Nil(t, err)
+	assert.ErrorIs(t, results[0].Err, ErrApprovalRequired)
+	assert.Equal(t, 0, processed)
+	// Up to the threshold is sent
+	_, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, nil, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, processed)
+
+	// Scheduled sends are refused, and skipped if they were scheduled before
+	_, err = MockWallet.SendScheduleCreate(wallet, acc.Address, destination, "5000", 60, time.Now())
+	assert.ErrorIs(t, err, ErrApprovalRequired)
+	sent, err := MockWallet.runSendSchedule(schedule.ID, time.Now())
+	assert.Nil(t, err)
+	assert.False(t, sent)
+	assert.Equal(t, 1, processed)
+
+	// Blocks signed here can be published anywhere, sends of more than the threshold aren't signed
+	send := nanoblock.StateBlock{
+		Type:           "state",
+		Account:        acc.Address,
+		Previous:       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		Representative: "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+		Balance:        "995000",
+		Link:           "d4bbfa50649d80e5f63fc396c6f4cf6321cabd7c1480e964c2701d56aafeb5e3",
+		Work:           work,
+	}
+	_, err = MockWallet.SignBlock(wallet, send)
+	assert.ErrorIs(t, err, ErrApprovalRequired)
+	_, err = MockWallet.OfflineSendBlock(wallet, acc.Address, destination, "5000", AccountState{Frontier: send.Previous, Balance: "1000000", Representative: send.Representative}, &work)
+	assert.ErrorIs(t, err, ErrApprovalRequired)
+	small := send
+	small.Balance = "999500"
+	_, err = MockWallet.SignBlock(wallet, small)
+	assert.Nil(t, err)
+	// Receives aren't sends
+	receive := send
+	receive.Balance = "1005000"
+	_, err = MockWallet.SignBlock(wallet, receive)
+	assert.Nil(t, err)
+
+	// Or published raw
+	signed, err := MockWallet.signUncheckedBlock(wallet, send)
+	assert.Nil(t, err)
+	_, err = MockWallet.PublishRawBlock(wallet, *signed, &work, nil)
+	assert.ErrorIs(t, err, ErrApprovalRequired)
+	assert.Equal(t, 1, processed)
+}
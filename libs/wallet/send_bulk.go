@@ -0,0 +1,68 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+)
+
+// One send of SendBulk, with an ID it's only sent once like a send with an id
+type BulkSend struct {
+	Destination string
+	Amount      string
+	ID          *string
+	Work        *string
+}
+
+// What happened to one send of SendBulk, Hash is empty if Err is set
+type BulkSendResult struct {
+	BulkSend
+	Hash string
+	Err  error
+}
+
+// How long the account lock is held for each send, it's refreshed before the next one
+const bulkSendLockTTL = time.Second * 30
+
+// Send from one account to each destination in order, the account is locked for all of them
+// Each send builds on the frontier the one before it published, so they don't wait for the node
+// A send that fails is returned with its error and the rest still go ahead, retrying with the same IDs only sends the ones that failed
+func (w *NanoWallet) SendBulk(wallet *ent.Wallet, source string, sends []BulkSend, bpowKey *string) ([]BulkSendResult, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if wallet.FrozenAt != nil {
+		return nil, ErrWalletFrozen
+	}
+	acc, err := w.GetAccount(wallet, source)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), bulkSendLockTTL, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	results := make([]BulkSendResult, len(sends))
+	for i, send := range sends {
+		results[i].BulkSend = send
+		if i > 0 {
+			if err := lock.Refresh(w.Ctx, bulkSendLockTTL, nil); err != nil {
+				// Another send could have the account now, none of the rest are made
+				for j := i; j < len(sends); j++ {
+					results[j] = BulkSendResult{BulkSend: sends[j], Err: database.ErrLockNotObtained}
+				}
+				return results, nil
+			}
+		}
+		results[i].Hash, results[i].Err = w.publishSend(wallet, acc, send.Amount, send.Destination, send.ID, send.Work, bpowKey, 0, false)
+		if results[i].Err == nil && results[i].Hash == "" {
+			results[i].Err = errors.New("Unable to publish send block")
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendBulk(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// Hashes other tests don't use, blocks are saved in the same database
+	hashOf := func(i int) string { return fmt.Sprintf("%064X", 0xB0000+i) }
+	accountInfoCalls := 0
+	processed := 0
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				accountInfoCalls++
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "process" {
+				processed++
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": hashOf(processed),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("9e2a5c8d1f4b7e0a3c6d9f2b5e8a1c476a1d4f7b0e3c9a2d5f8b1e4a7c0d3f6b"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	work := "0000000000000000"
+	firstID := "bulk-1"
+	secondID := "bulk-2"
+	sends := []BulkSend{
+		{Destination: destination, Amount: "1000", ID: &firstID, Work: &work},
+		{Destination: destination, Amount: "100000000000000000000000000000000000000", ID: &secondID, Work: &work},
+		{Destination: destination, Amount: "2000", Work: &work},
+	}
+
+	_, err = MockWallet.SendBulk(nil, acc.Address, sends, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+	_, err = MockWallet.SendBulk(wallet, destination, sends, nil)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	results, err := MockWallet.SendBulk(wallet, acc.Address, sends, nil)
+	assert.Nil(t, err)
+	assert.Len(t, results, 3)
+	assert.Equal(t, hashOf(1), results[0].Hash)
+	assert.Nil(t, results[0].Err)
+	assert.Equal(t, "bulk-1", *results[0].ID)
+	assert.ErrorIs(t, results[1].Err, ErrInsufficientBalance)
+	assert.Empty(t, results[1].Hash)
+	assert.Equal(t, hashOf(2), results[2].Hash)
+	// Each send built on the one before it, only the first asked the node
+	assert.Equal(t, 1, accountInfoCalls)
+	assert.Equal(t, 2, processed)
+
+	// Retrying sends the one that failed again, the one with an ID that was sent is only republished
+	sends[1].Amount = "3000"
+	results, err = MockWallet.SendBulk(wallet, acc.Address, sends[:2], nil)
+	assert.Nil(t, err)
+	assert.Equal(t, hashOf(1), results[0].Hash)
+	assert.Equal(t, hashOf(4), results[1].Hash)
+	assert.Equal(t, 4, processed)
+}
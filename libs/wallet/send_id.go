@@ -0,0 +1,72 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+)
+
+var ErrInvalidSendID = errors.New("invalid send_id")
+var ErrSendIDMismatch = errors.New("send_id was used with a different source, destination or amount")
+
+// Sends with a send_id are recorded with their status, retrying one that was sent returns its block instead of sending again
+// The send_id is also the id of the send block, so a send that was published before Pippin stopped isn't sent twice either
+// Records are forgotten after send_id_ttl seconds
+
+// Send amount from source to destination once for sendID, a send that failed or didn't finish is retried
+func (w *NanoWallet) SendWithID(wallet *ent.Wallet, sendID string, source string, destination string, amount string, work *string, bpowKey *string) (string, error) {
+	if wallet == nil {
+		return "", ErrInvalidWallet
+	} else if sendID == "" || len(sendID) > 256 {
+		return "", ErrInvalidSendID
+	} else if wallet.FrozenAt != nil {
+		return "", ErrWalletFrozen
+	}
+
+	// Only one send per send_id at a time
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("send_id:%s:%s", wallet.ID.String(), sendID), time.Second*60, &database.LockRetryStrategy)
+	if err != nil {
+		return "", database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	// Forget expired records
+	expiry := time.Now().Add(-time.Duration(w.Config.Wallet.SendIDTTL) * time.Second)
+	if _, err := w.DB.IdempotentSend.Delete().Where(idempotentsend.CreatedAtLT(expiry)).Exec(w.Ctx); err != nil {
+		return "", err
+	}
+
+	record, err := w.DB.IdempotentSend.Query().Where(idempotentsend.WalletID(wallet.ID), idempotentsend.SendID(sendID)).Only(database.WithPrimary(w.Ctx))
+	if ent.IsNotFound(err) {
+		record, err = w.DB.IdempotentSend.Create().SetWallet(wallet).SetSendID(sendID).SetSource(source).SetDestination(destination).SetAmount(amount).Save(w.Ctx)
+		if err != nil {
+			return "", err
+		}
+	} else if err != nil {
+		return "", err
+	} else if record.Source != source || record.Destination != destination || record.Amount != amount {
+		return "", ErrSendIDMismatch
+	} else if record.Status == idempotentsend.StatusSent && record.BlockHash != nil {
+		return *record.BlockHash, nil
+	}
+
+	hash, err := w.CreateAndPublishSendBlock(wallet, amount, source, destination, &sendID, work, bpowKey)
+	if err == nil && hash == "" {
+		err = errors.New("Unable to publish send block")
+	}
+	if err != nil {
+		if _, updateErr := record.Update().SetStatus(idempotentsend.StatusFailed).Save(w.Ctx); updateErr != nil {
+			return "", updateErr
+		}
+		return "", err
+	}
+
+	if _, err := record.Update().SetStatus(idempotentsend.StatusSent).SetBlockHash(hash).Save(w.Ctx); err != nil {
+		return "", err
+	}
+	return hash, nil
+}
@@ -0,0 +1,111 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/idempotentsend"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendWithID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processed := 0
+	processFails := false
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "account_info":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "process":
+				if processFails {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Fork"})
+				}
+				processed++
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("5E4D%060X", processed),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	// The mocked node always returns the same frontier, so don't cache the ones we publish
+	conf := *MockWallet.Config
+	conf.Wallet.FrontierCacheSize = 0
+	sendWallet := &NanoWallet{
+		DB:         MockWallet.DB,
+		Ctx:        MockWallet.Ctx,
+		RpcClient:  MockWallet.RpcClient,
+		WorkClient: MockWallet.WorkClient,
+		Config:     &conf,
+	}
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("3f6e9b2d5a8c1f4e7b0d3a6c9f2e5b8d1a7c4f0e3b6d9a2c5f8e1b4d7a0c3f6e"))
+	wallet, err := sendWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	source, err := sendWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	work := "0000000000000000"
+
+	_, err = sendWallet.SendWithID(nil, "id1", source.Address, destination, "1", &work, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+	_, err = sendWallet.SendWithID(wallet, "", source.Address, destination, "1", &work, nil)
+	assert.ErrorIs(t, err, ErrInvalidSendID)
+
+	hash, err := sendWallet.SendWithID(wallet, "id1", source.Address, destination, "1", &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("5E4D%060X", 1), hash)
+
+	// Same send_id returns the same block without sending again
+	hash, err = sendWallet.SendWithID(wallet, "id1", source.Address, destination, "1", &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("5E4D%060X", 1), hash)
+	assert.Equal(t, 1, processed)
+
+	// But not with other parameters
+	_, err = sendWallet.SendWithID(wallet, "id1", source.Address, destination, "2", &work, nil)
+	assert.ErrorIs(t, err, ErrSendIDMismatch)
+
+	// A failed send is recorded and retried
+	processFails = true
+	_, err = sendWallet.SendWithID(wallet, "id2", source.Address, destination, "1", &work, nil)
+	assert.NotNil(t, err)
+	record, err := sendWallet.DB.IdempotentSend.Query().Where(idempotentsend.WalletID(wallet.ID), idempotentsend.SendID("id2")).Only(sendWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, idempotentsend.StatusFailed, record.Status)
+	assert.Nil(t, record.BlockHash)
+
+	processFails = false
+	hash, err = sendWallet.SendWithID(wallet, "id2", source.Address, destination, "1", &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("5E4D%060X", 2), hash)
+	record, err = sendWallet.DB.IdempotentSend.Query().Where(idempotentsend.WalletID(wallet.ID), idempotentsend.SendID("id2")).Only(sendWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, idempotentsend.StatusSent, record.Status)
+	assert.Equal(t, hash, *record.BlockHash)
+
+	// Expired records are forgotten, the block saved with the send_id is republished instead of a new send
+	conf.Wallet.SendIDTTL = 0
+	hash, err = sendWallet.SendWithID(wallet, "id1", source.Address, destination, "1", &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("5E4D%060X", 1), hash)
+	count, err := sendWallet.DB.IdempotentSend.Query().Where(idempotentsend.WalletID(wallet.ID)).Count(sendWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+}
@@ -0,0 +1,152 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+var ErrInvalidBlock = errors.New("invalid block")
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// Publish a block that was built and signed somewhere else, e.g. on a hardware wallet
+// Its account has to be in the wallet and it has to be signed by that account, the wallet's keys aren't used
+// Work is generated for it if the block doesn't have any and none is given
+func (w *NanoWallet) PublishRawBlock(wallet *ent.Wallet, sb nanoblock.StateBlock, work *string, bpowKey *string) (string, error) {
+	if wallet == nil {
+		return "", ErrInvalidWallet
+	} else if wallet.FrozenAt != nil {
+		return "", ErrWalletFrozen
+	}
+	sb.Banano = w.Config.Wallet.Banano
+	if sb.Type == "" {
+		sb.Type = "state"
+	}
+	if sb.Type != "state" || sb.Validate() != nil {
+		return "", ErrInvalidBlock
+	}
+	acc, err := w.GetAccount(wallet, sb.Account)
+	if err != nil {
+		return "", err
+	}
+	if err := sb.VerifySignature(); err != nil {
+		return "", ErrInvalidSignature
+	}
+
+	// Obtain lock
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*30, &database.LockRetryStrategy)
+	if err != nil {
+		return "", database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	// Sends are held to the same approval threshold and daily limit as send, the node is only asked if there's one
+	var amount *big.Int
+	if wallet.ApprovalsRequired != nil || w.dailySendLimit() != nil {
+		amount, err = w.blockSendAmount(&sb)
+		if err != nil {
+			return "", err
+		}
+	}
+	if amount != nil {
+		if w.RequiresApproval(wallet, amount.String()) {
+			return "", ErrApprovalRequired
+		}
+		release, err := w.obtainDailySend(wallet, amount.String())
+		if err != nil {
+			return "", err
+		}
+		defer release()
+	}
+
+	if work != nil {
+		sb.Work = *work
+	}
+	if sb.Work == "" {
+		sb.Work, err = w.rawBlockWork(acc, &sb, bpowKey)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// The node works out the subtype
+	resp, err := w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "process",
+		},
+		JsonBlock: true,
+		Block:     sb,
+	})
+	if err == nil && !utils.Validate64HexHash(resp.Hash) {
+		err = errors.New("Unable to publish block")
+	}
+	if err != nil {
+		w.frontiers().Invalidate(acc.Address)
+		// Like a send, one the node may have taken counts against the limit
+		var rejected *nanorpc.ProcessRejectedError
+		if amount != nil && w.dailySendLimit() != nil && !errors.As(err, &rejected) {
+			hash := sb.Hash()
+			w.recordSpend(wallet, amount.String(), strings.ToUpper(hex.EncodeToString(hash[:])))
+		}
+		return "", err
+	}
+	w.frontiers().Set(acc.Address, resp.Hash, sb.Balance)
+	w.precacheNextWork(wallet, acc.Address, resp.Hash)
+	if amount != nil && w.dailySendLimit() != nil {
+		w.recordSpend(wallet, amount.String(), resp.Hash)
+	}
+
+	return resp.Hash, nil
+}
+
+// Work for a raw block, at the send difficulty if it lowers the balance of the account
+func (w *NanoWallet) rawBlockWork(acc *ent.Account, sb *nanoblock.StateBlock, bpowKey *string) (string, error) {
+	key := ""
+	if bpowKey != nil {
+		key = *bpowKey
+	}
+
+	// Open blocks are always receives, their work is for the account's public key
+	if strings.Trim(sb.Previous, "0") == "" {
+		pub, err := utils.AddressToPub(acc.Address, w.Config.Wallet.Banano)
+		if err != nil {
+			return "", err
+		}
+		return w.generateWork(acc.WalletID, acc.Address, nil, hex.EncodeToString(pub), 1, false, key)
+	}
+
+	accountInfo, err := w.accountFrontier(acc.Address)
+	if err != nil {
+		return "", err
+	}
+	currentBalance, ok := big.NewInt(0).SetString(accountInfo.Balance, 10)
+	if !ok {
+		return "", errors.New("Unable to parse balance")
+	}
+	newBalance, _ := big.NewInt(0).SetString(sb.Balance, 10)
+
+	var amount *big.Int
+	difficulty := pow.ReceiveMultiplier(w.Config.Wallet.Banano)
+	if newBalance.Cmp(currentBalance) <= 0 {
+		// Sends and changes
+		difficulty = pow.SendMultiplier(w.Config.Wallet.Banano)
+	}
+	if newBalance.Cmp(currentBalance) < 0 {
+		amount = big.NewInt(0).Sub(currentBalance, newBalance)
+	}
+	if prefetched, ok := w.prefetchedWork(acc.Address, sb.Previous, difficulty); ok {
+		return prefetched, nil
+	}
+	return w.generateWork(acc.WalletID, acc.Address, amount, sb.Previous, difficulty, false, key)
+}
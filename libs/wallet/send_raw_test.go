@@ -0,0 +1,116 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishRawBlock(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var published []nanoblock.StateBlock
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "account_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "1000",
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			case "process":
+				// The node works out the subtype
+				assert.Nil(t, pr["subtype"])
+				var sb nanoblock.StateBlock
+				block, _ := json.Marshal(pr["block"])
+				json.Unmarshal(block, &sb)
+				published = append(published, sb)
+				hash := sb.Hash()
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%X", hash),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	_, err := MockWallet.PublishRawBlock(nil, nanoblock.StateBlock{}, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("e1b4d7a0c3f6e9b2d5a8c1f4e7b0d3a6c9f2e5b8d1a4c7f0e3b6d9a2c5f8e1b4"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	_, priv, _ := utils.KeypairFromSeed(seed, uint32(*acc.AccountIndex))
+
+	// Built and signed off-device, a send of 400 raw
+	sb := nanoblock.StateBlock{
+		Type:           "state",
+		Account:        acc.Address,
+		Previous:       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		Representative: "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+		Balance:        "600",
+		Link:           "d4bbfa50649d80e5f63fc396c6f4cf6321cabd7c1480e964c2701d56aafeb5e3",
+	}
+	assert.Nil(t, sb.Sign(privateKeySeed(priv)))
+	expectedHash := sb.Hash()
+
+	// Work is generated for it
+	hash, err := MockWallet.PublishRawBlock(wallet, sb, nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, fmt.Sprintf("%X", expectedHash), hash)
+	assert.Len(t, published, 1)
+	assert.Equal(t, "205452237a9b01f4", published[0].Work)
+	assert.Equal(t, sb.Signature, published[0].Signature)
+
+	// Given work is used as is, it isn't signed
+	work := "0000000000000000"
+	_, err = MockWallet.PublishRawBlock(wallet, sb, &work, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "0000000000000000", published[1].Work)
+
+	// Tampered blocks are refused before they reach the node
+	tampered := sb
+	tampered.Balance = "1"
+	_, err = MockWallet.PublishRawBlock(wallet, tampered, &work, nil)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	tampered = sb
+	tampered.Link = "0000000000000000000000000000000000000000000000000000000000000000"
+	_, err = MockWallet.PublishRawBlock(wallet, tampered, &work, nil)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	tampered = sb
+	tampered.Signature = ""
+	_, err = MockWallet.PublishRawBlock(wallet, tampered, &work, nil)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	tampered = sb
+	tampered.Balance = "lots"
+	_, err = MockWallet.PublishRawBlock(wallet, tampered, &work, nil)
+	assert.ErrorIs(t, err, ErrInvalidBlock)
+	tampered = sb
+	tampered.Type = "send"
+	_, err = MockWallet.PublishRawBlock(wallet, tampered, &work, nil)
+	assert.ErrorIs(t, err, ErrInvalidBlock)
+	assert.Len(t, published, 2)
+
+	// The account has to be in the wallet
+	otherSeed, _ := utils.GenerateSeed(strings.NewReader("b4d7a0c3f6e9b2d5a8c1f4e7b0d3a6c9f2e5b8d1a4c7f0e3b6d9a2c5f8e1b4e1"))
+	other, err := MockWallet.WalletCreate(otherSeed)
+	assert.Nil(t, err)
+	_, err = MockWallet.PublishRawBlock(other, sb, &work, nil)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+	assert.Len(t, published, 2)
+}
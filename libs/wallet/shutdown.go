@@ -0,0 +1,163 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/google/uuid"
+)
+
+var ErrShuttingDown = errors.New("shutting down")
+
+// Once Shutdown is called background work doesn't start anything new, and what's running is waited for
+// Auto receive and work precaching stop between accounts, they go by what's on the node so the next start picks up what's left
+// Receive callbacks waiting to be retried are kept in redis, the next instance that starts delivers them
+
+// Redis hash of the receive callbacks that weren't delivered before a shutdown, by hash
+const queuedReceiveCallbacksKey = "receive_callbacks"
+
+type shutdownState struct {
+	mutex    sync.Mutex
+	stopping bool
+	stopped  chan struct{}
+	running  sync.WaitGroup
+	// Jobs running in this process
+	jobs map[uuid.UUID]struct{}
+}
+
+func (w *NanoWallet) shutdown() *shutdownState {
+	root := w.root()
+	root.shutdownOnce.Do(func() {
+		root.shutdownState = &shutdownState{stopped: make(chan struct{}), jobs: map[uuid.UUID]struct{}{}}
+	})
+	return root.shutdownState
+}
+
+// Whether Shutdown was called
+func (w *NanoWallet) ShuttingDown() bool {
+	state := w.shutdown()
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	return state.stopping
+}
+
+// Closed when Shutdown is called
+func (w *NanoWallet) stopped() <-chan struct{} {
+	return w.shutdown().stopped
+}
+
+// Count background work that's starting, false if we're shutting down and it shouldn't start
+// Every true has to be followed by done
+func (w *NanoWallet) begin() bool {
+	state := w.shutdown()
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	if state.stopping {
+		return false
+	}
+	state.running.Add(1)
+	return true
+}
+
+func (w *NanoWallet) done() {
+	w.shutdown().running.Done()
+}
+
+// Run f unless we're shutting down, Shutdown waits for it to return
+// Returns whether f ran
+func (w *NanoWallet) Background(f func()) bool {
+	if !w.begin() {
+		return false
+	}
+	defer w.done()
+	f()
+	return true
+}
+
+// Stop the background work and wait for what's running until ctx is done
+// Jobs still running then are failed, they can't be resumed
+func (w *NanoWallet) Shutdown(ctx context.Context) error {
+	state := w.shutdown()
+	state.mutex.Lock()
+	if !state.stopping {
+		state.stopping = true
+		close(state.stopped)
+	}
+	state.mutex.Unlock()
+
+	finished := make(chan struct{})
+	go func() {
+		state.running.Wait()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+	}
+
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	for id := range state.jobs {
+		if err := w.DB.Job.UpdateOneID(id).SetStatus(job.StatusFailed).SetError(ErrShuttingDown.Error()).Exec(w.Ctx); err != nil {
+			log.Errorf("Error failing job %s on shutdown %s", id, err)
+		}
+	}
+	return ctx.Err()
+}
+
+func (w *NanoWallet) trackJob(id uuid.UUID, running bool) {
+	state := w.shutdown()
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	if running {
+		state.jobs[id] = struct{}{}
+	} else {
+		delete(state.jobs, id)
+	}
+}
+
+// Keep a receive callback that wasn't delivered for the next instance that starts
+func (w *NanoWallet) queueReceiveCallback(callback ReceiveCallback) {
+	body, err := json.Marshal(callback)
+	if err != nil {
+		return
+	}
+	if err := database.GetRedisDB().Hset(queuedReceiveCallbacksKey, callback.Hash, string(body)); err != nil {
+		log.Errorf("Unable to keep the receive callback for %s %s", callback.Hash, err)
+	}
+}
+
+// Deliver the receive callbacks a shutdown kept, in the background, returns how many there were
+func (w *NanoWallet) ResumeReceiveCallbacks() (int, error) {
+	// Only one instance takes them
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, "resume_receive_callbacks", time.Second*30, nil)
+	if err != nil {
+		return 0, database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	queued, err := database.GetRedisDB().Hgetall(queuedReceiveCallbacksKey)
+	if err != nil {
+		return 0, err
+	}
+	resumed := 0
+	for hash, body := range queued {
+		if err := database.GetRedisDB().Hdel(queuedReceiveCallbacksKey, hash); err != nil {
+			return resumed, err
+		}
+		var callback ReceiveCallback
+		if err := json.Unmarshal([]byte(body), &callback); err != nil {
+			continue
+		}
+		w.deliverReceiveCallback(callback)
+		resumed++
+	}
+	return resumed, nil
+}
@@ -0,0 +1,135 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/job"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShutdown(t *testing.T) {
+	stopping := &NanoWallet{DB: MockWallet.DB, Ctx: MockWallet.Ctx, Config: MockWallet.Config}
+	seed, _ := utils.GenerateSeed(strings.NewReader("7a1c4e9b2d6f3a8c5e0b7d2f9a4c1e6b3d8f5a0c7e2b9d4f1a6c3e8b5d0f7a2c"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+
+	assert.False(t, stopping.ShuttingDown())
+	assert.True(t, stopping.Background(func() {}))
+
+	// Waits for what's running
+	release := make(chan bool)
+	started := make(chan bool)
+	go stopping.Background(func() {
+		started <- true
+		<-release
+	})
+	<-started
+	var shutdownDone atomic.Bool
+	go func() {
+		assert.Nil(t, stopping.Shutdown(context.Background()))
+		shutdownDone.Store(true)
+	}()
+	assert.Eventually(t, stopping.ShuttingDown, time.Second, time.Millisecond)
+	assert.False(t, shutdownDone.Load())
+	release <- true
+	assert.Eventually(t, shutdownDone.Load, time.Second, time.Millisecond)
+
+	// Nothing new starts
+	assert.False(t, stopping.Background(func() { t.Fail() }))
+	_, err = stopping.JobStart(wallet, "receive_all", func(progress JobProgress) (interface{}, error) {
+		return nil, nil
+	})
+	assert.ErrorIs(t, err, ErrShuttingDown)
+	// Calling it again is fine
+	assert.Nil(t, stopping.Shutdown(context.Background()))
+}
+
+func TestShutdownTimeout(t *testing.T) {
+	stopping := &NanoWallet{DB: MockWallet.DB, Ctx: MockWallet.Ctx, Config: MockWallet.Config}
+	seed, _ := utils.GenerateSeed(strings.NewReader("3e8b5d0f7a2c7a1c4e9b2d6f3a8c5e0b7d2f9a4c1e6b3d8f5a0c7e2b9d4f1a6c"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+
+	release := make(chan bool)
+	defer close(release)
+	dbJob, err := stopping.JobStart(wallet, "receive_all", func(progress JobProgress) (interface{}, error) {
+		<-release
+		return nil, nil
+	})
+	assert.Nil(t, err)
+	waitForJob(t, dbJob.ID, job.StatusRunning)
+
+	// The job that's still running is failed
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, stopping.Shutdown(ctx), context.DeadlineExceeded)
+	dbJob, err = MockWallet.JobGet(dbJob.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, job.StatusFailed, dbJob.Status)
+	assert.Equal(t, ErrShuttingDown.Error(), *dbJob.Error)
+}
+
+func TestShutdownReceiveCallbacks(t *testing.T) {
+	receiveCallbackRetryDelay = time.Hour
+	defer func() { receiveCallbackRetryDelay = time.Second }()
+
+	attempts := make(chan bool, 10)
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts <- true
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+	var delivered []ReceiveCallback
+	working := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var callback ReceiveCallback
+		json.Unmarshal(body, &callback)
+		delivered = append(delivered, callback)
+		attempts <- true
+	}))
+	defer working.Close()
+
+	conf := *MockWallet.Config
+	conf.Wallet.CallbackUrl = failing.URL
+	conf.Wallet.CallbackRetries = 5
+	stopping := &NanoWallet{Ctx: MockWallet.Ctx, Config: &conf}
+	retrying := ReceiveCallback{Wallet: "1234", Account: "nano_1", Hash: "C1", Source: "D1", AmountRaw: "5", BalanceRaw: "10", Timestamp: 1700000000}
+	stopping.deliverReceiveCallback(retrying)
+	<-attempts
+
+	// The callback waiting to be retried is kept, and so is one that comes after
+	assert.Nil(t, stopping.Shutdown(context.Background()))
+	after := ReceiveCallback{Wallet: "1234", Account: "nano_1", Hash: "C2", Source: "D2", AmountRaw: "6", BalanceRaw: "16", Timestamp: 1700000001}
+	stopping.deliverReceiveCallback(after)
+	queued, err := database.GetRedisDB().Hlen(queuedReceiveCallbacksKey)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), queued)
+
+	// The next start delivers them
+	restartConf := conf
+	restartConf.Wallet.CallbackUrl = working.URL
+	restarted := &NanoWallet{Ctx: MockWallet.Ctx, Config: &restartConf}
+	resumed, err := restarted.ResumeReceiveCallbacks()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, resumed)
+	<-attempts
+	<-attempts
+	assert.ElementsMatch(t, []ReceiveCallback{retrying, after}, delivered)
+	queued, err = database.GetRedisDB().Hlen(queuedReceiveCallbacksKey)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), queued)
+
+	resumed, err = restarted.ResumeReceiveCallbacks()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, resumed)
+}
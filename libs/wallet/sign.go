@@ -0,0 +1,137 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+)
+
+// Sign a block that was built somewhere else with the key of its account, for offline signing
+// Nothing is published and the block isn't checked against the account's chain, only its fields are validated
+// A signature it already has is replaced, its work is kept as it is
+// The signed block can be published anywhere, so if the wallet has an approval threshold or there's a daily_send_limit
+// the node is asked for the balance of its previous, and sends that couldn't be made with send are refused
+func (w *NanoWallet) SignBlock(wallet *ent.Wallet, sb nanoblock.StateBlock) (*nanoblock.StateBlock, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	if wallet.ApprovalsRequired != nil || w.dailySendLimit() != nil {
+		amount, err := w.blockSendAmount(&sb)
+		if err != nil {
+			return nil, err
+		} else if amount != nil {
+			if err := w.checkSignedSend(wallet, amount); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return w.signUncheckedBlock(wallet, sb)
+}
+
+// Refuse to sign a send of amount raw if the wallet couldn't publish it itself
+func (w *NanoWallet) checkSignedSend(wallet *ent.Wallet, amount *big.Int) error {
+	if w.RequiresApproval(wallet, amount.String()) {
+		return ErrApprovalRequired
+	}
+	remaining, err := w.DailySendRemaining(wallet)
+	if err != nil {
+		return err
+	} else if remaining != nil && amount.Cmp(remaining) > 0 {
+		return fmt.Errorf("%w, %s raw left", ErrDailySendLimitExceeded, remaining)
+	}
+	return nil
+}
+
+// SignBlock without the checks, the caller knows what sb is
+func (w *NanoWallet) signUncheckedBlock(wallet *ent.Wallet, sb nanoblock.StateBlock) (*nanoblock.StateBlock, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if wallet.FrozenAt != nil {
+		return nil, ErrWalletFrozen
+	}
+	sb.Banano = w.Config.Wallet.Banano
+	if sb.Type == "" {
+		sb.Type = "state"
+	}
+	if sb.Type != "state" || sb.Validate() != nil {
+		return nil, ErrInvalidBlock
+	}
+	// Fails if the wallet is locked
+	acc, err := w.GetAccount(wallet, sb.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := w.accountSigner(wallet, acc)
+	if err != nil {
+		return nil, err
+	}
+	if err := signer.SignBlock(&sb); err != nil {
+		return nil, err
+	}
+
+	return &sb, nil
+}
+
+// Sign message with the key of an account of the wallet, to prove the wallet holds it, see utils.SignMessage
+// Accounts of a hardware wallet's seed can't, the Ledger only signs blocks
+func (w *NanoWallet) SignMessage(wallet *ent.Wallet, address string, message string) ([]byte, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	// Fails if the wallet is locked
+	acc, err := w.GetAccount(wallet, address)
+	if err != nil {
+		return nil, err
+	}
+	priv, err := accountPrivateKey(wallet, acc)
+	if err != nil {
+		return nil, err
+	}
+	return utils.SignMessage(priv, message, w.Config.Wallet.Banano), nil
+}
+
+// The private key of an account, whether it was derived from the wallet's seed, another seed or added with its key
+func accountPrivateKey(wallet *ent.Wallet, acct *ent.Account) (ed25519.PrivateKey, error) {
+	if wallet.WatchOnly {
+		return nil, ErrWalletWatchOnly
+	} else if wallet.FrozenAt != nil {
+		return nil, ErrWalletFrozen
+	} else if acct.WatchOnly {
+		return nil, ErrNoPrivateKey
+	}
+	if acct.Seed != nil && acct.SeedIndex != nil {
+		acctSeed, err := storedAccountKey(wallet, accountSeedKey(acct.Address), *acct.Seed)
+		if err != nil {
+			return nil, err
+		}
+		_, priv, err := utils.KeypairFromSeed(acctSeed, uint32(*acct.SeedIndex))
+		return priv, err
+	} else if acct.PrivateKey != nil {
+		privateKey, err := storedAccountKey(wallet, acct.Address, *acct.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		decoded, err := hex.DecodeString(privateKey)
+		if err != nil || len(decoded) != ed25519.PrivateKeySize {
+			return nil, ErrInvalidPrivKey
+		}
+		return ed25519.PrivateKey(decoded), nil
+	} else if acct.AccountIndex == nil {
+		return nil, ErrInvalidAccount
+	} else if wallet.Hardware {
+		// It's on the Ledger
+		return nil, ErrNoPrivateKey
+	}
+	seed, err := GetDecryptedKeyFromStorage(wallet, "seed")
+	if err != nil {
+		return nil, err
+	}
+	_, priv, err := utils.KeypairFromSeed(seed, uint32(*acct.AccountIndex))
+	return priv, err
+}
@@ -0,0 +1,113 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignBlock(t *testing.T) {
+	_, err := MockWallet.SignBlock(nil, nanoblock.StateBlock{})
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("d5a8c1f4e7b0d3a6c9f2e5b8d1a4c7f0e3b6d9a2c5f8e1b4e1b4d7a0c3f6e9b2"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	_, adhocPriv, _ := ed25519.GenerateKey(strings.NewReader("9c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040d1f729340e07eee69abac04"))
+	adhoc, err := MockWallet.AdhocAccountCreate(wallet, adhocPriv)
+	assert.Nil(t, err)
+
+	unsigned := nanoblock.StateBlock{
+		Account:        acc.Address,
+		Previous:       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		Representative: "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+		Balance:        "600",
+		Link:           "d4bbfa50649d80e5f63fc396c6f4cf6321cabd7c1480e964c2701d56aafeb5e3",
+		Work:           "205452237a9b01f4",
+	}
+
+	// The signature verifies against the account's public key
+	for _, address := range []string{acc.Address, adhoc.Address} {
+		sb := unsigned
+		sb.Account = address
+		signed, err := MockWallet.SignBlock(wallet, sb)
+		assert.Nil(t, err)
+		assert.Equal(t, "state", signed.Type)
+		assert.Equal(t, "205452237a9b01f4", signed.Work)
+		pub, _ := utils.AddressToPub(address, false)
+		signature, err := hex.DecodeString(signed.Signature)
+		assert.Nil(t, err)
+		hash := signed.Hash()
+		assert.True(t, ed25519.Verify(ed25519.PublicKey(pub), hash[:], signature))
+		assert.Nil(t, signed.VerifySignature())
+	}
+
+	// Invalid blocks aren't signed
+	sb := unsigned
+	sb.Balance = "lots"
+	_, err = MockWallet.SignBlock(wallet, sb)
+	assert.ErrorIs(t, err, ErrInvalidBlock)
+	sb = unsigned
+	sb.Type = "send"
+	_, err = MockWallet.SignBlock(wallet, sb)
+	assert.ErrorIs(t, err, ErrInvalidBlock)
+
+	// The account has to be in the wallet
+	sb = unsigned
+	sb.Account = "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est"
+	_, err = MockWallet.SignBlock(wallet, sb)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	// and the wallet unlocked
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+	_, err = MockWallet.SignBlock(wallet, unsigned)
+	assert.ErrorIs(t, err, ErrWalletLocked)
+
+	// Once it's unlocked the keys come from the unlocked copy
+	_, err = MockWallet.UnlockWallet(wallet, "password")
+	assert.Nil(t, err)
+	sb = unsigned
+	sb.Account = adhoc.Address
+	signed, err := MockWallet.SignBlock(wallet, sb)
+	assert.Nil(t, err)
+	assert.Nil(t, signed.VerifySignature())
+}
+
+func TestSignMessage(t *testing.T) {
+	_, err := MockWallet.SignMessage(nil, "nano_1", "message")
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("a3f6c9e2b5d8a1f4c7e0b3d6a9f2c5e8b1d4a7f0c3e6b9d2a5f8c1e4b7d0a3f6"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	_, adhocPriv, _ := ed25519.GenerateKey(strings.NewReader("4e7b0d3a6c9f2e5b8d1a4c7f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e7b"))
+	adhoc, err := MockWallet.AdhocAccountCreate(wallet, adhocPriv)
+	assert.Nil(t, err)
+
+	for _, address := range []string{acc.Address, adhoc.Address} {
+		signature, err := MockWallet.SignMessage(wallet, address, "Deposit address of order 1234")
+		assert.Nil(t, err)
+		pub, _ := utils.AddressToPub(address, false)
+		assert.True(t, utils.VerifyMessage(pub, "Deposit address of order 1234", signature, false))
+	}
+
+	// The account has to be in the wallet
+	_, err = MockWallet.SignMessage(wallet, "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est", "message")
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	// and the wallet unlocked
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+	_, err = MockWallet.SignMessage(wallet, acc.Address, "message")
+	assert.ErrorIs(t, err, ErrWalletLocked)
+}
@@ -0,0 +1,46 @@
+package wallet
+
+import (
+	"errors"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+)
+
+// Signs blocks for one account, with its private key here or on a hardware wallet
+type Signer interface {
+	SignBlock(sb *nanoblock.StateBlock) error
+}
+
+// Signs with the account's private key in process
+type keySigner ed25519.PrivateKey
+
+func (s keySigner) SignBlock(sb *nanoblock.StateBlock) error {
+	return sb.Sign(privateKeySeed(ed25519.PrivateKey(s)))
+}
+
+// Signs on the Ledger with the key at the account's index
+type ledgerSigner struct {
+	ledger *Ledger
+	index  uint32
+}
+
+func (s ledgerSigner) SignBlock(sb *nanoblock.StateBlock) error {
+	return s.ledger.SignBlock(s.index, sb)
+}
+
+// What signs the account's blocks, the Ledger for the accounts of a hardware wallet's seed and the private key for everything else
+func (w *NanoWallet) accountSigner(wallet *ent.Wallet, acct *ent.Account) (Signer, error) {
+	priv, err := accountPrivateKey(wallet, acct)
+	if errors.Is(err, ErrNoPrivateKey) && wallet.Hardware && !acct.WatchOnly && acct.AccountIndex != nil {
+		ledger, err := w.ledger()
+		if err != nil {
+			return nil, err
+		}
+		return ledgerSigner{ledger: ledger, index: uint32(*acct.AccountIndex)}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return keySigner(priv), nil
+}
@@ -0,0 +1,100 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletspend"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+)
+
+var ErrDailySendLimitExceeded = errors.New("daily send limit exceeded")
+
+// With daily_send_limit each wallet can send at most that much in any 24 hours
+// Sends are recorded while a limit is set, so ones made before it was set don't count
+
+const spendWindow = 24 * time.Hour
+
+func (w *NanoWallet) dailySendLimit() *big.Int {
+	if w.Config == nil || w.Config.Wallet.DailySendLimit == "" {
+		return nil
+	}
+	limit, ok := big.NewInt(0).SetString(w.Config.Wallet.DailySendLimit, 10)
+	if !ok {
+		return nil
+	}
+	return limit
+}
+
+// How much the wallet sent in the 24 hours before now
+func (w *NanoWallet) DailySent(wallet *ent.Wallet, now time.Time) (*big.Int, error) {
+	spends, err := w.DB.WalletSpend.Query().Where(walletspend.WalletID(wallet.ID), walletspend.CreatedAtGT(now.Add(-spendWindow))).All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	sent := big.NewInt(0)
+	for _, spend := range spends {
+		amount, ok := big.NewInt(0).SetString(spend.Amount, 10)
+		if !ok {
+			continue
+		}
+		sent.Add(sent, amount)
+	}
+	return sent, nil
+}
+
+// How much more the wallet can send now, nil without a daily_send_limit
+func (w *NanoWallet) DailySendRemaining(wallet *ent.Wallet) (*big.Int, error) {
+	limit := w.dailySendLimit()
+	if limit == nil {
+		return nil, nil
+	}
+	sent, err := w.DailySent(wallet, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	remaining := limit.Sub(limit, sent)
+	if remaining.Sign() < 0 {
+		remaining.SetInt64(0)
+	}
+	return remaining, nil
+}
+
+// Obtain the wallet's spend lock and refuse amount raw if it's more than what's left of the limit, the lock is released with release
+// The account lock doesn't stop the wallet's other accounts from sending, so the limit has its own
+// Without a daily_send_limit nothing is locked
+func (w *NanoWallet) obtainDailySend(wallet *ent.Wallet, amount string) (release func(), err error) {
+	if w.dailySendLimit() == nil {
+		return func() {}, nil
+	}
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("spend:%s", wallet.ID), time.Second*30, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, database.ErrLockNotObtained
+	}
+	release = func() { lock.Release(w.Ctx) }
+	remaining, err := w.DailySendRemaining(wallet)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	// An amount that doesn't parse fails when the block is made
+	if sendAmount, ok := big.NewInt(0).SetString(amount, 10); ok && sendAmount.Cmp(remaining) > 0 {
+		release()
+		return nil, fmt.Errorf("%w, %s raw left", ErrDailySendLimitExceeded, remaining)
+	}
+	return release, nil
+}
+
+// Record a send against the wallet's limit, spends that are out of the window are dropped
+func (w *NanoWallet) recordSpend(wallet *ent.Wallet, amount string, hash string) {
+	if _, err := w.DB.WalletSpend.Create().SetWalletID(wallet.ID).SetAmount(amount).SetBlockHash(hash).Save(w.Ctx); err != nil {
+		log.Ctx(w.Ctx).Errorf("Error recording send %s of wallet %s %s", hash, wallet.ID, err)
+	}
+	if _, err := w.DB.WalletSpend.Delete().Where(walletspend.WalletID(wallet.ID), walletspend.CreatedAtLT(time.Now().Add(-spendWindow))).Exec(w.Ctx); err != nil {
+		log.Ctx(w.Ctx).Errorf("Error pruning sends of wallet %s %s", wallet.ID, err)
+	}
+}
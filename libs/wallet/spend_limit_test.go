@@ -0,0 +1,142 @@
+package wallet
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDailySendLimit(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	processed := 0
+	processTimesOut := false
+	processRefused := false
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr requests.BaseRequest
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr.Action == "account_info" {
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.AccountInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			} else if pr.Action == "block_info" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"balance": "1000000"})
+			} else if pr.Action == "process" && processTimesOut {
+				return nil, errors.New("timeout")
+			} else if pr.Action == "process" && processRefused {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Fork"})
+			} else if pr.Action == "process" {
+				processed++
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", processed),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	conf := *MockWallet.Config
+	conf.Wallet.DailySendLimit = "2500"
+	limited := &NanoWallet{
+		DB:         MockWallet.DB,
+		Ctx:        MockWallet.Ctx,
+		RpcClient:  MockWallet.RpcClient,
+		WorkClient: MockWallet.WorkClient,
+		Config:     &conf,
+	}
+	seed, _ := utils.GenerateSeed(strings.NewReader("5c8f1b4e7a0d3c6f9b2e5a8d1c4f7b0e3a6d9c2f5b8e1a4d7c0f3b6e9a2d5c81"))
+	wallet, err := limited.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := limited.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	other, err := limited.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	destination := "nano_3o7uzba8b9e1wqu5ziwpruteyrs3scyqr761x7ke6w1xctohxfh5du75qgaj"
+	work := "0000000000000000"
+
+	_, err = limited.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, nil, &work, nil)
+	assert.Nil(t, err)
+	remaining, err := limited.DailySendRemaining(wallet)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1500), remaining)
+
+	// The limit is for the wallet, not each account
+	_, err = limited.CreateAndPublishSendBlock(wallet, "1000", other.Address, destination, nil, &work, nil)
+	assert.Nil(t, err)
+	_, err = limited.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, nil, &work, nil)
+	assert.ErrorIs(t, err, ErrDailySendLimitExceeded)
+	assert.Contains(t, err.Error(), "500 raw left")
+	assert.Equal(t, 2, processed)
+	_, err = limited.CreateAndPublishSendBlock(wallet, "500", acc.Address, destination, nil, &work, nil)
+	assert.Nil(t, err)
+
+	// Sends older than a day don't count
+	sent, err := limited.DailySent(wallet, time.Now().Add(25*time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), sent)
+
+	// Without a limit nothing is refused
+	_, err = MockWallet.CreateAndPublishSendBlock(wallet, "1000", acc.Address, destination, nil, &work, nil)
+	assert.Nil(t, err)
+	remaining, err = MockWallet.DailySendRemaining(wallet)
+	assert.Nil(t, err)
+	assert.Nil(t, remaining)
+
+	// A send the node refused doesn't count, one it may have taken does
+	seed, _ = utils.GenerateSeed(strings.NewReader("0d3c6f9b2e5a8d1c4f7b0e3a6d9c2f5b8e1a4d7c0f3b6e9a2d5c815c8f1b4e7a"))
+	unanswered, err := limited.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err = limited.AccountCreate(unanswered, nil)
+	assert.Nil(t, err)
+	processRefused = true
+	_, err = limited.CreateAndPublishSendBlock(unanswered, "1000", acc.Address, destination, nil, &work, nil)
+	assert.NotNil(t, err)
+	processRefused = false
+	processTimesOut = true
+	_, err = limited.CreateAndPublishSendBlock(unanswered, "2000", acc.Address, destination, nil, &work, nil)
+	assert.NotNil(t, err)
+	remaining, err = limited.DailySendRemaining(unanswered)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(500), remaining)
+
+	// Raw sends count too
+	processTimesOut = false
+	raw := nanoblock.StateBlock{
+		Type:           "state",
+		Account:        acc.Address,
+		Previous:       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		Representative: "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+		Balance:        "999400",
+		Link:           "d4bbfa50649d80e5f63fc396c6f4cf6321cabd7c1480e964c2701d56aafeb5e3",
+	}
+	_, err = limited.SignBlock(unanswered, raw)
+	assert.ErrorIs(t, err, ErrDailySendLimitExceeded)
+	signed, err := limited.signUncheckedBlock(unanswered, raw)
+	assert.Nil(t, err)
+	_, err = limited.PublishRawBlock(unanswered, *signed, &work, nil)
+	assert.ErrorIs(t, err, ErrDailySendLimitExceeded)
+	raw.Balance = "999600"
+	signed, err = limited.signUncheckedBlock(unanswered, raw)
+	assert.Nil(t, err)
+	_, err = limited.PublishRawBlock(unanswered, *signed, &work, nil)
+	assert.Nil(t, err)
+	remaining, err = limited.DailySendRemaining(unanswered)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), remaining)
+}
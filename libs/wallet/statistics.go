@@ -0,0 +1,130 @@
+package wallet
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+// Periods of wallet statistics, each ends now
+const (
+	StatisticsPeriodDay   = "day"
+	StatisticsPeriodWeek  = "week"
+	StatisticsPeriodMonth = "month"
+)
+
+var statisticsPeriods = map[string]time.Duration{
+	StatisticsPeriodDay:   24 * time.Hour,
+	StatisticsPeriodWeek:  7 * 24 * time.Hour,
+	StatisticsPeriodMonth: 30 * 24 * time.Hour,
+}
+
+// Blocks per account_history call
+const statisticsHistoryPageSize = 100
+
+// Count the sends and receives of every account in the wallet over the period before now, from account_history
+// History is read newest first until a block is older than the period, blocks the node has no local_timestamp for count as older
+func (w *NanoWallet) WalletStatistics(wallet *ent.Wallet, period string, now time.Time) (*models.WalletStatistics, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	duration, ok := statisticsPeriods[period]
+	if !ok {
+		return nil, ErrInvalidPeriod
+	}
+
+	// Fails if the wallet is locked
+	_, err := GetDecryptedKeyFromStorage(wallet, "seed")
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &models.WalletStatistics{
+		Since: now.Add(-duration),
+		Confirmed: models.BlockStatistics{
+			SendTotal:    big.NewInt(0),
+			ReceiveTotal: big.NewInt(0),
+		},
+		Unconfirmed: models.BlockStatistics{
+			SendTotal:    big.NewInt(0),
+			ReceiveTotal: big.NewInt(0),
+		},
+	}
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Order(ent.Asc(account.FieldCreatedAt)).All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counterparties := map[*models.BlockStatistics]map[string]bool{
+		&stats.Confirmed:   {},
+		&stats.Unconfirmed: {},
+	}
+	for _, acc := range accounts {
+		var head *string
+		for {
+			resp, err := w.RpcClient.MakeAccountHistoryRequest(acc.Address, statisticsHistoryPageSize, head)
+			if err != nil {
+				return nil, err
+			}
+			inPeriod := true
+			for _, entry := range resp.History {
+				timestamp, err := strconv.ParseInt(entry.LocalTimestamp, 10, 64)
+				if err != nil {
+					return nil, errors.New("Unable to parse local_timestamp")
+				}
+				if time.Unix(timestamp, 0).Before(stats.Since) {
+					inPeriod = false
+					break
+				}
+				if entry.Type != "send" && entry.Type != "receive" {
+					continue
+				}
+
+				amount, ok := big.NewInt(0).SetString(entry.Amount, 10)
+				if !ok {
+					return nil, errors.New("Unable to parse amount")
+				}
+				// Keyed by our own prefix, so the xrb_ and nano_ addresses of an account are one counterparty
+				pub, err := utils.AddressToPub(entry.Account, w.Banano)
+				if err != nil {
+					return nil, errors.New("Unable to parse account")
+				}
+				counterparty := utils.PubKeyToAddress(pub, w.Banano)
+
+				blockStats := &stats.Unconfirmed
+				if entry.Confirmed == "true" {
+					blockStats = &stats.Confirmed
+				}
+				counterparties[blockStats][counterparty] = true
+				if entry.Type == "receive" {
+					blockStats.ReceiveCount++
+					blockStats.ReceiveTotal.Add(blockStats.ReceiveTotal, amount)
+					continue
+				}
+				blockStats.SendCount++
+				blockStats.SendTotal.Add(blockStats.SendTotal, amount)
+				if blockStats.LargestSend == nil || amount.Cmp(blockStats.LargestSend.Amount) > 0 {
+					blockStats.LargestSend = &models.LargestSend{
+						Hash:    entry.Hash,
+						Account: counterparty,
+						Amount:  amount,
+					}
+				}
+			}
+			if !inPeriod || resp.Previous == "" || len(resp.History) < statisticsHistoryPageSize {
+				break
+			}
+			head = &resp.Previous
+		}
+	}
+	stats.Confirmed.UniqueCounterparties = len(counterparties[&stats.Confirmed])
+	stats.Unconfirmed.UniqueCounterparties = len(counterparties[&stats.Unconfirmed])
+
+	return stats, nil
+}
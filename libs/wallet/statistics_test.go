@@ -0,0 +1,140 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletStatistics(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("3b8e1d6a9c4f7b2e5d0a3c8f1b6e9d4a7c2f5b0e3d8a1c6f9b4e7d2a5c0f3b8e"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	created, err := MockWallet.AccountsCreate(wallet, 2)
+	assert.Nil(t, err)
+	pub, _, _ := utils.KeypairFromSeed(seed, 0)
+	first := utils.PubKeyToAddress(pub, false)
+	// The second account is unopened
+	third := created[1].Address
+
+	repA := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	repB := "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k"
+	other := "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est"
+	now := time.Unix(1700000000, 0)
+	entry := func(blockType string, account string, amount string, age time.Duration, confirmed bool) map[string]interface{} {
+		return map[string]interface{}{
+			"type":            blockType,
+			"account":         account,
+			"amount":          amount,
+			"local_timestamp": fmt.Sprint(now.Add(-age).Unix()),
+			"hash":            fmt.Sprintf("%064X", int64(age/time.Second)),
+			"confirmed":       fmt.Sprint(confirmed),
+		}
+	}
+
+	// A full first page, the second is read from its previous
+	firstPage := []interface{}{
+		entry("send", repA, "300", 10*time.Second, false),
+		entry("receive", repB, "50", 20*time.Second, false),
+	}
+	for i := 0; i < 98; i++ {
+		firstPage = append(firstPage, entry("receive", repB, "1", 100*time.Second, true))
+	}
+	secondPage := []interface{}{
+		entry("send", other, "1000", 1000*time.Second, true),
+		// The same account as repA
+		entry("send", "xrb_"+strings.TrimPrefix(repA, "nano_"), "5", 2000*time.Second, true),
+		entry("send", other, "99999", 48*time.Hour, true),
+		entry("receive", repB, "12345", 40*24*time.Hour, true),
+	}
+	calls := 0
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var hr requests.AccountHistoryRequest
+			json.NewDecoder(req.Body).Decode(&hr)
+			if hr.Action != "account_history" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+			}
+			calls++
+			switch hr.Account {
+			case first:
+				if hr.Head == nil {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"account": first, "history": firstPage, "previous": "ABCD"})
+				} else if *hr.Head == "ABCD" {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"account": first, "history": secondPage})
+				}
+			case third:
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"account": third, "history": []interface{}{
+					entry("change", "", "0", time.Minute, true),
+					entry("receive", repA, "7", time.Hour, true),
+				}})
+			}
+			// Unopened
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"account": hr.Account, "history": ""})
+		},
+	)
+
+	stats, err := MockWallet.WalletStatistics(wallet, StatisticsPeriodDay, now)
+	assert.Nil(t, err)
+	assert.Equal(t, now.Add(-24*time.Hour), stats.Since)
+	assert.Equal(t, 2, stats.Confirmed.SendCount)
+	assert.Equal(t, "1005", stats.Confirmed.SendTotal.String())
+	assert.Equal(t, 99, stats.Confirmed.ReceiveCount)
+	assert.Equal(t, "105", stats.Confirmed.ReceiveTotal.String())
+	assert.Equal(t, 3, stats.Confirmed.UniqueCounterparties)
+	assert.Equal(t, other, stats.Confirmed.LargestSend.Account)
+	assert.Equal(t, "1000", stats.Confirmed.LargestSend.Amount.String())
+	assert.Equal(t, fmt.Sprintf("%064X", 1000), stats.Confirmed.LargestSend.Hash)
+	assert.Equal(t, 1, stats.Unconfirmed.SendCount)
+	assert.Equal(t, "300", stats.Unconfirmed.SendTotal.String())
+	assert.Equal(t, 1, stats.Unconfirmed.ReceiveCount)
+	assert.Equal(t, "50", stats.Unconfirmed.ReceiveTotal.String())
+	assert.Equal(t, 2, stats.Unconfirmed.UniqueCounterparties)
+	assert.Equal(t, repA, stats.Unconfirmed.LargestSend.Account)
+	// Two pages for the first account
+	assert.Equal(t, 4, calls)
+
+	stats, err = MockWallet.WalletStatistics(wallet, StatisticsPeriodWeek, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, stats.Confirmed.SendCount)
+	assert.Equal(t, "101004", stats.Confirmed.SendTotal.String())
+	assert.Equal(t, "99999", stats.Confirmed.LargestSend.Amount.String())
+	assert.Equal(t, 99, stats.Confirmed.ReceiveCount)
+
+	stats, err = MockWallet.WalletStatistics(wallet, StatisticsPeriodMonth, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 99, stats.Confirmed.ReceiveCount)
+
+	// Only unopened accounts
+	unopenedSeed, _ := utils.GenerateSeed(strings.NewReader("8c3f6a1d4b9e2c7f0a5d8b3e6c1f4a9d2b7e0c5f8a3d6b1e4c9f2a7d0b5e8c3f"))
+	unopenedWallet, err := MockWallet.WalletCreate(unopenedSeed)
+	assert.Nil(t, err)
+	stats, err = MockWallet.WalletStatistics(unopenedWallet, StatisticsPeriodDay, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, stats.Confirmed.ReceiveCount)
+	assert.Equal(t, 0, stats.Confirmed.UniqueCounterparties)
+	assert.Nil(t, stats.Confirmed.LargestSend)
+	assert.Equal(t, 0, stats.Unconfirmed.SendCount)
+	assert.Equal(t, "0", stats.Unconfirmed.SendTotal.String())
+	assert.Nil(t, stats.Unconfirmed.LargestSend)
+
+	_, err = MockWallet.WalletStatistics(wallet, "year", now)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+
+	// Locked
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+	_, err = MockWallet.WalletStatistics(wallet, StatisticsPeriodDay, now)
+	assert.ErrorIs(t, err, ErrWalletLocked)
+}
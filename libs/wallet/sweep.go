@@ -0,0 +1,142 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+var ErrInvalidSweepSource = errors.New("invalid sweep source")
+
+// Sweeping moves everything from accounts that aren't in Pippin into an account of a wallet
+// The source keys are derived in memory to sign the blocks, they are never saved
+
+// An account to sweep, the key at index of seed
+type SweepSource struct {
+	Seed  string
+	Index int
+}
+
+// Receive everything pending on each source, then send its whole balance to destination, which must be in the wallet
+// Returns the hashes of every block that was published, in order, also when it fails part way
+func (w *NanoWallet) SweepToWallet(wallet *ent.Wallet, destination string, sources []SweepSource, bpowKey *string) ([]string, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if wallet.FrozenAt != nil {
+		return nil, ErrWalletFrozen
+	}
+	for _, source := range sources {
+		if !utils.Validate64HexHash(source.Seed) || source.Index < 0 {
+			return nil, ErrInvalidSweepSource
+		}
+	}
+
+	// Destination must be in this wallet, this also fails if the wallet is locked
+	destinationAcc, err := w.GetAccount(wallet, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := []string{}
+	for _, source := range sources {
+		pub, priv, err := utils.KeypairFromSeed(source.Seed, uint32(source.Index))
+		if err != nil {
+			return hashes, err
+		}
+		// Never saved, it only carries the key to the block builders
+		privateKey := hex.EncodeToString(priv)
+		acc := &ent.Account{
+			Address:    utils.PubKeyToAddress(pub, w.Banano),
+			PrivateKey: &privateKey,
+		}
+		if acc.Address == destinationAcc.Address {
+			continue
+		}
+
+		// What's swept isn't the wallet's until it's received
+		receiveHashes, sendHash, err := w.sweepAccount(wallet, acc, destinationAcc.Address, bpowKey, false)
+		hashes = append(hashes, receiveHashes...)
+		if sendHash != "" {
+			hashes = append(hashes, sendHash)
+		}
+		if err != nil {
+			return hashes, err
+		}
+	}
+
+	return hashes, nil
+}
+
+// Receive everything pending on acc, then send its whole balance to destination
+// With spend acc is the wallet's, its send is made like a send, held to the wallet's approval threshold and daily limit
+// Returns the receive hashes and the send hash, which is empty if there was nothing to send
+func (w *NanoWallet) sweepAccount(wallet *ent.Wallet, acc *ent.Account, destination string, bpowKey *string, spend bool) ([]string, string, error) {
+	// Obtain lock
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("acl:%s", acc.Address), time.Second*300, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, "", database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	hashes, err := w.receiveAllHashes(wallet, acc, bpowKey)
+	if err != nil {
+		return hashes, "", err
+	}
+
+	// Nothing to send if it was never opened
+	accountInfo, err := w.accountFrontier(acc.Address)
+	if errors.Is(err, nanorpc.ErrAccountNotFound) {
+		return hashes, "", nil
+	} else if err != nil {
+		return hashes, "", err
+	}
+	balance, ok := big.NewInt(0).SetString(accountInfo.Balance, 10)
+	if !ok {
+		return hashes, "", errors.New("Unable to parse balance")
+	} else if balance.Sign() == 0 {
+		return hashes, "", nil
+	}
+
+	if spend {
+		sendHash, err := w.publishSend(wallet, acc, balance.String(), destination, nil, nil, bpowKey, 0, false)
+		if err == nil && sendHash == "" {
+			err = errors.New("Unable to publish send block")
+		}
+		return hashes, sendHash, err
+	}
+
+	sb, err := w.createSendBlock(wallet, acc, balance.String(), destination, nil, bpowKey, 0, false)
+	if err != nil {
+		return hashes, "", err
+	}
+
+	// Publish block
+	subtype := "send"
+	resp, err := w.RpcClient.MakeProcessRequest(requests.ProcessRequest{
+		BaseRequest: requests.BaseRequest{
+			Action: "process",
+		},
+		Subtype:   &subtype,
+		JsonBlock: true,
+		Block:     *sb,
+	})
+	if err != nil || !utils.Validate64HexHash(resp.Hash) {
+		w.frontiers().Invalidate(acc.Address)
+		if err == nil {
+			err = errors.New("Unable to publish send block")
+		}
+		return hashes, "", err
+	}
+	// Nothing is left to send, don't keep it around
+	w.frontiers().Invalidate(acc.Address)
+
+	return hashes, resp.Hash, nil
+}
@@ -0,0 +1,131 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/mocks"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSweepToWallet(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// The pow client only has work for this frontier
+	frontier := "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3"
+	sourceSeed, _ := utils.GenerateSeed(strings.NewReader("5a8d1f4b7e0c3a6d9f2b5e8c1a4d7f0b3e6c9a2d5f8b1e4c7a0d3f6b9e2c5a81"))
+	sourcePub, _, _ := utils.KeypairFromSeed(sourceSeed, 3)
+	source := utils.PubKeyToAddress(sourcePub, false)
+	unopenedSeed, _ := utils.GenerateSeed(strings.NewReader("e2c5a8d1f4b7e0c3a6d9f2b5e8c1a4d7f0b3e6c9a2d5f8b1e4c7a0d3f6b9e2c5"))
+
+	balance, _ := big.NewInt(0).SetString("5", 10)
+	var published []nanoblock.StateBlock
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "receivable":
+				if pr["account"] != source || len(published) > 0 {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": ""})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"blocks": map[string]interface{}{"FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE": "30000000000000000000000000000000000"},
+				})
+			case "block_info":
+				var js map[string]interface{}
+				json.Unmarshal([]byte(mocks.BlockInfoResponseStr), &js)
+				return httpmock.NewJsonResponse(200, js)
+			case "account_info":
+				if pr["account"] != source {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Account not found"})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       frontier,
+					"balance":        balance.String(),
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			case "process":
+				var sb nanoblock.StateBlock
+				block, _ := json.Marshal(pr["block"])
+				json.Unmarshal(block, &sb)
+				published = append(published, sb)
+				newBalance, _ := big.NewInt(0).SetString(sb.Balance, 10)
+				balance = newBalance
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", len(published)),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	// The mocked node always returns the same frontier, so don't cache the ones we publish
+	conf := *MockWallet.Config
+	conf.Wallet.FrontierCacheSize = 0
+	sweepWallet := &NanoWallet{
+		DB:         MockWallet.DB,
+		Ctx:        MockWallet.Ctx,
+		RpcClient:  MockWallet.RpcClient,
+		WorkClient: MockWallet.WorkClient,
+		Config:     &conf,
+	}
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("b3e6c9a2d5f8b1e4c7a0d3f6b9e2c5a8d1f4b7e0c3a6d9f2b5e8c1a4d7f0b3e6"))
+	wallet, err := sweepWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	destination, err := sweepWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	_, err = sweepWallet.SweepToWallet(nil, destination.Address, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+	_, err = sweepWallet.SweepToWallet(wallet, destination.Address, []SweepSource{{Seed: "1234", Index: 0}}, nil)
+	assert.ErrorIs(t, err, ErrInvalidSweepSource)
+	_, err = sweepWallet.SweepToWallet(wallet, source, []SweepSource{{Seed: sourceSeed, Index: 3}}, nil)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	hashes, err := sweepWallet.SweepToWallet(wallet, destination.Address, []SweepSource{{Seed: sourceSeed, Index: 3}, {Seed: unopenedSeed, Index: 0}}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{fmt.Sprintf("%064X", 1), fmt.Sprintf("%064X", 2)}, hashes)
+	assert.Len(t, published, 2)
+
+	// Receive the pending block
+	receive := published[0]
+	assert.Equal(t, source, receive.Account)
+	assert.Equal(t, frontier, receive.Previous)
+	assert.Equal(t, "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE", receive.Link)
+	assert.Equal(t, "30000000000000000000000000000000005", receive.Balance)
+
+	// Then send everything to the destination
+	send := published[1]
+	destinationPub, _ := utils.AddressToPub(destination.Address, false)
+	assert.Equal(t, source, send.Account)
+	assert.Equal(t, "0", send.Balance)
+	assert.Equal(t, hex.EncodeToString(destinationPub), send.Link)
+
+	// Signed by the source key
+	for _, sb := range published {
+		hash := sb.Hash()
+		sig, err := hex.DecodeString(sb.Signature)
+		assert.Nil(t, err)
+		assert.True(t, ed25519.Verify(ed25519.PublicKey(sourcePub), hash[:], sig))
+	}
+
+	// Nothing about the source was saved
+	count, err := sweepWallet.DB.Account.Query().Where(account.Address(source)).Count(sweepWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+}
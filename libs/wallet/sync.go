@@ -0,0 +1,88 @@
+package wallet
+
+import (
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+// How many indexes past the highest account index are checked for accounts that are on chain but not in the database
+const syncGapLimit = 20
+
+// The accounts of a wallet compared with the node's frontiers
+type AccountsSyncResult struct {
+	// In the database, with a frontier
+	Opened []string
+	// In the database, never opened
+	Unopened []string
+	// Derived from the wallet's seed, with a frontier, but not in the database
+	MissingFromDB []string
+}
+
+// Ask the node for the frontiers of every account of the wallet, and of the seed's accounts that aren't in the database
+// Those are every missing index up to syncGapLimit past the highest one in the database
+func (w *NanoWallet) AccountsSync(wallet *ent.Wallet) (*AccountsSyncResult, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+
+	// Fails if the wallet is locked
+	seed, err := GetDecryptedKeyFromStorage(wallet, "seed")
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Order(ent.Asc(account.FieldAccountIndex), ent.Asc(account.FieldCreatedAt)).All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	inDB := make(map[string]bool, len(accounts))
+	dbAddresses := make([]string, len(accounts))
+	highestIndex := -1
+	for i, acc := range accounts {
+		inDB[acc.Address] = true
+		dbAddresses[i] = acc.Address
+		if acc.AccountIndex != nil && *acc.AccountIndex > highestIndex {
+			highestIndex = *acc.AccountIndex
+		}
+	}
+
+	var derived []string
+	for index := 0; index <= highestIndex+syncGapLimit; index++ {
+		pub, _, err := utils.KeypairFromSeed(seed, uint32(index))
+		if err != nil {
+			return nil, err
+		}
+		address := utils.PubKeyToAddress(pub, w.Banano)
+		if !inDB[address] {
+			derived = append(derived, address)
+		}
+	}
+
+	resp, err := w.RpcClient.MakeAccountsFrontiersRequest(append(append([]string{}, dbAddresses...), derived...))
+	if err != nil {
+		return nil, err
+	}
+	frontiers := *resp.Frontiers
+
+	result := &AccountsSyncResult{
+		Opened:        []string{},
+		Unopened:      []string{},
+		MissingFromDB: []string{},
+	}
+	for _, address := range dbAddresses {
+		if _, ok := frontiers[address]; ok {
+			result.Opened = append(result.Opened, address)
+		} else {
+			result.Unopened = append(result.Unopened, address)
+		}
+	}
+	for _, address := range derived {
+		if _, ok := frontiers[address]; ok {
+			result.MissingFromDB = append(result.MissingFromDB, address)
+		}
+	}
+
+	return result, nil
+}
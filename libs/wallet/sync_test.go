@@ -0,0 +1,71 @@
+package wallet
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountsSync(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("4d7a0c3f6e9b2d5a8c1f4e7b0d3a6c9f2e5b8d1a7c4f0e3b6d9a2c5f8e1b4d7a"))
+	address := func(index uint32) string {
+		pub, _, _ := utils.KeypairFromSeed(seed, index)
+		return utils.PubKeyToAddress(pub, false)
+	}
+
+	// Index 0 is opened, 1 and 2 aren't, 5 is opened but was never created in Pippin
+	opened := map[string]bool{address(0): true, address(5): true}
+	var requested []string
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] != "accounts_frontiers" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+			}
+			requested = []string{}
+			frontiers := map[string]string{}
+			errors := map[string]string{}
+			for _, acc := range pr["accounts"].([]interface{}) {
+				requested = append(requested, acc.(string))
+				if opened[acc.(string)] {
+					frontiers[acc.(string)] = "791AF413173EEE674A6FCF633B5DFC0F3C33F397F0DA08E987D9E0741D40D81A"
+				} else {
+					errors[acc.(string)] = "Account not found"
+				}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"frontiers": frontiers, "errors": errors})
+		},
+	)
+
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	_, err = MockWallet.AccountsCreate(wallet, 2)
+	assert.Nil(t, err)
+
+	result, err := MockWallet.AccountsSync(wallet)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{address(0)}, result.Opened)
+	assert.Equal(t, []string{address(1), address(2)}, result.Unopened)
+	assert.Equal(t, []string{address(5)}, result.MissingFromDB)
+	// The wallet's accounts, then up to syncGapLimit past the highest index
+	assert.Len(t, requested, 3+syncGapLimit)
+	assert.Equal(t, address(3), requested[3])
+	assert.Equal(t, address(2+syncGapLimit), requested[len(requested)-1])
+
+	_, err = MockWallet.AccountsSync(nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	// Needs the seed
+	MockWallet.EncryptWallet(wallet, "password")
+	_, err = MockWallet.AccountsSync(wallet)
+	assert.ErrorIs(t, err, ErrWalletLocked)
+}
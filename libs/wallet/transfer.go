@@ -0,0 +1,81 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+var ErrSameWallet = errors.New("source and destination wallets are the same")
+
+// Move everything in source to an account of destination, both wallets have to be unlocked
+// Every source account receives what's pending and sends its whole balance, then the destination account receives the sends
+// Pippin has the keys of both, so the destination doesn't wait for anybody to receive
+// Returns the blocks that were published, also when it fails part way
+func (w *NanoWallet) CrossWalletTransfer(source *ent.Wallet, destination *ent.Wallet, destinationAccount string, bpowKey *string) (*models.CrossWalletTransfer, error) {
+	if source == nil || destination == nil {
+		return nil, ErrInvalidWallet
+	} else if source.ID == destination.ID {
+		return nil, ErrSameWallet
+	} else if source.FrozenAt != nil || destination.FrozenAt != nil {
+		return nil, ErrWalletFrozen
+	}
+
+	// Destination must be in its wallet, this also fails if the wallet is locked
+	destinationAcc, err := w.GetAccount(destination, destinationAccount)
+	if err != nil {
+		return nil, err
+	}
+	accounts, _, err := w.AccountsList(source, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := &models.CrossWalletTransfer{
+		SourceReceives:      []string{},
+		Sends:               []string{},
+		DestinationReceives: []string{},
+	}
+	for _, acc := range accounts {
+		// Nothing can be sent from them
+		if acc.WatchOnly {
+			continue
+		}
+		receiveHashes, sendHash, err := w.sweepAccount(source, acc, destinationAcc.Address, bpowKey, true)
+		transfer.SourceReceives = append(transfer.SourceReceives, receiveHashes...)
+		if sendHash != "" {
+			transfer.Sends = append(transfer.Sends, sendHash)
+		}
+		if err != nil {
+			return transfer, err
+		}
+	}
+	if len(transfer.Sends) == 0 {
+		return transfer, nil
+	}
+
+	// Obtain lock
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("acl:%s", destinationAcc.Address), time.Second*300, &database.LockRetryStrategy)
+	if err != nil {
+		return transfer, database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	// Only the sends of this transfer, whatever else is pending stays
+	for _, sendHash := range transfer.Sends {
+		receivedHash, err := w.publishReceive(destination, destinationAcc, sendHash, nil, bpowKey, 0)
+		if err == nil && receivedHash == "" {
+			err = errors.New("Unable to publish receive block")
+		}
+		if err != nil {
+			return transfer, err
+		}
+		transfer.DestinationReceives = append(transfer.DestinationReceives, receivedHash)
+	}
+
+	return transfer, nil
+}
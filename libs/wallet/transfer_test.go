@@ -0,0 +1,161 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrossWalletTransfer(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// The mocked node always returns the same frontier, so don't cache the ones we publish
+	conf := *MockWallet.Config
+	conf.Wallet.FrontierCacheSize = 0
+	transferWallet := &NanoWallet{
+		DB:         MockWallet.DB,
+		Ctx:        MockWallet.Ctx,
+		RpcClient:  MockWallet.RpcClient,
+		WorkClient: MockWallet.WorkClient,
+		Config:     &conf,
+	}
+
+	sourceSeed, _ := utils.GenerateSeed(strings.NewReader("7d0a3c6f9b2e5d8a1c4f7b0e3d6a9c2f5b8e1d4a7c0f3b6e9d2a5c8f1b4e7d0a"))
+	source, err := transferWallet.WalletCreate(sourceSeed)
+	assert.Nil(t, err)
+	sourceAccounts, _, err := transferWallet.AccountsList(source, 0)
+	assert.Nil(t, err)
+	// Never opened, nothing to move
+	_, err = transferWallet.AccountCreate(source, nil)
+	assert.Nil(t, err)
+	destinationSeed, _ := utils.GenerateSeed(strings.NewReader("c4f7b0e3d6a9c2f5b8e1d4a7c0f3b6e9d2a5c8f1b4e7d0a3c6f9b2e5d8a1c4f7"))
+	destination, err := transferWallet.WalletCreate(destinationSeed)
+	assert.Nil(t, err)
+	destinationAccounts, _, err := transferWallet.AccountsList(destination, 0)
+	assert.Nil(t, err)
+	sourceAcc := sourceAccounts[0].Address
+	destinationAcc := destinationAccounts[0].Address
+
+	// A small ledger, the pow client only has work for this frontier
+	frontier := "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3"
+	balances := map[string]*big.Int{
+		sourceAcc:      big.NewInt(5),
+		destinationAcc: big.NewInt(7),
+	}
+	pendingAmount, _ := big.NewInt(0).SetString("30000000000000000000000000000000000", 10)
+	amounts := map[string]*big.Int{"FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE": pendingAmount}
+	pending := map[string][]string{sourceAcc: {"FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE"}}
+	var published []nanoblock.StateBlock
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "receivable":
+				account := pr["account"].(string)
+				if len(pending[account]) == 0 {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": ""})
+				}
+				blocks := map[string]interface{}{}
+				for _, hash := range pending[account] {
+					blocks[hash] = amounts[hash].String()
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": blocks})
+			case "block_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"amount":   amounts[pr["hash"].(string)].String(),
+					"subtype":  "send",
+					"contents": map[string]interface{}{},
+				})
+			case "account_info":
+				balance, ok := balances[pr["account"].(string)]
+				if !ok {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Account not found"})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       frontier,
+					"balance":        balance.String(),
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			case "process":
+				var sb nanoblock.StateBlock
+				block, _ := json.Marshal(pr["block"])
+				json.Unmarshal(block, &sb)
+				published = append(published, sb)
+				hash := fmt.Sprintf("%064X", len(published))
+				newBalance, _ := big.NewInt(0).SetString(sb.Balance, 10)
+				if pr["subtype"] == "send" {
+					linkPub, _ := hex.DecodeString(sb.Link)
+					to := utils.PubKeyToAddress(linkPub, false)
+					amounts[hash] = big.NewInt(0).Sub(balances[sb.Account], newBalance)
+					pending[to] = append(pending[to], hash)
+				} else {
+					pending[sb.Account] = nil
+				}
+				balances[sb.Account] = newBalance
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": hash,
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	// errors
+	_, err = transferWallet.CrossWalletTransfer(nil, destination, destinationAcc, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+	_, err = transferWallet.CrossWalletTransfer(destination, destination, destinationAcc, nil)
+	assert.ErrorIs(t, err, ErrSameWallet)
+	_, err = transferWallet.CrossWalletTransfer(source, destination, sourceAcc, nil)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+	assert.Len(t, published, 0)
+
+	transfer, err := transferWallet.CrossWalletTransfer(source, destination, destinationAcc, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{fmt.Sprintf("%064X", 1)}, transfer.SourceReceives)
+	assert.Equal(t, []string{fmt.Sprintf("%064X", 2)}, transfer.Sends)
+	assert.Equal(t, []string{fmt.Sprintf("%064X", 3)}, transfer.DestinationReceives)
+	assert.Len(t, published, 3)
+
+	// The source receives what was pending, then sends everything
+	sourcePub, _ := utils.AddressToPub(sourceAcc, false)
+	destinationPub, _ := utils.AddressToPub(destinationAcc, false)
+	assert.Equal(t, sourceAcc, published[0].Account)
+	assert.Equal(t, "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE", published[0].Link)
+	assert.Equal(t, sourceAcc, published[1].Account)
+	assert.Equal(t, "0", published[1].Balance)
+	assert.Equal(t, hex.EncodeToString(destinationPub), published[1].Link)
+
+	// The destination receives the send right away
+	receive := published[2]
+	assert.Equal(t, destinationAcc, receive.Account)
+	assert.Equal(t, fmt.Sprintf("%064X", 2), receive.Link)
+	assert.Equal(t, "30000000000000000000000000000000012", receive.Balance)
+	hash := receive.Hash()
+	sig, _ := hex.DecodeString(receive.Signature)
+	assert.True(t, ed25519.Verify(ed25519.PublicKey(destinationPub), hash[:], sig))
+	hash = published[1].Hash()
+	sig, _ = hex.DecodeString(published[1].Signature)
+	assert.True(t, ed25519.Verify(ed25519.PublicKey(sourcePub), hash[:], sig))
+
+	// Nothing left to move
+	transfer, err = transferWallet.CrossWalletTransfer(source, destination, destinationAcc, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, transfer.SourceReceives)
+	assert.Empty(t, transfer.Sends)
+	assert.Empty(t, transfer.DestinationReceives)
+	assert.Len(t, published, 3)
+}
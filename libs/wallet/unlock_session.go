@@ -0,0 +1,138 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	entwallet "github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+)
+
+// Unlocked wallets lock themselves after auto_lock_minutes without a request for them
+// An unlock with an API key is a session of that key, other keys find the wallet locked until they unlock it too
+// The decrypted keys are shared, sessions only decide who can use them. Unlocks without a key, like the CLI's, aren't sessions,
+// a wallet without sessions is unlocked for everyone like before
+// Sessions are in the <wallet>:sessions hash, with when each one was last used
+
+func unlockSessionsKey(wallet *ent.Wallet) string {
+	return fmt.Sprintf("%s:sessions", wallet.ID.String())
+}
+
+// 0 if wallets aren't locked automatically
+func (w *NanoWallet) autoLockAfter() time.Duration {
+	if w.Config == nil {
+		return 0
+	}
+	return time.Duration(w.Config.Wallet.AutoLockMinutes) * time.Minute
+}
+
+// Start the auto lock countdown of the wallet's keys and sessions over
+func (w *NanoWallet) refreshAutoLock(wallet *ent.Wallet) error {
+	after := w.autoLockAfter()
+	if after <= 0 {
+		return nil
+	}
+	if _, err := database.GetRedisDB().Expire(wallet.ID.String(), after); err != nil {
+		return err
+	}
+	_, err := database.GetRedisDB().Expire(unlockSessionsKey(wallet), after)
+	return err
+}
+
+// Unlock the wallet for session, an API key ID, or for everyone if it's empty
+func (w *NanoWallet) UnlockWalletSession(wallet *ent.Wallet, password string, session string) (bool, error) {
+	unlocked, err := w.UnlockWallet(wallet, password)
+	if err != nil || !unlocked || session == "" {
+		return unlocked, err
+	}
+	if err := database.GetRedisDB().Hset(unlockSessionsKey(wallet), session, time.Now().Unix()); err != nil {
+		return false, err
+	}
+	return true, w.refreshAutoLock(wallet)
+}
+
+// Whether session can use the wallet's keys, the wallet is unlocked and has no sessions or one for session
+// Without a session it's whether the wallet is unlocked at all
+func (w *NanoWallet) SessionUnlocked(wallet *ent.Wallet, session string) (bool, error) {
+	if _, err := GetDecryptedKeyFromStorage(wallet, "seed"); errors.Is(err, ErrWalletLocked) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	if !wallet.Encrypted || session == "" {
+		return true, nil
+	}
+	sessions, err := database.GetRedisDB().Hgetall(unlockSessionsKey(wallet))
+	if err != nil {
+		return false, err
+	} else if len(sessions) == 0 {
+		return true, nil
+	}
+	lastUsed, ok := sessions[session]
+	if !ok {
+		return false, nil
+	}
+	// The wallet can be in use by other sessions, but this one is over
+	if after := w.autoLockAfter(); after > 0 {
+		unix, _ := strconv.ParseInt(lastUsed, 10, 64)
+		if time.Since(time.Unix(unix, 0)) > after {
+			return false, w.LockWalletSession(wallet, session)
+		}
+	}
+	return true, nil
+}
+
+// A request of session for the wallet, it starts the auto lock countdown over
+func (w *NanoWallet) TouchWalletSession(wallet *ent.Wallet, session string) error {
+	if session != "" {
+		if _, err := database.GetRedisDB().Hget(unlockSessionsKey(wallet), session); err == nil {
+			if err := database.GetRedisDB().Hset(unlockSessionsKey(wallet), session, time.Now().Unix()); err != nil {
+				return err
+			}
+		}
+	}
+	return w.refreshAutoLock(wallet)
+}
+
+// End session, the wallet is locked once no sessions are left
+// Without a session the wallet is locked for everyone, like LockWallet
+func (w *NanoWallet) LockWalletSession(wallet *ent.Wallet, session string) error {
+	if session == "" {
+		return w.LockWallet(wallet)
+	} else if wallet == nil {
+		return ErrInvalidWallet
+	} else if !wallet.Encrypted {
+		return ErrWalletNotLocked
+	}
+	if err := database.GetRedisDB().Hdel(unlockSessionsKey(wallet), session); err != nil {
+		return err
+	}
+	left, err := database.GetRedisDB().Hlen(unlockSessionsKey(wallet))
+	if err != nil {
+		return err
+	} else if left == 0 {
+		return w.LockWallet(wallet)
+	}
+	return nil
+}
+
+// Lock every encrypted wallet and end all their sessions, returns how many were unlocked
+func (w *NanoWallet) LockAllWallets() (int, error) {
+	wallets, err := w.DB.Wallet.Query().Where(entwallet.Encrypted(true)).All(w.Ctx)
+	if err != nil {
+		return 0, err
+	}
+	locked := 0
+	for _, dbWallet := range wallets {
+		if _, err := GetDecryptedKeyFromStorage(dbWallet, "seed"); err == nil {
+			locked++
+		}
+		if err := w.LockWallet(dbWallet); err != nil {
+			return locked, err
+		}
+	}
+	return locked, nil
+}
@@ -0,0 +1,138 @@
+package wallet
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnlockSessions(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("3a7c1e9b5d2f8a4c6e0b2d4f6a8c1e3b5d7f9a2c4e6b8d0f1a3c5e7b9d2f4a6c"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+
+	// Locked for everyone
+	unlocked, err := MockWallet.SessionUnlocked(wallet, "")
+	assert.Nil(t, err)
+	assert.False(t, unlocked)
+
+	// Unlocked by one key, still locked for the others
+	unlocked, err = MockWallet.UnlockWalletSession(wallet, "password", "key1")
+	assert.Nil(t, err)
+	assert.True(t, unlocked)
+	unlocked, _ = MockWallet.SessionUnlocked(wallet, "key1")
+	assert.True(t, unlocked)
+	unlocked, _ = MockWallet.SessionUnlocked(wallet, "key2")
+	assert.False(t, unlocked)
+	// Requests without a key aren't sessions
+	unlocked, _ = MockWallet.SessionUnlocked(wallet, "")
+	assert.True(t, unlocked)
+	unlocked, err = MockWallet.UnlockWalletSession(wallet, "wrong", "key2")
+	assert.NotNil(t, err)
+	assert.False(t, unlocked)
+	unlocked, _ = MockWallet.SessionUnlocked(wallet, "key2")
+	assert.False(t, unlocked)
+
+	// Locking ends the session, the wallet locks with the last one
+	_, err = MockWallet.UnlockWalletSession(wallet, "password", "key2")
+	assert.Nil(t, err)
+	assert.Nil(t, MockWallet.LockWalletSession(wallet, "key1"))
+	unlocked, _ = MockWallet.SessionUnlocked(wallet, "key1")
+	assert.False(t, unlocked)
+	unlocked, _ = MockWallet.SessionUnlocked(wallet, "key2")
+	assert.True(t, unlocked)
+	assert.Nil(t, MockWallet.LockWalletSession(wallet, "key2"))
+	unlocked, _ = MockWallet.SessionUnlocked(wallet, "")
+	assert.False(t, unlocked)
+
+	// Unlocked without a key it's unlocked for every key
+	_, err = MockWallet.UnlockWallet(wallet, "password")
+	assert.Nil(t, err)
+	unlocked, _ = MockWallet.SessionUnlocked(wallet, "key1")
+	assert.True(t, unlocked)
+	assert.Nil(t, MockWallet.LockWalletSession(wallet, ""))
+
+	// Not encrypted
+	plain, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	unlocked, _ = MockWallet.SessionUnlocked(plain, "key1")
+	assert.True(t, unlocked)
+	assert.ErrorIs(t, MockWallet.LockWalletSession(plain, "key1"), ErrWalletNotLocked)
+}
+
+func TestAutoLock(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("8d2f4a6c1e3b5d7f9a0c2e4b6d8f1a3c5e7b9d0f2a4c6e8b1d3f5a7c9e2b4d6f"))
+	conf := *MockWallet.Config
+	conf.Wallet.AutoLockMinutes = 5
+	lockingWallet := &NanoWallet{
+		DB:     MockWallet.DB,
+		Ctx:    MockWallet.Ctx,
+		Config: &conf,
+	}
+	wallet, err := lockingWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	_, err = lockingWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+
+	// The keys expire
+	_, err = lockingWallet.UnlockWalletSession(wallet, "password", "key1")
+	assert.Nil(t, err)
+	redisDB := database.GetRedisDB()
+	ttl, _ := redisDB.Client.TTL(lockingWallet.Ctx, redisDB.Key(wallet.ID.String())).Result()
+	assert.Equal(t, 5*time.Minute, ttl)
+	ttl, _ = redisDB.Client.TTL(lockingWallet.Ctx, redisDB.Key(unlockSessionsKey(wallet))).Result()
+	assert.Equal(t, 5*time.Minute, ttl)
+
+	// A session that wasn't used is over
+	assert.Nil(t, redisDB.Hset(unlockSessionsKey(wallet), "key2", time.Now().Add(-10*time.Minute).Unix()))
+	unlocked, err := lockingWallet.SessionUnlocked(wallet, "key2")
+	assert.Nil(t, err)
+	assert.False(t, unlocked)
+	unlocked, _ = lockingWallet.SessionUnlocked(wallet, "key1")
+	assert.True(t, unlocked)
+
+	// Using it keeps it going
+	assert.Nil(t, redisDB.Hset(unlockSessionsKey(wallet), "key1", time.Now().Add(-4*time.Minute).Unix()))
+	assert.Nil(t, lockingWallet.TouchWalletSession(wallet, "key1"))
+	lastUsed, _ := redisDB.Hget(unlockSessionsKey(wallet), "key1")
+	assert.Equal(t, strconv.FormatInt(time.Now().Unix(), 10), lastUsed)
+	// Only sessions it has
+	assert.Nil(t, lockingWallet.TouchWalletSession(wallet, "key3"))
+	unlocked, _ = lockingWallet.SessionUnlocked(wallet, "key3")
+	assert.False(t, unlocked)
+
+	// The last session running out locks the wallet
+	assert.Nil(t, redisDB.Hset(unlockSessionsKey(wallet), "key1", time.Now().Add(-10*time.Minute).Unix()))
+	unlocked, _ = lockingWallet.SessionUnlocked(wallet, "key1")
+	assert.False(t, unlocked)
+	unlocked, _ = lockingWallet.SessionUnlocked(wallet, "")
+	assert.False(t, unlocked)
+}
+
+func TestLockAllWallets(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("5c7e9a1b3d5f7a9c2e4b6d8f0a1c3e5b7d9f2a4c6e8b0d1f3a5c7e9b2d4f6a8c"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+	_, err = MockWallet.UnlockWalletSession(wallet, "password", "key1")
+	assert.Nil(t, err)
+
+	locked, err := MockWallet.LockAllWallets()
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, locked, 1)
+	unlocked, _ := MockWallet.SessionUnlocked(wallet, "key1")
+	assert.False(t, unlocked)
+	sessions, _ := database.GetRedisDB().Hgetall(unlockSessionsKey(wallet))
+	assert.Len(t, sessions, 0)
+	locked, err = MockWallet.LockAllWallets()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, locked)
+}
@@ -0,0 +1,51 @@
+package wallet
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+// How long account_create_vanity searches without a timeout, and the longest it can be given
+const (
+	VanityDefaultTimeout = time.Minute
+	VanityMaxTimeout     = 10 * time.Minute
+)
+
+// An account created from a vanity key, after Attempts keys were tried
+type VanityAccount struct {
+	Account  *ent.Account
+	Attempts uint64
+}
+
+// The most goroutines a vanity search uses, and what it uses by default, more than the CPUs only slows it down
+func VanityMaxWorkers() int {
+	return runtime.NumCPU()
+}
+
+// Search for a key with an address matching pattern and add it to the wallet as an adhoc account
+// utils.ErrVanityNotFound if there's none before timeout
+func (w *NanoWallet) AccountCreateVanity(wallet *ent.Wallet, pattern utils.VanityPattern, workers int, timeout time.Duration) (*VanityAccount, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	// Don't search for nothing, AdhocAccountCreate checks again
+	if _, err := GetDecryptedKeyFromStorage(wallet, "seed"); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(w.Ctx, timeout)
+	defer cancel()
+	found, err := utils.VanitySearch(ctx, pattern, w.Banano, min(workers, VanityMaxWorkers()))
+	if err != nil {
+		return nil, err
+	}
+	acc, err := w.AdhocAccountCreate(wallet, found.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &VanityAccount{Account: acc, Attempts: found.Attempts}, nil
+}
@@ -0,0 +1,37 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountCreateVanity(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("8e3b6f1a4d7c0e9b2f5a8d1c4e7b0a3f6d9c2e5b8a1f4d7c0b3e6a9d2f5c8b1e"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+
+	pattern, err := utils.NewVanityPattern("1x", "", false)
+	assert.Nil(t, err)
+	vanity, err := MockWallet.AccountCreateVanity(wallet, pattern, 2, VanityDefaultTimeout)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(vanity.Account.Address, "nano_1x"))
+	assert.NotZero(t, vanity.Attempts)
+	// It's an adhoc account with its key
+	assert.Nil(t, vanity.Account.AccountIndex)
+	assert.NotNil(t, vanity.Account.PrivateKey)
+	acc, err := MockWallet.GetAccount(wallet, vanity.Account.Address)
+	assert.Nil(t, err)
+	assert.Equal(t, vanity.Account.ID, acc.ID)
+
+	pattern, err = utils.NewVanityPattern("1pippinpippin", "", false)
+	assert.Nil(t, err)
+	_, err = MockWallet.AccountCreateVanity(wallet, pattern, 1, 20*time.Millisecond)
+	assert.ErrorIs(t, err, utils.ErrVanityNotFound)
+
+	_, err = MockWallet.AccountCreateVanity(nil, pattern, 1, time.Second)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+}
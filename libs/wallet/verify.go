@@ -0,0 +1,138 @@
+package wallet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"runtime"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/nano"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"golang.org/x/sync/errgroup"
+)
+
+// Reasons an account fails verification
+const (
+	MismatchInvalidAddress = "invalid_address"
+	MismatchInvalidKey     = "invalid_key"
+	MismatchAddress        = "address_mismatch"
+)
+
+// Check that every account's address is derived from the keys stored with it
+// Accounts of the wallet seed are derived at account_index, accounts of another seed at seed_index of that seed
+// and adhoc accounts from their private key. Accounts without a key only get their address format checked
+// Encrypted keys are only available while unlocked, a locked wallet gets the format checks only
+func (w *NanoWallet) WalletVerify(wallet *ent.Wallet) (*models.WalletVerification, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+
+	locked := false
+	seed, err := GetDecryptedKeyFromStorage(wallet, "seed")
+	if errors.Is(err, ErrWalletLocked) {
+		locked = true
+	} else if err != nil {
+		return nil, err
+	}
+
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Order(ent.Asc(account.FieldCreatedAt)).All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deriving keys is CPU bound, so verify as many accounts at once as there are CPUs
+	mismatches := make([]*models.AccountMismatch, len(accounts))
+	var g errgroup.Group
+	g.SetLimit(runtime.NumCPU())
+	for i, acct := range accounts {
+		g.Go(func() error {
+			mismatch, err := w.verifyAccount(wallet, acct, seed, locked)
+			mismatches[i] = mismatch
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	verification := &models.WalletVerification{
+		Mismatches: []models.AccountMismatch{},
+		Locked:     locked,
+	}
+	for _, mismatch := range mismatches {
+		if mismatch != nil {
+			verification.Mismatches = append(verification.Mismatches, *mismatch)
+		}
+	}
+	return verification, nil
+}
+
+// nil if the account is valid
+func (w *NanoWallet) verifyAccount(wallet *ent.Wallet, acct *ent.Account, seed string, locked bool) (*models.AccountMismatch, error) {
+	storedPub, err := nano.ValidateAddress(acct.Address, w.Banano)
+	if err != nil {
+		return &models.AccountMismatch{Account: acct.Address, Reason: MismatchInvalidAddress}, nil
+	}
+	if locked {
+		return nil, nil
+	}
+
+	var pub ed25519.PublicKey
+	if acct.Seed != nil && acct.SeedIndex != nil {
+		acctSeed, err := storedAccountKey(wallet, accountSeedKey(acct.Address), *acct.Seed)
+		if err != nil {
+			return nil, err
+		}
+		if !utils.Validate64HexHash(acctSeed) || *acct.SeedIndex < 0 {
+			return &models.AccountMismatch{Account: acct.Address, Reason: MismatchInvalidKey}, nil
+		}
+		pub, _, err = utils.KeypairFromSeed(acctSeed, uint32(*acct.SeedIndex))
+		if err != nil {
+			return nil, err
+		}
+	} else if acct.PrivateKey != nil {
+		privateKey, err := storedAccountKey(wallet, acct.Address, *acct.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		decoded, err := hex.DecodeString(privateKey)
+		if err != nil || len(decoded) != ed25519.PrivateKeySize {
+			return &models.AccountMismatch{Account: acct.Address, Reason: MismatchInvalidKey}, nil
+		}
+		// Derive it again from the seed half rather than trusting the stored public half
+		priv, err := ed25519.NewKeyFromSeed(ed25519.PrivateKey(decoded).Seed())
+		if err != nil {
+			return nil, err
+		}
+		pub = priv.Public().(ed25519.PublicKey)
+	} else if acct.AccountIndex != nil {
+		if *acct.AccountIndex < 0 {
+			return &models.AccountMismatch{Account: acct.Address, Reason: MismatchInvalidKey}, nil
+		}
+		pub, _, err = utils.KeypairFromSeed(seed, uint32(*acct.AccountIndex))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Nothing to derive it from
+		return nil, nil
+	}
+
+	if !bytes.Equal(pub, storedPub) {
+		expected := utils.PubKeyToAddress(pub, w.Banano)
+		return &models.AccountMismatch{Account: acct.Address, Reason: MismatchAddress, Expected: &expected}, nil
+	}
+	return nil, nil
+}
+
+// Keys of adhoc accounts are in the database unless the wallet is encrypted, then they are in storage while unlocked
+func storedAccountKey(wallet *ent.Wallet, key string, stored string) (string, error) {
+	if !wallet.Encrypted {
+		return stored, nil
+	}
+	return GetDecryptedKeyFromStorage(wallet, key)
+}
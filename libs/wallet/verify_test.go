@@ -0,0 +1,75 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletVerify(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("7c1e4a9d2f5b8e0c3a6d9f1b4e7c0a3d6f9b2e5c8a1d4f7b0e3c6a9d2f5b8e0c"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	_, err = MockWallet.AccountsCreate(wallet, 3)
+	assert.Nil(t, err)
+	_, priv, _ := ed25519.GenerateKey(strings.NewReader("3c6a9d2f5b8e0c7c1e4a9d2f5b8e0c3a6d9f1b4e7c0a3d6f9b2e5c8a1d4f7b0e"))
+	_, err = MockWallet.AdhocAccountCreate(wallet, priv)
+	assert.Nil(t, err)
+	otherSeed, _ := utils.GenerateSeed(strings.NewReader("e0c3a6d9f1b4e7c0a3d6f9b2e5c8a1d4f7b0e3c6a9d2f5b8e0c7c1e4a9d2f5b8"))
+	_, err = MockWallet.AccountCreateFromSeed(wallet, otherSeed, nil)
+	assert.Nil(t, err)
+
+	_, err = MockWallet.WalletVerify(nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	verification, err := MockWallet.WalletVerify(wallet)
+	assert.Nil(t, err)
+	assert.False(t, verification.Locked)
+	assert.Len(t, verification.Mismatches, 0)
+
+	// Store the address of another seed at index 2 and break the format of index 3
+	index2, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndex(2)).Only(MockWallet.Ctx)
+	assert.Nil(t, err)
+	index3, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndex(3)).Only(MockWallet.Ctx)
+	assert.Nil(t, err)
+	strangerPub, _, _ := utils.KeypairFromSeed(otherSeed, 7)
+	stranger := utils.PubKeyToAddress(strangerPub, false)
+	_, err = MockWallet.DB.Account.UpdateOne(index2).SetAddress(stranger).Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+	broken := index3.Address[:len(index3.Address)-1] + "1"
+	_, err = MockWallet.DB.Account.UpdateOne(index3).SetAddress(broken).Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+	// Without a key there's only the format to check
+	_, err = MockWallet.DB.Account.Create().SetWallet(wallet).SetAddress("nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5").Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+
+	expected := []models.AccountMismatch{
+		{Account: stranger, Reason: MismatchAddress, Expected: &index2.Address},
+		{Account: broken, Reason: MismatchInvalidAddress},
+	}
+	verification, err = MockWallet.WalletVerify(wallet)
+	assert.Nil(t, err)
+	assert.False(t, verification.Locked)
+	assert.ElementsMatch(t, expected, verification.Mismatches)
+
+	// Locked, the keys can't be derived
+	_, err = MockWallet.EncryptWallet(wallet, "mypassword")
+	assert.Nil(t, err)
+	verification, err = MockWallet.WalletVerify(wallet)
+	assert.Nil(t, err)
+	assert.True(t, verification.Locked)
+	assert.Equal(t, []models.AccountMismatch{{Account: broken, Reason: MismatchInvalidAddress}}, verification.Mismatches)
+
+	// Unlocked, the encrypted keys are derived from storage
+	_, err = MockWallet.UnlockWallet(wallet, "mypassword")
+	assert.Nil(t, err)
+	verification, err = MockWallet.WalletVerify(wallet)
+	assert.Nil(t, err)
+	assert.False(t, verification.Locked)
+	assert.ElementsMatch(t, expected, verification.Mismatches)
+}
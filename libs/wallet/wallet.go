@@ -4,17 +4,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	config "github.com/appditto/pippin_nano_wallet/libs/config/models"
 	"github.com/appditto/pippin_nano_wallet/libs/database"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	entwallet "github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
 	"github.com/appditto/pippin_nano_wallet/libs/pow"
 	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
 	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
 )
 
 type NanoWallet struct {
@@ -24,6 +28,65 @@ type NanoWallet struct {
 	WorkClient *pow.PippinPow
 	Config     *config.PippinConfig
 	Banano     bool
+	// Key for signing callbacks, they aren't signed if it's empty
+	WebhookSecret string
+	// What hardware wallets sign with, it's opened from ledger_device if it isn't set
+	Ledger *Ledger
+
+	frontierCache     *frontierCache
+	frontierCacheOnce sync.Once
+	events            *eventHub
+	eventHubOnce      sync.Once
+	ledgerOnce        sync.Once
+	shutdownState     *shutdownState
+	shutdownOnce      sync.Once
+	// The config a reload applied, see ApplyConfig
+	live atomic.Pointer[config.PippinConfig]
+	// The wallet WithContext was called on, its caches, events and shutdown are used
+	parent *NanoWallet
+}
+
+// The same wallet for a request, the node and work peers get the request ID of ctx and so do the logs
+// It shares the caches, events and shutdown of w. It isn't cancelled with ctx, a send can't stop halfway
+func (w *NanoWallet) WithContext(ctx context.Context) *NanoWallet {
+	ctx = context.WithoutCancel(ctx)
+	root := w.root()
+	rpcClient := root.RpcClient
+	if rpcClient != nil {
+		rpcClient = rpcClient.WithContext(ctx)
+	}
+	return &NanoWallet{
+		DB:            root.DB,
+		Ctx:           ctx,
+		RpcClient:     rpcClient,
+		WorkClient:    root.WorkClient,
+		Config:        root.Config,
+		Banano:        root.Banano,
+		WebhookSecret: root.WebhookSecret,
+		Ledger:        root.Ledger,
+		parent:        root,
+	}
+}
+
+func (w *NanoWallet) root() *NanoWallet {
+	if w.parent != nil {
+		return w.parent
+	}
+	return w
+}
+
+// Apply a reloaded config, safe to call while the wallet is in use
+// receive_minimum, callback_url and callback_retries are read from it from then on, everything else still comes from Config
+func (w *NanoWallet) ApplyConfig(conf *config.PippinConfig) {
+	w.root().live.Store(conf)
+}
+
+// The last config ApplyConfig was called with, or Config
+func (w *NanoWallet) liveConfig() *config.PippinConfig {
+	if conf := w.root().live.Load(); conf != nil {
+		return conf
+	}
+	return w.Config
 }
 
 var ErrInvalidSeed = errors.New("invalid seed")
@@ -32,6 +95,11 @@ var ErrInvalidAccount = errors.New("invalid account")
 var ErrInvalidPrivKey = errors.New("invalid private key")
 var ErrInvalidAccountCount = errors.New("invalid count")
 var ErrWalletNotFound = errors.New("wallet not found")
+var ErrInvalidPagination = errors.New("invalid offset or limit")
+var ErrInvalidWalletName = errors.New("invalid name")
+var ErrWalletWatchOnly = errors.New("wallet is watch-only")
+var ErrNoPrivateKey = errors.New("no_private_key")
+var ErrWalletFrozen = errors.New("wallet is frozen")
 
 // Retrieves wallet
 func (w *NanoWallet) GetWallet(walletID string) (*ent.Wallet, error) {
@@ -58,6 +126,67 @@ func (w *NanoWallet) GetWallets() ([]*ent.Wallet, error) {
 	return wallets, nil
 }
 
+// Retrieve a page of wallets in creation order, along with how many accounts each has
+// Destroyed wallets are deleted with their accounts, there's no soft delete to filter out
+func (w *NanoWallet) WalletList(offset int, limit int) ([]*models.WalletSummary, error) {
+	if offset < 0 || limit < 1 {
+		return nil, ErrInvalidPagination
+	}
+
+	wallets, err := w.DB.Wallet.Query().Order(ent.Asc(entwallet.FieldCreatedAt), ent.Asc(entwallet.FieldID)).Offset(offset).Limit(limit).All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(wallets))
+	for i, wlt := range wallets {
+		ids[i] = wlt.ID
+	}
+
+	// Count accounts with an aggregate, rather than loading every account
+	var counts []struct {
+		WalletID uuid.UUID `json:"wallet_id"`
+		Count    int       `json:"count"`
+	}
+	err = w.DB.Account.Query().Where(account.WalletIDIn(ids...)).GroupBy(account.FieldWalletID).Aggregate(ent.Count()).Scan(w.Ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+	countMap := make(map[uuid.UUID]int, len(counts))
+	for _, c := range counts {
+		countMap[c.WalletID] = c.Count
+	}
+
+	summaries := make([]*models.WalletSummary, len(wallets))
+	for i, wlt := range wallets {
+		summaries[i] = &models.WalletSummary{
+			ID:           wlt.ID,
+			Name:         wlt.Name,
+			AccountCount: countMap[wlt.ID],
+			WatchOnly:    wlt.WatchOnly,
+			CreatedAt:    wlt.CreatedAt,
+		}
+	}
+
+	return summaries, nil
+}
+
+// Set the name wallet_list shows for a wallet, an empty name removes it
+func (w *NanoWallet) WalletNameSet(wallet *ent.Wallet, name string) (*ent.Wallet, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if len(name) > 128 {
+		return nil, ErrInvalidWalletName
+	}
+	update := w.DB.Wallet.UpdateOne(wallet)
+	if name == "" {
+		update.ClearName()
+	} else {
+		update.SetName(name)
+	}
+	return update.Save(w.Ctx)
+}
+
 // Creates a new wallet with provided seed
 func (w *NanoWallet) WalletCreate(seed string) (*ent.Wallet, error) {
 	if !utils.Validate64HexHash(seed) {
@@ -97,6 +226,101 @@ func (w *NanoWallet) WalletCreate(seed string) (*ent.Wallet, error) {
 	return wallet, nil
 }
 
+// Creates a new wallet with provided seed and its first count accounts, from index 0
+// Nothing is created unless all of it is
+func (w *NanoWallet) WalletCreateFromSeed(seed string, count int, name *string) (*ent.Wallet, []*ent.Account, error) {
+	if !utils.Validate64HexHash(seed) {
+		return nil, nil, ErrInvalidSeed
+	} else if count < 1 {
+		return nil, nil, ErrInvalidAccountCount
+	} else if name != nil && (*name == "" || len(*name) > 128) {
+		return nil, nil, ErrInvalidWalletName
+	}
+
+	tx, err := w.DB.Tx(w.Ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	wallet, err := tx.Wallet.Create().SetSeed(seed).SetNillableName(name).Save(w.Ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+	accounts := make([]*ent.Account, count)
+	for index := 0; index < count; index++ {
+		pub, _, err := utils.KeypairFromSeed(seed, uint32(index))
+		if err != nil {
+			tx.Rollback()
+			return nil, nil, err
+		}
+		accounts[index], err = tx.Account.Create().SetWallet(wallet).SetAccountIndex(index).SetAddress(utils.PubKeyToAddress(pub, w.Banano)).Save(w.Ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, nil, err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return wallet, accounts, nil
+}
+
+// Creates a watch-only wallet with an account for every address, its balances and history can be queried but it can't sign
+// Addresses are stored as nano_ (or ban_) addresses, one that's there twice gets one account
+func (w *NanoWallet) WalletCreateWatchOnly(addresses []string, name *string) (*ent.Wallet, []*ent.Account, error) {
+	if len(addresses) < 1 || len(addresses) > w.Config.Server.WatchOnlyMaxAccounts {
+		return nil, nil, ErrInvalidAccountCount
+	} else if name != nil && (*name == "" || len(*name) > 128) {
+		return nil, nil, ErrInvalidWalletName
+	}
+	normalized := make([]string, 0, len(addresses))
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		pub, err := utils.AddressToPub(address, w.Banano)
+		if err != nil {
+			return nil, nil, ErrInvalidAccount
+		}
+		address = utils.PubKeyToAddress(pub, w.Banano)
+		if !seen[address] {
+			seen[address] = true
+			normalized = append(normalized, address)
+		}
+	}
+
+	tx, err := w.DB.Tx(w.Ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	// There's no seed, the wallet's ID keeps the unique seed column unique
+	id := uuid.New()
+	wallet, err := tx.Wallet.Create().SetID(id).SetSeed(watchOnlySeed(id)).SetWatchOnly(true).SetNillableName(name).Save(w.Ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, nil, err
+	}
+	accounts := make([]*ent.Account, len(normalized))
+	for i, address := range normalized {
+		accounts[i], err = tx.Account.Create().SetWallet(wallet).SetAddress(address).Save(w.Ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, nil, err
+		}
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return wallet, accounts, nil
+}
+
+// What's stored as the seed of a watch-only wallet, it's never returned as one
+func watchOnlySeed(id uuid.UUID) string {
+	return "watch_only:" + id.String()
+}
+
 func (w *NanoWallet) WalletDestroy(wallet *ent.Wallet) error {
 	if wallet == nil {
 		return ErrInvalidWallet
@@ -123,7 +347,7 @@ func (w *NanoWallet) WalletInfo(wallet *ent.Wallet) (*models.WalletInfo, error)
 	}
 
 	// Obtain a lock, prevent concurrent calls
-	lock, err := database.GetRedisDB().Locker.Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
 	if err != nil {
 		return nil, database.ErrLockNotObtained
 	}
@@ -163,33 +387,100 @@ func (w *NanoWallet) WalletInfo(wallet *ent.Wallet) (*models.WalletInfo, error)
 	}, nil
 }
 
-func (w *NanoWallet) WalletRepresentativeSet(wallet *ent.Wallet, representative string, changeExisting bool, bpowKey *string) error {
+// Set the representative new accounts of the wallet are opened with
+// With changeExisting change blocks are also published for the accounts that already exist, like AccountsRepresentativeSet, and their results are returned
+func (w *NanoWallet) WalletRepresentativeSet(wallet *ent.Wallet, representative string, changeExisting bool, bpowKey *string) (*models.RepresentativeChanges, error) {
 	if wallet == nil {
-		return ErrInvalidWallet
+		return nil, ErrInvalidWallet
+	} else if changeExisting && wallet.FrozenAt != nil {
+		return nil, ErrWalletFrozen
+	}
+
+	// Nothing is set if the accounts can't be changed
+	if changeExisting {
+		if _, err := GetDecryptedKeyFromStorage(wallet, "seed"); err != nil {
+			return nil, err
+		}
 	}
 
 	// Update wallet with representative
 	wallet, err := wallet.Update().SetRepresentative(representative).Save(w.Ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !changeExisting {
-		return nil
+		return nil, nil
+	}
+	return w.AccountsRepresentativeSet(wallet, representative, bpowKey)
+}
+
+// Publish change blocks for every account in the wallet that doesn't already have representative
+// Accounts are changed concurrently, up to representative_change_concurrency at once, each one holds its account lock while it's changed
+// If some accounts fail the others are still changed, the failed ones are returned in Failed, watch-only accounts aren't in any of them
+func (w *NanoWallet) AccountsRepresentativeSet(wallet *ent.Wallet, representative string, bpowKey *string) (*models.RepresentativeChanges, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if wallet.FrozenAt != nil {
+		return nil, ErrWalletFrozen
 	}
 
-	// Create and publish change blocks for every account on the wallet.
-	_, addresses, err := w.AccountsList(wallet, 0)
+	accounts, _, err := w.AccountsList(wallet, 0)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	for _, address := range addresses {
-		_, err := w.CreateAndPublishChangeBlock(wallet, address, representative, nil, bpowKey, true)
-		if err != nil && !errors.Is(err, ErrSameRepresentative) && !errors.Is(err, nanorpc.ErrAccountNotFound) {
-			return err
+	addresses := SigningAddresses(accounts)
+
+	hashes := make([]string, len(addresses))
+	errs := make([]error, len(addresses))
+	var g errgroup.Group
+	g.SetLimit(max(w.Config.Wallet.RepresentativeChangeConcurrency, 1))
+	for i, address := range addresses {
+		g.Go(func() error {
+			hashes[i], errs[i] = w.CreateAndPublishChangeBlock(wallet, address, representative, nil, bpowKey, true)
+			return nil
+		})
+	}
+	g.Wait()
+
+	changes := &models.RepresentativeChanges{
+		Changed: []models.RepresentativeChange{},
+		Skipped: []string{},
+		Failed:  []string{},
+	}
+	for i, address := range addresses {
+		if errors.Is(errs[i], ErrSameRepresentative) || errors.Is(errs[i], nanorpc.ErrAccountNotFound) {
+			changes.Skipped = append(changes.Skipped, address)
+		} else if errs[i] != nil || hashes[i] == "" {
+			changes.Failed = append(changes.Failed, address)
+		} else {
+			changes.Changed = append(changes.Changed, models.RepresentativeChange{
+				Account:   address,
+				BlockHash: hashes[i],
+			})
 		}
 	}
-	return nil
+
+	return changes, nil
+}
+
+// Freeze the wallet, nothing can be signed for it until it's unfrozen
+// A wallet that's already frozen keeps the time it was first frozen at
+func (w *NanoWallet) WalletFreeze(wallet *ent.Wallet) (*ent.Wallet, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if wallet.FrozenAt != nil {
+		return wallet, nil
+	}
+	return w.DB.Wallet.UpdateOne(wallet).SetFrozenAt(time.Now()).Save(w.Ctx)
+}
+
+// Unfreeze the wallet so it can sign again
+func (w *NanoWallet) WalletUnfreeze(wallet *ent.Wallet) (*ent.Wallet, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	return w.DB.Wallet.UpdateOne(wallet).ClearFrozenAt().Save(w.Ctx)
 }
 
 // Change the seed of the wallet, will decrypt it if encrypted
@@ -215,6 +506,12 @@ func (w *NanoWallet) WalletChangeSeed(wallet *ent.Wallet, newSeed string) (*ent.
 		}
 	}
 
+	// Accounts created after this are derived from the new seed too
+	_, err = w.DB.Wallet.UpdateOneID(wallet.ID).SetSeed(newSeed).Save(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Loop all accounts, update their address with new derived address
 	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndexNotNil()).All(w.Ctx)
 	if err != nil {
@@ -0,0 +1,146 @@
+package wallet
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
+	rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+)
+
+// Blocks per account_history call of WalletHistory
+const walletHistoryPageSize = 100
+
+// A send or receive of one of the wallet's accounts, block_account is that account
+type WalletHistoryEntry struct {
+	BlockAccount string
+	rpcresponses.AccountHistoryEntry
+}
+
+// Criteria for WalletHistory, nil fields aren't filtered on
+type WalletHistoryFilter struct {
+	// Only this account of the wallet
+	Account *string
+	// send or receive
+	Direction *string
+	// Start at this block, like account_history's head, it has to be a block of one of the wallet's accounts
+	Head *string
+}
+
+// Whether a comes before b in the wallet history, newest first
+// Blocks with the same local_timestamp are ordered by account, then by height
+func walletHistoryBefore(a WalletHistoryEntry, b WalletHistoryEntry) bool {
+	aTime, _ := strconv.ParseInt(a.LocalTimestamp, 10, 64)
+	bTime, _ := strconv.ParseInt(b.LocalTimestamp, 10, 64)
+	if aTime != bTime {
+		return aTime > bTime
+	}
+	if a.BlockAccount != b.BlockAccount {
+		return a.BlockAccount < b.BlockAccount
+	}
+	aHeight, _ := strconv.ParseInt(a.Height, 10, 64)
+	bHeight, _ := strconv.ParseInt(b.Height, 10, 64)
+	return aHeight > bHeight
+}
+
+// The sends and receives of every account in the wallet merged into one history, newest first by local_timestamp
+// Skips offset matching blocks and returns at most count, every account's chain is read from the frontier until it has offset+count matching blocks, at most maxDepth blocks per account
+// A chain's local_timestamps are assumed to only go up with its height, which is true unless the node bootstrapped the blocks out of order
+func (w *NanoWallet) WalletHistory(wallet *ent.Wallet, filter WalletHistoryFilter, offset int, count int, maxDepth int) ([]WalletHistoryEntry, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	if filter.Direction != nil && *filter.Direction != "send" && *filter.Direction != "receive" {
+		return nil, ErrInvalidDirection
+	}
+
+	// Fails if the wallet is locked
+	var addresses []string
+	if filter.Account != nil {
+		acc, err := w.GetAccount(wallet, *filter.Account)
+		if err != nil {
+			return nil, err
+		}
+		addresses = []string{acc.Address}
+	} else {
+		_, list, err := w.AccountsList(wallet, 0)
+		if err != nil {
+			return nil, err
+		}
+		addresses = list
+	}
+
+	// The other accounts only have blocks that come after head in the merged history
+	var head *WalletHistoryEntry
+	if filter.Head != nil {
+		info, err := w.RpcClient.MakeBlockInfoRequest(*filter.Head)
+		if errors.Is(err, nanorpc.ErrBlockNotFound) {
+			return nil, ErrBlockNotFound
+		} else if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(addresses, info.BlockAccount) {
+			return nil, ErrBlockNotFound
+		}
+		head = &WalletHistoryEntry{
+			BlockAccount: info.BlockAccount,
+			AccountHistoryEntry: rpcresponses.AccountHistoryEntry{
+				LocalTimestamp: info.LocalTimestamp,
+				Height:         info.Height,
+			},
+		}
+	}
+
+	wanted := offset + count
+	history := []WalletHistoryEntry{}
+	for _, address := range addresses {
+		var accountHead *string
+		if head != nil && head.BlockAccount == address {
+			accountHead = filter.Head
+		}
+		matched := 0
+		read := 0
+		for matched < wanted && read < maxDepth {
+			resp, err := w.RpcClient.MakeAccountHistoryRequest(address, min(walletHistoryPageSize, maxDepth-read), accountHead)
+			if err != nil {
+				return nil, err
+			}
+			for _, entry := range resp.History {
+				walletEntry := WalletHistoryEntry{BlockAccount: address, AccountHistoryEntry: entry}
+				if filter.Direction != nil && entry.Type != *filter.Direction {
+					continue
+				}
+				if head != nil && head.BlockAccount != address && !walletHistoryBefore(*head, walletEntry) {
+					continue
+				}
+				history = append(history, walletEntry)
+				matched++
+				if matched >= wanted {
+					break
+				}
+			}
+			read += len(resp.History)
+			// No previous means this page ended at the open block
+			if resp.Previous == "" || len(resp.History) == 0 {
+				break
+			}
+			accountHead = &resp.Previous
+		}
+	}
+
+	slices.SortStableFunc(history, func(a WalletHistoryEntry, b WalletHistoryEntry) int {
+		if walletHistoryBefore(a, b) {
+			return -1
+		} else if walletHistoryBefore(b, a) {
+			return 1
+		}
+		return strings.Compare(a.Hash, b.Hash)
+	})
+	if offset >= len(history) {
+		return []WalletHistoryEntry{}, nil
+	}
+	return history[offset:min(wanted, len(history))], nil
+}
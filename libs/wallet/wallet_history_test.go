@@ -0,0 +1,157 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletHistory(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("4e7b0d3a6c9f2e5b8d1a4c7f0e3b6d9a2c5f8e1b4d7a0c3f6e9b2d5a8c1f4e7b"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	first, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	second, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	// The first account has blocks at 10, 20, ... 50 seconds, the second at 15, 25, 35
+	// Odd heights are receives and even heights are sends
+	chains := map[string][]int{
+		first.Address:  {10, 20, 30, 40, 50},
+		second.Address: {15, 25, 35},
+	}
+	hash := func(address string, height int) string {
+		prefix := "A"
+		if address == second.Address {
+			prefix = "B"
+		}
+		return fmt.Sprintf("%s%063X", prefix, height)
+	}
+	var requested []requests.AccountHistoryRequest
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "block_info" {
+				for address, times := range chains {
+					for height := range times {
+						if hash(address, height+1) == pr["hash"] {
+							return httpmock.NewJsonResponse(200, map[string]interface{}{
+								"block_account":   address,
+								"height":          fmt.Sprint(height + 1),
+								"local_timestamp": fmt.Sprint(times[height]),
+							})
+						}
+					}
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Block not found"})
+			}
+			encoded, _ := json.Marshal(pr)
+			var hr requests.AccountHistoryRequest
+			json.Unmarshal(encoded, &hr)
+			requested = append(requested, hr)
+			times := chains[hr.Account]
+			height := len(times)
+			if hr.Head != nil {
+				fmt.Sscanf((*hr.Head)[1:], "%X", &height)
+			}
+			history := []interface{}{}
+			for ; height > 0 && len(history) < hr.Count; height-- {
+				blockType := "receive"
+				if height%2 == 0 {
+					blockType = "send"
+				}
+				history = append(history, map[string]interface{}{
+					"type":            blockType,
+					"account":         "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est",
+					"amount":          "1",
+					"local_timestamp": fmt.Sprint(times[height-1]),
+					"height":          fmt.Sprint(height),
+					"hash":            hash(hr.Account, height),
+					"confirmed":       "true",
+				})
+			}
+			resp := map[string]interface{}{"account": hr.Account, "history": history}
+			if height > 0 {
+				resp["previous"] = hash(hr.Account, height)
+			}
+			return httpmock.NewJsonResponse(200, resp)
+		},
+	)
+
+	// Both chains merged, newest first
+	history, err := MockWallet.WalletHistory(wallet, WalletHistoryFilter{}, 0, 100, 1000)
+	assert.Nil(t, err)
+	assert.Len(t, history, 8)
+	var order []string
+	for _, entry := range history {
+		order = append(order, entry.LocalTimestamp)
+	}
+	assert.Equal(t, []string{"50", "40", "35", "30", "25", "20", "15", "10"}, order)
+	assert.Equal(t, first.Address, history[0].BlockAccount)
+	assert.Equal(t, hash(first.Address, 5), history[0].Hash)
+	assert.Equal(t, second.Address, history[2].BlockAccount)
+
+	// Pages
+	history, err = MockWallet.WalletHistory(wallet, WalletHistoryFilter{}, 2, 3, 1000)
+	assert.Nil(t, err)
+	assert.Len(t, history, 3)
+	assert.Equal(t, "35", history[0].LocalTimestamp)
+	assert.Equal(t, "25", history[2].LocalTimestamp)
+	history, err = MockWallet.WalletHistory(wallet, WalletHistoryFilter{}, 10, 3, 1000)
+	assert.Nil(t, err)
+	assert.Empty(t, history)
+
+	// Each chain is read page by page, at most max depth blocks, the wallet's first account has no blocks
+	requested = nil
+	history, err = MockWallet.WalletHistory(wallet, WalletHistoryFilter{}, 0, 100, 2)
+	assert.Nil(t, err)
+	assert.Len(t, history, 4)
+	assert.Equal(t, "50", history[0].LocalTimestamp)
+	assert.Equal(t, "25", history[3].LocalTimestamp)
+	assert.Len(t, requested, 3)
+	assert.Equal(t, 2, requested[1].Count)
+
+	// Only sends of one account
+	direction := "send"
+	history, err = MockWallet.WalletHistory(wallet, WalletHistoryFilter{Account: &first.Address, Direction: &direction}, 0, 100, 1000)
+	assert.Nil(t, err)
+	assert.Len(t, history, 2)
+	assert.Equal(t, "40", history[0].LocalTimestamp)
+	assert.Equal(t, "20", history[1].LocalTimestamp)
+
+	// Starting at a block of the first account, the second account's newer blocks are left out
+	head := hash(first.Address, 3)
+	history, err = MockWallet.WalletHistory(wallet, WalletHistoryFilter{Head: &head}, 0, 100, 1000)
+	assert.Nil(t, err)
+	order = nil
+	for _, entry := range history {
+		order = append(order, entry.LocalTimestamp)
+	}
+	assert.Equal(t, []string{"30", "25", "20", "15", "10"}, order)
+
+	// Head has to be a block of the wallet
+	head = "80392607E85E73CC3E94B4126F24488EBDFEB174944B890C97E8F36D89591DC5"
+	_, err = MockWallet.WalletHistory(wallet, WalletHistoryFilter{Head: &head}, 0, 100, 1000)
+	assert.ErrorIs(t, err, ErrBlockNotFound)
+
+	direction = "sideways"
+	_, err = MockWallet.WalletHistory(wallet, WalletHistoryFilter{Direction: &direction}, 0, 100, 1000)
+	assert.ErrorIs(t, err, ErrInvalidDirection)
+	other := "nano_1ipx847tk8o46pwxt5qjdbncjqcbwcc1rrmqnkztrfjy5k7z4imsrata9est"
+	_, err = MockWallet.WalletHistory(wallet, WalletHistoryFilter{Account: &other}, 0, 100, 1000)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+	_, err = MockWallet.WalletHistory(nil, WalletHistoryFilter{}, 0, 100, 1000)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+}
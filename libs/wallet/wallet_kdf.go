@@ -0,0 +1,77 @@
+package wallet
+
+import (
+	"strings"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+// Encrypted wallets derive their key from the password with Argon2id, with the parameters and salt in the wallet's kdf
+// Wallets encrypted before that have no kdf and use the password's SHA-256, they're upgraded the next time they're unlocked
+
+// Argon2id parameters from kdf_memory, kdf_iterations and kdf_parallelism, with a new salt
+func (w *NanoWallet) newKdfParams() (*utils.KdfParams, error) {
+	memory, iterations, parallelism := w.kdfCost()
+	return utils.NewKdfParams(memory, iterations, parallelism)
+}
+
+func (w *NanoWallet) kdfCost() (uint32, uint32, uint8) {
+	return uint32(w.Config.Wallet.KdfMemory), uint32(w.Config.Wallet.KdfIterations), uint8(w.Config.Wallet.KdfParallelism)
+}
+
+// The current kdf without a salt, like argon2id$m=19456,t=2,p=1
+func (w *NanoWallet) kdfName() string {
+	memory, iterations, parallelism := w.kdfCost()
+	return strings.TrimSuffix((&utils.KdfParams{Memory: memory, Iterations: iterations, Parallelism: parallelism}).String(), "$")
+}
+
+// Whether an encrypted wallet's key is derived with SHA-256 or other Argon2id parameters than the config's
+func (w *NanoWallet) KdfOutdated(wallet *ent.Wallet) bool {
+	if !wallet.Encrypted {
+		return false
+	} else if wallet.Kdf == nil {
+		return true
+	}
+	params, err := utils.ParseKdfParams(*wallet.Kdf)
+	if err != nil {
+		return true
+	}
+	return !params.SameCost(w.kdfCost())
+}
+
+// How the key of every encrypted wallet is derived, or only of the given wallet
+func (w *NanoWallet) WalletKdfInfo(dbWallet *ent.Wallet) ([]models.WalletKdf, error) {
+	wallets := []*ent.Wallet{dbWallet}
+	if dbWallet == nil {
+		var err error
+		wallets, err = w.DB.Wallet.Query().Where(wallet.Encrypted(true)).Order(ent.Asc(wallet.FieldCreatedAt)).All(w.Ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	infos := []models.WalletKdf{}
+	for _, wlt := range wallets {
+		info := models.WalletKdf{
+			WalletID:  wlt.ID,
+			Encrypted: wlt.Encrypted,
+			Outdated:  w.KdfOutdated(wlt),
+		}
+		if wlt.Encrypted {
+			info.Algorithm = "sha256"
+			if wlt.Kdf != nil {
+				if params, err := utils.ParseKdfParams(*wlt.Kdf); err == nil {
+					info.Algorithm = "argon2id"
+					info.Memory = params.Memory
+					info.Iterations = params.Iterations
+					info.Parallelism = params.Parallelism
+				}
+			}
+		}
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
@@ -0,0 +1,119 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKdfUpgrade(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("7c1e9a3f5b2d8c4e6a0f1b3d5c7e9a2b4d6f8a1c3e5b7d9f0a2c4e6b8d1f3a5c"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	_, priv, _ := ed25519.GenerateKey(strings.NewReader("9e4b2d7f1a6c3e8b5d0f2a4c6e8b1d3f5a7c9e2b4d6f8a0c3e5b7d9f1a4c6e8b"))
+	adhoc, err := MockWallet.AdhocAccountCreate(wallet, priv)
+	assert.Nil(t, err)
+
+	// Encrypted the way it was before Argon2id
+	legacy := utils.NewAesCrypt("mypassword")
+	encryptedSeed, _ := legacy.Encrypt(seed)
+	encryptedKey, _ := legacy.Encrypt(*adhoc.PrivateKey)
+	wallet, err = MockWallet.DB.Wallet.UpdateOne(wallet).SetEncrypted(true).SetSeed(encryptedSeed).Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+	_, err = MockWallet.DB.Account.UpdateOne(adhoc).SetPrivateKey(encryptedKey).Save(MockWallet.Ctx)
+	assert.Nil(t, err)
+
+	infos, err := MockWallet.WalletKdfInfo(wallet)
+	assert.Nil(t, err)
+	assert.Len(t, infos, 1)
+	assert.Equal(t, wallet.ID, infos[0].WalletID)
+	assert.True(t, infos[0].Encrypted)
+	assert.Equal(t, "sha256", infos[0].Algorithm)
+	assert.True(t, infos[0].Outdated)
+
+	// Unlocking it upgrades it
+	unlocked, err := MockWallet.UnlockWallet(wallet, "mypassword")
+	assert.Nil(t, err)
+	assert.True(t, unlocked)
+	assert.True(t, strings.HasPrefix(*wallet.Kdf, "argon2id$m=19456,t=2,p=1$"))
+	infos, _ = MockWallet.WalletKdfInfo(nil)
+	var upgraded bool
+	for _, info := range infos {
+		if info.WalletID == wallet.ID {
+			upgraded = true
+			assert.Equal(t, "argon2id", info.Algorithm)
+			assert.Equal(t, uint32(19456), info.Memory)
+			assert.Equal(t, uint32(2), info.Iterations)
+			assert.Equal(t, uint8(1), info.Parallelism)
+			assert.False(t, info.Outdated)
+		}
+	}
+	assert.True(t, upgraded)
+
+	// The old key doesn't work anymore, the password still does
+	_, err = legacy.Decrypt(wallet.Seed)
+	assert.NotNil(t, err)
+	assert.Nil(t, MockWallet.LockWallet(wallet))
+	_, err = MockWallet.UnlockWallet(wallet, "hunter2")
+	assert.ErrorIs(t, err, ErrBadPassword)
+	_, err = MockWallet.UnlockWallet(wallet, "mypassword")
+	assert.Nil(t, err)
+	key, err := GetDecryptedKeyFromStorage(wallet, adhoc.Address)
+	assert.Nil(t, err)
+	assert.Equal(t, *adhoc.PrivateKey, key)
+	storedSeed, _ := GetDecryptedKeyFromStorage(wallet, "seed")
+	assert.Equal(t, seed, storedSeed)
+
+	// Stronger parameters in the config upgrade it again
+	conf := *MockWallet.Config
+	conf.Wallet.KdfMemory = 32768
+	strongerWallet := &NanoWallet{
+		DB:     MockWallet.DB,
+		Ctx:    MockWallet.Ctx,
+		Config: &conf,
+	}
+	assert.True(t, strongerWallet.KdfOutdated(wallet))
+	_, err = strongerWallet.UnlockWallet(wallet, "mypassword")
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(*wallet.Kdf, "argon2id$m=32768,t=2,p=1$"))
+	assert.False(t, strongerWallet.KdfOutdated(wallet))
+	assert.True(t, MockWallet.KdfOutdated(wallet))
+}
+
+func TestPasswordChangeWhileEncrypted(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("3a8f6c1e4b9d2f7a5c0e8b3d6f1a9c4e7b2d5f8a0c3e6b9d1f4a7c2e5b8d0f3a"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	_, priv, _ := ed25519.GenerateKey(strings.NewReader("5d2a8f4c1e7b3d9a6f0c2e5b8d1a4f7c3e6b9d2a5f8c1e4b7d0a3f6c9e2b5d8a"))
+	adhoc, err := MockWallet.AdhocAccountCreate(wallet, priv)
+	assert.Nil(t, err)
+
+	_, err = MockWallet.EncryptWallet(wallet, "mypassword")
+	assert.Nil(t, err)
+	_, err = MockWallet.UnlockWallet(wallet, "mypassword")
+	assert.Nil(t, err)
+	// The adhoc keys are encrypted again from their decrypted keys, not the encrypted ones
+	_, err = MockWallet.EncryptWallet(wallet, "newpassword")
+	assert.Nil(t, err)
+	assert.Nil(t, MockWallet.LockWallet(wallet))
+	_, err = MockWallet.UnlockWallet(wallet, "mypassword")
+	assert.ErrorIs(t, err, ErrBadPassword)
+	_, err = MockWallet.UnlockWallet(wallet, "newpassword")
+	assert.Nil(t, err)
+	key, err := GetDecryptedKeyFromStorage(wallet, adhoc.Address)
+	assert.Nil(t, err)
+	assert.Equal(t, *adhoc.PrivateKey, key)
+
+	// Removing the password removes the kdf
+	_, err = MockWallet.EncryptWallet(wallet, "")
+	assert.Nil(t, err)
+	assert.Nil(t, wallet.Kdf)
+	infos, err := MockWallet.WalletKdfInfo(wallet)
+	assert.Nil(t, err)
+	assert.False(t, infos[0].Encrypted)
+	assert.Equal(t, "", infos[0].Algorithm)
+	assert.False(t, infos[0].Outdated)
+}
@@ -0,0 +1,52 @@
+package wallet
+
+import (
+	"strconv"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	rpcresponses "github.com/appditto/pippin_nano_wallet/libs/rpc/models/responses"
+)
+
+// Accounts per accounts_info call
+const walletLedgerBatchSize = 1000
+
+// The node's accounts_info of every opened account of a wallet, like the node's wallet_ledger
+// Accounts modified before modifiedSince are left out, 0 includes them all
+func (w *NanoWallet) WalletLedger(wallet *ent.Wallet, representative bool, weight bool, pending bool, modifiedSince int64) (map[string]rpcresponses.AccountInfoResponse, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	// Fails if the wallet is locked
+	if _, err := GetDecryptedKeyFromStorage(wallet, "seed"); err != nil {
+		return nil, err
+	}
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Order(ent.Asc(account.FieldCreatedAt)).All(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger := map[string]rpcresponses.AccountInfoResponse{}
+	for start := 0; start < len(accounts); start += walletLedgerBatchSize {
+		batch := accounts[start:min(start+walletLedgerBatchSize, len(accounts))]
+		addresses := make([]string, len(batch))
+		for i, acct := range batch {
+			addresses[i] = acct.Address
+		}
+		infosResp, err := w.RpcClient.MakeAccountsInfoRequest(addresses, representative, weight, pending)
+		if err != nil {
+			return nil, err
+		}
+		// Unopened accounts are in the errors
+		for address, info := range *infosResp.Infos {
+			if modifiedSince > 0 {
+				modified, err := strconv.ParseInt(info.ModifiedTimestamp, 10, 64)
+				if err == nil && modified < modifiedSince {
+					continue
+				}
+			}
+			ledger[address] = info
+		}
+	}
+	return ledger, nil
+}
@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletLedger(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("7b2e5a8d1c4f7b0e3a6d9c2f5b8e1a4d7c0f3b6e9a2d5c8f1b4e7a0d3c6f9b2e"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	created, err := MockWallet.AccountsCreate(wallet, 2)
+	assert.Nil(t, err)
+	pub, _, _ := utils.KeypairFromSeed(seed, 0)
+	opened := utils.PubKeyToAddress(pub, false)
+	old := created[0].Address
+	unopened := created[1].Address
+
+	rep := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	var lastRequest requests.AccountsInfoRequest
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			lastRequest = requests.AccountsInfoRequest{}
+			json.NewDecoder(req.Body).Decode(&lastRequest)
+			if lastRequest.Action != "accounts_info" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+			}
+			info := func(modified string) map[string]interface{} {
+				resp := map[string]interface{}{
+					"frontier":             "791AF413173EEE674A6FCF633B5DFC0F3C33F397F0DA08E987D9E0741D40D81A",
+					"open_block":           "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948",
+					"representative_block": "991CF190094C00F0B68E2E5F75F6BEE95A2E0BD93CEAA4A6734DB9F19B728948",
+					"balance":              "1000",
+					"modified_timestamp":   modified,
+					"block_count":          "3",
+				}
+				if lastRequest.Representative != nil {
+					resp["representative"] = rep
+				}
+				return resp
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"infos":  map[string]interface{}{opened: info("1700000000"), old: info("1500000000")},
+				"errors": map[string]string{unopened: "Account not found"},
+			})
+		},
+	)
+
+	_, err = MockWallet.WalletLedger(nil, false, false, false, 0)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	// Unopened accounts are left out
+	ledger, err := MockWallet.WalletLedger(wallet, false, false, false, 0)
+	assert.Nil(t, err)
+	assert.Len(t, ledger, 2)
+	assert.ElementsMatch(t, []string{opened, old, unopened}, lastRequest.Accounts)
+	assert.Nil(t, lastRequest.Representative)
+	assert.Equal(t, "1000", ledger[opened].Balance)
+	assert.Equal(t, "3", ledger[opened].BlockCount)
+	assert.Equal(t, "", ledger[opened].Representative)
+
+	ledger, err = MockWallet.WalletLedger(wallet, true, true, false, 1600000000)
+	assert.Nil(t, err)
+	assert.True(t, *lastRequest.Representative)
+	assert.True(t, *lastRequest.Weight)
+	assert.Nil(t, lastRequest.Pending)
+	assert.Len(t, ledger, 1)
+	assert.Equal(t, rep, ledger[opened].Representative)
+
+	// Locked wallets
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+	assert.Nil(t, MockWallet.LockWallet(wallet))
+	_, err = MockWallet.WalletLedger(wallet, false, false, false, 0)
+	assert.ErrorIs(t, err, ErrWalletLocked)
+}
@@ -0,0 +1,128 @@
+package wallet
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/walletsnapshot"
+	"github.com/google/uuid"
+)
+
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+var ErrInvalidSnapshotLabel = errors.New("invalid label")
+
+// Wallet snapshots, the balance of every account in a wallet at one point in time, e.g. for an accounting cutoff
+// They're taken on request, with an optional label, and never change once they're taken
+
+// Longest label of a snapshot
+const snapshotLabelMaxLen = 128
+
+// Record the balance of every account in the wallet, from one accounts_balances call
+// The balances are stored in balance_snapshots with the periodic ones, so they're in the balance history too
+func (w *NanoWallet) SnapshotBalances(wallet *ent.Wallet, label *string, now time.Time) (*ent.WalletSnapshot, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	if label != nil && *label == "" {
+		label = nil
+	}
+	if label != nil && len(*label) > snapshotLabelMaxLen {
+		return nil, ErrInvalidSnapshotLabel
+	}
+
+	// Fails if the wallet is locked
+	accounts, addresses, err := w.AccountsList(wallet, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accounts the node doesn't have a balance for are unopened, they're recorded with a balance of 0
+	balances := map[string]string{}
+	if len(accounts) > 0 {
+		balancesResp, err := w.RpcClient.MakeAccountsBalancesRequest(addresses)
+		if err != nil {
+			return nil, err
+		}
+		if balancesResp.Balances != nil {
+			for address, item := range *balancesResp.Balances {
+				balances[address] = item.Balance
+			}
+		}
+	}
+
+	total := big.NewInt(0)
+	for _, acct := range accounts {
+		balance, ok := big.NewInt(0).SetString(balances[acct.Address], 10)
+		if !ok {
+			balance = big.NewInt(0)
+		}
+		balances[acct.Address] = balance.String()
+		total.Add(total, balance)
+	}
+
+	tx, err := w.DB.Tx(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := tx.WalletSnapshot.Create().
+		SetWalletID(wallet.ID).
+		SetNillableLabel(label).
+		SetTotalRaw(total.String()).
+		SetAccountCount(len(accounts)).
+		SetCreatedAt(now).
+		Save(w.Ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if len(accounts) > 0 {
+		creates := make([]*ent.BalanceSnapshotCreate, len(accounts))
+		for i, acct := range accounts {
+			creates[i] = tx.BalanceSnapshot.Create().SetAccountID(acct.ID).SetBalanceRaw(balances[acct.Address]).SetSnapshotAt(now).SetWalletSnapshotID(snapshot.ID)
+		}
+		if _, err := tx.BalanceSnapshot.CreateBulk(creates...).Save(w.Ctx); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// Every snapshot of the wallet, newest first, without their balances
+func (w *NanoWallet) WalletSnapshots(wallet *ent.Wallet) ([]*ent.WalletSnapshot, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	return w.DB.WalletSnapshot.Query().Where(walletsnapshot.WalletID(wallet.ID)).Order(ent.Desc(walletsnapshot.FieldCreatedAt)).All(w.Ctx)
+}
+
+// A snapshot of the wallet with its balances and their accounts loaded, if the wallet isn't locked
+func (w *NanoWallet) GetWalletSnapshot(wallet *ent.Wallet, snapshotID string) (*ent.WalletSnapshot, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+	if _, err := GetDecryptedKeyFromStorage(wallet, "seed"); err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(snapshotID)
+	if err != nil {
+		return nil, ErrSnapshotNotFound
+	}
+
+	snapshot, err := w.DB.WalletSnapshot.Query().
+		Where(walletsnapshot.ID(id), walletsnapshot.WalletID(wallet.ID)).
+		WithBalances(func(q *ent.BalanceSnapshotQuery) {
+			q.WithAccount()
+		}).
+		Only(w.Ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrSnapshotNotFound
+	}
+	return snapshot, err
+}
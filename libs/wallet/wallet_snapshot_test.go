@@ -0,0 +1,113 @@
+package wallet
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotBalances(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("c2f5a8d1b4e7c0f3a6d9b2e5c8f1a4d7b0e3c6f9a2d5b8e1c4f7a0d3b6e9c2f5"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	opened, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	unopened, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+
+	balance := "1000"
+	calls := 0
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			var ar requests.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&ar)
+			resp := map[string]interface{}{}
+			for _, account := range ar.Accounts {
+				if account != unopened.Address {
+					resp[account] = map[string]interface{}{"balance": balance, "pending": "5", "receivable": "5"}
+				}
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"balances": resp,
+			})
+		},
+	)
+
+	// 3 accounts, the one at index 0 is created with the wallet
+	label := "2023"
+	first, err := MockWallet.SnapshotBalances(wallet, &label, time.Unix(1700000000, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, "2023", *first.Label)
+	assert.Equal(t, "2000", first.TotalRaw)
+	assert.Equal(t, 3, first.AccountCount)
+
+	// Balances change, a new snapshot doesn't touch the first one
+	balance = "1"
+	empty := ""
+	second, err := MockWallet.SnapshotBalances(wallet, &empty, time.Unix(1700003600, 0))
+	assert.Nil(t, err)
+	assert.Nil(t, second.Label)
+	assert.Equal(t, "2", second.TotalRaw)
+
+	snapshot, err := MockWallet.GetWalletSnapshot(wallet, first.ID.String())
+	assert.Nil(t, err)
+	assert.Equal(t, "2000", snapshot.TotalRaw)
+	assert.True(t, time.Unix(1700000000, 0).Equal(snapshot.CreatedAt))
+	balances := map[string]string{}
+	for _, b := range snapshot.Edges.Balances {
+		balances[b.Edges.Account.Address] = b.BalanceRaw
+	}
+	assert.Len(t, balances, 3)
+	assert.Equal(t, "1000", balances[opened.Address])
+	assert.Equal(t, "0", balances[unopened.Address])
+
+	snapshots, err := MockWallet.WalletSnapshots(wallet)
+	assert.Nil(t, err)
+	assert.Len(t, snapshots, 2)
+	assert.Equal(t, second.ID, snapshots[0].ID)
+	assert.Equal(t, first.ID, snapshots[1].ID)
+
+	// They're in the balance history too
+	history, err := MockWallet.AccountBalanceHistory(wallet, opened.Address, PeriodHourly, time.Unix(1699999000, 0), time.Unix(1700010000, 0))
+	assert.Nil(t, err)
+	assert.Len(t, history, 2)
+
+	// Snapshots of another wallet aren't found
+	otherSeed, _ := utils.GenerateSeed(strings.NewReader("f5a8d1b4e7c0f3a6d9b2e5c8f1a4d7b0e3c6f9a2d5b8e1c4f7a0d3b6e9c2f5a8"))
+	other, _ := MockWallet.WalletCreate(otherSeed)
+	_, err = MockWallet.GetWalletSnapshot(other, first.ID.String())
+	assert.ErrorIs(t, err, ErrSnapshotNotFound)
+	_, err = MockWallet.GetWalletSnapshot(wallet, "1234")
+	assert.ErrorIs(t, err, ErrSnapshotNotFound)
+	snapshots, err = MockWallet.WalletSnapshots(other)
+	assert.Nil(t, err)
+	assert.Empty(t, snapshots)
+
+	long := strings.Repeat("a", 129)
+	_, err = MockWallet.SnapshotBalances(wallet, &long, time.Now())
+	assert.ErrorIs(t, err, ErrInvalidSnapshotLabel)
+	_, err = MockWallet.SnapshotBalances(nil, nil, time.Now())
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	// A locked wallet can't be snapshotted or have its snapshots read
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+	assert.Nil(t, MockWallet.LockWallet(wallet))
+	_, err = MockWallet.SnapshotBalances(wallet, nil, time.Now())
+	assert.ErrorIs(t, err, ErrWalletLocked)
+	_, err = MockWallet.GetWalletSnapshot(wallet, first.ID.String())
+	assert.ErrorIs(t, err, ErrWalletLocked)
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,118 @@
+package wallet
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+)
+
+// Accounts per accounts_balances call
+const walletSweepBatchSize = 1000
+
+// Receive everything pending on every account of a wallet, then send its whole balance to destination
+// destination doesn't have to be in the wallet, if it is it's left alone
+// With dryRun nothing is published, it only returns what would be moved
+// swept is called after each account that was swept if it isn't nil
+// Returns what was swept, also when it fails part way
+func (w *NanoWallet) WalletSweep(wallet *ent.Wallet, destination string, dryRun bool, bpowKey *string, swept func(done int, total int, sweep *models.WalletSweep)) (*models.WalletSweep, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if !dryRun && wallet.FrozenAt != nil {
+		return nil, ErrWalletFrozen
+	}
+
+	// Fails if the wallet is locked
+	dbAccounts, _, err := w.AccountsList(wallet, 0)
+	if err != nil {
+		return nil, err
+	}
+	// Nothing can be sent from watch-only accounts
+	var accounts []*ent.Account
+	for _, acc := range dbAccounts {
+		if !acc.WatchOnly && acc.Address != destination {
+			accounts = append(accounts, acc)
+		}
+	}
+
+	// Only the accounts that have something to move
+	var planned []models.WalletSweepAccount
+	var toSweep []*ent.Account
+	total := big.NewInt(0)
+	for start := 0; start < len(accounts); start += walletSweepBatchSize {
+		batch := accounts[start:min(start+walletSweepBatchSize, len(accounts))]
+		addresses := make([]string, len(batch))
+		for i, acc := range batch {
+			addresses[i] = acc.Address
+		}
+		balancesResp, err := w.RpcClient.MakeAccountsBalancesRequest(addresses)
+		if err != nil {
+			return nil, err
+		}
+		for _, acc := range batch {
+			balance, pending := big.NewInt(0), big.NewInt(0)
+			if balancesResp.Balances != nil {
+				if item, ok := (*balancesResp.Balances)[acc.Address]; ok {
+					balance.SetString(item.Balance, 10)
+					// Newer nodes call it receivable
+					if item.Receivable != "" {
+						pending.SetString(item.Receivable, 10)
+					} else {
+						pending.SetString(item.Pending, 10)
+					}
+				}
+			}
+			if balance.Sign() <= 0 && pending.Sign() <= 0 {
+				continue
+			}
+			total.Add(total, balance)
+			total.Add(total, pending)
+			planned = append(planned, models.WalletSweepAccount{
+				Address:    acc.Address,
+				BalanceRaw: balance.String(),
+				PendingRaw: pending.String(),
+				Receives:   []string{},
+			})
+			toSweep = append(toSweep, acc)
+		}
+	}
+
+	sweep := &models.WalletSweep{
+		Accounts: []models.WalletSweepAccount{},
+		TotalRaw: total.String(),
+	}
+	if dryRun {
+		sweep.Accounts = append(sweep.Accounts, planned...)
+		return sweep, nil
+	}
+
+	// Each send is held to the approval threshold and daily limit like a send, so nothing is swept unless all of it can be
+	for _, account := range planned {
+		amount, _ := big.NewInt(0).SetString(account.BalanceRaw, 10)
+		pending, _ := big.NewInt(0).SetString(account.PendingRaw, 10)
+		if w.RequiresApproval(wallet, amount.Add(amount, pending).String()) {
+			return sweep, fmt.Errorf("%w, sweeping %s", ErrApprovalRequired, account.Address)
+		}
+	}
+	remaining, err := w.DailySendRemaining(wallet)
+	if err != nil {
+		return sweep, err
+	} else if remaining != nil && total.Cmp(remaining) > 0 {
+		return sweep, fmt.Errorf("%w, %s raw left", ErrDailySendLimitExceeded, remaining)
+	}
+
+	for i, acc := range toSweep {
+		receiveHashes, sendHash, err := w.sweepAccount(wallet, acc, destination, bpowKey, true)
+		planned[i].Receives = append(planned[i].Receives, receiveHashes...)
+		planned[i].Send = sendHash
+		sweep.Accounts = append(sweep.Accounts, planned[i])
+		if err != nil {
+			return sweep, err
+		}
+		if swept != nil {
+			swept(i+1, len(toSweep), sweep)
+		}
+	}
+	return sweep, nil
+}
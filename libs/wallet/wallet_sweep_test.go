@@ -0,0 +1,173 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/wallet/models"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletSweep(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// The mocked node always returns the same frontier, so don't cache the ones we publish
+	conf := *MockWallet.Config
+	conf.Wallet.FrontierCacheSize = 0
+	sweepWallet := &NanoWallet{
+		DB:         MockWallet.DB,
+		Ctx:        MockWallet.Ctx,
+		RpcClient:  MockWallet.RpcClient,
+		WorkClient: MockWallet.WorkClient,
+		Config:     &conf,
+	}
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("9c2f5b8e1d4a7c0f3b6e9d2a5c8f1b4e7d0a3c6f9b2e5d8a1c4f7b0e3d6a9c2f"))
+	wallet, err := sweepWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	accounts, _, err := sweepWallet.AccountsList(wallet, 0)
+	assert.Nil(t, err)
+	funded := accounts[0].Address
+	// Never opened, nothing to move
+	_, err = sweepWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	// Cold storage, not in Pippin
+	destination := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+
+	// A small ledger, the pow client only has work for this frontier
+	frontier := "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3"
+	balances := map[string]*big.Int{funded: big.NewInt(5)}
+	pendingAmount, _ := big.NewInt(0).SetString("30000000000000000000000000000000000", 10)
+	pending := map[string]map[string]string{funded: {"FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE": pendingAmount.String()}}
+	var published []nanoblock.StateBlock
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			switch pr["action"] {
+			case "accounts_balances":
+				resp := map[string]interface{}{}
+				for _, account := range pr["accounts"].([]interface{}) {
+					balance, receivable := big.NewInt(0), big.NewInt(0)
+					if b, ok := balances[account.(string)]; ok {
+						balance = b
+					}
+					for _, amount := range pending[account.(string)] {
+						a, _ := big.NewInt(0).SetString(amount, 10)
+						receivable.Add(receivable, a)
+					}
+					resp[account.(string)] = map[string]string{"balance": balance.String(), "pending": receivable.String(), "receivable": receivable.String()}
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"balances": resp})
+			case "receivable":
+				blocks := pending[pr["account"].(string)]
+				if len(blocks) == 0 {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": ""})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": blocks})
+			case "block_info":
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"amount":   pendingAmount.String(),
+					"subtype":  "send",
+					"contents": map[string]interface{}{},
+				})
+			case "account_info":
+				balance, ok := balances[pr["account"].(string)]
+				if !ok {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "Account not found"})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       frontier,
+					"balance":        balance.String(),
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			case "process":
+				var sb nanoblock.StateBlock
+				block, _ := json.Marshal(pr["block"])
+				json.Unmarshal(block, &sb)
+				published = append(published, sb)
+				if pr["subtype"] != "send" {
+					pending[sb.Account] = nil
+				}
+				balances[sb.Account], _ = big.NewInt(0).SetString(sb.Balance, 10)
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", len(published)),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	_, err = sweepWallet.WalletSweep(nil, destination, false, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	// A dry run only reports what would be moved
+	sweep, err := sweepWallet.WalletSweep(wallet, destination, true, nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, published, 0)
+	assert.Equal(t, "30000000000000000000000000000000005", sweep.TotalRaw)
+	assert.Equal(t, []models.WalletSweepAccount{{Address: funded, BalanceRaw: "5", PendingRaw: pendingAmount.String(), Receives: []string{}}}, sweep.Accounts)
+
+	// Refused when it's more than what's left of the daily limit, or a send that needs approvals
+	conf.Wallet.DailySendLimit = "1000"
+	_, err = sweepWallet.WalletSweep(wallet, destination, false, nil, nil)
+	assert.ErrorIs(t, err, ErrDailySendLimitExceeded)
+	conf.Wallet.DailySendLimit = "100000000000000000000000000000000000"
+	wallet, err = sweepWallet.SetApprovalPolicy(wallet, 1, "1000")
+	assert.Nil(t, err)
+	_, err = sweepWallet.WalletSweep(wallet, destination, false, nil, nil)
+	assert.ErrorIs(t, err, ErrApprovalRequired)
+	assert.Len(t, published, 0)
+	wallet, err = sweepWallet.SetApprovalPolicy(wallet, 0, "")
+	assert.Nil(t, err)
+
+	var progress []int
+	sweep, err = sweepWallet.WalletSweep(wallet, destination, false, nil, func(done int, total int, sweep *models.WalletSweep) {
+		progress = append(progress, done, total, len(sweep.Accounts))
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 1, 1}, progress)
+	assert.Equal(t, "30000000000000000000000000000000005", sweep.TotalRaw)
+	assert.Len(t, sweep.Accounts, 1)
+	assert.Equal(t, []string{fmt.Sprintf("%064X", 1)}, sweep.Accounts[0].Receives)
+	assert.Equal(t, fmt.Sprintf("%064X", 2), sweep.Accounts[0].Send)
+
+	// Received what was pending, then sent everything to the destination
+	assert.Len(t, published, 2)
+	assert.Equal(t, "FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE", published[0].Link)
+	destinationPub, _ := utils.AddressToPub(destination, false)
+	assert.Equal(t, funded, published[1].Account)
+	assert.Equal(t, "0", published[1].Balance)
+	assert.Equal(t, hex.EncodeToString(destinationPub), published[1].Link)
+	// The send counts against the limit
+	sent, err := sweepWallet.DailySent(wallet, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, "30000000000000000000000000000000005", sent.String())
+
+	// Nothing left to move
+	sweep, err = sweepWallet.WalletSweep(wallet, destination, false, nil, nil)
+	assert.Nil(t, err)
+	assert.Empty(t, sweep.Accounts)
+	assert.Equal(t, "0", sweep.TotalRaw)
+	assert.Len(t, published, 2)
+
+	// Frozen wallets can still be looked at
+	frozen, err := sweepWallet.WalletFreeze(wallet)
+	assert.Nil(t, err)
+	_, err = sweepWallet.WalletSweep(frozen, destination, false, nil, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = sweepWallet.WalletSweep(frozen, destination, true, nil, nil)
+	assert.Nil(t, err)
+}
@@ -2,18 +2,27 @@ package wallet
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"os"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/appditto/pippin_nano_wallet/libs/config"
 	"github.com/appditto/pippin_nano_wallet/libs/database"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
 	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	entwallet "github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	nanoblock "github.com/appditto/pippin_nano_wallet/libs/nano/block"
 	"github.com/appditto/pippin_nano_wallet/libs/pow"
 	nanorpc "github.com/appditto/pippin_nano_wallet/libs/rpc"
 	"github.com/appditto/pippin_nano_wallet/libs/utils"
 	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -38,7 +47,7 @@ func testMainWrapper(m *testing.M) int {
 	defer os.RemoveAll(".testdata")
 	config, _ := config.ParsePippinConfig()
 	rpcclient := nanorpc.NewRPCClient("/mockrpcendpoint")
-	powClient := pow.NewPippinPow([]string{}, "", "", 30)
+	powClient := pow.NewPippinPow([]string{}, "", "", nil)
 	MockWallet = &NanoWallet{
 		DB:         client,
 		Ctx:        context.TODO(),
@@ -102,6 +111,56 @@ func TestGetWallets(t *testing.T) {
 	assert.Contains(t, walletIDs, wallet.ID.String())
 }
 
+func TestWalletList(t *testing.T) {
+	// Predictable seed
+	seed, _ := utils.GenerateSeed(strings.NewReader("f7c1a8261a4b2c1f1c5bd6b4b32be4b3c46f5e57a7c9a0a6e8a3b1d223b5a0c1"))
+
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	_, err = MockWallet.AccountsCreate(wallet, 2)
+	assert.Nil(t, err)
+
+	// Newest wallet is always last
+	total, err := MockWallet.DB.Wallet.Query().Count(MockWallet.Ctx)
+	assert.Nil(t, err)
+	summaries, err := MockWallet.WalletList(total-1, 10)
+	assert.Nil(t, err)
+	assert.Len(t, summaries, 1)
+	assert.Equal(t, wallet.ID, summaries[0].ID)
+	assert.Equal(t, 3, summaries[0].AccountCount)
+	assert.Nil(t, summaries[0].Name)
+
+	// Limit is respected
+	summaries, err = MockWallet.WalletList(0, 1)
+	assert.Nil(t, err)
+	assert.Len(t, summaries, 1)
+
+	// Bad input
+	_, err = MockWallet.WalletList(-1, 10)
+	assert.ErrorIs(t, err, ErrInvalidPagination)
+	_, err = MockWallet.WalletList(0, 0)
+	assert.ErrorIs(t, err, ErrInvalidPagination)
+}
+
+func TestWalletNameSet(t *testing.T) {
+	seed, _ := utils.GenerateSeed(strings.NewReader("a7d0f3b6e9c2a5d8f1b4e7c0a3d6f9b2e5c8a1d4f7b0e3c6a9d2f5b8e1c4a7d0"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+
+	named, err := MockWallet.WalletNameSet(wallet, "payroll")
+	assert.Nil(t, err)
+	assert.Equal(t, "payroll", *named.Name)
+	_, err = MockWallet.WalletNameSet(wallet, strings.Repeat("a", 129))
+	assert.ErrorIs(t, err, ErrInvalidWalletName)
+	_, err = MockWallet.WalletNameSet(nil, "payroll")
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	// Empty removes it
+	named, err = MockWallet.WalletNameSet(named, "")
+	assert.Nil(t, err)
+	assert.Nil(t, named.Name)
+}
+
 func TestWalletCreate(t *testing.T) {
 	// Predictable seed
 	seed, _ := utils.GenerateSeed(strings.NewReader("8d729340e07eee69abac049c2fdd4a3c4b50e4672a2fabdf1ae295f2b4f3040b"))
@@ -148,6 +207,94 @@ func TestWalletCreateBadInput(t *testing.T) {
 	assert.ErrorIs(t, ErrInvalidSeed, err)
 }
 
+func TestWalletCreateFromSeed(t *testing.T) {
+	// Nano test vector, the zero seed
+	seed := "0000000000000000000000000000000000000000000000000000000000000000"
+	name := "Exchange hot wallet"
+
+	// Nothing is created for bad input
+	_, _, err := MockWallet.WalletCreateFromSeed("1234", 3, nil)
+	assert.ErrorIs(t, err, ErrInvalidSeed)
+	_, _, err = MockWallet.WalletCreateFromSeed(seed, 0, nil)
+	assert.ErrorIs(t, err, ErrInvalidAccountCount)
+	_, _, err = MockWallet.WalletCreateFromSeed(seed, 3, &[]string{strings.Repeat("a", 129)}[0])
+	assert.ErrorIs(t, err, ErrInvalidWalletName)
+	exists, err := MockWallet.DB.Wallet.Query().Where(entwallet.Seed(seed)).Exist(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	created, accounts, err := MockWallet.WalletCreateFromSeed(seed, 3, &name)
+	assert.Nil(t, err)
+	assert.Equal(t, seed, created.Seed)
+	assert.Equal(t, name, *created.Name)
+	assert.Len(t, accounts, 3)
+	for i, acc := range accounts {
+		assert.Equal(t, i, *acc.AccountIndex)
+	}
+	assert.Equal(t, "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7", accounts[0].Address)
+	assert.Equal(t, "nano_3rrf6cus8pye6o1kzi5n6wwjof8bjb7ff4xcgesi3njxid6x64pms6onw1f9", accounts[1].Address)
+	assert.Equal(t, "nano_1dzcca9ycmtx3q79mocmu95zdduxptp3gp5fqkmb1ownscpweggzah8cb4rb", accounts[2].Address)
+	count, err := MockWallet.DB.Account.Query().Where(account.WalletID(created.ID)).Count(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+
+	// Same seed again
+	_, _, err = MockWallet.WalletCreateFromSeed(seed, 1, nil)
+	assert.True(t, ent.IsConstraintError(err))
+}
+
+func TestWalletCreateWatchOnly(t *testing.T) {
+	addresses := []string{
+		"nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7",
+		"xrb_3rrf6cus8pye6o1kzi5n6wwjof8bjb7ff4xcgesi3njxid6x64pms6onw1f9",
+		// Twice is one account
+		"nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7",
+	}
+
+	// Nothing is created for bad input
+	_, _, err := MockWallet.WalletCreateWatchOnly([]string{}, nil)
+	assert.ErrorIs(t, err, ErrInvalidAccountCount)
+	_, _, err = MockWallet.WalletCreateWatchOnly(make([]string, MockWallet.Config.Server.WatchOnlyMaxAccounts+1), nil)
+	assert.ErrorIs(t, err, ErrInvalidAccountCount)
+	_, _, err = MockWallet.WalletCreateWatchOnly([]string{addresses[0], "nano_1234"}, nil)
+	assert.ErrorIs(t, err, ErrInvalidAccount)
+	// Banano addresses aren't nano ones
+	_, _, err = MockWallet.WalletCreateWatchOnly([]string{"ban_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7"}, nil)
+	assert.ErrorIs(t, err, ErrInvalidAccount)
+	exists, err := MockWallet.DB.Wallet.Query().Where(entwallet.WatchOnly(true)).Exist(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.False(t, exists)
+
+	name := "Cold storage"
+	created, accounts, err := MockWallet.WalletCreateWatchOnly(addresses, &name)
+	assert.Nil(t, err)
+	assert.True(t, created.WatchOnly)
+	assert.Equal(t, name, *created.Name)
+	assert.Len(t, accounts, 2)
+	assert.Equal(t, "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7", accounts[0].Address)
+	assert.Equal(t, "nano_3rrf6cus8pye6o1kzi5n6wwjof8bjb7ff4xcgesi3njxid6x64pms6onw1f9", accounts[1].Address)
+	for _, acc := range accounts {
+		assert.Nil(t, acc.AccountIndex)
+		assert.Nil(t, acc.PrivateKey)
+	}
+
+	// It has no seed, and more than one can be created
+	seed, err := GetDecryptedKeyFromStorage(created, "seed")
+	assert.Nil(t, err)
+	assert.Equal(t, "", seed)
+	other, _, err := MockWallet.WalletCreateWatchOnly(addresses[:1], nil)
+	assert.Nil(t, err)
+	assert.NotEqual(t, created.ID, other.ID)
+
+	// Nothing can be signed or derived
+	_, err = MockWallet.AccountCreate(created, nil)
+	assert.ErrorIs(t, err, ErrWalletWatchOnly)
+	_, err = MockWallet.CreateAndPublishSendBlock(created, "1", accounts[0].Address, accounts[1].Address, nil, nil, nil)
+	assert.ErrorIs(t, err, ErrWalletWatchOnly)
+	_, err = MockWallet.CreateAndPublishChangeBlock(created, accounts[0].Address, accounts[1].Address, nil, nil, false)
+	assert.ErrorIs(t, err, ErrWalletWatchOnly)
+}
+
 func TestWalletDestroy(t *testing.T) {
 	// Create a test wallet
 	seed, _ := utils.GenerateSeed(strings.NewReader("783c75f57c76937b2bab1e0ada730d1386bacfa06258ddebfcc976b36c0e5549"))
@@ -214,13 +361,132 @@ func TestWalletRepresentativeSet(t *testing.T) {
 	wallet, err = MockWallet.GetWallet(wallet.ID.String())
 	assert.Nil(t, err)
 
-	err = MockWallet.WalletRepresentativeSet(wallet, "nano_1efa1gxbitary1urzix9h13nkzadtz71n3auyj7uztb8i4qbtipu8cxz61ee", false, nil)
+	changes, err := MockWallet.WalletRepresentativeSet(wallet, "nano_1efa1gxbitary1urzix9h13nkzadtz71n3auyj7uztb8i4qbtipu8cxz61ee", false, nil)
 	assert.Nil(t, err)
+	assert.Nil(t, changes)
 
 	// Retrieve wallet
 	wallet, err = MockWallet.GetWallet(wallet.ID.String())
 	assert.Nil(t, err)
 	assert.Equal(t, "nano_1efa1gxbitary1urzix9h13nkzadtz71n3auyj7uztb8i4qbtipu8cxz61ee", *wallet.Representative)
+
+	// The existing account gets a change block
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "account_info" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "1",
+					"representative": "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5",
+				})
+			} else if pr["action"] == "process" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": strings.Repeat("B", 64),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+	changes, err = MockWallet.WalletRepresentativeSet(wallet, "nano_1jtx5p8141zjtukz4msp1x93st7nh475f74odj8673qqm96xczmtcnanos1o", true, nil)
+	assert.Nil(t, err)
+	assert.Len(t, changes.Changed, 1)
+	assert.Equal(t, strings.Repeat("B", 64), changes.Changed[0].BlockHash)
+	assert.Empty(t, changes.Failed)
+
+	// A locked wallet keeps its representative
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+	wallet, _ = MockWallet.GetWallet(wallet.ID.String())
+	_, err = MockWallet.WalletRepresentativeSet(wallet, "nano_1efa1gxbitary1urzix9h13nkzadtz71n3auyj7uztb8i4qbtipu8cxz61ee", true, nil)
+	assert.ErrorIs(t, err, ErrWalletLocked)
+	wallet, _ = MockWallet.GetWallet(wallet.ID.String())
+	assert.Equal(t, "nano_1jtx5p8141zjtukz4msp1x93st7nh475f74odj8673qqm96xczmtcnanos1o", *wallet.Representative)
+}
+
+func TestAccountsRepresentativeSet(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("b3e6a9c2f5d8b1e4a7c0f3d6b9e2a5c8f1d4b7e0a3c6f9d2b5e8a1c4f7d0b3e6"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	accounts, err := MockWallet.AccountsCreate(wallet, 4)
+	assert.Nil(t, err)
+	alreadySet := accounts[0].Address
+	unopened := accounts[1].Address
+	failing := accounts[2].Address
+	representative := "nano_1efa1gxbitary1urzix9h13nkzadtz71n3auyj7uztb8i4qbtipu8cxz61ee"
+
+	var processed, inFlight, maxInFlight int32
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "account_info" && pr["account"] == unopened {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"error": "Account not found",
+				})
+			} else if pr["action"] == "account_info" {
+				// The frontier has hard coded work in the pow client
+				rep := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+				if pr["account"] == alreadySet {
+					rep = representative
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontier":       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+					"balance":        "11999999999999999918751838129509869131",
+					"representative": rep,
+				})
+			} else if pr["action"] == "process" {
+				current := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					seen := atomic.LoadInt32(&maxInFlight)
+					if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond * 100)
+				if pr["block"].(map[string]interface{})["account"] == failing {
+					return httpmock.NewJsonResponse(200, map[string]interface{}{
+						"error": "Fork",
+					})
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"hash": fmt.Sprintf("%064X", atomic.AddInt32(&processed, 1)),
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+
+	_, err = MockWallet.AccountsRepresentativeSet(nil, representative, nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	changes, err := MockWallet.AccountsRepresentativeSet(wallet, representative, nil)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{alreadySet, unopened}, changes.Skipped)
+	assert.Equal(t, []string{failing}, changes.Failed)
+	assert.Len(t, changes.Changed, 2)
+	var changed []string
+	for _, change := range changes.Changed {
+		changed = append(changed, change.Account)
+		assert.Len(t, change.BlockHash, 64)
+	}
+	first, err := MockWallet.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AccountIndex(0)).Only(MockWallet.Ctx)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{first.Address, accounts[3].Address}, changed)
+
+	// The change blocks were published concurrently
+	assert.Greater(t, atomic.LoadInt32(&maxInFlight), int32(1))
 }
 
 func TestWalletChangeSeed(t *testing.T) {
@@ -241,6 +507,9 @@ func TestWalletChangeSeed(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "nano_33fj9exam1ppgzaco6hjd7z1nnapnf4gh3ech4fbfkr6eotb7bui3qzukt73", newest.Address)
 	assert.NotEqual(t, oldAddress, newest.Address)
+	wallet, err = MockWallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+	assert.Equal(t, "c0e319472702d7cbe728ad05647395498a6ad498b9ae7e36a33cc37fef60f27a", wallet.Seed)
 
 	// Test with locked wallet
 	_, err = MockWallet.EncryptWallet(wallet, "password")
@@ -258,3 +527,118 @@ func TestWalletChangeSeed(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "nano_16rxu414wbt34tyn7yugup99s4xt1htrfufkwjce19ezfwfbmzrf343ynyoi", newest.Address)
 }
+
+func TestWalletFreeze(t *testing.T) {
+	// Nothing may reach the node while the wallet is frozen
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	_, err := MockWallet.WalletFreeze(nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("0e3b6d9a2c5f8e1b4e1b4d7a0c3f6e9b2d5a8c1f4e7b0d3a6c9f2e5b8d1a4c7f"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	acc, err := MockWallet.AccountCreate(wallet, nil)
+	assert.Nil(t, err)
+	other, err := MockWallet.WalletCreate(strings.Repeat("7", 64))
+	assert.Nil(t, err)
+	otherAcc, err := MockWallet.AccountCreate(other, nil)
+	assert.Nil(t, err)
+
+	frozen, err := MockWallet.WalletFreeze(wallet)
+	assert.Nil(t, err)
+	assert.NotNil(t, frozen.FrozenAt)
+	// Freezing again keeps when it was frozen
+	again, err := MockWallet.WalletFreeze(frozen)
+	assert.Nil(t, err)
+	assert.Equal(t, *frozen.FrozenAt, *again.FrozenAt)
+	stored, err := MockWallet.GetWallet(wallet.ID.String())
+	assert.Nil(t, err)
+	assert.NotNil(t, stored.FrozenAt)
+
+	_, err = MockWallet.CreateAndPublishSendBlock(stored, "1", acc.Address, otherAcc.Address, nil, nil, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	sendID := "frozen"
+	_, err = MockWallet.CreateAndPublishSendBlock(stored, "1", acc.Address, otherAcc.Address, &sendID, nil, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = MockWallet.SendWithID(stored, sendID, acc.Address, otherAcc.Address, "1", nil, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = MockWallet.CreateAndPublishReceiveBlock(stored, acc.Address, strings.Repeat("A", 64), nil, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = MockWallet.ReceiveAllBlocks(stored, acc.Address, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = MockWallet.ReceiveBatch(stored, acc.Address, []string{strings.Repeat("A", 64)}, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = MockWallet.AccountSync(stored, acc.Address, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = MockWallet.CreateAndPublishChangeBlock(stored, acc.Address, otherAcc.Address, nil, nil, false)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = MockWallet.WalletRepresentativeSet(stored, otherAcc.Address, true, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = MockWallet.AccountsRepresentativeSet(stored, otherAcc.Address, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = MockWallet.SignBlock(stored, nanoblock.StateBlock{Account: acc.Address})
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = MockWallet.PublishRawBlock(stored, nanoblock.StateBlock{Account: acc.Address}, nil, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = MockWallet.SweepToWallet(stored, acc.Address, []SweepSource{{Seed: strings.Repeat("1", 64)}}, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	// Frozen on either side of a transfer
+	_, err = MockWallet.CrossWalletTransfer(stored, other, otherAcc.Address, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = MockWallet.CrossWalletTransfer(other, stored, acc.Address, nil)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	_, err = accountPrivateKey(stored, acc)
+	assert.ErrorIs(t, err, ErrWalletFrozen)
+	assert.Equal(t, 0, httpmock.GetTotalCallCount())
+
+	// Setting the representative without changing the accounts is fine
+	_, err = MockWallet.WalletRepresentativeSet(stored, otherAcc.Address, false, nil)
+	assert.Nil(t, err)
+
+	unfrozen, err := MockWallet.WalletUnfreeze(stored)
+	assert.Nil(t, err)
+	assert.Nil(t, unfrozen.FrozenAt)
+	_, err = MockWallet.SignBlock(unfrozen, nanoblock.StateBlock{
+		Account:        acc.Address,
+		Previous:       "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3",
+		Representative: otherAcc.Address,
+		Balance:        "600",
+		Link:           "d4bbfa50649d80e5f63fc396c6f4cf6321cabd7c1480e964c2701d56aafeb5e3",
+		Work:           "205452237a9b01f4",
+	})
+	assert.Nil(t, err)
+}
+
+func TestWithContext(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	requestIDs := []string{}
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			requestIDs = append(requestIDs, req.Header.Get("X-Request-ID"))
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"balance": "1", "receivable": "0", "pending": "0"})
+		},
+	)
+
+	ctx, cancel := context.WithCancel(log.WithRequestID(context.Background(), "req-1"))
+	scoped := MockWallet.WithContext(ctx)
+	// Cancelling the request doesn't stop what the wallet is doing for it
+	cancel()
+	assert.Nil(t, scoped.Ctx.Err())
+	assert.Equal(t, "req-1", log.RequestID(scoped.Ctx))
+
+	// The node gets the request ID
+	_, err := scoped.RpcClient.MakeAccountBalanceRequest("nano_1")
+	assert.Nil(t, err)
+	_, err = MockWallet.RpcClient.MakeAccountBalanceRequest("nano_1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"req-1", ""}, requestIDs)
+
+	// The caches, events and shutdown are the wallet's
+	assert.Same(t, MockWallet.frontiers(), scoped.frontiers())
+	assert.Same(t, MockWallet.eventHub(), scoped.eventHub())
+	assert.Same(t, MockWallet.shutdown(), scoped.shutdown())
+	assert.Same(t, MockWallet, scoped.WithContext(context.Background()).root())
+}
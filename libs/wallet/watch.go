@@ -0,0 +1,90 @@
+package wallet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+// Add addresses to the wallet without their private keys, at most watch_only_max_accounts at once
+// They're listed with the wallet's other accounts, so their balances, pending blocks, history and events are too, but anything that signs for them is ErrNoPrivateKey
+// Addresses that are already in the wallet are left as they are, the returned accounts are the ones that were added
+func (w *NanoWallet) WalletAddWatch(wallet *ent.Wallet, addresses []string) ([]*ent.Account, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	} else if len(addresses) < 1 || len(addresses) > w.Config.Server.WatchOnlyMaxAccounts {
+		return nil, ErrInvalidAccountCount
+	}
+	normalized := make([]string, 0, len(addresses))
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		pub, err := utils.AddressToPub(address, w.Banano)
+		if err != nil {
+			return nil, ErrInvalidAccount
+		}
+		address = utils.PubKeyToAddress(pub, w.Banano)
+		if !seen[address] {
+			seen[address] = true
+			normalized = append(normalized, address)
+		}
+	}
+
+	// Obtain a lock, prevent concurrent calls
+	lock, err := database.GetRedisDB().Obtain(w.Ctx, fmt.Sprintf("wallet:%s", wallet.ID.String()), time.Second*10, &database.LockRetryStrategy)
+	if err != nil {
+		return nil, database.ErrLockNotObtained
+	}
+	defer lock.Release(w.Ctx)
+
+	// Determine if wallet is locked or not
+	_, err = GetDecryptedKeyFromStorage(wallet, "seed")
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID), account.AddressIn(normalized...)).All(database.WithPrimary(w.Ctx))
+	if err != nil {
+		return nil, err
+	}
+	for _, acc := range existing {
+		delete(seen, acc.Address)
+	}
+
+	tx, err := w.DB.Tx(w.Ctx)
+	if err != nil {
+		return nil, err
+	}
+	added := []*ent.Account{}
+	for _, address := range normalized {
+		if !seen[address] {
+			continue
+		}
+		acc, err := tx.Account.Create().SetWallet(wallet).SetAddress(address).SetWatchOnly(true).Save(w.Ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		added = append(added, acc)
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+// The addresses of the accounts that can sign, without the watch-only ones
+func SigningAddresses(accounts []*ent.Account) []string {
+	addresses := []string{}
+	for _, acc := range accounts {
+		if !acc.WatchOnly {
+			addresses = append(addresses, acc.Address)
+		}
+	}
+	return addresses
+}
@@ -0,0 +1,63 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/appditto/pippin_nano_wallet/libs/utils/ed25519"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletAddWatch(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	_, err := MockWallet.WalletAddWatch(nil, []string{"nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7"})
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("d4a7e0b3c6f9a2d5e8b1c4f7a0d3e6b9c2f5a8d1e4b7c0f3a6d9e2b5c8f1a4d7"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	_, own, err := MockWallet.AccountsList(wallet, 0)
+	assert.Nil(t, err)
+
+	_, err = MockWallet.WalletAddWatch(wallet, []string{})
+	assert.ErrorIs(t, err, ErrInvalidAccountCount)
+	_, err = MockWallet.WalletAddWatch(wallet, []string{"nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b8"})
+	assert.ErrorIs(t, err, ErrInvalidAccount)
+
+	// xrb_ is normalized and duplicates are added once, the wallet's own account is left alone
+	watched := "nano_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7"
+	added, err := MockWallet.WalletAddWatch(wallet, []string{watched, "xrb_3i1aq1cchnmbn9x5rsbap8b15akfh7wj7pwskuzi7ahz8oq6cobd99d4r3b7", own[0]})
+	assert.Nil(t, err)
+	assert.Len(t, added, 1)
+	assert.Equal(t, watched, added[0].Address)
+	assert.True(t, added[0].WatchOnly)
+	assert.Nil(t, added[0].PrivateKey)
+	added, err = MockWallet.WalletAddWatch(wallet, []string{watched})
+	assert.Nil(t, err)
+	assert.Empty(t, added)
+
+	// Listed with the others, but it can't sign
+	accounts, addresses, err := MockWallet.AccountsList(wallet, 0)
+	assert.Nil(t, err)
+	assert.Contains(t, addresses, watched)
+	assert.Equal(t, own, SigningAddresses(accounts))
+	_, err = MockWallet.CreateAndPublishSendBlock(wallet, "1", watched, own[0], nil, nil, nil)
+	assert.ErrorIs(t, err, ErrNoPrivateKey)
+	_, err = MockWallet.CreateAndPublishReceiveBlock(wallet, watched, "3F93C5CD2E314FA16702189041E68E68C07B27961BF37F0B7705145BEFBA3AA3", nil, nil)
+	assert.ErrorIs(t, err, ErrNoPrivateKey)
+
+	// Adding its private key makes it a normal account
+	pub, priv, _ := ed25519.GenerateKey(strings.NewReader("9e2b5d8a1c4f7e0b3d6a9c2f5e8b1d4a7c0f3e6b9d2a5c8f1e4b7d0a3c6f9e2b"))
+	address := utils.PubKeyToAddress(pub, false)
+	_, err = MockWallet.WalletAddWatch(wallet, []string{address})
+	assert.Nil(t, err)
+	acc, err := MockWallet.AdhocAccountCreate(wallet, priv)
+	assert.Nil(t, err)
+	assert.Equal(t, address, acc.Address)
+	assert.False(t, acc.WatchOnly)
+	assert.NotNil(t, acc.PrivateKey)
+}
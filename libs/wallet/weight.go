@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent/account"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+// The accounts of a wallet delegating to one representative
+type RepresentativeWeight struct {
+	// Oldest first
+	Accounts []string
+	// Sum of the balances of Accounts, receivable amounts don't count towards weight
+	TotalWeight *big.Int
+}
+
+// Group the accounts of a wallet by their representative, keyed by the representative's address
+// Balances come from one accounts_balances call and representatives from one accounts_representatives call
+// Unopened accounts have no representative, so they're left out
+func (w *NanoWallet) AccountsWeight(wallet *ent.Wallet) (map[string]*RepresentativeWeight, error) {
+	if wallet == nil {
+		return nil, ErrInvalidWallet
+	}
+
+	// Fails if the wallet is locked
+	_, err := GetDecryptedKeyFromStorage(wallet, "seed")
+	if err != nil {
+		return nil, err
+	}
+
+	weights := map[string]*RepresentativeWeight{}
+	accounts, err := w.DB.Account.Query().Where(account.WalletID(wallet.ID)).Order(ent.Asc(account.FieldCreatedAt)).All(w.Ctx)
+	if err != nil || len(accounts) == 0 {
+		return weights, err
+	}
+	addresses := make([]string, len(accounts))
+	for i, acc := range accounts {
+		addresses[i] = acc.Address
+	}
+
+	balancesResp, err := w.RpcClient.MakeAccountsBalancesRequest(addresses)
+	if err != nil {
+		return nil, err
+	}
+	representativesResp, err := w.RpcClient.MakeAccountsRepresentativesRequest(addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, address := range addresses {
+		representative, ok := (*representativesResp.Representatives)[address]
+		if !ok {
+			continue
+		}
+		// Keyed by our own prefix, so xrb_ and nano_ addresses of a representative are one group
+		pub, err := utils.AddressToPub(representative, w.Banano)
+		if err != nil {
+			return nil, errors.New("Unable to parse representative")
+		}
+		representative = utils.PubKeyToAddress(pub, w.Banano)
+
+		balance := big.NewInt(0)
+		if item, ok := (*balancesResp.Balances)[address]; ok && item.Balance != "" {
+			balance, ok = balance.SetString(item.Balance, 10)
+			if !ok {
+				return nil, errors.New("Unable to parse balance")
+			}
+		}
+
+		weight, ok := weights[representative]
+		if !ok {
+			weight = &RepresentativeWeight{
+				Accounts:    []string{},
+				TotalWeight: big.NewInt(0),
+			}
+			weights[representative] = weight
+		}
+		weight.Accounts = append(weight.Accounts, address)
+		weight.TotalWeight.Add(weight.TotalWeight, balance)
+	}
+
+	return weights, nil
+}
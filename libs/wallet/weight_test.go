@@ -0,0 +1,82 @@
+package wallet
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/appditto/pippin_nano_wallet/libs/rpc/models/requests"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountsWeight(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("7e2a9c4f1b6d3e8a5c0f7b2d9e4a1c6f3b8d5e0a7c2f9b4d1e6a3c8f5b0d7e2a"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	created, err := MockWallet.AccountsCreate(wallet, 3)
+	assert.Nil(t, err)
+	pub, _, _ := utils.KeypairFromSeed(seed, 0)
+	first := utils.PubKeyToAddress(pub, false)
+	second, third, unopened := created[0].Address, created[1].Address, created[2].Address
+
+	repA := "nano_1gyeqc6u5j3oaxbe5qy1hyz3q745a318kh8h9ocnpan7fuxnq85cxqboapu5"
+	repB := "nano_3hd4ezdgsp15iemx7h81in7xz5tpxi43b6b41zn3qmwiuypankocw3awes5k"
+	// third uses the xrb_ address of repA, it's the same representative
+	xrbRepA := "xrb_" + strings.TrimPrefix(repA, "nano_")
+	balances := map[string]string{first: "500", second: "10000", third: "7"}
+	representatives := map[string]string{first: repA, second: repB, third: xrbRepA}
+	calls := map[string]int{}
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var ar requests.AccountsRequest
+			json.NewDecoder(req.Body).Decode(&ar)
+			calls[ar.Action]++
+			switch ar.Action {
+			case "accounts_balances":
+				resp := map[string]interface{}{}
+				for _, account := range ar.Accounts {
+					if balance, ok := balances[account]; ok {
+						resp[account] = map[string]interface{}{"balance": balance, "pending": "99", "receivable": "99"}
+					}
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"balances": resp})
+			case "accounts_representatives":
+				resp := map[string]string{}
+				for _, account := range ar.Accounts {
+					if representative, ok := representatives[account]; ok {
+						resp[account] = representative
+					}
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"representatives": resp})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{"error": "error"})
+		},
+	)
+
+	weights, err := MockWallet.AccountsWeight(wallet)
+	assert.Nil(t, err)
+	assert.Len(t, weights, 2)
+	// Receivable amounts aren't weight, the unopened account has no representative
+	assert.Equal(t, []string{first, third}, weights[repA].Accounts)
+	assert.Equal(t, "507", weights[repA].TotalWeight.String())
+	assert.Equal(t, []string{second}, weights[repB].Accounts)
+	assert.Equal(t, "10000", weights[repB].TotalWeight.String())
+	for _, weight := range weights {
+		assert.NotContains(t, weight.Accounts, unopened)
+	}
+	// One call of each for all accounts
+	assert.Equal(t, 1, calls["accounts_balances"])
+	assert.Equal(t, 1, calls["accounts_representatives"])
+
+	// Locked
+	_, err = MockWallet.EncryptWallet(wallet, "password")
+	assert.Nil(t, err)
+	_, err = MockWallet.AccountsWeight(wallet)
+	assert.ErrorIs(t, err, ErrWalletLocked)
+}
@@ -0,0 +1,139 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	entwallet "github.com/appditto/pippin_nano_wallet/libs/database/ent/wallet"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+)
+
+// With work_precache_interval set, work is kept ready for the accounts of every wallet without asking for it with work_prefetch_accounts
+// Every interval the frontiers of the wallets that are unlocked and not frozen get work, and so do unopened accounts with something to receive
+// After we publish a block work for the next one is generated right away, the work for the old frontier isn't used anymore since it's kept per frontier
+// Every instance precaches for itself, the work is kept in its frontier cache
+
+func (w *NanoWallet) workPrecacheEnabled() bool {
+	return w.Config.Wallet.WorkPrecacheInterval > 0 && w.frontiers() != nil
+}
+
+// Generate work for every account of every wallet we don't have work for yet, returns how many accounts needed it
+// Locked and frozen wallets are skipped
+func (w *NanoWallet) PrecacheWork() (int, error) {
+	if w.frontiers() == nil {
+		return 0, ErrFrontierCacheDisabled
+	}
+	wallets, err := w.DB.Wallet.Query().Where(entwallet.FrozenAtIsNil()).All(w.Ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	queued := 0
+	for _, wallet := range wallets {
+		if w.ShuttingDown() {
+			break
+		}
+		roots, err := w.precacheRoots(wallet)
+		if err != nil {
+			continue
+		}
+		for address, root := range roots {
+			if _, ok := w.prefetchedWork(address, root.root, root.difficulty); ok {
+				delete(roots, address)
+			}
+		}
+		queued += len(roots)
+		w.generateWorkForRoots(wallet, roots)
+	}
+	return queued, nil
+}
+
+// The roots of the wallet's opened accounts, and of its unopened accounts that have something to receive
+func (w *NanoWallet) precacheRoots(wallet *ent.Wallet) (map[string]workRoot, error) {
+	// Fails if the wallet is locked
+	accounts, _, err := w.AccountsList(wallet, 0)
+	if err != nil {
+		return nil, err
+	}
+	// Nothing is published for watch-only accounts
+	addresses := SigningAddresses(accounts)
+	if len(addresses) < 1 {
+		return nil, nil
+	}
+	resp, err := w.RpcClient.MakeAccountsFrontiersRequest(addresses)
+	if err != nil {
+		return nil, err
+	}
+	roots := map[string]workRoot{}
+	if resp.Frontiers != nil {
+		for address, frontier := range *resp.Frontiers {
+			roots[address] = workRoot{root: frontier, difficulty: w.sendDifficulty()}
+		}
+	}
+
+	unopened := []string{}
+	for _, address := range addresses {
+		if _, ok := roots[address]; !ok {
+			unopened = append(unopened, address)
+		}
+	}
+	if len(unopened) < 1 {
+		return roots, nil
+	}
+	// The open block is a receive, its root is the account's public key
+	pending, err := w.RpcClient.MakeAccountsPendingRequest(unopened)
+	if err != nil {
+		log.Warnf("Unable to get pending blocks to precache work %s", err)
+		return roots, nil
+	}
+	for address, blocks := range *pending.Blocks {
+		if len(blocks) < 1 {
+			continue
+		}
+		pub, err := utils.AddressToPub(address, w.Config.Wallet.Banano)
+		if err != nil {
+			continue
+		}
+		roots[address] = workRoot{root: hex.EncodeToString(pub), difficulty: 1}
+	}
+	return roots, nil
+}
+
+// Generate work on hash, the new frontier of address, in the background if precaching is enabled
+func (w *NanoWallet) precacheNextWork(wallet *ent.Wallet, address string, hash string) {
+	if !w.workPrecacheEnabled() {
+		return
+	}
+	if !w.begin() {
+		return
+	}
+	go func() {
+		defer w.done()
+		w.generateWorkForRoots(wallet, map[string]workRoot{address: {root: hash, difficulty: w.sendDifficulty()}})
+	}()
+}
+
+// Precache work every tick until the wallet context is done or we're shutting down
+func (w *NanoWallet) StartWorkPrecacher(tick time.Duration) {
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	for {
+		ran := w.Background(func() {
+			if _, err := w.PrecacheWork(); err != nil {
+				log.Errorf("Error precaching work %s", err)
+			}
+		})
+		if !ran {
+			return
+		}
+		select {
+		case <-w.Ctx.Done():
+			return
+		case <-w.stopped():
+			return
+		case <-ticker.C:
+		}
+	}
+}
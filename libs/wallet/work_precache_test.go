@@ -0,0 +1,149 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrecacheWork(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	conf := *MockWallet.Config
+	conf.Wallet.Banano = true
+	conf.Wallet.WorkPrecacheInterval = 60
+	precacheWallet := &NanoWallet{
+		DB:         MockWallet.DB,
+		Ctx:        MockWallet.Ctx,
+		Banano:     true,
+		RpcClient:  MockWallet.RpcClient,
+		WorkClient: pow.NewPippinPow([]string{"http://workpeer"}, "", "", nil),
+		Config:     &conf,
+	}
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("a3f6c9e2b5d8a1f4c7e0b3d6a9c2f5e8b1d4a7c0f3e6b9d2a5c8f1e4b7d0a3c6"))
+	wallet, err := precacheWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	accounts, err := precacheWallet.AccountsCreate(wallet, 3)
+	assert.Nil(t, err)
+	pub, _ := utils.AddressToPub(accounts[2].Address, true)
+	openRoot := hex.EncodeToString(pub)
+
+	// Real 1x work for each root, so the peer's work passes validation
+	work := map[string]string{
+		"0D7F1B3A6E2C9F4B8A5D1E7C3F6B9A2D4E8C1F5A7B3D9E6C2A4F8B1D5E7C3A96": "00000001003f78f6",
+		"5B2E8D1F4A7C3E9B6D2F8A1C5E7B3D9F4A6C2E8B1D5F7A3C9E2B6D8F1A4C7E53": "000000010033523d",
+		"9E4A1C7F3B6D2E8A5C1F7B4D9E3A6C2F8B5D1E7A4C9F3B6E2D8A1C5F7B4E9D20": "0000000100da78e2",
+		openRoot: "00000001009ad7f3",
+	}
+	frontiers := map[string]string{
+		accounts[0].Address: "0D7F1B3A6E2C9F4B8A5D1E7C3F6B9A2D4E8C1F5A7B3D9E6C2A4F8B1D5E7C3A96",
+		accounts[1].Address: "5B2E8D1F4A7C3E9B6D2F8A1C5E7B3D9F4A6C2E8B1D5F7A3C9E2B6D8F1A4C7E53",
+	}
+	// Only the unopened account has something to receive
+	pending := map[string][]string{
+		accounts[2].Address: {"FB20236176F12827E71FD1F2928C8ABCBE6D2D9EE02E8BE8AC13F13AAF5575AE"},
+	}
+
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			// Other tests' wallets are in the same database, they have no frontiers or pending blocks
+			requested := map[string]bool{}
+			for _, address := range pr["accounts"].([]interface{}) {
+				requested[address.(string)] = true
+			}
+			switch pr["action"] {
+			case "accounts_frontiers":
+				found := map[string]string{}
+				for address, frontier := range frontiers {
+					if requested[address] {
+						found[address] = frontier
+					}
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"frontiers": found})
+			case "accounts_pending":
+				found := map[string][]string{}
+				for address, blocks := range pending {
+					if requested[address] {
+						found[address] = blocks
+					}
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{"blocks": found})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+	var generateCalls int32
+	httpmock.RegisterResponder("POST", "http://workpeer",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] != "work_generate" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{})
+			}
+			atomic.AddInt32(&generateCalls, 1)
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"work": work[pr["hash"].(string)],
+				"hash": pr["hash"],
+			})
+		},
+	)
+
+	// Both frontiers and the open block of the account with something to receive
+	queued, err := precacheWallet.PrecacheWork()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, queued)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&generateCalls))
+	for address, frontier := range frontiers {
+		got, ok := precacheWallet.prefetchedWork(address, frontier, 1)
+		assert.True(t, ok)
+		assert.Equal(t, work[frontier], got)
+	}
+	got, ok := precacheWallet.prefetchedWork(accounts[2].Address, openRoot, 1)
+	assert.True(t, ok)
+	assert.Equal(t, "00000001009ad7f3", got)
+
+	// Nothing is generated again while the frontiers are the same
+	queued, err = precacheWallet.PrecacheWork()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, queued)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&generateCalls))
+
+	// A block we publish gets work for the next one, the work for the old frontier is no longer used
+	next := "9E4A1C7F3B6D2E8A5C1F7B4D9E3A6C2F8B5D1E7A4C9F3B6E2D8A1C5F7B4E9D20"
+	precacheWallet.precacheNextWork(wallet, accounts[0].Address, next)
+	assert.Eventually(t, func() bool {
+		got, ok := precacheWallet.prefetchedWork(accounts[0].Address, next, 1)
+		return ok && got == work[next]
+	}, time.Second*5, time.Millisecond*10)
+	_, ok = precacheWallet.prefetchedWork(accounts[0].Address, frontiers[accounts[0].Address], 1)
+	assert.False(t, ok)
+
+	// Frozen wallets are skipped
+	_, err = precacheWallet.WalletFreeze(wallet)
+	assert.Nil(t, err)
+	precacheWallet.frontiers().Invalidate(accounts[1].Address)
+	queued, err = precacheWallet.PrecacheWork()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, queued)
+
+	// Nothing after publishing when precaching is off
+	conf.Wallet.WorkPrecacheInterval = 0
+	precacheWallet.precacheNextWork(wallet, accounts[1].Address, frontiers[accounts[1].Address])
+	time.Sleep(time.Millisecond * 50)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&generateCalls))
+}
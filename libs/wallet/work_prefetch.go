@@ -0,0 +1,89 @@
+package wallet
+
+import (
+	"errors"
+
+	"github.com/appditto/pippin_nano_wallet/libs/database/ent"
+	"github.com/appditto/pippin_nano_wallet/libs/log"
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"golang.org/x/sync/errgroup"
+)
+
+var ErrFrontierCacheDisabled = errors.New("frontier cache is disabled")
+
+// Generate work for the frontier of every opened account in the wallet ahead of time, so the next block doesn't wait for it
+// The work is for a send, which is also enough for a receive or change, and it's kept in the frontier cache
+// Returns how many accounts were queued, the work is generated in the background, up to work_prefetch_concurrency at once
+func (w *NanoWallet) WorkPrefetch(wallet *ent.Wallet) (int, error) {
+	if wallet == nil {
+		return 0, ErrInvalidWallet
+	}
+	// Nowhere to keep the work
+	if w.frontiers() == nil {
+		return 0, ErrFrontierCacheDisabled
+	}
+
+	accounts, _, err := w.AccountsList(wallet, 0)
+	if err != nil {
+		return 0, err
+	}
+	addresses := SigningAddresses(accounts)
+	if len(addresses) < 1 {
+		return 0, nil
+	}
+
+	// Unopened accounts are in errors, they have no frontier to generate work for
+	resp, err := w.RpcClient.MakeAccountsFrontiersRequest(addresses)
+	if err != nil {
+		return 0, err
+	}
+	frontiers := map[string]string{}
+	if resp.Frontiers != nil {
+		frontiers = *resp.Frontiers
+	}
+
+	roots := make(map[string]workRoot, len(frontiers))
+	for address, frontier := range frontiers {
+		roots[address] = workRoot{root: frontier, difficulty: w.sendDifficulty()}
+	}
+	go w.generateWorkForRoots(wallet, roots)
+
+	return len(frontiers), nil
+}
+
+// The root to generate work for an account's next block on, with the difficulty it needs
+type workRoot struct {
+	root       string
+	difficulty int
+}
+
+// The difficulty of a send, which is also enough for a receive or change
+func (w *NanoWallet) sendDifficulty() int {
+	return pow.SendMultiplier(w.Config.Wallet.Banano)
+}
+
+// Generate work for the root of every address and keep it in the frontier cache, up to work_prefetch_concurrency at once
+// Roots we already have work for are skipped
+func (w *NanoWallet) generateWorkForRoots(wallet *ent.Wallet, roots map[string]workRoot) {
+	var g errgroup.Group
+	g.SetLimit(max(w.Config.Wallet.WorkPrefetchConcurrency, 1))
+	for address, root := range roots {
+		if _, ok := w.prefetchedWork(address, root.root, root.difficulty); ok {
+			continue
+		}
+		g.Go(func() error {
+			// Work that didn't start isn't needed, it's generated again after the next start
+			if w.ShuttingDown() {
+				return nil
+			}
+			work, err := w.generateWork(wallet.ID, address, nil, root.root, root.difficulty, false, "")
+			if err != nil {
+				log.Warnf("Unable to prefetch work for %s %s", address, err)
+				return nil
+			}
+			w.frontiers().SetWork(address, root.root, work)
+			return nil
+		})
+	}
+	g.Wait()
+}
@@ -0,0 +1,127 @@
+package wallet
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/appditto/pippin_nano_wallet/libs/pow"
+	"github.com/appditto/pippin_nano_wallet/libs/utils"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkPrefetch(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	seed, _ := utils.GenerateSeed(strings.NewReader("2c5f8b1e4a7d0c3f6b9e2a5d8c1f4b7e0a3d6c9f2b5e8a1d4c7f0b3e6a9d2c58"))
+	wallet, err := MockWallet.WalletCreate(seed)
+	assert.Nil(t, err)
+	accounts, err := MockWallet.AccountsCreate(wallet, 3)
+	assert.Nil(t, err)
+	_, addresses, err := MockWallet.AccountsList(wallet, 0)
+	assert.Nil(t, err)
+	unopened := accounts[2].Address
+
+	// Real 1x work for each frontier, so the peer's work passes validation
+	work := map[string]string{
+		"0D7F1B3A6E2C9F4B8A5D1E7C3F6B9A2D4E8C1F5A7B3D9E6C2A4F8B1D5E7C3A96": "00000001003f78f6",
+		"5B2E8D1F4A7C3E9B6D2F8A1C5E7B3D9F4A6C2E8B1D5F7A3C9E2B6D8F1A4C7E53": "000000010033523d",
+		"9E4A1C7F3B6D2E8A5C1F7B4D9E3A6C2F8B5D1E7A4C9F3B6E2D8A1C5F7B4E9D20": "0000000100da78e2",
+	}
+	frontiers := map[string]string{}
+	i := 0
+	for hash := range work {
+		if addresses[i] == unopened {
+			i++
+		}
+		frontiers[addresses[i]] = hash
+		i++
+	}
+
+	httpmock.RegisterResponder("POST", "/mockrpcendpoint",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] == "accounts_frontiers" {
+				return httpmock.NewJsonResponse(200, map[string]interface{}{
+					"frontiers": frontiers,
+					"errors": map[string]interface{}{
+						unopened: "Account not found",
+					},
+				})
+			}
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"error": "error",
+			})
+		},
+	)
+	var generateCalls, cancelCalls int32
+	httpmock.RegisterResponder("POST", "http://workpeer",
+		func(req *http.Request) (*http.Response, error) {
+			var pr map[string]interface{}
+			json.NewDecoder(req.Body).Decode(&pr)
+			if pr["action"] != "work_generate" {
+				if pr["action"] == "work_cancel" {
+					atomic.AddInt32(&cancelCalls, 1)
+				}
+				return httpmock.NewJsonResponse(200, map[string]interface{}{})
+			}
+			atomic.AddInt32(&generateCalls, 1)
+			return httpmock.NewJsonResponse(200, map[string]interface{}{
+				"work": work[pr["hash"].(string)],
+				"hash": pr["hash"],
+			})
+		},
+	)
+
+	conf := *MockWallet.Config
+	conf.Wallet.Banano = true
+	prefetchWallet := &NanoWallet{
+		DB:         MockWallet.DB,
+		Ctx:        MockWallet.Ctx,
+		Banano:     true,
+		RpcClient:  MockWallet.RpcClient,
+		WorkClient: pow.NewPippinPow([]string{"http://workpeer"}, "", "", nil),
+		Config:     &conf,
+	}
+
+	_, err = prefetchWallet.WorkPrefetch(nil)
+	assert.ErrorIs(t, err, ErrInvalidWallet)
+
+	queued, err := prefetchWallet.WorkPrefetch(wallet)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, queued)
+
+	// Work is generated in the background, once for every opened account
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&generateCalls) == 3 && atomic.LoadInt32(&cancelCalls) == 3
+	}, time.Second*5, time.Millisecond*10)
+	for address, frontier := range frontiers {
+		assert.Eventually(t, func() bool {
+			got, ok := prefetchWallet.prefetchedWork(address, frontier, 1)
+			return ok && got == work[frontier]
+		}, time.Second*5, time.Millisecond*10)
+	}
+	_, ok := prefetchWallet.frontiers().Work(unopened, "")
+	assert.False(t, ok)
+	// Not enough for a nano send
+	_, ok = prefetchWallet.prefetchedWork(addresses[0], frontiers[addresses[0]], 64)
+	assert.False(t, ok)
+
+	// Nowhere to keep the work
+	conf.Wallet.FrontierCacheSize = 0
+	disabledWallet := &NanoWallet{
+		DB:         MockWallet.DB,
+		Ctx:        MockWallet.Ctx,
+		RpcClient:  MockWallet.RpcClient,
+		WorkClient: MockWallet.WorkClient,
+		Config:     &conf,
+	}
+	_, err = disabledWallet.WorkPrefetch(wallet)
+	assert.ErrorIs(t, err, ErrFrontierCacheDisabled)
+}